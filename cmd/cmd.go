@@ -17,6 +17,7 @@ func Run() error {
 		Usage: "IM Providers Service",
 		Commands: []*cli.Command{
 			apiCmd(),
+			reconcileProfilesCmd(),
 		},
 	}
 
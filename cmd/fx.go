@@ -15,11 +15,22 @@ import (
 	grpcsrv "github.com/webitel/im-providers-service/infra/srv/grpc"
 	httpsrv "github.com/webitel/im-providers-service/infra/srv/http"
 	"github.com/webitel/im-providers-service/infra/tls"
+	"github.com/webitel/im-providers-service/internal/applebusiness"
 	"github.com/webitel/im-providers-service/internal/core"
 	sharedhandler "github.com/webitel/im-providers-service/internal/core/handler"
 	"github.com/webitel/im-providers-service/internal/core/webhook"
+	"github.com/webitel/im-providers-service/internal/custom"
+	"github.com/webitel/im-providers-service/internal/email"
 	"github.com/webitel/im-providers-service/internal/facebook"
+	"github.com/webitel/im-providers-service/internal/line"
 	"github.com/webitel/im-providers-service/internal/provider"
+	"github.com/webitel/im-providers-service/internal/slack"
+	"github.com/webitel/im-providers-service/internal/sms"
+	"github.com/webitel/im-providers-service/internal/teams"
+	"github.com/webitel/im-providers-service/internal/telegramapp"
+	"github.com/webitel/im-providers-service/internal/viber"
+	"github.com/webitel/im-providers-service/internal/vk"
+	"github.com/webitel/im-providers-service/internal/webchat"
 	"github.com/webitel/im-providers-service/internal/whatsapp"
 	"github.com/webitel/im-providers-service/pkg/crypto"
 	"go.uber.org/fx"
@@ -46,6 +57,17 @@ func NewApp(cfg *config.Config) *fx.App {
 		core.Module,
 		facebook.Module,
 		whatsapp.Module,
+		viber.Module,
+		telegramapp.Module,
+		custom.Module,
+		sms.Module,
+		email.Module,
+		line.Module,
+		slack.Module,
+		teams.Module,
+		applebusiness.Module,
+		webchat.Module,
+		vk.Module,
 		webhook.Module,
 		grpcsrv.Module,
 		httpsrv.Module,
@@ -54,7 +76,7 @@ func NewApp(cfg *config.Config) *fx.App {
 }
 
 // ProvideRouter sets up the Chi router with dynamic path parameters.
-func ProvideRouter(wh *webhook.Handler, cfg *config.Config, logger *slog.Logger) http.Handler {
+func ProvideRouter(wh *webhook.Handler, wc *webchat.Handler, cfg *config.Config, logger *slog.Logger) http.Handler {
 	r := chi.NewRouter()
 
 	// Sanitize base path (e.g., "/wh")
@@ -73,6 +95,11 @@ func ProvideRouter(wh *webhook.Handler, cfg *config.Config, logger *slog.Logger)
 
 	// Handle both GET (verify) and POST (events)
 	r.HandleFunc(fullPath, wh.ServeHTTP)
+	// Apple Messages for Business posts to <endpoint>/message.
+	r.HandleFunc(fullPath+"/message", wh.ServeHTTP)
+
+	// The web chat widget API, called by the widgets from the websites of the customers.
+	r.Mount(webchat.BasePath, wc)
 
 	return r
 }
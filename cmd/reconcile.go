@@ -0,0 +1,73 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/urfave/cli/v2"
+	"github.com/webitel/im-providers-service/config"
+	"github.com/webitel/im-providers-service/infra/db/pg"
+	"github.com/webitel/im-providers-service/internal/core"
+	sharedstore "github.com/webitel/im-providers-service/internal/core/store"
+	"github.com/webitel/im-providers-service/internal/facebook"
+	fbservice "github.com/webitel/im-providers-service/internal/facebook/service"
+	"github.com/webitel/im-providers-service/pkg/crypto"
+	"go.uber.org/fx"
+)
+
+// reconcileProfilesCmd re-pushes the stored Messenger Profiles (persistent menu,
+// get started, greeting, ice breakers) for every gate of a MetaApp.
+// Run it after rotating page tokens: Facebook drops the profile together with the old token.
+func reconcileProfilesCmd() *cli.Command {
+	return &cli.Command{
+		Name:      "reconcile-profiles",
+		Usage:     "Re-push stored Messenger Profiles for all gates of a MetaApp",
+		ArgsUsage: "<meta_app_id>",
+		Action: func(c *cli.Context) error {
+			metaAppID := c.Args().First()
+			if metaAppID == "" {
+				return cli.Exit("meta_app_id argument is required", 1)
+			}
+
+			cfg, err := config.LoadConfig()
+			if err != nil {
+				return err
+			}
+
+			var svc fbservice.FacebookManager
+			app := fx.New(
+				fx.NopLogger,
+				fx.Supply(cfg),
+				fx.Provide(
+					ProvideLogger,
+					core.ProvideNewDBConnection,
+					pg.ProvidePgxPool,
+					func() (sharedstore.GateCache, error) {
+						return sharedstore.NewLRUCache(1000)
+					},
+				),
+				crypto.Module,
+				facebook.ProfileModule,
+				fx.Populate(&svc),
+			)
+
+			if err := app.Start(c.Context); err != nil {
+				return err
+			}
+			defer app.Stop(context.Background())
+
+			res, err := svc.ReconcileProfiles(c.Context, metaAppID)
+			if err != nil {
+				return err
+			}
+
+			slog.Info("messenger profiles reconciled",
+				"meta_app_id", metaAppID, "pushed", res.Pushed, "skipped", res.Skipped, "failed", res.Failed)
+			if len(res.Failed) > 0 {
+				return cli.Exit(fmt.Sprintf("%d gate(s) failed to reconcile", len(res.Failed)), 1)
+			}
+			return nil
+		},
+	}
+}
@@ -14,21 +14,37 @@ import (
 )
 
 type Config struct {
-	Service  ServiceConfig    `mapstructure:"service"`
-	Log      appconfig.Log    `mapstructure:"log"`
-	Postgres PostgresConfig   `mapstructure:"postgres"`
-	Redis    appconfig.Redis  `mapstructure:"redis"`
-	Consul   appconfig.Consul `mapstructure:"consul"`
+	Service     ServiceConfig     `mapstructure:"service"`
+	Log         appconfig.Log     `mapstructure:"log"`
+	Postgres    PostgresConfig    `mapstructure:"postgres"`
+	Redis       appconfig.Redis   `mapstructure:"redis"`
+	Consul      appconfig.Consul  `mapstructure:"consul"`
+	Facebook    FacebookConfig    `mapstructure:"facebook"`
+	TelegramApp TelegramAppConfig `mapstructure:"telegram_app"`
 }
 
 type ServiceConfig struct {
 	GRPCAddr    string             `mapstructure:"addr"`
 	HTTPAddr    string             `mapstructure:"http_addr"`
 	WebhookPath string             `mapstructure:"webhook_path"`
+	PublicURL   string             `mapstructure:"public_url"` // externally reachable base URL, used to build webhook URLs
 	Connection  appconfig.GRPCConn `mapstructure:"conn"`
 	SecretKey   string             `mapstructure:"secret_key"`
 }
 
+// FacebookConfig holds Messenger provider tuning.
+type FacebookConfig struct {
+	// ProfileTTL is how long a fetched user profile is reused before it is requested again.
+	ProfileTTL time.Duration `mapstructure:"profile_ttl"`
+}
+
+// TelegramAppConfig identifies the Telegram application registered at my.telegram.org.
+// Without it no Telegram user account can sign in.
+type TelegramAppConfig struct {
+	APIID   int    `mapstructure:"api_id"`
+	APIHash string `mapstructure:"api_hash"`
+}
+
 // PostgresConfig extends the basic DSN with connection-pool options specific to this service.
 type PostgresConfig struct {
 	DSN      string   `mapstructure:"dsn"`
@@ -108,6 +124,8 @@ func LoadConfig() (*Config, error) {
 	loader.RegisterFlags(pflag.CommandLine)
 	registerServiceFlags()
 	registerPostgresFlags()
+	registerFacebookFlags()
+	registerTelegramAppFlags()
 	pflag.Parse()
 
 	cfg := &Config{}
@@ -142,6 +160,7 @@ func registerServiceFlags() {
 	pflag.String("service.addr", "localhost:8080", "gRPC listen address")
 	pflag.String("service.http_addr", ":8085", "HTTP listen address")
 	pflag.String("service.webhook_path", "/wh", "Base path for incoming webhooks")
+	pflag.String("service.public_url", "", "Public base URL of the HTTP server (e.g. https://im.example.com)")
 	pflag.String("service.secret_key", "", "32-byte AES key for token encryption (required)")
 
 	appconfig.RegisterGRPCConnFlags(pflag.CommandLine, "service.conn", false)
@@ -161,6 +180,15 @@ func registerPostgresFlags() {
 	pflag.String("postgres.application_name", "webitel-im-provider", "application_name sent to PostgreSQL")
 }
 
+func registerFacebookFlags() {
+	pflag.Duration("facebook.profile_ttl", 24*time.Hour, "How long Facebook user profiles are cached")
+}
+
+func registerTelegramAppFlags() {
+	pflag.Int("telegram_app.api_id", 0, "Telegram API ID of the application user accounts sign in through")
+	pflag.String("telegram_app.api_hash", "", "Telegram API hash of the application user accounts sign in through")
+}
+
 func (c *Config) validate() error {
 	if c.Service.GRPCAddr == "" {
 		return fmt.Errorf("config: service.addr is required")
@@ -173,10 +201,16 @@ func (c *Config) validate() error {
 		c.Service.WebhookPath = "/" + c.Service.WebhookPath
 	}
 
+	c.Service.PublicURL = strings.TrimRight(c.Service.PublicURL, "/")
+
 	if err := appconfig.ValidateGRPCConn("service.conn", c.Service.Connection); err != nil {
 		return err
 	}
 
+	if c.Facebook.ProfileTTL <= 0 {
+		c.Facebook.ProfileTTL = 24 * time.Hour
+	}
+
 	if c.Postgres.DSN == "" {
 		return errors.InvalidArgument("postgres.dsn is required", errors.WithID("config.config.validate"))
 	}
@@ -0,0 +1,947 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.34.1
+// 	protoc        (unknown)
+// source: service/provider/v1/apple_business_service.proto
+
+package provider
+
+import (
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// / ProviderAppleBusinessGate is a business registered for Apple Messages for Business, connected as a messaging gateway.
+type ProviderAppleBusinessGate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id         string         `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name       string         `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Peer       *Peer          `protobuf:"bytes,3,opt,name=peer,proto3" json:"peer,omitempty"`                               // Identity details (sub and iss)
+	BusinessId string         `protobuf:"bytes,4,opt,name=business_id,json=businessId,proto3" json:"business_id,omitempty"` // Messages for Business account ID
+	CspId      string         `protobuf:"bytes,5,opt,name=csp_id,json=cspId,proto3" json:"csp_id,omitempty"`                // MSP account ID issued by Apple Business Register
+	WebhookUrl string         `protobuf:"bytes,6,opt,name=webhook_url,json=webhookUrl,proto3" json:"webhook_url,omitempty"` // MSP endpoint to register with Apple
+	Status     ProviderStatus `protobuf:"varint,7,opt,name=status,proto3,enum=webitel.im.provider.v1.ProviderStatus" json:"status,omitempty"`
+	CreatedAt  int64          `protobuf:"varint,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"` // Unix timestamp in milliseconds
+	UpdatedAt  int64          `protobuf:"varint,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"` // Unix timestamp in milliseconds
+	Enabled    bool           `protobuf:"varint,10,opt,name=enabled,proto3" json:"enabled,omitempty"`
+}
+
+func (x *ProviderAppleBusinessGate) Reset() {
+	*x = ProviderAppleBusinessGate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_provider_v1_apple_business_service_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProviderAppleBusinessGate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProviderAppleBusinessGate) ProtoMessage() {}
+
+func (x *ProviderAppleBusinessGate) ProtoReflect() protoreflect.Message {
+	mi := &file_service_provider_v1_apple_business_service_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProviderAppleBusinessGate.ProtoReflect.Descriptor instead.
+func (*ProviderAppleBusinessGate) Descriptor() ([]byte, []int) {
+	return file_service_provider_v1_apple_business_service_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ProviderAppleBusinessGate) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ProviderAppleBusinessGate) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ProviderAppleBusinessGate) GetPeer() *Peer {
+	if x != nil {
+		return x.Peer
+	}
+	return nil
+}
+
+func (x *ProviderAppleBusinessGate) GetBusinessId() string {
+	if x != nil {
+		return x.BusinessId
+	}
+	return ""
+}
+
+func (x *ProviderAppleBusinessGate) GetCspId() string {
+	if x != nil {
+		return x.CspId
+	}
+	return ""
+}
+
+func (x *ProviderAppleBusinessGate) GetWebhookUrl() string {
+	if x != nil {
+		return x.WebhookUrl
+	}
+	return ""
+}
+
+func (x *ProviderAppleBusinessGate) GetStatus() ProviderStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ProviderStatus_PROVIDER_STATUS_UNSPECIFIED
+}
+
+func (x *ProviderAppleBusinessGate) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *ProviderAppleBusinessGate) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+func (x *ProviderAppleBusinessGate) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+type ProviderCreateAppleBusinessGateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name       string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	BusinessId string `protobuf:"bytes,2,opt,name=business_id,json=businessId,proto3" json:"business_id,omitempty"`
+	CspId      string `protobuf:"bytes,3,opt,name=csp_id,json=cspId,proto3" json:"csp_id,omitempty"`
+	Secret     string `protobuf:"bytes,4,opt,name=secret,proto3" json:"secret,omitempty"` // Base64-encoded MSP secret, as issued by Apple
+	Peer       *Peer  `protobuf:"bytes,5,opt,name=peer,proto3" json:"peer,omitempty"`     // Identity details (sub and iss)
+}
+
+func (x *ProviderCreateAppleBusinessGateRequest) Reset() {
+	*x = ProviderCreateAppleBusinessGateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_provider_v1_apple_business_service_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProviderCreateAppleBusinessGateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProviderCreateAppleBusinessGateRequest) ProtoMessage() {}
+
+func (x *ProviderCreateAppleBusinessGateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_provider_v1_apple_business_service_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProviderCreateAppleBusinessGateRequest.ProtoReflect.Descriptor instead.
+func (*ProviderCreateAppleBusinessGateRequest) Descriptor() ([]byte, []int) {
+	return file_service_provider_v1_apple_business_service_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ProviderCreateAppleBusinessGateRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ProviderCreateAppleBusinessGateRequest) GetBusinessId() string {
+	if x != nil {
+		return x.BusinessId
+	}
+	return ""
+}
+
+func (x *ProviderCreateAppleBusinessGateRequest) GetCspId() string {
+	if x != nil {
+		return x.CspId
+	}
+	return ""
+}
+
+func (x *ProviderCreateAppleBusinessGateRequest) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+func (x *ProviderCreateAppleBusinessGateRequest) GetPeer() *Peer {
+	if x != nil {
+		return x.Peer
+	}
+	return nil
+}
+
+type ProviderCreateAppleBusinessGateResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Item *ProviderAppleBusinessGate `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`
+}
+
+func (x *ProviderCreateAppleBusinessGateResponse) Reset() {
+	*x = ProviderCreateAppleBusinessGateResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_provider_v1_apple_business_service_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProviderCreateAppleBusinessGateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProviderCreateAppleBusinessGateResponse) ProtoMessage() {}
+
+func (x *ProviderCreateAppleBusinessGateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_provider_v1_apple_business_service_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProviderCreateAppleBusinessGateResponse.ProtoReflect.Descriptor instead.
+func (*ProviderCreateAppleBusinessGateResponse) Descriptor() ([]byte, []int) {
+	return file_service_provider_v1_apple_business_service_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ProviderCreateAppleBusinessGateResponse) GetItem() *ProviderAppleBusinessGate {
+	if x != nil {
+		return x.Item
+	}
+	return nil
+}
+
+type ProviderGetAppleBusinessGateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *ProviderGetAppleBusinessGateRequest) Reset() {
+	*x = ProviderGetAppleBusinessGateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_provider_v1_apple_business_service_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProviderGetAppleBusinessGateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProviderGetAppleBusinessGateRequest) ProtoMessage() {}
+
+func (x *ProviderGetAppleBusinessGateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_provider_v1_apple_business_service_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProviderGetAppleBusinessGateRequest.ProtoReflect.Descriptor instead.
+func (*ProviderGetAppleBusinessGateRequest) Descriptor() ([]byte, []int) {
+	return file_service_provider_v1_apple_business_service_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ProviderGetAppleBusinessGateRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ProviderGetAppleBusinessGateResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Item *ProviderAppleBusinessGate `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`
+}
+
+func (x *ProviderGetAppleBusinessGateResponse) Reset() {
+	*x = ProviderGetAppleBusinessGateResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_provider_v1_apple_business_service_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProviderGetAppleBusinessGateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProviderGetAppleBusinessGateResponse) ProtoMessage() {}
+
+func (x *ProviderGetAppleBusinessGateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_provider_v1_apple_business_service_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProviderGetAppleBusinessGateResponse.ProtoReflect.Descriptor instead.
+func (*ProviderGetAppleBusinessGateResponse) Descriptor() ([]byte, []int) {
+	return file_service_provider_v1_apple_business_service_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ProviderGetAppleBusinessGateResponse) GetItem() *ProviderAppleBusinessGate {
+	if x != nil {
+		return x.Item
+	}
+	return nil
+}
+
+// / ProviderUpdateAppleBusinessGateRequest changes the gate; the business ID is fixed.
+type ProviderUpdateAppleBusinessGateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id      string  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name    *string `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	CspId   *string `protobuf:"bytes,3,opt,name=csp_id,json=cspId,proto3,oneof" json:"csp_id,omitempty"`
+	Secret  *string `protobuf:"bytes,4,opt,name=secret,proto3,oneof" json:"secret,omitempty"`
+	Enabled *bool   `protobuf:"varint,5,opt,name=enabled,proto3,oneof" json:"enabled,omitempty"`
+	Peer    *Peer   `protobuf:"bytes,6,opt,name=peer,proto3" json:"peer,omitempty"` // Identity details (sub and iss); kept when unset
+}
+
+func (x *ProviderUpdateAppleBusinessGateRequest) Reset() {
+	*x = ProviderUpdateAppleBusinessGateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_provider_v1_apple_business_service_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProviderUpdateAppleBusinessGateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProviderUpdateAppleBusinessGateRequest) ProtoMessage() {}
+
+func (x *ProviderUpdateAppleBusinessGateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_provider_v1_apple_business_service_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProviderUpdateAppleBusinessGateRequest.ProtoReflect.Descriptor instead.
+func (*ProviderUpdateAppleBusinessGateRequest) Descriptor() ([]byte, []int) {
+	return file_service_provider_v1_apple_business_service_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ProviderUpdateAppleBusinessGateRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ProviderUpdateAppleBusinessGateRequest) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *ProviderUpdateAppleBusinessGateRequest) GetCspId() string {
+	if x != nil && x.CspId != nil {
+		return *x.CspId
+	}
+	return ""
+}
+
+func (x *ProviderUpdateAppleBusinessGateRequest) GetSecret() string {
+	if x != nil && x.Secret != nil {
+		return *x.Secret
+	}
+	return ""
+}
+
+func (x *ProviderUpdateAppleBusinessGateRequest) GetEnabled() bool {
+	if x != nil && x.Enabled != nil {
+		return *x.Enabled
+	}
+	return false
+}
+
+func (x *ProviderUpdateAppleBusinessGateRequest) GetPeer() *Peer {
+	if x != nil {
+		return x.Peer
+	}
+	return nil
+}
+
+type ProviderUpdateAppleBusinessGateResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Item *ProviderAppleBusinessGate `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`
+}
+
+func (x *ProviderUpdateAppleBusinessGateResponse) Reset() {
+	*x = ProviderUpdateAppleBusinessGateResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_provider_v1_apple_business_service_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProviderUpdateAppleBusinessGateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProviderUpdateAppleBusinessGateResponse) ProtoMessage() {}
+
+func (x *ProviderUpdateAppleBusinessGateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_provider_v1_apple_business_service_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProviderUpdateAppleBusinessGateResponse.ProtoReflect.Descriptor instead.
+func (*ProviderUpdateAppleBusinessGateResponse) Descriptor() ([]byte, []int) {
+	return file_service_provider_v1_apple_business_service_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ProviderUpdateAppleBusinessGateResponse) GetItem() *ProviderAppleBusinessGate {
+	if x != nil {
+		return x.Item
+	}
+	return nil
+}
+
+type ProviderDeleteAppleBusinessGateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *ProviderDeleteAppleBusinessGateRequest) Reset() {
+	*x = ProviderDeleteAppleBusinessGateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_provider_v1_apple_business_service_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProviderDeleteAppleBusinessGateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProviderDeleteAppleBusinessGateRequest) ProtoMessage() {}
+
+func (x *ProviderDeleteAppleBusinessGateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_provider_v1_apple_business_service_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProviderDeleteAppleBusinessGateRequest.ProtoReflect.Descriptor instead.
+func (*ProviderDeleteAppleBusinessGateRequest) Descriptor() ([]byte, []int) {
+	return file_service_provider_v1_apple_business_service_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ProviderDeleteAppleBusinessGateRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ProviderDeleteAppleBusinessGateResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Item *ProviderAppleBusinessGate `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`
+}
+
+func (x *ProviderDeleteAppleBusinessGateResponse) Reset() {
+	*x = ProviderDeleteAppleBusinessGateResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_provider_v1_apple_business_service_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProviderDeleteAppleBusinessGateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProviderDeleteAppleBusinessGateResponse) ProtoMessage() {}
+
+func (x *ProviderDeleteAppleBusinessGateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_provider_v1_apple_business_service_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProviderDeleteAppleBusinessGateResponse.ProtoReflect.Descriptor instead.
+func (*ProviderDeleteAppleBusinessGateResponse) Descriptor() ([]byte, []int) {
+	return file_service_provider_v1_apple_business_service_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ProviderDeleteAppleBusinessGateResponse) GetItem() *ProviderAppleBusinessGate {
+	if x != nil {
+		return x.Item
+	}
+	return nil
+}
+
+var File_service_provider_v1_apple_business_service_proto protoreflect.FileDescriptor
+
+var file_service_provider_v1_apple_business_service_proto_rawDesc = []byte{
+	0x0a, 0x30, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64,
+	0x65, 0x72, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x70, 0x70, 0x6c, 0x65, 0x5f, 0x62, 0x75, 0x73, 0x69,
+	0x6e, 0x65, 0x73, 0x73, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x12, 0x16, 0x77, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x2e, 0x69, 0x6d, 0x2e, 0x70,
+	0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x2f, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x2f, 0x76, 0x31, 0x2f, 0x65, 0x6e,
+	0x75, 0x6d, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x22, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x2f, 0x76, 0x31, 0x2f, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xe2, 0x02,
+	0x0a, 0x19, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x41, 0x70, 0x70, 0x6c, 0x65, 0x42,
+	0x75, 0x73, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x47, 0x61, 0x74, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x30, 0x0a, 0x04, 0x70, 0x65, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
+	0x77, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x2e, 0x69, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69,
+	0x64, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x65, 0x65, 0x72, 0x52, 0x04, 0x70, 0x65, 0x65,
+	0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x62, 0x75, 0x73, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x5f, 0x69, 0x64,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x62, 0x75, 0x73, 0x69, 0x6e, 0x65, 0x73, 0x73,
+	0x49, 0x64, 0x12, 0x15, 0x0a, 0x06, 0x63, 0x73, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x63, 0x73, 0x70, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x77, 0x65, 0x62,
+	0x68, 0x6f, 0x6f, 0x6b, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x55, 0x72, 0x6c, 0x12, 0x3e, 0x0a, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x26, 0x2e, 0x77, 0x65, 0x62,
+	0x69, 0x74, 0x65, 0x6c, 0x2e, 0x69, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
+	0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x75,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62,
+	0x6c, 0x65, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c,
+	0x65, 0x64, 0x22, 0xbe, 0x01, 0x0a, 0x26, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x70, 0x70, 0x6c, 0x65, 0x42, 0x75, 0x73, 0x69, 0x6e, 0x65,
+	0x73, 0x73, 0x47, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x62, 0x75, 0x73, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x5f, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x62, 0x75, 0x73, 0x69, 0x6e, 0x65, 0x73, 0x73,
+	0x49, 0x64, 0x12, 0x15, 0x0a, 0x06, 0x63, 0x73, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x63, 0x73, 0x70, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x63,
+	0x72, 0x65, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65,
+	0x74, 0x12, 0x30, 0x0a, 0x04, 0x70, 0x65, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1c, 0x2e, 0x77, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x2e, 0x69, 0x6d, 0x2e, 0x70, 0x72, 0x6f,
+	0x76, 0x69, 0x64, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x65, 0x65, 0x72, 0x52, 0x04, 0x70,
+	0x65, 0x65, 0x72, 0x22, 0x70, 0x0a, 0x27, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x70, 0x70, 0x6c, 0x65, 0x42, 0x75, 0x73, 0x69, 0x6e, 0x65,
+	0x73, 0x73, 0x47, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x45,
+	0x0a, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x31, 0x2e, 0x77,
+	0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x2e, 0x69, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64,
+	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x41, 0x70,
+	0x70, 0x6c, 0x65, 0x42, 0x75, 0x73, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x47, 0x61, 0x74, 0x65, 0x52,
+	0x04, 0x69, 0x74, 0x65, 0x6d, 0x22, 0x35, 0x0a, 0x23, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65,
+	0x72, 0x47, 0x65, 0x74, 0x41, 0x70, 0x70, 0x6c, 0x65, 0x42, 0x75, 0x73, 0x69, 0x6e, 0x65, 0x73,
+	0x73, 0x47, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x6d, 0x0a, 0x24,
+	0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x47, 0x65, 0x74, 0x41, 0x70, 0x70, 0x6c, 0x65,
+	0x42, 0x75, 0x73, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x47, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x45, 0x0a, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x31, 0x2e, 0x77, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x2e, 0x69, 0x6d, 0x2e,
+	0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x76,
+	0x69, 0x64, 0x65, 0x72, 0x41, 0x70, 0x70, 0x6c, 0x65, 0x42, 0x75, 0x73, 0x69, 0x6e, 0x65, 0x73,
+	0x73, 0x47, 0x61, 0x74, 0x65, 0x52, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x22, 0x86, 0x02, 0x0a, 0x26,
+	0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x70,
+	0x70, 0x6c, 0x65, 0x42, 0x75, 0x73, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x47, 0x61, 0x74, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x17, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x12,
+	0x1a, 0x0a, 0x06, 0x63, 0x73, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48,
+	0x01, 0x52, 0x05, 0x63, 0x73, 0x70, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x1b, 0x0a, 0x06, 0x73,
+	0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x06, 0x73,
+	0x65, 0x63, 0x72, 0x65, 0x74, 0x88, 0x01, 0x01, 0x12, 0x1d, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62,
+	0x6c, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x48, 0x03, 0x52, 0x07, 0x65, 0x6e, 0x61,
+	0x62, 0x6c, 0x65, 0x64, 0x88, 0x01, 0x01, 0x12, 0x30, 0x0a, 0x04, 0x70, 0x65, 0x65, 0x72, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x77, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x2e,
+	0x69, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50,
+	0x65, 0x65, 0x72, 0x52, 0x04, 0x70, 0x65, 0x65, 0x72, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x6e, 0x61,
+	0x6d, 0x65, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x63, 0x73, 0x70, 0x5f, 0x69, 0x64, 0x42, 0x09, 0x0a,
+	0x07, 0x5f, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x65, 0x6e, 0x61,
+	0x62, 0x6c, 0x65, 0x64, 0x22, 0x70, 0x0a, 0x27, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x70, 0x70, 0x6c, 0x65, 0x42, 0x75, 0x73, 0x69, 0x6e,
+	0x65, 0x73, 0x73, 0x47, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x45, 0x0a, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x31, 0x2e,
+	0x77, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x2e, 0x69, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69,
+	0x64, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x41,
+	0x70, 0x70, 0x6c, 0x65, 0x42, 0x75, 0x73, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x47, 0x61, 0x74, 0x65,
+	0x52, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x22, 0x38, 0x0a, 0x26, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64,
+	0x65, 0x72, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x70, 0x70, 0x6c, 0x65, 0x42, 0x75, 0x73,
+	0x69, 0x6e, 0x65, 0x73, 0x73, 0x47, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
+	0x22, 0x70, 0x0a, 0x27, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x41, 0x70, 0x70, 0x6c, 0x65, 0x42, 0x75, 0x73, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x47,
+	0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x45, 0x0a, 0x04, 0x69,
+	0x74, 0x65, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x31, 0x2e, 0x77, 0x65, 0x62, 0x69,
+	0x74, 0x65, 0x6c, 0x2e, 0x69, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x2e,
+	0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x41, 0x70, 0x70, 0x6c, 0x65,
+	0x42, 0x75, 0x73, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x47, 0x61, 0x74, 0x65, 0x52, 0x04, 0x69, 0x74,
+	0x65, 0x6d, 0x32, 0x9e, 0x06, 0x0a, 0x14, 0x41, 0x70, 0x70, 0x6c, 0x65, 0x42, 0x75, 0x73, 0x69,
+	0x6e, 0x65, 0x73, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0xbf, 0x01, 0x0a, 0x17,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x70, 0x70, 0x6c, 0x65, 0x42, 0x75, 0x73, 0x69, 0x6e,
+	0x65, 0x73, 0x73, 0x47, 0x61, 0x74, 0x65, 0x12, 0x3e, 0x2e, 0x77, 0x65, 0x62, 0x69, 0x74, 0x65,
+	0x6c, 0x2e, 0x69, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x2e, 0x76, 0x31,
+	0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41,
+	0x70, 0x70, 0x6c, 0x65, 0x42, 0x75, 0x73, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x47, 0x61, 0x74, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x3f, 0x2e, 0x77, 0x65, 0x62, 0x69, 0x74, 0x65,
+	0x6c, 0x2e, 0x69, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x2e, 0x76, 0x31,
+	0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41,
+	0x70, 0x70, 0x6c, 0x65, 0x42, 0x75, 0x73, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x47, 0x61, 0x74, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x23, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1d,
+	0x3a, 0x01, 0x2a, 0x22, 0x18, 0x2f, 0x69, 0x6d, 0x2f, 0x67, 0x61, 0x74, 0x65, 0x73, 0x2f, 0x61,
+	0x70, 0x70, 0x6c, 0x65, 0x2d, 0x62, 0x75, 0x73, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x12, 0xb8, 0x01,
+	0x0a, 0x14, 0x47, 0x65, 0x74, 0x41, 0x70, 0x70, 0x6c, 0x65, 0x42, 0x75, 0x73, 0x69, 0x6e, 0x65,
+	0x73, 0x73, 0x47, 0x61, 0x74, 0x65, 0x12, 0x3b, 0x2e, 0x77, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c,
+	0x2e, 0x69, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e,
+	0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x47, 0x65, 0x74, 0x41, 0x70, 0x70, 0x6c, 0x65,
+	0x42, 0x75, 0x73, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x47, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x3c, 0x2e, 0x77, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x2e, 0x69, 0x6d,
+	0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f,
+	0x76, 0x69, 0x64, 0x65, 0x72, 0x47, 0x65, 0x74, 0x41, 0x70, 0x70, 0x6c, 0x65, 0x42, 0x75, 0x73,
+	0x69, 0x6e, 0x65, 0x73, 0x73, 0x47, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x25, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1f, 0x12, 0x1d, 0x2f, 0x69, 0x6d, 0x2f, 0x67,
+	0x61, 0x74, 0x65, 0x73, 0x2f, 0x61, 0x70, 0x70, 0x6c, 0x65, 0x2d, 0x62, 0x75, 0x73, 0x69, 0x6e,
+	0x65, 0x73, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12, 0xc4, 0x01, 0x0a, 0x17, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x41, 0x70, 0x70, 0x6c, 0x65, 0x42, 0x75, 0x73, 0x69, 0x6e, 0x65, 0x73, 0x73,
+	0x47, 0x61, 0x74, 0x65, 0x12, 0x3e, 0x2e, 0x77, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x2e, 0x69,
+	0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72,
+	0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x70, 0x70, 0x6c,
+	0x65, 0x42, 0x75, 0x73, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x47, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x3f, 0x2e, 0x77, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x2e, 0x69,
+	0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72,
+	0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x70, 0x70, 0x6c,
+	0x65, 0x42, 0x75, 0x73, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x47, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x28, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x22, 0x3a, 0x01, 0x2a,
+	0x32, 0x1d, 0x2f, 0x69, 0x6d, 0x2f, 0x67, 0x61, 0x74, 0x65, 0x73, 0x2f, 0x61, 0x70, 0x70, 0x6c,
+	0x65, 0x2d, 0x62, 0x75, 0x73, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12,
+	0xc1, 0x01, 0x0a, 0x17, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x70, 0x70, 0x6c, 0x65, 0x42,
+	0x75, 0x73, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x47, 0x61, 0x74, 0x65, 0x12, 0x3e, 0x2e, 0x77, 0x65,
+	0x62, 0x69, 0x74, 0x65, 0x6c, 0x2e, 0x69, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65,
+	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x41, 0x70, 0x70, 0x6c, 0x65, 0x42, 0x75, 0x73, 0x69, 0x6e, 0x65, 0x73, 0x73,
+	0x47, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x3f, 0x2e, 0x77, 0x65,
+	0x62, 0x69, 0x74, 0x65, 0x6c, 0x2e, 0x69, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65,
+	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x41, 0x70, 0x70, 0x6c, 0x65, 0x42, 0x75, 0x73, 0x69, 0x6e, 0x65, 0x73, 0x73,
+	0x47, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x25, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x1f, 0x2a, 0x1d, 0x2f, 0x69, 0x6d, 0x2f, 0x67, 0x61, 0x74, 0x65, 0x73, 0x2f,
+	0x61, 0x70, 0x70, 0x6c, 0x65, 0x2d, 0x62, 0x75, 0x73, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x2f, 0x7b,
+	0x69, 0x64, 0x7d, 0x42, 0xec, 0x01, 0x0a, 0x1a, 0x63, 0x6f, 0x6d, 0x2e, 0x77, 0x65, 0x62, 0x69,
+	0x74, 0x65, 0x6c, 0x2e, 0x69, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x2e,
+	0x76, 0x31, 0x42, 0x19, 0x41, 0x70, 0x70, 0x6c, 0x65, 0x42, 0x75, 0x73, 0x69, 0x6e, 0x65, 0x73,
+	0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a,
+	0x38, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x77, 0x65, 0x62, 0x69,
+	0x74, 0x65, 0x6c, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x67,
+	0x6f, 0x2f, 0x69, 0x6d, 0x2f, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x2f, 0x76, 0x31,
+	0x3b, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0xa2, 0x02, 0x03, 0x57, 0x49, 0x50, 0xaa,
+	0x02, 0x16, 0x57, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x2e, 0x49, 0x6d, 0x2e, 0x50, 0x72, 0x6f,
+	0x76, 0x69, 0x64, 0x65, 0x72, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x16, 0x57, 0x65, 0x62, 0x69, 0x74,
+	0x65, 0x6c, 0x5c, 0x49, 0x6d, 0x5c, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x5c, 0x56,
+	0x31, 0xe2, 0x02, 0x22, 0x57, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x5c, 0x49, 0x6d, 0x5c, 0x50,
+	0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x19, 0x57, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c,
+	0x3a, 0x3a, 0x49, 0x6d, 0x3a, 0x3a, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x3a, 0x3a,
+	0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_service_provider_v1_apple_business_service_proto_rawDescOnce sync.Once
+	file_service_provider_v1_apple_business_service_proto_rawDescData = file_service_provider_v1_apple_business_service_proto_rawDesc
+)
+
+func file_service_provider_v1_apple_business_service_proto_rawDescGZIP() []byte {
+	file_service_provider_v1_apple_business_service_proto_rawDescOnce.Do(func() {
+		file_service_provider_v1_apple_business_service_proto_rawDescData = protoimpl.X.CompressGZIP(file_service_provider_v1_apple_business_service_proto_rawDescData)
+	})
+	return file_service_provider_v1_apple_business_service_proto_rawDescData
+}
+
+var file_service_provider_v1_apple_business_service_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_service_provider_v1_apple_business_service_proto_goTypes = []interface{}{
+	(*ProviderAppleBusinessGate)(nil),               // 0: webitel.im.provider.v1.ProviderAppleBusinessGate
+	(*ProviderCreateAppleBusinessGateRequest)(nil),  // 1: webitel.im.provider.v1.ProviderCreateAppleBusinessGateRequest
+	(*ProviderCreateAppleBusinessGateResponse)(nil), // 2: webitel.im.provider.v1.ProviderCreateAppleBusinessGateResponse
+	(*ProviderGetAppleBusinessGateRequest)(nil),     // 3: webitel.im.provider.v1.ProviderGetAppleBusinessGateRequest
+	(*ProviderGetAppleBusinessGateResponse)(nil),    // 4: webitel.im.provider.v1.ProviderGetAppleBusinessGateResponse
+	(*ProviderUpdateAppleBusinessGateRequest)(nil),  // 5: webitel.im.provider.v1.ProviderUpdateAppleBusinessGateRequest
+	(*ProviderUpdateAppleBusinessGateResponse)(nil), // 6: webitel.im.provider.v1.ProviderUpdateAppleBusinessGateResponse
+	(*ProviderDeleteAppleBusinessGateRequest)(nil),  // 7: webitel.im.provider.v1.ProviderDeleteAppleBusinessGateRequest
+	(*ProviderDeleteAppleBusinessGateResponse)(nil), // 8: webitel.im.provider.v1.ProviderDeleteAppleBusinessGateResponse
+	(*Peer)(nil),        // 9: webitel.im.provider.v1.Peer
+	(ProviderStatus)(0), // 10: webitel.im.provider.v1.ProviderStatus
+}
+var file_service_provider_v1_apple_business_service_proto_depIdxs = []int32{
+	9,  // 0: webitel.im.provider.v1.ProviderAppleBusinessGate.peer:type_name -> webitel.im.provider.v1.Peer
+	10, // 1: webitel.im.provider.v1.ProviderAppleBusinessGate.status:type_name -> webitel.im.provider.v1.ProviderStatus
+	9,  // 2: webitel.im.provider.v1.ProviderCreateAppleBusinessGateRequest.peer:type_name -> webitel.im.provider.v1.Peer
+	0,  // 3: webitel.im.provider.v1.ProviderCreateAppleBusinessGateResponse.item:type_name -> webitel.im.provider.v1.ProviderAppleBusinessGate
+	0,  // 4: webitel.im.provider.v1.ProviderGetAppleBusinessGateResponse.item:type_name -> webitel.im.provider.v1.ProviderAppleBusinessGate
+	9,  // 5: webitel.im.provider.v1.ProviderUpdateAppleBusinessGateRequest.peer:type_name -> webitel.im.provider.v1.Peer
+	0,  // 6: webitel.im.provider.v1.ProviderUpdateAppleBusinessGateResponse.item:type_name -> webitel.im.provider.v1.ProviderAppleBusinessGate
+	0,  // 7: webitel.im.provider.v1.ProviderDeleteAppleBusinessGateResponse.item:type_name -> webitel.im.provider.v1.ProviderAppleBusinessGate
+	1,  // 8: webitel.im.provider.v1.AppleBusinessService.CreateAppleBusinessGate:input_type -> webitel.im.provider.v1.ProviderCreateAppleBusinessGateRequest
+	3,  // 9: webitel.im.provider.v1.AppleBusinessService.GetAppleBusinessGate:input_type -> webitel.im.provider.v1.ProviderGetAppleBusinessGateRequest
+	5,  // 10: webitel.im.provider.v1.AppleBusinessService.UpdateAppleBusinessGate:input_type -> webitel.im.provider.v1.ProviderUpdateAppleBusinessGateRequest
+	7,  // 11: webitel.im.provider.v1.AppleBusinessService.DeleteAppleBusinessGate:input_type -> webitel.im.provider.v1.ProviderDeleteAppleBusinessGateRequest
+	2,  // 12: webitel.im.provider.v1.AppleBusinessService.CreateAppleBusinessGate:output_type -> webitel.im.provider.v1.ProviderCreateAppleBusinessGateResponse
+	4,  // 13: webitel.im.provider.v1.AppleBusinessService.GetAppleBusinessGate:output_type -> webitel.im.provider.v1.ProviderGetAppleBusinessGateResponse
+	6,  // 14: webitel.im.provider.v1.AppleBusinessService.UpdateAppleBusinessGate:output_type -> webitel.im.provider.v1.ProviderUpdateAppleBusinessGateResponse
+	8,  // 15: webitel.im.provider.v1.AppleBusinessService.DeleteAppleBusinessGate:output_type -> webitel.im.provider.v1.ProviderDeleteAppleBusinessGateResponse
+	12, // [12:16] is the sub-list for method output_type
+	8,  // [8:12] is the sub-list for method input_type
+	8,  // [8:8] is the sub-list for extension type_name
+	8,  // [8:8] is the sub-list for extension extendee
+	0,  // [0:8] is the sub-list for field type_name
+}
+
+func init() { file_service_provider_v1_apple_business_service_proto_init() }
+func file_service_provider_v1_apple_business_service_proto_init() {
+	if File_service_provider_v1_apple_business_service_proto != nil {
+		return
+	}
+	file_service_provider_v1_enums_proto_init()
+	file_service_provider_v1_messages_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_service_provider_v1_apple_business_service_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProviderAppleBusinessGate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_provider_v1_apple_business_service_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProviderCreateAppleBusinessGateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_provider_v1_apple_business_service_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProviderCreateAppleBusinessGateResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_provider_v1_apple_business_service_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProviderGetAppleBusinessGateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_provider_v1_apple_business_service_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProviderGetAppleBusinessGateResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_provider_v1_apple_business_service_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProviderUpdateAppleBusinessGateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_provider_v1_apple_business_service_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProviderUpdateAppleBusinessGateResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_provider_v1_apple_business_service_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProviderDeleteAppleBusinessGateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_provider_v1_apple_business_service_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProviderDeleteAppleBusinessGateResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_service_provider_v1_apple_business_service_proto_msgTypes[5].OneofWrappers = []interface{}{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_service_provider_v1_apple_business_service_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   9,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_service_provider_v1_apple_business_service_proto_goTypes,
+		DependencyIndexes: file_service_provider_v1_apple_business_service_proto_depIdxs,
+		MessageInfos:      file_service_provider_v1_apple_business_service_proto_msgTypes,
+	}.Build()
+	File_service_provider_v1_apple_business_service_proto = out.File
+	file_service_provider_v1_apple_business_service_proto_rawDesc = nil
+	file_service_provider_v1_apple_business_service_proto_goTypes = nil
+	file_service_provider_v1_apple_business_service_proto_depIdxs = nil
+}
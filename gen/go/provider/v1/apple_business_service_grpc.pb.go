@@ -0,0 +1,243 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: service/provider/v1/apple_business_service.proto
+
+package provider
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	AppleBusinessService_CreateAppleBusinessGate_FullMethodName = "/webitel.im.provider.v1.AppleBusinessService/CreateAppleBusinessGate"
+	AppleBusinessService_GetAppleBusinessGate_FullMethodName    = "/webitel.im.provider.v1.AppleBusinessService/GetAppleBusinessGate"
+	AppleBusinessService_UpdateAppleBusinessGate_FullMethodName = "/webitel.im.provider.v1.AppleBusinessService/UpdateAppleBusinessGate"
+	AppleBusinessService_DeleteAppleBusinessGate_FullMethodName = "/webitel.im.provider.v1.AppleBusinessService/DeleteAppleBusinessGate"
+)
+
+// AppleBusinessServiceClient is the client API for AppleBusinessService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type AppleBusinessServiceClient interface {
+	// / CreateAppleBusinessGate connects a business and issues its MSP endpoint.
+	CreateAppleBusinessGate(ctx context.Context, in *ProviderCreateAppleBusinessGateRequest, opts ...grpc.CallOption) (*ProviderCreateAppleBusinessGateResponse, error)
+	// / GetAppleBusinessGate returns the Apple Messages for Business gate.
+	GetAppleBusinessGate(ctx context.Context, in *ProviderGetAppleBusinessGateRequest, opts ...grpc.CallOption) (*ProviderGetAppleBusinessGateResponse, error)
+	// / UpdateAppleBusinessGate renames, enables or disables the gate or moves it to another MSP account.
+	UpdateAppleBusinessGate(ctx context.Context, in *ProviderUpdateAppleBusinessGateRequest, opts ...grpc.CallOption) (*ProviderUpdateAppleBusinessGateResponse, error)
+	// / DeleteAppleBusinessGate removes the Apple Messages for Business gate.
+	DeleteAppleBusinessGate(ctx context.Context, in *ProviderDeleteAppleBusinessGateRequest, opts ...grpc.CallOption) (*ProviderDeleteAppleBusinessGateResponse, error)
+}
+
+type appleBusinessServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAppleBusinessServiceClient(cc grpc.ClientConnInterface) AppleBusinessServiceClient {
+	return &appleBusinessServiceClient{cc}
+}
+
+func (c *appleBusinessServiceClient) CreateAppleBusinessGate(ctx context.Context, in *ProviderCreateAppleBusinessGateRequest, opts ...grpc.CallOption) (*ProviderCreateAppleBusinessGateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProviderCreateAppleBusinessGateResponse)
+	err := c.cc.Invoke(ctx, AppleBusinessService_CreateAppleBusinessGate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appleBusinessServiceClient) GetAppleBusinessGate(ctx context.Context, in *ProviderGetAppleBusinessGateRequest, opts ...grpc.CallOption) (*ProviderGetAppleBusinessGateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProviderGetAppleBusinessGateResponse)
+	err := c.cc.Invoke(ctx, AppleBusinessService_GetAppleBusinessGate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appleBusinessServiceClient) UpdateAppleBusinessGate(ctx context.Context, in *ProviderUpdateAppleBusinessGateRequest, opts ...grpc.CallOption) (*ProviderUpdateAppleBusinessGateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProviderUpdateAppleBusinessGateResponse)
+	err := c.cc.Invoke(ctx, AppleBusinessService_UpdateAppleBusinessGate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appleBusinessServiceClient) DeleteAppleBusinessGate(ctx context.Context, in *ProviderDeleteAppleBusinessGateRequest, opts ...grpc.CallOption) (*ProviderDeleteAppleBusinessGateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProviderDeleteAppleBusinessGateResponse)
+	err := c.cc.Invoke(ctx, AppleBusinessService_DeleteAppleBusinessGate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AppleBusinessServiceServer is the server API for AppleBusinessService service.
+// All implementations must embed UnimplementedAppleBusinessServiceServer
+// for forward compatibility.
+type AppleBusinessServiceServer interface {
+	// / CreateAppleBusinessGate connects a business and issues its MSP endpoint.
+	CreateAppleBusinessGate(context.Context, *ProviderCreateAppleBusinessGateRequest) (*ProviderCreateAppleBusinessGateResponse, error)
+	// / GetAppleBusinessGate returns the Apple Messages for Business gate.
+	GetAppleBusinessGate(context.Context, *ProviderGetAppleBusinessGateRequest) (*ProviderGetAppleBusinessGateResponse, error)
+	// / UpdateAppleBusinessGate renames, enables or disables the gate or moves it to another MSP account.
+	UpdateAppleBusinessGate(context.Context, *ProviderUpdateAppleBusinessGateRequest) (*ProviderUpdateAppleBusinessGateResponse, error)
+	// / DeleteAppleBusinessGate removes the Apple Messages for Business gate.
+	DeleteAppleBusinessGate(context.Context, *ProviderDeleteAppleBusinessGateRequest) (*ProviderDeleteAppleBusinessGateResponse, error)
+	mustEmbedUnimplementedAppleBusinessServiceServer()
+}
+
+// UnimplementedAppleBusinessServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedAppleBusinessServiceServer struct{}
+
+func (UnimplementedAppleBusinessServiceServer) CreateAppleBusinessGate(context.Context, *ProviderCreateAppleBusinessGateRequest) (*ProviderCreateAppleBusinessGateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAppleBusinessGate not implemented")
+}
+func (UnimplementedAppleBusinessServiceServer) GetAppleBusinessGate(context.Context, *ProviderGetAppleBusinessGateRequest) (*ProviderGetAppleBusinessGateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAppleBusinessGate not implemented")
+}
+func (UnimplementedAppleBusinessServiceServer) UpdateAppleBusinessGate(context.Context, *ProviderUpdateAppleBusinessGateRequest) (*ProviderUpdateAppleBusinessGateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateAppleBusinessGate not implemented")
+}
+func (UnimplementedAppleBusinessServiceServer) DeleteAppleBusinessGate(context.Context, *ProviderDeleteAppleBusinessGateRequest) (*ProviderDeleteAppleBusinessGateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteAppleBusinessGate not implemented")
+}
+func (UnimplementedAppleBusinessServiceServer) mustEmbedUnimplementedAppleBusinessServiceServer() {}
+func (UnimplementedAppleBusinessServiceServer) testEmbeddedByValue()                              {}
+
+// UnsafeAppleBusinessServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AppleBusinessServiceServer will
+// result in compilation errors.
+type UnsafeAppleBusinessServiceServer interface {
+	mustEmbedUnimplementedAppleBusinessServiceServer()
+}
+
+func RegisterAppleBusinessServiceServer(s grpc.ServiceRegistrar, srv AppleBusinessServiceServer) {
+	// If the following call pancis, it indicates UnimplementedAppleBusinessServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&AppleBusinessService_ServiceDesc, srv)
+}
+
+func _AppleBusinessService_CreateAppleBusinessGate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProviderCreateAppleBusinessGateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppleBusinessServiceServer).CreateAppleBusinessGate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AppleBusinessService_CreateAppleBusinessGate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppleBusinessServiceServer).CreateAppleBusinessGate(ctx, req.(*ProviderCreateAppleBusinessGateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AppleBusinessService_GetAppleBusinessGate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProviderGetAppleBusinessGateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppleBusinessServiceServer).GetAppleBusinessGate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AppleBusinessService_GetAppleBusinessGate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppleBusinessServiceServer).GetAppleBusinessGate(ctx, req.(*ProviderGetAppleBusinessGateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AppleBusinessService_UpdateAppleBusinessGate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProviderUpdateAppleBusinessGateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppleBusinessServiceServer).UpdateAppleBusinessGate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AppleBusinessService_UpdateAppleBusinessGate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppleBusinessServiceServer).UpdateAppleBusinessGate(ctx, req.(*ProviderUpdateAppleBusinessGateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AppleBusinessService_DeleteAppleBusinessGate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProviderDeleteAppleBusinessGateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppleBusinessServiceServer).DeleteAppleBusinessGate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AppleBusinessService_DeleteAppleBusinessGate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppleBusinessServiceServer).DeleteAppleBusinessGate(ctx, req.(*ProviderDeleteAppleBusinessGateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// AppleBusinessService_ServiceDesc is the grpc.ServiceDesc for AppleBusinessService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var AppleBusinessService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "webitel.im.provider.v1.AppleBusinessService",
+	HandlerType: (*AppleBusinessServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateAppleBusinessGate",
+			Handler:    _AppleBusinessService_CreateAppleBusinessGate_Handler,
+		},
+		{
+			MethodName: "GetAppleBusinessGate",
+			Handler:    _AppleBusinessService_GetAppleBusinessGate_Handler,
+		},
+		{
+			MethodName: "UpdateAppleBusinessGate",
+			Handler:    _AppleBusinessService_UpdateAppleBusinessGate_Handler,
+		},
+		{
+			MethodName: "DeleteAppleBusinessGate",
+			Handler:    _AppleBusinessService_DeleteAppleBusinessGate_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "service/provider/v1/apple_business_service.proto",
+}
@@ -0,0 +1,243 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: service/provider/v1/custom_service.proto
+
+package provider
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	CustomService_CreateCustomGate_FullMethodName = "/webitel.im.provider.v1.CustomService/CreateCustomGate"
+	CustomService_GetCustomGate_FullMethodName    = "/webitel.im.provider.v1.CustomService/GetCustomGate"
+	CustomService_UpdateCustomGate_FullMethodName = "/webitel.im.provider.v1.CustomService/UpdateCustomGate"
+	CustomService_DeleteCustomGate_FullMethodName = "/webitel.im.provider.v1.CustomService/DeleteCustomGate"
+)
+
+// CustomServiceClient is the client API for CustomService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type CustomServiceClient interface {
+	// / CreateCustomGate registers an in-house channel and returns the secret its envelopes are signed with.
+	CreateCustomGate(ctx context.Context, in *ProviderCreateCustomGateRequest, opts ...grpc.CallOption) (*ProviderCreateCustomGateResponse, error)
+	// / GetCustomGate returns the custom gate.
+	GetCustomGate(ctx context.Context, in *ProviderGetCustomGateRequest, opts ...grpc.CallOption) (*ProviderGetCustomGateResponse, error)
+	// / UpdateCustomGate renames, enables or disables the gate, moves its callback or rotates its secret.
+	UpdateCustomGate(ctx context.Context, in *ProviderUpdateCustomGateRequest, opts ...grpc.CallOption) (*ProviderUpdateCustomGateResponse, error)
+	// / DeleteCustomGate removes the custom gate.
+	DeleteCustomGate(ctx context.Context, in *ProviderDeleteCustomGateRequest, opts ...grpc.CallOption) (*ProviderDeleteCustomGateResponse, error)
+}
+
+type customServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewCustomServiceClient(cc grpc.ClientConnInterface) CustomServiceClient {
+	return &customServiceClient{cc}
+}
+
+func (c *customServiceClient) CreateCustomGate(ctx context.Context, in *ProviderCreateCustomGateRequest, opts ...grpc.CallOption) (*ProviderCreateCustomGateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProviderCreateCustomGateResponse)
+	err := c.cc.Invoke(ctx, CustomService_CreateCustomGate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *customServiceClient) GetCustomGate(ctx context.Context, in *ProviderGetCustomGateRequest, opts ...grpc.CallOption) (*ProviderGetCustomGateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProviderGetCustomGateResponse)
+	err := c.cc.Invoke(ctx, CustomService_GetCustomGate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *customServiceClient) UpdateCustomGate(ctx context.Context, in *ProviderUpdateCustomGateRequest, opts ...grpc.CallOption) (*ProviderUpdateCustomGateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProviderUpdateCustomGateResponse)
+	err := c.cc.Invoke(ctx, CustomService_UpdateCustomGate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *customServiceClient) DeleteCustomGate(ctx context.Context, in *ProviderDeleteCustomGateRequest, opts ...grpc.CallOption) (*ProviderDeleteCustomGateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProviderDeleteCustomGateResponse)
+	err := c.cc.Invoke(ctx, CustomService_DeleteCustomGate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CustomServiceServer is the server API for CustomService service.
+// All implementations must embed UnimplementedCustomServiceServer
+// for forward compatibility.
+type CustomServiceServer interface {
+	// / CreateCustomGate registers an in-house channel and returns the secret its envelopes are signed with.
+	CreateCustomGate(context.Context, *ProviderCreateCustomGateRequest) (*ProviderCreateCustomGateResponse, error)
+	// / GetCustomGate returns the custom gate.
+	GetCustomGate(context.Context, *ProviderGetCustomGateRequest) (*ProviderGetCustomGateResponse, error)
+	// / UpdateCustomGate renames, enables or disables the gate, moves its callback or rotates its secret.
+	UpdateCustomGate(context.Context, *ProviderUpdateCustomGateRequest) (*ProviderUpdateCustomGateResponse, error)
+	// / DeleteCustomGate removes the custom gate.
+	DeleteCustomGate(context.Context, *ProviderDeleteCustomGateRequest) (*ProviderDeleteCustomGateResponse, error)
+	mustEmbedUnimplementedCustomServiceServer()
+}
+
+// UnimplementedCustomServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedCustomServiceServer struct{}
+
+func (UnimplementedCustomServiceServer) CreateCustomGate(context.Context, *ProviderCreateCustomGateRequest) (*ProviderCreateCustomGateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCustomGate not implemented")
+}
+func (UnimplementedCustomServiceServer) GetCustomGate(context.Context, *ProviderGetCustomGateRequest) (*ProviderGetCustomGateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCustomGate not implemented")
+}
+func (UnimplementedCustomServiceServer) UpdateCustomGate(context.Context, *ProviderUpdateCustomGateRequest) (*ProviderUpdateCustomGateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCustomGate not implemented")
+}
+func (UnimplementedCustomServiceServer) DeleteCustomGate(context.Context, *ProviderDeleteCustomGateRequest) (*ProviderDeleteCustomGateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteCustomGate not implemented")
+}
+func (UnimplementedCustomServiceServer) mustEmbedUnimplementedCustomServiceServer() {}
+func (UnimplementedCustomServiceServer) testEmbeddedByValue()                       {}
+
+// UnsafeCustomServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to CustomServiceServer will
+// result in compilation errors.
+type UnsafeCustomServiceServer interface {
+	mustEmbedUnimplementedCustomServiceServer()
+}
+
+func RegisterCustomServiceServer(s grpc.ServiceRegistrar, srv CustomServiceServer) {
+	// If the following call pancis, it indicates UnimplementedCustomServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&CustomService_ServiceDesc, srv)
+}
+
+func _CustomService_CreateCustomGate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProviderCreateCustomGateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CustomServiceServer).CreateCustomGate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CustomService_CreateCustomGate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CustomServiceServer).CreateCustomGate(ctx, req.(*ProviderCreateCustomGateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CustomService_GetCustomGate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProviderGetCustomGateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CustomServiceServer).GetCustomGate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CustomService_GetCustomGate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CustomServiceServer).GetCustomGate(ctx, req.(*ProviderGetCustomGateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CustomService_UpdateCustomGate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProviderUpdateCustomGateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CustomServiceServer).UpdateCustomGate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CustomService_UpdateCustomGate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CustomServiceServer).UpdateCustomGate(ctx, req.(*ProviderUpdateCustomGateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CustomService_DeleteCustomGate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProviderDeleteCustomGateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CustomServiceServer).DeleteCustomGate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CustomService_DeleteCustomGate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CustomServiceServer).DeleteCustomGate(ctx, req.(*ProviderDeleteCustomGateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// CustomService_ServiceDesc is the grpc.ServiceDesc for CustomService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var CustomService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "webitel.im.provider.v1.CustomService",
+	HandlerType: (*CustomServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateCustomGate",
+			Handler:    _CustomService_CreateCustomGate_Handler,
+		},
+		{
+			MethodName: "GetCustomGate",
+			Handler:    _CustomService_GetCustomGate_Handler,
+		},
+		{
+			MethodName: "UpdateCustomGate",
+			Handler:    _CustomService_UpdateCustomGate_Handler,
+		},
+		{
+			MethodName: "DeleteCustomGate",
+			Handler:    _CustomService_DeleteCustomGate_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "service/provider/v1/custom_service.proto",
+}
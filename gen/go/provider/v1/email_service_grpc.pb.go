@@ -0,0 +1,243 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: service/provider/v1/email_service.proto
+
+package provider
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	EmailService_CreateEmailGate_FullMethodName = "/webitel.im.provider.v1.EmailService/CreateEmailGate"
+	EmailService_GetEmailGate_FullMethodName    = "/webitel.im.provider.v1.EmailService/GetEmailGate"
+	EmailService_UpdateEmailGate_FullMethodName = "/webitel.im.provider.v1.EmailService/UpdateEmailGate"
+	EmailService_DeleteEmailGate_FullMethodName = "/webitel.im.provider.v1.EmailService/DeleteEmailGate"
+)
+
+// EmailServiceClient is the client API for EmailService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type EmailServiceClient interface {
+	// / CreateEmailGate connects a mailbox and starts collecting its mail.
+	CreateEmailGate(ctx context.Context, in *ProviderCreateEmailGateRequest, opts ...grpc.CallOption) (*ProviderCreateEmailGateResponse, error)
+	// / GetEmailGate returns the email gate without its passwords.
+	GetEmailGate(ctx context.Context, in *ProviderGetEmailGateRequest, opts ...grpc.CallOption) (*ProviderGetEmailGateResponse, error)
+	// / UpdateEmailGate renames, enables or disables the gate, changes its address or its servers.
+	UpdateEmailGate(ctx context.Context, in *ProviderUpdateEmailGateRequest, opts ...grpc.CallOption) (*ProviderUpdateEmailGateResponse, error)
+	// / DeleteEmailGate stops collecting the mailbox and removes the gate.
+	DeleteEmailGate(ctx context.Context, in *ProviderDeleteEmailGateRequest, opts ...grpc.CallOption) (*ProviderDeleteEmailGateResponse, error)
+}
+
+type emailServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewEmailServiceClient(cc grpc.ClientConnInterface) EmailServiceClient {
+	return &emailServiceClient{cc}
+}
+
+func (c *emailServiceClient) CreateEmailGate(ctx context.Context, in *ProviderCreateEmailGateRequest, opts ...grpc.CallOption) (*ProviderCreateEmailGateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProviderCreateEmailGateResponse)
+	err := c.cc.Invoke(ctx, EmailService_CreateEmailGate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *emailServiceClient) GetEmailGate(ctx context.Context, in *ProviderGetEmailGateRequest, opts ...grpc.CallOption) (*ProviderGetEmailGateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProviderGetEmailGateResponse)
+	err := c.cc.Invoke(ctx, EmailService_GetEmailGate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *emailServiceClient) UpdateEmailGate(ctx context.Context, in *ProviderUpdateEmailGateRequest, opts ...grpc.CallOption) (*ProviderUpdateEmailGateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProviderUpdateEmailGateResponse)
+	err := c.cc.Invoke(ctx, EmailService_UpdateEmailGate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *emailServiceClient) DeleteEmailGate(ctx context.Context, in *ProviderDeleteEmailGateRequest, opts ...grpc.CallOption) (*ProviderDeleteEmailGateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProviderDeleteEmailGateResponse)
+	err := c.cc.Invoke(ctx, EmailService_DeleteEmailGate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// EmailServiceServer is the server API for EmailService service.
+// All implementations must embed UnimplementedEmailServiceServer
+// for forward compatibility.
+type EmailServiceServer interface {
+	// / CreateEmailGate connects a mailbox and starts collecting its mail.
+	CreateEmailGate(context.Context, *ProviderCreateEmailGateRequest) (*ProviderCreateEmailGateResponse, error)
+	// / GetEmailGate returns the email gate without its passwords.
+	GetEmailGate(context.Context, *ProviderGetEmailGateRequest) (*ProviderGetEmailGateResponse, error)
+	// / UpdateEmailGate renames, enables or disables the gate, changes its address or its servers.
+	UpdateEmailGate(context.Context, *ProviderUpdateEmailGateRequest) (*ProviderUpdateEmailGateResponse, error)
+	// / DeleteEmailGate stops collecting the mailbox and removes the gate.
+	DeleteEmailGate(context.Context, *ProviderDeleteEmailGateRequest) (*ProviderDeleteEmailGateResponse, error)
+	mustEmbedUnimplementedEmailServiceServer()
+}
+
+// UnimplementedEmailServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedEmailServiceServer struct{}
+
+func (UnimplementedEmailServiceServer) CreateEmailGate(context.Context, *ProviderCreateEmailGateRequest) (*ProviderCreateEmailGateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateEmailGate not implemented")
+}
+func (UnimplementedEmailServiceServer) GetEmailGate(context.Context, *ProviderGetEmailGateRequest) (*ProviderGetEmailGateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEmailGate not implemented")
+}
+func (UnimplementedEmailServiceServer) UpdateEmailGate(context.Context, *ProviderUpdateEmailGateRequest) (*ProviderUpdateEmailGateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateEmailGate not implemented")
+}
+func (UnimplementedEmailServiceServer) DeleteEmailGate(context.Context, *ProviderDeleteEmailGateRequest) (*ProviderDeleteEmailGateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteEmailGate not implemented")
+}
+func (UnimplementedEmailServiceServer) mustEmbedUnimplementedEmailServiceServer() {}
+func (UnimplementedEmailServiceServer) testEmbeddedByValue()                      {}
+
+// UnsafeEmailServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to EmailServiceServer will
+// result in compilation errors.
+type UnsafeEmailServiceServer interface {
+	mustEmbedUnimplementedEmailServiceServer()
+}
+
+func RegisterEmailServiceServer(s grpc.ServiceRegistrar, srv EmailServiceServer) {
+	// If the following call pancis, it indicates UnimplementedEmailServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&EmailService_ServiceDesc, srv)
+}
+
+func _EmailService_CreateEmailGate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProviderCreateEmailGateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EmailServiceServer).CreateEmailGate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EmailService_CreateEmailGate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EmailServiceServer).CreateEmailGate(ctx, req.(*ProviderCreateEmailGateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EmailService_GetEmailGate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProviderGetEmailGateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EmailServiceServer).GetEmailGate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EmailService_GetEmailGate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EmailServiceServer).GetEmailGate(ctx, req.(*ProviderGetEmailGateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EmailService_UpdateEmailGate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProviderUpdateEmailGateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EmailServiceServer).UpdateEmailGate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EmailService_UpdateEmailGate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EmailServiceServer).UpdateEmailGate(ctx, req.(*ProviderUpdateEmailGateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EmailService_DeleteEmailGate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProviderDeleteEmailGateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EmailServiceServer).DeleteEmailGate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EmailService_DeleteEmailGate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EmailServiceServer).DeleteEmailGate(ctx, req.(*ProviderDeleteEmailGateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// EmailService_ServiceDesc is the grpc.ServiceDesc for EmailService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var EmailService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "webitel.im.provider.v1.EmailService",
+	HandlerType: (*EmailServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateEmailGate",
+			Handler:    _EmailService_CreateEmailGate_Handler,
+		},
+		{
+			MethodName: "GetEmailGate",
+			Handler:    _EmailService_GetEmailGate_Handler,
+		},
+		{
+			MethodName: "UpdateEmailGate",
+			Handler:    _EmailService_UpdateEmailGate_Handler,
+		},
+		{
+			MethodName: "DeleteEmailGate",
+			Handler:    _EmailService_DeleteEmailGate_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "service/provider/v1/email_service.proto",
+}
@@ -26,9 +26,10 @@ type ProviderSetPersistentMenuRequest struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	GateId                string              `protobuf:"bytes,1,opt,name=gate_id,json=gateId,proto3" json:"gate_id,omitempty"`
-	Items                 []*ProviderMenuItem `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
-	ComposerInputDisabled bool                `protobuf:"varint,3,opt,name=composer_input_disabled,json=composerInputDisabled,proto3" json:"composer_input_disabled,omitempty"`
+	GateId                string                `protobuf:"bytes,1,opt,name=gate_id,json=gateId,proto3" json:"gate_id,omitempty"`
+	Items                 []*ProviderMenuItem   `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
+	ComposerInputDisabled bool                  `protobuf:"varint,3,opt,name=composer_input_disabled,json=composerInputDisabled,proto3" json:"composer_input_disabled,omitempty"`
+	Locales               []*ProviderLocaleMenu `protobuf:"bytes,4,rep,name=locales,proto3" json:"locales,omitempty"` // Localized menus; takes precedence over items and composer_input_disabled
 }
 
 func (x *ProviderSetPersistentMenuRequest) Reset() {
@@ -84,6 +85,13 @@ func (x *ProviderSetPersistentMenuRequest) GetComposerInputDisabled() bool {
 	return false
 }
 
+func (x *ProviderSetPersistentMenuRequest) GetLocales() []*ProviderLocaleMenu {
+	if x != nil {
+		return x.Locales
+	}
+	return nil
+}
+
 type ProviderSetPersistentMenuResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -122,6 +130,164 @@ func (*ProviderSetPersistentMenuResponse) Descriptor() ([]byte, []int) {
 	return file_service_provider_v1_facebook_service_proto_rawDescGZIP(), []int{1}
 }
 
+// / ProviderLocaleMenu is the persistent menu shown to users of one Messenger locale.
+type ProviderLocaleMenu struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Locale                string              `protobuf:"bytes,1,opt,name=locale,proto3" json:"locale,omitempty"` // Messenger locale such as "en_US", or "default"
+	Items                 []*ProviderMenuItem `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
+	ComposerInputDisabled bool                `protobuf:"varint,3,opt,name=composer_input_disabled,json=composerInputDisabled,proto3" json:"composer_input_disabled,omitempty"`
+}
+
+func (x *ProviderLocaleMenu) Reset() {
+	*x = ProviderLocaleMenu{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_provider_v1_facebook_service_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProviderLocaleMenu) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProviderLocaleMenu) ProtoMessage() {}
+
+func (x *ProviderLocaleMenu) ProtoReflect() protoreflect.Message {
+	mi := &file_service_provider_v1_facebook_service_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProviderLocaleMenu.ProtoReflect.Descriptor instead.
+func (*ProviderLocaleMenu) Descriptor() ([]byte, []int) {
+	return file_service_provider_v1_facebook_service_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ProviderLocaleMenu) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+func (x *ProviderLocaleMenu) GetItems() []*ProviderMenuItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *ProviderLocaleMenu) GetComposerInputDisabled() bool {
+	if x != nil {
+		return x.ComposerInputDisabled
+	}
+	return false
+}
+
+type ProviderGetPersistentMenuRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GateId string `protobuf:"bytes,1,opt,name=gate_id,json=gateId,proto3" json:"gate_id,omitempty"`
+}
+
+func (x *ProviderGetPersistentMenuRequest) Reset() {
+	*x = ProviderGetPersistentMenuRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_provider_v1_facebook_service_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProviderGetPersistentMenuRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProviderGetPersistentMenuRequest) ProtoMessage() {}
+
+func (x *ProviderGetPersistentMenuRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_provider_v1_facebook_service_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProviderGetPersistentMenuRequest.ProtoReflect.Descriptor instead.
+func (*ProviderGetPersistentMenuRequest) Descriptor() ([]byte, []int) {
+	return file_service_provider_v1_facebook_service_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ProviderGetPersistentMenuRequest) GetGateId() string {
+	if x != nil {
+		return x.GateId
+	}
+	return ""
+}
+
+type ProviderGetPersistentMenuResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Locales []*ProviderLocaleMenu `protobuf:"bytes,1,rep,name=locales,proto3" json:"locales,omitempty"`
+}
+
+func (x *ProviderGetPersistentMenuResponse) Reset() {
+	*x = ProviderGetPersistentMenuResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_provider_v1_facebook_service_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProviderGetPersistentMenuResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProviderGetPersistentMenuResponse) ProtoMessage() {}
+
+func (x *ProviderGetPersistentMenuResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_provider_v1_facebook_service_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProviderGetPersistentMenuResponse.ProtoReflect.Descriptor instead.
+func (*ProviderGetPersistentMenuResponse) Descriptor() ([]byte, []int) {
+	return file_service_provider_v1_facebook_service_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ProviderGetPersistentMenuResponse) GetLocales() []*ProviderLocaleMenu {
+	if x != nil {
+		return x.Locales
+	}
+	return nil
+}
+
 type ProviderDeletePersistentMenuRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -133,7 +299,7 @@ type ProviderDeletePersistentMenuRequest struct {
 func (x *ProviderDeletePersistentMenuRequest) Reset() {
 	*x = ProviderDeletePersistentMenuRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_service_provider_v1_facebook_service_proto_msgTypes[2]
+		mi := &file_service_provider_v1_facebook_service_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -146,7 +312,7 @@ func (x *ProviderDeletePersistentMenuRequest) String() string {
 func (*ProviderDeletePersistentMenuRequest) ProtoMessage() {}
 
 func (x *ProviderDeletePersistentMenuRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_service_provider_v1_facebook_service_proto_msgTypes[2]
+	mi := &file_service_provider_v1_facebook_service_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -159,7 +325,7 @@ func (x *ProviderDeletePersistentMenuRequest) ProtoReflect() protoreflect.Messag
 
 // Deprecated: Use ProviderDeletePersistentMenuRequest.ProtoReflect.Descriptor instead.
 func (*ProviderDeletePersistentMenuRequest) Descriptor() ([]byte, []int) {
-	return file_service_provider_v1_facebook_service_proto_rawDescGZIP(), []int{2}
+	return file_service_provider_v1_facebook_service_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *ProviderDeletePersistentMenuRequest) GetGateId() string {
@@ -178,7 +344,7 @@ type ProviderDeletePersistentMenuResponse struct {
 func (x *ProviderDeletePersistentMenuResponse) Reset() {
 	*x = ProviderDeletePersistentMenuResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_service_provider_v1_facebook_service_proto_msgTypes[3]
+		mi := &file_service_provider_v1_facebook_service_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -191,7 +357,100 @@ func (x *ProviderDeletePersistentMenuResponse) String() string {
 func (*ProviderDeletePersistentMenuResponse) ProtoMessage() {}
 
 func (x *ProviderDeletePersistentMenuResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_service_provider_v1_facebook_service_proto_msgTypes[3]
+	mi := &file_service_provider_v1_facebook_service_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProviderDeletePersistentMenuResponse.ProtoReflect.Descriptor instead.
+func (*ProviderDeletePersistentMenuResponse) Descriptor() ([]byte, []int) {
+	return file_service_provider_v1_facebook_service_proto_rawDescGZIP(), []int{6}
+}
+
+type ProviderSetGetStartedRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GateId  string `protobuf:"bytes,1,opt,name=gate_id,json=gateId,proto3" json:"gate_id,omitempty"`
+	Payload string `protobuf:"bytes,2,opt,name=payload,proto3" json:"payload,omitempty"`
+}
+
+func (x *ProviderSetGetStartedRequest) Reset() {
+	*x = ProviderSetGetStartedRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_provider_v1_facebook_service_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProviderSetGetStartedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProviderSetGetStartedRequest) ProtoMessage() {}
+
+func (x *ProviderSetGetStartedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_provider_v1_facebook_service_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProviderSetGetStartedRequest.ProtoReflect.Descriptor instead.
+func (*ProviderSetGetStartedRequest) Descriptor() ([]byte, []int) {
+	return file_service_provider_v1_facebook_service_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ProviderSetGetStartedRequest) GetGateId() string {
+	if x != nil {
+		return x.GateId
+	}
+	return ""
+}
+
+func (x *ProviderSetGetStartedRequest) GetPayload() string {
+	if x != nil {
+		return x.Payload
+	}
+	return ""
+}
+
+type ProviderSetGetStartedResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ProviderSetGetStartedResponse) Reset() {
+	*x = ProviderSetGetStartedResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_provider_v1_facebook_service_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProviderSetGetStartedResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProviderSetGetStartedResponse) ProtoMessage() {}
+
+func (x *ProviderSetGetStartedResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_provider_v1_facebook_service_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -199,40 +458,469 @@ func (x *ProviderDeletePersistentMenuResponse) ProtoReflect() protoreflect.Messa
 		}
 		return ms
 	}
-	return mi.MessageOf(x)
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProviderSetGetStartedResponse.ProtoReflect.Descriptor instead.
+func (*ProviderSetGetStartedResponse) Descriptor() ([]byte, []int) {
+	return file_service_provider_v1_facebook_service_proto_rawDescGZIP(), []int{8}
+}
+
+type ProviderDeleteGetStartedRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GateId string `protobuf:"bytes,1,opt,name=gate_id,json=gateId,proto3" json:"gate_id,omitempty"`
+}
+
+func (x *ProviderDeleteGetStartedRequest) Reset() {
+	*x = ProviderDeleteGetStartedRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_provider_v1_facebook_service_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProviderDeleteGetStartedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProviderDeleteGetStartedRequest) ProtoMessage() {}
+
+func (x *ProviderDeleteGetStartedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_provider_v1_facebook_service_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProviderDeleteGetStartedRequest.ProtoReflect.Descriptor instead.
+func (*ProviderDeleteGetStartedRequest) Descriptor() ([]byte, []int) {
+	return file_service_provider_v1_facebook_service_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ProviderDeleteGetStartedRequest) GetGateId() string {
+	if x != nil {
+		return x.GateId
+	}
+	return ""
+}
+
+type ProviderDeleteGetStartedResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ProviderDeleteGetStartedResponse) Reset() {
+	*x = ProviderDeleteGetStartedResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_provider_v1_facebook_service_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProviderDeleteGetStartedResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProviderDeleteGetStartedResponse) ProtoMessage() {}
+
+func (x *ProviderDeleteGetStartedResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_provider_v1_facebook_service_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProviderDeleteGetStartedResponse.ProtoReflect.Descriptor instead.
+func (*ProviderDeleteGetStartedResponse) Descriptor() ([]byte, []int) {
+	return file_service_provider_v1_facebook_service_proto_rawDescGZIP(), []int{10}
+}
+
+type ProviderLocaleText struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Locale string `protobuf:"bytes,1,opt,name=locale,proto3" json:"locale,omitempty"` // Messenger locale such as "en_US", or "default"
+	Text   string `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`
+}
+
+func (x *ProviderLocaleText) Reset() {
+	*x = ProviderLocaleText{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_provider_v1_facebook_service_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProviderLocaleText) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProviderLocaleText) ProtoMessage() {}
+
+func (x *ProviderLocaleText) ProtoReflect() protoreflect.Message {
+	mi := &file_service_provider_v1_facebook_service_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProviderLocaleText.ProtoReflect.Descriptor instead.
+func (*ProviderLocaleText) Descriptor() ([]byte, []int) {
+	return file_service_provider_v1_facebook_service_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ProviderLocaleText) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+func (x *ProviderLocaleText) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+type ProviderSetGreetingRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GateId   string                `protobuf:"bytes,1,opt,name=gate_id,json=gateId,proto3" json:"gate_id,omitempty"`
+	Greeting []*ProviderLocaleText `protobuf:"bytes,2,rep,name=greeting,proto3" json:"greeting,omitempty"`
+}
+
+func (x *ProviderSetGreetingRequest) Reset() {
+	*x = ProviderSetGreetingRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_provider_v1_facebook_service_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProviderSetGreetingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProviderSetGreetingRequest) ProtoMessage() {}
+
+func (x *ProviderSetGreetingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_provider_v1_facebook_service_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProviderSetGreetingRequest.ProtoReflect.Descriptor instead.
+func (*ProviderSetGreetingRequest) Descriptor() ([]byte, []int) {
+	return file_service_provider_v1_facebook_service_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ProviderSetGreetingRequest) GetGateId() string {
+	if x != nil {
+		return x.GateId
+	}
+	return ""
+}
+
+func (x *ProviderSetGreetingRequest) GetGreeting() []*ProviderLocaleText {
+	if x != nil {
+		return x.Greeting
+	}
+	return nil
+}
+
+type ProviderSetGreetingResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ProviderSetGreetingResponse) Reset() {
+	*x = ProviderSetGreetingResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_provider_v1_facebook_service_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProviderSetGreetingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProviderSetGreetingResponse) ProtoMessage() {}
+
+func (x *ProviderSetGreetingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_provider_v1_facebook_service_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProviderSetGreetingResponse.ProtoReflect.Descriptor instead.
+func (*ProviderSetGreetingResponse) Descriptor() ([]byte, []int) {
+	return file_service_provider_v1_facebook_service_proto_rawDescGZIP(), []int{13}
+}
+
+type ProviderDeleteGreetingRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GateId string `protobuf:"bytes,1,opt,name=gate_id,json=gateId,proto3" json:"gate_id,omitempty"`
+}
+
+func (x *ProviderDeleteGreetingRequest) Reset() {
+	*x = ProviderDeleteGreetingRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_provider_v1_facebook_service_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProviderDeleteGreetingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProviderDeleteGreetingRequest) ProtoMessage() {}
+
+func (x *ProviderDeleteGreetingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_provider_v1_facebook_service_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProviderDeleteGreetingRequest.ProtoReflect.Descriptor instead.
+func (*ProviderDeleteGreetingRequest) Descriptor() ([]byte, []int) {
+	return file_service_provider_v1_facebook_service_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ProviderDeleteGreetingRequest) GetGateId() string {
+	if x != nil {
+		return x.GateId
+	}
+	return ""
+}
+
+type ProviderDeleteGreetingResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ProviderDeleteGreetingResponse) Reset() {
+	*x = ProviderDeleteGreetingResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_provider_v1_facebook_service_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProviderDeleteGreetingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProviderDeleteGreetingResponse) ProtoMessage() {}
+
+func (x *ProviderDeleteGreetingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_provider_v1_facebook_service_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProviderDeleteGreetingResponse.ProtoReflect.Descriptor instead.
+func (*ProviderDeleteGreetingResponse) Descriptor() ([]byte, []int) {
+	return file_service_provider_v1_facebook_service_proto_rawDescGZIP(), []int{15}
+}
+
+type ProviderIceBreaker struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Question string `protobuf:"bytes,1,opt,name=question,proto3" json:"question,omitempty"`
+	Payload  string `protobuf:"bytes,2,opt,name=payload,proto3" json:"payload,omitempty"`
+}
+
+func (x *ProviderIceBreaker) Reset() {
+	*x = ProviderIceBreaker{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_provider_v1_facebook_service_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProviderIceBreaker) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProviderIceBreaker) ProtoMessage() {}
+
+func (x *ProviderIceBreaker) ProtoReflect() protoreflect.Message {
+	mi := &file_service_provider_v1_facebook_service_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProviderIceBreaker.ProtoReflect.Descriptor instead.
+func (*ProviderIceBreaker) Descriptor() ([]byte, []int) {
+	return file_service_provider_v1_facebook_service_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ProviderIceBreaker) GetQuestion() string {
+	if x != nil {
+		return x.Question
+	}
+	return ""
+}
+
+func (x *ProviderIceBreaker) GetPayload() string {
+	if x != nil {
+		return x.Payload
+	}
+	return ""
+}
+
+// / ProviderLocaleIceBreakers is the set of ice breakers shown to users of one Messenger locale.
+type ProviderLocaleIceBreakers struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Locale      string                `protobuf:"bytes,1,opt,name=locale,proto3" json:"locale,omitempty"` // Messenger locale such as "en_US", or "default"
+	IceBreakers []*ProviderIceBreaker `protobuf:"bytes,2,rep,name=ice_breakers,json=iceBreakers,proto3" json:"ice_breakers,omitempty"`
+}
+
+func (x *ProviderLocaleIceBreakers) Reset() {
+	*x = ProviderLocaleIceBreakers{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_provider_v1_facebook_service_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProviderLocaleIceBreakers) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProviderLocaleIceBreakers) ProtoMessage() {}
+
+func (x *ProviderLocaleIceBreakers) ProtoReflect() protoreflect.Message {
+	mi := &file_service_provider_v1_facebook_service_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProviderLocaleIceBreakers.ProtoReflect.Descriptor instead.
+func (*ProviderLocaleIceBreakers) Descriptor() ([]byte, []int) {
+	return file_service_provider_v1_facebook_service_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ProviderLocaleIceBreakers) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
 }
 
-// Deprecated: Use ProviderDeletePersistentMenuResponse.ProtoReflect.Descriptor instead.
-func (*ProviderDeletePersistentMenuResponse) Descriptor() ([]byte, []int) {
-	return file_service_provider_v1_facebook_service_proto_rawDescGZIP(), []int{3}
+func (x *ProviderLocaleIceBreakers) GetIceBreakers() []*ProviderIceBreaker {
+	if x != nil {
+		return x.IceBreakers
+	}
+	return nil
 }
 
-type ProviderSetGetStartedRequest struct {
+type ProviderSetIceBreakersRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	GateId  string `protobuf:"bytes,1,opt,name=gate_id,json=gateId,proto3" json:"gate_id,omitempty"`
-	Payload string `protobuf:"bytes,2,opt,name=payload,proto3" json:"payload,omitempty"`
+	GateId  string                       `protobuf:"bytes,1,opt,name=gate_id,json=gateId,proto3" json:"gate_id,omitempty"`
+	Locales []*ProviderLocaleIceBreakers `protobuf:"bytes,2,rep,name=locales,proto3" json:"locales,omitempty"`
 }
 
-func (x *ProviderSetGetStartedRequest) Reset() {
-	*x = ProviderSetGetStartedRequest{}
+func (x *ProviderSetIceBreakersRequest) Reset() {
+	*x = ProviderSetIceBreakersRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_service_provider_v1_facebook_service_proto_msgTypes[4]
+		mi := &file_service_provider_v1_facebook_service_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ProviderSetGetStartedRequest) String() string {
+func (x *ProviderSetIceBreakersRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ProviderSetGetStartedRequest) ProtoMessage() {}
+func (*ProviderSetIceBreakersRequest) ProtoMessage() {}
 
-func (x *ProviderSetGetStartedRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_service_provider_v1_facebook_service_proto_msgTypes[4]
+func (x *ProviderSetIceBreakersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_provider_v1_facebook_service_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -243,48 +931,48 @@ func (x *ProviderSetGetStartedRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ProviderSetGetStartedRequest.ProtoReflect.Descriptor instead.
-func (*ProviderSetGetStartedRequest) Descriptor() ([]byte, []int) {
-	return file_service_provider_v1_facebook_service_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use ProviderSetIceBreakersRequest.ProtoReflect.Descriptor instead.
+func (*ProviderSetIceBreakersRequest) Descriptor() ([]byte, []int) {
+	return file_service_provider_v1_facebook_service_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *ProviderSetGetStartedRequest) GetGateId() string {
+func (x *ProviderSetIceBreakersRequest) GetGateId() string {
 	if x != nil {
 		return x.GateId
 	}
 	return ""
 }
 
-func (x *ProviderSetGetStartedRequest) GetPayload() string {
+func (x *ProviderSetIceBreakersRequest) GetLocales() []*ProviderLocaleIceBreakers {
 	if x != nil {
-		return x.Payload
+		return x.Locales
 	}
-	return ""
+	return nil
 }
 
-type ProviderSetGetStartedResponse struct {
+type ProviderSetIceBreakersResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *ProviderSetGetStartedResponse) Reset() {
-	*x = ProviderSetGetStartedResponse{}
+func (x *ProviderSetIceBreakersResponse) Reset() {
+	*x = ProviderSetIceBreakersResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_service_provider_v1_facebook_service_proto_msgTypes[5]
+		mi := &file_service_provider_v1_facebook_service_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ProviderSetGetStartedResponse) String() string {
+func (x *ProviderSetIceBreakersResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ProviderSetGetStartedResponse) ProtoMessage() {}
+func (*ProviderSetIceBreakersResponse) ProtoMessage() {}
 
-func (x *ProviderSetGetStartedResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_service_provider_v1_facebook_service_proto_msgTypes[5]
+func (x *ProviderSetIceBreakersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_provider_v1_facebook_service_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -295,12 +983,12 @@ func (x *ProviderSetGetStartedResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ProviderSetGetStartedResponse.ProtoReflect.Descriptor instead.
-func (*ProviderSetGetStartedResponse) Descriptor() ([]byte, []int) {
-	return file_service_provider_v1_facebook_service_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use ProviderSetIceBreakersResponse.ProtoReflect.Descriptor instead.
+func (*ProviderSetIceBreakersResponse) Descriptor() ([]byte, []int) {
+	return file_service_provider_v1_facebook_service_proto_rawDescGZIP(), []int{19}
 }
 
-type ProviderDeleteGetStartedRequest struct {
+type ProviderDeleteIceBreakersRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
@@ -308,23 +996,23 @@ type ProviderDeleteGetStartedRequest struct {
 	GateId string `protobuf:"bytes,1,opt,name=gate_id,json=gateId,proto3" json:"gate_id,omitempty"`
 }
 
-func (x *ProviderDeleteGetStartedRequest) Reset() {
-	*x = ProviderDeleteGetStartedRequest{}
+func (x *ProviderDeleteIceBreakersRequest) Reset() {
+	*x = ProviderDeleteIceBreakersRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_service_provider_v1_facebook_service_proto_msgTypes[6]
+		mi := &file_service_provider_v1_facebook_service_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ProviderDeleteGetStartedRequest) String() string {
+func (x *ProviderDeleteIceBreakersRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ProviderDeleteGetStartedRequest) ProtoMessage() {}
+func (*ProviderDeleteIceBreakersRequest) ProtoMessage() {}
 
-func (x *ProviderDeleteGetStartedRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_service_provider_v1_facebook_service_proto_msgTypes[6]
+func (x *ProviderDeleteIceBreakersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_provider_v1_facebook_service_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -335,41 +1023,41 @@ func (x *ProviderDeleteGetStartedRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ProviderDeleteGetStartedRequest.ProtoReflect.Descriptor instead.
-func (*ProviderDeleteGetStartedRequest) Descriptor() ([]byte, []int) {
-	return file_service_provider_v1_facebook_service_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use ProviderDeleteIceBreakersRequest.ProtoReflect.Descriptor instead.
+func (*ProviderDeleteIceBreakersRequest) Descriptor() ([]byte, []int) {
+	return file_service_provider_v1_facebook_service_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *ProviderDeleteGetStartedRequest) GetGateId() string {
+func (x *ProviderDeleteIceBreakersRequest) GetGateId() string {
 	if x != nil {
 		return x.GateId
 	}
 	return ""
 }
 
-type ProviderDeleteGetStartedResponse struct {
+type ProviderDeleteIceBreakersResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *ProviderDeleteGetStartedResponse) Reset() {
-	*x = ProviderDeleteGetStartedResponse{}
+func (x *ProviderDeleteIceBreakersResponse) Reset() {
+	*x = ProviderDeleteIceBreakersResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_service_provider_v1_facebook_service_proto_msgTypes[7]
+		mi := &file_service_provider_v1_facebook_service_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ProviderDeleteGetStartedResponse) String() string {
+func (x *ProviderDeleteIceBreakersResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ProviderDeleteGetStartedResponse) ProtoMessage() {}
+func (*ProviderDeleteIceBreakersResponse) ProtoMessage() {}
 
-func (x *ProviderDeleteGetStartedResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_service_provider_v1_facebook_service_proto_msgTypes[7]
+func (x *ProviderDeleteIceBreakersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_provider_v1_facebook_service_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -380,9 +1068,9 @@ func (x *ProviderDeleteGetStartedResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ProviderDeleteGetStartedResponse.ProtoReflect.Descriptor instead.
-func (*ProviderDeleteGetStartedResponse) Descriptor() ([]byte, []int) {
-	return file_service_provider_v1_facebook_service_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use ProviderDeleteIceBreakersResponse.ProtoReflect.Descriptor instead.
+func (*ProviderDeleteIceBreakersResponse) Descriptor() ([]byte, []int) {
+	return file_service_provider_v1_facebook_service_proto_rawDescGZIP(), []int{21}
 }
 
 type ProviderMenuItem struct {
@@ -402,7 +1090,7 @@ type ProviderMenuItem struct {
 func (x *ProviderMenuItem) Reset() {
 	*x = ProviderMenuItem{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_service_provider_v1_facebook_service_proto_msgTypes[8]
+		mi := &file_service_provider_v1_facebook_service_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -415,7 +1103,7 @@ func (x *ProviderMenuItem) String() string {
 func (*ProviderMenuItem) ProtoMessage() {}
 
 func (x *ProviderMenuItem) ProtoReflect() protoreflect.Message {
-	mi := &file_service_provider_v1_facebook_service_proto_msgTypes[8]
+	mi := &file_service_provider_v1_facebook_service_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -428,7 +1116,7 @@ func (x *ProviderMenuItem) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProviderMenuItem.ProtoReflect.Descriptor instead.
 func (*ProviderMenuItem) Descriptor() ([]byte, []int) {
-	return file_service_provider_v1_facebook_service_proto_rawDescGZIP(), []int{8}
+	return file_service_provider_v1_facebook_service_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *ProviderMenuItem) GetTitle() string {
@@ -499,7 +1187,7 @@ type ProviderMenuNestedItems struct {
 func (x *ProviderMenuNestedItems) Reset() {
 	*x = ProviderMenuNestedItems{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_service_provider_v1_facebook_service_proto_msgTypes[9]
+		mi := &file_service_provider_v1_facebook_service_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -512,7 +1200,7 @@ func (x *ProviderMenuNestedItems) String() string {
 func (*ProviderMenuNestedItems) ProtoMessage() {}
 
 func (x *ProviderMenuNestedItems) ProtoReflect() protoreflect.Message {
-	mi := &file_service_provider_v1_facebook_service_proto_msgTypes[9]
+	mi := &file_service_provider_v1_facebook_service_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -525,7 +1213,7 @@ func (x *ProviderMenuNestedItems) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProviderMenuNestedItems.ProtoReflect.Descriptor instead.
 func (*ProviderMenuNestedItems) Descriptor() ([]byte, []int) {
-	return file_service_provider_v1_facebook_service_proto_rawDescGZIP(), []int{9}
+	return file_service_provider_v1_facebook_service_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *ProviderMenuNestedItems) GetItems() []*ProviderMenuItem {
@@ -548,7 +1236,7 @@ var file_service_provider_v1_facebook_service_proto_rawDesc = []byte{
 	0x69, 0x64, 0x65, 0x72, 0x2f, 0x76, 0x31, 0x2f, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73,
 	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x22, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f,
 	0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x2f, 0x76, 0x31, 0x2f, 0x6d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xb3, 0x01, 0x0a, 0x20, 0x50,
+	0x61, 0x67, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xf9, 0x01, 0x0a, 0x20, 0x50,
 	0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x53, 0x65, 0x74, 0x50, 0x65, 0x72, 0x73, 0x69, 0x73,
 	0x74, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x6e, 0x75, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
 	0x17, 0x0a, 0x07, 0x67, 0x61, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
@@ -560,151 +1248,283 @@ var file_service_provider_v1_facebook_service_proto_rawDesc = []byte{
 	0x6f, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x5f, 0x64, 0x69, 0x73, 0x61, 0x62,
 	0x6c, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x15, 0x63, 0x6f, 0x6d, 0x70, 0x6f,
 	0x73, 0x65, 0x72, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64,
-	0x22, 0x23, 0x0a, 0x21, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x53, 0x65, 0x74, 0x50,
-	0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x6e, 0x75, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3e, 0x0a, 0x23, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65,
-	0x72, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e,
-	0x74, 0x4d, 0x65, 0x6e, 0x75, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07,
-	0x67, 0x61, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67,
-	0x61, 0x74, 0x65, 0x49, 0x64, 0x22, 0x26, 0x0a, 0x24, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65,
-	0x72, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e,
-	0x74, 0x4d, 0x65, 0x6e, 0x75, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x51, 0x0a,
-	0x1c, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x53, 0x65, 0x74, 0x47, 0x65, 0x74, 0x53,
-	0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a,
-	0x07, 0x67, 0x61, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
-	0x67, 0x61, 0x74, 0x65, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61,
-	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64,
-	0x22, 0x1f, 0x0a, 0x1d, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x53, 0x65, 0x74, 0x47,
-	0x65, 0x74, 0x53, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x3a, 0x0a, 0x1f, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x44, 0x65, 0x6c,
-	0x65, 0x74, 0x65, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x61, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x61, 0x74, 0x65, 0x49, 0x64, 0x22, 0x22, 0x0a,
-	0x20, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x47,
-	0x65, 0x74, 0x53, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0xad, 0x01, 0x0a, 0x10, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x4d, 0x65,
-	0x6e, 0x75, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x1a, 0x0a, 0x07,
-	0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52,
-	0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x12, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x49, 0x0a, 0x06,
-	0x6e, 0x65, 0x73, 0x74, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x77,
-	0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x2e, 0x69, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64,
-	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x4d, 0x65,
-	0x6e, 0x75, 0x4e, 0x65, 0x73, 0x74, 0x65, 0x64, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x48, 0x00, 0x52,
-	0x06, 0x6e, 0x65, 0x73, 0x74, 0x65, 0x64, 0x42, 0x08, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x22, 0x59, 0x0a, 0x17, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x4d, 0x65, 0x6e,
-	0x75, 0x4e, 0x65, 0x73, 0x74, 0x65, 0x64, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x3e, 0x0a, 0x05,
-	0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x77, 0x65,
-	0x62, 0x69, 0x74, 0x65, 0x6c, 0x2e, 0x69, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65,
-	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x4d, 0x65, 0x6e,
-	0x75, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x32, 0xab, 0x0b, 0x0a,
-	0x0f, 0x46, 0x61, 0x63, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x12, 0xaa, 0x01, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x46, 0x61, 0x63, 0x65, 0x62,
-	0x6f, 0x6f, 0x6b, 0x47, 0x61, 0x74, 0x65, 0x12, 0x39, 0x2e, 0x77, 0x65, 0x62, 0x69, 0x74, 0x65,
-	0x6c, 0x2e, 0x69, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x2e, 0x76, 0x31,
-	0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x46,
-	0x61, 0x63, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x47, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x3a, 0x2e, 0x77, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x2e, 0x69, 0x6d, 0x2e,
-	0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x76,
-	0x69, 0x64, 0x65, 0x72, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x46, 0x61, 0x63, 0x65, 0x62, 0x6f,
-	0x6f, 0x6b, 0x47, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1d,
-	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x17, 0x3a, 0x01, 0x2a, 0x22, 0x12, 0x2f, 0x69, 0x6d, 0x2f, 0x67,
-	0x61, 0x74, 0x65, 0x73, 0x2f, 0x66, 0x61, 0x63, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x12, 0xa3, 0x01,
-	0x0a, 0x0f, 0x47, 0x65, 0x74, 0x46, 0x61, 0x63, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x47, 0x61, 0x74,
-	0x65, 0x12, 0x36, 0x2e, 0x77, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x2e, 0x69, 0x6d, 0x2e, 0x70,
+	0x12, 0x44, 0x0a, 0x07, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x2a, 0x2e, 0x77, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x2e, 0x69, 0x6d, 0x2e, 0x70,
+	0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69,
+	0x64, 0x65, 0x72, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x4d, 0x65, 0x6e, 0x75, 0x52, 0x07, 0x6c,
+	0x6f, 0x63, 0x61, 0x6c, 0x65, 0x73, 0x22, 0x23, 0x0a, 0x21, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64,
+	0x65, 0x72, 0x53, 0x65, 0x74, 0x50, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x74, 0x4d,
+	0x65, 0x6e, 0x75, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xa4, 0x01, 0x0a, 0x12,
+	0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x4d, 0x65,
+	0x6e, 0x75, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x12, 0x3e, 0x0a, 0x05, 0x69, 0x74,
+	0x65, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x77, 0x65, 0x62, 0x69,
+	0x74, 0x65, 0x6c, 0x2e, 0x69, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x2e,
+	0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x4d, 0x65, 0x6e, 0x75, 0x49,
+	0x74, 0x65, 0x6d, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x36, 0x0a, 0x17, 0x63, 0x6f,
+	0x6d, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x5f, 0x64, 0x69, 0x73,
+	0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x15, 0x63, 0x6f, 0x6d,
+	0x70, 0x6f, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c,
+	0x65, 0x64, 0x22, 0x3b, 0x0a, 0x20, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x47, 0x65,
+	0x74, 0x50, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x6e, 0x75, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x61, 0x74, 0x65, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x61, 0x74, 0x65, 0x49, 0x64, 0x22,
+	0x69, 0x0a, 0x21, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x47, 0x65, 0x74, 0x50, 0x65,
+	0x72, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x6e, 0x75, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x44, 0x0a, 0x07, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x77, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x2e,
+	0x69, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50,
+	0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x4d, 0x65, 0x6e,
+	0x75, 0x52, 0x07, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x73, 0x22, 0x3e, 0x0a, 0x23, 0x50, 0x72,
+	0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x65, 0x72, 0x73,
+	0x69, 0x73, 0x74, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x6e, 0x75, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x61, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x67, 0x61, 0x74, 0x65, 0x49, 0x64, 0x22, 0x26, 0x0a, 0x24, 0x50, 0x72,
+	0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x65, 0x72, 0x73,
+	0x69, 0x73, 0x74, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x6e, 0x75, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x51, 0x0a, 0x1c, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x53, 0x65,
+	0x74, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x61, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x61, 0x74, 0x65, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x70,
+	0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x61,
+	0x79, 0x6c, 0x6f, 0x61, 0x64, 0x22, 0x1f, 0x0a, 0x1d, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65,
+	0x72, 0x53, 0x65, 0x74, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3a, 0x0a, 0x1f, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64,
+	0x65, 0x72, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x72, 0x74,
+	0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x61, 0x74,
+	0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x61, 0x74, 0x65,
+	0x49, 0x64, 0x22, 0x22, 0x0a, 0x20, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x40, 0x0a, 0x12, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64,
+	0x65, 0x72, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x54, 0x65, 0x78, 0x74, 0x12, 0x16, 0x0a, 0x06,
+	0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6c, 0x6f,
+	0x63, 0x61, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x22, 0x7d, 0x0a, 0x1a, 0x50, 0x72, 0x6f, 0x76,
+	0x69, 0x64, 0x65, 0x72, 0x53, 0x65, 0x74, 0x47, 0x72, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x61, 0x74, 0x65, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x61, 0x74, 0x65, 0x49, 0x64, 0x12,
+	0x46, 0x0a, 0x08, 0x67, 0x72, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x2a, 0x2e, 0x77, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x2e, 0x69, 0x6d, 0x2e, 0x70,
 	0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69,
-	0x64, 0x65, 0x72, 0x47, 0x65, 0x74, 0x46, 0x61, 0x63, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x47, 0x61,
-	0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x37, 0x2e, 0x77, 0x65, 0x62, 0x69,
+	0x64, 0x65, 0x72, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x54, 0x65, 0x78, 0x74, 0x52, 0x08, 0x67,
+	0x72, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x22, 0x1d, 0x0a, 0x1b, 0x50, 0x72, 0x6f, 0x76, 0x69,
+	0x64, 0x65, 0x72, 0x53, 0x65, 0x74, 0x47, 0x72, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x38, 0x0a, 0x1d, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64,
+	0x65, 0x72, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x47, 0x72, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x61, 0x74, 0x65, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x61, 0x74, 0x65, 0x49, 0x64,
+	0x22, 0x20, 0x0a, 0x1e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x47, 0x72, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x4a, 0x0a, 0x12, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x49, 0x63,
+	0x65, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x22, 0x82,
+	0x01, 0x0a, 0x19, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x4c, 0x6f, 0x63, 0x61, 0x6c,
+	0x65, 0x49, 0x63, 0x65, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x73, 0x12, 0x16, 0x0a, 0x06,
+	0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6c, 0x6f,
+	0x63, 0x61, 0x6c, 0x65, 0x12, 0x4d, 0x0a, 0x0c, 0x69, 0x63, 0x65, 0x5f, 0x62, 0x72, 0x65, 0x61,
+	0x6b, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x77, 0x65, 0x62,
+	0x69, 0x74, 0x65, 0x6c, 0x2e, 0x69, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
+	0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x49, 0x63, 0x65, 0x42,
+	0x72, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x52, 0x0b, 0x69, 0x63, 0x65, 0x42, 0x72, 0x65, 0x61, 0x6b,
+	0x65, 0x72, 0x73, 0x22, 0x85, 0x01, 0x0a, 0x1d, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
+	0x53, 0x65, 0x74, 0x49, 0x63, 0x65, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x61, 0x74, 0x65, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x61, 0x74, 0x65, 0x49, 0x64, 0x12, 0x4b,
+	0x0a, 0x07, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x31, 0x2e, 0x77, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x2e, 0x69, 0x6d, 0x2e, 0x70, 0x72, 0x6f,
+	0x76, 0x69, 0x64, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65,
+	0x72, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x49, 0x63, 0x65, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x65,
+	0x72, 0x73, 0x52, 0x07, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x73, 0x22, 0x20, 0x0a, 0x1e, 0x50,
+	0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x53, 0x65, 0x74, 0x49, 0x63, 0x65, 0x42, 0x72, 0x65,
+	0x61, 0x6b, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3b, 0x0a,
+	0x20, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x49,
+	0x63, 0x65, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x61, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x67, 0x61, 0x74, 0x65, 0x49, 0x64, 0x22, 0x23, 0x0a, 0x21, 0x50, 0x72,
+	0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x49, 0x63, 0x65, 0x42,
+	0x72, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0xad, 0x01, 0x0a, 0x10, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x4d, 0x65, 0x6e, 0x75,
+	0x49, 0x74, 0x65, 0x6d, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x1a, 0x0a, 0x07, 0x70, 0x61,
+	0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x07, 0x70,
+	0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x12, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x49, 0x0a, 0x06, 0x6e, 0x65,
+	0x73, 0x74, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x77, 0x65, 0x62,
+	0x69, 0x74, 0x65, 0x6c, 0x2e, 0x69, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
+	0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x4d, 0x65, 0x6e, 0x75,
+	0x4e, 0x65, 0x73, 0x74, 0x65, 0x64, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x48, 0x00, 0x52, 0x06, 0x6e,
+	0x65, 0x73, 0x74, 0x65, 0x64, 0x42, 0x08, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22,
+	0x59, 0x0a, 0x17, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x4d, 0x65, 0x6e, 0x75, 0x4e,
+	0x65, 0x73, 0x74, 0x65, 0x64, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x3e, 0x0a, 0x05, 0x69, 0x74,
+	0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x77, 0x65, 0x62, 0x69,
 	0x74, 0x65, 0x6c, 0x2e, 0x69, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x2e,
-	0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x47, 0x65, 0x74, 0x46, 0x61,
-	0x63, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x47, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x1f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x19, 0x12, 0x17, 0x2f, 0x69, 0x6d, 0x2f,
+	0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x4d, 0x65, 0x6e, 0x75, 0x49,
+	0x74, 0x65, 0x6d, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x32, 0xb3, 0x12, 0x0a, 0x0f, 0x46,
+	0x61, 0x63, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0xaa,
+	0x01, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x46, 0x61, 0x63, 0x65, 0x62, 0x6f, 0x6f,
+	0x6b, 0x47, 0x61, 0x74, 0x65, 0x12, 0x39, 0x2e, 0x77, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x2e,
+	0x69, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50,
+	0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x46, 0x61, 0x63,
+	0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x47, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x3a, 0x2e, 0x77, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x2e, 0x69, 0x6d, 0x2e, 0x70, 0x72,
+	0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64,
+	0x65, 0x72, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x46, 0x61, 0x63, 0x65, 0x62, 0x6f, 0x6f, 0x6b,
+	0x47, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1d, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x17, 0x3a, 0x01, 0x2a, 0x22, 0x12, 0x2f, 0x69, 0x6d, 0x2f, 0x67, 0x61, 0x74,
+	0x65, 0x73, 0x2f, 0x66, 0x61, 0x63, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x12, 0xa3, 0x01, 0x0a, 0x0f,
+	0x47, 0x65, 0x74, 0x46, 0x61, 0x63, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x47, 0x61, 0x74, 0x65, 0x12,
+	0x36, 0x2e, 0x77, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x2e, 0x69, 0x6d, 0x2e, 0x70, 0x72, 0x6f,
+	0x76, 0x69, 0x64, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65,
+	0x72, 0x47, 0x65, 0x74, 0x46, 0x61, 0x63, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x47, 0x61, 0x74, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x37, 0x2e, 0x77, 0x65, 0x62, 0x69, 0x74, 0x65,
+	0x6c, 0x2e, 0x69, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x2e, 0x76, 0x31,
+	0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x47, 0x65, 0x74, 0x46, 0x61, 0x63, 0x65,
+	0x62, 0x6f, 0x6f, 0x6b, 0x47, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x1f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x19, 0x12, 0x17, 0x2f, 0x69, 0x6d, 0x2f, 0x67, 0x61,
+	0x74, 0x65, 0x73, 0x2f, 0x66, 0x61, 0x63, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x2f, 0x7b, 0x69, 0x64,
+	0x7d, 0x12, 0xaf, 0x01, 0x0a, 0x12, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x46, 0x61, 0x63, 0x65,
+	0x62, 0x6f, 0x6f, 0x6b, 0x47, 0x61, 0x74, 0x65, 0x12, 0x39, 0x2e, 0x77, 0x65, 0x62, 0x69, 0x74,
+	0x65, 0x6c, 0x2e, 0x69, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x2e, 0x76,
+	0x31, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x46, 0x61, 0x63, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x47, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x3a, 0x2e, 0x77, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x2e, 0x69, 0x6d,
+	0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f,
+	0x76, 0x69, 0x64, 0x65, 0x72, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x46, 0x61, 0x63, 0x65, 0x62,
+	0x6f, 0x6f, 0x6b, 0x47, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x22, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1c, 0x3a, 0x01, 0x2a, 0x32, 0x17, 0x2f, 0x69, 0x6d, 0x2f,
 	0x67, 0x61, 0x74, 0x65, 0x73, 0x2f, 0x66, 0x61, 0x63, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x2f, 0x7b,
-	0x69, 0x64, 0x7d, 0x12, 0xaf, 0x01, 0x0a, 0x12, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x46, 0x61,
+	0x69, 0x64, 0x7d, 0x12, 0xac, 0x01, 0x0a, 0x12, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x46, 0x61,
 	0x63, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x47, 0x61, 0x74, 0x65, 0x12, 0x39, 0x2e, 0x77, 0x65, 0x62,
 	0x69, 0x74, 0x65, 0x6c, 0x2e, 0x69, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
-	0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x55, 0x70, 0x64, 0x61,
+	0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x44, 0x65, 0x6c, 0x65,
 	0x74, 0x65, 0x46, 0x61, 0x63, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x47, 0x61, 0x74, 0x65, 0x52, 0x65,
 	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x3a, 0x2e, 0x77, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x2e,
 	0x69, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50,
-	0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x46, 0x61, 0x63,
+	0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x46, 0x61, 0x63,
 	0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x47, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x22, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1c, 0x3a, 0x01, 0x2a, 0x32, 0x17, 0x2f, 0x69,
-	0x6d, 0x2f, 0x67, 0x61, 0x74, 0x65, 0x73, 0x2f, 0x66, 0x61, 0x63, 0x65, 0x62, 0x6f, 0x6f, 0x6b,
-	0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12, 0xac, 0x01, 0x0a, 0x12, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
-	0x46, 0x61, 0x63, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x47, 0x61, 0x74, 0x65, 0x12, 0x39, 0x2e, 0x77,
-	0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x2e, 0x69, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64,
-	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x44, 0x65,
-	0x6c, 0x65, 0x74, 0x65, 0x46, 0x61, 0x63, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x47, 0x61, 0x74, 0x65,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x3a, 0x2e, 0x77, 0x65, 0x62, 0x69, 0x74, 0x65,
-	0x6c, 0x2e, 0x69, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x2e, 0x76, 0x31,
-	0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x46,
-	0x61, 0x63, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x47, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x1f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x19, 0x2a, 0x17, 0x2f, 0x69, 0x6d,
-	0x2f, 0x67, 0x61, 0x74, 0x65, 0x73, 0x2f, 0x66, 0x61, 0x63, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x2f,
-	0x7b, 0x69, 0x64, 0x7d, 0x12, 0xb6, 0x01, 0x0a, 0x11, 0x53, 0x65, 0x74, 0x50, 0x65, 0x72, 0x73,
-	0x69, 0x73, 0x74, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x6e, 0x75, 0x12, 0x38, 0x2e, 0x77, 0x65, 0x62,
+	0x65, 0x22, 0x1f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x19, 0x2a, 0x17, 0x2f, 0x69, 0x6d, 0x2f, 0x67,
+	0x61, 0x74, 0x65, 0x73, 0x2f, 0x66, 0x61, 0x63, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x2f, 0x7b, 0x69,
+	0x64, 0x7d, 0x12, 0xb6, 0x01, 0x0a, 0x11, 0x53, 0x65, 0x74, 0x50, 0x65, 0x72, 0x73, 0x69, 0x73,
+	0x74, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x6e, 0x75, 0x12, 0x38, 0x2e, 0x77, 0x65, 0x62, 0x69, 0x74,
+	0x65, 0x6c, 0x2e, 0x69, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x2e, 0x76,
+	0x31, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x53, 0x65, 0x74, 0x50, 0x65, 0x72,
+	0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x6e, 0x75, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x39, 0x2e, 0x77, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x2e, 0x69, 0x6d, 0x2e,
+	0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x76,
+	0x69, 0x64, 0x65, 0x72, 0x53, 0x65, 0x74, 0x50, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e,
+	0x74, 0x4d, 0x65, 0x6e, 0x75, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2c, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x26, 0x3a, 0x01, 0x2a, 0x1a, 0x21, 0x2f, 0x69, 0x6d, 0x2f, 0x67, 0x61,
+	0x74, 0x65, 0x73, 0x2f, 0x66, 0x61, 0x63, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x2f, 0x7b, 0x67, 0x61,
+	0x74, 0x65, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x6d, 0x65, 0x6e, 0x75, 0x12, 0xb3, 0x01, 0x0a, 0x11,
+	0x47, 0x65, 0x74, 0x50, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x6e,
+	0x75, 0x12, 0x38, 0x2e, 0x77, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x2e, 0x69, 0x6d, 0x2e, 0x70,
+	0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69,
+	0x64, 0x65, 0x72, 0x47, 0x65, 0x74, 0x50, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x74,
+	0x4d, 0x65, 0x6e, 0x75, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x39, 0x2e, 0x77, 0x65,
+	0x62, 0x69, 0x74, 0x65, 0x6c, 0x2e, 0x69, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65,
+	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x47, 0x65, 0x74,
+	0x50, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x6e, 0x75, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x29, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x23, 0x12, 0x21,
+	0x2f, 0x69, 0x6d, 0x2f, 0x67, 0x61, 0x74, 0x65, 0x73, 0x2f, 0x66, 0x61, 0x63, 0x65, 0x62, 0x6f,
+	0x6f, 0x6b, 0x2f, 0x7b, 0x67, 0x61, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x6d, 0x65, 0x6e,
+	0x75, 0x12, 0xbc, 0x01, 0x0a, 0x14, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x65, 0x72, 0x73,
+	0x69, 0x73, 0x74, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x6e, 0x75, 0x12, 0x3b, 0x2e, 0x77, 0x65, 0x62,
 	0x69, 0x74, 0x65, 0x6c, 0x2e, 0x69, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
-	0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x53, 0x65, 0x74, 0x50,
-	0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x6e, 0x75, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x39, 0x2e, 0x77, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x2e, 0x69,
-	0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72,
-	0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x53, 0x65, 0x74, 0x50, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74,
-	0x65, 0x6e, 0x74, 0x4d, 0x65, 0x6e, 0x75, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x2c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x26, 0x3a, 0x01, 0x2a, 0x1a, 0x21, 0x2f, 0x69, 0x6d, 0x2f,
+	0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x50, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x6e, 0x75,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x3c, 0x2e, 0x77, 0x65, 0x62, 0x69, 0x74, 0x65,
+	0x6c, 0x2e, 0x69, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x2e, 0x76, 0x31,
+	0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50,
+	0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x6e, 0x75, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x29, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x23, 0x2a, 0x21, 0x2f,
+	0x69, 0x6d, 0x2f, 0x67, 0x61, 0x74, 0x65, 0x73, 0x2f, 0x66, 0x61, 0x63, 0x65, 0x62, 0x6f, 0x6f,
+	0x6b, 0x2f, 0x7b, 0x67, 0x61, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x6d, 0x65, 0x6e, 0x75,
+	0x12, 0xb1, 0x01, 0x0a, 0x0d, 0x53, 0x65, 0x74, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x72, 0x74,
+	0x65, 0x64, 0x12, 0x34, 0x2e, 0x77, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x2e, 0x69, 0x6d, 0x2e,
+	0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x76,
+	0x69, 0x64, 0x65, 0x72, 0x53, 0x65, 0x74, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x72, 0x74, 0x65,
+	0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x35, 0x2e, 0x77, 0x65, 0x62, 0x69, 0x74,
+	0x65, 0x6c, 0x2e, 0x69, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x2e, 0x76,
+	0x31, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x53, 0x65, 0x74, 0x47, 0x65, 0x74,
+	0x53, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x33, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2d, 0x3a, 0x01, 0x2a, 0x1a, 0x28, 0x2f, 0x69, 0x6d, 0x2f,
 	0x67, 0x61, 0x74, 0x65, 0x73, 0x2f, 0x66, 0x61, 0x63, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x2f, 0x7b,
-	0x67, 0x61, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x6d, 0x65, 0x6e, 0x75, 0x12, 0xbc, 0x01,
-	0x0a, 0x14, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x65,
-	0x6e, 0x74, 0x4d, 0x65, 0x6e, 0x75, 0x12, 0x3b, 0x2e, 0x77, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c,
-	0x2e, 0x69, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e,
-	0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x65,
-	0x72, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x6e, 0x75, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x3c, 0x2e, 0x77, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x2e, 0x69, 0x6d,
-	0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f,
-	0x76, 0x69, 0x64, 0x65, 0x72, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x65, 0x72, 0x73, 0x69,
-	0x73, 0x74, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x6e, 0x75, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x29, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x23, 0x2a, 0x21, 0x2f, 0x69, 0x6d, 0x2f, 0x67,
-	0x61, 0x74, 0x65, 0x73, 0x2f, 0x66, 0x61, 0x63, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x2f, 0x7b, 0x67,
-	0x61, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x6d, 0x65, 0x6e, 0x75, 0x12, 0xb1, 0x01, 0x0a,
-	0x0d, 0x53, 0x65, 0x74, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x12, 0x34,
+	0x67, 0x61, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x67, 0x65, 0x74, 0x2d, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x65, 0x64, 0x12, 0xb7, 0x01, 0x0a, 0x10, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x47,
+	0x65, 0x74, 0x53, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x12, 0x37, 0x2e, 0x77, 0x65, 0x62, 0x69,
+	0x74, 0x65, 0x6c, 0x2e, 0x69, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x2e,
+	0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x38, 0x2e, 0x77, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x2e, 0x69, 0x6d, 0x2e,
+	0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x76,
+	0x69, 0x64, 0x65, 0x72, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61,
+	0x72, 0x74, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x30, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x2a, 0x2a, 0x28, 0x2f, 0x69, 0x6d, 0x2f, 0x67, 0x61, 0x74, 0x65, 0x73, 0x2f,
+	0x66, 0x61, 0x63, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x2f, 0x7b, 0x67, 0x61, 0x74, 0x65, 0x5f, 0x69,
+	0x64, 0x7d, 0x2f, 0x67, 0x65, 0x74, 0x2d, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x12, 0xa8,
+	0x01, 0x0a, 0x0b, 0x53, 0x65, 0x74, 0x47, 0x72, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x32,
 	0x2e, 0x77, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x2e, 0x69, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x76,
 	0x69, 0x64, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
-	0x53, 0x65, 0x74, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x35, 0x2e, 0x77, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x2e, 0x69,
+	0x53, 0x65, 0x74, 0x47, 0x72, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x33, 0x2e, 0x77, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x2e, 0x69, 0x6d, 0x2e,
+	0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x76,
+	0x69, 0x64, 0x65, 0x72, 0x53, 0x65, 0x74, 0x47, 0x72, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x30, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2a, 0x3a,
+	0x01, 0x2a, 0x1a, 0x25, 0x2f, 0x69, 0x6d, 0x2f, 0x67, 0x61, 0x74, 0x65, 0x73, 0x2f, 0x66, 0x61,
+	0x63, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x2f, 0x7b, 0x67, 0x61, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x7d,
+	0x2f, 0x67, 0x72, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x12, 0xae, 0x01, 0x0a, 0x0e, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x47, 0x72, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x35, 0x2e, 0x77,
+	0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x2e, 0x69, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64,
+	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x47, 0x72, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x36, 0x2e, 0x77, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x2e, 0x69, 0x6d,
+	0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f,
+	0x76, 0x69, 0x64, 0x65, 0x72, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x47, 0x72, 0x65, 0x65, 0x74,
+	0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2d, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x27, 0x2a, 0x25, 0x2f, 0x69, 0x6d, 0x2f, 0x67, 0x61, 0x74, 0x65, 0x73, 0x2f, 0x66,
+	0x61, 0x63, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x2f, 0x7b, 0x67, 0x61, 0x74, 0x65, 0x5f, 0x69, 0x64,
+	0x7d, 0x2f, 0x67, 0x72, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x12, 0xb5, 0x01, 0x0a, 0x0e, 0x53,
+	0x65, 0x74, 0x49, 0x63, 0x65, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x73, 0x12, 0x35, 0x2e,
+	0x77, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x2e, 0x69, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69,
+	0x64, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x53,
+	0x65, 0x74, 0x49, 0x63, 0x65, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x36, 0x2e, 0x77, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x2e, 0x69,
 	0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72,
-	0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x53, 0x65, 0x74, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x72,
-	0x74, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x33, 0x82, 0xd3, 0xe4,
-	0x93, 0x02, 0x2d, 0x3a, 0x01, 0x2a, 0x1a, 0x28, 0x2f, 0x69, 0x6d, 0x2f, 0x67, 0x61, 0x74, 0x65,
-	0x73, 0x2f, 0x66, 0x61, 0x63, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x2f, 0x7b, 0x67, 0x61, 0x74, 0x65,
-	0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x67, 0x65, 0x74, 0x2d, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64,
-	0x12, 0xb7, 0x01, 0x0a, 0x10, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x47, 0x65, 0x74, 0x53, 0x74,
-	0x61, 0x72, 0x74, 0x65, 0x64, 0x12, 0x37, 0x2e, 0x77, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x2e,
-	0x69, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50,
-	0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x47, 0x65, 0x74,
-	0x53, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x38,
-	0x2e, 0x77, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x2e, 0x69, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x76,
-	0x69, 0x64, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
-	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x30, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2a,
-	0x2a, 0x28, 0x2f, 0x69, 0x6d, 0x2f, 0x67, 0x61, 0x74, 0x65, 0x73, 0x2f, 0x66, 0x61, 0x63, 0x65,
-	0x62, 0x6f, 0x6f, 0x6b, 0x2f, 0x7b, 0x67, 0x61, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x67,
-	0x65, 0x74, 0x2d, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x42, 0xe7, 0x01, 0x0a, 0x1a, 0x63,
-	0x6f, 0x6d, 0x2e, 0x77, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x2e, 0x69, 0x6d, 0x2e, 0x70, 0x72,
-	0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x42, 0x14, 0x46, 0x61, 0x63, 0x65, 0x62,
-	0x6f, 0x6f, 0x6b, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50,
-	0x01, 0x5a, 0x38, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x77, 0x65,
-	0x62, 0x69, 0x74, 0x65, 0x6c, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2f, 0x67, 0x65, 0x6e,
-	0x2f, 0x67, 0x6f, 0x2f, 0x69, 0x6d, 0x2f, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x2f,
-	0x76, 0x31, 0x3b, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0xa2, 0x02, 0x03, 0x57, 0x49,
-	0x50, 0xaa, 0x02, 0x16, 0x57, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x2e, 0x49, 0x6d, 0x2e, 0x50,
-	0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x16, 0x57, 0x65, 0x62,
-	0x69, 0x74, 0x65, 0x6c, 0x5c, 0x49, 0x6d, 0x5c, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
-	0x5c, 0x56, 0x31, 0xe2, 0x02, 0x22, 0x57, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x5c, 0x49, 0x6d,
-	0x5c, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42,
-	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x19, 0x57, 0x65, 0x62, 0x69, 0x74,
-	0x65, 0x6c, 0x3a, 0x3a, 0x49, 0x6d, 0x3a, 0x3a, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
-	0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x53, 0x65, 0x74, 0x49, 0x63, 0x65, 0x42, 0x72, 0x65, 0x61,
+	0x6b, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x34, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x2e, 0x3a, 0x01, 0x2a, 0x1a, 0x29, 0x2f, 0x69, 0x6d, 0x2f, 0x67, 0x61, 0x74,
+	0x65, 0x73, 0x2f, 0x66, 0x61, 0x63, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x2f, 0x7b, 0x67, 0x61, 0x74,
+	0x65, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x69, 0x63, 0x65, 0x2d, 0x62, 0x72, 0x65, 0x61, 0x6b, 0x65,
+	0x72, 0x73, 0x12, 0xbb, 0x01, 0x0a, 0x11, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x49, 0x63, 0x65,
+	0x42, 0x72, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x73, 0x12, 0x38, 0x2e, 0x77, 0x65, 0x62, 0x69, 0x74,
+	0x65, 0x6c, 0x2e, 0x69, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x2e, 0x76,
+	0x31, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x49, 0x63, 0x65, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x39, 0x2e, 0x77, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x2e, 0x69, 0x6d, 0x2e,
+	0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x76,
+	0x69, 0x64, 0x65, 0x72, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x49, 0x63, 0x65, 0x42, 0x72, 0x65,
+	0x61, 0x6b, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x31, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x2b, 0x2a, 0x29, 0x2f, 0x69, 0x6d, 0x2f, 0x67, 0x61, 0x74, 0x65, 0x73,
+	0x2f, 0x66, 0x61, 0x63, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x2f, 0x7b, 0x67, 0x61, 0x74, 0x65, 0x5f,
+	0x69, 0x64, 0x7d, 0x2f, 0x69, 0x63, 0x65, 0x2d, 0x62, 0x72, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x73,
+	0x42, 0xe7, 0x01, 0x0a, 0x1a, 0x63, 0x6f, 0x6d, 0x2e, 0x77, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c,
+	0x2e, 0x69, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x42,
+	0x14, 0x46, 0x61, 0x63, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x38, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2f, 0x77, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x2f, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x73, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x67, 0x6f, 0x2f, 0x69, 0x6d, 0x2f, 0x70, 0x72, 0x6f,
+	0x76, 0x69, 0x64, 0x65, 0x72, 0x2f, 0x76, 0x31, 0x3b, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65,
+	0x72, 0xa2, 0x02, 0x03, 0x57, 0x49, 0x50, 0xaa, 0x02, 0x16, 0x57, 0x65, 0x62, 0x69, 0x74, 0x65,
+	0x6c, 0x2e, 0x49, 0x6d, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x2e, 0x56, 0x31,
+	0xca, 0x02, 0x16, 0x57, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x5c, 0x49, 0x6d, 0x5c, 0x50, 0x72,
+	0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x22, 0x57, 0x65, 0x62, 0x69,
+	0x74, 0x65, 0x6c, 0x5c, 0x49, 0x6d, 0x5c, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x5c,
+	0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02,
+	0x19, 0x57, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x3a, 0x3a, 0x49, 0x6d, 0x3a, 0x3a, 0x50, 0x72,
+	0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
 }
 
 var (
@@ -719,52 +1539,82 @@ func file_service_provider_v1_facebook_service_proto_rawDescGZIP() []byte {
 	return file_service_provider_v1_facebook_service_proto_rawDescData
 }
 
-var file_service_provider_v1_facebook_service_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_service_provider_v1_facebook_service_proto_msgTypes = make([]protoimpl.MessageInfo, 24)
 var file_service_provider_v1_facebook_service_proto_goTypes = []interface{}{
 	(*ProviderSetPersistentMenuRequest)(nil),     // 0: webitel.im.provider.v1.ProviderSetPersistentMenuRequest
 	(*ProviderSetPersistentMenuResponse)(nil),    // 1: webitel.im.provider.v1.ProviderSetPersistentMenuResponse
-	(*ProviderDeletePersistentMenuRequest)(nil),  // 2: webitel.im.provider.v1.ProviderDeletePersistentMenuRequest
-	(*ProviderDeletePersistentMenuResponse)(nil), // 3: webitel.im.provider.v1.ProviderDeletePersistentMenuResponse
-	(*ProviderSetGetStartedRequest)(nil),         // 4: webitel.im.provider.v1.ProviderSetGetStartedRequest
-	(*ProviderSetGetStartedResponse)(nil),        // 5: webitel.im.provider.v1.ProviderSetGetStartedResponse
-	(*ProviderDeleteGetStartedRequest)(nil),      // 6: webitel.im.provider.v1.ProviderDeleteGetStartedRequest
-	(*ProviderDeleteGetStartedResponse)(nil),     // 7: webitel.im.provider.v1.ProviderDeleteGetStartedResponse
-	(*ProviderMenuItem)(nil),                     // 8: webitel.im.provider.v1.ProviderMenuItem
-	(*ProviderMenuNestedItems)(nil),              // 9: webitel.im.provider.v1.ProviderMenuNestedItems
-	(*ProviderCreateFacebookGateRequest)(nil),    // 10: webitel.im.provider.v1.ProviderCreateFacebookGateRequest
-	(*ProviderGetFacebookGateRequest)(nil),       // 11: webitel.im.provider.v1.ProviderGetFacebookGateRequest
-	(*ProviderUpdateFacebookGateRequest)(nil),    // 12: webitel.im.provider.v1.ProviderUpdateFacebookGateRequest
-	(*ProviderDeleteFacebookGateRequest)(nil),    // 13: webitel.im.provider.v1.ProviderDeleteFacebookGateRequest
-	(*ProviderCreateFacebookGateResponse)(nil),   // 14: webitel.im.provider.v1.ProviderCreateFacebookGateResponse
-	(*ProviderGetFacebookGateResponse)(nil),      // 15: webitel.im.provider.v1.ProviderGetFacebookGateResponse
-	(*ProviderUpdateFacebookGateResponse)(nil),   // 16: webitel.im.provider.v1.ProviderUpdateFacebookGateResponse
-	(*ProviderDeleteFacebookGateResponse)(nil),   // 17: webitel.im.provider.v1.ProviderDeleteFacebookGateResponse
+	(*ProviderLocaleMenu)(nil),                   // 2: webitel.im.provider.v1.ProviderLocaleMenu
+	(*ProviderGetPersistentMenuRequest)(nil),     // 3: webitel.im.provider.v1.ProviderGetPersistentMenuRequest
+	(*ProviderGetPersistentMenuResponse)(nil),    // 4: webitel.im.provider.v1.ProviderGetPersistentMenuResponse
+	(*ProviderDeletePersistentMenuRequest)(nil),  // 5: webitel.im.provider.v1.ProviderDeletePersistentMenuRequest
+	(*ProviderDeletePersistentMenuResponse)(nil), // 6: webitel.im.provider.v1.ProviderDeletePersistentMenuResponse
+	(*ProviderSetGetStartedRequest)(nil),         // 7: webitel.im.provider.v1.ProviderSetGetStartedRequest
+	(*ProviderSetGetStartedResponse)(nil),        // 8: webitel.im.provider.v1.ProviderSetGetStartedResponse
+	(*ProviderDeleteGetStartedRequest)(nil),      // 9: webitel.im.provider.v1.ProviderDeleteGetStartedRequest
+	(*ProviderDeleteGetStartedResponse)(nil),     // 10: webitel.im.provider.v1.ProviderDeleteGetStartedResponse
+	(*ProviderLocaleText)(nil),                   // 11: webitel.im.provider.v1.ProviderLocaleText
+	(*ProviderSetGreetingRequest)(nil),           // 12: webitel.im.provider.v1.ProviderSetGreetingRequest
+	(*ProviderSetGreetingResponse)(nil),          // 13: webitel.im.provider.v1.ProviderSetGreetingResponse
+	(*ProviderDeleteGreetingRequest)(nil),        // 14: webitel.im.provider.v1.ProviderDeleteGreetingRequest
+	(*ProviderDeleteGreetingResponse)(nil),       // 15: webitel.im.provider.v1.ProviderDeleteGreetingResponse
+	(*ProviderIceBreaker)(nil),                   // 16: webitel.im.provider.v1.ProviderIceBreaker
+	(*ProviderLocaleIceBreakers)(nil),            // 17: webitel.im.provider.v1.ProviderLocaleIceBreakers
+	(*ProviderSetIceBreakersRequest)(nil),        // 18: webitel.im.provider.v1.ProviderSetIceBreakersRequest
+	(*ProviderSetIceBreakersResponse)(nil),       // 19: webitel.im.provider.v1.ProviderSetIceBreakersResponse
+	(*ProviderDeleteIceBreakersRequest)(nil),     // 20: webitel.im.provider.v1.ProviderDeleteIceBreakersRequest
+	(*ProviderDeleteIceBreakersResponse)(nil),    // 21: webitel.im.provider.v1.ProviderDeleteIceBreakersResponse
+	(*ProviderMenuItem)(nil),                     // 22: webitel.im.provider.v1.ProviderMenuItem
+	(*ProviderMenuNestedItems)(nil),              // 23: webitel.im.provider.v1.ProviderMenuNestedItems
+	(*ProviderCreateFacebookGateRequest)(nil),    // 24: webitel.im.provider.v1.ProviderCreateFacebookGateRequest
+	(*ProviderGetFacebookGateRequest)(nil),       // 25: webitel.im.provider.v1.ProviderGetFacebookGateRequest
+	(*ProviderUpdateFacebookGateRequest)(nil),    // 26: webitel.im.provider.v1.ProviderUpdateFacebookGateRequest
+	(*ProviderDeleteFacebookGateRequest)(nil),    // 27: webitel.im.provider.v1.ProviderDeleteFacebookGateRequest
+	(*ProviderCreateFacebookGateResponse)(nil),   // 28: webitel.im.provider.v1.ProviderCreateFacebookGateResponse
+	(*ProviderGetFacebookGateResponse)(nil),      // 29: webitel.im.provider.v1.ProviderGetFacebookGateResponse
+	(*ProviderUpdateFacebookGateResponse)(nil),   // 30: webitel.im.provider.v1.ProviderUpdateFacebookGateResponse
+	(*ProviderDeleteFacebookGateResponse)(nil),   // 31: webitel.im.provider.v1.ProviderDeleteFacebookGateResponse
 }
 var file_service_provider_v1_facebook_service_proto_depIdxs = []int32{
-	8,  // 0: webitel.im.provider.v1.ProviderSetPersistentMenuRequest.items:type_name -> webitel.im.provider.v1.ProviderMenuItem
-	9,  // 1: webitel.im.provider.v1.ProviderMenuItem.nested:type_name -> webitel.im.provider.v1.ProviderMenuNestedItems
-	8,  // 2: webitel.im.provider.v1.ProviderMenuNestedItems.items:type_name -> webitel.im.provider.v1.ProviderMenuItem
-	10, // 3: webitel.im.provider.v1.FacebookService.CreateFacebookGate:input_type -> webitel.im.provider.v1.ProviderCreateFacebookGateRequest
-	11, // 4: webitel.im.provider.v1.FacebookService.GetFacebookGate:input_type -> webitel.im.provider.v1.ProviderGetFacebookGateRequest
-	12, // 5: webitel.im.provider.v1.FacebookService.UpdateFacebookGate:input_type -> webitel.im.provider.v1.ProviderUpdateFacebookGateRequest
-	13, // 6: webitel.im.provider.v1.FacebookService.DeleteFacebookGate:input_type -> webitel.im.provider.v1.ProviderDeleteFacebookGateRequest
-	0,  // 7: webitel.im.provider.v1.FacebookService.SetPersistentMenu:input_type -> webitel.im.provider.v1.ProviderSetPersistentMenuRequest
-	2,  // 8: webitel.im.provider.v1.FacebookService.DeletePersistentMenu:input_type -> webitel.im.provider.v1.ProviderDeletePersistentMenuRequest
-	4,  // 9: webitel.im.provider.v1.FacebookService.SetGetStarted:input_type -> webitel.im.provider.v1.ProviderSetGetStartedRequest
-	6,  // 10: webitel.im.provider.v1.FacebookService.DeleteGetStarted:input_type -> webitel.im.provider.v1.ProviderDeleteGetStartedRequest
-	14, // 11: webitel.im.provider.v1.FacebookService.CreateFacebookGate:output_type -> webitel.im.provider.v1.ProviderCreateFacebookGateResponse
-	15, // 12: webitel.im.provider.v1.FacebookService.GetFacebookGate:output_type -> webitel.im.provider.v1.ProviderGetFacebookGateResponse
-	16, // 13: webitel.im.provider.v1.FacebookService.UpdateFacebookGate:output_type -> webitel.im.provider.v1.ProviderUpdateFacebookGateResponse
-	17, // 14: webitel.im.provider.v1.FacebookService.DeleteFacebookGate:output_type -> webitel.im.provider.v1.ProviderDeleteFacebookGateResponse
-	1,  // 15: webitel.im.provider.v1.FacebookService.SetPersistentMenu:output_type -> webitel.im.provider.v1.ProviderSetPersistentMenuResponse
-	3,  // 16: webitel.im.provider.v1.FacebookService.DeletePersistentMenu:output_type -> webitel.im.provider.v1.ProviderDeletePersistentMenuResponse
-	5,  // 17: webitel.im.provider.v1.FacebookService.SetGetStarted:output_type -> webitel.im.provider.v1.ProviderSetGetStartedResponse
-	7,  // 18: webitel.im.provider.v1.FacebookService.DeleteGetStarted:output_type -> webitel.im.provider.v1.ProviderDeleteGetStartedResponse
-	11, // [11:19] is the sub-list for method output_type
-	3,  // [3:11] is the sub-list for method input_type
-	3,  // [3:3] is the sub-list for extension type_name
-	3,  // [3:3] is the sub-list for extension extendee
-	0,  // [0:3] is the sub-list for field type_name
+	22, // 0: webitel.im.provider.v1.ProviderSetPersistentMenuRequest.items:type_name -> webitel.im.provider.v1.ProviderMenuItem
+	2,  // 1: webitel.im.provider.v1.ProviderSetPersistentMenuRequest.locales:type_name -> webitel.im.provider.v1.ProviderLocaleMenu
+	22, // 2: webitel.im.provider.v1.ProviderLocaleMenu.items:type_name -> webitel.im.provider.v1.ProviderMenuItem
+	2,  // 3: webitel.im.provider.v1.ProviderGetPersistentMenuResponse.locales:type_name -> webitel.im.provider.v1.ProviderLocaleMenu
+	11, // 4: webitel.im.provider.v1.ProviderSetGreetingRequest.greeting:type_name -> webitel.im.provider.v1.ProviderLocaleText
+	16, // 5: webitel.im.provider.v1.ProviderLocaleIceBreakers.ice_breakers:type_name -> webitel.im.provider.v1.ProviderIceBreaker
+	17, // 6: webitel.im.provider.v1.ProviderSetIceBreakersRequest.locales:type_name -> webitel.im.provider.v1.ProviderLocaleIceBreakers
+	23, // 7: webitel.im.provider.v1.ProviderMenuItem.nested:type_name -> webitel.im.provider.v1.ProviderMenuNestedItems
+	22, // 8: webitel.im.provider.v1.ProviderMenuNestedItems.items:type_name -> webitel.im.provider.v1.ProviderMenuItem
+	24, // 9: webitel.im.provider.v1.FacebookService.CreateFacebookGate:input_type -> webitel.im.provider.v1.ProviderCreateFacebookGateRequest
+	25, // 10: webitel.im.provider.v1.FacebookService.GetFacebookGate:input_type -> webitel.im.provider.v1.ProviderGetFacebookGateRequest
+	26, // 11: webitel.im.provider.v1.FacebookService.UpdateFacebookGate:input_type -> webitel.im.provider.v1.ProviderUpdateFacebookGateRequest
+	27, // 12: webitel.im.provider.v1.FacebookService.DeleteFacebookGate:input_type -> webitel.im.provider.v1.ProviderDeleteFacebookGateRequest
+	0,  // 13: webitel.im.provider.v1.FacebookService.SetPersistentMenu:input_type -> webitel.im.provider.v1.ProviderSetPersistentMenuRequest
+	3,  // 14: webitel.im.provider.v1.FacebookService.GetPersistentMenu:input_type -> webitel.im.provider.v1.ProviderGetPersistentMenuRequest
+	5,  // 15: webitel.im.provider.v1.FacebookService.DeletePersistentMenu:input_type -> webitel.im.provider.v1.ProviderDeletePersistentMenuRequest
+	7,  // 16: webitel.im.provider.v1.FacebookService.SetGetStarted:input_type -> webitel.im.provider.v1.ProviderSetGetStartedRequest
+	9,  // 17: webitel.im.provider.v1.FacebookService.DeleteGetStarted:input_type -> webitel.im.provider.v1.ProviderDeleteGetStartedRequest
+	12, // 18: webitel.im.provider.v1.FacebookService.SetGreeting:input_type -> webitel.im.provider.v1.ProviderSetGreetingRequest
+	14, // 19: webitel.im.provider.v1.FacebookService.DeleteGreeting:input_type -> webitel.im.provider.v1.ProviderDeleteGreetingRequest
+	18, // 20: webitel.im.provider.v1.FacebookService.SetIceBreakers:input_type -> webitel.im.provider.v1.ProviderSetIceBreakersRequest
+	20, // 21: webitel.im.provider.v1.FacebookService.DeleteIceBreakers:input_type -> webitel.im.provider.v1.ProviderDeleteIceBreakersRequest
+	28, // 22: webitel.im.provider.v1.FacebookService.CreateFacebookGate:output_type -> webitel.im.provider.v1.ProviderCreateFacebookGateResponse
+	29, // 23: webitel.im.provider.v1.FacebookService.GetFacebookGate:output_type -> webitel.im.provider.v1.ProviderGetFacebookGateResponse
+	30, // 24: webitel.im.provider.v1.FacebookService.UpdateFacebookGate:output_type -> webitel.im.provider.v1.ProviderUpdateFacebookGateResponse
+	31, // 25: webitel.im.provider.v1.FacebookService.DeleteFacebookGate:output_type -> webitel.im.provider.v1.ProviderDeleteFacebookGateResponse
+	1,  // 26: webitel.im.provider.v1.FacebookService.SetPersistentMenu:output_type -> webitel.im.provider.v1.ProviderSetPersistentMenuResponse
+	4,  // 27: webitel.im.provider.v1.FacebookService.GetPersistentMenu:output_type -> webitel.im.provider.v1.ProviderGetPersistentMenuResponse
+	6,  // 28: webitel.im.provider.v1.FacebookService.DeletePersistentMenu:output_type -> webitel.im.provider.v1.ProviderDeletePersistentMenuResponse
+	8,  // 29: webitel.im.provider.v1.FacebookService.SetGetStarted:output_type -> webitel.im.provider.v1.ProviderSetGetStartedResponse
+	10, // 30: webitel.im.provider.v1.FacebookService.DeleteGetStarted:output_type -> webitel.im.provider.v1.ProviderDeleteGetStartedResponse
+	13, // 31: webitel.im.provider.v1.FacebookService.SetGreeting:output_type -> webitel.im.provider.v1.ProviderSetGreetingResponse
+	15, // 32: webitel.im.provider.v1.FacebookService.DeleteGreeting:output_type -> webitel.im.provider.v1.ProviderDeleteGreetingResponse
+	19, // 33: webitel.im.provider.v1.FacebookService.SetIceBreakers:output_type -> webitel.im.provider.v1.ProviderSetIceBreakersResponse
+	21, // 34: webitel.im.provider.v1.FacebookService.DeleteIceBreakers:output_type -> webitel.im.provider.v1.ProviderDeleteIceBreakersResponse
+	22, // [22:35] is the sub-list for method output_type
+	9,  // [9:22] is the sub-list for method input_type
+	9,  // [9:9] is the sub-list for extension type_name
+	9,  // [9:9] is the sub-list for extension extendee
+	0,  // [0:9] is the sub-list for field type_name
 }
 
 func init() { file_service_provider_v1_facebook_service_proto_init() }
@@ -800,7 +1650,7 @@ func file_service_provider_v1_facebook_service_proto_init() {
 			}
 		}
 		file_service_provider_v1_facebook_service_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProviderDeletePersistentMenuRequest); i {
+			switch v := v.(*ProviderLocaleMenu); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -812,7 +1662,7 @@ func file_service_provider_v1_facebook_service_proto_init() {
 			}
 		}
 		file_service_provider_v1_facebook_service_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProviderDeletePersistentMenuResponse); i {
+			switch v := v.(*ProviderGetPersistentMenuRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -824,7 +1674,7 @@ func file_service_provider_v1_facebook_service_proto_init() {
 			}
 		}
 		file_service_provider_v1_facebook_service_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProviderSetGetStartedRequest); i {
+			switch v := v.(*ProviderGetPersistentMenuResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -836,7 +1686,7 @@ func file_service_provider_v1_facebook_service_proto_init() {
 			}
 		}
 		file_service_provider_v1_facebook_service_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProviderSetGetStartedResponse); i {
+			switch v := v.(*ProviderDeletePersistentMenuRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -848,7 +1698,7 @@ func file_service_provider_v1_facebook_service_proto_init() {
 			}
 		}
 		file_service_provider_v1_facebook_service_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProviderDeleteGetStartedRequest); i {
+			switch v := v.(*ProviderDeletePersistentMenuResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -860,7 +1710,7 @@ func file_service_provider_v1_facebook_service_proto_init() {
 			}
 		}
 		file_service_provider_v1_facebook_service_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProviderDeleteGetStartedResponse); i {
+			switch v := v.(*ProviderSetGetStartedRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -872,7 +1722,7 @@ func file_service_provider_v1_facebook_service_proto_init() {
 			}
 		}
 		file_service_provider_v1_facebook_service_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProviderMenuItem); i {
+			switch v := v.(*ProviderSetGetStartedResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -884,6 +1734,174 @@ func file_service_provider_v1_facebook_service_proto_init() {
 			}
 		}
 		file_service_provider_v1_facebook_service_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProviderDeleteGetStartedRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_provider_v1_facebook_service_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProviderDeleteGetStartedResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_provider_v1_facebook_service_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProviderLocaleText); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_provider_v1_facebook_service_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProviderSetGreetingRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_provider_v1_facebook_service_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProviderSetGreetingResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_provider_v1_facebook_service_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProviderDeleteGreetingRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_provider_v1_facebook_service_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProviderDeleteGreetingResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_provider_v1_facebook_service_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProviderIceBreaker); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_provider_v1_facebook_service_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProviderLocaleIceBreakers); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_provider_v1_facebook_service_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProviderSetIceBreakersRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_provider_v1_facebook_service_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProviderSetIceBreakersResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_provider_v1_facebook_service_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProviderDeleteIceBreakersRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_provider_v1_facebook_service_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProviderDeleteIceBreakersResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_provider_v1_facebook_service_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProviderMenuItem); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_provider_v1_facebook_service_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ProviderMenuNestedItems); i {
 			case 0:
 				return &v.state
@@ -896,7 +1914,7 @@ func file_service_provider_v1_facebook_service_proto_init() {
 			}
 		}
 	}
-	file_service_provider_v1_facebook_service_proto_msgTypes[8].OneofWrappers = []interface{}{
+	file_service_provider_v1_facebook_service_proto_msgTypes[22].OneofWrappers = []interface{}{
 		(*ProviderMenuItem_Payload)(nil),
 		(*ProviderMenuItem_Url)(nil),
 		(*ProviderMenuItem_Nested)(nil),
@@ -907,7 +1925,7 @@ func file_service_provider_v1_facebook_service_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_service_provider_v1_facebook_service_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   10,
+			NumMessages:   24,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
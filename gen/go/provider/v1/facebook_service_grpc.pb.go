@@ -24,9 +24,14 @@ const (
 	FacebookService_UpdateFacebookGate_FullMethodName   = "/webitel.im.provider.v1.FacebookService/UpdateFacebookGate"
 	FacebookService_DeleteFacebookGate_FullMethodName   = "/webitel.im.provider.v1.FacebookService/DeleteFacebookGate"
 	FacebookService_SetPersistentMenu_FullMethodName    = "/webitel.im.provider.v1.FacebookService/SetPersistentMenu"
+	FacebookService_GetPersistentMenu_FullMethodName    = "/webitel.im.provider.v1.FacebookService/GetPersistentMenu"
 	FacebookService_DeletePersistentMenu_FullMethodName = "/webitel.im.provider.v1.FacebookService/DeletePersistentMenu"
 	FacebookService_SetGetStarted_FullMethodName        = "/webitel.im.provider.v1.FacebookService/SetGetStarted"
 	FacebookService_DeleteGetStarted_FullMethodName     = "/webitel.im.provider.v1.FacebookService/DeleteGetStarted"
+	FacebookService_SetGreeting_FullMethodName          = "/webitel.im.provider.v1.FacebookService/SetGreeting"
+	FacebookService_DeleteGreeting_FullMethodName       = "/webitel.im.provider.v1.FacebookService/DeleteGreeting"
+	FacebookService_SetIceBreakers_FullMethodName       = "/webitel.im.provider.v1.FacebookService/SetIceBreakers"
+	FacebookService_DeleteIceBreakers_FullMethodName    = "/webitel.im.provider.v1.FacebookService/DeleteIceBreakers"
 )
 
 // FacebookServiceClient is the client API for FacebookService service.
@@ -45,12 +50,22 @@ type FacebookServiceClient interface {
 	DeleteFacebookGate(ctx context.Context, in *ProviderDeleteFacebookGateRequest, opts ...grpc.CallOption) (*ProviderDeleteFacebookGateResponse, error)
 	// / SetPersistentMenu sets the Messenger persistent menu for a Facebook gate.
 	SetPersistentMenu(ctx context.Context, in *ProviderSetPersistentMenuRequest, opts ...grpc.CallOption) (*ProviderSetPersistentMenuResponse, error)
+	// / GetPersistentMenu returns the localized Messenger persistent menu stored for a Facebook gate.
+	GetPersistentMenu(ctx context.Context, in *ProviderGetPersistentMenuRequest, opts ...grpc.CallOption) (*ProviderGetPersistentMenuResponse, error)
 	// / DeletePersistentMenu removes the Messenger persistent menu for a Facebook gate.
 	DeletePersistentMenu(ctx context.Context, in *ProviderDeletePersistentMenuRequest, opts ...grpc.CallOption) (*ProviderDeletePersistentMenuResponse, error)
 	// / SetGetStarted sets the Get Started button payload for a Facebook gate.
 	SetGetStarted(ctx context.Context, in *ProviderSetGetStartedRequest, opts ...grpc.CallOption) (*ProviderSetGetStartedResponse, error)
 	// / DeleteGetStarted removes the Get Started button for a Facebook gate.
 	DeleteGetStarted(ctx context.Context, in *ProviderDeleteGetStartedRequest, opts ...grpc.CallOption) (*ProviderDeleteGetStartedResponse, error)
+	// / SetGreeting sets the localized greeting text shown before a conversation starts.
+	SetGreeting(ctx context.Context, in *ProviderSetGreetingRequest, opts ...grpc.CallOption) (*ProviderSetGreetingResponse, error)
+	// / DeleteGreeting removes the greeting text for a Facebook gate.
+	DeleteGreeting(ctx context.Context, in *ProviderDeleteGreetingRequest, opts ...grpc.CallOption) (*ProviderDeleteGreetingResponse, error)
+	// / SetIceBreakers sets the localized ice breaker questions for a Facebook gate.
+	SetIceBreakers(ctx context.Context, in *ProviderSetIceBreakersRequest, opts ...grpc.CallOption) (*ProviderSetIceBreakersResponse, error)
+	// / DeleteIceBreakers removes the ice breaker questions for a Facebook gate.
+	DeleteIceBreakers(ctx context.Context, in *ProviderDeleteIceBreakersRequest, opts ...grpc.CallOption) (*ProviderDeleteIceBreakersResponse, error)
 }
 
 type facebookServiceClient struct {
@@ -111,6 +126,16 @@ func (c *facebookServiceClient) SetPersistentMenu(ctx context.Context, in *Provi
 	return out, nil
 }
 
+func (c *facebookServiceClient) GetPersistentMenu(ctx context.Context, in *ProviderGetPersistentMenuRequest, opts ...grpc.CallOption) (*ProviderGetPersistentMenuResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProviderGetPersistentMenuResponse)
+	err := c.cc.Invoke(ctx, FacebookService_GetPersistentMenu_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *facebookServiceClient) DeletePersistentMenu(ctx context.Context, in *ProviderDeletePersistentMenuRequest, opts ...grpc.CallOption) (*ProviderDeletePersistentMenuResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ProviderDeletePersistentMenuResponse)
@@ -141,6 +166,46 @@ func (c *facebookServiceClient) DeleteGetStarted(ctx context.Context, in *Provid
 	return out, nil
 }
 
+func (c *facebookServiceClient) SetGreeting(ctx context.Context, in *ProviderSetGreetingRequest, opts ...grpc.CallOption) (*ProviderSetGreetingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProviderSetGreetingResponse)
+	err := c.cc.Invoke(ctx, FacebookService_SetGreeting_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *facebookServiceClient) DeleteGreeting(ctx context.Context, in *ProviderDeleteGreetingRequest, opts ...grpc.CallOption) (*ProviderDeleteGreetingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProviderDeleteGreetingResponse)
+	err := c.cc.Invoke(ctx, FacebookService_DeleteGreeting_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *facebookServiceClient) SetIceBreakers(ctx context.Context, in *ProviderSetIceBreakersRequest, opts ...grpc.CallOption) (*ProviderSetIceBreakersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProviderSetIceBreakersResponse)
+	err := c.cc.Invoke(ctx, FacebookService_SetIceBreakers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *facebookServiceClient) DeleteIceBreakers(ctx context.Context, in *ProviderDeleteIceBreakersRequest, opts ...grpc.CallOption) (*ProviderDeleteIceBreakersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProviderDeleteIceBreakersResponse)
+	err := c.cc.Invoke(ctx, FacebookService_DeleteIceBreakers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // FacebookServiceServer is the server API for FacebookService service.
 // All implementations must embed UnimplementedFacebookServiceServer
 // for forward compatibility.
@@ -157,12 +222,22 @@ type FacebookServiceServer interface {
 	DeleteFacebookGate(context.Context, *ProviderDeleteFacebookGateRequest) (*ProviderDeleteFacebookGateResponse, error)
 	// / SetPersistentMenu sets the Messenger persistent menu for a Facebook gate.
 	SetPersistentMenu(context.Context, *ProviderSetPersistentMenuRequest) (*ProviderSetPersistentMenuResponse, error)
+	// / GetPersistentMenu returns the localized Messenger persistent menu stored for a Facebook gate.
+	GetPersistentMenu(context.Context, *ProviderGetPersistentMenuRequest) (*ProviderGetPersistentMenuResponse, error)
 	// / DeletePersistentMenu removes the Messenger persistent menu for a Facebook gate.
 	DeletePersistentMenu(context.Context, *ProviderDeletePersistentMenuRequest) (*ProviderDeletePersistentMenuResponse, error)
 	// / SetGetStarted sets the Get Started button payload for a Facebook gate.
 	SetGetStarted(context.Context, *ProviderSetGetStartedRequest) (*ProviderSetGetStartedResponse, error)
 	// / DeleteGetStarted removes the Get Started button for a Facebook gate.
 	DeleteGetStarted(context.Context, *ProviderDeleteGetStartedRequest) (*ProviderDeleteGetStartedResponse, error)
+	// / SetGreeting sets the localized greeting text shown before a conversation starts.
+	SetGreeting(context.Context, *ProviderSetGreetingRequest) (*ProviderSetGreetingResponse, error)
+	// / DeleteGreeting removes the greeting text for a Facebook gate.
+	DeleteGreeting(context.Context, *ProviderDeleteGreetingRequest) (*ProviderDeleteGreetingResponse, error)
+	// / SetIceBreakers sets the localized ice breaker questions for a Facebook gate.
+	SetIceBreakers(context.Context, *ProviderSetIceBreakersRequest) (*ProviderSetIceBreakersResponse, error)
+	// / DeleteIceBreakers removes the ice breaker questions for a Facebook gate.
+	DeleteIceBreakers(context.Context, *ProviderDeleteIceBreakersRequest) (*ProviderDeleteIceBreakersResponse, error)
 	mustEmbedUnimplementedFacebookServiceServer()
 }
 
@@ -188,6 +263,9 @@ func (UnimplementedFacebookServiceServer) DeleteFacebookGate(context.Context, *P
 func (UnimplementedFacebookServiceServer) SetPersistentMenu(context.Context, *ProviderSetPersistentMenuRequest) (*ProviderSetPersistentMenuResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetPersistentMenu not implemented")
 }
+func (UnimplementedFacebookServiceServer) GetPersistentMenu(context.Context, *ProviderGetPersistentMenuRequest) (*ProviderGetPersistentMenuResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPersistentMenu not implemented")
+}
 func (UnimplementedFacebookServiceServer) DeletePersistentMenu(context.Context, *ProviderDeletePersistentMenuRequest) (*ProviderDeletePersistentMenuResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeletePersistentMenu not implemented")
 }
@@ -197,6 +275,18 @@ func (UnimplementedFacebookServiceServer) SetGetStarted(context.Context, *Provid
 func (UnimplementedFacebookServiceServer) DeleteGetStarted(context.Context, *ProviderDeleteGetStartedRequest) (*ProviderDeleteGetStartedResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteGetStarted not implemented")
 }
+func (UnimplementedFacebookServiceServer) SetGreeting(context.Context, *ProviderSetGreetingRequest) (*ProviderSetGreetingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetGreeting not implemented")
+}
+func (UnimplementedFacebookServiceServer) DeleteGreeting(context.Context, *ProviderDeleteGreetingRequest) (*ProviderDeleteGreetingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteGreeting not implemented")
+}
+func (UnimplementedFacebookServiceServer) SetIceBreakers(context.Context, *ProviderSetIceBreakersRequest) (*ProviderSetIceBreakersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetIceBreakers not implemented")
+}
+func (UnimplementedFacebookServiceServer) DeleteIceBreakers(context.Context, *ProviderDeleteIceBreakersRequest) (*ProviderDeleteIceBreakersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteIceBreakers not implemented")
+}
 func (UnimplementedFacebookServiceServer) mustEmbedUnimplementedFacebookServiceServer() {}
 func (UnimplementedFacebookServiceServer) testEmbeddedByValue()                         {}
 
@@ -308,6 +398,24 @@ func _FacebookService_SetPersistentMenu_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _FacebookService_GetPersistentMenu_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProviderGetPersistentMenuRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FacebookServiceServer).GetPersistentMenu(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FacebookService_GetPersistentMenu_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FacebookServiceServer).GetPersistentMenu(ctx, req.(*ProviderGetPersistentMenuRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _FacebookService_DeletePersistentMenu_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ProviderDeletePersistentMenuRequest)
 	if err := dec(in); err != nil {
@@ -362,6 +470,78 @@ func _FacebookService_DeleteGetStarted_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _FacebookService_SetGreeting_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProviderSetGreetingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FacebookServiceServer).SetGreeting(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FacebookService_SetGreeting_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FacebookServiceServer).SetGreeting(ctx, req.(*ProviderSetGreetingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FacebookService_DeleteGreeting_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProviderDeleteGreetingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FacebookServiceServer).DeleteGreeting(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FacebookService_DeleteGreeting_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FacebookServiceServer).DeleteGreeting(ctx, req.(*ProviderDeleteGreetingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FacebookService_SetIceBreakers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProviderSetIceBreakersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FacebookServiceServer).SetIceBreakers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FacebookService_SetIceBreakers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FacebookServiceServer).SetIceBreakers(ctx, req.(*ProviderSetIceBreakersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FacebookService_DeleteIceBreakers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProviderDeleteIceBreakersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FacebookServiceServer).DeleteIceBreakers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FacebookService_DeleteIceBreakers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FacebookServiceServer).DeleteIceBreakers(ctx, req.(*ProviderDeleteIceBreakersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // FacebookService_ServiceDesc is the grpc.ServiceDesc for FacebookService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -389,6 +569,10 @@ var FacebookService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SetPersistentMenu",
 			Handler:    _FacebookService_SetPersistentMenu_Handler,
 		},
+		{
+			MethodName: "GetPersistentMenu",
+			Handler:    _FacebookService_GetPersistentMenu_Handler,
+		},
 		{
 			MethodName: "DeletePersistentMenu",
 			Handler:    _FacebookService_DeletePersistentMenu_Handler,
@@ -401,6 +585,22 @@ var FacebookService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteGetStarted",
 			Handler:    _FacebookService_DeleteGetStarted_Handler,
 		},
+		{
+			MethodName: "SetGreeting",
+			Handler:    _FacebookService_SetGreeting_Handler,
+		},
+		{
+			MethodName: "DeleteGreeting",
+			Handler:    _FacebookService_DeleteGreeting_Handler,
+		},
+		{
+			MethodName: "SetIceBreakers",
+			Handler:    _FacebookService_SetIceBreakers_Handler,
+		},
+		{
+			MethodName: "DeleteIceBreakers",
+			Handler:    _FacebookService_DeleteIceBreakers_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "service/provider/v1/facebook_service.proto",
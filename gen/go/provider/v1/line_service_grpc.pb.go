@@ -0,0 +1,243 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: service/provider/v1/line_service.proto
+
+package provider
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	LineService_CreateLineGate_FullMethodName = "/webitel.im.provider.v1.LineService/CreateLineGate"
+	LineService_GetLineGate_FullMethodName    = "/webitel.im.provider.v1.LineService/GetLineGate"
+	LineService_UpdateLineGate_FullMethodName = "/webitel.im.provider.v1.LineService/UpdateLineGate"
+	LineService_DeleteLineGate_FullMethodName = "/webitel.im.provider.v1.LineService/DeleteLineGate"
+)
+
+// LineServiceClient is the client API for LineService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type LineServiceClient interface {
+	// / CreateLineGate connects an Official Account and points its webhook at the service.
+	CreateLineGate(ctx context.Context, in *ProviderCreateLineGateRequest, opts ...grpc.CallOption) (*ProviderCreateLineGateResponse, error)
+	// / GetLineGate returns the LINE gate.
+	GetLineGate(ctx context.Context, in *ProviderGetLineGateRequest, opts ...grpc.CallOption) (*ProviderGetLineGateResponse, error)
+	// / UpdateLineGate renames, enables or disables the gate or replaces its channel credentials.
+	UpdateLineGate(ctx context.Context, in *ProviderUpdateLineGateRequest, opts ...grpc.CallOption) (*ProviderUpdateLineGateResponse, error)
+	// / DeleteLineGate removes the LINE gate.
+	DeleteLineGate(ctx context.Context, in *ProviderDeleteLineGateRequest, opts ...grpc.CallOption) (*ProviderDeleteLineGateResponse, error)
+}
+
+type lineServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewLineServiceClient(cc grpc.ClientConnInterface) LineServiceClient {
+	return &lineServiceClient{cc}
+}
+
+func (c *lineServiceClient) CreateLineGate(ctx context.Context, in *ProviderCreateLineGateRequest, opts ...grpc.CallOption) (*ProviderCreateLineGateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProviderCreateLineGateResponse)
+	err := c.cc.Invoke(ctx, LineService_CreateLineGate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lineServiceClient) GetLineGate(ctx context.Context, in *ProviderGetLineGateRequest, opts ...grpc.CallOption) (*ProviderGetLineGateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProviderGetLineGateResponse)
+	err := c.cc.Invoke(ctx, LineService_GetLineGate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lineServiceClient) UpdateLineGate(ctx context.Context, in *ProviderUpdateLineGateRequest, opts ...grpc.CallOption) (*ProviderUpdateLineGateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProviderUpdateLineGateResponse)
+	err := c.cc.Invoke(ctx, LineService_UpdateLineGate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lineServiceClient) DeleteLineGate(ctx context.Context, in *ProviderDeleteLineGateRequest, opts ...grpc.CallOption) (*ProviderDeleteLineGateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProviderDeleteLineGateResponse)
+	err := c.cc.Invoke(ctx, LineService_DeleteLineGate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// LineServiceServer is the server API for LineService service.
+// All implementations must embed UnimplementedLineServiceServer
+// for forward compatibility.
+type LineServiceServer interface {
+	// / CreateLineGate connects an Official Account and points its webhook at the service.
+	CreateLineGate(context.Context, *ProviderCreateLineGateRequest) (*ProviderCreateLineGateResponse, error)
+	// / GetLineGate returns the LINE gate.
+	GetLineGate(context.Context, *ProviderGetLineGateRequest) (*ProviderGetLineGateResponse, error)
+	// / UpdateLineGate renames, enables or disables the gate or replaces its channel credentials.
+	UpdateLineGate(context.Context, *ProviderUpdateLineGateRequest) (*ProviderUpdateLineGateResponse, error)
+	// / DeleteLineGate removes the LINE gate.
+	DeleteLineGate(context.Context, *ProviderDeleteLineGateRequest) (*ProviderDeleteLineGateResponse, error)
+	mustEmbedUnimplementedLineServiceServer()
+}
+
+// UnimplementedLineServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedLineServiceServer struct{}
+
+func (UnimplementedLineServiceServer) CreateLineGate(context.Context, *ProviderCreateLineGateRequest) (*ProviderCreateLineGateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateLineGate not implemented")
+}
+func (UnimplementedLineServiceServer) GetLineGate(context.Context, *ProviderGetLineGateRequest) (*ProviderGetLineGateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLineGate not implemented")
+}
+func (UnimplementedLineServiceServer) UpdateLineGate(context.Context, *ProviderUpdateLineGateRequest) (*ProviderUpdateLineGateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateLineGate not implemented")
+}
+func (UnimplementedLineServiceServer) DeleteLineGate(context.Context, *ProviderDeleteLineGateRequest) (*ProviderDeleteLineGateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteLineGate not implemented")
+}
+func (UnimplementedLineServiceServer) mustEmbedUnimplementedLineServiceServer() {}
+func (UnimplementedLineServiceServer) testEmbeddedByValue()                     {}
+
+// UnsafeLineServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to LineServiceServer will
+// result in compilation errors.
+type UnsafeLineServiceServer interface {
+	mustEmbedUnimplementedLineServiceServer()
+}
+
+func RegisterLineServiceServer(s grpc.ServiceRegistrar, srv LineServiceServer) {
+	// If the following call pancis, it indicates UnimplementedLineServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&LineService_ServiceDesc, srv)
+}
+
+func _LineService_CreateLineGate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProviderCreateLineGateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LineServiceServer).CreateLineGate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LineService_CreateLineGate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LineServiceServer).CreateLineGate(ctx, req.(*ProviderCreateLineGateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LineService_GetLineGate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProviderGetLineGateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LineServiceServer).GetLineGate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LineService_GetLineGate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LineServiceServer).GetLineGate(ctx, req.(*ProviderGetLineGateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LineService_UpdateLineGate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProviderUpdateLineGateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LineServiceServer).UpdateLineGate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LineService_UpdateLineGate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LineServiceServer).UpdateLineGate(ctx, req.(*ProviderUpdateLineGateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LineService_DeleteLineGate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProviderDeleteLineGateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LineServiceServer).DeleteLineGate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LineService_DeleteLineGate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LineServiceServer).DeleteLineGate(ctx, req.(*ProviderDeleteLineGateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// LineService_ServiceDesc is the grpc.ServiceDesc for LineService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var LineService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "webitel.im.provider.v1.LineService",
+	HandlerType: (*LineServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateLineGate",
+			Handler:    _LineService_CreateLineGate_Handler,
+		},
+		{
+			MethodName: "GetLineGate",
+			Handler:    _LineService_GetLineGate_Handler,
+		},
+		{
+			MethodName: "UpdateLineGate",
+			Handler:    _LineService_UpdateLineGate_Handler,
+		},
+		{
+			MethodName: "DeleteLineGate",
+			Handler:    _LineService_DeleteLineGate_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "service/provider/v1/line_service.proto",
+}
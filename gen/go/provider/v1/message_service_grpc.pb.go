@@ -23,6 +23,10 @@ const (
 	ProviderMessageService_SendDocument_FullMethodName      = "/webitel.im.provider.v1.ProviderMessageService/SendDocument"
 	ProviderMessageService_SendImage_FullMethodName         = "/webitel.im.provider.v1.ProviderMessageService/SendImage"
 	ProviderMessageService_SendInteractive_FullMethodName   = "/webitel.im.provider.v1.ProviderMessageService/SendInteractive"
+	ProviderMessageService_SendCards_FullMethodName         = "/webitel.im.provider.v1.ProviderMessageService/SendCards"
+	ProviderMessageService_SendLocation_FullMethodName      = "/webitel.im.provider.v1.ProviderMessageService/SendLocation"
+	ProviderMessageService_SendContact_FullMethodName       = "/webitel.im.provider.v1.ProviderMessageService/SendContact"
+	ProviderMessageService_SendReaction_FullMethodName      = "/webitel.im.provider.v1.ProviderMessageService/SendReaction"
 	ProviderMessageService_SendSystemMessage_FullMethodName = "/webitel.im.provider.v1.ProviderMessageService/SendSystemMessage"
 )
 
@@ -40,6 +44,14 @@ type ProviderMessageServiceClient interface {
 	SendImage(ctx context.Context, in *ProviderSendImageRequest, opts ...grpc.CallOption) (*ProviderSendMessageResponse, error)
 	// SendInteractive delivers a message with interactive UI elements (buttons, menus).
 	SendInteractive(ctx context.Context, in *ProviderSendInteractiveRequest, opts ...grpc.CallOption) (*ProviderSendMessageResponse, error)
+	// SendCards delivers rich cards (a carousel or a media card) to the external chat partner.
+	SendCards(ctx context.Context, in *ProviderSendCardsRequest, opts ...grpc.CallOption) (*ProviderSendMessageResponse, error)
+	// SendLocation delivers a location to the external chat partner.
+	SendLocation(ctx context.Context, in *ProviderSendLocationRequest, opts ...grpc.CallOption) (*ProviderSendMessageResponse, error)
+	// SendContact delivers contact cards to the external chat partner.
+	SendContact(ctx context.Context, in *ProviderSendContactRequest, opts ...grpc.CallOption) (*ProviderSendMessageResponse, error)
+	// SendReaction reacts to a message of the external chat partner.
+	SendReaction(ctx context.Context, in *ProviderSendReactionRequest, opts ...grpc.CallOption) (*ProviderSendMessageResponse, error)
 	// SendSystemMessage delivers a system event notification to the external chat partner.
 	// The im-providers-service resolves the gate-specific template and renders it as text
 	// before forwarding to the underlying provider (Facebook, WhatsApp, etc.).
@@ -94,6 +106,46 @@ func (c *providerMessageServiceClient) SendInteractive(ctx context.Context, in *
 	return out, nil
 }
 
+func (c *providerMessageServiceClient) SendCards(ctx context.Context, in *ProviderSendCardsRequest, opts ...grpc.CallOption) (*ProviderSendMessageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProviderSendMessageResponse)
+	err := c.cc.Invoke(ctx, ProviderMessageService_SendCards_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *providerMessageServiceClient) SendLocation(ctx context.Context, in *ProviderSendLocationRequest, opts ...grpc.CallOption) (*ProviderSendMessageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProviderSendMessageResponse)
+	err := c.cc.Invoke(ctx, ProviderMessageService_SendLocation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *providerMessageServiceClient) SendContact(ctx context.Context, in *ProviderSendContactRequest, opts ...grpc.CallOption) (*ProviderSendMessageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProviderSendMessageResponse)
+	err := c.cc.Invoke(ctx, ProviderMessageService_SendContact_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *providerMessageServiceClient) SendReaction(ctx context.Context, in *ProviderSendReactionRequest, opts ...grpc.CallOption) (*ProviderSendMessageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProviderSendMessageResponse)
+	err := c.cc.Invoke(ctx, ProviderMessageService_SendReaction_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *providerMessageServiceClient) SendSystemMessage(ctx context.Context, in *ProviderSendSystemMessageRequest, opts ...grpc.CallOption) (*ProviderSendMessageResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ProviderSendMessageResponse)
@@ -118,6 +170,14 @@ type ProviderMessageServiceServer interface {
 	SendImage(context.Context, *ProviderSendImageRequest) (*ProviderSendMessageResponse, error)
 	// SendInteractive delivers a message with interactive UI elements (buttons, menus).
 	SendInteractive(context.Context, *ProviderSendInteractiveRequest) (*ProviderSendMessageResponse, error)
+	// SendCards delivers rich cards (a carousel or a media card) to the external chat partner.
+	SendCards(context.Context, *ProviderSendCardsRequest) (*ProviderSendMessageResponse, error)
+	// SendLocation delivers a location to the external chat partner.
+	SendLocation(context.Context, *ProviderSendLocationRequest) (*ProviderSendMessageResponse, error)
+	// SendContact delivers contact cards to the external chat partner.
+	SendContact(context.Context, *ProviderSendContactRequest) (*ProviderSendMessageResponse, error)
+	// SendReaction reacts to a message of the external chat partner.
+	SendReaction(context.Context, *ProviderSendReactionRequest) (*ProviderSendMessageResponse, error)
 	// SendSystemMessage delivers a system event notification to the external chat partner.
 	// The im-providers-service resolves the gate-specific template and renders it as text
 	// before forwarding to the underlying provider (Facebook, WhatsApp, etc.).
@@ -144,6 +204,18 @@ func (UnimplementedProviderMessageServiceServer) SendImage(context.Context, *Pro
 func (UnimplementedProviderMessageServiceServer) SendInteractive(context.Context, *ProviderSendInteractiveRequest) (*ProviderSendMessageResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SendInteractive not implemented")
 }
+func (UnimplementedProviderMessageServiceServer) SendCards(context.Context, *ProviderSendCardsRequest) (*ProviderSendMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendCards not implemented")
+}
+func (UnimplementedProviderMessageServiceServer) SendLocation(context.Context, *ProviderSendLocationRequest) (*ProviderSendMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendLocation not implemented")
+}
+func (UnimplementedProviderMessageServiceServer) SendContact(context.Context, *ProviderSendContactRequest) (*ProviderSendMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendContact not implemented")
+}
+func (UnimplementedProviderMessageServiceServer) SendReaction(context.Context, *ProviderSendReactionRequest) (*ProviderSendMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendReaction not implemented")
+}
 func (UnimplementedProviderMessageServiceServer) SendSystemMessage(context.Context, *ProviderSendSystemMessageRequest) (*ProviderSendMessageResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SendSystemMessage not implemented")
 }
@@ -241,6 +313,78 @@ func _ProviderMessageService_SendInteractive_Handler(srv interface{}, ctx contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ProviderMessageService_SendCards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProviderSendCardsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProviderMessageServiceServer).SendCards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProviderMessageService_SendCards_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProviderMessageServiceServer).SendCards(ctx, req.(*ProviderSendCardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProviderMessageService_SendLocation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProviderSendLocationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProviderMessageServiceServer).SendLocation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProviderMessageService_SendLocation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProviderMessageServiceServer).SendLocation(ctx, req.(*ProviderSendLocationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProviderMessageService_SendContact_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProviderSendContactRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProviderMessageServiceServer).SendContact(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProviderMessageService_SendContact_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProviderMessageServiceServer).SendContact(ctx, req.(*ProviderSendContactRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProviderMessageService_SendReaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProviderSendReactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProviderMessageServiceServer).SendReaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProviderMessageService_SendReaction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProviderMessageServiceServer).SendReaction(ctx, req.(*ProviderSendReactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ProviderMessageService_SendSystemMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ProviderSendSystemMessageRequest)
 	if err := dec(in); err != nil {
@@ -282,6 +426,22 @@ var ProviderMessageService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SendInteractive",
 			Handler:    _ProviderMessageService_SendInteractive_Handler,
 		},
+		{
+			MethodName: "SendCards",
+			Handler:    _ProviderMessageService_SendCards_Handler,
+		},
+		{
+			MethodName: "SendLocation",
+			Handler:    _ProviderMessageService_SendLocation_Handler,
+		},
+		{
+			MethodName: "SendContact",
+			Handler:    _ProviderMessageService_SendContact_Handler,
+		},
+		{
+			MethodName: "SendReaction",
+			Handler:    _ProviderMessageService_SendReaction_Handler,
+		},
 		{
 			MethodName: "SendSystemMessage",
 			Handler:    _ProviderMessageService_SendSystemMessage_Handler,
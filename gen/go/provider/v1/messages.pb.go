@@ -554,8 +554,9 @@ type ProviderMetaOAuthStartRequest struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	MetaAppId   string   `protobuf:"bytes,1,opt,name=meta_app_id,json=metaAppId,proto3" json:"meta_app_id,omitempty"`     // Internal ID of the MetaApp to use for this flow
-	ExtraScopes []string `protobuf:"bytes,2,rep,name=extra_scopes,json=extraScopes,proto3" json:"extra_scopes,omitempty"` // Optional additional permissions for this specific session
+	MetaAppId   string                      `protobuf:"bytes,1,opt,name=meta_app_id,json=metaAppId,proto3" json:"meta_app_id,omitempty"`     // Internal ID of the MetaApp to use for this flow
+	ExtraScopes []string                    `protobuf:"bytes,2,rep,name=extra_scopes,json=extraScopes,proto3" json:"extra_scopes,omitempty"` // Optional additional permissions for this specific session
+	Provision   *ProviderMetaOAuthProvision `protobuf:"bytes,3,opt,name=provision,proto3" json:"provision,omitempty"`                        // Optional assets to turn into gates once the callback succeeds
 }
 
 func (x *ProviderMetaOAuthStartRequest) Reset() {
@@ -604,6 +605,86 @@ func (x *ProviderMetaOAuthStartRequest) GetExtraScopes() []string {
 	return nil
 }
 
+func (x *ProviderMetaOAuthStartRequest) GetProvision() *ProviderMetaOAuthProvision {
+	if x != nil {
+		return x.Provision
+	}
+	return nil
+}
+
+// / ProviderMetaOAuthProvision selects the assets discovered during the callback that become gates.
+// / Existing gates for the same asset get their access token refreshed.
+type ProviderMetaOAuthProvision struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	All                    bool     `protobuf:"varint,1,opt,name=all,proto3" json:"all,omitempty"`                                                                        // Provision every discovered page and WhatsApp phone number
+	PageIds                []string `protobuf:"bytes,2,rep,name=page_ids,json=pageIds,proto3" json:"page_ids,omitempty"`                                                  // Facebook and Instagram page IDs to provision
+	WhatsappPhoneNumberIds []string `protobuf:"bytes,3,rep,name=whatsapp_phone_number_ids,json=whatsappPhoneNumberIds,proto3" json:"whatsapp_phone_number_ids,omitempty"` // WhatsApp phone number IDs to provision
+	Peer                   *Peer    `protobuf:"bytes,4,opt,name=peer,proto3" json:"peer,omitempty"`                                                                       // Bot contact bound to every provisioned gate
+}
+
+func (x *ProviderMetaOAuthProvision) Reset() {
+	*x = ProviderMetaOAuthProvision{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_provider_v1_messages_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProviderMetaOAuthProvision) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProviderMetaOAuthProvision) ProtoMessage() {}
+
+func (x *ProviderMetaOAuthProvision) ProtoReflect() protoreflect.Message {
+	mi := &file_service_provider_v1_messages_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProviderMetaOAuthProvision.ProtoReflect.Descriptor instead.
+func (*ProviderMetaOAuthProvision) Descriptor() ([]byte, []int) {
+	return file_service_provider_v1_messages_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ProviderMetaOAuthProvision) GetAll() bool {
+	if x != nil {
+		return x.All
+	}
+	return false
+}
+
+func (x *ProviderMetaOAuthProvision) GetPageIds() []string {
+	if x != nil {
+		return x.PageIds
+	}
+	return nil
+}
+
+func (x *ProviderMetaOAuthProvision) GetWhatsappPhoneNumberIds() []string {
+	if x != nil {
+		return x.WhatsappPhoneNumberIds
+	}
+	return nil
+}
+
+func (x *ProviderMetaOAuthProvision) GetPeer() *Peer {
+	if x != nil {
+		return x.Peer
+	}
+	return nil
+}
+
 // / ProviderMetaOAuthStartResponse provides the entry point for the user authorization.
 type ProviderMetaOAuthStartResponse struct {
 	state         protoimpl.MessageState
@@ -617,7 +698,7 @@ type ProviderMetaOAuthStartResponse struct {
 func (x *ProviderMetaOAuthStartResponse) Reset() {
 	*x = ProviderMetaOAuthStartResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_service_provider_v1_messages_proto_msgTypes[10]
+		mi := &file_service_provider_v1_messages_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -630,7 +711,7 @@ func (x *ProviderMetaOAuthStartResponse) String() string {
 func (*ProviderMetaOAuthStartResponse) ProtoMessage() {}
 
 func (x *ProviderMetaOAuthStartResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_service_provider_v1_messages_proto_msgTypes[10]
+	mi := &file_service_provider_v1_messages_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -643,7 +724,7 @@ func (x *ProviderMetaOAuthStartResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProviderMetaOAuthStartResponse.ProtoReflect.Descriptor instead.
 func (*ProviderMetaOAuthStartResponse) Descriptor() ([]byte, []int) {
-	return file_service_provider_v1_messages_proto_rawDescGZIP(), []int{10}
+	return file_service_provider_v1_messages_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *ProviderMetaOAuthStartResponse) GetAuthUrl() string {
@@ -674,7 +755,7 @@ type ProviderMetaOAuthCallbackRequest struct {
 func (x *ProviderMetaOAuthCallbackRequest) Reset() {
 	*x = ProviderMetaOAuthCallbackRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_service_provider_v1_messages_proto_msgTypes[11]
+		mi := &file_service_provider_v1_messages_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -687,7 +768,7 @@ func (x *ProviderMetaOAuthCallbackRequest) String() string {
 func (*ProviderMetaOAuthCallbackRequest) ProtoMessage() {}
 
 func (x *ProviderMetaOAuthCallbackRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_service_provider_v1_messages_proto_msgTypes[11]
+	mi := &file_service_provider_v1_messages_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -700,7 +781,7 @@ func (x *ProviderMetaOAuthCallbackRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProviderMetaOAuthCallbackRequest.ProtoReflect.Descriptor instead.
 func (*ProviderMetaOAuthCallbackRequest) Descriptor() ([]byte, []int) {
-	return file_service_provider_v1_messages_proto_rawDescGZIP(), []int{11}
+	return file_service_provider_v1_messages_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *ProviderMetaOAuthCallbackRequest) GetMetaAppId() string {
@@ -737,7 +818,7 @@ type ProviderMetaOAuthCallbackResponse struct {
 func (x *ProviderMetaOAuthCallbackResponse) Reset() {
 	*x = ProviderMetaOAuthCallbackResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_service_provider_v1_messages_proto_msgTypes[12]
+		mi := &file_service_provider_v1_messages_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -750,7 +831,7 @@ func (x *ProviderMetaOAuthCallbackResponse) String() string {
 func (*ProviderMetaOAuthCallbackResponse) ProtoMessage() {}
 
 func (x *ProviderMetaOAuthCallbackResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_service_provider_v1_messages_proto_msgTypes[12]
+	mi := &file_service_provider_v1_messages_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -763,7 +844,7 @@ func (x *ProviderMetaOAuthCallbackResponse) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use ProviderMetaOAuthCallbackResponse.ProtoReflect.Descriptor instead.
 func (*ProviderMetaOAuthCallbackResponse) Descriptor() ([]byte, []int) {
-	return file_service_provider_v1_messages_proto_rawDescGZIP(), []int{12}
+	return file_service_provider_v1_messages_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *ProviderMetaOAuthCallbackResponse) GetUserAccessToken() string {
@@ -795,7 +876,7 @@ type ProviderMetaLinkedPage struct {
 func (x *ProviderMetaLinkedPage) Reset() {
 	*x = ProviderMetaLinkedPage{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_service_provider_v1_messages_proto_msgTypes[13]
+		mi := &file_service_provider_v1_messages_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -808,7 +889,7 @@ func (x *ProviderMetaLinkedPage) String() string {
 func (*ProviderMetaLinkedPage) ProtoMessage() {}
 
 func (x *ProviderMetaLinkedPage) ProtoReflect() protoreflect.Message {
-	mi := &file_service_provider_v1_messages_proto_msgTypes[13]
+	mi := &file_service_provider_v1_messages_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -821,7 +902,7 @@ func (x *ProviderMetaLinkedPage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProviderMetaLinkedPage.ProtoReflect.Descriptor instead.
 func (*ProviderMetaLinkedPage) Descriptor() ([]byte, []int) {
-	return file_service_provider_v1_messages_proto_rawDescGZIP(), []int{13}
+	return file_service_provider_v1_messages_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *ProviderMetaLinkedPage) GetPageId() string {
@@ -870,7 +951,7 @@ type CreateGateRequest struct {
 func (x *CreateGateRequest) Reset() {
 	*x = CreateGateRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_service_provider_v1_messages_proto_msgTypes[14]
+		mi := &file_service_provider_v1_messages_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -883,7 +964,7 @@ func (x *CreateGateRequest) String() string {
 func (*CreateGateRequest) ProtoMessage() {}
 
 func (x *CreateGateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_service_provider_v1_messages_proto_msgTypes[14]
+	mi := &file_service_provider_v1_messages_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -896,7 +977,7 @@ func (x *CreateGateRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateGateRequest.ProtoReflect.Descriptor instead.
 func (*CreateGateRequest) Descriptor() ([]byte, []int) {
-	return file_service_provider_v1_messages_proto_rawDescGZIP(), []int{14}
+	return file_service_provider_v1_messages_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *CreateGateRequest) GetName() string {
@@ -972,7 +1053,7 @@ type CreateWABAGateRequest struct {
 func (x *CreateWABAGateRequest) Reset() {
 	*x = CreateWABAGateRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_service_provider_v1_messages_proto_msgTypes[15]
+		mi := &file_service_provider_v1_messages_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -985,7 +1066,7 @@ func (x *CreateWABAGateRequest) String() string {
 func (*CreateWABAGateRequest) ProtoMessage() {}
 
 func (x *CreateWABAGateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_service_provider_v1_messages_proto_msgTypes[15]
+	mi := &file_service_provider_v1_messages_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -998,7 +1079,7 @@ func (x *CreateWABAGateRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateWABAGateRequest.ProtoReflect.Descriptor instead.
 func (*CreateWABAGateRequest) Descriptor() ([]byte, []int) {
-	return file_service_provider_v1_messages_proto_rawDescGZIP(), []int{15}
+	return file_service_provider_v1_messages_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *CreateWABAGateRequest) GetMetaAppId() string {
@@ -1059,7 +1140,7 @@ type GateResponse struct {
 func (x *GateResponse) Reset() {
 	*x = GateResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_service_provider_v1_messages_proto_msgTypes[16]
+		mi := &file_service_provider_v1_messages_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1072,7 +1153,7 @@ func (x *GateResponse) String() string {
 func (*GateResponse) ProtoMessage() {}
 
 func (x *GateResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_service_provider_v1_messages_proto_msgTypes[16]
+	mi := &file_service_provider_v1_messages_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1085,7 +1166,7 @@ func (x *GateResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GateResponse.ProtoReflect.Descriptor instead.
 func (*GateResponse) Descriptor() ([]byte, []int) {
-	return file_service_provider_v1_messages_proto_rawDescGZIP(), []int{16}
+	return file_service_provider_v1_messages_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *GateResponse) GetId() string {
@@ -1192,7 +1273,7 @@ type WhatsAppBusinessAccount struct {
 func (x *WhatsAppBusinessAccount) Reset() {
 	*x = WhatsAppBusinessAccount{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_service_provider_v1_messages_proto_msgTypes[17]
+		mi := &file_service_provider_v1_messages_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1205,7 +1286,7 @@ func (x *WhatsAppBusinessAccount) String() string {
 func (*WhatsAppBusinessAccount) ProtoMessage() {}
 
 func (x *WhatsAppBusinessAccount) ProtoReflect() protoreflect.Message {
-	mi := &file_service_provider_v1_messages_proto_msgTypes[17]
+	mi := &file_service_provider_v1_messages_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1218,7 +1299,7 @@ func (x *WhatsAppBusinessAccount) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WhatsAppBusinessAccount.ProtoReflect.Descriptor instead.
 func (*WhatsAppBusinessAccount) Descriptor() ([]byte, []int) {
-	return file_service_provider_v1_messages_proto_rawDescGZIP(), []int{17}
+	return file_service_provider_v1_messages_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *WhatsAppBusinessAccount) GetId() string {
@@ -1287,7 +1368,7 @@ type ProviderCreateFacebookGateRequest struct {
 func (x *ProviderCreateFacebookGateRequest) Reset() {
 	*x = ProviderCreateFacebookGateRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_service_provider_v1_messages_proto_msgTypes[18]
+		mi := &file_service_provider_v1_messages_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1300,7 +1381,7 @@ func (x *ProviderCreateFacebookGateRequest) String() string {
 func (*ProviderCreateFacebookGateRequest) ProtoMessage() {}
 
 func (x *ProviderCreateFacebookGateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_service_provider_v1_messages_proto_msgTypes[18]
+	mi := &file_service_provider_v1_messages_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1313,7 +1394,7 @@ func (x *ProviderCreateFacebookGateRequest) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use ProviderCreateFacebookGateRequest.ProtoReflect.Descriptor instead.
 func (*ProviderCreateFacebookGateRequest) Descriptor() ([]byte, []int) {
-	return file_service_provider_v1_messages_proto_rawDescGZIP(), []int{18}
+	return file_service_provider_v1_messages_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *ProviderCreateFacebookGateRequest) GetName() string {
@@ -1370,7 +1451,7 @@ type ProviderCreateFacebookGateResponse struct {
 func (x *ProviderCreateFacebookGateResponse) Reset() {
 	*x = ProviderCreateFacebookGateResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_service_provider_v1_messages_proto_msgTypes[19]
+		mi := &file_service_provider_v1_messages_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1383,7 +1464,7 @@ func (x *ProviderCreateFacebookGateResponse) String() string {
 func (*ProviderCreateFacebookGateResponse) ProtoMessage() {}
 
 func (x *ProviderCreateFacebookGateResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_service_provider_v1_messages_proto_msgTypes[19]
+	mi := &file_service_provider_v1_messages_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1396,7 +1477,7 @@ func (x *ProviderCreateFacebookGateResponse) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use ProviderCreateFacebookGateResponse.ProtoReflect.Descriptor instead.
 func (*ProviderCreateFacebookGateResponse) Descriptor() ([]byte, []int) {
-	return file_service_provider_v1_messages_proto_rawDescGZIP(), []int{19}
+	return file_service_provider_v1_messages_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *ProviderCreateFacebookGateResponse) GetItem() *ProviderFacebookGate {
@@ -1418,7 +1499,7 @@ type ProviderGetFacebookGateRequest struct {
 func (x *ProviderGetFacebookGateRequest) Reset() {
 	*x = ProviderGetFacebookGateRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_service_provider_v1_messages_proto_msgTypes[20]
+		mi := &file_service_provider_v1_messages_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1431,7 +1512,7 @@ func (x *ProviderGetFacebookGateRequest) String() string {
 func (*ProviderGetFacebookGateRequest) ProtoMessage() {}
 
 func (x *ProviderGetFacebookGateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_service_provider_v1_messages_proto_msgTypes[20]
+	mi := &file_service_provider_v1_messages_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1444,7 +1525,7 @@ func (x *ProviderGetFacebookGateRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProviderGetFacebookGateRequest.ProtoReflect.Descriptor instead.
 func (*ProviderGetFacebookGateRequest) Descriptor() ([]byte, []int) {
-	return file_service_provider_v1_messages_proto_rawDescGZIP(), []int{20}
+	return file_service_provider_v1_messages_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *ProviderGetFacebookGateRequest) GetId() string {
@@ -1465,7 +1546,7 @@ type ProviderGetFacebookGateResponse struct {
 func (x *ProviderGetFacebookGateResponse) Reset() {
 	*x = ProviderGetFacebookGateResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_service_provider_v1_messages_proto_msgTypes[21]
+		mi := &file_service_provider_v1_messages_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1478,7 +1559,7 @@ func (x *ProviderGetFacebookGateResponse) String() string {
 func (*ProviderGetFacebookGateResponse) ProtoMessage() {}
 
 func (x *ProviderGetFacebookGateResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_service_provider_v1_messages_proto_msgTypes[21]
+	mi := &file_service_provider_v1_messages_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1491,7 +1572,7 @@ func (x *ProviderGetFacebookGateResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProviderGetFacebookGateResponse.ProtoReflect.Descriptor instead.
 func (*ProviderGetFacebookGateResponse) Descriptor() ([]byte, []int) {
-	return file_service_provider_v1_messages_proto_rawDescGZIP(), []int{21}
+	return file_service_provider_v1_messages_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *ProviderGetFacebookGateResponse) GetItem() *ProviderFacebookGate {
@@ -1517,7 +1598,7 @@ type ProviderUpdateFacebookGateRequest struct {
 func (x *ProviderUpdateFacebookGateRequest) Reset() {
 	*x = ProviderUpdateFacebookGateRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_service_provider_v1_messages_proto_msgTypes[22]
+		mi := &file_service_provider_v1_messages_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1530,7 +1611,7 @@ func (x *ProviderUpdateFacebookGateRequest) String() string {
 func (*ProviderUpdateFacebookGateRequest) ProtoMessage() {}
 
 func (x *ProviderUpdateFacebookGateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_service_provider_v1_messages_proto_msgTypes[22]
+	mi := &file_service_provider_v1_messages_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1543,7 +1624,7 @@ func (x *ProviderUpdateFacebookGateRequest) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use ProviderUpdateFacebookGateRequest.ProtoReflect.Descriptor instead.
 func (*ProviderUpdateFacebookGateRequest) Descriptor() ([]byte, []int) {
-	return file_service_provider_v1_messages_proto_rawDescGZIP(), []int{22}
+	return file_service_provider_v1_messages_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *ProviderUpdateFacebookGateRequest) GetId() string {
@@ -1593,7 +1674,7 @@ type ProviderUpdateFacebookGateResponse struct {
 func (x *ProviderUpdateFacebookGateResponse) Reset() {
 	*x = ProviderUpdateFacebookGateResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_service_provider_v1_messages_proto_msgTypes[23]
+		mi := &file_service_provider_v1_messages_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1606,7 +1687,7 @@ func (x *ProviderUpdateFacebookGateResponse) String() string {
 func (*ProviderUpdateFacebookGateResponse) ProtoMessage() {}
 
 func (x *ProviderUpdateFacebookGateResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_service_provider_v1_messages_proto_msgTypes[23]
+	mi := &file_service_provider_v1_messages_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1619,7 +1700,7 @@ func (x *ProviderUpdateFacebookGateResponse) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use ProviderUpdateFacebookGateResponse.ProtoReflect.Descriptor instead.
 func (*ProviderUpdateFacebookGateResponse) Descriptor() ([]byte, []int) {
-	return file_service_provider_v1_messages_proto_rawDescGZIP(), []int{23}
+	return file_service_provider_v1_messages_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *ProviderUpdateFacebookGateResponse) GetItem() *ProviderFacebookGate {
@@ -1641,7 +1722,7 @@ type ProviderDeleteFacebookGateRequest struct {
 func (x *ProviderDeleteFacebookGateRequest) Reset() {
 	*x = ProviderDeleteFacebookGateRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_service_provider_v1_messages_proto_msgTypes[24]
+		mi := &file_service_provider_v1_messages_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1654,7 +1735,7 @@ func (x *ProviderDeleteFacebookGateRequest) String() string {
 func (*ProviderDeleteFacebookGateRequest) ProtoMessage() {}
 
 func (x *ProviderDeleteFacebookGateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_service_provider_v1_messages_proto_msgTypes[24]
+	mi := &file_service_provider_v1_messages_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1667,7 +1748,7 @@ func (x *ProviderDeleteFacebookGateRequest) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use ProviderDeleteFacebookGateRequest.ProtoReflect.Descriptor instead.
 func (*ProviderDeleteFacebookGateRequest) Descriptor() ([]byte, []int) {
-	return file_service_provider_v1_messages_proto_rawDescGZIP(), []int{24}
+	return file_service_provider_v1_messages_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *ProviderDeleteFacebookGateRequest) GetId() string {
@@ -1688,7 +1769,7 @@ type ProviderDeleteFacebookGateResponse struct {
 func (x *ProviderDeleteFacebookGateResponse) Reset() {
 	*x = ProviderDeleteFacebookGateResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_service_provider_v1_messages_proto_msgTypes[25]
+		mi := &file_service_provider_v1_messages_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1701,7 +1782,7 @@ func (x *ProviderDeleteFacebookGateResponse) String() string {
 func (*ProviderDeleteFacebookGateResponse) ProtoMessage() {}
 
 func (x *ProviderDeleteFacebookGateResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_service_provider_v1_messages_proto_msgTypes[25]
+	mi := &file_service_provider_v1_messages_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1714,7 +1795,7 @@ func (x *ProviderDeleteFacebookGateResponse) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use ProviderDeleteFacebookGateResponse.ProtoReflect.Descriptor instead.
 func (*ProviderDeleteFacebookGateResponse) Descriptor() ([]byte, []int) {
-	return file_service_provider_v1_messages_proto_rawDescGZIP(), []int{25}
+	return file_service_provider_v1_messages_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *ProviderDeleteFacebookGateResponse) GetItem() *ProviderFacebookGate {
@@ -1740,7 +1821,7 @@ type ProviderCreateWhatsAppGateRequest struct {
 func (x *ProviderCreateWhatsAppGateRequest) Reset() {
 	*x = ProviderCreateWhatsAppGateRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_service_provider_v1_messages_proto_msgTypes[26]
+		mi := &file_service_provider_v1_messages_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1753,7 +1834,7 @@ func (x *ProviderCreateWhatsAppGateRequest) String() string {
 func (*ProviderCreateWhatsAppGateRequest) ProtoMessage() {}
 
 func (x *ProviderCreateWhatsAppGateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_service_provider_v1_messages_proto_msgTypes[26]
+	mi := &file_service_provider_v1_messages_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1766,7 +1847,7 @@ func (x *ProviderCreateWhatsAppGateRequest) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use ProviderCreateWhatsAppGateRequest.ProtoReflect.Descriptor instead.
 func (*ProviderCreateWhatsAppGateRequest) Descriptor() ([]byte, []int) {
-	return file_service_provider_v1_messages_proto_rawDescGZIP(), []int{26}
+	return file_service_provider_v1_messages_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *ProviderCreateWhatsAppGateRequest) GetName() string {
@@ -1816,7 +1897,7 @@ type ProviderCreateWhatsAppGateResponse struct {
 func (x *ProviderCreateWhatsAppGateResponse) Reset() {
 	*x = ProviderCreateWhatsAppGateResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_service_provider_v1_messages_proto_msgTypes[27]
+		mi := &file_service_provider_v1_messages_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1829,7 +1910,7 @@ func (x *ProviderCreateWhatsAppGateResponse) String() string {
 func (*ProviderCreateWhatsAppGateResponse) ProtoMessage() {}
 
 func (x *ProviderCreateWhatsAppGateResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_service_provider_v1_messages_proto_msgTypes[27]
+	mi := &file_service_provider_v1_messages_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1842,7 +1923,7 @@ func (x *ProviderCreateWhatsAppGateResponse) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use ProviderCreateWhatsAppGateResponse.ProtoReflect.Descriptor instead.
 func (*ProviderCreateWhatsAppGateResponse) Descriptor() ([]byte, []int) {
-	return file_service_provider_v1_messages_proto_rawDescGZIP(), []int{27}
+	return file_service_provider_v1_messages_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *ProviderCreateWhatsAppGateResponse) GetItem() *ProviderWhatsAppGate {
@@ -1864,7 +1945,7 @@ type ProviderGetWhatsAppGateRequest struct {
 func (x *ProviderGetWhatsAppGateRequest) Reset() {
 	*x = ProviderGetWhatsAppGateRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_service_provider_v1_messages_proto_msgTypes[28]
+		mi := &file_service_provider_v1_messages_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1877,7 +1958,7 @@ func (x *ProviderGetWhatsAppGateRequest) String() string {
 func (*ProviderGetWhatsAppGateRequest) ProtoMessage() {}
 
 func (x *ProviderGetWhatsAppGateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_service_provider_v1_messages_proto_msgTypes[28]
+	mi := &file_service_provider_v1_messages_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1890,7 +1971,7 @@ func (x *ProviderGetWhatsAppGateRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProviderGetWhatsAppGateRequest.ProtoReflect.Descriptor instead.
 func (*ProviderGetWhatsAppGateRequest) Descriptor() ([]byte, []int) {
-	return file_service_provider_v1_messages_proto_rawDescGZIP(), []int{28}
+	return file_service_provider_v1_messages_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *ProviderGetWhatsAppGateRequest) GetId() string {
@@ -1911,7 +1992,7 @@ type ProviderGetWhatsAppGateResponse struct {
 func (x *ProviderGetWhatsAppGateResponse) Reset() {
 	*x = ProviderGetWhatsAppGateResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_service_provider_v1_messages_proto_msgTypes[29]
+		mi := &file_service_provider_v1_messages_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1924,7 +2005,7 @@ func (x *ProviderGetWhatsAppGateResponse) String() string {
 func (*ProviderGetWhatsAppGateResponse) ProtoMessage() {}
 
 func (x *ProviderGetWhatsAppGateResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_service_provider_v1_messages_proto_msgTypes[29]
+	mi := &file_service_provider_v1_messages_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1937,7 +2018,7 @@ func (x *ProviderGetWhatsAppGateResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProviderGetWhatsAppGateResponse.ProtoReflect.Descriptor instead.
 func (*ProviderGetWhatsAppGateResponse) Descriptor() ([]byte, []int) {
-	return file_service_provider_v1_messages_proto_rawDescGZIP(), []int{29}
+	return file_service_provider_v1_messages_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *ProviderGetWhatsAppGateResponse) GetItem() *ProviderWhatsAppGate {
@@ -1961,7 +2042,7 @@ type ProviderUpdateWhatsAppGateRequest struct {
 func (x *ProviderUpdateWhatsAppGateRequest) Reset() {
 	*x = ProviderUpdateWhatsAppGateRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_service_provider_v1_messages_proto_msgTypes[30]
+		mi := &file_service_provider_v1_messages_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1974,7 +2055,7 @@ func (x *ProviderUpdateWhatsAppGateRequest) String() string {
 func (*ProviderUpdateWhatsAppGateRequest) ProtoMessage() {}
 
 func (x *ProviderUpdateWhatsAppGateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_service_provider_v1_messages_proto_msgTypes[30]
+	mi := &file_service_provider_v1_messages_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1987,7 +2068,7 @@ func (x *ProviderUpdateWhatsAppGateRequest) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use ProviderUpdateWhatsAppGateRequest.ProtoReflect.Descriptor instead.
 func (*ProviderUpdateWhatsAppGateRequest) Descriptor() ([]byte, []int) {
-	return file_service_provider_v1_messages_proto_rawDescGZIP(), []int{30}
+	return file_service_provider_v1_messages_proto_rawDescGZIP(), []int{31}
 }
 
 func (x *ProviderUpdateWhatsAppGateRequest) GetId() string {
@@ -2023,7 +2104,7 @@ type ProviderUpdateWhatsAppGateResponse struct {
 func (x *ProviderUpdateWhatsAppGateResponse) Reset() {
 	*x = ProviderUpdateWhatsAppGateResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_service_provider_v1_messages_proto_msgTypes[31]
+		mi := &file_service_provider_v1_messages_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2036,7 +2117,7 @@ func (x *ProviderUpdateWhatsAppGateResponse) String() string {
 func (*ProviderUpdateWhatsAppGateResponse) ProtoMessage() {}
 
 func (x *ProviderUpdateWhatsAppGateResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_service_provider_v1_messages_proto_msgTypes[31]
+	mi := &file_service_provider_v1_messages_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2049,7 +2130,7 @@ func (x *ProviderUpdateWhatsAppGateResponse) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use ProviderUpdateWhatsAppGateResponse.ProtoReflect.Descriptor instead.
 func (*ProviderUpdateWhatsAppGateResponse) Descriptor() ([]byte, []int) {
-	return file_service_provider_v1_messages_proto_rawDescGZIP(), []int{31}
+	return file_service_provider_v1_messages_proto_rawDescGZIP(), []int{32}
 }
 
 func (x *ProviderUpdateWhatsAppGateResponse) GetItem() *ProviderWhatsAppGate {
@@ -2071,7 +2152,7 @@ type ProviderDeleteWhatsAppGateRequest struct {
 func (x *ProviderDeleteWhatsAppGateRequest) Reset() {
 	*x = ProviderDeleteWhatsAppGateRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_service_provider_v1_messages_proto_msgTypes[32]
+		mi := &file_service_provider_v1_messages_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2084,7 +2165,7 @@ func (x *ProviderDeleteWhatsAppGateRequest) String() string {
 func (*ProviderDeleteWhatsAppGateRequest) ProtoMessage() {}
 
 func (x *ProviderDeleteWhatsAppGateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_service_provider_v1_messages_proto_msgTypes[32]
+	mi := &file_service_provider_v1_messages_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2097,7 +2178,7 @@ func (x *ProviderDeleteWhatsAppGateRequest) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use ProviderDeleteWhatsAppGateRequest.ProtoReflect.Descriptor instead.
 func (*ProviderDeleteWhatsAppGateRequest) Descriptor() ([]byte, []int) {
-	return file_service_provider_v1_messages_proto_rawDescGZIP(), []int{32}
+	return file_service_provider_v1_messages_proto_rawDescGZIP(), []int{33}
 }
 
 func (x *ProviderDeleteWhatsAppGateRequest) GetId() string {
@@ -2118,7 +2199,7 @@ type ProviderDeleteWhatsAppGateResponse struct {
 func (x *ProviderDeleteWhatsAppGateResponse) Reset() {
 	*x = ProviderDeleteWhatsAppGateResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_service_provider_v1_messages_proto_msgTypes[33]
+		mi := &file_service_provider_v1_messages_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2131,7 +2212,7 @@ func (x *ProviderDeleteWhatsAppGateResponse) String() string {
 func (*ProviderDeleteWhatsAppGateResponse) ProtoMessage() {}
 
 func (x *ProviderDeleteWhatsAppGateResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_service_provider_v1_messages_proto_msgTypes[33]
+	mi := &file_service_provider_v1_messages_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2144,7 +2225,7 @@ func (x *ProviderDeleteWhatsAppGateResponse) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use ProviderDeleteWhatsAppGateResponse.ProtoReflect.Descriptor instead.
 func (*ProviderDeleteWhatsAppGateResponse) Descriptor() ([]byte, []int) {
-	return file_service_provider_v1_messages_proto_rawDescGZIP(), []int{33}
+	return file_service_provider_v1_messages_proto_rawDescGZIP(), []int{34}
 }
 
 func (x *ProviderDeleteWhatsAppGateResponse) GetItem() *ProviderWhatsAppGate {
@@ -2170,7 +2251,7 @@ type ProviderListGatesRequest struct {
 func (x *ProviderListGatesRequest) Reset() {
 	*x = ProviderListGatesRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_service_provider_v1_messages_proto_msgTypes[34]
+		mi := &file_service_provider_v1_messages_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2183,7 +2264,7 @@ func (x *ProviderListGatesRequest) String() string {
 func (*ProviderListGatesRequest) ProtoMessage() {}
 
 func (x *ProviderListGatesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_service_provider_v1_messages_proto_msgTypes[34]
+	mi := &file_service_provider_v1_messages_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2196,7 +2277,7 @@ func (x *ProviderListGatesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProviderListGatesRequest.ProtoReflect.Descriptor instead.
 func (*ProviderListGatesRequest) Descriptor() ([]byte, []int) {
-	return file_service_provider_v1_messages_proto_rawDescGZIP(), []int{34}
+	return file_service_provider_v1_messages_proto_rawDescGZIP(), []int{35}
 }
 
 func (x *ProviderListGatesRequest) GetPage() int32 {
@@ -2252,7 +2333,7 @@ type ProviderListGatesResponse struct {
 func (x *ProviderListGatesResponse) Reset() {
 	*x = ProviderListGatesResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_service_provider_v1_messages_proto_msgTypes[35]
+		mi := &file_service_provider_v1_messages_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2265,7 +2346,7 @@ func (x *ProviderListGatesResponse) String() string {
 func (*ProviderListGatesResponse) ProtoMessage() {}
 
 func (x *ProviderListGatesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_service_provider_v1_messages_proto_msgTypes[35]
+	mi := &file_service_provider_v1_messages_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2278,7 +2359,7 @@ func (x *ProviderListGatesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProviderListGatesResponse.ProtoReflect.Descriptor instead.
 func (*ProviderListGatesResponse) Descriptor() ([]byte, []int) {
-	return file_service_provider_v1_messages_proto_rawDescGZIP(), []int{35}
+	return file_service_provider_v1_messages_proto_rawDescGZIP(), []int{36}
 }
 
 func (x *ProviderListGatesResponse) GetItems() []*ProviderSummary {
@@ -2379,259 +2460,276 @@ var file_service_provider_v1_messages_proto_rawDesc = []byte{
 	0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x77, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x2e, 0x69, 0x6d,
 	0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f,
 	0x76, 0x69, 0x64, 0x65, 0x72, 0x4d, 0x65, 0x74, 0x61, 0x41, 0x70, 0x70, 0x52, 0x04, 0x69, 0x74,
-	0x65, 0x6d, 0x22, 0x62, 0x0a, 0x1d, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x4d, 0x65,
-	0x74, 0x61, 0x4f, 0x41, 0x75, 0x74, 0x68, 0x53, 0x74, 0x61, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x6d, 0x22, 0xb4, 0x01, 0x0a, 0x1d, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x4d,
+	0x65, 0x74, 0x61, 0x4f, 0x41, 0x75, 0x74, 0x68, 0x53, 0x74, 0x61, 0x72, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0b, 0x6d, 0x65, 0x74, 0x61, 0x5f, 0x61, 0x70, 0x70,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6d, 0x65, 0x74, 0x61, 0x41,
+	0x70, 0x70, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x65, 0x78, 0x74, 0x72, 0x61, 0x5f, 0x73, 0x63,
+	0x6f, 0x70, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x65, 0x78, 0x74, 0x72,
+	0x61, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x73, 0x12, 0x50, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x76, 0x69,
+	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x77, 0x65, 0x62,
+	0x69, 0x74, 0x65, 0x6c, 0x2e, 0x69, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
+	0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x4d, 0x65, 0x74, 0x61,
+	0x4f, 0x41, 0x75, 0x74, 0x68, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x09,
+	0x70, 0x72, 0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0xb6, 0x01, 0x0a, 0x1a, 0x50, 0x72,
+	0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x4d, 0x65, 0x74, 0x61, 0x4f, 0x41, 0x75, 0x74, 0x68, 0x50,
+	0x72, 0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x61, 0x6c, 0x6c, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x03, 0x61, 0x6c, 0x6c, 0x12, 0x19, 0x0a, 0x08, 0x70, 0x61,
+	0x67, 0x65, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x70, 0x61,
+	0x67, 0x65, 0x49, 0x64, 0x73, 0x12, 0x39, 0x0a, 0x19, 0x77, 0x68, 0x61, 0x74, 0x73, 0x61, 0x70,
+	0x70, 0x5f, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x5f, 0x69,
+	0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x16, 0x77, 0x68, 0x61, 0x74, 0x73, 0x61,
+	0x70, 0x70, 0x50, 0x68, 0x6f, 0x6e, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x49, 0x64, 0x73,
+	0x12, 0x30, 0x0a, 0x04, 0x70, 0x65, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c,
+	0x2e, 0x77, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x2e, 0x69, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x76,
+	0x69, 0x64, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x65, 0x65, 0x72, 0x52, 0x04, 0x70, 0x65,
+	0x65, 0x72, 0x22, 0x51, 0x0a, 0x1e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x4d, 0x65,
+	0x74, 0x61, 0x4f, 0x41, 0x75, 0x74, 0x68, 0x53, 0x74, 0x61, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x75, 0x72, 0x6c,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x75, 0x74, 0x68, 0x55, 0x72, 0x6c, 0x12,
+	0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x73, 0x74, 0x61, 0x74, 0x65, 0x22, 0x6c, 0x0a, 0x20, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65,
+	0x72, 0x4d, 0x65, 0x74, 0x61, 0x4f, 0x41, 0x75, 0x74, 0x68, 0x43, 0x61, 0x6c, 0x6c, 0x62, 0x61,
+	0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0b, 0x6d, 0x65, 0x74,
+	0x61, 0x5f, 0x61, 0x70, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x6d, 0x65, 0x74, 0x61, 0x41, 0x70, 0x70, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x14, 0x0a,
+	0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74,
+	0x61, 0x74, 0x65, 0x22, 0x95, 0x01, 0x0a, 0x21, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
+	0x4d, 0x65, 0x74, 0x61, 0x4f, 0x41, 0x75, 0x74, 0x68, 0x43, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63,
+	0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x11, 0x75, 0x73, 0x65,
+	0x72, 0x5f, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x75, 0x73, 0x65, 0x72, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x44, 0x0a, 0x05, 0x70, 0x61, 0x67, 0x65, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x77, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x2e, 0x69,
+	0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72,
+	0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x4d, 0x65, 0x74, 0x61, 0x4c, 0x69, 0x6e, 0x6b, 0x65, 0x64,
+	0x50, 0x61, 0x67, 0x65, 0x52, 0x05, 0x70, 0x61, 0x67, 0x65, 0x73, 0x22, 0x8d, 0x01, 0x0a, 0x16,
+	0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x4d, 0x65, 0x74, 0x61, 0x4c, 0x69, 0x6e, 0x6b,
+	0x65, 0x64, 0x50, 0x61, 0x67, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x61, 0x67, 0x65, 0x49, 0x64, 0x12,
+	0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c,
+	0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12,
+	0x1a, 0x0a, 0x08, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x22, 0x8b, 0x02, 0x0a, 0x11,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12,
+	0x2e, 0x0a, 0x03, 0x62, 0x6f, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x77,
+	0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x2e, 0x69, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64,
+	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x65, 0x65, 0x72, 0x52, 0x03, 0x62, 0x6f, 0x74, 0x12,
+	0x43, 0x0a, 0x04, 0x77, 0x61, 0x62, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e,
+	0x77, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x2e, 0x69, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69,
+	0x64, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x57, 0x41, 0x42,
+	0x41, 0x47, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x04,
+	0x77, 0x61, 0x62, 0x61, 0x12, 0x4b, 0x0a, 0x02, 0x66, 0x62, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x39, 0x2e, 0x77, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x2e, 0x69, 0x6d, 0x2e, 0x70, 0x72,
+	0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64,
+	0x65, 0x72, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x46, 0x61, 0x63, 0x65, 0x62, 0x6f, 0x6f, 0x6b,
+	0x47, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x02, 0x66,
+	0x62, 0x42, 0x06, 0x0a, 0x04, 0x67, 0x61, 0x74, 0x65, 0x22, 0xc6, 0x01, 0x0a, 0x15, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x57, 0x41, 0x42, 0x41, 0x47, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75,
 	0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0b, 0x6d, 0x65, 0x74, 0x61, 0x5f, 0x61, 0x70, 0x70, 0x5f,
 	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6d, 0x65, 0x74, 0x61, 0x41, 0x70,
-	0x70, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x65, 0x78, 0x74, 0x72, 0x61, 0x5f, 0x73, 0x63, 0x6f,
-	0x70, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x65, 0x78, 0x74, 0x72, 0x61,
-	0x53, 0x63, 0x6f, 0x70, 0x65, 0x73, 0x22, 0x51, 0x0a, 0x1e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64,
-	0x65, 0x72, 0x4d, 0x65, 0x74, 0x61, 0x4f, 0x41, 0x75, 0x74, 0x68, 0x53, 0x74, 0x61, 0x72, 0x74,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x75, 0x74, 0x68,
-	0x5f, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x75, 0x74, 0x68,
-	0x55, 0x72, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x22, 0x6c, 0x0a, 0x20, 0x50, 0x72, 0x6f,
-	0x76, 0x69, 0x64, 0x65, 0x72, 0x4d, 0x65, 0x74, 0x61, 0x4f, 0x41, 0x75, 0x74, 0x68, 0x43, 0x61,
-	0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a,
-	0x0b, 0x6d, 0x65, 0x74, 0x61, 0x5f, 0x61, 0x70, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x09, 0x6d, 0x65, 0x74, 0x61, 0x41, 0x70, 0x70, 0x49, 0x64, 0x12, 0x12, 0x0a,
-	0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64,
-	0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x22, 0x95, 0x01, 0x0a, 0x21, 0x50, 0x72, 0x6f, 0x76,
-	0x69, 0x64, 0x65, 0x72, 0x4d, 0x65, 0x74, 0x61, 0x4f, 0x41, 0x75, 0x74, 0x68, 0x43, 0x61, 0x6c,
-	0x6c, 0x62, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a,
-	0x11, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x74, 0x6f, 0x6b,
-	0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x75, 0x73, 0x65, 0x72, 0x41, 0x63,
-	0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x44, 0x0a, 0x05, 0x70, 0x61, 0x67,
-	0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x77, 0x65, 0x62, 0x69, 0x74,
-	0x65, 0x6c, 0x2e, 0x69, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x2e, 0x76,
-	0x31, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x4d, 0x65, 0x74, 0x61, 0x4c, 0x69,
-	0x6e, 0x6b, 0x65, 0x64, 0x50, 0x61, 0x67, 0x65, 0x52, 0x05, 0x70, 0x61, 0x67, 0x65, 0x73, 0x22,
-	0x8d, 0x01, 0x0a, 0x16, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x4d, 0x65, 0x74, 0x61,
-	0x4c, 0x69, 0x6e, 0x6b, 0x65, 0x64, 0x50, 0x61, 0x67, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x61,
-	0x67, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x61, 0x67,
-	0x65, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x4e, 0x61, 0x6d, 0x65,
-	0x12, 0x21, 0x0a, 0x0c, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f,
-	0x6b, 0x65, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x22,
-	0x8b, 0x02, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x61, 0x74, 0x65, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x61,
-	0x62, 0x6c, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62,
-	0x6c, 0x65, 0x64, 0x12, 0x2e, 0x0a, 0x03, 0x62, 0x6f, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1c, 0x2e, 0x77, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x2e, 0x69, 0x6d, 0x2e, 0x70, 0x72,
-	0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x65, 0x65, 0x72, 0x52, 0x03,
-	0x62, 0x6f, 0x74, 0x12, 0x43, 0x0a, 0x04, 0x77, 0x61, 0x62, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x2d, 0x2e, 0x77, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x2e, 0x69, 0x6d, 0x2e, 0x70,
-	0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x57, 0x41, 0x42, 0x41, 0x47, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x48, 0x00, 0x52, 0x04, 0x77, 0x61, 0x62, 0x61, 0x12, 0x4b, 0x0a, 0x02, 0x66, 0x62, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x39, 0x2e, 0x77, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x2e, 0x69,
-	0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72,
-	0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x46, 0x61, 0x63, 0x65,
-	0x62, 0x6f, 0x6f, 0x6b, 0x47, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48,
-	0x00, 0x52, 0x02, 0x66, 0x62, 0x42, 0x06, 0x0a, 0x04, 0x67, 0x61, 0x74, 0x65, 0x22, 0xc6, 0x01,
-	0x0a, 0x15, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x57, 0x41, 0x42, 0x41, 0x47, 0x61, 0x74, 0x65,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0b, 0x6d, 0x65, 0x74, 0x61, 0x5f,
-	0x61, 0x70, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6d, 0x65,
-	0x74, 0x61, 0x41, 0x70, 0x70, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x68, 0x6f, 0x6e, 0x65,
-	0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70,
-	0x68, 0x6f, 0x6e, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x26, 0x0a, 0x0f, 0x70, 0x68,
-	0x6f, 0x6e, 0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0d, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72,
-	0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x74, 0x6f, 0x6b,
-	0x65, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73,
-	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x62, 0x75, 0x73, 0x69, 0x6e, 0x65, 0x73,
-	0x73, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x62, 0x75, 0x73, 0x69,
-	0x6e, 0x65, 0x73, 0x73, 0x49, 0x64, 0x22, 0xdb, 0x02, 0x0a, 0x0c, 0x47, 0x61, 0x74, 0x65, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74,
-	0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12,
-	0x18, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x64, 0x42, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x75, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x64, 0x5f, 0x62, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x64, 0x42, 0x79, 0x12, 0x2e, 0x0a, 0x03, 0x62, 0x6f, 0x74, 0x18, 0x09, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x77, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x2e, 0x69, 0x6d, 0x2e,
-	0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x65, 0x65, 0x72,
-	0x52, 0x03, 0x62, 0x6f, 0x74, 0x12, 0x45, 0x0a, 0x04, 0x77, 0x61, 0x62, 0x61, 0x18, 0x0a, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x77, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x2e, 0x69, 0x6d,
-	0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x68, 0x61,
-	0x74, 0x73, 0x41, 0x70, 0x70, 0x42, 0x75, 0x73, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x41, 0x63, 0x63,
-	0x6f, 0x75, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x04, 0x77, 0x61, 0x62, 0x61, 0x42, 0x06, 0x0a, 0x04,
-	0x67, 0x61, 0x74, 0x65, 0x22, 0xb0, 0x02, 0x0a, 0x17, 0x57, 0x68, 0x61, 0x74, 0x73, 0x41, 0x70,
+	0x70, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x5f, 0x6e, 0x75, 0x6d,
+	0x62, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x68, 0x6f, 0x6e, 0x65,
+	0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x26, 0x0a, 0x0f, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x5f,
+	0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0d, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x49, 0x64, 0x12, 0x21,
+	0x0a, 0x0c, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x62, 0x75, 0x73, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x5f, 0x69, 0x64,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x62, 0x75, 0x73, 0x69, 0x6e, 0x65, 0x73, 0x73,
+	0x49, 0x64, 0x22, 0xdb, 0x02, 0x0a, 0x0c, 0x47, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x65,
+	0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e,
+	0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x41, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f,
+	0x62, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x42, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61,
+	0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64,
+	0x41, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x79,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x42,
+	0x79, 0x12, 0x2e, 0x0a, 0x03, 0x62, 0x6f, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c,
+	0x2e, 0x77, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x2e, 0x69, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x76,
+	0x69, 0x64, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x65, 0x65, 0x72, 0x52, 0x03, 0x62, 0x6f,
+	0x74, 0x12, 0x45, 0x0a, 0x04, 0x77, 0x61, 0x62, 0x61, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x2f, 0x2e, 0x77, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x2e, 0x69, 0x6d, 0x2e, 0x70, 0x72, 0x6f,
+	0x76, 0x69, 0x64, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x68, 0x61, 0x74, 0x73, 0x41, 0x70,
 	0x70, 0x42, 0x75, 0x73, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
-	0x12, 0x1e, 0x0a, 0x0b, 0x6d, 0x65, 0x74, 0x61, 0x5f, 0x61, 0x70, 0x70, 0x5f, 0x69, 0x64, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6d, 0x65, 0x74, 0x61, 0x41, 0x70, 0x70, 0x49, 0x64,
-	0x12, 0x21, 0x0a, 0x0c, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x4e, 0x75, 0x6d,
-	0x62, 0x65, 0x72, 0x12, 0x26, 0x0a, 0x0f, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x5f, 0x6e, 0x75, 0x6d,
-	0x62, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x70, 0x68,
-	0x6f, 0x6e, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x61,
-	0x63, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x3a,
-	0x0a, 0x17, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x65,
-	0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x48,
-	0x00, 0x52, 0x14, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x45, 0x78,
-	0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x88, 0x01, 0x01, 0x12, 0x1f, 0x0a, 0x0b, 0x62, 0x75,
-	0x73, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x5f, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0a, 0x62, 0x75, 0x73, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x49, 0x64, 0x42, 0x1a, 0x0a, 0x18, 0x5f,
-	0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x65, 0x78, 0x70,
-	0x69, 0x72, 0x65, 0x73, 0x5f, 0x61, 0x74, 0x22, 0xdb, 0x01, 0x0a, 0x21, 0x50, 0x72, 0x6f, 0x76,
-	0x69, 0x64, 0x65, 0x72, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x46, 0x61, 0x63, 0x65, 0x62, 0x6f,
-	0x6f, 0x6b, 0x47, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a,
-	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
-	0x65, 0x12, 0x1e, 0x0a, 0x0b, 0x6d, 0x65, 0x74, 0x61, 0x5f, 0x61, 0x70, 0x70, 0x5f, 0x69, 0x64,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6d, 0x65, 0x74, 0x61, 0x41, 0x70, 0x70, 0x49,
-	0x64, 0x12, 0x30, 0x0a, 0x04, 0x70, 0x65, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1c, 0x2e, 0x77, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x2e, 0x69, 0x6d, 0x2e, 0x70, 0x72, 0x6f,
-	0x76, 0x69, 0x64, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x65, 0x65, 0x72, 0x52, 0x04, 0x70,
-	0x65, 0x65, 0x72, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x61, 0x67, 0x65, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a,
-	0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x48, 0x00, 0x52, 0x04, 0x77, 0x61, 0x62, 0x61, 0x42, 0x06, 0x0a, 0x04, 0x67, 0x61, 0x74, 0x65,
+	0x22, 0xb0, 0x02, 0x0a, 0x17, 0x57, 0x68, 0x61, 0x74, 0x73, 0x41, 0x70, 0x70, 0x42, 0x75, 0x73,
+	0x69, 0x6e, 0x65, 0x73, 0x73, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1e, 0x0a, 0x0b,
+	0x6d, 0x65, 0x74, 0x61, 0x5f, 0x61, 0x70, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x6d, 0x65, 0x74, 0x61, 0x41, 0x70, 0x70, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c,
+	0x70, 0x68, 0x6f, 0x6e, 0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12,
+	0x26, 0x0a, 0x0f, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x5f,
+	0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x4e,
+	0x75, 0x6d, 0x62, 0x65, 0x72, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x3a, 0x0a, 0x17, 0x61, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x65, 0x78, 0x70, 0x69, 0x72,
+	0x65, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x48, 0x00, 0x52, 0x14, 0x61,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65,
+	0x73, 0x41, 0x74, 0x88, 0x01, 0x01, 0x12, 0x1f, 0x0a, 0x0b, 0x62, 0x75, 0x73, 0x69, 0x6e, 0x65,
+	0x73, 0x73, 0x5f, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x62, 0x75, 0x73,
+	0x69, 0x6e, 0x65, 0x73, 0x73, 0x49, 0x64, 0x42, 0x1a, 0x0a, 0x18, 0x5f, 0x61, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73,
+	0x5f, 0x61, 0x74, 0x22, 0xdb, 0x01, 0x0a, 0x21, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x46, 0x61, 0x63, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x47, 0x61,
+	0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a,
+	0x0b, 0x6d, 0x65, 0x74, 0x61, 0x5f, 0x61, 0x70, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x6d, 0x65, 0x74, 0x61, 0x41, 0x70, 0x70, 0x49, 0x64, 0x12, 0x30, 0x0a,
+	0x04, 0x70, 0x65, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x77, 0x65,
+	0x62, 0x69, 0x74, 0x65, 0x6c, 0x2e, 0x69, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65,
+	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x65, 0x65, 0x72, 0x52, 0x04, 0x70, 0x65, 0x65, 0x72, 0x12,
+	0x17, 0x0a, 0x07, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x70, 0x61, 0x67, 0x65, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65,
+	0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61,
+	0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c,
+	0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65,
+	0x64, 0x22, 0x66, 0x0a, 0x22, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x46, 0x61, 0x63, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x47, 0x61, 0x74, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x40, 0x0a, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x77, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x2e,
+	0x69, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50,
+	0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x46, 0x61, 0x63, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x47,
+	0x61, 0x74, 0x65, 0x52, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x22, 0x30, 0x0a, 0x1e, 0x50, 0x72, 0x6f,
+	0x76, 0x69, 0x64, 0x65, 0x72, 0x47, 0x65, 0x74, 0x46, 0x61, 0x63, 0x65, 0x62, 0x6f, 0x6f, 0x6b,
+	0x47, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x63, 0x0a, 0x1f, 0x50,
+	0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x47, 0x65, 0x74, 0x46, 0x61, 0x63, 0x65, 0x62, 0x6f,
+	0x6f, 0x6b, 0x47, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x40,
+	0x0a, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x77,
+	0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x2e, 0x69, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64,
+	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x46, 0x61,
+	0x63, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x47, 0x61, 0x74, 0x65, 0x52, 0x04, 0x69, 0x74, 0x65, 0x6d,
+	0x22, 0xb2, 0x01, 0x0a, 0x21, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x46, 0x61, 0x63, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x47, 0x61, 0x74, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x30, 0x0a, 0x04, 0x70, 0x65,
+	0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x77, 0x65, 0x62, 0x69, 0x74,
+	0x65, 0x6c, 0x2e, 0x69, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x2e, 0x76,
+	0x31, 0x2e, 0x50, 0x65, 0x65, 0x72, 0x52, 0x04, 0x70, 0x65, 0x65, 0x72, 0x12, 0x1d, 0x0a, 0x0a,
+	0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
 	0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x65,
-	0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e,
+	0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e,
 	0x61, 0x62, 0x6c, 0x65, 0x64, 0x22, 0x66, 0x0a, 0x22, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65,
-	0x72, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x46, 0x61, 0x63, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x47,
+	0x72, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x46, 0x61, 0x63, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x47,
 	0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x40, 0x0a, 0x04, 0x69,
 	0x74, 0x65, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x77, 0x65, 0x62, 0x69,
 	0x74, 0x65, 0x6c, 0x2e, 0x69, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x2e,
 	0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x46, 0x61, 0x63, 0x65, 0x62,
-	0x6f, 0x6f, 0x6b, 0x47, 0x61, 0x74, 0x65, 0x52, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x22, 0x30, 0x0a,
-	0x1e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x47, 0x65, 0x74, 0x46, 0x61, 0x63, 0x65,
-	0x62, 0x6f, 0x6f, 0x6b, 0x47, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22,
-	0x63, 0x0a, 0x1f, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x47, 0x65, 0x74, 0x46, 0x61,
-	0x63, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x47, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x40, 0x0a, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x2c, 0x2e, 0x77, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x2e, 0x69, 0x6d, 0x2e, 0x70, 0x72,
-	0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64,
-	0x65, 0x72, 0x46, 0x61, 0x63, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x47, 0x61, 0x74, 0x65, 0x52, 0x04,
-	0x69, 0x74, 0x65, 0x6d, 0x22, 0xb2, 0x01, 0x0a, 0x21, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65,
-	0x72, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x46, 0x61, 0x63, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x47,
-	0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
-	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x30,
-	0x0a, 0x04, 0x70, 0x65, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x77,
+	0x6f, 0x6f, 0x6b, 0x47, 0x61, 0x74, 0x65, 0x52, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x22, 0x33, 0x0a,
+	0x21, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x46,
+	0x61, 0x63, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x47, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
+	0x69, 0x64, 0x22, 0x66, 0x0a, 0x22, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x46, 0x61, 0x63, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x47, 0x61, 0x74, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x40, 0x0a, 0x04, 0x69, 0x74, 0x65, 0x6d,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x77, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c,
+	0x2e, 0x69, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e,
+	0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x46, 0x61, 0x63, 0x65, 0x62, 0x6f, 0x6f, 0x6b,
+	0x47, 0x61, 0x74, 0x65, 0x52, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x22, 0xbb, 0x01, 0x0a, 0x21, 0x50,
+	0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x57, 0x68, 0x61,
+	0x74, 0x73, 0x41, 0x70, 0x70, 0x47, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0b, 0x6d, 0x65, 0x74, 0x61, 0x5f, 0x61, 0x70, 0x70,
+	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6d, 0x65, 0x74, 0x61, 0x41,
+	0x70, 0x70, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x77, 0x61, 0x62, 0x61, 0x5f, 0x69, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x77, 0x61, 0x62, 0x61, 0x49, 0x64, 0x12, 0x26, 0x0a,
+	0x0f, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x5f, 0x69, 0x64,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x4e, 0x75, 0x6d,
+	0x62, 0x65, 0x72, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x5f,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x66, 0x0a, 0x22, 0x50, 0x72, 0x6f, 0x76,
+	0x69, 0x64, 0x65, 0x72, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x57, 0x68, 0x61, 0x74, 0x73, 0x41,
+	0x70, 0x70, 0x47, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x40,
+	0x0a, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x77,
 	0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x2e, 0x69, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64,
-	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x65, 0x65, 0x72, 0x52, 0x04, 0x70, 0x65, 0x65, 0x72,
-	0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12,
-	0x18, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x22, 0x66, 0x0a, 0x22, 0x50, 0x72, 0x6f,
-	0x76, 0x69, 0x64, 0x65, 0x72, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x46, 0x61, 0x63, 0x65, 0x62,
-	0x6f, 0x6f, 0x6b, 0x47, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x40, 0x0a, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e,
-	0x77, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x2e, 0x69, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69,
-	0x64, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x46,
-	0x61, 0x63, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x47, 0x61, 0x74, 0x65, 0x52, 0x04, 0x69, 0x74, 0x65,
-	0x6d, 0x22, 0x33, 0x0a, 0x21, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x44, 0x65, 0x6c,
-	0x65, 0x74, 0x65, 0x46, 0x61, 0x63, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x47, 0x61, 0x74, 0x65, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x66, 0x0a, 0x22, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64,
-	0x65, 0x72, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x46, 0x61, 0x63, 0x65, 0x62, 0x6f, 0x6f, 0x6b,
-	0x47, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x40, 0x0a, 0x04,
-	0x69, 0x74, 0x65, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x77, 0x65, 0x62,
-	0x69, 0x74, 0x65, 0x6c, 0x2e, 0x69, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
-	0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x46, 0x61, 0x63, 0x65,
-	0x62, 0x6f, 0x6f, 0x6b, 0x47, 0x61, 0x74, 0x65, 0x52, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x22, 0xbb,
-	0x01, 0x0a, 0x21, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x57, 0x68, 0x61, 0x74, 0x73, 0x41, 0x70, 0x70, 0x47, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0b, 0x6d, 0x65, 0x74, 0x61,
-	0x5f, 0x61, 0x70, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6d,
-	0x65, 0x74, 0x61, 0x41, 0x70, 0x70, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x77, 0x61, 0x62, 0x61,
-	0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x77, 0x61, 0x62, 0x61, 0x49,
-	0x64, 0x12, 0x26, 0x0a, 0x0f, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65,
-	0x72, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x70, 0x68, 0x6f, 0x6e,
-	0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x63, 0x63,
-	0x65, 0x73, 0x73, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0b, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x66, 0x0a, 0x22,
-	0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x57, 0x68,
-	0x61, 0x74, 0x73, 0x41, 0x70, 0x70, 0x47, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x40, 0x0a, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x2c, 0x2e, 0x77, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x2e, 0x69, 0x6d, 0x2e, 0x70, 0x72,
-	0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64,
-	0x65, 0x72, 0x57, 0x68, 0x61, 0x74, 0x73, 0x41, 0x70, 0x70, 0x47, 0x61, 0x74, 0x65, 0x52, 0x04,
-	0x69, 0x74, 0x65, 0x6d, 0x22, 0x30, 0x0a, 0x1e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
-	0x47, 0x65, 0x74, 0x57, 0x68, 0x61, 0x74, 0x73, 0x41, 0x70, 0x70, 0x47, 0x61, 0x74, 0x65, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x63, 0x0a, 0x1f, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64,
-	0x65, 0x72, 0x47, 0x65, 0x74, 0x57, 0x68, 0x61, 0x74, 0x73, 0x41, 0x70, 0x70, 0x47, 0x61, 0x74,
+	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x57, 0x68,
+	0x61, 0x74, 0x73, 0x41, 0x70, 0x70, 0x47, 0x61, 0x74, 0x65, 0x52, 0x04, 0x69, 0x74, 0x65, 0x6d,
+	0x22, 0x30, 0x0a, 0x1e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x47, 0x65, 0x74, 0x57,
+	0x68, 0x61, 0x74, 0x73, 0x41, 0x70, 0x70, 0x47, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
+	0x69, 0x64, 0x22, 0x63, 0x0a, 0x1f, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x47, 0x65,
+	0x74, 0x57, 0x68, 0x61, 0x74, 0x73, 0x41, 0x70, 0x70, 0x47, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x40, 0x0a, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x77, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x2e, 0x69, 0x6d,
+	0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f,
+	0x76, 0x69, 0x64, 0x65, 0x72, 0x57, 0x68, 0x61, 0x74, 0x73, 0x41, 0x70, 0x70, 0x47, 0x61, 0x74,
+	0x65, 0x52, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x22, 0x6a, 0x0a, 0x21, 0x50, 0x72, 0x6f, 0x76, 0x69,
+	0x64, 0x65, 0x72, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x57, 0x68, 0x61, 0x74, 0x73, 0x41, 0x70,
+	0x70, 0x47, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x21, 0x0a, 0x0c, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x22, 0x66, 0x0a, 0x22, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x57, 0x68, 0x61, 0x74, 0x73, 0x41, 0x70, 0x70, 0x47, 0x61, 0x74,
 	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x40, 0x0a, 0x04, 0x69, 0x74, 0x65,
 	0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x77, 0x65, 0x62, 0x69, 0x74, 0x65,
 	0x6c, 0x2e, 0x69, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x2e, 0x76, 0x31,
 	0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x57, 0x68, 0x61, 0x74, 0x73, 0x41, 0x70,
-	0x70, 0x47, 0x61, 0x74, 0x65, 0x52, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x22, 0x6a, 0x0a, 0x21, 0x50,
-	0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x57, 0x68, 0x61,
+	0x70, 0x47, 0x61, 0x74, 0x65, 0x52, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x22, 0x33, 0x0a, 0x21, 0x50,
+	0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x57, 0x68, 0x61,
 	0x74, 0x73, 0x41, 0x70, 0x70, 0x47, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
 	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
-	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x74,
-	0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x65,
-	0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x66, 0x0a, 0x22, 0x50, 0x72, 0x6f, 0x76, 0x69,
-	0x64, 0x65, 0x72, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x57, 0x68, 0x61, 0x74, 0x73, 0x41, 0x70,
-	0x70, 0x47, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x40, 0x0a,
-	0x04, 0x69, 0x74, 0x65, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x77, 0x65,
-	0x62, 0x69, 0x74, 0x65, 0x6c, 0x2e, 0x69, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65,
-	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x57, 0x68, 0x61,
-	0x74, 0x73, 0x41, 0x70, 0x70, 0x47, 0x61, 0x74, 0x65, 0x52, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x22,
-	0x33, 0x0a, 0x21, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x44, 0x65, 0x6c, 0x65, 0x74,
-	0x65, 0x57, 0x68, 0x61, 0x74, 0x73, 0x41, 0x70, 0x70, 0x47, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x02, 0x69, 0x64, 0x22, 0x66, 0x0a, 0x22, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
-	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x57, 0x68, 0x61, 0x74, 0x73, 0x41, 0x70, 0x70, 0x47, 0x61,
-	0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x40, 0x0a, 0x04, 0x69, 0x74,
-	0x65, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x77, 0x65, 0x62, 0x69, 0x74,
-	0x65, 0x6c, 0x2e, 0x69, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x2e, 0x76,
-	0x31, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x57, 0x68, 0x61, 0x74, 0x73, 0x41,
-	0x70, 0x70, 0x47, 0x61, 0x74, 0x65, 0x52, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x22, 0xcc, 0x01, 0x0a,
-	0x18, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x47, 0x61, 0x74,
-	0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x12, 0x0a,
-	0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x73, 0x69, 0x7a,
-	0x65, 0x12, 0x3a, 0x0a, 0x05, 0x74, 0x79, 0x70, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0e,
-	0x32, 0x24, 0x2e, 0x77, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x2e, 0x69, 0x6d, 0x2e, 0x70, 0x72,
-	0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64,
-	0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x52, 0x05, 0x74, 0x79, 0x70, 0x65, 0x73, 0x12, 0x3e, 0x0a,
-	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x26, 0x2e,
-	0x77, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x2e, 0x69, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69,
-	0x64, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x53,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x0c, 0x0a,
-	0x01, 0x71, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x01, 0x71, 0x22, 0x96, 0x01, 0x0a, 0x19,
-	0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x47, 0x61, 0x74, 0x65,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3d, 0x0a, 0x05, 0x69, 0x74, 0x65,
-	0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x77, 0x65, 0x62, 0x69, 0x74,
-	0x65, 0x6c, 0x2e, 0x69, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x2e, 0x76,
-	0x31, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72,
-	0x79, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x12, 0x0a, 0x04,
-	0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65,
-	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x65, 0x78, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04,
-	0x6e, 0x65, 0x78, 0x74, 0x42, 0xe0, 0x01, 0x0a, 0x1a, 0x63, 0x6f, 0x6d, 0x2e, 0x77, 0x65, 0x62,
-	0x69, 0x74, 0x65, 0x6c, 0x2e, 0x69, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
-	0x2e, 0x76, 0x31, 0x42, 0x0d, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x50, 0x72, 0x6f,
-	0x74, 0x6f, 0x50, 0x01, 0x5a, 0x38, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
-	0x2f, 0x77, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2f,
-	0x67, 0x65, 0x6e, 0x2f, 0x67, 0x6f, 0x2f, 0x69, 0x6d, 0x2f, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64,
-	0x65, 0x72, 0x2f, 0x76, 0x31, 0x3b, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0xa2, 0x02,
-	0x03, 0x57, 0x49, 0x50, 0xaa, 0x02, 0x16, 0x57, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x2e, 0x49,
-	0x6d, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x16,
-	0x57, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x5c, 0x49, 0x6d, 0x5c, 0x50, 0x72, 0x6f, 0x76, 0x69,
-	0x64, 0x65, 0x72, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x22, 0x57, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c,
-	0x5c, 0x49, 0x6d, 0x5c, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x5c, 0x56, 0x31, 0x5c,
-	0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x19, 0x57, 0x65,
-	0x62, 0x69, 0x74, 0x65, 0x6c, 0x3a, 0x3a, 0x49, 0x6d, 0x3a, 0x3a, 0x50, 0x72, 0x6f, 0x76, 0x69,
-	0x64, 0x65, 0x72, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x22, 0x66, 0x0a, 0x22, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x57, 0x68, 0x61, 0x74, 0x73, 0x41, 0x70, 0x70, 0x47, 0x61, 0x74, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x40, 0x0a, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x77, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x2e, 0x69,
+	0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72,
+	0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x57, 0x68, 0x61, 0x74, 0x73, 0x41, 0x70, 0x70, 0x47, 0x61,
+	0x74, 0x65, 0x52, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x22, 0xcc, 0x01, 0x0a, 0x18, 0x50, 0x72, 0x6f,
+	0x76, 0x69, 0x64, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x47, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x3a, 0x0a,
+	0x05, 0x74, 0x79, 0x70, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x24, 0x2e, 0x77,
+	0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x2e, 0x69, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64,
+	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x54, 0x79,
+	0x70, 0x65, 0x52, 0x05, 0x74, 0x79, 0x70, 0x65, 0x73, 0x12, 0x3e, 0x0a, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x26, 0x2e, 0x77, 0x65, 0x62, 0x69,
+	0x74, 0x65, 0x6c, 0x2e, 0x69, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x2e,
+	0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x0c, 0x0a, 0x01, 0x71, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x01, 0x71, 0x22, 0x96, 0x01, 0x0a, 0x19, 0x50, 0x72, 0x6f, 0x76,
+	0x69, 0x64, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x47, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3d, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x77, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x2e, 0x69,
+	0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72,
+	0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x05, 0x69,
+	0x74, 0x65, 0x6d, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x65, 0x78, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x6e, 0x65, 0x78, 0x74,
+	0x42, 0xe0, 0x01, 0x0a, 0x1a, 0x63, 0x6f, 0x6d, 0x2e, 0x77, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c,
+	0x2e, 0x69, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x42,
+	0x0d, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01,
+	0x5a, 0x38, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x77, 0x65, 0x62,
+	0x69, 0x74, 0x65, 0x6c, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2f, 0x67, 0x65, 0x6e, 0x2f,
+	0x67, 0x6f, 0x2f, 0x69, 0x6d, 0x2f, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x2f, 0x76,
+	0x31, 0x3b, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0xa2, 0x02, 0x03, 0x57, 0x49, 0x50,
+	0xaa, 0x02, 0x16, 0x57, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x2e, 0x49, 0x6d, 0x2e, 0x50, 0x72,
+	0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x16, 0x57, 0x65, 0x62, 0x69,
+	0x74, 0x65, 0x6c, 0x5c, 0x49, 0x6d, 0x5c, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x5c,
+	0x56, 0x31, 0xe2, 0x02, 0x22, 0x57, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x5c, 0x49, 0x6d, 0x5c,
+	0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x19, 0x57, 0x65, 0x62, 0x69, 0x74, 0x65,
+	0x6c, 0x3a, 0x3a, 0x49, 0x6d, 0x3a, 0x3a, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x3a,
+	0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -2646,7 +2744,7 @@ func file_service_provider_v1_messages_proto_rawDescGZIP() []byte {
 	return file_service_provider_v1_messages_proto_rawDescData
 }
 
-var file_service_provider_v1_messages_proto_msgTypes = make([]protoimpl.MessageInfo, 36)
+var file_service_provider_v1_messages_proto_msgTypes = make([]protoimpl.MessageInfo, 37)
 var file_service_provider_v1_messages_proto_goTypes = []interface{}{
 	(*Peer)(nil),                               // 0: webitel.im.provider.v1.Peer
 	(*ProviderCreateMetaAppRequest)(nil),       // 1: webitel.im.provider.v1.ProviderCreateMetaAppRequest
@@ -2658,68 +2756,71 @@ var file_service_provider_v1_messages_proto_goTypes = []interface{}{
 	(*ProviderDeleteMetaAppRequest)(nil),       // 7: webitel.im.provider.v1.ProviderDeleteMetaAppRequest
 	(*ProviderDeleteMetaAppResponse)(nil),      // 8: webitel.im.provider.v1.ProviderDeleteMetaAppResponse
 	(*ProviderMetaOAuthStartRequest)(nil),      // 9: webitel.im.provider.v1.ProviderMetaOAuthStartRequest
-	(*ProviderMetaOAuthStartResponse)(nil),     // 10: webitel.im.provider.v1.ProviderMetaOAuthStartResponse
-	(*ProviderMetaOAuthCallbackRequest)(nil),   // 11: webitel.im.provider.v1.ProviderMetaOAuthCallbackRequest
-	(*ProviderMetaOAuthCallbackResponse)(nil),  // 12: webitel.im.provider.v1.ProviderMetaOAuthCallbackResponse
-	(*ProviderMetaLinkedPage)(nil),             // 13: webitel.im.provider.v1.ProviderMetaLinkedPage
-	(*CreateGateRequest)(nil),                  // 14: webitel.im.provider.v1.CreateGateRequest
-	(*CreateWABAGateRequest)(nil),              // 15: webitel.im.provider.v1.CreateWABAGateRequest
-	(*GateResponse)(nil),                       // 16: webitel.im.provider.v1.GateResponse
-	(*WhatsAppBusinessAccount)(nil),            // 17: webitel.im.provider.v1.WhatsAppBusinessAccount
-	(*ProviderCreateFacebookGateRequest)(nil),  // 18: webitel.im.provider.v1.ProviderCreateFacebookGateRequest
-	(*ProviderCreateFacebookGateResponse)(nil), // 19: webitel.im.provider.v1.ProviderCreateFacebookGateResponse
-	(*ProviderGetFacebookGateRequest)(nil),     // 20: webitel.im.provider.v1.ProviderGetFacebookGateRequest
-	(*ProviderGetFacebookGateResponse)(nil),    // 21: webitel.im.provider.v1.ProviderGetFacebookGateResponse
-	(*ProviderUpdateFacebookGateRequest)(nil),  // 22: webitel.im.provider.v1.ProviderUpdateFacebookGateRequest
-	(*ProviderUpdateFacebookGateResponse)(nil), // 23: webitel.im.provider.v1.ProviderUpdateFacebookGateResponse
-	(*ProviderDeleteFacebookGateRequest)(nil),  // 24: webitel.im.provider.v1.ProviderDeleteFacebookGateRequest
-	(*ProviderDeleteFacebookGateResponse)(nil), // 25: webitel.im.provider.v1.ProviderDeleteFacebookGateResponse
-	(*ProviderCreateWhatsAppGateRequest)(nil),  // 26: webitel.im.provider.v1.ProviderCreateWhatsAppGateRequest
-	(*ProviderCreateWhatsAppGateResponse)(nil), // 27: webitel.im.provider.v1.ProviderCreateWhatsAppGateResponse
-	(*ProviderGetWhatsAppGateRequest)(nil),     // 28: webitel.im.provider.v1.ProviderGetWhatsAppGateRequest
-	(*ProviderGetWhatsAppGateResponse)(nil),    // 29: webitel.im.provider.v1.ProviderGetWhatsAppGateResponse
-	(*ProviderUpdateWhatsAppGateRequest)(nil),  // 30: webitel.im.provider.v1.ProviderUpdateWhatsAppGateRequest
-	(*ProviderUpdateWhatsAppGateResponse)(nil), // 31: webitel.im.provider.v1.ProviderUpdateWhatsAppGateResponse
-	(*ProviderDeleteWhatsAppGateRequest)(nil),  // 32: webitel.im.provider.v1.ProviderDeleteWhatsAppGateRequest
-	(*ProviderDeleteWhatsAppGateResponse)(nil), // 33: webitel.im.provider.v1.ProviderDeleteWhatsAppGateResponse
-	(*ProviderListGatesRequest)(nil),           // 34: webitel.im.provider.v1.ProviderListGatesRequest
-	(*ProviderListGatesResponse)(nil),          // 35: webitel.im.provider.v1.ProviderListGatesResponse
-	(*ProviderMetaApp)(nil),                    // 36: webitel.im.provider.v1.ProviderMetaApp
-	(*ProviderFacebookGate)(nil),               // 37: webitel.im.provider.v1.ProviderFacebookGate
-	(*ProviderWhatsAppGate)(nil),               // 38: webitel.im.provider.v1.ProviderWhatsAppGate
-	(ProviderType)(0),                          // 39: webitel.im.provider.v1.ProviderType
-	(ProviderStatus)(0),                        // 40: webitel.im.provider.v1.ProviderStatus
-	(*ProviderSummary)(nil),                    // 41: webitel.im.provider.v1.ProviderSummary
+	(*ProviderMetaOAuthProvision)(nil),         // 10: webitel.im.provider.v1.ProviderMetaOAuthProvision
+	(*ProviderMetaOAuthStartResponse)(nil),     // 11: webitel.im.provider.v1.ProviderMetaOAuthStartResponse
+	(*ProviderMetaOAuthCallbackRequest)(nil),   // 12: webitel.im.provider.v1.ProviderMetaOAuthCallbackRequest
+	(*ProviderMetaOAuthCallbackResponse)(nil),  // 13: webitel.im.provider.v1.ProviderMetaOAuthCallbackResponse
+	(*ProviderMetaLinkedPage)(nil),             // 14: webitel.im.provider.v1.ProviderMetaLinkedPage
+	(*CreateGateRequest)(nil),                  // 15: webitel.im.provider.v1.CreateGateRequest
+	(*CreateWABAGateRequest)(nil),              // 16: webitel.im.provider.v1.CreateWABAGateRequest
+	(*GateResponse)(nil),                       // 17: webitel.im.provider.v1.GateResponse
+	(*WhatsAppBusinessAccount)(nil),            // 18: webitel.im.provider.v1.WhatsAppBusinessAccount
+	(*ProviderCreateFacebookGateRequest)(nil),  // 19: webitel.im.provider.v1.ProviderCreateFacebookGateRequest
+	(*ProviderCreateFacebookGateResponse)(nil), // 20: webitel.im.provider.v1.ProviderCreateFacebookGateResponse
+	(*ProviderGetFacebookGateRequest)(nil),     // 21: webitel.im.provider.v1.ProviderGetFacebookGateRequest
+	(*ProviderGetFacebookGateResponse)(nil),    // 22: webitel.im.provider.v1.ProviderGetFacebookGateResponse
+	(*ProviderUpdateFacebookGateRequest)(nil),  // 23: webitel.im.provider.v1.ProviderUpdateFacebookGateRequest
+	(*ProviderUpdateFacebookGateResponse)(nil), // 24: webitel.im.provider.v1.ProviderUpdateFacebookGateResponse
+	(*ProviderDeleteFacebookGateRequest)(nil),  // 25: webitel.im.provider.v1.ProviderDeleteFacebookGateRequest
+	(*ProviderDeleteFacebookGateResponse)(nil), // 26: webitel.im.provider.v1.ProviderDeleteFacebookGateResponse
+	(*ProviderCreateWhatsAppGateRequest)(nil),  // 27: webitel.im.provider.v1.ProviderCreateWhatsAppGateRequest
+	(*ProviderCreateWhatsAppGateResponse)(nil), // 28: webitel.im.provider.v1.ProviderCreateWhatsAppGateResponse
+	(*ProviderGetWhatsAppGateRequest)(nil),     // 29: webitel.im.provider.v1.ProviderGetWhatsAppGateRequest
+	(*ProviderGetWhatsAppGateResponse)(nil),    // 30: webitel.im.provider.v1.ProviderGetWhatsAppGateResponse
+	(*ProviderUpdateWhatsAppGateRequest)(nil),  // 31: webitel.im.provider.v1.ProviderUpdateWhatsAppGateRequest
+	(*ProviderUpdateWhatsAppGateResponse)(nil), // 32: webitel.im.provider.v1.ProviderUpdateWhatsAppGateResponse
+	(*ProviderDeleteWhatsAppGateRequest)(nil),  // 33: webitel.im.provider.v1.ProviderDeleteWhatsAppGateRequest
+	(*ProviderDeleteWhatsAppGateResponse)(nil), // 34: webitel.im.provider.v1.ProviderDeleteWhatsAppGateResponse
+	(*ProviderListGatesRequest)(nil),           // 35: webitel.im.provider.v1.ProviderListGatesRequest
+	(*ProviderListGatesResponse)(nil),          // 36: webitel.im.provider.v1.ProviderListGatesResponse
+	(*ProviderMetaApp)(nil),                    // 37: webitel.im.provider.v1.ProviderMetaApp
+	(*ProviderFacebookGate)(nil),               // 38: webitel.im.provider.v1.ProviderFacebookGate
+	(*ProviderWhatsAppGate)(nil),               // 39: webitel.im.provider.v1.ProviderWhatsAppGate
+	(ProviderType)(0),                          // 40: webitel.im.provider.v1.ProviderType
+	(ProviderStatus)(0),                        // 41: webitel.im.provider.v1.ProviderStatus
+	(*ProviderSummary)(nil),                    // 42: webitel.im.provider.v1.ProviderSummary
 }
 var file_service_provider_v1_messages_proto_depIdxs = []int32{
-	36, // 0: webitel.im.provider.v1.ProviderCreateMetaAppResponse.item:type_name -> webitel.im.provider.v1.ProviderMetaApp
-	36, // 1: webitel.im.provider.v1.ProviderGetMetaAppResponse.item:type_name -> webitel.im.provider.v1.ProviderMetaApp
-	36, // 2: webitel.im.provider.v1.ProviderUpdateMetaAppResponse.item:type_name -> webitel.im.provider.v1.ProviderMetaApp
-	36, // 3: webitel.im.provider.v1.ProviderDeleteMetaAppResponse.item:type_name -> webitel.im.provider.v1.ProviderMetaApp
-	13, // 4: webitel.im.provider.v1.ProviderMetaOAuthCallbackResponse.pages:type_name -> webitel.im.provider.v1.ProviderMetaLinkedPage
-	0,  // 5: webitel.im.provider.v1.CreateGateRequest.bot:type_name -> webitel.im.provider.v1.Peer
-	15, // 6: webitel.im.provider.v1.CreateGateRequest.waba:type_name -> webitel.im.provider.v1.CreateWABAGateRequest
-	18, // 7: webitel.im.provider.v1.CreateGateRequest.fb:type_name -> webitel.im.provider.v1.ProviderCreateFacebookGateRequest
-	0,  // 8: webitel.im.provider.v1.GateResponse.bot:type_name -> webitel.im.provider.v1.Peer
-	17, // 9: webitel.im.provider.v1.GateResponse.waba:type_name -> webitel.im.provider.v1.WhatsAppBusinessAccount
-	0,  // 10: webitel.im.provider.v1.ProviderCreateFacebookGateRequest.peer:type_name -> webitel.im.provider.v1.Peer
-	37, // 11: webitel.im.provider.v1.ProviderCreateFacebookGateResponse.item:type_name -> webitel.im.provider.v1.ProviderFacebookGate
-	37, // 12: webitel.im.provider.v1.ProviderGetFacebookGateResponse.item:type_name -> webitel.im.provider.v1.ProviderFacebookGate
-	0,  // 13: webitel.im.provider.v1.ProviderUpdateFacebookGateRequest.peer:type_name -> webitel.im.provider.v1.Peer
-	37, // 14: webitel.im.provider.v1.ProviderUpdateFacebookGateResponse.item:type_name -> webitel.im.provider.v1.ProviderFacebookGate
-	37, // 15: webitel.im.provider.v1.ProviderDeleteFacebookGateResponse.item:type_name -> webitel.im.provider.v1.ProviderFacebookGate
-	38, // 16: webitel.im.provider.v1.ProviderCreateWhatsAppGateResponse.item:type_name -> webitel.im.provider.v1.ProviderWhatsAppGate
-	38, // 17: webitel.im.provider.v1.ProviderGetWhatsAppGateResponse.item:type_name -> webitel.im.provider.v1.ProviderWhatsAppGate
-	38, // 18: webitel.im.provider.v1.ProviderUpdateWhatsAppGateResponse.item:type_name -> webitel.im.provider.v1.ProviderWhatsAppGate
-	38, // 19: webitel.im.provider.v1.ProviderDeleteWhatsAppGateResponse.item:type_name -> webitel.im.provider.v1.ProviderWhatsAppGate
-	39, // 20: webitel.im.provider.v1.ProviderListGatesRequest.types:type_name -> webitel.im.provider.v1.ProviderType
-	40, // 21: webitel.im.provider.v1.ProviderListGatesRequest.status:type_name -> webitel.im.provider.v1.ProviderStatus
-	41, // 22: webitel.im.provider.v1.ProviderListGatesResponse.items:type_name -> webitel.im.provider.v1.ProviderSummary
-	23, // [23:23] is the sub-list for method output_type
-	23, // [23:23] is the sub-list for method input_type
-	23, // [23:23] is the sub-list for extension type_name
-	23, // [23:23] is the sub-list for extension extendee
-	0,  // [0:23] is the sub-list for field type_name
+	37, // 0: webitel.im.provider.v1.ProviderCreateMetaAppResponse.item:type_name -> webitel.im.provider.v1.ProviderMetaApp
+	37, // 1: webitel.im.provider.v1.ProviderGetMetaAppResponse.item:type_name -> webitel.im.provider.v1.ProviderMetaApp
+	37, // 2: webitel.im.provider.v1.ProviderUpdateMetaAppResponse.item:type_name -> webitel.im.provider.v1.ProviderMetaApp
+	37, // 3: webitel.im.provider.v1.ProviderDeleteMetaAppResponse.item:type_name -> webitel.im.provider.v1.ProviderMetaApp
+	10, // 4: webitel.im.provider.v1.ProviderMetaOAuthStartRequest.provision:type_name -> webitel.im.provider.v1.ProviderMetaOAuthProvision
+	0,  // 5: webitel.im.provider.v1.ProviderMetaOAuthProvision.peer:type_name -> webitel.im.provider.v1.Peer
+	14, // 6: webitel.im.provider.v1.ProviderMetaOAuthCallbackResponse.pages:type_name -> webitel.im.provider.v1.ProviderMetaLinkedPage
+	0,  // 7: webitel.im.provider.v1.CreateGateRequest.bot:type_name -> webitel.im.provider.v1.Peer
+	16, // 8: webitel.im.provider.v1.CreateGateRequest.waba:type_name -> webitel.im.provider.v1.CreateWABAGateRequest
+	19, // 9: webitel.im.provider.v1.CreateGateRequest.fb:type_name -> webitel.im.provider.v1.ProviderCreateFacebookGateRequest
+	0,  // 10: webitel.im.provider.v1.GateResponse.bot:type_name -> webitel.im.provider.v1.Peer
+	18, // 11: webitel.im.provider.v1.GateResponse.waba:type_name -> webitel.im.provider.v1.WhatsAppBusinessAccount
+	0,  // 12: webitel.im.provider.v1.ProviderCreateFacebookGateRequest.peer:type_name -> webitel.im.provider.v1.Peer
+	38, // 13: webitel.im.provider.v1.ProviderCreateFacebookGateResponse.item:type_name -> webitel.im.provider.v1.ProviderFacebookGate
+	38, // 14: webitel.im.provider.v1.ProviderGetFacebookGateResponse.item:type_name -> webitel.im.provider.v1.ProviderFacebookGate
+	0,  // 15: webitel.im.provider.v1.ProviderUpdateFacebookGateRequest.peer:type_name -> webitel.im.provider.v1.Peer
+	38, // 16: webitel.im.provider.v1.ProviderUpdateFacebookGateResponse.item:type_name -> webitel.im.provider.v1.ProviderFacebookGate
+	38, // 17: webitel.im.provider.v1.ProviderDeleteFacebookGateResponse.item:type_name -> webitel.im.provider.v1.ProviderFacebookGate
+	39, // 18: webitel.im.provider.v1.ProviderCreateWhatsAppGateResponse.item:type_name -> webitel.im.provider.v1.ProviderWhatsAppGate
+	39, // 19: webitel.im.provider.v1.ProviderGetWhatsAppGateResponse.item:type_name -> webitel.im.provider.v1.ProviderWhatsAppGate
+	39, // 20: webitel.im.provider.v1.ProviderUpdateWhatsAppGateResponse.item:type_name -> webitel.im.provider.v1.ProviderWhatsAppGate
+	39, // 21: webitel.im.provider.v1.ProviderDeleteWhatsAppGateResponse.item:type_name -> webitel.im.provider.v1.ProviderWhatsAppGate
+	40, // 22: webitel.im.provider.v1.ProviderListGatesRequest.types:type_name -> webitel.im.provider.v1.ProviderType
+	41, // 23: webitel.im.provider.v1.ProviderListGatesRequest.status:type_name -> webitel.im.provider.v1.ProviderStatus
+	42, // 24: webitel.im.provider.v1.ProviderListGatesResponse.items:type_name -> webitel.im.provider.v1.ProviderSummary
+	25, // [25:25] is the sub-list for method output_type
+	25, // [25:25] is the sub-list for method input_type
+	25, // [25:25] is the sub-list for extension type_name
+	25, // [25:25] is the sub-list for extension extendee
+	0,  // [0:25] is the sub-list for field type_name
 }
 
 func init() { file_service_provider_v1_messages_proto_init() }
@@ -2851,7 +2952,7 @@ func file_service_provider_v1_messages_proto_init() {
 			}
 		}
 		file_service_provider_v1_messages_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProviderMetaOAuthStartResponse); i {
+			switch v := v.(*ProviderMetaOAuthProvision); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2863,7 +2964,7 @@ func file_service_provider_v1_messages_proto_init() {
 			}
 		}
 		file_service_provider_v1_messages_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProviderMetaOAuthCallbackRequest); i {
+			switch v := v.(*ProviderMetaOAuthStartResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2875,7 +2976,7 @@ func file_service_provider_v1_messages_proto_init() {
 			}
 		}
 		file_service_provider_v1_messages_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProviderMetaOAuthCallbackResponse); i {
+			switch v := v.(*ProviderMetaOAuthCallbackRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2887,7 +2988,7 @@ func file_service_provider_v1_messages_proto_init() {
 			}
 		}
 		file_service_provider_v1_messages_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProviderMetaLinkedPage); i {
+			switch v := v.(*ProviderMetaOAuthCallbackResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2899,7 +3000,7 @@ func file_service_provider_v1_messages_proto_init() {
 			}
 		}
 		file_service_provider_v1_messages_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateGateRequest); i {
+			switch v := v.(*ProviderMetaLinkedPage); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2911,7 +3012,7 @@ func file_service_provider_v1_messages_proto_init() {
 			}
 		}
 		file_service_provider_v1_messages_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateWABAGateRequest); i {
+			switch v := v.(*CreateGateRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2923,7 +3024,7 @@ func file_service_provider_v1_messages_proto_init() {
 			}
 		}
 		file_service_provider_v1_messages_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GateResponse); i {
+			switch v := v.(*CreateWABAGateRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2935,7 +3036,7 @@ func file_service_provider_v1_messages_proto_init() {
 			}
 		}
 		file_service_provider_v1_messages_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*WhatsAppBusinessAccount); i {
+			switch v := v.(*GateResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2947,7 +3048,7 @@ func file_service_provider_v1_messages_proto_init() {
 			}
 		}
 		file_service_provider_v1_messages_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProviderCreateFacebookGateRequest); i {
+			switch v := v.(*WhatsAppBusinessAccount); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2959,7 +3060,7 @@ func file_service_provider_v1_messages_proto_init() {
 			}
 		}
 		file_service_provider_v1_messages_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProviderCreateFacebookGateResponse); i {
+			switch v := v.(*ProviderCreateFacebookGateRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2971,7 +3072,7 @@ func file_service_provider_v1_messages_proto_init() {
 			}
 		}
 		file_service_provider_v1_messages_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProviderGetFacebookGateRequest); i {
+			switch v := v.(*ProviderCreateFacebookGateResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2983,7 +3084,7 @@ func file_service_provider_v1_messages_proto_init() {
 			}
 		}
 		file_service_provider_v1_messages_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProviderGetFacebookGateResponse); i {
+			switch v := v.(*ProviderGetFacebookGateRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2995,7 +3096,7 @@ func file_service_provider_v1_messages_proto_init() {
 			}
 		}
 		file_service_provider_v1_messages_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProviderUpdateFacebookGateRequest); i {
+			switch v := v.(*ProviderGetFacebookGateResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3007,7 +3108,7 @@ func file_service_provider_v1_messages_proto_init() {
 			}
 		}
 		file_service_provider_v1_messages_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProviderUpdateFacebookGateResponse); i {
+			switch v := v.(*ProviderUpdateFacebookGateRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3019,7 +3120,7 @@ func file_service_provider_v1_messages_proto_init() {
 			}
 		}
 		file_service_provider_v1_messages_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProviderDeleteFacebookGateRequest); i {
+			switch v := v.(*ProviderUpdateFacebookGateResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3031,7 +3132,7 @@ func file_service_provider_v1_messages_proto_init() {
 			}
 		}
 		file_service_provider_v1_messages_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProviderDeleteFacebookGateResponse); i {
+			switch v := v.(*ProviderDeleteFacebookGateRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3043,7 +3144,7 @@ func file_service_provider_v1_messages_proto_init() {
 			}
 		}
 		file_service_provider_v1_messages_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProviderCreateWhatsAppGateRequest); i {
+			switch v := v.(*ProviderDeleteFacebookGateResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3055,7 +3156,7 @@ func file_service_provider_v1_messages_proto_init() {
 			}
 		}
 		file_service_provider_v1_messages_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProviderCreateWhatsAppGateResponse); i {
+			switch v := v.(*ProviderCreateWhatsAppGateRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3067,7 +3168,7 @@ func file_service_provider_v1_messages_proto_init() {
 			}
 		}
 		file_service_provider_v1_messages_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProviderGetWhatsAppGateRequest); i {
+			switch v := v.(*ProviderCreateWhatsAppGateResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3079,7 +3180,7 @@ func file_service_provider_v1_messages_proto_init() {
 			}
 		}
 		file_service_provider_v1_messages_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProviderGetWhatsAppGateResponse); i {
+			switch v := v.(*ProviderGetWhatsAppGateRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3091,7 +3192,7 @@ func file_service_provider_v1_messages_proto_init() {
 			}
 		}
 		file_service_provider_v1_messages_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProviderUpdateWhatsAppGateRequest); i {
+			switch v := v.(*ProviderGetWhatsAppGateResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3103,7 +3204,7 @@ func file_service_provider_v1_messages_proto_init() {
 			}
 		}
 		file_service_provider_v1_messages_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProviderUpdateWhatsAppGateResponse); i {
+			switch v := v.(*ProviderUpdateWhatsAppGateRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3115,7 +3216,7 @@ func file_service_provider_v1_messages_proto_init() {
 			}
 		}
 		file_service_provider_v1_messages_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProviderDeleteWhatsAppGateRequest); i {
+			switch v := v.(*ProviderUpdateWhatsAppGateResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3127,7 +3228,7 @@ func file_service_provider_v1_messages_proto_init() {
 			}
 		}
 		file_service_provider_v1_messages_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProviderDeleteWhatsAppGateResponse); i {
+			switch v := v.(*ProviderDeleteWhatsAppGateRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3139,7 +3240,7 @@ func file_service_provider_v1_messages_proto_init() {
 			}
 		}
 		file_service_provider_v1_messages_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProviderListGatesRequest); i {
+			switch v := v.(*ProviderDeleteWhatsAppGateResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3151,6 +3252,18 @@ func file_service_provider_v1_messages_proto_init() {
 			}
 		}
 		file_service_provider_v1_messages_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProviderListGatesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_provider_v1_messages_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ProviderListGatesResponse); i {
 			case 0:
 				return &v.state
@@ -3163,21 +3276,21 @@ func file_service_provider_v1_messages_proto_init() {
 			}
 		}
 	}
-	file_service_provider_v1_messages_proto_msgTypes[14].OneofWrappers = []interface{}{
+	file_service_provider_v1_messages_proto_msgTypes[15].OneofWrappers = []interface{}{
 		(*CreateGateRequest_Waba)(nil),
 		(*CreateGateRequest_Fb)(nil),
 	}
-	file_service_provider_v1_messages_proto_msgTypes[16].OneofWrappers = []interface{}{
+	file_service_provider_v1_messages_proto_msgTypes[17].OneofWrappers = []interface{}{
 		(*GateResponse_Waba)(nil),
 	}
-	file_service_provider_v1_messages_proto_msgTypes[17].OneofWrappers = []interface{}{}
+	file_service_provider_v1_messages_proto_msgTypes[18].OneofWrappers = []interface{}{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_service_provider_v1_messages_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   36,
+			NumMessages:   37,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -0,0 +1,243 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: service/provider/v1/slack_service.proto
+
+package provider
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	SlackService_CreateSlackGate_FullMethodName = "/webitel.im.provider.v1.SlackService/CreateSlackGate"
+	SlackService_GetSlackGate_FullMethodName    = "/webitel.im.provider.v1.SlackService/GetSlackGate"
+	SlackService_UpdateSlackGate_FullMethodName = "/webitel.im.provider.v1.SlackService/UpdateSlackGate"
+	SlackService_DeleteSlackGate_FullMethodName = "/webitel.im.provider.v1.SlackService/DeleteSlackGate"
+)
+
+// SlackServiceClient is the client API for SlackService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type SlackServiceClient interface {
+	// / CreateSlackGate connects an installed app and issues its Events API request URL.
+	CreateSlackGate(ctx context.Context, in *ProviderCreateSlackGateRequest, opts ...grpc.CallOption) (*ProviderCreateSlackGateResponse, error)
+	// / GetSlackGate returns the Slack gate.
+	GetSlackGate(ctx context.Context, in *ProviderGetSlackGateRequest, opts ...grpc.CallOption) (*ProviderGetSlackGateResponse, error)
+	// / UpdateSlackGate renames, enables or disables the gate or replaces its bot token or signing secret.
+	UpdateSlackGate(ctx context.Context, in *ProviderUpdateSlackGateRequest, opts ...grpc.CallOption) (*ProviderUpdateSlackGateResponse, error)
+	// / DeleteSlackGate removes the Slack gate.
+	DeleteSlackGate(ctx context.Context, in *ProviderDeleteSlackGateRequest, opts ...grpc.CallOption) (*ProviderDeleteSlackGateResponse, error)
+}
+
+type slackServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewSlackServiceClient(cc grpc.ClientConnInterface) SlackServiceClient {
+	return &slackServiceClient{cc}
+}
+
+func (c *slackServiceClient) CreateSlackGate(ctx context.Context, in *ProviderCreateSlackGateRequest, opts ...grpc.CallOption) (*ProviderCreateSlackGateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProviderCreateSlackGateResponse)
+	err := c.cc.Invoke(ctx, SlackService_CreateSlackGate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *slackServiceClient) GetSlackGate(ctx context.Context, in *ProviderGetSlackGateRequest, opts ...grpc.CallOption) (*ProviderGetSlackGateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProviderGetSlackGateResponse)
+	err := c.cc.Invoke(ctx, SlackService_GetSlackGate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *slackServiceClient) UpdateSlackGate(ctx context.Context, in *ProviderUpdateSlackGateRequest, opts ...grpc.CallOption) (*ProviderUpdateSlackGateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProviderUpdateSlackGateResponse)
+	err := c.cc.Invoke(ctx, SlackService_UpdateSlackGate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *slackServiceClient) DeleteSlackGate(ctx context.Context, in *ProviderDeleteSlackGateRequest, opts ...grpc.CallOption) (*ProviderDeleteSlackGateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProviderDeleteSlackGateResponse)
+	err := c.cc.Invoke(ctx, SlackService_DeleteSlackGate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SlackServiceServer is the server API for SlackService service.
+// All implementations must embed UnimplementedSlackServiceServer
+// for forward compatibility.
+type SlackServiceServer interface {
+	// / CreateSlackGate connects an installed app and issues its Events API request URL.
+	CreateSlackGate(context.Context, *ProviderCreateSlackGateRequest) (*ProviderCreateSlackGateResponse, error)
+	// / GetSlackGate returns the Slack gate.
+	GetSlackGate(context.Context, *ProviderGetSlackGateRequest) (*ProviderGetSlackGateResponse, error)
+	// / UpdateSlackGate renames, enables or disables the gate or replaces its bot token or signing secret.
+	UpdateSlackGate(context.Context, *ProviderUpdateSlackGateRequest) (*ProviderUpdateSlackGateResponse, error)
+	// / DeleteSlackGate removes the Slack gate.
+	DeleteSlackGate(context.Context, *ProviderDeleteSlackGateRequest) (*ProviderDeleteSlackGateResponse, error)
+	mustEmbedUnimplementedSlackServiceServer()
+}
+
+// UnimplementedSlackServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedSlackServiceServer struct{}
+
+func (UnimplementedSlackServiceServer) CreateSlackGate(context.Context, *ProviderCreateSlackGateRequest) (*ProviderCreateSlackGateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSlackGate not implemented")
+}
+func (UnimplementedSlackServiceServer) GetSlackGate(context.Context, *ProviderGetSlackGateRequest) (*ProviderGetSlackGateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSlackGate not implemented")
+}
+func (UnimplementedSlackServiceServer) UpdateSlackGate(context.Context, *ProviderUpdateSlackGateRequest) (*ProviderUpdateSlackGateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateSlackGate not implemented")
+}
+func (UnimplementedSlackServiceServer) DeleteSlackGate(context.Context, *ProviderDeleteSlackGateRequest) (*ProviderDeleteSlackGateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteSlackGate not implemented")
+}
+func (UnimplementedSlackServiceServer) mustEmbedUnimplementedSlackServiceServer() {}
+func (UnimplementedSlackServiceServer) testEmbeddedByValue()                      {}
+
+// UnsafeSlackServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to SlackServiceServer will
+// result in compilation errors.
+type UnsafeSlackServiceServer interface {
+	mustEmbedUnimplementedSlackServiceServer()
+}
+
+func RegisterSlackServiceServer(s grpc.ServiceRegistrar, srv SlackServiceServer) {
+	// If the following call pancis, it indicates UnimplementedSlackServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&SlackService_ServiceDesc, srv)
+}
+
+func _SlackService_CreateSlackGate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProviderCreateSlackGateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SlackServiceServer).CreateSlackGate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SlackService_CreateSlackGate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SlackServiceServer).CreateSlackGate(ctx, req.(*ProviderCreateSlackGateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SlackService_GetSlackGate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProviderGetSlackGateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SlackServiceServer).GetSlackGate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SlackService_GetSlackGate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SlackServiceServer).GetSlackGate(ctx, req.(*ProviderGetSlackGateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SlackService_UpdateSlackGate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProviderUpdateSlackGateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SlackServiceServer).UpdateSlackGate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SlackService_UpdateSlackGate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SlackServiceServer).UpdateSlackGate(ctx, req.(*ProviderUpdateSlackGateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SlackService_DeleteSlackGate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProviderDeleteSlackGateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SlackServiceServer).DeleteSlackGate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SlackService_DeleteSlackGate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SlackServiceServer).DeleteSlackGate(ctx, req.(*ProviderDeleteSlackGateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// SlackService_ServiceDesc is the grpc.ServiceDesc for SlackService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var SlackService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "webitel.im.provider.v1.SlackService",
+	HandlerType: (*SlackServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateSlackGate",
+			Handler:    _SlackService_CreateSlackGate_Handler,
+		},
+		{
+			MethodName: "GetSlackGate",
+			Handler:    _SlackService_GetSlackGate_Handler,
+		},
+		{
+			MethodName: "UpdateSlackGate",
+			Handler:    _SlackService_UpdateSlackGate_Handler,
+		},
+		{
+			MethodName: "DeleteSlackGate",
+			Handler:    _SlackService_DeleteSlackGate_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "service/provider/v1/slack_service.proto",
+}
@@ -0,0 +1,1237 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.34.1
+// 	protoc        (unknown)
+// source: service/provider/v1/sms_service.proto
+
+package provider
+
+import (
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// / SMSBackend is how an SMS gate exchanges messages.
+type SMSBackend int32
+
+const (
+	SMSBackend_SMS_BACKEND_UNSPECIFIED SMSBackend = 0
+	SMSBackend_SMS_BACKEND_SMPP        SMSBackend = 1 // Binds to an SMSC as a transceiver
+	SMSBackend_SMS_BACKEND_HTTP        SMSBackend = 2 // Posts to an HTTP SMS gateway and receives its webhooks
+)
+
+// Enum value maps for SMSBackend.
+var (
+	SMSBackend_name = map[int32]string{
+		0: "SMS_BACKEND_UNSPECIFIED",
+		1: "SMS_BACKEND_SMPP",
+		2: "SMS_BACKEND_HTTP",
+	}
+	SMSBackend_value = map[string]int32{
+		"SMS_BACKEND_UNSPECIFIED": 0,
+		"SMS_BACKEND_SMPP":        1,
+		"SMS_BACKEND_HTTP":        2,
+	}
+)
+
+func (x SMSBackend) Enum() *SMSBackend {
+	p := new(SMSBackend)
+	*p = x
+	return p
+}
+
+func (x SMSBackend) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SMSBackend) Descriptor() protoreflect.EnumDescriptor {
+	return file_service_provider_v1_sms_service_proto_enumTypes[0].Descriptor()
+}
+
+func (SMSBackend) Type() protoreflect.EnumType {
+	return &file_service_provider_v1_sms_service_proto_enumTypes[0]
+}
+
+func (x SMSBackend) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SMSBackend.Descriptor instead.
+func (SMSBackend) EnumDescriptor() ([]byte, []int) {
+	return file_service_provider_v1_sms_service_proto_rawDescGZIP(), []int{0}
+}
+
+// / ProviderSMPPConfig is the SMSC account of an SMPP gate.
+type ProviderSMPPConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Addr       string `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"` // host:port of the SMSC
+	SystemId   string `protobuf:"bytes,2,opt,name=system_id,json=systemId,proto3" json:"system_id,omitempty"`
+	Password   string `protobuf:"bytes,3,opt,name=password,proto3" json:"password,omitempty"` // Write-only; empty on update keeps the stored one
+	SystemType string `protobuf:"bytes,4,opt,name=system_type,json=systemType,proto3" json:"system_type,omitempty"`
+	Tls        bool   `protobuf:"varint,5,opt,name=tls,proto3" json:"tls,omitempty"`
+}
+
+func (x *ProviderSMPPConfig) Reset() {
+	*x = ProviderSMPPConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_provider_v1_sms_service_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProviderSMPPConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProviderSMPPConfig) ProtoMessage() {}
+
+func (x *ProviderSMPPConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_service_provider_v1_sms_service_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProviderSMPPConfig.ProtoReflect.Descriptor instead.
+func (*ProviderSMPPConfig) Descriptor() ([]byte, []int) {
+	return file_service_provider_v1_sms_service_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ProviderSMPPConfig) GetAddr() string {
+	if x != nil {
+		return x.Addr
+	}
+	return ""
+}
+
+func (x *ProviderSMPPConfig) GetSystemId() string {
+	if x != nil {
+		return x.SystemId
+	}
+	return ""
+}
+
+func (x *ProviderSMPPConfig) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *ProviderSMPPConfig) GetSystemType() string {
+	if x != nil {
+		return x.SystemType
+	}
+	return ""
+}
+
+func (x *ProviderSMPPConfig) GetTls() bool {
+	if x != nil {
+		return x.Tls
+	}
+	return false
+}
+
+// / ProviderSMSHTTPGateway is the account of an HTTP gateway gate.
+type ProviderSMSHTTPGateway struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SendUrl string `protobuf:"bytes,1,opt,name=send_url,json=sendUrl,proto3" json:"send_url,omitempty"`
+	ApiKey  string `protobuf:"bytes,2,opt,name=api_key,json=apiKey,proto3" json:"api_key,omitempty"` // Write-only bearer token of outbound requests; empty on update keeps the stored one
+}
+
+func (x *ProviderSMSHTTPGateway) Reset() {
+	*x = ProviderSMSHTTPGateway{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_provider_v1_sms_service_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProviderSMSHTTPGateway) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProviderSMSHTTPGateway) ProtoMessage() {}
+
+func (x *ProviderSMSHTTPGateway) ProtoReflect() protoreflect.Message {
+	mi := &file_service_provider_v1_sms_service_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProviderSMSHTTPGateway.ProtoReflect.Descriptor instead.
+func (*ProviderSMSHTTPGateway) Descriptor() ([]byte, []int) {
+	return file_service_provider_v1_sms_service_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ProviderSMSHTTPGateway) GetSendUrl() string {
+	if x != nil {
+		return x.SendUrl
+	}
+	return ""
+}
+
+func (x *ProviderSMSHTTPGateway) GetApiKey() string {
+	if x != nil {
+		return x.ApiKey
+	}
+	return ""
+}
+
+// / ProviderSMSGate is a sender number or alphanumeric sender ID connected as a messaging gateway.
+type ProviderSMSGate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id         string                  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name       string                  `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Peer       *Peer                   `protobuf:"bytes,3,opt,name=peer,proto3" json:"peer,omitempty"` // Identity details (sub and iss)
+	Backend    SMSBackend              `protobuf:"varint,4,opt,name=backend,proto3,enum=webitel.im.provider.v1.SMSBackend" json:"backend,omitempty"`
+	Sender     string                  `protobuf:"bytes,5,opt,name=sender,proto3" json:"sender,omitempty"` // E.164 number with a leading "+" or an alphanumeric sender ID
+	Smpp       *ProviderSMPPConfig     `protobuf:"bytes,6,opt,name=smpp,proto3" json:"smpp,omitempty"`
+	Http       *ProviderSMSHTTPGateway `protobuf:"bytes,7,opt,name=http,proto3" json:"http,omitempty"`
+	WebhookUrl string                  `protobuf:"bytes,8,opt,name=webhook_url,json=webhookUrl,proto3" json:"webhook_url,omitempty"` // Receives the events of an HTTP gateway; empty for SMPP gates
+	Status     ProviderStatus          `protobuf:"varint,9,opt,name=status,proto3,enum=webitel.im.provider.v1.ProviderStatus" json:"status,omitempty"`
+	CreatedAt  int64                   `protobuf:"varint,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"` // Unix timestamp in milliseconds
+	UpdatedAt  int64                   `protobuf:"varint,11,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"` // Unix timestamp in milliseconds
+	Enabled    bool                    `protobuf:"varint,12,opt,name=enabled,proto3" json:"enabled,omitempty"`
+}
+
+func (x *ProviderSMSGate) Reset() {
+	*x = ProviderSMSGate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_provider_v1_sms_service_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProviderSMSGate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProviderSMSGate) ProtoMessage() {}
+
+func (x *ProviderSMSGate) ProtoReflect() protoreflect.Message {
+	mi := &file_service_provider_v1_sms_service_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProviderSMSGate.ProtoReflect.Descriptor instead.
+func (*ProviderSMSGate) Descriptor() ([]byte, []int) {
+	return file_service_provider_v1_sms_service_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ProviderSMSGate) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ProviderSMSGate) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ProviderSMSGate) GetPeer() *Peer {
+	if x != nil {
+		return x.Peer
+	}
+	return nil
+}
+
+func (x *ProviderSMSGate) GetBackend() SMSBackend {
+	if x != nil {
+		return x.Backend
+	}
+	return SMSBackend_SMS_BACKEND_UNSPECIFIED
+}
+
+func (x *ProviderSMSGate) GetSender() string {
+	if x != nil {
+		return x.Sender
+	}
+	return ""
+}
+
+func (x *ProviderSMSGate) GetSmpp() *ProviderSMPPConfig {
+	if x != nil {
+		return x.Smpp
+	}
+	return nil
+}
+
+func (x *ProviderSMSGate) GetHttp() *ProviderSMSHTTPGateway {
+	if x != nil {
+		return x.Http
+	}
+	return nil
+}
+
+func (x *ProviderSMSGate) GetWebhookUrl() string {
+	if x != nil {
+		return x.WebhookUrl
+	}
+	return ""
+}
+
+func (x *ProviderSMSGate) GetStatus() ProviderStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ProviderStatus_PROVIDER_STATUS_UNSPECIFIED
+}
+
+func (x *ProviderSMSGate) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *ProviderSMSGate) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+func (x *ProviderSMSGate) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+type ProviderCreateSMSGateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name    string                  `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Backend SMSBackend              `protobuf:"varint,2,opt,name=backend,proto3,enum=webitel.im.provider.v1.SMSBackend" json:"backend,omitempty"`
+	Sender  string                  `protobuf:"bytes,3,opt,name=sender,proto3" json:"sender,omitempty"`
+	Smpp    *ProviderSMPPConfig     `protobuf:"bytes,4,opt,name=smpp,proto3" json:"smpp,omitempty"` // Required by SMPP gates
+	Http    *ProviderSMSHTTPGateway `protobuf:"bytes,5,opt,name=http,proto3" json:"http,omitempty"` // Required by HTTP gates
+	Peer    *Peer                   `protobuf:"bytes,6,opt,name=peer,proto3" json:"peer,omitempty"` // Identity details (sub and iss)
+}
+
+func (x *ProviderCreateSMSGateRequest) Reset() {
+	*x = ProviderCreateSMSGateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_provider_v1_sms_service_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProviderCreateSMSGateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProviderCreateSMSGateRequest) ProtoMessage() {}
+
+func (x *ProviderCreateSMSGateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_provider_v1_sms_service_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProviderCreateSMSGateRequest.ProtoReflect.Descriptor instead.
+func (*ProviderCreateSMSGateRequest) Descriptor() ([]byte, []int) {
+	return file_service_provider_v1_sms_service_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ProviderCreateSMSGateRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ProviderCreateSMSGateRequest) GetBackend() SMSBackend {
+	if x != nil {
+		return x.Backend
+	}
+	return SMSBackend_SMS_BACKEND_UNSPECIFIED
+}
+
+func (x *ProviderCreateSMSGateRequest) GetSender() string {
+	if x != nil {
+		return x.Sender
+	}
+	return ""
+}
+
+func (x *ProviderCreateSMSGateRequest) GetSmpp() *ProviderSMPPConfig {
+	if x != nil {
+		return x.Smpp
+	}
+	return nil
+}
+
+func (x *ProviderCreateSMSGateRequest) GetHttp() *ProviderSMSHTTPGateway {
+	if x != nil {
+		return x.Http
+	}
+	return nil
+}
+
+func (x *ProviderCreateSMSGateRequest) GetPeer() *Peer {
+	if x != nil {
+		return x.Peer
+	}
+	return nil
+}
+
+type ProviderCreateSMSGateResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Item          *ProviderSMSGate `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`
+	WebhookSecret string           `protobuf:"bytes,2,opt,name=webhook_secret,json=webhookSecret,proto3" json:"webhook_secret,omitempty"` // Keys the HMAC signature of the HTTP gateway events; it is not returned again
+}
+
+func (x *ProviderCreateSMSGateResponse) Reset() {
+	*x = ProviderCreateSMSGateResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_provider_v1_sms_service_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProviderCreateSMSGateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProviderCreateSMSGateResponse) ProtoMessage() {}
+
+func (x *ProviderCreateSMSGateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_provider_v1_sms_service_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProviderCreateSMSGateResponse.ProtoReflect.Descriptor instead.
+func (*ProviderCreateSMSGateResponse) Descriptor() ([]byte, []int) {
+	return file_service_provider_v1_sms_service_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ProviderCreateSMSGateResponse) GetItem() *ProviderSMSGate {
+	if x != nil {
+		return x.Item
+	}
+	return nil
+}
+
+func (x *ProviderCreateSMSGateResponse) GetWebhookSecret() string {
+	if x != nil {
+		return x.WebhookSecret
+	}
+	return ""
+}
+
+type ProviderGetSMSGateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *ProviderGetSMSGateRequest) Reset() {
+	*x = ProviderGetSMSGateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_provider_v1_sms_service_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProviderGetSMSGateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProviderGetSMSGateRequest) ProtoMessage() {}
+
+func (x *ProviderGetSMSGateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_provider_v1_sms_service_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProviderGetSMSGateRequest.ProtoReflect.Descriptor instead.
+func (*ProviderGetSMSGateRequest) Descriptor() ([]byte, []int) {
+	return file_service_provider_v1_sms_service_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ProviderGetSMSGateRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ProviderGetSMSGateResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Item *ProviderSMSGate `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`
+}
+
+func (x *ProviderGetSMSGateResponse) Reset() {
+	*x = ProviderGetSMSGateResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_provider_v1_sms_service_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProviderGetSMSGateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProviderGetSMSGateResponse) ProtoMessage() {}
+
+func (x *ProviderGetSMSGateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_provider_v1_sms_service_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProviderGetSMSGateResponse.ProtoReflect.Descriptor instead.
+func (*ProviderGetSMSGateResponse) Descriptor() ([]byte, []int) {
+	return file_service_provider_v1_sms_service_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ProviderGetSMSGateResponse) GetItem() *ProviderSMSGate {
+	if x != nil {
+		return x.Item
+	}
+	return nil
+}
+
+// / ProviderUpdateSMSGateRequest changes the gate; a new smpp or http configuration replaces the stored one.
+type ProviderUpdateSMSGateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id      string                  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name    *string                 `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	Sender  *string                 `protobuf:"bytes,3,opt,name=sender,proto3,oneof" json:"sender,omitempty"`
+	Smpp    *ProviderSMPPConfig     `protobuf:"bytes,4,opt,name=smpp,proto3" json:"smpp,omitempty"` // Kept when unset
+	Http    *ProviderSMSHTTPGateway `protobuf:"bytes,5,opt,name=http,proto3" json:"http,omitempty"` // Kept when unset
+	Enabled *bool                   `protobuf:"varint,6,opt,name=enabled,proto3,oneof" json:"enabled,omitempty"`
+	Peer    *Peer                   `protobuf:"bytes,7,opt,name=peer,proto3" json:"peer,omitempty"` // Identity details (sub and iss); kept when unset
+}
+
+func (x *ProviderUpdateSMSGateRequest) Reset() {
+	*x = ProviderUpdateSMSGateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_provider_v1_sms_service_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProviderUpdateSMSGateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProviderUpdateSMSGateRequest) ProtoMessage() {}
+
+func (x *ProviderUpdateSMSGateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_provider_v1_sms_service_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProviderUpdateSMSGateRequest.ProtoReflect.Descriptor instead.
+func (*ProviderUpdateSMSGateRequest) Descriptor() ([]byte, []int) {
+	return file_service_provider_v1_sms_service_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ProviderUpdateSMSGateRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ProviderUpdateSMSGateRequest) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *ProviderUpdateSMSGateRequest) GetSender() string {
+	if x != nil && x.Sender != nil {
+		return *x.Sender
+	}
+	return ""
+}
+
+func (x *ProviderUpdateSMSGateRequest) GetSmpp() *ProviderSMPPConfig {
+	if x != nil {
+		return x.Smpp
+	}
+	return nil
+}
+
+func (x *ProviderUpdateSMSGateRequest) GetHttp() *ProviderSMSHTTPGateway {
+	if x != nil {
+		return x.Http
+	}
+	return nil
+}
+
+func (x *ProviderUpdateSMSGateRequest) GetEnabled() bool {
+	if x != nil && x.Enabled != nil {
+		return *x.Enabled
+	}
+	return false
+}
+
+func (x *ProviderUpdateSMSGateRequest) GetPeer() *Peer {
+	if x != nil {
+		return x.Peer
+	}
+	return nil
+}
+
+type ProviderUpdateSMSGateResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Item *ProviderSMSGate `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`
+}
+
+func (x *ProviderUpdateSMSGateResponse) Reset() {
+	*x = ProviderUpdateSMSGateResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_provider_v1_sms_service_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProviderUpdateSMSGateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProviderUpdateSMSGateResponse) ProtoMessage() {}
+
+func (x *ProviderUpdateSMSGateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_provider_v1_sms_service_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProviderUpdateSMSGateResponse.ProtoReflect.Descriptor instead.
+func (*ProviderUpdateSMSGateResponse) Descriptor() ([]byte, []int) {
+	return file_service_provider_v1_sms_service_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ProviderUpdateSMSGateResponse) GetItem() *ProviderSMSGate {
+	if x != nil {
+		return x.Item
+	}
+	return nil
+}
+
+type ProviderDeleteSMSGateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *ProviderDeleteSMSGateRequest) Reset() {
+	*x = ProviderDeleteSMSGateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_provider_v1_sms_service_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProviderDeleteSMSGateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProviderDeleteSMSGateRequest) ProtoMessage() {}
+
+func (x *ProviderDeleteSMSGateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_provider_v1_sms_service_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProviderDeleteSMSGateRequest.ProtoReflect.Descriptor instead.
+func (*ProviderDeleteSMSGateRequest) Descriptor() ([]byte, []int) {
+	return file_service_provider_v1_sms_service_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ProviderDeleteSMSGateRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ProviderDeleteSMSGateResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Item *ProviderSMSGate `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`
+}
+
+func (x *ProviderDeleteSMSGateResponse) Reset() {
+	*x = ProviderDeleteSMSGateResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_provider_v1_sms_service_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProviderDeleteSMSGateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProviderDeleteSMSGateResponse) ProtoMessage() {}
+
+func (x *ProviderDeleteSMSGateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_provider_v1_sms_service_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProviderDeleteSMSGateResponse.ProtoReflect.Descriptor instead.
+func (*ProviderDeleteSMSGateResponse) Descriptor() ([]byte, []int) {
+	return file_service_provider_v1_sms_service_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ProviderDeleteSMSGateResponse) GetItem() *ProviderSMSGate {
+	if x != nil {
+		return x.Item
+	}
+	return nil
+}
+
+var File_service_provider_v1_sms_service_proto protoreflect.FileDescriptor
+
+var file_service_provider_v1_sms_service_proto_rawDesc = []byte{
+	0x0a, 0x25, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64,
+	0x65, 0x72, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x6d, 0x73, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x16, 0x77, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c,
+	0x2e, 0x69, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x1a,
+	0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f,
+	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x2f,
+	0x76, 0x31, 0x2f, 0x65, 0x6e, 0x75, 0x6d, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x22,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
+	0x2f, 0x76, 0x31, 0x2f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x22, 0x94, 0x01, 0x0a, 0x12, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x53,
+	0x4d, 0x50, 0x50, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x64, 0x64,
+	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x61, 0x64, 0x64, 0x72, 0x12, 0x1b, 0x0a,
+	0x09, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61,
+	0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61,
+	0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d,
+	0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x79, 0x73,
+	0x74, 0x65, 0x6d, 0x54, 0x79, 0x70, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x6c, 0x73, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x03, 0x74, 0x6c, 0x73, 0x22, 0x4c, 0x0a, 0x16, 0x50, 0x72, 0x6f,
+	0x76, 0x69, 0x64, 0x65, 0x72, 0x53, 0x4d, 0x53, 0x48, 0x54, 0x54, 0x50, 0x47, 0x61, 0x74, 0x65,
+	0x77, 0x61, 0x79, 0x12, 0x19, 0x0a, 0x08, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x75, 0x72, 0x6c, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x65, 0x6e, 0x64, 0x55, 0x72, 0x6c, 0x12, 0x17,
+	0x0a, 0x07, 0x61, 0x70, 0x69, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x61, 0x70, 0x69, 0x4b, 0x65, 0x79, 0x22, 0xfa, 0x03, 0x0a, 0x0f, 0x50, 0x72, 0x6f, 0x76,
+	0x69, 0x64, 0x65, 0x72, 0x53, 0x4d, 0x53, 0x47, 0x61, 0x74, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x30, 0x0a, 0x04, 0x70, 0x65, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
+	0x77, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x2e, 0x69, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69,
+	0x64, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x65, 0x65, 0x72, 0x52, 0x04, 0x70, 0x65, 0x65,
+	0x72, 0x12, 0x3c, 0x0a, 0x07, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x22, 0x2e, 0x77, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x2e, 0x69, 0x6d, 0x2e,
+	0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x4d, 0x53, 0x42,
+	0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x52, 0x07, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x12,
+	0x16, 0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x3e, 0x0a, 0x04, 0x73, 0x6d, 0x70, 0x70, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x77, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x2e,
+	0x69, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50,
+	0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x53, 0x4d, 0x50, 0x50, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x52, 0x04, 0x73, 0x6d, 0x70, 0x70, 0x12, 0x42, 0x0a, 0x04, 0x68, 0x74, 0x74, 0x70, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x77, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x2e,
+	0x69, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50,
+	0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x53, 0x4d, 0x53, 0x48, 0x54, 0x54, 0x50, 0x47, 0x61,
+	0x74, 0x65, 0x77, 0x61, 0x79, 0x52, 0x04, 0x68, 0x74, 0x74, 0x70, 0x12, 0x1f, 0x0a, 0x0b, 0x77,
+	0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x55, 0x72, 0x6c, 0x12, 0x3e, 0x0a, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x26, 0x2e, 0x77,
+	0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x2e, 0x69, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64,
+	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1d, 0x0a, 0x0a,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x75,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e,
+	0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x61,
+	0x62, 0x6c, 0x65, 0x64, 0x22, 0xbe, 0x02, 0x0a, 0x1c, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65,
+	0x72, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x4d, 0x53, 0x47, 0x61, 0x74, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x3c, 0x0a, 0x07, 0x62, 0x61, 0x63,
+	0x6b, 0x65, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x22, 0x2e, 0x77, 0x65, 0x62,
+	0x69, 0x74, 0x65, 0x6c, 0x2e, 0x69, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
+	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x4d, 0x53, 0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x52, 0x07,
+	0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65,
+	0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12,
+	0x3e, 0x0a, 0x04, 0x73, 0x6d, 0x70, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e,
+	0x77, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x2e, 0x69, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69,
+	0x64, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x53,
+	0x4d, 0x50, 0x50, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x04, 0x73, 0x6d, 0x70, 0x70, 0x12,
+	0x42, 0x0a, 0x04, 0x68, 0x74, 0x74, 0x70, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2e, 0x2e,
+	0x77, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x2e, 0x69, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69,
+	0x64, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x53,
+	0x4d, 0x53, 0x48, 0x54, 0x54, 0x50, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x52, 0x04, 0x68,
+	0x74, 0x74, 0x70, 0x12, 0x30, 0x0a, 0x04, 0x70, 0x65, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1c, 0x2e, 0x77, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x2e, 0x69, 0x6d, 0x2e, 0x70,
+	0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x65, 0x65, 0x72, 0x52,
+	0x04, 0x70, 0x65, 0x65, 0x72, 0x22, 0x83, 0x01, 0x0a, 0x1d, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64,
+	0x65, 0x72, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x4d, 0x53, 0x47, 0x61, 0x74, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x77, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x2e,
+	0x69, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50,
+	0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x53, 0x4d, 0x53, 0x47, 0x61, 0x74, 0x65, 0x52, 0x04,
+	0x69, 0x74, 0x65, 0x6d, 0x12, 0x25, 0x0a, 0x0e, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x5f,
+	0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x77, 0x65,
+	0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x22, 0x2b, 0x0a, 0x19, 0x50,
+	0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x47, 0x65, 0x74, 0x53, 0x4d, 0x53, 0x47, 0x61, 0x74,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x59, 0x0a, 0x1a, 0x50, 0x72, 0x6f, 0x76,
+	0x69, 0x64, 0x65, 0x72, 0x47, 0x65, 0x74, 0x53, 0x4d, 0x53, 0x47, 0x61, 0x74, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x77, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x2e, 0x69,
+	0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72,
+	0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x53, 0x4d, 0x53, 0x47, 0x61, 0x74, 0x65, 0x52, 0x04, 0x69,
+	0x74, 0x65, 0x6d, 0x22, 0xd9, 0x02, 0x0a, 0x1c, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x4d, 0x53, 0x47, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x17, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x48, 0x00, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x12, 0x1b, 0x0a,
+	0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52,
+	0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x88, 0x01, 0x01, 0x12, 0x3e, 0x0a, 0x04, 0x73, 0x6d,
+	0x70, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x77, 0x65, 0x62, 0x69, 0x74,
+	0x65, 0x6c, 0x2e, 0x69, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x2e, 0x76,
+	0x31, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x53, 0x4d, 0x50, 0x50, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x52, 0x04, 0x73, 0x6d, 0x70, 0x70, 0x12, 0x42, 0x0a, 0x04, 0x68, 0x74,
+	0x74, 0x70, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x77, 0x65, 0x62, 0x69, 0x74,
+	0x65, 0x6c, 0x2e, 0x69, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x2e, 0x76,
+	0x31, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x53, 0x4d, 0x53, 0x48, 0x54, 0x54,
+	0x50, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x52, 0x04, 0x68, 0x74, 0x74, 0x70, 0x12, 0x1d,
+	0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x48,
+	0x02, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x88, 0x01, 0x01, 0x12, 0x30, 0x0a,
+	0x04, 0x70, 0x65, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x77, 0x65,
+	0x62, 0x69, 0x74, 0x65, 0x6c, 0x2e, 0x69, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65,
+	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x65, 0x65, 0x72, 0x52, 0x04, 0x70, 0x65, 0x65, 0x72, 0x42,
+	0x07, 0x0a, 0x05, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x73, 0x65, 0x6e,
+	0x64, 0x65, 0x72, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x22,
+	0x5c, 0x0a, 0x1d, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x53, 0x4d, 0x53, 0x47, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x3b, 0x0a, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27,
+	0x2e, 0x77, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x2e, 0x69, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x76,
+	0x69, 0x64, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
+	0x53, 0x4d, 0x53, 0x47, 0x61, 0x74, 0x65, 0x52, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x22, 0x2e, 0x0a,
+	0x1c, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53,
+	0x4d, 0x53, 0x47, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x5c, 0x0a,
+	0x1d, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53,
+	0x4d, 0x53, 0x47, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b,
+	0x0a, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x77,
+	0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x2e, 0x69, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64,
+	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x53, 0x4d,
+	0x53, 0x47, 0x61, 0x74, 0x65, 0x52, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x2a, 0x55, 0x0a, 0x0a, 0x53,
+	0x4d, 0x53, 0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x12, 0x1b, 0x0a, 0x17, 0x53, 0x4d, 0x53,
+	0x5f, 0x42, 0x41, 0x43, 0x4b, 0x45, 0x4e, 0x44, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49,
+	0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x14, 0x0a, 0x10, 0x53, 0x4d, 0x53, 0x5f, 0x42, 0x41,
+	0x43, 0x4b, 0x45, 0x4e, 0x44, 0x5f, 0x53, 0x4d, 0x50, 0x50, 0x10, 0x01, 0x12, 0x14, 0x0a, 0x10,
+	0x53, 0x4d, 0x53, 0x5f, 0x42, 0x41, 0x43, 0x4b, 0x45, 0x4e, 0x44, 0x5f, 0x48, 0x54, 0x54, 0x50,
+	0x10, 0x02, 0x32, 0xf0, 0x04, 0x0a, 0x0a, 0x53, 0x4d, 0x53, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x12, 0x96, 0x01, 0x0a, 0x0d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x4d, 0x53, 0x47,
+	0x61, 0x74, 0x65, 0x12, 0x34, 0x2e, 0x77, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x2e, 0x69, 0x6d,
+	0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f,
+	0x76, 0x69, 0x64, 0x65, 0x72, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x4d, 0x53, 0x47, 0x61,
+	0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x35, 0x2e, 0x77, 0x65, 0x62, 0x69,
+	0x74, 0x65, 0x6c, 0x2e, 0x69, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x2e,
+	0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x53, 0x4d, 0x53, 0x47, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x18, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x12, 0x3a, 0x01, 0x2a, 0x22, 0x0d, 0x2f, 0x69, 0x6d,
+	0x2f, 0x67, 0x61, 0x74, 0x65, 0x73, 0x2f, 0x73, 0x6d, 0x73, 0x12, 0x8f, 0x01, 0x0a, 0x0a, 0x47,
+	0x65, 0x74, 0x53, 0x4d, 0x53, 0x47, 0x61, 0x74, 0x65, 0x12, 0x31, 0x2e, 0x77, 0x65, 0x62, 0x69,
+	0x74, 0x65, 0x6c, 0x2e, 0x69, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x2e,
+	0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x47, 0x65, 0x74, 0x53, 0x4d,
+	0x53, 0x47, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x32, 0x2e, 0x77,
+	0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x2e, 0x69, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64,
+	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x47, 0x65,
+	0x74, 0x53, 0x4d, 0x53, 0x47, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x1a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x14, 0x12, 0x12, 0x2f, 0x69, 0x6d, 0x2f, 0x67, 0x61,
+	0x74, 0x65, 0x73, 0x2f, 0x73, 0x6d, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12, 0x9b, 0x01, 0x0a,
+	0x0d, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x4d, 0x53, 0x47, 0x61, 0x74, 0x65, 0x12, 0x34,
+	0x2e, 0x77, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x2e, 0x69, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x76,
+	0x69, 0x64, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x4d, 0x53, 0x47, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x35, 0x2e, 0x77, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x2e, 0x69,
+	0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72,
+	0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x4d, 0x53, 0x47,
+	0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1d, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x17, 0x3a, 0x01, 0x2a, 0x32, 0x12, 0x2f, 0x69, 0x6d, 0x2f, 0x67, 0x61, 0x74, 0x65,
+	0x73, 0x2f, 0x73, 0x6d, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12, 0x98, 0x01, 0x0a, 0x0d, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x4d, 0x53, 0x47, 0x61, 0x74, 0x65, 0x12, 0x34, 0x2e, 0x77,
+	0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x2e, 0x69, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64,
+	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x53, 0x4d, 0x53, 0x47, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x35, 0x2e, 0x77, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x2e, 0x69, 0x6d, 0x2e,
+	0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x76,
+	0x69, 0x64, 0x65, 0x72, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x4d, 0x53, 0x47, 0x61, 0x74,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1a, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x14, 0x2a, 0x12, 0x2f, 0x69, 0x6d, 0x2f, 0x67, 0x61, 0x74, 0x65, 0x73, 0x2f, 0x73, 0x6d, 0x73,
+	0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x42, 0xe2, 0x01, 0x0a, 0x1a, 0x63, 0x6f, 0x6d, 0x2e, 0x77, 0x65,
+	0x62, 0x69, 0x74, 0x65, 0x6c, 0x2e, 0x69, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65,
+	0x72, 0x2e, 0x76, 0x31, 0x42, 0x0f, 0x53, 0x6d, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x38, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2f, 0x77, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x2f, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x73, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x67, 0x6f, 0x2f, 0x69, 0x6d, 0x2f, 0x70, 0x72, 0x6f,
+	0x76, 0x69, 0x64, 0x65, 0x72, 0x2f, 0x76, 0x31, 0x3b, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65,
+	0x72, 0xa2, 0x02, 0x03, 0x57, 0x49, 0x50, 0xaa, 0x02, 0x16, 0x57, 0x65, 0x62, 0x69, 0x74, 0x65,
+	0x6c, 0x2e, 0x49, 0x6d, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x2e, 0x56, 0x31,
+	0xca, 0x02, 0x16, 0x57, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x5c, 0x49, 0x6d, 0x5c, 0x50, 0x72,
+	0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x22, 0x57, 0x65, 0x62, 0x69,
+	0x74, 0x65, 0x6c, 0x5c, 0x49, 0x6d, 0x5c, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x5c,
+	0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02,
+	0x19, 0x57, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x3a, 0x3a, 0x49, 0x6d, 0x3a, 0x3a, 0x50, 0x72,
+	0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
+}
+
+var (
+	file_service_provider_v1_sms_service_proto_rawDescOnce sync.Once
+	file_service_provider_v1_sms_service_proto_rawDescData = file_service_provider_v1_sms_service_proto_rawDesc
+)
+
+func file_service_provider_v1_sms_service_proto_rawDescGZIP() []byte {
+	file_service_provider_v1_sms_service_proto_rawDescOnce.Do(func() {
+		file_service_provider_v1_sms_service_proto_rawDescData = protoimpl.X.CompressGZIP(file_service_provider_v1_sms_service_proto_rawDescData)
+	})
+	return file_service_provider_v1_sms_service_proto_rawDescData
+}
+
+var file_service_provider_v1_sms_service_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_service_provider_v1_sms_service_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_service_provider_v1_sms_service_proto_goTypes = []interface{}{
+	(SMSBackend)(0),                       // 0: webitel.im.provider.v1.SMSBackend
+	(*ProviderSMPPConfig)(nil),            // 1: webitel.im.provider.v1.ProviderSMPPConfig
+	(*ProviderSMSHTTPGateway)(nil),        // 2: webitel.im.provider.v1.ProviderSMSHTTPGateway
+	(*ProviderSMSGate)(nil),               // 3: webitel.im.provider.v1.ProviderSMSGate
+	(*ProviderCreateSMSGateRequest)(nil),  // 4: webitel.im.provider.v1.ProviderCreateSMSGateRequest
+	(*ProviderCreateSMSGateResponse)(nil), // 5: webitel.im.provider.v1.ProviderCreateSMSGateResponse
+	(*ProviderGetSMSGateRequest)(nil),     // 6: webitel.im.provider.v1.ProviderGetSMSGateRequest
+	(*ProviderGetSMSGateResponse)(nil),    // 7: webitel.im.provider.v1.ProviderGetSMSGateResponse
+	(*ProviderUpdateSMSGateRequest)(nil),  // 8: webitel.im.provider.v1.ProviderUpdateSMSGateRequest
+	(*ProviderUpdateSMSGateResponse)(nil), // 9: webitel.im.provider.v1.ProviderUpdateSMSGateResponse
+	(*ProviderDeleteSMSGateRequest)(nil),  // 10: webitel.im.provider.v1.ProviderDeleteSMSGateRequest
+	(*ProviderDeleteSMSGateResponse)(nil), // 11: webitel.im.provider.v1.ProviderDeleteSMSGateResponse
+	(*Peer)(nil),                          // 12: webitel.im.provider.v1.Peer
+	(ProviderStatus)(0),                   // 13: webitel.im.provider.v1.ProviderStatus
+}
+var file_service_provider_v1_sms_service_proto_depIdxs = []int32{
+	12, // 0: webitel.im.provider.v1.ProviderSMSGate.peer:type_name -> webitel.im.provider.v1.Peer
+	0,  // 1: webitel.im.provider.v1.ProviderSMSGate.backend:type_name -> webitel.im.provider.v1.SMSBackend
+	1,  // 2: webitel.im.provider.v1.ProviderSMSGate.smpp:type_name -> webitel.im.provider.v1.ProviderSMPPConfig
+	2,  // 3: webitel.im.provider.v1.ProviderSMSGate.http:type_name -> webitel.im.provider.v1.ProviderSMSHTTPGateway
+	13, // 4: webitel.im.provider.v1.ProviderSMSGate.status:type_name -> webitel.im.provider.v1.ProviderStatus
+	0,  // 5: webitel.im.provider.v1.ProviderCreateSMSGateRequest.backend:type_name -> webitel.im.provider.v1.SMSBackend
+	1,  // 6: webitel.im.provider.v1.ProviderCreateSMSGateRequest.smpp:type_name -> webitel.im.provider.v1.ProviderSMPPConfig
+	2,  // 7: webitel.im.provider.v1.ProviderCreateSMSGateRequest.http:type_name -> webitel.im.provider.v1.ProviderSMSHTTPGateway
+	12, // 8: webitel.im.provider.v1.ProviderCreateSMSGateRequest.peer:type_name -> webitel.im.provider.v1.Peer
+	3,  // 9: webitel.im.provider.v1.ProviderCreateSMSGateResponse.item:type_name -> webitel.im.provider.v1.ProviderSMSGate
+	3,  // 10: webitel.im.provider.v1.ProviderGetSMSGateResponse.item:type_name -> webitel.im.provider.v1.ProviderSMSGate
+	1,  // 11: webitel.im.provider.v1.ProviderUpdateSMSGateRequest.smpp:type_name -> webitel.im.provider.v1.ProviderSMPPConfig
+	2,  // 12: webitel.im.provider.v1.ProviderUpdateSMSGateRequest.http:type_name -> webitel.im.provider.v1.ProviderSMSHTTPGateway
+	12, // 13: webitel.im.provider.v1.ProviderUpdateSMSGateRequest.peer:type_name -> webitel.im.provider.v1.Peer
+	3,  // 14: webitel.im.provider.v1.ProviderUpdateSMSGateResponse.item:type_name -> webitel.im.provider.v1.ProviderSMSGate
+	3,  // 15: webitel.im.provider.v1.ProviderDeleteSMSGateResponse.item:type_name -> webitel.im.provider.v1.ProviderSMSGate
+	4,  // 16: webitel.im.provider.v1.SMSService.CreateSMSGate:input_type -> webitel.im.provider.v1.ProviderCreateSMSGateRequest
+	6,  // 17: webitel.im.provider.v1.SMSService.GetSMSGate:input_type -> webitel.im.provider.v1.ProviderGetSMSGateRequest
+	8,  // 18: webitel.im.provider.v1.SMSService.UpdateSMSGate:input_type -> webitel.im.provider.v1.ProviderUpdateSMSGateRequest
+	10, // 19: webitel.im.provider.v1.SMSService.DeleteSMSGate:input_type -> webitel.im.provider.v1.ProviderDeleteSMSGateRequest
+	5,  // 20: webitel.im.provider.v1.SMSService.CreateSMSGate:output_type -> webitel.im.provider.v1.ProviderCreateSMSGateResponse
+	7,  // 21: webitel.im.provider.v1.SMSService.GetSMSGate:output_type -> webitel.im.provider.v1.ProviderGetSMSGateResponse
+	9,  // 22: webitel.im.provider.v1.SMSService.UpdateSMSGate:output_type -> webitel.im.provider.v1.ProviderUpdateSMSGateResponse
+	11, // 23: webitel.im.provider.v1.SMSService.DeleteSMSGate:output_type -> webitel.im.provider.v1.ProviderDeleteSMSGateResponse
+	20, // [20:24] is the sub-list for method output_type
+	16, // [16:20] is the sub-list for method input_type
+	16, // [16:16] is the sub-list for extension type_name
+	16, // [16:16] is the sub-list for extension extendee
+	0,  // [0:16] is the sub-list for field type_name
+}
+
+func init() { file_service_provider_v1_sms_service_proto_init() }
+func file_service_provider_v1_sms_service_proto_init() {
+	if File_service_provider_v1_sms_service_proto != nil {
+		return
+	}
+	file_service_provider_v1_enums_proto_init()
+	file_service_provider_v1_messages_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_service_provider_v1_sms_service_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProviderSMPPConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_provider_v1_sms_service_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProviderSMSHTTPGateway); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_provider_v1_sms_service_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProviderSMSGate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_provider_v1_sms_service_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProviderCreateSMSGateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_provider_v1_sms_service_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProviderCreateSMSGateResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_provider_v1_sms_service_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProviderGetSMSGateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_provider_v1_sms_service_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProviderGetSMSGateResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_provider_v1_sms_service_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProviderUpdateSMSGateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_provider_v1_sms_service_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProviderUpdateSMSGateResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_provider_v1_sms_service_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProviderDeleteSMSGateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_provider_v1_sms_service_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProviderDeleteSMSGateResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_service_provider_v1_sms_service_proto_msgTypes[7].OneofWrappers = []interface{}{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_service_provider_v1_sms_service_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   11,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_service_provider_v1_sms_service_proto_goTypes,
+		DependencyIndexes: file_service_provider_v1_sms_service_proto_depIdxs,
+		EnumInfos:         file_service_provider_v1_sms_service_proto_enumTypes,
+		MessageInfos:      file_service_provider_v1_sms_service_proto_msgTypes,
+	}.Build()
+	File_service_provider_v1_sms_service_proto = out.File
+	file_service_provider_v1_sms_service_proto_rawDesc = nil
+	file_service_provider_v1_sms_service_proto_goTypes = nil
+	file_service_provider_v1_sms_service_proto_depIdxs = nil
+}
@@ -0,0 +1,243 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: service/provider/v1/sms_service.proto
+
+package provider
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	SMSService_CreateSMSGate_FullMethodName = "/webitel.im.provider.v1.SMSService/CreateSMSGate"
+	SMSService_GetSMSGate_FullMethodName    = "/webitel.im.provider.v1.SMSService/GetSMSGate"
+	SMSService_UpdateSMSGate_FullMethodName = "/webitel.im.provider.v1.SMSService/UpdateSMSGate"
+	SMSService_DeleteSMSGate_FullMethodName = "/webitel.im.provider.v1.SMSService/DeleteSMSGate"
+)
+
+// SMSServiceClient is the client API for SMSService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type SMSServiceClient interface {
+	// / CreateSMSGate connects a sender number through an SMSC or an HTTP gateway.
+	CreateSMSGate(ctx context.Context, in *ProviderCreateSMSGateRequest, opts ...grpc.CallOption) (*ProviderCreateSMSGateResponse, error)
+	// / GetSMSGate returns the SMS gate without its credentials.
+	GetSMSGate(ctx context.Context, in *ProviderGetSMSGateRequest, opts ...grpc.CallOption) (*ProviderGetSMSGateResponse, error)
+	// / UpdateSMSGate renames, enables or disables the gate, changes its sender or its SMSC or gateway account.
+	UpdateSMSGate(ctx context.Context, in *ProviderUpdateSMSGateRequest, opts ...grpc.CallOption) (*ProviderUpdateSMSGateResponse, error)
+	// / DeleteSMSGate unbinds and removes the SMS gate.
+	DeleteSMSGate(ctx context.Context, in *ProviderDeleteSMSGateRequest, opts ...grpc.CallOption) (*ProviderDeleteSMSGateResponse, error)
+}
+
+type sMSServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewSMSServiceClient(cc grpc.ClientConnInterface) SMSServiceClient {
+	return &sMSServiceClient{cc}
+}
+
+func (c *sMSServiceClient) CreateSMSGate(ctx context.Context, in *ProviderCreateSMSGateRequest, opts ...grpc.CallOption) (*ProviderCreateSMSGateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProviderCreateSMSGateResponse)
+	err := c.cc.Invoke(ctx, SMSService_CreateSMSGate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sMSServiceClient) GetSMSGate(ctx context.Context, in *ProviderGetSMSGateRequest, opts ...grpc.CallOption) (*ProviderGetSMSGateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProviderGetSMSGateResponse)
+	err := c.cc.Invoke(ctx, SMSService_GetSMSGate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sMSServiceClient) UpdateSMSGate(ctx context.Context, in *ProviderUpdateSMSGateRequest, opts ...grpc.CallOption) (*ProviderUpdateSMSGateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProviderUpdateSMSGateResponse)
+	err := c.cc.Invoke(ctx, SMSService_UpdateSMSGate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sMSServiceClient) DeleteSMSGate(ctx context.Context, in *ProviderDeleteSMSGateRequest, opts ...grpc.CallOption) (*ProviderDeleteSMSGateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProviderDeleteSMSGateResponse)
+	err := c.cc.Invoke(ctx, SMSService_DeleteSMSGate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SMSServiceServer is the server API for SMSService service.
+// All implementations must embed UnimplementedSMSServiceServer
+// for forward compatibility.
+type SMSServiceServer interface {
+	// / CreateSMSGate connects a sender number through an SMSC or an HTTP gateway.
+	CreateSMSGate(context.Context, *ProviderCreateSMSGateRequest) (*ProviderCreateSMSGateResponse, error)
+	// / GetSMSGate returns the SMS gate without its credentials.
+	GetSMSGate(context.Context, *ProviderGetSMSGateRequest) (*ProviderGetSMSGateResponse, error)
+	// / UpdateSMSGate renames, enables or disables the gate, changes its sender or its SMSC or gateway account.
+	UpdateSMSGate(context.Context, *ProviderUpdateSMSGateRequest) (*ProviderUpdateSMSGateResponse, error)
+	// / DeleteSMSGate unbinds and removes the SMS gate.
+	DeleteSMSGate(context.Context, *ProviderDeleteSMSGateRequest) (*ProviderDeleteSMSGateResponse, error)
+	mustEmbedUnimplementedSMSServiceServer()
+}
+
+// UnimplementedSMSServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedSMSServiceServer struct{}
+
+func (UnimplementedSMSServiceServer) CreateSMSGate(context.Context, *ProviderCreateSMSGateRequest) (*ProviderCreateSMSGateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSMSGate not implemented")
+}
+func (UnimplementedSMSServiceServer) GetSMSGate(context.Context, *ProviderGetSMSGateRequest) (*ProviderGetSMSGateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSMSGate not implemented")
+}
+func (UnimplementedSMSServiceServer) UpdateSMSGate(context.Context, *ProviderUpdateSMSGateRequest) (*ProviderUpdateSMSGateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateSMSGate not implemented")
+}
+func (UnimplementedSMSServiceServer) DeleteSMSGate(context.Context, *ProviderDeleteSMSGateRequest) (*ProviderDeleteSMSGateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteSMSGate not implemented")
+}
+func (UnimplementedSMSServiceServer) mustEmbedUnimplementedSMSServiceServer() {}
+func (UnimplementedSMSServiceServer) testEmbeddedByValue()                    {}
+
+// UnsafeSMSServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to SMSServiceServer will
+// result in compilation errors.
+type UnsafeSMSServiceServer interface {
+	mustEmbedUnimplementedSMSServiceServer()
+}
+
+func RegisterSMSServiceServer(s grpc.ServiceRegistrar, srv SMSServiceServer) {
+	// If the following call pancis, it indicates UnimplementedSMSServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&SMSService_ServiceDesc, srv)
+}
+
+func _SMSService_CreateSMSGate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProviderCreateSMSGateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SMSServiceServer).CreateSMSGate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SMSService_CreateSMSGate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SMSServiceServer).CreateSMSGate(ctx, req.(*ProviderCreateSMSGateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SMSService_GetSMSGate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProviderGetSMSGateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SMSServiceServer).GetSMSGate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SMSService_GetSMSGate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SMSServiceServer).GetSMSGate(ctx, req.(*ProviderGetSMSGateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SMSService_UpdateSMSGate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProviderUpdateSMSGateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SMSServiceServer).UpdateSMSGate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SMSService_UpdateSMSGate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SMSServiceServer).UpdateSMSGate(ctx, req.(*ProviderUpdateSMSGateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SMSService_DeleteSMSGate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProviderDeleteSMSGateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SMSServiceServer).DeleteSMSGate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SMSService_DeleteSMSGate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SMSServiceServer).DeleteSMSGate(ctx, req.(*ProviderDeleteSMSGateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// SMSService_ServiceDesc is the grpc.ServiceDesc for SMSService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var SMSService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "webitel.im.provider.v1.SMSService",
+	HandlerType: (*SMSServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateSMSGate",
+			Handler:    _SMSService_CreateSMSGate_Handler,
+		},
+		{
+			MethodName: "GetSMSGate",
+			Handler:    _SMSService_GetSMSGate_Handler,
+		},
+		{
+			MethodName: "UpdateSMSGate",
+			Handler:    _SMSService_UpdateSMSGate_Handler,
+		},
+		{
+			MethodName: "DeleteSMSGate",
+			Handler:    _SMSService_DeleteSMSGate_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "service/provider/v1/sms_service.proto",
+}
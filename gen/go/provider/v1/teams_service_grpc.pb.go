@@ -0,0 +1,243 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: service/provider/v1/teams_service.proto
+
+package provider
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	TeamsService_CreateTeamsGate_FullMethodName = "/webitel.im.provider.v1.TeamsService/CreateTeamsGate"
+	TeamsService_GetTeamsGate_FullMethodName    = "/webitel.im.provider.v1.TeamsService/GetTeamsGate"
+	TeamsService_UpdateTeamsGate_FullMethodName = "/webitel.im.provider.v1.TeamsService/UpdateTeamsGate"
+	TeamsService_DeleteTeamsGate_FullMethodName = "/webitel.im.provider.v1.TeamsService/DeleteTeamsGate"
+)
+
+// TeamsServiceClient is the client API for TeamsService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type TeamsServiceClient interface {
+	// / CreateTeamsGate connects a bot registration and issues its messaging endpoint.
+	CreateTeamsGate(ctx context.Context, in *ProviderCreateTeamsGateRequest, opts ...grpc.CallOption) (*ProviderCreateTeamsGateResponse, error)
+	// / GetTeamsGate returns the Teams gate.
+	GetTeamsGate(ctx context.Context, in *ProviderGetTeamsGateRequest, opts ...grpc.CallOption) (*ProviderGetTeamsGateResponse, error)
+	// / UpdateTeamsGate renames, enables or disables the gate or rotates its client secret.
+	UpdateTeamsGate(ctx context.Context, in *ProviderUpdateTeamsGateRequest, opts ...grpc.CallOption) (*ProviderUpdateTeamsGateResponse, error)
+	// / DeleteTeamsGate removes the Teams gate.
+	DeleteTeamsGate(ctx context.Context, in *ProviderDeleteTeamsGateRequest, opts ...grpc.CallOption) (*ProviderDeleteTeamsGateResponse, error)
+}
+
+type teamsServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewTeamsServiceClient(cc grpc.ClientConnInterface) TeamsServiceClient {
+	return &teamsServiceClient{cc}
+}
+
+func (c *teamsServiceClient) CreateTeamsGate(ctx context.Context, in *ProviderCreateTeamsGateRequest, opts ...grpc.CallOption) (*ProviderCreateTeamsGateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProviderCreateTeamsGateResponse)
+	err := c.cc.Invoke(ctx, TeamsService_CreateTeamsGate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *teamsServiceClient) GetTeamsGate(ctx context.Context, in *ProviderGetTeamsGateRequest, opts ...grpc.CallOption) (*ProviderGetTeamsGateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProviderGetTeamsGateResponse)
+	err := c.cc.Invoke(ctx, TeamsService_GetTeamsGate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *teamsServiceClient) UpdateTeamsGate(ctx context.Context, in *ProviderUpdateTeamsGateRequest, opts ...grpc.CallOption) (*ProviderUpdateTeamsGateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProviderUpdateTeamsGateResponse)
+	err := c.cc.Invoke(ctx, TeamsService_UpdateTeamsGate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *teamsServiceClient) DeleteTeamsGate(ctx context.Context, in *ProviderDeleteTeamsGateRequest, opts ...grpc.CallOption) (*ProviderDeleteTeamsGateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProviderDeleteTeamsGateResponse)
+	err := c.cc.Invoke(ctx, TeamsService_DeleteTeamsGate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// TeamsServiceServer is the server API for TeamsService service.
+// All implementations must embed UnimplementedTeamsServiceServer
+// for forward compatibility.
+type TeamsServiceServer interface {
+	// / CreateTeamsGate connects a bot registration and issues its messaging endpoint.
+	CreateTeamsGate(context.Context, *ProviderCreateTeamsGateRequest) (*ProviderCreateTeamsGateResponse, error)
+	// / GetTeamsGate returns the Teams gate.
+	GetTeamsGate(context.Context, *ProviderGetTeamsGateRequest) (*ProviderGetTeamsGateResponse, error)
+	// / UpdateTeamsGate renames, enables or disables the gate or rotates its client secret.
+	UpdateTeamsGate(context.Context, *ProviderUpdateTeamsGateRequest) (*ProviderUpdateTeamsGateResponse, error)
+	// / DeleteTeamsGate removes the Teams gate.
+	DeleteTeamsGate(context.Context, *ProviderDeleteTeamsGateRequest) (*ProviderDeleteTeamsGateResponse, error)
+	mustEmbedUnimplementedTeamsServiceServer()
+}
+
+// UnimplementedTeamsServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedTeamsServiceServer struct{}
+
+func (UnimplementedTeamsServiceServer) CreateTeamsGate(context.Context, *ProviderCreateTeamsGateRequest) (*ProviderCreateTeamsGateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTeamsGate not implemented")
+}
+func (UnimplementedTeamsServiceServer) GetTeamsGate(context.Context, *ProviderGetTeamsGateRequest) (*ProviderGetTeamsGateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTeamsGate not implemented")
+}
+func (UnimplementedTeamsServiceServer) UpdateTeamsGate(context.Context, *ProviderUpdateTeamsGateRequest) (*ProviderUpdateTeamsGateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateTeamsGate not implemented")
+}
+func (UnimplementedTeamsServiceServer) DeleteTeamsGate(context.Context, *ProviderDeleteTeamsGateRequest) (*ProviderDeleteTeamsGateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteTeamsGate not implemented")
+}
+func (UnimplementedTeamsServiceServer) mustEmbedUnimplementedTeamsServiceServer() {}
+func (UnimplementedTeamsServiceServer) testEmbeddedByValue()                      {}
+
+// UnsafeTeamsServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to TeamsServiceServer will
+// result in compilation errors.
+type UnsafeTeamsServiceServer interface {
+	mustEmbedUnimplementedTeamsServiceServer()
+}
+
+func RegisterTeamsServiceServer(s grpc.ServiceRegistrar, srv TeamsServiceServer) {
+	// If the following call pancis, it indicates UnimplementedTeamsServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&TeamsService_ServiceDesc, srv)
+}
+
+func _TeamsService_CreateTeamsGate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProviderCreateTeamsGateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TeamsServiceServer).CreateTeamsGate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TeamsService_CreateTeamsGate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TeamsServiceServer).CreateTeamsGate(ctx, req.(*ProviderCreateTeamsGateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TeamsService_GetTeamsGate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProviderGetTeamsGateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TeamsServiceServer).GetTeamsGate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TeamsService_GetTeamsGate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TeamsServiceServer).GetTeamsGate(ctx, req.(*ProviderGetTeamsGateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TeamsService_UpdateTeamsGate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProviderUpdateTeamsGateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TeamsServiceServer).UpdateTeamsGate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TeamsService_UpdateTeamsGate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TeamsServiceServer).UpdateTeamsGate(ctx, req.(*ProviderUpdateTeamsGateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TeamsService_DeleteTeamsGate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProviderDeleteTeamsGateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TeamsServiceServer).DeleteTeamsGate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TeamsService_DeleteTeamsGate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TeamsServiceServer).DeleteTeamsGate(ctx, req.(*ProviderDeleteTeamsGateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// TeamsService_ServiceDesc is the grpc.ServiceDesc for TeamsService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var TeamsService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "webitel.im.provider.v1.TeamsService",
+	HandlerType: (*TeamsServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateTeamsGate",
+			Handler:    _TeamsService_CreateTeamsGate_Handler,
+		},
+		{
+			MethodName: "GetTeamsGate",
+			Handler:    _TeamsService_GetTeamsGate_Handler,
+		},
+		{
+			MethodName: "UpdateTeamsGate",
+			Handler:    _TeamsService_UpdateTeamsGate_Handler,
+		},
+		{
+			MethodName: "DeleteTeamsGate",
+			Handler:    _TeamsService_DeleteTeamsGate_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "service/provider/v1/teams_service.proto",
+}
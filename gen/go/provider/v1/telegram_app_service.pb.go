@@ -0,0 +1,1387 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.34.1
+// 	protoc        (unknown)
+// source: service/provider/v1/telegram_app_service.proto
+
+package provider
+
+import (
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// / TelegramAppLoginStep is the input a Telegram account login expects next.
+type TelegramAppLoginStep int32
+
+const (
+	TelegramAppLoginStep_TELEGRAM_APP_LOGIN_STEP_UNSPECIFIED TelegramAppLoginStep = 0
+	TelegramAppLoginStep_TELEGRAM_APP_LOGIN_STEP_CODE        TelegramAppLoginStep = 1 // The code Telegram sent to the account
+	TelegramAppLoginStep_TELEGRAM_APP_LOGIN_STEP_PASSWORD    TelegramAppLoginStep = 2 // The two-step verification password
+	TelegramAppLoginStep_TELEGRAM_APP_LOGIN_STEP_DONE        TelegramAppLoginStep = 3 // The account is connected as a gate
+)
+
+// Enum value maps for TelegramAppLoginStep.
+var (
+	TelegramAppLoginStep_name = map[int32]string{
+		0: "TELEGRAM_APP_LOGIN_STEP_UNSPECIFIED",
+		1: "TELEGRAM_APP_LOGIN_STEP_CODE",
+		2: "TELEGRAM_APP_LOGIN_STEP_PASSWORD",
+		3: "TELEGRAM_APP_LOGIN_STEP_DONE",
+	}
+	TelegramAppLoginStep_value = map[string]int32{
+		"TELEGRAM_APP_LOGIN_STEP_UNSPECIFIED": 0,
+		"TELEGRAM_APP_LOGIN_STEP_CODE":        1,
+		"TELEGRAM_APP_LOGIN_STEP_PASSWORD":    2,
+		"TELEGRAM_APP_LOGIN_STEP_DONE":        3,
+	}
+)
+
+func (x TelegramAppLoginStep) Enum() *TelegramAppLoginStep {
+	p := new(TelegramAppLoginStep)
+	*p = x
+	return p
+}
+
+func (x TelegramAppLoginStep) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TelegramAppLoginStep) Descriptor() protoreflect.EnumDescriptor {
+	return file_service_provider_v1_telegram_app_service_proto_enumTypes[0].Descriptor()
+}
+
+func (TelegramAppLoginStep) Type() protoreflect.EnumType {
+	return &file_service_provider_v1_telegram_app_service_proto_enumTypes[0]
+}
+
+func (x TelegramAppLoginStep) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TelegramAppLoginStep.Descriptor instead.
+func (TelegramAppLoginStep) EnumDescriptor() ([]byte, []int) {
+	return file_service_provider_v1_telegram_app_service_proto_rawDescGZIP(), []int{0}
+}
+
+// / ProviderTelegramAppGate is a Telegram user account connected as a messaging gateway.
+type ProviderTelegramAppGate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        string         `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name      string         `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Peer      *Peer          `protobuf:"bytes,3,opt,name=peer,proto3" json:"peer,omitempty"`                    // Identity details (sub and iss)
+	UserId    int64          `protobuf:"varint,4,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // Telegram ID of the signed-in account
+	Phone     string         `protobuf:"bytes,5,opt,name=phone,proto3" json:"phone,omitempty"`
+	Username  string         `protobuf:"bytes,6,opt,name=username,proto3" json:"username,omitempty"`
+	Status    ProviderStatus `protobuf:"varint,7,opt,name=status,proto3,enum=webitel.im.provider.v1.ProviderStatus" json:"status,omitempty"`
+	CreatedAt int64          `protobuf:"varint,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"` // Unix timestamp in milliseconds
+	UpdatedAt int64          `protobuf:"varint,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"` // Unix timestamp in milliseconds
+	Enabled   bool           `protobuf:"varint,10,opt,name=enabled,proto3" json:"enabled,omitempty"`
+}
+
+func (x *ProviderTelegramAppGate) Reset() {
+	*x = ProviderTelegramAppGate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_provider_v1_telegram_app_service_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProviderTelegramAppGate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProviderTelegramAppGate) ProtoMessage() {}
+
+func (x *ProviderTelegramAppGate) ProtoReflect() protoreflect.Message {
+	mi := &file_service_provider_v1_telegram_app_service_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProviderTelegramAppGate.ProtoReflect.Descriptor instead.
+func (*ProviderTelegramAppGate) Descriptor() ([]byte, []int) {
+	return file_service_provider_v1_telegram_app_service_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ProviderTelegramAppGate) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ProviderTelegramAppGate) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ProviderTelegramAppGate) GetPeer() *Peer {
+	if x != nil {
+		return x.Peer
+	}
+	return nil
+}
+
+func (x *ProviderTelegramAppGate) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ProviderTelegramAppGate) GetPhone() string {
+	if x != nil {
+		return x.Phone
+	}
+	return ""
+}
+
+func (x *ProviderTelegramAppGate) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *ProviderTelegramAppGate) GetStatus() ProviderStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ProviderStatus_PROVIDER_STATUS_UNSPECIFIED
+}
+
+func (x *ProviderTelegramAppGate) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *ProviderTelegramAppGate) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+func (x *ProviderTelegramAppGate) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+// / ProviderTelegramAppLogin is an in-progress login; item is set once it is done.
+type ProviderTelegramAppLogin struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id    string                   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Phone string                   `protobuf:"bytes,2,opt,name=phone,proto3" json:"phone,omitempty"`
+	Step  TelegramAppLoginStep     `protobuf:"varint,3,opt,name=step,proto3,enum=webitel.im.provider.v1.TelegramAppLoginStep" json:"step,omitempty"`
+	Item  *ProviderTelegramAppGate `protobuf:"bytes,4,opt,name=item,proto3" json:"item,omitempty"`
+}
+
+func (x *ProviderTelegramAppLogin) Reset() {
+	*x = ProviderTelegramAppLogin{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_provider_v1_telegram_app_service_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProviderTelegramAppLogin) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProviderTelegramAppLogin) ProtoMessage() {}
+
+func (x *ProviderTelegramAppLogin) ProtoReflect() protoreflect.Message {
+	mi := &file_service_provider_v1_telegram_app_service_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProviderTelegramAppLogin.ProtoReflect.Descriptor instead.
+func (*ProviderTelegramAppLogin) Descriptor() ([]byte, []int) {
+	return file_service_provider_v1_telegram_app_service_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ProviderTelegramAppLogin) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ProviderTelegramAppLogin) GetPhone() string {
+	if x != nil {
+		return x.Phone
+	}
+	return ""
+}
+
+func (x *ProviderTelegramAppLogin) GetStep() TelegramAppLoginStep {
+	if x != nil {
+		return x.Step
+	}
+	return TelegramAppLoginStep_TELEGRAM_APP_LOGIN_STEP_UNSPECIFIED
+}
+
+func (x *ProviderTelegramAppLogin) GetItem() *ProviderTelegramAppGate {
+	if x != nil {
+		return x.Item
+	}
+	return nil
+}
+
+// / ProviderStartTelegramAppLoginRequest requests a login code for the account of the phone.
+type ProviderStartTelegramAppLoginRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name  string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Phone string `protobuf:"bytes,2,opt,name=phone,proto3" json:"phone,omitempty"` // International format, e.g. +380501234567
+	Peer  *Peer  `protobuf:"bytes,3,opt,name=peer,proto3" json:"peer,omitempty"`   // Identity details (sub and iss)
+}
+
+func (x *ProviderStartTelegramAppLoginRequest) Reset() {
+	*x = ProviderStartTelegramAppLoginRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_provider_v1_telegram_app_service_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProviderStartTelegramAppLoginRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProviderStartTelegramAppLoginRequest) ProtoMessage() {}
+
+func (x *ProviderStartTelegramAppLoginRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_provider_v1_telegram_app_service_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProviderStartTelegramAppLoginRequest.ProtoReflect.Descriptor instead.
+func (*ProviderStartTelegramAppLoginRequest) Descriptor() ([]byte, []int) {
+	return file_service_provider_v1_telegram_app_service_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ProviderStartTelegramAppLoginRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ProviderStartTelegramAppLoginRequest) GetPhone() string {
+	if x != nil {
+		return x.Phone
+	}
+	return ""
+}
+
+func (x *ProviderStartTelegramAppLoginRequest) GetPeer() *Peer {
+	if x != nil {
+		return x.Peer
+	}
+	return nil
+}
+
+type ProviderStartTelegramAppLoginResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Login *ProviderTelegramAppLogin `protobuf:"bytes,1,opt,name=login,proto3" json:"login,omitempty"`
+}
+
+func (x *ProviderStartTelegramAppLoginResponse) Reset() {
+	*x = ProviderStartTelegramAppLoginResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_provider_v1_telegram_app_service_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProviderStartTelegramAppLoginResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProviderStartTelegramAppLoginResponse) ProtoMessage() {}
+
+func (x *ProviderStartTelegramAppLoginResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_provider_v1_telegram_app_service_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProviderStartTelegramAppLoginResponse.ProtoReflect.Descriptor instead.
+func (*ProviderStartTelegramAppLoginResponse) Descriptor() ([]byte, []int) {
+	return file_service_provider_v1_telegram_app_service_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ProviderStartTelegramAppLoginResponse) GetLogin() *ProviderTelegramAppLogin {
+	if x != nil {
+		return x.Login
+	}
+	return nil
+}
+
+// / ProviderSubmitTelegramAppCodeRequest continues the login with the received code.
+type ProviderSubmitTelegramAppCodeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LoginId string `protobuf:"bytes,1,opt,name=login_id,json=loginId,proto3" json:"login_id,omitempty"`
+	Code    string `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+}
+
+func (x *ProviderSubmitTelegramAppCodeRequest) Reset() {
+	*x = ProviderSubmitTelegramAppCodeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_provider_v1_telegram_app_service_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProviderSubmitTelegramAppCodeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProviderSubmitTelegramAppCodeRequest) ProtoMessage() {}
+
+func (x *ProviderSubmitTelegramAppCodeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_provider_v1_telegram_app_service_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProviderSubmitTelegramAppCodeRequest.ProtoReflect.Descriptor instead.
+func (*ProviderSubmitTelegramAppCodeRequest) Descriptor() ([]byte, []int) {
+	return file_service_provider_v1_telegram_app_service_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ProviderSubmitTelegramAppCodeRequest) GetLoginId() string {
+	if x != nil {
+		return x.LoginId
+	}
+	return ""
+}
+
+func (x *ProviderSubmitTelegramAppCodeRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+type ProviderSubmitTelegramAppCodeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Login *ProviderTelegramAppLogin `protobuf:"bytes,1,opt,name=login,proto3" json:"login,omitempty"`
+}
+
+func (x *ProviderSubmitTelegramAppCodeResponse) Reset() {
+	*x = ProviderSubmitTelegramAppCodeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_provider_v1_telegram_app_service_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProviderSubmitTelegramAppCodeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProviderSubmitTelegramAppCodeResponse) ProtoMessage() {}
+
+func (x *ProviderSubmitTelegramAppCodeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_provider_v1_telegram_app_service_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProviderSubmitTelegramAppCodeResponse.ProtoReflect.Descriptor instead.
+func (*ProviderSubmitTelegramAppCodeResponse) Descriptor() ([]byte, []int) {
+	return file_service_provider_v1_telegram_app_service_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ProviderSubmitTelegramAppCodeResponse) GetLogin() *ProviderTelegramAppLogin {
+	if x != nil {
+		return x.Login
+	}
+	return nil
+}
+
+// / ProviderSubmitTelegramAppPasswordRequest completes the login of an account with two-step verification.
+type ProviderSubmitTelegramAppPasswordRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LoginId  string `protobuf:"bytes,1,opt,name=login_id,json=loginId,proto3" json:"login_id,omitempty"`
+	Password string `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+}
+
+func (x *ProviderSubmitTelegramAppPasswordRequest) Reset() {
+	*x = ProviderSubmitTelegramAppPasswordRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_provider_v1_telegram_app_service_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProviderSubmitTelegramAppPasswordRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProviderSubmitTelegramAppPasswordRequest) ProtoMessage() {}
+
+func (x *ProviderSubmitTelegramAppPasswordRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_provider_v1_telegram_app_service_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProviderSubmitTelegramAppPasswordRequest.ProtoReflect.Descriptor instead.
+func (*ProviderSubmitTelegramAppPasswordRequest) Descriptor() ([]byte, []int) {
+	return file_service_provider_v1_telegram_app_service_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ProviderSubmitTelegramAppPasswordRequest) GetLoginId() string {
+	if x != nil {
+		return x.LoginId
+	}
+	return ""
+}
+
+func (x *ProviderSubmitTelegramAppPasswordRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+type ProviderSubmitTelegramAppPasswordResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Login *ProviderTelegramAppLogin `protobuf:"bytes,1,opt,name=login,proto3" json:"login,omitempty"`
+}
+
+func (x *ProviderSubmitTelegramAppPasswordResponse) Reset() {
+	*x = ProviderSubmitTelegramAppPasswordResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_provider_v1_telegram_app_service_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProviderSubmitTelegramAppPasswordResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProviderSubmitTelegramAppPasswordResponse) ProtoMessage() {}
+
+func (x *ProviderSubmitTelegramAppPasswordResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_provider_v1_telegram_app_service_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProviderSubmitTelegramAppPasswordResponse.ProtoReflect.Descriptor instead.
+func (*ProviderSubmitTelegramAppPasswordResponse) Descriptor() ([]byte, []int) {
+	return file_service_provider_v1_telegram_app_service_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ProviderSubmitTelegramAppPasswordResponse) GetLogin() *ProviderTelegramAppLogin {
+	if x != nil {
+		return x.Login
+	}
+	return nil
+}
+
+type ProviderGetTelegramAppGateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *ProviderGetTelegramAppGateRequest) Reset() {
+	*x = ProviderGetTelegramAppGateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_provider_v1_telegram_app_service_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProviderGetTelegramAppGateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProviderGetTelegramAppGateRequest) ProtoMessage() {}
+
+func (x *ProviderGetTelegramAppGateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_provider_v1_telegram_app_service_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProviderGetTelegramAppGateRequest.ProtoReflect.Descriptor instead.
+func (*ProviderGetTelegramAppGateRequest) Descriptor() ([]byte, []int) {
+	return file_service_provider_v1_telegram_app_service_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ProviderGetTelegramAppGateRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ProviderGetTelegramAppGateResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Item *ProviderTelegramAppGate `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`
+}
+
+func (x *ProviderGetTelegramAppGateResponse) Reset() {
+	*x = ProviderGetTelegramAppGateResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_provider_v1_telegram_app_service_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProviderGetTelegramAppGateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProviderGetTelegramAppGateResponse) ProtoMessage() {}
+
+func (x *ProviderGetTelegramAppGateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_provider_v1_telegram_app_service_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProviderGetTelegramAppGateResponse.ProtoReflect.Descriptor instead.
+func (*ProviderGetTelegramAppGateResponse) Descriptor() ([]byte, []int) {
+	return file_service_provider_v1_telegram_app_service_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ProviderGetTelegramAppGateResponse) GetItem() *ProviderTelegramAppGate {
+	if x != nil {
+		return x.Item
+	}
+	return nil
+}
+
+type ProviderUpdateTelegramAppGateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id      string  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name    *string `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	Enabled *bool   `protobuf:"varint,3,opt,name=enabled,proto3,oneof" json:"enabled,omitempty"`
+	Peer    *Peer   `protobuf:"bytes,4,opt,name=peer,proto3" json:"peer,omitempty"` // Identity details (sub and iss); kept when unset
+}
+
+func (x *ProviderUpdateTelegramAppGateRequest) Reset() {
+	*x = ProviderUpdateTelegramAppGateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_provider_v1_telegram_app_service_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProviderUpdateTelegramAppGateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProviderUpdateTelegramAppGateRequest) ProtoMessage() {}
+
+func (x *ProviderUpdateTelegramAppGateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_provider_v1_telegram_app_service_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProviderUpdateTelegramAppGateRequest.ProtoReflect.Descriptor instead.
+func (*ProviderUpdateTelegramAppGateRequest) Descriptor() ([]byte, []int) {
+	return file_service_provider_v1_telegram_app_service_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ProviderUpdateTelegramAppGateRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ProviderUpdateTelegramAppGateRequest) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *ProviderUpdateTelegramAppGateRequest) GetEnabled() bool {
+	if x != nil && x.Enabled != nil {
+		return *x.Enabled
+	}
+	return false
+}
+
+func (x *ProviderUpdateTelegramAppGateRequest) GetPeer() *Peer {
+	if x != nil {
+		return x.Peer
+	}
+	return nil
+}
+
+type ProviderUpdateTelegramAppGateResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Item *ProviderTelegramAppGate `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`
+}
+
+func (x *ProviderUpdateTelegramAppGateResponse) Reset() {
+	*x = ProviderUpdateTelegramAppGateResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_provider_v1_telegram_app_service_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProviderUpdateTelegramAppGateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProviderUpdateTelegramAppGateResponse) ProtoMessage() {}
+
+func (x *ProviderUpdateTelegramAppGateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_provider_v1_telegram_app_service_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProviderUpdateTelegramAppGateResponse.ProtoReflect.Descriptor instead.
+func (*ProviderUpdateTelegramAppGateResponse) Descriptor() ([]byte, []int) {
+	return file_service_provider_v1_telegram_app_service_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ProviderUpdateTelegramAppGateResponse) GetItem() *ProviderTelegramAppGate {
+	if x != nil {
+		return x.Item
+	}
+	return nil
+}
+
+// / ProviderDeleteTelegramAppGateRequest disconnects and removes the gate.
+type ProviderDeleteTelegramAppGateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *ProviderDeleteTelegramAppGateRequest) Reset() {
+	*x = ProviderDeleteTelegramAppGateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_provider_v1_telegram_app_service_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProviderDeleteTelegramAppGateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProviderDeleteTelegramAppGateRequest) ProtoMessage() {}
+
+func (x *ProviderDeleteTelegramAppGateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_provider_v1_telegram_app_service_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProviderDeleteTelegramAppGateRequest.ProtoReflect.Descriptor instead.
+func (*ProviderDeleteTelegramAppGateRequest) Descriptor() ([]byte, []int) {
+	return file_service_provider_v1_telegram_app_service_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ProviderDeleteTelegramAppGateRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ProviderDeleteTelegramAppGateResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Item *ProviderTelegramAppGate `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`
+}
+
+func (x *ProviderDeleteTelegramAppGateResponse) Reset() {
+	*x = ProviderDeleteTelegramAppGateResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_provider_v1_telegram_app_service_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProviderDeleteTelegramAppGateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProviderDeleteTelegramAppGateResponse) ProtoMessage() {}
+
+func (x *ProviderDeleteTelegramAppGateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_provider_v1_telegram_app_service_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProviderDeleteTelegramAppGateResponse.ProtoReflect.Descriptor instead.
+func (*ProviderDeleteTelegramAppGateResponse) Descriptor() ([]byte, []int) {
+	return file_service_provider_v1_telegram_app_service_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ProviderDeleteTelegramAppGateResponse) GetItem() *ProviderTelegramAppGate {
+	if x != nil {
+		return x.Item
+	}
+	return nil
+}
+
+var File_service_provider_v1_telegram_app_service_proto protoreflect.FileDescriptor
+
+var file_service_provider_v1_telegram_app_service_proto_rawDesc = []byte{
+	0x0a, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64,
+	0x65, 0x72, 0x2f, 0x76, 0x31, 0x2f, 0x74, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x5f, 0x61,
+	0x70, 0x70, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x12, 0x16, 0x77, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x2e, 0x69, 0x6d, 0x2e, 0x70, 0x72, 0x6f,
+	0x76, 0x69, 0x64, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f,
+	0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x2f, 0x76, 0x31, 0x2f, 0x65, 0x6e, 0x75, 0x6d,
+	0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x22, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x2f, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x2f, 0x76, 0x31, 0x2f, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xd2, 0x02, 0x0a, 0x17,
+	0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x54, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d,
+	0x41, 0x70, 0x70, 0x47, 0x61, 0x74, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x30, 0x0a, 0x04, 0x70,
+	0x65, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x77, 0x65, 0x62, 0x69,
+	0x74, 0x65, 0x6c, 0x2e, 0x69, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x2e,
+	0x76, 0x31, 0x2e, 0x50, 0x65, 0x65, 0x72, 0x52, 0x04, 0x70, 0x65, 0x65, 0x72, 0x12, 0x17, 0x0a,
+	0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06,
+	0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x12, 0x1a, 0x0a, 0x08,
+	0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x3e, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x26, 0x2e, 0x77, 0x65, 0x62, 0x69, 0x74,
+	0x65, 0x6c, 0x2e, 0x69, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x2e, 0x76,
+	0x31, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x75, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65,
+	0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64,
+	0x22, 0xc7, 0x01, 0x0a, 0x18, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x54, 0x65, 0x6c,
+	0x65, 0x67, 0x72, 0x61, 0x6d, 0x41, 0x70, 0x70, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a,
+	0x05, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x70, 0x68,
+	0x6f, 0x6e, 0x65, 0x12, 0x40, 0x0a, 0x04, 0x73, 0x74, 0x65, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x2c, 0x2e, 0x77, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x2e, 0x69, 0x6d, 0x2e, 0x70,
+	0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x65, 0x6c, 0x65, 0x67,
+	0x72, 0x61, 0x6d, 0x41, 0x70, 0x70, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x53, 0x74, 0x65, 0x70, 0x52,
+	0x04, 0x73, 0x74, 0x65, 0x70, 0x12, 0x43, 0x0a, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x77, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x2e, 0x69, 0x6d,
+	0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f,
+	0x76, 0x69, 0x64, 0x65, 0x72, 0x54, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x41, 0x70, 0x70,
+	0x47, 0x61, 0x74, 0x65, 0x52, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x22, 0x82, 0x01, 0x0a, 0x24, 0x50,
+	0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x53, 0x74, 0x61, 0x72, 0x74, 0x54, 0x65, 0x6c, 0x65,
+	0x67, 0x72, 0x61, 0x6d, 0x41, 0x70, 0x70, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x68, 0x6f, 0x6e, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x12, 0x30, 0x0a,
+	0x04, 0x70, 0x65, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x77, 0x65,
+	0x62, 0x69, 0x74, 0x65, 0x6c, 0x2e, 0x69, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65,
+	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x65, 0x65, 0x72, 0x52, 0x04, 0x70, 0x65, 0x65, 0x72, 0x22,
+	0x6f, 0x0a, 0x25, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x53, 0x74, 0x61, 0x72, 0x74,
+	0x54, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x41, 0x70, 0x70, 0x4c, 0x6f, 0x67, 0x69, 0x6e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x46, 0x0a, 0x05, 0x6c, 0x6f, 0x67, 0x69,
+	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x77, 0x65, 0x62, 0x69, 0x74, 0x65,
+	0x6c, 0x2e, 0x69, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x2e, 0x76, 0x31,
+	0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x54, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61,
+	0x6d, 0x41, 0x70, 0x70, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x05, 0x6c, 0x6f, 0x67, 0x69, 0x6e,
+	0x22, 0x55, 0x0a, 0x24, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x53, 0x75, 0x62, 0x6d,
+	0x69, 0x74, 0x54, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x41, 0x70, 0x70, 0x43, 0x6f, 0x64,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x6c, 0x6f, 0x67, 0x69,
+	0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6c, 0x6f, 0x67, 0x69,
+	0x6e, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x22, 0x6f, 0x0a, 0x25, 0x50, 0x72, 0x6f, 0x76, 0x69,
+	0x64, 0x65, 0x72, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x54, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61,
+	0x6d, 0x41, 0x70, 0x70, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x46, 0x0a, 0x05, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x30, 0x2e, 0x77, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x2e, 0x69, 0x6d, 0x2e, 0x70, 0x72, 0x6f,
+	0x76, 0x69, 0x64, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65,
+	0x72, 0x54, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x41, 0x70, 0x70, 0x4c, 0x6f, 0x67, 0x69,
+	0x6e, 0x52, 0x05, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x22, 0x61, 0x0a, 0x28, 0x50, 0x72, 0x6f, 0x76,
+	0x69, 0x64, 0x65, 0x72, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x54, 0x65, 0x6c, 0x65, 0x67, 0x72,
+	0x61, 0x6d, 0x41, 0x70, 0x70, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x49, 0x64, 0x12,
+	0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x22, 0x73, 0x0a, 0x29, 0x50,
+	0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x54, 0x65, 0x6c,
+	0x65, 0x67, 0x72, 0x61, 0x6d, 0x41, 0x70, 0x70, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x46, 0x0a, 0x05, 0x6c, 0x6f, 0x67, 0x69,
+	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x77, 0x65, 0x62, 0x69, 0x74, 0x65,
+	0x6c, 0x2e, 0x69, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x2e, 0x76, 0x31,
+	0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x54, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61,
+	0x6d, 0x41, 0x70, 0x70, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x05, 0x6c, 0x6f, 0x67, 0x69, 0x6e,
+	0x22, 0x33, 0x0a, 0x21, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x47, 0x65, 0x74, 0x54,
+	0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x41, 0x70, 0x70, 0x47, 0x61, 0x74, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x69, 0x0a, 0x22, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65,
+	0x72, 0x47, 0x65, 0x74, 0x54, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x41, 0x70, 0x70, 0x47,
+	0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x43, 0x0a, 0x04, 0x69,
+	0x74, 0x65, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x77, 0x65, 0x62, 0x69,
+	0x74, 0x65, 0x6c, 0x2e, 0x69, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x2e,
+	0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x54, 0x65, 0x6c, 0x65, 0x67,
+	0x72, 0x61, 0x6d, 0x41, 0x70, 0x70, 0x47, 0x61, 0x74, 0x65, 0x52, 0x04, 0x69, 0x74, 0x65, 0x6d,
+	0x22, 0xb5, 0x01, 0x0a, 0x24, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x54, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x41, 0x70, 0x70, 0x47, 0x61,
+	0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x17, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x88,
+	0x01, 0x01, 0x12, 0x1d, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x08, 0x48, 0x01, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x88, 0x01,
+	0x01, 0x12, 0x30, 0x0a, 0x04, 0x70, 0x65, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1c, 0x2e, 0x77, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x2e, 0x69, 0x6d, 0x2e, 0x70, 0x72, 0x6f,
+	0x76, 0x69, 0x64, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x65, 0x65, 0x72, 0x52, 0x04, 0x70,
+	0x65, 0x65, 0x72, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x42, 0x0a, 0x0a, 0x08,
+	0x5f, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x22, 0x6c, 0x0a, 0x25, 0x50, 0x72, 0x6f, 0x76,
+	0x69, 0x64, 0x65, 0x72, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x65, 0x6c, 0x65, 0x67, 0x72,
+	0x61, 0x6d, 0x41, 0x70, 0x70, 0x47, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x43, 0x0a, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x2f, 0x2e, 0x77, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x2e, 0x69, 0x6d, 0x2e, 0x70, 0x72, 0x6f,
+	0x76, 0x69, 0x64, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65,
+	0x72, 0x54, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x41, 0x70, 0x70, 0x47, 0x61, 0x74, 0x65,
+	0x52, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x22, 0x36, 0x0a, 0x24, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64,
+	0x65, 0x72, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d,
+	0x41, 0x70, 0x70, 0x47, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x6c,
+	0x0a, 0x25, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x54, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x41, 0x70, 0x70, 0x47, 0x61, 0x74, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x43, 0x0a, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x77, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x2e,
+	0x69, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50,
+	0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x54, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x41,
+	0x70, 0x70, 0x47, 0x61, 0x74, 0x65, 0x52, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x2a, 0xa9, 0x01, 0x0a,
+	0x14, 0x54, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x41, 0x70, 0x70, 0x4c, 0x6f, 0x67, 0x69,
+	0x6e, 0x53, 0x74, 0x65, 0x70, 0x12, 0x27, 0x0a, 0x23, 0x54, 0x45, 0x4c, 0x45, 0x47, 0x52, 0x41,
+	0x4d, 0x5f, 0x41, 0x50, 0x50, 0x5f, 0x4c, 0x4f, 0x47, 0x49, 0x4e, 0x5f, 0x53, 0x54, 0x45, 0x50,
+	0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x20,
+	0x0a, 0x1c, 0x54, 0x45, 0x4c, 0x45, 0x47, 0x52, 0x41, 0x4d, 0x5f, 0x41, 0x50, 0x50, 0x5f, 0x4c,
+	0x4f, 0x47, 0x49, 0x4e, 0x5f, 0x53, 0x54, 0x45, 0x50, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x10, 0x01,
+	0x12, 0x24, 0x0a, 0x20, 0x54, 0x45, 0x4c, 0x45, 0x47, 0x52, 0x41, 0x4d, 0x5f, 0x41, 0x50, 0x50,
+	0x5f, 0x4c, 0x4f, 0x47, 0x49, 0x4e, 0x5f, 0x53, 0x54, 0x45, 0x50, 0x5f, 0x50, 0x41, 0x53, 0x53,
+	0x57, 0x4f, 0x52, 0x44, 0x10, 0x02, 0x12, 0x20, 0x0a, 0x1c, 0x54, 0x45, 0x4c, 0x45, 0x47, 0x52,
+	0x41, 0x4d, 0x5f, 0x41, 0x50, 0x50, 0x5f, 0x4c, 0x4f, 0x47, 0x49, 0x4e, 0x5f, 0x53, 0x54, 0x45,
+	0x50, 0x5f, 0x44, 0x4f, 0x4e, 0x45, 0x10, 0x03, 0x32, 0xb5, 0x09, 0x0a, 0x12, 0x54, 0x65, 0x6c,
+	0x65, 0x67, 0x72, 0x61, 0x6d, 0x41, 0x70, 0x70, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12,
+	0xbe, 0x01, 0x0a, 0x15, 0x53, 0x74, 0x61, 0x72, 0x74, 0x54, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61,
+	0x6d, 0x41, 0x70, 0x70, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x12, 0x3c, 0x2e, 0x77, 0x65, 0x62, 0x69,
+	0x74, 0x65, 0x6c, 0x2e, 0x69, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x2e,
+	0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x53, 0x74, 0x61, 0x72, 0x74,
+	0x54, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x41, 0x70, 0x70, 0x4c, 0x6f, 0x67, 0x69, 0x6e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x3d, 0x2e, 0x77, 0x65, 0x62, 0x69, 0x74, 0x65,
+	0x6c, 0x2e, 0x69, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x2e, 0x76, 0x31,
+	0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x53, 0x74, 0x61, 0x72, 0x74, 0x54, 0x65,
+	0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x41, 0x70, 0x70, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x28, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x22, 0x3a, 0x01,
+	0x2a, 0x22, 0x1d, 0x2f, 0x69, 0x6d, 0x2f, 0x67, 0x61, 0x74, 0x65, 0x73, 0x2f, 0x74, 0x65, 0x6c,
+	0x65, 0x67, 0x72, 0x61, 0x6d, 0x2d, 0x61, 0x70, 0x70, 0x2f, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x73,
+	0x12, 0xce, 0x01, 0x0a, 0x15, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x54, 0x65, 0x6c, 0x65, 0x67,
+	0x72, 0x61, 0x6d, 0x41, 0x70, 0x70, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x3c, 0x2e, 0x77, 0x65, 0x62,
+	0x69, 0x74, 0x65, 0x6c, 0x2e, 0x69, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
+	0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x53, 0x75, 0x62, 0x6d,
+	0x69, 0x74, 0x54, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x41, 0x70, 0x70, 0x43, 0x6f, 0x64,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x3d, 0x2e, 0x77, 0x65, 0x62, 0x69, 0x74,
+	0x65, 0x6c, 0x2e, 0x69, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x2e, 0x76,
+	0x31, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74,
+	0x54, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x41, 0x70, 0x70, 0x43, 0x6f, 0x64, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x38, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x32, 0x3a,
+	0x01, 0x2a, 0x22, 0x2d, 0x2f, 0x69, 0x6d, 0x2f, 0x67, 0x61, 0x74, 0x65, 0x73, 0x2f, 0x74, 0x65,
+	0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x2d, 0x61, 0x70, 0x70, 0x2f, 0x6c, 0x6f, 0x67, 0x69, 0x6e,
+	0x73, 0x2f, 0x7b, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x63, 0x6f, 0x64,
+	0x65, 0x12, 0xde, 0x01, 0x0a, 0x19, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x54, 0x65, 0x6c, 0x65,
+	0x67, 0x72, 0x61, 0x6d, 0x41, 0x70, 0x70, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12,
+	0x40, 0x2e, 0x77, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x2e, 0x69, 0x6d, 0x2e, 0x70, 0x72, 0x6f,
+	0x76, 0x69, 0x64, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65,
+	0x72, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x54, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x41,
+	0x70, 0x70, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x41, 0x2e, 0x77, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x2e, 0x69, 0x6d, 0x2e, 0x70,
+	0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69,
+	0x64, 0x65, 0x72, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x54, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61,
+	0x6d, 0x41, 0x70, 0x70, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x36, 0x3a, 0x01, 0x2a, 0x22,
+	0x31, 0x2f, 0x69, 0x6d, 0x2f, 0x67, 0x61, 0x74, 0x65, 0x73, 0x2f, 0x74, 0x65, 0x6c, 0x65, 0x67,
+	0x72, 0x61, 0x6d, 0x2d, 0x61, 0x70, 0x70, 0x2f, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x73, 0x2f, 0x7b,
+	0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f,
+	0x72, 0x64, 0x12, 0xb0, 0x01, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x54, 0x65, 0x6c, 0x65, 0x67, 0x72,
+	0x61, 0x6d, 0x41, 0x70, 0x70, 0x47, 0x61, 0x74, 0x65, 0x12, 0x39, 0x2e, 0x77, 0x65, 0x62, 0x69,
+	0x74, 0x65, 0x6c, 0x2e, 0x69, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x2e,
+	0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x47, 0x65, 0x74, 0x54, 0x65,
+	0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x41, 0x70, 0x70, 0x47, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x3a, 0x2e, 0x77, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x2e, 0x69,
+	0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72,
+	0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x47, 0x65, 0x74, 0x54, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61,
+	0x6d, 0x41, 0x70, 0x70, 0x47, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x23, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1d, 0x12, 0x1b, 0x2f, 0x69, 0x6d, 0x2f, 0x67, 0x61,
+	0x74, 0x65, 0x73, 0x2f, 0x74, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x2d, 0x61, 0x70, 0x70,
+	0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12, 0xbc, 0x01, 0x0a, 0x15, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x54, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x41, 0x70, 0x70, 0x47, 0x61, 0x74, 0x65, 0x12,
+	0x3c, 0x2e, 0x77, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x2e, 0x69, 0x6d, 0x2e, 0x70, 0x72, 0x6f,
+	0x76, 0x69, 0x64, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65,
+	0x72, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x41,
+	0x70, 0x70, 0x47, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x3d, 0x2e,
+	0x77, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x2e, 0x69, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69,
+	0x64, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x41, 0x70, 0x70,
+	0x47, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x26, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x20, 0x3a, 0x01, 0x2a, 0x32, 0x1b, 0x2f, 0x69, 0x6d, 0x2f, 0x67, 0x61, 0x74,
+	0x65, 0x73, 0x2f, 0x74, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x2d, 0x61, 0x70, 0x70, 0x2f,
+	0x7b, 0x69, 0x64, 0x7d, 0x12, 0xb9, 0x01, 0x0a, 0x15, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x54,
+	0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x41, 0x70, 0x70, 0x47, 0x61, 0x74, 0x65, 0x12, 0x3c,
+	0x2e, 0x77, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x2e, 0x69, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x76,
+	0x69, 0x64, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x41, 0x70,
+	0x70, 0x47, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x3d, 0x2e, 0x77,
+	0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x2e, 0x69, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64,
+	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x54, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x41, 0x70, 0x70, 0x47,
+	0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x23, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x1d, 0x2a, 0x1b, 0x2f, 0x69, 0x6d, 0x2f, 0x67, 0x61, 0x74, 0x65, 0x73, 0x2f, 0x74,
+	0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x2d, 0x61, 0x70, 0x70, 0x2f, 0x7b, 0x69, 0x64, 0x7d,
+	0x42, 0xea, 0x01, 0x0a, 0x1a, 0x63, 0x6f, 0x6d, 0x2e, 0x77, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c,
+	0x2e, 0x69, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x42,
+	0x17, 0x54, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x41, 0x70, 0x70, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x38, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x77, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x2f, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x67, 0x6f, 0x2f, 0x69, 0x6d, 0x2f,
+	0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x2f, 0x76, 0x31, 0x3b, 0x70, 0x72, 0x6f, 0x76,
+	0x69, 0x64, 0x65, 0x72, 0xa2, 0x02, 0x03, 0x57, 0x49, 0x50, 0xaa, 0x02, 0x16, 0x57, 0x65, 0x62,
+	0x69, 0x74, 0x65, 0x6c, 0x2e, 0x49, 0x6d, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
+	0x2e, 0x56, 0x31, 0xca, 0x02, 0x16, 0x57, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x5c, 0x49, 0x6d,
+	0x5c, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x22, 0x57,
+	0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x5c, 0x49, 0x6d, 0x5c, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64,
+	0x65, 0x72, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
+	0x61, 0xea, 0x02, 0x19, 0x57, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x3a, 0x3a, 0x49, 0x6d, 0x3a,
+	0x3a, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_service_provider_v1_telegram_app_service_proto_rawDescOnce sync.Once
+	file_service_provider_v1_telegram_app_service_proto_rawDescData = file_service_provider_v1_telegram_app_service_proto_rawDesc
+)
+
+func file_service_provider_v1_telegram_app_service_proto_rawDescGZIP() []byte {
+	file_service_provider_v1_telegram_app_service_proto_rawDescOnce.Do(func() {
+		file_service_provider_v1_telegram_app_service_proto_rawDescData = protoimpl.X.CompressGZIP(file_service_provider_v1_telegram_app_service_proto_rawDescData)
+	})
+	return file_service_provider_v1_telegram_app_service_proto_rawDescData
+}
+
+var file_service_provider_v1_telegram_app_service_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_service_provider_v1_telegram_app_service_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
+var file_service_provider_v1_telegram_app_service_proto_goTypes = []interface{}{
+	(TelegramAppLoginStep)(0),                         // 0: webitel.im.provider.v1.TelegramAppLoginStep
+	(*ProviderTelegramAppGate)(nil),                   // 1: webitel.im.provider.v1.ProviderTelegramAppGate
+	(*ProviderTelegramAppLogin)(nil),                  // 2: webitel.im.provider.v1.ProviderTelegramAppLogin
+	(*ProviderStartTelegramAppLoginRequest)(nil),      // 3: webitel.im.provider.v1.ProviderStartTelegramAppLoginRequest
+	(*ProviderStartTelegramAppLoginResponse)(nil),     // 4: webitel.im.provider.v1.ProviderStartTelegramAppLoginResponse
+	(*ProviderSubmitTelegramAppCodeRequest)(nil),      // 5: webitel.im.provider.v1.ProviderSubmitTelegramAppCodeRequest
+	(*ProviderSubmitTelegramAppCodeResponse)(nil),     // 6: webitel.im.provider.v1.ProviderSubmitTelegramAppCodeResponse
+	(*ProviderSubmitTelegramAppPasswordRequest)(nil),  // 7: webitel.im.provider.v1.ProviderSubmitTelegramAppPasswordRequest
+	(*ProviderSubmitTelegramAppPasswordResponse)(nil), // 8: webitel.im.provider.v1.ProviderSubmitTelegramAppPasswordResponse
+	(*ProviderGetTelegramAppGateRequest)(nil),         // 9: webitel.im.provider.v1.ProviderGetTelegramAppGateRequest
+	(*ProviderGetTelegramAppGateResponse)(nil),        // 10: webitel.im.provider.v1.ProviderGetTelegramAppGateResponse
+	(*ProviderUpdateTelegramAppGateRequest)(nil),      // 11: webitel.im.provider.v1.ProviderUpdateTelegramAppGateRequest
+	(*ProviderUpdateTelegramAppGateResponse)(nil),     // 12: webitel.im.provider.v1.ProviderUpdateTelegramAppGateResponse
+	(*ProviderDeleteTelegramAppGateRequest)(nil),      // 13: webitel.im.provider.v1.ProviderDeleteTelegramAppGateRequest
+	(*ProviderDeleteTelegramAppGateResponse)(nil),     // 14: webitel.im.provider.v1.ProviderDeleteTelegramAppGateResponse
+	(*Peer)(nil),        // 15: webitel.im.provider.v1.Peer
+	(ProviderStatus)(0), // 16: webitel.im.provider.v1.ProviderStatus
+}
+var file_service_provider_v1_telegram_app_service_proto_depIdxs = []int32{
+	15, // 0: webitel.im.provider.v1.ProviderTelegramAppGate.peer:type_name -> webitel.im.provider.v1.Peer
+	16, // 1: webitel.im.provider.v1.ProviderTelegramAppGate.status:type_name -> webitel.im.provider.v1.ProviderStatus
+	0,  // 2: webitel.im.provider.v1.ProviderTelegramAppLogin.step:type_name -> webitel.im.provider.v1.TelegramAppLoginStep
+	1,  // 3: webitel.im.provider.v1.ProviderTelegramAppLogin.item:type_name -> webitel.im.provider.v1.ProviderTelegramAppGate
+	15, // 4: webitel.im.provider.v1.ProviderStartTelegramAppLoginRequest.peer:type_name -> webitel.im.provider.v1.Peer
+	2,  // 5: webitel.im.provider.v1.ProviderStartTelegramAppLoginResponse.login:type_name -> webitel.im.provider.v1.ProviderTelegramAppLogin
+	2,  // 6: webitel.im.provider.v1.ProviderSubmitTelegramAppCodeResponse.login:type_name -> webitel.im.provider.v1.ProviderTelegramAppLogin
+	2,  // 7: webitel.im.provider.v1.ProviderSubmitTelegramAppPasswordResponse.login:type_name -> webitel.im.provider.v1.ProviderTelegramAppLogin
+	1,  // 8: webitel.im.provider.v1.ProviderGetTelegramAppGateResponse.item:type_name -> webitel.im.provider.v1.ProviderTelegramAppGate
+	15, // 9: webitel.im.provider.v1.ProviderUpdateTelegramAppGateRequest.peer:type_name -> webitel.im.provider.v1.Peer
+	1,  // 10: webitel.im.provider.v1.ProviderUpdateTelegramAppGateResponse.item:type_name -> webitel.im.provider.v1.ProviderTelegramAppGate
+	1,  // 11: webitel.im.provider.v1.ProviderDeleteTelegramAppGateResponse.item:type_name -> webitel.im.provider.v1.ProviderTelegramAppGate
+	3,  // 12: webitel.im.provider.v1.TelegramAppService.StartTelegramAppLogin:input_type -> webitel.im.provider.v1.ProviderStartTelegramAppLoginRequest
+	5,  // 13: webitel.im.provider.v1.TelegramAppService.SubmitTelegramAppCode:input_type -> webitel.im.provider.v1.ProviderSubmitTelegramAppCodeRequest
+	7,  // 14: webitel.im.provider.v1.TelegramAppService.SubmitTelegramAppPassword:input_type -> webitel.im.provider.v1.ProviderSubmitTelegramAppPasswordRequest
+	9,  // 15: webitel.im.provider.v1.TelegramAppService.GetTelegramAppGate:input_type -> webitel.im.provider.v1.ProviderGetTelegramAppGateRequest
+	11, // 16: webitel.im.provider.v1.TelegramAppService.UpdateTelegramAppGate:input_type -> webitel.im.provider.v1.ProviderUpdateTelegramAppGateRequest
+	13, // 17: webitel.im.provider.v1.TelegramAppService.DeleteTelegramAppGate:input_type -> webitel.im.provider.v1.ProviderDeleteTelegramAppGateRequest
+	4,  // 18: webitel.im.provider.v1.TelegramAppService.StartTelegramAppLogin:output_type -> webitel.im.provider.v1.ProviderStartTelegramAppLoginResponse
+	6,  // 19: webitel.im.provider.v1.TelegramAppService.SubmitTelegramAppCode:output_type -> webitel.im.provider.v1.ProviderSubmitTelegramAppCodeResponse
+	8,  // 20: webitel.im.provider.v1.TelegramAppService.SubmitTelegramAppPassword:output_type -> webitel.im.provider.v1.ProviderSubmitTelegramAppPasswordResponse
+	10, // 21: webitel.im.provider.v1.TelegramAppService.GetTelegramAppGate:output_type -> webitel.im.provider.v1.ProviderGetTelegramAppGateResponse
+	12, // 22: webitel.im.provider.v1.TelegramAppService.UpdateTelegramAppGate:output_type -> webitel.im.provider.v1.ProviderUpdateTelegramAppGateResponse
+	14, // 23: webitel.im.provider.v1.TelegramAppService.DeleteTelegramAppGate:output_type -> webitel.im.provider.v1.ProviderDeleteTelegramAppGateResponse
+	18, // [18:24] is the sub-list for method output_type
+	12, // [12:18] is the sub-list for method input_type
+	12, // [12:12] is the sub-list for extension type_name
+	12, // [12:12] is the sub-list for extension extendee
+	0,  // [0:12] is the sub-list for field type_name
+}
+
+func init() { file_service_provider_v1_telegram_app_service_proto_init() }
+func file_service_provider_v1_telegram_app_service_proto_init() {
+	if File_service_provider_v1_telegram_app_service_proto != nil {
+		return
+	}
+	file_service_provider_v1_enums_proto_init()
+	file_service_provider_v1_messages_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_service_provider_v1_telegram_app_service_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProviderTelegramAppGate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_provider_v1_telegram_app_service_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProviderTelegramAppLogin); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_provider_v1_telegram_app_service_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProviderStartTelegramAppLoginRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_provider_v1_telegram_app_service_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProviderStartTelegramAppLoginResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_provider_v1_telegram_app_service_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProviderSubmitTelegramAppCodeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_provider_v1_telegram_app_service_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProviderSubmitTelegramAppCodeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_provider_v1_telegram_app_service_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProviderSubmitTelegramAppPasswordRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_provider_v1_telegram_app_service_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProviderSubmitTelegramAppPasswordResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_provider_v1_telegram_app_service_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProviderGetTelegramAppGateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_provider_v1_telegram_app_service_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProviderGetTelegramAppGateResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_provider_v1_telegram_app_service_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProviderUpdateTelegramAppGateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_provider_v1_telegram_app_service_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProviderUpdateTelegramAppGateResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_provider_v1_telegram_app_service_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProviderDeleteTelegramAppGateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_provider_v1_telegram_app_service_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProviderDeleteTelegramAppGateResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_service_provider_v1_telegram_app_service_proto_msgTypes[10].OneofWrappers = []interface{}{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_service_provider_v1_telegram_app_service_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   14,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_service_provider_v1_telegram_app_service_proto_goTypes,
+		DependencyIndexes: file_service_provider_v1_telegram_app_service_proto_depIdxs,
+		EnumInfos:         file_service_provider_v1_telegram_app_service_proto_enumTypes,
+		MessageInfos:      file_service_provider_v1_telegram_app_service_proto_msgTypes,
+	}.Build()
+	File_service_provider_v1_telegram_app_service_proto = out.File
+	file_service_provider_v1_telegram_app_service_proto_rawDesc = nil
+	file_service_provider_v1_telegram_app_service_proto_goTypes = nil
+	file_service_provider_v1_telegram_app_service_proto_depIdxs = nil
+}
@@ -0,0 +1,323 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: service/provider/v1/telegram_app_service.proto
+
+package provider
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	TelegramAppService_StartTelegramAppLogin_FullMethodName     = "/webitel.im.provider.v1.TelegramAppService/StartTelegramAppLogin"
+	TelegramAppService_SubmitTelegramAppCode_FullMethodName     = "/webitel.im.provider.v1.TelegramAppService/SubmitTelegramAppCode"
+	TelegramAppService_SubmitTelegramAppPassword_FullMethodName = "/webitel.im.provider.v1.TelegramAppService/SubmitTelegramAppPassword"
+	TelegramAppService_GetTelegramAppGate_FullMethodName        = "/webitel.im.provider.v1.TelegramAppService/GetTelegramAppGate"
+	TelegramAppService_UpdateTelegramAppGate_FullMethodName     = "/webitel.im.provider.v1.TelegramAppService/UpdateTelegramAppGate"
+	TelegramAppService_DeleteTelegramAppGate_FullMethodName     = "/webitel.im.provider.v1.TelegramAppService/DeleteTelegramAppGate"
+)
+
+// TelegramAppServiceClient is the client API for TelegramAppService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type TelegramAppServiceClient interface {
+	// / StartTelegramAppLogin sends a login code to the Telegram account of the phone.
+	StartTelegramAppLogin(ctx context.Context, in *ProviderStartTelegramAppLoginRequest, opts ...grpc.CallOption) (*ProviderStartTelegramAppLoginResponse, error)
+	// / SubmitTelegramAppCode signs the account in with the received code.
+	SubmitTelegramAppCode(ctx context.Context, in *ProviderSubmitTelegramAppCodeRequest, opts ...grpc.CallOption) (*ProviderSubmitTelegramAppCodeResponse, error)
+	// / SubmitTelegramAppPassword signs the account in with its two-step verification password.
+	SubmitTelegramAppPassword(ctx context.Context, in *ProviderSubmitTelegramAppPasswordRequest, opts ...grpc.CallOption) (*ProviderSubmitTelegramAppPasswordResponse, error)
+	// / GetTelegramAppGate returns the Telegram account gate.
+	GetTelegramAppGate(ctx context.Context, in *ProviderGetTelegramAppGateRequest, opts ...grpc.CallOption) (*ProviderGetTelegramAppGateResponse, error)
+	// / UpdateTelegramAppGate renames, enables or disables the gate.
+	UpdateTelegramAppGate(ctx context.Context, in *ProviderUpdateTelegramAppGateRequest, opts ...grpc.CallOption) (*ProviderUpdateTelegramAppGateResponse, error)
+	// / DeleteTelegramAppGate disconnects and removes the Telegram account gate.
+	DeleteTelegramAppGate(ctx context.Context, in *ProviderDeleteTelegramAppGateRequest, opts ...grpc.CallOption) (*ProviderDeleteTelegramAppGateResponse, error)
+}
+
+type telegramAppServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewTelegramAppServiceClient(cc grpc.ClientConnInterface) TelegramAppServiceClient {
+	return &telegramAppServiceClient{cc}
+}
+
+func (c *telegramAppServiceClient) StartTelegramAppLogin(ctx context.Context, in *ProviderStartTelegramAppLoginRequest, opts ...grpc.CallOption) (*ProviderStartTelegramAppLoginResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProviderStartTelegramAppLoginResponse)
+	err := c.cc.Invoke(ctx, TelegramAppService_StartTelegramAppLogin_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *telegramAppServiceClient) SubmitTelegramAppCode(ctx context.Context, in *ProviderSubmitTelegramAppCodeRequest, opts ...grpc.CallOption) (*ProviderSubmitTelegramAppCodeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProviderSubmitTelegramAppCodeResponse)
+	err := c.cc.Invoke(ctx, TelegramAppService_SubmitTelegramAppCode_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *telegramAppServiceClient) SubmitTelegramAppPassword(ctx context.Context, in *ProviderSubmitTelegramAppPasswordRequest, opts ...grpc.CallOption) (*ProviderSubmitTelegramAppPasswordResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProviderSubmitTelegramAppPasswordResponse)
+	err := c.cc.Invoke(ctx, TelegramAppService_SubmitTelegramAppPassword_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *telegramAppServiceClient) GetTelegramAppGate(ctx context.Context, in *ProviderGetTelegramAppGateRequest, opts ...grpc.CallOption) (*ProviderGetTelegramAppGateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProviderGetTelegramAppGateResponse)
+	err := c.cc.Invoke(ctx, TelegramAppService_GetTelegramAppGate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *telegramAppServiceClient) UpdateTelegramAppGate(ctx context.Context, in *ProviderUpdateTelegramAppGateRequest, opts ...grpc.CallOption) (*ProviderUpdateTelegramAppGateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProviderUpdateTelegramAppGateResponse)
+	err := c.cc.Invoke(ctx, TelegramAppService_UpdateTelegramAppGate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *telegramAppServiceClient) DeleteTelegramAppGate(ctx context.Context, in *ProviderDeleteTelegramAppGateRequest, opts ...grpc.CallOption) (*ProviderDeleteTelegramAppGateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProviderDeleteTelegramAppGateResponse)
+	err := c.cc.Invoke(ctx, TelegramAppService_DeleteTelegramAppGate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// TelegramAppServiceServer is the server API for TelegramAppService service.
+// All implementations must embed UnimplementedTelegramAppServiceServer
+// for forward compatibility.
+type TelegramAppServiceServer interface {
+	// / StartTelegramAppLogin sends a login code to the Telegram account of the phone.
+	StartTelegramAppLogin(context.Context, *ProviderStartTelegramAppLoginRequest) (*ProviderStartTelegramAppLoginResponse, error)
+	// / SubmitTelegramAppCode signs the account in with the received code.
+	SubmitTelegramAppCode(context.Context, *ProviderSubmitTelegramAppCodeRequest) (*ProviderSubmitTelegramAppCodeResponse, error)
+	// / SubmitTelegramAppPassword signs the account in with its two-step verification password.
+	SubmitTelegramAppPassword(context.Context, *ProviderSubmitTelegramAppPasswordRequest) (*ProviderSubmitTelegramAppPasswordResponse, error)
+	// / GetTelegramAppGate returns the Telegram account gate.
+	GetTelegramAppGate(context.Context, *ProviderGetTelegramAppGateRequest) (*ProviderGetTelegramAppGateResponse, error)
+	// / UpdateTelegramAppGate renames, enables or disables the gate.
+	UpdateTelegramAppGate(context.Context, *ProviderUpdateTelegramAppGateRequest) (*ProviderUpdateTelegramAppGateResponse, error)
+	// / DeleteTelegramAppGate disconnects and removes the Telegram account gate.
+	DeleteTelegramAppGate(context.Context, *ProviderDeleteTelegramAppGateRequest) (*ProviderDeleteTelegramAppGateResponse, error)
+	mustEmbedUnimplementedTelegramAppServiceServer()
+}
+
+// UnimplementedTelegramAppServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedTelegramAppServiceServer struct{}
+
+func (UnimplementedTelegramAppServiceServer) StartTelegramAppLogin(context.Context, *ProviderStartTelegramAppLoginRequest) (*ProviderStartTelegramAppLoginResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartTelegramAppLogin not implemented")
+}
+func (UnimplementedTelegramAppServiceServer) SubmitTelegramAppCode(context.Context, *ProviderSubmitTelegramAppCodeRequest) (*ProviderSubmitTelegramAppCodeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitTelegramAppCode not implemented")
+}
+func (UnimplementedTelegramAppServiceServer) SubmitTelegramAppPassword(context.Context, *ProviderSubmitTelegramAppPasswordRequest) (*ProviderSubmitTelegramAppPasswordResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitTelegramAppPassword not implemented")
+}
+func (UnimplementedTelegramAppServiceServer) GetTelegramAppGate(context.Context, *ProviderGetTelegramAppGateRequest) (*ProviderGetTelegramAppGateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTelegramAppGate not implemented")
+}
+func (UnimplementedTelegramAppServiceServer) UpdateTelegramAppGate(context.Context, *ProviderUpdateTelegramAppGateRequest) (*ProviderUpdateTelegramAppGateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateTelegramAppGate not implemented")
+}
+func (UnimplementedTelegramAppServiceServer) DeleteTelegramAppGate(context.Context, *ProviderDeleteTelegramAppGateRequest) (*ProviderDeleteTelegramAppGateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteTelegramAppGate not implemented")
+}
+func (UnimplementedTelegramAppServiceServer) mustEmbedUnimplementedTelegramAppServiceServer() {}
+func (UnimplementedTelegramAppServiceServer) testEmbeddedByValue()                            {}
+
+// UnsafeTelegramAppServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to TelegramAppServiceServer will
+// result in compilation errors.
+type UnsafeTelegramAppServiceServer interface {
+	mustEmbedUnimplementedTelegramAppServiceServer()
+}
+
+func RegisterTelegramAppServiceServer(s grpc.ServiceRegistrar, srv TelegramAppServiceServer) {
+	// If the following call pancis, it indicates UnimplementedTelegramAppServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&TelegramAppService_ServiceDesc, srv)
+}
+
+func _TelegramAppService_StartTelegramAppLogin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProviderStartTelegramAppLoginRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TelegramAppServiceServer).StartTelegramAppLogin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TelegramAppService_StartTelegramAppLogin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TelegramAppServiceServer).StartTelegramAppLogin(ctx, req.(*ProviderStartTelegramAppLoginRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TelegramAppService_SubmitTelegramAppCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProviderSubmitTelegramAppCodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TelegramAppServiceServer).SubmitTelegramAppCode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TelegramAppService_SubmitTelegramAppCode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TelegramAppServiceServer).SubmitTelegramAppCode(ctx, req.(*ProviderSubmitTelegramAppCodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TelegramAppService_SubmitTelegramAppPassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProviderSubmitTelegramAppPasswordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TelegramAppServiceServer).SubmitTelegramAppPassword(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TelegramAppService_SubmitTelegramAppPassword_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TelegramAppServiceServer).SubmitTelegramAppPassword(ctx, req.(*ProviderSubmitTelegramAppPasswordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TelegramAppService_GetTelegramAppGate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProviderGetTelegramAppGateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TelegramAppServiceServer).GetTelegramAppGate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TelegramAppService_GetTelegramAppGate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TelegramAppServiceServer).GetTelegramAppGate(ctx, req.(*ProviderGetTelegramAppGateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TelegramAppService_UpdateTelegramAppGate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProviderUpdateTelegramAppGateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TelegramAppServiceServer).UpdateTelegramAppGate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TelegramAppService_UpdateTelegramAppGate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TelegramAppServiceServer).UpdateTelegramAppGate(ctx, req.(*ProviderUpdateTelegramAppGateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TelegramAppService_DeleteTelegramAppGate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProviderDeleteTelegramAppGateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TelegramAppServiceServer).DeleteTelegramAppGate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TelegramAppService_DeleteTelegramAppGate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TelegramAppServiceServer).DeleteTelegramAppGate(ctx, req.(*ProviderDeleteTelegramAppGateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// TelegramAppService_ServiceDesc is the grpc.ServiceDesc for TelegramAppService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var TelegramAppService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "webitel.im.provider.v1.TelegramAppService",
+	HandlerType: (*TelegramAppServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "StartTelegramAppLogin",
+			Handler:    _TelegramAppService_StartTelegramAppLogin_Handler,
+		},
+		{
+			MethodName: "SubmitTelegramAppCode",
+			Handler:    _TelegramAppService_SubmitTelegramAppCode_Handler,
+		},
+		{
+			MethodName: "SubmitTelegramAppPassword",
+			Handler:    _TelegramAppService_SubmitTelegramAppPassword_Handler,
+		},
+		{
+			MethodName: "GetTelegramAppGate",
+			Handler:    _TelegramAppService_GetTelegramAppGate_Handler,
+		},
+		{
+			MethodName: "UpdateTelegramAppGate",
+			Handler:    _TelegramAppService_UpdateTelegramAppGate_Handler,
+		},
+		{
+			MethodName: "DeleteTelegramAppGate",
+			Handler:    _TelegramAppService_DeleteTelegramAppGate_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "service/provider/v1/telegram_app_service.proto",
+}
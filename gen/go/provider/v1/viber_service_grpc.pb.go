@@ -0,0 +1,243 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: service/provider/v1/viber_service.proto
+
+package provider
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	ViberService_CreateViberGate_FullMethodName = "/webitel.im.provider.v1.ViberService/CreateViberGate"
+	ViberService_GetViberGate_FullMethodName    = "/webitel.im.provider.v1.ViberService/GetViberGate"
+	ViberService_UpdateViberGate_FullMethodName = "/webitel.im.provider.v1.ViberService/UpdateViberGate"
+	ViberService_DeleteViberGate_FullMethodName = "/webitel.im.provider.v1.ViberService/DeleteViberGate"
+)
+
+// ViberServiceClient is the client API for ViberService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ViberServiceClient interface {
+	// / CreateViberGate connects a Viber bot and points its webhook to the gate.
+	CreateViberGate(ctx context.Context, in *ProviderCreateViberGateRequest, opts ...grpc.CallOption) (*ProviderCreateViberGateResponse, error)
+	// / GetViberGate returns the Viber gate.
+	GetViberGate(ctx context.Context, in *ProviderGetViberGateRequest, opts ...grpc.CallOption) (*ProviderGetViberGateResponse, error)
+	// / UpdateViberGate renames, enables or disables the gate or replaces its bot token.
+	UpdateViberGate(ctx context.Context, in *ProviderUpdateViberGateRequest, opts ...grpc.CallOption) (*ProviderUpdateViberGateResponse, error)
+	// / DeleteViberGate removes the Viber gate.
+	DeleteViberGate(ctx context.Context, in *ProviderDeleteViberGateRequest, opts ...grpc.CallOption) (*ProviderDeleteViberGateResponse, error)
+}
+
+type viberServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewViberServiceClient(cc grpc.ClientConnInterface) ViberServiceClient {
+	return &viberServiceClient{cc}
+}
+
+func (c *viberServiceClient) CreateViberGate(ctx context.Context, in *ProviderCreateViberGateRequest, opts ...grpc.CallOption) (*ProviderCreateViberGateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProviderCreateViberGateResponse)
+	err := c.cc.Invoke(ctx, ViberService_CreateViberGate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *viberServiceClient) GetViberGate(ctx context.Context, in *ProviderGetViberGateRequest, opts ...grpc.CallOption) (*ProviderGetViberGateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProviderGetViberGateResponse)
+	err := c.cc.Invoke(ctx, ViberService_GetViberGate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *viberServiceClient) UpdateViberGate(ctx context.Context, in *ProviderUpdateViberGateRequest, opts ...grpc.CallOption) (*ProviderUpdateViberGateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProviderUpdateViberGateResponse)
+	err := c.cc.Invoke(ctx, ViberService_UpdateViberGate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *viberServiceClient) DeleteViberGate(ctx context.Context, in *ProviderDeleteViberGateRequest, opts ...grpc.CallOption) (*ProviderDeleteViberGateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProviderDeleteViberGateResponse)
+	err := c.cc.Invoke(ctx, ViberService_DeleteViberGate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ViberServiceServer is the server API for ViberService service.
+// All implementations must embed UnimplementedViberServiceServer
+// for forward compatibility.
+type ViberServiceServer interface {
+	// / CreateViberGate connects a Viber bot and points its webhook to the gate.
+	CreateViberGate(context.Context, *ProviderCreateViberGateRequest) (*ProviderCreateViberGateResponse, error)
+	// / GetViberGate returns the Viber gate.
+	GetViberGate(context.Context, *ProviderGetViberGateRequest) (*ProviderGetViberGateResponse, error)
+	// / UpdateViberGate renames, enables or disables the gate or replaces its bot token.
+	UpdateViberGate(context.Context, *ProviderUpdateViberGateRequest) (*ProviderUpdateViberGateResponse, error)
+	// / DeleteViberGate removes the Viber gate.
+	DeleteViberGate(context.Context, *ProviderDeleteViberGateRequest) (*ProviderDeleteViberGateResponse, error)
+	mustEmbedUnimplementedViberServiceServer()
+}
+
+// UnimplementedViberServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedViberServiceServer struct{}
+
+func (UnimplementedViberServiceServer) CreateViberGate(context.Context, *ProviderCreateViberGateRequest) (*ProviderCreateViberGateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateViberGate not implemented")
+}
+func (UnimplementedViberServiceServer) GetViberGate(context.Context, *ProviderGetViberGateRequest) (*ProviderGetViberGateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetViberGate not implemented")
+}
+func (UnimplementedViberServiceServer) UpdateViberGate(context.Context, *ProviderUpdateViberGateRequest) (*ProviderUpdateViberGateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateViberGate not implemented")
+}
+func (UnimplementedViberServiceServer) DeleteViberGate(context.Context, *ProviderDeleteViberGateRequest) (*ProviderDeleteViberGateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteViberGate not implemented")
+}
+func (UnimplementedViberServiceServer) mustEmbedUnimplementedViberServiceServer() {}
+func (UnimplementedViberServiceServer) testEmbeddedByValue()                      {}
+
+// UnsafeViberServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ViberServiceServer will
+// result in compilation errors.
+type UnsafeViberServiceServer interface {
+	mustEmbedUnimplementedViberServiceServer()
+}
+
+func RegisterViberServiceServer(s grpc.ServiceRegistrar, srv ViberServiceServer) {
+	// If the following call pancis, it indicates UnimplementedViberServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&ViberService_ServiceDesc, srv)
+}
+
+func _ViberService_CreateViberGate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProviderCreateViberGateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ViberServiceServer).CreateViberGate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ViberService_CreateViberGate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ViberServiceServer).CreateViberGate(ctx, req.(*ProviderCreateViberGateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ViberService_GetViberGate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProviderGetViberGateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ViberServiceServer).GetViberGate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ViberService_GetViberGate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ViberServiceServer).GetViberGate(ctx, req.(*ProviderGetViberGateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ViberService_UpdateViberGate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProviderUpdateViberGateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ViberServiceServer).UpdateViberGate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ViberService_UpdateViberGate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ViberServiceServer).UpdateViberGate(ctx, req.(*ProviderUpdateViberGateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ViberService_DeleteViberGate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProviderDeleteViberGateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ViberServiceServer).DeleteViberGate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ViberService_DeleteViberGate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ViberServiceServer).DeleteViberGate(ctx, req.(*ProviderDeleteViberGateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ViberService_ServiceDesc is the grpc.ServiceDesc for ViberService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ViberService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "webitel.im.provider.v1.ViberService",
+	HandlerType: (*ViberServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateViberGate",
+			Handler:    _ViberService_CreateViberGate_Handler,
+		},
+		{
+			MethodName: "GetViberGate",
+			Handler:    _ViberService_GetViberGate_Handler,
+		},
+		{
+			MethodName: "UpdateViberGate",
+			Handler:    _ViberService_UpdateViberGate_Handler,
+		},
+		{
+			MethodName: "DeleteViberGate",
+			Handler:    _ViberService_DeleteViberGate_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "service/provider/v1/viber_service.proto",
+}
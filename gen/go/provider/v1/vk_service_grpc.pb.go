@@ -0,0 +1,243 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: service/provider/v1/vk_service.proto
+
+package provider
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	VKService_CreateVKGate_FullMethodName = "/webitel.im.provider.v1.VKService/CreateVKGate"
+	VKService_GetVKGate_FullMethodName    = "/webitel.im.provider.v1.VKService/GetVKGate"
+	VKService_UpdateVKGate_FullMethodName = "/webitel.im.provider.v1.VKService/UpdateVKGate"
+	VKService_DeleteVKGate_FullMethodName = "/webitel.im.provider.v1.VKService/DeleteVKGate"
+)
+
+// VKServiceClient is the client API for VKService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type VKServiceClient interface {
+	// / CreateVKGate connects a community and adds a callback server pointing to the gate.
+	CreateVKGate(ctx context.Context, in *ProviderCreateVKGateRequest, opts ...grpc.CallOption) (*ProviderCreateVKGateResponse, error)
+	// / GetVKGate returns the VK gate.
+	GetVKGate(ctx context.Context, in *ProviderGetVKGateRequest, opts ...grpc.CallOption) (*ProviderGetVKGateResponse, error)
+	// / UpdateVKGate renames, enables or disables the gate or replaces its access token.
+	UpdateVKGate(ctx context.Context, in *ProviderUpdateVKGateRequest, opts ...grpc.CallOption) (*ProviderUpdateVKGateResponse, error)
+	// / DeleteVKGate removes the VK gate and the callback server of its community.
+	DeleteVKGate(ctx context.Context, in *ProviderDeleteVKGateRequest, opts ...grpc.CallOption) (*ProviderDeleteVKGateResponse, error)
+}
+
+type vKServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewVKServiceClient(cc grpc.ClientConnInterface) VKServiceClient {
+	return &vKServiceClient{cc}
+}
+
+func (c *vKServiceClient) CreateVKGate(ctx context.Context, in *ProviderCreateVKGateRequest, opts ...grpc.CallOption) (*ProviderCreateVKGateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProviderCreateVKGateResponse)
+	err := c.cc.Invoke(ctx, VKService_CreateVKGate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vKServiceClient) GetVKGate(ctx context.Context, in *ProviderGetVKGateRequest, opts ...grpc.CallOption) (*ProviderGetVKGateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProviderGetVKGateResponse)
+	err := c.cc.Invoke(ctx, VKService_GetVKGate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vKServiceClient) UpdateVKGate(ctx context.Context, in *ProviderUpdateVKGateRequest, opts ...grpc.CallOption) (*ProviderUpdateVKGateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProviderUpdateVKGateResponse)
+	err := c.cc.Invoke(ctx, VKService_UpdateVKGate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vKServiceClient) DeleteVKGate(ctx context.Context, in *ProviderDeleteVKGateRequest, opts ...grpc.CallOption) (*ProviderDeleteVKGateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProviderDeleteVKGateResponse)
+	err := c.cc.Invoke(ctx, VKService_DeleteVKGate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// VKServiceServer is the server API for VKService service.
+// All implementations must embed UnimplementedVKServiceServer
+// for forward compatibility.
+type VKServiceServer interface {
+	// / CreateVKGate connects a community and adds a callback server pointing to the gate.
+	CreateVKGate(context.Context, *ProviderCreateVKGateRequest) (*ProviderCreateVKGateResponse, error)
+	// / GetVKGate returns the VK gate.
+	GetVKGate(context.Context, *ProviderGetVKGateRequest) (*ProviderGetVKGateResponse, error)
+	// / UpdateVKGate renames, enables or disables the gate or replaces its access token.
+	UpdateVKGate(context.Context, *ProviderUpdateVKGateRequest) (*ProviderUpdateVKGateResponse, error)
+	// / DeleteVKGate removes the VK gate and the callback server of its community.
+	DeleteVKGate(context.Context, *ProviderDeleteVKGateRequest) (*ProviderDeleteVKGateResponse, error)
+	mustEmbedUnimplementedVKServiceServer()
+}
+
+// UnimplementedVKServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedVKServiceServer struct{}
+
+func (UnimplementedVKServiceServer) CreateVKGate(context.Context, *ProviderCreateVKGateRequest) (*ProviderCreateVKGateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateVKGate not implemented")
+}
+func (UnimplementedVKServiceServer) GetVKGate(context.Context, *ProviderGetVKGateRequest) (*ProviderGetVKGateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetVKGate not implemented")
+}
+func (UnimplementedVKServiceServer) UpdateVKGate(context.Context, *ProviderUpdateVKGateRequest) (*ProviderUpdateVKGateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateVKGate not implemented")
+}
+func (UnimplementedVKServiceServer) DeleteVKGate(context.Context, *ProviderDeleteVKGateRequest) (*ProviderDeleteVKGateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteVKGate not implemented")
+}
+func (UnimplementedVKServiceServer) mustEmbedUnimplementedVKServiceServer() {}
+func (UnimplementedVKServiceServer) testEmbeddedByValue()                   {}
+
+// UnsafeVKServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to VKServiceServer will
+// result in compilation errors.
+type UnsafeVKServiceServer interface {
+	mustEmbedUnimplementedVKServiceServer()
+}
+
+func RegisterVKServiceServer(s grpc.ServiceRegistrar, srv VKServiceServer) {
+	// If the following call pancis, it indicates UnimplementedVKServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&VKService_ServiceDesc, srv)
+}
+
+func _VKService_CreateVKGate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProviderCreateVKGateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VKServiceServer).CreateVKGate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VKService_CreateVKGate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VKServiceServer).CreateVKGate(ctx, req.(*ProviderCreateVKGateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VKService_GetVKGate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProviderGetVKGateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VKServiceServer).GetVKGate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VKService_GetVKGate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VKServiceServer).GetVKGate(ctx, req.(*ProviderGetVKGateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VKService_UpdateVKGate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProviderUpdateVKGateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VKServiceServer).UpdateVKGate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VKService_UpdateVKGate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VKServiceServer).UpdateVKGate(ctx, req.(*ProviderUpdateVKGateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VKService_DeleteVKGate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProviderDeleteVKGateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VKServiceServer).DeleteVKGate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VKService_DeleteVKGate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VKServiceServer).DeleteVKGate(ctx, req.(*ProviderDeleteVKGateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// VKService_ServiceDesc is the grpc.ServiceDesc for VKService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var VKService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "webitel.im.provider.v1.VKService",
+	HandlerType: (*VKServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateVKGate",
+			Handler:    _VKService_CreateVKGate_Handler,
+		},
+		{
+			MethodName: "GetVKGate",
+			Handler:    _VKService_GetVKGate_Handler,
+		},
+		{
+			MethodName: "UpdateVKGate",
+			Handler:    _VKService_UpdateVKGate_Handler,
+		},
+		{
+			MethodName: "DeleteVKGate",
+			Handler:    _VKService_DeleteVKGate_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "service/provider/v1/vk_service.proto",
+}
@@ -0,0 +1,243 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: service/provider/v1/webchat_service.proto
+
+package provider
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	WebChatService_CreateWebChatGate_FullMethodName = "/webitel.im.provider.v1.WebChatService/CreateWebChatGate"
+	WebChatService_GetWebChatGate_FullMethodName    = "/webitel.im.provider.v1.WebChatService/GetWebChatGate"
+	WebChatService_UpdateWebChatGate_FullMethodName = "/webitel.im.provider.v1.WebChatService/UpdateWebChatGate"
+	WebChatService_DeleteWebChatGate_FullMethodName = "/webitel.im.provider.v1.WebChatService/DeleteWebChatGate"
+)
+
+// WebChatServiceClient is the client API for WebChatService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type WebChatServiceClient interface {
+	// / CreateWebChatGate connects a website widget and issues its endpoint.
+	CreateWebChatGate(ctx context.Context, in *ProviderCreateWebChatGateRequest, opts ...grpc.CallOption) (*ProviderCreateWebChatGateResponse, error)
+	// / GetWebChatGate returns the web chat gate.
+	GetWebChatGate(ctx context.Context, in *ProviderGetWebChatGateRequest, opts ...grpc.CallOption) (*ProviderGetWebChatGateResponse, error)
+	// / UpdateWebChatGate renames, enables or disables the gate, changes its origins or rotates its signing key.
+	UpdateWebChatGate(ctx context.Context, in *ProviderUpdateWebChatGateRequest, opts ...grpc.CallOption) (*ProviderUpdateWebChatGateResponse, error)
+	// / DeleteWebChatGate removes the web chat gate; its embedded widgets are answered not found from then on.
+	DeleteWebChatGate(ctx context.Context, in *ProviderDeleteWebChatGateRequest, opts ...grpc.CallOption) (*ProviderDeleteWebChatGateResponse, error)
+}
+
+type webChatServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewWebChatServiceClient(cc grpc.ClientConnInterface) WebChatServiceClient {
+	return &webChatServiceClient{cc}
+}
+
+func (c *webChatServiceClient) CreateWebChatGate(ctx context.Context, in *ProviderCreateWebChatGateRequest, opts ...grpc.CallOption) (*ProviderCreateWebChatGateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProviderCreateWebChatGateResponse)
+	err := c.cc.Invoke(ctx, WebChatService_CreateWebChatGate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *webChatServiceClient) GetWebChatGate(ctx context.Context, in *ProviderGetWebChatGateRequest, opts ...grpc.CallOption) (*ProviderGetWebChatGateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProviderGetWebChatGateResponse)
+	err := c.cc.Invoke(ctx, WebChatService_GetWebChatGate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *webChatServiceClient) UpdateWebChatGate(ctx context.Context, in *ProviderUpdateWebChatGateRequest, opts ...grpc.CallOption) (*ProviderUpdateWebChatGateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProviderUpdateWebChatGateResponse)
+	err := c.cc.Invoke(ctx, WebChatService_UpdateWebChatGate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *webChatServiceClient) DeleteWebChatGate(ctx context.Context, in *ProviderDeleteWebChatGateRequest, opts ...grpc.CallOption) (*ProviderDeleteWebChatGateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProviderDeleteWebChatGateResponse)
+	err := c.cc.Invoke(ctx, WebChatService_DeleteWebChatGate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// WebChatServiceServer is the server API for WebChatService service.
+// All implementations must embed UnimplementedWebChatServiceServer
+// for forward compatibility.
+type WebChatServiceServer interface {
+	// / CreateWebChatGate connects a website widget and issues its endpoint.
+	CreateWebChatGate(context.Context, *ProviderCreateWebChatGateRequest) (*ProviderCreateWebChatGateResponse, error)
+	// / GetWebChatGate returns the web chat gate.
+	GetWebChatGate(context.Context, *ProviderGetWebChatGateRequest) (*ProviderGetWebChatGateResponse, error)
+	// / UpdateWebChatGate renames, enables or disables the gate, changes its origins or rotates its signing key.
+	UpdateWebChatGate(context.Context, *ProviderUpdateWebChatGateRequest) (*ProviderUpdateWebChatGateResponse, error)
+	// / DeleteWebChatGate removes the web chat gate; its embedded widgets are answered not found from then on.
+	DeleteWebChatGate(context.Context, *ProviderDeleteWebChatGateRequest) (*ProviderDeleteWebChatGateResponse, error)
+	mustEmbedUnimplementedWebChatServiceServer()
+}
+
+// UnimplementedWebChatServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedWebChatServiceServer struct{}
+
+func (UnimplementedWebChatServiceServer) CreateWebChatGate(context.Context, *ProviderCreateWebChatGateRequest) (*ProviderCreateWebChatGateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateWebChatGate not implemented")
+}
+func (UnimplementedWebChatServiceServer) GetWebChatGate(context.Context, *ProviderGetWebChatGateRequest) (*ProviderGetWebChatGateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWebChatGate not implemented")
+}
+func (UnimplementedWebChatServiceServer) UpdateWebChatGate(context.Context, *ProviderUpdateWebChatGateRequest) (*ProviderUpdateWebChatGateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateWebChatGate not implemented")
+}
+func (UnimplementedWebChatServiceServer) DeleteWebChatGate(context.Context, *ProviderDeleteWebChatGateRequest) (*ProviderDeleteWebChatGateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteWebChatGate not implemented")
+}
+func (UnimplementedWebChatServiceServer) mustEmbedUnimplementedWebChatServiceServer() {}
+func (UnimplementedWebChatServiceServer) testEmbeddedByValue()                        {}
+
+// UnsafeWebChatServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to WebChatServiceServer will
+// result in compilation errors.
+type UnsafeWebChatServiceServer interface {
+	mustEmbedUnimplementedWebChatServiceServer()
+}
+
+func RegisterWebChatServiceServer(s grpc.ServiceRegistrar, srv WebChatServiceServer) {
+	// If the following call pancis, it indicates UnimplementedWebChatServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&WebChatService_ServiceDesc, srv)
+}
+
+func _WebChatService_CreateWebChatGate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProviderCreateWebChatGateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WebChatServiceServer).CreateWebChatGate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WebChatService_CreateWebChatGate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WebChatServiceServer).CreateWebChatGate(ctx, req.(*ProviderCreateWebChatGateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WebChatService_GetWebChatGate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProviderGetWebChatGateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WebChatServiceServer).GetWebChatGate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WebChatService_GetWebChatGate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WebChatServiceServer).GetWebChatGate(ctx, req.(*ProviderGetWebChatGateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WebChatService_UpdateWebChatGate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProviderUpdateWebChatGateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WebChatServiceServer).UpdateWebChatGate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WebChatService_UpdateWebChatGate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WebChatServiceServer).UpdateWebChatGate(ctx, req.(*ProviderUpdateWebChatGateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WebChatService_DeleteWebChatGate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProviderDeleteWebChatGateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WebChatServiceServer).DeleteWebChatGate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WebChatService_DeleteWebChatGate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WebChatServiceServer).DeleteWebChatGate(ctx, req.(*ProviderDeleteWebChatGateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// WebChatService_ServiceDesc is the grpc.ServiceDesc for WebChatService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var WebChatService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "webitel.im.provider.v1.WebChatService",
+	HandlerType: (*WebChatServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateWebChatGate",
+			Handler:    _WebChatService_CreateWebChatGate_Handler,
+		},
+		{
+			MethodName: "GetWebChatGate",
+			Handler:    _WebChatService_GetWebChatGate_Handler,
+		},
+		{
+			MethodName: "UpdateWebChatGate",
+			Handler:    _WebChatService_UpdateWebChatGate_Handler,
+		},
+		{
+			MethodName: "DeleteWebChatGate",
+			Handler:    _WebChatService_DeleteWebChatGate_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "service/provider/v1/webchat_service.proto",
+}
@@ -11,6 +11,7 @@ import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	reflect "reflect"
+	sync "sync"
 )
 
 const (
@@ -20,6 +21,1140 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// / ProviderWhatsAppBusinessProfile is the WhatsApp Business profile shown to customers.
+type ProviderWhatsAppBusinessProfile struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	About             string   `protobuf:"bytes,1,opt,name=about,proto3" json:"about,omitempty"`
+	Address           string   `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Description       string   `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Email             string   `protobuf:"bytes,4,opt,name=email,proto3" json:"email,omitempty"`
+	Websites          []string `protobuf:"bytes,5,rep,name=websites,proto3" json:"websites,omitempty"`
+	Vertical          string   `protobuf:"bytes,6,opt,name=vertical,proto3" json:"vertical,omitempty"` // Business category, e.g. "RETAIL"
+	ProfilePictureUrl string   `protobuf:"bytes,7,opt,name=profile_picture_url,json=profilePictureUrl,proto3" json:"profile_picture_url,omitempty"`
+}
+
+func (x *ProviderWhatsAppBusinessProfile) Reset() {
+	*x = ProviderWhatsAppBusinessProfile{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_provider_v1_whatsapp_service_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProviderWhatsAppBusinessProfile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProviderWhatsAppBusinessProfile) ProtoMessage() {}
+
+func (x *ProviderWhatsAppBusinessProfile) ProtoReflect() protoreflect.Message {
+	mi := &file_service_provider_v1_whatsapp_service_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProviderWhatsAppBusinessProfile.ProtoReflect.Descriptor instead.
+func (*ProviderWhatsAppBusinessProfile) Descriptor() ([]byte, []int) {
+	return file_service_provider_v1_whatsapp_service_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ProviderWhatsAppBusinessProfile) GetAbout() string {
+	if x != nil {
+		return x.About
+	}
+	return ""
+}
+
+func (x *ProviderWhatsAppBusinessProfile) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *ProviderWhatsAppBusinessProfile) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *ProviderWhatsAppBusinessProfile) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *ProviderWhatsAppBusinessProfile) GetWebsites() []string {
+	if x != nil {
+		return x.Websites
+	}
+	return nil
+}
+
+func (x *ProviderWhatsAppBusinessProfile) GetVertical() string {
+	if x != nil {
+		return x.Vertical
+	}
+	return ""
+}
+
+func (x *ProviderWhatsAppBusinessProfile) GetProfilePictureUrl() string {
+	if x != nil {
+		return x.ProfilePictureUrl
+	}
+	return ""
+}
+
+type ProviderRegisterWhatsAppPhoneNumberRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id  string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`   // Gate ID
+	Pin string `protobuf:"bytes,2,opt,name=pin,proto3" json:"pin,omitempty"` // 6-digit PIN; becomes the two-step verification PIN of the number
+}
+
+func (x *ProviderRegisterWhatsAppPhoneNumberRequest) Reset() {
+	*x = ProviderRegisterWhatsAppPhoneNumberRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_provider_v1_whatsapp_service_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProviderRegisterWhatsAppPhoneNumberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProviderRegisterWhatsAppPhoneNumberRequest) ProtoMessage() {}
+
+func (x *ProviderRegisterWhatsAppPhoneNumberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_provider_v1_whatsapp_service_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProviderRegisterWhatsAppPhoneNumberRequest.ProtoReflect.Descriptor instead.
+func (*ProviderRegisterWhatsAppPhoneNumberRequest) Descriptor() ([]byte, []int) {
+	return file_service_provider_v1_whatsapp_service_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ProviderRegisterWhatsAppPhoneNumberRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ProviderRegisterWhatsAppPhoneNumberRequest) GetPin() string {
+	if x != nil {
+		return x.Pin
+	}
+	return ""
+}
+
+type ProviderRegisterWhatsAppPhoneNumberResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ProviderRegisterWhatsAppPhoneNumberResponse) Reset() {
+	*x = ProviderRegisterWhatsAppPhoneNumberResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_provider_v1_whatsapp_service_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProviderRegisterWhatsAppPhoneNumberResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProviderRegisterWhatsAppPhoneNumberResponse) ProtoMessage() {}
+
+func (x *ProviderRegisterWhatsAppPhoneNumberResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_provider_v1_whatsapp_service_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProviderRegisterWhatsAppPhoneNumberResponse.ProtoReflect.Descriptor instead.
+func (*ProviderRegisterWhatsAppPhoneNumberResponse) Descriptor() ([]byte, []int) {
+	return file_service_provider_v1_whatsapp_service_proto_rawDescGZIP(), []int{2}
+}
+
+type ProviderDeregisterWhatsAppPhoneNumberRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"` // Gate ID
+}
+
+func (x *ProviderDeregisterWhatsAppPhoneNumberRequest) Reset() {
+	*x = ProviderDeregisterWhatsAppPhoneNumberRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_provider_v1_whatsapp_service_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProviderDeregisterWhatsAppPhoneNumberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProviderDeregisterWhatsAppPhoneNumberRequest) ProtoMessage() {}
+
+func (x *ProviderDeregisterWhatsAppPhoneNumberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_provider_v1_whatsapp_service_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProviderDeregisterWhatsAppPhoneNumberRequest.ProtoReflect.Descriptor instead.
+func (*ProviderDeregisterWhatsAppPhoneNumberRequest) Descriptor() ([]byte, []int) {
+	return file_service_provider_v1_whatsapp_service_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ProviderDeregisterWhatsAppPhoneNumberRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ProviderDeregisterWhatsAppPhoneNumberResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ProviderDeregisterWhatsAppPhoneNumberResponse) Reset() {
+	*x = ProviderDeregisterWhatsAppPhoneNumberResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_provider_v1_whatsapp_service_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProviderDeregisterWhatsAppPhoneNumberResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProviderDeregisterWhatsAppPhoneNumberResponse) ProtoMessage() {}
+
+func (x *ProviderDeregisterWhatsAppPhoneNumberResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_provider_v1_whatsapp_service_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProviderDeregisterWhatsAppPhoneNumberResponse.ProtoReflect.Descriptor instead.
+func (*ProviderDeregisterWhatsAppPhoneNumberResponse) Descriptor() ([]byte, []int) {
+	return file_service_provider_v1_whatsapp_service_proto_rawDescGZIP(), []int{4}
+}
+
+type ProviderSetWhatsAppTwoStepVerificationPinRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id  string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`   // Gate ID
+	Pin string `protobuf:"bytes,2,opt,name=pin,proto3" json:"pin,omitempty"` // New 6-digit PIN
+}
+
+func (x *ProviderSetWhatsAppTwoStepVerificationPinRequest) Reset() {
+	*x = ProviderSetWhatsAppTwoStepVerificationPinRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_provider_v1_whatsapp_service_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProviderSetWhatsAppTwoStepVerificationPinRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProviderSetWhatsAppTwoStepVerificationPinRequest) ProtoMessage() {}
+
+func (x *ProviderSetWhatsAppTwoStepVerificationPinRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_provider_v1_whatsapp_service_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProviderSetWhatsAppTwoStepVerificationPinRequest.ProtoReflect.Descriptor instead.
+func (*ProviderSetWhatsAppTwoStepVerificationPinRequest) Descriptor() ([]byte, []int) {
+	return file_service_provider_v1_whatsapp_service_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ProviderSetWhatsAppTwoStepVerificationPinRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ProviderSetWhatsAppTwoStepVerificationPinRequest) GetPin() string {
+	if x != nil {
+		return x.Pin
+	}
+	return ""
+}
+
+type ProviderSetWhatsAppTwoStepVerificationPinResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ProviderSetWhatsAppTwoStepVerificationPinResponse) Reset() {
+	*x = ProviderSetWhatsAppTwoStepVerificationPinResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_provider_v1_whatsapp_service_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProviderSetWhatsAppTwoStepVerificationPinResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProviderSetWhatsAppTwoStepVerificationPinResponse) ProtoMessage() {}
+
+func (x *ProviderSetWhatsAppTwoStepVerificationPinResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_provider_v1_whatsapp_service_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProviderSetWhatsAppTwoStepVerificationPinResponse.ProtoReflect.Descriptor instead.
+func (*ProviderSetWhatsAppTwoStepVerificationPinResponse) Descriptor() ([]byte, []int) {
+	return file_service_provider_v1_whatsapp_service_proto_rawDescGZIP(), []int{6}
+}
+
+type ProviderGetWhatsAppBusinessProfileRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"` // Gate ID
+}
+
+func (x *ProviderGetWhatsAppBusinessProfileRequest) Reset() {
+	*x = ProviderGetWhatsAppBusinessProfileRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_provider_v1_whatsapp_service_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProviderGetWhatsAppBusinessProfileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProviderGetWhatsAppBusinessProfileRequest) ProtoMessage() {}
+
+func (x *ProviderGetWhatsAppBusinessProfileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_provider_v1_whatsapp_service_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProviderGetWhatsAppBusinessProfileRequest.ProtoReflect.Descriptor instead.
+func (*ProviderGetWhatsAppBusinessProfileRequest) Descriptor() ([]byte, []int) {
+	return file_service_provider_v1_whatsapp_service_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ProviderGetWhatsAppBusinessProfileRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ProviderGetWhatsAppBusinessProfileResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Profile *ProviderWhatsAppBusinessProfile `protobuf:"bytes,1,opt,name=profile,proto3" json:"profile,omitempty"`
+}
+
+func (x *ProviderGetWhatsAppBusinessProfileResponse) Reset() {
+	*x = ProviderGetWhatsAppBusinessProfileResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_provider_v1_whatsapp_service_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProviderGetWhatsAppBusinessProfileResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProviderGetWhatsAppBusinessProfileResponse) ProtoMessage() {}
+
+func (x *ProviderGetWhatsAppBusinessProfileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_provider_v1_whatsapp_service_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProviderGetWhatsAppBusinessProfileResponse.ProtoReflect.Descriptor instead.
+func (*ProviderGetWhatsAppBusinessProfileResponse) Descriptor() ([]byte, []int) {
+	return file_service_provider_v1_whatsapp_service_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ProviderGetWhatsAppBusinessProfileResponse) GetProfile() *ProviderWhatsAppBusinessProfile {
+	if x != nil {
+		return x.Profile
+	}
+	return nil
+}
+
+// / ProviderUpdateWhatsAppBusinessProfileRequest changes the given profile fields; unset fields are left untouched.
+type ProviderUpdateWhatsAppBusinessProfileRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id             string                          `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"` // Gate ID
+	About          *string                         `protobuf:"bytes,2,opt,name=about,proto3,oneof" json:"about,omitempty"`
+	Address        *string                         `protobuf:"bytes,3,opt,name=address,proto3,oneof" json:"address,omitempty"`
+	Description    *string                         `protobuf:"bytes,4,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	Email          *string                         `protobuf:"bytes,5,opt,name=email,proto3,oneof" json:"email,omitempty"`
+	Websites       []string                        `protobuf:"bytes,6,rep,name=websites,proto3" json:"websites,omitempty"` // Replaces the websites when not empty
+	Vertical       *string                         `protobuf:"bytes,7,opt,name=vertical,proto3,oneof" json:"vertical,omitempty"`
+	ProfilePicture *ProviderWhatsAppProfilePicture `protobuf:"bytes,8,opt,name=profile_picture,json=profilePicture,proto3" json:"profile_picture,omitempty"` // New profile photo
+}
+
+func (x *ProviderUpdateWhatsAppBusinessProfileRequest) Reset() {
+	*x = ProviderUpdateWhatsAppBusinessProfileRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_provider_v1_whatsapp_service_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProviderUpdateWhatsAppBusinessProfileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProviderUpdateWhatsAppBusinessProfileRequest) ProtoMessage() {}
+
+func (x *ProviderUpdateWhatsAppBusinessProfileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_provider_v1_whatsapp_service_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProviderUpdateWhatsAppBusinessProfileRequest.ProtoReflect.Descriptor instead.
+func (*ProviderUpdateWhatsAppBusinessProfileRequest) Descriptor() ([]byte, []int) {
+	return file_service_provider_v1_whatsapp_service_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ProviderUpdateWhatsAppBusinessProfileRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ProviderUpdateWhatsAppBusinessProfileRequest) GetAbout() string {
+	if x != nil && x.About != nil {
+		return *x.About
+	}
+	return ""
+}
+
+func (x *ProviderUpdateWhatsAppBusinessProfileRequest) GetAddress() string {
+	if x != nil && x.Address != nil {
+		return *x.Address
+	}
+	return ""
+}
+
+func (x *ProviderUpdateWhatsAppBusinessProfileRequest) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *ProviderUpdateWhatsAppBusinessProfileRequest) GetEmail() string {
+	if x != nil && x.Email != nil {
+		return *x.Email
+	}
+	return ""
+}
+
+func (x *ProviderUpdateWhatsAppBusinessProfileRequest) GetWebsites() []string {
+	if x != nil {
+		return x.Websites
+	}
+	return nil
+}
+
+func (x *ProviderUpdateWhatsAppBusinessProfileRequest) GetVertical() string {
+	if x != nil && x.Vertical != nil {
+		return *x.Vertical
+	}
+	return ""
+}
+
+func (x *ProviderUpdateWhatsAppBusinessProfileRequest) GetProfilePicture() *ProviderWhatsAppProfilePicture {
+	if x != nil {
+		return x.ProfilePicture
+	}
+	return nil
+}
+
+type ProviderUpdateWhatsAppBusinessProfileResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Profile *ProviderWhatsAppBusinessProfile `protobuf:"bytes,1,opt,name=profile,proto3" json:"profile,omitempty"`
+}
+
+func (x *ProviderUpdateWhatsAppBusinessProfileResponse) Reset() {
+	*x = ProviderUpdateWhatsAppBusinessProfileResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_provider_v1_whatsapp_service_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProviderUpdateWhatsAppBusinessProfileResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProviderUpdateWhatsAppBusinessProfileResponse) ProtoMessage() {}
+
+func (x *ProviderUpdateWhatsAppBusinessProfileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_provider_v1_whatsapp_service_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProviderUpdateWhatsAppBusinessProfileResponse.ProtoReflect.Descriptor instead.
+func (*ProviderUpdateWhatsAppBusinessProfileResponse) Descriptor() ([]byte, []int) {
+	return file_service_provider_v1_whatsapp_service_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ProviderUpdateWhatsAppBusinessProfileResponse) GetProfile() *ProviderWhatsAppBusinessProfile {
+	if x != nil {
+		return x.Profile
+	}
+	return nil
+}
+
+// / ProviderWhatsAppProfilePicture is a JPEG or PNG image uploaded as the business profile photo.
+type ProviderWhatsAppProfilePicture struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MimeType string `protobuf:"bytes,1,opt,name=mime_type,json=mimeType,proto3" json:"mime_type,omitempty"`
+	Content  []byte `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+}
+
+func (x *ProviderWhatsAppProfilePicture) Reset() {
+	*x = ProviderWhatsAppProfilePicture{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_provider_v1_whatsapp_service_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProviderWhatsAppProfilePicture) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProviderWhatsAppProfilePicture) ProtoMessage() {}
+
+func (x *ProviderWhatsAppProfilePicture) ProtoReflect() protoreflect.Message {
+	mi := &file_service_provider_v1_whatsapp_service_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProviderWhatsAppProfilePicture.ProtoReflect.Descriptor instead.
+func (*ProviderWhatsAppProfilePicture) Descriptor() ([]byte, []int) {
+	return file_service_provider_v1_whatsapp_service_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ProviderWhatsAppProfilePicture) GetMimeType() string {
+	if x != nil {
+		return x.MimeType
+	}
+	return ""
+}
+
+func (x *ProviderWhatsAppProfilePicture) GetContent() []byte {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+// / ProviderWhatsAppFlow is a WhatsApp Flow of the business account behind a gate.
+type ProviderWhatsAppFlow struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id               string                                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name             string                                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Status           string                                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"` // DRAFT, PUBLISHED, DEPRECATED, BLOCKED or THROTTLED
+	Categories       []string                               `protobuf:"bytes,4,rep,name=categories,proto3" json:"categories,omitempty"`
+	ValidationErrors []*ProviderWhatsAppFlowValidationError `protobuf:"bytes,5,rep,name=validation_errors,json=validationErrors,proto3" json:"validation_errors,omitempty"` // Errors keeping the flow from being published
+}
+
+func (x *ProviderWhatsAppFlow) Reset() {
+	*x = ProviderWhatsAppFlow{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_provider_v1_whatsapp_service_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProviderWhatsAppFlow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProviderWhatsAppFlow) ProtoMessage() {}
+
+func (x *ProviderWhatsAppFlow) ProtoReflect() protoreflect.Message {
+	mi := &file_service_provider_v1_whatsapp_service_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProviderWhatsAppFlow.ProtoReflect.Descriptor instead.
+func (*ProviderWhatsAppFlow) Descriptor() ([]byte, []int) {
+	return file_service_provider_v1_whatsapp_service_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ProviderWhatsAppFlow) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ProviderWhatsAppFlow) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ProviderWhatsAppFlow) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ProviderWhatsAppFlow) GetCategories() []string {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+func (x *ProviderWhatsAppFlow) GetValidationErrors() []*ProviderWhatsAppFlowValidationError {
+	if x != nil {
+		return x.ValidationErrors
+	}
+	return nil
+}
+
+type ProviderWhatsAppFlowValidationError struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Error       string `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
+	ErrorType   string `protobuf:"bytes,2,opt,name=error_type,json=errorType,proto3" json:"error_type,omitempty"`
+	Message     string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	LineStart   int32  `protobuf:"varint,4,opt,name=line_start,json=lineStart,proto3" json:"line_start,omitempty"`
+	ColumnStart int32  `protobuf:"varint,5,opt,name=column_start,json=columnStart,proto3" json:"column_start,omitempty"`
+}
+
+func (x *ProviderWhatsAppFlowValidationError) Reset() {
+	*x = ProviderWhatsAppFlowValidationError{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_provider_v1_whatsapp_service_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProviderWhatsAppFlowValidationError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProviderWhatsAppFlowValidationError) ProtoMessage() {}
+
+func (x *ProviderWhatsAppFlowValidationError) ProtoReflect() protoreflect.Message {
+	mi := &file_service_provider_v1_whatsapp_service_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProviderWhatsAppFlowValidationError.ProtoReflect.Descriptor instead.
+func (*ProviderWhatsAppFlowValidationError) Descriptor() ([]byte, []int) {
+	return file_service_provider_v1_whatsapp_service_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ProviderWhatsAppFlowValidationError) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *ProviderWhatsAppFlowValidationError) GetErrorType() string {
+	if x != nil {
+		return x.ErrorType
+	}
+	return ""
+}
+
+func (x *ProviderWhatsAppFlowValidationError) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ProviderWhatsAppFlowValidationError) GetLineStart() int32 {
+	if x != nil {
+		return x.LineStart
+	}
+	return 0
+}
+
+func (x *ProviderWhatsAppFlowValidationError) GetColumnStart() int32 {
+	if x != nil {
+		return x.ColumnStart
+	}
+	return 0
+}
+
+type ProviderListWhatsAppFlowsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"` // Gate ID
+}
+
+func (x *ProviderListWhatsAppFlowsRequest) Reset() {
+	*x = ProviderListWhatsAppFlowsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_provider_v1_whatsapp_service_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProviderListWhatsAppFlowsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProviderListWhatsAppFlowsRequest) ProtoMessage() {}
+
+func (x *ProviderListWhatsAppFlowsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_provider_v1_whatsapp_service_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProviderListWhatsAppFlowsRequest.ProtoReflect.Descriptor instead.
+func (*ProviderListWhatsAppFlowsRequest) Descriptor() ([]byte, []int) {
+	return file_service_provider_v1_whatsapp_service_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ProviderListWhatsAppFlowsRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ProviderListWhatsAppFlowsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Items []*ProviderWhatsAppFlow `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+}
+
+func (x *ProviderListWhatsAppFlowsResponse) Reset() {
+	*x = ProviderListWhatsAppFlowsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_provider_v1_whatsapp_service_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProviderListWhatsAppFlowsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProviderListWhatsAppFlowsResponse) ProtoMessage() {}
+
+func (x *ProviderListWhatsAppFlowsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_provider_v1_whatsapp_service_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProviderListWhatsAppFlowsResponse.ProtoReflect.Descriptor instead.
+func (*ProviderListWhatsAppFlowsResponse) Descriptor() ([]byte, []int) {
+	return file_service_provider_v1_whatsapp_service_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ProviderListWhatsAppFlowsResponse) GetItems() []*ProviderWhatsAppFlow {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type ProviderGetWhatsAppUsageRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GateIds  []string `protobuf:"bytes,1,rep,name=gate_ids,json=gateIds,proto3" json:"gate_ids,omitempty"` // Gate IDs; all gates of the domain when empty
+	Category string   `protobuf:"bytes,2,opt,name=category,proto3" json:"category,omitempty"`              // Pricing category; all categories when empty
+	From     int64    `protobuf:"varint,3,opt,name=from,proto3" json:"from,omitempty"`                     // Period start, unix milliseconds
+	To       int64    `protobuf:"varint,4,opt,name=to,proto3" json:"to,omitempty"`                         // Period end, unix milliseconds
+}
+
+func (x *ProviderGetWhatsAppUsageRequest) Reset() {
+	*x = ProviderGetWhatsAppUsageRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_provider_v1_whatsapp_service_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProviderGetWhatsAppUsageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProviderGetWhatsAppUsageRequest) ProtoMessage() {}
+
+func (x *ProviderGetWhatsAppUsageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_provider_v1_whatsapp_service_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProviderGetWhatsAppUsageRequest.ProtoReflect.Descriptor instead.
+func (*ProviderGetWhatsAppUsageRequest) Descriptor() ([]byte, []int) {
+	return file_service_provider_v1_whatsapp_service_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ProviderGetWhatsAppUsageRequest) GetGateIds() []string {
+	if x != nil {
+		return x.GateIds
+	}
+	return nil
+}
+
+func (x *ProviderGetWhatsAppUsageRequest) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *ProviderGetWhatsAppUsageRequest) GetFrom() int64 {
+	if x != nil {
+		return x.From
+	}
+	return 0
+}
+
+func (x *ProviderGetWhatsAppUsageRequest) GetTo() int64 {
+	if x != nil {
+		return x.To
+	}
+	return 0
+}
+
+type ProviderWhatsAppUsage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GateId                string `protobuf:"bytes,1,opt,name=gate_id,json=gateId,proto3" json:"gate_id,omitempty"`
+	Category              string `protobuf:"bytes,2,opt,name=category,proto3" json:"category,omitempty"`
+	Day                   int64  `protobuf:"varint,3,opt,name=day,proto3" json:"day,omitempty"` // Day start (UTC), unix milliseconds
+	Conversations         int64  `protobuf:"varint,4,opt,name=conversations,proto3" json:"conversations,omitempty"`
+	BillableConversations int64  `protobuf:"varint,5,opt,name=billable_conversations,json=billableConversations,proto3" json:"billable_conversations,omitempty"`
+	Messages              int64  `protobuf:"varint,6,opt,name=messages,proto3" json:"messages,omitempty"`
+	BillableMessages      int64  `protobuf:"varint,7,opt,name=billable_messages,json=billableMessages,proto3" json:"billable_messages,omitempty"`
+}
+
+func (x *ProviderWhatsAppUsage) Reset() {
+	*x = ProviderWhatsAppUsage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_provider_v1_whatsapp_service_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProviderWhatsAppUsage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProviderWhatsAppUsage) ProtoMessage() {}
+
+func (x *ProviderWhatsAppUsage) ProtoReflect() protoreflect.Message {
+	mi := &file_service_provider_v1_whatsapp_service_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProviderWhatsAppUsage.ProtoReflect.Descriptor instead.
+func (*ProviderWhatsAppUsage) Descriptor() ([]byte, []int) {
+	return file_service_provider_v1_whatsapp_service_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ProviderWhatsAppUsage) GetGateId() string {
+	if x != nil {
+		return x.GateId
+	}
+	return ""
+}
+
+func (x *ProviderWhatsAppUsage) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *ProviderWhatsAppUsage) GetDay() int64 {
+	if x != nil {
+		return x.Day
+	}
+	return 0
+}
+
+func (x *ProviderWhatsAppUsage) GetConversations() int64 {
+	if x != nil {
+		return x.Conversations
+	}
+	return 0
+}
+
+func (x *ProviderWhatsAppUsage) GetBillableConversations() int64 {
+	if x != nil {
+		return x.BillableConversations
+	}
+	return 0
+}
+
+func (x *ProviderWhatsAppUsage) GetMessages() int64 {
+	if x != nil {
+		return x.Messages
+	}
+	return 0
+}
+
+func (x *ProviderWhatsAppUsage) GetBillableMessages() int64 {
+	if x != nil {
+		return x.BillableMessages
+	}
+	return 0
+}
+
+type ProviderGetWhatsAppUsageResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Items []*ProviderWhatsAppUsage `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+}
+
+func (x *ProviderGetWhatsAppUsageResponse) Reset() {
+	*x = ProviderGetWhatsAppUsageResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_provider_v1_whatsapp_service_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProviderGetWhatsAppUsageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProviderGetWhatsAppUsageResponse) ProtoMessage() {}
+
+func (x *ProviderGetWhatsAppUsageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_provider_v1_whatsapp_service_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProviderGetWhatsAppUsageResponse.ProtoReflect.Descriptor instead.
+func (*ProviderGetWhatsAppUsageResponse) Descriptor() ([]byte, []int) {
+	return file_service_provider_v1_whatsapp_service_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ProviderGetWhatsAppUsageResponse) GetItems() []*ProviderWhatsAppUsage {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
 var File_service_provider_v1_whatsapp_service_proto protoreflect.FileDescriptor
 
 var file_service_provider_v1_whatsapp_service_proto_rawDesc = []byte{
@@ -33,90 +1168,393 @@ var file_service_provider_v1_whatsapp_service_proto_rawDesc = []byte{
 	0x69, 0x64, 0x65, 0x72, 0x2f, 0x76, 0x31, 0x2f, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73,
 	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x22, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f,
 	0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x2f, 0x76, 0x31, 0x2f, 0x6d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x32, 0x9f, 0x05, 0x0a, 0x0f, 0x57,
-	0x68, 0x61, 0x74, 0x73, 0x41, 0x70, 0x70, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x84,
-	0x01, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x57, 0x68, 0x61, 0x74, 0x73, 0x41, 0x70,
-	0x70, 0x47, 0x61, 0x74, 0x65, 0x12, 0x29, 0x2e, 0x77, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x2e,
-	0x69, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x24, 0x2e, 0x77, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x2e, 0x69, 0x6d, 0x2e, 0x70, 0x72,
-	0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x61, 0x74, 0x65, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x17, 0x3a, 0x01,
-	0x2a, 0x22, 0x12, 0x2f, 0x69, 0x6d, 0x2f, 0x67, 0x61, 0x74, 0x65, 0x73, 0x2f, 0x77, 0x68, 0x61,
-	0x74, 0x73, 0x61, 0x70, 0x70, 0x12, 0xa3, 0x01, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x57, 0x68, 0x61,
-	0x74, 0x73, 0x41, 0x70, 0x70, 0x47, 0x61, 0x74, 0x65, 0x12, 0x36, 0x2e, 0x77, 0x65, 0x62, 0x69,
-	0x74, 0x65, 0x6c, 0x2e, 0x69, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x2e,
-	0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x47, 0x65, 0x74, 0x57, 0x68,
-	0x61, 0x74, 0x73, 0x41, 0x70, 0x70, 0x47, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x37, 0x2e, 0x77, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x2e, 0x69, 0x6d, 0x2e, 0x70,
-	0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69,
-	0x64, 0x65, 0x72, 0x47, 0x65, 0x74, 0x57, 0x68, 0x61, 0x74, 0x73, 0x41, 0x70, 0x70, 0x47, 0x61,
-	0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1f, 0x82, 0xd3, 0xe4, 0x93,
-	0x02, 0x19, 0x12, 0x17, 0x2f, 0x69, 0x6d, 0x2f, 0x67, 0x61, 0x74, 0x65, 0x73, 0x2f, 0x77, 0x68,
-	0x61, 0x74, 0x73, 0x61, 0x70, 0x70, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12, 0xaf, 0x01, 0x0a, 0x12,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x57, 0x68, 0x61, 0x74, 0x73, 0x41, 0x70, 0x70, 0x47, 0x61,
-	0x74, 0x65, 0x12, 0x39, 0x2e, 0x77, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x2e, 0x69, 0x6d, 0x2e,
-	0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x76,
+	0x61, 0x67, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xf1, 0x01, 0x0a, 0x1f, 0x50,
+	0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x57, 0x68, 0x61, 0x74, 0x73, 0x41, 0x70, 0x70, 0x42,
+	0x75, 0x73, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x14,
+	0x0a, 0x05, 0x61, 0x62, 0x6f, 0x75, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61,
+	0x62, 0x6f, 0x75, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x20,
+	0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x77, 0x65, 0x62, 0x73, 0x69, 0x74,
+	0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x77, 0x65, 0x62, 0x73, 0x69, 0x74,
+	0x65, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x76, 0x65, 0x72, 0x74, 0x69, 0x63, 0x61, 0x6c, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x76, 0x65, 0x72, 0x74, 0x69, 0x63, 0x61, 0x6c, 0x12, 0x2e,
+	0x0a, 0x13, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x70, 0x69, 0x63, 0x74, 0x75, 0x72,
+	0x65, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x70, 0x72, 0x6f,
+	0x66, 0x69, 0x6c, 0x65, 0x50, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x55, 0x72, 0x6c, 0x22, 0x4e,
+	0x0a, 0x2a, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74,
+	0x65, 0x72, 0x57, 0x68, 0x61, 0x74, 0x73, 0x41, 0x70, 0x70, 0x50, 0x68, 0x6f, 0x6e, 0x65, 0x4e,
+	0x75, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x10, 0x0a, 0x03,
+	0x70, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x70, 0x69, 0x6e, 0x22, 0x2d,
+	0x0a, 0x2b, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74,
+	0x65, 0x72, 0x57, 0x68, 0x61, 0x74, 0x73, 0x41, 0x70, 0x70, 0x50, 0x68, 0x6f, 0x6e, 0x65, 0x4e,
+	0x75, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3e, 0x0a,
+	0x2c, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x44, 0x65, 0x72, 0x65, 0x67, 0x69, 0x73,
+	0x74, 0x65, 0x72, 0x57, 0x68, 0x61, 0x74, 0x73, 0x41, 0x70, 0x70, 0x50, 0x68, 0x6f, 0x6e, 0x65,
+	0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x2f, 0x0a,
+	0x2d, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x44, 0x65, 0x72, 0x65, 0x67, 0x69, 0x73,
+	0x74, 0x65, 0x72, 0x57, 0x68, 0x61, 0x74, 0x73, 0x41, 0x70, 0x70, 0x50, 0x68, 0x6f, 0x6e, 0x65,
+	0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x54,
+	0x0a, 0x30, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x53, 0x65, 0x74, 0x57, 0x68, 0x61,
+	0x74, 0x73, 0x41, 0x70, 0x70, 0x54, 0x77, 0x6f, 0x53, 0x74, 0x65, 0x70, 0x56, 0x65, 0x72, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x70, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x70, 0x69, 0x6e, 0x22, 0x33, 0x0a, 0x31, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
+	0x53, 0x65, 0x74, 0x57, 0x68, 0x61, 0x74, 0x73, 0x41, 0x70, 0x70, 0x54, 0x77, 0x6f, 0x53, 0x74,
+	0x65, 0x70, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x69,
+	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3b, 0x0a, 0x29, 0x50, 0x72, 0x6f,
+	0x76, 0x69, 0x64, 0x65, 0x72, 0x47, 0x65, 0x74, 0x57, 0x68, 0x61, 0x74, 0x73, 0x41, 0x70, 0x70,
+	0x42, 0x75, 0x73, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x7f, 0x0a, 0x2a, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64,
+	0x65, 0x72, 0x47, 0x65, 0x74, 0x57, 0x68, 0x61, 0x74, 0x73, 0x41, 0x70, 0x70, 0x42, 0x75, 0x73,
+	0x69, 0x6e, 0x65, 0x73, 0x73, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x51, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x37, 0x2e, 0x77, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x2e,
+	0x69, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50,
+	0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x57, 0x68, 0x61, 0x74, 0x73, 0x41, 0x70, 0x70, 0x42,
+	0x75, 0x73, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x07,
+	0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x22, 0x95, 0x03, 0x0a, 0x2c, 0x50, 0x72, 0x6f, 0x76,
 	0x69, 0x64, 0x65, 0x72, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x57, 0x68, 0x61, 0x74, 0x73, 0x41,
-	0x70, 0x70, 0x47, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x3a, 0x2e,
+	0x70, 0x70, 0x42, 0x75, 0x73, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x19, 0x0a, 0x05, 0x61, 0x62, 0x6f, 0x75,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x05, 0x61, 0x62, 0x6f, 0x75, 0x74,
+	0x88, 0x01, 0x01, 0x12, 0x1d, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x88,
+	0x01, 0x01, 0x12, 0x25, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x19, 0x0a, 0x05, 0x65, 0x6d, 0x61,
+	0x69, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x48, 0x03, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69,
+	0x6c, 0x88, 0x01, 0x01, 0x12, 0x1a, 0x0a, 0x08, 0x77, 0x65, 0x62, 0x73, 0x69, 0x74, 0x65, 0x73,
+	0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x77, 0x65, 0x62, 0x73, 0x69, 0x74, 0x65, 0x73,
+	0x12, 0x1f, 0x0a, 0x08, 0x76, 0x65, 0x72, 0x74, 0x69, 0x63, 0x61, 0x6c, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x09, 0x48, 0x04, 0x52, 0x08, 0x76, 0x65, 0x72, 0x74, 0x69, 0x63, 0x61, 0x6c, 0x88, 0x01,
+	0x01, 0x12, 0x5f, 0x0a, 0x0f, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x70, 0x69, 0x63,
+	0x74, 0x75, 0x72, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x77, 0x65, 0x62,
+	0x69, 0x74, 0x65, 0x6c, 0x2e, 0x69, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
+	0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x57, 0x68, 0x61, 0x74,
+	0x73, 0x41, 0x70, 0x70, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x50, 0x69, 0x63, 0x74, 0x75,
+	0x72, 0x65, 0x52, 0x0e, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x50, 0x69, 0x63, 0x74, 0x75,
+	0x72, 0x65, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x61, 0x62, 0x6f, 0x75, 0x74, 0x42, 0x0a, 0x0a, 0x08,
+	0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x64, 0x65, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x65, 0x6d, 0x61,
+	0x69, 0x6c, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x76, 0x65, 0x72, 0x74, 0x69, 0x63, 0x61, 0x6c, 0x22,
+	0x82, 0x01, 0x0a, 0x2d, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x57, 0x68, 0x61, 0x74, 0x73, 0x41, 0x70, 0x70, 0x42, 0x75, 0x73, 0x69, 0x6e, 0x65,
+	0x73, 0x73, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x51, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x37, 0x2e, 0x77, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x2e, 0x69, 0x6d, 0x2e,
+	0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x76,
+	0x69, 0x64, 0x65, 0x72, 0x57, 0x68, 0x61, 0x74, 0x73, 0x41, 0x70, 0x70, 0x42, 0x75, 0x73, 0x69,
+	0x6e, 0x65, 0x73, 0x73, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x07, 0x70, 0x72, 0x6f,
+	0x66, 0x69, 0x6c, 0x65, 0x22, 0x57, 0x0a, 0x1e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
+	0x57, 0x68, 0x61, 0x74, 0x73, 0x41, 0x70, 0x70, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x50,
+	0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x69, 0x6d, 0x65, 0x5f, 0x74,
+	0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x69, 0x6d, 0x65, 0x54,
+	0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x22, 0xdc, 0x01,
+	0x0a, 0x14, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x57, 0x68, 0x61, 0x74, 0x73, 0x41,
+	0x70, 0x70, 0x46, 0x6c, 0x6f, 0x77, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73,
+	0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69,
+	0x65, 0x73, 0x12, 0x68, 0x0a, 0x11, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3b, 0x2e,
+	0x77, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x2e, 0x69, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69,
+	0x64, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x57,
+	0x68, 0x61, 0x74, 0x73, 0x41, 0x70, 0x70, 0x46, 0x6c, 0x6f, 0x77, 0x56, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x10, 0x76, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x22, 0xb6, 0x01, 0x0a,
+	0x23, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x57, 0x68, 0x61, 0x74, 0x73, 0x41, 0x70,
+	0x70, 0x46, 0x6c, 0x6f, 0x77, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45,
+	0x72, 0x72, 0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x54, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x69, 0x6e, 0x65, 0x5f, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x6c, 0x69, 0x6e, 0x65, 0x53, 0x74, 0x61,
+	0x72, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x5f, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e,
+	0x53, 0x74, 0x61, 0x72, 0x74, 0x22, 0x32, 0x0a, 0x20, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65,
+	0x72, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x68, 0x61, 0x74, 0x73, 0x41, 0x70, 0x70, 0x46, 0x6c, 0x6f,
+	0x77, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x67, 0x0a, 0x21, 0x50, 0x72, 0x6f,
+	0x76, 0x69, 0x64, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x68, 0x61, 0x74, 0x73, 0x41, 0x70,
+	0x70, 0x46, 0x6c, 0x6f, 0x77, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x42,
+	0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2c, 0x2e,
 	0x77, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x2e, 0x69, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69,
-	0x64, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x57, 0x68, 0x61, 0x74, 0x73, 0x41, 0x70, 0x70, 0x47, 0x61, 0x74,
-	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x22, 0x82, 0xd3, 0xe4, 0x93, 0x02,
-	0x1c, 0x3a, 0x01, 0x2a, 0x32, 0x17, 0x2f, 0x69, 0x6d, 0x2f, 0x67, 0x61, 0x74, 0x65, 0x73, 0x2f,
-	0x77, 0x68, 0x61, 0x74, 0x73, 0x61, 0x70, 0x70, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12, 0xac, 0x01,
-	0x0a, 0x12, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x57, 0x68, 0x61, 0x74, 0x73, 0x41, 0x70, 0x70,
-	0x47, 0x61, 0x74, 0x65, 0x12, 0x39, 0x2e, 0x77, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x2e, 0x69,
-	0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72,
-	0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x57, 0x68, 0x61, 0x74,
-	0x73, 0x41, 0x70, 0x70, 0x47, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x3a, 0x2e, 0x77, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x2e, 0x69, 0x6d, 0x2e, 0x70, 0x72, 0x6f,
+	0x64, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x57,
+	0x68, 0x61, 0x74, 0x73, 0x41, 0x70, 0x70, 0x46, 0x6c, 0x6f, 0x77, 0x52, 0x05, 0x69, 0x74, 0x65,
+	0x6d, 0x73, 0x22, 0x7c, 0x0a, 0x1f, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x47, 0x65,
+	0x74, 0x57, 0x68, 0x61, 0x74, 0x73, 0x41, 0x70, 0x70, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x67, 0x61, 0x74, 0x65, 0x5f, 0x69, 0x64,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x67, 0x61, 0x74, 0x65, 0x49, 0x64, 0x73,
+	0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x12, 0x0a, 0x04,
+	0x66, 0x72, 0x6f, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d,
+	0x12, 0x0e, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x74, 0x6f,
+	0x22, 0x84, 0x02, 0x0a, 0x15, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x57, 0x68, 0x61,
+	0x74, 0x73, 0x41, 0x70, 0x70, 0x55, 0x73, 0x61, 0x67, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x61,
+	0x74, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x61, 0x74,
+	0x65, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12,
+	0x10, 0x0a, 0x03, 0x64, 0x61, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x64, 0x61,
+	0x79, 0x12, 0x24, 0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72,
+	0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x35, 0x0a, 0x16, 0x62, 0x69, 0x6c, 0x6c, 0x61,
+	0x62, 0x6c, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x15, 0x62, 0x69, 0x6c, 0x6c, 0x61, 0x62, 0x6c,
+	0x65, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1a,
+	0x0a, 0x08, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x08, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x12, 0x2b, 0x0a, 0x11, 0x62, 0x69,
+	0x6c, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x62, 0x69, 0x6c, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x22, 0x67, 0x0a, 0x20, 0x50, 0x72, 0x6f, 0x76, 0x69,
+	0x64, 0x65, 0x72, 0x47, 0x65, 0x74, 0x57, 0x68, 0x61, 0x74, 0x73, 0x41, 0x70, 0x70, 0x55, 0x73,
+	0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x43, 0x0a, 0x05, 0x69,
+	0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x77, 0x65, 0x62,
+	0x69, 0x74, 0x65, 0x6c, 0x2e, 0x69, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
+	0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x57, 0x68, 0x61, 0x74,
+	0x73, 0x41, 0x70, 0x70, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73,
+	0x32, 0xff, 0x10, 0x0a, 0x0f, 0x57, 0x68, 0x61, 0x74, 0x73, 0x41, 0x70, 0x70, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x12, 0x84, 0x01, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x57,
+	0x68, 0x61, 0x74, 0x73, 0x41, 0x70, 0x70, 0x47, 0x61, 0x74, 0x65, 0x12, 0x29, 0x2e, 0x77, 0x65,
+	0x62, 0x69, 0x74, 0x65, 0x6c, 0x2e, 0x69, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65,
+	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x61, 0x74, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x77, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c,
+	0x2e, 0x69, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e,
+	0x47, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1d, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x17, 0x3a, 0x01, 0x2a, 0x22, 0x12, 0x2f, 0x69, 0x6d, 0x2f, 0x67, 0x61, 0x74,
+	0x65, 0x73, 0x2f, 0x77, 0x68, 0x61, 0x74, 0x73, 0x61, 0x70, 0x70, 0x12, 0xa3, 0x01, 0x0a, 0x0f,
+	0x47, 0x65, 0x74, 0x57, 0x68, 0x61, 0x74, 0x73, 0x41, 0x70, 0x70, 0x47, 0x61, 0x74, 0x65, 0x12,
+	0x36, 0x2e, 0x77, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x2e, 0x69, 0x6d, 0x2e, 0x70, 0x72, 0x6f,
 	0x76, 0x69, 0x64, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65,
-	0x72, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x57, 0x68, 0x61, 0x74, 0x73, 0x41, 0x70, 0x70, 0x47,
-	0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1f, 0x82, 0xd3, 0xe4,
-	0x93, 0x02, 0x19, 0x2a, 0x17, 0x2f, 0x69, 0x6d, 0x2f, 0x67, 0x61, 0x74, 0x65, 0x73, 0x2f, 0x77,
-	0x68, 0x61, 0x74, 0x73, 0x61, 0x70, 0x70, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x42, 0xe7, 0x01, 0x0a,
-	0x1a, 0x63, 0x6f, 0x6d, 0x2e, 0x77, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x2e, 0x69, 0x6d, 0x2e,
-	0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x42, 0x14, 0x57, 0x68, 0x61,
-	0x74, 0x73, 0x61, 0x70, 0x70, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x50, 0x72, 0x6f, 0x74,
-	0x6f, 0x50, 0x01, 0x5a, 0x38, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
-	0x77, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2f, 0x67,
-	0x65, 0x6e, 0x2f, 0x67, 0x6f, 0x2f, 0x69, 0x6d, 0x2f, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65,
-	0x72, 0x2f, 0x76, 0x31, 0x3b, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0xa2, 0x02, 0x03,
-	0x57, 0x49, 0x50, 0xaa, 0x02, 0x16, 0x57, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x2e, 0x49, 0x6d,
-	0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x16, 0x57,
-	0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x5c, 0x49, 0x6d, 0x5c, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64,
-	0x65, 0x72, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x22, 0x57, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x5c,
-	0x49, 0x6d, 0x5c, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x5c, 0x56, 0x31, 0x5c, 0x47,
-	0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x19, 0x57, 0x65, 0x62,
-	0x69, 0x74, 0x65, 0x6c, 0x3a, 0x3a, 0x49, 0x6d, 0x3a, 0x3a, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64,
-	0x65, 0x72, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x72, 0x47, 0x65, 0x74, 0x57, 0x68, 0x61, 0x74, 0x73, 0x41, 0x70, 0x70, 0x47, 0x61, 0x74, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x37, 0x2e, 0x77, 0x65, 0x62, 0x69, 0x74, 0x65,
+	0x6c, 0x2e, 0x69, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x2e, 0x76, 0x31,
+	0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x47, 0x65, 0x74, 0x57, 0x68, 0x61, 0x74,
+	0x73, 0x41, 0x70, 0x70, 0x47, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x1f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x19, 0x12, 0x17, 0x2f, 0x69, 0x6d, 0x2f, 0x67, 0x61,
+	0x74, 0x65, 0x73, 0x2f, 0x77, 0x68, 0x61, 0x74, 0x73, 0x61, 0x70, 0x70, 0x2f, 0x7b, 0x69, 0x64,
+	0x7d, 0x12, 0xaf, 0x01, 0x0a, 0x12, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x57, 0x68, 0x61, 0x74,
+	0x73, 0x41, 0x70, 0x70, 0x47, 0x61, 0x74, 0x65, 0x12, 0x39, 0x2e, 0x77, 0x65, 0x62, 0x69, 0x74,
+	0x65, 0x6c, 0x2e, 0x69, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x2e, 0x76,
+	0x31, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x57, 0x68, 0x61, 0x74, 0x73, 0x41, 0x70, 0x70, 0x47, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x3a, 0x2e, 0x77, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x2e, 0x69, 0x6d,
+	0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f,
+	0x76, 0x69, 0x64, 0x65, 0x72, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x57, 0x68, 0x61, 0x74, 0x73,
+	0x41, 0x70, 0x70, 0x47, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x22, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1c, 0x3a, 0x01, 0x2a, 0x32, 0x17, 0x2f, 0x69, 0x6d, 0x2f,
+	0x67, 0x61, 0x74, 0x65, 0x73, 0x2f, 0x77, 0x68, 0x61, 0x74, 0x73, 0x61, 0x70, 0x70, 0x2f, 0x7b,
+	0x69, 0x64, 0x7d, 0x12, 0xac, 0x01, 0x0a, 0x12, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x57, 0x68,
+	0x61, 0x74, 0x73, 0x41, 0x70, 0x70, 0x47, 0x61, 0x74, 0x65, 0x12, 0x39, 0x2e, 0x77, 0x65, 0x62,
+	0x69, 0x74, 0x65, 0x6c, 0x2e, 0x69, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
+	0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x57, 0x68, 0x61, 0x74, 0x73, 0x41, 0x70, 0x70, 0x47, 0x61, 0x74, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x3a, 0x2e, 0x77, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x2e,
+	0x69, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50,
+	0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x57, 0x68, 0x61,
+	0x74, 0x73, 0x41, 0x70, 0x70, 0x47, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x1f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x19, 0x2a, 0x17, 0x2f, 0x69, 0x6d, 0x2f, 0x67,
+	0x61, 0x74, 0x65, 0x73, 0x2f, 0x77, 0x68, 0x61, 0x74, 0x73, 0x61, 0x70, 0x70, 0x2f, 0x7b, 0x69,
+	0x64, 0x7d, 0x12, 0xe0, 0x01, 0x0a, 0x1b, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x57,
+	0x68, 0x61, 0x74, 0x73, 0x41, 0x70, 0x70, 0x50, 0x68, 0x6f, 0x6e, 0x65, 0x4e, 0x75, 0x6d, 0x62,
+	0x65, 0x72, 0x12, 0x42, 0x2e, 0x77, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x2e, 0x69, 0x6d, 0x2e,
+	0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x76,
+	0x69, 0x64, 0x65, 0x72, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x57, 0x68, 0x61, 0x74,
+	0x73, 0x41, 0x70, 0x70, 0x50, 0x68, 0x6f, 0x6e, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x43, 0x2e, 0x77, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c,
+	0x2e, 0x69, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e,
+	0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72,
+	0x57, 0x68, 0x61, 0x74, 0x73, 0x41, 0x70, 0x70, 0x50, 0x68, 0x6f, 0x6e, 0x65, 0x4e, 0x75, 0x6d,
+	0x62, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x38, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x32, 0x3a, 0x01, 0x2a, 0x22, 0x2d, 0x2f, 0x69, 0x6d, 0x2f, 0x67, 0x61, 0x74, 0x65,
+	0x73, 0x2f, 0x77, 0x68, 0x61, 0x74, 0x73, 0x61, 0x70, 0x70, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x2f,
+	0x70, 0x68, 0x6f, 0x6e, 0x65, 0x2d, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x2f, 0x72, 0x65, 0x67,
+	0x69, 0x73, 0x74, 0x65, 0x72, 0x12, 0xe8, 0x01, 0x0a, 0x1d, 0x44, 0x65, 0x72, 0x65, 0x67, 0x69,
+	0x73, 0x74, 0x65, 0x72, 0x57, 0x68, 0x61, 0x74, 0x73, 0x41, 0x70, 0x70, 0x50, 0x68, 0x6f, 0x6e,
+	0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x44, 0x2e, 0x77, 0x65, 0x62, 0x69, 0x74, 0x65,
+	0x6c, 0x2e, 0x69, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x2e, 0x76, 0x31,
+	0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x44, 0x65, 0x72, 0x65, 0x67, 0x69, 0x73,
+	0x74, 0x65, 0x72, 0x57, 0x68, 0x61, 0x74, 0x73, 0x41, 0x70, 0x70, 0x50, 0x68, 0x6f, 0x6e, 0x65,
+	0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x45, 0x2e,
+	0x77, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x2e, 0x69, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69,
+	0x64, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x44,
+	0x65, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x57, 0x68, 0x61, 0x74, 0x73, 0x41, 0x70,
+	0x70, 0x50, 0x68, 0x6f, 0x6e, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x34, 0x3a, 0x01, 0x2a, 0x22,
+	0x2f, 0x2f, 0x69, 0x6d, 0x2f, 0x67, 0x61, 0x74, 0x65, 0x73, 0x2f, 0x77, 0x68, 0x61, 0x74, 0x73,
+	0x61, 0x70, 0x70, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x2f, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x2d, 0x6e,
+	0x75, 0x6d, 0x62, 0x65, 0x72, 0x2f, 0x64, 0x65, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72,
+	0x12, 0xed, 0x01, 0x0a, 0x21, 0x53, 0x65, 0x74, 0x57, 0x68, 0x61, 0x74, 0x73, 0x41, 0x70, 0x70,
+	0x54, 0x77, 0x6f, 0x53, 0x74, 0x65, 0x70, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x50, 0x69, 0x6e, 0x12, 0x48, 0x2e, 0x77, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c,
+	0x2e, 0x69, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e,
+	0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x53, 0x65, 0x74, 0x57, 0x68, 0x61, 0x74, 0x73,
+	0x41, 0x70, 0x70, 0x54, 0x77, 0x6f, 0x53, 0x74, 0x65, 0x70, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x49, 0x2e, 0x77, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x2e, 0x69, 0x6d, 0x2e, 0x70, 0x72,
+	0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64,
+	0x65, 0x72, 0x53, 0x65, 0x74, 0x57, 0x68, 0x61, 0x74, 0x73, 0x41, 0x70, 0x70, 0x54, 0x77, 0x6f,
+	0x53, 0x74, 0x65, 0x70, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x50, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x33, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x2d, 0x3a, 0x01, 0x2a, 0x1a, 0x28, 0x2f, 0x69, 0x6d, 0x2f, 0x67, 0x61, 0x74, 0x65,
+	0x73, 0x2f, 0x77, 0x68, 0x61, 0x74, 0x73, 0x61, 0x70, 0x70, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x2f,
+	0x70, 0x68, 0x6f, 0x6e, 0x65, 0x2d, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x2f, 0x70, 0x69, 0x6e,
+	0x12, 0xd5, 0x01, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x57, 0x68, 0x61, 0x74, 0x73, 0x41, 0x70, 0x70,
+	0x42, 0x75, 0x73, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12,
+	0x41, 0x2e, 0x77, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x2e, 0x69, 0x6d, 0x2e, 0x70, 0x72, 0x6f,
+	0x76, 0x69, 0x64, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65,
+	0x72, 0x47, 0x65, 0x74, 0x57, 0x68, 0x61, 0x74, 0x73, 0x41, 0x70, 0x70, 0x42, 0x75, 0x73, 0x69,
+	0x6e, 0x65, 0x73, 0x73, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x42, 0x2e, 0x77, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x2e, 0x69, 0x6d, 0x2e,
+	0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x76,
+	0x69, 0x64, 0x65, 0x72, 0x47, 0x65, 0x74, 0x57, 0x68, 0x61, 0x74, 0x73, 0x41, 0x70, 0x70, 0x42,
+	0x75, 0x73, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x30, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2a, 0x12, 0x28,
+	0x2f, 0x69, 0x6d, 0x2f, 0x67, 0x61, 0x74, 0x65, 0x73, 0x2f, 0x77, 0x68, 0x61, 0x74, 0x73, 0x61,
+	0x70, 0x70, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x2f, 0x62, 0x75, 0x73, 0x69, 0x6e, 0x65, 0x73, 0x73,
+	0x2d, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0xe1, 0x01, 0x0a, 0x1d, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x57, 0x68, 0x61, 0x74, 0x73, 0x41, 0x70, 0x70, 0x42, 0x75, 0x73, 0x69, 0x6e,
+	0x65, 0x73, 0x73, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x44, 0x2e, 0x77, 0x65, 0x62,
+	0x69, 0x74, 0x65, 0x6c, 0x2e, 0x69, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
+	0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x57, 0x68, 0x61, 0x74, 0x73, 0x41, 0x70, 0x70, 0x42, 0x75, 0x73, 0x69, 0x6e, 0x65,
+	0x73, 0x73, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x45, 0x2e, 0x77, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x2e, 0x69, 0x6d, 0x2e, 0x70, 0x72,
+	0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64,
+	0x65, 0x72, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x57, 0x68, 0x61, 0x74, 0x73, 0x41, 0x70, 0x70,
+	0x42, 0x75, 0x73, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x33, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2d, 0x3a,
+	0x01, 0x2a, 0x32, 0x28, 0x2f, 0x69, 0x6d, 0x2f, 0x67, 0x61, 0x74, 0x65, 0x73, 0x2f, 0x77, 0x68,
+	0x61, 0x74, 0x73, 0x61, 0x70, 0x70, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x2f, 0x62, 0x75, 0x73, 0x69,
+	0x6e, 0x65, 0x73, 0x73, 0x2d, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0xaf, 0x01, 0x0a,
+	0x11, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x68, 0x61, 0x74, 0x73, 0x41, 0x70, 0x70, 0x46, 0x6c, 0x6f,
+	0x77, 0x73, 0x12, 0x38, 0x2e, 0x77, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x2e, 0x69, 0x6d, 0x2e,
+	0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x76,
+	0x69, 0x64, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x68, 0x61, 0x74, 0x73, 0x41, 0x70, 0x70,
+	0x46, 0x6c, 0x6f, 0x77, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x39, 0x2e, 0x77,
+	0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x2e, 0x69, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64,
+	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x4c, 0x69,
+	0x73, 0x74, 0x57, 0x68, 0x61, 0x74, 0x73, 0x41, 0x70, 0x70, 0x46, 0x6c, 0x6f, 0x77, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x25, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1f, 0x12,
+	0x1d, 0x2f, 0x69, 0x6d, 0x2f, 0x67, 0x61, 0x74, 0x65, 0x73, 0x2f, 0x77, 0x68, 0x61, 0x74, 0x73,
+	0x61, 0x70, 0x70, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x2f, 0x66, 0x6c, 0x6f, 0x77, 0x73, 0x12, 0xb1,
+	0x01, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x57, 0x68, 0x61, 0x74, 0x73, 0x41, 0x70, 0x70, 0x55, 0x73,
+	0x61, 0x67, 0x65, 0x12, 0x37, 0x2e, 0x77, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x2e, 0x69, 0x6d,
+	0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f,
+	0x76, 0x69, 0x64, 0x65, 0x72, 0x47, 0x65, 0x74, 0x57, 0x68, 0x61, 0x74, 0x73, 0x41, 0x70, 0x70,
+	0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x38, 0x2e, 0x77,
+	0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x2e, 0x69, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64,
+	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x47, 0x65,
+	0x74, 0x57, 0x68, 0x61, 0x74, 0x73, 0x41, 0x70, 0x70, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x24, 0x12, 0x22,
+	0x2f, 0x69, 0x6d, 0x2f, 0x67, 0x61, 0x74, 0x65, 0x73, 0x2f, 0x77, 0x68, 0x61, 0x74, 0x73, 0x61,
+	0x70, 0x70, 0x2f, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2f, 0x75, 0x73, 0x61,
+	0x67, 0x65, 0x42, 0xe7, 0x01, 0x0a, 0x1a, 0x63, 0x6f, 0x6d, 0x2e, 0x77, 0x65, 0x62, 0x69, 0x74,
+	0x65, 0x6c, 0x2e, 0x69, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x2e, 0x76,
+	0x31, 0x42, 0x14, 0x57, 0x68, 0x61, 0x74, 0x73, 0x61, 0x70, 0x70, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x38, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x77, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x2f, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x73, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x67, 0x6f, 0x2f, 0x69, 0x6d, 0x2f, 0x70,
+	0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x2f, 0x76, 0x31, 0x3b, 0x70, 0x72, 0x6f, 0x76, 0x69,
+	0x64, 0x65, 0x72, 0xa2, 0x02, 0x03, 0x57, 0x49, 0x50, 0xaa, 0x02, 0x16, 0x57, 0x65, 0x62, 0x69,
+	0x74, 0x65, 0x6c, 0x2e, 0x49, 0x6d, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x2e,
+	0x56, 0x31, 0xca, 0x02, 0x16, 0x57, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x5c, 0x49, 0x6d, 0x5c,
+	0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x22, 0x57, 0x65,
+	0x62, 0x69, 0x74, 0x65, 0x6c, 0x5c, 0x49, 0x6d, 0x5c, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65,
+	0x72, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0xea, 0x02, 0x19, 0x57, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x3a, 0x3a, 0x49, 0x6d, 0x3a, 0x3a,
+	0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_service_provider_v1_whatsapp_service_proto_rawDescOnce sync.Once
+	file_service_provider_v1_whatsapp_service_proto_rawDescData = file_service_provider_v1_whatsapp_service_proto_rawDesc
+)
+
+func file_service_provider_v1_whatsapp_service_proto_rawDescGZIP() []byte {
+	file_service_provider_v1_whatsapp_service_proto_rawDescOnce.Do(func() {
+		file_service_provider_v1_whatsapp_service_proto_rawDescData = protoimpl.X.CompressGZIP(file_service_provider_v1_whatsapp_service_proto_rawDescData)
+	})
+	return file_service_provider_v1_whatsapp_service_proto_rawDescData
 }
 
+var file_service_provider_v1_whatsapp_service_proto_msgTypes = make([]protoimpl.MessageInfo, 19)
 var file_service_provider_v1_whatsapp_service_proto_goTypes = []interface{}{
-	(*CreateGateRequest)(nil),                  // 0: webitel.im.provider.v1.CreateGateRequest
-	(*ProviderGetWhatsAppGateRequest)(nil),     // 1: webitel.im.provider.v1.ProviderGetWhatsAppGateRequest
-	(*ProviderUpdateWhatsAppGateRequest)(nil),  // 2: webitel.im.provider.v1.ProviderUpdateWhatsAppGateRequest
-	(*ProviderDeleteWhatsAppGateRequest)(nil),  // 3: webitel.im.provider.v1.ProviderDeleteWhatsAppGateRequest
-	(*GateResponse)(nil),                       // 4: webitel.im.provider.v1.GateResponse
-	(*ProviderGetWhatsAppGateResponse)(nil),    // 5: webitel.im.provider.v1.ProviderGetWhatsAppGateResponse
-	(*ProviderUpdateWhatsAppGateResponse)(nil), // 6: webitel.im.provider.v1.ProviderUpdateWhatsAppGateResponse
-	(*ProviderDeleteWhatsAppGateResponse)(nil), // 7: webitel.im.provider.v1.ProviderDeleteWhatsAppGateResponse
+	(*ProviderWhatsAppBusinessProfile)(nil),                   // 0: webitel.im.provider.v1.ProviderWhatsAppBusinessProfile
+	(*ProviderRegisterWhatsAppPhoneNumberRequest)(nil),        // 1: webitel.im.provider.v1.ProviderRegisterWhatsAppPhoneNumberRequest
+	(*ProviderRegisterWhatsAppPhoneNumberResponse)(nil),       // 2: webitel.im.provider.v1.ProviderRegisterWhatsAppPhoneNumberResponse
+	(*ProviderDeregisterWhatsAppPhoneNumberRequest)(nil),      // 3: webitel.im.provider.v1.ProviderDeregisterWhatsAppPhoneNumberRequest
+	(*ProviderDeregisterWhatsAppPhoneNumberResponse)(nil),     // 4: webitel.im.provider.v1.ProviderDeregisterWhatsAppPhoneNumberResponse
+	(*ProviderSetWhatsAppTwoStepVerificationPinRequest)(nil),  // 5: webitel.im.provider.v1.ProviderSetWhatsAppTwoStepVerificationPinRequest
+	(*ProviderSetWhatsAppTwoStepVerificationPinResponse)(nil), // 6: webitel.im.provider.v1.ProviderSetWhatsAppTwoStepVerificationPinResponse
+	(*ProviderGetWhatsAppBusinessProfileRequest)(nil),         // 7: webitel.im.provider.v1.ProviderGetWhatsAppBusinessProfileRequest
+	(*ProviderGetWhatsAppBusinessProfileResponse)(nil),        // 8: webitel.im.provider.v1.ProviderGetWhatsAppBusinessProfileResponse
+	(*ProviderUpdateWhatsAppBusinessProfileRequest)(nil),      // 9: webitel.im.provider.v1.ProviderUpdateWhatsAppBusinessProfileRequest
+	(*ProviderUpdateWhatsAppBusinessProfileResponse)(nil),     // 10: webitel.im.provider.v1.ProviderUpdateWhatsAppBusinessProfileResponse
+	(*ProviderWhatsAppProfilePicture)(nil),                    // 11: webitel.im.provider.v1.ProviderWhatsAppProfilePicture
+	(*ProviderWhatsAppFlow)(nil),                              // 12: webitel.im.provider.v1.ProviderWhatsAppFlow
+	(*ProviderWhatsAppFlowValidationError)(nil),               // 13: webitel.im.provider.v1.ProviderWhatsAppFlowValidationError
+	(*ProviderListWhatsAppFlowsRequest)(nil),                  // 14: webitel.im.provider.v1.ProviderListWhatsAppFlowsRequest
+	(*ProviderListWhatsAppFlowsResponse)(nil),                 // 15: webitel.im.provider.v1.ProviderListWhatsAppFlowsResponse
+	(*ProviderGetWhatsAppUsageRequest)(nil),                   // 16: webitel.im.provider.v1.ProviderGetWhatsAppUsageRequest
+	(*ProviderWhatsAppUsage)(nil),                             // 17: webitel.im.provider.v1.ProviderWhatsAppUsage
+	(*ProviderGetWhatsAppUsageResponse)(nil),                  // 18: webitel.im.provider.v1.ProviderGetWhatsAppUsageResponse
+	(*CreateGateRequest)(nil),                                 // 19: webitel.im.provider.v1.CreateGateRequest
+	(*ProviderGetWhatsAppGateRequest)(nil),                    // 20: webitel.im.provider.v1.ProviderGetWhatsAppGateRequest
+	(*ProviderUpdateWhatsAppGateRequest)(nil),                 // 21: webitel.im.provider.v1.ProviderUpdateWhatsAppGateRequest
+	(*ProviderDeleteWhatsAppGateRequest)(nil),                 // 22: webitel.im.provider.v1.ProviderDeleteWhatsAppGateRequest
+	(*GateResponse)(nil),                                      // 23: webitel.im.provider.v1.GateResponse
+	(*ProviderGetWhatsAppGateResponse)(nil),                   // 24: webitel.im.provider.v1.ProviderGetWhatsAppGateResponse
+	(*ProviderUpdateWhatsAppGateResponse)(nil),                // 25: webitel.im.provider.v1.ProviderUpdateWhatsAppGateResponse
+	(*ProviderDeleteWhatsAppGateResponse)(nil),                // 26: webitel.im.provider.v1.ProviderDeleteWhatsAppGateResponse
 }
 var file_service_provider_v1_whatsapp_service_proto_depIdxs = []int32{
-	0, // 0: webitel.im.provider.v1.WhatsAppService.CreateWhatsAppGate:input_type -> webitel.im.provider.v1.CreateGateRequest
-	1, // 1: webitel.im.provider.v1.WhatsAppService.GetWhatsAppGate:input_type -> webitel.im.provider.v1.ProviderGetWhatsAppGateRequest
-	2, // 2: webitel.im.provider.v1.WhatsAppService.UpdateWhatsAppGate:input_type -> webitel.im.provider.v1.ProviderUpdateWhatsAppGateRequest
-	3, // 3: webitel.im.provider.v1.WhatsAppService.DeleteWhatsAppGate:input_type -> webitel.im.provider.v1.ProviderDeleteWhatsAppGateRequest
-	4, // 4: webitel.im.provider.v1.WhatsAppService.CreateWhatsAppGate:output_type -> webitel.im.provider.v1.GateResponse
-	5, // 5: webitel.im.provider.v1.WhatsAppService.GetWhatsAppGate:output_type -> webitel.im.provider.v1.ProviderGetWhatsAppGateResponse
-	6, // 6: webitel.im.provider.v1.WhatsAppService.UpdateWhatsAppGate:output_type -> webitel.im.provider.v1.ProviderUpdateWhatsAppGateResponse
-	7, // 7: webitel.im.provider.v1.WhatsAppService.DeleteWhatsAppGate:output_type -> webitel.im.provider.v1.ProviderDeleteWhatsAppGateResponse
-	4, // [4:8] is the sub-list for method output_type
-	0, // [0:4] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	0,  // 0: webitel.im.provider.v1.ProviderGetWhatsAppBusinessProfileResponse.profile:type_name -> webitel.im.provider.v1.ProviderWhatsAppBusinessProfile
+	11, // 1: webitel.im.provider.v1.ProviderUpdateWhatsAppBusinessProfileRequest.profile_picture:type_name -> webitel.im.provider.v1.ProviderWhatsAppProfilePicture
+	0,  // 2: webitel.im.provider.v1.ProviderUpdateWhatsAppBusinessProfileResponse.profile:type_name -> webitel.im.provider.v1.ProviderWhatsAppBusinessProfile
+	13, // 3: webitel.im.provider.v1.ProviderWhatsAppFlow.validation_errors:type_name -> webitel.im.provider.v1.ProviderWhatsAppFlowValidationError
+	12, // 4: webitel.im.provider.v1.ProviderListWhatsAppFlowsResponse.items:type_name -> webitel.im.provider.v1.ProviderWhatsAppFlow
+	17, // 5: webitel.im.provider.v1.ProviderGetWhatsAppUsageResponse.items:type_name -> webitel.im.provider.v1.ProviderWhatsAppUsage
+	19, // 6: webitel.im.provider.v1.WhatsAppService.CreateWhatsAppGate:input_type -> webitel.im.provider.v1.CreateGateRequest
+	20, // 7: webitel.im.provider.v1.WhatsAppService.GetWhatsAppGate:input_type -> webitel.im.provider.v1.ProviderGetWhatsAppGateRequest
+	21, // 8: webitel.im.provider.v1.WhatsAppService.UpdateWhatsAppGate:input_type -> webitel.im.provider.v1.ProviderUpdateWhatsAppGateRequest
+	22, // 9: webitel.im.provider.v1.WhatsAppService.DeleteWhatsAppGate:input_type -> webitel.im.provider.v1.ProviderDeleteWhatsAppGateRequest
+	1,  // 10: webitel.im.provider.v1.WhatsAppService.RegisterWhatsAppPhoneNumber:input_type -> webitel.im.provider.v1.ProviderRegisterWhatsAppPhoneNumberRequest
+	3,  // 11: webitel.im.provider.v1.WhatsAppService.DeregisterWhatsAppPhoneNumber:input_type -> webitel.im.provider.v1.ProviderDeregisterWhatsAppPhoneNumberRequest
+	5,  // 12: webitel.im.provider.v1.WhatsAppService.SetWhatsAppTwoStepVerificationPin:input_type -> webitel.im.provider.v1.ProviderSetWhatsAppTwoStepVerificationPinRequest
+	7,  // 13: webitel.im.provider.v1.WhatsAppService.GetWhatsAppBusinessProfile:input_type -> webitel.im.provider.v1.ProviderGetWhatsAppBusinessProfileRequest
+	9,  // 14: webitel.im.provider.v1.WhatsAppService.UpdateWhatsAppBusinessProfile:input_type -> webitel.im.provider.v1.ProviderUpdateWhatsAppBusinessProfileRequest
+	14, // 15: webitel.im.provider.v1.WhatsAppService.ListWhatsAppFlows:input_type -> webitel.im.provider.v1.ProviderListWhatsAppFlowsRequest
+	16, // 16: webitel.im.provider.v1.WhatsAppService.GetWhatsAppUsage:input_type -> webitel.im.provider.v1.ProviderGetWhatsAppUsageRequest
+	23, // 17: webitel.im.provider.v1.WhatsAppService.CreateWhatsAppGate:output_type -> webitel.im.provider.v1.GateResponse
+	24, // 18: webitel.im.provider.v1.WhatsAppService.GetWhatsAppGate:output_type -> webitel.im.provider.v1.ProviderGetWhatsAppGateResponse
+	25, // 19: webitel.im.provider.v1.WhatsAppService.UpdateWhatsAppGate:output_type -> webitel.im.provider.v1.ProviderUpdateWhatsAppGateResponse
+	26, // 20: webitel.im.provider.v1.WhatsAppService.DeleteWhatsAppGate:output_type -> webitel.im.provider.v1.ProviderDeleteWhatsAppGateResponse
+	2,  // 21: webitel.im.provider.v1.WhatsAppService.RegisterWhatsAppPhoneNumber:output_type -> webitel.im.provider.v1.ProviderRegisterWhatsAppPhoneNumberResponse
+	4,  // 22: webitel.im.provider.v1.WhatsAppService.DeregisterWhatsAppPhoneNumber:output_type -> webitel.im.provider.v1.ProviderDeregisterWhatsAppPhoneNumberResponse
+	6,  // 23: webitel.im.provider.v1.WhatsAppService.SetWhatsAppTwoStepVerificationPin:output_type -> webitel.im.provider.v1.ProviderSetWhatsAppTwoStepVerificationPinResponse
+	8,  // 24: webitel.im.provider.v1.WhatsAppService.GetWhatsAppBusinessProfile:output_type -> webitel.im.provider.v1.ProviderGetWhatsAppBusinessProfileResponse
+	10, // 25: webitel.im.provider.v1.WhatsAppService.UpdateWhatsAppBusinessProfile:output_type -> webitel.im.provider.v1.ProviderUpdateWhatsAppBusinessProfileResponse
+	15, // 26: webitel.im.provider.v1.WhatsAppService.ListWhatsAppFlows:output_type -> webitel.im.provider.v1.ProviderListWhatsAppFlowsResponse
+	18, // 27: webitel.im.provider.v1.WhatsAppService.GetWhatsAppUsage:output_type -> webitel.im.provider.v1.ProviderGetWhatsAppUsageResponse
+	17, // [17:28] is the sub-list for method output_type
+	6,  // [6:17] is the sub-list for method input_type
+	6,  // [6:6] is the sub-list for extension type_name
+	6,  // [6:6] is the sub-list for extension extendee
+	0,  // [0:6] is the sub-list for field type_name
 }
 
 func init() { file_service_provider_v1_whatsapp_service_proto_init() }
@@ -126,18 +1564,250 @@ func file_service_provider_v1_whatsapp_service_proto_init() {
 	}
 	file_service_provider_v1_entities_proto_init()
 	file_service_provider_v1_messages_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_service_provider_v1_whatsapp_service_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProviderWhatsAppBusinessProfile); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_provider_v1_whatsapp_service_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProviderRegisterWhatsAppPhoneNumberRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_provider_v1_whatsapp_service_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProviderRegisterWhatsAppPhoneNumberResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_provider_v1_whatsapp_service_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProviderDeregisterWhatsAppPhoneNumberRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_provider_v1_whatsapp_service_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProviderDeregisterWhatsAppPhoneNumberResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_provider_v1_whatsapp_service_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProviderSetWhatsAppTwoStepVerificationPinRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_provider_v1_whatsapp_service_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProviderSetWhatsAppTwoStepVerificationPinResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_provider_v1_whatsapp_service_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProviderGetWhatsAppBusinessProfileRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_provider_v1_whatsapp_service_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProviderGetWhatsAppBusinessProfileResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_provider_v1_whatsapp_service_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProviderUpdateWhatsAppBusinessProfileRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_provider_v1_whatsapp_service_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProviderUpdateWhatsAppBusinessProfileResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_provider_v1_whatsapp_service_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProviderWhatsAppProfilePicture); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_provider_v1_whatsapp_service_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProviderWhatsAppFlow); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_provider_v1_whatsapp_service_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProviderWhatsAppFlowValidationError); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_provider_v1_whatsapp_service_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProviderListWhatsAppFlowsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_provider_v1_whatsapp_service_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProviderListWhatsAppFlowsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_provider_v1_whatsapp_service_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProviderGetWhatsAppUsageRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_provider_v1_whatsapp_service_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProviderWhatsAppUsage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_provider_v1_whatsapp_service_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProviderGetWhatsAppUsageResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_service_provider_v1_whatsapp_service_proto_msgTypes[9].OneofWrappers = []interface{}{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_service_provider_v1_whatsapp_service_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   0,
+			NumMessages:   19,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_service_provider_v1_whatsapp_service_proto_goTypes,
 		DependencyIndexes: file_service_provider_v1_whatsapp_service_proto_depIdxs,
+		MessageInfos:      file_service_provider_v1_whatsapp_service_proto_msgTypes,
 	}.Build()
 	File_service_provider_v1_whatsapp_service_proto = out.File
 	file_service_provider_v1_whatsapp_service_proto_rawDesc = nil
@@ -19,10 +19,17 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	WhatsAppService_CreateWhatsAppGate_FullMethodName = "/webitel.im.provider.v1.WhatsAppService/CreateWhatsAppGate"
-	WhatsAppService_GetWhatsAppGate_FullMethodName    = "/webitel.im.provider.v1.WhatsAppService/GetWhatsAppGate"
-	WhatsAppService_UpdateWhatsAppGate_FullMethodName = "/webitel.im.provider.v1.WhatsAppService/UpdateWhatsAppGate"
-	WhatsAppService_DeleteWhatsAppGate_FullMethodName = "/webitel.im.provider.v1.WhatsAppService/DeleteWhatsAppGate"
+	WhatsAppService_CreateWhatsAppGate_FullMethodName                = "/webitel.im.provider.v1.WhatsAppService/CreateWhatsAppGate"
+	WhatsAppService_GetWhatsAppGate_FullMethodName                   = "/webitel.im.provider.v1.WhatsAppService/GetWhatsAppGate"
+	WhatsAppService_UpdateWhatsAppGate_FullMethodName                = "/webitel.im.provider.v1.WhatsAppService/UpdateWhatsAppGate"
+	WhatsAppService_DeleteWhatsAppGate_FullMethodName                = "/webitel.im.provider.v1.WhatsAppService/DeleteWhatsAppGate"
+	WhatsAppService_RegisterWhatsAppPhoneNumber_FullMethodName       = "/webitel.im.provider.v1.WhatsAppService/RegisterWhatsAppPhoneNumber"
+	WhatsAppService_DeregisterWhatsAppPhoneNumber_FullMethodName     = "/webitel.im.provider.v1.WhatsAppService/DeregisterWhatsAppPhoneNumber"
+	WhatsAppService_SetWhatsAppTwoStepVerificationPin_FullMethodName = "/webitel.im.provider.v1.WhatsAppService/SetWhatsAppTwoStepVerificationPin"
+	WhatsAppService_GetWhatsAppBusinessProfile_FullMethodName        = "/webitel.im.provider.v1.WhatsAppService/GetWhatsAppBusinessProfile"
+	WhatsAppService_UpdateWhatsAppBusinessProfile_FullMethodName     = "/webitel.im.provider.v1.WhatsAppService/UpdateWhatsAppBusinessProfile"
+	WhatsAppService_ListWhatsAppFlows_FullMethodName                 = "/webitel.im.provider.v1.WhatsAppService/ListWhatsAppFlows"
+	WhatsAppService_GetWhatsAppUsage_FullMethodName                  = "/webitel.im.provider.v1.WhatsAppService/GetWhatsAppUsage"
 )
 
 // WhatsAppServiceClient is the client API for WhatsAppService service.
@@ -37,6 +44,20 @@ type WhatsAppServiceClient interface {
 	UpdateWhatsAppGate(ctx context.Context, in *ProviderUpdateWhatsAppGateRequest, opts ...grpc.CallOption) (*ProviderUpdateWhatsAppGateResponse, error)
 	// / DeleteWhatsAppGate removes the WhatsApp gateway integration.
 	DeleteWhatsAppGate(ctx context.Context, in *ProviderDeleteWhatsAppGateRequest, opts ...grpc.CallOption) (*ProviderDeleteWhatsAppGateResponse, error)
+	// / RegisterWhatsAppPhoneNumber registers the gate phone number for Cloud API messaging.
+	RegisterWhatsAppPhoneNumber(ctx context.Context, in *ProviderRegisterWhatsAppPhoneNumberRequest, opts ...grpc.CallOption) (*ProviderRegisterWhatsAppPhoneNumberResponse, error)
+	// / DeregisterWhatsAppPhoneNumber removes the gate phone number from Cloud API messaging.
+	DeregisterWhatsAppPhoneNumber(ctx context.Context, in *ProviderDeregisterWhatsAppPhoneNumberRequest, opts ...grpc.CallOption) (*ProviderDeregisterWhatsAppPhoneNumberResponse, error)
+	// / SetWhatsAppTwoStepVerificationPin changes the two-step verification PIN of the gate phone number.
+	SetWhatsAppTwoStepVerificationPin(ctx context.Context, in *ProviderSetWhatsAppTwoStepVerificationPinRequest, opts ...grpc.CallOption) (*ProviderSetWhatsAppTwoStepVerificationPinResponse, error)
+	// / GetWhatsAppBusinessProfile returns the business profile of the gate phone number.
+	GetWhatsAppBusinessProfile(ctx context.Context, in *ProviderGetWhatsAppBusinessProfileRequest, opts ...grpc.CallOption) (*ProviderGetWhatsAppBusinessProfileResponse, error)
+	// / UpdateWhatsAppBusinessProfile changes the business profile of the gate phone number.
+	UpdateWhatsAppBusinessProfile(ctx context.Context, in *ProviderUpdateWhatsAppBusinessProfileRequest, opts ...grpc.CallOption) (*ProviderUpdateWhatsAppBusinessProfileResponse, error)
+	// / ListWhatsAppFlows lists the WhatsApp Flows of the business account behind the gate.
+	ListWhatsAppFlows(ctx context.Context, in *ProviderListWhatsAppFlowsRequest, opts ...grpc.CallOption) (*ProviderListWhatsAppFlowsResponse, error)
+	// / GetWhatsAppUsage reports the conversations and messages of the domain by gate, pricing category and day.
+	GetWhatsAppUsage(ctx context.Context, in *ProviderGetWhatsAppUsageRequest, opts ...grpc.CallOption) (*ProviderGetWhatsAppUsageResponse, error)
 }
 
 type whatsAppServiceClient struct {
@@ -87,6 +108,76 @@ func (c *whatsAppServiceClient) DeleteWhatsAppGate(ctx context.Context, in *Prov
 	return out, nil
 }
 
+func (c *whatsAppServiceClient) RegisterWhatsAppPhoneNumber(ctx context.Context, in *ProviderRegisterWhatsAppPhoneNumberRequest, opts ...grpc.CallOption) (*ProviderRegisterWhatsAppPhoneNumberResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProviderRegisterWhatsAppPhoneNumberResponse)
+	err := c.cc.Invoke(ctx, WhatsAppService_RegisterWhatsAppPhoneNumber_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *whatsAppServiceClient) DeregisterWhatsAppPhoneNumber(ctx context.Context, in *ProviderDeregisterWhatsAppPhoneNumberRequest, opts ...grpc.CallOption) (*ProviderDeregisterWhatsAppPhoneNumberResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProviderDeregisterWhatsAppPhoneNumberResponse)
+	err := c.cc.Invoke(ctx, WhatsAppService_DeregisterWhatsAppPhoneNumber_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *whatsAppServiceClient) SetWhatsAppTwoStepVerificationPin(ctx context.Context, in *ProviderSetWhatsAppTwoStepVerificationPinRequest, opts ...grpc.CallOption) (*ProviderSetWhatsAppTwoStepVerificationPinResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProviderSetWhatsAppTwoStepVerificationPinResponse)
+	err := c.cc.Invoke(ctx, WhatsAppService_SetWhatsAppTwoStepVerificationPin_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *whatsAppServiceClient) GetWhatsAppBusinessProfile(ctx context.Context, in *ProviderGetWhatsAppBusinessProfileRequest, opts ...grpc.CallOption) (*ProviderGetWhatsAppBusinessProfileResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProviderGetWhatsAppBusinessProfileResponse)
+	err := c.cc.Invoke(ctx, WhatsAppService_GetWhatsAppBusinessProfile_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *whatsAppServiceClient) UpdateWhatsAppBusinessProfile(ctx context.Context, in *ProviderUpdateWhatsAppBusinessProfileRequest, opts ...grpc.CallOption) (*ProviderUpdateWhatsAppBusinessProfileResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProviderUpdateWhatsAppBusinessProfileResponse)
+	err := c.cc.Invoke(ctx, WhatsAppService_UpdateWhatsAppBusinessProfile_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *whatsAppServiceClient) ListWhatsAppFlows(ctx context.Context, in *ProviderListWhatsAppFlowsRequest, opts ...grpc.CallOption) (*ProviderListWhatsAppFlowsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProviderListWhatsAppFlowsResponse)
+	err := c.cc.Invoke(ctx, WhatsAppService_ListWhatsAppFlows_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *whatsAppServiceClient) GetWhatsAppUsage(ctx context.Context, in *ProviderGetWhatsAppUsageRequest, opts ...grpc.CallOption) (*ProviderGetWhatsAppUsageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProviderGetWhatsAppUsageResponse)
+	err := c.cc.Invoke(ctx, WhatsAppService_GetWhatsAppUsage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // WhatsAppServiceServer is the server API for WhatsAppService service.
 // All implementations must embed UnimplementedWhatsAppServiceServer
 // for forward compatibility.
@@ -99,6 +190,20 @@ type WhatsAppServiceServer interface {
 	UpdateWhatsAppGate(context.Context, *ProviderUpdateWhatsAppGateRequest) (*ProviderUpdateWhatsAppGateResponse, error)
 	// / DeleteWhatsAppGate removes the WhatsApp gateway integration.
 	DeleteWhatsAppGate(context.Context, *ProviderDeleteWhatsAppGateRequest) (*ProviderDeleteWhatsAppGateResponse, error)
+	// / RegisterWhatsAppPhoneNumber registers the gate phone number for Cloud API messaging.
+	RegisterWhatsAppPhoneNumber(context.Context, *ProviderRegisterWhatsAppPhoneNumberRequest) (*ProviderRegisterWhatsAppPhoneNumberResponse, error)
+	// / DeregisterWhatsAppPhoneNumber removes the gate phone number from Cloud API messaging.
+	DeregisterWhatsAppPhoneNumber(context.Context, *ProviderDeregisterWhatsAppPhoneNumberRequest) (*ProviderDeregisterWhatsAppPhoneNumberResponse, error)
+	// / SetWhatsAppTwoStepVerificationPin changes the two-step verification PIN of the gate phone number.
+	SetWhatsAppTwoStepVerificationPin(context.Context, *ProviderSetWhatsAppTwoStepVerificationPinRequest) (*ProviderSetWhatsAppTwoStepVerificationPinResponse, error)
+	// / GetWhatsAppBusinessProfile returns the business profile of the gate phone number.
+	GetWhatsAppBusinessProfile(context.Context, *ProviderGetWhatsAppBusinessProfileRequest) (*ProviderGetWhatsAppBusinessProfileResponse, error)
+	// / UpdateWhatsAppBusinessProfile changes the business profile of the gate phone number.
+	UpdateWhatsAppBusinessProfile(context.Context, *ProviderUpdateWhatsAppBusinessProfileRequest) (*ProviderUpdateWhatsAppBusinessProfileResponse, error)
+	// / ListWhatsAppFlows lists the WhatsApp Flows of the business account behind the gate.
+	ListWhatsAppFlows(context.Context, *ProviderListWhatsAppFlowsRequest) (*ProviderListWhatsAppFlowsResponse, error)
+	// / GetWhatsAppUsage reports the conversations and messages of the domain by gate, pricing category and day.
+	GetWhatsAppUsage(context.Context, *ProviderGetWhatsAppUsageRequest) (*ProviderGetWhatsAppUsageResponse, error)
 	mustEmbedUnimplementedWhatsAppServiceServer()
 }
 
@@ -121,6 +226,27 @@ func (UnimplementedWhatsAppServiceServer) UpdateWhatsAppGate(context.Context, *P
 func (UnimplementedWhatsAppServiceServer) DeleteWhatsAppGate(context.Context, *ProviderDeleteWhatsAppGateRequest) (*ProviderDeleteWhatsAppGateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteWhatsAppGate not implemented")
 }
+func (UnimplementedWhatsAppServiceServer) RegisterWhatsAppPhoneNumber(context.Context, *ProviderRegisterWhatsAppPhoneNumberRequest) (*ProviderRegisterWhatsAppPhoneNumberResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterWhatsAppPhoneNumber not implemented")
+}
+func (UnimplementedWhatsAppServiceServer) DeregisterWhatsAppPhoneNumber(context.Context, *ProviderDeregisterWhatsAppPhoneNumberRequest) (*ProviderDeregisterWhatsAppPhoneNumberResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeregisterWhatsAppPhoneNumber not implemented")
+}
+func (UnimplementedWhatsAppServiceServer) SetWhatsAppTwoStepVerificationPin(context.Context, *ProviderSetWhatsAppTwoStepVerificationPinRequest) (*ProviderSetWhatsAppTwoStepVerificationPinResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetWhatsAppTwoStepVerificationPin not implemented")
+}
+func (UnimplementedWhatsAppServiceServer) GetWhatsAppBusinessProfile(context.Context, *ProviderGetWhatsAppBusinessProfileRequest) (*ProviderGetWhatsAppBusinessProfileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWhatsAppBusinessProfile not implemented")
+}
+func (UnimplementedWhatsAppServiceServer) UpdateWhatsAppBusinessProfile(context.Context, *ProviderUpdateWhatsAppBusinessProfileRequest) (*ProviderUpdateWhatsAppBusinessProfileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateWhatsAppBusinessProfile not implemented")
+}
+func (UnimplementedWhatsAppServiceServer) ListWhatsAppFlows(context.Context, *ProviderListWhatsAppFlowsRequest) (*ProviderListWhatsAppFlowsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWhatsAppFlows not implemented")
+}
+func (UnimplementedWhatsAppServiceServer) GetWhatsAppUsage(context.Context, *ProviderGetWhatsAppUsageRequest) (*ProviderGetWhatsAppUsageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWhatsAppUsage not implemented")
+}
 func (UnimplementedWhatsAppServiceServer) mustEmbedUnimplementedWhatsAppServiceServer() {}
 func (UnimplementedWhatsAppServiceServer) testEmbeddedByValue()                         {}
 
@@ -214,6 +340,132 @@ func _WhatsAppService_DeleteWhatsAppGate_Handler(srv interface{}, ctx context.Co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _WhatsAppService_RegisterWhatsAppPhoneNumber_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProviderRegisterWhatsAppPhoneNumberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WhatsAppServiceServer).RegisterWhatsAppPhoneNumber(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WhatsAppService_RegisterWhatsAppPhoneNumber_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WhatsAppServiceServer).RegisterWhatsAppPhoneNumber(ctx, req.(*ProviderRegisterWhatsAppPhoneNumberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WhatsAppService_DeregisterWhatsAppPhoneNumber_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProviderDeregisterWhatsAppPhoneNumberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WhatsAppServiceServer).DeregisterWhatsAppPhoneNumber(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WhatsAppService_DeregisterWhatsAppPhoneNumber_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WhatsAppServiceServer).DeregisterWhatsAppPhoneNumber(ctx, req.(*ProviderDeregisterWhatsAppPhoneNumberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WhatsAppService_SetWhatsAppTwoStepVerificationPin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProviderSetWhatsAppTwoStepVerificationPinRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WhatsAppServiceServer).SetWhatsAppTwoStepVerificationPin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WhatsAppService_SetWhatsAppTwoStepVerificationPin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WhatsAppServiceServer).SetWhatsAppTwoStepVerificationPin(ctx, req.(*ProviderSetWhatsAppTwoStepVerificationPinRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WhatsAppService_GetWhatsAppBusinessProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProviderGetWhatsAppBusinessProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WhatsAppServiceServer).GetWhatsAppBusinessProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WhatsAppService_GetWhatsAppBusinessProfile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WhatsAppServiceServer).GetWhatsAppBusinessProfile(ctx, req.(*ProviderGetWhatsAppBusinessProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WhatsAppService_UpdateWhatsAppBusinessProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProviderUpdateWhatsAppBusinessProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WhatsAppServiceServer).UpdateWhatsAppBusinessProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WhatsAppService_UpdateWhatsAppBusinessProfile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WhatsAppServiceServer).UpdateWhatsAppBusinessProfile(ctx, req.(*ProviderUpdateWhatsAppBusinessProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WhatsAppService_ListWhatsAppFlows_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProviderListWhatsAppFlowsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WhatsAppServiceServer).ListWhatsAppFlows(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WhatsAppService_ListWhatsAppFlows_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WhatsAppServiceServer).ListWhatsAppFlows(ctx, req.(*ProviderListWhatsAppFlowsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WhatsAppService_GetWhatsAppUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProviderGetWhatsAppUsageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WhatsAppServiceServer).GetWhatsAppUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WhatsAppService_GetWhatsAppUsage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WhatsAppServiceServer).GetWhatsAppUsage(ctx, req.(*ProviderGetWhatsAppUsageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // WhatsAppService_ServiceDesc is the grpc.ServiceDesc for WhatsAppService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -237,6 +489,34 @@ var WhatsAppService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteWhatsAppGate",
 			Handler:    _WhatsAppService_DeleteWhatsAppGate_Handler,
 		},
+		{
+			MethodName: "RegisterWhatsAppPhoneNumber",
+			Handler:    _WhatsAppService_RegisterWhatsAppPhoneNumber_Handler,
+		},
+		{
+			MethodName: "DeregisterWhatsAppPhoneNumber",
+			Handler:    _WhatsAppService_DeregisterWhatsAppPhoneNumber_Handler,
+		},
+		{
+			MethodName: "SetWhatsAppTwoStepVerificationPin",
+			Handler:    _WhatsAppService_SetWhatsAppTwoStepVerificationPin_Handler,
+		},
+		{
+			MethodName: "GetWhatsAppBusinessProfile",
+			Handler:    _WhatsAppService_GetWhatsAppBusinessProfile_Handler,
+		},
+		{
+			MethodName: "UpdateWhatsAppBusinessProfile",
+			Handler:    _WhatsAppService_UpdateWhatsAppBusinessProfile_Handler,
+		},
+		{
+			MethodName: "ListWhatsAppFlows",
+			Handler:    _WhatsAppService_ListWhatsAppFlows_Handler,
+		},
+		{
+			MethodName: "GetWhatsAppUsage",
+			Handler:    _WhatsAppService_GetWhatsAppUsage_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "service/provider/v1/whatsapp_service.proto",
@@ -56,6 +56,21 @@ func (c *Client) SearchContact(ctx context.Context, req *contactv1.SearchContact
 	return resp, err
 }
 
+// PatchContact updates only the contact fields listed in the request field mask
+func (c *Client) PatchContact(ctx context.Context, req *contactv1.PatchContactRequest) (*contactv1.Contact, error) {
+	var resp *contactv1.Contact
+
+	err := c.rpc.Execute(ctx, func(api contactv1.ContactsClient) error {
+		c.logger.Debug("CONTACTS.PATCH", slog.Any("req", req))
+
+		var err error
+		resp, err = api.Patch(ctx, req)
+		return err
+	})
+
+	return resp, err
+}
+
 // Close gracefully shuts down the underlying gRPC connection pool
 func (c *Client) Close() error {
 	if c.rpc != nil {
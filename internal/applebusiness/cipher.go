@@ -0,0 +1,72 @@
+package applebusiness
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// Files stored by Apple are encrypted with AES-256 in CTR mode, with a random key
+// per file and a zero IV. The key travels hex-encoded, prefixed with "00".
+// https://register.apple.com/resources/messages/msp-rest-api/attachments
+const (
+	fileKeySize   = 32
+	fileKeyPrefix = "00"
+)
+
+// newFileKey returns a random key for a file to upload.
+func newFileKey() ([]byte, error) {
+	key := make([]byte, fileKeySize)
+	if _, err := rand.Read(key); err != nil {
+		return nil, fmt.Errorf("generate file key: %w", err)
+	}
+	return key, nil
+}
+
+// formatFileKey returns the key as sent in a message.
+func formatFileKey(key []byte) string {
+	return fileKeyPrefix + hex.EncodeToString(key)
+}
+
+// parseFileKey is the inverse of formatFileKey.
+func parseFileKey(s string) ([]byte, error) {
+	key, err := hex.DecodeString(strings.TrimPrefix(s, fileKeyPrefix))
+	if err != nil || len(key) != fileKeySize {
+		return nil, fmt.Errorf("malformed file key")
+	}
+	return key, nil
+}
+
+// fileStream returns the AES-CTR keystream of a file. CTR is symmetric, so the same
+// stream encrypts and decrypts.
+func fileStream(key []byte) (cipher.Stream, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewCTR(block, make([]byte, aes.BlockSize)), nil
+}
+
+// encryptFile returns the file encrypted with the key.
+func encryptFile(key, plain []byte) ([]byte, error) {
+	stream, err := fileStream(key)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]byte, len(plain))
+	stream.XORKeyStream(out, plain)
+	return out, nil
+}
+
+// decryptingReader decrypts the file read from r.
+func decryptingReader(key []byte, r io.Reader) (io.Reader, error) {
+	stream, err := fileStream(key)
+	if err != nil {
+		return nil, err
+	}
+	return &cipher.StreamReader{S: stream, R: r}, nil
+}
@@ -0,0 +1,225 @@
+package applebusiness
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"time"
+
+	abmodel "github.com/webitel/im-providers-service/internal/applebusiness/model"
+)
+
+// GatewayBaseURL is the base URL of the MSP gateway.
+const GatewayBaseURL = "https://mspgw.push.apple.com/v1"
+
+// botAPI is the contract used by appleProvider to talk to the MSP gateway.
+// Keeping it as an interface allows the provider to be tested without network calls.
+type botAPI interface {
+	SendMessage(ctx context.Context, creds abmodel.Credentials, m *message) error
+	Upload(ctx context.Context, creds abmodel.Credentials, data []byte) (*fileRef, error)
+	Download(ctx context.Context, creds abmodel.Credentials, ref fileRef) (*content, error)
+}
+
+// content is the decrypted body of a downloaded file. The caller closes Body.
+type content struct {
+	Body     io.ReadCloser
+	MimeType string
+	Size     int64
+}
+
+type apiClient struct {
+	client     *http.Client
+	logger     *slog.Logger
+	gatewayURL string
+	now        func() time.Time
+}
+
+var _ botAPI = (*apiClient)(nil)
+
+func newAPIClient(l *slog.Logger) *apiClient {
+	return &apiClient{
+		// Attachments share the client, hence the generous timeout.
+		client:     &http.Client{Timeout: 2 * time.Minute},
+		logger:     l.With("component", "applebusiness.api"),
+		gatewayURL: GatewayBaseURL,
+		now:        time.Now,
+	}
+}
+
+// SendMessage sends a gzip-compressed message to a customer.
+// https://register.apple.com/resources/messages/msp-rest-api/getting-started#send-a-message
+func (c *apiClient) SendMessage(ctx context.Context, creds abmodel.Credentials, m *message) error {
+	raw, err := json.Marshal(m)
+	if err != nil {
+		return fmt.Errorf("apple business message: marshal: %w", err)
+	}
+	var body bytes.Buffer
+	zw := gzip.NewWriter(&body)
+	if _, err := zw.Write(raw); err != nil {
+		return fmt.Errorf("apple business message: compress: %w", err)
+	}
+	if err := zw.Close(); err != nil {
+		return fmt.Errorf("apple business message: compress: %w", err)
+	}
+
+	req, err := c.request(ctx, http.MethodPost, c.gatewayURL+"/message", &body, creds)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Content-Encoding", "gzip")
+	req.Header.Set("id", m.ID)
+	req.Header.Set("destination-id", m.DestinationID)
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("apple business message: %w", err)
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, resp.Body)
+
+	if resp.StatusCode != http.StatusOK {
+		c.logger.WarnContext(ctx, "message rejected", "id", m.ID, "status", resp.StatusCode)
+		return &APIError{Operation: "message", StatusCode: resp.StatusCode}
+	}
+	return nil
+}
+
+// Upload encrypts a file with a key of its own and stores it with Apple.
+// https://register.apple.com/resources/messages/msp-rest-api/attachments#upload-an-attachment
+func (c *apiClient) Upload(ctx context.Context, creds abmodel.Credentials, data []byte) (*fileRef, error) {
+	key, err := newFileKey()
+	if err != nil {
+		return nil, err
+	}
+	encrypted, err := encryptFile(key, data)
+	if err != nil {
+		return nil, fmt.Errorf("apple business upload: %w", err)
+	}
+	size := int64(len(encrypted))
+
+	req, err := c.request(ctx, http.MethodGet, c.gatewayURL+"/preUpload", nil, creds)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("MMCS-Size", strconv.FormatInt(size, 10))
+	var target preUploadResponse
+	if err := c.doJSON(req, "preUpload", &target); err != nil {
+		return nil, err
+	}
+
+	upload, err := http.NewRequestWithContext(ctx, http.MethodPost, target.UploadURL, bytes.NewReader(encrypted))
+	if err != nil {
+		return nil, fmt.Errorf("apple business upload: %w", err)
+	}
+	upload.Header.Set("Content-Type", "application/octet-stream")
+	var stored uploadResponse
+	if err := c.doJSON(upload, "upload", &stored); err != nil {
+		return nil, err
+	}
+
+	return &fileRef{
+		URL:             target.MMCSURL,
+		Owner:           target.MMCSOwner,
+		SignatureBase64: stored.SingleFile.FileChecksum,
+		Key:             formatFileKey(key),
+		Size:            size,
+	}, nil
+}
+
+// Download fetches a file stored by Apple and decrypts it while it is read.
+// https://register.apple.com/resources/messages/msp-rest-api/attachments#download-an-attachment
+func (c *apiClient) Download(ctx context.Context, creds abmodel.Credentials, ref fileRef) (*content, error) {
+	key, err := parseFileKey(ref.Key)
+	if err != nil {
+		return nil, fmt.Errorf("apple business download: %w", err)
+	}
+	signature, err := base64.StdEncoding.DecodeString(ref.SignatureBase64)
+	if err != nil {
+		return nil, fmt.Errorf("apple business download: malformed signature: %w", err)
+	}
+
+	req, err := c.request(ctx, http.MethodGet, c.gatewayURL+"/preDownload", nil, creds)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("url", ref.URL)
+	req.Header.Set("owner", ref.Owner)
+	req.Header.Set("signature", hex.EncodeToString(signature))
+	var target preDownloadResponse
+	if err := c.doJSON(req, "preDownload", &target); err != nil {
+		return nil, err
+	}
+
+	download, err := http.NewRequestWithContext(ctx, http.MethodGet, target.DownloadURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("apple business download: %w", err)
+	}
+	resp, err := c.client.Do(download)
+	if err != nil {
+		return nil, fmt.Errorf("apple business download: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		resp.Body.Close()
+		return nil, fmt.Errorf("apple business download: status %s", resp.Status)
+	}
+
+	plain, err := decryptingReader(key, resp.Body)
+	if err != nil {
+		resp.Body.Close()
+		return nil, fmt.Errorf("apple business download: %w", err)
+	}
+	return &content{
+		Body:     readCloser{Reader: plain, Closer: resp.Body},
+		MimeType: resp.Header.Get("Content-Type"),
+		Size:     resp.ContentLength,
+	}, nil
+}
+
+// request returns a request to the gateway authenticated as the MSP account on
+// behalf of the business.
+func (c *apiClient) request(ctx context.Context, method, url string, body io.Reader, creds abmodel.Credentials) (*http.Request, error) {
+	token, err := signToken(creds.CSPID, creds.Secret, c.now())
+	if err != nil {
+		return nil, fmt.Errorf("apple business: %w", err)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, url, body)
+	if err != nil {
+		return nil, fmt.Errorf("apple business: %w", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+	req.Header.Set("source-id", creds.BusinessID)
+	return req, nil
+}
+
+// doJSON sends the request and decodes the JSON reply into out.
+func (c *apiClient) doJSON(req *http.Request, operation string, out any) error {
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("apple business %s: %w", operation, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		c.logger.WarnContext(req.Context(), "request rejected", "operation", operation, "status", resp.StatusCode)
+		return &APIError{Operation: operation, StatusCode: resp.StatusCode}
+	}
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fmt.Errorf("apple business %s: decode response: %w", operation, err)
+	}
+	return nil
+}
+
+// readCloser closes the response body a decrypting reader reads from.
+type readCloser struct {
+	io.Reader
+	io.Closer
+}
@@ -0,0 +1,133 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	impb "github.com/webitel/im-providers-service/gen/go/provider/v1"
+	abmodel "github.com/webitel/im-providers-service/internal/applebusiness/model"
+	abservice "github.com/webitel/im-providers-service/internal/applebusiness/service"
+	"github.com/webitel/im-providers-service/internal/provider/gaterpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type AppleBusinessHandler struct {
+	logger *slog.Logger
+	srv    abservice.AppleBusinessManager
+	impb.UnimplementedAppleBusinessServiceServer
+}
+
+func NewAppleBusinessHandler(logger *slog.Logger, srv abservice.AppleBusinessManager) *AppleBusinessHandler {
+	return &AppleBusinessHandler{logger: logger, srv: srv}
+}
+
+func (h *AppleBusinessHandler) CreateAppleBusinessGate(ctx context.Context, req *impb.ProviderCreateAppleBusinessGateRequest) (*impb.ProviderCreateAppleBusinessGateResponse, error) {
+	domainID, err := gaterpc.DomainID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	create := abmodel.CreateAppleBusiness{
+		Name:       req.GetName(),
+		Dc:         domainID,
+		BusinessID: req.GetBusinessId(),
+		CSPID:      req.GetCspId(),
+		Secret:     req.GetSecret(),
+	}
+	if peer := gaterpc.Peer(req.GetPeer()); peer != nil {
+		create.Peer = *peer
+	}
+
+	gate, err := h.srv.CreateGate(ctx, create)
+	if err != nil {
+		return nil, toStatus(err, "create gate")
+	}
+
+	return &impb.ProviderCreateAppleBusinessGateResponse{Item: gateToProto(gate)}, nil
+}
+
+func (h *AppleBusinessHandler) GetAppleBusinessGate(ctx context.Context, req *impb.ProviderGetAppleBusinessGateRequest) (*impb.ProviderGetAppleBusinessGateResponse, error) {
+	gate, err := h.gate(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	return &impb.ProviderGetAppleBusinessGateResponse{Item: gateToProto(gate)}, nil
+}
+
+func (h *AppleBusinessHandler) UpdateAppleBusinessGate(ctx context.Context, req *impb.ProviderUpdateAppleBusinessGateRequest) (*impb.ProviderUpdateAppleBusinessGateResponse, error) {
+	if _, err := h.gate(ctx, req.GetId()); err != nil {
+		return nil, err
+	}
+
+	gate, err := h.srv.UpdateGate(ctx, abmodel.UpdateAppleBusiness{
+		ID:      req.GetId(),
+		Name:    req.Name,
+		CSPID:   req.CspId,
+		Secret:  req.Secret,
+		Enabled: req.Enabled,
+		Peer:    gaterpc.Peer(req.GetPeer()),
+	})
+	if err != nil {
+		return nil, toStatus(err, "update gate")
+	}
+
+	return &impb.ProviderUpdateAppleBusinessGateResponse{Item: gateToProto(gate)}, nil
+}
+
+func (h *AppleBusinessHandler) DeleteAppleBusinessGate(ctx context.Context, req *impb.ProviderDeleteAppleBusinessGateRequest) (*impb.ProviderDeleteAppleBusinessGateResponse, error) {
+	if _, err := h.gate(ctx, req.GetId()); err != nil {
+		return nil, err
+	}
+
+	gate, err := h.srv.DeleteGate(ctx, req.GetId())
+	if err != nil {
+		return nil, toStatus(err, "delete gate")
+	}
+
+	return &impb.ProviderDeleteAppleBusinessGateResponse{Item: gateToProto(gate)}, nil
+}
+
+// gate returns the gate when it belongs to the domain of the caller.
+func (h *AppleBusinessHandler) gate(ctx context.Context, id string) (*abmodel.AppleBusinessGate, error) {
+	gate, err := h.srv.GetGate(ctx, id)
+	if err != nil {
+		return nil, toStatus(err, "get gate")
+	}
+	if err := gaterpc.CheckDomain(ctx, gate.DomainID); err != nil {
+		return nil, err
+	}
+	return gate, nil
+}
+
+// toStatus reports a secret that is not base64 as the caller's mistake, and a missing
+// public URL as a precondition: Apple needs an MSP endpoint to deliver to.
+func toStatus(err error, internalMsg string) error {
+	switch {
+	case errors.Is(err, abservice.ErrSecretMalformed):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, abservice.ErrPublicURLNotSet):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	}
+	return gaterpc.ToStatus(err, internalMsg)
+}
+
+func gateToProto(g *abmodel.AppleBusinessGate) *impb.ProviderAppleBusinessGate {
+	if g == nil {
+		return nil
+	}
+	return &impb.ProviderAppleBusinessGate{
+		Id:         g.ID,
+		Name:       g.Name,
+		Peer:       gaterpc.ToProtoPeer(g.Peer),
+		BusinessId: g.BusinessID,
+		CspId:      g.CSPID,
+		WebhookUrl: g.WebhookURL,
+		Status:     impb.ProviderStatus(g.Status),
+		CreatedAt:  g.CreatedAt.UnixMilli(),
+		UpdatedAt:  g.UpdatedAt.UnixMilli(),
+		Enabled:    g.Enabled,
+	}
+}
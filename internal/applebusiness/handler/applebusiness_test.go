@@ -0,0 +1,186 @@
+package handler
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"log/slog"
+	"testing"
+
+	"github.com/webitel/im-providers-service/config"
+	impb "github.com/webitel/im-providers-service/gen/go/provider/v1"
+	"github.com/webitel/im-providers-service/infra/auth"
+	abmodel "github.com/webitel/im-providers-service/internal/applebusiness/model"
+	abservice "github.com/webitel/im-providers-service/internal/applebusiness/service"
+	abstore "github.com/webitel/im-providers-service/internal/applebusiness/store"
+	sharedstore "github.com/webitel/im-providers-service/internal/core/store"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var mspSecret = base64.StdEncoding.EncodeToString([]byte("msp-secret"))
+
+type merchant struct{ domainID int64 }
+
+func (m merchant) GetContactID() string { return "" }
+func (m merchant) GetDomainID() int64   { return m.domainID }
+func (m merchant) GetName() string      { return "" }
+
+func merchantContext(domainID int64) context.Context {
+	return context.WithValue(context.Background(), auth.AuthContextKey, merchant{domainID: domainID})
+}
+
+// businessAccounts stores the gates under sequential IDs; a business connects once,
+// like the unique business ID of the postgres store enforces.
+type businessAccounts map[string]*abmodel.AppleBusinessGate
+
+var _ abstore.AppleBusinessStore = businessAccounts(nil)
+
+func (a businessAccounts) Insert(_ context.Context, dc int64, g *abmodel.AppleBusinessGate) error {
+	for _, existing := range a {
+		if existing.BusinessID == g.BusinessID {
+			return sharedstore.ErrConflict
+		}
+	}
+	g.ID = fmt.Sprintf("gate-%d", len(a)+1)
+	g.DomainID = dc
+	cp := *g
+	a[g.ID] = &cp
+	return nil
+}
+
+func (a businessAccounts) Select(_ context.Context, id string) (*abmodel.AppleBusinessGate, error) {
+	g, ok := a[id]
+	if !ok {
+		return nil, sharedstore.ErrNotFound
+	}
+	cp := *g
+	return &cp, nil
+}
+
+func (a businessAccounts) Update(_ context.Context, g *abmodel.AppleBusinessGate) error {
+	cp := *g
+	a[g.ID] = &cp
+	return nil
+}
+
+func (a businessAccounts) Delete(_ context.Context, id string) error {
+	delete(a, id)
+	return nil
+}
+
+// newHandler serves the gates through the Apple Messages for Business service; an empty
+// publicURL leaves service.public_url unset.
+func newHandler(publicURL string, accounts businessAccounts) *AppleBusinessHandler {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	cfg := &config.Config{Service: config.ServiceConfig{PublicURL: publicURL, WebhookPath: "/wh"}}
+	return NewAppleBusinessHandler(logger, abservice.NewAppleBusinessService(accounts, cfg, logger))
+}
+
+func boutique() *abmodel.AppleBusinessGate {
+	return &abmodel.AppleBusinessGate{
+		ID:         "gate-1",
+		DomainID:   7,
+		Name:       "Boutique",
+		BusinessID: "biz-boutique",
+		CSPID:      "csp-1",
+		Secret:     mspSecret,
+		Enabled:    true,
+	}
+}
+
+func TestCreateAppleBusinessGate_IssuesEndpoint(t *testing.T) {
+	accounts := businessAccounts{}
+
+	resp, err := newHandler("https://im.example.com", accounts).CreateAppleBusinessGate(merchantContext(7), &impb.ProviderCreateAppleBusinessGateRequest{
+		Name:       "Boutique",
+		BusinessId: "biz-boutique",
+		CspId:      "csp-1",
+		Secret:     mspSecret,
+	})
+	if err != nil {
+		t.Fatalf("CreateAppleBusinessGate: %v", err)
+	}
+	item := resp.GetItem()
+	if item.GetWebhookUrl() != "https://im.example.com/wh/apple_business/gate-1" || item.GetBusinessId() != "biz-boutique" || item.GetCspId() != "csp-1" {
+		t.Errorf("unexpected gate: %+v", item)
+	}
+	if g := accounts["gate-1"]; g.DomainID != 7 || g.Secret != mspSecret {
+		t.Errorf("stored gate = %+v", g)
+	}
+}
+
+func TestCreateAppleBusinessGate_ErrorCodes(t *testing.T) {
+	valid := func() *impb.ProviderCreateAppleBusinessGateRequest {
+		return &impb.ProviderCreateAppleBusinessGateRequest{Name: "Outlet", BusinessId: "biz-outlet", CspId: "csp-1", Secret: mspSecret}
+	}
+	noBusiness := valid()
+	noBusiness.BusinessId = ""
+	rawSecret := valid()
+	rawSecret.Secret = "msp-secret!"
+	connected := valid()
+	connected.BusinessId = "biz-boutique"
+
+	tests := []struct {
+		name      string
+		publicURL string
+		req       *impb.ProviderCreateAppleBusinessGateRequest
+		want      codes.Code
+	}{
+		{"no business id", "https://im.example.com", noBusiness, codes.InvalidArgument},
+		{"secret not base64", "https://im.example.com", rawSecret, codes.InvalidArgument},
+		{"business already connected", "https://im.example.com", connected, codes.AlreadyExists},
+		{"no public url", "", valid(), codes.FailedPrecondition},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := newHandler(tt.publicURL, businessAccounts{"gate-1": boutique()})
+			if _, err := h.CreateAppleBusinessGate(merchantContext(7), tt.req); status.Code(err) != tt.want {
+				t.Errorf("code = %v, want %v", status.Code(err), tt.want)
+			}
+		})
+	}
+}
+
+func TestUpdateAppleBusinessGate_MovesToAnotherMSPAccount(t *testing.T) {
+	accounts := businessAccounts{"gate-1": boutique()}
+	h := newHandler("https://im.example.com", accounts)
+
+	csp, secret := "csp-2", base64.StdEncoding.EncodeToString([]byte("other-secret"))
+	resp, err := h.UpdateAppleBusinessGate(merchantContext(7), &impb.ProviderUpdateAppleBusinessGateRequest{Id: "gate-1", CspId: &csp, Secret: &secret})
+	if err != nil {
+		t.Fatalf("UpdateAppleBusinessGate: %v", err)
+	}
+	if item := resp.GetItem(); item.GetCspId() != "csp-2" || item.GetBusinessId() != "biz-boutique" {
+		t.Errorf("item = %+v", item)
+	}
+	if accounts["gate-1"].Secret != secret {
+		t.Error("secret not stored")
+	}
+
+	raw := "plain"
+	if _, err := h.UpdateAppleBusinessGate(merchantContext(7), &impb.ProviderUpdateAppleBusinessGateRequest{Id: "gate-1", Secret: &raw}); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("code = %v, want InvalidArgument", status.Code(err))
+	}
+}
+
+func TestAppleBusinessGate_OtherDomainIsNotFound(t *testing.T) {
+	accounts := businessAccounts{"gate-1": boutique()}
+	h := newHandler("https://im.example.com", accounts)
+	ctx := merchantContext(8)
+
+	if _, err := h.GetAppleBusinessGate(ctx, &impb.ProviderGetAppleBusinessGateRequest{Id: "gate-1"}); status.Code(err) != codes.NotFound {
+		t.Errorf("get: code = %v, want NotFound", status.Code(err))
+	}
+	csp := "csp-evil"
+	if _, err := h.UpdateAppleBusinessGate(ctx, &impb.ProviderUpdateAppleBusinessGateRequest{Id: "gate-1", CspId: &csp}); status.Code(err) != codes.NotFound {
+		t.Errorf("update: code = %v, want NotFound", status.Code(err))
+	}
+	if _, err := h.DeleteAppleBusinessGate(ctx, &impb.ProviderDeleteAppleBusinessGateRequest{Id: "gate-1"}); status.Code(err) != codes.NotFound {
+		t.Errorf("delete: code = %v, want NotFound", status.Code(err))
+	}
+	if g, ok := accounts["gate-1"]; !ok || g.CSPID != "csp-1" {
+		t.Errorf("gate of another domain changed: %+v", g)
+	}
+}
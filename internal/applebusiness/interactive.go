@@ -0,0 +1,166 @@
+package applebusiness
+
+import (
+	"encoding/json"
+	"errors"
+	"time"
+
+	sharedmodel "github.com/webitel/im-providers-service/internal/core/model"
+)
+
+// Bubble styles of an interactive message.
+const (
+	styleIcon    = "icon"
+	styleDefault = "default"
+)
+
+// errNothingToPick is returned for an interactive message without a callback button or time slot.
+var errNothingToPick = errors.New("apple business: interactive message has nothing to pick")
+
+// itemData is sent as the identifier of a list picker item and echoed back in the
+// reply. It carries the button code along with the callback data, so no state is kept.
+type itemData struct {
+	Code string `json:"c"`
+	Data string `json:"d"`
+}
+
+// encodeItem returns the identifier of a list picker item for a callback button.
+func encodeItem(b sharedmodel.KeyboardButton) string {
+	code := b.ID
+	if code == "" {
+		code = b.Label
+	}
+	raw, err := json.Marshal(itemData{Code: code, Data: b.Callback.Data})
+	if err != nil {
+		return b.Callback.Data
+	}
+	return string(raw)
+}
+
+// decodeItem splits the identifier of a picked item. Identifiers not sent in the
+// envelope are returned as the callback data.
+func decodeItem(identifier string) itemData {
+	var d itemData
+	if json.Unmarshal([]byte(identifier), &d) != nil || d.Code == "" {
+		return itemData{Data: identifier}
+	}
+	return d
+}
+
+// buildInteractive maps an interactive message onto a time picker, or onto a list
+// picker of its callback buttons. Messages for Business has no buttons, so a
+// keyboard becomes a list picker of a single section; URL and request buttons are dropped.
+func buildInteractive(requestID, text string, interactive *sharedmodel.Interactive) (*interactiveData, error) {
+	if interactive == nil {
+		return nil, errNothingToPick
+	}
+
+	content := interactiveContent{
+		Version:           interactiveVersion,
+		MSPVersion:        interactiveVersion,
+		RequestIdentifier: requestID,
+	}
+	title := text
+
+	switch {
+	case interactive.TimePicker != nil:
+		tp := interactive.TimePicker
+		ev := &event{Identifier: requestID, Title: tp.Title, TimezoneOffset: tp.TimezoneOffset}
+		for _, slot := range tp.Slots {
+			ev.Timeslots = append(ev.Timeslots, timeslot{
+				Identifier: slot.ID,
+				StartTime:  time.Unix(slot.Start, 0).UTC().Format(timeslotLayout),
+				Duration:   slot.Duration,
+			})
+		}
+		if len(ev.Timeslots) == 0 {
+			return nil, errNothingToPick
+		}
+		if title == "" {
+			title = tp.Title
+		}
+		content.Event = ev
+
+	case interactive.ListReply != nil:
+		picker := &listPicker{}
+		for _, section := range interactive.ListReply.Sections {
+			if items := listItems(section.Buttons); len(items) > 0 {
+				picker.Sections = append(picker.Sections, listSection{Title: section.Section, Order: len(picker.Sections), Items: items})
+			}
+		}
+		if len(picker.Sections) == 0 {
+			return nil, errNothingToPick
+		}
+		if title == "" {
+			title = interactive.ListReply.MainButtonTitle
+		}
+		content.ListPicker = picker
+
+	case interactive.Markup != nil:
+		var buttons []sharedmodel.KeyboardButton
+		for _, row := range interactive.Markup.Rows {
+			buttons = append(buttons, row.Buttons...)
+		}
+		items := listItems(buttons)
+		if len(items) == 0 {
+			return nil, errNothingToPick
+		}
+		content.ListPicker = &listPicker{Sections: []listSection{{Items: items}}}
+
+	default:
+		return nil, errNothingToPick
+	}
+
+	if title == "" {
+		title = interactive.Body
+	}
+	content.ReceivedMessage = &messageStyle{Title: title, Style: styleIcon}
+	content.ReplyMessage = &messageStyle{Title: title, Style: styleIcon}
+	return &interactiveData{BID: businessExtensionBID, Data: content}, nil
+}
+
+// listItems returns the list picker items of the callback buttons.
+func listItems(buttons []sharedmodel.KeyboardButton) []listItem {
+	var items []listItem
+	for _, b := range buttons {
+		if b.Callback == nil || b.Callback.Data == "" {
+			continue
+		}
+		items = append(items, listItem{
+			Identifier: encodeItem(b),
+			Title:      b.Label,
+			Order:      len(items),
+			Style:      styleDefault,
+		})
+	}
+	return items
+}
+
+// pick is an item or a time slot picked in reply to an interactive message.
+type pick struct {
+	code string
+	data string
+}
+
+// picks returns what the customer picked in the reply to a list picker or a time picker.
+func picks(content *interactiveContent) []pick {
+	var out []pick
+	if content.ListPicker != nil {
+		for _, section := range content.ListPicker.Sections {
+			for _, item := range section.Items {
+				d := decodeItem(item.Identifier)
+				out = append(out, pick{code: d.Code, data: d.Data})
+			}
+		}
+	}
+	if content.Event != nil {
+		for _, slot := range content.Event.Timeslots {
+			start := slot.StartTime
+			if t, err := time.Parse(timeslotLayout, slot.StartTime); err == nil {
+				start = t.UTC().Format(time.RFC3339)
+			}
+			out = append(out, pick{code: slot.Identifier, data: start})
+		}
+	}
+	return out
+}
@@ -0,0 +1,131 @@
+package applebusiness
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+)
+
+// clockSkew tolerates the clock difference with Apple.
+const clockSkew = 5 * time.Minute
+
+// jwtHeader is the header of the HS256 tokens exchanged with Apple.
+var jwtHeader = base64.RawURLEncoding.EncodeToString([]byte(`{"alg":"HS256","typ":"JWT"}`))
+
+// tokenClaims are the claims of the tokens exchanged with Apple.
+type tokenClaims struct {
+	Audience  audience `json:"aud"`
+	IssuedAt  int64    `json:"iat,omitempty"`
+	ExpiresAt int64    `json:"exp,omitempty"`
+}
+
+// audience is the aud claim, a single string or a list of strings.
+type audience []string
+
+func (a audience) MarshalJSON() ([]byte, error) {
+	if len(a) == 1 {
+		return json.Marshal(a[0])
+	}
+	return json.Marshal([]string(a))
+}
+
+func (a *audience) UnmarshalJSON(data []byte) error {
+	var single string
+	if err := json.Unmarshal(data, &single); err == nil {
+		*a = audience{single}
+		return nil
+	}
+	var list []string
+	if err := json.Unmarshal(data, &list); err != nil {
+		return err
+	}
+	*a = list
+	return nil
+}
+
+// signToken issues the token authenticating a request of the MSP account to the gateway.
+// https://register.apple.com/resources/messages/msp-rest-api/getting-started#authorization
+func signToken(cspID, secret string, now time.Time) (string, error) {
+	key, err := decodeSecret(secret)
+	if err != nil {
+		return "", err
+	}
+	claims, err := json.Marshal(tokenClaims{Audience: audience{cspID}, IssuedAt: now.Unix()})
+	if err != nil {
+		return "", err
+	}
+	signed := jwtHeader + "." + base64.RawURLEncoding.EncodeToString(claims)
+	return signed + "." + base64.RawURLEncoding.EncodeToString(hmacSHA256(key, signed)), nil
+}
+
+// verifyToken checks a token Apple sends with every message: it is signed with the
+// secret of the MSP account and issued for its CSP ID.
+func verifyToken(raw, cspID, secret string, now time.Time) error {
+	parts := strings.Split(raw, ".")
+	if len(parts) != 3 {
+		return errors.New("malformed token")
+	}
+
+	var header struct {
+		Alg string `json:"alg"`
+	}
+	if err := decodeSegment(parts[0], &header); err != nil {
+		return fmt.Errorf("malformed token header: %w", err)
+	}
+	if header.Alg != "HS256" {
+		return fmt.Errorf("unexpected signing algorithm %q", header.Alg)
+	}
+
+	key, err := decodeSecret(secret)
+	if err != nil {
+		return err
+	}
+	sig, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return fmt.Errorf("malformed token signature: %w", err)
+	}
+	if !hmac.Equal(sig, hmacSHA256(key, parts[0]+"."+parts[1])) {
+		return errors.New("token signature mismatch")
+	}
+
+	var claims tokenClaims
+	if err := decodeSegment(parts[1], &claims); err != nil {
+		return fmt.Errorf("malformed token claims: %w", err)
+	}
+	if !slices.Contains(claims.Audience, cspID) {
+		return errors.New("token issued for another msp")
+	}
+	if claims.ExpiresAt != 0 && now.After(time.Unix(claims.ExpiresAt, 0).Add(clockSkew)) {
+		return errors.New("token expired")
+	}
+	return nil
+}
+
+// decodeSecret returns the key of the base64-encoded MSP secret.
+func decodeSecret(secret string) ([]byte, error) {
+	key, err := base64.StdEncoding.DecodeString(secret)
+	if err != nil {
+		return nil, fmt.Errorf("malformed msp secret: %w", err)
+	}
+	return key, nil
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
+
+func decodeSegment(segment string, out any) error {
+	raw, err := base64.RawURLEncoding.DecodeString(segment)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(raw, out)
+}
@@ -0,0 +1,81 @@
+package applebusiness
+
+import (
+	"context"
+	"strings"
+
+	abmodel "github.com/webitel/im-providers-service/internal/applebusiness/model"
+	sharedmodel "github.com/webitel/im-providers-service/internal/core/model"
+	"github.com/webitel/im-providers-service/internal/provider/contactsync"
+)
+
+// handleAttachment downloads and decrypts an attachment, copies it to the storage
+// and forwards it with body as the caption. Images become images, anything else
+// becomes a document. It reports whether the attachment was forwarded, so a caption
+// is not lost when it was not.
+func (p *appleProvider) handleAttachment(ctx context.Context, gate *abmodel.AppleBusinessGate, peers contactsync.Peers, att *attachment, body string) bool {
+	c, err := p.api.Download(ctx, gate.Credentials(), att.ref())
+	if err != nil {
+		p.logger.Error("failed to download attachment", "name", att.Name, "err", err)
+		return false
+	}
+	defer c.Body.Close()
+
+	name := att.Name
+	if name == "" {
+		name = "attachment"
+	}
+	mimeType := att.MimeType
+	if mimeType == "" {
+		mimeType = "application/octet-stream"
+	}
+
+	uploaded, err := p.media.UploadFile(ctx, sharedmodel.UploadRequest{
+		DomainID: gate.DomainID,
+		Name:     name,
+		MimeType: mimeType,
+	}, c.Body)
+	if err != nil {
+		p.logger.Error("failed to sync attachment", "name", name, "err", err)
+		return false
+	}
+
+	if strings.HasPrefix(mimeType, "image/") {
+		if _, err := p.messenger.SendImage(ctx, &sharedmodel.SendImageRequest{
+			DomainID: gate.DomainID,
+			From:     peers.From,
+			To:       peers.To,
+			Image: sharedmodel.ImageRequest{
+				Body: body,
+				Images: []*sharedmodel.Image{{
+					ID:       uploaded.ID,
+					FileName: name,
+					MimeType: mimeType,
+				}},
+			},
+		}); err != nil {
+			p.logger.Error("failed to send image", "fileName", name, "err", err)
+			return false
+		}
+		return true
+	}
+
+	if _, err := p.messenger.SendDocument(ctx, &sharedmodel.SendDocumentRequest{
+		DomainID: gate.DomainID,
+		From:     peers.From,
+		To:       peers.To,
+		Document: sharedmodel.DocumentRequest{
+			Body: body,
+			Documents: []*sharedmodel.Document{{
+				ID:       uploaded.ID,
+				FileName: name,
+				MimeType: mimeType,
+				Size:     max(att.Size, 1),
+			}},
+		},
+	}); err != nil {
+		p.logger.Error("failed to send document", "fileName", name, "err", err)
+		return false
+	}
+	return true
+}
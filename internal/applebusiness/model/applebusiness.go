@@ -0,0 +1,99 @@
+package model
+
+import (
+	"time"
+
+	sharedmodel "github.com/webitel/im-providers-service/internal/core/model"
+)
+
+// AppleBusinessGate represents a business registered for Apple Messages for Business,
+// served through the messaging service provider (MSP) account of the platform.
+type AppleBusinessGate struct {
+	ID       string           `json:"id" db:"id"`
+	DomainID int64            `json:"domain_id" db:"domain_id"`
+	Peer     sharedmodel.Peer `json:"peer" db:"peer"`
+	Name     string           `json:"name" db:"name"`
+	// BusinessID is the Messages for Business account ID, the destination of inbound messages.
+	BusinessID string `json:"business_id" db:"business_id"`
+	// CSPID and Secret are the MSP account ID and its base64-encoded secret, issued by
+	// Apple Business Register; the secret signs the tokens in both directions.
+	CSPID  string `json:"csp_id" db:"csp_id"`
+	Secret string `json:"-" db:"secret"`
+	// WebhookURL is the MSP endpoint to register with Apple.
+	WebhookURL string                 `json:"webhook_url" db:"webhook_url"`
+	Status     sharedmodel.GateStatus `json:"status" db:"status"`
+	CreatedAt  time.Time              `json:"created_at" db:"created_at"`
+	UpdatedAt  time.Time              `json:"updated_at" db:"updated_at"`
+	Enabled    bool                   `json:"enabled" db:"enabled"`
+}
+
+// Credentials returns the credentials the gate talks to the MSP gateway with.
+func (g *AppleBusinessGate) Credentials() Credentials {
+	return Credentials{BusinessID: g.BusinessID, CSPID: g.CSPID, Secret: g.Secret}
+}
+
+// Credentials identify a business and authenticate its MSP account.
+type Credentials struct {
+	BusinessID string
+	CSPID      string
+	Secret     string
+}
+
+type CreateAppleBusiness struct {
+	Name       string
+	Dc         int64
+	BusinessID string
+	CSPID      string
+	Secret     string
+	Peer       sharedmodel.Peer
+}
+
+type UpdateAppleBusiness struct {
+	ID      string
+	Name    *string
+	CSPID   *string
+	Secret  *string
+	Enabled *bool
+	Peer    *sharedmodel.Peer
+}
+
+func (r UpdateAppleBusiness) ApplyTo(gate *AppleBusinessGate) {
+	if r.Name != nil {
+		gate.Name = *r.Name
+	}
+	if r.Enabled != nil {
+		gate.Enabled = *r.Enabled
+	}
+	if r.CSPID != nil {
+		gate.CSPID = *r.CSPID
+	}
+	if r.Secret != nil {
+		gate.Secret = *r.Secret
+	}
+	if r.Peer != nil {
+		gate.Peer = *r.Peer
+	}
+}
+
+func (r CreateAppleBusiness) Validate() error {
+	var missing []string
+	if r.Name == "" {
+		missing = append(missing, "name")
+	}
+	if r.BusinessID == "" {
+		missing = append(missing, "business_id")
+	}
+	if r.CSPID == "" {
+		missing = append(missing, "csp_id")
+	}
+	if r.Secret == "" {
+		missing = append(missing, "secret")
+	}
+	if r.Dc <= 0 {
+		missing = append(missing, "domain_id")
+	}
+	if len(missing) > 0 {
+		return &sharedmodel.ValidationError{Fields: missing}
+	}
+	return nil
+}
@@ -0,0 +1,43 @@
+package applebusiness
+
+import (
+	impb "github.com/webitel/im-providers-service/gen/go/provider/v1"
+	grpcsrv "github.com/webitel/im-providers-service/infra/srv/grpc"
+	abhandler "github.com/webitel/im-providers-service/internal/applebusiness/handler"
+	abservice "github.com/webitel/im-providers-service/internal/applebusiness/service"
+	abstore "github.com/webitel/im-providers-service/internal/applebusiness/store"
+	abpostgres "github.com/webitel/im-providers-service/internal/applebusiness/store/postgres"
+	"github.com/webitel/im-providers-service/internal/provider"
+	"go.uber.org/fx"
+)
+
+// Module provides the Apple Messages for Business provider adapter and the gRPC gate service.
+var Module = fx.Module("apple_business",
+	fx.Provide(
+		// MSP gateway client
+		newAPIClient,
+
+		// Provider adapter
+		fx.Annotate(
+			New,
+			fx.As(new(provider.Provider)),
+			fx.ResultTags(`group:"providers"`),
+		),
+
+		// Store implementations
+		fx.Annotate(abpostgres.NewAppleBusinessStore, fx.As(new(abstore.AppleBusinessStore))),
+
+		// Services
+		fx.Annotate(abservice.NewAppleBusinessService, fx.As(new(abservice.AppleBusinessManager))),
+
+		// gRPC handlers
+		abhandler.NewAppleBusinessHandler,
+	),
+	fx.Invoke(RegisterAppleBusinessService),
+)
+
+// RegisterAppleBusinessService connects the Apple Messages for Business gate gRPC handler
+// to the gRPC server.
+func RegisterAppleBusinessService(server *grpcsrv.Server, apple *abhandler.AppleBusinessHandler) {
+	impb.RegisterAppleBusinessServiceServer(server.Server, apple)
+}
@@ -0,0 +1,189 @@
+package applebusiness
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+
+	"github.com/google/uuid"
+	contactv1 "github.com/webitel/im-providers-service/gen/go/contact/v1"
+	abmodel "github.com/webitel/im-providers-service/internal/applebusiness/model"
+	sharedmodel "github.com/webitel/im-providers-service/internal/core/model"
+	"github.com/webitel/im-providers-service/internal/provider/contactsync"
+)
+
+// maxAttachmentSize is the largest attachment Messages for Business accepts.
+const maxAttachmentSize = 100 << 20
+
+// sharedFile is a file of an outbound message.
+type sharedFile struct {
+	url      string
+	name     string
+	mimeType string
+}
+
+func (p *appleProvider) SendText(ctx context.Context, req *sharedmodel.Message) (*sharedmodel.MessageResponse, error) {
+	return p.send(ctx, req, func(*abmodel.AppleBusinessGate, *message) error { return nil })
+}
+
+func (p *appleProvider) SendImage(ctx context.Context, req *sharedmodel.Message) (*sharedmodel.MessageResponse, error) {
+	files := make([]sharedFile, 0, len(req.Images))
+	for _, img := range req.Images {
+		files = append(files, sharedFile{url: img.URL, name: img.FileName, mimeType: img.MimeType})
+	}
+	return p.sendFiles(ctx, req, files)
+}
+
+func (p *appleProvider) SendDocument(ctx context.Context, req *sharedmodel.Message) (*sharedmodel.MessageResponse, error) {
+	files := make([]sharedFile, 0, len(req.Documents))
+	for _, doc := range req.Documents {
+		files = append(files, sharedFile{url: doc.URL, name: doc.FileName, mimeType: doc.MimeType})
+	}
+	return p.sendFiles(ctx, req, files)
+}
+
+// SendInteractive sends a list picker or a time picker. The message ID is the
+// request identifier echoed back in the reply, see processInteractive.
+func (p *appleProvider) SendInteractive(ctx context.Context, req *sharedmodel.Message) (*sharedmodel.MessageResponse, error) {
+	return p.send(ctx, req, func(_ *abmodel.AppleBusinessGate, m *message) error {
+		data, err := buildInteractive(m.ID, req.Text, req.Interactive)
+		if err != nil {
+			return err
+		}
+		m.Type, m.Body, m.InteractiveData = MessageInteractive, "", data
+		return nil
+	})
+}
+
+// sendFiles encrypts and uploads the files one by one and sends them in a single
+// message after the text.
+func (p *appleProvider) sendFiles(ctx context.Context, req *sharedmodel.Message, files []sharedFile) (*sharedmodel.MessageResponse, error) {
+	if len(files) == 0 {
+		return nil, fmt.Errorf("apple business: message has no files")
+	}
+	return p.send(ctx, req, func(g *abmodel.AppleBusinessGate, m *message) error {
+		for _, f := range files {
+			att, err := p.uploadFile(ctx, g, f)
+			if err != nil {
+				return err
+			}
+			m.Attachments = append(m.Attachments, *att)
+		}
+		m.Body += strings.Repeat(attachmentPlaceholder, len(files))
+		return nil
+	})
+}
+
+// send builds a text message to the customer, lets build complete it and sends it.
+func (p *appleProvider) send(ctx context.Context, req *sharedmodel.Message, build func(*abmodel.AppleBusinessGate, *message) error) (*sharedmodel.MessageResponse, error) {
+	g, err := p.repo.Select(ctx, req.GateID)
+	if err != nil {
+		return nil, err
+	}
+	customerID, err := p.resolveReceiver(ctx, g, req.To.Sub)
+	if err != nil {
+		return nil, err
+	}
+
+	m := &message{
+		V:             messageVersion,
+		Type:          MessageText,
+		ID:            uuid.NewString(),
+		SourceID:      g.BusinessID,
+		DestinationID: customerID,
+		Body:          req.Text,
+	}
+	if err := build(g, m); err != nil {
+		return nil, err
+	}
+	if err := p.api.SendMessage(ctx, g.Credentials(), m); err != nil {
+		return nil, err
+	}
+	return &sharedmodel.MessageResponse{ID: m.ID}, nil
+}
+
+// uploadFile copies a file from the media URL to Apple.
+func (p *appleProvider) uploadFile(ctx context.Context, g *abmodel.AppleBusinessGate, f sharedFile) (*attachment, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, f.url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("apple business: download %s: %w", f.url, err)
+	}
+	resp, err := p.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("apple business: download %s: %w", f.url, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("apple business: download %s: status %s", f.url, resp.Status)
+	}
+	data, err := io.ReadAll(io.LimitReader(resp.Body, maxAttachmentSize+1))
+	if err != nil {
+		return nil, fmt.Errorf("apple business: download %s: %w", f.url, err)
+	}
+	if len(data) > maxAttachmentSize {
+		return nil, fmt.Errorf("apple business: download %s: file exceeds %d bytes", f.url, maxAttachmentSize)
+	}
+
+	ref, err := p.api.Upload(ctx, g.Credentials(), data)
+	if err != nil {
+		return nil, err
+	}
+
+	mimeType := f.mimeType
+	if mimeType == "" {
+		mimeType = resp.Header.Get("Content-Type")
+	}
+	return &attachment{
+		Name:            fileName(f),
+		MimeType:        mimeType,
+		Size:            int64(len(data)),
+		URL:             ref.URL,
+		Owner:           ref.Owner,
+		SignatureBase64: ref.SignatureBase64,
+		Key:             ref.Key,
+	}, nil
+}
+
+// resolveReceiver returns the opaque customer ID for the given sub.
+// A customer ID is returned as-is; an internal contact UUID is resolved via the contact service.
+func (p *appleProvider) resolveReceiver(ctx context.Context, gate *abmodel.AppleBusinessGate, contactID string) (string, error) {
+	if _, err := uuid.Parse(contactID); err != nil {
+		return contactID, nil
+	}
+	if sub, ok := p.receiverCache.Get(contactID); ok {
+		return sub, nil
+	}
+
+	authCtx := contactsync.WithGatewayIdentity(ctx, gate.DomainID, gate.Peer)
+	resp, err := p.contactClient.SearchContact(authCtx, &contactv1.SearchContactRequest{
+		Ids: []string{contactID},
+	})
+	if err != nil {
+		return "", fmt.Errorf("resolve apple business customer for %s: %w", contactID, err)
+	}
+	items := resp.GetContacts()
+	if len(items) == 0 || items[0].GetSubject() == "" {
+		return "", fmt.Errorf("resolve apple business customer for %s: contact not found or has no subject", contactID)
+	}
+	sub := items[0].GetSubject()
+	p.receiverCache.Add(contactID, sub)
+	return sub, nil
+}
+
+// fileName returns the name of the file, falling back to the last segment of its URL.
+func fileName(f sharedFile) string {
+	if f.name != "" {
+		return f.name
+	}
+	if u, err := url.Parse(f.url); err == nil {
+		if base := path.Base(u.Path); base != "." && base != "/" {
+			return base
+		}
+	}
+	return "file"
+}
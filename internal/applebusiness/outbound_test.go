@@ -0,0 +1,165 @@
+package applebusiness
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	sharedmodel "github.com/webitel/im-providers-service/internal/core/model"
+)
+
+func outboundMessage(text string) *sharedmodel.Message {
+	return &sharedmodel.Message{GateID: testGateID, To: sharedmodel.Peer{Sub: testCustomerID}, Text: text}
+}
+
+func keyboardButton(id, label, data string) sharedmodel.KeyboardButton {
+	return sharedmodel.KeyboardButton{ID: id, Label: label, Callback: &sharedmodel.KeyboardButtonCallback{Data: data}}
+}
+
+func TestSendText(t *testing.T) {
+	p := newMSPAccount(t)
+
+	resp, err := p.SendText(context.Background(), outboundMessage("Your order has shipped."))
+	if err != nil {
+		t.Fatalf("SendText() error = %v", err)
+	}
+
+	messages, headers := p.stub.sent()
+	if len(messages) != 1 {
+		t.Fatalf("messages sent = %d, want 1", len(messages))
+	}
+	m, h := messages[0], headers[0]
+	if m.Type != MessageText || m.Body != "Your order has shipped." || m.SourceID != testBusinessID || m.DestinationID != testCustomerID || m.V != messageVersion {
+		t.Errorf("message = %+v", m)
+	}
+	if resp.ID != m.ID || h.Get("id") != m.ID || h.Get("destination-id") != testCustomerID || h.Get("Content-Encoding") != "gzip" {
+		t.Errorf("response id = %q, headers = %v", resp.ID, h)
+	}
+}
+
+func TestSendText_Unauthorized(t *testing.T) {
+	p := newMSPAccount(t)
+	p.businesses[testGateID].CSPID = "another-msp"
+
+	if _, err := p.SendText(context.Background(), outboundMessage("Hello")); !errors.Is(err, ErrUnauthorized) {
+		t.Errorf("SendText() error = %v, want ErrUnauthorized", err)
+	}
+}
+
+func TestSendImage(t *testing.T) {
+	p := newMSPAccount(t)
+
+	req := outboundMessage("Your receipt")
+	req.Images = []*sharedmodel.Image{{URL: p.stub.url + "/media/receipt.png", MimeType: "image/png"}}
+	if _, err := p.SendImage(context.Background(), req); err != nil {
+		t.Fatalf("SendImage() error = %v", err)
+	}
+
+	messages, _ := p.stub.sent()
+	if len(messages) != 1 || len(messages[0].Attachments) != 1 {
+		t.Fatalf("messages sent = %+v", messages)
+	}
+	m := messages[0]
+	if m.Body != "Your receipt"+attachmentPlaceholder {
+		t.Errorf("body = %q, want the text and a placeholder", m.Body)
+	}
+
+	const plain = "content of /media/receipt.png"
+	att := m.Attachments[0]
+	if att.Name != "receipt.png" || att.MimeType != "image/png" || att.Size != int64(len(plain)) || att.URL != "mmcs://uploaded" || att.Owner != testOwner || att.SignatureBase64 != testChecksum {
+		t.Errorf("attachment = %+v", att)
+	}
+
+	// The uploaded file is encrypted with the key sent in the attachment.
+	uploads := p.stub.uploaded()
+	if len(uploads) != 1 || string(uploads[0]) == plain {
+		t.Fatalf("uploads = %q, want one encrypted file", uploads)
+	}
+	key, err := parseFileKey(att.Key)
+	if err != nil {
+		t.Fatalf("attachment key: %v", err)
+	}
+	decrypted, _ := encryptFile(key, uploads[0])
+	if string(decrypted) != plain {
+		t.Errorf("decrypted upload = %q, want %q", decrypted, plain)
+	}
+}
+
+func TestSendInteractive_ListPicker(t *testing.T) {
+	p := newMSPAccount(t)
+
+	req := outboundMessage("Pick a delivery option")
+	req.Interactive = &sharedmodel.Interactive{Markup: &sharedmodel.KeyboardMarkup{Rows: []sharedmodel.KeyboardRow{
+		{Buttons: []sharedmodel.KeyboardButton{keyboardButton("std", "Standard", "delivery:std"), keyboardButton("exp", "Express", "delivery:exp")}},
+		{Buttons: []sharedmodel.KeyboardButton{{Label: "Track", URL: &sharedmodel.KeyboardButtonURL{URL: "https://example.com"}}}},
+	}}}
+	resp, err := p.SendInteractive(context.Background(), req)
+	if err != nil {
+		t.Fatalf("SendInteractive() error = %v", err)
+	}
+
+	messages, _ := p.stub.sent()
+	if len(messages) != 1 || messages[0].InteractiveData == nil {
+		t.Fatalf("messages sent = %+v", messages)
+	}
+	m := messages[0]
+	data := m.InteractiveData.Data
+	if m.Type != MessageInteractive || m.Body != "" || m.InteractiveData.BID != businessExtensionBID || data.RequestIdentifier != resp.ID {
+		t.Errorf("message = %+v", m)
+	}
+	if data.ReceivedMessage == nil || data.ReceivedMessage.Title != "Pick a delivery option" {
+		t.Errorf("received message = %+v", data.ReceivedMessage)
+	}
+	if data.ListPicker == nil || len(data.ListPicker.Sections) != 1 || len(data.ListPicker.Sections[0].Items) != 2 {
+		t.Fatalf("list picker = %+v, want the callback buttons only", data.ListPicker)
+	}
+	if got := picks(&data); got[1] != (pick{code: "exp", data: "delivery:exp"}) {
+		t.Errorf("picks = %+v", got)
+	}
+}
+
+func TestSendInteractive_TimePicker(t *testing.T) {
+	p := newMSPAccount(t)
+
+	start := time.Date(2026, 10, 20, 7, 30, 0, 0, time.UTC)
+	req := outboundMessage("")
+	req.Interactive = &sharedmodel.Interactive{TimePicker: &sharedmodel.InteractiveTimePicker{
+		Title:          "Book a call",
+		TimezoneOffset: 120,
+		Slots:          []sharedmodel.TimeSlot{{ID: "slot-1", Start: start.Unix(), Duration: 1800}},
+	}}
+	if _, err := p.SendInteractive(context.Background(), req); err != nil {
+		t.Fatalf("SendInteractive() error = %v", err)
+	}
+
+	messages, _ := p.stub.sent()
+	if len(messages) != 1 || messages[0].InteractiveData == nil {
+		t.Fatalf("messages sent = %+v", messages)
+	}
+	data := messages[0].InteractiveData.Data
+	if data.Event == nil || data.Event.TimezoneOffset != 120 || len(data.Event.Timeslots) != 1 {
+		t.Fatalf("event = %+v", data.Event)
+	}
+	if slot := data.Event.Timeslots[0]; slot != (timeslot{Identifier: "slot-1", StartTime: "2026-10-20T07:30+0000", Duration: 1800}) {
+		t.Errorf("timeslot = %+v", slot)
+	}
+	if data.ReceivedMessage == nil || data.ReceivedMessage.Title != "Book a call" {
+		t.Errorf("received message = %+v", data.ReceivedMessage)
+	}
+}
+
+func TestSendInteractive_NothingToPick(t *testing.T) {
+	p := newMSPAccount(t)
+
+	req := outboundMessage("Visit us")
+	req.Interactive = &sharedmodel.Interactive{Markup: &sharedmodel.KeyboardMarkup{Rows: []sharedmodel.KeyboardRow{
+		{Buttons: []sharedmodel.KeyboardButton{{Label: "Site", URL: &sharedmodel.KeyboardButtonURL{URL: "https://example.com"}}}},
+	}}}
+	if _, err := p.SendInteractive(context.Background(), req); !errors.Is(err, errNothingToPick) {
+		t.Errorf("SendInteractive() error = %v, want errNothingToPick", err)
+	}
+	if messages, _ := p.stub.sent(); len(messages) != 0 {
+		t.Errorf("nothing must be sent: %+v", messages)
+	}
+}
@@ -0,0 +1,128 @@
+// Package applebusiness implements the Apple Messages for Business provider: the
+// conversations customers start with a business from Messages are bridged through
+// the webhook and the REST API of the messaging service provider (MSP) gateway.
+// https://register.apple.com/resources/messages/msp-rest-api/
+package applebusiness
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	lru "github.com/hashicorp/golang-lru/v2"
+	"github.com/hashicorp/golang-lru/v2/expirable"
+	imcontact "github.com/webitel/im-providers-service/infra/client/grpc/im-contact"
+	imgateway "github.com/webitel/im-providers-service/infra/client/grpc/im-gateway"
+	abmodel "github.com/webitel/im-providers-service/internal/applebusiness/model"
+	abstore "github.com/webitel/im-providers-service/internal/applebusiness/store"
+	sharedsvc "github.com/webitel/im-providers-service/internal/core/service"
+	sharedstore "github.com/webitel/im-providers-service/internal/core/store"
+	"github.com/webitel/im-providers-service/internal/provider"
+)
+
+const (
+	// seenTTL covers the redeliveries of a message Apple did not get an answer for in time.
+	seenTTL     = time.Hour
+	seenEntries = 10000
+)
+
+type appleProvider struct {
+	api botAPI
+	// httpClient downloads outbound media before it is encrypted and uploaded to Apple.
+	httpClient    *http.Client
+	logger        *slog.Logger
+	messenger     sharedsvc.Messenger
+	gateCache     sharedstore.GateCache
+	userCache     sharedstore.ExternalUserCache
+	repo          abstore.AppleBusinessStore
+	gatewayer     *imgateway.Client
+	media         sharedsvc.MediaManager
+	contactClient *imcontact.Client
+	// receiverCache maps internal contact UUID → opaque customer ID to avoid an
+	// im-contact round-trip on every outbound message.
+	receiverCache *lru.Cache[string, string]
+	// seen holds the messages already routed.
+	seenMu sync.Mutex
+	seen   *expirable.LRU[string, struct{}]
+	now    func() time.Time
+}
+
+func New(
+	m sharedsvc.Messenger,
+	l *slog.Logger,
+	gc sharedstore.GateCache,
+	uc sharedstore.ExternalUserCache,
+	repo abstore.AppleBusinessStore,
+	gatewayer *imgateway.Client,
+	media sharedsvc.MediaManager,
+	contactClient *imcontact.Client,
+	api *apiClient,
+) provider.Provider {
+	receiverCache, _ := lru.New[string, string](1000)
+	return &appleProvider{
+		api:           api,
+		httpClient:    &http.Client{Timeout: 60 * time.Second},
+		logger:        l.With("provider", "apple_business"),
+		messenger:     m,
+		gateCache:     gc,
+		userCache:     uc,
+		repo:          repo,
+		gatewayer:     gatewayer,
+		media:         media,
+		contactClient: contactClient,
+		receiverCache: receiverCache,
+		seen:          expirable.NewLRU[string, struct{}](seenEntries, nil, seenTTL),
+		now:           time.Now,
+	}
+}
+
+var (
+	_ provider.SignatureValidator = (*appleProvider)(nil)
+	_ provider.SignatureHeader    = (*appleProvider)(nil)
+	_ provider.InteractiveSender  = (*appleProvider)(nil)
+)
+
+func (p *appleProvider) Type() string { return "apple_business" }
+
+// resolveGate returns the gate a webhook was delivered to. The webhook URI segment is
+// the gate ID, registered as the MSP endpoint of the business. Disabled gates are
+// short-circuited from the cache to avoid a DB round-trip on every request.
+func (p *appleProvider) resolveGate(ctx context.Context) (*abmodel.AppleBusinessGate, error) {
+	gateID, _ := ctx.Value(provider.WebhookURIKey).(string)
+	if _, err := uuid.Parse(gateID); err != nil {
+		return nil, fmt.Errorf("invalid webhook uri %q: %w", gateID, sharedstore.ErrNotFound)
+	}
+
+	if cached, ok := p.gateCache.Get(gateID); ok && !cached.Enabled {
+		return &abmodel.AppleBusinessGate{ID: gateID, Enabled: false}, nil
+	}
+
+	g, err := p.repo.Select(ctx, gateID)
+	if err != nil {
+		return nil, err
+	}
+	p.gateCache.Set(gateID, sharedstore.GateState{
+		GateID:  g.ID,
+		Enabled: g.Enabled,
+		Issuer:  g.Peer.Iss,
+		Sub:     g.Peer.Sub,
+		Domain:  g.DomainID,
+	})
+	return g, nil
+}
+
+// firstSeen reports whether the key is seen for the first time and remembers it.
+func (p *appleProvider) firstSeen(key string) bool {
+	p.seenMu.Lock()
+	defer p.seenMu.Unlock()
+
+	if p.seen.Contains(key) {
+		return false
+	}
+	p.seen.Add(key, struct{}{})
+	return true
+}
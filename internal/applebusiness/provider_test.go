@@ -0,0 +1,255 @@
+package applebusiness
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	abmodel "github.com/webitel/im-providers-service/internal/applebusiness/model"
+	abstore "github.com/webitel/im-providers-service/internal/applebusiness/store"
+	sharedmodel "github.com/webitel/im-providers-service/internal/core/model"
+	sharedstore "github.com/webitel/im-providers-service/internal/core/store"
+	"github.com/webitel/im-providers-service/internal/provider"
+	"github.com/webitel/im-providers-service/internal/provider/providertest"
+)
+
+const (
+	testGateID     = "0190a8a4-5f0e-7c2a-9d3b-0a1b2c3d4e5f"
+	testBusinessID = "5b6c7d8e-1f2a-4b3c-8d4e-5f6a7b8c9d0e"
+	testCSPID      = "a1b2c3d4-e5f6-4a7b-8c9d-0e1f2a3b4c5d"
+	testCustomerID = "urn:mbid:AQAAY2hYgRmZ0q0IbdsyyPZu"
+	testOwner      = "owner-1"
+	testChecksum   = "AbCdEfGhIjKlMnOpQrStUvWxYz0="
+)
+
+// testSecret is the base64-encoded MSP secret.
+var testSecret = base64.StdEncoding.EncodeToString([]byte("msp-secret-of-the-test-account"))
+
+var noopLogger = slog.New(slog.NewTextHandler(io.Discard, nil))
+
+// -- MSP gateway stub --
+
+// storedFile is a file held by the gateway stub, encrypted with key.
+type storedFile struct {
+	key       []byte
+	encrypted []byte
+}
+
+// gatewayStub serves the MSP gateway (/message, /preUpload, /preDownload), the
+// upload and download endpoints of the file storage (/upload, /download/...) and
+// the media of outbound messages (/media/...).
+type gatewayStub struct {
+	mu       sync.Mutex
+	messages []*message
+	headers  []http.Header
+	uploads  [][]byte
+	// files are keyed by the MMCS URL.
+	files map[string]storedFile
+	url   string
+}
+
+func newGatewayStub(t *testing.T) (*gatewayStub, *apiClient) {
+	t.Helper()
+
+	stub := &gatewayStub{files: map[string]storedFile{}}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasPrefix(r.URL.Path, "/media/") {
+			w.Header().Set("Content-Type", "image/png")
+			_, _ = w.Write([]byte("content of " + r.URL.Path))
+			return
+		}
+		if strings.HasPrefix(r.URL.Path, "/download/") {
+			stub.mu.Lock()
+			f, ok := stub.files["mmcs://"+strings.TrimPrefix(r.URL.Path, "/download/")]
+			stub.mu.Unlock()
+			if !ok {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			_, _ = w.Write(f.encrypted)
+			return
+		}
+		if r.URL.Path == "/upload" {
+			raw, _ := io.ReadAll(r.Body)
+			stub.mu.Lock()
+			stub.uploads = append(stub.uploads, raw)
+			stub.mu.Unlock()
+			_, _ = w.Write([]byte(`{"singleFile":{"fileChecksum":"` + testChecksum + `"}}`))
+			return
+		}
+
+		token, _ := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+		if err := verifyToken(token, testCSPID, testSecret, time.Now()); err != nil || r.Header.Get("source-id") != testBusinessID {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		switch r.URL.Path {
+		case "/message":
+			zr, err := gzip.NewReader(r.Body)
+			if err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			var m message
+			if err := json.NewDecoder(zr).Decode(&m); err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			stub.mu.Lock()
+			stub.messages = append(stub.messages, &m)
+			stub.headers = append(stub.headers, r.Header.Clone())
+			stub.mu.Unlock()
+		case "/preUpload":
+			_, _ = w.Write([]byte(`{"upload-url":"` + stub.url + `/upload","mmcs-url":"mmcs://uploaded","mmcs-owner":"` + testOwner + `"}`))
+		case "/preDownload":
+			stub.mu.Lock()
+			_, ok := stub.files[r.Header.Get("url")]
+			stub.mu.Unlock()
+			if !ok || r.Header.Get("owner") != testOwner || r.Header.Get("signature") == "" {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			name := strings.TrimPrefix(r.Header.Get("url"), "mmcs://")
+			_, _ = w.Write([]byte(`{"download-url":"` + stub.url + `/download/` + name + `"}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	stub.url = server.URL
+	api := newAPIClient(noopLogger)
+	api.gatewayURL = server.URL
+	return stub, api
+}
+
+// store encrypts the content with a key of its own and returns the reference to it.
+func (s *gatewayStub) store(t *testing.T, name string, plain []byte) fileRef {
+	t.Helper()
+
+	key, err := newFileKey()
+	if err != nil {
+		t.Fatalf("file key: %v", err)
+	}
+	encrypted, err := encryptFile(key, plain)
+	if err != nil {
+		t.Fatalf("encrypt: %v", err)
+	}
+	s.mu.Lock()
+	s.files["mmcs://"+name] = storedFile{key: key, encrypted: encrypted}
+	s.mu.Unlock()
+
+	return fileRef{
+		URL:             "mmcs://" + name,
+		Owner:           testOwner,
+		SignatureBase64: testChecksum,
+		Key:             formatFileKey(key),
+		Size:            int64(len(encrypted)),
+	}
+}
+
+func (s *gatewayStub) sent() ([]*message, []http.Header) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]*message(nil), s.messages...), append([]http.Header(nil), s.headers...)
+}
+
+func (s *gatewayStub) uploaded() [][]byte {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([][]byte(nil), s.uploads...)
+}
+
+// -- fakes --
+
+// registeredBusinesses serves the gates to the MSP endpoint and to outbound messages.
+// The provider only reads gates; writing them is the job of the gate service.
+type registeredBusinesses map[string]*abmodel.AppleBusinessGate
+
+var _ abstore.AppleBusinessStore = registeredBusinesses(nil)
+
+func (r registeredBusinesses) Select(_ context.Context, id string) (*abmodel.AppleBusinessGate, error) {
+	g, ok := r[id]
+	if !ok {
+		return nil, sharedstore.ErrNotFound
+	}
+	cp := *g
+	return &cp, nil
+}
+
+func (registeredBusinesses) Insert(context.Context, int64, *abmodel.AppleBusinessGate) error {
+	panic("apple business provider must not insert gates")
+}
+
+func (registeredBusinesses) Update(context.Context, *abmodel.AppleBusinessGate) error {
+	panic("apple business provider must not update gates")
+}
+
+func (registeredBusinesses) Delete(context.Context, string) error {
+	panic("apple business provider must not delete gates")
+}
+
+// storeBusiness is the business of the gate, served through the MSP account of the tests.
+func storeBusiness() *abmodel.AppleBusinessGate {
+	return &abmodel.AppleBusinessGate{
+		ID:         testGateID,
+		DomainID:   1,
+		Name:       "Support",
+		Peer:       sharedmodel.Peer{Sub: "bot-1", Iss: "apple_business"},
+		BusinessID: testBusinessID,
+		CSPID:      testCSPID,
+		Secret:     testSecret,
+		Enabled:    true,
+	}
+}
+
+// mspAccount is the provider of the business talking to the MSP gateway stub.
+type mspAccount struct {
+	*appleProvider
+	stub       *gatewayStub
+	messenger  *providertest.Messenger
+	media      *providertest.Media
+	businesses registeredBusinesses
+}
+
+func newMSPAccount(t *testing.T) *mspAccount {
+	t.Helper()
+
+	stub, api := newGatewayStub(t)
+	messenger := &providertest.Messenger{}
+	media := &providertest.Media{}
+	businesses := registeredBusinesses{testGateID: storeBusiness()}
+
+	p := New(messenger, noopLogger, providertest.NewGateCache(), providertest.KnownUsers{}, businesses, nil, media, nil, api).(*appleProvider)
+	return &mspAccount{appleProvider: p, stub: stub, messenger: messenger, media: media, businesses: businesses}
+}
+
+func webhookContext(gateID string) context.Context {
+	return context.WithValue(context.Background(), provider.WebhookURIKey, gateID)
+}
+
+// gzipped compresses a message as Apple sends it.
+func gzipped(t *testing.T, m any) []byte {
+	t.Helper()
+
+	raw, err := json.Marshal(m)
+	if err != nil {
+		t.Fatalf("marshal: %v", err)
+	}
+	var buf bytes.Buffer
+	zw := gzip.NewWriter(&buf)
+	_, _ = zw.Write(raw)
+	_ = zw.Close()
+	return buf.Bytes()
+}
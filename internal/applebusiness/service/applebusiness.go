@@ -0,0 +1,134 @@
+package service
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"log/slog"
+
+	"github.com/webitel/im-providers-service/config"
+	abmodel "github.com/webitel/im-providers-service/internal/applebusiness/model"
+	abstore "github.com/webitel/im-providers-service/internal/applebusiness/store"
+)
+
+var (
+	// ErrPublicURLNotSet is returned when an MSP endpoint is to be issued but service.public_url is empty.
+	ErrPublicURLNotSet = errors.New("apple business: service.public_url is not configured")
+	// ErrSecretMalformed is returned when the MSP secret is not base64-encoded as issued by Apple.
+	ErrSecretMalformed = errors.New("apple business: msp secret is not base64-encoded")
+)
+
+var _ AppleBusinessManager = (*AppleBusinessService)(nil)
+
+type AppleBusinessManager interface {
+	CreateGate(ctx context.Context, req abmodel.CreateAppleBusiness) (*abmodel.AppleBusinessGate, error)
+	GetGate(ctx context.Context, id string) (*abmodel.AppleBusinessGate, error)
+	UpdateGate(ctx context.Context, req abmodel.UpdateAppleBusiness) (*abmodel.AppleBusinessGate, error)
+	DeleteGate(ctx context.Context, id string) (*abmodel.AppleBusinessGate, error)
+}
+
+type AppleBusinessService struct {
+	repo abstore.AppleBusinessStore
+	cfg  *config.Config
+	log  *slog.Logger
+}
+
+func NewAppleBusinessService(repo abstore.AppleBusinessStore, cfg *config.Config, log *slog.Logger) *AppleBusinessService {
+	return &AppleBusinessService{
+		repo: repo,
+		cfg:  cfg,
+		log:  log.With("layer", "service", "domain", "apple_business_gate"),
+	}
+}
+
+// CreateGate stores the gate of a business. The MSP gateway has no endpoint to check
+// the credentials with, and the MSP endpoint is registered with Apple Business
+// Register by hand: the returned webhook URL is the one to register there.
+func (s *AppleBusinessService) CreateGate(ctx context.Context, req abmodel.CreateAppleBusiness) (*abmodel.AppleBusinessGate, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	if s.cfg.Service.PublicURL == "" {
+		return nil, ErrPublicURLNotSet
+	}
+	if !validSecret(req.Secret) {
+		return nil, ErrSecretMalformed
+	}
+
+	gate := &abmodel.AppleBusinessGate{
+		Name:       req.Name,
+		BusinessID: req.BusinessID,
+		CSPID:      req.CSPID,
+		Secret:     req.Secret,
+		Peer:       req.Peer,
+		Enabled:    true,
+	}
+
+	if err := s.repo.Insert(ctx, req.Dc, gate); err != nil {
+		s.log.Error("failed to create apple business gate", "business_id", req.BusinessID, "err", err)
+		return nil, err
+	}
+
+	// The MSP endpoint contains the gate ID, known only once the gate is stored.
+	gate.WebhookURL = s.webhookURL(gate.ID)
+	if err := s.repo.Update(ctx, gate); err != nil {
+		s.log.Error("failed to store apple business endpoint", "id", gate.ID, "err", err)
+		return nil, err
+	}
+
+	s.log.Info("apple business gate created", "id", gate.ID, "business_id", gate.BusinessID, "webhook", gate.WebhookURL)
+	return gate, nil
+}
+
+func (s *AppleBusinessService) GetGate(ctx context.Context, id string) (*abmodel.AppleBusinessGate, error) {
+	return s.repo.Select(ctx, id)
+}
+
+func (s *AppleBusinessService) UpdateGate(ctx context.Context, req abmodel.UpdateAppleBusiness) (*abmodel.AppleBusinessGate, error) {
+	if req.Secret != nil && !validSecret(*req.Secret) {
+		return nil, ErrSecretMalformed
+	}
+
+	gate, err := s.repo.Select(ctx, req.ID)
+	if err != nil {
+		return nil, err
+	}
+	req.ApplyTo(gate)
+
+	if err := s.repo.Update(ctx, gate); err != nil {
+		s.log.Error("failed to update apple business gate", "id", req.ID, "err", err)
+		return nil, err
+	}
+
+	s.log.Info("apple business gate updated", "id", gate.ID, "business_id", gate.BusinessID)
+	return gate, nil
+}
+
+// DeleteGate removes the gate. Apple keeps delivering the messages of the business
+// to the MSP endpoint, answered not found, until it is changed in Apple Business Register.
+func (s *AppleBusinessService) DeleteGate(ctx context.Context, id string) (*abmodel.AppleBusinessGate, error) {
+	gate, err := s.repo.Select(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.repo.Delete(ctx, id); err != nil {
+		s.log.Error("failed to delete apple business gate", "id", id, "err", err)
+		return nil, err
+	}
+
+	s.log.Warn("apple business gate removed", "id", id, "business_id", gate.BusinessID)
+	return gate, nil
+}
+
+// webhookURL is the MSP endpoint of the gate: the gate ID is the webhook URI segment.
+// Apple appends the /message path itself.
+func (s *AppleBusinessService) webhookURL(gateID string) string {
+	return s.cfg.Service.PublicURL + s.cfg.Service.WebhookPath + "/apple_business/" + gateID
+}
+
+// validSecret reports whether the MSP secret decodes to a signing key.
+func validSecret(secret string) bool {
+	key, err := base64.StdEncoding.DecodeString(secret)
+	return err == nil && len(key) > 0
+}
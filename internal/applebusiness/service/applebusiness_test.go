@@ -0,0 +1,152 @@
+package service
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"testing"
+
+	"github.com/webitel/im-providers-service/config"
+	abmodel "github.com/webitel/im-providers-service/internal/applebusiness/model"
+	abstore "github.com/webitel/im-providers-service/internal/applebusiness/store"
+	sharedstore "github.com/webitel/im-providers-service/internal/core/store"
+)
+
+var noopLogger = slog.New(slog.NewTextHandler(io.Discard, nil))
+
+var testSecret = base64.StdEncoding.EncodeToString([]byte("msp-secret"))
+
+// businessRegister stores the gates under sequential IDs.
+type businessRegister map[string]*abmodel.AppleBusinessGate
+
+var _ abstore.AppleBusinessStore = businessRegister(nil)
+
+func (r businessRegister) Insert(_ context.Context, dc int64, g *abmodel.AppleBusinessGate) error {
+	g.ID = fmt.Sprintf("gate-%d", len(r)+1)
+	g.DomainID = dc
+	cp := *g
+	r[g.ID] = &cp
+	return nil
+}
+
+func (r businessRegister) Select(_ context.Context, id string) (*abmodel.AppleBusinessGate, error) {
+	g, ok := r[id]
+	if !ok {
+		return nil, sharedstore.ErrNotFound
+	}
+	cp := *g
+	return &cp, nil
+}
+
+func (r businessRegister) Update(_ context.Context, g *abmodel.AppleBusinessGate) error {
+	cp := *g
+	r[g.ID] = &cp
+	return nil
+}
+
+func (r businessRegister) Delete(_ context.Context, id string) error {
+	delete(r, id)
+	return nil
+}
+
+func newAppleBusinessService(publicURL string) (*AppleBusinessService, businessRegister) {
+	register := businessRegister{}
+	cfg := &config.Config{Service: config.ServiceConfig{PublicURL: publicURL, WebhookPath: "/wh"}}
+	return NewAppleBusinessService(register, cfg, noopLogger), register
+}
+
+func createRequest() abmodel.CreateAppleBusiness {
+	return abmodel.CreateAppleBusiness{Name: "Support", Dc: 1, BusinessID: "biz-1", CSPID: "csp-1", Secret: testSecret}
+}
+
+func TestCreateGate_IssuesEndpoint(t *testing.T) {
+	svc, register := newAppleBusinessService("https://im.example.com")
+
+	gate, err := svc.CreateGate(context.Background(), createRequest())
+	if err != nil {
+		t.Fatalf("CreateGate: %v", err)
+	}
+
+	const wantURL = "https://im.example.com/wh/apple_business/gate-1"
+	stored := register["gate-1"]
+	if stored == nil || stored.WebhookURL != wantURL || stored.BusinessID != "biz-1" || stored.CSPID != "csp-1" || stored.Secret != testSecret || !stored.Enabled {
+		t.Errorf("unexpected stored gate: %+v", stored)
+	}
+	if gate.WebhookURL != wantURL {
+		t.Errorf("returned gate webhook = %q", gate.WebhookURL)
+	}
+}
+
+func TestCreateGate_Rejected(t *testing.T) {
+	malformed := createRequest()
+	malformed.Secret = "not base64!"
+
+	tests := []struct {
+		name      string
+		publicURL string
+		req       abmodel.CreateAppleBusiness
+		wantErr   error
+	}{
+		{name: "missing fields", publicURL: "https://im.example.com", req: abmodel.CreateAppleBusiness{Dc: 1}},
+		{name: "public url not set", req: createRequest(), wantErr: ErrPublicURLNotSet},
+		{name: "malformed secret", publicURL: "https://im.example.com", req: malformed, wantErr: ErrSecretMalformed},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc, register := newAppleBusinessService(tt.publicURL)
+			_, err := svc.CreateGate(context.Background(), tt.req)
+			if err == nil {
+				t.Fatal("expected an error")
+			}
+			if tt.wantErr != nil && !errors.Is(err, tt.wantErr) {
+				t.Errorf("error = %v, want %v", err, tt.wantErr)
+			}
+			if len(register) != 0 {
+				t.Errorf("no gate must be stored, got %v", register)
+			}
+		})
+	}
+}
+
+func TestUpdateGate(t *testing.T) {
+	svc, register := newAppleBusinessService("https://im.example.com")
+	if _, err := svc.CreateGate(context.Background(), createRequest()); err != nil {
+		t.Fatalf("CreateGate: %v", err)
+	}
+
+	bad := "not base64!"
+	if _, err := svc.UpdateGate(context.Background(), abmodel.UpdateAppleBusiness{ID: "gate-1", Secret: &bad}); !errors.Is(err, ErrSecretMalformed) {
+		t.Fatalf("UpdateGate() error = %v, want ErrSecretMalformed", err)
+	}
+
+	secret := base64.StdEncoding.EncodeToString([]byte("rotated"))
+	cspID := "csp-2"
+	if _, err := svc.UpdateGate(context.Background(), abmodel.UpdateAppleBusiness{ID: "gate-1", Secret: &secret, CSPID: &cspID}); err != nil {
+		t.Fatalf("UpdateGate: %v", err)
+	}
+	if stored := register["gate-1"]; stored.Secret != secret || stored.CSPID != "csp-2" || stored.BusinessID != "biz-1" {
+		t.Errorf("unexpected stored gate: %+v", stored)
+	}
+}
+
+func TestDeleteGate(t *testing.T) {
+	svc, register := newAppleBusinessService("https://im.example.com")
+	if _, err := svc.CreateGate(context.Background(), createRequest()); err != nil {
+		t.Fatalf("CreateGate: %v", err)
+	}
+
+	if _, err := svc.DeleteGate(context.Background(), "gate-1"); err != nil {
+		t.Fatalf("DeleteGate: %v", err)
+	}
+	if len(register) != 0 {
+		t.Errorf("gate not removed: %v", register)
+	}
+
+	if _, err := svc.DeleteGate(context.Background(), "gate-1"); !errors.Is(err, sharedstore.ErrNotFound) {
+		t.Errorf("second delete error = %v, want ErrNotFound", err)
+	}
+}
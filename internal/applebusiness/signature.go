@@ -0,0 +1,34 @@
+package applebusiness
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// authorizationHeader carries the token of a message sent by Apple.
+const authorizationHeader = "Authorization"
+
+// SignatureHeader implements provider.SignatureHeader.
+func (p *appleProvider) SignatureHeader() string { return authorizationHeader }
+
+// ValidateSignature implements provider.SignatureValidator: Apple signs a token for
+// every message with the secret of the MSP account. The body is compressed and
+// checked against the gate by HandleWebhook.
+func (p *appleProvider) ValidateSignature(ctx context.Context, header string, _ []byte) error {
+	token, ok := strings.CutPrefix(header, "Bearer ")
+	if !ok || token == "" {
+		return fmt.Errorf("missing bearer token")
+	}
+
+	gate, err := p.resolveGate(ctx)
+	if err != nil {
+		return fmt.Errorf("signature: gate lookup failed: %w", err)
+	}
+	if !gate.Enabled {
+		// Messages of a disabled gate are acknowledged and dropped by HandleWebhook.
+		return nil
+	}
+
+	return verifyToken(token, gate.CSPID, gate.Secret, p.now())
+}
@@ -0,0 +1,165 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/georgysavva/scany/v2/pgxscan"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/webitel/im-providers-service/infra/db/pg"
+	abmodel "github.com/webitel/im-providers-service/internal/applebusiness/model"
+	abstore "github.com/webitel/im-providers-service/internal/applebusiness/store"
+	sharedmodel "github.com/webitel/im-providers-service/internal/core/model"
+	sharedstore "github.com/webitel/im-providers-service/internal/core/store"
+	"github.com/webitel/im-providers-service/pkg/crypto"
+)
+
+var _ abstore.AppleBusinessStore = (*appleBusinessStore)(nil)
+
+type appleBusinessStore struct {
+	pool   *pgxpool.Pool
+	crypto crypto.Encryptor
+	cache  sharedstore.GateCache
+}
+
+func NewAppleBusinessStore(pool *pgxpool.Pool, crypt crypto.Encryptor, cache sharedstore.GateCache) abstore.AppleBusinessStore {
+	return &appleBusinessStore{
+		pool:   pool,
+		crypto: crypt,
+		cache:  cache,
+	}
+}
+
+func (s *appleBusinessStore) Insert(ctx context.Context, dc int64, g *abmodel.AppleBusinessGate) error {
+	secret, err := s.crypto.Encrypt(g.Secret)
+	if err != nil {
+		return fmt.Errorf("crypto: %w", err)
+	}
+
+	const query = `
+	WITH new_gate AS (
+		INSERT INTO im_provider.gates (dc, name, type, enabled)
+		VALUES ($1, $2, 'apple_business', $3)
+		RETURNING id, name, created_at, updated_at
+	),
+	new_bot AS (
+		INSERT INTO im_provider.bots (sub, iss, gate_id)
+		SELECT $4, $5, id FROM new_gate
+		RETURNING id
+	)
+	INSERT INTO im_provider.apple_business (gate_id, business_id, csp_id, secret, webhook_url)
+	SELECT id, $6, $7, $8, $9 FROM new_gate
+	RETURNING
+		gate_id,
+		(SELECT name FROM new_gate),
+		(SELECT created_at FROM new_gate),
+		(SELECT updated_at FROM new_gate)`
+
+	err = s.pool.QueryRow(ctx, query,
+		dc, g.Name, g.Enabled, g.Peer.Sub, g.Peer.Iss, g.BusinessID, g.CSPID, secret, g.WebhookURL,
+	).Scan(&g.ID, &g.Name, &g.CreatedAt, &g.UpdatedAt)
+	if err != nil {
+		if pg.IsUniqueViolation(err) {
+			return fmt.Errorf("business %s is already bound: %w", g.BusinessID, sharedstore.ErrConflict)
+		}
+		return fmt.Errorf("postgres: insert apple business gateway: %w", err)
+	}
+
+	g.DomainID = dc
+	s.mapVirtualFields(g)
+	return nil
+}
+
+func (s *appleBusinessStore) Select(ctx context.Context, id string) (*abmodel.AppleBusinessGate, error) {
+	const query = `
+	SELECT
+		g.id,
+		g.dc AS domain_id,
+		g.name,
+		g.enabled,
+		g.created_at,
+		g.updated_at,
+		b.sub AS "peer.sub",
+		b.iss AS "peer.iss",
+		ab.business_id,
+		ab.csp_id,
+		ab.secret,
+		ab.webhook_url
+	FROM im_provider.gates g
+	JOIN im_provider.bots b ON g.id = b.gate_id
+	JOIN im_provider.apple_business ab ON g.id = ab.gate_id
+	WHERE g.id = $1`
+
+	var g abmodel.AppleBusinessGate
+	if err := pgxscan.Get(ctx, s.pool, &g, query, id); err != nil {
+		if pgxscan.NotFound(err) {
+			return nil, sharedstore.ErrNotFound
+		}
+		return nil, fmt.Errorf("postgres: select apple business gate: %w", err)
+	}
+
+	if dec, err := s.crypto.Decrypt(g.Secret); err == nil {
+		g.Secret = dec
+	}
+
+	s.mapVirtualFields(&g)
+	return &g, nil
+}
+
+func (s *appleBusinessStore) Update(ctx context.Context, g *abmodel.AppleBusinessGate) error {
+	secret, err := s.crypto.Encrypt(g.Secret)
+	if err != nil {
+		return fmt.Errorf("crypto: %w", err)
+	}
+
+	err = pgx.BeginFunc(ctx, s.pool, func(tx pgx.Tx) error {
+		const uGate = `UPDATE im_provider.gates SET name = $1, enabled = $2, updated_at = NOW() WHERE id = $3 RETURNING updated_at`
+		if err := tx.QueryRow(ctx, uGate, g.Name, g.Enabled, g.ID).Scan(&g.UpdatedAt); err != nil {
+			return err
+		}
+
+		const uBot = `UPDATE im_provider.bots SET sub = $1, iss = $2 WHERE gate_id = $3`
+		if _, err := tx.Exec(ctx, uBot, g.Peer.Sub, g.Peer.Iss, g.ID); err != nil {
+			return err
+		}
+
+		const uConfig = `
+			UPDATE im_provider.apple_business
+			SET business_id = $1, csp_id = $2, secret = $3, webhook_url = $4
+			WHERE gate_id = $5`
+		_, err := tx.Exec(ctx, uConfig, g.BusinessID, g.CSPID, secret, g.WebhookURL, g.ID)
+		return err
+	})
+	if err != nil {
+		if pg.IsUniqueViolation(err) {
+			return fmt.Errorf("business %s is already bound: %w", g.BusinessID, sharedstore.ErrConflict)
+		}
+		return fmt.Errorf("postgres: update apple business gate: %w", err)
+	}
+
+	s.cache.Delete(g.ID)
+	s.mapVirtualFields(g)
+	return nil
+}
+
+func (s *appleBusinessStore) Delete(ctx context.Context, id string) error {
+	res, err := s.pool.Exec(ctx, "DELETE FROM im_provider.gates WHERE id = $1 AND type = 'apple_business'", id)
+	if err != nil {
+		return fmt.Errorf("postgres: delete apple business gate: %w", err)
+	}
+	if res.RowsAffected() == 0 {
+		return sharedstore.ErrNotFound
+	}
+
+	s.cache.Delete(id)
+	return nil
+}
+
+func (s *appleBusinessStore) mapVirtualFields(g *abmodel.AppleBusinessGate) {
+	if g.Enabled {
+		g.Status = sharedmodel.StatusActive
+	} else {
+		g.Status = sharedmodel.StatusDisabled
+	}
+}
@@ -0,0 +1,16 @@
+package store
+
+import (
+	"context"
+
+	abmodel "github.com/webitel/im-providers-service/internal/applebusiness/model"
+)
+
+// AppleBusinessStore manages the businesses registered for Apple Messages for Business.
+type AppleBusinessStore interface {
+	// Insert creates the gate together with its bot peer and MSP credentials.
+	Insert(ctx context.Context, dc int64, g *abmodel.AppleBusinessGate) error
+	Select(ctx context.Context, id string) (*abmodel.AppleBusinessGate, error)
+	Update(ctx context.Context, g *abmodel.AppleBusinessGate) error
+	Delete(ctx context.Context, id string) error
+}
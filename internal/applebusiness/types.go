@@ -0,0 +1,170 @@
+package applebusiness
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Message types.
+// https://register.apple.com/resources/messages/msp-rest-api/type-interactive
+const (
+	MessageText        = "text"
+	MessageInteractive = "interactive"
+	MessageTypingStart = "typing_start"
+	MessageTypingEnd   = "typing_end"
+	MessageClose       = "close"
+)
+
+const (
+	// messageVersion is the version of the message format.
+	messageVersion = 1
+	// interactiveVersion is the version of the interactive data format.
+	interactiveVersion = "1.0"
+	// attachmentPlaceholder marks the position of an attachment in the body of a message.
+	attachmentPlaceholder = "\uFFFC"
+	// businessExtensionBID identifies the Messages for Business extension rendering
+	// list pickers and time pickers.
+	businessExtensionBID = "com.apple.messages.MSMessageExtensionBalloonPlugin:0000000000:com.apple.icloud.apps.messages.business.extension"
+	// timeslotLayout is the start time format of a time picker slot.
+	timeslotLayout = "2006-01-02T15:04-0700"
+)
+
+// ErrUnauthorized is returned when the MSP gateway rejects the credentials of a gate.
+var ErrUnauthorized = errors.New("apple business: msp credentials rejected")
+
+// APIError is an error reply of the MSP gateway.
+// https://register.apple.com/resources/messages/msp-rest-api/code-samples#response-codes
+type APIError struct {
+	Operation  string
+	StatusCode int
+}
+
+func (e *APIError) Error() string {
+	return fmt.Sprintf("apple business %s: status %d", e.Operation, e.StatusCode)
+}
+
+// Is matches the sentinel errors of the statuses callers act on.
+func (e *APIError) Is(target error) bool {
+	return target == ErrUnauthorized && (e.StatusCode == 401 || e.StatusCode == 403)
+}
+
+// --- Messages ---
+
+// message is the body of a message in both directions.
+type message struct {
+	V                  int              `json:"v"`
+	Type               string           `json:"type"`
+	ID                 string           `json:"id"`
+	SourceID           string           `json:"sourceId"`
+	DestinationID      string           `json:"destinationId"`
+	Body               string           `json:"body,omitempty"`
+	Locale             string           `json:"locale,omitempty"`
+	Attachments        []attachment     `json:"attachments,omitempty"`
+	InteractiveData    *interactiveData `json:"interactiveData,omitempty"`
+	InteractiveDataRef *fileRef         `json:"interactiveDataRef,omitempty"`
+}
+
+// attachment is an encrypted file stored by Apple.
+// https://register.apple.com/resources/messages/msp-rest-api/type-text#attachments
+type attachment struct {
+	Name            string `json:"name"`
+	MimeType        string `json:"mimeType"`
+	Size            int64  `json:"size"`
+	URL             string `json:"url"`
+	Owner           string `json:"owner"`
+	SignatureBase64 string `json:"signature-base64"`
+	// Key is the hex-encoded AES key, prefixed with "00".
+	Key string `json:"key"`
+}
+
+func (a *attachment) ref() fileRef {
+	return fileRef{URL: a.URL, Owner: a.Owner, SignatureBase64: a.SignatureBase64, Key: a.Key, Size: a.Size}
+}
+
+// fileRef locates and decrypts a file stored by Apple: an attachment, or the
+// interactive data of a reply too large to be sent inline.
+type fileRef struct {
+	BID             string `json:"bid,omitempty"`
+	URL             string `json:"url"`
+	Owner           string `json:"owner"`
+	SignatureBase64 string `json:"signature-base64"`
+	Key             string `json:"key"`
+	Size            int64  `json:"size"`
+}
+
+// interactiveData is the payload of an interactive message or of the reply to it.
+type interactiveData struct {
+	BID  string             `json:"bid"`
+	Data interactiveContent `json:"data"`
+}
+
+type interactiveContent struct {
+	Version           string        `json:"version"`
+	MSPVersion        string        `json:"mspVersion,omitempty"`
+	RequestIdentifier string        `json:"requestIdentifier"`
+	ReceivedMessage   *messageStyle `json:"receivedMessage,omitempty"`
+	ReplyMessage      *messageStyle `json:"replyMessage,omitempty"`
+	ListPicker        *listPicker   `json:"listPicker,omitempty"`
+	Event             *event        `json:"event,omitempty"`
+}
+
+// messageStyle is the bubble of an interactive message before (received) and after (reply) the answer.
+type messageStyle struct {
+	Title    string `json:"title"`
+	Subtitle string `json:"subtitle,omitempty"`
+	Style    string `json:"style,omitempty"`
+}
+
+// listPicker offers items to pick from. A reply holds the picked items only.
+// https://register.apple.com/resources/messages/msp-rest-api/type-interactive#list-picker
+type listPicker struct {
+	Sections []listSection `json:"sections"`
+}
+
+type listSection struct {
+	Title             string     `json:"title"`
+	Order             int        `json:"order"`
+	MultipleSelection bool       `json:"multipleSelection"`
+	Items             []listItem `json:"items"`
+}
+
+type listItem struct {
+	Identifier string `json:"identifier"`
+	Title      string `json:"title"`
+	Subtitle   string `json:"subtitle,omitempty"`
+	Order      int    `json:"order"`
+	Style      string `json:"style,omitempty"`
+}
+
+// event is a time picker. A reply holds the picked slot only.
+// https://register.apple.com/resources/messages/msp-rest-api/type-interactive#time-picker
+type event struct {
+	Identifier     string     `json:"identifier"`
+	Title          string     `json:"title"`
+	Timeslots      []timeslot `json:"timeslots"`
+	TimezoneOffset int        `json:"timezoneOffset,omitempty"`
+}
+
+type timeslot struct {
+	Identifier string `json:"identifier"`
+	StartTime  string `json:"startTime"`
+	Duration   int64  `json:"duration"`
+}
+
+// --- Attachment replies ---
+
+type preUploadResponse struct {
+	UploadURL string `json:"upload-url"`
+	MMCSURL   string `json:"mmcs-url"`
+	MMCSOwner string `json:"mmcs-owner"`
+}
+
+type uploadResponse struct {
+	SingleFile struct {
+		FileChecksum string `json:"fileChecksum"`
+	} `json:"singleFile"`
+}
+
+type preDownloadResponse struct {
+	DownloadURL string `json:"download-url"`
+}
@@ -0,0 +1,64 @@
+package applebusiness
+
+import (
+	"context"
+
+	gatewayv1 "github.com/webitel/im-providers-service/gen/go/gateway/v1"
+	abmodel "github.com/webitel/im-providers-service/internal/applebusiness/model"
+	sharedmodel "github.com/webitel/im-providers-service/internal/core/model"
+	"github.com/webitel/im-providers-service/internal/provider/contactsync"
+)
+
+// Contact metadata keys of a customer.
+const (
+	metadataBusiness = "business_id"
+	metadataLocale   = "locale"
+)
+
+// customerNameSuffix is the length of the customer ID suffix naming a contact.
+const customerNameSuffix = 6
+
+// syncContact resolves the internal contact for a customer, creating it if necessary.
+// Customers are known by an opaque ID only: Apple never shares their name or handle.
+func (p *appleProvider) syncContact(ctx context.Context, gate *abmodel.AppleBusinessGate, m *message) (*gatewayv1.Contact, error) {
+	key := contactsync.KnownUser(gate.ID, &sharedmodel.ExternalUser{ID: m.SourceID})
+	if known, _ := p.userCache.IsKnown(ctx, key); known {
+		return &gatewayv1.Contact{Sub: m.SourceID}, nil
+	}
+
+	authCtx := contactsync.WithGatewayIdentity(ctx, gate.DomainID, gate.Peer)
+	contact, err := p.ensureContact(authCtx, gate, m)
+	if err != nil {
+		return nil, err
+	}
+
+	contactsync.EnsureVia(authCtx, p.gatewayer, p.logger, gate.ID, m.SourceID, contact.Iss)
+	_ = p.userCache.MarkKnown(ctx, key)
+
+	return contact, nil
+}
+
+// customerName returns the contact name of a customer, told apart by the end of the opaque ID.
+func customerName(customerID string) string {
+	if len(customerID) > customerNameSuffix {
+		customerID = customerID[len(customerID)-customerNameSuffix:]
+	}
+	return "Messages customer " + customerID
+}
+
+// ensureContact creates the internal contact or returns a stub when the
+// contact already exists (idempotent by design on the gateway side).
+func (p *appleProvider) ensureContact(ctx context.Context, gate *abmodel.AppleBusinessGate, m *message) (*gatewayv1.Contact, error) {
+	metadata := map[string]string{metadataBusiness: gate.BusinessID}
+	if m.Locale != "" {
+		metadata[metadataLocale] = m.Locale
+	}
+
+	return contactsync.CreateContact(ctx, p.gatewayer, &gatewayv1.CreateContactRequest{
+		IssId:    p.Type(),
+		Type:     p.Type(),
+		Name:     customerName(m.SourceID),
+		Subject:  m.SourceID,
+		Metadata: metadata,
+	})
+}
@@ -0,0 +1,162 @@
+package applebusiness
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	abmodel "github.com/webitel/im-providers-service/internal/applebusiness/model"
+	sharedmodel "github.com/webitel/im-providers-service/internal/core/model"
+	"github.com/webitel/im-providers-service/internal/provider/contactsync"
+)
+
+// maxPayloadSize bounds a decompressed message and the interactive data of a reply.
+const maxPayloadSize = 10 << 20
+
+func (p *appleProvider) HandleWebhook(ctx context.Context, data []byte) error {
+	raw, err := decompress(data)
+	if err != nil {
+		p.logger.Warn("malformed payload dropped", "err", err)
+		return nil
+	}
+	var m message
+	if err := json.Unmarshal(raw, &m); err != nil {
+		p.logger.Warn("malformed message dropped", "err", err)
+		return nil
+	}
+
+	gate, err := p.resolveGate(ctx)
+	if err != nil || !gate.Enabled {
+		return err
+	}
+	if m.DestinationID != gate.BusinessID {
+		p.logger.Warn("message of another business dropped", "destination_id", m.DestinationID, "gate_id", gate.ID)
+		return nil
+	}
+	if m.SourceID == "" || !p.firstSeen(m.ID) {
+		return nil
+	}
+
+	switch m.Type {
+	case MessageText:
+		err = p.processText(ctx, gate, &m)
+	case MessageInteractive:
+		err = p.processInteractive(ctx, gate, &m)
+	case MessageClose:
+		p.logger.Info("conversation closed by the customer", "source_id", m.SourceID, "gate_id", gate.ID)
+	default:
+		// typing_start and typing_end have no counterpart.
+		p.logger.Debug("message acknowledged", "type", m.Type, "id", m.ID)
+	}
+	if err != nil {
+		p.logger.Error("message dropped", "id", m.ID, "type", m.Type, "err", err)
+	}
+	return nil
+}
+
+// processText routes a text message and its attachments. The body holds a
+// placeholder at the position of every attachment.
+func (p *appleProvider) processText(ctx context.Context, gate *abmodel.AppleBusinessGate, m *message) error {
+	if _, err := p.syncContact(ctx, gate, m); err != nil {
+		return fmt.Errorf("sync contact [source_id=%s]: %w", m.SourceID, err)
+	}
+
+	peers := contactsync.InboundPeers(gate.ID, gate.Peer, m.SourceID)
+	body := strings.TrimSpace(strings.ReplaceAll(m.Body, attachmentPlaceholder, ""))
+	for i := range m.Attachments {
+		if p.handleAttachment(ctx, gate, peers, &m.Attachments[i], body) {
+			body = ""
+		}
+	}
+	p.sendText(ctx, gate, peers, body)
+	return nil
+}
+
+// processInteractive forwards the items or the time slot picked in reply to an
+// interactive message as interactive callbacks. Large replies are stored by Apple
+// and downloaded first.
+func (p *appleProvider) processInteractive(ctx context.Context, gate *abmodel.AppleBusinessGate, m *message) error {
+	data := m.InteractiveData
+	if data == nil && m.InteractiveDataRef != nil {
+		var err error
+		if data, err = p.fetchInteractiveData(ctx, gate, *m.InteractiveDataRef); err != nil {
+			return err
+		}
+	}
+	if data == nil || data.BID != businessExtensionBID {
+		p.logger.Debug("interactive reply skipped", "id", m.ID)
+		return nil
+	}
+
+	if _, err := p.syncContact(ctx, gate, m); err != nil {
+		return fmt.Errorf("sync contact [source_id=%s]: %w", m.SourceID, err)
+	}
+
+	peers := contactsync.InboundPeers(gate.ID, gate.Peer, m.SourceID)
+	for _, picked := range picks(&data.Data) {
+		if err := p.messenger.SendInteractiveCallback(ctx, &sharedmodel.SendInteractiveCallbackRequest{
+			DomainID:     gate.DomainID,
+			From:         peers.From,
+			To:           peers.To,
+			InReplyTo:    data.Data.RequestIdentifier,
+			ButtonCode:   picked.code,
+			CallbackData: picked.data,
+		}); err != nil {
+			return fmt.Errorf("send interactive callback [in_reply_to=%s]: %w", data.Data.RequestIdentifier, err)
+		}
+	}
+	return nil
+}
+
+func (p *appleProvider) fetchInteractiveData(ctx context.Context, gate *abmodel.AppleBusinessGate, ref fileRef) (*interactiveData, error) {
+	c, err := p.api.Download(ctx, gate.Credentials(), ref)
+	if err != nil {
+		return nil, fmt.Errorf("download interactive data: %w", err)
+	}
+	defer c.Body.Close()
+
+	var data interactiveData
+	if err := json.NewDecoder(io.LimitReader(c.Body, maxPayloadSize)).Decode(&data); err != nil {
+		return nil, fmt.Errorf("decode interactive data: %w", err)
+	}
+	return &data, nil
+}
+
+func (p *appleProvider) sendText(ctx context.Context, gate *abmodel.AppleBusinessGate, peers contactsync.Peers, text string) {
+	if text == "" {
+		return
+	}
+	if _, err := p.messenger.SendText(ctx, &sharedmodel.SendTextRequest{
+		DomainID: gate.DomainID,
+		From:     peers.From,
+		To:       peers.To,
+		Body:     text,
+	}); err != nil {
+		p.logger.Error("send text failed", "err", err)
+	}
+}
+
+// decompress returns the body of a message, which Apple sends gzip-compressed.
+func decompress(data []byte) ([]byte, error) {
+	if len(data) < 2 || data[0] != 0x1f || data[1] != 0x8b {
+		return data, nil
+	}
+	zr, err := gzip.NewReader(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	defer zr.Close()
+
+	raw, err := io.ReadAll(io.LimitReader(zr, maxPayloadSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(raw) > maxPayloadSize {
+		return nil, fmt.Errorf("payload exceeds %d bytes", maxPayloadSize)
+	}
+	return raw, nil
+}
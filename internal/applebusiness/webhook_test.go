@@ -0,0 +1,264 @@
+package applebusiness
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+// signClaims issues a token as Apple does, with arbitrary claims.
+func signClaims(t *testing.T, claims tokenClaims, secret string) string {
+	t.Helper()
+
+	key, err := decodeSecret(secret)
+	if err != nil {
+		t.Fatalf("secret: %v", err)
+	}
+	payload, _ := json.Marshal(claims)
+	signed := jwtHeader + "." + base64.RawURLEncoding.EncodeToString(payload)
+	return signed + "." + base64.RawURLEncoding.EncodeToString(hmacSHA256(key, signed))
+}
+
+func textMessage(id, body string, attachments ...attachment) *message {
+	return &message{
+		V:             messageVersion,
+		Type:          MessageText,
+		ID:            id,
+		SourceID:      testCustomerID,
+		DestinationID: testBusinessID,
+		Body:          body,
+		Locale:        "en_US",
+		Attachments:   attachments,
+	}
+}
+
+func TestValidateSignature(t *testing.T) {
+	otherSecret := base64.StdEncoding.EncodeToString([]byte("another secret"))
+	valid := tokenClaims{Audience: audience{testCSPID}, ExpiresAt: time.Now().Add(time.Hour).Unix()}
+
+	tests := []struct {
+		name    string
+		gateID  string
+		header  func(t *testing.T) string
+		wantErr bool
+	}{
+		{name: "valid", header: func(t *testing.T) string { return "Bearer " + signClaims(t, valid, testSecret) }},
+		{name: "audience list", header: func(t *testing.T) string {
+			return "Bearer " + signClaims(t, tokenClaims{Audience: audience{"other", testCSPID}}, testSecret)
+		}},
+		{name: "missing bearer", header: func(*testing.T) string { return "" }, wantErr: true},
+		{name: "another secret", header: func(t *testing.T) string { return "Bearer " + signClaims(t, valid, otherSecret) }, wantErr: true},
+		{name: "another msp", header: func(t *testing.T) string {
+			return "Bearer " + signClaims(t, tokenClaims{Audience: audience{"other"}}, testSecret)
+		}, wantErr: true},
+		{name: "expired", header: func(t *testing.T) string {
+			return "Bearer " + signClaims(t, tokenClaims{Audience: audience{testCSPID}, ExpiresAt: time.Now().Add(-time.Hour).Unix()}, testSecret)
+		}, wantErr: true},
+		{name: "unsigned", header: func(t *testing.T) string {
+			header := base64.RawURLEncoding.EncodeToString([]byte(`{"alg":"none"}`))
+			payload, _ := json.Marshal(valid)
+			return "Bearer " + header + "." + base64.RawURLEncoding.EncodeToString(payload) + "."
+		}, wantErr: true},
+		{name: "unknown gate", gateID: "0190a8a4-0000-7000-8000-000000000000", header: func(t *testing.T) string {
+			return "Bearer " + signClaims(t, valid, testSecret)
+		}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := newMSPAccount(t)
+			gateID := testGateID
+			if tt.gateID != "" {
+				gateID = tt.gateID
+			}
+
+			err := p.ValidateSignature(webhookContext(gateID), tt.header(t), nil)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ValidateSignature() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestHandleWebhook_Text(t *testing.T) {
+	p := newMSPAccount(t)
+
+	if err := p.HandleWebhook(webhookContext(testGateID), gzipped(t, textMessage("m-1", "Hello"))); err != nil {
+		t.Fatalf("HandleWebhook: %v", err)
+	}
+
+	if len(p.messenger.Texts()) != 1 {
+		t.Fatalf("texts = %d, want 1", len(p.messenger.Texts()))
+	}
+	got := p.messenger.Texts()[0]
+	if got.Body != "Hello" || got.From.Sub != testCustomerID || got.To.Sub != "bot-1" || got.To.Via == nil || *got.To.Via != testGateID {
+		t.Errorf("unexpected text: %+v", got)
+	}
+}
+
+func TestHandleWebhook_Attachments(t *testing.T) {
+	p := newMSPAccount(t)
+	photo := p.stub.store(t, "photo", []byte("png bytes"))
+	report := p.stub.store(t, "report", []byte("pdf bytes"))
+
+	m := textMessage("m-1", "Look"+attachmentPlaceholder+attachmentPlaceholder,
+		attachment{Name: "photo.png", MimeType: "image/png", Size: 9, URL: photo.URL, Owner: photo.Owner, SignatureBase64: photo.SignatureBase64, Key: photo.Key},
+		attachment{Name: "report.pdf", MimeType: "application/pdf", Size: 9, URL: report.URL, Owner: report.Owner, SignatureBase64: report.SignatureBase64, Key: report.Key},
+	)
+	if err := p.HandleWebhook(webhookContext(testGateID), gzipped(t, m)); err != nil {
+		t.Fatalf("HandleWebhook: %v", err)
+	}
+
+	if len(p.media.Uploads()) != 2 || p.media.Uploads()[0].Body != "png bytes" || p.media.Uploads()[1].Body != "pdf bytes" {
+		t.Fatalf("attachments not decrypted: %+v", p.media.Uploads())
+	}
+	if len(p.messenger.Images()) != 1 || p.messenger.Images()[0].Image.Body != "Look" {
+		t.Errorf("image must carry the caption: %+v", p.messenger.Images())
+	}
+	if len(p.messenger.Documents()) != 1 || p.messenger.Documents()[0].Document.Body != "" || p.messenger.Documents()[0].Document.Documents[0].FileName != "report.pdf" {
+		t.Errorf("unexpected document: %+v", p.messenger.Documents())
+	}
+	if len(p.messenger.Texts()) != 0 {
+		t.Errorf("caption sent twice: %+v", p.messenger.Texts())
+	}
+}
+
+func TestHandleWebhook_AttachmentFailureKeepsText(t *testing.T) {
+	p := newMSPAccount(t)
+
+	// The file was never stored, so the download fails.
+	m := textMessage("m-1", "Look"+attachmentPlaceholder,
+		attachment{Name: "photo.png", MimeType: "image/png", URL: "mmcs://missing", Owner: testOwner, SignatureBase64: testChecksum, Key: formatFileKey(make([]byte, fileKeySize))},
+	)
+	if err := p.HandleWebhook(webhookContext(testGateID), gzipped(t, m)); err != nil {
+		t.Fatalf("HandleWebhook: %v", err)
+	}
+
+	if len(p.messenger.Texts()) != 1 || p.messenger.Texts()[0].Body != "Look" {
+		t.Errorf("text must be routed: %+v", p.messenger.Texts())
+	}
+}
+
+func TestHandleWebhook_ListPickerReply(t *testing.T) {
+	p := newMSPAccount(t)
+
+	item := encodeItem(keyboardButton("yes", "Yes", "confirm:42"))
+	m := &message{
+		V:             messageVersion,
+		Type:          MessageInteractive,
+		ID:            "m-1",
+		SourceID:      testCustomerID,
+		DestinationID: testBusinessID,
+		InteractiveData: &interactiveData{
+			BID: businessExtensionBID,
+			Data: interactiveContent{
+				Version:           interactiveVersion,
+				RequestIdentifier: "req-1",
+				ListPicker:        &listPicker{Sections: []listSection{{Items: []listItem{{Identifier: item, Title: "Yes"}}}}},
+			},
+		},
+	}
+	if err := p.HandleWebhook(webhookContext(testGateID), gzipped(t, m)); err != nil {
+		t.Fatalf("HandleWebhook: %v", err)
+	}
+
+	if len(p.messenger.Callbacks()) != 1 {
+		t.Fatalf("callbacks = %d, want 1", len(p.messenger.Callbacks()))
+	}
+	got := p.messenger.Callbacks()[0]
+	if got.InReplyTo != "req-1" || got.ButtonCode != "yes" || got.CallbackData != "confirm:42" || got.From.Sub != testCustomerID {
+		t.Errorf("unexpected callback: %+v", got)
+	}
+}
+
+func TestHandleWebhook_TimePickerReplyByRef(t *testing.T) {
+	p := newMSPAccount(t)
+
+	raw, _ := json.Marshal(interactiveData{
+		BID: businessExtensionBID,
+		Data: interactiveContent{
+			Version:           interactiveVersion,
+			RequestIdentifier: "req-2",
+			Event: &event{
+				Identifier: "req-2",
+				Timeslots:  []timeslot{{Identifier: "slot-2", StartTime: "2026-10-20T09:30+0200", Duration: 1800}},
+			},
+		},
+	})
+	ref := p.stub.store(t, "reply", raw)
+	ref.BID = businessExtensionBID
+
+	m := &message{
+		V:                  messageVersion,
+		Type:               MessageInteractive,
+		ID:                 "m-1",
+		SourceID:           testCustomerID,
+		DestinationID:      testBusinessID,
+		InteractiveDataRef: &ref,
+	}
+	if err := p.HandleWebhook(webhookContext(testGateID), gzipped(t, m)); err != nil {
+		t.Fatalf("HandleWebhook: %v", err)
+	}
+
+	if len(p.messenger.Callbacks()) != 1 {
+		t.Fatalf("callbacks = %d, want 1", len(p.messenger.Callbacks()))
+	}
+	got := p.messenger.Callbacks()[0]
+	if got.InReplyTo != "req-2" || got.ButtonCode != "slot-2" || got.CallbackData != "2026-10-20T07:30:00Z" {
+		t.Errorf("unexpected callback: %+v", got)
+	}
+}
+
+func TestHandleWebhook_Dropped(t *testing.T) {
+	otherBusiness := textMessage("m-1", "Hello")
+	otherBusiness.DestinationID = "another-business"
+
+	tests := []struct {
+		name string
+		body []byte
+	}{
+		{name: "another business", body: gzipped(t, otherBusiness)},
+		{name: "malformed", body: []byte("not a message")},
+		{name: "typing", body: gzipped(t, &message{Type: MessageTypingStart, ID: "m-2", SourceID: testCustomerID, DestinationID: testBusinessID})},
+		{name: "close", body: gzipped(t, &message{Type: MessageClose, ID: "m-3", SourceID: testCustomerID, DestinationID: testBusinessID})},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := newMSPAccount(t)
+			if err := p.HandleWebhook(webhookContext(testGateID), tt.body); err != nil {
+				t.Fatalf("HandleWebhook: %v", err)
+			}
+			if len(p.messenger.Texts())+len(p.messenger.Callbacks()) != 0 {
+				t.Errorf("nothing must be routed: %+v %+v", p.messenger.Texts(), p.messenger.Callbacks())
+			}
+		})
+	}
+}
+
+func TestHandleWebhook_Redelivery(t *testing.T) {
+	p := newMSPAccount(t)
+	body := gzipped(t, textMessage("m-1", "Hello"))
+
+	for range 2 {
+		if err := p.HandleWebhook(webhookContext(testGateID), body); err != nil {
+			t.Fatalf("HandleWebhook: %v", err)
+		}
+	}
+	if len(p.messenger.Texts()) != 1 {
+		t.Errorf("redelivered message routed %d times", len(p.messenger.Texts()))
+	}
+}
+
+func TestHandleWebhook_DisabledGate(t *testing.T) {
+	p := newMSPAccount(t)
+	p.businesses[testGateID].Enabled = false
+
+	if err := p.HandleWebhook(webhookContext(testGateID), gzipped(t, textMessage("m-1", "Hello"))); err != nil {
+		t.Fatalf("HandleWebhook: %v", err)
+	}
+	if len(p.messenger.Texts()) != 0 {
+		t.Errorf("disabled gate must drop messages: %+v", p.messenger.Texts())
+	}
+}
@@ -127,6 +127,17 @@ func TestToProtoType(t *testing.T) {
 		{sharedmodel.TypeWhatsApp, impb.ProviderType_PROVIDER_TYPE_WHATSAPP},
 		{sharedmodel.TypeTelegramBot, impb.ProviderType_PROVIDER_TYPE_TELEGRAM_BOT},
 		{sharedmodel.TypeTelegramApp, impb.ProviderType_PROVIDER_TYPE_TELEGRAM_APP},
+		{sharedmodel.TypeViber, impb.ProviderType_PROVIDER_TYPE_VIBER},
+		// The proto has no custom, SMS, email, LINE, Slack, Teams, Apple Messages for Business, web chat or VK provider type yet.
+		{sharedmodel.TypeCustom, impb.ProviderType_PROVIDER_TYPE_UNSPECIFIED},
+		{sharedmodel.TypeSMS, impb.ProviderType_PROVIDER_TYPE_UNSPECIFIED},
+		{sharedmodel.TypeEmail, impb.ProviderType_PROVIDER_TYPE_UNSPECIFIED},
+		{sharedmodel.TypeLine, impb.ProviderType_PROVIDER_TYPE_UNSPECIFIED},
+		{sharedmodel.TypeSlack, impb.ProviderType_PROVIDER_TYPE_UNSPECIFIED},
+		{sharedmodel.TypeTeams, impb.ProviderType_PROVIDER_TYPE_UNSPECIFIED},
+		{sharedmodel.TypeAppleBusiness, impb.ProviderType_PROVIDER_TYPE_UNSPECIFIED},
+		{sharedmodel.TypeWebChat, impb.ProviderType_PROVIDER_TYPE_UNSPECIFIED},
+		{sharedmodel.TypeVK, impb.ProviderType_PROVIDER_TYPE_UNSPECIFIED},
 		{sharedmodel.GateType(99), impb.ProviderType_PROVIDER_TYPE_UNSPECIFIED},
 	}
 	for _, c := range cases {
@@ -6,6 +6,7 @@ import (
 	"log/slog"
 	"time"
 
+	"github.com/google/uuid"
 	lru "github.com/hashicorp/golang-lru/v2"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -229,6 +230,10 @@ func (p *OutboundMessageHandler) SendInteractive(ctx context.Context, req *impb.
 		DomainID:    int64(req.GetDomainId()),
 		Interactive: mapInteractive(req.GetInteractive()),
 	}
+	// The send ID is the internal message ID; providers key button taps by it.
+	if id, err := uuid.Parse(req.GetSendId()); err == nil {
+		msg.ID = id
+	}
 
 	resp, err := is.SendInteractive(ctx, msg)
 	if err != nil {
@@ -243,6 +248,166 @@ func (p *OutboundMessageHandler) SendInteractive(ctx context.Context, req *impb.
 	}, nil
 }
 
+// SendCards handles outgoing rich cards. Providers without card support get the
+// cards as text with links, see provider.SendCards.
+func (p *OutboundMessageHandler) SendCards(ctx context.Context, req *impb.ProviderSendCardsRequest) (*impb.ProviderSendMessageResponse, error) {
+	log := p.logger.With(
+		slog.String("method", "SendCards"),
+		slog.String("gate_id", req.GetGateId()),
+		slog.String("external_user_id", req.GetExternalUserId()),
+	)
+	log.InfoContext(ctx, "outbound cards message request received")
+
+	if req.GetCarousel() == nil && req.GetMediaCard() == nil {
+		return nil, status.Error(codes.InvalidArgument, "carousel or media_card is required")
+	}
+
+	sender, err := p.resolveSender(ctx, req.GetGateId())
+	if err != nil {
+		log.WarnContext(ctx, "failed to resolve sender", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	msg := &sharedmodel.Message{
+		GateID:    req.GetGateId(),
+		To:        sharedmodel.Peer{Sub: req.GetExternalUserId()},
+		Text:      req.GetBody(),
+		DomainID:  int64(req.GetDomainId()),
+		Carousel:  mapCarousel(req.GetCarousel()),
+		MediaCard: mapMediaCard(req.GetMediaCard()),
+	}
+	// The send ID is the internal message ID; providers key button taps by it.
+	if id, err := uuid.Parse(req.GetSendId()); err == nil {
+		msg.ID = id
+	}
+
+	resp, err := provider.SendCards(ctx, sender, msg)
+	if err != nil {
+		log.ErrorContext(ctx, "failed to send cards message", slog.String("error", err.Error()))
+		return nil, toGRPCError(err)
+	}
+
+	log.InfoContext(ctx, "cards message sent", slog.String("external_id", resp.ID))
+	return &impb.ProviderSendMessageResponse{
+		ExternalId: resp.ID,
+		CreatedAt:  time.Now().Unix(),
+	}, nil
+}
+
+// SendLocation handles outgoing locations. Providers without location support
+// answer Unimplemented, see provider.SendLocation.
+func (p *OutboundMessageHandler) SendLocation(ctx context.Context, req *impb.ProviderSendLocationRequest) (*impb.ProviderSendMessageResponse, error) {
+	msg := &sharedmodel.Message{
+		GateID:   req.GetGateId(),
+		To:       sharedmodel.Peer{Sub: req.GetExternalUserId()},
+		DomainID: int64(req.GetDomainId()),
+		Location: &sharedmodel.Location{
+			Latitude:  req.GetLatitude(),
+			Longitude: req.GetLongitude(),
+			Name:      req.GetName(),
+			Address:   req.GetAddress(),
+		},
+	}
+	return p.sendOptional(ctx, "SendLocation", msg, provider.SendLocation)
+}
+
+// SendContact handles outgoing contact cards. Providers without contact support
+// answer Unimplemented, see provider.SendContact.
+func (p *OutboundMessageHandler) SendContact(ctx context.Context, req *impb.ProviderSendContactRequest) (*impb.ProviderSendMessageResponse, error) {
+	contacts := make([]*sharedmodel.ContactCard, 0, len(req.GetContacts()))
+	for _, c := range req.GetContacts() {
+		contacts = append(contacts, &sharedmodel.ContactCard{
+			FirstName: c.GetFirstName(),
+			LastName:  c.GetLastName(),
+			Company:   c.GetCompany(),
+			Phones:    c.GetPhones(),
+			Emails:    c.GetEmails(),
+		})
+	}
+
+	msg := &sharedmodel.Message{
+		GateID:   req.GetGateId(),
+		To:       sharedmodel.Peer{Sub: req.GetExternalUserId()},
+		DomainID: int64(req.GetDomainId()),
+		Contacts: contacts,
+	}
+	return p.sendOptional(ctx, "SendContact", msg, provider.SendContact)
+}
+
+// SendReaction handles outgoing reactions. Providers without reaction support
+// answer Unimplemented, see provider.SendReaction.
+func (p *OutboundMessageHandler) SendReaction(ctx context.Context, req *impb.ProviderSendReactionRequest) (*impb.ProviderSendMessageResponse, error) {
+	msg := &sharedmodel.Message{
+		GateID:   req.GetGateId(),
+		To:       sharedmodel.Peer{Sub: req.GetExternalUserId()},
+		DomainID: int64(req.GetDomainId()),
+		Reaction: &sharedmodel.Reaction{MessageID: req.GetMessageId(), Emoji: req.GetEmoji()},
+	}
+	return p.sendOptional(ctx, "SendReaction", msg, provider.SendReaction)
+}
+
+// sendOptional resolves the gate provider and delivers msg through send, one of the
+// provider helpers for optional message kinds.
+func (p *OutboundMessageHandler) sendOptional(
+	ctx context.Context,
+	method string,
+	msg *sharedmodel.Message,
+	send func(context.Context, provider.Sender, *sharedmodel.Message) (*sharedmodel.MessageResponse, error),
+) (*impb.ProviderSendMessageResponse, error) {
+	log := p.logger.With(
+		slog.String("method", method),
+		slog.String("gate_id", msg.GateID),
+		slog.String("external_user_id", msg.To.Sub),
+	)
+	log.InfoContext(ctx, "outbound message request received")
+
+	sender, err := p.resolveSender(ctx, msg.GateID)
+	if err != nil {
+		log.WarnContext(ctx, "failed to resolve sender", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	resp, err := send(ctx, sender, msg)
+	if err != nil {
+		log.ErrorContext(ctx, "failed to send message", slog.String("error", err.Error()))
+		return nil, toGRPCError(err)
+	}
+
+	log.InfoContext(ctx, "message sent", slog.String("external_id", resp.ID))
+	return &impb.ProviderSendMessageResponse{
+		ExternalId: resp.ID,
+		CreatedAt:  time.Now().Unix(),
+	}, nil
+}
+
+func mapCarousel(pb *impb.ProviderCarousel) *sharedmodel.Carousel {
+	if pb == nil {
+		return nil
+	}
+	cards := make([]sharedmodel.Card, 0, len(pb.GetCards()))
+	for _, c := range pb.GetCards() {
+		cards = append(cards, sharedmodel.Card{
+			Title:      c.GetTitle(),
+			Subtitle:   c.GetSubtitle(),
+			ImageURL:   c.GetImageUrl(),
+			DefaultURL: c.GetDefaultUrl(),
+			Buttons:    mapButtons(c.GetButtons()),
+		})
+	}
+	return &sharedmodel.Carousel{Cards: cards, SquareImages: pb.GetSquareImages()}
+}
+
+func mapMediaCard(pb *impb.ProviderMediaCard) *sharedmodel.MediaCard {
+	if pb == nil {
+		return nil
+	}
+	return &sharedmodel.MediaCard{
+		MediaType: pb.GetMediaType(),
+		URL:       pb.GetUrl(),
+		Buttons:   mapButtons(pb.GetButtons()),
+	}
+}
+
 func mapInteractive(pb *impb.ProviderInteractive) *sharedmodel.Interactive {
 	if pb == nil {
 		return nil
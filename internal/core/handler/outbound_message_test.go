@@ -0,0 +1,236 @@
+package handler
+
+import (
+	"context"
+	"testing"
+
+	impb "github.com/webitel/im-providers-service/gen/go/provider/v1"
+	sharedmodel "github.com/webitel/im-providers-service/internal/core/model"
+	"github.com/webitel/im-providers-service/internal/provider"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// -- fakes --
+
+type stubGateStore struct {
+	gateType sharedmodel.GateType
+}
+
+func (s *stubGateStore) List(context.Context, sharedmodel.ListFilter) ([]*sharedmodel.GateSummary, bool, error) {
+	return nil, false, nil
+}
+func (s *stubGateStore) Delete(context.Context, string) error { return nil }
+func (s *stubGateStore) GetTypeByID(context.Context, string) (sharedmodel.GateType, error) {
+	return s.gateType, nil
+}
+
+// textProvider supports plain messages only.
+type textProvider struct {
+	texts []*sharedmodel.Message
+}
+
+func (p *textProvider) Type() string { return sharedmodel.TypeCustom.String() }
+func (p *textProvider) SendText(_ context.Context, req *sharedmodel.Message) (*sharedmodel.MessageResponse, error) {
+	p.texts = append(p.texts, req)
+	return &sharedmodel.MessageResponse{ID: "text-1"}, nil
+}
+func (p *textProvider) SendImage(context.Context, *sharedmodel.Message) (*sharedmodel.MessageResponse, error) {
+	return &sharedmodel.MessageResponse{}, nil
+}
+func (p *textProvider) SendDocument(context.Context, *sharedmodel.Message) (*sharedmodel.MessageResponse, error) {
+	return &sharedmodel.MessageResponse{}, nil
+}
+func (p *textProvider) HandleWebhook(context.Context, []byte) error { return nil }
+
+// cardProvider renders cards natively.
+type cardProvider struct {
+	textProvider
+	cards []*sharedmodel.Message
+}
+
+func (p *cardProvider) SendCards(_ context.Context, req *sharedmodel.Message) (*sharedmodel.MessageResponse, error) {
+	p.cards = append(p.cards, req)
+	return &sharedmodel.MessageResponse{ID: "cards-1"}, nil
+}
+
+// shareProvider sends locations, contacts and reactions natively.
+type shareProvider struct {
+	textProvider
+	sent []*sharedmodel.Message
+}
+
+func (p *shareProvider) SendLocation(_ context.Context, req *sharedmodel.Message) (*sharedmodel.MessageResponse, error) {
+	p.sent = append(p.sent, req)
+	return &sharedmodel.MessageResponse{ID: "location-1"}, nil
+}
+
+func (p *shareProvider) SendContact(_ context.Context, req *sharedmodel.Message) (*sharedmodel.MessageResponse, error) {
+	p.sent = append(p.sent, req)
+	return &sharedmodel.MessageResponse{ID: "contact-1"}, nil
+}
+
+func (p *shareProvider) SendReaction(_ context.Context, req *sharedmodel.Message) (*sharedmodel.MessageResponse, error) {
+	p.sent = append(p.sent, req)
+	return &sharedmodel.MessageResponse{ID: "reaction-1"}, nil
+}
+
+func newOutboundHandler(p provider.Provider) *OutboundMessageHandler {
+	return NewOutboundMessageHandler(noopLogger, provider.NewRegistry([]provider.Provider{p}), &stubGateStore{gateType: sharedmodel.TypeCustom}, nil)
+}
+
+// -- tests --
+
+func TestSendCards_Carousel(t *testing.T) {
+	p := &cardProvider{}
+	h := newOutboundHandler(p)
+
+	sendID := "0b7f6a53-59c2-4f0e-9a53-2d3c4d1b6f10"
+	resp, err := h.SendCards(context.Background(), &impb.ProviderSendCardsRequest{
+		GateId:         "gate-1",
+		ExternalUserId: "user-1",
+		SendId:         &sendID,
+		Kind: &impb.ProviderSendCardsRequest_Carousel{Carousel: &impb.ProviderCarousel{
+			SquareImages: true,
+			Cards: []*impb.ProviderCard{{
+				Title:      "Shoes",
+				Subtitle:   "Size 42",
+				ImageUrl:   "https://example.com/shoes.png",
+				DefaultUrl: "https://example.com/shoes",
+				Buttons: []*impb.ProviderKeyboardButton{{
+					Id:    "buy",
+					Label: "Buy",
+					Kind:  &impb.ProviderKeyboardButton_Callback{Callback: &impb.ProviderKeyboardButtonCallback{Data: "BUY"}},
+				}},
+			}},
+		}},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if resp.ExternalId != "cards-1" {
+		t.Errorf("unexpected external id: %s", resp.ExternalId)
+	}
+	if len(p.cards) != 1 {
+		t.Fatalf("expected 1 cards message, got %d", len(p.cards))
+	}
+
+	msg := p.cards[0]
+	if msg.ID.String() != sendID {
+		t.Errorf("expected message ID %s, got %s", sendID, msg.ID)
+	}
+	if msg.Carousel == nil || !msg.Carousel.SquareImages || len(msg.Carousel.Cards) != 1 {
+		t.Fatalf("unexpected carousel: %+v", msg.Carousel)
+	}
+	card := msg.Carousel.Cards[0]
+	if card.Title != "Shoes" || card.ImageURL != "https://example.com/shoes.png" || card.DefaultURL != "https://example.com/shoes" {
+		t.Errorf("unexpected card: %+v", card)
+	}
+	if len(card.Buttons) != 1 || card.Buttons[0].Callback == nil || card.Buttons[0].Callback.Data != "BUY" {
+		t.Errorf("unexpected buttons: %+v", card.Buttons)
+	}
+}
+
+func TestSendCards_FallsBackToText(t *testing.T) {
+	p := &textProvider{}
+	h := newOutboundHandler(p)
+
+	_, err := h.SendCards(context.Background(), &impb.ProviderSendCardsRequest{
+		GateId:         "gate-1",
+		ExternalUserId: "user-1",
+		Kind: &impb.ProviderSendCardsRequest_MediaCard{MediaCard: &impb.ProviderMediaCard{
+			MediaType: sharedmodel.MediaCardVideo,
+			Url:       "https://example.com/promo.mp4",
+		}},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(p.texts) != 1 || p.texts[0].Text != "https://example.com/promo.mp4" {
+		t.Fatalf("expected the media link as text, got %+v", p.texts)
+	}
+}
+
+func TestSendCards_RequiresCards(t *testing.T) {
+	h := newOutboundHandler(&cardProvider{})
+
+	_, err := h.SendCards(context.Background(), &impb.ProviderSendCardsRequest{GateId: "gate-1"})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("expected InvalidArgument, got %v", err)
+	}
+}
+
+func TestSendLocation(t *testing.T) {
+	p := &shareProvider{}
+	h := newOutboundHandler(p)
+
+	resp, err := h.SendLocation(context.Background(), &impb.ProviderSendLocationRequest{
+		GateId:         "gate-1",
+		ExternalUserId: "user-1",
+		Latitude:       50.4501,
+		Longitude:      30.5234,
+		Name:           "Head office",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if resp.ExternalId != "location-1" {
+		t.Errorf("unexpected external id: %s", resp.ExternalId)
+	}
+	if len(p.sent) != 1 || p.sent[0].Location == nil {
+		t.Fatalf("expected a location message, got %+v", p.sent)
+	}
+	if loc := p.sent[0].Location; loc.Latitude != 50.4501 || loc.Longitude != 30.5234 || loc.Name != "Head office" {
+		t.Errorf("unexpected location: %+v", loc)
+	}
+}
+
+func TestSendContact(t *testing.T) {
+	p := &shareProvider{}
+	h := newOutboundHandler(p)
+
+	_, err := h.SendContact(context.Background(), &impb.ProviderSendContactRequest{
+		GateId:   "gate-1",
+		Contacts: []*impb.ProviderContactCard{{FirstName: "Olena", Phones: []string{"+380501234567"}}},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(p.sent) != 1 || len(p.sent[0].Contacts) != 1 {
+		t.Fatalf("expected a contact message, got %+v", p.sent)
+	}
+	if c := p.sent[0].Contacts[0]; c.FirstName != "Olena" || len(c.Phones) != 1 || c.Phones[0] != "+380501234567" {
+		t.Errorf("unexpected contact: %+v", c)
+	}
+}
+
+func TestSendReaction(t *testing.T) {
+	p := &shareProvider{}
+	h := newOutboundHandler(p)
+
+	_, err := h.SendReaction(context.Background(), &impb.ProviderSendReactionRequest{GateId: "gate-1", MessageId: "wamid.1", Emoji: "👍"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(p.sent) != 1 || *p.sent[0].Reaction != (sharedmodel.Reaction{MessageID: "wamid.1", Emoji: "👍"}) {
+		t.Fatalf("expected a reaction message, got %+v", p.sent)
+	}
+}
+
+func TestSendOptional_Unsupported(t *testing.T) {
+	h := newOutboundHandler(&textProvider{})
+	ctx := context.Background()
+
+	_, err := h.SendLocation(ctx, &impb.ProviderSendLocationRequest{GateId: "gate-1"})
+	if status.Code(err) != codes.Unimplemented {
+		t.Errorf("SendLocation: expected Unimplemented, got %v", err)
+	}
+	_, err = h.SendReaction(ctx, &impb.ProviderSendReactionRequest{GateId: "gate-1", MessageId: "m-1"})
+	if status.Code(err) != codes.Unimplemented {
+		t.Errorf("SendReaction: expected Unimplemented, got %v", err)
+	}
+	_, err = h.SendContact(ctx, &impb.ProviderSendContactRequest{GateId: "gate-1"})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("SendContact without contacts: expected InvalidArgument, got %v", err)
+	}
+}
@@ -0,0 +1,79 @@
+package model
+
+import "strings"
+
+// Card is a rich card: an optional image, a title, a subtitle and buttons.
+type Card struct {
+	Title    string `json:"title"`
+	Subtitle string `json:"subtitle,omitempty"`
+	ImageURL string `json:"image_url,omitempty"`
+	// DefaultURL is opened when the card itself is tapped.
+	DefaultURL string           `json:"default_url,omitempty"`
+	Buttons    []KeyboardButton `json:"buttons,omitempty"`
+}
+
+// Carousel is a horizontally scrollable set of cards. A single card is a carousel of one.
+type Carousel struct {
+	Cards []Card `json:"cards"`
+	// SquareImages renders card images 1:1 instead of the landscape 1.91:1 default.
+	SquareImages bool `json:"square_images,omitempty"`
+}
+
+// Media types for MediaCard.
+const (
+	MediaCardImage = "image"
+	MediaCardVideo = "video"
+)
+
+// MediaCard is an image or video with buttons and no text.
+type MediaCard struct {
+	MediaType string           `json:"media_type"`
+	URL       string           `json:"url"`
+	Buttons   []KeyboardButton `json:"buttons,omitempty"`
+}
+
+// Buttons returns every button of every card in order.
+func (c *Carousel) Buttons() []KeyboardButton {
+	var out []KeyboardButton
+	for _, card := range c.Cards {
+		out = append(out, card.Buttons...)
+	}
+	return out
+}
+
+// PlainText renders the cards as text with links, for providers without card support.
+// Callback buttons cannot be tapped in plain text, so only their labels are listed.
+func (c *Carousel) PlainText() string {
+	blocks := make([]string, 0, len(c.Cards))
+	for _, card := range c.Cards {
+		var lines []string
+		for _, s := range []string{card.Title, card.Subtitle, card.DefaultURL} {
+			if s != "" {
+				lines = append(lines, s)
+			}
+		}
+		if card.DefaultURL == "" && card.ImageURL != "" {
+			lines = append(lines, card.ImageURL)
+		}
+		lines = append(lines, buttonLines(card.Buttons)...)
+		blocks = append(blocks, strings.Join(lines, "\n"))
+	}
+	return strings.Join(blocks, "\n\n")
+}
+
+// PlainText renders the media link followed by the buttons.
+func (m *MediaCard) PlainText() string {
+	return strings.Join(append([]string{m.URL}, buttonLines(m.Buttons)...), "\n")
+}
+
+func buttonLines(buttons []KeyboardButton) []string {
+	lines := make([]string, 0, len(buttons))
+	for _, b := range buttons {
+		if b.URL != nil {
+			lines = append(lines, "• "+b.Label+": "+b.URL.URL)
+			continue
+		}
+		lines = append(lines, "• "+b.Label)
+	}
+	return lines
+}
@@ -15,12 +15,22 @@ type (
 )
 
 const (
-	TypeUnknown     GateType = iota // unknown
-	TypeFacebook                    // facebook
-	TypeInstagram                   // instagram
-	TypeWhatsApp                    // whatsapp
-	TypeTelegramBot                 // telegram_bot
-	TypeTelegramApp                 // telegram_app
+	TypeUnknown       GateType = iota // unknown
+	TypeFacebook                      // facebook
+	TypeInstagram                     // instagram
+	TypeWhatsApp                      // whatsapp
+	TypeTelegramBot                   // telegram_bot
+	TypeTelegramApp                   // telegram_app
+	TypeViber                         // viber
+	TypeCustom                        // custom
+	TypeSMS                           // sms
+	TypeEmail                         // email
+	TypeLine                          // line
+	TypeSlack                         // slack
+	TypeTeams                         // teams
+	TypeAppleBusiness                 // apple_business
+	TypeWebChat                       // webchat
+	TypeVK                            // vk
 )
 
 const (
@@ -111,11 +121,21 @@ func ParseGateType(s string) GateType {
 	val := strings.ToLower(strings.TrimSpace(s))
 	// Internal mapping
 	m := map[string]GateType{
-		"facebook":     TypeFacebook,
-		"instagram":    TypeInstagram,
-		"whatsapp":     TypeWhatsApp,
-		"telegram_bot": TypeTelegramBot,
-		"telegram_app": TypeTelegramApp,
+		"facebook":       TypeFacebook,
+		"instagram":      TypeInstagram,
+		"whatsapp":       TypeWhatsApp,
+		"telegram_bot":   TypeTelegramBot,
+		"telegram_app":   TypeTelegramApp,
+		"viber":          TypeViber,
+		"custom":         TypeCustom,
+		"sms":            TypeSMS,
+		"email":          TypeEmail,
+		"line":           TypeLine,
+		"slack":          TypeSlack,
+		"teams":          TypeTeams,
+		"apple_business": TypeAppleBusiness,
+		"webchat":        TypeWebChat,
+		"vk":             TypeVK,
 	}
 	if v, ok := m[val]; ok {
 		return v
@@ -14,11 +14,21 @@ func _() {
 	_ = x[TypeWhatsApp-3]
 	_ = x[TypeTelegramBot-4]
 	_ = x[TypeTelegramApp-5]
+	_ = x[TypeViber-6]
+	_ = x[TypeCustom-7]
+	_ = x[TypeSMS-8]
+	_ = x[TypeEmail-9]
+	_ = x[TypeLine-10]
+	_ = x[TypeSlack-11]
+	_ = x[TypeTeams-12]
+	_ = x[TypeAppleBusiness-13]
+	_ = x[TypeWebChat-14]
+	_ = x[TypeVK-15]
 }
 
-const _GateType_name = "unknownfacebookinstagramwhatsapptelegram_bottelegram_app"
+const _GateType_name = "unknownfacebookinstagramwhatsapptelegram_bottelegram_appvibercustomsmsemaillineslackteamsapple_businesswebchatvk"
 
-var _GateType_index = [...]uint8{0, 7, 15, 24, 32, 44, 56}
+var _GateType_index = [...]uint8{0, 7, 15, 24, 32, 44, 56, 61, 67, 70, 75, 79, 84, 89, 103, 110, 112}
 
 func (i GateType) String() string {
 	if i < 0 || i >= GateType(len(_GateType_index)-1) {
@@ -6,54 +6,91 @@ import (
 
 // Interactive represents a rich message with interactive UI elements.
 type Interactive struct {
-	Body      string
-	SingleUse bool
-	Markup    *KeyboardMarkup
-	ListReply *KeyboardListReply
+	Body      string             `json:"body,omitempty"`
+	SingleUse bool               `json:"single_use,omitempty"`
+	Markup    *KeyboardMarkup    `json:"markup,omitempty"`
+	ListReply *KeyboardListReply `json:"list_reply,omitempty"`
+	Flow      *InteractiveFlow   `json:"flow,omitempty"`
+	// TimePicker offers time slots to pick from, see InteractiveTimePicker.
+	TimePicker *InteractiveTimePicker `json:"time_picker,omitempty"`
+}
+
+// InteractiveFlow opens a WhatsApp Flow (a multi-screen form) from a call-to-action button.
+type InteractiveFlow struct {
+	FlowID string `json:"flow_id"`
+	// Token is echoed back in the flow completion callback; the message ID is used when empty.
+	Token string `json:"token,omitempty"`
+	CTA   string `json:"cta"`
+	// Screen is the first screen to navigate to with Data; without a screen the flow
+	// starts by a data exchange with the flow endpoint.
+	Screen string         `json:"screen,omitempty"`
+	Data   map[string]any `json:"data,omitempty"`
+	// Draft sends the unpublished version of the flow, for testing.
+	Draft bool `json:"draft,omitempty"`
+}
+
+// InteractiveTimePicker offers time slots to pick one from (e.g. an appointment).
+// The picked slot is delivered as an interactive callback with the slot ID as the
+// button code and its start time (RFC 3339) as the callback data.
+type InteractiveTimePicker struct {
+	Title string     `json:"title"`
+	Slots []TimeSlot `json:"slots"`
+	// TimezoneOffset is the offset from UTC, in minutes, the slots are shown in;
+	// the device time zone is used when zero.
+	TimezoneOffset int `json:"timezone_offset,omitempty"`
+}
+
+// TimeSlot is a time slot of a time picker.
+type TimeSlot struct {
+	ID string `json:"id"`
+	// Start is the start of the slot in Unix seconds.
+	Start int64 `json:"start"`
+	// Duration is the length of the slot in seconds.
+	Duration int64 `json:"duration"`
 }
 
 // KeyboardMarkup is a grid of button rows.
 type KeyboardMarkup struct {
-	Rows []KeyboardRow
+	Rows []KeyboardRow `json:"rows"`
 }
 
 // KeyboardListReply is a list-style menu with titled sections.
 type KeyboardListReply struct {
-	MainButtonTitle string
-	Sections        []KeyboardRowWithSection
+	MainButtonTitle string                   `json:"main_button_title"`
+	Sections        []KeyboardRowWithSection `json:"sections"`
 }
 
 // KeyboardRow is a horizontal row of buttons.
 type KeyboardRow struct {
-	Buttons []KeyboardButton
+	Buttons []KeyboardButton `json:"buttons"`
 }
 
 // KeyboardRowWithSection is a labeled group of buttons.
 type KeyboardRowWithSection struct {
-	Section string
-	Buttons []KeyboardButton
+	Section string           `json:"section"`
+	Buttons []KeyboardButton `json:"buttons"`
 }
 
 // KeyboardButton is a single interactive element.
 type KeyboardButton struct {
-	ID       string
-	Label    string
-	URL      *KeyboardButtonURL
-	Callback *KeyboardButtonCallback
-	Request  *KeyboardButtonRequest
+	ID       string                  `json:"id,omitempty"`
+	Label    string                  `json:"label"`
+	URL      *KeyboardButtonURL      `json:"url,omitempty"`
+	Callback *KeyboardButtonCallback `json:"callback,omitempty"`
+	Request  *KeyboardButtonRequest  `json:"request,omitempty"`
 }
 
 type KeyboardButtonURL struct {
-	URL string
+	URL string `json:"url"`
 }
 
 type KeyboardButtonCallback struct {
-	Data string
+	Data string `json:"data"`
 }
 
 // KeyboardButtonRequest prompts the user for device data (e.g. location, phone, email).
 type KeyboardButtonRequest struct {
-	Action string
+	Action string `json:"action"`
 }
 
 // SendInteractiveCallbackRequest is forwarded when a user clicks an interactive button.
@@ -66,6 +103,18 @@ type SendInteractiveCallbackRequest struct {
 	CallbackData string
 }
 
+// SendSystemMessageRequest delivers a provider event with structured metadata
+// (e.g. an order or a customer number change) into the conversation.
+type SendSystemMessageRequest struct {
+	From       Peer
+	To         Peer
+	DomainID   int64
+	Type       string
+	Body       string
+	Metadata   map[string]any
+	ExternalID string
+}
+
 // MessageResponse represents the common return value for provider send operations.
 type MessageResponse struct {
 	ID string         `json:"id"`
@@ -87,6 +136,13 @@ type Message struct {
 	Documents   []*Document  `json:"documents,omitempty"`
 	Images      []*Image     `json:"images,omitempty"`
 	Interactive *Interactive `json:"interactive,omitempty"`
+	// Carousel and MediaCard are rich-card payloads, see provider.CardSender.
+	Carousel  *Carousel  `json:"carousel,omitempty"`
+	MediaCard *MediaCard `json:"media_card,omitempty"`
+	// Location, Contacts and Reaction are sent by the optional provider senders, see provider.LocationSender.
+	Location *Location      `json:"location,omitempty"`
+	Contacts []*ContactCard `json:"contacts,omitempty"`
+	Reaction *Reaction      `json:"reaction,omitempty"`
 }
 
 // SendTextRequest defines the payload for sending a plain text message.
@@ -0,0 +1,82 @@
+package model
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Location is a point on the map shared in a chat.
+type Location struct {
+	Latitude  float64 `json:"latitude"`
+	Longitude float64 `json:"longitude"`
+	Name      string  `json:"name,omitempty"`
+	Address   string  `json:"address,omitempty"`
+}
+
+// MapURL links the location on Google Maps.
+func (l *Location) MapURL() string {
+	return "https://maps.google.com/?q=" +
+		strconv.FormatFloat(l.Latitude, 'f', -1, 64) + "," +
+		strconv.FormatFloat(l.Longitude, 'f', -1, 64)
+}
+
+// PlainText renders the location as its name, address and map link, for providers without location support.
+func (l *Location) PlainText() string {
+	return joinNonEmpty(l.Name, l.Address, l.MapURL())
+}
+
+// ContactCard is a person's contact details shared in a chat.
+type ContactCard struct {
+	FirstName string   `json:"first_name,omitempty"`
+	LastName  string   `json:"last_name,omitempty"`
+	Company   string   `json:"company,omitempty"`
+	Phones    []string `json:"phones,omitempty"`
+	Emails    []string `json:"emails,omitempty"`
+}
+
+// FormattedName is the full name, falling back to the company or the first phone.
+func (c *ContactCard) FormattedName() string {
+	if name := strings.TrimSpace(c.FirstName + " " + c.LastName); name != "" {
+		return name
+	}
+	if c.Company != "" {
+		return c.Company
+	}
+	if len(c.Phones) > 0 {
+		return c.Phones[0]
+	}
+	return ""
+}
+
+// PlainText renders the contact as text lines, for providers without contact card support.
+func (c *ContactCard) PlainText() string {
+	lines := []string{c.FormattedName()}
+	if c.Company != "" && c.Company != lines[0] {
+		lines = append(lines, c.Company)
+	}
+	for _, phone := range c.Phones {
+		lines = append(lines, fmt.Sprintf("Phone: %s", phone))
+	}
+	for _, email := range c.Emails {
+		lines = append(lines, fmt.Sprintf("Email: %s", email))
+	}
+	return joinNonEmpty(lines...)
+}
+
+// Reaction is an emoji reaction to a message of the chat. An empty Emoji removes the reaction.
+type Reaction struct {
+	// MessageID is the provider ID of the message reacted to.
+	MessageID string `json:"message_id"`
+	Emoji     string `json:"emoji"`
+}
+
+func joinNonEmpty(parts ...string) string {
+	lines := make([]string, 0, len(parts))
+	for _, s := range parts {
+		if s != "" {
+			lines = append(lines, s)
+		}
+	}
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,15 @@
+package model
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ValidationError is returned when a request is missing one or more required fields.
+type ValidationError struct {
+	Fields []string
+}
+
+func (e *ValidationError) Error() string {
+	return fmt.Sprintf("required fields missing: %s", strings.Join(e.Fields, ", "))
+}
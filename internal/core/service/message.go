@@ -21,6 +21,7 @@ type Messenger interface {
 	SendLocation(ctx context.Context, in *sharedmodel.SendLocationRequest) (*sharedmodel.SendResponse, error)
 	SendContact(ctx context.Context, in *sharedmodel.SendContactRequest) (*sharedmodel.SendResponse, error)
 	SendInteractiveCallback(ctx context.Context, in *sharedmodel.SendInteractiveCallbackRequest) error
+	SendSystemMessage(ctx context.Context, in *sharedmodel.SendSystemMessageRequest) (*sharedmodel.SendResponse, error)
 }
 
 type messageService struct {
@@ -194,6 +195,29 @@ func (m *messageService) SendInteractiveCallback(ctx context.Context, in *shared
 	return nil
 }
 
+func (m *messageService) SendSystemMessage(ctx context.Context, in *sharedmodel.SendSystemMessageRequest) (*sharedmodel.SendResponse, error) {
+	systemMetadata, err := structpb.NewStruct(in.Metadata)
+	if err != nil {
+		return nil, errors.InvalidArgument("converting model metadata to structb", errors.WithCause(err), errors.WithID("service.message.send_system_message"))
+	}
+
+	resp, err := m.gatewayer.SendSystemMessage(ctx, &gatewayv1.SendSystemMessageRequest{
+		To:       transformDomainPeerIntoPB(in.To),
+		Type:     in.Type,
+		Body:     in.Body,
+		Metadata: systemMetadata,
+		SendId:   in.ExternalID,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, errors.WithID("service.message.send_system_message"))
+	}
+
+	return &sharedmodel.SendResponse{
+		ID: m.parseUUID(resp.GetId()),
+		To: in.To,
+	}, nil
+}
+
 func (m *messageService) parseUUID(id string) uuid.UUID {
 	if id == "" {
 		return uuid.Nil
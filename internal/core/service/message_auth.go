@@ -35,3 +35,7 @@ func (m *messengerAuthMiddleware) SendDocument(ctx context.Context, in *sharedmo
 func (m *messengerAuthMiddleware) SendInteractiveCallback(ctx context.Context, in *sharedmodel.SendInteractiveCallbackRequest) error {
 	return m.Messenger.SendInteractiveCallback(m.withIdentity(ctx, in.DomainID, in.From.Sub), in)
 }
+
+func (m *messengerAuthMiddleware) SendSystemMessage(ctx context.Context, in *sharedmodel.SendSystemMessageRequest) (*sharedmodel.SendResponse, error) {
+	return m.Messenger.SendSystemMessage(m.withIdentity(ctx, in.DomainID, in.From.Sub), in)
+}
@@ -10,6 +10,9 @@ import (
 	"github.com/webitel/im-providers-service/internal/provider"
 )
 
+// defaultSignatureHeader carries the webhook signature of Meta platforms.
+const defaultSignatureHeader = "X-Hub-Signature-256"
+
 type Handler struct {
 	logger    *slog.Logger
 	providers map[string]provider.Provider
@@ -68,8 +71,19 @@ func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 
 	ctx := context.WithValue(r.Context(), provider.WebhookURIKey, uri)
 
-	if sv, ok := p.(provider.SignatureValidator); ok {
-		sig := r.Header.Get("X-Hub-Signature-256")
+	if rv, ok := p.(provider.RequestValidator); ok {
+		if err := rv.ValidateRequest(ctx, r.Header, body); err != nil {
+			h.logger.Warn("request validation failed", "provider", pType, "uri", uri, "err", err)
+			http.Error(w, "forbidden", http.StatusForbidden)
+			return
+		}
+	} else if sv, ok := p.(provider.SignatureValidator); ok {
+		header := defaultSignatureHeader
+		if sh, ok := p.(provider.SignatureHeader); ok {
+			header = sh.SignatureHeader()
+		}
+
+		sig := r.Header.Get(header)
 		if err := sv.ValidateSignature(ctx, sig, body); err != nil {
 			h.logger.Warn("signature validation failed", "provider", pType, "uri", uri, "err", err)
 			http.Error(w, "forbidden", http.StatusForbidden)
@@ -77,11 +91,27 @@ func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 
+	// [POST] Verification (for Slack/etc.), answered once the request is authenticated.
+	if hs, ok := p.(provider.Handshaker); ok {
+		if reply, ok := hs.Handshake(ctx, body); ok {
+			w.Header().Set("Content-Type", "text/plain")
+			w.WriteHeader(http.StatusOK)
+			_, _ = w.Write([]byte(reply))
+			return
+		}
+	}
+
 	if err := p.HandleWebhook(ctx, body); err != nil {
 		h.logger.Error("processing failed", "provider", pType, "uri", uri, "error", err)
 		http.Error(w, err.Error(), http.StatusInternalServerError)
 		return
 	}
 
+	if ack, ok := p.(provider.Acknowledger); ok {
+		w.Header().Set("Content-Type", "text/plain")
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(ack.Acknowledgement()))
+		return
+	}
 	w.WriteHeader(http.StatusOK)
 }
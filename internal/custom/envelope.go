@@ -0,0 +1,69 @@
+package custom
+
+import (
+	sharedmodel "github.com/webitel/im-providers-service/internal/core/model"
+)
+
+// Envelope events.
+const (
+	// EventMessage carries a message: from the user inbound, to the user outbound.
+	EventMessage = "message"
+	// EventCallback reports a tap on a button of an outbound interactive message.
+	EventCallback = "callback"
+)
+
+// Envelope is the JSON document exchanged with the channel in both directions.
+// Inbound envelopes are posted to the gate webhook, outbound ones to the gate
+// callback URL; both carry the signature header.
+//
+//	{
+//	  "id": "msg-1",
+//	  "event": "message",
+//	  "timestamp": 1721800000000,
+//	  "user": {"id": "visitor-42", "name": "Jane", "metadata": {"plan": "pro"}},
+//	  "message": {
+//	    "text": "Hi!",
+//	    "images": [{"url": "https://cdn.example.com/a.jpg", "file_name": "a.jpg"}],
+//	    "documents": [{"url": "https://cdn.example.com/cv.pdf", "file_name": "cv.pdf"}],
+//	    "location": {"latitude": 50.45, "longitude": 30.52, "name": "Office"},
+//	    "contacts": [{"first_name": "Ivan", "phones": ["+380671234567"]}]
+//	  }
+//	}
+//
+// A callback envelope replaces the message with
+// {"callback": {"in_reply_to": "<outbound message id>", "button_code": "yes"}}.
+// Outbound envelopes carry the whole message, including interactive
+// keyboards, cards and reactions; the channel renders what it supports.
+type Envelope struct {
+	// ID is the channel message ID inbound and the internal message ID outbound.
+	ID    string `json:"id"`
+	Event string `json:"event"`
+	// GateID is set on outbound envelopes.
+	GateID string `json:"gate_id,omitempty"`
+	// Timestamp is in Unix milliseconds.
+	Timestamp int64 `json:"timestamp"`
+	// User is the channel user: the sender inbound and the receiver outbound.
+	User     User                 `json:"user"`
+	Message  *sharedmodel.Message `json:"message,omitempty"`
+	Callback *Callback            `json:"callback,omitempty"`
+}
+
+// User is a user of the channel.
+type User struct {
+	ID       string            `json:"id"`
+	Name     string            `json:"name,omitempty"`
+	Metadata map[string]string `json:"metadata,omitempty"`
+}
+
+// Callback is a button tap.
+type Callback struct {
+	InReplyTo  string `json:"in_reply_to"`
+	ButtonCode string `json:"button_code"`
+	Data       string `json:"data,omitempty"`
+}
+
+// deliveryResponse is the optional body the callback URL answers with.
+type deliveryResponse struct {
+	// ID is the channel message ID of the delivered message.
+	ID string `json:"id"`
+}
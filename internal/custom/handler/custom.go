@@ -0,0 +1,123 @@
+package handler
+
+import (
+	"context"
+	"log/slog"
+
+	impb "github.com/webitel/im-providers-service/gen/go/provider/v1"
+	cmodel "github.com/webitel/im-providers-service/internal/custom/model"
+	cservice "github.com/webitel/im-providers-service/internal/custom/service"
+	"github.com/webitel/im-providers-service/internal/provider/gaterpc"
+)
+
+type CustomHandler struct {
+	logger *slog.Logger
+	srv    cservice.CustomManager
+	impb.UnimplementedCustomServiceServer
+}
+
+func NewCustomHandler(logger *slog.Logger, srv cservice.CustomManager) *CustomHandler {
+	return &CustomHandler{logger: logger, srv: srv}
+}
+
+// CreateCustomGate returns the secret of the new gate next to it: the channel signs its
+// envelopes with it, and it is not readable afterwards.
+func (h *CustomHandler) CreateCustomGate(ctx context.Context, req *impb.ProviderCreateCustomGateRequest) (*impb.ProviderCreateCustomGateResponse, error) {
+	domainID, err := gaterpc.DomainID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	create := cmodel.CreateCustom{
+		Name:        req.GetName(),
+		Dc:          domainID,
+		CallbackURL: req.GetCallbackUrl(),
+		Secret:      req.GetSecret(),
+	}
+	if peer := gaterpc.Peer(req.GetPeer()); peer != nil {
+		create.Peer = *peer
+	}
+
+	gate, err := h.srv.CreateGate(ctx, create)
+	if err != nil {
+		return nil, gaterpc.ToStatus(err, "create gate")
+	}
+
+	return &impb.ProviderCreateCustomGateResponse{Item: gateToProto(gate), Secret: gate.Secret}, nil
+}
+
+func (h *CustomHandler) GetCustomGate(ctx context.Context, req *impb.ProviderGetCustomGateRequest) (*impb.ProviderGetCustomGateResponse, error) {
+	gate, err := h.gate(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	return &impb.ProviderGetCustomGateResponse{Item: gateToProto(gate)}, nil
+}
+
+// UpdateCustomGate returns the secret only when the request set or rotated it.
+func (h *CustomHandler) UpdateCustomGate(ctx context.Context, req *impb.ProviderUpdateCustomGateRequest) (*impb.ProviderUpdateCustomGateResponse, error) {
+	if _, err := h.gate(ctx, req.GetId()); err != nil {
+		return nil, err
+	}
+
+	gate, err := h.srv.UpdateGate(ctx, cmodel.UpdateCustom{
+		ID:          req.GetId(),
+		Name:        req.Name,
+		CallbackURL: req.CallbackUrl,
+		Secret:      req.Secret,
+		Enabled:     req.Enabled,
+		Peer:        gaterpc.Peer(req.GetPeer()),
+	})
+	if err != nil {
+		return nil, gaterpc.ToStatus(err, "update gate")
+	}
+
+	resp := &impb.ProviderUpdateCustomGateResponse{Item: gateToProto(gate)}
+	if req.Secret != nil {
+		resp.Secret = gate.Secret
+	}
+	return resp, nil
+}
+
+func (h *CustomHandler) DeleteCustomGate(ctx context.Context, req *impb.ProviderDeleteCustomGateRequest) (*impb.ProviderDeleteCustomGateResponse, error) {
+	if _, err := h.gate(ctx, req.GetId()); err != nil {
+		return nil, err
+	}
+
+	gate, err := h.srv.DeleteGate(ctx, req.GetId())
+	if err != nil {
+		return nil, gaterpc.ToStatus(err, "delete gate")
+	}
+
+	return &impb.ProviderDeleteCustomGateResponse{Item: gateToProto(gate)}, nil
+}
+
+// gate returns the gate when it belongs to the domain of the caller.
+func (h *CustomHandler) gate(ctx context.Context, id string) (*cmodel.CustomGate, error) {
+	gate, err := h.srv.GetGate(ctx, id)
+	if err != nil {
+		return nil, gaterpc.ToStatus(err, "get gate")
+	}
+	if err := gaterpc.CheckDomain(ctx, gate.DomainID); err != nil {
+		return nil, err
+	}
+	return gate, nil
+}
+
+func gateToProto(g *cmodel.CustomGate) *impb.ProviderCustomGate {
+	if g == nil {
+		return nil
+	}
+	return &impb.ProviderCustomGate{
+		Id:          g.ID,
+		Name:        g.Name,
+		Peer:        gaterpc.ToProtoPeer(g.Peer),
+		CallbackUrl: g.CallbackURL,
+		WebhookUrl:  g.WebhookURL,
+		Status:      impb.ProviderStatus(g.Status),
+		CreatedAt:   g.CreatedAt.UnixMilli(),
+		UpdatedAt:   g.UpdatedAt.UnixMilli(),
+		Enabled:     g.Enabled,
+	}
+}
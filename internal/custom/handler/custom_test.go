@@ -0,0 +1,175 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"testing"
+
+	impb "github.com/webitel/im-providers-service/gen/go/provider/v1"
+	"github.com/webitel/im-providers-service/infra/auth"
+	sharedmodel "github.com/webitel/im-providers-service/internal/core/model"
+	sharedstore "github.com/webitel/im-providers-service/internal/core/store"
+	cmodel "github.com/webitel/im-providers-service/internal/custom/model"
+	cservice "github.com/webitel/im-providers-service/internal/custom/service"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type operator struct{ domainID int64 }
+
+func (o operator) GetContactID() string { return "" }
+func (o operator) GetDomainID() int64   { return o.domainID }
+func (o operator) GetName() string      { return "" }
+
+func operatorContext(domainID int64) context.Context {
+	return context.WithValue(context.Background(), auth.AuthContextKey, operator{domainID: domainID})
+}
+
+// channelRegistry keeps the gates like the custom service does: a missing secret is
+// generated on create and an empty one is rotated on update.
+type channelRegistry struct {
+	gates   map[string]*cmodel.CustomGate
+	deleted []string
+}
+
+var _ cservice.CustomManager = (*channelRegistry)(nil)
+
+func newChannelRegistry(gates ...*cmodel.CustomGate) *channelRegistry {
+	r := &channelRegistry{gates: map[string]*cmodel.CustomGate{}}
+	for _, g := range gates {
+		r.gates[g.ID] = g
+	}
+	return r
+}
+
+func (r *channelRegistry) CreateGate(_ context.Context, req cmodel.CreateCustom) (*cmodel.CustomGate, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	gate := &cmodel.CustomGate{
+		ID:          fmt.Sprintf("gate-%d", len(r.gates)+1),
+		DomainID:    req.Dc,
+		Name:        req.Name,
+		Peer:        req.Peer,
+		Secret:      req.Secret,
+		CallbackURL: req.CallbackURL,
+		Enabled:     true,
+	}
+	if gate.Secret == "" {
+		gate.Secret = "generated-secret"
+	}
+	gate.WebhookURL = "https://im.example.com/wh/custom/" + gate.ID
+	r.gates[gate.ID] = gate
+	return gate, nil
+}
+
+func (r *channelRegistry) GetGate(_ context.Context, id string) (*cmodel.CustomGate, error) {
+	g, ok := r.gates[id]
+	if !ok {
+		return nil, sharedstore.ErrNotFound
+	}
+	return g, nil
+}
+
+func (r *channelRegistry) UpdateGate(_ context.Context, req cmodel.UpdateCustom) (*cmodel.CustomGate, error) {
+	if req.Secret != nil && *req.Secret == "" {
+		rotated := "rotated-secret"
+		req.Secret = &rotated
+	}
+	req.ApplyTo(r.gates[req.ID])
+	return r.gates[req.ID], nil
+}
+
+func (r *channelRegistry) DeleteGate(_ context.Context, id string) (*cmodel.CustomGate, error) {
+	r.deleted = append(r.deleted, id)
+	return r.gates[id], nil
+}
+
+func newHandler(r *channelRegistry) *CustomHandler {
+	return NewCustomHandler(slog.New(slog.NewTextHandler(io.Discard, nil)), r)
+}
+
+func TestCreateCustomGate_ReturnsSecretOnce(t *testing.T) {
+	r := newChannelRegistry()
+	h := newHandler(r)
+	ctx := operatorContext(7)
+
+	resp, err := h.CreateCustomGate(ctx, &impb.ProviderCreateCustomGateRequest{
+		Name:        "Widget",
+		CallbackUrl: "https://widget.example.com/webitel",
+		Peer:        &impb.Peer{Sub: "widget-bot", Iss: "custom"},
+	})
+	if err != nil {
+		t.Fatalf("CreateCustomGate: %v", err)
+	}
+	if resp.GetSecret() != "generated-secret" {
+		t.Errorf("secret = %q, want the generated one", resp.GetSecret())
+	}
+	item := resp.GetItem()
+	if item.GetWebhookUrl() != "https://im.example.com/wh/custom/gate-1" || item.GetCallbackUrl() != "https://widget.example.com/webitel" {
+		t.Errorf("unexpected gate: %+v", item)
+	}
+	if g := r.gates["gate-1"]; g.DomainID != 7 || g.Peer != (sharedmodel.Peer{Sub: "widget-bot", Iss: "custom"}) {
+		t.Errorf("stored gate = %+v", g)
+	}
+
+	if _, err := h.GetCustomGate(ctx, &impb.ProviderGetCustomGateRequest{Id: "gate-1"}); err != nil {
+		t.Fatalf("GetCustomGate: %v", err)
+	}
+}
+
+func TestCreateCustomGate_InvalidCallback(t *testing.T) {
+	_, err := newHandler(newChannelRegistry()).CreateCustomGate(operatorContext(7), &impb.ProviderCreateCustomGateRequest{
+		Name:        "Widget",
+		CallbackUrl: "widget.example.com",
+	})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("code = %v, want InvalidArgument", status.Code(err))
+	}
+}
+
+func TestUpdateCustomGate_SecretOnlyWhenChanged(t *testing.T) {
+	r := newChannelRegistry(&cmodel.CustomGate{ID: "gate-1", DomainID: 7, Name: "Widget", Secret: "old", CallbackURL: "https://a.example.com", Enabled: true})
+	h := newHandler(r)
+	ctx := operatorContext(7)
+
+	renamed := "Support widget"
+	resp, err := h.UpdateCustomGate(ctx, &impb.ProviderUpdateCustomGateRequest{Id: "gate-1", Name: &renamed})
+	if err != nil {
+		t.Fatalf("UpdateCustomGate: %v", err)
+	}
+	if resp.GetSecret() != "" || resp.GetItem().GetName() != "Support widget" || resp.GetItem().GetCallbackUrl() != "https://a.example.com" {
+		t.Errorf("rename: %+v", resp)
+	}
+
+	rotate := ""
+	resp, err = h.UpdateCustomGate(ctx, &impb.ProviderUpdateCustomGateRequest{Id: "gate-1", Secret: &rotate})
+	if err != nil {
+		t.Fatalf("UpdateCustomGate: %v", err)
+	}
+	if resp.GetSecret() != "rotated-secret" {
+		t.Errorf("secret = %q, want the rotated one", resp.GetSecret())
+	}
+}
+
+func TestCustomGate_OtherDomainIsNotFound(t *testing.T) {
+	r := newChannelRegistry(&cmodel.CustomGate{ID: "gate-1", DomainID: 7, Secret: "old"})
+	h := newHandler(r)
+	ctx := operatorContext(8)
+
+	if _, err := h.GetCustomGate(ctx, &impb.ProviderGetCustomGateRequest{Id: "gate-1"}); status.Code(err) != codes.NotFound {
+		t.Errorf("get: code = %v, want NotFound", status.Code(err))
+	}
+	rotate := ""
+	if _, err := h.UpdateCustomGate(ctx, &impb.ProviderUpdateCustomGateRequest{Id: "gate-1", Secret: &rotate}); status.Code(err) != codes.NotFound {
+		t.Errorf("update: code = %v, want NotFound", status.Code(err))
+	}
+	if _, err := h.DeleteCustomGate(ctx, &impb.ProviderDeleteCustomGateRequest{Id: "gate-1"}); status.Code(err) != codes.NotFound {
+		t.Errorf("delete: code = %v, want NotFound", status.Code(err))
+	}
+	if r.gates["gate-1"].Secret != "old" || len(r.deleted) != 0 {
+		t.Errorf("gate of another domain changed: %+v, deleted %v", r.gates["gate-1"], r.deleted)
+	}
+}
@@ -0,0 +1,112 @@
+package custom
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"path"
+
+	sharedmodel "github.com/webitel/im-providers-service/internal/core/model"
+	cmodel "github.com/webitel/im-providers-service/internal/custom/model"
+	"github.com/webitel/im-providers-service/internal/provider/contactsync"
+)
+
+type syncedMedia struct {
+	id       string
+	fileName string
+	mimeType string
+	size     int64
+}
+
+// sendImages copies the images to the storage and forwards them with the text as the caption.
+func (p *customProvider) sendImages(ctx context.Context, gate *cmodel.CustomGate, peers contactsync.Peers, msg *sharedmodel.Message) error {
+	images := make([]*sharedmodel.Image, 0, len(msg.Images))
+	for _, img := range msg.Images {
+		media, err := p.downloadAndUpload(ctx, gate, img.URL, img.FileName, img.MimeType)
+		if err != nil {
+			return err
+		}
+		images = append(images, &sharedmodel.Image{ID: media.id, FileName: media.fileName, MimeType: media.mimeType})
+	}
+
+	_, err := p.messenger.SendImage(ctx, &sharedmodel.SendImageRequest{
+		DomainID: gate.DomainID,
+		From:     peers.From,
+		To:       peers.To,
+		Image:    sharedmodel.ImageRequest{Body: msg.Text, Images: images},
+	})
+	return err
+}
+
+// sendDocuments copies the documents to the storage and forwards them with the text as the caption.
+func (p *customProvider) sendDocuments(ctx context.Context, gate *cmodel.CustomGate, peers contactsync.Peers, msg *sharedmodel.Message) error {
+	docs := make([]*sharedmodel.Document, 0, len(msg.Documents))
+	for _, doc := range msg.Documents {
+		media, err := p.downloadAndUpload(ctx, gate, doc.URL, doc.FileName, doc.MimeType)
+		if err != nil {
+			return err
+		}
+
+		size := media.size
+		if size <= 0 {
+			size = doc.Size
+		}
+		if size <= 0 {
+			size = 1
+		}
+		docs = append(docs, &sharedmodel.Document{ID: media.id, FileName: media.fileName, MimeType: media.mimeType, Size: size})
+	}
+
+	_, err := p.messenger.SendDocument(ctx, &sharedmodel.SendDocumentRequest{
+		DomainID: gate.DomainID,
+		From:     peers.From,
+		To:       peers.To,
+		Document: sharedmodel.DocumentRequest{Body: msg.Text, Documents: docs},
+	})
+	return err
+}
+
+// downloadAndUpload fetches the file from the channel and stores it. The file name and
+// MIME type of the envelope win over the ones derived from the download.
+func (p *customProvider) downloadAndUpload(ctx context.Context, gate *cmodel.CustomGate, fileURL, fileName, mimeType string) (*syncedMedia, error) {
+	if fileURL == "" {
+		return nil, fmt.Errorf("custom: media has no url")
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, fileURL, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := p.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("custom: download %s: status %s", fileURL, resp.Status)
+	}
+
+	if mimeType == "" {
+		mimeType = resp.Header.Get("Content-Type")
+	}
+	if fileName == "" {
+		fileName = "file"
+		if u, err := url.Parse(fileURL); err == nil && path.Base(u.Path) != "/" && path.Base(u.Path) != "." {
+			fileName = path.Base(u.Path)
+		}
+	}
+
+	uploaded, err := p.media.UploadFile(ctx, sharedmodel.UploadRequest{
+		DomainID: gate.DomainID,
+		Name:     fileName,
+		MimeType: mimeType,
+	}, resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	return &syncedMedia{id: uploaded.ID, fileName: fileName, mimeType: mimeType, size: resp.ContentLength}, nil
+}
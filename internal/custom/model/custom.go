@@ -0,0 +1,85 @@
+package model
+
+import (
+	"net/url"
+	"time"
+
+	sharedmodel "github.com/webitel/im-providers-service/internal/core/model"
+)
+
+// CustomGate represents a gate of an in-house channel integrated over the custom webhook envelope.
+type CustomGate struct {
+	ID       string           `json:"id" db:"id"`
+	DomainID int64            `json:"domain_id" db:"domain_id"`
+	Peer     sharedmodel.Peer `json:"peer" db:"peer"`
+	Name     string           `json:"name" db:"name"`
+	// Secret keys the HMAC signature of envelopes in both directions.
+	Secret string `json:"-" db:"secret"`
+	// CallbackURL receives the outbound envelopes.
+	CallbackURL string `json:"callback_url" db:"callback_url"`
+	// WebhookURL receives the inbound envelopes. It is derived from service.public_url.
+	WebhookURL string                 `json:"webhook_url" db:"-"`
+	Status     sharedmodel.GateStatus `json:"status" db:"status"`
+	CreatedAt  time.Time              `json:"created_at" db:"created_at"`
+	UpdatedAt  time.Time              `json:"updated_at" db:"updated_at"`
+	Enabled    bool                   `json:"enabled" db:"enabled"`
+}
+
+type CreateCustom struct {
+	Name        string
+	Dc          int64
+	CallbackURL string
+	// Secret is generated when empty.
+	Secret string
+	Peer   sharedmodel.Peer
+}
+
+type UpdateCustom struct {
+	ID          string
+	Name        *string
+	CallbackURL *string
+	Secret      *string
+	Enabled     *bool
+	Peer        *sharedmodel.Peer
+}
+
+func (r UpdateCustom) ApplyTo(gate *CustomGate) {
+	if r.Name != nil {
+		gate.Name = *r.Name
+	}
+	if r.CallbackURL != nil {
+		gate.CallbackURL = *r.CallbackURL
+	}
+	if r.Secret != nil {
+		gate.Secret = *r.Secret
+	}
+	if r.Enabled != nil {
+		gate.Enabled = *r.Enabled
+	}
+	if r.Peer != nil {
+		gate.Peer = *r.Peer
+	}
+}
+
+func (r CreateCustom) Validate() error {
+	var missing []string
+	if r.Name == "" {
+		missing = append(missing, "name")
+	}
+	if !ValidCallbackURL(r.CallbackURL) {
+		missing = append(missing, "callback_url")
+	}
+	if r.Dc <= 0 {
+		missing = append(missing, "domain_id")
+	}
+	if len(missing) > 0 {
+		return &sharedmodel.ValidationError{Fields: missing}
+	}
+	return nil
+}
+
+// ValidCallbackURL reports whether u is an absolute http(s) URL.
+func ValidCallbackURL(u string) bool {
+	parsed, err := url.Parse(u)
+	return err == nil && (parsed.Scheme == "https" || parsed.Scheme == "http") && parsed.Host != ""
+}
@@ -0,0 +1,39 @@
+package custom
+
+import (
+	impb "github.com/webitel/im-providers-service/gen/go/provider/v1"
+	grpcsrv "github.com/webitel/im-providers-service/infra/srv/grpc"
+	chandler "github.com/webitel/im-providers-service/internal/custom/handler"
+	cservice "github.com/webitel/im-providers-service/internal/custom/service"
+	cstore "github.com/webitel/im-providers-service/internal/custom/store"
+	cpostgres "github.com/webitel/im-providers-service/internal/custom/store/postgres"
+	"github.com/webitel/im-providers-service/internal/provider"
+	"go.uber.org/fx"
+)
+
+// Module provides the custom webhook provider adapter and the gRPC gate service.
+var Module = fx.Module("custom",
+	fx.Provide(
+		// Provider adapter
+		fx.Annotate(
+			New,
+			fx.As(new(provider.Provider)),
+			fx.ResultTags(`group:"providers"`),
+		),
+
+		// Store implementations
+		fx.Annotate(cpostgres.NewCustomStore, fx.As(new(cstore.CustomStore))),
+
+		// Services
+		fx.Annotate(cservice.NewCustomService, fx.As(new(cservice.CustomManager))),
+
+		// gRPC handlers
+		chandler.NewCustomHandler,
+	),
+	fx.Invoke(RegisterCustomService),
+)
+
+// RegisterCustomService connects the custom gate gRPC handler to the gRPC server.
+func RegisterCustomService(server *grpcsrv.Server, custom *chandler.CustomHandler) {
+	impb.RegisterCustomServiceServer(server.Server, custom)
+}
@@ -0,0 +1,176 @@
+package custom
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+	contactv1 "github.com/webitel/im-providers-service/gen/go/contact/v1"
+	sharedmodel "github.com/webitel/im-providers-service/internal/core/model"
+	cmodel "github.com/webitel/im-providers-service/internal/custom/model"
+	"github.com/webitel/im-providers-service/internal/provider/contactsync"
+)
+
+// maxResponseSize bounds the callback URL response read for the delivered message ID.
+const maxResponseSize = 64 << 10
+
+// Every message kind is delivered as the same envelope; the channel renders what it supports.
+
+func (p *customProvider) SendText(ctx context.Context, req *sharedmodel.Message) (*sharedmodel.MessageResponse, error) {
+	return p.deliver(ctx, req)
+}
+
+func (p *customProvider) SendImage(ctx context.Context, req *sharedmodel.Message) (*sharedmodel.MessageResponse, error) {
+	return p.deliver(ctx, req)
+}
+
+func (p *customProvider) SendDocument(ctx context.Context, req *sharedmodel.Message) (*sharedmodel.MessageResponse, error) {
+	return p.deliver(ctx, req)
+}
+
+func (p *customProvider) SendInteractive(ctx context.Context, req *sharedmodel.Message) (*sharedmodel.MessageResponse, error) {
+	return p.deliver(ctx, req)
+}
+
+func (p *customProvider) SendCards(ctx context.Context, req *sharedmodel.Message) (*sharedmodel.MessageResponse, error) {
+	return p.deliver(ctx, req)
+}
+
+func (p *customProvider) SendLocation(ctx context.Context, req *sharedmodel.Message) (*sharedmodel.MessageResponse, error) {
+	return p.deliver(ctx, req)
+}
+
+func (p *customProvider) SendContact(ctx context.Context, req *sharedmodel.Message) (*sharedmodel.MessageResponse, error) {
+	return p.deliver(ctx, req)
+}
+
+func (p *customProvider) SendReaction(ctx context.Context, req *sharedmodel.Message) (*sharedmodel.MessageResponse, error) {
+	return p.deliver(ctx, req)
+}
+
+// deliveryError is a failed POST of an envelope; retryable failures are retried.
+type deliveryError struct {
+	err       error
+	retryable bool
+}
+
+func (e *deliveryError) Error() string { return e.err.Error() }
+func (e *deliveryError) Unwrap() error { return e.err }
+
+// deliver posts the message envelope to the callback URL of the gate. Network errors,
+// 429 and 5xx answers are retried with an exponential backoff.
+func (p *customProvider) deliver(ctx context.Context, req *sharedmodel.Message) (*sharedmodel.MessageResponse, error) {
+	gate, err := p.repo.Select(ctx, req.GateID)
+	if err != nil {
+		return nil, err
+	}
+	receiver, err := p.resolveReceiver(ctx, gate, req.To.Sub)
+	if err != nil {
+		return nil, err
+	}
+
+	env := Envelope{
+		ID:        messageID(req),
+		Event:     EventMessage,
+		GateID:    gate.ID,
+		Timestamp: time.Now().UnixMilli(),
+		User:      User{ID: receiver},
+		Message:   req,
+	}
+	body, err := json.Marshal(&env)
+	if err != nil {
+		return nil, fmt.Errorf("custom: marshal envelope: %w", err)
+	}
+
+	delay := p.backoff
+	for attempt := 1; ; attempt++ {
+		id, err := p.post(ctx, gate, body)
+		if err == nil {
+			if id == "" {
+				id = env.ID
+			}
+			return &sharedmodel.MessageResponse{ID: id}, nil
+		}
+
+		var dErr *deliveryError
+		if !errors.As(err, &dErr) || !dErr.retryable || attempt == deliveryAttempts {
+			return nil, fmt.Errorf("custom: deliver to %s (attempt %d): %w", gate.CallbackURL, attempt, err)
+		}
+		p.logger.Warn("delivery failed, retrying", "gate_id", gate.ID, "attempt", attempt, "delay", delay, "err", err)
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(delay):
+		}
+		delay *= 2
+	}
+}
+
+// post sends the signed envelope once and returns the message ID the channel answered with.
+func (p *customProvider) post(ctx context.Context, gate *cmodel.CustomGate, body []byte) (string, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, gate.CallbackURL, bytes.NewReader(body))
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(signatureHeader, signaturePrefix+sign(gate.Secret, body))
+
+	resp, err := p.httpClient.Do(req)
+	if err != nil {
+		return "", &deliveryError{err: err, retryable: ctx.Err() == nil}
+	}
+	defer resp.Body.Close()
+
+	raw, _ := io.ReadAll(io.LimitReader(resp.Body, maxResponseSize))
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return "", &deliveryError{
+			err:       fmt.Errorf("status %s", resp.Status),
+			retryable: resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= 500,
+		}
+	}
+
+	// The response body is optional; anything but {"id": "..."} is ignored.
+	var out deliveryResponse
+	_ = json.Unmarshal(raw, &out)
+	return out.ID, nil
+}
+
+// resolveReceiver returns the channel user ID for the given sub.
+// A channel ID is returned as-is; an internal contact UUID is resolved via the contact service.
+func (p *customProvider) resolveReceiver(ctx context.Context, gate *cmodel.CustomGate, contactID string) (string, error) {
+	if _, err := uuid.Parse(contactID); err != nil {
+		return contactID, nil
+	}
+	if userID, ok := p.receiverCache.Get(contactID); ok {
+		return userID, nil
+	}
+
+	authCtx := contactsync.WithGatewayIdentity(ctx, gate.DomainID, gate.Peer)
+	resp, err := p.contactClient.SearchContact(authCtx, &contactv1.SearchContactRequest{
+		Ids: []string{contactID},
+	})
+	if err != nil {
+		return "", fmt.Errorf("resolve channel user for %s: %w", contactID, err)
+	}
+	items := resp.GetContacts()
+	if len(items) == 0 || items[0].GetSubject() == "" {
+		return "", fmt.Errorf("resolve channel user for %s: contact not found or has no subject", contactID)
+	}
+	userID := items[0].GetSubject()
+	p.receiverCache.Add(contactID, userID)
+	return userID, nil
+}
+
+func messageID(req *sharedmodel.Message) string {
+	if req.ID == uuid.Nil {
+		return uuid.NewString()
+	}
+	return req.ID.String()
+}
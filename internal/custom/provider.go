@@ -0,0 +1,111 @@
+// Package custom implements the custom webhook provider. It integrates any channel
+// that speaks the documented Envelope: inbound envelopes are posted to the gate
+// webhook, outbound ones are posted to the callback URL of the gate.
+package custom
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+	lru "github.com/hashicorp/golang-lru/v2"
+	imcontact "github.com/webitel/im-providers-service/infra/client/grpc/im-contact"
+	imgateway "github.com/webitel/im-providers-service/infra/client/grpc/im-gateway"
+	sharedsvc "github.com/webitel/im-providers-service/internal/core/service"
+	sharedstore "github.com/webitel/im-providers-service/internal/core/store"
+	cmodel "github.com/webitel/im-providers-service/internal/custom/model"
+	cstore "github.com/webitel/im-providers-service/internal/custom/store"
+	"github.com/webitel/im-providers-service/internal/provider"
+)
+
+const (
+	// deliveryAttempts bounds the POSTs of an outbound envelope to the callback URL.
+	deliveryAttempts = 3
+	// deliveryBackoff is the delay before the first retry, doubled on every next one.
+	deliveryBackoff = 500 * time.Millisecond
+)
+
+type customProvider struct {
+	logger        *slog.Logger
+	messenger     sharedsvc.Messenger
+	gateCache     sharedstore.GateCache
+	userCache     sharedstore.ExternalUserCache
+	repo          cstore.CustomStore
+	gatewayer     *imgateway.Client
+	media         sharedsvc.MediaManager
+	contactClient *imcontact.Client
+	// receiverCache maps internal contact UUID → channel user ID to avoid an
+	// im-contact round-trip on every outbound message.
+	receiverCache *lru.Cache[string, string]
+	// httpClient posts outbound envelopes and downloads inbound media.
+	httpClient *http.Client
+	backoff    time.Duration
+}
+
+func New(
+	m sharedsvc.Messenger,
+	l *slog.Logger,
+	gc sharedstore.GateCache,
+	uc sharedstore.ExternalUserCache,
+	repo cstore.CustomStore,
+	gatewayer *imgateway.Client,
+	media sharedsvc.MediaManager,
+	contactClient *imcontact.Client,
+) provider.Provider {
+	receiverCache, _ := lru.New[string, string](1000)
+	return &customProvider{
+		logger:        l.With("provider", "custom"),
+		messenger:     m,
+		gateCache:     gc,
+		userCache:     uc,
+		repo:          repo,
+		gatewayer:     gatewayer,
+		media:         media,
+		contactClient: contactClient,
+		receiverCache: receiverCache,
+		httpClient:    &http.Client{Timeout: 30 * time.Second},
+		backoff:       deliveryBackoff,
+	}
+}
+
+var (
+	_ provider.SignatureValidator = (*customProvider)(nil)
+	_ provider.SignatureHeader    = (*customProvider)(nil)
+	_ provider.InteractiveSender  = (*customProvider)(nil)
+	_ provider.CardSender         = (*customProvider)(nil)
+	_ provider.LocationSender     = (*customProvider)(nil)
+	_ provider.ContactSender      = (*customProvider)(nil)
+	_ provider.ReactionSender     = (*customProvider)(nil)
+)
+
+func (p *customProvider) Type() string { return "custom" }
+
+// resolveGate returns the gate a webhook was delivered to. The webhook URI segment is
+// the gate ID. Disabled gates are short-circuited from the cache to avoid a DB
+// round-trip on every envelope.
+func (p *customProvider) resolveGate(ctx context.Context) (*cmodel.CustomGate, error) {
+	gateID, _ := ctx.Value(provider.WebhookURIKey).(string)
+	if _, err := uuid.Parse(gateID); err != nil {
+		return nil, fmt.Errorf("invalid webhook uri %q: %w", gateID, sharedstore.ErrNotFound)
+	}
+
+	if cached, ok := p.gateCache.Get(gateID); ok && !cached.Enabled {
+		return &cmodel.CustomGate{ID: gateID, Enabled: false}, nil
+	}
+
+	g, err := p.repo.Select(ctx, gateID)
+	if err != nil {
+		return nil, err
+	}
+	p.gateCache.Set(gateID, sharedstore.GateState{
+		GateID:  g.ID,
+		Enabled: g.Enabled,
+		Issuer:  g.Peer.Iss,
+		Sub:     g.Peer.Sub,
+		Domain:  g.DomainID,
+	})
+	return g, nil
+}
@@ -0,0 +1,294 @@
+package custom
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	sharedmodel "github.com/webitel/im-providers-service/internal/core/model"
+	sharedstore "github.com/webitel/im-providers-service/internal/core/store"
+	cmodel "github.com/webitel/im-providers-service/internal/custom/model"
+	cstore "github.com/webitel/im-providers-service/internal/custom/store"
+	"github.com/webitel/im-providers-service/internal/provider"
+	"github.com/webitel/im-providers-service/internal/provider/providertest"
+)
+
+const (
+	testGateID = "0190a8a4-5f0e-7c2a-9d3b-0a1b2c3d4e5f"
+	testSecret = "s3cr3t"
+)
+
+var noopLogger = slog.New(slog.NewTextHandler(io.Discard, nil))
+
+// widgetGates serves the gates the inbound envelopes resolve to. The provider only
+// reads gates; writing them is the job of the gate service.
+type widgetGates map[string]*cmodel.CustomGate
+
+var _ cstore.CustomStore = widgetGates(nil)
+
+func (g widgetGates) Select(_ context.Context, id string) (*cmodel.CustomGate, error) {
+	gate, ok := g[id]
+	if !ok {
+		return nil, sharedstore.ErrNotFound
+	}
+	cp := *gate
+	return &cp, nil
+}
+
+func (widgetGates) Insert(context.Context, int64, *cmodel.CustomGate) error {
+	panic("custom provider must not insert gates")
+}
+
+func (widgetGates) Update(context.Context, *cmodel.CustomGate) error {
+	panic("custom provider must not update gates")
+}
+
+func (widgetGates) Delete(context.Context, string) error {
+	panic("custom provider must not delete gates")
+}
+
+func widgetGate(callbackURL string) *cmodel.CustomGate {
+	return &cmodel.CustomGate{
+		ID:          testGateID,
+		DomainID:    1,
+		Name:        "Widget",
+		Peer:        sharedmodel.Peer{Sub: "widget-bot", Iss: "custom"},
+		Secret:      testSecret,
+		CallbackURL: callbackURL,
+		Enabled:     true,
+	}
+}
+
+// newWidgetProvider returns the provider of the widget gate, retrying deliveries without delay.
+func newWidgetProvider(gate *cmodel.CustomGate) (*customProvider, *providertest.Messenger, *providertest.Media) {
+	m := &providertest.Messenger{}
+	media := &providertest.Media{}
+	p := New(m, noopLogger, providertest.NewGateCache(), providertest.KnownUsers{}, widgetGates{gate.ID: gate}, nil, media, nil).(*customProvider)
+	p.backoff = time.Millisecond
+	return p, m, media
+}
+
+func webhookCtx() context.Context {
+	return context.WithValue(context.Background(), provider.WebhookURIKey, testGateID)
+}
+
+// -- tests --
+
+func TestValidateSignature(t *testing.T) {
+	p, _, _ := newWidgetProvider(widgetGate(""))
+	body := []byte(`{"id":"1"}`)
+
+	if err := p.ValidateSignature(webhookCtx(), signaturePrefix+sign(testSecret, body), body); err != nil {
+		t.Errorf("valid signature rejected: %v", err)
+	}
+	for name, header := range map[string]string{
+		"missing":    "",
+		"no prefix":  sign(testSecret, body),
+		"wrong key":  signaturePrefix + sign("other", body),
+		"tampered":   signaturePrefix + sign(testSecret, []byte(`{"id":"2"}`)),
+		"not hex":    signaturePrefix + "zz",
+		"wrong hash": "sha1=" + sign(testSecret, body),
+	} {
+		if err := p.ValidateSignature(webhookCtx(), header, body); err == nil {
+			t.Errorf("%s: signature accepted", name)
+		}
+	}
+}
+
+func TestHandleWebhookMessages(t *testing.T) {
+	files := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "image/png")
+		_, _ = w.Write([]byte("PNG"))
+	}))
+	t.Cleanup(files.Close)
+
+	p, m, media := newWidgetProvider(widgetGate(""))
+	envelopes := []string{
+		`{"id":"m1","event":"message","user":{"id":"visitor-42","name":"Jane"},"message":{"text":"Hi!"}}`,
+		`{"id":"m2","event":"message","user":{"id":"visitor-42"},"message":{"text":"see","images":[{"url":"` + files.URL + `/shots/a.png"}]}}`,
+		`{"id":"m3","event":"message","user":{"id":"visitor-42"},"message":{"location":{"latitude":50.45,"longitude":30.52,"name":"Office"}}}`,
+		`{"id":"m4","event":"message","user":{"id":"visitor-42"},"message":{"contacts":[{"first_name":"Ivan","phones":["+380671234567"],"emails":["ivan@example.com"]}]}}`,
+		`{"id":"m5","event":"callback","user":{"id":"visitor-42"},"callback":{"in_reply_to":"out-1","button_code":"yes","data":"order=7"}}`,
+	}
+	for _, env := range envelopes {
+		if err := p.HandleWebhook(webhookCtx(), []byte(env)); err != nil {
+			t.Fatalf("HandleWebhook(%s): %v", env, err)
+		}
+	}
+
+	if len(m.Texts()) != 1 || m.Texts()[0].Body != "Hi!" {
+		t.Fatalf("texts = %+v", m.Texts())
+	}
+	if from, to := m.Texts()[0].From, m.Texts()[0].To; from.Sub != "visitor-42" || to.Sub != "widget-bot" || to.Via == nil || *to.Via != testGateID {
+		t.Errorf("peers = %+v → %+v", from, to)
+	}
+
+	if len(m.Images()) != 1 || m.Images()[0].Image.Body != "see" {
+		t.Fatalf("images = %+v", m.Images())
+	}
+	if img := m.Images()[0].Image.Images[0]; img.ID != "file-1" || img.FileName != "a.png" || img.MimeType != "image/png" {
+		t.Errorf("image = %+v", img)
+	}
+	if uploads := media.Uploads(); len(uploads) != 1 || uploads[0].Body != "PNG" {
+		t.Errorf("uploads = %+v", uploads)
+	}
+
+	if len(m.Locations()) != 1 || m.Locations()[0].Latitude != 50.45 || *m.Locations()[0].Name != "Office" || m.Locations()[0].ExternalID != "m3" {
+		t.Errorf("locations = %+v", m.Locations())
+	}
+	if len(m.Contacts()) != 1 || *m.Contacts()[0].Name != "Ivan" || *m.Contacts()[0].PhoneNumber != "+380671234567" || *m.Contacts()[0].Email != "ivan@example.com" {
+		t.Errorf("contacts = %+v", m.Contacts())
+	}
+	if len(m.Callbacks()) != 1 || m.Callbacks()[0].InReplyTo != "out-1" || m.Callbacks()[0].ButtonCode != "yes" || m.Callbacks()[0].CallbackData != "order=7" {
+		t.Errorf("callbacks = %+v", m.Callbacks())
+	}
+}
+
+func TestHandleWebhookRejectsBadEnvelopes(t *testing.T) {
+	p, m, _ := newWidgetProvider(widgetGate(""))
+
+	for name, env := range map[string]string{
+		"malformed":     `{"id":`,
+		"no user":       `{"id":"m1","event":"message","message":{"text":"Hi!"}}`,
+		"empty message": `{"id":"m1","event":"message","user":{"id":"u"},"message":{}}`,
+		"no button":     `{"id":"m1","event":"callback","user":{"id":"u"},"callback":{}}`,
+		"unknown event": `{"id":"m1","event":"typing","user":{"id":"u"}}`,
+	} {
+		if err := p.HandleWebhook(webhookCtx(), []byte(env)); err == nil {
+			t.Errorf("%s: envelope accepted", name)
+		}
+	}
+	if len(m.Texts())+len(m.Callbacks()) != 0 {
+		t.Errorf("rejected envelopes were forwarded")
+	}
+}
+
+func TestHandleWebhookDropsDisabledGate(t *testing.T) {
+	gate := widgetGate("")
+	gate.Enabled = false
+	p, m, _ := newWidgetProvider(gate)
+
+	env := `{"id":"m1","event":"message","user":{"id":"u"},"message":{"text":"Hi!"}}`
+	if err := p.HandleWebhook(webhookCtx(), []byte(env)); err != nil {
+		t.Fatalf("HandleWebhook: %v", err)
+	}
+	if len(m.Texts()) != 0 {
+		t.Errorf("envelope of a disabled gate forwarded")
+	}
+}
+
+// callbackStub answers the outbound envelopes with the scripted status codes, then with 200.
+type callbackStub struct {
+	mu       sync.Mutex
+	statuses []int
+	bodies   [][]byte
+	sigs     []string
+}
+
+func (s *callbackStub) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	body, _ := io.ReadAll(r.Body)
+
+	s.mu.Lock()
+	s.bodies = append(s.bodies, body)
+	s.sigs = append(s.sigs, r.Header.Get(signatureHeader))
+	status := http.StatusOK
+	if n := len(s.bodies); n <= len(s.statuses) {
+		status = s.statuses[n-1]
+	}
+	s.mu.Unlock()
+
+	w.WriteHeader(status)
+	if status == http.StatusOK {
+		_, _ = w.Write([]byte(`{"id":"ext-7"}`))
+	}
+}
+
+func TestDeliverRetries(t *testing.T) {
+	stub := &callbackStub{statuses: []int{http.StatusServiceUnavailable, http.StatusTooManyRequests}}
+	server := httptest.NewServer(stub)
+	t.Cleanup(server.Close)
+
+	p, _, _ := newWidgetProvider(widgetGate(server.URL))
+	msgID := uuid.New()
+	resp, err := p.SendLocation(context.Background(), &sharedmodel.Message{
+		ID:       msgID,
+		GateID:   testGateID,
+		To:       sharedmodel.Peer{Sub: "visitor-42"},
+		Text:     "We are here",
+		Location: &sharedmodel.Location{Latitude: 50.45, Longitude: 30.52},
+	})
+	if err != nil {
+		t.Fatalf("SendLocation: %v", err)
+	}
+	if resp.ID != "ext-7" {
+		t.Errorf("response id = %q, want ext-7", resp.ID)
+	}
+	if len(stub.bodies) != 3 {
+		t.Fatalf("posted %d times, want 3", len(stub.bodies))
+	}
+
+	body := stub.bodies[2]
+	if stub.sigs[2] != signaturePrefix+sign(testSecret, body) {
+		t.Errorf("signature = %q", stub.sigs[2])
+	}
+
+	var env struct {
+		ID      string `json:"id"`
+		Event   string `json:"event"`
+		GateID  string `json:"gate_id"`
+		User    User   `json:"user"`
+		Message struct {
+			Text     string         `json:"text"`
+			Location map[string]any `json:"location"`
+		} `json:"message"`
+	}
+	if err := json.Unmarshal(body, &env); err != nil {
+		t.Fatalf("envelope: %v", err)
+	}
+	if env.ID != msgID.String() || env.Event != EventMessage || env.GateID != testGateID || env.User.ID != "visitor-42" {
+		t.Errorf("envelope = %+v", env)
+	}
+	if env.Message.Text != "We are here" || env.Message.Location["latitude"] != 50.45 {
+		t.Errorf("message = %+v", env.Message)
+	}
+}
+
+func TestDeliverDoesNotRetryClientErrors(t *testing.T) {
+	stub := &callbackStub{statuses: []int{http.StatusBadRequest}}
+	server := httptest.NewServer(stub)
+	t.Cleanup(server.Close)
+
+	p, _, _ := newWidgetProvider(widgetGate(server.URL))
+	_, err := p.SendText(context.Background(), &sharedmodel.Message{GateID: testGateID, To: sharedmodel.Peer{Sub: "u"}, Text: "hi"})
+	if err == nil || !strings.Contains(err.Error(), "400") {
+		t.Fatalf("err = %v, want the 400 status", err)
+	}
+	if len(stub.bodies) != 1 {
+		t.Errorf("posted %d times, want 1", len(stub.bodies))
+	}
+}
+
+func TestDeliverGivesUp(t *testing.T) {
+	stub := &callbackStub{statuses: []int{500, 500, 500, 500}}
+	server := httptest.NewServer(stub)
+	t.Cleanup(server.Close)
+
+	p, _, _ := newWidgetProvider(widgetGate(server.URL))
+	_, err := p.SendText(context.Background(), &sharedmodel.Message{GateID: testGateID, To: sharedmodel.Peer{Sub: "u"}, Text: "hi"})
+	var dErr *deliveryError
+	if !errors.As(err, &dErr) {
+		t.Fatalf("err = %v, want a delivery error", err)
+	}
+	if len(stub.bodies) != deliveryAttempts {
+		t.Errorf("posted %d times, want %d", len(stub.bodies), deliveryAttempts)
+	}
+}
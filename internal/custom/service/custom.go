@@ -0,0 +1,142 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"log/slog"
+
+	"github.com/webitel/im-providers-service/config"
+	sharedmodel "github.com/webitel/im-providers-service/internal/core/model"
+	cmodel "github.com/webitel/im-providers-service/internal/custom/model"
+	cstore "github.com/webitel/im-providers-service/internal/custom/store"
+)
+
+// secretSize is the number of random bytes of a generated gate secret.
+const secretSize = 32
+
+var _ CustomManager = (*CustomService)(nil)
+
+type CustomManager interface {
+	CreateGate(ctx context.Context, req cmodel.CreateCustom) (*cmodel.CustomGate, error)
+	GetGate(ctx context.Context, id string) (*cmodel.CustomGate, error)
+	UpdateGate(ctx context.Context, req cmodel.UpdateCustom) (*cmodel.CustomGate, error)
+	DeleteGate(ctx context.Context, id string) (*cmodel.CustomGate, error)
+}
+
+type CustomService struct {
+	repo cstore.CustomStore
+	cfg  *config.Config
+	log  *slog.Logger
+}
+
+func NewCustomService(repo cstore.CustomStore, cfg *config.Config, log *slog.Logger) *CustomService {
+	return &CustomService{
+		repo: repo,
+		cfg:  cfg,
+		log:  log.With("layer", "service", "domain", "custom_gate"),
+	}
+}
+
+// CreateGate stores the gate. Without a secret in the request one is generated;
+// the returned gate carries it, so it can be handed over to the channel.
+func (c *CustomService) CreateGate(ctx context.Context, req cmodel.CreateCustom) (*cmodel.CustomGate, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	secret := req.Secret
+	if secret == "" {
+		var err error
+		if secret, err = generateSecret(); err != nil {
+			return nil, err
+		}
+	}
+
+	gate := &cmodel.CustomGate{
+		Name:        req.Name,
+		CallbackURL: req.CallbackURL,
+		Secret:      secret,
+		Peer:        req.Peer,
+		Enabled:     true,
+	}
+	if err := c.repo.Insert(ctx, req.Dc, gate); err != nil {
+		c.log.Error("failed to create custom gate", "err", err)
+		return nil, err
+	}
+
+	c.setWebhookURL(gate)
+	c.log.Info("custom gate created", "id", gate.ID, "callback_url", gate.CallbackURL)
+	return gate, nil
+}
+
+func (c *CustomService) GetGate(ctx context.Context, id string) (*cmodel.CustomGate, error) {
+	gate, err := c.repo.Select(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	c.setWebhookURL(gate)
+	return gate, nil
+}
+
+// UpdateGate applies the changes. An empty secret in the request rotates the secret.
+func (c *CustomService) UpdateGate(ctx context.Context, req cmodel.UpdateCustom) (*cmodel.CustomGate, error) {
+	if req.CallbackURL != nil && !cmodel.ValidCallbackURL(*req.CallbackURL) {
+		return nil, &sharedmodel.ValidationError{Fields: []string{"callback_url"}}
+	}
+	if req.Secret != nil && *req.Secret == "" {
+		secret, err := generateSecret()
+		if err != nil {
+			return nil, err
+		}
+		req.Secret = &secret
+	}
+
+	gate, err := c.repo.Select(ctx, req.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	req.ApplyTo(gate)
+	if err := c.repo.Update(ctx, gate); err != nil {
+		c.log.Error("failed to update custom gate", "id", req.ID, "err", err)
+		return nil, err
+	}
+
+	c.setWebhookURL(gate)
+	c.log.Info("custom gate updated", "id", gate.ID)
+	return gate, nil
+}
+
+func (c *CustomService) DeleteGate(ctx context.Context, id string) (*cmodel.CustomGate, error) {
+	gate, err := c.repo.Select(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := c.repo.Delete(ctx, id); err != nil {
+		c.log.Error("failed to delete custom gate", "id", id, "err", err)
+		return nil, err
+	}
+
+	c.log.Warn("custom gate removed", "id", id)
+	return gate, nil
+}
+
+// setWebhookURL fills the URL inbound envelopes are posted to: the gate ID is the
+// webhook URI segment. It stays empty until service.public_url is configured.
+func (c *CustomService) setWebhookURL(gate *cmodel.CustomGate) {
+	if c.cfg.Service.PublicURL == "" {
+		return
+	}
+	gate.WebhookURL = c.cfg.Service.PublicURL + c.cfg.Service.WebhookPath + "/custom/" + gate.ID
+}
+
+func generateSecret() (string, error) {
+	b := make([]byte, secretSize)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("generate secret: %w", err)
+	}
+	return hex.EncodeToString(b), nil
+}
@@ -0,0 +1,115 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"testing"
+
+	"github.com/webitel/im-providers-service/config"
+	sharedmodel "github.com/webitel/im-providers-service/internal/core/model"
+	sharedstore "github.com/webitel/im-providers-service/internal/core/store"
+	cmodel "github.com/webitel/im-providers-service/internal/custom/model"
+	cstore "github.com/webitel/im-providers-service/internal/custom/store"
+)
+
+var noopLogger = slog.New(slog.NewTextHandler(io.Discard, nil))
+
+// integrations stores the gates of the in-house channels under sequential IDs.
+type integrations map[string]*cmodel.CustomGate
+
+var _ cstore.CustomStore = integrations(nil)
+
+func (i integrations) Insert(_ context.Context, dc int64, g *cmodel.CustomGate) error {
+	g.ID = fmt.Sprintf("gate-%d", len(i)+1)
+	g.DomainID = dc
+	cp := *g
+	i[g.ID] = &cp
+	return nil
+}
+
+func (i integrations) Select(_ context.Context, id string) (*cmodel.CustomGate, error) {
+	g, ok := i[id]
+	if !ok {
+		return nil, sharedstore.ErrNotFound
+	}
+	cp := *g
+	return &cp, nil
+}
+
+func (i integrations) Update(_ context.Context, g *cmodel.CustomGate) error {
+	cp := *g
+	i[g.ID] = &cp
+	return nil
+}
+
+func (i integrations) Delete(_ context.Context, id string) error {
+	delete(i, id)
+	return nil
+}
+
+// newCustomService derives the webhook URLs from publicURL; empty leaves them unset.
+func newCustomService(publicURL string) (*CustomService, integrations) {
+	gates := integrations{}
+	cfg := &config.Config{}
+	cfg.Service.PublicURL = publicURL
+	cfg.Service.WebhookPath = "/wh"
+	return NewCustomService(gates, cfg, noopLogger), gates
+}
+
+func TestCreateGateGeneratesSecret(t *testing.T) {
+	svc, gates := newCustomService("https://im.example.com")
+
+	gate, err := svc.CreateGate(context.Background(), cmodel.CreateCustom{
+		Name:        "Widget",
+		Dc:          1,
+		CallbackURL: "https://widget.example.com/webitel",
+	})
+	if err != nil {
+		t.Fatalf("CreateGate: %v", err)
+	}
+	if len(gate.Secret) != 2*secretSize {
+		t.Errorf("secret = %q, want %d hex chars", gate.Secret, 2*secretSize)
+	}
+	if gates["gate-1"].Secret != gate.Secret {
+		t.Error("generated secret not stored")
+	}
+	if gate.WebhookURL != "https://im.example.com/wh/custom/gate-1" {
+		t.Errorf("webhook url = %q", gate.WebhookURL)
+	}
+}
+
+func TestCreateGateValidates(t *testing.T) {
+	svc, _ := newCustomService("")
+
+	_, err := svc.CreateGate(context.Background(), cmodel.CreateCustom{Name: "Widget", Dc: 1, CallbackURL: "widget.example.com"})
+	var vErr *sharedmodel.ValidationError
+	if !errors.As(err, &vErr) || len(vErr.Fields) != 1 || vErr.Fields[0] != "callback_url" {
+		t.Errorf("err = %v, want callback_url validation error", err)
+	}
+}
+
+func TestUpdateGateRotatesSecret(t *testing.T) {
+	svc, gates := newCustomService("")
+	gates["gate-1"] = &cmodel.CustomGate{ID: "gate-1", Secret: "old", CallbackURL: "https://a.example.com"}
+	ctx := context.Background()
+
+	empty := ""
+	gate, err := svc.UpdateGate(ctx, cmodel.UpdateCustom{ID: "gate-1", Secret: &empty})
+	if err != nil {
+		t.Fatalf("UpdateGate: %v", err)
+	}
+	if gate.Secret == "old" || gate.Secret == "" || gates["gate-1"].Secret != gate.Secret {
+		t.Errorf("secret = %q, want a new stored one", gate.Secret)
+	}
+	if gate.WebhookURL != "" {
+		t.Errorf("webhook url = %q without public url", gate.WebhookURL)
+	}
+
+	bad := "ftp://a.example.com"
+	if _, err := svc.UpdateGate(ctx, cmodel.UpdateCustom{ID: "gate-1", CallbackURL: &bad}); err == nil {
+		t.Error("invalid callback url accepted")
+	}
+}
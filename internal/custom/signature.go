@@ -0,0 +1,48 @@
+package custom
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+)
+
+// signatureHeader carries "sha256=" and the hex HMAC-SHA256 of the envelope keyed by the
+// gate secret. Outbound envelopes are signed the same way.
+const signatureHeader = "X-Webitel-Signature"
+
+const signaturePrefix = "sha256="
+
+// SignatureHeader implements provider.SignatureHeader.
+func (p *customProvider) SignatureHeader() string { return signatureHeader }
+
+// ValidateSignature implements provider.SignatureValidator.
+func (p *customProvider) ValidateSignature(ctx context.Context, header string, body []byte) error {
+	if header == "" {
+		return fmt.Errorf("missing %s header", signatureHeader)
+	}
+
+	gate, err := p.resolveGate(ctx)
+	if err != nil {
+		return fmt.Errorf("signature: gate lookup failed: %w", err)
+	}
+	if !gate.Enabled {
+		// Envelopes of a disabled gate are acknowledged and dropped by HandleWebhook.
+		return nil
+	}
+
+	sig, ok := strings.CutPrefix(header, signaturePrefix)
+	if !ok || !hmac.Equal([]byte(sig), []byte(sign(gate.Secret, body))) {
+		return fmt.Errorf("signature mismatch")
+	}
+	return nil
+}
+
+// sign returns the hex HMAC-SHA256 of body keyed by secret.
+func sign(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,160 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/georgysavva/scany/v2/pgxscan"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	sharedmodel "github.com/webitel/im-providers-service/internal/core/model"
+	sharedstore "github.com/webitel/im-providers-service/internal/core/store"
+	cmodel "github.com/webitel/im-providers-service/internal/custom/model"
+	cstore "github.com/webitel/im-providers-service/internal/custom/store"
+	"github.com/webitel/im-providers-service/pkg/crypto"
+)
+
+var _ cstore.CustomStore = (*customStore)(nil)
+
+type customStore struct {
+	pool   *pgxpool.Pool
+	crypto crypto.Encryptor
+	cache  sharedstore.GateCache
+}
+
+func NewCustomStore(pool *pgxpool.Pool, crypt crypto.Encryptor, cache sharedstore.GateCache) cstore.CustomStore {
+	return &customStore{
+		pool:   pool,
+		crypto: crypt,
+		cache:  cache,
+	}
+}
+
+func (s *customStore) Insert(ctx context.Context, dc int64, g *cmodel.CustomGate) error {
+	secret, err := s.crypto.Encrypt(g.Secret)
+	if err != nil {
+		return fmt.Errorf("crypto: %w", err)
+	}
+
+	const query = `
+	WITH new_gate AS (
+		INSERT INTO im_provider.gates (dc, name, type, enabled)
+		VALUES ($1, $2, 'custom', $3)
+		RETURNING id, name, created_at, updated_at
+	),
+	new_bot AS (
+		INSERT INTO im_provider.bots (sub, iss, gate_id)
+		SELECT $4, $5, id FROM new_gate
+		RETURNING id
+	)
+	INSERT INTO im_provider.custom (gate_id, callback_url, secret)
+	SELECT id, $6, $7 FROM new_gate
+	RETURNING
+		gate_id,
+		(SELECT name FROM new_gate),
+		(SELECT created_at FROM new_gate),
+		(SELECT updated_at FROM new_gate)`
+
+	err = s.pool.QueryRow(ctx, query,
+		dc, g.Name, g.Enabled, g.Peer.Sub, g.Peer.Iss, g.CallbackURL, secret,
+	).Scan(&g.ID, &g.Name, &g.CreatedAt, &g.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("postgres: insert custom gateway: %w", err)
+	}
+
+	g.DomainID = dc
+	s.mapVirtualFields(g)
+	return nil
+}
+
+func (s *customStore) Select(ctx context.Context, id string) (*cmodel.CustomGate, error) {
+	const query = `
+	SELECT
+		g.id,
+		g.dc AS domain_id,
+		g.name,
+		g.enabled,
+		g.created_at,
+		g.updated_at,
+		b.sub AS "peer.sub",
+		b.iss AS "peer.iss",
+		c.callback_url,
+		c.secret
+	FROM im_provider.gates g
+	JOIN im_provider.bots b ON g.id = b.gate_id
+	JOIN im_provider.custom c ON g.id = c.gate_id
+	WHERE g.id = $1`
+
+	var g cmodel.CustomGate
+	if err := pgxscan.Get(ctx, s.pool, &g, query, id); err != nil {
+		if pgxscan.NotFound(err) {
+			return nil, sharedstore.ErrNotFound
+		}
+		return nil, fmt.Errorf("postgres: select custom gate: %w", err)
+	}
+
+	// A secret that cannot be decrypted would fail every signature, so it is an error.
+	dec, err := s.crypto.Decrypt(g.Secret)
+	if err != nil {
+		return nil, fmt.Errorf("custom gate %s: crypto: %w", id, err)
+	}
+	g.Secret = dec
+
+	s.mapVirtualFields(&g)
+	return &g, nil
+}
+
+func (s *customStore) Update(ctx context.Context, g *cmodel.CustomGate) error {
+	secret, err := s.crypto.Encrypt(g.Secret)
+	if err != nil {
+		return fmt.Errorf("crypto: %w", err)
+	}
+
+	err = pgx.BeginFunc(ctx, s.pool, func(tx pgx.Tx) error {
+		const uGate = `UPDATE im_provider.gates SET name = $1, enabled = $2, updated_at = NOW() WHERE id = $3 RETURNING updated_at`
+		if err := tx.QueryRow(ctx, uGate, g.Name, g.Enabled, g.ID).Scan(&g.UpdatedAt); err != nil {
+			return err
+		}
+
+		const uBot = `UPDATE im_provider.bots SET sub = $1, iss = $2 WHERE gate_id = $3`
+		if _, err := tx.Exec(ctx, uBot, g.Peer.Sub, g.Peer.Iss, g.ID); err != nil {
+			return err
+		}
+
+		const uConfig = `UPDATE im_provider.custom SET callback_url = $1, secret = $2 WHERE gate_id = $3`
+		_, err := tx.Exec(ctx, uConfig, g.CallbackURL, secret, g.ID)
+		return err
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return sharedstore.ErrNotFound
+		}
+		return fmt.Errorf("postgres: update custom gate: %w", err)
+	}
+
+	s.cache.Delete(g.ID)
+	s.mapVirtualFields(g)
+	return nil
+}
+
+func (s *customStore) Delete(ctx context.Context, id string) error {
+	res, err := s.pool.Exec(ctx, "DELETE FROM im_provider.gates WHERE id = $1 AND type = 'custom'", id)
+	if err != nil {
+		return fmt.Errorf("postgres: delete custom gate: %w", err)
+	}
+	if res.RowsAffected() == 0 {
+		return sharedstore.ErrNotFound
+	}
+
+	s.cache.Delete(id)
+	return nil
+}
+
+func (s *customStore) mapVirtualFields(g *cmodel.CustomGate) {
+	if g.Enabled {
+		g.Status = sharedmodel.StatusActive
+	} else {
+		g.Status = sharedmodel.StatusDisabled
+	}
+}
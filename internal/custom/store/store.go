@@ -0,0 +1,16 @@
+package store
+
+import (
+	"context"
+
+	cmodel "github.com/webitel/im-providers-service/internal/custom/model"
+)
+
+// CustomStore manages custom webhook gates. Secrets are stored encrypted.
+type CustomStore interface {
+	// Insert creates the gate together with its bot peer and webhook settings.
+	Insert(ctx context.Context, dc int64, g *cmodel.CustomGate) error
+	Select(ctx context.Context, id string) (*cmodel.CustomGate, error)
+	Update(ctx context.Context, g *cmodel.CustomGate) error
+	Delete(ctx context.Context, id string) error
+}
@@ -0,0 +1,57 @@
+package custom
+
+import (
+	"context"
+
+	gatewayv1 "github.com/webitel/im-providers-service/gen/go/gateway/v1"
+	sharedmodel "github.com/webitel/im-providers-service/internal/core/model"
+	cmodel "github.com/webitel/im-providers-service/internal/custom/model"
+	"github.com/webitel/im-providers-service/internal/provider/contactsync"
+)
+
+// syncContact resolves the internal contact for a channel user, creating it
+// if necessary. The result is cached so repeated envelopes from the same
+// user skip the gateway round-trip.
+func (p *customProvider) syncContact(ctx context.Context, gate *cmodel.CustomGate, u *User) (*gatewayv1.Contact, error) {
+	external := toExternalUser(u)
+	key := contactsync.KnownUser(gate.ID, external)
+
+	if known, _ := p.userCache.IsKnown(ctx, key); known {
+		return &gatewayv1.Contact{Sub: u.ID}, nil
+	}
+
+	authCtx := contactsync.WithGatewayIdentity(ctx, gate.DomainID, gate.Peer)
+
+	contact, err := p.ensureContact(authCtx, external, u)
+	if err != nil {
+		return nil, err
+	}
+
+	contactsync.EnsureVia(authCtx, p.gatewayer, p.logger, gate.ID, u.ID, contact.Iss)
+	_ = p.userCache.MarkKnown(ctx, key)
+
+	return contact, nil
+}
+
+// ensureContact creates the internal contact or returns a stub when the
+// contact already exists (idempotent by design on the gateway side).
+// The user metadata of the envelope is stored on the contact as-is.
+func (p *customProvider) ensureContact(ctx context.Context, external *sharedmodel.ExternalUser, u *User) (*gatewayv1.Contact, error) {
+	return contactsync.CreateContact(ctx, p.gatewayer, &gatewayv1.CreateContactRequest{
+		IssId:    p.Type(),
+		Type:     p.Type(),
+		Name:     external.FirstName,
+		Subject:  external.ID,
+		Metadata: u.Metadata,
+	})
+}
+
+// toExternalUser maps a channel user to the domain cache key.
+// Users without a name fall back to the user ID as the display name.
+func toExternalUser(u *User) *sharedmodel.ExternalUser {
+	name := u.Name
+	if name == "" {
+		name = u.ID
+	}
+	return &sharedmodel.ExternalUser{ID: u.ID, FirstName: name}
+}
@@ -0,0 +1,124 @@
+package custom
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	sharedmodel "github.com/webitel/im-providers-service/internal/core/model"
+	cmodel "github.com/webitel/im-providers-service/internal/custom/model"
+	"github.com/webitel/im-providers-service/internal/provider/contactsync"
+)
+
+// errNoContent is returned for a message envelope without anything to forward.
+var errNoContent = errors.New("custom: message has no content")
+
+// HandleWebhook forwards an inbound envelope. Unlike the platform providers it reports
+// malformed envelopes back: the channel is written by the customer, who needs to
+// see why an envelope was refused.
+func (p *customProvider) HandleWebhook(ctx context.Context, data []byte) error {
+	var env Envelope
+	if err := json.Unmarshal(data, &env); err != nil {
+		return fmt.Errorf("custom: malformed envelope: %w", err)
+	}
+
+	gate, err := p.resolveGate(ctx)
+	if err != nil || !gate.Enabled {
+		return err
+	}
+
+	if env.User.ID == "" {
+		return fmt.Errorf("custom: envelope %q has no user id", env.ID)
+	}
+	if _, err := p.syncContact(ctx, gate, &env.User); err != nil {
+		return fmt.Errorf("sync contact [user_id=%s]: %w", env.User.ID, err)
+	}
+
+	peers := contactsync.InboundPeers(gate.ID, gate.Peer, env.User.ID)
+	switch env.Event {
+	case EventMessage:
+		if env.Message == nil {
+			return errNoContent
+		}
+		return p.routeMessage(ctx, gate, peers, env.ID, env.Message)
+	case EventCallback:
+		if env.Callback == nil || env.Callback.ButtonCode == "" {
+			return fmt.Errorf("custom: callback envelope %q has no button code", env.ID)
+		}
+		return p.messenger.SendInteractiveCallback(ctx, &sharedmodel.SendInteractiveCallbackRequest{
+			DomainID:     gate.DomainID,
+			From:         peers.From,
+			To:           peers.To,
+			InReplyTo:    env.Callback.InReplyTo,
+			ButtonCode:   env.Callback.ButtonCode,
+			CallbackData: env.Callback.Data,
+		})
+	default:
+		return fmt.Errorf("custom: unsupported event %q", env.Event)
+	}
+}
+
+// routeMessage forwards the richest content of the message: images, documents,
+// a location, contacts, then plain text. The text is the caption of media.
+func (p *customProvider) routeMessage(ctx context.Context, gate *cmodel.CustomGate, peers contactsync.Peers, externalID string, msg *sharedmodel.Message) error {
+	switch {
+	case len(msg.Images) > 0:
+		return p.sendImages(ctx, gate, peers, msg)
+	case len(msg.Documents) > 0:
+		return p.sendDocuments(ctx, gate, peers, msg)
+	case msg.Location != nil:
+		req := &sharedmodel.SendLocationRequest{
+			DomainID:   int(gate.DomainID),
+			From:       peers.From,
+			To:         peers.To,
+			Latitude:   msg.Location.Latitude,
+			Longitude:  msg.Location.Longitude,
+			ExternalID: externalID,
+		}
+		if msg.Location.Name != "" {
+			req.Name = &msg.Location.Name
+		}
+		if msg.Location.Address != "" {
+			req.Address = &msg.Location.Address
+		}
+		_, err := p.messenger.SendLocation(ctx, req)
+		return err
+	case len(msg.Contacts) > 0:
+		for _, card := range msg.Contacts {
+			if err := p.sendContact(ctx, gate, peers, card, externalID); err != nil {
+				return err
+			}
+		}
+		return nil
+	case msg.Text != "":
+		_, err := p.messenger.SendText(ctx, &sharedmodel.SendTextRequest{
+			DomainID: gate.DomainID,
+			From:     peers.From,
+			To:       peers.To,
+			Body:     msg.Text,
+		})
+		return err
+	}
+	return errNoContent
+}
+
+func (p *customProvider) sendContact(ctx context.Context, gate *cmodel.CustomGate, peers contactsync.Peers, card *sharedmodel.ContactCard, externalID string) error {
+	req := &sharedmodel.SendContactRequest{
+		DomainID:   int(gate.DomainID),
+		From:       peers.From,
+		To:         peers.To,
+		ExternalID: externalID,
+	}
+	if name := card.FormattedName(); name != "" {
+		req.Name = &name
+	}
+	if len(card.Phones) > 0 {
+		req.PhoneNumber = &card.Phones[0]
+	}
+	if len(card.Emails) > 0 {
+		req.Email = &card.Emails[0]
+	}
+	_, err := p.messenger.SendContact(ctx, req)
+	return err
+}
@@ -0,0 +1,152 @@
+package handler
+
+import (
+	"context"
+	"log/slog"
+
+	impb "github.com/webitel/im-providers-service/gen/go/provider/v1"
+	emailmodel "github.com/webitel/im-providers-service/internal/email/model"
+	emailservice "github.com/webitel/im-providers-service/internal/email/service"
+	"github.com/webitel/im-providers-service/internal/provider/gaterpc"
+)
+
+type EmailHandler struct {
+	logger *slog.Logger
+	srv    emailservice.EmailManager
+	impb.UnimplementedEmailServiceServer
+}
+
+func NewEmailHandler(logger *slog.Logger, srv emailservice.EmailManager) *EmailHandler {
+	return &EmailHandler{logger: logger, srv: srv}
+}
+
+func (h *EmailHandler) CreateEmailGate(ctx context.Context, req *impb.ProviderCreateEmailGateRequest) (*impb.ProviderCreateEmailGateResponse, error) {
+	domainID, err := gaterpc.DomainID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	create := emailmodel.CreateEmail{
+		Name:        req.GetName(),
+		Dc:          domainID,
+		Address:     req.GetAddress(),
+		DisplayName: req.GetDisplayName(),
+		Mailbox:     req.GetMailbox(),
+	}
+	if imap := serverFromProto(req.GetImap()); imap != nil {
+		create.IMAP = *imap
+	}
+	if smtp := serverFromProto(req.GetSmtp()); smtp != nil {
+		create.SMTP = *smtp
+	}
+	if peer := gaterpc.Peer(req.GetPeer()); peer != nil {
+		create.Peer = *peer
+	}
+
+	gate, err := h.srv.CreateGate(ctx, create)
+	if err != nil {
+		return nil, gaterpc.ToStatus(err, "create gate")
+	}
+
+	return &impb.ProviderCreateEmailGateResponse{Item: gateToProto(gate)}, nil
+}
+
+func (h *EmailHandler) GetEmailGate(ctx context.Context, req *impb.ProviderGetEmailGateRequest) (*impb.ProviderGetEmailGateResponse, error) {
+	gate, err := h.gate(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	return &impb.ProviderGetEmailGateResponse{Item: gateToProto(gate)}, nil
+}
+
+func (h *EmailHandler) UpdateEmailGate(ctx context.Context, req *impb.ProviderUpdateEmailGateRequest) (*impb.ProviderUpdateEmailGateResponse, error) {
+	if _, err := h.gate(ctx, req.GetId()); err != nil {
+		return nil, err
+	}
+
+	gate, err := h.srv.UpdateGate(ctx, emailmodel.UpdateEmail{
+		ID:          req.GetId(),
+		Name:        req.Name,
+		Address:     req.Address,
+		DisplayName: req.DisplayName,
+		IMAP:        serverFromProto(req.GetImap()),
+		SMTP:        serverFromProto(req.GetSmtp()),
+		Mailbox:     req.Mailbox,
+		Enabled:     req.Enabled,
+		Peer:        gaterpc.Peer(req.GetPeer()),
+	})
+	if err != nil {
+		return nil, gaterpc.ToStatus(err, "update gate")
+	}
+
+	return &impb.ProviderUpdateEmailGateResponse{Item: gateToProto(gate)}, nil
+}
+
+func (h *EmailHandler) DeleteEmailGate(ctx context.Context, req *impb.ProviderDeleteEmailGateRequest) (*impb.ProviderDeleteEmailGateResponse, error) {
+	if _, err := h.gate(ctx, req.GetId()); err != nil {
+		return nil, err
+	}
+
+	gate, err := h.srv.DeleteGate(ctx, req.GetId())
+	if err != nil {
+		return nil, gaterpc.ToStatus(err, "delete gate")
+	}
+
+	return &impb.ProviderDeleteEmailGateResponse{Item: gateToProto(gate)}, nil
+}
+
+// gate returns the gate when it belongs to the domain of the caller.
+func (h *EmailHandler) gate(ctx context.Context, id string) (*emailmodel.EmailGate, error) {
+	gate, err := h.srv.GetGate(ctx, id)
+	if err != nil {
+		return nil, gaterpc.ToStatus(err, "get gate")
+	}
+	if err := gaterpc.CheckDomain(ctx, gate.DomainID); err != nil {
+		return nil, err
+	}
+	return gate, nil
+}
+
+func serverFromProto(s *impb.ProviderMailServer) *emailmodel.ServerConfig {
+	if s == nil {
+		return nil
+	}
+	return &emailmodel.ServerConfig{
+		Host:     s.GetHost(),
+		Port:     int(s.GetPort()),
+		Username: s.GetUsername(),
+		Password: s.GetPassword(),
+		TLS:      s.GetTls(),
+	}
+}
+
+// serverToProto leaves out the password.
+func serverToProto(c emailmodel.ServerConfig) *impb.ProviderMailServer {
+	return &impb.ProviderMailServer{
+		Host:     c.Host,
+		Port:     int32(c.Port),
+		Username: c.Username,
+		Tls:      c.TLS,
+	}
+}
+
+func gateToProto(g *emailmodel.EmailGate) *impb.ProviderEmailGate {
+	if g == nil {
+		return nil
+	}
+	return &impb.ProviderEmailGate{
+		Id:          g.ID,
+		Name:        g.Name,
+		Peer:        gaterpc.ToProtoPeer(g.Peer),
+		Address:     g.Address,
+		DisplayName: g.DisplayName,
+		Imap:        serverToProto(g.IMAP),
+		Smtp:        serverToProto(g.SMTP),
+		Mailbox:     g.Mailbox,
+		Status:      impb.ProviderStatus(g.Status),
+		CreatedAt:   g.CreatedAt.UnixMilli(),
+		UpdatedAt:   g.UpdatedAt.UnixMilli(),
+		Enabled:     g.Enabled,
+	}
+}
@@ -0,0 +1,195 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"testing"
+
+	impb "github.com/webitel/im-providers-service/gen/go/provider/v1"
+	"github.com/webitel/im-providers-service/infra/auth"
+	sharedmodel "github.com/webitel/im-providers-service/internal/core/model"
+	sharedstore "github.com/webitel/im-providers-service/internal/core/store"
+	emailmodel "github.com/webitel/im-providers-service/internal/email/model"
+	emailservice "github.com/webitel/im-providers-service/internal/email/service"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type agent struct{ domainID int64 }
+
+func (a agent) GetContactID() string { return "" }
+func (a agent) GetDomainID() int64   { return a.domainID }
+func (a agent) GetName() string      { return "" }
+
+func agentContext(domainID int64) context.Context {
+	return context.WithValue(context.Background(), auth.AuthContextKey, agent{domainID: domainID})
+}
+
+// postOffice keeps the mailboxes like the email service does: requests are validated
+// and passwords left empty on update keep the stored ones.
+type postOffice struct {
+	gates   map[string]*emailmodel.EmailGate
+	deleted []string
+}
+
+var _ emailservice.EmailManager = (*postOffice)(nil)
+
+func newPostOffice(gates ...*emailmodel.EmailGate) *postOffice {
+	o := &postOffice{gates: map[string]*emailmodel.EmailGate{}}
+	for _, g := range gates {
+		o.gates[g.ID] = g
+	}
+	return o
+}
+
+func (o *postOffice) CreateGate(_ context.Context, req emailmodel.CreateEmail) (*emailmodel.EmailGate, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	gate := &emailmodel.EmailGate{
+		ID:          fmt.Sprintf("gate-%d", len(o.gates)+1),
+		DomainID:    req.Dc,
+		Name:        req.Name,
+		Address:     req.Address,
+		DisplayName: req.DisplayName,
+		IMAP:        req.IMAP,
+		SMTP:        req.SMTP,
+		Mailbox:     req.Mailbox,
+		Peer:        req.Peer,
+		Enabled:     true,
+	}
+	o.gates[gate.ID] = gate
+	return gate, nil
+}
+
+func (o *postOffice) GetGate(_ context.Context, id string) (*emailmodel.EmailGate, error) {
+	g, ok := o.gates[id]
+	if !ok {
+		return nil, sharedstore.ErrNotFound
+	}
+	return g, nil
+}
+
+func (o *postOffice) UpdateGate(_ context.Context, req emailmodel.UpdateEmail) (*emailmodel.EmailGate, error) {
+	gate := *o.gates[req.ID]
+	req.ApplyTo(&gate)
+	if invalid := emailmodel.ValidateAccount(gate.Address, gate.IMAP, gate.SMTP); len(invalid) > 0 {
+		return nil, &sharedmodel.ValidationError{Fields: invalid}
+	}
+	o.gates[req.ID] = &gate
+	return &gate, nil
+}
+
+func (o *postOffice) DeleteGate(_ context.Context, id string) (*emailmodel.EmailGate, error) {
+	o.deleted = append(o.deleted, id)
+	return o.gates[id], nil
+}
+
+func supportMailbox() *emailmodel.EmailGate {
+	return &emailmodel.EmailGate{
+		ID:       "gate-1",
+		DomainID: 7,
+		Name:     "Support",
+		Address:  "support@webitel.com",
+		IMAP:     emailmodel.ServerConfig{Host: "imap.webitel.com", Port: 993, Username: "support@webitel.com", Password: "imap-secret", TLS: true},
+		SMTP:     emailmodel.ServerConfig{Host: "smtp.webitel.com", Port: 587, Username: "support@webitel.com", Password: "smtp-secret"},
+		Mailbox:  emailmodel.DefaultMailbox,
+		Enabled:  true,
+	}
+}
+
+func newHandler(o *postOffice) *EmailHandler {
+	return NewEmailHandler(slog.New(slog.NewTextHandler(io.Discard, nil)), o)
+}
+
+func TestCreateEmailGate_HidesPasswords(t *testing.T) {
+	o := newPostOffice()
+	h := newHandler(o)
+
+	resp, err := h.CreateEmailGate(agentContext(7), &impb.ProviderCreateEmailGateRequest{
+		Name:        "Support",
+		Address:     "support@webitel.com",
+		DisplayName: "Webitel Support",
+		Imap:        &impb.ProviderMailServer{Host: "imap.webitel.com", Port: 993, Username: "support@webitel.com", Password: "imap-secret", Tls: true},
+		Smtp:        &impb.ProviderMailServer{Host: "smtp.webitel.com", Port: 587, Username: "support@webitel.com", Password: "smtp-secret"},
+	})
+	if err != nil {
+		t.Fatalf("CreateEmailGate: %v", err)
+	}
+	item := resp.GetItem()
+	if item.GetImap().GetPort() != 993 || !item.GetImap().GetTls() || item.GetSmtp().GetHost() != "smtp.webitel.com" {
+		t.Errorf("servers = %+v, %+v", item.GetImap(), item.GetSmtp())
+	}
+	if item.GetImap().GetPassword() != "" || item.GetSmtp().GetPassword() != "" {
+		t.Error("passwords returned")
+	}
+	if g := o.gates["gate-1"]; g.DomainID != 7 || g.IMAP.Password != "imap-secret" || g.SMTP.Password != "smtp-secret" {
+		t.Errorf("stored gate = %+v", g)
+	}
+}
+
+func TestEmailGate_InvalidAccount(t *testing.T) {
+	h := newHandler(newPostOffice(supportMailbox()))
+	ctx := agentContext(7)
+
+	_, err := h.CreateEmailGate(ctx, &impb.ProviderCreateEmailGateRequest{
+		Name:    "Support",
+		Address: "Support <support@webitel.com>",
+		Imap:    &impb.ProviderMailServer{Host: "imap.webitel.com", Port: 993, Username: "support@webitel.com"},
+		Smtp:    &impb.ProviderMailServer{Host: "smtp.webitel.com", Port: 587},
+	})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("create with display name in address: code = %v, want InvalidArgument", status.Code(err))
+	}
+
+	_, err = h.UpdateEmailGate(ctx, &impb.ProviderUpdateEmailGateRequest{
+		Id:   "gate-1",
+		Smtp: &impb.ProviderMailServer{Host: "smtp.webitel.com", Port: 70000},
+	})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("update with smtp port out of range: code = %v, want InvalidArgument", status.Code(err))
+	}
+}
+
+func TestUpdateEmailGate_NewIMAPServerKeepsPassword(t *testing.T) {
+	o := newPostOffice(supportMailbox())
+	h := newHandler(o)
+
+	archive := "Support/Incoming"
+	resp, err := h.UpdateEmailGate(agentContext(7), &impb.ProviderUpdateEmailGateRequest{
+		Id:      "gate-1",
+		Imap:    &impb.ProviderMailServer{Host: "mail.webitel.com", Port: 143, Username: "support@webitel.com"},
+		Mailbox: &archive,
+	})
+	if err != nil {
+		t.Fatalf("UpdateEmailGate: %v", err)
+	}
+	if item := resp.GetItem(); item.GetImap().GetHost() != "mail.webitel.com" || item.GetMailbox() != archive || item.GetSmtp().GetHost() != "smtp.webitel.com" {
+		t.Errorf("item = %+v", item)
+	}
+	if g := o.gates["gate-1"]; g.IMAP.Password != "imap-secret" || g.SMTP.Password != "smtp-secret" {
+		t.Errorf("passwords = %q, %q", g.IMAP.Password, g.SMTP.Password)
+	}
+}
+
+func TestEmailGate_OtherDomainIsNotFound(t *testing.T) {
+	o := newPostOffice(supportMailbox())
+	h := newHandler(o)
+	ctx := agentContext(8)
+
+	if _, err := h.GetEmailGate(ctx, &impb.ProviderGetEmailGateRequest{Id: "gate-1"}); status.Code(err) != codes.NotFound {
+		t.Errorf("get: code = %v, want NotFound", status.Code(err))
+	}
+	disabled := false
+	if _, err := h.UpdateEmailGate(ctx, &impb.ProviderUpdateEmailGateRequest{Id: "gate-1", Enabled: &disabled}); status.Code(err) != codes.NotFound {
+		t.Errorf("update: code = %v, want NotFound", status.Code(err))
+	}
+	if _, err := h.DeleteEmailGate(ctx, &impb.ProviderDeleteEmailGateRequest{Id: "gate-1"}); status.Code(err) != codes.NotFound {
+		t.Errorf("delete: code = %v, want NotFound", status.Code(err))
+	}
+	if !o.gates["gate-1"].Enabled || len(o.deleted) != 0 {
+		t.Errorf("gate of another domain changed: %+v, deleted %v", o.gates["gate-1"], o.deleted)
+	}
+}
@@ -0,0 +1,270 @@
+// Package imap implements the subset of IMAP4rev1 the email provider needs to collect
+// inbound mail: LOGIN, SELECT, UID SEARCH/FETCH/STORE and IDLE (RFC 3501, RFC 2177).
+package imap
+
+import (
+	"bufio"
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ErrNo is returned for a command the server answered with NO or BAD.
+var ErrNo = errors.New("imap: command failed")
+
+// Client is a connection to an IMAP server. It is not safe for concurrent use.
+type Client struct {
+	conn net.Conn
+	r    *bufio.Reader
+	tag  int
+	caps map[string]bool
+}
+
+// Dial connects to addr, over TLS when tlsConfig is set, and reads the greeting.
+func Dial(ctx context.Context, addr string, tlsConfig *tls.Config) (*Client, error) {
+	var d net.Dialer
+	conn, err := d.DialContext(ctx, "tcp", addr)
+	if err != nil {
+		return nil, fmt.Errorf("imap: dial %s: %w", addr, err)
+	}
+	if tlsConfig != nil {
+		conn = tls.Client(conn, tlsConfig)
+	}
+
+	c := &Client{conn: conn, r: bufio.NewReader(conn)}
+	line, _, err := c.readResponse()
+	if err != nil {
+		conn.Close()
+		return nil, err
+	}
+	if !strings.HasPrefix(line, "* OK") && !strings.HasPrefix(line, "* PREAUTH") {
+		conn.Close()
+		return nil, fmt.Errorf("imap: unexpected greeting %q", line)
+	}
+	return c, nil
+}
+
+// Close closes the connection without logging out; it unblocks a pending read.
+func (c *Client) Close() error { return c.conn.Close() }
+
+// Login authenticates and loads the capabilities of the server.
+func (c *Client) Login(username, password string) error {
+	if _, err := c.command("LOGIN " + quote(username) + " " + quote(password)); err != nil {
+		return err
+	}
+
+	untagged, err := c.command("CAPABILITY")
+	if err != nil {
+		return err
+	}
+	c.caps = map[string]bool{}
+	for _, u := range untagged {
+		if rest, ok := strings.CutPrefix(u.line, "* CAPABILITY "); ok {
+			for _, cp := range strings.Fields(rest) {
+				c.caps[strings.ToUpper(cp)] = true
+			}
+		}
+	}
+	return nil
+}
+
+// SupportsIdle reports whether the server advertised IDLE.
+func (c *Client) SupportsIdle() bool { return c.caps["IDLE"] }
+
+// Select opens the mailbox read-write.
+func (c *Client) Select(mailbox string) error {
+	_, err := c.command("SELECT " + quote(mailbox))
+	return err
+}
+
+// SearchUnseen returns the UIDs of the messages without the \Seen flag.
+func (c *Client) SearchUnseen() ([]uint32, error) {
+	untagged, err := c.command("UID SEARCH UNSEEN")
+	if err != nil {
+		return nil, err
+	}
+
+	var uids []uint32
+	for _, u := range untagged {
+		rest, ok := strings.CutPrefix(u.line, "* SEARCH")
+		if !ok {
+			continue
+		}
+		for _, f := range strings.Fields(rest) {
+			uid, err := strconv.ParseUint(f, 10, 32)
+			if err != nil {
+				return nil, fmt.Errorf("imap: malformed search result %q", u.line)
+			}
+			uids = append(uids, uint32(uid))
+		}
+	}
+	return uids, nil
+}
+
+// Fetch returns the raw RFC 5322 message without setting its \Seen flag.
+func (c *Client) Fetch(uid uint32) ([]byte, error) {
+	untagged, err := c.command(fmt.Sprintf("UID FETCH %d (BODY.PEEK[])", uid))
+	if err != nil {
+		return nil, err
+	}
+	for _, u := range untagged {
+		if strings.Contains(u.line, " FETCH ") && len(u.literals) > 0 {
+			return u.literals[0], nil
+		}
+	}
+	return nil, fmt.Errorf("imap: message %d not found", uid)
+}
+
+// MarkSeen sets the \Seen flag of the message.
+func (c *Client) MarkSeen(uid uint32) error {
+	_, err := c.command(fmt.Sprintf(`UID STORE %d +FLAGS.SILENT (\Seen)`, uid))
+	return err
+}
+
+// Idle waits up to max for the server to report new messages in the selected
+// mailbox. It returns false when the wait timed out.
+func (c *Client) Idle(max time.Duration) (bool, error) {
+	tag := c.nextTag()
+	if err := c.write(tag + " IDLE"); err != nil {
+		return false, err
+	}
+	line, _, err := c.readResponse()
+	if err != nil {
+		return false, err
+	}
+	if !strings.HasPrefix(line, "+") {
+		return false, fmt.Errorf("%w: IDLE: %s", ErrNo, line)
+	}
+
+	_ = c.conn.SetReadDeadline(time.Now().Add(max))
+	arrived := false
+	for !arrived {
+		line, _, err = c.readResponse()
+		if err != nil {
+			var netErr net.Error
+			if errors.As(err, &netErr) && netErr.Timeout() && c.r.Buffered() == 0 {
+				break
+			}
+			return false, err
+		}
+		arrived = strings.HasSuffix(line, " EXISTS") || strings.HasSuffix(line, " RECENT")
+	}
+	_ = c.conn.SetReadDeadline(time.Time{})
+
+	if err := c.write("DONE"); err != nil {
+		return false, err
+	}
+	if _, err := c.readTagged(tag); err != nil {
+		return false, err
+	}
+	return arrived, nil
+}
+
+// Logout ends the session and closes the connection.
+func (c *Client) Logout() error {
+	_, err := c.command("LOGOUT")
+	c.conn.Close()
+	return err
+}
+
+// response is an untagged response line with its literals cut out.
+type response struct {
+	line     string
+	literals [][]byte
+}
+
+func (c *Client) command(cmd string) ([]response, error) {
+	tag := c.nextTag()
+	if err := c.write(tag + " " + cmd); err != nil {
+		return nil, err
+	}
+	untagged, err := c.readTagged(tag)
+	if err != nil {
+		// The command line may carry a password; only its verb goes into the error.
+		verb, _, _ := strings.Cut(cmd, " ")
+		return nil, fmt.Errorf("%s: %w", verb, err)
+	}
+	return untagged, nil
+}
+
+// readTagged reads the responses up to the tagged completion of the command.
+func (c *Client) readTagged(tag string) ([]response, error) {
+	var untagged []response
+	for {
+		line, literals, err := c.readResponse()
+		if err != nil {
+			return nil, err
+		}
+		status, ok := strings.CutPrefix(line, tag+" ")
+		if !ok {
+			untagged = append(untagged, response{line: line, literals: literals})
+			continue
+		}
+		if strings.HasPrefix(strings.ToUpper(status), "OK") {
+			return untagged, nil
+		}
+		return nil, fmt.Errorf("%w: %s", ErrNo, status)
+	}
+}
+
+// readResponse reads a response line. Literals ({n} followed by n octets) are cut out
+// of the line and returned in order.
+func (c *Client) readResponse() (string, [][]byte, error) {
+	var (
+		b        strings.Builder
+		literals [][]byte
+	)
+	for {
+		line, err := c.r.ReadString('\n')
+		if err != nil {
+			return "", nil, err
+		}
+		line = strings.TrimRight(line, "\r\n")
+
+		n, ok := literalSize(line)
+		if !ok {
+			b.WriteString(line)
+			return b.String(), literals, nil
+		}
+		b.WriteString(line[:strings.LastIndexByte(line, '{')])
+		lit := make([]byte, n)
+		if _, err := io.ReadFull(c.r, lit); err != nil {
+			return "", nil, err
+		}
+		literals = append(literals, lit)
+	}
+}
+
+// literalSize returns n of a line ending in {n}.
+func literalSize(line string) (int, bool) {
+	if !strings.HasSuffix(line, "}") {
+		return 0, false
+	}
+	i := strings.LastIndexByte(line, '{')
+	if i < 0 {
+		return 0, false
+	}
+	n, err := strconv.Atoi(line[i+1 : len(line)-1])
+	return n, err == nil && n >= 0
+}
+
+func (c *Client) write(line string) error {
+	_, err := io.WriteString(c.conn, line+"\r\n")
+	return err
+}
+
+func (c *Client) nextTag() string {
+	c.tag++
+	return fmt.Sprintf("a%d", c.tag)
+}
+
+// quote returns s as an IMAP quoted string.
+func quote(s string) string {
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(s) + `"`
+}
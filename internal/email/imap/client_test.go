@@ -0,0 +1,116 @@
+package imap_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/webitel/im-providers-service/internal/email/imap"
+	"github.com/webitel/im-providers-service/internal/email/mailtest"
+)
+
+const testMessage = "From: alice@example.com\r\nSubject: Hi\r\n\r\nHello {braces}\r\n"
+
+func startServer(t *testing.T, idle bool) *mailtest.IMAPServer {
+	t.Helper()
+	srv, err := mailtest.NewIMAPServer("support@example.com", `pa"ss\word`, idle)
+	if err != nil {
+		t.Fatalf("NewIMAPServer: %v", err)
+	}
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func login(t *testing.T, srv *mailtest.IMAPServer) *imap.Client {
+	t.Helper()
+	c, err := imap.Dial(context.Background(), srv.Addr, nil)
+	if err != nil {
+		t.Fatalf("Dial: %v", err)
+	}
+	t.Cleanup(func() { _ = c.Close() })
+	if err := c.Login("support@example.com", `pa"ss\word`); err != nil {
+		t.Fatalf("Login: %v", err)
+	}
+	if err := c.Select("INBOX"); err != nil {
+		t.Fatalf("Select: %v", err)
+	}
+	return c
+}
+
+func TestLoginRejected(t *testing.T) {
+	srv := startServer(t, false)
+	c, err := imap.Dial(context.Background(), srv.Addr, nil)
+	if err != nil {
+		t.Fatalf("Dial: %v", err)
+	}
+	defer c.Close()
+
+	err = c.Login("support@example.com", "wrong")
+	if !errors.Is(err, imap.ErrNo) {
+		t.Fatalf("err = %v, want ErrNo", err)
+	}
+	if got := err.Error(); got != "LOGIN: imap: command failed: NO [AUTHENTICATIONFAILED] invalid credentials" {
+		t.Errorf("error leaks the command: %q", got)
+	}
+}
+
+func TestSearchFetchMarkSeen(t *testing.T) {
+	srv := startServer(t, false)
+	first := srv.Append([]byte(testMessage))
+	srv.Append([]byte(testMessage))
+	c := login(t, srv)
+
+	if c.SupportsIdle() {
+		t.Error("IDLE reported for a server without it")
+	}
+	if err := c.MarkSeen(first); err != nil {
+		t.Fatalf("MarkSeen: %v", err)
+	}
+	uids, err := c.SearchUnseen()
+	if err != nil || len(uids) != 1 || uids[0] != 2 {
+		t.Fatalf("SearchUnseen = %v, %v", uids, err)
+	}
+
+	raw, err := c.Fetch(2)
+	if err != nil {
+		t.Fatalf("Fetch: %v", err)
+	}
+	// The message ends in "}" lines of its own; only the literal marker is parsed.
+	if string(raw) != testMessage {
+		t.Errorf("Fetch = %q", raw)
+	}
+	if srv.Seen(2) {
+		t.Error("Fetch set \\Seen")
+	}
+	if err := c.Logout(); err != nil {
+		t.Errorf("Logout: %v", err)
+	}
+}
+
+func TestIdle(t *testing.T) {
+	srv := startServer(t, true)
+	c := login(t, srv)
+	if !c.SupportsIdle() {
+		t.Fatal("IDLE not reported")
+	}
+
+	arrived, err := c.Idle(50 * time.Millisecond)
+	if err != nil || arrived {
+		t.Fatalf("Idle on an empty mailbox = %v, %v", arrived, err)
+	}
+
+	go func() {
+		time.Sleep(20 * time.Millisecond)
+		srv.Append([]byte(testMessage))
+	}()
+	arrived, err = c.Idle(5 * time.Second)
+	if err != nil || !arrived {
+		t.Fatalf("Idle = %v, %v, want new mail", arrived, err)
+	}
+
+	// The connection is usable after IDLE.
+	if uids, err := c.SearchUnseen(); err != nil || len(uids) != 1 {
+		t.Errorf("SearchUnseen after IDLE = %v, %v", uids, err)
+	}
+}
@@ -0,0 +1,157 @@
+package email
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	sharedmodel "github.com/webitel/im-providers-service/internal/core/model"
+	sharedstore "github.com/webitel/im-providers-service/internal/core/store"
+	"github.com/webitel/im-providers-service/internal/email/mailmsg"
+	emailmodel "github.com/webitel/im-providers-service/internal/email/model"
+	"github.com/webitel/im-providers-service/internal/provider/contactsync"
+)
+
+// handleMail forwards a message collected from the mailbox of the gate. Unreadable
+// messages, automatic responses and the own messages of the gate are dropped, since
+// collecting them again would not change anything; forwarding errors are returned,
+// so the message stays unseen and is retried.
+func (p *emailProvider) handleMail(ctx context.Context, gate *emailmodel.EmailGate, raw []byte) error {
+	log := p.logger.With("gate_id", gate.ID)
+
+	msg, err := mailmsg.Parse(raw)
+	if err != nil {
+		log.Warn("unreadable message dropped", "err", err)
+		return nil
+	}
+	if msg.AutoReply {
+		log.Debug("automatic response dropped", "from", msg.From.Address, "message_id", msg.MessageID)
+		return nil
+	}
+	from := strings.ToLower(msg.From.Address)
+	if strings.EqualFold(from, gate.Address) {
+		return nil
+	}
+
+	if err := p.syncContact(ctx, gate, from, msg.From.Name); err != nil {
+		return fmt.Errorf("sync contact [email=%s]: %w", from, err)
+	}
+
+	thread, started, err := p.inboundThread(ctx, gate, from, msg)
+	if err != nil {
+		return err
+	}
+
+	text := msg.Text
+	if started && msg.Subject != "" {
+		// The first message of a thread carries its subject, which replies keep.
+		text = strings.TrimSpace(msg.Subject + "\n\n" + text)
+	}
+
+	peers := contactsync.InboundPeers(gate.ID, gate.Peer, from)
+	if len(msg.Attachments) > 0 {
+		err = p.sendAttachments(ctx, gate, peers, text, msg)
+	} else if text != "" {
+		_, err = p.messenger.SendText(ctx, &sharedmodel.SendTextRequest{
+			From:     peers.From,
+			To:       peers.To,
+			Body:     text,
+			DomainID: gate.DomainID,
+		})
+	}
+	if err != nil {
+		return err
+	}
+
+	// The message is forwarded already: a thread that is not saved only loses the
+	// threading headers of the next reply.
+	if err := p.threads.SaveThread(ctx, thread); err != nil {
+		log.Warn("thread not saved", "contact", from, "err", err)
+	}
+	return nil
+}
+
+// inboundThread returns the thread of the correspondent with the message appended. A
+// message that answers none of the messages of the current thread starts a new one,
+// which continues the references of the message.
+func (p *emailProvider) inboundThread(ctx context.Context, gate *emailmodel.EmailGate, from string, msg *mailmsg.Message) (*emailmodel.Thread, bool, error) {
+	thread, err := p.threads.GetThread(ctx, gate.ID, from)
+	if err != nil {
+		if !errors.Is(err, sharedstore.ErrNotFound) {
+			return nil, false, err
+		}
+		thread = &emailmodel.Thread{GateID: gate.ID, Contact: from}
+	}
+
+	started := !answers(thread, msg)
+	if started {
+		thread.Subject = msg.Subject
+		thread.References = append([]string(nil), msg.References...)
+		if msg.InReplyTo != "" && !containsID(thread.References, msg.InReplyTo) {
+			thread.References = append(thread.References, msg.InReplyTo)
+		}
+	}
+	thread.Append(msg.MessageID)
+	return thread, started, nil
+}
+
+// answers reports whether the message replies to a message of the thread.
+func answers(thread *emailmodel.Thread, msg *mailmsg.Message) bool {
+	if len(thread.References) == 0 {
+		return false
+	}
+	if containsID(thread.References, msg.InReplyTo) {
+		return true
+	}
+	for _, ref := range msg.References {
+		if containsID(thread.References, ref) {
+			return true
+		}
+	}
+	return false
+}
+
+func containsID(ids []string, id string) bool {
+	if id == "" {
+		return false
+	}
+	for _, v := range ids {
+		if v == id {
+			return true
+		}
+	}
+	return false
+}
+
+// sendAttachments copies the attachments to the storage and forwards them as documents
+// with the text as the caption.
+func (p *emailProvider) sendAttachments(ctx context.Context, gate *emailmodel.EmailGate, peers contactsync.Peers, text string, msg *mailmsg.Message) error {
+	docs := make([]*sharedmodel.Document, 0, len(msg.Attachments))
+	for _, a := range msg.Attachments {
+		uploaded, err := p.media.UploadFile(ctx, sharedmodel.UploadRequest{
+			DomainID:   gate.DomainID,
+			Name:       a.Name,
+			MimeType:   a.MimeType,
+			ExternalID: msg.MessageID,
+		}, bytes.NewReader(a.Data))
+		if err != nil {
+			return fmt.Errorf("upload attachment %q: %w", a.Name, err)
+		}
+
+		size := int64(len(a.Data))
+		if size <= 0 {
+			size = 1
+		}
+		docs = append(docs, &sharedmodel.Document{ID: uploaded.ID, FileName: a.Name, MimeType: a.MimeType, Size: size})
+	}
+
+	_, err := p.messenger.SendDocument(ctx, &sharedmodel.SendDocumentRequest{
+		DomainID: gate.DomainID,
+		From:     peers.From,
+		To:       peers.To,
+		Document: sharedmodel.DocumentRequest{Body: text, Documents: docs},
+	})
+	return err
+}
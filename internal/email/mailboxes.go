@@ -0,0 +1,232 @@
+package email
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/webitel/im-providers-service/internal/email/imap"
+	emailmodel "github.com/webitel/im-providers-service/internal/email/model"
+	emailstore "github.com/webitel/im-providers-service/internal/email/store"
+)
+
+const (
+	// reconnectMinDelay and reconnectMaxDelay bound the exponential backoff between
+	// connections of a gate whose IMAP connection was lost or refused.
+	reconnectMinDelay = time.Second
+	reconnectMaxDelay = 5 * time.Minute
+	// pollInterval is the period of the check for new mail on servers without IDLE.
+	pollInterval = time.Minute
+	// idleTimeout restarts IDLE before the 30 minute server timeout of RFC 2177.
+	idleTimeout = 25 * time.Minute
+)
+
+// mailHandler processes a raw message collected from the mailbox of the gate. A
+// message is marked seen only when it was handled without an error.
+type mailHandler func(ctx context.Context, gate *emailmodel.EmailGate, raw []byte) error
+
+// mailboxes keeps an IMAP connection per enabled gate and hands the unseen messages
+// of its mailbox to the handler.
+type mailboxes struct {
+	repo   emailstore.EmailStore
+	handle mailHandler
+	logger *slog.Logger
+
+	minDelay     time.Duration
+	maxDelay     time.Duration
+	pollInterval time.Duration
+	idleTimeout  time.Duration
+
+	mu      sync.Mutex
+	running map[string]*mailboxLoop
+}
+
+// mailboxLoop is the running loop of a gate.
+type mailboxLoop struct {
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+func newMailboxes(repo emailstore.EmailStore, handle mailHandler, l *slog.Logger) *mailboxes {
+	return &mailboxes{
+		repo:         repo,
+		handle:       handle,
+		logger:       l,
+		minDelay:     reconnectMinDelay,
+		maxDelay:     reconnectMaxDelay,
+		pollInterval: pollInterval,
+		idleTimeout:  idleTimeout,
+		running:      make(map[string]*mailboxLoop),
+	}
+}
+
+// StartAll starts watching the mailbox of every enabled gate. Connecting happens in
+// the background, so an unreachable server does not keep the service down.
+func (m *mailboxes) StartAll(ctx context.Context) error {
+	gates, err := m.repo.SelectEnabled(ctx)
+	if err != nil {
+		return fmt.Errorf("email: load gates: %w", err)
+	}
+
+	for _, gate := range gates {
+		m.Start(gate)
+	}
+	return nil
+}
+
+// Start (re)starts watching the mailbox of the gate with its current settings.
+func (m *mailboxes) Start(gate *emailmodel.EmailGate) {
+	m.Stop(gate.ID)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	loop := &mailboxLoop{cancel: cancel, done: make(chan struct{})}
+
+	m.mu.Lock()
+	m.running[gate.ID] = loop
+	m.mu.Unlock()
+
+	go m.run(ctx, gate, loop)
+}
+
+// Stop stops watching the mailbox of the gate and waits for its loop to exit.
+func (m *mailboxes) Stop(gateID string) {
+	m.mu.Lock()
+	loop, ok := m.running[gateID]
+	delete(m.running, gateID)
+	m.mu.Unlock()
+
+	if ok {
+		loop.cancel()
+		<-loop.done
+	}
+}
+
+// StopAll stops every loop, waiting for them until ctx is done.
+func (m *mailboxes) StopAll(ctx context.Context) error {
+	m.mu.Lock()
+	all := make([]*mailboxLoop, 0, len(m.running))
+	for id, loop := range m.running {
+		all = append(all, loop)
+		delete(m.running, id)
+	}
+	m.mu.Unlock()
+
+	for _, loop := range all {
+		loop.cancel()
+	}
+	for _, loop := range all {
+		select {
+		case <-loop.done:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+	return nil
+}
+
+// run watches the mailbox until its context is canceled, reconnecting with an
+// exponential backoff.
+func (m *mailboxes) run(ctx context.Context, gate *emailmodel.EmailGate, loop *mailboxLoop) {
+	defer close(loop.done)
+
+	log := m.logger.With("gate_id", gate.ID)
+	delay := m.minDelay
+	for {
+		started := time.Now()
+		err := m.watch(ctx, gate, log)
+		if ctx.Err() != nil {
+			return
+		}
+
+		// A connection that stayed up longer than the longest delay starts the backoff over.
+		if time.Since(started) > m.maxDelay {
+			delay = m.minDelay
+		}
+		log.Warn("IMAP connection lost, reconnecting", "err", err, "delay", delay)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(delay):
+		}
+		delay = min(delay*2, m.maxDelay)
+	}
+}
+
+// watch connects to the mailbox and collects its unseen messages whenever the server
+// reports new ones, or every poll interval without IDLE. It returns on a connection
+// error or when ctx is done.
+func (m *mailboxes) watch(ctx context.Context, gate *emailmodel.EmailGate, log *slog.Logger) error {
+	var tlsConfig *tls.Config
+	if gate.IMAP.TLS {
+		tlsConfig = &tls.Config{ServerName: gate.IMAP.Host}
+	}
+	c, err := imap.Dial(ctx, gate.IMAP.Addr(), tlsConfig)
+	if err != nil {
+		return err
+	}
+	// Closing the connection unblocks a pending IDLE when the loop is stopped.
+	stop := context.AfterFunc(ctx, func() { _ = c.Close() })
+	defer stop()
+	defer c.Close()
+
+	if err := c.Login(gate.IMAP.Username, gate.IMAP.Password); err != nil {
+		return err
+	}
+	mailbox := gate.Mailbox
+	if mailbox == "" {
+		mailbox = emailmodel.DefaultMailbox
+	}
+	if err := c.Select(mailbox); err != nil {
+		return err
+	}
+	log.Info("watching the mailbox", "addr", gate.IMAP.Addr(), "mailbox", mailbox, "idle", c.SupportsIdle())
+
+	for {
+		if err := m.collect(ctx, c, gate, log); err != nil {
+			return err
+		}
+
+		if c.SupportsIdle() {
+			if _, err := c.Idle(m.idleTimeout); err != nil {
+				return err
+			}
+			continue
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(m.pollInterval):
+		}
+	}
+}
+
+// collect hands the unseen messages to the handler and marks the handled ones seen.
+// A message that failed stays unseen and is retried with the next collection.
+func (m *mailboxes) collect(ctx context.Context, c *imap.Client, gate *emailmodel.EmailGate, log *slog.Logger) error {
+	uids, err := c.SearchUnseen()
+	if err != nil {
+		return err
+	}
+
+	for _, uid := range uids {
+		raw, err := c.Fetch(uid)
+		if err != nil {
+			return err
+		}
+		if err := m.handle(ctx, gate, raw); err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			log.Error("message not forwarded", "uid", uid, "err", err)
+			continue
+		}
+		if err := c.MarkSeen(uid); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,138 @@
+package mailmsg
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net/mail"
+	"net/textproto"
+	"strings"
+	"time"
+)
+
+// base64LineLen is the line length of base64 bodies (RFC 2045).
+const base64LineLen = 76
+
+// Outgoing is a message sent by a gate.
+type Outgoing struct {
+	From    mail.Address
+	To      mail.Address
+	Subject string
+	// MessageID identifies the message; see NewMessageID.
+	MessageID string
+	// InReplyTo and References thread the message with the ones it answers.
+	InReplyTo   string
+	References  []string
+	Date        time.Time
+	Text        string
+	Attachments []*Attachment
+}
+
+// Compose encodes the message: plain text in quoted-printable, with the attachments
+// in base64 parts of a multipart/mixed body.
+func Compose(m *Outgoing) ([]byte, error) {
+	var b bytes.Buffer
+	writeHeader(&b, "From", m.From.String())
+	writeHeader(&b, "To", m.To.String())
+	writeHeader(&b, "Subject", mime.QEncoding.Encode("utf-8", m.Subject))
+	writeHeader(&b, "Date", m.Date.Format(time.RFC1123Z))
+	writeHeader(&b, "Message-ID", m.MessageID)
+	if m.InReplyTo != "" {
+		writeHeader(&b, "In-Reply-To", m.InReplyTo)
+	}
+	if len(m.References) > 0 {
+		// Folded one ID per line to stay within the line length limit.
+		writeHeader(&b, "References", strings.Join(m.References, "\r\n "))
+	}
+	writeHeader(&b, "MIME-Version", "1.0")
+
+	if len(m.Attachments) == 0 {
+		writeHeader(&b, "Content-Type", "text/plain; charset=utf-8")
+		writeHeader(&b, "Content-Transfer-Encoding", "quoted-printable")
+		b.WriteString("\r\n")
+		if err := writeText(&b, m.Text); err != nil {
+			return nil, err
+		}
+		return b.Bytes(), nil
+	}
+
+	w := multipart.NewWriter(&b)
+	writeHeader(&b, "Content-Type", mime.FormatMediaType("multipart/mixed", map[string]string{"boundary": w.Boundary()}))
+	b.WriteString("\r\n")
+
+	part, err := w.CreatePart(textproto.MIMEHeader{
+		"Content-Type":              {"text/plain; charset=utf-8"},
+		"Content-Transfer-Encoding": {"quoted-printable"},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := writeText(part, m.Text); err != nil {
+		return nil, err
+	}
+
+	for _, a := range m.Attachments {
+		mimeType := a.MimeType
+		if mimeType == "" {
+			mimeType = "application/octet-stream"
+		}
+		part, err := w.CreatePart(textproto.MIMEHeader{
+			"Content-Type":              {mime.FormatMediaType(mimeType, map[string]string{"name": a.Name})},
+			"Content-Disposition":       {mime.FormatMediaType("attachment", map[string]string{"filename": a.Name})},
+			"Content-Transfer-Encoding": {"base64"},
+		})
+		if err != nil {
+			return nil, err
+		}
+		enc := base64.StdEncoding.EncodeToString(a.Data)
+		for len(enc) > base64LineLen {
+			fmt.Fprintf(part, "%s\r\n", enc[:base64LineLen])
+			enc = enc[base64LineLen:]
+		}
+		fmt.Fprintf(part, "%s\r\n", enc)
+	}
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+	return b.Bytes(), nil
+}
+
+func writeHeader(b *bytes.Buffer, key, value string) {
+	b.WriteString(key)
+	b.WriteString(": ")
+	b.WriteString(value)
+	b.WriteString("\r\n")
+}
+
+func writeText(w io.Writer, text string) error {
+	qp := quotedprintable.NewWriter(w)
+	if _, err := qp.Write([]byte(strings.ReplaceAll(strings.ReplaceAll(text, "\r\n", "\n"), "\n", "\r\n"))); err != nil {
+		return err
+	}
+	return qp.Close()
+}
+
+// NewMessageID returns a unique Message-ID in the domain of the sender address.
+func NewMessageID(address string) string {
+	domain := "localhost"
+	if i := strings.LastIndexByte(address, '@'); i >= 0 && i < len(address)-1 {
+		domain = address[i+1:]
+	}
+	b := make([]byte, 16)
+	_, _ = rand.Read(b)
+	return "<" + hex.EncodeToString(b) + "@" + domain + ">"
+}
+
+// ReplySubject returns the subject of a reply: "Re: " is added unless it is there.
+func ReplySubject(subject string) string {
+	if subject == "" || strings.HasPrefix(strings.ToLower(subject), "re:") {
+		return subject
+	}
+	return "Re: " + subject
+}
@@ -0,0 +1,91 @@
+package mailmsg
+
+import (
+	"bytes"
+	"net/mail"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestComposeRoundTrip(t *testing.T) {
+	out := &Outgoing{
+		From:       mail.Address{Name: "Webitel Support", Address: "support@webitel.com"},
+		To:         mail.Address{Address: "olena@example.com"},
+		Subject:    ReplySubject("Rechnung für Mai"),
+		MessageID:  NewMessageID("support@webitel.com"),
+		InReplyTo:  "<m2@example.com>",
+		References: []string{"<m1@example.com>", "<m2@example.com>"},
+		Date:       time.Date(2026, 10, 19, 10, 0, 0, 0, time.UTC),
+		Text:       "Grüße!\n.leading dot and a line longer than seventy-six characters, which needs a soft break",
+		Attachments: []*Attachment{
+			{Name: "звіт.pdf", MimeType: "application/pdf", Data: bytes.Repeat([]byte{0, 1, 2, 0xff}, 100)},
+		},
+	}
+
+	raw, err := Compose(out)
+	if err != nil {
+		t.Fatalf("Compose: %v", err)
+	}
+	for _, line := range strings.Split(string(raw), "\r\n") {
+		if len(line) > 998 {
+			t.Fatalf("line of %d octets", len(line))
+		}
+	}
+
+	msg, err := Parse(raw)
+	if err != nil {
+		t.Fatalf("Parse: %v", err)
+	}
+	if msg.Subject != "Re: Rechnung für Mai" || msg.From.Name != "Webitel Support" {
+		t.Errorf("Subject = %q, From = %+v", msg.Subject, msg.From)
+	}
+	if msg.MessageID != out.MessageID || !strings.HasSuffix(out.MessageID, "@webitel.com>") {
+		t.Errorf("Message-ID = %q, want %q", msg.MessageID, out.MessageID)
+	}
+	if msg.InReplyTo != "<m2@example.com>" || len(msg.References) != 2 || msg.References[0] != "<m1@example.com>" {
+		t.Errorf("In-Reply-To = %q, References = %v", msg.InReplyTo, msg.References)
+	}
+	if msg.Text != out.Text {
+		t.Errorf("Text = %q", msg.Text)
+	}
+	if len(msg.Attachments) != 1 {
+		t.Fatalf("attachments = %d", len(msg.Attachments))
+	}
+	a := msg.Attachments[0]
+	if a.Name != "звіт.pdf" || a.MimeType != "application/pdf" || !bytes.Equal(a.Data, out.Attachments[0].Data) {
+		t.Errorf("attachment = %q %q %d bytes", a.Name, a.MimeType, len(a.Data))
+	}
+}
+
+func TestComposePlainText(t *testing.T) {
+	raw, err := Compose(&Outgoing{
+		From:      mail.Address{Address: "support@webitel.com"},
+		To:        mail.Address{Address: "bob@example.com"},
+		Subject:   "Hello",
+		MessageID: "<1@webitel.com>",
+		Text:      "Hi Bob",
+	})
+	if err != nil {
+		t.Fatalf("Compose: %v", err)
+	}
+	if bytes.Contains(raw, []byte("In-Reply-To")) || bytes.Contains(raw, []byte("References")) {
+		t.Errorf("first message carries threading headers:\n%s", raw)
+	}
+	msg, err := Parse(raw)
+	if err != nil || msg.Text != "Hi Bob" || len(msg.Attachments) != 0 {
+		t.Errorf("Parse = %+v, %v", msg, err)
+	}
+}
+
+func TestReplySubject(t *testing.T) {
+	for in, want := range map[string]string{
+		"Invoice":     "Re: Invoice",
+		"RE: Invoice": "RE: Invoice",
+		"":            "",
+	} {
+		if got := ReplySubject(in); got != want {
+			t.Errorf("ReplySubject(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
@@ -0,0 +1,301 @@
+// Package mailmsg reads inbound RFC 5322 messages into text and attachments and
+// composes the replies sent to them.
+package mailmsg
+
+import (
+	"bytes"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net/mail"
+	"regexp"
+	"strings"
+	"time"
+
+	"golang.org/x/net/html"
+	"golang.org/x/text/encoding/htmlindex"
+)
+
+// maxDepth bounds the nesting of multipart bodies.
+const maxDepth = 8
+
+// ErrNoSender is returned for a message without a readable From address.
+var ErrNoSender = errors.New("mailmsg: message has no sender")
+
+// Message is an inbound message.
+type Message struct {
+	MessageID  string
+	InReplyTo  string
+	References []string
+	From       *mail.Address
+	Subject    string
+	Date       time.Time
+	// Text is the plain text body; an HTML-only message is reduced to its text.
+	Text        string
+	Attachments []*Attachment
+	// AutoReply marks automatic responses: vacation notices, bounces and the like.
+	AutoReply bool
+}
+
+// Attachment is a file of a message, including inline images.
+type Attachment struct {
+	Name     string
+	MimeType string
+	Data     []byte
+}
+
+var wordDecoder = &mime.WordDecoder{CharsetReader: charsetReader}
+
+// Parse reads a raw message.
+func Parse(raw []byte) (*Message, error) {
+	m, err := mail.ReadMessage(bytes.NewReader(raw))
+	if err != nil {
+		return nil, fmt.Errorf("mailmsg: %w", err)
+	}
+
+	h := m.Header
+	from, err := (&mail.AddressParser{WordDecoder: wordDecoder}).Parse(h.Get("From"))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrNoSender, err)
+	}
+
+	msg := &Message{
+		MessageID:  firstID(h.Get("Message-Id")),
+		InReplyTo:  firstID(h.Get("In-Reply-To")),
+		References: ParseIDs(h.Get("References")),
+		From:       from,
+		Subject:    decodeHeader(h.Get("Subject")),
+		AutoReply:  isAutoReply(h),
+	}
+	msg.Date, _ = h.Date()
+
+	var p parts
+	if err := p.walk(h, m.Body, 0); err != nil {
+		return nil, err
+	}
+	msg.Text = p.plain
+	if msg.Text == "" && p.html != "" {
+		msg.Text = HTMLToText(p.html)
+	}
+	msg.Text = strings.TrimSpace(strings.ReplaceAll(msg.Text, "\r\n", "\n"))
+	msg.Attachments = p.attachments
+	return msg, nil
+}
+
+// header is the part of a message or MIME part header the walk reads.
+type header interface {
+	Get(key string) string
+}
+
+// parts collects the bodies of a message: the first plain text and HTML bodies and
+// every other part as an attachment.
+type parts struct {
+	plain, html string
+	attachments []*Attachment
+}
+
+func (p *parts) walk(h header, body io.Reader, depth int) error {
+	mediaType, params, err := mime.ParseMediaType(h.Get("Content-Type"))
+	if err != nil {
+		mediaType, params = "text/plain", map[string]string{}
+	}
+
+	if strings.HasPrefix(mediaType, "multipart/") {
+		if depth >= maxDepth {
+			return fmt.Errorf("mailmsg: multipart nested deeper than %d", maxDepth)
+		}
+		r := multipart.NewReader(body, params["boundary"])
+		for {
+			part, err := r.NextRawPart()
+			if err == io.EOF {
+				return nil
+			}
+			if err != nil {
+				return fmt.Errorf("mailmsg: %w", err)
+			}
+			if err := p.walk(part.Header, part, depth+1); err != nil {
+				return err
+			}
+		}
+	}
+
+	data, err := io.ReadAll(decodeTransfer(h.Get("Content-Transfer-Encoding"), body))
+	if err != nil {
+		return fmt.Errorf("mailmsg: decode %s body: %w", mediaType, err)
+	}
+
+	disposition, dparams, _ := mime.ParseMediaType(h.Get("Content-Disposition"))
+	name := decodeHeader(dparams["filename"])
+	if name == "" {
+		name = decodeHeader(params["name"])
+	}
+
+	isText := mediaType == "text/plain" || mediaType == "text/html"
+	if isText && disposition != "attachment" && name == "" {
+		text, err := decodeCharset(params["charset"], data)
+		if err != nil {
+			return err
+		}
+		if mediaType == "text/plain" && p.plain == "" {
+			p.plain = text
+		} else if mediaType == "text/html" && p.html == "" {
+			p.html = text
+		}
+		return nil
+	}
+
+	if name == "" {
+		name = "attachment"
+		if exts, _ := mime.ExtensionsByType(mediaType); len(exts) > 0 {
+			name += exts[0]
+		}
+	}
+	p.attachments = append(p.attachments, &Attachment{Name: name, MimeType: mediaType, Data: data})
+	return nil
+}
+
+func decodeTransfer(encoding string, r io.Reader) io.Reader {
+	switch strings.ToLower(strings.TrimSpace(encoding)) {
+	case "base64":
+		// Line breaks are not part of the alphabet; the decoder skips them.
+		return base64.NewDecoder(base64.StdEncoding, r)
+	case "quoted-printable":
+		return quotedprintable.NewReader(r)
+	default:
+		return r
+	}
+}
+
+func decodeCharset(charset string, data []byte) (string, error) {
+	switch strings.ToLower(charset) {
+	case "", "utf-8", "us-ascii":
+		return string(data), nil
+	}
+	r, err := charsetReader(charset, bytes.NewReader(data))
+	if err != nil {
+		// An unknown charset is read as is rather than losing the message.
+		return string(data), nil
+	}
+	out, err := io.ReadAll(r)
+	if err != nil {
+		return "", fmt.Errorf("mailmsg: decode %s text: %w", charset, err)
+	}
+	return string(out), nil
+}
+
+func charsetReader(charset string, r io.Reader) (io.Reader, error) {
+	enc, err := htmlindex.Get(charset)
+	if err != nil {
+		return nil, fmt.Errorf("mailmsg: unknown charset %q", charset)
+	}
+	return enc.NewDecoder().Reader(r), nil
+}
+
+// decodeHeader decodes the RFC 2047 encoded words of a header value.
+func decodeHeader(v string) string {
+	dec, err := wordDecoder.DecodeHeader(v)
+	if err != nil {
+		return v
+	}
+	return dec
+}
+
+// isAutoReply reports whether the headers mark an automatic response (RFC 3834),
+// which must not be forwarded as a conversation message.
+func isAutoReply(h mail.Header) bool {
+	if v := strings.ToLower(h.Get("Auto-Submitted")); v != "" && v != "no" {
+		return true
+	}
+	switch strings.ToLower(h.Get("Precedence")) {
+	case "bulk", "junk", "auto_reply":
+		return true
+	}
+	return h.Get("X-Autoreply") != "" || h.Get("X-Autorespond") != ""
+}
+
+// ParseIDs returns the message IDs of a References or In-Reply-To header.
+func ParseIDs(v string) []string {
+	var ids []string
+	for {
+		start := strings.IndexByte(v, '<')
+		if start < 0 {
+			return ids
+		}
+		end := strings.IndexByte(v[start:], '>')
+		if end < 0 {
+			return ids
+		}
+		ids = append(ids, v[start:start+end+1])
+		v = v[start+end+1:]
+	}
+}
+
+func firstID(v string) string {
+	if ids := ParseIDs(v); len(ids) > 0 {
+		return ids[0]
+	}
+	return ""
+}
+
+var spaces = regexp.MustCompile(`\s+`)
+
+// HTMLToText returns the text of an HTML body: block elements become line breaks,
+// scripts and styles are dropped.
+func HTMLToText(s string) string {
+	var (
+		b    strings.Builder
+		skip int
+	)
+	z := html.NewTokenizer(strings.NewReader(s))
+	for {
+		switch z.Next() {
+		case html.ErrorToken:
+			return collapseBlankLines(b.String())
+		case html.TextToken:
+			if skip == 0 {
+				b.WriteString(spaces.ReplaceAllString(string(z.Text()), " "))
+			}
+		case html.StartTagToken, html.SelfClosingTagToken:
+			name, _ := z.TagName()
+			switch string(name) {
+			case "script", "style", "head":
+				skip++
+			case "br", "p", "div", "li", "tr", "h1", "h2", "h3", "h4", "h5", "h6", "blockquote":
+				b.WriteByte('\n')
+			}
+		case html.EndTagToken:
+			name, _ := z.TagName()
+			switch string(name) {
+			case "script", "style", "head":
+				if skip > 0 {
+					skip--
+				}
+			case "p", "div", "tr", "h1", "h2", "h3", "h4", "h5", "h6", "blockquote":
+				b.WriteByte('\n')
+			}
+		}
+	}
+}
+
+func collapseBlankLines(s string) string {
+	lines := strings.Split(s, "\n")
+	out := lines[:0]
+	blank := false
+	for _, l := range lines {
+		l = strings.TrimSpace(l)
+		if l == "" {
+			if !blank && len(out) > 0 {
+				out = append(out, "")
+			}
+			blank = true
+			continue
+		}
+		out = append(out, l)
+		blank = false
+	}
+	return strings.TrimSpace(strings.Join(out, "\n"))
+}
@@ -0,0 +1,107 @@
+package mailmsg
+
+import (
+	"strings"
+	"testing"
+)
+
+func crlf(s string) []byte { return []byte(strings.ReplaceAll(s, "\n", "\r\n")) }
+
+func TestParseMultipartWithAttachment(t *testing.T) {
+	raw := crlf(`From: =?UTF-8?B?0J7Qu9C10L3QsA==?= <olena@example.com>
+To: support@example.com
+Subject: =?UTF-8?Q?Rechnung_f=C3=BCr_Mai?=
+Message-ID: <m2@example.com>
+In-Reply-To: <m1@webitel.com>
+References: <m0@example.com>
+ <m1@webitel.com>
+Date: Mon, 19 Oct 2026 10:00:00 +0300
+MIME-Version: 1.0
+Content-Type: multipart/mixed; boundary="outer"
+
+--outer
+Content-Type: multipart/alternative; boundary="inner"
+
+--inner
+Content-Type: text/plain; charset=utf-8
+Content-Transfer-Encoding: quoted-printable
+
+Gr=C3=BC=C3=9Fe, see the inv=
+oice.
+--inner
+Content-Type: text/html; charset=utf-8
+
+<p>ignored</p>
+--inner--
+--outer
+Content-Type: application/pdf; name="invoice.pdf"
+Content-Disposition: attachment; filename="invoice.pdf"
+Content-Transfer-Encoding: base64
+
+JVBERi0x
+LjQK
+--outer--
+`)
+
+	msg, err := Parse(raw)
+	if err != nil {
+		t.Fatalf("Parse: %v", err)
+	}
+	if msg.From.Address != "olena@example.com" || msg.From.Name != "Олена" {
+		t.Errorf("From = %+v", msg.From)
+	}
+	if msg.Subject != "Rechnung für Mai" {
+		t.Errorf("Subject = %q", msg.Subject)
+	}
+	if msg.MessageID != "<m2@example.com>" || msg.InReplyTo != "<m1@webitel.com>" {
+		t.Errorf("ids = %q, %q", msg.MessageID, msg.InReplyTo)
+	}
+	if len(msg.References) != 2 || msg.References[1] != "<m1@webitel.com>" {
+		t.Errorf("References = %v", msg.References)
+	}
+	if msg.Text != "Grüße, see the invoice." {
+		t.Errorf("Text = %q", msg.Text)
+	}
+	if len(msg.Attachments) != 1 {
+		t.Fatalf("attachments = %d", len(msg.Attachments))
+	}
+	a := msg.Attachments[0]
+	if a.Name != "invoice.pdf" || a.MimeType != "application/pdf" || string(a.Data) != "%PDF-1.4\n" {
+		t.Errorf("attachment = %q %q %q", a.Name, a.MimeType, a.Data)
+	}
+}
+
+func TestParseHTMLOnlyAndCharset(t *testing.T) {
+	raw := append(crlf(`From: bob@example.com
+Subject: html
+Content-Type: text/html; charset=windows-1251
+
+<html><head><style>p{}</style></head><body><p>`), 0xcf, 0xf0, 0xe8, 0xe2, 0xb3, 0xf2)
+	raw = append(raw, crlf(`, <b>world</b></p><script>x()</script><div>second<br>line</div></body></html>
+`)...)
+
+	msg, err := Parse(raw)
+	if err != nil {
+		t.Fatalf("Parse: %v", err)
+	}
+	if msg.Text != "Привіт, world\n\nsecond\nline" {
+		t.Errorf("Text = %q", msg.Text)
+	}
+}
+
+func TestParseAutoReply(t *testing.T) {
+	msg, err := Parse(crlf("From: bob@example.com\nAuto-Submitted: auto-replied\n\nOut of office\n"))
+	if err != nil || !msg.AutoReply {
+		t.Errorf("AutoReply = %v, err = %v", msg != nil && msg.AutoReply, err)
+	}
+	msg, err = Parse(crlf("From: bob@example.com\nAuto-Submitted: no\n\nHi\n"))
+	if err != nil || msg.AutoReply {
+		t.Errorf("Auto-Submitted: no is an automatic response")
+	}
+}
+
+func TestParseWithoutSender(t *testing.T) {
+	if _, err := Parse(crlf("Subject: x\n\nbody\n")); err == nil {
+		t.Error("message without From parsed")
+	}
+}
@@ -0,0 +1,290 @@
+// Package mailtest provides in-process IMAP and SMTP servers for tests of mail clients.
+package mailtest
+
+import (
+	"bufio"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// IMAPServer serves a single INBOX on a loopback port. It supports the commands the
+// email provider issues: LOGIN, CAPABILITY, SELECT, UID SEARCH UNSEEN, UID FETCH,
+// UID STORE, IDLE and LOGOUT.
+type IMAPServer struct {
+	// Addr is the host:port to dial.
+	Addr     string
+	username string
+	password string
+	idle     bool
+	ln       net.Listener
+
+	mu       sync.Mutex
+	conns    []net.Conn
+	messages []*imapMessage
+	logins   int
+	// arrived is closed and replaced whenever a message is appended, waking the IDLE sessions.
+	arrived chan struct{}
+}
+
+type imapMessage struct {
+	uid  uint32
+	raw  []byte
+	seen bool
+}
+
+// NewIMAPServer listens on a loopback port and accepts logins with the given
+// credentials. With idle set the server advertises and supports IDLE.
+func NewIMAPServer(username, password string, idle bool) (*IMAPServer, error) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return nil, err
+	}
+	s := &IMAPServer{
+		Addr:     ln.Addr().String(),
+		username: username,
+		password: password,
+		idle:     idle,
+		ln:       ln,
+		arrived:  make(chan struct{}),
+	}
+	go s.accept()
+	return s, nil
+}
+
+// Close stops listening and drops every connection.
+func (s *IMAPServer) Close() {
+	_ = s.ln.Close()
+	s.DropConnections()
+}
+
+// DropConnections closes the client connections, as a network failure would.
+func (s *IMAPServer) DropConnections() {
+	s.mu.Lock()
+	conns := s.conns
+	s.conns = nil
+	s.mu.Unlock()
+	for _, c := range conns {
+		_ = c.Close()
+	}
+}
+
+// Append adds an unseen message to the mailbox and returns its UID.
+func (s *IMAPServer) Append(raw []byte) uint32 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	uid := uint32(len(s.messages) + 1)
+	s.messages = append(s.messages, &imapMessage{uid: uid, raw: raw})
+	close(s.arrived)
+	s.arrived = make(chan struct{})
+	return uid
+}
+
+// Seen reports whether the message has the \Seen flag.
+func (s *IMAPServer) Seen(uid uint32) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if uid == 0 || int(uid) > len(s.messages) {
+		return false
+	}
+	return s.messages[uid-1].seen
+}
+
+// Logins returns the number of successful logins so far.
+func (s *IMAPServer) Logins() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.logins
+}
+
+func (s *IMAPServer) accept() {
+	for {
+		c, err := s.ln.Accept()
+		if err != nil {
+			return
+		}
+		s.mu.Lock()
+		s.conns = append(s.conns, c)
+		s.mu.Unlock()
+		go s.serve(c)
+	}
+}
+
+func (s *IMAPServer) serve(c net.Conn) {
+	defer c.Close()
+	r := bufio.NewReader(c)
+	fmt.Fprint(c, "* OK IMAP4rev1 test server ready\r\n")
+
+	// known is the number of messages the client was told about; IDLE reports the
+	// ones appended since right away, as a server reports pending EXISTS.
+	authed, known := false, 0
+	for {
+		line, err := r.ReadString('\n')
+		if err != nil {
+			return
+		}
+		tag, cmd, ok := strings.Cut(strings.TrimRight(line, "\r\n"), " ")
+		if !ok {
+			fmt.Fprintf(c, "%s BAD missing command\r\n", tag)
+			continue
+		}
+		verb, args, _ := strings.Cut(cmd, " ")
+		verb = strings.ToUpper(verb)
+		if verb == "UID" {
+			var sub string
+			sub, args, _ = strings.Cut(args, " ")
+			verb += " " + strings.ToUpper(sub)
+		}
+
+		if !authed && verb != "LOGIN" && verb != "CAPABILITY" && verb != "LOGOUT" {
+			fmt.Fprintf(c, "%s NO not authenticated\r\n", tag)
+			continue
+		}
+
+		switch verb {
+		case "CAPABILITY":
+			caps := "IMAP4rev1"
+			if s.idle {
+				caps += " IDLE"
+			}
+			fmt.Fprintf(c, "* CAPABILITY %s\r\n%s OK CAPABILITY completed\r\n", caps, tag)
+		case "LOGIN":
+			fields := parseQuoted(args)
+			if len(fields) != 2 || fields[0] != s.username || fields[1] != s.password {
+				fmt.Fprintf(c, "%s NO [AUTHENTICATIONFAILED] invalid credentials\r\n", tag)
+				continue
+			}
+			authed = true
+			s.mu.Lock()
+			s.logins++
+			s.mu.Unlock()
+			fmt.Fprintf(c, "%s OK LOGIN completed\r\n", tag)
+		case "SELECT":
+			if mailbox := parseQuoted(args); len(mailbox) != 1 || !strings.EqualFold(mailbox[0], "INBOX") {
+				fmt.Fprintf(c, "%s NO no such mailbox\r\n", tag)
+				continue
+			}
+			s.mu.Lock()
+			known = len(s.messages)
+			s.mu.Unlock()
+			fmt.Fprintf(c, "* %d EXISTS\r\n%s OK [READ-WRITE] SELECT completed\r\n", known, tag)
+		case "UID SEARCH":
+			var uids []string
+			s.mu.Lock()
+			known = len(s.messages)
+			for _, m := range s.messages {
+				if !m.seen {
+					uids = append(uids, strconv.FormatUint(uint64(m.uid), 10))
+				}
+			}
+			s.mu.Unlock()
+			fmt.Fprintf(c, "* SEARCH %s\r\n%s OK SEARCH completed\r\n", strings.Join(uids, " "), tag)
+		case "UID FETCH":
+			m := s.message(args)
+			if m == nil {
+				fmt.Fprintf(c, "%s OK FETCH completed\r\n", tag)
+				continue
+			}
+			fmt.Fprintf(c, "* %d FETCH (UID %d BODY[] {%d}\r\n%s)\r\n%s OK FETCH completed\r\n", m.uid, m.uid, len(m.raw), m.raw, tag)
+		case "UID STORE":
+			m := s.message(args)
+			if m != nil && strings.Contains(args, `\Seen`) {
+				s.mu.Lock()
+				m.seen = true
+				s.mu.Unlock()
+			}
+			fmt.Fprintf(c, "%s OK STORE completed\r\n", tag)
+		case "IDLE":
+			if !s.idle {
+				fmt.Fprintf(c, "%s BAD IDLE not supported\r\n", tag)
+				continue
+			}
+			if !s.serveIdle(c, r, tag, &known) {
+				return
+			}
+		case "LOGOUT":
+			fmt.Fprintf(c, "* BYE logging out\r\n%s OK LOGOUT completed\r\n", tag)
+			return
+		default:
+			fmt.Fprintf(c, "%s BAD unknown command\r\n", tag)
+		}
+	}
+}
+
+// serveIdle reports new messages until the client sends DONE. It returns false when
+// the connection is gone.
+func (s *IMAPServer) serveIdle(c net.Conn, r *bufio.Reader, tag string, known *int) bool {
+	done := make(chan bool, 1)
+	go func() {
+		line, err := r.ReadString('\n')
+		done <- err == nil && strings.EqualFold(strings.TrimSpace(line), "DONE")
+	}()
+
+	fmt.Fprint(c, "+ idling\r\n")
+	for {
+		s.mu.Lock()
+		arrived, n := s.arrived, len(s.messages)
+		s.mu.Unlock()
+		if n > *known {
+			*known = n
+			fmt.Fprintf(c, "* %d EXISTS\r\n", n)
+		}
+
+		select {
+		case ok := <-done:
+			if !ok {
+				return false
+			}
+			fmt.Fprintf(c, "%s OK IDLE terminated\r\n", tag)
+			return true
+		case <-arrived:
+		}
+	}
+}
+
+// message returns the message of the UID the arguments start with.
+func (s *IMAPServer) message(args string) *imapMessage {
+	f, _, _ := strings.Cut(args, " ")
+	uid, err := strconv.Atoi(f)
+	if err != nil {
+		return nil
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if uid <= 0 || uid > len(s.messages) {
+		return nil
+	}
+	return s.messages[uid-1]
+}
+
+// parseQuoted splits arguments given as quoted strings or atoms.
+func parseQuoted(args string) []string {
+	var (
+		out []string
+		b   strings.Builder
+	)
+	for i := 0; i < len(args); i++ {
+		switch {
+		case args[i] == ' ':
+		case args[i] == '"':
+			b.Reset()
+			for i++; i < len(args) && args[i] != '"'; i++ {
+				if args[i] == '\\' && i+1 < len(args) {
+					i++
+				}
+				b.WriteByte(args[i])
+			}
+			out = append(out, b.String())
+		default:
+			j := strings.IndexByte(args[i:], ' ')
+			if j < 0 {
+				j = len(args) - i
+			}
+			out = append(out, args[i:i+j])
+			i += j
+		}
+	}
+	return out
+}
@@ -0,0 +1,176 @@
+package mailtest
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"net"
+	"strings"
+	"sync"
+)
+
+// Mail is a message accepted by the SMTP server.
+type Mail struct {
+	From string
+	To   []string
+	Data []byte
+}
+
+// SMTPServer accepts mail on a loopback port: EHLO, AUTH PLAIN, MAIL, RCPT, DATA,
+// RSET, NOOP and QUIT, without TLS.
+type SMTPServer struct {
+	// Addr is the host:port to dial.
+	Addr     string
+	username string
+	password string
+	ln       net.Listener
+
+	mu       sync.Mutex
+	received []*Mail
+	// rejectRcpt answers every RCPT with 550 when set.
+	rejectRcpt bool
+}
+
+// NewSMTPServer listens on a loopback port. With a username the server requires
+// AUTH PLAIN with the given credentials before MAIL.
+func NewSMTPServer(username, password string) (*SMTPServer, error) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return nil, err
+	}
+	s := &SMTPServer{Addr: ln.Addr().String(), username: username, password: password, ln: ln}
+	go s.accept()
+	return s, nil
+}
+
+// Close stops listening.
+func (s *SMTPServer) Close() { _ = s.ln.Close() }
+
+// Received returns the messages accepted so far.
+func (s *SMTPServer) Received() []*Mail {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]*Mail(nil), s.received...)
+}
+
+// RejectRecipients makes the server refuse every recipient.
+func (s *SMTPServer) RejectRecipients(reject bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.rejectRcpt = reject
+}
+
+func (s *SMTPServer) accept() {
+	for {
+		c, err := s.ln.Accept()
+		if err != nil {
+			return
+		}
+		go s.serve(c)
+	}
+}
+
+func (s *SMTPServer) serve(c net.Conn) {
+	defer c.Close()
+	r := bufio.NewReader(c)
+	fmt.Fprint(c, "220 localhost ESMTP test server\r\n")
+
+	var (
+		authed = s.username == ""
+		mail   *Mail
+	)
+	for {
+		line, err := r.ReadString('\n')
+		if err != nil {
+			return
+		}
+		line = strings.TrimRight(line, "\r\n")
+		verb, arg, _ := strings.Cut(line, " ")
+
+		switch strings.ToUpper(verb) {
+		case "EHLO":
+			fmt.Fprint(c, "250-localhost\r\n250-8BITMIME\r\n250 AUTH PLAIN\r\n")
+		case "HELO":
+			fmt.Fprint(c, "250 localhost\r\n")
+		case "AUTH":
+			mech, initial, _ := strings.Cut(arg, " ")
+			creds, err := base64.StdEncoding.DecodeString(initial)
+			want := "\x00" + s.username + "\x00" + s.password
+			if !strings.EqualFold(mech, "PLAIN") || err != nil || string(creds) != want {
+				fmt.Fprint(c, "535 5.7.8 authentication failed\r\n")
+				continue
+			}
+			authed = true
+			fmt.Fprint(c, "235 2.7.0 authenticated\r\n")
+		case "MAIL":
+			if !authed {
+				fmt.Fprint(c, "530 5.7.0 authentication required\r\n")
+				continue
+			}
+			mail = &Mail{From: address(arg)}
+			fmt.Fprint(c, "250 OK\r\n")
+		case "RCPT":
+			s.mu.Lock()
+			reject := s.rejectRcpt
+			s.mu.Unlock()
+			if mail == nil || reject {
+				fmt.Fprint(c, "550 5.1.1 recipient rejected\r\n")
+				continue
+			}
+			mail.To = append(mail.To, address(arg))
+			fmt.Fprint(c, "250 OK\r\n")
+		case "DATA":
+			if mail == nil || len(mail.To) == 0 {
+				fmt.Fprint(c, "503 5.5.1 no recipients\r\n")
+				continue
+			}
+			fmt.Fprint(c, "354 end data with <CR><LF>.<CR><LF>\r\n")
+			data, err := readData(r)
+			if err != nil {
+				return
+			}
+			mail.Data = data
+			s.mu.Lock()
+			s.received = append(s.received, mail)
+			s.mu.Unlock()
+			mail = nil
+			fmt.Fprint(c, "250 OK queued\r\n")
+		case "RSET":
+			mail = nil
+			fmt.Fprint(c, "250 OK\r\n")
+		case "NOOP":
+			fmt.Fprint(c, "250 OK\r\n")
+		case "QUIT":
+			fmt.Fprint(c, "221 bye\r\n")
+			return
+		default:
+			fmt.Fprint(c, "502 5.5.2 command not recognized\r\n")
+		}
+	}
+}
+
+// readData reads the message up to the terminating dot, undoing dot-stuffing.
+func readData(r *bufio.Reader) ([]byte, error) {
+	var b bytes.Buffer
+	for {
+		line, err := r.ReadString('\n')
+		if err != nil {
+			return nil, err
+		}
+		if line == ".\r\n" {
+			return b.Bytes(), nil
+		}
+		b.WriteString(strings.TrimPrefix(line, "."))
+	}
+}
+
+// address returns the address of a "FROM:<a@b>" or "TO:<a@b>" argument.
+func address(arg string) string {
+	start := strings.IndexByte(arg, '<')
+	end := strings.IndexByte(arg, '>')
+	if start < 0 || end < start {
+		return ""
+	}
+	return arg[start+1 : end]
+}
@@ -0,0 +1,179 @@
+package model
+
+import (
+	"fmt"
+	"net/mail"
+	"time"
+
+	sharedmodel "github.com/webitel/im-providers-service/internal/core/model"
+)
+
+// DefaultMailbox is the IMAP mailbox polled when a gate names none.
+const DefaultMailbox = "INBOX"
+
+// EmailGate represents a gate of a mailbox: inbound mail is collected over IMAP,
+// replies are sent over SMTP from Address.
+type EmailGate struct {
+	ID       string           `json:"id" db:"id"`
+	DomainID int64            `json:"domain_id" db:"domain_id"`
+	Peer     sharedmodel.Peer `json:"peer" db:"peer"`
+	Name     string           `json:"name" db:"name"`
+	// Address is the mailbox address replies are sent from.
+	Address string `json:"address" db:"address"`
+	// DisplayName is the name shown in the From header of replies.
+	DisplayName string                 `json:"display_name" db:"display_name"`
+	IMAP        ServerConfig           `json:"imap" db:"imap"`
+	SMTP        ServerConfig           `json:"smtp" db:"smtp"`
+	Mailbox     string                 `json:"mailbox" db:"mailbox"`
+	Status      sharedmodel.GateStatus `json:"status" db:"status"`
+	CreatedAt   time.Time              `json:"created_at" db:"created_at"`
+	UpdatedAt   time.Time              `json:"updated_at" db:"updated_at"`
+	Enabled     bool                   `json:"enabled" db:"enabled"`
+}
+
+// ServerConfig is the account of the gate on an IMAP or SMTP server.
+type ServerConfig struct {
+	Host     string `json:"host" db:"host"`
+	Port     int    `json:"port" db:"port"`
+	Username string `json:"username" db:"username"`
+	Password string `json:"-" db:"password"`
+	// TLS selects implicit TLS (IMAPS on 993, SMTPS on 465). Without it SMTP
+	// upgrades the connection with STARTTLS when the server offers it.
+	TLS bool `json:"tls" db:"tls"`
+}
+
+// Addr returns the host:port of the server.
+func (c ServerConfig) Addr() string { return fmt.Sprintf("%s:%d", c.Host, c.Port) }
+
+type CreateEmail struct {
+	Name        string
+	Dc          int64
+	Address     string
+	DisplayName string
+	IMAP        ServerConfig
+	SMTP        ServerConfig
+	Mailbox     string
+	Peer        sharedmodel.Peer
+}
+
+type UpdateEmail struct {
+	ID          string
+	Name        *string
+	Address     *string
+	DisplayName *string
+	IMAP        *ServerConfig
+	SMTP        *ServerConfig
+	Mailbox     *string
+	Enabled     *bool
+	Peer        *sharedmodel.Peer
+}
+
+// ApplyTo applies the changes. A password left empty in a new server configuration
+// keeps its stored value.
+func (r UpdateEmail) ApplyTo(gate *EmailGate) {
+	if r.Name != nil {
+		gate.Name = *r.Name
+	}
+	if r.Address != nil {
+		gate.Address = *r.Address
+	}
+	if r.DisplayName != nil {
+		gate.DisplayName = *r.DisplayName
+	}
+	if r.IMAP != nil {
+		cfg := *r.IMAP
+		if cfg.Password == "" {
+			cfg.Password = gate.IMAP.Password
+		}
+		gate.IMAP = cfg
+	}
+	if r.SMTP != nil {
+		cfg := *r.SMTP
+		if cfg.Password == "" {
+			cfg.Password = gate.SMTP.Password
+		}
+		gate.SMTP = cfg
+	}
+	if r.Mailbox != nil {
+		gate.Mailbox = *r.Mailbox
+	}
+	if r.Enabled != nil {
+		gate.Enabled = *r.Enabled
+	}
+	if r.Peer != nil {
+		gate.Peer = *r.Peer
+	}
+}
+
+func (r CreateEmail) Validate() error {
+	var missing []string
+	if r.Name == "" {
+		missing = append(missing, "name")
+	}
+	if r.Dc <= 0 {
+		missing = append(missing, "domain_id")
+	}
+	missing = append(missing, ValidateAccount(r.Address, r.IMAP, r.SMTP)...)
+	if len(missing) > 0 {
+		return &sharedmodel.ValidationError{Fields: missing}
+	}
+	return nil
+}
+
+// ValidateAccount returns the invalid fields of the mailbox address and servers.
+func ValidateAccount(address string, imap, smtp ServerConfig) []string {
+	var invalid []string
+	if a, err := mail.ParseAddress(address); err != nil || a.Address != address {
+		invalid = append(invalid, "address")
+	}
+	for _, s := range []struct {
+		name string
+		cfg  ServerConfig
+	}{{"imap", imap}, {"smtp", smtp}} {
+		if s.cfg.Host == "" {
+			invalid = append(invalid, s.name+".host")
+		}
+		if s.cfg.Port <= 0 || s.cfg.Port > 65535 {
+			invalid = append(invalid, s.name+".port")
+		}
+	}
+	if imap.Username == "" {
+		invalid = append(invalid, "imap.username")
+	}
+	return invalid
+}
+
+// Thread is the mail conversation of a gate with a correspondent. Replies continue
+// it: they answer its last message and carry its references.
+type Thread struct {
+	GateID string `db:"gate_id"`
+	// Contact is the address of the correspondent.
+	Contact string `db:"contact"`
+	Subject string `db:"subject"`
+	// MessageID is the Message-ID of the last message of the thread.
+	MessageID string `db:"message_id"`
+	// References are the Message-IDs of the thread, oldest first.
+	References []string  `db:"refs"`
+	UpdatedAt  time.Time `db:"updated_at"`
+}
+
+// maxReferences bounds the References header: the first message and the latest ones
+// are enough for clients to thread the conversation.
+const maxReferences = 20
+
+// Append records a message of the thread.
+func (t *Thread) Append(messageID string) {
+	if messageID == "" {
+		return
+	}
+	t.MessageID = messageID
+	for _, ref := range t.References {
+		if ref == messageID {
+			return
+		}
+	}
+	t.References = append(t.References, messageID)
+	if n := len(t.References); n > maxReferences {
+		t.References = append(t.References[:1], t.References[n-maxReferences+1:]...)
+	}
+}
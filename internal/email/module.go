@@ -0,0 +1,51 @@
+package email
+
+import (
+	impb "github.com/webitel/im-providers-service/gen/go/provider/v1"
+	grpcsrv "github.com/webitel/im-providers-service/infra/srv/grpc"
+	emailhandler "github.com/webitel/im-providers-service/internal/email/handler"
+	emailservice "github.com/webitel/im-providers-service/internal/email/service"
+	emailstore "github.com/webitel/im-providers-service/internal/email/store"
+	emailpostgres "github.com/webitel/im-providers-service/internal/email/store/postgres"
+	"github.com/webitel/im-providers-service/internal/provider"
+	"go.uber.org/fx"
+)
+
+// Module provides the email provider, the mailbox loops of its gates and the gRPC gate service.
+var Module = fx.Module("email",
+	fx.Provide(
+		// Provider adapter — provided as *emailProvider for the lifecycle hooks,
+		// as a Provider for the registry and its mailboxes as Watcher for the service.
+		newProvider,
+		fx.Annotate(
+			func(p *emailProvider) provider.Provider { return p },
+			fx.ResultTags(`group:"providers"`),
+		),
+		func(p *emailProvider) emailservice.Watcher { return p.mailboxes },
+
+		// Store implementations
+		fx.Annotate(emailpostgres.NewEmailStore, fx.As(new(emailstore.EmailStore))),
+		fx.Annotate(emailpostgres.NewThreadStore, fx.As(new(emailstore.ThreadStore))),
+
+		// Services
+		fx.Annotate(emailservice.NewEmailService, fx.As(new(emailservice.EmailManager))),
+
+		// gRPC handlers
+		emailhandler.NewEmailHandler,
+	),
+	fx.Invoke(registerLifecycle, RegisterEmailService),
+)
+
+// RegisterEmailService connects the email gate gRPC handler to the gRPC server.
+func RegisterEmailService(server *grpcsrv.Server, email *emailhandler.EmailHandler) {
+	impb.RegisterEmailServiceServer(server.Server, email)
+}
+
+// registerLifecycle starts watching the mailboxes of the enabled gates on start and
+// stops on stop.
+func registerLifecycle(lc fx.Lifecycle, p *emailProvider) {
+	lc.Append(fx.Hook{
+		OnStart: p.mailboxes.StartAll,
+		OnStop:  p.mailboxes.StopAll,
+	})
+}
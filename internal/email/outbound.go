@@ -0,0 +1,190 @@
+package email
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/mail"
+	"net/url"
+	"path"
+	"time"
+
+	"github.com/google/uuid"
+	contactv1 "github.com/webitel/im-providers-service/gen/go/contact/v1"
+	sharedmodel "github.com/webitel/im-providers-service/internal/core/model"
+	sharedstore "github.com/webitel/im-providers-service/internal/core/store"
+	"github.com/webitel/im-providers-service/internal/email/mailmsg"
+	emailmodel "github.com/webitel/im-providers-service/internal/email/model"
+	"github.com/webitel/im-providers-service/internal/provider/contactsync"
+)
+
+// maxAttachmentSize bounds a file attached to an outbound message; most servers
+// reject messages over 25MB.
+const maxAttachmentSize = 20 << 20
+
+// file is a file of an outbound message to attach.
+type file struct {
+	url, name, mimeType string
+}
+
+func (p *emailProvider) SendText(ctx context.Context, req *sharedmodel.Message) (*sharedmodel.MessageResponse, error) {
+	return p.send(ctx, req, nil)
+}
+
+// SendImage attaches the images to a message with the caption as the text.
+func (p *emailProvider) SendImage(ctx context.Context, req *sharedmodel.Message) (*sharedmodel.MessageResponse, error) {
+	files := make([]file, 0, len(req.Images))
+	for _, img := range req.Images {
+		files = append(files, file{url: img.URL, name: img.FileName, mimeType: img.MimeType})
+	}
+	return p.send(ctx, req, files)
+}
+
+// SendDocument attaches the documents to a message with the caption as the text.
+func (p *emailProvider) SendDocument(ctx context.Context, req *sharedmodel.Message) (*sharedmodel.MessageResponse, error) {
+	files := make([]file, 0, len(req.Documents))
+	for _, doc := range req.Documents {
+		files = append(files, file{url: doc.URL, name: doc.FileName, mimeType: doc.MimeType})
+	}
+	return p.send(ctx, req, files)
+}
+
+// send mails the message to the correspondent as a reply to the last message of
+// their thread, so mail clients keep the conversation together. The response
+// carries the Message-ID of the sent message.
+func (p *emailProvider) send(ctx context.Context, req *sharedmodel.Message, files []file) (*sharedmodel.MessageResponse, error) {
+	if req.Text == "" && len(files) == 0 {
+		return nil, fmt.Errorf("email: message is empty")
+	}
+
+	gate, err := p.repo.Select(ctx, req.GateID)
+	if err != nil {
+		return nil, err
+	}
+	receiver, err := p.resolveReceiver(ctx, gate, req.To.Sub)
+	if err != nil {
+		return nil, err
+	}
+
+	attachments := make([]*mailmsg.Attachment, 0, len(files))
+	for _, f := range files {
+		a, err := p.download(ctx, f)
+		if err != nil {
+			return nil, err
+		}
+		attachments = append(attachments, a)
+	}
+
+	thread, err := p.threads.GetThread(ctx, gate.ID, receiver)
+	if err != nil {
+		if !errors.Is(err, sharedstore.ErrNotFound) {
+			return nil, err
+		}
+		thread = &emailmodel.Thread{GateID: gate.ID, Contact: receiver}
+	}
+	// A conversation started by the gate is titled after it.
+	subject := thread.Subject
+	if thread.MessageID != "" {
+		subject = mailmsg.ReplySubject(subject)
+	} else if subject == "" {
+		subject = cmp.Or(gate.DisplayName, gate.Name)
+		thread.Subject = subject
+	}
+
+	out := &mailmsg.Outgoing{
+		From:        mail.Address{Name: gate.DisplayName, Address: gate.Address},
+		To:          mail.Address{Address: receiver},
+		Subject:     subject,
+		MessageID:   mailmsg.NewMessageID(gate.Address),
+		InReplyTo:   thread.MessageID,
+		References:  thread.References,
+		Date:        time.Now(),
+		Text:        req.Text,
+		Attachments: attachments,
+	}
+	raw, err := mailmsg.Compose(out)
+	if err != nil {
+		return nil, fmt.Errorf("email: compose: %w", err)
+	}
+	if err := p.smtp.Send(ctx, gate, receiver, raw); err != nil {
+		return nil, fmt.Errorf("email: send to %s: %w", receiver, err)
+	}
+
+	// The message is out already: a thread that is not saved only loses the
+	// threading headers of the next reply.
+	thread.Append(out.MessageID)
+	if err := p.threads.SaveThread(ctx, thread); err != nil {
+		p.logger.Warn("thread not saved", "gate_id", gate.ID, "contact", receiver, "err", err)
+	}
+
+	return &sharedmodel.MessageResponse{ID: out.MessageID}, nil
+}
+
+// download fetches a file to attach.
+func (p *emailProvider) download(ctx context.Context, f file) (*mailmsg.Attachment, error) {
+	if f.url == "" {
+		return nil, fmt.Errorf("email: file has no url")
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, f.url, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := p.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("email: download %s: status %s", f.url, resp.Status)
+	}
+	data, err := io.ReadAll(io.LimitReader(resp.Body, maxAttachmentSize+1))
+	if err != nil {
+		return nil, fmt.Errorf("email: download %s: %w", f.url, err)
+	}
+	if len(data) > maxAttachmentSize {
+		return nil, fmt.Errorf("email: file %s exceeds %d bytes", f.url, maxAttachmentSize)
+	}
+
+	a := &mailmsg.Attachment{Name: f.name, MimeType: f.mimeType, Data: data}
+	if a.MimeType == "" {
+		a.MimeType = resp.Header.Get("Content-Type")
+	}
+	if a.Name == "" {
+		a.Name = "file"
+		if u, err := url.Parse(f.url); err == nil && path.Base(u.Path) != "/" && path.Base(u.Path) != "." {
+			a.Name = path.Base(u.Path)
+		}
+	}
+	return a, nil
+}
+
+// resolveReceiver returns the email address for the given sub.
+// An address is returned as-is; an internal contact UUID is resolved via the contact service.
+func (p *emailProvider) resolveReceiver(ctx context.Context, gate *emailmodel.EmailGate, contactID string) (string, error) {
+	if _, err := uuid.Parse(contactID); err != nil {
+		return contactID, nil
+	}
+	if address, ok := p.receiverCache.Get(contactID); ok {
+		return address, nil
+	}
+
+	authCtx := contactsync.WithGatewayIdentity(ctx, gate.DomainID, gate.Peer)
+	resp, err := p.contactClient.SearchContact(authCtx, &contactv1.SearchContactRequest{
+		Ids: []string{contactID},
+	})
+	if err != nil {
+		return "", fmt.Errorf("resolve email address for %s: %w", contactID, err)
+	}
+	items := resp.GetContacts()
+	if len(items) == 0 || items[0].GetSubject() == "" {
+		return "", fmt.Errorf("resolve email address for %s: contact not found or has no subject", contactID)
+	}
+	address := items[0].GetSubject()
+	p.receiverCache.Add(contactID, address)
+	return address, nil
+}
@@ -0,0 +1,77 @@
+// Package email implements the email provider. A gate is a mailbox: inbound mail is
+// collected from it over IMAP, with IDLE when the server supports it, and replies
+// are sent over SMTP threaded with the conversation of the correspondent.
+package email
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+	"time"
+
+	lru "github.com/hashicorp/golang-lru/v2"
+	imcontact "github.com/webitel/im-providers-service/infra/client/grpc/im-contact"
+	imgateway "github.com/webitel/im-providers-service/infra/client/grpc/im-gateway"
+	sharedsvc "github.com/webitel/im-providers-service/internal/core/service"
+	sharedstore "github.com/webitel/im-providers-service/internal/core/store"
+	emailstore "github.com/webitel/im-providers-service/internal/email/store"
+	"github.com/webitel/im-providers-service/internal/provider"
+)
+
+// errWebhookUnsupported is returned for webhook deliveries: mail is collected over IMAP.
+var errWebhookUnsupported = errors.New("email: mail is collected over IMAP, not webhooks")
+
+type emailProvider struct {
+	logger        *slog.Logger
+	messenger     sharedsvc.Messenger
+	userCache     sharedstore.ExternalUserCache
+	repo          emailstore.EmailStore
+	threads       emailstore.ThreadStore
+	gatewayer     *imgateway.Client
+	media         sharedsvc.MediaManager
+	contactClient *imcontact.Client
+	mailboxes     *mailboxes
+	smtp          *smtpSender
+	// httpClient downloads the files of outbound messages.
+	httpClient *http.Client
+	// receiverCache maps internal contact UUID → email address to avoid an
+	// im-contact round-trip on every outbound message.
+	receiverCache *lru.Cache[string, string]
+}
+
+func newProvider(
+	m sharedsvc.Messenger,
+	l *slog.Logger,
+	uc sharedstore.ExternalUserCache,
+	repo emailstore.EmailStore,
+	threads emailstore.ThreadStore,
+	gatewayer *imgateway.Client,
+	media sharedsvc.MediaManager,
+	contactClient *imcontact.Client,
+) *emailProvider {
+	receiverCache, _ := lru.New[string, string](1000)
+	p := &emailProvider{
+		logger:        l.With("provider", "email"),
+		messenger:     m,
+		userCache:     uc,
+		repo:          repo,
+		threads:       threads,
+		gatewayer:     gatewayer,
+		media:         media,
+		contactClient: contactClient,
+		smtp:          &smtpSender{timeout: time.Minute},
+		httpClient:    &http.Client{Timeout: time.Minute},
+		receiverCache: receiverCache,
+	}
+	p.mailboxes = newMailboxes(repo, p.handleMail, p.logger)
+	return p
+}
+
+var _ provider.Provider = (*emailProvider)(nil)
+
+func (p *emailProvider) Type() string { return "email" }
+
+func (p *emailProvider) HandleWebhook(context.Context, []byte) error {
+	return errWebhookUnsupported
+}
@@ -0,0 +1,413 @@
+package email
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	sharedmodel "github.com/webitel/im-providers-service/internal/core/model"
+	sharedstore "github.com/webitel/im-providers-service/internal/core/store"
+	"github.com/webitel/im-providers-service/internal/email/mailmsg"
+	"github.com/webitel/im-providers-service/internal/email/mailtest"
+	emailmodel "github.com/webitel/im-providers-service/internal/email/model"
+	emailstore "github.com/webitel/im-providers-service/internal/email/store"
+	"github.com/webitel/im-providers-service/internal/provider/providertest"
+)
+
+const (
+	testGateID   = "0190a8a4-5f0e-7c2a-9d3b-0a1b2c3d4e5f"
+	testAddress  = "support@webitel.com"
+	testPassword = "secret"
+	testContact  = "olena@example.com"
+)
+
+var noopLogger = slog.New(slog.NewTextHandler(io.Discard, nil))
+
+// -- fakes --
+
+// mailboxGates serves the gates to the mailbox loops and to outbound mail. The provider
+// only reads gates; writing them is the job of the gate service.
+type mailboxGates map[string]*emailmodel.EmailGate
+
+var _ emailstore.EmailStore = mailboxGates(nil)
+
+func (g mailboxGates) Select(_ context.Context, id string) (*emailmodel.EmailGate, error) {
+	gate, ok := g[id]
+	if !ok {
+		return nil, sharedstore.ErrNotFound
+	}
+	cp := *gate
+	return &cp, nil
+}
+
+func (g mailboxGates) SelectEnabled(context.Context) ([]*emailmodel.EmailGate, error) {
+	var out []*emailmodel.EmailGate
+	for _, gate := range g {
+		if gate.Enabled {
+			cp := *gate
+			out = append(out, &cp)
+		}
+	}
+	return out, nil
+}
+
+func (mailboxGates) Insert(context.Context, int64, *emailmodel.EmailGate) error {
+	panic("email provider must not insert gates")
+}
+
+func (mailboxGates) Update(context.Context, *emailmodel.EmailGate) error {
+	panic("email provider must not update gates")
+}
+
+func (mailboxGates) Delete(context.Context, string) error {
+	panic("email provider must not delete gates")
+}
+
+// correspondence keeps the threads by gate and correspondent; addresses are matched
+// case-insensitively like the postgres store does.
+type correspondence struct {
+	mu      sync.Mutex
+	threads map[string]emailmodel.Thread
+}
+
+var _ emailstore.ThreadStore = (*correspondence)(nil)
+
+func (m *correspondence) GetThread(_ context.Context, gateID, contact string) (*emailmodel.Thread, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	t, ok := m.threads[gateID+"/"+strings.ToLower(contact)]
+	if !ok {
+		return nil, sharedstore.ErrNotFound
+	}
+	t.References = append([]string(nil), t.References...)
+	return &t, nil
+}
+
+func (m *correspondence) SaveThread(_ context.Context, t *emailmodel.Thread) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	cp := *t
+	cp.References = append([]string(nil), t.References...)
+	m.threads[t.GateID+"/"+strings.ToLower(t.Contact)] = cp
+	return nil
+}
+
+func supportGate(imapAddr, smtpAddr string) *emailmodel.EmailGate {
+	return &emailmodel.EmailGate{
+		ID:          testGateID,
+		DomainID:    1,
+		Name:        "Support",
+		Peer:        sharedmodel.Peer{Sub: "email-bot", Iss: "email"},
+		Address:     testAddress,
+		DisplayName: "Webitel Support",
+		IMAP:        serverConfig(imapAddr),
+		SMTP:        serverConfig(smtpAddr),
+		Mailbox:     emailmodel.DefaultMailbox,
+		Enabled:     true,
+	}
+}
+
+func serverConfig(addr string) emailmodel.ServerConfig {
+	host, port, _ := net.SplitHostPort(addr)
+	p, _ := strconv.Atoi(port)
+	return emailmodel.ServerConfig{Host: host, Port: p, Username: testAddress, Password: testPassword}
+}
+
+// supportMailbox is the provider with a gate of the support mailbox on fake IMAP and
+// SMTP servers; its loops retry and poll quickly.
+type supportMailbox struct {
+	p       *emailProvider
+	m       *providertest.Messenger
+	media   *providertest.Media
+	threads *correspondence
+	imap    *mailtest.IMAPServer
+	smtp    *mailtest.SMTPServer
+}
+
+func newSupportMailbox(t *testing.T, idle bool) *supportMailbox {
+	t.Helper()
+	imapSrv, err := mailtest.NewIMAPServer(testAddress, testPassword, idle)
+	if err != nil {
+		t.Fatalf("NewIMAPServer: %v", err)
+	}
+	t.Cleanup(imapSrv.Close)
+	smtpSrv, err := mailtest.NewSMTPServer(testAddress, testPassword)
+	if err != nil {
+		t.Fatalf("NewSMTPServer: %v", err)
+	}
+	t.Cleanup(smtpSrv.Close)
+
+	env := &supportMailbox{
+		m:       &providertest.Messenger{},
+		media:   &providertest.Media{},
+		threads: &correspondence{threads: map[string]emailmodel.Thread{}},
+		imap:    imapSrv,
+		smtp:    smtpSrv,
+	}
+	gate := supportGate(imapSrv.Addr, smtpSrv.Addr)
+	env.p = newProvider(env.m, noopLogger, providertest.KnownUsers{}, mailboxGates{gate.ID: gate}, env.threads, nil, env.media, nil)
+	env.p.mailboxes.minDelay, env.p.mailboxes.maxDelay = 10*time.Millisecond, 50*time.Millisecond
+	env.p.mailboxes.pollInterval = 20 * time.Millisecond
+	return env
+}
+
+func (e *supportMailbox) start(t *testing.T) {
+	t.Helper()
+	if err := e.p.mailboxes.StartAll(context.Background()); err != nil {
+		t.Fatalf("StartAll: %v", err)
+	}
+	t.Cleanup(func() { _ = e.p.mailboxes.StopAll(context.Background()) })
+}
+
+func waitFor(t *testing.T, cond func() bool) {
+	t.Helper()
+	deadline := time.Now().Add(3 * time.Second)
+	for !cond() {
+		if time.Now().After(deadline) {
+			t.Fatal("condition not met in time")
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+}
+
+func rawMail(headers, body string) []byte {
+	return []byte(strings.ReplaceAll(headers+"\n\n"+body+"\n", "\n", "\r\n"))
+}
+
+// -- inbound --
+
+func TestInboundWithIdle(t *testing.T) {
+	env := newSupportMailbox(t, true)
+	env.start(t)
+	waitFor(t, func() bool { return env.imap.Logins() == 1 })
+
+	uid := env.imap.Append(rawMail("From: Olena <Olena@Example.com>\nSubject: Order 42\nMessage-ID: <m1@example.com>", "Where is my order?"))
+	waitFor(t, func() bool { return env.imap.Seen(uid) })
+
+	texts := env.m.Texts()
+	if len(texts) != 1 {
+		t.Fatalf("forwarded %d texts", len(texts))
+	}
+	got := texts[0]
+	if got.Body != "Order 42\n\nWhere is my order?" || got.From.Sub != testContact || got.From.Iss != "email" || got.DomainID != 1 {
+		t.Errorf("forwarded = %+v", got)
+	}
+	if got.To.Sub != "email-bot" || got.To.Via == nil || *got.To.Via != testGateID {
+		t.Errorf("addressed to %+v", got.To)
+	}
+
+	thread, err := env.threads.GetThread(context.Background(), testGateID, testContact)
+	if err != nil || thread.Subject != "Order 42" || thread.MessageID != "<m1@example.com>" {
+		t.Errorf("thread = %+v, %v", thread, err)
+	}
+}
+
+func TestInboundPollingAndAttachments(t *testing.T) {
+	env := newSupportMailbox(t, false)
+	uid := env.imap.Append(rawMail(`From: olena@example.com
+Subject: Photo
+Message-ID: <m1@example.com>
+MIME-Version: 1.0
+Content-Type: multipart/mixed; boundary="b"`, `--b
+Content-Type: text/plain
+
+See attached
+--b
+Content-Type: image/png
+Content-Disposition: attachment; filename="shot.png"
+Content-Transfer-Encoding: base64
+
+iVBORw==
+--b--`))
+	env.start(t)
+	waitFor(t, func() bool { return env.imap.Seen(uid) })
+
+	docs := env.m.Documents()
+	if len(docs) != 1 {
+		t.Fatalf("forwarded %d documents", len(docs))
+	}
+	doc := docs[0].Document
+	if doc.Body != "Photo\n\nSee attached" || len(doc.Documents) != 1 {
+		t.Fatalf("document = %+v", doc)
+	}
+	if d := doc.Documents[0]; d.ID != "file-1" || d.FileName != "shot.png" || d.MimeType != "image/png" || d.Size != 4 {
+		t.Errorf("file = %+v", d)
+	}
+	if f := env.media.Uploads()[0]; f.Body != "\x89PNG" || f.Req.DomainID != 1 || f.Req.ExternalID != "<m1@example.com>" {
+		t.Errorf("uploaded = %+v", f)
+	}
+}
+
+func TestInboundDropsAutoRepliesAndOwnMail(t *testing.T) {
+	env := newSupportMailbox(t, false)
+	auto := env.imap.Append(rawMail("From: olena@example.com\nAuto-Submitted: auto-replied\nSubject: Out of office", "Back on Monday"))
+	own := env.imap.Append(rawMail("From: "+testAddress+"\nSubject: Loop", "Sent by the gate"))
+	env.start(t)
+	waitFor(t, func() bool { return env.imap.Seen(auto) && env.imap.Seen(own) })
+
+	if texts, docs := env.m.Texts(), env.m.Documents(); len(texts)+len(docs) != 0 {
+		t.Errorf("forwarded %v %v", texts, docs)
+	}
+}
+
+func TestInboundFailureKeepsMessageUnseen(t *testing.T) {
+	env := newSupportMailbox(t, false)
+	env.m.Fail(errors.New("core unavailable"))
+	uid := env.imap.Append(rawMail("From: olena@example.com\nSubject: Hi", "Hello"))
+	env.start(t)
+
+	time.Sleep(100 * time.Millisecond)
+	if env.imap.Seen(uid) {
+		t.Fatal("message marked seen although it was not forwarded")
+	}
+
+	// It is forwarded with a later collection.
+	env.m.Fail(nil)
+	waitFor(t, func() bool { return env.imap.Seen(uid) })
+	if texts := env.m.Texts(); len(texts) != 1 {
+		t.Errorf("forwarded %d texts", len(texts))
+	}
+}
+
+func TestReconnectsAfterConnectionLoss(t *testing.T) {
+	env := newSupportMailbox(t, true)
+	env.start(t)
+	waitFor(t, func() bool { return env.imap.Logins() == 1 })
+
+	env.imap.DropConnections()
+	waitFor(t, func() bool { return env.imap.Logins() == 2 })
+
+	uid := env.imap.Append(rawMail("From: olena@example.com\nSubject: Hi", "Still there?"))
+	waitFor(t, func() bool { return env.imap.Seen(uid) })
+}
+
+// -- outbound --
+
+func TestReplyIsThreaded(t *testing.T) {
+	env := newSupportMailbox(t, false)
+	ctx := context.Background()
+	err := env.threads.SaveThread(ctx, &emailmodel.Thread{
+		GateID:     testGateID,
+		Contact:    testContact,
+		Subject:    "Order 42",
+		MessageID:  "<m2@example.com>",
+		References: []string{"<m1@example.com>", "<m2@example.com>"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resp, err := env.p.SendText(ctx, &sharedmodel.Message{
+		ID:     uuid.New(),
+		GateID: testGateID,
+		To:     sharedmodel.Peer{Sub: testContact},
+		Text:   "It ships today.",
+	})
+	if err != nil {
+		t.Fatalf("SendText: %v", err)
+	}
+
+	received := env.smtp.Received()
+	if len(received) != 1 {
+		t.Fatalf("received %d mails", len(received))
+	}
+	mail := received[0]
+	if mail.From != testAddress || len(mail.To) != 1 || mail.To[0] != testContact {
+		t.Errorf("envelope = %s → %v", mail.From, mail.To)
+	}
+	msg, err := mailmsg.Parse(mail.Data)
+	if err != nil {
+		t.Fatalf("Parse: %v", err)
+	}
+	if msg.Subject != "Re: Order 42" || msg.Text != "It ships today." || msg.From.Name != "Webitel Support" {
+		t.Errorf("message = %q %q %+v", msg.Subject, msg.Text, msg.From)
+	}
+	if msg.InReplyTo != "<m2@example.com>" || strings.Join(msg.References, " ") != "<m1@example.com> <m2@example.com>" {
+		t.Errorf("In-Reply-To = %q, References = %v", msg.InReplyTo, msg.References)
+	}
+	if msg.MessageID != resp.ID {
+		t.Errorf("response ID = %q, Message-ID = %q", resp.ID, msg.MessageID)
+	}
+
+	// The answer to the reply continues the thread instead of starting a new one.
+	thread, _ := env.threads.GetThread(ctx, testGateID, testContact)
+	if thread.MessageID != resp.ID || len(thread.References) != 3 {
+		t.Fatalf("thread = %+v", thread)
+	}
+	answer := rawMail("From: olena@example.com\nSubject: Re: Order 42\nMessage-ID: <m3@example.com>\nIn-Reply-To: "+resp.ID, "Thanks!")
+	if err := env.p.handleMail(ctx, supportGate(env.imap.Addr, env.smtp.Addr), answer); err != nil {
+		t.Fatalf("handleMail: %v", err)
+	}
+	if texts := env.m.Texts(); len(texts) != 1 || texts[0].Body != "Thanks!" {
+		t.Errorf("forwarded = %+v", texts)
+	}
+}
+
+func TestFirstMessageWithAttachment(t *testing.T) {
+	env := newSupportMailbox(t, false)
+	files := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/pdf")
+		_, _ = io.WriteString(w, "%PDF-1.4")
+	}))
+	defer files.Close()
+
+	_, err := env.p.SendDocument(context.Background(), &sharedmodel.Message{
+		ID:        uuid.New(),
+		GateID:    testGateID,
+		To:        sharedmodel.Peer{Sub: testContact},
+		Text:      "Your invoice",
+		Documents: []*sharedmodel.Document{{URL: files.URL + "/files/invoice.pdf"}},
+	})
+	if err != nil {
+		t.Fatalf("SendDocument: %v", err)
+	}
+
+	received := env.smtp.Received()
+	if len(received) != 1 {
+		t.Fatalf("received %d mails", len(received))
+	}
+	msg, err := mailmsg.Parse(received[0].Data)
+	if err != nil {
+		t.Fatalf("Parse: %v", err)
+	}
+	if msg.Subject != "Webitel Support" || msg.InReplyTo != "" || len(msg.References) != 0 {
+		t.Errorf("first message = %q, In-Reply-To %q, References %v", msg.Subject, msg.InReplyTo, msg.References)
+	}
+	if len(msg.Attachments) != 1 || msg.Attachments[0].Name != "invoice.pdf" || string(msg.Attachments[0].Data) != "%PDF-1.4" {
+		t.Errorf("attachments = %+v", msg.Attachments)
+	}
+}
+
+func TestSendFailsOnRejectedRecipient(t *testing.T) {
+	env := newSupportMailbox(t, false)
+	env.smtp.RejectRecipients(true)
+
+	_, err := env.p.SendText(context.Background(), &sharedmodel.Message{
+		GateID: testGateID,
+		To:     sharedmodel.Peer{Sub: testContact},
+		Text:   "Hello",
+	})
+	if err == nil {
+		t.Fatal("rejected recipient accepted")
+	}
+	if _, err := env.threads.GetThread(context.Background(), testGateID, testContact); !errors.Is(err, sharedstore.ErrNotFound) {
+		t.Errorf("thread saved for an unsent message: %v", err)
+	}
+}
+
+func TestHandleWebhookIsRejected(t *testing.T) {
+	env := newSupportMailbox(t, false)
+	if err := env.p.HandleWebhook(context.Background(), []byte(`{}`)); !errors.Is(err, errWebhookUnsupported) {
+		t.Errorf("err = %v, want errWebhookUnsupported", err)
+	}
+}
@@ -0,0 +1,123 @@
+package service
+
+import (
+	"context"
+	"log/slog"
+
+	sharedmodel "github.com/webitel/im-providers-service/internal/core/model"
+	emailmodel "github.com/webitel/im-providers-service/internal/email/model"
+	emailstore "github.com/webitel/im-providers-service/internal/email/store"
+)
+
+var _ EmailManager = (*EmailService)(nil)
+
+type EmailManager interface {
+	CreateGate(ctx context.Context, req emailmodel.CreateEmail) (*emailmodel.EmailGate, error)
+	GetGate(ctx context.Context, id string) (*emailmodel.EmailGate, error)
+	UpdateGate(ctx context.Context, req emailmodel.UpdateEmail) (*emailmodel.EmailGate, error)
+	DeleteGate(ctx context.Context, id string) (*emailmodel.EmailGate, error)
+}
+
+// Watcher keeps the mailboxes of the enabled gates watched for inbound mail.
+// Defined here (exported) so the parent email package can satisfy it without an import cycle.
+type Watcher interface {
+	Start(gate *emailmodel.EmailGate)
+	Stop(gateID string)
+}
+
+type EmailService struct {
+	repo      emailstore.EmailStore
+	mailboxes Watcher
+	log       *slog.Logger
+}
+
+func NewEmailService(repo emailstore.EmailStore, mailboxes Watcher, log *slog.Logger) *EmailService {
+	return &EmailService{
+		repo:      repo,
+		mailboxes: mailboxes,
+		log:       log.With("layer", "service", "domain", "email_gate"),
+	}
+}
+
+// CreateGate stores the gate and starts watching its mailbox.
+func (s *EmailService) CreateGate(ctx context.Context, req emailmodel.CreateEmail) (*emailmodel.EmailGate, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	mailbox := req.Mailbox
+	if mailbox == "" {
+		mailbox = emailmodel.DefaultMailbox
+	}
+	gate := &emailmodel.EmailGate{
+		Name:        req.Name,
+		Address:     req.Address,
+		DisplayName: req.DisplayName,
+		IMAP:        req.IMAP,
+		SMTP:        req.SMTP,
+		Mailbox:     mailbox,
+		Peer:        req.Peer,
+		Enabled:     true,
+	}
+	if err := s.repo.Insert(ctx, req.Dc, gate); err != nil {
+		s.log.Error("failed to create email gate", "err", err)
+		return nil, err
+	}
+
+	s.rewatch(gate)
+	s.log.Info("email gate created", "id", gate.ID, "address", gate.Address)
+	return gate, nil
+}
+
+func (s *EmailService) GetGate(ctx context.Context, id string) (*emailmodel.EmailGate, error) {
+	return s.repo.Select(ctx, id)
+}
+
+// UpdateGate applies the changes and reconnects to the mailbox with them.
+func (s *EmailService) UpdateGate(ctx context.Context, req emailmodel.UpdateEmail) (*emailmodel.EmailGate, error) {
+	gate, err := s.repo.Select(ctx, req.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	req.ApplyTo(gate)
+	if invalid := emailmodel.ValidateAccount(gate.Address, gate.IMAP, gate.SMTP); len(invalid) > 0 {
+		return nil, &sharedmodel.ValidationError{Fields: invalid}
+	}
+	if gate.Mailbox == "" {
+		gate.Mailbox = emailmodel.DefaultMailbox
+	}
+	if err := s.repo.Update(ctx, gate); err != nil {
+		s.log.Error("failed to update email gate", "id", req.ID, "err", err)
+		return nil, err
+	}
+
+	s.rewatch(gate)
+	s.log.Info("email gate updated", "id", gate.ID)
+	return gate, nil
+}
+
+func (s *EmailService) DeleteGate(ctx context.Context, id string) (*emailmodel.EmailGate, error) {
+	gate, err := s.repo.Select(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	s.mailboxes.Stop(id)
+	if err := s.repo.Delete(ctx, id); err != nil {
+		s.log.Error("failed to delete email gate", "id", id, "err", err)
+		return nil, err
+	}
+
+	s.log.Warn("email gate removed", "id", id)
+	return gate, nil
+}
+
+// rewatch (re)starts watching the mailbox of an enabled gate and stops it otherwise.
+func (s *EmailService) rewatch(gate *emailmodel.EmailGate) {
+	if gate.Enabled {
+		s.mailboxes.Start(gate)
+		return
+	}
+	s.mailboxes.Stop(gate.ID)
+}
@@ -0,0 +1,168 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"reflect"
+	"testing"
+
+	sharedmodel "github.com/webitel/im-providers-service/internal/core/model"
+	sharedstore "github.com/webitel/im-providers-service/internal/core/store"
+	emailmodel "github.com/webitel/im-providers-service/internal/email/model"
+	emailstore "github.com/webitel/im-providers-service/internal/email/store"
+)
+
+var noopLogger = slog.New(slog.NewTextHandler(io.Discard, nil))
+
+// mailAccounts stores the gates under sequential IDs.
+type mailAccounts map[string]*emailmodel.EmailGate
+
+var _ emailstore.EmailStore = mailAccounts(nil)
+
+func (a mailAccounts) Insert(_ context.Context, dc int64, g *emailmodel.EmailGate) error {
+	g.ID = fmt.Sprintf("gate-%d", len(a)+1)
+	g.DomainID = dc
+	cp := *g
+	a[g.ID] = &cp
+	return nil
+}
+
+func (a mailAccounts) Select(_ context.Context, id string) (*emailmodel.EmailGate, error) {
+	g, ok := a[id]
+	if !ok {
+		return nil, sharedstore.ErrNotFound
+	}
+	cp := *g
+	return &cp, nil
+}
+
+func (a mailAccounts) SelectEnabled(context.Context) ([]*emailmodel.EmailGate, error) {
+	return nil, nil
+}
+
+func (a mailAccounts) Update(_ context.Context, g *emailmodel.EmailGate) error {
+	cp := *g
+	a[g.ID] = &cp
+	return nil
+}
+
+func (a mailAccounts) Delete(_ context.Context, id string) error {
+	delete(a, id)
+	return nil
+}
+
+// watchedMailboxes records the gates whose mailboxes were watched and unwatched.
+type watchedMailboxes struct {
+	started []*emailmodel.EmailGate
+	stopped []string
+}
+
+func (w *watchedMailboxes) Start(gate *emailmodel.EmailGate) { w.started = append(w.started, gate) }
+func (w *watchedMailboxes) Stop(gateID string)               { w.stopped = append(w.stopped, gateID) }
+
+func newEmailService() (*EmailService, mailAccounts, *watchedMailboxes) {
+	accounts := mailAccounts{}
+	watcher := &watchedMailboxes{}
+	return NewEmailService(accounts, watcher, noopLogger), accounts, watcher
+}
+
+func validCreate() emailmodel.CreateEmail {
+	return emailmodel.CreateEmail{
+		Name:    "Support",
+		Dc:      1,
+		Address: "support@webitel.com",
+		IMAP:    emailmodel.ServerConfig{Host: "imap.webitel.com", Port: 993, Username: "support@webitel.com", Password: "imap-secret", TLS: true},
+		SMTP:    emailmodel.ServerConfig{Host: "smtp.webitel.com", Port: 587, Username: "support@webitel.com", Password: "smtp-secret"},
+	}
+}
+
+func TestCreateGateStartsWatching(t *testing.T) {
+	svc, _, watcher := newEmailService()
+
+	gate, err := svc.CreateGate(context.Background(), validCreate())
+	if err != nil {
+		t.Fatalf("CreateGate: %v", err)
+	}
+	if gate.Mailbox != emailmodel.DefaultMailbox || !gate.Enabled {
+		t.Errorf("gate = %+v", gate)
+	}
+	if len(watcher.started) != 1 || watcher.started[0].ID != "gate-1" {
+		t.Errorf("started = %v", watcher.started)
+	}
+}
+
+func TestCreateGateValidation(t *testing.T) {
+	svc, accounts, watcher := newEmailService()
+
+	req := validCreate()
+	req.Address = "Support <support@webitel.com>"
+	req.IMAP.Port = 0
+	req.SMTP.Host = ""
+	req.IMAP.Username = ""
+
+	_, err := svc.CreateGate(context.Background(), req)
+	var verr *sharedmodel.ValidationError
+	if !errors.As(err, &verr) {
+		t.Fatalf("err = %v, want ValidationError", err)
+	}
+	want := []string{"address", "imap.port", "smtp.host", "imap.username"}
+	if !reflect.DeepEqual(verr.Fields, want) {
+		t.Errorf("fields = %v, want %v", verr.Fields, want)
+	}
+	if len(accounts) != 0 || len(watcher.started) != 0 {
+		t.Error("invalid gate stored or watched")
+	}
+}
+
+func TestUpdateGateKeepsPasswords(t *testing.T) {
+	svc, accounts, watcher := newEmailService()
+	ctx := context.Background()
+	if _, err := svc.CreateGate(ctx, validCreate()); err != nil {
+		t.Fatal(err)
+	}
+
+	imap := emailmodel.ServerConfig{Host: "mail.webitel.com", Port: 143, Username: "support@webitel.com"}
+	gate, err := svc.UpdateGate(ctx, emailmodel.UpdateEmail{ID: "gate-1", IMAP: &imap})
+	if err != nil {
+		t.Fatalf("UpdateGate: %v", err)
+	}
+	if gate.IMAP.Host != "mail.webitel.com" || gate.IMAP.Password != "imap-secret" {
+		t.Errorf("imap = %+v", gate.IMAP)
+	}
+	if accounts["gate-1"].SMTP.Password != "smtp-secret" {
+		t.Error("smtp password lost")
+	}
+	// The mailbox is watched again with the new server.
+	if len(watcher.started) != 2 || watcher.started[1].IMAP.Host != "mail.webitel.com" {
+		t.Errorf("started = %v", watcher.started)
+	}
+}
+
+func TestDisableAndDeleteStopWatching(t *testing.T) {
+	svc, accounts, watcher := newEmailService()
+	ctx := context.Background()
+	if _, err := svc.CreateGate(ctx, validCreate()); err != nil {
+		t.Fatal(err)
+	}
+
+	disabled := false
+	if _, err := svc.UpdateGate(ctx, emailmodel.UpdateEmail{ID: "gate-1", Enabled: &disabled}); err != nil {
+		t.Fatalf("UpdateGate: %v", err)
+	}
+	if len(watcher.started) != 1 || len(watcher.stopped) != 1 {
+		t.Errorf("started %d, stopped %v", len(watcher.started), watcher.stopped)
+	}
+
+	if _, err := svc.DeleteGate(ctx, "gate-1"); err != nil {
+		t.Fatalf("DeleteGate: %v", err)
+	}
+	if len(accounts) != 0 || len(watcher.stopped) != 2 {
+		t.Errorf("gates %v, stopped %v", accounts, watcher.stopped)
+	}
+	if _, err := svc.DeleteGate(ctx, "gate-1"); !errors.Is(err, sharedstore.ErrNotFound) {
+		t.Errorf("second delete err = %v", err)
+	}
+}
@@ -0,0 +1,78 @@
+package email
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net"
+	"net/smtp"
+	"time"
+
+	emailmodel "github.com/webitel/im-providers-service/internal/email/model"
+)
+
+// smtpSender delivers composed messages to the SMTP server of a gate.
+type smtpSender struct {
+	// timeout bounds a whole delivery.
+	timeout time.Duration
+}
+
+// Send delivers the message from the address of the gate to the recipient. The
+// connection is wrapped in TLS for an implicit TLS server, and upgraded with STARTTLS
+// otherwise when the server offers it; the account of the gate authenticates with
+// PLAIN when it has a username.
+func (s *smtpSender) Send(ctx context.Context, gate *emailmodel.EmailGate, to string, msg []byte) error {
+	cfg := gate.SMTP
+
+	var d net.Dialer
+	conn, err := d.DialContext(ctx, "tcp", cfg.Addr())
+	if err != nil {
+		return fmt.Errorf("smtp: dial %s: %w", cfg.Addr(), err)
+	}
+	deadline := time.Now().Add(s.timeout)
+	if dl, ok := ctx.Deadline(); ok && dl.Before(deadline) {
+		deadline = dl
+	}
+	_ = conn.SetDeadline(deadline)
+	if cfg.TLS {
+		conn = tls.Client(conn, &tls.Config{ServerName: cfg.Host})
+	}
+
+	c, err := smtp.NewClient(conn, cfg.Host)
+	if err != nil {
+		conn.Close()
+		return fmt.Errorf("smtp: %w", err)
+	}
+	defer c.Close()
+
+	if !cfg.TLS {
+		if ok, _ := c.Extension("STARTTLS"); ok {
+			if err := c.StartTLS(&tls.Config{ServerName: cfg.Host}); err != nil {
+				return fmt.Errorf("smtp: starttls: %w", err)
+			}
+		}
+	}
+	if cfg.Username != "" {
+		if err := c.Auth(smtp.PlainAuth("", cfg.Username, cfg.Password, cfg.Host)); err != nil {
+			return fmt.Errorf("smtp: auth: %w", err)
+		}
+	}
+
+	if err := c.Mail(gate.Address); err != nil {
+		return fmt.Errorf("smtp: mail from: %w", err)
+	}
+	if err := c.Rcpt(to); err != nil {
+		return fmt.Errorf("smtp: rcpt to %s: %w", to, err)
+	}
+	w, err := c.Data()
+	if err != nil {
+		return fmt.Errorf("smtp: data: %w", err)
+	}
+	if _, err := w.Write(msg); err != nil {
+		return fmt.Errorf("smtp: data: %w", err)
+	}
+	if err := w.Close(); err != nil {
+		return fmt.Errorf("smtp: data: %w", err)
+	}
+	return c.Quit()
+}
@@ -0,0 +1,228 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/georgysavva/scany/v2/pgxscan"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	sharedmodel "github.com/webitel/im-providers-service/internal/core/model"
+	sharedstore "github.com/webitel/im-providers-service/internal/core/store"
+	emailmodel "github.com/webitel/im-providers-service/internal/email/model"
+	emailstore "github.com/webitel/im-providers-service/internal/email/store"
+	"github.com/webitel/im-providers-service/pkg/crypto"
+)
+
+var _ emailstore.EmailStore = (*emailStore)(nil)
+
+type emailStore struct {
+	pool   *pgxpool.Pool
+	crypto crypto.Encryptor
+	cache  sharedstore.GateCache
+}
+
+func NewEmailStore(pool *pgxpool.Pool, crypt crypto.Encryptor, cache sharedstore.GateCache) emailstore.EmailStore {
+	return &emailStore{
+		pool:   pool,
+		crypto: crypt,
+		cache:  cache,
+	}
+}
+
+const selectGates = `
+	SELECT
+		g.id,
+		g.dc AS domain_id,
+		g.name,
+		g.enabled,
+		g.created_at,
+		g.updated_at,
+		b.sub AS "peer.sub",
+		b.iss AS "peer.iss",
+		e.address,
+		e.display_name,
+		e.mailbox,
+		e.imap_host AS "imap.host",
+		e.imap_port AS "imap.port",
+		e.imap_username AS "imap.username",
+		e.imap_password AS "imap.password",
+		e.imap_tls AS "imap.tls",
+		e.smtp_host AS "smtp.host",
+		e.smtp_port AS "smtp.port",
+		e.smtp_username AS "smtp.username",
+		e.smtp_password AS "smtp.password",
+		e.smtp_tls AS "smtp.tls"
+	FROM im_provider.gates g
+	JOIN im_provider.bots b ON g.id = b.gate_id
+	JOIN im_provider.email e ON g.id = e.gate_id`
+
+func (s *emailStore) Insert(ctx context.Context, dc int64, g *emailmodel.EmailGate) error {
+	imapPassword, smtpPassword, err := s.encrypt(g)
+	if err != nil {
+		return err
+	}
+
+	const query = `
+	WITH new_gate AS (
+		INSERT INTO im_provider.gates (dc, name, type, enabled)
+		VALUES ($1, $2, 'email', $3)
+		RETURNING id, name, created_at, updated_at
+	),
+	new_bot AS (
+		INSERT INTO im_provider.bots (sub, iss, gate_id)
+		SELECT $4, $5, id FROM new_gate
+		RETURNING id
+	)
+	INSERT INTO im_provider.email (
+		gate_id, address, display_name, mailbox,
+		imap_host, imap_port, imap_username, imap_password, imap_tls,
+		smtp_host, smtp_port, smtp_username, smtp_password, smtp_tls
+	)
+	SELECT id, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18 FROM new_gate
+	RETURNING
+		gate_id,
+		(SELECT name FROM new_gate),
+		(SELECT created_at FROM new_gate),
+		(SELECT updated_at FROM new_gate)`
+
+	err = s.pool.QueryRow(ctx, query,
+		dc, g.Name, g.Enabled, g.Peer.Sub, g.Peer.Iss, g.Address, g.DisplayName, g.Mailbox,
+		g.IMAP.Host, g.IMAP.Port, g.IMAP.Username, imapPassword, g.IMAP.TLS,
+		g.SMTP.Host, g.SMTP.Port, g.SMTP.Username, smtpPassword, g.SMTP.TLS,
+	).Scan(&g.ID, &g.Name, &g.CreatedAt, &g.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("postgres: insert email gate: %w", err)
+	}
+
+	g.DomainID = dc
+	mapVirtualFields(g)
+	return nil
+}
+
+func (s *emailStore) Select(ctx context.Context, id string) (*emailmodel.EmailGate, error) {
+	var g emailmodel.EmailGate
+	if err := pgxscan.Get(ctx, s.pool, &g, selectGates+` WHERE g.id = $1`, id); err != nil {
+		if pgxscan.NotFound(err) {
+			return nil, sharedstore.ErrNotFound
+		}
+		return nil, fmt.Errorf("postgres: select email gate: %w", err)
+	}
+	if err := s.decrypt(&g); err != nil {
+		return nil, err
+	}
+
+	mapVirtualFields(&g)
+	return &g, nil
+}
+
+func (s *emailStore) SelectEnabled(ctx context.Context) ([]*emailmodel.EmailGate, error) {
+	var gates []*emailmodel.EmailGate
+	if err := pgxscan.Select(ctx, s.pool, &gates, selectGates+` WHERE g.enabled ORDER BY g.created_at`); err != nil {
+		return nil, fmt.Errorf("postgres: select enabled email gates: %w", err)
+	}
+
+	for _, g := range gates {
+		if err := s.decrypt(g); err != nil {
+			return nil, err
+		}
+		mapVirtualFields(g)
+	}
+	return gates, nil
+}
+
+func (s *emailStore) Update(ctx context.Context, g *emailmodel.EmailGate) error {
+	imapPassword, smtpPassword, err := s.encrypt(g)
+	if err != nil {
+		return err
+	}
+
+	err = pgx.BeginFunc(ctx, s.pool, func(tx pgx.Tx) error {
+		const uGate = `UPDATE im_provider.gates SET name = $1, enabled = $2, updated_at = NOW() WHERE id = $3 RETURNING updated_at`
+		if err := tx.QueryRow(ctx, uGate, g.Name, g.Enabled, g.ID).Scan(&g.UpdatedAt); err != nil {
+			return err
+		}
+
+		const uBot = `UPDATE im_provider.bots SET sub = $1, iss = $2 WHERE gate_id = $3`
+		if _, err := tx.Exec(ctx, uBot, g.Peer.Sub, g.Peer.Iss, g.ID); err != nil {
+			return err
+		}
+
+		const uConfig = `
+		UPDATE im_provider.email SET
+			address = $1, display_name = $2, mailbox = $3,
+			imap_host = $4, imap_port = $5, imap_username = $6, imap_password = $7, imap_tls = $8,
+			smtp_host = $9, smtp_port = $10, smtp_username = $11, smtp_password = $12, smtp_tls = $13
+		WHERE gate_id = $14`
+		_, err := tx.Exec(ctx, uConfig,
+			g.Address, g.DisplayName, g.Mailbox,
+			g.IMAP.Host, g.IMAP.Port, g.IMAP.Username, imapPassword, g.IMAP.TLS,
+			g.SMTP.Host, g.SMTP.Port, g.SMTP.Username, smtpPassword, g.SMTP.TLS,
+			g.ID,
+		)
+		return err
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return sharedstore.ErrNotFound
+		}
+		return fmt.Errorf("postgres: update email gate: %w", err)
+	}
+
+	s.cache.Delete(g.ID)
+	mapVirtualFields(g)
+	return nil
+}
+
+func (s *emailStore) Delete(ctx context.Context, id string) error {
+	res, err := s.pool.Exec(ctx, "DELETE FROM im_provider.gates WHERE id = $1 AND type = 'email'", id)
+	if err != nil {
+		return fmt.Errorf("postgres: delete email gate: %w", err)
+	}
+	if res.RowsAffected() == 0 {
+		return sharedstore.ErrNotFound
+	}
+
+	s.cache.Delete(id)
+	return nil
+}
+
+// encrypt returns the encrypted IMAP and SMTP passwords; unset ones stay empty.
+func (s *emailStore) encrypt(g *emailmodel.EmailGate) (imapPassword, smtpPassword string, err error) {
+	if g.IMAP.Password != "" {
+		if imapPassword, err = s.crypto.Encrypt(g.IMAP.Password); err != nil {
+			return "", "", fmt.Errorf("crypto: %w", err)
+		}
+	}
+	if g.SMTP.Password != "" {
+		if smtpPassword, err = s.crypto.Encrypt(g.SMTP.Password); err != nil {
+			return "", "", fmt.Errorf("crypto: %w", err)
+		}
+	}
+	return imapPassword, smtpPassword, nil
+}
+
+// decrypt decrypts the passwords in place. A password that cannot be decrypted would
+// fail every login, so it is an error.
+func (s *emailStore) decrypt(g *emailmodel.EmailGate) error {
+	for _, v := range []*string{&g.IMAP.Password, &g.SMTP.Password} {
+		if *v == "" {
+			continue
+		}
+		dec, err := s.crypto.Decrypt(*v)
+		if err != nil {
+			return fmt.Errorf("email gate %s: crypto: %w", g.ID, err)
+		}
+		*v = dec
+	}
+	return nil
+}
+
+func mapVirtualFields(g *emailmodel.EmailGate) {
+	if g.Enabled {
+		g.Status = sharedmodel.StatusActive
+	} else {
+		g.Status = sharedmodel.StatusDisabled
+	}
+}
@@ -0,0 +1,55 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/georgysavva/scany/v2/pgxscan"
+	"github.com/jackc/pgx/v5/pgxpool"
+	sharedstore "github.com/webitel/im-providers-service/internal/core/store"
+	emailmodel "github.com/webitel/im-providers-service/internal/email/model"
+	emailstore "github.com/webitel/im-providers-service/internal/email/store"
+)
+
+var _ emailstore.ThreadStore = (*threadStore)(nil)
+
+type threadStore struct {
+	pool *pgxpool.Pool
+}
+
+func NewThreadStore(pool *pgxpool.Pool) emailstore.ThreadStore {
+	return &threadStore{pool: pool}
+}
+
+func (s *threadStore) GetThread(ctx context.Context, gateID, contact string) (*emailmodel.Thread, error) {
+	const query = `
+	SELECT gate_id, contact, subject, message_id, refs, updated_at
+	FROM im_provider.email_threads
+	WHERE gate_id = $1 AND contact = lower($2)`
+
+	var t emailmodel.Thread
+	if err := pgxscan.Get(ctx, s.pool, &t, query, gateID, contact); err != nil {
+		if pgxscan.NotFound(err) {
+			return nil, sharedstore.ErrNotFound
+		}
+		return nil, fmt.Errorf("postgres: select email thread: %w", err)
+	}
+	return &t, nil
+}
+
+func (s *threadStore) SaveThread(ctx context.Context, t *emailmodel.Thread) error {
+	const query = `
+	INSERT INTO im_provider.email_threads (gate_id, contact, subject, message_id, refs)
+	VALUES ($1, lower($2), $3, $4, $5)
+	ON CONFLICT (gate_id, contact) DO UPDATE SET
+		subject = EXCLUDED.subject,
+		message_id = EXCLUDED.message_id,
+		refs = EXCLUDED.refs,
+		updated_at = NOW()
+	RETURNING updated_at`
+
+	if err := s.pool.QueryRow(ctx, query, t.GateID, t.Contact, t.Subject, t.MessageID, t.References).Scan(&t.UpdatedAt); err != nil {
+		return fmt.Errorf("postgres: save email thread: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,25 @@
+package store
+
+import (
+	"context"
+
+	emailmodel "github.com/webitel/im-providers-service/internal/email/model"
+)
+
+// EmailStore manages email gates. Server passwords are stored encrypted.
+type EmailStore interface {
+	// Insert creates the gate together with its bot peer and mailbox settings.
+	Insert(ctx context.Context, dc int64, g *emailmodel.EmailGate) error
+	Select(ctx context.Context, id string) (*emailmodel.EmailGate, error)
+	// SelectEnabled returns every enabled gate; their mailboxes are watched on start.
+	SelectEnabled(ctx context.Context) ([]*emailmodel.EmailGate, error)
+	Update(ctx context.Context, g *emailmodel.EmailGate) error
+	Delete(ctx context.Context, id string) error
+}
+
+// ThreadStore keeps the mail thread of every correspondent of a gate.
+type ThreadStore interface {
+	// GetThread returns sharedstore.ErrNotFound for a correspondent without a thread.
+	GetThread(ctx context.Context, gateID, contact string) (*emailmodel.Thread, error)
+	SaveThread(ctx context.Context, t *emailmodel.Thread) error
+}
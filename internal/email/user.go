@@ -0,0 +1,53 @@
+package email
+
+import (
+	"context"
+
+	gatewayv1 "github.com/webitel/im-providers-service/gen/go/gateway/v1"
+	sharedmodel "github.com/webitel/im-providers-service/internal/core/model"
+	emailmodel "github.com/webitel/im-providers-service/internal/email/model"
+	"github.com/webitel/im-providers-service/internal/provider/contactsync"
+)
+
+// metadataEmail is the contact metadata key of the email address.
+const metadataEmail = "email"
+
+// syncContact resolves the internal contact for an email address, creating it if
+// necessary. The address is the subject; the display name of the sender, or the
+// address when there is none, is the name of the contact. The result is cached so
+// repeated messages from the same address skip the gateway round-trip.
+func (p *emailProvider) syncContact(ctx context.Context, gate *emailmodel.EmailGate, address, name string) error {
+	if name == "" {
+		name = address
+	}
+	external := &sharedmodel.ExternalUser{ID: address, FirstName: name}
+	key := contactsync.KnownUser(gate.ID, external)
+
+	if known, _ := p.userCache.IsKnown(ctx, key); known {
+		return nil
+	}
+
+	authCtx := contactsync.WithGatewayIdentity(ctx, gate.DomainID, gate.Peer)
+
+	contact, err := p.ensureContact(authCtx, external)
+	if err != nil {
+		return err
+	}
+
+	contactsync.EnsureVia(authCtx, p.gatewayer, p.logger, gate.ID, external.ID, contact.Iss)
+	_ = p.userCache.MarkKnown(ctx, key)
+
+	return nil
+}
+
+// ensureContact creates the internal contact or returns a stub when the
+// contact already exists (idempotent by design on the gateway side).
+func (p *emailProvider) ensureContact(ctx context.Context, external *sharedmodel.ExternalUser) (*gatewayv1.Contact, error) {
+	return contactsync.CreateContact(ctx, p.gatewayer, &gatewayv1.CreateContactRequest{
+		IssId:    p.Type(),
+		Type:     p.Type(),
+		Name:     external.FirstName,
+		Subject:  external.ID,
+		Metadata: map[string]string{metadataEmail: external.ID},
+	})
+}
@@ -0,0 +1,97 @@
+package facebook
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	sharedmodel "github.com/webitel/im-providers-service/internal/core/model"
+	fbmodel "github.com/webitel/im-providers-service/internal/facebook/model"
+	fbstore "github.com/webitel/im-providers-service/internal/facebook/store"
+)
+
+const (
+	// callbackTTL matches the Messenger standard messaging window: a keyboard
+	// older than that cannot be answered by the user anyway.
+	callbackTTL = 24 * time.Hour
+	// callbackKeyboardsPerConversation keeps only the latest keyboards of a conversation.
+	callbackKeyboardsPerConversation = 5
+)
+
+// callbackRegistry correlates postback and quick-reply payloads with the interactive
+// message that carried the tapped button. Facebook does not echo the original message
+// ID back, so the lookup is done per conversation by payload, newest keyboard first.
+type callbackRegistry struct {
+	store  fbstore.CallbackStore
+	logger *slog.Logger
+}
+
+func newCallbackRegistry(store fbstore.CallbackStore, logger *slog.Logger) *callbackRegistry {
+	return &callbackRegistry{store: store, logger: logger}
+}
+
+// remember stores the callback buttons of a sent message.
+// Messages without callback buttons are ignored. A storage failure only loses
+// the correlation, so it is logged and never fails the send.
+func (r *callbackRegistry) remember(ctx context.Context, gateID, psid, messageID string, all []sharedmodel.KeyboardButton) {
+	buttons := callbackButtons(all)
+	if messageID == "" || len(buttons) == 0 {
+		return
+	}
+
+	if err := r.store.Push(ctx, gateID, psid, &fbmodel.SentKeyboard{MessageID: messageID, Buttons: buttons}); err != nil {
+		r.logger.WarnContext(ctx, "remember keyboard failed", "gate_id", gateID, "message_id", messageID, "err", err)
+	}
+}
+
+// lookup returns the message and button that carried the given callback data.
+func (r *callbackRegistry) lookup(ctx context.Context, gateID, psid, data string) (messageID, buttonCode string, ok bool) {
+	sent, err := r.store.List(ctx, gateID, psid)
+	if err != nil {
+		r.logger.WarnContext(ctx, "keyboard lookup failed", "gate_id", gateID, "err", err)
+		return "", "", false
+	}
+	for _, kb := range sent {
+		if code, found := kb.Buttons[data]; found {
+			return kb.MessageID, code, true
+		}
+	}
+	return "", "", false
+}
+
+// interactiveButtons returns every button of the keyboard or list.
+func interactiveButtons(interactive *sharedmodel.Interactive) []sharedmodel.KeyboardButton {
+	if interactive == nil {
+		return nil
+	}
+
+	var all []sharedmodel.KeyboardButton
+	if interactive.Markup != nil {
+		for _, row := range interactive.Markup.Rows {
+			all = append(all, row.Buttons...)
+		}
+	}
+	if interactive.ListReply != nil {
+		for _, section := range interactive.ListReply.Sections {
+			all = append(all, section.Buttons...)
+		}
+	}
+	return all
+}
+
+// callbackButtons collects callback data → button code for every callback button.
+// The button label stands in for the code when the button has no ID.
+func callbackButtons(all []sharedmodel.KeyboardButton) map[string]string {
+	buttons := make(map[string]string, len(all))
+	for _, b := range all {
+		if b.Callback == nil || b.Callback.Data == "" {
+			continue
+		}
+		code := b.ID
+		if code == "" {
+			code = b.Label
+		}
+		buttons[b.Callback.Data] = code
+	}
+	return buttons
+}
@@ -0,0 +1,120 @@
+package facebook
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"testing"
+
+	sharedmodel "github.com/webitel/im-providers-service/internal/core/model"
+	fbmodel "github.com/webitel/im-providers-service/internal/facebook/model"
+	fbstore "github.com/webitel/im-providers-service/internal/facebook/store"
+)
+
+// memCallbackStore keeps keyboards per conversation, newest first.
+type memCallbackStore struct {
+	sent map[string][]*fbmodel.SentKeyboard
+	err  error
+}
+
+func (m *memCallbackStore) Push(_ context.Context, gateID, psid string, kb *fbmodel.SentKeyboard) error {
+	if m.err != nil {
+		return m.err
+	}
+	k := gateID + ":" + psid
+	m.sent[k] = append([]*fbmodel.SentKeyboard{kb}, m.sent[k]...)
+	return nil
+}
+
+func (m *memCallbackStore) List(_ context.Context, gateID, psid string) ([]*fbmodel.SentKeyboard, error) {
+	if m.err != nil {
+		return nil, m.err
+	}
+	return m.sent[gateID+":"+psid], nil
+}
+
+var _ fbstore.CallbackStore = (*memCallbackStore)(nil)
+
+func newTestCallbackRegistry() (*callbackRegistry, *memCallbackStore) {
+	store := &memCallbackStore{sent: map[string][]*fbmodel.SentKeyboard{}}
+	return newCallbackRegistry(store, slog.New(slog.NewTextHandler(io.Discard, nil))), store
+}
+
+func keyboard(buttons ...sharedmodel.KeyboardButton) []sharedmodel.KeyboardButton {
+	return interactiveButtons(&sharedmodel.Interactive{
+		Markup: &sharedmodel.KeyboardMarkup{Rows: []sharedmodel.KeyboardRow{{Buttons: buttons}}},
+	})
+}
+
+func callbackButton(id, label, data string) sharedmodel.KeyboardButton {
+	return sharedmodel.KeyboardButton{ID: id, Label: label, Callback: &sharedmodel.KeyboardButtonCallback{Data: data}}
+}
+
+func TestCallbackRegistry_Lookup(t *testing.T) {
+	r, _ := newTestCallbackRegistry()
+	r.remember(context.Background(), "gate-1", "psid-1", "msg-1", keyboard(
+		callbackButton("yes", "Yes", "ANSWER_YES"),
+		callbackButton("", "No", "ANSWER_NO"),
+		sharedmodel.KeyboardButton{Label: "Site", URL: &sharedmodel.KeyboardButtonURL{URL: "https://example.com"}},
+	))
+
+	tests := []struct {
+		name     string
+		gateID   string
+		psid     string
+		data     string
+		wantOK   bool
+		wantCode string
+	}{
+		{"button with id", "gate-1", "psid-1", "ANSWER_YES", true, "yes"},
+		{"label stands in for missing id", "gate-1", "psid-1", "ANSWER_NO", true, "No"},
+		{"unknown payload", "gate-1", "psid-1", "GET_STARTED", false, ""},
+		{"other user", "gate-1", "psid-2", "ANSWER_YES", false, ""},
+		{"other gate", "gate-2", "psid-1", "ANSWER_YES", false, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			msgID, code, ok := r.lookup(context.Background(), tt.gateID, tt.psid, tt.data)
+			if ok != tt.wantOK {
+				t.Fatalf("ok = %v, want %v", ok, tt.wantOK)
+			}
+			if ok && (msgID != "msg-1" || code != tt.wantCode) {
+				t.Errorf("got (%s, %s), want (msg-1, %s)", msgID, code, tt.wantCode)
+			}
+		})
+	}
+}
+
+func TestCallbackRegistry_NewestKeyboardWins(t *testing.T) {
+	r, _ := newTestCallbackRegistry()
+	r.remember(context.Background(), "gate-1", "psid-1", "msg-1", keyboard(callbackButton("ok", "OK", "OK")))
+	r.remember(context.Background(), "gate-1", "psid-1", "msg-2", keyboard(callbackButton("ok", "OK", "OK")))
+
+	msgID, _, ok := r.lookup(context.Background(), "gate-1", "psid-1", "OK")
+	if !ok || msgID != "msg-2" {
+		t.Errorf("expected newest keyboard msg-2, got %q (ok=%v)", msgID, ok)
+	}
+}
+
+func TestCallbackRegistry_StoreErrorMissesLookup(t *testing.T) {
+	r, store := newTestCallbackRegistry()
+	store.err = errors.New("redis down")
+	r.remember(context.Background(), "gate-1", "psid-1", "msg-1", keyboard(callbackButton("ok", "OK", "OK")))
+
+	if _, _, ok := r.lookup(context.Background(), "gate-1", "psid-1", "OK"); ok {
+		t.Error("expected no match when the store fails")
+	}
+}
+
+func TestCallbackRegistry_IgnoresKeyboardWithoutCallbacks(t *testing.T) {
+	r, store := newTestCallbackRegistry()
+	r.remember(context.Background(), "gate-1", "psid-1", "msg-1", keyboard(
+		sharedmodel.KeyboardButton{Label: "Phone", Request: &sharedmodel.KeyboardButtonRequest{Action: "phone"}},
+	))
+
+	if len(store.sent) != 0 {
+		t.Error("keyboard without callback buttons must not be stored")
+	}
+}
@@ -14,6 +14,7 @@ import (
 	"time"
 
 	sharedmodel "github.com/webitel/im-providers-service/internal/core/model"
+	fbmodel "github.com/webitel/im-providers-service/internal/facebook/model"
 )
 
 // GraphBaseURL is the versioned Graph API endpoint.
@@ -30,11 +31,12 @@ const (
 // graphAPI is the contract used by facebookProvider to talk to the Graph API.
 // Keeping it as an interface allows the provider to be tested without network calls.
 type graphAPI interface {
-	GetUserProfile(ctx context.Context, psid, token string) (*UserProfile, error)
+	GetUserProfile(ctx context.Context, psid, token string) (*fbmodel.UserProfile, error)
 	ParseWebhook(data []byte) (*WebhookRequest, error)
 	SendText(ctx context.Context, token, psid, text string) (*sharedmodel.MessageResponse, error)
 	SendMedia(ctx context.Context, token, psid, mediaType, rawURL string) (*sharedmodel.MessageResponse, error)
 	SendInteractive(ctx context.Context, token, psid, body string, interactive *sharedmodel.Interactive) (*sharedmodel.MessageResponse, error)
+	SendCards(ctx context.Context, token, psid string, carousel *sharedmodel.Carousel, media *sharedmodel.MediaCard) (*sharedmodel.MessageResponse, error)
 	SetMessengerProfile(ctx context.Context, token string, profile any) error
 	DeleteMessengerProfile(ctx context.Context, token string, fields []string) error
 }
@@ -74,7 +76,7 @@ const (
 	fieldTimezone   = "timezone"
 )
 
-func (c *apiClient) GetUserProfile(ctx context.Context, psid, token string) (*UserProfile, error) {
+func (c *apiClient) GetUserProfile(ctx context.Context, psid, token string) (*fbmodel.UserProfile, error) {
 	rawURL, err := buildProfileQuery(c.apiURL, psid,
 		fieldID, fieldFirstName, fieldLastName, fieldProfilePic, fieldLocale, fieldTimezone,
 	)
@@ -96,10 +98,16 @@ func (c *apiClient) GetUserProfile(ctx context.Context, psid, token string) (*Us
 
 	if resp.StatusCode != http.StatusOK {
 		b, _ := io.ReadAll(resp.Body)
+		if isTokenInvalidError(b) {
+			return nil, ErrTokenInvalid
+		}
+		if isPermissionError(b) {
+			return nil, fbmodel.ErrProfileUnavailable
+		}
 		return nil, fmt.Errorf("fb profile: status %d: %s", resp.StatusCode, b)
 	}
 
-	var profile UserProfile
+	var profile fbmodel.UserProfile
 	return &profile, json.NewDecoder(resp.Body).Decode(&profile)
 }
 
@@ -256,14 +264,36 @@ type fbButtonTemplatePayload struct {
 }
 
 type fbGenericTemplatePayload struct {
-	TemplateType string      `json:"template_type"` // "generic"
-	Elements     []fbElement `json:"elements"`
+	TemplateType     string      `json:"template_type"`                // "generic"
+	ImageAspectRatio string      `json:"image_aspect_ratio,omitempty"` // horizontal | square
+	Elements         []fbElement `json:"elements"`
 }
 
 type fbElement struct {
-	Title    string     `json:"title"`
-	Subtitle string     `json:"subtitle,omitempty"`
-	Buttons  []fbButton `json:"buttons,omitempty"`
+	Title         string           `json:"title"`
+	Subtitle      string           `json:"subtitle,omitempty"`
+	ImageURL      string           `json:"image_url,omitempty"`
+	DefaultAction *fbDefaultAction `json:"default_action,omitempty"`
+	Buttons       []fbButton       `json:"buttons,omitempty"`
+}
+
+// fbDefaultAction is opened when the card itself is tapped.
+type fbDefaultAction struct {
+	Type               string `json:"type"` // always "web_url"
+	URL                string `json:"url"`
+	WebviewHeightRatio string `json:"webview_height_ratio,omitempty"`
+}
+
+// https://developers.facebook.com/docs/messenger-platform/send-messages/template/media
+type fbMediaTemplatePayload struct {
+	TemplateType string           `json:"template_type"` // "media"
+	Elements     []fbMediaElement `json:"elements"`      // exactly one
+}
+
+type fbMediaElement struct {
+	MediaType string     `json:"media_type"` // image | video
+	URL       string     `json:"url"`
+	Buttons   []fbButton `json:"buttons,omitempty"`
 }
 
 // SendInteractive sends a Quick Replies, Button Template, or Generic Template message
@@ -277,6 +307,27 @@ func (c *apiClient) SendInteractive(ctx context.Context, token, psid, body strin
 	if err != nil {
 		return nil, err
 	}
+	return c.sendInteractiveMessage(ctx, token, psid, msg)
+}
+
+// SendCards sends a Generic Template (carousel) or, when media is set, a Media Template.
+func (c *apiClient) SendCards(ctx context.Context, token, psid string, carousel *sharedmodel.Carousel, media *sharedmodel.MediaCard) (*sharedmodel.MessageResponse, error) {
+	var (
+		msg interactiveOutboundMessage
+		err error
+	)
+	if media != nil {
+		msg, err = buildMediaTemplate(media)
+	} else {
+		msg, err = buildCarouselMessage(carousel)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return c.sendInteractiveMessage(ctx, token, psid, msg)
+}
+
+func (c *apiClient) sendInteractiveMessage(ctx context.Context, token, psid string, msg interactiveOutboundMessage) (*sharedmodel.MessageResponse, error) {
 	payload := interactiveOutboundPayload{
 		Type:      msgTypeResponse,
 		Recipient: outboundRecipient{ID: psid},
@@ -326,6 +377,8 @@ func buildInteractiveMessage(body string, interactive *sharedmodel.Interactive)
 		return buildMarkupMessage(body, interactive.Markup)
 	case interactive.ListReply != nil:
 		return buildListReplyMessage(interactive.ListReply)
+	case interactive.Flow != nil:
+		return interactiveOutboundMessage{}, fmt.Errorf("flow messages are supported by WhatsApp only")
 	default:
 		return interactiveOutboundMessage{}, fmt.Errorf("interactive has no kind set")
 	}
@@ -439,19 +492,10 @@ func buildListReplyMessage(list *sharedmodel.KeyboardListReply) (interactiveOutb
 		if i >= maxElements {
 			break
 		}
-		el := fbElement{Title: section.Section}
-		for _, b := range section.Buttons {
-			if len(el.Buttons) >= maxButtonsPerElement {
-				break
-			}
-			switch {
-			case b.URL != nil:
-				el.Buttons = append(el.Buttons, fbButton{Type: "web_url", Title: b.Label, URL: b.URL.URL})
-			case b.Callback != nil:
-				el.Buttons = append(el.Buttons, fbButton{Type: "postback", Title: b.Label, Payload: b.Callback.Data})
-			}
-		}
-		elements = append(elements, el)
+		elements = append(elements, fbElement{
+			Title:   section.Section,
+			Buttons: toTemplateButtons(section.Buttons, maxButtonsPerElement),
+		})
 	}
 	if len(elements) == 0 {
 		return interactiveOutboundMessage{}, fmt.Errorf("no sections in list reply")
@@ -464,6 +508,100 @@ func buildListReplyMessage(list *sharedmodel.KeyboardListReply) (interactiveOutb
 	}, nil
 }
 
+// Template limits.
+// https://developers.facebook.com/docs/messenger-platform/reference/templates/generic#elements
+const (
+	maxCarouselCards       = 10
+	maxTemplateCardButtons = 3
+)
+
+// buildCarouselMessage maps cards to a Facebook Generic Template; several cards render as a carousel.
+// https://developers.facebook.com/docs/messenger-platform/send-messages/template/generic
+func buildCarouselMessage(carousel *sharedmodel.Carousel) (interactiveOutboundMessage, error) {
+	if carousel == nil || len(carousel.Cards) == 0 {
+		return interactiveOutboundMessage{}, fmt.Errorf("carousel has no cards")
+	}
+
+	elements := make([]fbElement, 0, len(carousel.Cards))
+	for i, card := range carousel.Cards {
+		if i >= maxCarouselCards {
+			break
+		}
+		if card.Title == "" {
+			return interactiveOutboundMessage{}, fmt.Errorf("card %d: title is required", i)
+		}
+		el := fbElement{
+			Title:    card.Title,
+			Subtitle: card.Subtitle,
+			ImageURL: card.ImageURL,
+			Buttons:  toTemplateButtons(card.Buttons, maxTemplateCardButtons),
+		}
+		if card.DefaultURL != "" {
+			el.DefaultAction = &fbDefaultAction{Type: "web_url", URL: card.DefaultURL, WebviewHeightRatio: "tall"}
+		}
+		elements = append(elements, el)
+	}
+
+	ratio := "horizontal"
+	if carousel.SquareImages {
+		ratio = "square"
+	}
+	return interactiveOutboundMessage{
+		Attachment: &templateAttachment{
+			Type: "template",
+			Payload: fbGenericTemplatePayload{
+				TemplateType:     "generic",
+				ImageAspectRatio: ratio,
+				Elements:         elements,
+			},
+		},
+	}, nil
+}
+
+// buildMediaTemplate maps a media card to a Facebook Media Template.
+// https://developers.facebook.com/docs/messenger-platform/send-messages/template/media
+func buildMediaTemplate(media *sharedmodel.MediaCard) (interactiveOutboundMessage, error) {
+	if media.URL == "" {
+		return interactiveOutboundMessage{}, fmt.Errorf("media card: url is required")
+	}
+	switch media.MediaType {
+	case sharedmodel.MediaCardImage, sharedmodel.MediaCardVideo:
+	default:
+		return interactiveOutboundMessage{}, fmt.Errorf("media card: unsupported media type %q", media.MediaType)
+	}
+
+	return interactiveOutboundMessage{
+		Attachment: &templateAttachment{
+			Type: "template",
+			Payload: fbMediaTemplatePayload{
+				TemplateType: "media",
+				Elements: []fbMediaElement{{
+					MediaType: media.MediaType,
+					URL:       media.URL,
+					Buttons:   toTemplateButtons(media.Buttons, maxTemplateCardButtons),
+				}},
+			},
+		},
+	}, nil
+}
+
+// toTemplateButtons maps URL and callback buttons to template buttons, up to limit.
+func toTemplateButtons(buttons []sharedmodel.KeyboardButton, limit int) []fbButton {
+	var out []fbButton
+	for _, b := range buttons {
+		if len(out) >= limit {
+			break
+		}
+		switch {
+		case b.URL != nil:
+			out = append(out, fbButton{Type: "web_url", Title: b.Label, URL: b.URL.URL})
+		case b.Callback != nil:
+			out = append(out, fbButton{Type: "postback", Title: b.Label, Payload: b.Callback.Data})
+		}
+	}
+	return out
+}
+
 // --- Messenger Profile API ---
 // https://developers.facebook.com/docs/messenger-platform/messenger-profile/persistent-menu
 
@@ -574,3 +712,18 @@ func isTokenInvalidError(body []byte) bool {
 	}
 	return json.Unmarshal(body, &e) == nil && e.Error.Code == 190
 }
+
+// isPermissionError reports whether the Facebook API error body signals a missing
+// permission (code 10 or the 200-299 range) or an unreadable object (code 100).
+func isPermissionError(body []byte) bool {
+	var e struct {
+		Error struct {
+			Code int `json:"code"`
+		} `json:"error"`
+	}
+	if json.Unmarshal(body, &e) != nil {
+		return false
+	}
+	code := e.Error.Code
+	return code == 10 || code == 100 || (code >= 200 && code <= 299)
+}
@@ -0,0 +1,124 @@
+package facebook
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	sharedmodel "github.com/webitel/im-providers-service/internal/core/model"
+)
+
+func TestBuildCarouselMessage(t *testing.T) {
+	carousel := &sharedmodel.Carousel{
+		SquareImages: true,
+		Cards: []sharedmodel.Card{
+			{
+				Title:      "Sneakers",
+				Subtitle:   "Size 42",
+				ImageURL:   "https://cdn.example.com/1.jpg",
+				DefaultURL: "https://shop.example.com/1",
+				Buttons: []sharedmodel.KeyboardButton{
+					{Label: "Buy", Callback: &sharedmodel.KeyboardButtonCallback{Data: "BUY_1"}},
+					{Label: "Open", URL: &sharedmodel.KeyboardButtonURL{URL: "https://shop.example.com/1"}},
+					{Label: "Share", Request: &sharedmodel.KeyboardButtonRequest{Action: "share"}},
+				},
+			},
+			{Title: "Boots"},
+		},
+	}
+
+	msg, err := buildCarouselMessage(carousel)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	raw, _ := json.Marshal(msg)
+	var got struct {
+		Attachment struct {
+			Payload struct {
+				TemplateType     string `json:"template_type"`
+				ImageAspectRatio string `json:"image_aspect_ratio"`
+				Elements         []struct {
+					Title         string `json:"title"`
+					Subtitle      string `json:"subtitle"`
+					ImageURL      string `json:"image_url"`
+					DefaultAction *struct {
+						Type string `json:"type"`
+						URL  string `json:"url"`
+					} `json:"default_action"`
+					Buttons []fbButton `json:"buttons"`
+				} `json:"elements"`
+			} `json:"payload"`
+		} `json:"attachment"`
+	}
+	if err := json.Unmarshal(raw, &got); err != nil {
+		t.Fatal(err)
+	}
+
+	payload := got.Attachment.Payload
+	if payload.TemplateType != "generic" || payload.ImageAspectRatio != "square" {
+		t.Errorf("unexpected template header: %+v", payload)
+	}
+	if len(payload.Elements) != 2 {
+		t.Fatalf("expected 2 cards, got %d", len(payload.Elements))
+	}
+	card := payload.Elements[0]
+	if card.Subtitle != "Size 42" || card.ImageURL == "" {
+		t.Errorf("card fields lost: %+v", card)
+	}
+	if card.DefaultAction == nil || card.DefaultAction.Type != "web_url" || card.DefaultAction.URL != "https://shop.example.com/1" {
+		t.Errorf("unexpected default action: %+v", card.DefaultAction)
+	}
+	if len(card.Buttons) != 2 || card.Buttons[0].Type != "postback" || card.Buttons[1].Type != "web_url" {
+		t.Errorf("unexpected buttons: %+v", card.Buttons)
+	}
+	if payload.Elements[1].DefaultAction != nil {
+		t.Error("card without default URL must not get a default action")
+	}
+}
+
+func TestBuildCarouselMessage_Errors(t *testing.T) {
+	if _, err := buildCarouselMessage(&sharedmodel.Carousel{}); err == nil {
+		t.Error("expected error for empty carousel")
+	}
+	if _, err := buildCarouselMessage(&sharedmodel.Carousel{Cards: []sharedmodel.Card{{Subtitle: "x"}}}); err == nil {
+		t.Error("expected error for card without title")
+	}
+}
+
+func TestBuildCarouselMessage_CapsCards(t *testing.T) {
+	cards := make([]sharedmodel.Card, maxCarouselCards+3)
+	for i := range cards {
+		cards[i] = sharedmodel.Card{Title: "card"}
+	}
+
+	msg, err := buildCarouselMessage(&sharedmodel.Carousel{Cards: cards})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if n := len(msg.Attachment.Payload.(fbGenericTemplatePayload).Elements); n != maxCarouselCards {
+		t.Errorf("expected %d cards, got %d", maxCarouselCards, n)
+	}
+}
+
+func TestBuildMediaTemplate(t *testing.T) {
+	msg, err := buildMediaTemplate(&sharedmodel.MediaCard{
+		MediaType: sharedmodel.MediaCardVideo,
+		URL:       "https://business.facebook.com/page/videos/1",
+		Buttons:   []sharedmodel.KeyboardButton{{Label: "Watch", URL: &sharedmodel.KeyboardButtonURL{URL: "https://example.com"}}},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	raw, _ := json.Marshal(msg)
+	if !strings.Contains(string(raw), `"template_type":"media"`) || !strings.Contains(string(raw), `"media_type":"video"`) {
+		t.Errorf("unexpected payload: %s", raw)
+	}
+
+	if _, err := buildMediaTemplate(&sharedmodel.MediaCard{MediaType: "audio", URL: "https://x"}); err == nil {
+		t.Error("expected error for unsupported media type")
+	}
+	if _, err := buildMediaTemplate(&sharedmodel.MediaCard{MediaType: sharedmodel.MediaCardImage}); err == nil {
+		t.Error("expected error for missing url")
+	}
+}
@@ -16,6 +16,8 @@ func toStatus(err error, internalMsg string) error {
 	switch {
 	case errors.As(err, &ve):
 		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, fbmodel.ErrInvalidOAuthState):
+		return status.Error(codes.PermissionDenied, err.Error())
 	case errors.Is(err, sharedstore.ErrNotFound):
 		return status.Error(codes.NotFound, "not found")
 	case errors.Is(err, sharedstore.ErrConflict):
@@ -35,6 +35,11 @@ type mockFacebookService struct {
 	getFn    func(ctx context.Context, id string) (*fbmodel.FacebookGate, error)
 	updateFn func(ctx context.Context, req fbmodel.UpdateFacebook) (*fbmodel.FacebookGate, error)
 	deleteFn func(ctx context.Context, id string) (*fbmodel.FacebookGate, error)
+
+	// Messenger Profile fields stored through the profile methods.
+	menu        fbmodel.PersistentMenu
+	greeting    map[string]string
+	iceBreakers map[string][]fbmodel.IceBreaker
 }
 
 func (m *mockFacebookService) CreateGate(ctx context.Context, req fbmodel.CreateFacebook) (*fbmodel.FacebookGate, error) {
@@ -50,14 +55,31 @@ func (m *mockFacebookService) DeleteGate(ctx context.Context, id string) (*fbmod
 	return m.deleteFn(ctx, id)
 }
 
-func (m *mockFacebookService) SetPersistentMenu(_ context.Context, _ string, _ []fbmodel.MenuItem, _ bool) error {
+func (m *mockFacebookService) SetPersistentMenu(_ context.Context, _ string, menu fbmodel.PersistentMenu) error {
+	m.menu = menu
 	return nil
 }
+func (m *mockFacebookService) GetPersistentMenu(_ context.Context, _ string) (fbmodel.PersistentMenu, error) {
+	return m.menu, nil
+}
 func (m *mockFacebookService) DeletePersistentMenu(_ context.Context, _ string) error { return nil }
 func (m *mockFacebookService) SetGetStarted(_ context.Context, _ string, _ string) error {
 	return nil
 }
 func (m *mockFacebookService) DeleteGetStarted(_ context.Context, _ string) error { return nil }
+func (m *mockFacebookService) SetGreeting(_ context.Context, _ string, greeting map[string]string) error {
+	m.greeting = greeting
+	return nil
+}
+func (m *mockFacebookService) DeleteGreeting(_ context.Context, _ string) error { return nil }
+func (m *mockFacebookService) SetIceBreakers(_ context.Context, _ string, iceBreakers map[string][]fbmodel.IceBreaker) error {
+	m.iceBreakers = iceBreakers
+	return nil
+}
+func (m *mockFacebookService) DeleteIceBreakers(_ context.Context, _ string) error { return nil }
+func (m *mockFacebookService) ReconcileProfiles(_ context.Context, _ string) (*fbmodel.ReconcileResult, error) {
+	return &fbmodel.ReconcileResult{}, nil
+}
 
 // -- helpers --
 
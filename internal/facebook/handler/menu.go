@@ -3,6 +3,7 @@ package handler
 import (
 	"context"
 	"log/slog"
+	"sort"
 
 	impb "github.com/webitel/im-providers-service/gen/go/provider/v1"
 	fbmodel "github.com/webitel/im-providers-service/internal/facebook/model"
@@ -16,10 +17,10 @@ func (f *FacebookHandler) SetPersistentMenu(ctx context.Context, req *impb.Provi
 		return nil, status.Error(codes.InvalidArgument, "gate_id is required")
 	}
 
-	items := protoMenuItemsToModel(req.GetItems())
-	log.InfoContext(ctx, "setting persistent menu", slog.Int("items", len(items)), slog.Bool("composer_disabled", req.GetComposerInputDisabled()))
+	menu := protoPersistentMenuToModel(req)
+	log.InfoContext(ctx, "setting persistent menu", slog.Int("locales", len(menu)))
 
-	if err := f.srv.SetPersistentMenu(ctx, req.GetGateId(), items, req.GetComposerInputDisabled()); err != nil {
+	if err := f.srv.SetPersistentMenu(ctx, req.GetGateId(), menu); err != nil {
 		log.ErrorContext(ctx, "failed to set persistent menu", slog.String("error", err.Error()))
 		return nil, toStatus(err, "set persistent menu")
 	}
@@ -28,6 +29,30 @@ func (f *FacebookHandler) SetPersistentMenu(ctx context.Context, req *impb.Provi
 	return &impb.ProviderSetPersistentMenuResponse{}, nil
 }
 
+func (f *FacebookHandler) GetPersistentMenu(ctx context.Context, req *impb.ProviderGetPersistentMenuRequest) (*impb.ProviderGetPersistentMenuResponse, error) {
+	log := f.logger.With(slog.String("method", "GetPersistentMenu"), slog.String("gate_id", req.GetGateId()))
+	if req.GetGateId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "gate_id is required")
+	}
+
+	menu, err := f.srv.GetPersistentMenu(ctx, req.GetGateId())
+	if err != nil {
+		log.ErrorContext(ctx, "failed to get persistent menu", slog.String("error", err.Error()))
+		return nil, toStatus(err, "get persistent menu")
+	}
+
+	locales := make([]*impb.ProviderLocaleMenu, 0, len(menu))
+	for _, locale := range sortedLocales(menu) {
+		lm := menu[locale]
+		locales = append(locales, &impb.ProviderLocaleMenu{
+			Locale:                locale,
+			Items:                 modelMenuItemsToProto(lm.Items),
+			ComposerInputDisabled: lm.ComposerInputDisabled,
+		})
+	}
+	return &impb.ProviderGetPersistentMenuResponse{Locales: locales}, nil
+}
+
 func (f *FacebookHandler) DeletePersistentMenu(ctx context.Context, req *impb.ProviderDeletePersistentMenuRequest) (*impb.ProviderDeletePersistentMenuResponse, error) {
 	log := f.logger.With(slog.String("method", "DeletePersistentMenu"), slog.String("gate_id", req.GetGateId()))
 	if req.GetGateId() == "" {
@@ -79,6 +104,103 @@ func (f *FacebookHandler) DeleteGetStarted(ctx context.Context, req *impb.Provid
 	return &impb.ProviderDeleteGetStartedResponse{}, nil
 }
 
+func (f *FacebookHandler) SetGreeting(ctx context.Context, req *impb.ProviderSetGreetingRequest) (*impb.ProviderSetGreetingResponse, error) {
+	log := f.logger.With(slog.String("method", "SetGreeting"), slog.String("gate_id", req.GetGateId()))
+	if req.GetGateId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "gate_id is required")
+	}
+
+	greeting := make(map[string]string, len(req.GetGreeting()))
+	for _, g := range req.GetGreeting() {
+		greeting[g.GetLocale()] = g.GetText()
+	}
+
+	log.InfoContext(ctx, "setting greeting", slog.Int("locales", len(greeting)))
+	if err := f.srv.SetGreeting(ctx, req.GetGateId(), greeting); err != nil {
+		log.ErrorContext(ctx, "failed to set greeting", slog.String("error", err.Error()))
+		return nil, toStatus(err, "set greeting")
+	}
+
+	log.InfoContext(ctx, "greeting set successfully")
+	return &impb.ProviderSetGreetingResponse{}, nil
+}
+
+func (f *FacebookHandler) DeleteGreeting(ctx context.Context, req *impb.ProviderDeleteGreetingRequest) (*impb.ProviderDeleteGreetingResponse, error) {
+	log := f.logger.With(slog.String("method", "DeleteGreeting"), slog.String("gate_id", req.GetGateId()))
+	if req.GetGateId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "gate_id is required")
+	}
+
+	log.InfoContext(ctx, "deleting greeting")
+	if err := f.srv.DeleteGreeting(ctx, req.GetGateId()); err != nil {
+		log.ErrorContext(ctx, "failed to delete greeting", slog.String("error", err.Error()))
+		return nil, toStatus(err, "delete greeting")
+	}
+
+	log.InfoContext(ctx, "greeting deleted")
+	return &impb.ProviderDeleteGreetingResponse{}, nil
+}
+
+func (f *FacebookHandler) SetIceBreakers(ctx context.Context, req *impb.ProviderSetIceBreakersRequest) (*impb.ProviderSetIceBreakersResponse, error) {
+	log := f.logger.With(slog.String("method", "SetIceBreakers"), slog.String("gate_id", req.GetGateId()))
+	if req.GetGateId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "gate_id is required")
+	}
+
+	iceBreakers := make(map[string][]fbmodel.IceBreaker, len(req.GetLocales()))
+	for _, l := range req.GetLocales() {
+		questions := make([]fbmodel.IceBreaker, 0, len(l.GetIceBreakers()))
+		for _, ib := range l.GetIceBreakers() {
+			questions = append(questions, fbmodel.IceBreaker{Question: ib.GetQuestion(), Payload: ib.GetPayload()})
+		}
+		iceBreakers[l.GetLocale()] = questions
+	}
+
+	log.InfoContext(ctx, "setting ice breakers", slog.Int("locales", len(iceBreakers)))
+	if err := f.srv.SetIceBreakers(ctx, req.GetGateId(), iceBreakers); err != nil {
+		log.ErrorContext(ctx, "failed to set ice breakers", slog.String("error", err.Error()))
+		return nil, toStatus(err, "set ice breakers")
+	}
+
+	log.InfoContext(ctx, "ice breakers set successfully")
+	return &impb.ProviderSetIceBreakersResponse{}, nil
+}
+
+func (f *FacebookHandler) DeleteIceBreakers(ctx context.Context, req *impb.ProviderDeleteIceBreakersRequest) (*impb.ProviderDeleteIceBreakersResponse, error) {
+	log := f.logger.With(slog.String("method", "DeleteIceBreakers"), slog.String("gate_id", req.GetGateId()))
+	if req.GetGateId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "gate_id is required")
+	}
+
+	log.InfoContext(ctx, "deleting ice breakers")
+	if err := f.srv.DeleteIceBreakers(ctx, req.GetGateId()); err != nil {
+		log.ErrorContext(ctx, "failed to delete ice breakers", slog.String("error", err.Error()))
+		return nil, toStatus(err, "delete ice breakers")
+	}
+
+	log.InfoContext(ctx, "ice breakers deleted")
+	return &impb.ProviderDeleteIceBreakersResponse{}, nil
+}
+
+// protoPersistentMenuToModel maps the request to a localized menu. Without locales the
+// top-level items become the default locale block.
+func protoPersistentMenuToModel(req *impb.ProviderSetPersistentMenuRequest) fbmodel.PersistentMenu {
+	if len(req.GetLocales()) == 0 {
+		return fbmodel.PersistentMenu{
+			fbmodel.DefaultLocale: {Items: protoMenuItemsToModel(req.GetItems()), ComposerInputDisabled: req.GetComposerInputDisabled()},
+		}
+	}
+
+	menu := make(fbmodel.PersistentMenu, len(req.GetLocales()))
+	for _, l := range req.GetLocales() {
+		menu[l.GetLocale()] = fbmodel.LocaleMenu{
+			Items:                 protoMenuItemsToModel(l.GetItems()),
+			ComposerInputDisabled: l.GetComposerInputDisabled(),
+		}
+	}
+	return menu
+}
+
 // protoMenuItemsToModel maps proto ProviderMenuItem repeated field to domain model recursively.
 func protoMenuItemsToModel(items []*impb.ProviderMenuItem) []fbmodel.MenuItem {
 	result := make([]fbmodel.MenuItem, 0, len(items))
@@ -98,3 +220,36 @@ func protoMenuItemsToModel(items []*impb.ProviderMenuItem) []fbmodel.MenuItem {
 	}
 	return result
 }
+
+// modelMenuItemsToProto maps domain menu items back to the proto ProviderMenuItem field recursively.
+func modelMenuItemsToProto(items []fbmodel.MenuItem) []*impb.ProviderMenuItem {
+	result := make([]*impb.ProviderMenuItem, 0, len(items))
+	for _, item := range items {
+		m := &impb.ProviderMenuItem{Title: item.Title}
+		switch {
+		case len(item.Nested) > 0:
+			m.Action = &impb.ProviderMenuItem_Nested{Nested: &impb.ProviderMenuNestedItems{Items: modelMenuItemsToProto(item.Nested)}}
+		case item.URL != "":
+			m.Action = &impb.ProviderMenuItem_Url{Url: item.URL}
+		default:
+			m.Action = &impb.ProviderMenuItem_Payload{Payload: item.Payload}
+		}
+		result = append(result, m)
+	}
+	return result
+}
+
+// sortedLocales returns the menu locales with the default locale first and the rest sorted.
+func sortedLocales(menu fbmodel.PersistentMenu) []string {
+	locales := make([]string, 0, len(menu))
+	for locale := range menu {
+		if locale != fbmodel.DefaultLocale {
+			locales = append(locales, locale)
+		}
+	}
+	sort.Strings(locales)
+	if _, ok := menu[fbmodel.DefaultLocale]; ok {
+		locales = append([]string{fbmodel.DefaultLocale}, locales...)
+	}
+	return locales
+}
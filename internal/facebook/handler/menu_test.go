@@ -0,0 +1,158 @@
+package handler
+
+import (
+	"testing"
+
+	impb "github.com/webitel/im-providers-service/gen/go/provider/v1"
+	fbmodel "github.com/webitel/im-providers-service/internal/facebook/model"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestSetPersistentMenu_ItemsBecomeDefaultLocale(t *testing.T) {
+	svc := &mockFacebookService{}
+	h := newFacebookHandler(svc)
+
+	_, err := h.SetPersistentMenu(ctxWithAuth(1), &impb.ProviderSetPersistentMenuRequest{
+		GateId:                "gate-1",
+		Items:                 []*impb.ProviderMenuItem{{Title: "Help", Action: &impb.ProviderMenuItem_Payload{Payload: "HELP"}}},
+		ComposerInputDisabled: true,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	lm, ok := svc.menu[fbmodel.DefaultLocale]
+	if len(svc.menu) != 1 || !ok {
+		t.Fatalf("expected a single default locale, got %+v", svc.menu)
+	}
+	if !lm.ComposerInputDisabled || len(lm.Items) != 1 || lm.Items[0].Payload != "HELP" {
+		t.Errorf("unexpected default menu: %+v", lm)
+	}
+}
+
+func TestSetPersistentMenu_Locales(t *testing.T) {
+	svc := &mockFacebookService{}
+	h := newFacebookHandler(svc)
+
+	_, err := h.SetPersistentMenu(ctxWithAuth(1), &impb.ProviderSetPersistentMenuRequest{
+		GateId: "gate-1",
+		Items:  []*impb.ProviderMenuItem{{Title: "ignored", Action: &impb.ProviderMenuItem_Payload{Payload: "X"}}},
+		Locales: []*impb.ProviderLocaleMenu{
+			{Locale: "default", Items: []*impb.ProviderMenuItem{{Title: "Help", Action: &impb.ProviderMenuItem_Payload{Payload: "HELP"}}}},
+			{Locale: "uk_UA", Items: []*impb.ProviderMenuItem{{Title: "Сайт", Action: &impb.ProviderMenuItem_Url{Url: "https://example.com"}}}, ComposerInputDisabled: true},
+		},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(svc.menu) != 2 {
+		t.Fatalf("expected 2 locales, got %+v", svc.menu)
+	}
+	if got := svc.menu[fbmodel.DefaultLocale].Items; len(got) != 1 || got[0].Payload != "HELP" {
+		t.Errorf("unexpected default items: %+v", got)
+	}
+	if got := svc.menu["uk_UA"]; !got.ComposerInputDisabled || len(got.Items) != 1 || got.Items[0].URL != "https://example.com" {
+		t.Errorf("unexpected uk_UA menu: %+v", got)
+	}
+}
+
+func TestGetPersistentMenu(t *testing.T) {
+	svc := &mockFacebookService{menu: fbmodel.PersistentMenu{
+		"uk_UA": {Items: []fbmodel.MenuItem{{Title: "Сайт", URL: "https://example.com"}}},
+		fbmodel.DefaultLocale: {Items: []fbmodel.MenuItem{
+			{Title: "More", Nested: []fbmodel.MenuItem{{Title: "Help", Payload: "HELP"}}},
+		}, ComposerInputDisabled: true},
+	}}
+	h := newFacebookHandler(svc)
+
+	resp, err := h.GetPersistentMenu(ctxWithAuth(1), &impb.ProviderGetPersistentMenuRequest{GateId: "gate-1"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(resp.Locales) != 2 || resp.Locales[0].Locale != fbmodel.DefaultLocale || resp.Locales[1].Locale != "uk_UA" {
+		t.Fatalf("expected default locale first, got %+v", resp.Locales)
+	}
+	if !resp.Locales[0].ComposerInputDisabled {
+		t.Error("expected composer input disabled on the default locale")
+	}
+	nested := resp.Locales[0].Items[0].GetNested().GetItems()
+	if len(nested) != 1 || nested[0].GetPayload() != "HELP" {
+		t.Errorf("unexpected nested items: %+v", nested)
+	}
+	if got := resp.Locales[1].Items[0].GetUrl(); got != "https://example.com" {
+		t.Errorf("unexpected url: %s", got)
+	}
+}
+
+func TestSetGreeting(t *testing.T) {
+	svc := &mockFacebookService{}
+	h := newFacebookHandler(svc)
+
+	_, err := h.SetGreeting(ctxWithAuth(1), &impb.ProviderSetGreetingRequest{
+		GateId: "gate-1",
+		Greeting: []*impb.ProviderLocaleText{
+			{Locale: "default", Text: "Hi {{user_first_name}}"},
+			{Locale: "uk_UA", Text: "Вітаємо"},
+		},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if svc.greeting["default"] != "Hi {{user_first_name}}" || svc.greeting["uk_UA"] != "Вітаємо" {
+		t.Errorf("unexpected greeting: %+v", svc.greeting)
+	}
+}
+
+func TestSetIceBreakers(t *testing.T) {
+	svc := &mockFacebookService{}
+	h := newFacebookHandler(svc)
+
+	_, err := h.SetIceBreakers(ctxWithAuth(1), &impb.ProviderSetIceBreakersRequest{
+		GateId: "gate-1",
+		Locales: []*impb.ProviderLocaleIceBreakers{
+			{Locale: "default", IceBreakers: []*impb.ProviderIceBreaker{{Question: "Where is my order?", Payload: "ORDER"}}},
+		},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	got := svc.iceBreakers["default"]
+	if len(got) != 1 || got[0] != (fbmodel.IceBreaker{Question: "Where is my order?", Payload: "ORDER"}) {
+		t.Errorf("unexpected ice breakers: %+v", svc.iceBreakers)
+	}
+}
+
+func TestProfileMethods_RequireGateID(t *testing.T) {
+	h := newFacebookHandler(&mockFacebookService{})
+	ctx := ctxWithAuth(1)
+
+	calls := map[string]func() error{
+		"GetPersistentMenu": func() error {
+			_, err := h.GetPersistentMenu(ctx, &impb.ProviderGetPersistentMenuRequest{})
+			return err
+		},
+		"SetGreeting": func() error {
+			_, err := h.SetGreeting(ctx, &impb.ProviderSetGreetingRequest{})
+			return err
+		},
+		"DeleteGreeting": func() error {
+			_, err := h.DeleteGreeting(ctx, &impb.ProviderDeleteGreetingRequest{})
+			return err
+		},
+		"SetIceBreakers": func() error {
+			_, err := h.SetIceBreakers(ctx, &impb.ProviderSetIceBreakersRequest{})
+			return err
+		},
+		"DeleteIceBreakers": func() error {
+			_, err := h.DeleteIceBreakers(ctx, &impb.ProviderDeleteIceBreakersRequest{})
+			return err
+		},
+	}
+	for name, call := range calls {
+		if err := call(); status.Code(err) != codes.InvalidArgument {
+			t.Errorf("%s: expected InvalidArgument, got %v", name, err)
+		}
+	}
+}
@@ -5,8 +5,12 @@ import (
 	"log/slog"
 
 	impb "github.com/webitel/im-providers-service/gen/go/provider/v1"
+	"github.com/webitel/im-providers-service/infra/auth"
+	sharedmodel "github.com/webitel/im-providers-service/internal/core/model"
 	fbmodel "github.com/webitel/im-providers-service/internal/facebook/model"
 	fbservice "github.com/webitel/im-providers-service/internal/facebook/service"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 var _ impb.MetaOAuthServiceServer = (*MetaOauthHandler)(nil)
@@ -22,8 +26,17 @@ func NewMetaOauthHandler(logger *slog.Logger, srv fbservice.MetaOAuthManager) *M
 }
 
 func (h *MetaOauthHandler) StartMetaOAuth(ctx context.Context, req *impb.ProviderMetaOAuthStartRequest) (*impb.ProviderMetaOAuthStartResponse, error) {
+	identity, ok := auth.GetIdentityFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "missing identity in context")
+	}
+
 	authURL, state, err := h.srv.StartOAuth(ctx, fbmodel.OAuthStart{
-		MetaAppID: req.GetMetaAppId(),
+		MetaAppID:   req.GetMetaAppId(),
+		DomainID:    identity.GetDomainID(),
+		UserID:      identity.GetContactID(),
+		ExtraScopes: req.GetExtraScopes(),
+		Provision:   toOAuthProvision(req.GetProvision()),
 	})
 	if err != nil {
 		return nil, toStatus(err, "start oauth")
@@ -36,8 +49,15 @@ func (h *MetaOauthHandler) StartMetaOAuth(ctx context.Context, req *impb.Provide
 }
 
 func (h *MetaOauthHandler) MetaOAuthCallback(ctx context.Context, req *impb.ProviderMetaOAuthCallbackRequest) (*impb.ProviderMetaOAuthCallbackResponse, error) {
+	identity, ok := auth.GetIdentityFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "missing identity in context")
+	}
+
 	userToken, pages, err := h.srv.HandleCallback(ctx, fbmodel.OAuthCallback{
 		MetaAppID: req.GetMetaAppId(),
+		DomainID:  identity.GetDomainID(),
+		UserID:    identity.GetContactID(),
 		Code:      req.GetCode(),
 		State:     req.GetState(),
 	})
@@ -47,11 +67,15 @@ func (h *MetaOauthHandler) MetaOAuthCallback(ctx context.Context, req *impb.Prov
 
 	linkedPages := make([]*impb.ProviderMetaLinkedPage, len(pages))
 	for i, p := range pages {
+		platform := p.Platform
+		if platform == "" {
+			platform = fbmodel.PlatformFacebook
+		}
 		linkedPages[i] = &impb.ProviderMetaLinkedPage{
 			PageId:      p.PageID,
 			PageName:    p.PageName,
 			AccessToken: p.PageToken,
-			Platform:    "facebook",
+			Platform:    platform,
 		}
 	}
 
@@ -60,3 +84,24 @@ func (h *MetaOauthHandler) MetaOAuthCallback(ctx context.Context, req *impb.Prov
 		Pages:           linkedPages,
 	}, nil
 }
+
+// toOAuthProvision maps the provisioning selection of the start request, or returns nil
+// when no asset is selected.
+func toOAuthProvision(in *impb.ProviderMetaOAuthProvision) *fbmodel.OAuthProvision {
+	if in == nil {
+		return nil
+	}
+	if !in.GetAll() && len(in.GetPageIds()) == 0 && len(in.GetWhatsappPhoneNumberIds()) == 0 {
+		return nil
+	}
+
+	return &fbmodel.OAuthProvision{
+		All:                    in.GetAll(),
+		PageIDs:                in.GetPageIds(),
+		WhatsAppPhoneNumberIDs: in.GetWhatsappPhoneNumberIds(),
+		Peer: sharedmodel.Peer{
+			Sub: in.GetPeer().GetSub(),
+			Iss: in.GetPeer().GetIss(),
+		},
+	}
+}
@@ -3,10 +3,13 @@ package handler
 import (
 	"context"
 	"errors"
+	"slices"
 	"testing"
 
 	impb "github.com/webitel/im-providers-service/gen/go/provider/v1"
 	fbmodel "github.com/webitel/im-providers-service/internal/facebook/model"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type mockMetaOAuthService struct {
@@ -28,11 +31,14 @@ func TestStartMetaOAuth_Success(t *testing.T) {
 			if req.MetaAppID != "app-1" {
 				t.Errorf("unexpected meta app id: %s", req.MetaAppID)
 			}
+			if req.DomainID != 1 || req.UserID != "contact-1" {
+				t.Errorf("unexpected session binding: %d/%s", req.DomainID, req.UserID)
+			}
 			return "https://fb.com/dialog/oauth?...", "secure-state", nil
 		},
 	}
 	h := NewMetaOauthHandler(noopLogger, svc)
-	resp, err := h.StartMetaOAuth(context.Background(), &impb.ProviderMetaOAuthStartRequest{
+	resp, err := h.StartMetaOAuth(ctxWithAuth(1), &impb.ProviderMetaOAuthStartRequest{
 		MetaAppId: "app-1",
 	})
 	if err != nil {
@@ -46,6 +52,58 @@ func TestStartMetaOAuth_Success(t *testing.T) {
 	}
 }
 
+func TestStartMetaOAuth_Provision(t *testing.T) {
+	var got *fbmodel.OAuthProvision
+	svc := &mockMetaOAuthService{
+		startFn: func(_ context.Context, req fbmodel.OAuthStart) (string, string, error) {
+			got = req.Provision
+			return "https://fb.com/dialog/oauth?...", "secure-state", nil
+		},
+	}
+	h := NewMetaOauthHandler(noopLogger, svc)
+
+	_, err := h.StartMetaOAuth(ctxWithAuth(1), &impb.ProviderMetaOAuthStartRequest{
+		MetaAppId: "app-1",
+		Provision: &impb.ProviderMetaOAuthProvision{
+			PageIds:                []string{"page-1", "page-2"},
+			WhatsappPhoneNumberIds: []string{"phone-1", "phone-2"},
+			Peer:                   &impb.Peer{Sub: "bot-1", Iss: "iss"},
+		},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got == nil {
+		t.Fatal("expected provisioning to be passed to the service")
+	}
+	if got.All || !slices.Equal(got.PageIDs, []string{"page-1", "page-2"}) || !slices.Equal(got.WhatsAppPhoneNumberIDs, []string{"phone-1", "phone-2"}) {
+		t.Errorf("unexpected assets: %+v", got)
+	}
+	if got.Peer.Sub != "bot-1" || got.Peer.Iss != "iss" {
+		t.Errorf("unexpected peer: %+v", got.Peer)
+	}
+}
+
+func TestStartMetaOAuth_NoProvisionWithoutAssets(t *testing.T) {
+	svc := &mockMetaOAuthService{
+		startFn: func(_ context.Context, req fbmodel.OAuthStart) (string, string, error) {
+			if req.Provision != nil {
+				t.Errorf("expected no provisioning, got %+v", req.Provision)
+			}
+			return "https://fb.com/dialog/oauth?...", "secure-state", nil
+		},
+	}
+	h := NewMetaOauthHandler(noopLogger, svc)
+
+	_, err := h.StartMetaOAuth(ctxWithAuth(1), &impb.ProviderMetaOAuthStartRequest{
+		MetaAppId: "app-1",
+		Provision: &impb.ProviderMetaOAuthProvision{Peer: &impb.Peer{Sub: "bot-1"}},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
 func TestStartMetaOAuth_ServiceError(t *testing.T) {
 	svc := &mockMetaOAuthService{
 		startFn: func(_ context.Context, _ fbmodel.OAuthStart) (string, string, error) {
@@ -53,7 +111,7 @@ func TestStartMetaOAuth_ServiceError(t *testing.T) {
 		},
 	}
 	h := NewMetaOauthHandler(noopLogger, svc)
-	_, err := h.StartMetaOAuth(context.Background(), &impb.ProviderMetaOAuthStartRequest{MetaAppId: "missing"})
+	_, err := h.StartMetaOAuth(ctxWithAuth(1), &impb.ProviderMetaOAuthStartRequest{MetaAppId: "missing"})
 	if err == nil {
 		t.Fatal("expected error, got nil")
 	}
@@ -68,11 +126,12 @@ func TestMetaOAuthCallback_Success(t *testing.T) {
 			return "long-user-token", []fbmodel.LinkedPage{
 				{PageID: "page-1", PageName: "Page One", PageToken: "page-tok"},
 				{PageID: "page-2", PageName: "Page Two", PageToken: "page-tok-2"},
+				{PageID: "phone-1", PageName: "+380000000000", PageToken: "long-user-token", Platform: fbmodel.PlatformWhatsApp},
 			}, nil
 		},
 	}
 	h := NewMetaOauthHandler(noopLogger, svc)
-	resp, err := h.MetaOAuthCallback(context.Background(), &impb.ProviderMetaOAuthCallbackRequest{
+	resp, err := h.MetaOAuthCallback(ctxWithAuth(1), &impb.ProviderMetaOAuthCallbackRequest{
 		MetaAppId: "app-1",
 		Code:      "auth-code",
 		State:     "state-abc",
@@ -83,8 +142,8 @@ func TestMetaOAuthCallback_Success(t *testing.T) {
 	if resp.UserAccessToken != "long-user-token" {
 		t.Errorf("unexpected user access token: %s", resp.UserAccessToken)
 	}
-	if len(resp.Pages) != 2 {
-		t.Errorf("expected 2 pages, got %d", len(resp.Pages))
+	if len(resp.Pages) != 3 {
+		t.Fatalf("expected 3 pages, got %d", len(resp.Pages))
 	}
 	if resp.Pages[0].PageId != "page-1" {
 		t.Errorf("unexpected page id: %s", resp.Pages[0].PageId)
@@ -92,6 +151,36 @@ func TestMetaOAuthCallback_Success(t *testing.T) {
 	if resp.Pages[0].Platform != "facebook" {
 		t.Errorf("unexpected platform: %s", resp.Pages[0].Platform)
 	}
+	if resp.Pages[2].Platform != "whatsapp" {
+		t.Errorf("unexpected platform: %s", resp.Pages[2].Platform)
+	}
+}
+
+func TestMetaOAuthCallback_Unauthenticated(t *testing.T) {
+	svc := &mockMetaOAuthService{
+		callbackFn: func(_ context.Context, _ fbmodel.OAuthCallback) (string, []fbmodel.LinkedPage, error) {
+			t.Fatal("service must not be called without identity")
+			return "", nil, nil
+		},
+	}
+	h := NewMetaOauthHandler(noopLogger, svc)
+	_, err := h.MetaOAuthCallback(context.Background(), &impb.ProviderMetaOAuthCallbackRequest{State: "s"})
+	if status.Code(err) != codes.Unauthenticated {
+		t.Errorf("expected Unauthenticated, got %v", err)
+	}
+}
+
+func TestMetaOAuthCallback_InvalidState(t *testing.T) {
+	svc := &mockMetaOAuthService{
+		callbackFn: func(_ context.Context, _ fbmodel.OAuthCallback) (string, []fbmodel.LinkedPage, error) {
+			return "", nil, fbmodel.ErrInvalidOAuthState
+		},
+	}
+	h := NewMetaOauthHandler(noopLogger, svc)
+	_, err := h.MetaOAuthCallback(ctxWithAuth(1), &impb.ProviderMetaOAuthCallbackRequest{State: "forged"})
+	if status.Code(err) != codes.PermissionDenied {
+		t.Errorf("expected PermissionDenied, got %v", err)
+	}
 }
 
 func TestMetaOAuthCallback_EmptyPages(t *testing.T) {
@@ -101,7 +190,7 @@ func TestMetaOAuthCallback_EmptyPages(t *testing.T) {
 		},
 	}
 	h := NewMetaOauthHandler(noopLogger, svc)
-	resp, err := h.MetaOAuthCallback(context.Background(), &impb.ProviderMetaOAuthCallbackRequest{})
+	resp, err := h.MetaOAuthCallback(ctxWithAuth(1), &impb.ProviderMetaOAuthCallbackRequest{})
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
@@ -117,7 +206,7 @@ func TestMetaOAuthCallback_ServiceError(t *testing.T) {
 		},
 	}
 	h := NewMetaOauthHandler(noopLogger, svc)
-	_, err := h.MetaOAuthCallback(context.Background(), &impb.ProviderMetaOAuthCallbackRequest{})
+	_, err := h.MetaOAuthCallback(ctxWithAuth(1), &impb.ProviderMetaOAuthCallbackRequest{})
 	if err == nil {
 		t.Fatal("expected error, got nil")
 	}
@@ -0,0 +1,8 @@
+package model
+
+// SentKeyboard is an interactive message sent to a user along with its callback buttons.
+type SentKeyboard struct {
+	MessageID string `json:"message_id"`
+	// Buttons maps callback data to the button code.
+	Buttons map[string]string `json:"buttons"`
+}
@@ -59,8 +59,8 @@ func (r UpdateFacebook) ApplyTo(gate *FacebookGate) {
 // MenuItem represents a single entry in the Messenger Persistent Menu.
 // Exactly one of Payload, URL, or Nested should be set.
 type MenuItem struct {
-	Title   string
-	Payload string     // postback button
-	URL     string     // web_url button
-	Nested  []MenuItem // nested submenu (one level deep)
+	Title   string     `json:"title"`
+	Payload string     `json:"payload,omitempty"` // postback button
+	URL     string     `json:"url,omitempty"`     // web_url button
+	Nested  []MenuItem `json:"nested,omitempty"`  // nested submenu, up to MaxMenuDepth levels
 }
@@ -1,19 +1,107 @@
 package model
 
+import (
+	"errors"
+	"time"
+
+	sharedmodel "github.com/webitel/im-providers-service/internal/core/model"
+)
+
+// ErrInvalidOAuthState is returned when the callback state is unknown, expired,
+// already consumed or was issued to a different domain/user.
+var ErrInvalidOAuthState = errors.New("oauth: invalid or expired state")
+
+// Platform identifiers reported for linked assets.
+const (
+	PlatformFacebook = "facebook"
+	PlatformWhatsApp = "whatsapp"
+)
+
 type OAuthStart struct {
 	MetaAppID   string
+	DomainID    int64
+	UserID      string
 	ExtraScopes []string
+	// Provision, when set, is bound to the state and applied on callback.
+	Provision *OAuthProvision
 }
 
 type OAuthCallback struct {
 	MetaAppID string
+	DomainID  int64
+	UserID    string
 	Code      string
 	State     string
 }
 
-// LinkedPage carries the minimal page info returned after a successful OAuth flow.
+// OAuthProvision describes which assets discovered during the callback should be
+// turned into gates right away. Existing gates for the same asset get their token refreshed.
+type OAuthProvision struct {
+	// Peer is the bot contact bound to every gate created by the callback.
+	Peer sharedmodel.Peer
+	// All provisions every discovered page and WhatsApp number.
+	All                    bool
+	PageIDs                []string
+	WhatsAppPhoneNumberIDs []string
+}
+
+// Wants reports whether the asset with the given ID was selected for provisioning.
+func (p *OAuthProvision) Wants(platform, id string) bool {
+	if p == nil {
+		return false
+	}
+	if p.All {
+		return true
+	}
+
+	ids := p.PageIDs
+	if platform == PlatformWhatsApp {
+		ids = p.WhatsAppPhoneNumberIDs
+	}
+	for _, v := range ids {
+		if v == id {
+			return true
+		}
+	}
+	return false
+}
+
+// OAuthState is the server-side record of an issued OAuth state.
+// It binds the flow to the domain and user that started it.
+type OAuthState struct {
+	MetaAppID string          `json:"meta_app_id"`
+	DomainID  int64           `json:"domain_id"`
+	UserID    string          `json:"user_id"`
+	Provision *OAuthProvision `json:"provision,omitempty"`
+	CreatedAt time.Time       `json:"created_at"`
+}
+
+// LinkedPage carries the minimal asset info returned after a successful OAuth flow.
+// Platform is PlatformFacebook for pages and PlatformWhatsApp for phone numbers;
+// for the latter PageID holds the phone number ID and PageName the display number.
 type LinkedPage struct {
 	PageID    string
 	PageName  string
 	PageToken string
+	Platform  string
+
+	// BusinessID is the WhatsApp Business Account ID (WhatsApp only).
+	BusinessID string
+	// OwnerBusinessID is the Meta business portfolio the account was discovered
+	// through (WhatsApp only); gates are provisioned with its system user token.
+	OwnerBusinessID string
+	// GateID is set when the asset was provisioned (created or refreshed) by the callback.
+	GateID string
+}
+
+// ProvisionWhatsApp is the request to create or refresh a WhatsApp gate for a
+// phone number discovered through the OAuth flow.
+type ProvisionWhatsApp struct {
+	MetaAppID     string
+	BusinessID    string
+	PhoneNumberID string
+	PhoneNumber   string
+	Name          string
+	AccessToken   string
+	Peer          sharedmodel.Peer
 }
@@ -0,0 +1,133 @@
+package model
+
+import (
+	"fmt"
+	"time"
+)
+
+// DefaultLocale is the fallback locale Messenger uses when no block matches the user locale.
+// Every localized profile field must define it.
+const DefaultLocale = "default"
+
+// Messenger Profile limits.
+// https://developers.facebook.com/docs/messenger-platform/reference/messenger-profile-api
+const (
+	MaxMenuDepth        = 3
+	MaxMenuTopItems     = 20
+	MaxMenuNestedItems  = 5
+	MaxIceBreakers      = 4
+	MaxGreetingTextSize = 160
+)
+
+// LocaleMenu is the persistent menu shown to users of a single locale.
+type LocaleMenu struct {
+	Items                 []MenuItem `json:"items"`
+	ComposerInputDisabled bool       `json:"composer_input_disabled"`
+}
+
+// PersistentMenu maps a Messenger locale (e.g. "en_US") to its menu. "default" is required.
+type PersistentMenu map[string]LocaleMenu
+
+// IceBreaker is a predefined question shown when a user opens a new conversation.
+type IceBreaker struct {
+	Question string `json:"question"`
+	Payload  string `json:"payload"`
+}
+
+// MessengerProfile is the Messenger Profile configuration stored for a gate.
+// It is the source of truth used to re-push the profile after token rotation.
+type MessengerProfile struct {
+	GateID         string                  `json:"gate_id" db:"gate_id"`
+	PersistentMenu PersistentMenu          `json:"persistent_menu" db:"persistent_menu"`
+	GetStarted     string                  `json:"get_started" db:"get_started"`
+	Greeting       map[string]string       `json:"greeting" db:"greeting"`
+	IceBreakers    map[string][]IceBreaker `json:"ice_breakers" db:"ice_breakers"`
+	UpdatedAt      time.Time               `json:"updated_at" db:"updated_at"`
+}
+
+// IsEmpty reports whether nothing is configured, so there is nothing to push.
+func (p *MessengerProfile) IsEmpty() bool {
+	return len(p.PersistentMenu) == 0 && p.GetStarted == "" && len(p.Greeting) == 0 && len(p.IceBreakers) == 0
+}
+
+func (m PersistentMenu) Validate() error {
+	if _, ok := m[DefaultLocale]; !ok {
+		return &ValidationError{Fields: []string{"persistent_menu.default"}}
+	}
+	for locale, lm := range m {
+		if len(lm.Items) == 0 {
+			return &ValidationError{Fields: []string{"persistent_menu." + locale + ".items"}}
+		}
+		if err := validateMenuItems(lm.Items, 1, "persistent_menu."+locale); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func validateMenuItems(items []MenuItem, depth int, path string) error {
+	if depth > MaxMenuDepth {
+		return &ValidationError{Fields: []string{fmt.Sprintf("%s: nesting deeper than %d levels", path, MaxMenuDepth)}}
+	}
+	limit := MaxMenuTopItems
+	if depth > 1 {
+		limit = MaxMenuNestedItems
+	}
+	if len(items) > limit {
+		return &ValidationError{Fields: []string{fmt.Sprintf("%s: more than %d items", path, limit)}}
+	}
+	for i, item := range items {
+		itemPath := fmt.Sprintf("%s[%d]", path, i)
+		if item.Title == "" {
+			return &ValidationError{Fields: []string{itemPath + ".title"}}
+		}
+		if len(item.Nested) > 0 {
+			if err := validateMenuItems(item.Nested, depth+1, itemPath+".nested"); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// ValidateGreeting checks the localized greeting texts.
+func ValidateGreeting(greeting map[string]string) error {
+	if _, ok := greeting[DefaultLocale]; !ok {
+		return &ValidationError{Fields: []string{"greeting.default"}}
+	}
+	for locale, text := range greeting {
+		if text == "" || len([]rune(text)) > MaxGreetingTextSize {
+			return &ValidationError{Fields: []string{"greeting." + locale}}
+		}
+	}
+	return nil
+}
+
+// ValidateIceBreakers checks the localized ice breaker sets.
+func ValidateIceBreakers(iceBreakers map[string][]IceBreaker) error {
+	if _, ok := iceBreakers[DefaultLocale]; !ok {
+		return &ValidationError{Fields: []string{"ice_breakers.default"}}
+	}
+	for locale, set := range iceBreakers {
+		if len(set) == 0 || len(set) > MaxIceBreakers {
+			return &ValidationError{Fields: []string{"ice_breakers." + locale}}
+		}
+		for i, ib := range set {
+			if err := requireFields(
+				fmt.Sprintf("ice_breakers.%s[%d].question", locale, i), ib.Question,
+				fmt.Sprintf("ice_breakers.%s[%d].payload", locale, i), ib.Payload,
+			); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// ReconcileResult summarizes a re-push of stored profiles for the gates of a MetaApp.
+type ReconcileResult struct {
+	Pushed  int
+	Skipped int
+	// Failed lists the IDs of gates whose profile was rejected by the Graph API.
+	Failed []string
+}
@@ -0,0 +1,24 @@
+package model
+
+import "errors"
+
+// ErrProfileUnavailable is returned when the page is not allowed to read the user
+// profile (missing pages_user_* permissions or a user that restricted access).
+var ErrProfileUnavailable = errors.New("facebook: user profile not accessible")
+
+// UserProfile holds the fields returned by the Graph API user node.
+// https://developers.facebook.com/docs/messenger-platform/identity/user-profile#fields
+type UserProfile struct {
+	ID         string `json:"id"`
+	FirstName  string `json:"first_name"`
+	LastName   string `json:"last_name"`
+	ProfilePic string `json:"profile_pic"`
+	Locale     string `json:"locale"`
+	Timezone   int    `json:"timezone"`
+}
+
+// IsPSIDOnly reports whether the profile carries nothing but the user PSID,
+// which is the case when the profile could not be read.
+func (p *UserProfile) IsPSIDOnly() bool {
+	return p.FirstName == "" && p.LastName == "" && p.ProfilePic == ""
+}
@@ -48,3 +48,17 @@ func (r CreateMetaApp) Validate() error {
 		"verify_token", r.VerifyToken,
 	)
 }
+
+func (r OAuthStart) Validate() error {
+	if err := requireFields("meta_app_id", r.MetaAppID); err != nil {
+		return err
+	}
+	if r.DomainID <= 0 {
+		return &ValidationError{Fields: []string{"domain_id"}}
+	}
+	if r.Provision != nil {
+		// Provisioned gates need the bot contact they are bound to.
+		return requireFields("provision.peer.sub", r.Provision.Peer.Sub, "provision.peer.iss", r.Provision.Peer.Iss)
+	}
+	return nil
+}
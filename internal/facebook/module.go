@@ -1,6 +1,8 @@
 package facebook
 
 import (
+	"github.com/redis/go-redis/v9"
+	"github.com/webitel/im-providers-service/config"
 	impb "github.com/webitel/im-providers-service/gen/go/provider/v1"
 	grpcsrv "github.com/webitel/im-providers-service/infra/srv/grpc"
 	fbhandler "github.com/webitel/im-providers-service/internal/facebook/handler"
@@ -11,13 +13,26 @@ import (
 	"go.uber.org/fx"
 )
 
-var Module = fx.Module("facebook",
+// ProfileModule provides the gate store and the Facebook service together with the
+// Graph API client they push Messenger Profiles through. It is self-contained so
+// maintenance commands can run it without the gRPC and webhook stack.
+var ProfileModule = fx.Module("facebook_profile",
 	fx.Provide(
 		// Graph API client — provided as *apiClient for the provider adapter
 		// and as MessengerProfileAPI for the Facebook service.
 		newAPIClient,
 		func(c *apiClient) fbservice.MessengerProfileAPI { return c },
 
+		fx.Annotate(fbpostgres.NewFacebookStore, fx.As(new(fbstore.FacebookStore))),
+		fbpostgres.NewMessengerProfileStore,
+
+		fx.Annotate(fbservice.NewFacebookService, fx.As(new(fbservice.FacebookManager))),
+	),
+)
+
+var Module = fx.Module("facebook",
+	ProfileModule,
+	fx.Provide(
 		// Provider adapter
 		fx.Annotate(
 			New,
@@ -26,11 +41,16 @@ var Module = fx.Module("facebook",
 		),
 
 		// Store implementations
-		fx.Annotate(fbpostgres.NewFacebookStore, fx.As(new(fbstore.FacebookStore))),
 		fx.Annotate(fbpostgres.NewMetaAppStore, fx.As(new(fbstore.MetaAppStore))),
+		fbstore.NewRedisOAuthStateStore,
+		func(rdb *redis.Client, cfg *config.Config) fbstore.UserProfileCache {
+			return fbstore.NewRedisUserProfileCache(rdb, cfg.Facebook.ProfileTTL)
+		},
+		func(rdb *redis.Client) fbstore.CallbackStore {
+			return fbstore.NewRedisCallbackStore(rdb, callbackTTL, callbackKeyboardsPerConversation)
+		},
 
 		// Services
-		fx.Annotate(fbservice.NewFacebookService, fx.As(new(fbservice.FacebookManager))),
 		fx.Annotate(fbservice.NewMetaAppService, fx.As(new(fbservice.MetaAppManager))),
 		fx.Annotate(fbservice.NewMetaOAuthService, fx.As(new(fbservice.MetaOAuthManager))),
 
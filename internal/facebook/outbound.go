@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/google/uuid"
 	contactv1 "github.com/webitel/im-providers-service/gen/go/contact/v1"
 	sharedmodel "github.com/webitel/im-providers-service/internal/core/model"
 	fbmodel "github.com/webitel/im-providers-service/internal/facebook/model"
@@ -55,7 +56,79 @@ func (p *facebookProvider) SendInteractive(ctx context.Context, req *sharedmodel
 	if err != nil {
 		return nil, err
 	}
-	return p.api.SendInteractive(ctx, g.PageToken, psid, req.Text, req.Interactive)
+	resp, err := p.api.SendInteractive(ctx, g.PageToken, psid, req.Text, req.Interactive)
+	if err != nil {
+		return nil, err
+	}
+	if req.ID != uuid.Nil {
+		p.callbacks.remember(ctx, g.ID, psid, req.ID.String(), interactiveButtons(req.Interactive))
+	}
+	return resp, nil
+}
+
+// SendCards sends a carousel as a Generic Template or a media card as a Media Template.
+// Templates carry no free text, so a message text is sent first as a separate message.
+func (p *facebookProvider) SendCards(ctx context.Context, req *sharedmodel.Message) (*sharedmodel.MessageResponse, error) {
+	g, err := p.fetchGate(ctx, req.GateID)
+	if err != nil {
+		return nil, err
+	}
+	psid, err := p.resolvePSID(ctx, g, req.To.Sub)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Text != "" {
+		if _, err := p.api.SendText(ctx, g.PageToken, psid, req.Text); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := p.api.SendCards(ctx, g.PageToken, psid, req.Carousel, req.MediaCard)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.ID != uuid.Nil {
+		var buttons []sharedmodel.KeyboardButton
+		if req.MediaCard != nil {
+			buttons = req.MediaCard.Buttons
+		} else if req.Carousel != nil {
+			buttons = req.Carousel.Buttons()
+		}
+		p.callbacks.remember(ctx, g.ID, psid, req.ID.String(), buttons)
+	}
+	return resp, nil
+}
+
+// SendLocation sends the location as text with a map link, Messenger has no location attachment for pages.
+func (p *facebookProvider) SendLocation(ctx context.Context, req *sharedmodel.Message) (*sharedmodel.MessageResponse, error) {
+	g, err := p.fetchGate(ctx, req.GateID)
+	if err != nil {
+		return nil, err
+	}
+	psid, err := p.resolvePSID(ctx, g, req.To.Sub)
+	if err != nil {
+		return nil, err
+	}
+	return p.api.SendText(ctx, g.PageToken, psid, req.Location.PlainText())
+}
+
+// SendContact sends the contact cards as formatted text, one card per paragraph.
+func (p *facebookProvider) SendContact(ctx context.Context, req *sharedmodel.Message) (*sharedmodel.MessageResponse, error) {
+	g, err := p.fetchGate(ctx, req.GateID)
+	if err != nil {
+		return nil, err
+	}
+	psid, err := p.resolvePSID(ctx, g, req.To.Sub)
+	if err != nil {
+		return nil, err
+	}
+	cards := make([]string, 0, len(req.Contacts))
+	for _, card := range req.Contacts {
+		cards = append(cards, card.PlainText())
+	}
+	return p.api.SendText(ctx, g.PageToken, psid, strings.Join(cards, "\n\n"))
 }
 
 // resolvePSID returns the Facebook PSID for the given sub.
@@ -28,6 +28,7 @@ type facebookProvider struct {
 	messenger   sharedsvc.Messenger
 	gateCache   sharedstore.GateCache
 	userCache   sharedstore.ExternalUserCache
+	profiles    fbstore.UserProfileCache
 	repo        fbstore.FacebookStore
 	metaAppRepo fbstore.MetaAppStore
 	gatewayer   *imgateway.Client
@@ -36,6 +37,9 @@ type facebookProvider struct {
 	// psidCache maps internal contact UUID → Facebook PSID to avoid an
 	// im-contact round-trip on every outbound message.
 	psidCache *lru.Cache[string, string]
+	// callbacks correlates inbound postbacks and quick replies with the
+	// interactive message that carried the tapped button.
+	callbacks *callbackRegistry
 	// httpClient is used exclusively for media downloads; kept separate from
 	// api.http so the two timeouts can be tuned independently.
 	httpClient *http.Client
@@ -46,6 +50,8 @@ func New(
 	l *slog.Logger,
 	gc sharedstore.GateCache,
 	uc sharedstore.ExternalUserCache,
+	profiles fbstore.UserProfileCache,
+	callbacks fbstore.CallbackStore,
 	repo fbstore.FacebookStore,
 	metaAppRepo fbstore.MetaAppStore,
 	gatewayer *imgateway.Client,
@@ -54,23 +60,31 @@ func New(
 	api *apiClient,
 ) provider.Provider {
 	psidCache, _ := lru.New[string, string](1000)
+	logger := l.With("provider", "facebook")
 	return &facebookProvider{
 		api:           api,
-		logger:        l.With("provider", "facebook"),
+		logger:        logger,
 		messenger:     m,
 		gateCache:     gc,
 		userCache:     uc,
+		profiles:      profiles,
 		repo:          repo,
 		metaAppRepo:   metaAppRepo,
 		gatewayer:     gatewayer,
 		media:         media,
 		contactClient: contactClient,
 		psidCache:     psidCache,
+		callbacks:     newCallbackRegistry(callbacks, logger),
 		httpClient:    &http.Client{Timeout: 30 * time.Second},
 	}
 }
 
-var _ provider.InteractiveSender = (*facebookProvider)(nil)
+var (
+	_ provider.InteractiveSender = (*facebookProvider)(nil)
+	_ provider.CardSender        = (*facebookProvider)(nil)
+	_ provider.LocationSender    = (*facebookProvider)(nil)
+	_ provider.ContactSender     = (*facebookProvider)(nil)
+)
 
 func (p *facebookProvider) Type() string { return "facebook" }
 
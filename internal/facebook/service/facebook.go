@@ -16,10 +16,16 @@ type FacebookManager interface {
 	UpdateGate(ctx context.Context, req fbmodel.UpdateFacebook) (*fbmodel.FacebookGate, error)
 	DeleteGate(ctx context.Context, id string) (*fbmodel.FacebookGate, error)
 
-	SetPersistentMenu(ctx context.Context, gateID string, items []fbmodel.MenuItem, composerDisabled bool) error
+	SetPersistentMenu(ctx context.Context, gateID string, menu fbmodel.PersistentMenu) error
+	GetPersistentMenu(ctx context.Context, gateID string) (fbmodel.PersistentMenu, error)
 	DeletePersistentMenu(ctx context.Context, gateID string) error
 	SetGetStarted(ctx context.Context, gateID string, payload string) error
 	DeleteGetStarted(ctx context.Context, gateID string) error
+	SetGreeting(ctx context.Context, gateID string, greeting map[string]string) error
+	DeleteGreeting(ctx context.Context, gateID string) error
+	SetIceBreakers(ctx context.Context, gateID string, iceBreakers map[string][]fbmodel.IceBreaker) error
+	DeleteIceBreakers(ctx context.Context, gateID string) error
+	ReconcileProfiles(ctx context.Context, metaAppID string) (*fbmodel.ReconcileResult, error)
 }
 
 // MessengerProfileAPI is the subset of the Graph API used for Messenger Profile operations.
@@ -36,6 +42,8 @@ type MessengerProfileAPI interface {
 type messengerProfilePayload struct {
 	PersistentMenu []persistentMenuLocale `json:"persistent_menu,omitempty"`
 	GetStarted     *getStartedPayload     `json:"get_started,omitempty"`
+	Greeting       []greetingLocale       `json:"greeting,omitempty"`
+	IceBreakers    []iceBreakersLocale    `json:"ice_breakers,omitempty"`
 }
 
 type persistentMenuLocale struct {
@@ -59,15 +67,27 @@ type getStartedPayload struct {
 	Payload string `json:"payload"`
 }
 
+type greetingLocale struct {
+	Locale string `json:"locale"`
+	Text   string `json:"text"`
+}
+
+type iceBreakersLocale struct {
+	Locale        string               `json:"locale"`
+	CallToActions []fbmodel.IceBreaker `json:"call_to_actions"`
+}
+
 type FacebookService struct {
 	repo     fbstore.FacebookStore
+	profiles fbstore.MessengerProfileStore
 	graphAPI MessengerProfileAPI
 	log      *slog.Logger
 }
 
-func NewFacebookService(repo fbstore.FacebookStore, graphAPI MessengerProfileAPI, log *slog.Logger) *FacebookService {
+func NewFacebookService(repo fbstore.FacebookStore, profiles fbstore.MessengerProfileStore, graphAPI MessengerProfileAPI, log *slog.Logger) *FacebookService {
 	return &FacebookService{
 		repo:     repo,
+		profiles: profiles,
 		graphAPI: graphAPI,
 		log:      log.With("layer", "service", "domain", "facebook_gate"),
 	}
@@ -133,111 +153,3 @@ func (f *FacebookService) DeleteGate(ctx context.Context, id string) (*fbmodel.F
 	return gate, nil
 }
 
-// SetPersistentMenu pushes a persistent menu to the Messenger Profile for the given gate's page.
-func (f *FacebookService) SetPersistentMenu(ctx context.Context, gateID string, items []fbmodel.MenuItem, composerDisabled bool) error {
-	gate, err := f.repo.Select(ctx, gateID)
-	if err != nil {
-		f.log.ErrorContext(ctx, "failed to fetch gate", "gate_id", gateID, "error", err)
-		return err
-	}
-
-	f.log.InfoContext(ctx, "pushing persistent menu to FB", "gate_id", gateID, "page_id", gate.PageID, "items", len(items))
-	profile := messengerProfilePayload{
-		PersistentMenu: []persistentMenuLocale{
-			{
-				Locale:                "default",
-				ComposerInputDisabled: composerDisabled,
-				CallToActions:         menuItemsToActions(items, false),
-			},
-		},
-	}
-	if err := f.graphAPI.SetMessengerProfile(ctx, gate.PageToken, profile); err != nil {
-		f.log.ErrorContext(ctx, "FB API rejected set persistent menu", "gate_id", gateID, "page_id", gate.PageID, "error", err)
-		return err
-	}
-	f.log.InfoContext(ctx, "persistent menu set on Facebook", "gate_id", gateID, "page_id", gate.PageID)
-	return nil
-}
-
-// DeletePersistentMenu removes the persistent_menu field from the Messenger Profile.
-func (f *FacebookService) DeletePersistentMenu(ctx context.Context, gateID string) error {
-	gate, err := f.repo.Select(ctx, gateID)
-	if err != nil {
-		f.log.ErrorContext(ctx, "failed to fetch gate", "gate_id", gateID, "error", err)
-		return err
-	}
-
-	f.log.InfoContext(ctx, "deleting persistent menu from FB", "gate_id", gateID, "page_id", gate.PageID)
-	if err := f.graphAPI.DeleteMessengerProfile(ctx, gate.PageToken, []string{"persistent_menu"}); err != nil {
-		f.log.ErrorContext(ctx, "FB API rejected delete persistent menu", "gate_id", gateID, "page_id", gate.PageID, "error", err)
-		return err
-	}
-	f.log.InfoContext(ctx, "persistent menu deleted from Facebook", "gate_id", gateID, "page_id", gate.PageID)
-	return nil
-}
-
-// SetGetStarted sets the Get Started button payload on the Messenger Profile.
-func (f *FacebookService) SetGetStarted(ctx context.Context, gateID string, payload string) error {
-	gate, err := f.repo.Select(ctx, gateID)
-	if err != nil {
-		f.log.ErrorContext(ctx, "failed to fetch gate", "gate_id", gateID, "error", err)
-		return err
-	}
-
-	f.log.InfoContext(ctx, "pushing get started button to FB", "gate_id", gateID, "page_id", gate.PageID, "payload", payload)
-	profile := messengerProfilePayload{
-		GetStarted: &getStartedPayload{Payload: payload},
-	}
-	if err := f.graphAPI.SetMessengerProfile(ctx, gate.PageToken, profile); err != nil {
-		f.log.ErrorContext(ctx, "FB API rejected set get started", "gate_id", gateID, "page_id", gate.PageID, "error", err)
-		return err
-	}
-	f.log.InfoContext(ctx, "get started button set on Facebook", "gate_id", gateID, "page_id", gate.PageID)
-	return nil
-}
-
-// DeleteGetStarted removes the get_started field from the Messenger Profile.
-func (f *FacebookService) DeleteGetStarted(ctx context.Context, gateID string) error {
-	gate, err := f.repo.Select(ctx, gateID)
-	if err != nil {
-		f.log.ErrorContext(ctx, "failed to fetch gate", "gate_id", gateID, "error", err)
-		return err
-	}
-
-	f.log.InfoContext(ctx, "deleting get started button from FB", "gate_id", gateID, "page_id", gate.PageID)
-	if err := f.graphAPI.DeleteMessengerProfile(ctx, gate.PageToken, []string{"get_started"}); err != nil {
-		f.log.ErrorContext(ctx, "FB API rejected delete get started", "gate_id", gateID, "page_id", gate.PageID, "error", err)
-		return err
-	}
-	f.log.InfoContext(ctx, "get started button deleted from Facebook", "gate_id", gateID, "page_id", gate.PageID)
-	return nil
-}
-
-// menuItemsToActions converts domain menu items to the Graph API call-to-action structure.
-// Facebook persistent menu only supports postback and web_url types (no nested).
-// Items with nested children are flattened into the parent list.
-func menuItemsToActions(items []fbmodel.MenuItem, _ bool) []menuAction {
-	actions := make([]menuAction, 0, len(items))
-	for _, item := range items {
-		switch {
-		case len(item.Nested) > 0:
-			// FB persistent menu does not support nested type — flatten children into the list.
-			actions = append(actions, menuItemsToActions(item.Nested, false)...)
-		case item.URL != "":
-			// webview_height_ratio is required by the FB API for web_url buttons.
-			actions = append(actions, menuAction{
-				Type:               "web_url",
-				Title:              item.Title,
-				URL:                item.URL,
-				WebviewHeightRatio: "full",
-			})
-		default:
-			actions = append(actions, menuAction{
-				Type:    "postback",
-				Title:   item.Title,
-				Payload: item.Payload,
-			})
-		}
-	}
-	return actions
-}
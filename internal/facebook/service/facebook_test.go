@@ -22,6 +22,8 @@ type mockFacebookStore struct {
 	insertFn             func(ctx context.Context, dc int64, g *fbmodel.FacebookGate) error
 	selectFn             func(ctx context.Context, id string) (*fbmodel.FacebookGate, error)
 	selectByPageAndURIFn func(ctx context.Context, pageID, uri string) (*fbmodel.FacebookGate, error)
+	selectByAppAndPageFn func(ctx context.Context, metaAppID, pageID string) (*fbmodel.FacebookGate, error)
+	selectByMetaAppFn    func(ctx context.Context, metaAppID string) ([]*fbmodel.FacebookGate, error)
 	updateFn             func(ctx context.Context, g *fbmodel.FacebookGate) error
 	unbindFn             func(ctx context.Context, gateID string) error
 }
@@ -35,6 +37,12 @@ func (m *mockFacebookStore) Select(ctx context.Context, id string) (*fbmodel.Fac
 func (m *mockFacebookStore) SelectByPageAndURI(ctx context.Context, pageID, uri string) (*fbmodel.FacebookGate, error) {
 	return m.selectByPageAndURIFn(ctx, pageID, uri)
 }
+func (m *mockFacebookStore) SelectByMetaAppAndPage(ctx context.Context, metaAppID, pageID string) (*fbmodel.FacebookGate, error) {
+	return m.selectByAppAndPageFn(ctx, metaAppID, pageID)
+}
+func (m *mockFacebookStore) SelectByMetaApp(ctx context.Context, metaAppID string) ([]*fbmodel.FacebookGate, error) {
+	return m.selectByMetaAppFn(ctx, metaAppID)
+}
 func (m *mockFacebookStore) Update(ctx context.Context, g *fbmodel.FacebookGate) error {
 	return m.updateFn(ctx, g)
 }
@@ -68,7 +76,7 @@ func (noopMessengerProfileAPI) DeleteMessengerProfile(_ context.Context, _ strin
 }
 
 func newFBService(repo fbstore.FacebookStore) *FacebookService {
-	return NewFacebookService(repo, noopMessengerProfileAPI{}, noopLogger)
+	return NewFacebookService(repo, newMemProfileStore(), noopMessengerProfileAPI{}, noopLogger)
 }
 
 // -- tests --
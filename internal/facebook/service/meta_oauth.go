@@ -2,16 +2,21 @@ package service
 
 import (
 	"context"
+	"crypto/hmac"
 	"crypto/rand"
+	"crypto/sha256"
 	"encoding/hex"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"log/slog"
 	"net/http"
 	"net/url"
+	"slices"
 	"strings"
 	"time"
 
+	sharedstore "github.com/webitel/im-providers-service/internal/core/store"
 	fbmodel "github.com/webitel/im-providers-service/internal/facebook/model"
 	fbstore "github.com/webitel/im-providers-service/internal/facebook/store"
 )
@@ -28,18 +33,40 @@ const (
 	// metaTokenURL is the Graph API endpoint for both short-lived code exchange
 	// and long-lived token upgrade; both operations share the same URL.
 	metaTokenURL = "https://graph.facebook.com/" + metaAPIVersion + "/oauth/access_token"
+	// oauthStateTTL bounds how long the user may stay on the Meta consent screen.
+	oauthStateTTL = 10 * time.Minute
+	// whatsAppTokenScopes are requested for the business system user token WhatsApp gates are provisioned with.
+	whatsAppTokenScopes = "whatsapp_business_management,whatsapp_business_messaging"
 )
 
+// WhatsAppProvisioner creates or refreshes WhatsApp gates for phone numbers
+// discovered during the OAuth callback. Implemented by the whatsapp module.
+type WhatsAppProvisioner interface {
+	ProvisionWhatsAppGate(ctx context.Context, req fbmodel.ProvisionWhatsApp) (gateID string, err error)
+}
+
 type MetaOAuthService struct {
-	repo   fbstore.MetaAppStore
-	client *http.Client
-	logger *slog.Logger
+	repo     fbstore.MetaAppStore
+	states   fbstore.OAuthStateStore
+	gates    fbstore.FacebookStore
+	whatsApp WhatsAppProvisioner
+	client   *http.Client
+	logger   *slog.Logger
 }
 
-func NewMetaOAuthService(repo fbstore.MetaAppStore, logger *slog.Logger) *MetaOAuthService {
+func NewMetaOAuthService(
+	repo fbstore.MetaAppStore,
+	states fbstore.OAuthStateStore,
+	gates fbstore.FacebookStore,
+	whatsApp WhatsAppProvisioner,
+	logger *slog.Logger,
+) *MetaOAuthService {
 	return &MetaOAuthService{
-		repo:   repo,
-		logger: logger,
+		repo:     repo,
+		states:   states,
+		gates:    gates,
+		whatsApp: whatsApp,
+		logger:   logger,
 		client: &http.Client{
 			Timeout: 15 * time.Second,
 		},
@@ -47,6 +74,10 @@ func NewMetaOAuthService(repo fbstore.MetaAppStore, logger *slog.Logger) *MetaOA
 }
 
 func (s *MetaOAuthService) StartOAuth(ctx context.Context, req fbmodel.OAuthStart) (string, string, error) {
+	if err := req.Validate(); err != nil {
+		return "", "", err
+	}
+
 	app, err := s.repo.Select(ctx, req.MetaAppID)
 	if err != nil {
 		return "", "", fmt.Errorf("oauth: app not found: %w", err)
@@ -57,12 +88,23 @@ func (s *MetaOAuthService) StartOAuth(ctx context.Context, req fbmodel.OAuthStar
 		return "", "", fmt.Errorf("oauth: state generation failed: %w", err)
 	}
 
+	err = s.states.Save(ctx, state, &fbmodel.OAuthState{
+		MetaAppID: app.ID,
+		DomainID:  req.DomainID,
+		UserID:    req.UserID,
+		Provision: req.Provision,
+		CreatedAt: time.Now().UTC(),
+	}, oauthStateTTL)
+	if err != nil {
+		return "", "", fmt.Errorf("oauth: save state: %w", err)
+	}
+
 	q := url.Values{}
 	q.Set("client_id", app.AppID)
 	q.Set("redirect_uri", app.OAuthRedirectURI)
 	q.Set("state", state)
 	q.Set("response_type", "code")
-	q.Set("scope", strings.Join(app.Scopes, ","))
+	q.Set("scope", strings.Join(slices.Concat(app.Scopes, req.ExtraScopes), ","))
 	u := &url.URL{
 		Scheme:   "https",
 		Host:     "www.facebook.com",
@@ -79,6 +121,13 @@ func (s *MetaOAuthService) StartOAuth(ctx context.Context, req fbmodel.OAuthStar
 }
 
 func (s *MetaOAuthService) HandleCallback(ctx context.Context, req fbmodel.OAuthCallback) (string, []fbmodel.LinkedPage, error) {
+	// The state is consumed before anything else so a leaked code cannot be
+	// replayed and a failed exchange requires a fresh StartOAuth.
+	state, err := s.consumeState(ctx, req)
+	if err != nil {
+		return "", nil, err
+	}
+
 	app, err := s.repo.Select(ctx, req.MetaAppID)
 	if err != nil {
 		return "", nil, fmt.Errorf("oauth: config lookup failed: %w", err)
@@ -105,13 +154,148 @@ func (s *MetaOAuthService) HandleCallback(ctx context.Context, req fbmodel.OAuth
 		return "", nil, err
 	}
 
+	// WhatsApp discovery requires business_management; apps without it still
+	// get their pages back.
+	numbers, err := s.fetchWhatsAppNumbers(ctx, longUserToken)
+	if err != nil {
+		s.logger.Warn("WhatsApp number discovery failed", slog.String("error", err.Error()))
+	}
+	pages = append(pages, numbers...)
+
+	if state.Provision != nil {
+		s.provision(ctx, app, state, longUserToken, pages)
+	}
+
 	s.logger.Info("OAuth callback processed successfully",
-		slog.Int("pages_found", len(pages)),
+		slog.Int("pages_found", len(pages)-len(numbers)),
+		slog.Int("whatsapp_numbers_found", len(numbers)),
 	)
 
 	return longUserToken, pages, nil
 }
 
+// consumeState verifies the callback state against the one issued by StartOAuth.
+// It must exist, belong to the same MetaApp and to the domain/user of the caller.
+func (s *MetaOAuthService) consumeState(ctx context.Context, req fbmodel.OAuthCallback) (*fbmodel.OAuthState, error) {
+	if req.State == "" {
+		return nil, fbmodel.ErrInvalidOAuthState
+	}
+
+	state, err := s.states.Consume(ctx, req.State)
+	if err != nil {
+		if errors.Is(err, sharedstore.ErrNotFound) {
+			return nil, fbmodel.ErrInvalidOAuthState
+		}
+		return nil, fmt.Errorf("oauth: consume state: %w", err)
+	}
+
+	if state.MetaAppID != req.MetaAppID ||
+		state.DomainID != req.DomainID ||
+		state.UserID != req.UserID {
+		s.logger.Warn("OAuth state bound to another session",
+			slog.String("meta_app_id", req.MetaAppID),
+			slog.Int64("domain_id", req.DomainID),
+		)
+		return nil, fbmodel.ErrInvalidOAuthState
+	}
+
+	return state, nil
+}
+
+// provision creates or refreshes gates for the assets selected in the state.
+// Failures are logged per asset and never fail the callback: the token exchange
+// already happened and the operator can still finish setup manually.
+func (s *MetaOAuthService) provision(ctx context.Context, app *fbmodel.MetaApp, state *fbmodel.OAuthState, userToken string, pages []fbmodel.LinkedPage) {
+	// Business tokens are fetched once per business portfolio.
+	businessTokens := make(map[string]string)
+	for i := range pages {
+		p := &pages[i]
+		if !state.Provision.Wants(p.Platform, p.PageID) {
+			continue
+		}
+
+		var (
+			gateID string
+			err    error
+		)
+		switch p.Platform {
+		case fbmodel.PlatformWhatsApp:
+			gateID, err = s.provisionWhatsApp(ctx, app, state, userToken, businessTokens, p)
+		default:
+			gateID, err = s.provisionPage(ctx, app, state, p)
+		}
+		if err != nil {
+			s.logger.Error("OAuth provisioning failed",
+				slog.String("platform", p.Platform),
+				slog.String("asset_id", p.PageID),
+				slog.String("error", err.Error()),
+			)
+			continue
+		}
+		p.GateID = gateID
+	}
+}
+
+func (s *MetaOAuthService) provisionPage(ctx context.Context, app *fbmodel.MetaApp, state *fbmodel.OAuthState, p *fbmodel.LinkedPage) (string, error) {
+	gate, err := s.gates.SelectByMetaAppAndPage(ctx, app.ID, p.PageID)
+	switch {
+	case err == nil:
+		if gate.DomainID != state.DomainID {
+			return "", fmt.Errorf("page %s is bound to another domain: %w", p.PageID, sharedstore.ErrConflict)
+		}
+		gate.PageToken = p.PageToken
+		if err := s.gates.Update(ctx, gate); err != nil {
+			return "", fmt.Errorf("refresh page token: %w", err)
+		}
+		return gate.ID, nil
+
+	case errors.Is(err, sharedstore.ErrNotFound):
+		gate = &fbmodel.FacebookGate{
+			Name:      p.PageName,
+			MetaAppID: app.ID,
+			PageID:    p.PageID,
+			PageToken: p.PageToken,
+			Peer:      state.Provision.Peer,
+			Enabled:   true,
+		}
+		if err := s.gates.Insert(ctx, state.DomainID, gate); err != nil {
+			return "", fmt.Errorf("create page gate: %w", err)
+		}
+		return gate.ID, nil
+
+	default:
+		return "", err
+	}
+}
+
+// provisionWhatsApp binds the phone number to a gate holding the owning business'
+// system user token. The user token expires and dies with the user's session, so
+// it is never stored on the gate.
+func (s *MetaOAuthService) provisionWhatsApp(ctx context.Context, app *fbmodel.MetaApp, state *fbmodel.OAuthState, userToken string, businessTokens map[string]string, p *fbmodel.LinkedPage) (string, error) {
+	if s.whatsApp == nil {
+		return "", errors.New("whatsapp provisioning is not available")
+	}
+
+	token, ok := businessTokens[p.OwnerBusinessID]
+	if !ok {
+		var err error
+		if token, err = s.fetchBusinessToken(ctx, app, userToken, p.OwnerBusinessID); err != nil {
+			return "", fmt.Errorf("fetch business token: %w", err)
+		}
+		businessTokens[p.OwnerBusinessID] = token
+	}
+
+	return s.whatsApp.ProvisionWhatsAppGate(ctx, fbmodel.ProvisionWhatsApp{
+		MetaAppID:     app.ID,
+		BusinessID:    p.BusinessID,
+		PhoneNumberID: p.PageID,
+		PhoneNumber:   p.PageName,
+		Name:          p.PageName,
+		AccessToken:   token,
+		Peer:          state.Provision.Peer,
+	})
+}
+
 func (s *MetaOAuthService) exchangeCodeForToken(ctx context.Context, app *fbmodel.MetaApp, code string) (string, error) {
 	val := url.Values{}
 	val.Set("client_id", app.AppID)
@@ -132,6 +316,26 @@ func (s *MetaOAuthService) upgradeToLongLivedToken(ctx context.Context, app *fbm
 	return s.doPOSTTokenRequest(ctx, metaTokenURL, val)
 }
 
+// fetchBusinessToken returns the business integration system user token the
+// app holds for businessID, issuing it on first use.
+func (s *MetaOAuthService) fetchBusinessToken(ctx context.Context, app *fbmodel.MetaApp, userToken, businessID string) (string, error) {
+	if businessID == "" {
+		return "", errors.New("business id is unknown")
+	}
+
+	mac := hmac.New(sha256.New, []byte(app.AppSecret))
+	mac.Write([]byte(userToken))
+
+	val := url.Values{}
+	val.Set("access_token", userToken)
+	val.Set("appsecret_proof", hex.EncodeToString(mac.Sum(nil)))
+	val.Set("scope", whatsAppTokenScopes)
+	val.Set("fetch_only", "false")
+
+	apiURL := fmt.Sprintf("https://graph.facebook.com/%s/%s/system_user_access_tokens", metaAPIVersion, url.PathEscape(businessID))
+	return s.doPOSTTokenRequest(ctx, apiURL, val)
+}
+
 func (s *MetaOAuthService) doPOSTTokenRequest(ctx context.Context, apiURL string, val url.Values) (string, error) {
 	req, err := http.NewRequestWithContext(ctx, http.MethodPost, apiURL, strings.NewReader(val.Encode()))
 	if err != nil {
@@ -209,11 +413,95 @@ func (s *MetaOAuthService) fetchUserPages(ctx context.Context, userToken string)
 			PageID:    p.ID,
 			PageName:  p.Name,
 			PageToken: p.AccessToken,
+			Platform:  fbmodel.PlatformFacebook,
 		}
 	}
 	return pages, nil
 }
 
+// fetchWhatsAppNumbers walks /me/businesses and collects the phone numbers of
+// every WhatsApp Business Account the user owns or has client access to.
+func (s *MetaOAuthService) fetchWhatsAppNumbers(ctx context.Context, userToken string) ([]fbmodel.LinkedPage, error) {
+	const wabaFields = "{id,name,phone_numbers{id,display_phone_number,verified_name}}"
+
+	q := url.Values{}
+	q.Set("access_token", userToken)
+	q.Set("fields", "id,name,owned_whatsapp_business_accounts"+wabaFields+",client_whatsapp_business_accounts"+wabaFields)
+	u := &url.URL{
+		Scheme:   "https",
+		Host:     "graph.facebook.com",
+		Path:     fmt.Sprintf("/%s/me/businesses", metaAPIVersion),
+		RawQuery: q.Encode(),
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	type phoneNumber struct {
+		ID                 string `json:"id"`
+		DisplayPhoneNumber string `json:"display_phone_number"`
+		VerifiedName       string `json:"verified_name"`
+	}
+	type waba struct {
+		ID           string `json:"id"`
+		PhoneNumbers struct {
+			Data []phoneNumber `json:"data"`
+		} `json:"phone_numbers"`
+	}
+	var result struct {
+		Data []struct {
+			ID    string `json:"id"`
+			Owned struct {
+				Data []waba `json:"data"`
+			} `json:"owned_whatsapp_business_accounts"`
+			Client struct {
+				Data []waba `json:"data"`
+			} `json:"client_whatsapp_business_accounts"`
+		} `json:"data"`
+		Error *struct {
+			Message string `json:"message"`
+		} `json:"error"`
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, err
+	}
+	if result.Error != nil {
+		return nil, fmt.Errorf("meta api error: %s", result.Error.Message)
+	}
+
+	var (
+		numbers []fbmodel.LinkedPage
+		seen    = make(map[string]struct{})
+	)
+	for _, b := range result.Data {
+		for _, w := range append(b.Owned.Data, b.Client.Data...) {
+			for _, n := range w.PhoneNumbers.Data {
+				if _, ok := seen[n.ID]; ok {
+					continue
+				}
+				seen[n.ID] = struct{}{}
+				numbers = append(numbers, fbmodel.LinkedPage{
+					PageID:          n.ID,
+					PageName:        n.DisplayPhoneNumber,
+					PageToken:       userToken,
+					Platform:        fbmodel.PlatformWhatsApp,
+					BusinessID:      w.ID,
+					OwnerBusinessID: b.ID,
+				})
+			}
+		}
+	}
+	return numbers, nil
+}
+
 func generateSecureState(n int) (string, error) {
 	b := make([]byte, n)
 	if _, err := rand.Read(b); err != nil {
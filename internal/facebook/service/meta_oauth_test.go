@@ -8,11 +8,14 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"strings"
+	"sync"
 	"testing"
+	"time"
 
+	sharedmodel "github.com/webitel/im-providers-service/internal/core/model"
+	sharedstore "github.com/webitel/im-providers-service/internal/core/store"
 	fbmodel "github.com/webitel/im-providers-service/internal/facebook/model"
 	fbstore "github.com/webitel/im-providers-service/internal/facebook/store"
-	sharedstore "github.com/webitel/im-providers-service/internal/core/store"
 )
 
 // -- mock store --
@@ -53,13 +56,84 @@ func stubMetaApp() *fbmodel.MetaApp {
 	}
 }
 
+// memOAuthStateStore is an in-memory OAuthStateStore with single-use semantics.
+type memOAuthStateStore struct {
+	mu     sync.Mutex
+	states map[string]*fbmodel.OAuthState
+	ttls   map[string]time.Duration
+}
+
+func newMemOAuthStateStore() *memOAuthStateStore {
+	return &memOAuthStateStore{
+		states: make(map[string]*fbmodel.OAuthState),
+		ttls:   make(map[string]time.Duration),
+	}
+}
+
+func (m *memOAuthStateStore) Save(_ context.Context, state string, s *fbmodel.OAuthState, ttl time.Duration) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.states[state] = s
+	m.ttls[state] = ttl
+	return nil
+}
+
+func (m *memOAuthStateStore) Consume(_ context.Context, state string) (*fbmodel.OAuthState, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	s, ok := m.states[state]
+	if !ok {
+		return nil, sharedstore.ErrNotFound
+	}
+	delete(m.states, state)
+	return s, nil
+}
+
+var _ fbstore.OAuthStateStore = (*memOAuthStateStore)(nil)
+
+type mockWhatsAppProvisioner struct {
+	provisionFn func(ctx context.Context, req fbmodel.ProvisionWhatsApp) (string, error)
+}
+
+func (m *mockWhatsAppProvisioner) ProvisionWhatsAppGate(ctx context.Context, req fbmodel.ProvisionWhatsApp) (string, error) {
+	return m.provisionFn(ctx, req)
+}
+
 // newTestOAuthService builds a MetaOAuthService whose HTTP client points to srv.
 func newTestOAuthService(repo fbstore.MetaAppStore, srv *httptest.Server) *MetaOAuthService {
-	svc := NewMetaOAuthService(repo, noopLogger)
+	svc := NewMetaOAuthService(repo, newMemOAuthStateStore(), &mockFacebookStore{}, nil, noopLogger)
 	svc.client = srv.Client()
 	return svc
 }
 
+// newRewriteOAuthService builds a MetaOAuthService that sends every Graph API call to srv.
+func newRewriteOAuthService(repo fbstore.MetaAppStore, states fbstore.OAuthStateStore, gates fbstore.FacebookStore, wa WhatsAppProvisioner, srv *httptest.Server) *MetaOAuthService {
+	svc := NewMetaOAuthService(repo, states, gates, wa, noopLogger)
+	svc.client = &http.Client{Transport: rewriteHostTransport(srv.URL)}
+	return svc
+}
+
+// issuedState stores a valid state for app-1 bound to domain 1 / user-1.
+func issuedState(states *memOAuthStateStore, provision *fbmodel.OAuthProvision) string {
+	states.states["state"] = &fbmodel.OAuthState{
+		MetaAppID: "app-1",
+		DomainID:  1,
+		UserID:    "user-1",
+		Provision: provision,
+	}
+	return "state"
+}
+
+func callbackReq(code string) fbmodel.OAuthCallback {
+	return fbmodel.OAuthCallback{
+		MetaAppID: "app-1",
+		DomainID:  1,
+		UserID:    "user-1",
+		Code:      code,
+		State:     "state",
+	}
+}
+
 // -- StartOAuth tests --
 
 func TestStartOAuth_Success(t *testing.T) {
@@ -75,11 +149,23 @@ func TestStartOAuth_Success(t *testing.T) {
 	ts := httptest.NewServer(http.NotFoundHandler())
 	defer ts.Close()
 
+	states := newMemOAuthStateStore()
 	svc := newTestOAuthService(repo, ts)
-	authURL, state, err := svc.StartOAuth(context.Background(), fbmodel.OAuthStart{MetaAppID: "app-1"})
+	svc.states = states
+	authURL, state, err := svc.StartOAuth(context.Background(), fbmodel.OAuthStart{MetaAppID: "app-1", DomainID: 1, UserID: "user-1"})
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
+	saved, ok := states.states[state]
+	if !ok {
+		t.Fatal("expected state to be persisted")
+	}
+	if saved.DomainID != 1 || saved.UserID != "user-1" || saved.MetaAppID != "app-1" {
+		t.Errorf("unexpected state binding: %+v", saved)
+	}
+	if states.ttls[state] != oauthStateTTL {
+		t.Errorf("unexpected state ttl: %s", states.ttls[state])
+	}
 	if !strings.Contains(authURL, "facebook.com") {
 		t.Errorf("expected facebook.com in auth url, got: %s", authURL)
 	}
@@ -101,7 +187,7 @@ func TestStartOAuth_AppNotFound(t *testing.T) {
 	defer ts.Close()
 
 	svc := newTestOAuthService(repo, ts)
-	_, _, err := svc.StartOAuth(context.Background(), fbmodel.OAuthStart{MetaAppID: "missing"})
+	_, _, err := svc.StartOAuth(context.Background(), fbmodel.OAuthStart{MetaAppID: "missing", DomainID: 1})
 	if err == nil {
 		t.Fatal("expected error, got nil")
 	}
@@ -120,7 +206,7 @@ func TestStartOAuth_URLContainsScopes(t *testing.T) {
 	defer ts.Close()
 
 	svc := newTestOAuthService(repo, ts)
-	authURL, _, err := svc.StartOAuth(context.Background(), fbmodel.OAuthStart{MetaAppID: "app-1"})
+	authURL, _, err := svc.StartOAuth(context.Background(), fbmodel.OAuthStart{MetaAppID: "app-1", DomainID: 1})
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
@@ -129,6 +215,34 @@ func TestStartOAuth_URLContainsScopes(t *testing.T) {
 	}
 }
 
+func TestStartOAuth_ExtraScopesDoNotLeakIntoApp(t *testing.T) {
+	app := stubMetaApp()
+	app.Scopes = make([]string, 1, 4)
+	app.Scopes[0] = "pages_messaging"
+	repo := &mockMetaAppStore{
+		selectFn: func(_ context.Context, _ string) (*fbmodel.MetaApp, error) {
+			return app, nil
+		},
+	}
+	ts := httptest.NewServer(http.NotFoundHandler())
+	defer ts.Close()
+
+	svc := newTestOAuthService(repo, ts)
+	if _, _, err := svc.StartOAuth(context.Background(), fbmodel.OAuthStart{MetaAppID: "app-1", DomainID: 1, ExtraScopes: []string{"first"}}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	authURL, _, err := svc.StartOAuth(context.Background(), fbmodel.OAuthStart{MetaAppID: "app-1", DomainID: 1, ExtraScopes: []string{"second"}})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if strings.Contains(authURL, "first") || !strings.Contains(authURL, "second") {
+		t.Errorf("extra scopes leaked between requests: %s", authURL)
+	}
+	if app.Scopes[:cap(app.Scopes)][1] != "" {
+		t.Errorf("app scopes backing array was written: %v", app.Scopes[:cap(app.Scopes)])
+	}
+}
+
 // -- HandleCallback tests --
 
 // metaTokenResponse simulates graph.facebook.com/v25.0/oauth/access_token
@@ -202,19 +316,14 @@ func TestHandleCallback_Success(t *testing.T) {
 		},
 	}
 
-	svc := NewMetaOAuthService(repo, noopLogger)
+	states := newMemOAuthStateStore()
+	issuedState(states, nil)
 	// Override the Graph API base to the test server by wrapping the client transport.
 	// Since doPOSTTokenRequest and fetchUserPages build absolute URLs from graph.facebook.com,
 	// we patch those calls via a transport that rewrites the host.
-	svc.client = &http.Client{
-		Transport: rewriteHostTransport(ts.URL),
-	}
+	svc := newRewriteOAuthService(repo, states, &mockFacebookStore{}, nil, ts)
 
-	longTok, fetchedPages, err := svc.HandleCallback(context.Background(), fbmodel.OAuthCallback{
-		MetaAppID: "app-1",
-		Code:      "auth-code",
-		State:     "state",
-	})
+	longTok, fetchedPages, err := svc.HandleCallback(context.Background(), callbackReq("auth-code"))
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
@@ -238,8 +347,13 @@ func TestHandleCallback_AppNotFound(t *testing.T) {
 			return nil, sharedstore.ErrNotFound
 		},
 	}
+	states := newMemOAuthStateStore()
+	states.states["state"] = &fbmodel.OAuthState{MetaAppID: "missing", DomainID: 1, UserID: "user-1"}
 	svc := newTestOAuthService(repo, ts)
-	_, _, err := svc.HandleCallback(context.Background(), fbmodel.OAuthCallback{MetaAppID: "missing"})
+	svc.states = states
+	req := callbackReq("code")
+	req.MetaAppID = "missing"
+	_, _, err := svc.HandleCallback(context.Background(), req)
 	if err == nil {
 		t.Fatal("expected error, got nil")
 	}
@@ -262,13 +376,11 @@ func TestHandleCallback_TokenExchangeError(t *testing.T) {
 			return stubMetaApp(), nil
 		},
 	}
-	svc := NewMetaOAuthService(repo, noopLogger)
-	svc.client = &http.Client{Transport: rewriteHostTransport(ts.URL)}
+	states := newMemOAuthStateStore()
+	issuedState(states, nil)
+	svc := newRewriteOAuthService(repo, states, &mockFacebookStore{}, nil, ts)
 
-	_, _, err := svc.HandleCallback(context.Background(), fbmodel.OAuthCallback{
-		MetaAppID: "app-1",
-		Code:      "bad-code",
-	})
+	_, _, err := svc.HandleCallback(context.Background(), callbackReq("bad-code"))
 	if err == nil {
 		t.Fatal("expected error from meta api, got nil")
 	}
@@ -289,13 +401,11 @@ func TestHandleCallback_EmptyPages(t *testing.T) {
 			return stubMetaApp(), nil
 		},
 	}
-	svc := NewMetaOAuthService(repo, noopLogger)
-	svc.client = &http.Client{Transport: rewriteHostTransport(ts.URL)}
+	states := newMemOAuthStateStore()
+	issuedState(states, nil)
+	svc := newRewriteOAuthService(repo, states, &mockFacebookStore{}, nil, ts)
 
-	longTok, fetchedPages, err := svc.HandleCallback(context.Background(), fbmodel.OAuthCallback{
-		MetaAppID: "app-1",
-		Code:      "code",
-	})
+	longTok, fetchedPages, err := svc.HandleCallback(context.Background(), callbackReq("code"))
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
@@ -307,6 +417,281 @@ func TestHandleCallback_EmptyPages(t *testing.T) {
 	}
 }
 
+// -- state verification tests --
+
+func TestHandleCallback_RejectsInvalidState(t *testing.T) {
+	tests := []struct {
+		name   string
+		stored *fbmodel.OAuthState
+		mutate func(req *fbmodel.OAuthCallback)
+	}{
+		{
+			name:   "empty state",
+			stored: &fbmodel.OAuthState{MetaAppID: "app-1", DomainID: 1, UserID: "user-1"},
+			mutate: func(req *fbmodel.OAuthCallback) { req.State = "" },
+		},
+		{
+			name:   "unknown state",
+			stored: &fbmodel.OAuthState{MetaAppID: "app-1", DomainID: 1, UserID: "user-1"},
+			mutate: func(req *fbmodel.OAuthCallback) { req.State = "forged" },
+		},
+		{
+			name:   "other domain",
+			stored: &fbmodel.OAuthState{MetaAppID: "app-1", DomainID: 2, UserID: "user-1"},
+		},
+		{
+			name:   "other user",
+			stored: &fbmodel.OAuthState{MetaAppID: "app-1", DomainID: 1, UserID: "user-2"},
+		},
+		{
+			name:   "other meta app",
+			stored: &fbmodel.OAuthState{MetaAppID: "app-2", DomainID: 1, UserID: "user-1"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				t.Errorf("meta api must not be called, got %s", r.URL.Path)
+			}))
+			defer ts.Close()
+
+			repo := &mockMetaAppStore{
+				selectFn: func(_ context.Context, _ string) (*fbmodel.MetaApp, error) {
+					return stubMetaApp(), nil
+				},
+			}
+			states := newMemOAuthStateStore()
+			states.states["state"] = tt.stored
+			svc := newRewriteOAuthService(repo, states, &mockFacebookStore{}, nil, ts)
+
+			req := callbackReq("code")
+			if tt.mutate != nil {
+				tt.mutate(&req)
+			}
+			_, _, err := svc.HandleCallback(context.Background(), req)
+			if !errors.Is(err, fbmodel.ErrInvalidOAuthState) {
+				t.Errorf("expected ErrInvalidOAuthState, got: %v", err)
+			}
+		})
+	}
+}
+
+func TestHandleCallback_StateIsSingleUse(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.Contains(r.URL.Path, "me/accounts") {
+			json.NewEncoder(w).Encode(map[string]interface{}{"data": []interface{}{}})
+			return
+		}
+		json.NewEncoder(w).Encode(map[string]string{"access_token": "tok"})
+	}))
+	defer ts.Close()
+
+	repo := &mockMetaAppStore{
+		selectFn: func(_ context.Context, _ string) (*fbmodel.MetaApp, error) {
+			return stubMetaApp(), nil
+		},
+	}
+	states := newMemOAuthStateStore()
+	issuedState(states, nil)
+	svc := newRewriteOAuthService(repo, states, &mockFacebookStore{}, nil, ts)
+
+	if _, _, err := svc.HandleCallback(context.Background(), callbackReq("code")); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	_, _, err := svc.HandleCallback(context.Background(), callbackReq("code"))
+	if !errors.Is(err, fbmodel.ErrInvalidOAuthState) {
+		t.Errorf("expected replay to be rejected, got: %v", err)
+	}
+}
+
+// -- provisioning tests --
+
+// provisioningServer answers token exchange, /me/accounts with two pages,
+// /me/businesses with one WhatsApp number and the business system user token.
+func provisioningServer() *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case strings.Contains(r.URL.Path, "me/accounts"):
+			w.Write(metaPagesResponse([]struct{ ID, Name, AccessToken string }{
+				{"page-1", "Page One", "page-tok-1"},
+				{"page-2", "Page Two", "page-tok-2"},
+			}))
+		case strings.Contains(r.URL.Path, "me/businesses"):
+			json.NewEncoder(w).Encode(map[string]interface{}{
+				"data": []interface{}{map[string]interface{}{
+					"id": "biz-1",
+					"owned_whatsapp_business_accounts": map[string]interface{}{
+						"data": []interface{}{map[string]interface{}{
+							"id": "waba-1",
+							"phone_numbers": map[string]interface{}{
+								"data": []interface{}{map[string]interface{}{
+									"id":                   "phone-1",
+									"display_phone_number": "+380000000000",
+								}},
+							},
+						}},
+					},
+				}},
+			})
+		case strings.Contains(r.URL.Path, "biz-1/system_user_access_tokens"):
+			r.ParseForm()
+			if r.PostForm.Get("access_token") != "long-tok" || r.PostForm.Get("appsecret_proof") == "" {
+				w.WriteHeader(http.StatusBadRequest)
+				w.Write([]byte(`{"error":{"message":"invalid appsecret_proof"}}`))
+				return
+			}
+			w.Write(metaTokenResponse("biz-tok"))
+		default:
+			w.Write(metaTokenResponse("long-tok"))
+		}
+	}))
+}
+
+func TestHandleCallback_DiscoversWhatsAppNumbers(t *testing.T) {
+	ts := provisioningServer()
+	defer ts.Close()
+
+	repo := &mockMetaAppStore{
+		selectFn: func(_ context.Context, _ string) (*fbmodel.MetaApp, error) {
+			return stubMetaApp(), nil
+		},
+	}
+	states := newMemOAuthStateStore()
+	issuedState(states, nil)
+	svc := newRewriteOAuthService(repo, states, &mockFacebookStore{}, nil, ts)
+
+	_, pages, err := svc.HandleCallback(context.Background(), callbackReq("code"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(pages) != 3 {
+		t.Fatalf("expected 2 pages + 1 number, got %d", len(pages))
+	}
+	wa := pages[2]
+	if wa.Platform != fbmodel.PlatformWhatsApp || wa.PageID != "phone-1" || wa.BusinessID != "waba-1" || wa.OwnerBusinessID != "biz-1" {
+		t.Errorf("unexpected whatsapp asset: %+v", wa)
+	}
+	if wa.GateID != "" {
+		t.Error("nothing should be provisioned without a provision request")
+	}
+}
+
+func TestHandleCallback_ProvisionsSelectedAssets(t *testing.T) {
+	ts := provisioningServer()
+	defer ts.Close()
+
+	repo := &mockMetaAppStore{
+		selectFn: func(_ context.Context, _ string) (*fbmodel.MetaApp, error) {
+			return stubMetaApp(), nil
+		},
+	}
+
+	var (
+		inserted []*fbmodel.FacebookGate
+		updated  []*fbmodel.FacebookGate
+	)
+	gates := &mockFacebookStore{
+		selectByAppAndPageFn: func(_ context.Context, metaAppID, pageID string) (*fbmodel.FacebookGate, error) {
+			if metaAppID != "app-1" {
+				t.Errorf("unexpected meta app id: %s", metaAppID)
+			}
+			if pageID == "page-2" {
+				g := stubFBGate()
+				g.ID = "gate-existing"
+				g.DomainID = 1
+				g.PageID = "page-2"
+				return g, nil
+			}
+			return nil, sharedstore.ErrNotFound
+		},
+		insertFn: func(_ context.Context, dc int64, g *fbmodel.FacebookGate) error {
+			if dc != 1 {
+				t.Errorf("unexpected dc: %d", dc)
+			}
+			g.ID = "gate-new"
+			inserted = append(inserted, g)
+			return nil
+		},
+		updateFn: func(_ context.Context, g *fbmodel.FacebookGate) error {
+			updated = append(updated, g)
+			return nil
+		},
+	}
+
+	var provisioned []fbmodel.ProvisionWhatsApp
+	wa := &mockWhatsAppProvisioner{
+		provisionFn: func(_ context.Context, req fbmodel.ProvisionWhatsApp) (string, error) {
+			provisioned = append(provisioned, req)
+			return "gate-wa", nil
+		},
+	}
+
+	states := newMemOAuthStateStore()
+	issuedState(states, &fbmodel.OAuthProvision{
+		Peer:                   sharedmodel.Peer{Sub: "bot-1", Iss: "iss"},
+		PageIDs:                []string{"page-1", "page-2"},
+		WhatsAppPhoneNumberIDs: []string{"phone-1"},
+	})
+	svc := newRewriteOAuthService(repo, states, gates, wa, ts)
+
+	_, pages, err := svc.HandleCallback(context.Background(), callbackReq("code"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(inserted) != 1 || inserted[0].PageID != "page-1" || inserted[0].Peer.Sub != "bot-1" || inserted[0].PageToken != "page-tok-1" {
+		t.Errorf("expected page-1 to be created, got %+v", inserted)
+	}
+	if len(updated) != 1 || updated[0].PageToken != "page-tok-2" {
+		t.Errorf("expected page-2 token to be refreshed, got %+v", updated)
+	}
+	if len(provisioned) != 1 || provisioned[0].BusinessID != "waba-1" || provisioned[0].AccessToken != "biz-tok" {
+		t.Errorf("unexpected whatsapp provisioning: %+v", provisioned)
+	}
+
+	want := map[string]string{"page-1": "gate-new", "page-2": "gate-existing", "phone-1": "gate-wa"}
+	for _, p := range pages {
+		if p.GateID != want[p.PageID] {
+			t.Errorf("asset %s: expected gate %q, got %q", p.PageID, want[p.PageID], p.GateID)
+		}
+	}
+}
+
+func TestHandleCallback_ProvisionSkipsForeignDomainPage(t *testing.T) {
+	ts := provisioningServer()
+	defer ts.Close()
+
+	repo := &mockMetaAppStore{
+		selectFn: func(_ context.Context, _ string) (*fbmodel.MetaApp, error) {
+			return stubMetaApp(), nil
+		},
+	}
+	gates := &mockFacebookStore{
+		selectByAppAndPageFn: func(_ context.Context, _, _ string) (*fbmodel.FacebookGate, error) {
+			g := stubFBGate()
+			g.DomainID = 99
+			return g, nil
+		},
+		updateFn: func(_ context.Context, _ *fbmodel.FacebookGate) error {
+			t.Error("gate of another domain must not be updated")
+			return nil
+		},
+	}
+
+	states := newMemOAuthStateStore()
+	issuedState(states, &fbmodel.OAuthProvision{PageIDs: []string{"page-1"}})
+	svc := newRewriteOAuthService(repo, states, gates, nil, ts)
+
+	_, pages, err := svc.HandleCallback(context.Background(), callbackReq("code"))
+	if err != nil {
+		t.Fatalf("provisioning failures must not fail the callback: %v", err)
+	}
+	if pages[0].GateID != "" {
+		t.Errorf("expected no gate for foreign page, got %s", pages[0].GateID)
+	}
+}
+
 // -- generateSecureState tests --
 
 func TestGenerateSecureState_Length(t *testing.T) {
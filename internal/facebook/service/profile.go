@@ -0,0 +1,295 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"sort"
+
+	sharedstore "github.com/webitel/im-providers-service/internal/core/store"
+	fbmodel "github.com/webitel/im-providers-service/internal/facebook/model"
+)
+
+// Messenger Profile field names used by the delete endpoint.
+const (
+	fieldPersistentMenu = "persistent_menu"
+	fieldGetStarted     = "get_started"
+	fieldGreeting       = "greeting"
+	fieldIceBreakers    = "ice_breakers"
+)
+
+// SetPersistentMenu pushes the localized persistent menu to the Messenger Profile
+// of the gate's page and stores it as the gate configuration.
+func (f *FacebookService) SetPersistentMenu(ctx context.Context, gateID string, menu fbmodel.PersistentMenu) error {
+	if err := menu.Validate(); err != nil {
+		return err
+	}
+
+	return f.updateProfile(ctx, gateID, fieldPersistentMenu,
+		messengerProfilePayload{PersistentMenu: persistentMenuToPayload(menu)},
+		func(p *fbmodel.MessengerProfile) { p.PersistentMenu = menu },
+	)
+}
+
+// GetPersistentMenu returns the menu stored for the gate, or nil when none is configured.
+func (f *FacebookService) GetPersistentMenu(ctx context.Context, gateID string) (fbmodel.PersistentMenu, error) {
+	_, profile, err := f.loadProfile(ctx, gateID)
+	if err != nil {
+		return nil, err
+	}
+	return profile.PersistentMenu, nil
+}
+
+// DeletePersistentMenu removes the persistent_menu field from the Messenger Profile.
+func (f *FacebookService) DeletePersistentMenu(ctx context.Context, gateID string) error {
+	return f.deleteProfileField(ctx, gateID, fieldPersistentMenu,
+		func(p *fbmodel.MessengerProfile) { p.PersistentMenu = nil },
+	)
+}
+
+// SetGetStarted sets the Get Started button payload on the Messenger Profile.
+func (f *FacebookService) SetGetStarted(ctx context.Context, gateID string, payload string) error {
+	return f.updateProfile(ctx, gateID, fieldGetStarted,
+		messengerProfilePayload{GetStarted: &getStartedPayload{Payload: payload}},
+		func(p *fbmodel.MessengerProfile) { p.GetStarted = payload },
+	)
+}
+
+// DeleteGetStarted removes the get_started field from the Messenger Profile.
+func (f *FacebookService) DeleteGetStarted(ctx context.Context, gateID string) error {
+	return f.deleteProfileField(ctx, gateID, fieldGetStarted,
+		func(p *fbmodel.MessengerProfile) { p.GetStarted = "" },
+	)
+}
+
+// SetGreeting sets the localized greeting text shown before the conversation starts.
+func (f *FacebookService) SetGreeting(ctx context.Context, gateID string, greeting map[string]string) error {
+	if err := fbmodel.ValidateGreeting(greeting); err != nil {
+		return err
+	}
+
+	return f.updateProfile(ctx, gateID, fieldGreeting,
+		messengerProfilePayload{Greeting: greetingToPayload(greeting)},
+		func(p *fbmodel.MessengerProfile) { p.Greeting = greeting },
+	)
+}
+
+// DeleteGreeting removes the greeting field from the Messenger Profile.
+func (f *FacebookService) DeleteGreeting(ctx context.Context, gateID string) error {
+	return f.deleteProfileField(ctx, gateID, fieldGreeting,
+		func(p *fbmodel.MessengerProfile) { p.Greeting = nil },
+	)
+}
+
+// SetIceBreakers sets the localized ice breaker questions.
+func (f *FacebookService) SetIceBreakers(ctx context.Context, gateID string, iceBreakers map[string][]fbmodel.IceBreaker) error {
+	if err := fbmodel.ValidateIceBreakers(iceBreakers); err != nil {
+		return err
+	}
+
+	return f.updateProfile(ctx, gateID, fieldIceBreakers,
+		messengerProfilePayload{IceBreakers: iceBreakersToPayload(iceBreakers)},
+		func(p *fbmodel.MessengerProfile) { p.IceBreakers = iceBreakers },
+	)
+}
+
+// DeleteIceBreakers removes the ice_breakers field from the Messenger Profile.
+func (f *FacebookService) DeleteIceBreakers(ctx context.Context, gateID string) error {
+	return f.deleteProfileField(ctx, gateID, fieldIceBreakers,
+		func(p *fbmodel.MessengerProfile) { p.IceBreakers = nil },
+	)
+}
+
+// ReconcileProfiles re-pushes the stored Messenger Profile of every gate linked to the MetaApp.
+// It is meant to run after token rotation; a failing gate does not stop the others.
+func (f *FacebookService) ReconcileProfiles(ctx context.Context, metaAppID string) (*fbmodel.ReconcileResult, error) {
+	gates, err := f.repo.SelectByMetaApp(ctx, metaAppID)
+	if err != nil {
+		f.log.ErrorContext(ctx, "failed to list gates for reconcile", "meta_app_id", metaAppID, "error", err)
+		return nil, err
+	}
+
+	res := &fbmodel.ReconcileResult{}
+	for _, gate := range gates {
+		profile, err := f.profiles.Select(ctx, gate.ID)
+		if errors.Is(err, sharedstore.ErrNotFound) || (err == nil && profile.IsEmpty()) {
+			res.Skipped++
+			continue
+		}
+		if err != nil {
+			f.log.ErrorContext(ctx, "failed to load messenger profile", "gate_id", gate.ID, "error", err)
+			res.Failed = append(res.Failed, gate.ID)
+			continue
+		}
+
+		if err := f.graphAPI.SetMessengerProfile(ctx, gate.PageToken, profileToPayload(profile)); err != nil {
+			f.log.ErrorContext(ctx, "FB API rejected messenger profile", "gate_id", gate.ID, "page_id", gate.PageID, "error", err)
+			res.Failed = append(res.Failed, gate.ID)
+			continue
+		}
+		res.Pushed++
+	}
+
+	f.log.InfoContext(ctx, "messenger profiles reconciled",
+		"meta_app_id", metaAppID, "pushed", res.Pushed, "skipped", res.Skipped, "failed", len(res.Failed))
+	return res, nil
+}
+
+// loadProfile returns the gate and its stored profile. A gate without a stored
+// profile gets an empty one so callers can fill it in.
+func (f *FacebookService) loadProfile(ctx context.Context, gateID string) (*fbmodel.FacebookGate, *fbmodel.MessengerProfile, error) {
+	gate, err := f.repo.Select(ctx, gateID)
+	if err != nil {
+		f.log.ErrorContext(ctx, "failed to fetch gate", "gate_id", gateID, "error", err)
+		return nil, nil, err
+	}
+
+	profile, err := f.profiles.Select(ctx, gateID)
+	if errors.Is(err, sharedstore.ErrNotFound) {
+		return gate, &fbmodel.MessengerProfile{GateID: gateID}, nil
+	}
+	if err != nil {
+		f.log.ErrorContext(ctx, "failed to fetch messenger profile", "gate_id", gateID, "error", err)
+		return nil, nil, err
+	}
+	return gate, profile, nil
+}
+
+// updateProfile pushes a single profile field to Facebook and, once accepted, stores it.
+func (f *FacebookService) updateProfile(
+	ctx context.Context,
+	gateID, field string,
+	payload messengerProfilePayload,
+	apply func(*fbmodel.MessengerProfile),
+) error {
+	gate, profile, err := f.loadProfile(ctx, gateID)
+	if err != nil {
+		return err
+	}
+
+	f.log.InfoContext(ctx, "pushing messenger profile to FB", "gate_id", gateID, "page_id", gate.PageID, "field", field)
+	if err := f.graphAPI.SetMessengerProfile(ctx, gate.PageToken, payload); err != nil {
+		f.log.ErrorContext(ctx, "FB API rejected set messenger profile", "gate_id", gateID, "page_id", gate.PageID, "field", field, "error", err)
+		return err
+	}
+
+	apply(profile)
+	if err := f.profiles.Save(ctx, profile); err != nil {
+		f.log.ErrorContext(ctx, "failed to store messenger profile", "gate_id", gateID, "field", field, "error", err)
+		return err
+	}
+
+	f.log.InfoContext(ctx, "messenger profile set on Facebook", "gate_id", gateID, "page_id", gate.PageID, "field", field)
+	return nil
+}
+
+// deleteProfileField removes a single profile field on Facebook and clears it in storage.
+func (f *FacebookService) deleteProfileField(ctx context.Context, gateID, field string, clear func(*fbmodel.MessengerProfile)) error {
+	gate, profile, err := f.loadProfile(ctx, gateID)
+	if err != nil {
+		return err
+	}
+
+	f.log.InfoContext(ctx, "deleting messenger profile field from FB", "gate_id", gateID, "page_id", gate.PageID, "field", field)
+	if err := f.graphAPI.DeleteMessengerProfile(ctx, gate.PageToken, []string{field}); err != nil {
+		f.log.ErrorContext(ctx, "FB API rejected delete messenger profile", "gate_id", gateID, "page_id", gate.PageID, "field", field, "error", err)
+		return err
+	}
+
+	clear(profile)
+	if err := f.profiles.Save(ctx, profile); err != nil {
+		f.log.ErrorContext(ctx, "failed to store messenger profile", "gate_id", gateID, "field", field, "error", err)
+		return err
+	}
+
+	f.log.InfoContext(ctx, "messenger profile field deleted from Facebook", "gate_id", gateID, "page_id", gate.PageID, "field", field)
+	return nil
+}
+
+// profileToPayload builds the full Messenger Profile from the stored configuration.
+func profileToPayload(p *fbmodel.MessengerProfile) messengerProfilePayload {
+	payload := messengerProfilePayload{
+		PersistentMenu: persistentMenuToPayload(p.PersistentMenu),
+		Greeting:       greetingToPayload(p.Greeting),
+		IceBreakers:    iceBreakersToPayload(p.IceBreakers),
+	}
+	if p.GetStarted != "" {
+		payload.GetStarted = &getStartedPayload{Payload: p.GetStarted}
+	}
+	return payload
+}
+
+func persistentMenuToPayload(menu fbmodel.PersistentMenu) []persistentMenuLocale {
+	var out []persistentMenuLocale
+	for _, locale := range sortedLocales(menu) {
+		lm := menu[locale]
+		out = append(out, persistentMenuLocale{
+			Locale:                locale,
+			ComposerInputDisabled: lm.ComposerInputDisabled,
+			CallToActions:         menuItemsToActions(lm.Items),
+		})
+	}
+	return out
+}
+
+func greetingToPayload(greeting map[string]string) []greetingLocale {
+	var out []greetingLocale
+	for _, locale := range sortedLocales(greeting) {
+		out = append(out, greetingLocale{Locale: locale, Text: greeting[locale]})
+	}
+	return out
+}
+
+func iceBreakersToPayload(iceBreakers map[string][]fbmodel.IceBreaker) []iceBreakersLocale {
+	var out []iceBreakersLocale
+	for _, locale := range sortedLocales(iceBreakers) {
+		out = append(out, iceBreakersLocale{Locale: locale, CallToActions: iceBreakers[locale]})
+	}
+	return out
+}
+
+// menuItemsToActions converts domain menu items to the Graph API call-to-action structure.
+// Items with children become "nested" actions carrying their own call_to_actions.
+func menuItemsToActions(items []fbmodel.MenuItem) []menuAction {
+	actions := make([]menuAction, 0, len(items))
+	for _, item := range items {
+		switch {
+		case len(item.Nested) > 0:
+			actions = append(actions, menuAction{
+				Type:          "nested",
+				Title:         item.Title,
+				CallToActions: menuItemsToActions(item.Nested),
+			})
+		case item.URL != "":
+			// webview_height_ratio is required by the FB API for web_url buttons.
+			actions = append(actions, menuAction{
+				Type:               "web_url",
+				Title:              item.Title,
+				URL:                item.URL,
+				WebviewHeightRatio: "full",
+			})
+		default:
+			actions = append(actions, menuAction{
+				Type:    "postback",
+				Title:   item.Title,
+				Payload: item.Payload,
+			})
+		}
+	}
+	return actions
+}
+
+// sortedLocales returns the map keys with the default locale first and the rest
+// sorted, so the pushed payload is deterministic.
+func sortedLocales[V any](m map[string]V) []string {
+	locales := make([]string, 0, len(m))
+	for locale := range m {
+		if locale != fbmodel.DefaultLocale {
+			locales = append(locales, locale)
+		}
+	}
+	sort.Strings(locales)
+	if _, ok := m[fbmodel.DefaultLocale]; ok {
+		locales = append([]string{fbmodel.DefaultLocale}, locales...)
+	}
+	return locales
+}
@@ -0,0 +1,341 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	sharedstore "github.com/webitel/im-providers-service/internal/core/store"
+	fbmodel "github.com/webitel/im-providers-service/internal/facebook/model"
+	fbstore "github.com/webitel/im-providers-service/internal/facebook/store"
+)
+
+// -- mock profile store --
+
+type memProfileStore struct {
+	profiles map[string]*fbmodel.MessengerProfile
+	saveErr  error
+}
+
+func newMemProfileStore() *memProfileStore {
+	return &memProfileStore{profiles: map[string]*fbmodel.MessengerProfile{}}
+}
+
+func (m *memProfileStore) Select(_ context.Context, gateID string) (*fbmodel.MessengerProfile, error) {
+	p, ok := m.profiles[gateID]
+	if !ok {
+		return nil, sharedstore.ErrNotFound
+	}
+	cp := *p
+	return &cp, nil
+}
+
+func (m *memProfileStore) Save(_ context.Context, p *fbmodel.MessengerProfile) error {
+	if m.saveErr != nil {
+		return m.saveErr
+	}
+	cp := *p
+	m.profiles[p.GateID] = &cp
+	return nil
+}
+
+var _ fbstore.MessengerProfileStore = (*memProfileStore)(nil)
+
+// -- recording Graph API --
+
+type recordingProfileAPI struct {
+	sets    []map[string]any
+	deletes [][]string
+	tokens  []string
+	setErr  error
+}
+
+func (r *recordingProfileAPI) SetMessengerProfile(_ context.Context, token string, profile any) error {
+	if r.setErr != nil {
+		return r.setErr
+	}
+	// Round-trip through JSON to assert on the wire format.
+	raw, _ := json.Marshal(profile)
+	var m map[string]any
+	_ = json.Unmarshal(raw, &m)
+	r.sets = append(r.sets, m)
+	r.tokens = append(r.tokens, token)
+	return nil
+}
+
+func (r *recordingProfileAPI) DeleteMessengerProfile(_ context.Context, _ string, fields []string) error {
+	r.deletes = append(r.deletes, fields)
+	return nil
+}
+
+func newProfileService(profiles *memProfileStore, api *recordingProfileAPI) *FacebookService {
+	repo := &mockFacebookStore{
+		selectFn: func(_ context.Context, id string) (*fbmodel.FacebookGate, error) {
+			g := stubFBGate()
+			g.ID = id
+			return g, nil
+		},
+	}
+	return NewFacebookService(repo, profiles, api, noopLogger)
+}
+
+func twoLocaleMenu() fbmodel.PersistentMenu {
+	return fbmodel.PersistentMenu{
+		"uk_UA": {Items: []fbmodel.MenuItem{{Title: "Допомога", Payload: "HELP"}}},
+		fbmodel.DefaultLocale: {
+			ComposerInputDisabled: true,
+			Items: []fbmodel.MenuItem{
+				{Title: "Help", Payload: "HELP"},
+				{Title: "More", Nested: []fbmodel.MenuItem{
+					{Title: "Site", URL: "https://example.com"},
+				}},
+			},
+		},
+	}
+}
+
+// -- tests --
+
+func TestFacebookService_SetPersistentMenu_PerLocale(t *testing.T) {
+	profiles := newMemProfileStore()
+	api := &recordingProfileAPI{}
+	svc := newProfileService(profiles, api)
+
+	if err := svc.SetPersistentMenu(context.Background(), "gate-1", twoLocaleMenu()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(api.sets) != 1 {
+		t.Fatalf("expected 1 push, got %d", len(api.sets))
+	}
+
+	locales := api.sets[0]["persistent_menu"].([]any)
+	if len(locales) != 2 {
+		t.Fatalf("expected 2 locale blocks, got %d", len(locales))
+	}
+	first := locales[0].(map[string]any)
+	if first["locale"] != "default" {
+		t.Errorf("expected default locale first, got %v", first["locale"])
+	}
+	if first["composer_input_disabled"] != true {
+		t.Error("expected composer_input_disabled on default locale")
+	}
+	if locales[1].(map[string]any)["locale"] != "uk_UA" {
+		t.Errorf("unexpected second locale: %v", locales[1])
+	}
+
+	actions := first["call_to_actions"].([]any)
+	nested := actions[1].(map[string]any)
+	if nested["type"] != "nested" {
+		t.Fatalf("expected nested action, got %v", nested["type"])
+	}
+	children := nested["call_to_actions"].([]any)
+	if len(children) != 1 || children[0].(map[string]any)["type"] != "web_url" {
+		t.Errorf("unexpected nested children: %v", children)
+	}
+
+	got, err := svc.GetPersistentMenu(context.Background(), "gate-1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(got) != 2 || len(got[fbmodel.DefaultLocale].Items) != 2 {
+		t.Errorf("stored menu mismatch: %+v", got)
+	}
+}
+
+func TestFacebookService_SetPersistentMenu_Validation(t *testing.T) {
+	deep := []fbmodel.MenuItem{{Title: "1", Nested: []fbmodel.MenuItem{{Title: "2", Nested: []fbmodel.MenuItem{
+		{Title: "3", Nested: []fbmodel.MenuItem{{Title: "4", Payload: "P"}}},
+	}}}}}
+
+	tests := []struct {
+		name string
+		menu fbmodel.PersistentMenu
+	}{
+		{"missing default", fbmodel.PersistentMenu{"en_US": {Items: []fbmodel.MenuItem{{Title: "A", Payload: "A"}}}}},
+		{"empty items", fbmodel.PersistentMenu{fbmodel.DefaultLocale: {}}},
+		{"missing title", fbmodel.PersistentMenu{fbmodel.DefaultLocale: {Items: []fbmodel.MenuItem{{Payload: "A"}}}}},
+		{"too deep", fbmodel.PersistentMenu{fbmodel.DefaultLocale: {Items: deep}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			api := &recordingProfileAPI{}
+			svc := newProfileService(newMemProfileStore(), api)
+
+			err := svc.SetPersistentMenu(context.Background(), "gate-1", tt.menu)
+			var vErr *fbmodel.ValidationError
+			if !errors.As(err, &vErr) {
+				t.Fatalf("expected ValidationError, got %v", err)
+			}
+			if len(api.sets) != 0 {
+				t.Error("invalid menu must not be pushed")
+			}
+		})
+	}
+}
+
+func TestFacebookService_SetPersistentMenu_APIErrorNotStored(t *testing.T) {
+	profiles := newMemProfileStore()
+	svc := newProfileService(profiles, &recordingProfileAPI{setErr: errors.New("graph down")})
+
+	if err := svc.SetPersistentMenu(context.Background(), "gate-1", twoLocaleMenu()); err == nil {
+		t.Fatal("expected error")
+	}
+	if _, ok := profiles.profiles["gate-1"]; ok {
+		t.Error("rejected menu must not be stored")
+	}
+}
+
+func TestFacebookService_GetPersistentMenu_NotConfigured(t *testing.T) {
+	svc := newProfileService(newMemProfileStore(), &recordingProfileAPI{})
+
+	menu, err := svc.GetPersistentMenu(context.Background(), "gate-1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if menu != nil {
+		t.Errorf("expected nil menu, got %+v", menu)
+	}
+}
+
+func TestFacebookService_DeletePersistentMenu_KeepsOtherFields(t *testing.T) {
+	profiles := newMemProfileStore()
+	profiles.profiles["gate-1"] = &fbmodel.MessengerProfile{
+		GateID:         "gate-1",
+		PersistentMenu: twoLocaleMenu(),
+		GetStarted:     "START",
+	}
+	api := &recordingProfileAPI{}
+	svc := newProfileService(profiles, api)
+
+	if err := svc.DeletePersistentMenu(context.Background(), "gate-1"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(api.deletes) != 1 || api.deletes[0][0] != "persistent_menu" {
+		t.Errorf("unexpected delete fields: %v", api.deletes)
+	}
+	stored := profiles.profiles["gate-1"]
+	if stored.PersistentMenu != nil || stored.GetStarted != "START" {
+		t.Errorf("unexpected stored profile: %+v", stored)
+	}
+}
+
+func TestFacebookService_SetGreetingAndIceBreakers(t *testing.T) {
+	profiles := newMemProfileStore()
+	api := &recordingProfileAPI{}
+	svc := newProfileService(profiles, api)
+	ctx := context.Background()
+
+	if err := svc.SetGreeting(ctx, "gate-1", map[string]string{"default": "Hi {{user_first_name}}"}); err != nil {
+		t.Fatalf("greeting: %v", err)
+	}
+	iceBreakers := map[string][]fbmodel.IceBreaker{
+		"default": {{Question: "Prices?", Payload: "PRICES"}},
+	}
+	if err := svc.SetIceBreakers(ctx, "gate-1", iceBreakers); err != nil {
+		t.Fatalf("ice breakers: %v", err)
+	}
+
+	greeting := api.sets[0]["greeting"].([]any)[0].(map[string]any)
+	if greeting["locale"] != "default" || greeting["text"] != "Hi {{user_first_name}}" {
+		t.Errorf("unexpected greeting payload: %v", greeting)
+	}
+	ib := api.sets[1]["ice_breakers"].([]any)[0].(map[string]any)
+	cta := ib["call_to_actions"].([]any)[0].(map[string]any)
+	if cta["question"] != "Prices?" || cta["payload"] != "PRICES" {
+		t.Errorf("unexpected ice breaker payload: %v", ib)
+	}
+
+	stored := profiles.profiles["gate-1"]
+	if stored.Greeting["default"] == "" || len(stored.IceBreakers["default"]) != 1 {
+		t.Errorf("profile not accumulated: %+v", stored)
+	}
+}
+
+func TestFacebookService_SetIceBreakers_Validation(t *testing.T) {
+	svc := newProfileService(newMemProfileStore(), &recordingProfileAPI{})
+
+	tooMany := make([]fbmodel.IceBreaker, fbmodel.MaxIceBreakers+1)
+	for i := range tooMany {
+		tooMany[i] = fbmodel.IceBreaker{Question: "Q", Payload: "P"}
+	}
+
+	for name, set := range map[string]map[string][]fbmodel.IceBreaker{
+		"missing default": {"en_US": {{Question: "Q", Payload: "P"}}},
+		"too many":        {"default": tooMany},
+		"missing payload": {"default": {{Question: "Q"}}},
+	} {
+		t.Run(name, func(t *testing.T) {
+			var vErr *fbmodel.ValidationError
+			if err := svc.SetIceBreakers(context.Background(), "gate-1", set); !errors.As(err, &vErr) {
+				t.Fatalf("expected ValidationError, got %v", err)
+			}
+		})
+	}
+}
+
+func TestFacebookService_ReconcileProfiles(t *testing.T) {
+	profiles := newMemProfileStore()
+	profiles.profiles["gate-1"] = &fbmodel.MessengerProfile{
+		GateID:         "gate-1",
+		PersistentMenu: twoLocaleMenu(),
+		GetStarted:     "START",
+	}
+	profiles.profiles["gate-3"] = &fbmodel.MessengerProfile{GateID: "gate-3"}
+
+	repo := &mockFacebookStore{
+		selectByMetaAppFn: func(_ context.Context, metaAppID string) ([]*fbmodel.FacebookGate, error) {
+			if metaAppID != "app-1" {
+				t.Errorf("unexpected meta app: %s", metaAppID)
+			}
+			var gates []*fbmodel.FacebookGate
+			for _, id := range []string{"gate-1", "gate-2", "gate-3"} {
+				g := stubFBGate()
+				g.ID, g.PageToken = id, "rotated-"+id
+				gates = append(gates, g)
+			}
+			return gates, nil
+		},
+	}
+	api := &recordingProfileAPI{}
+	svc := NewFacebookService(repo, profiles, api, noopLogger)
+
+	res, err := svc.ReconcileProfiles(context.Background(), "app-1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if res.Pushed != 1 || res.Skipped != 2 || len(res.Failed) != 0 {
+		t.Errorf("unexpected result: %+v", res)
+	}
+	if len(api.tokens) != 1 || api.tokens[0] != "rotated-gate-1" {
+		t.Errorf("expected push with rotated token, got %v", api.tokens)
+	}
+	if _, ok := api.sets[0]["get_started"]; !ok {
+		t.Error("expected full profile including get_started")
+	}
+}
+
+func TestFacebookService_ReconcileProfiles_ContinuesOnFailure(t *testing.T) {
+	profiles := newMemProfileStore()
+	for _, id := range []string{"gate-1", "gate-2"} {
+		profiles.profiles[id] = &fbmodel.MessengerProfile{GateID: id, GetStarted: "START"}
+	}
+
+	repo := &mockFacebookStore{
+		selectByMetaAppFn: func(_ context.Context, _ string) ([]*fbmodel.FacebookGate, error) {
+			a, b := stubFBGate(), stubFBGate()
+			a.ID, b.ID = "gate-1", "gate-2"
+			return []*fbmodel.FacebookGate{a, b}, nil
+		},
+	}
+	svc := NewFacebookService(repo, profiles, &recordingProfileAPI{setErr: errors.New("token expired")}, noopLogger)
+
+	res, err := svc.ReconcileProfiles(context.Background(), "app-1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(res.Failed) != 2 || res.Pushed != 0 {
+		t.Errorf("unexpected result: %+v", res)
+	}
+}
@@ -0,0 +1,61 @@
+package store
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	fbmodel "github.com/webitel/im-providers-service/internal/facebook/model"
+)
+
+var _ CallbackStore = (*redisCallbackStore)(nil)
+
+type redisCallbackStore struct {
+	rdb  *redis.Client
+	ttl  time.Duration
+	keep int64
+}
+
+// NewRedisCallbackStore initializes the Redis-based keyboard storage. A conversation
+// keeps its latest keep keyboards and expires ttl after the last one was sent.
+func NewRedisCallbackStore(rdb *redis.Client, ttl time.Duration, keep int) CallbackStore {
+	return &redisCallbackStore{rdb: rdb, ttl: ttl, keep: int64(keep)}
+}
+
+func (r *redisCallbackStore) Push(ctx context.Context, gateID, psid string, kb *fbmodel.SentKeyboard) error {
+	raw, err := json.Marshal(kb)
+	if err != nil {
+		return fmt.Errorf("redis: marshal keyboard: %w", err)
+	}
+
+	key := callbackKey(gateID, psid)
+	_, err = r.rdb.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.LPush(ctx, key, raw)
+		pipe.LTrim(ctx, key, 0, r.keep-1)
+		pipe.Expire(ctx, key, r.ttl)
+		return nil
+	})
+	return err
+}
+
+func (r *redisCallbackStore) List(ctx context.Context, gateID, psid string) ([]*fbmodel.SentKeyboard, error) {
+	raw, err := r.rdb.LRange(ctx, callbackKey(gateID, psid), 0, -1).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	sent := make([]*fbmodel.SentKeyboard, 0, len(raw))
+	for _, v := range raw {
+		var kb fbmodel.SentKeyboard
+		if err := json.Unmarshal([]byte(v), &kb); err != nil {
+			return nil, fmt.Errorf("redis: unmarshal keyboard: %w", err)
+		}
+		sent = append(sent, &kb)
+	}
+	return sent, nil
+}
+
+// Key format: fb:callbacks:<gate_id>:<psid>
+func callbackKey(gateID, psid string) string { return "fb:callbacks:" + gateID + ":" + psid }
@@ -0,0 +1,50 @@
+package store
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	sharedstore "github.com/webitel/im-providers-service/internal/core/store"
+	fbmodel "github.com/webitel/im-providers-service/internal/facebook/model"
+)
+
+var _ OAuthStateStore = (*redisOAuthStateStore)(nil)
+
+type redisOAuthStateStore struct {
+	rdb *redis.Client
+}
+
+// NewRedisOAuthStateStore initializes the Redis-based OAuth state storage.
+func NewRedisOAuthStateStore(rdb *redis.Client) OAuthStateStore {
+	return &redisOAuthStateStore{rdb: rdb}
+}
+
+func (r *redisOAuthStateStore) Save(ctx context.Context, state string, s *fbmodel.OAuthState, ttl time.Duration) error {
+	raw, err := json.Marshal(s)
+	if err != nil {
+		return fmt.Errorf("redis: marshal oauth state: %w", err)
+	}
+	// Key format: oauth:state:<state>
+	return r.rdb.Set(ctx, "oauth:state:"+state, raw, ttl).Err()
+}
+
+func (r *redisOAuthStateStore) Consume(ctx context.Context, state string) (*fbmodel.OAuthState, error) {
+	// GETDEL makes the state single-use even under concurrent callbacks.
+	raw, err := r.rdb.GetDel(ctx, "oauth:state:"+state).Bytes()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return nil, sharedstore.ErrNotFound
+		}
+		return nil, err
+	}
+
+	var s fbmodel.OAuthState
+	if err := json.Unmarshal(raw, &s); err != nil {
+		return nil, fmt.Errorf("redis: unmarshal oauth state: %w", err)
+	}
+	return &s, nil
+}
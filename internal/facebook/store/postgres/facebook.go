@@ -130,6 +130,75 @@ func (s *facebookStore) SelectByPageAndURI(ctx context.Context, pageID, uri stri
 	return &g, nil
 }
 
+func (s *facebookStore) SelectByMetaAppAndPage(ctx context.Context, metaAppID, pageID string) (*fbmodel.FacebookGate, error) {
+	const query = `
+	SELECT
+		g.id,
+		g.dc AS domain_id,
+		g.name,
+		g.enabled,
+		g.created_at,
+		g.updated_at,
+		b.sub AS "peer.sub",
+		b.iss AS "peer.iss",
+		fb.meta_app_id,
+		fb.page_id,
+		fb.page_token
+	FROM im_provider.gates g
+	JOIN im_provider.bots b ON g.id = b.gate_id
+	JOIN im_provider.facebook fb ON g.id = fb.gate_id
+	WHERE fb.meta_app_id = $1 AND fb.page_id = $2`
+
+	var g fbmodel.FacebookGate
+	if err := pgxscan.Get(ctx, s.pool, &g, query, metaAppID, pageID); err != nil {
+		if pgxscan.NotFound(err) {
+			return nil, sharedstore.ErrNotFound
+		}
+		return nil, fmt.Errorf("postgres: select by meta_app_id and page_id: %w", err)
+	}
+
+	if dec, err := s.crypto.Decrypt(g.PageToken); err == nil {
+		g.PageToken = dec
+	}
+
+	s.mapVirtualFields(&g)
+	return &g, nil
+}
+
+func (s *facebookStore) SelectByMetaApp(ctx context.Context, metaAppID string) ([]*fbmodel.FacebookGate, error) {
+	const query = `
+	SELECT
+		g.id,
+		g.dc AS domain_id,
+		g.name,
+		g.enabled,
+		g.created_at,
+		g.updated_at,
+		b.sub AS "peer.sub",
+		b.iss AS "peer.iss",
+		fb.meta_app_id,
+		fb.page_id,
+		fb.page_token
+	FROM im_provider.gates g
+	JOIN im_provider.bots b ON g.id = b.gate_id
+	JOIN im_provider.facebook fb ON g.id = fb.gate_id
+	WHERE fb.meta_app_id = $1
+	ORDER BY g.created_at`
+
+	var gates []*fbmodel.FacebookGate
+	if err := pgxscan.Select(ctx, s.pool, &gates, query, metaAppID); err != nil {
+		return nil, fmt.Errorf("postgres: select by meta_app_id: %w", err)
+	}
+
+	for _, g := range gates {
+		if dec, err := s.crypto.Decrypt(g.PageToken); err == nil {
+			g.PageToken = dec
+		}
+		s.mapVirtualFields(g)
+	}
+	return gates, nil
+}
+
 func (s *facebookStore) Update(ctx context.Context, g *fbmodel.FacebookGate) error {
 	token, err := s.crypto.Encrypt(g.PageToken)
 	if err != nil {
@@ -0,0 +1,64 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/georgysavva/scany/v2/pgxscan"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	sharedstore "github.com/webitel/im-providers-service/internal/core/store"
+	fbmodel "github.com/webitel/im-providers-service/internal/facebook/model"
+	fbstore "github.com/webitel/im-providers-service/internal/facebook/store"
+)
+
+var _ fbstore.MessengerProfileStore = (*profileStore)(nil)
+
+type profileStore struct {
+	pool *pgxpool.Pool
+}
+
+func NewMessengerProfileStore(pool *pgxpool.Pool) fbstore.MessengerProfileStore {
+	return &profileStore{pool: pool}
+}
+
+func (s *profileStore) Select(ctx context.Context, gateID string) (*fbmodel.MessengerProfile, error) {
+	const query = `
+	SELECT gate_id, persistent_menu, get_started, greeting, ice_breakers, updated_at
+	FROM im_provider.facebook_profile
+	WHERE gate_id = $1`
+
+	var p fbmodel.MessengerProfile
+	if err := pgxscan.Get(ctx, s.pool, &p, query, gateID); err != nil {
+		if pgxscan.NotFound(err) {
+			return nil, sharedstore.ErrNotFound
+		}
+		return nil, fmt.Errorf("postgres: select facebook profile: %w", err)
+	}
+	return &p, nil
+}
+
+func (s *profileStore) Save(ctx context.Context, p *fbmodel.MessengerProfile) error {
+	const query = `
+	INSERT INTO im_provider.facebook_profile (gate_id, persistent_menu, get_started, greeting, ice_breakers)
+	VALUES (@gate_id, @persistent_menu, @get_started, @greeting, @ice_breakers)
+	ON CONFLICT (gate_id) DO UPDATE SET
+		persistent_menu = EXCLUDED.persistent_menu,
+		get_started     = EXCLUDED.get_started,
+		greeting        = EXCLUDED.greeting,
+		ice_breakers    = EXCLUDED.ice_breakers
+	RETURNING updated_at`
+
+	args := pgx.NamedArgs{
+		"gate_id":         p.GateID,
+		"persistent_menu": p.PersistentMenu,
+		"get_started":     p.GetStarted,
+		"greeting":        p.Greeting,
+		"ice_breakers":    p.IceBreakers,
+	}
+
+	if err := s.pool.QueryRow(ctx, query, args).Scan(&p.UpdatedAt); err != nil {
+		return fmt.Errorf("postgres: save facebook profile: %w", err)
+	}
+	return nil
+}
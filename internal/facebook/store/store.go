@@ -2,6 +2,7 @@ package store
 
 import (
 	"context"
+	"time"
 
 	fbmodel "github.com/webitel/im-providers-service/internal/facebook/model"
 )
@@ -12,10 +13,38 @@ type FacebookStore interface {
 	Insert(ctx context.Context, dc int64, g *fbmodel.FacebookGate) error
 	Select(ctx context.Context, id string) (*fbmodel.FacebookGate, error)
 	SelectByPageAndURI(ctx context.Context, pageID, uri string) (*fbmodel.FacebookGate, error)
+	// SelectByMetaAppAndPage returns the gate bound to the page within the given MetaApp.
+	SelectByMetaAppAndPage(ctx context.Context, metaAppID, pageID string) (*fbmodel.FacebookGate, error)
+	// SelectByMetaApp returns every gate linked to the MetaApp.
+	SelectByMetaApp(ctx context.Context, metaAppID string) ([]*fbmodel.FacebookGate, error)
 	Update(ctx context.Context, g *fbmodel.FacebookGate) error
 	Unbind(ctx context.Context, gateID string) error
 }
 
+// MessengerProfileStore persists the Messenger Profile configured per gate.
+type MessengerProfileStore interface {
+	// Select returns ErrNotFound when nothing was configured for the gate yet.
+	Select(ctx context.Context, gateID string) (*fbmodel.MessengerProfile, error)
+	// Save creates or replaces the whole profile of the gate.
+	Save(ctx context.Context, p *fbmodel.MessengerProfile) error
+}
+
+// UserProfileCache keeps Graph API user profiles to spare a profile request per inbound event.
+type UserProfileCache interface {
+	// Get returns ErrNotFound on a cache miss.
+	Get(ctx context.Context, pageID, psid string) (*fbmodel.UserProfile, error)
+	Set(ctx context.Context, pageID, psid string, p *fbmodel.UserProfile) error
+}
+
+// CallbackStore keeps the interactive messages sent to a conversation, so button
+// taps can be correlated with the message that carried the button on any replica.
+type CallbackStore interface {
+	// Push records kb as the newest keyboard of the conversation.
+	Push(ctx context.Context, gateID, psid string, kb *fbmodel.SentKeyboard) error
+	// List returns the keyboards of the conversation, newest first.
+	List(ctx context.Context, gateID, psid string) ([]*fbmodel.SentKeyboard, error)
+}
+
 // MetaAppStore manages shared technical credentials for the Meta API.
 type MetaAppStore interface {
 	Insert(ctx context.Context, a *fbmodel.MetaApp) error
@@ -24,3 +53,12 @@ type MetaAppStore interface {
 	Update(ctx context.Context, a *fbmodel.MetaApp) error
 	Delete(ctx context.Context, id string) error
 }
+
+// OAuthStateStore keeps short-lived OAuth states issued by StartOAuth.
+type OAuthStateStore interface {
+	// Save stores the state record; it expires after ttl.
+	Save(ctx context.Context, state string, s *fbmodel.OAuthState, ttl time.Duration) error
+	// Consume atomically fetches and removes the state record.
+	// Returns ErrNotFound when the state is unknown, expired or already consumed.
+	Consume(ctx context.Context, state string) (*fbmodel.OAuthState, error)
+}
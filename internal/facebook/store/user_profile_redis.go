@@ -0,0 +1,52 @@
+package store
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	sharedstore "github.com/webitel/im-providers-service/internal/core/store"
+	fbmodel "github.com/webitel/im-providers-service/internal/facebook/model"
+)
+
+var _ UserProfileCache = (*redisUserProfileCache)(nil)
+
+type redisUserProfileCache struct {
+	rdb *redis.Client
+	ttl time.Duration
+}
+
+// NewRedisUserProfileCache initializes the Redis-based Facebook user profile cache.
+func NewRedisUserProfileCache(rdb *redis.Client, ttl time.Duration) UserProfileCache {
+	return &redisUserProfileCache{rdb: rdb, ttl: ttl}
+}
+
+func (r *redisUserProfileCache) Get(ctx context.Context, pageID, psid string) (*fbmodel.UserProfile, error) {
+	raw, err := r.rdb.Get(ctx, profileKey(pageID, psid)).Bytes()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return nil, sharedstore.ErrNotFound
+		}
+		return nil, err
+	}
+
+	var p fbmodel.UserProfile
+	if err := json.Unmarshal(raw, &p); err != nil {
+		return nil, fmt.Errorf("redis: unmarshal user profile: %w", err)
+	}
+	return &p, nil
+}
+
+func (r *redisUserProfileCache) Set(ctx context.Context, pageID, psid string, p *fbmodel.UserProfile) error {
+	raw, err := json.Marshal(p)
+	if err != nil {
+		return fmt.Errorf("redis: marshal user profile: %w", err)
+	}
+	return r.rdb.Set(ctx, profileKey(pageID, psid), raw, r.ttl).Err()
+}
+
+// Key format: fb:profile:<page_id>:<psid>
+func profileKey(pageID, psid string) string { return "fb:profile:" + pageID + ":" + psid }
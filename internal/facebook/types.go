@@ -33,6 +33,7 @@ type InboundMessage struct {
 	Mid         string       `json:"mid"`
 	Text        string       `json:"text,omitempty"`
 	Attachments []Attachment `json:"attachments,omitempty"`
+	QuickReply  *QuickReply  `json:"quick_reply,omitempty"`
 	// IsEcho is true for messages sent by the page itself via the Send API.
 	// https://developers.facebook.com/documentation/business-messaging/messenger-platform/webhooks/webhook-events/message-echoes
 	IsEcho bool `json:"is_echo,omitempty"`
@@ -49,6 +50,12 @@ type AttachmentPayload struct {
 	Name  string `json:"name,omitempty"`
 }
 
+// QuickReply carries the payload of a tapped quick reply. Text holds the button title.
+// https://developers.facebook.com/docs/messenger-platform/reference/webhook-events/messages#quick-reply
+type QuickReply struct {
+	Payload string `json:"payload"`
+}
+
 // Postback is sent when a user taps a persistent-menu or template button.
 // https://developers.facebook.com/docs/messenger-platform/reference/webhook-events/messaging-postbacks
 type Postback struct {
@@ -70,17 +77,6 @@ func (r *WebhookRequest) AllMessages() []Messaging {
 	return out
 }
 
-// UserProfile holds the fields returned by the Graph API user node.
-// https://developers.facebook.com/docs/messenger-platform/identity/user-profile#fields
-type UserProfile struct {
-	ID         string `json:"id"`
-	FirstName  string `json:"first_name"`
-	LastName   string `json:"last_name"`
-	ProfilePic string `json:"profile_pic"`
-	Locale     string `json:"locale"`
-	Timezone   int    `json:"timezone"`
-}
-
 // VerifyRequest holds parameters for the Facebook webhook verification handshake.
 // https://developers.facebook.com/docs/messenger-platform/webhooks#verification-requests
 type VerifyRequest struct {
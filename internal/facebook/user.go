@@ -3,23 +3,32 @@ package facebook
 import (
 	"context"
 	"fmt"
+	"maps"
 
+	contactv1 "github.com/webitel/im-providers-service/gen/go/contact/v1"
 	gatewayv1 "github.com/webitel/im-providers-service/gen/go/gateway/v1"
 	grpcclient "github.com/webitel/im-providers-service/infra/client/grpc"
 	sharedmodel "github.com/webitel/im-providers-service/internal/core/model"
 	fbmodel "github.com/webitel/im-providers-service/internal/facebook/model"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
 )
 
+// metadataProfilePic is the contact metadata key holding the Messenger profile picture URL.
+const metadataProfilePic = "profile_pic"
+
 // syncContact resolves the internal contact for a Facebook user, creating it
 // if necessary. The result is cached so repeated webhook deliveries from the
-// same PSID skip the gateway round-trip.
+// same PSID skip the gateway round-trip. An unresolved profile (a transient
+// Graph API failure) is never cached: the contact carries a PSID placeholder
+// name until a later event reads the profile and renames it.
 func (p *facebookProvider) syncContact(
 	ctx context.Context,
 	gate *fbmodel.FacebookGate,
 	psid string,
-	profile *UserProfile,
+	profile *fbmodel.UserProfile,
+	resolved bool,
 ) (*gatewayv1.Contact, error) {
 	user := toExternalUser(psid, profile)
 
@@ -29,12 +38,15 @@ func (p *facebookProvider) syncContact(
 
 	authCtx := withGatewayIdentity(ctx, gate)
 
-	contact, err := p.ensureContact(authCtx, user)
+	contact, err := p.ensureContact(authCtx, gate, user, profile)
 	if err != nil {
 		return nil, err
 	}
 
 	p.ensureVia(authCtx, &psid, &contact.Iss, gate.ID)
+	if !resolved {
+		return contact, nil
+	}
 	_ = p.userCache.MarkKnown(ctx, user)
 	if profile.Locale != "" {
 		_ = p.userCache.SetLocale(ctx, gate.ID, psid, profile.Locale)
@@ -43,15 +55,36 @@ func (p *facebookProvider) syncContact(
 	return contact, nil
 }
 
-// ensureContact creates the internal contact or returns a stub when the
-// contact already exists (idempotent by design on the gateway side).
-func (p *facebookProvider) ensureContact(ctx context.Context, user *sharedmodel.ExternalUser) (*gatewayv1.Contact, error) {
+// ensureContact returns the internal contact of the user, creating it when missing.
+// An existing contact is looked up first and brought in line with the profile, so
+// an uncached user costs a single im-contact round-trip.
+func (p *facebookProvider) ensureContact(ctx context.Context, gate *fbmodel.FacebookGate, user *sharedmodel.ExternalUser, profile *fbmodel.UserProfile) (*gatewayv1.Contact, error) {
+	resp, err := p.contactClient.SearchContact(ctx, &contactv1.SearchContactRequest{
+		Subjects: []string{user.ID},
+		IssId:    []string{p.Type()},
+		DomainId: int32(gate.DomainID),
+		Size:     1,
+	})
+	if err != nil {
+		// Creation is idempotent, so a failed lookup only costs the profile refresh.
+		p.logger.Warn("contact lookup failed", "psid", user.ID, "err", err)
+	} else if len(resp.GetContacts()) > 0 {
+		p.refreshContact(ctx, resp.GetContacts()[0], user, profile)
+		return &gatewayv1.Contact{Sub: user.ID, Iss: p.Type()}, nil
+	}
+
+	var metadata map[string]string
+	if profile.ProfilePic != "" {
+		metadata = map[string]string{metadataProfilePic: profile.ProfilePic}
+	}
+
 	contact, err := p.gatewayer.Create(ctx, &gatewayv1.CreateContactRequest{
 		IssId:    p.Type(),
 		Type:     p.Type(),
 		Name:     user.FirstName,
 		Username: user.LastName,
 		Subject:  user.ID,
+		Metadata: metadata,
 	})
 	if err != nil {
 		if isAlreadyExists(err) {
@@ -62,6 +95,48 @@ func (p *facebookProvider) ensureContact(ctx context.Context, user *sharedmodel.
 	return contact, nil
 }
 
+// refreshContact patches an existing contact with the current profile.
+// Errors are non-fatal: name and picture are cosmetic and must not block the message.
+func (p *facebookProvider) refreshContact(ctx context.Context, contact *contactv1.Contact, user *sharedmodel.ExternalUser, profile *fbmodel.UserProfile) {
+	req := contactPatch(contact, user, profile.ProfilePic)
+	if req == nil {
+		return
+	}
+	if _, err := p.contactClient.PatchContact(ctx, req); err != nil {
+		p.logger.Warn("contact refresh: patch failed", "psid", user.ID, "contact_id", contact.GetId(), "err", err)
+	}
+}
+
+// contactPatch returns the update bringing an existing contact in line with the
+// profile, or nil when it is up to date. Only a PSID placeholder name is replaced,
+// so a name set by an operator is kept. Facebook rotates picture URLs, so a
+// changed picture is always patched in.
+func contactPatch(contact *contactv1.Contact, user *sharedmodel.ExternalUser, pic string) *contactv1.PatchContactRequest {
+	req := &contactv1.PatchContactRequest{
+		Id:        contact.GetId(),
+		DomainId:  contact.GetDomainId(),
+		FieldMask: &fieldmaskpb.FieldMask{},
+	}
+
+	if contact.GetName() == user.ID && user.FirstName != user.ID {
+		req.Name = user.FirstName
+		req.Username = user.LastName
+		req.FieldMask.Paths = append(req.FieldMask.Paths, "name", "username")
+	}
+
+	if pic != "" && contact.GetMetadata()[metadataProfilePic] != pic {
+		req.Metadata = make(map[string]string, len(contact.GetMetadata())+1)
+		maps.Copy(req.Metadata, contact.GetMetadata())
+		req.Metadata[metadataProfilePic] = pic
+		req.FieldMask.Paths = append(req.FieldMask.Paths, "metadata")
+	}
+
+	if len(req.FieldMask.Paths) == 0 {
+		return nil
+	}
+	return req
+}
+
 // ensureVia links the gate to the internal contact as a "via" channel.
 // Errors are non-fatal — AlreadyExists is silently ignored.
 func (p *facebookProvider) ensureVia(ctx context.Context, contactSub, contactIss *string, gateID string) {
@@ -78,10 +153,15 @@ func (p *facebookProvider) ensureVia(ctx context.Context, contactSub, contactIss
 }
 
 // toExternalUser maps a Facebook user profile to the domain cache key.
-func toExternalUser(psid string, profile *UserProfile) *sharedmodel.ExternalUser {
+// A PSID-only profile falls back to the PSID as the display name.
+func toExternalUser(psid string, profile *fbmodel.UserProfile) *sharedmodel.ExternalUser {
+	firstName := profile.FirstName
+	if firstName == "" && profile.LastName == "" {
+		firstName = psid
+	}
 	return &sharedmodel.ExternalUser{
 		ID:        psid,
-		FirstName: profile.FirstName,
+		FirstName: firstName,
 		LastName:  profile.LastName,
 	}
 }
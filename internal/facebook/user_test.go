@@ -0,0 +1,185 @@
+package facebook
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"slices"
+	"testing"
+
+	contactv1 "github.com/webitel/im-providers-service/gen/go/contact/v1"
+	sharedmodel "github.com/webitel/im-providers-service/internal/core/model"
+	sharedstore "github.com/webitel/im-providers-service/internal/core/store"
+	fbmodel "github.com/webitel/im-providers-service/internal/facebook/model"
+	fbstore "github.com/webitel/im-providers-service/internal/facebook/store"
+)
+
+// profileAPI stubs the Graph API; only GetUserProfile is expected to be called.
+type profileAPI struct {
+	graphAPI
+	calls   int
+	profile *fbmodel.UserProfile
+	err     error
+}
+
+func (a *profileAPI) GetUserProfile(_ context.Context, _, _ string) (*fbmodel.UserProfile, error) {
+	a.calls++
+	return a.profile, a.err
+}
+
+type memUserProfileCache struct {
+	profiles map[string]*fbmodel.UserProfile
+}
+
+func (m *memUserProfileCache) Get(_ context.Context, pageID, psid string) (*fbmodel.UserProfile, error) {
+	p, ok := m.profiles[pageID+":"+psid]
+	if !ok {
+		return nil, sharedstore.ErrNotFound
+	}
+	return p, nil
+}
+
+func (m *memUserProfileCache) Set(_ context.Context, pageID, psid string, p *fbmodel.UserProfile) error {
+	m.profiles[pageID+":"+psid] = p
+	return nil
+}
+
+var _ fbstore.UserProfileCache = (*memUserProfileCache)(nil)
+
+func newProfileTestProvider(api *profileAPI) (*facebookProvider, *memUserProfileCache) {
+	cache := &memUserProfileCache{profiles: map[string]*fbmodel.UserProfile{}}
+	return &facebookProvider{
+		api:      api,
+		logger:   slog.New(slog.NewTextHandler(io.Discard, nil)),
+		profiles: cache,
+	}, cache
+}
+
+func TestUserProfile_FetchedOncePerTTL(t *testing.T) {
+	api := &profileAPI{profile: &fbmodel.UserProfile{ID: "psid-1", FirstName: "Ann", ProfilePic: "https://pic"}}
+	p, _ := newProfileTestProvider(api)
+	gate := &fbmodel.FacebookGate{PageID: "page-1", PageToken: "tok"}
+
+	for range 3 {
+		if got, resolved := p.userProfile(context.Background(), gate, "psid-1"); got.FirstName != "Ann" || !resolved {
+			t.Fatalf("unexpected profile: %+v (resolved=%v)", got, resolved)
+		}
+	}
+	if api.calls != 1 {
+		t.Errorf("expected 1 Graph API call, got %d", api.calls)
+	}
+}
+
+func TestUserProfile_PermissionDeniedDegradesAndIsCached(t *testing.T) {
+	api := &profileAPI{err: fbmodel.ErrProfileUnavailable}
+	p, cache := newProfileTestProvider(api)
+	gate := &fbmodel.FacebookGate{PageID: "page-1"}
+
+	got, resolved := p.userProfile(context.Background(), gate, "psid-1")
+	if got.ID != "psid-1" || !got.IsPSIDOnly() || !resolved {
+		t.Fatalf("expected resolved PSID-only profile, got %+v (resolved=%v)", got, resolved)
+	}
+	if _, ok := cache.profiles["page-1:psid-1"]; !ok {
+		t.Error("permission denial should be cached")
+	}
+
+	p.userProfile(context.Background(), gate, "psid-1")
+	if api.calls != 1 {
+		t.Errorf("expected cached denial to skip the Graph API, got %d calls", api.calls)
+	}
+}
+
+func TestUserProfile_TransientErrorNotCached(t *testing.T) {
+	api := &profileAPI{err: errors.New("connection reset")}
+	p, cache := newProfileTestProvider(api)
+
+	got, resolved := p.userProfile(context.Background(), &fbmodel.FacebookGate{PageID: "page-1"}, "psid-1")
+	if !got.IsPSIDOnly() || resolved {
+		t.Fatalf("expected unresolved PSID-only profile, got %+v (resolved=%v)", got, resolved)
+	}
+	if len(cache.profiles) != 0 {
+		t.Error("transient failures must not be cached")
+	}
+}
+
+func TestToExternalUser_PSIDOnlyFallback(t *testing.T) {
+	u := toExternalUser("psid-1", &fbmodel.UserProfile{ID: "psid-1"})
+	if u.FirstName != "psid-1" {
+		t.Errorf("expected PSID as display name, got %q", u.FirstName)
+	}
+
+	u = toExternalUser("psid-1", &fbmodel.UserProfile{FirstName: "Ann", LastName: "Lee"})
+	if u.FirstName != "Ann" || u.LastName != "Lee" {
+		t.Errorf("unexpected user: %+v", u)
+	}
+}
+
+func TestContactPatch(t *testing.T) {
+	ann := &sharedmodel.ExternalUser{ID: "psid-1", FirstName: "Ann", LastName: "Lee"}
+	placeholder := &sharedmodel.ExternalUser{ID: "psid-1", FirstName: "psid-1"}
+
+	tests := []struct {
+		name      string
+		contact   *contactv1.Contact
+		user      *sharedmodel.ExternalUser
+		pic       string
+		wantPaths []string
+	}{
+		{"up to date", &contactv1.Contact{Name: "Ann", Metadata: map[string]string{metadataProfilePic: "pic"}}, ann, "pic", nil},
+		{"placeholder renamed", &contactv1.Contact{Name: "psid-1"}, ann, "", []string{"name", "username"}},
+		{"operator name kept", &contactv1.Contact{Name: "VIP Ann"}, ann, "", nil},
+		{"placeholder kept without profile", &contactv1.Contact{Name: "psid-1"}, placeholder, "", nil},
+		{"picture rotated", &contactv1.Contact{Name: "Ann", Metadata: map[string]string{metadataProfilePic: "old", "k": "v"}}, ann, "new", []string{"metadata"}},
+		{"both", &contactv1.Contact{Name: "psid-1"}, ann, "new", []string{"name", "username", "metadata"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.contact.Id = "contact-1"
+			req := contactPatch(tt.contact, tt.user, tt.pic)
+			if tt.wantPaths == nil {
+				if req != nil {
+					t.Fatalf("expected no patch, got %v", req.GetFieldMask().GetPaths())
+				}
+				return
+			}
+			if req == nil || !slices.Equal(req.GetFieldMask().GetPaths(), tt.wantPaths) {
+				t.Fatalf("expected paths %v, got %+v", tt.wantPaths, req)
+			}
+			if req.GetId() != "contact-1" {
+				t.Errorf("unexpected contact id: %s", req.GetId())
+			}
+			if slices.Contains(tt.wantPaths, "name") && (req.GetName() != "Ann" || req.GetUsername() != "Lee") {
+				t.Errorf("unexpected name: %s/%s", req.GetName(), req.GetUsername())
+			}
+			if slices.Contains(tt.wantPaths, "metadata") {
+				if req.GetMetadata()[metadataProfilePic] != tt.pic {
+					t.Errorf("unexpected picture: %v", req.GetMetadata())
+				}
+				if tt.contact.GetMetadata()["k"] != req.GetMetadata()["k"] {
+					t.Errorf("existing metadata must be kept: %v", req.GetMetadata())
+				}
+			}
+		})
+	}
+}
+
+func TestIsPermissionError(t *testing.T) {
+	tests := []struct {
+		body string
+		want bool
+	}{
+		{`{"error":{"code":10,"message":"(#10) Requires pages_user_locale"}}`, true},
+		{`{"error":{"code":230}}`, true},
+		{`{"error":{"code":100}}`, true},
+		{`{"error":{"code":190}}`, false},
+		{`{"error":{"code":2}}`, false},
+		{`not json`, false},
+	}
+	for _, tt := range tests {
+		if got := isPermissionError([]byte(tt.body)); got != tt.want {
+			t.Errorf("isPermissionError(%s) = %v, want %v", tt.body, got, tt.want)
+		}
+	}
+}
@@ -2,10 +2,12 @@ package facebook
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	fbmodel "github.com/webitel/im-providers-service/internal/facebook/model"
 	sharedmodel "github.com/webitel/im-providers-service/internal/core/model"
+	sharedstore "github.com/webitel/im-providers-service/internal/core/store"
 )
 
 func (p *facebookProvider) HandleWebhook(ctx context.Context, data []byte) error {
@@ -29,7 +31,7 @@ func (p *facebookProvider) HandleWebhook(ctx context.Context, data []byte) error
 
 // processMessage is the per-event pipeline:
 //
-//	fetch profile → sync contact → route content
+//	fetch profile (cached) → sync contact → route content
 func (p *facebookProvider) processMessage(ctx context.Context, gate *fbmodel.FacebookGate, msg Messaging) error {
 	psid := msg.Sender.ID
 	if psid == "" {
@@ -42,25 +44,11 @@ func (p *facebookProvider) processMessage(ctx context.Context, gate *fbmodel.Fac
 		return nil
 	}
 
-	profile, err := p.api.GetUserProfile(ctx, psid, gate.PageToken)
-	if err != nil {
-		return fmt.Errorf("fetch profile [psid=%s]: %w", psid, err)
-	}
-
-	if _, err := p.syncContact(ctx, gate, psid, profile); err != nil {
+	profile, resolved := p.userProfile(ctx, gate, psid)
+	if _, err := p.syncContact(ctx, gate, psid, profile, resolved); err != nil {
 		return fmt.Errorf("sync contact [psid=%s]: %w", psid, err)
 	}
 
-	if msg.Message != nil {
-		profile, err := p.api.GetUserProfile(ctx, psid, gate.PageToken)
-		if err != nil {
-			return fmt.Errorf("fetch profile [psid=%s]: %w", psid, err)
-		}
-		if _, err := p.syncContact(ctx, gate, psid, profile); err != nil {
-			return fmt.Errorf("sync contact [psid=%s]: %w", psid, err)
-		}
-	}
-
 	peers := peerPair{
 		from: sharedmodel.Peer{Sub: psid, Iss: gate.Peer.Iss},
 		to:   sharedmodel.Peer{Sub: gate.Peer.Sub, Iss: gate.Peer.Iss, Via: &gate.ID},
@@ -83,18 +71,45 @@ func (p *facebookProvider) processMessage(ctx context.Context, gate *fbmodel.Fac
 	return nil
 }
 
+// userProfile returns the sender profile, fetching it at most once per cache TTL.
+// It never fails: when the profile cannot be read the event continues with a
+// PSID-only profile. Permission denials are cached too so a page without
+// pages_user_* permissions does not request the profile on every event.
+// resolved is false after a transient failure, when the PSID-only profile is
+// a stand-in for this event only.
+func (p *facebookProvider) userProfile(ctx context.Context, gate *fbmodel.FacebookGate, psid string) (profile *fbmodel.UserProfile, resolved bool) {
+	if cached, err := p.profiles.Get(ctx, gate.PageID, psid); err == nil {
+		return cached, true
+	} else if !errors.Is(err, sharedstore.ErrNotFound) {
+		p.logger.WarnContext(ctx, "profile cache read failed", "psid", psid, "err", err)
+	}
+
+	profile, err := p.api.GetUserProfile(ctx, psid, gate.PageToken)
+	switch {
+	case err == nil:
+	case errors.Is(err, fbmodel.ErrProfileUnavailable):
+		p.logger.InfoContext(ctx, "user profile not accessible, using PSID only", "psid", psid, "page_id", gate.PageID)
+		profile = &fbmodel.UserProfile{ID: psid}
+	default:
+		// Transient failure: degrade for this event only, the next one retries.
+		p.logger.WarnContext(ctx, "fetch user profile failed, using PSID only", "psid", psid, "err", err)
+		return &fbmodel.UserProfile{ID: psid}, false
+	}
+
+	if err := p.profiles.Set(ctx, gate.PageID, psid, profile); err != nil {
+		p.logger.WarnContext(ctx, "profile cache write failed", "psid", psid, "err", err)
+	}
+	return profile, true
+}
+
 // routeMessage dispatches inbound text and attachment content to the messenger.
 // Errors are logged and non-fatal: a single failed delivery must not block others.
 func (p *facebookProvider) routeMessage(ctx context.Context, gate *fbmodel.FacebookGate, peers peerPair, msg *InboundMessage) {
-	if msg.Text != "" {
-		if _, err := p.messenger.SendText(ctx, &sharedmodel.SendTextRequest{
-			DomainID: gate.DomainID,
-			From:     peers.from,
-			To:       peers.to,
-			Body:     msg.Text,
-		}); err != nil {
-			p.logger.Error("send text failed", "err", err)
-		}
+	if msg.QuickReply != nil && msg.QuickReply.Payload != "" {
+		// The text of a quick-reply tap is just the button title.
+		p.routeCallback(ctx, gate, peers, msg.QuickReply.Payload, msg.Text)
+	} else if msg.Text != "" {
+		p.sendText(ctx, gate, peers, msg.Text)
 	}
 
 	if len(msg.Attachments) > 0 {
@@ -103,16 +118,41 @@ func (p *facebookProvider) routeMessage(ctx context.Context, gate *fbmodel.Faceb
 }
 
 // routePostback forwards a Facebook button-click (persistent menu or template button) to the messenger.
-// FB postbacks carry a payload string, not a UUID, so they are routed as plain text messages
-// rather than interactive callbacks which require an existing message UUID as in_reply_to.
 // https://developers.facebook.com/docs/messenger-platform/reference/webhook-events/messaging-postbacks
 func (p *facebookProvider) routePostback(ctx context.Context, gate *fbmodel.FacebookGate, peers peerPair, pb *Postback) {
+	p.routeCallback(ctx, gate, peers, pb.Payload, pb.Payload)
+}
+
+// routeCallback emits a button tap as an interactive callback when the payload belongs
+// to a keyboard we sent to this user. Persistent menu, Get Started and expired keyboards
+// have no originating message, so they fall back to a text message.
+func (p *facebookProvider) routeCallback(ctx context.Context, gate *fbmodel.FacebookGate, peers peerPair, payload, fallback string) {
+	messageID, buttonCode, ok := p.callbacks.lookup(ctx, gate.ID, peers.from.Sub, payload)
+	if !ok {
+		p.logger.DebugContext(ctx, "no keyboard matches callback, routing as text", "payload", payload)
+		p.sendText(ctx, gate, peers, fallback)
+		return
+	}
+
+	if err := p.messenger.SendInteractiveCallback(ctx, &sharedmodel.SendInteractiveCallbackRequest{
+		DomainID:     gate.DomainID,
+		From:         peers.from,
+		To:           peers.to,
+		InReplyTo:    messageID,
+		ButtonCode:   buttonCode,
+		CallbackData: payload,
+	}); err != nil {
+		p.logger.Error("send interactive callback failed", "in_reply_to", messageID, "payload", payload, "err", err)
+	}
+}
+
+func (p *facebookProvider) sendText(ctx context.Context, gate *fbmodel.FacebookGate, peers peerPair, text string) {
 	if _, err := p.messenger.SendText(ctx, &sharedmodel.SendTextRequest{
 		DomainID: gate.DomainID,
 		From:     peers.from,
 		To:       peers.to,
-		Body:     pb.Payload,
+		Body:     text,
 	}); err != nil {
-		p.logger.Error("send postback as text failed", "payload", pb.Payload, "err", err)
+		p.logger.Error("send text failed", "err", err)
 	}
 }
@@ -0,0 +1,195 @@
+package line
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"time"
+
+	sharedmodel "github.com/webitel/im-providers-service/internal/core/model"
+	lnmodel "github.com/webitel/im-providers-service/internal/line/model"
+)
+
+// Messaging API endpoints. Message content is served from a separate host.
+// https://developers.line.biz/en/reference/messaging-api/
+const (
+	APIBaseURL  = "https://api.line.me"
+	DataBaseURL = "https://api-data.line.me"
+)
+
+// botAPI is the contract used by lineProvider and the gate service to talk to the Messaging API.
+// Keeping it as an interface allows both to be tested without network calls.
+type botAPI interface {
+	GetBotInfo(ctx context.Context, token string) (*lnmodel.BotInfo, error)
+	SetWebhookEndpoint(ctx context.Context, token, endpoint string) error
+	GetProfile(ctx context.Context, token, userID string) (*lnmodel.Profile, error)
+	Reply(ctx context.Context, token, replyToken string, msgs []*outboundMessage) (*sharedmodel.MessageResponse, error)
+	Push(ctx context.Context, token, to string, msgs []*outboundMessage) (*sharedmodel.MessageResponse, error)
+	GetContent(ctx context.Context, token, messageID string) (*content, error)
+}
+
+// content is the body of an inbound message media. The caller closes Body.
+type content struct {
+	Body     io.ReadCloser
+	MimeType string
+	Size     int64
+}
+
+type apiClient struct {
+	client  *http.Client
+	logger  *slog.Logger
+	apiURL  string
+	dataURL string
+}
+
+var _ botAPI = (*apiClient)(nil)
+
+func newAPIClient(l *slog.Logger) *apiClient {
+	return &apiClient{
+		client:  &http.Client{Timeout: 30 * time.Second},
+		logger:  l.With("component", "line.api"),
+		apiURL:  APIBaseURL,
+		dataURL: DataBaseURL,
+	}
+}
+
+// GetBotInfo returns the bot the channel access token belongs to.
+// https://developers.line.biz/en/reference/messaging-api/#get-bot-info
+func (c *apiClient) GetBotInfo(ctx context.Context, token string) (*lnmodel.BotInfo, error) {
+	var resp botInfoResponse
+	if err := c.call(ctx, token, http.MethodGet, "/v2/bot/info", nil, &resp); err != nil {
+		return nil, err
+	}
+	return &lnmodel.BotInfo{UserID: resp.UserID, BasicID: resp.BasicID, DisplayName: resp.DisplayName}, nil
+}
+
+// SetWebhookEndpoint points the channel webhooks to endpoint. Use webhook must still
+// be enabled in the LINE Official Account Manager.
+// https://developers.line.biz/en/reference/messaging-api/#set-webhook-endpoint-url
+func (c *apiClient) SetWebhookEndpoint(ctx context.Context, token, endpoint string) error {
+	return c.call(ctx, token, http.MethodPut, "/v2/bot/channel/webhook/endpoint", webhookEndpointRequest{Endpoint: endpoint}, nil)
+}
+
+// GetProfile returns the profile of a user who added the bot as a friend.
+// https://developers.line.biz/en/reference/messaging-api/#get-profile
+func (c *apiClient) GetProfile(ctx context.Context, token, userID string) (*lnmodel.Profile, error) {
+	var resp profileResponse
+	if err := c.call(ctx, token, http.MethodGet, "/v2/bot/profile/"+url.PathEscape(userID), nil, &resp); err != nil {
+		return nil, err
+	}
+	return &lnmodel.Profile{
+		UserID:      resp.UserID,
+		DisplayName: resp.DisplayName,
+		PictureURL:  resp.PictureURL,
+		Language:    resp.Language,
+	}, nil
+}
+
+// Reply answers a webhook event with up to five messages. A reply token is valid
+// for a single use shortly after the event; replies are not counted against the quota.
+// https://developers.line.biz/en/reference/messaging-api/#send-reply-message
+func (c *apiClient) Reply(ctx context.Context, token, replyToken string, msgs []*outboundMessage) (*sharedmodel.MessageResponse, error) {
+	var resp sendResponse
+	if err := c.call(ctx, token, http.MethodPost, "/v2/bot/message/reply", replyRequest{ReplyToken: replyToken, Messages: msgs}, &resp); err != nil {
+		return nil, err
+	}
+	return toMessageResponse(&resp), nil
+}
+
+// Push sends up to five messages to a user at any time.
+// https://developers.line.biz/en/reference/messaging-api/#send-push-message
+func (c *apiClient) Push(ctx context.Context, token, to string, msgs []*outboundMessage) (*sharedmodel.MessageResponse, error) {
+	var resp sendResponse
+	if err := c.call(ctx, token, http.MethodPost, "/v2/bot/message/push", pushRequest{To: to, Messages: msgs}, &resp); err != nil {
+		return nil, err
+	}
+	return toMessageResponse(&resp), nil
+}
+
+// GetContent streams the image, video, audio or file sent by a user.
+// https://developers.line.biz/en/reference/messaging-api/#get-content
+func (c *apiClient) GetContent(ctx context.Context, token, messageID string) (*content, error) {
+	path := "/v2/bot/message/" + url.PathEscape(messageID) + "/content"
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.dataURL+path, nil)
+	if err != nil {
+		return nil, fmt.Errorf("line get content: %w", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("line get content: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		defer resp.Body.Close()
+		return nil, fmt.Errorf("line get content: %w", decodeError(resp))
+	}
+	return &content{Body: resp.Body, MimeType: resp.Header.Get("Content-Type"), Size: resp.ContentLength}, nil
+}
+
+// call sends body as JSON to the API path and decodes the reply into out, if set.
+func (c *apiClient) call(ctx context.Context, token, method, path string, body, out any) error {
+	var reader io.Reader
+	if body != nil {
+		payload, err := json.Marshal(body)
+		if err != nil {
+			return fmt.Errorf("line %s: marshal: %w", path, err)
+		}
+		reader = bytes.NewReader(payload)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, c.apiURL+path, reader)
+	if err != nil {
+		return fmt.Errorf("line %s: %w", path, err)
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("line %s: %w", path, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		apiErr := decodeError(resp)
+		c.logger.WarnContext(ctx, "messaging api request rejected", "path", path, "status", apiErr.StatusCode, "message", apiErr.Message)
+		return fmt.Errorf("line %s: %w", path, apiErr)
+	}
+	if out == nil {
+		return nil
+	}
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fmt.Errorf("line %s: decode response: %w", path, err)
+	}
+	return nil
+}
+
+// decodeError reads the error body of a failed request. A body that is not an
+// error object keeps the HTTP status text as the message.
+func decodeError(resp *http.Response) *APIError {
+	apiErr := &APIError{StatusCode: resp.StatusCode}
+	raw, _ := io.ReadAll(io.LimitReader(resp.Body, 64<<10))
+	if json.Unmarshal(raw, apiErr) != nil || apiErr.Message == "" {
+		apiErr.Message = resp.Status
+	}
+	return apiErr
+}
+
+func toMessageResponse(resp *sendResponse) *sharedmodel.MessageResponse {
+	out := &sharedmodel.MessageResponse{}
+	if len(resp.SentMessages) > 0 {
+		out.ID = resp.SentMessages[0].ID
+		if q := resp.SentMessages[0].QuoteToken; q != "" {
+			out.MD = map[string]any{"quote_token": q}
+		}
+	}
+	return out
+}
@@ -0,0 +1,129 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	impb "github.com/webitel/im-providers-service/gen/go/provider/v1"
+	lnmodel "github.com/webitel/im-providers-service/internal/line/model"
+	lnservice "github.com/webitel/im-providers-service/internal/line/service"
+	"github.com/webitel/im-providers-service/internal/provider/gaterpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type LineHandler struct {
+	logger *slog.Logger
+	srv    lnservice.LineManager
+	impb.UnimplementedLineServiceServer
+}
+
+func NewLineHandler(logger *slog.Logger, srv lnservice.LineManager) *LineHandler {
+	return &LineHandler{logger: logger, srv: srv}
+}
+
+func (h *LineHandler) CreateLineGate(ctx context.Context, req *impb.ProviderCreateLineGateRequest) (*impb.ProviderCreateLineGateResponse, error) {
+	domainID, err := gaterpc.DomainID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	create := lnmodel.CreateLine{
+		Name:               req.GetName(),
+		Dc:                 domainID,
+		ChannelSecret:      req.GetChannelSecret(),
+		ChannelAccessToken: req.GetChannelAccessToken(),
+	}
+	if peer := gaterpc.Peer(req.GetPeer()); peer != nil {
+		create.Peer = *peer
+	}
+
+	gate, err := h.srv.CreateGate(ctx, create)
+	if err != nil {
+		return nil, toStatus(err, "create gate")
+	}
+
+	return &impb.ProviderCreateLineGateResponse{Item: gateToProto(gate)}, nil
+}
+
+func (h *LineHandler) GetLineGate(ctx context.Context, req *impb.ProviderGetLineGateRequest) (*impb.ProviderGetLineGateResponse, error) {
+	gate, err := h.gate(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	return &impb.ProviderGetLineGateResponse{Item: gateToProto(gate)}, nil
+}
+
+func (h *LineHandler) UpdateLineGate(ctx context.Context, req *impb.ProviderUpdateLineGateRequest) (*impb.ProviderUpdateLineGateResponse, error) {
+	if _, err := h.gate(ctx, req.GetId()); err != nil {
+		return nil, err
+	}
+
+	gate, err := h.srv.UpdateGate(ctx, lnmodel.UpdateLine{
+		ID:                 req.GetId(),
+		Name:               req.Name,
+		ChannelSecret:      req.ChannelSecret,
+		ChannelAccessToken: req.ChannelAccessToken,
+		Enabled:            req.Enabled,
+		Peer:               gaterpc.Peer(req.GetPeer()),
+	})
+	if err != nil {
+		return nil, toStatus(err, "update gate")
+	}
+
+	return &impb.ProviderUpdateLineGateResponse{Item: gateToProto(gate)}, nil
+}
+
+func (h *LineHandler) DeleteLineGate(ctx context.Context, req *impb.ProviderDeleteLineGateRequest) (*impb.ProviderDeleteLineGateResponse, error) {
+	if _, err := h.gate(ctx, req.GetId()); err != nil {
+		return nil, err
+	}
+
+	gate, err := h.srv.DeleteGate(ctx, req.GetId())
+	if err != nil {
+		return nil, toStatus(err, "delete gate")
+	}
+
+	return &impb.ProviderDeleteLineGateResponse{Item: gateToProto(gate)}, nil
+}
+
+// gate returns the gate when it belongs to the domain of the caller.
+func (h *LineHandler) gate(ctx context.Context, id string) (*lnmodel.LineGate, error) {
+	gate, err := h.srv.GetGate(ctx, id)
+	if err != nil {
+		return nil, toStatus(err, "get gate")
+	}
+	if err := gaterpc.CheckDomain(ctx, gate.DomainID); err != nil {
+		return nil, err
+	}
+	return gate, nil
+}
+
+// toStatus reports a missing public URL as a precondition: LINE delivers the events of a
+// channel only to the webhook endpoint set on it.
+func toStatus(err error, internalMsg string) error {
+	if errors.Is(err, lnservice.ErrPublicURLNotSet) {
+		return status.Error(codes.FailedPrecondition, err.Error())
+	}
+	return gaterpc.ToStatus(err, internalMsg)
+}
+
+func gateToProto(g *lnmodel.LineGate) *impb.ProviderLineGate {
+	if g == nil {
+		return nil
+	}
+	return &impb.ProviderLineGate{
+		Id:         g.ID,
+		Name:       g.Name,
+		Peer:       gaterpc.ToProtoPeer(g.Peer),
+		BotUserId:  g.BotUserID,
+		BasicId:    g.BasicID,
+		WebhookUrl: g.WebhookURL,
+		Status:     impb.ProviderStatus(g.Status),
+		CreatedAt:  g.CreatedAt.UnixMilli(),
+		UpdatedAt:  g.UpdatedAt.UnixMilli(),
+		Enabled:    g.Enabled,
+	}
+}
@@ -0,0 +1,204 @@
+package handler
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"testing"
+
+	impb "github.com/webitel/im-providers-service/gen/go/provider/v1"
+	"github.com/webitel/im-providers-service/infra/auth"
+	sharedstore "github.com/webitel/im-providers-service/internal/core/store"
+	lnmodel "github.com/webitel/im-providers-service/internal/line/model"
+	lnservice "github.com/webitel/im-providers-service/internal/line/service"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type member struct{ domainID int64 }
+
+func (m member) GetContactID() string { return "" }
+func (m member) GetDomainID() int64   { return m.domainID }
+func (m member) GetName() string      { return "" }
+
+func memberContext(domainID int64) context.Context {
+	return context.WithValue(context.Background(), auth.AuthContextKey, member{domainID: domainID})
+}
+
+// channelConsole connects Official Accounts by the bot behind their access token, one
+// gate per bot, and fails with err when it is set.
+type channelConsole struct {
+	bots    map[string]lnmodel.BotInfo
+	gates   map[string]*lnmodel.LineGate
+	deleted []string
+	err     error
+}
+
+var _ lnservice.LineManager = (*channelConsole)(nil)
+
+func newChannelConsole(gates ...*lnmodel.LineGate) *channelConsole {
+	c := &channelConsole{
+		bots: map[string]lnmodel.BotInfo{
+			"token-support": {UserID: "U1", BasicID: "@support"},
+			"token-sales":   {UserID: "U2", BasicID: "@sales"},
+		},
+		gates: map[string]*lnmodel.LineGate{},
+	}
+	for _, g := range gates {
+		c.gates[g.ID] = g
+	}
+	return c
+}
+
+func (c *channelConsole) CreateGate(_ context.Context, req lnmodel.CreateLine) (*lnmodel.LineGate, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	if c.err != nil {
+		return nil, c.err
+	}
+	bot := c.bots[req.ChannelAccessToken]
+	for _, g := range c.gates {
+		if g.BotUserID == bot.UserID {
+			return nil, sharedstore.ErrConflict
+		}
+	}
+	gate := &lnmodel.LineGate{
+		ID:                 "gate-" + bot.UserID,
+		DomainID:           req.Dc,
+		Name:               req.Name,
+		Peer:               req.Peer,
+		BotUserID:          bot.UserID,
+		BasicID:            bot.BasicID,
+		ChannelSecret:      req.ChannelSecret,
+		ChannelAccessToken: req.ChannelAccessToken,
+		WebhookURL:         "https://im.example.com/wh/line/gate-" + bot.UserID,
+		Enabled:            true,
+	}
+	c.gates[gate.ID] = gate
+	return gate, nil
+}
+
+func (c *channelConsole) GetGate(_ context.Context, id string) (*lnmodel.LineGate, error) {
+	g, ok := c.gates[id]
+	if !ok {
+		return nil, sharedstore.ErrNotFound
+	}
+	return g, nil
+}
+
+func (c *channelConsole) UpdateGate(_ context.Context, req lnmodel.UpdateLine) (*lnmodel.LineGate, error) {
+	gate := c.gates[req.ID]
+	req.ApplyTo(gate)
+	if bot, ok := c.bots[gate.ChannelAccessToken]; ok {
+		gate.BotUserID, gate.BasicID = bot.UserID, bot.BasicID
+	}
+	return gate, nil
+}
+
+func (c *channelConsole) DeleteGate(_ context.Context, id string) (*lnmodel.LineGate, error) {
+	c.deleted = append(c.deleted, id)
+	return c.gates[id], nil
+}
+
+func supportAccount() *lnmodel.LineGate {
+	return &lnmodel.LineGate{
+		ID:                 "gate-U1",
+		DomainID:           7,
+		Name:               "Support",
+		BotUserID:          "U1",
+		BasicID:            "@support",
+		ChannelSecret:      "secret-support",
+		ChannelAccessToken: "token-support",
+		Enabled:            true,
+	}
+}
+
+func newHandler(c *channelConsole) *LineHandler {
+	return NewLineHandler(slog.New(slog.NewTextHandler(io.Discard, nil)), c)
+}
+
+func TestCreateLineGate(t *testing.T) {
+	c := newChannelConsole()
+
+	resp, err := newHandler(c).CreateLineGate(memberContext(7), &impb.ProviderCreateLineGateRequest{
+		Name:               "Support",
+		ChannelSecret:      "secret-support",
+		ChannelAccessToken: "token-support",
+		Peer:               &impb.Peer{Sub: "line-bot", Iss: "line"},
+	})
+	if err != nil {
+		t.Fatalf("CreateLineGate: %v", err)
+	}
+	item := resp.GetItem()
+	if item.GetBotUserId() != "U1" || item.GetBasicId() != "@support" || item.GetWebhookUrl() != "https://im.example.com/wh/line/gate-U1" {
+		t.Errorf("unexpected gate: %+v", item)
+	}
+	if item.GetPeer().GetSub() != "line-bot" || c.gates["gate-U1"].DomainID != 7 {
+		t.Errorf("stored gate = %+v", c.gates["gate-U1"])
+	}
+}
+
+func TestCreateLineGate_ErrorCodes(t *testing.T) {
+	tests := []struct {
+		name    string
+		token   string
+		failure error
+		want    codes.Code
+	}{
+		{name: "no access token", want: codes.InvalidArgument},
+		{name: "bot already connected", token: "token-support", want: codes.AlreadyExists},
+		{name: "no public url", token: "token-sales", failure: lnservice.ErrPublicURLNotSet, want: codes.FailedPrecondition},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := newChannelConsole(supportAccount())
+			c.err = tt.failure
+
+			_, err := newHandler(c).CreateLineGate(memberContext(7), &impb.ProviderCreateLineGateRequest{
+				Name:               "Support",
+				ChannelSecret:      "secret",
+				ChannelAccessToken: tt.token,
+			})
+			if status.Code(err) != tt.want {
+				t.Errorf("code = %v, want %v", status.Code(err), tt.want)
+			}
+		})
+	}
+}
+
+func TestUpdateLineGate_NewAccessTokenKeepsSecret(t *testing.T) {
+	c := newChannelConsole(supportAccount())
+
+	token := "token-sales"
+	resp, err := newHandler(c).UpdateLineGate(memberContext(7), &impb.ProviderUpdateLineGateRequest{Id: "gate-U1", ChannelAccessToken: &token})
+	if err != nil {
+		t.Fatalf("UpdateLineGate: %v", err)
+	}
+	if item := resp.GetItem(); item.GetBasicId() != "@sales" || item.GetName() != "Support" {
+		t.Errorf("item = %+v", item)
+	}
+	if g := c.gates["gate-U1"]; g.ChannelSecret != "secret-support" || g.ChannelAccessToken != "token-sales" {
+		t.Errorf("credentials = %q, %q", g.ChannelSecret, g.ChannelAccessToken)
+	}
+}
+
+func TestLineGate_OtherDomainIsNotFound(t *testing.T) {
+	c := newChannelConsole(supportAccount())
+	h := newHandler(c)
+	ctx := memberContext(8)
+
+	if _, err := h.GetLineGate(ctx, &impb.ProviderGetLineGateRequest{Id: "gate-U1"}); status.Code(err) != codes.NotFound {
+		t.Errorf("get: code = %v, want NotFound", status.Code(err))
+	}
+	token := "token-sales"
+	if _, err := h.UpdateLineGate(ctx, &impb.ProviderUpdateLineGateRequest{Id: "gate-U1", ChannelAccessToken: &token}); status.Code(err) != codes.NotFound {
+		t.Errorf("update: code = %v, want NotFound", status.Code(err))
+	}
+	if _, err := h.DeleteLineGate(ctx, &impb.ProviderDeleteLineGateRequest{Id: "gate-U1"}); status.Code(err) != codes.NotFound {
+		t.Errorf("delete: code = %v, want NotFound", status.Code(err))
+	}
+	if c.gates["gate-U1"].ChannelAccessToken != "token-support" || len(c.deleted) != 0 {
+		t.Errorf("gate of another domain changed: %+v, deleted %v", c.gates["gate-U1"], c.deleted)
+	}
+}
@@ -0,0 +1,155 @@
+package line
+
+import (
+	"encoding/json"
+
+	sharedmodel "github.com/webitel/im-providers-service/internal/core/model"
+)
+
+// Interactive message limits.
+// https://developers.line.biz/en/reference/messaging-api/#quick-reply
+// https://developers.line.biz/en/reference/messaging-api/#buttons
+const (
+	quickReplyMaxItems        = 13
+	templateMaxActions        = 4
+	templateTextMaxLen        = 160
+	templateAltTextMaxLen     = 400
+	actionLabelMaxLen         = 20
+	postbackDataMaxLen        = 300
+	postbackDisplayTextMaxLen = 300
+)
+
+// Action types.
+const (
+	actionPostback = "postback"
+	actionURI      = "uri"
+	actionLocation = "location"
+)
+
+// postbackData is sent as the data of a postback action and echoed back in the
+// postback event. It correlates the tap with the message and button it came from,
+// so no state is kept.
+type postbackData struct {
+	MessageID string `json:"m"`
+	Code      string `json:"c"`
+	Data      string `json:"d"`
+}
+
+// encodePostback returns the postback data of a callback button. The bare callback
+// data is used when the message is unknown or the envelope does not fit the limit.
+func encodePostback(messageID string, b sharedmodel.KeyboardButton) string {
+	data := b.Callback.Data
+	if messageID == "" {
+		return data
+	}
+	code := b.ID
+	if code == "" {
+		code = b.Label
+	}
+	raw, err := json.Marshal(postbackData{MessageID: messageID, Code: code, Data: data})
+	if err != nil || len(raw) > postbackDataMaxLen {
+		return data
+	}
+	return string(raw)
+}
+
+// decodePostback splits the data of a postback event. Data not sent in the envelope
+// is returned as the callback data of an unknown message.
+func decodePostback(raw string) postbackData {
+	var d postbackData
+	if json.Unmarshal([]byte(raw), &d) != nil || d.MessageID == "" {
+		return postbackData{Data: raw}
+	}
+	return d
+}
+
+// interactiveButtons flattens the keyboard or list buttons of an interactive message.
+func interactiveButtons(interactive *sharedmodel.Interactive) []sharedmodel.KeyboardButton {
+	if interactive == nil {
+		return nil
+	}
+
+	var all []sharedmodel.KeyboardButton
+	if interactive.Markup != nil {
+		for _, row := range interactive.Markup.Rows {
+			all = append(all, row.Buttons...)
+		}
+	}
+	if interactive.ListReply != nil {
+		for _, section := range interactive.ListReply.Sections {
+			all = append(all, section.Buttons...)
+		}
+	}
+	return all
+}
+
+// buildInteractive maps the buttons onto a message. Callback and location buttons
+// become quick replies of a text message. Quick replies cannot open a URL, so a
+// message with URL buttons is sent as a buttons template holding the URL buttons and
+// as many callback buttons as fit the four actions; the callback buttons left over
+// stay quick replies. Buttons that fit neither are dropped.
+func buildInteractive(messageID, text string, buttons []sharedmodel.KeyboardButton) *outboundMessage {
+	// urls is the number of template actions kept for the URL buttons not placed yet.
+	urls := 0
+	for _, b := range buttons {
+		if b.URL != nil && b.URL.URL != "" && (b.Callback == nil || b.Callback.Data == "") {
+			urls++
+		}
+	}
+	urls = min(urls, templateMaxActions)
+	templated := urls > 0
+
+	var actions []*action
+	var items []quickReplyItem
+	for _, b := range buttons {
+		label := truncate(b.Label, actionLabelMaxLen)
+		switch {
+		case b.Callback != nil && b.Callback.Data != "":
+			a := &action{
+				Type:        actionPostback,
+				Label:       label,
+				Data:        encodePostback(messageID, b),
+				DisplayText: truncate(b.Label, postbackDisplayTextMaxLen),
+			}
+			if templated && len(actions)+urls < templateMaxActions {
+				actions = append(actions, a)
+			} else if len(items) < quickReplyMaxItems {
+				items = append(items, quickReplyItem{Type: "action", Action: a})
+			}
+		case b.URL != nil && b.URL.URL != "":
+			if urls > 0 {
+				actions = append(actions, &action{Type: actionURI, Label: label, URI: b.URL.URL})
+				urls--
+			}
+		case b.Request != nil && b.Request.Action == "location":
+			if len(items) < quickReplyMaxItems {
+				items = append(items, quickReplyItem{Type: "action", Action: &action{Type: actionLocation, Label: label}})
+			}
+		}
+	}
+
+	msg := &outboundMessage{Type: MessageText, Text: text}
+	if len(actions) > 0 {
+		msg = &outboundMessage{
+			Type:    MessageTemplate,
+			AltText: truncate(text, templateAltTextMaxLen),
+			Template: &template{
+				Type:    "buttons",
+				Text:    truncate(text, templateTextMaxLen),
+				Actions: actions,
+			},
+		}
+	}
+	if len(items) > 0 {
+		msg.QuickReply = &quickReply{Items: items}
+	}
+	return msg
+}
+
+// truncate cuts s to at most n characters.
+func truncate(s string, n int) string {
+	if runes := []rune(s); len(runes) > n {
+		return string(runes[:n])
+	}
+	return s
+}
@@ -0,0 +1,134 @@
+package line
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	sharedmodel "github.com/webitel/im-providers-service/internal/core/model"
+	lnmodel "github.com/webitel/im-providers-service/internal/line/model"
+	"github.com/webitel/im-providers-service/internal/provider/contactsync"
+)
+
+type syncedMedia struct {
+	id       string
+	mimeType string
+	size     int64
+}
+
+// handleMedia copies the media of an inbound message to the storage and forwards it.
+// Images become images, videos, audio and files become documents.
+func (p *lineProvider) handleMedia(ctx context.Context, gate *lnmodel.LineGate, peers contactsync.Peers, msg *eventMessage) {
+	name := mediaFileName(msg)
+	media, err := p.downloadAndUpload(ctx, gate, msg, name)
+	if err != nil {
+		p.logger.Error("failed to sync media", "type", msg.Type, "message_id", msg.ID, "err", err)
+		return
+	}
+
+	if media.size <= 0 {
+		media.size = msg.FileSize
+	}
+	if media.size <= 0 {
+		media.size = 1
+	}
+
+	switch msg.Type {
+	case MessageImage:
+		if _, err := p.messenger.SendImage(ctx, &sharedmodel.SendImageRequest{
+			DomainID: gate.DomainID,
+			From:     peers.From,
+			To:       peers.To,
+			Image: sharedmodel.ImageRequest{
+				Images: []*sharedmodel.Image{{
+					ID:       media.id,
+					FileName: name,
+					MimeType: media.mimeType,
+				}},
+			},
+		}); err != nil {
+			p.logger.Error("failed to send image", "fileName", name, "err", err)
+		}
+	default:
+		if _, err := p.messenger.SendDocument(ctx, &sharedmodel.SendDocumentRequest{
+			DomainID: gate.DomainID,
+			From:     peers.From,
+			To:       peers.To,
+			Document: sharedmodel.DocumentRequest{
+				Documents: []*sharedmodel.Document{{
+					ID:       media.id,
+					FileName: name,
+					MimeType: media.mimeType,
+					Size:     media.size,
+				}},
+			},
+		}); err != nil {
+			p.logger.Error("failed to send document", "fileName", name, "err", err)
+		}
+	}
+}
+
+// downloadAndUpload copies the content of the message to the storage. Content stored
+// on LINE is read through the Messaging API; content the sender app hosts itself is
+// only reachable through its original URL.
+func (p *lineProvider) downloadAndUpload(ctx context.Context, gate *lnmodel.LineGate, msg *eventMessage, fileName string) (*syncedMedia, error) {
+	var c *content
+	var err error
+	if cp := msg.ContentProvider; cp != nil && cp.Type != contentProviderLine && cp.OriginalContentURL != "" {
+		c, err = p.download(ctx, cp.OriginalContentURL)
+	} else {
+		c, err = p.api.GetContent(ctx, gate.ChannelAccessToken, msg.ID)
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer c.Body.Close()
+
+	uploaded, err := p.media.UploadFile(ctx, sharedmodel.UploadRequest{
+		DomainID: gate.DomainID,
+		Name:     fileName,
+		MimeType: c.MimeType,
+	}, c.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	return &syncedMedia{id: uploaded.ID, mimeType: c.MimeType, size: c.Size}, nil
+}
+
+// download fetches content hosted outside of LINE. The caller closes the body.
+func (p *lineProvider) download(ctx context.Context, mediaURL string) (*content, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, mediaURL, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := p.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		_, _ = io.Copy(io.Discard, resp.Body)
+		resp.Body.Close()
+		return nil, fmt.Errorf("line download: status %s", resp.Status)
+	}
+	return &content{Body: resp.Body, MimeType: resp.Header.Get("Content-Type"), Size: resp.ContentLength}, nil
+}
+
+func mediaFileName(msg *eventMessage) string {
+	if msg.FileName != "" {
+		return msg.FileName
+	}
+
+	ext := map[string]string{
+		MessageImage: ".jpg",
+		MessageVideo: ".mp4",
+		MessageAudio: ".m4a",
+	}[msg.Type]
+	if ext == "" {
+		ext = ".bin"
+	}
+	return fmt.Sprintf("line_%s_%d%s", msg.Type, time.Now().Unix(), ext)
+}
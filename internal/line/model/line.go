@@ -0,0 +1,97 @@
+package model
+
+import (
+	"time"
+
+	sharedmodel "github.com/webitel/im-providers-service/internal/core/model"
+)
+
+// LineGate represents a LINE Official Account gate configuration.
+type LineGate struct {
+	ID       string           `json:"id" db:"id"`
+	DomainID int64            `json:"domain_id" db:"domain_id"`
+	Peer     sharedmodel.Peer `json:"peer" db:"peer"`
+	Name     string           `json:"name" db:"name"`
+	// BotUserID is the user ID of the bot, sent as the webhook destination.
+	// BasicID is the public @-handle of the account.
+	BotUserID string `json:"bot_user_id" db:"bot_user_id"`
+	BasicID   string `json:"basic_id" db:"basic_id"`
+	// ChannelSecret signs the webhook requests, ChannelAccessToken authorizes the API calls.
+	ChannelSecret      string                 `json:"-" db:"channel_secret"`
+	ChannelAccessToken string                 `json:"-" db:"channel_access_token"`
+	WebhookURL         string                 `json:"webhook_url" db:"webhook_url"`
+	Status             sharedmodel.GateStatus `json:"status" db:"status"`
+	CreatedAt          time.Time              `json:"created_at" db:"created_at"`
+	UpdatedAt          time.Time              `json:"updated_at" db:"updated_at"`
+	Enabled            bool                   `json:"enabled" db:"enabled"`
+}
+
+type CreateLine struct {
+	Name               string
+	Dc                 int64
+	ChannelSecret      string
+	ChannelAccessToken string
+	Peer               sharedmodel.Peer
+}
+
+type UpdateLine struct {
+	ID                 string
+	Name               *string
+	ChannelSecret      *string
+	ChannelAccessToken *string
+	Enabled            *bool
+	Peer               *sharedmodel.Peer
+}
+
+func (r UpdateLine) ApplyTo(gate *LineGate) {
+	if r.Name != nil {
+		gate.Name = *r.Name
+	}
+	if r.Enabled != nil {
+		gate.Enabled = *r.Enabled
+	}
+	if r.ChannelSecret != nil {
+		gate.ChannelSecret = *r.ChannelSecret
+	}
+	if r.ChannelAccessToken != nil {
+		gate.ChannelAccessToken = *r.ChannelAccessToken
+	}
+	if r.Peer != nil {
+		gate.Peer = *r.Peer
+	}
+}
+
+// BotInfo is the bot account as returned by the bot info endpoint.
+type BotInfo struct {
+	UserID      string
+	BasicID     string
+	DisplayName string
+}
+
+// Profile is the profile of a LINE user who added the bot as a friend.
+type Profile struct {
+	UserID      string
+	DisplayName string
+	PictureURL  string
+	Language    string
+}
+
+func (r CreateLine) Validate() error {
+	var missing []string
+	if r.Name == "" {
+		missing = append(missing, "name")
+	}
+	if r.ChannelSecret == "" {
+		missing = append(missing, "channel_secret")
+	}
+	if r.ChannelAccessToken == "" {
+		missing = append(missing, "channel_access_token")
+	}
+	if r.Dc <= 0 {
+		missing = append(missing, "domain_id")
+	}
+	if len(missing) > 0 {
+		return &sharedmodel.ValidationError{Fields: missing}
+	}
+	return nil
+}
@@ -0,0 +1,44 @@
+package line
+
+import (
+	impb "github.com/webitel/im-providers-service/gen/go/provider/v1"
+	grpcsrv "github.com/webitel/im-providers-service/infra/srv/grpc"
+	lnhandler "github.com/webitel/im-providers-service/internal/line/handler"
+	lnservice "github.com/webitel/im-providers-service/internal/line/service"
+	lnstore "github.com/webitel/im-providers-service/internal/line/store"
+	lnpostgres "github.com/webitel/im-providers-service/internal/line/store/postgres"
+	"github.com/webitel/im-providers-service/internal/provider"
+	"go.uber.org/fx"
+)
+
+// Module provides the LINE provider adapter and the gRPC gate service.
+var Module = fx.Module("line",
+	fx.Provide(
+		// Messaging API client — provided as *apiClient for the provider adapter
+		// and as BotAPI for the LINE service.
+		newAPIClient,
+		func(c *apiClient) lnservice.BotAPI { return c },
+
+		// Provider adapter
+		fx.Annotate(
+			New,
+			fx.As(new(provider.Provider)),
+			fx.ResultTags(`group:"providers"`),
+		),
+
+		// Store implementations
+		fx.Annotate(lnpostgres.NewLineStore, fx.As(new(lnstore.LineStore))),
+
+		// Services
+		fx.Annotate(lnservice.NewLineService, fx.As(new(lnservice.LineManager))),
+
+		// gRPC handlers
+		lnhandler.NewLineHandler,
+	),
+	fx.Invoke(RegisterLineService),
+)
+
+// RegisterLineService connects the LINE gate gRPC handler to the gRPC server.
+func RegisterLineService(server *grpcsrv.Server, line *lnhandler.LineHandler) {
+	impb.RegisterLineServiceServer(server.Server, line)
+}
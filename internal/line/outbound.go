@@ -0,0 +1,170 @@
+package line
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/google/uuid"
+	contactv1 "github.com/webitel/im-providers-service/gen/go/contact/v1"
+	sharedmodel "github.com/webitel/im-providers-service/internal/core/model"
+	lnmodel "github.com/webitel/im-providers-service/internal/line/model"
+	"github.com/webitel/im-providers-service/internal/provider/contactsync"
+)
+
+// locationTextMaxLen is the limit of the title and the address of a location message.
+const locationTextMaxLen = 100
+
+func (p *lineProvider) SendText(ctx context.Context, req *sharedmodel.Message) (*sharedmodel.MessageResponse, error) {
+	return p.send(ctx, req, &outboundMessage{Type: MessageText, Text: req.Text})
+}
+
+// SendImage sends every image as an image message. Image messages carry no caption,
+// so a message text is sent first.
+func (p *lineProvider) SendImage(ctx context.Context, req *sharedmodel.Message) (*sharedmodel.MessageResponse, error) {
+	msgs := make([]*outboundMessage, 0, len(req.Images)+1)
+	if req.Text != "" {
+		msgs = append(msgs, &outboundMessage{Type: MessageText, Text: req.Text})
+	}
+	for _, img := range req.Images {
+		msgs = append(msgs, &outboundMessage{
+			Type:               MessageImage,
+			OriginalContentURL: img.URL,
+			PreviewImageURL:    img.URL,
+		})
+	}
+	return p.send(ctx, req, msgs...)
+}
+
+// SendDocument sends the documents as links below the message text: the Messaging API
+// cannot send files.
+func (p *lineProvider) SendDocument(ctx context.Context, req *sharedmodel.Message) (*sharedmodel.MessageResponse, error) {
+	lines := make([]string, 0, len(req.Documents)+1)
+	if req.Text != "" {
+		lines = append(lines, req.Text)
+	}
+	for _, doc := range req.Documents {
+		if doc.FileName != "" {
+			lines = append(lines, doc.FileName+": "+doc.URL)
+			continue
+		}
+		lines = append(lines, doc.URL)
+	}
+	return p.send(ctx, req, &outboundMessage{Type: MessageText, Text: strings.Join(lines, "\n")})
+}
+
+// SendInteractive sends the text with the buttons as quick replies or a buttons
+// template, see buildInteractive.
+func (p *lineProvider) SendInteractive(ctx context.Context, req *sharedmodel.Message) (*sharedmodel.MessageResponse, error) {
+	text := req.Text
+	if text == "" && req.Interactive != nil {
+		text = req.Interactive.Body
+	}
+	if text == "" {
+		return nil, fmt.Errorf("line: interactive message has no text")
+	}
+	return p.send(ctx, req, buildInteractive(messageID(req), text, interactiveButtons(req.Interactive)))
+}
+
+// SendLocation sends a location message. The title and the address are required,
+// the coordinates stand in for a missing address.
+func (p *lineProvider) SendLocation(ctx context.Context, req *sharedmodel.Message) (*sharedmodel.MessageResponse, error) {
+	loc := req.Location
+	if loc == nil {
+		return nil, fmt.Errorf("line: message has no location")
+	}
+	coords := strconv.FormatFloat(loc.Latitude, 'f', -1, 64) + ", " + strconv.FormatFloat(loc.Longitude, 'f', -1, 64)
+	return p.send(ctx, req, &outboundMessage{
+		Type:      MessageLocation,
+		Title:     truncate(cmp.Or(loc.Name, "Location"), locationTextMaxLen),
+		Address:   truncate(cmp.Or(loc.Address, coords), locationTextMaxLen),
+		Latitude:  loc.Latitude,
+		Longitude: loc.Longitude,
+	})
+}
+
+// send delivers the messages in order, five per request, and returns the response of
+// the first one. The first request replies with the reply token of the latest event
+// of the user when it is still fresh and falls back to a push message otherwise.
+func (p *lineProvider) send(ctx context.Context, req *sharedmodel.Message, msgs ...*outboundMessage) (*sharedmodel.MessageResponse, error) {
+	if len(msgs) == 0 {
+		return nil, fmt.Errorf("line: nothing to send")
+	}
+
+	g, err := p.repo.Select(ctx, req.GateID)
+	if err != nil {
+		return nil, err
+	}
+	userID, err := p.resolveReceiver(ctx, g, req.To.Sub)
+	if err != nil {
+		return nil, err
+	}
+
+	var first *sharedmodel.MessageResponse
+	for start := 0; start < len(msgs); start += maxMessagesPerRequest {
+		batch := msgs[start:min(start+maxMessagesPerRequest, len(msgs))]
+
+		var resp *sharedmodel.MessageResponse
+		if start == 0 {
+			resp, err = p.reply(ctx, g, userID, batch)
+		} else {
+			resp, err = p.api.Push(ctx, g.ChannelAccessToken, userID, batch)
+		}
+		if err != nil {
+			return nil, err
+		}
+		if first == nil {
+			first = resp
+		}
+	}
+	return first, nil
+}
+
+// reply sends the messages with the reply token of the conversation, if any, and
+// pushes them when there is none or LINE no longer accepts it.
+func (p *lineProvider) reply(ctx context.Context, g *lnmodel.LineGate, userID string, msgs []*outboundMessage) (*sharedmodel.MessageResponse, error) {
+	if token, ok := p.replyTokens.take(g.ID, userID); ok {
+		resp, err := p.api.Reply(ctx, g.ChannelAccessToken, token, msgs)
+		if !errors.Is(err, ErrInvalidReplyToken) {
+			return resp, err
+		}
+		p.logger.Debug("reply token rejected, pushing instead", "gate_id", g.ID, "user_id", userID)
+	}
+	return p.api.Push(ctx, g.ChannelAccessToken, userID, msgs)
+}
+
+// resolveReceiver returns the LINE user ID for the given sub.
+// A LINE user ID is returned as-is; an internal contact UUID is resolved via the contact service.
+func (p *lineProvider) resolveReceiver(ctx context.Context, gate *lnmodel.LineGate, contactID string) (string, error) {
+	if _, err := uuid.Parse(contactID); err != nil {
+		return contactID, nil
+	}
+	if userID, ok := p.receiverCache.Get(contactID); ok {
+		return userID, nil
+	}
+
+	authCtx := contactsync.WithGatewayIdentity(ctx, gate.DomainID, gate.Peer)
+	resp, err := p.contactClient.SearchContact(authCtx, &contactv1.SearchContactRequest{
+		Ids: []string{contactID},
+	})
+	if err != nil {
+		return "", fmt.Errorf("resolve line user for %s: %w", contactID, err)
+	}
+	items := resp.GetContacts()
+	if len(items) == 0 || items[0].GetSubject() == "" {
+		return "", fmt.Errorf("resolve line user for %s: contact not found or has no subject", contactID)
+	}
+	userID := items[0].GetSubject()
+	p.receiverCache.Add(contactID, userID)
+	return userID, nil
+}
+
+func messageID(req *sharedmodel.Message) string {
+	if req.ID == uuid.Nil {
+		return ""
+	}
+	return req.ID.String()
+}
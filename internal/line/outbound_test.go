@@ -0,0 +1,244 @@
+package line
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/google/uuid"
+	sharedmodel "github.com/webitel/im-providers-service/internal/core/model"
+)
+
+const (
+	routeReply = "POST /v2/bot/message/reply"
+	routePush  = "POST /v2/bot/message/push"
+)
+
+func outboundRequest() *sharedmodel.Message {
+	return &sharedmodel.Message{
+		ID:     uuid.MustParse("0190a8a4-aaaa-7000-8000-000000000001"),
+		GateID: testGateID,
+		To:     sharedmodel.Peer{Sub: testUserID},
+	}
+}
+
+func messagesOf(t *testing.T, call apiCall) []map[string]any {
+	t.Helper()
+	raw, _ := call.body["messages"].([]any)
+	msgs := make([]map[string]any, 0, len(raw))
+	for _, m := range raw {
+		msgs = append(msgs, m.(map[string]any))
+	}
+	return msgs
+}
+
+func TestSendText_Push(t *testing.T) {
+	p := newStubbedProvider(t)
+	p.stub.replies[routePush] = stubReply{body: `{"sentMessages":[{"id":"461230966842064897","quoteToken":"IStG5h1Tz7b"}]}`}
+
+	req := outboundRequest()
+	req.Text = "Hello, world"
+	resp, err := p.SendText(context.Background(), req)
+	if err != nil {
+		t.Fatalf("SendText: %v", err)
+	}
+	if resp.ID != "461230966842064897" || resp.MD["quote_token"] != "IStG5h1Tz7b" {
+		t.Errorf("unexpected response: %+v", resp)
+	}
+
+	calls := p.stub.sent()
+	if len(calls) != 1 || calls[0].route != routePush || calls[0].token != "Bearer "+testToken {
+		t.Fatalf("unexpected calls: %+v", calls)
+	}
+	if calls[0].body["to"] != testUserID {
+		t.Errorf("unexpected receiver: %v", calls[0].body["to"])
+	}
+	msgs := messagesOf(t, calls[0])
+	if len(msgs) != 1 || msgs[0]["type"] != "text" || msgs[0]["text"] != "Hello, world" {
+		t.Errorf("unexpected messages: %v", msgs)
+	}
+}
+
+func TestSendText_RepliesOnce(t *testing.T) {
+	p := newStubbedProvider(t)
+	p.replyTokens.remember(testGateID, testUserID, "reply-token-1")
+
+	req := outboundRequest()
+	req.Text = "first"
+	if _, err := p.SendText(context.Background(), req); err != nil {
+		t.Fatalf("SendText: %v", err)
+	}
+	req.Text = "second"
+	if _, err := p.SendText(context.Background(), req); err != nil {
+		t.Fatalf("SendText: %v", err)
+	}
+
+	calls := p.stub.sent()
+	if len(calls) != 2 || calls[0].route != routeReply || calls[1].route != routePush {
+		t.Fatalf("expected a reply then a push, got %+v", calls)
+	}
+	if calls[0].body["replyToken"] != "reply-token-1" {
+		t.Errorf("unexpected reply token: %v", calls[0].body["replyToken"])
+	}
+}
+
+func TestSendText_ExpiredReplyTokenFallsBackToPush(t *testing.T) {
+	p := newStubbedProvider(t)
+	p.replyTokens.remember(testGateID, testUserID, "stale")
+	p.stub.replies[routeReply] = stubReply{status: http.StatusBadRequest, body: `{"message":"Invalid reply token"}`}
+
+	req := outboundRequest()
+	req.Text = "Hello"
+	if _, err := p.SendText(context.Background(), req); err != nil {
+		t.Fatalf("SendText: %v", err)
+	}
+
+	calls := p.stub.sent()
+	if len(calls) != 2 || calls[0].route != routeReply || calls[1].route != routePush {
+		t.Fatalf("expected a reply then a push, got %+v", calls)
+	}
+}
+
+func TestSendText_TokenInvalid(t *testing.T) {
+	p := newStubbedProvider(t)
+	p.stub.replies[routePush] = stubReply{status: http.StatusUnauthorized, body: `{"message":"Authentication failed. Confirm that the access token in the authorization header is valid."}`}
+
+	req := outboundRequest()
+	req.Text = "Hello"
+	_, err := p.SendText(context.Background(), req)
+	if !errors.Is(err, ErrTokenInvalid) {
+		t.Fatalf("SendText() error = %v, want ErrTokenInvalid", err)
+	}
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusUnauthorized {
+		t.Errorf("error does not carry the API status: %v", err)
+	}
+}
+
+func TestSendImage_SplitsRequests(t *testing.T) {
+	p := newStubbedProvider(t)
+	p.replyTokens.remember(testGateID, testUserID, "reply-token-1")
+
+	req := outboundRequest()
+	req.Text = "Photos"
+	for range 6 {
+		req.Images = append(req.Images, &sharedmodel.Image{URL: "https://cdn.example.com/a.jpg"})
+	}
+	if _, err := p.SendImage(context.Background(), req); err != nil {
+		t.Fatalf("SendImage: %v", err)
+	}
+
+	calls := p.stub.sent()
+	if len(calls) != 2 || calls[0].route != routeReply || calls[1].route != routePush {
+		t.Fatalf("expected a reply then a push, got %+v", calls)
+	}
+	first, second := messagesOf(t, calls[0]), messagesOf(t, calls[1])
+	if len(first) != 5 || len(second) != 2 {
+		t.Fatalf("unexpected split: %d + %d", len(first), len(second))
+	}
+	if first[0]["type"] != "text" || first[0]["text"] != "Photos" {
+		t.Errorf("text must come first: %v", first[0])
+	}
+	if img := first[1]; img["type"] != "image" || img["originalContentUrl"] != "https://cdn.example.com/a.jpg" || img["previewImageUrl"] != "https://cdn.example.com/a.jpg" {
+		t.Errorf("unexpected image: %v", img)
+	}
+}
+
+func TestSendDocument_Links(t *testing.T) {
+	p := newStubbedProvider(t)
+
+	req := outboundRequest()
+	req.Text = "Your invoice"
+	req.Documents = []*sharedmodel.Document{
+		{URL: "https://cdn.example.com/invoice.pdf", FileName: "invoice.pdf"},
+		{URL: "https://cdn.example.com/terms.pdf"},
+	}
+	if _, err := p.SendDocument(context.Background(), req); err != nil {
+		t.Fatalf("SendDocument: %v", err)
+	}
+
+	msgs := messagesOf(t, p.stub.sent()[0])
+	want := "Your invoice\ninvoice.pdf: https://cdn.example.com/invoice.pdf\nhttps://cdn.example.com/terms.pdf"
+	if len(msgs) != 1 || msgs[0]["text"] != want {
+		t.Errorf("unexpected messages: %v", msgs)
+	}
+}
+
+func TestSendLocation(t *testing.T) {
+	p := newStubbedProvider(t)
+
+	req := outboundRequest()
+	req.Location = &sharedmodel.Location{Latitude: 35.65910807942215, Longitude: 139.70372892916203}
+	if _, err := p.SendLocation(context.Background(), req); err != nil {
+		t.Fatalf("SendLocation: %v", err)
+	}
+
+	msg := messagesOf(t, p.stub.sent()[0])[0]
+	if msg["type"] != "location" || msg["title"] != "Location" || msg["address"] != "35.65910807942215, 139.70372892916203" {
+		t.Errorf("unexpected location: %v", msg)
+	}
+}
+
+func TestSendInteractive_QuickReplies(t *testing.T) {
+	p := newStubbedProvider(t)
+
+	req := outboundRequest()
+	req.Interactive = &sharedmodel.Interactive{
+		Body: "Rate us",
+		Markup: &sharedmodel.KeyboardMarkup{Rows: []sharedmodel.KeyboardRow{{Buttons: []sharedmodel.KeyboardButton{
+			{ID: "good", Label: "Good", Callback: &sharedmodel.KeyboardButtonCallback{Data: "rate:5"}},
+			{Label: "Share location", Request: &sharedmodel.KeyboardButtonRequest{Action: "location"}},
+		}}}},
+	}
+	if _, err := p.SendInteractive(context.Background(), req); err != nil {
+		t.Fatalf("SendInteractive: %v", err)
+	}
+
+	msg := messagesOf(t, p.stub.sent()[0])[0]
+	if msg["type"] != "text" || msg["text"] != "Rate us" {
+		t.Fatalf("unexpected message: %v", msg)
+	}
+	items := msg["quickReply"].(map[string]any)["items"].([]any)
+	if len(items) != 2 {
+		t.Fatalf("expected 2 quick replies, got %v", items)
+	}
+	postback := items[0].(map[string]any)["action"].(map[string]any)
+	data := decodePostback(postback["data"].(string))
+	if postback["type"] != "postback" || data.MessageID != req.ID.String() || data.Code != "good" || data.Data != "rate:5" {
+		t.Errorf("unexpected postback action: %v", postback)
+	}
+	if location := items[1].(map[string]any)["action"].(map[string]any); location["type"] != "location" {
+		t.Errorf("unexpected location action: %v", location)
+	}
+}
+
+func TestBuildInteractive_URLButtonsKeepTemplateSlots(t *testing.T) {
+	var buttons []sharedmodel.KeyboardButton
+	for _, code := range []string{"a", "b", "c", "d", "e"} {
+		buttons = append(buttons, sharedmodel.KeyboardButton{ID: code, Label: code, Callback: &sharedmodel.KeyboardButtonCallback{Data: code}})
+	}
+	buttons = append(buttons, sharedmodel.KeyboardButton{Label: "Site", URL: &sharedmodel.KeyboardButtonURL{URL: "https://example.com"}})
+
+	msg := buildInteractive("msg-1", "Choose", buttons)
+	if msg.Type != MessageTemplate || msg.Template == nil {
+		t.Fatalf("expected a template, got %+v", msg)
+	}
+	actions := msg.Template.Actions
+	if len(actions) != templateMaxActions || actions[3].Type != actionURI || actions[3].URI != "https://example.com" {
+		t.Errorf("URL button must keep its template slot: %+v", actions)
+	}
+	if msg.QuickReply == nil || len(msg.QuickReply.Items) != 2 {
+		t.Errorf("left-over callback buttons must become quick replies: %+v", msg.QuickReply)
+	}
+}
+
+func TestSendInteractive_NoText(t *testing.T) {
+	p := newStubbedProvider(t)
+	if _, err := p.SendInteractive(context.Background(), outboundRequest()); err == nil {
+		t.Fatal("expected an error")
+	}
+	if calls := p.stub.sent(); len(calls) != 0 {
+		t.Errorf("nothing must be sent: %+v", calls)
+	}
+}
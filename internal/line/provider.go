@@ -0,0 +1,105 @@
+// Package line implements the LINE Messaging API provider.
+// https://developers.line.biz/en/docs/messaging-api/
+package line
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+	lru "github.com/hashicorp/golang-lru/v2"
+	imcontact "github.com/webitel/im-providers-service/infra/client/grpc/im-contact"
+	imgateway "github.com/webitel/im-providers-service/infra/client/grpc/im-gateway"
+	sharedsvc "github.com/webitel/im-providers-service/internal/core/service"
+	sharedstore "github.com/webitel/im-providers-service/internal/core/store"
+	lnmodel "github.com/webitel/im-providers-service/internal/line/model"
+	lnstore "github.com/webitel/im-providers-service/internal/line/store"
+	"github.com/webitel/im-providers-service/internal/provider"
+)
+
+type lineProvider struct {
+	api botAPI
+	// httpClient downloads inbound media hosted outside of LINE.
+	httpClient    *http.Client
+	logger        *slog.Logger
+	messenger     sharedsvc.Messenger
+	gateCache     sharedstore.GateCache
+	userCache     sharedstore.ExternalUserCache
+	repo          lnstore.LineStore
+	gatewayer     *imgateway.Client
+	media         sharedsvc.MediaManager
+	contactClient *imcontact.Client
+	// receiverCache maps internal contact UUID → LINE user ID to avoid an
+	// im-contact round-trip on every outbound message.
+	receiverCache *lru.Cache[string, string]
+	// replyTokens keeps the reply token of the latest event per conversation:
+	// replies are free, push messages count against the monthly quota.
+	replyTokens *replyTokens
+}
+
+func New(
+	m sharedsvc.Messenger,
+	l *slog.Logger,
+	gc sharedstore.GateCache,
+	uc sharedstore.ExternalUserCache,
+	repo lnstore.LineStore,
+	gatewayer *imgateway.Client,
+	media sharedsvc.MediaManager,
+	contactClient *imcontact.Client,
+	api *apiClient,
+) provider.Provider {
+	receiverCache, _ := lru.New[string, string](1000)
+	return &lineProvider{
+		api:           api,
+		httpClient:    &http.Client{Timeout: 30 * time.Second},
+		logger:        l.With("provider", "line"),
+		messenger:     m,
+		gateCache:     gc,
+		userCache:     uc,
+		repo:          repo,
+		gatewayer:     gatewayer,
+		media:         media,
+		contactClient: contactClient,
+		receiverCache: receiverCache,
+		replyTokens:   newReplyTokens(replyTokenConversations, replyTokenTTL),
+	}
+}
+
+var (
+	_ provider.SignatureValidator = (*lineProvider)(nil)
+	_ provider.SignatureHeader    = (*lineProvider)(nil)
+	_ provider.InteractiveSender  = (*lineProvider)(nil)
+	_ provider.LocationSender     = (*lineProvider)(nil)
+)
+
+func (p *lineProvider) Type() string { return "line" }
+
+// resolveGate returns the gate a webhook was delivered to. The webhook URI segment is
+// the gate ID, set by the gate service as the channel webhook endpoint. Disabled gates
+// are short-circuited from the cache to avoid a DB round-trip on every request.
+func (p *lineProvider) resolveGate(ctx context.Context) (*lnmodel.LineGate, error) {
+	gateID, _ := ctx.Value(provider.WebhookURIKey).(string)
+	if _, err := uuid.Parse(gateID); err != nil {
+		return nil, fmt.Errorf("invalid webhook uri %q: %w", gateID, sharedstore.ErrNotFound)
+	}
+
+	if cached, ok := p.gateCache.Get(gateID); ok && !cached.Enabled {
+		return &lnmodel.LineGate{ID: gateID, Enabled: false}, nil
+	}
+
+	g, err := p.repo.Select(ctx, gateID)
+	if err != nil {
+		return nil, err
+	}
+	p.gateCache.Set(gateID, sharedstore.GateState{
+		GateID:  g.ID,
+		Enabled: g.Enabled,
+		Issuer:  g.Peer.Iss,
+		Sub:     g.Peer.Sub,
+		Domain:  g.DomainID,
+	})
+	return g, nil
+}
@@ -0,0 +1,163 @@
+package line
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	sharedmodel "github.com/webitel/im-providers-service/internal/core/model"
+	sharedstore "github.com/webitel/im-providers-service/internal/core/store"
+	lnmodel "github.com/webitel/im-providers-service/internal/line/model"
+	lnstore "github.com/webitel/im-providers-service/internal/line/store"
+	"github.com/webitel/im-providers-service/internal/provider"
+	"github.com/webitel/im-providers-service/internal/provider/providertest"
+)
+
+const (
+	testGateID = "0190a8a4-5f0e-7c2a-9d3b-0a1b2c3d4e5f"
+	testSecret = "8c570fa6dd201bb328f1c1eac23a96d8"
+	testToken  = "kR8cB0wjPdNvXqTm2Lz5Yh=="
+	testUserID = "U4af4980629b1a2c3d4e5f6a7b8c9d0e1"
+)
+
+var noopLogger = slog.New(slog.NewTextHandler(io.Discard, nil))
+
+// -- Messaging API stub --
+
+type apiCall struct {
+	route string
+	token string
+	body  map[string]any
+}
+
+type stubReply struct {
+	status      int
+	body        string
+	contentType string
+}
+
+// botStub is a Messaging API stub serving both the API and the data host. Every
+// route ("METHOD /path") answers with the reply set for it, or with an empty sent
+// message list when none is set.
+type botStub struct {
+	mu      sync.Mutex
+	calls   []apiCall
+	replies map[string]stubReply
+}
+
+func newBotStub(t *testing.T) (*botStub, *apiClient) {
+	t.Helper()
+
+	stub := &botStub{replies: map[string]stubReply{}}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]any
+		if r.ContentLength != 0 && r.Method != http.MethodGet {
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				t.Errorf("decoding %s body: %v", r.URL.Path, err)
+			}
+		}
+
+		route := r.Method + " " + r.URL.Path
+		stub.mu.Lock()
+		stub.calls = append(stub.calls, apiCall{route: route, token: r.Header.Get("Authorization"), body: body})
+		reply, ok := stub.replies[route]
+		stub.mu.Unlock()
+
+		if !ok {
+			reply = stubReply{body: `{"sentMessages":[]}`}
+		}
+		if reply.contentType == "" {
+			reply.contentType = "application/json"
+		}
+		if reply.status == 0 {
+			reply.status = http.StatusOK
+		}
+		w.Header().Set("Content-Type", reply.contentType)
+		w.WriteHeader(reply.status)
+		_, _ = w.Write([]byte(reply.body))
+	}))
+	t.Cleanup(server.Close)
+
+	api := newAPIClient(noopLogger)
+	api.apiURL = server.URL
+	api.dataURL = server.URL
+	return stub, api
+}
+
+func (s *botStub) sent() []apiCall {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]apiCall(nil), s.calls...)
+}
+
+// -- fakes --
+
+// officialAccounts serves the gates to the webhook and to outbound messages. The
+// provider only reads gates; writing them is the job of the gate service.
+type officialAccounts map[string]*lnmodel.LineGate
+
+var _ lnstore.LineStore = officialAccounts(nil)
+
+func (a officialAccounts) Select(_ context.Context, id string) (*lnmodel.LineGate, error) {
+	g, ok := a[id]
+	if !ok {
+		return nil, sharedstore.ErrNotFound
+	}
+	cp := *g
+	return &cp, nil
+}
+
+func (officialAccounts) Insert(context.Context, int64, *lnmodel.LineGate) error {
+	panic("line provider must not insert gates")
+}
+
+func (officialAccounts) Update(context.Context, *lnmodel.LineGate) error {
+	panic("line provider must not update gates")
+}
+
+func (officialAccounts) Delete(context.Context, string) error {
+	panic("line provider must not delete gates")
+}
+
+func supportAccount() *lnmodel.LineGate {
+	return &lnmodel.LineGate{
+		ID:                 testGateID,
+		DomainID:           1,
+		Name:               "Support",
+		Peer:               sharedmodel.Peer{Sub: "bot-1", Iss: "line"},
+		BotUserID:          "U0bot0000000000000000000000000000",
+		ChannelSecret:      testSecret,
+		ChannelAccessToken: testToken,
+		Enabled:            true,
+	}
+}
+
+// stubbedProvider is the provider of the support account talking to the Messaging API stub.
+type stubbedProvider struct {
+	*lineProvider
+	stub      *botStub
+	messenger *providertest.Messenger
+	media     *providertest.Media
+	accounts  officialAccounts
+}
+
+func newStubbedProvider(t *testing.T) *stubbedProvider {
+	t.Helper()
+
+	stub, api := newBotStub(t)
+	messenger := &providertest.Messenger{}
+	media := &providertest.Media{}
+	accounts := officialAccounts{testGateID: supportAccount()}
+
+	p := New(messenger, noopLogger, providertest.NewGateCache(), providertest.KnownUsers{}, accounts, nil, media, nil, api).(*lineProvider)
+	return &stubbedProvider{lineProvider: p, stub: stub, messenger: messenger, media: media, accounts: accounts}
+}
+
+func webhookContext(gateID string) context.Context {
+	return context.WithValue(context.Background(), provider.WebhookURIKey, gateID)
+}
@@ -0,0 +1,56 @@
+package line
+
+import (
+	"sync"
+	"time"
+
+	"github.com/hashicorp/golang-lru/v2/expirable"
+)
+
+const (
+	// replyTokenTTL keeps a reply token a little shorter than the minute LINE
+	// guarantees it for, so a reply is not attempted with a token about to expire.
+	replyTokenTTL = 50 * time.Second
+	// replyTokenConversations bounds the number of conversations tracked at once.
+	replyTokenConversations = 10000
+)
+
+// replyTokens keeps the latest reply token of every conversation. A reply token can
+// be used once, so take removes it; a newer event of the conversation replaces it.
+type replyTokens struct {
+	mu    sync.Mutex
+	cache *expirable.LRU[string, string]
+}
+
+func newReplyTokens(size int, ttl time.Duration) *replyTokens {
+	return &replyTokens{cache: expirable.NewLRU[string, string](size, nil, ttl)}
+}
+
+// remember stores the reply token of an event from the user.
+func (r *replyTokens) remember(gateID, userID, token string) {
+	if token == "" {
+		return
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.cache.Add(conversationKey(gateID, userID), token)
+}
+
+// take returns the fresh reply token of the conversation, if any, and forgets it.
+func (r *replyTokens) take(gateID, userID string) (string, bool) {
+	k := conversationKey(gateID, userID)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	token, ok := r.cache.Get(k)
+	if ok {
+		r.cache.Remove(k)
+	}
+	return token, ok
+}
+
+func conversationKey(gateID, userID string) string {
+	return gateID + ":" + userID
+}
@@ -0,0 +1,174 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/webitel/im-providers-service/config"
+	lnmodel "github.com/webitel/im-providers-service/internal/line/model"
+	lnstore "github.com/webitel/im-providers-service/internal/line/store"
+)
+
+// ErrPublicURLNotSet is returned when a webhook is to be set but service.public_url is empty.
+var ErrPublicURLNotSet = errors.New("line: service.public_url is not configured")
+
+var _ LineManager = (*LineService)(nil)
+
+type LineManager interface {
+	CreateGate(ctx context.Context, req lnmodel.CreateLine) (*lnmodel.LineGate, error)
+	GetGate(ctx context.Context, id string) (*lnmodel.LineGate, error)
+	UpdateGate(ctx context.Context, req lnmodel.UpdateLine) (*lnmodel.LineGate, error)
+	DeleteGate(ctx context.Context, id string) (*lnmodel.LineGate, error)
+}
+
+// BotAPI is the subset of the Messaging API used to manage the channel webhook.
+// Defined here (exported) so the parent line package can satisfy it without an import cycle.
+type BotAPI interface {
+	GetBotInfo(ctx context.Context, token string) (*lnmodel.BotInfo, error)
+	SetWebhookEndpoint(ctx context.Context, token, endpoint string) error
+}
+
+type LineService struct {
+	repo   lnstore.LineStore
+	botAPI BotAPI
+	cfg    *config.Config
+	log    *slog.Logger
+}
+
+func NewLineService(repo lnstore.LineStore, botAPI BotAPI, cfg *config.Config, log *slog.Logger) *LineService {
+	return &LineService{
+		repo:   repo,
+		botAPI: botAPI,
+		cfg:    cfg,
+		log:    log.With("layer", "service", "domain", "line_gate"),
+	}
+}
+
+// CreateGate stores the gate of the channel the access token belongs to and points
+// the channel webhook to it. The gate is removed again when LINE rejects the webhook.
+func (s *LineService) CreateGate(ctx context.Context, req lnmodel.CreateLine) (*lnmodel.LineGate, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	if s.cfg.Service.PublicURL == "" {
+		return nil, ErrPublicURLNotSet
+	}
+
+	bot, err := s.botAPI.GetBotInfo(ctx, req.ChannelAccessToken)
+	if err != nil {
+		return nil, fmt.Errorf("get line bot info: %w", err)
+	}
+
+	gate := &lnmodel.LineGate{
+		Name:               req.Name,
+		BotUserID:          bot.UserID,
+		BasicID:            bot.BasicID,
+		ChannelSecret:      req.ChannelSecret,
+		ChannelAccessToken: req.ChannelAccessToken,
+		Peer:               req.Peer,
+		Enabled:            true,
+	}
+
+	if err := s.repo.Insert(ctx, req.Dc, gate); err != nil {
+		s.log.Error("failed to create line gate", "bot_user_id", bot.UserID, "err", err)
+		return nil, err
+	}
+
+	if err := s.setWebhook(ctx, gate); err != nil {
+		if delErr := s.repo.Delete(ctx, gate.ID); delErr != nil {
+			s.log.Error("failed to remove line gate without webhook", "id", gate.ID, "err", delErr)
+		}
+		return nil, err
+	}
+
+	s.log.Info("line gate created", "id", gate.ID, "bot_user_id", gate.BotUserID, "webhook", gate.WebhookURL)
+	return gate, nil
+}
+
+func (s *LineService) GetGate(ctx context.Context, id string) (*lnmodel.LineGate, error) {
+	return s.repo.Select(ctx, id)
+}
+
+// UpdateGate applies the changes; a new access token may belong to another channel,
+// whose webhook is then pointed to the gate.
+func (s *LineService) UpdateGate(ctx context.Context, req lnmodel.UpdateLine) (*lnmodel.LineGate, error) {
+	gate, err := s.repo.Select(ctx, req.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	prevToken := gate.ChannelAccessToken
+	req.ApplyTo(gate)
+
+	if gate.ChannelAccessToken != prevToken {
+		if s.cfg.Service.PublicURL == "" {
+			return nil, ErrPublicURLNotSet
+		}
+
+		bot, err := s.botAPI.GetBotInfo(ctx, gate.ChannelAccessToken)
+		if err != nil {
+			return nil, fmt.Errorf("get line bot info: %w", err)
+		}
+		gate.BotUserID, gate.BasicID = bot.UserID, bot.BasicID
+
+		// The gate must resolve with the new secret before LINE delivers to the webhook.
+		if err := s.repo.Update(ctx, gate); err != nil {
+			s.log.Error("failed to update line gate", "id", req.ID, "err", err)
+			return nil, err
+		}
+		if err := s.setWebhook(ctx, gate); err != nil {
+			return nil, err
+		}
+
+		s.log.Info("line gate updated", "id", gate.ID, "bot_user_id", gate.BotUserID)
+		return gate, nil
+	}
+
+	if err := s.repo.Update(ctx, gate); err != nil {
+		s.log.Error("failed to update line gate", "id", req.ID, "err", err)
+		return nil, err
+	}
+
+	s.log.Info("line gate updated", "id", gate.ID)
+	return gate, nil
+}
+
+// DeleteGate removes the gate. The Messaging API cannot unset a webhook endpoint,
+// so the channel keeps delivering to the removed gate, which answers not found.
+func (s *LineService) DeleteGate(ctx context.Context, id string) (*lnmodel.LineGate, error) {
+	gate, err := s.repo.Select(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.repo.Delete(ctx, id); err != nil {
+		s.log.Error("failed to delete line gate", "id", id, "err", err)
+		return nil, err
+	}
+
+	s.log.Warn("line gate removed", "id", id, "bot_user_id", gate.BotUserID)
+	return gate, nil
+}
+
+// setWebhook points the channel webhook to the gate and stores the URL.
+func (s *LineService) setWebhook(ctx context.Context, gate *lnmodel.LineGate) error {
+	url := s.webhookURL(gate.ID)
+	if err := s.botAPI.SetWebhookEndpoint(ctx, gate.ChannelAccessToken, url); err != nil {
+		s.log.Error("failed to set line webhook", "id", gate.ID, "url", url, "err", err)
+		return fmt.Errorf("set line webhook: %w", err)
+	}
+
+	gate.WebhookURL = url
+	if err := s.repo.Update(ctx, gate); err != nil {
+		s.log.Error("failed to store line webhook", "id", gate.ID, "err", err)
+		return err
+	}
+	return nil
+}
+
+// webhookURL is the webhook URL of the gate: the gate ID is the webhook URI segment.
+func (s *LineService) webhookURL(gateID string) string {
+	return s.cfg.Service.PublicURL + s.cfg.Service.WebhookPath + "/line/" + gateID
+}
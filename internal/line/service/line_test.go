@@ -0,0 +1,215 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"testing"
+
+	"github.com/webitel/im-providers-service/config"
+	sharedstore "github.com/webitel/im-providers-service/internal/core/store"
+	lnmodel "github.com/webitel/im-providers-service/internal/line/model"
+	lnstore "github.com/webitel/im-providers-service/internal/line/store"
+)
+
+var noopLogger = slog.New(slog.NewTextHandler(io.Discard, nil))
+
+// lineChannels stores the gates under sequential IDs and records the deleted ones.
+type lineChannels struct {
+	gates   map[string]*lnmodel.LineGate
+	deleted []string
+}
+
+var _ lnstore.LineStore = (*lineChannels)(nil)
+
+func (c *lineChannels) Insert(_ context.Context, dc int64, g *lnmodel.LineGate) error {
+	g.ID = fmt.Sprintf("gate-%d", len(c.gates)+len(c.deleted)+1)
+	g.DomainID = dc
+	cp := *g
+	c.gates[g.ID] = &cp
+	return nil
+}
+
+func (c *lineChannels) Select(_ context.Context, id string) (*lnmodel.LineGate, error) {
+	g, ok := c.gates[id]
+	if !ok {
+		return nil, sharedstore.ErrNotFound
+	}
+	cp := *g
+	return &cp, nil
+}
+
+func (c *lineChannels) Update(_ context.Context, g *lnmodel.LineGate) error {
+	cp := *g
+	c.gates[g.ID] = &cp
+	return nil
+}
+
+func (c *lineChannels) Delete(_ context.Context, id string) error {
+	c.deleted = append(c.deleted, id)
+	delete(c.gates, id)
+	return nil
+}
+
+// botConsole is the Messaging API of two channels, one access token each. It
+// records the webhook endpoint set for every token.
+type botConsole struct {
+	bots       map[string]*lnmodel.BotInfo
+	webhookErr error
+	webhooks   map[string]string
+}
+
+func (c *botConsole) GetBotInfo(_ context.Context, token string) (*lnmodel.BotInfo, error) {
+	b, ok := c.bots[token]
+	if !ok {
+		return nil, errors.New("authentication failed")
+	}
+	return b, nil
+}
+
+func (c *botConsole) SetWebhookEndpoint(_ context.Context, token, endpoint string) error {
+	if c.webhookErr != nil {
+		return c.webhookErr
+	}
+	c.webhooks[token] = endpoint
+	return nil
+}
+
+func newLineService(publicURL string) (*LineService, *lineChannels, *botConsole) {
+	channels := &lineChannels{gates: map[string]*lnmodel.LineGate{}}
+	console := &botConsole{
+		bots: map[string]*lnmodel.BotInfo{
+			"token-1": {UserID: "U1", BasicID: "@support", DisplayName: "Support"},
+			"token-2": {UserID: "U2", BasicID: "@sales", DisplayName: "Sales"},
+		},
+		webhooks: map[string]string{},
+	}
+	cfg := &config.Config{Service: config.ServiceConfig{PublicURL: publicURL, WebhookPath: "/wh"}}
+	return NewLineService(channels, console, cfg, noopLogger), channels, console
+}
+
+func createRequest() lnmodel.CreateLine {
+	return lnmodel.CreateLine{Name: "Support", Dc: 1, ChannelSecret: "secret-1", ChannelAccessToken: "token-1"}
+}
+
+func TestCreateGate_SetsWebhook(t *testing.T) {
+	svc, channels, console := newLineService("https://im.example.com")
+
+	gate, err := svc.CreateGate(context.Background(), createRequest())
+	if err != nil {
+		t.Fatalf("CreateGate: %v", err)
+	}
+
+	const wantURL = "https://im.example.com/wh/line/gate-1"
+	if console.webhooks["token-1"] != wantURL {
+		t.Errorf("webhook = %q, want %q", console.webhooks["token-1"], wantURL)
+	}
+	stored := channels.gates["gate-1"]
+	if stored == nil || stored.WebhookURL != wantURL || stored.BotUserID != "U1" || stored.BasicID != "@support" || stored.ChannelSecret != "secret-1" || !stored.Enabled {
+		t.Errorf("unexpected stored gate: %+v", stored)
+	}
+	if gate.WebhookURL != wantURL {
+		t.Errorf("returned gate webhook = %q", gate.WebhookURL)
+	}
+}
+
+func TestCreateGate_WebhookRejectedRemovesGate(t *testing.T) {
+	svc, channels, console := newLineService("https://im.example.com")
+	console.webhookErr = errors.New("invalid webhook endpoint")
+
+	if _, err := svc.CreateGate(context.Background(), createRequest()); err == nil {
+		t.Fatal("expected an error")
+	}
+	if len(channels.gates) != 0 || len(channels.deleted) != 1 {
+		t.Errorf("gate without webhook must be removed: gates=%v deleted=%v", channels.gates, channels.deleted)
+	}
+}
+
+func TestCreateGate_Rejected(t *testing.T) {
+	tests := []struct {
+		name      string
+		publicURL string
+		req       lnmodel.CreateLine
+		wantErr   error
+	}{
+		{name: "missing fields", publicURL: "https://im.example.com", req: lnmodel.CreateLine{Dc: 1}},
+		{name: "public url not set", req: createRequest(), wantErr: ErrPublicURLNotSet},
+		{name: "invalid token", publicURL: "https://im.example.com", req: lnmodel.CreateLine{Name: "x", Dc: 1, ChannelSecret: "s", ChannelAccessToken: "bad"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc, channels, _ := newLineService(tt.publicURL)
+			_, err := svc.CreateGate(context.Background(), tt.req)
+			if err == nil {
+				t.Fatal("expected an error")
+			}
+			if tt.wantErr != nil && !errors.Is(err, tt.wantErr) {
+				t.Errorf("error = %v, want %v", err, tt.wantErr)
+			}
+			if len(channels.gates) != 0 {
+				t.Errorf("no gate must be stored, got %v", channels.gates)
+			}
+		})
+	}
+}
+
+func TestUpdateGate_NewChannelSetsWebhook(t *testing.T) {
+	svc, channels, console := newLineService("https://im.example.com")
+	if _, err := svc.CreateGate(context.Background(), createRequest()); err != nil {
+		t.Fatalf("CreateGate: %v", err)
+	}
+
+	token, secret := "token-2", "secret-2"
+	gate, err := svc.UpdateGate(context.Background(), lnmodel.UpdateLine{ID: "gate-1", ChannelAccessToken: &token, ChannelSecret: &secret})
+	if err != nil {
+		t.Fatalf("UpdateGate: %v", err)
+	}
+
+	stored := channels.gates["gate-1"]
+	if gate.BotUserID != "U2" || stored.ChannelAccessToken != "token-2" || stored.ChannelSecret != "secret-2" || stored.BasicID != "@sales" {
+		t.Errorf("gate not moved to the new channel: %+v", stored)
+	}
+	if console.webhooks["token-2"] != "https://im.example.com/wh/line/gate-1" {
+		t.Errorf("webhook of the new channel not set: %v", console.webhooks)
+	}
+}
+
+func TestUpdateGate_NameOnly(t *testing.T) {
+	svc, channels, console := newLineService("https://im.example.com")
+	if _, err := svc.CreateGate(context.Background(), createRequest()); err != nil {
+		t.Fatalf("CreateGate: %v", err)
+	}
+	delete(console.webhooks, "token-1")
+
+	name := "Renamed"
+	if _, err := svc.UpdateGate(context.Background(), lnmodel.UpdateLine{ID: "gate-1", Name: &name}); err != nil {
+		t.Fatalf("UpdateGate: %v", err)
+	}
+	if channels.gates["gate-1"].Name != "Renamed" {
+		t.Errorf("name not updated: %+v", channels.gates["gate-1"])
+	}
+	if len(console.webhooks) != 0 {
+		t.Errorf("webhook must be left alone: %v", console.webhooks)
+	}
+}
+
+func TestDeleteGate(t *testing.T) {
+	svc, channels, _ := newLineService("https://im.example.com")
+	if _, err := svc.CreateGate(context.Background(), createRequest()); err != nil {
+		t.Fatalf("CreateGate: %v", err)
+	}
+
+	if _, err := svc.DeleteGate(context.Background(), "gate-1"); err != nil {
+		t.Fatalf("DeleteGate: %v", err)
+	}
+	if len(channels.gates) != 0 {
+		t.Errorf("gate not removed: %v", channels.gates)
+	}
+
+	if _, err := svc.DeleteGate(context.Background(), "gate-1"); !errors.Is(err, sharedstore.ErrNotFound) {
+		t.Errorf("second delete error = %v, want ErrNotFound", err)
+	}
+}
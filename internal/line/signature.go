@@ -0,0 +1,45 @@
+package line
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+)
+
+// signatureHeader carries the base64 HMAC-SHA256 of the request body keyed by the channel secret.
+// https://developers.line.biz/en/reference/messaging-api/#signature-validation
+const signatureHeader = "X-Line-Signature"
+
+// SignatureHeader implements provider.SignatureHeader.
+func (p *lineProvider) SignatureHeader() string { return signatureHeader }
+
+// ValidateSignature implements provider.SignatureValidator.
+// It validates the X-Line-Signature header sent with every webhook request.
+func (p *lineProvider) ValidateSignature(ctx context.Context, header string, body []byte) error {
+	if header == "" {
+		return fmt.Errorf("missing %s header", signatureHeader)
+	}
+
+	gate, err := p.resolveGate(ctx)
+	if err != nil {
+		return fmt.Errorf("signature: gate lookup failed: %w", err)
+	}
+	if !gate.Enabled {
+		// Events of a disabled gate are acknowledged and dropped by HandleWebhook.
+		return nil
+	}
+
+	if !validSignature(gate.ChannelSecret, header, body) {
+		return fmt.Errorf("signature mismatch")
+	}
+	return nil
+}
+
+func validSignature(secret, signature string, body []byte) bool {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	expected := base64.StdEncoding.EncodeToString(mac.Sum(nil))
+	return hmac.Equal([]byte(signature), []byte(expected))
+}
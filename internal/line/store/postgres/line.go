@@ -0,0 +1,180 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/georgysavva/scany/v2/pgxscan"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/webitel/im-providers-service/infra/db/pg"
+	sharedmodel "github.com/webitel/im-providers-service/internal/core/model"
+	sharedstore "github.com/webitel/im-providers-service/internal/core/store"
+	lnmodel "github.com/webitel/im-providers-service/internal/line/model"
+	lnstore "github.com/webitel/im-providers-service/internal/line/store"
+	"github.com/webitel/im-providers-service/pkg/crypto"
+)
+
+var _ lnstore.LineStore = (*lineStore)(nil)
+
+type lineStore struct {
+	pool   *pgxpool.Pool
+	crypto crypto.Encryptor
+	cache  sharedstore.GateCache
+}
+
+func NewLineStore(pool *pgxpool.Pool, crypt crypto.Encryptor, cache sharedstore.GateCache) lnstore.LineStore {
+	return &lineStore{
+		pool:   pool,
+		crypto: crypt,
+		cache:  cache,
+	}
+}
+
+func (s *lineStore) Insert(ctx context.Context, dc int64, g *lnmodel.LineGate) error {
+	secret, token, err := s.encrypt(g)
+	if err != nil {
+		return err
+	}
+
+	const query = `
+	WITH new_gate AS (
+		INSERT INTO im_provider.gates (dc, name, type, enabled)
+		VALUES ($1, $2, 'line', $3)
+		RETURNING id, name, created_at, updated_at
+	),
+	new_bot AS (
+		INSERT INTO im_provider.bots (sub, iss, gate_id)
+		SELECT $4, $5, id FROM new_gate
+		RETURNING id
+	)
+	INSERT INTO im_provider.line (gate_id, bot_user_id, basic_id, channel_secret, channel_access_token, webhook_url)
+	SELECT id, $6, $7, $8, $9, $10 FROM new_gate
+	RETURNING
+		gate_id,
+		(SELECT name FROM new_gate),
+		(SELECT created_at FROM new_gate),
+		(SELECT updated_at FROM new_gate)`
+
+	err = s.pool.QueryRow(ctx, query,
+		dc, g.Name, g.Enabled, g.Peer.Sub, g.Peer.Iss, g.BotUserID, g.BasicID, secret, token, g.WebhookURL,
+	).Scan(&g.ID, &g.Name, &g.CreatedAt, &g.UpdatedAt)
+	if err != nil {
+		if pg.IsUniqueViolation(err) {
+			return fmt.Errorf("line bot %s is already bound: %w", g.BotUserID, sharedstore.ErrConflict)
+		}
+		return fmt.Errorf("postgres: insert line gateway: %w", err)
+	}
+
+	g.DomainID = dc
+	s.mapVirtualFields(g)
+	return nil
+}
+
+func (s *lineStore) Select(ctx context.Context, id string) (*lnmodel.LineGate, error) {
+	const query = `
+	SELECT
+		g.id,
+		g.dc AS domain_id,
+		g.name,
+		g.enabled,
+		g.created_at,
+		g.updated_at,
+		b.sub AS "peer.sub",
+		b.iss AS "peer.iss",
+		ln.bot_user_id,
+		ln.basic_id,
+		ln.channel_secret,
+		ln.channel_access_token,
+		ln.webhook_url
+	FROM im_provider.gates g
+	JOIN im_provider.bots b ON g.id = b.gate_id
+	JOIN im_provider.line ln ON g.id = ln.gate_id
+	WHERE g.id = $1`
+
+	var g lnmodel.LineGate
+	if err := pgxscan.Get(ctx, s.pool, &g, query, id); err != nil {
+		if pgxscan.NotFound(err) {
+			return nil, sharedstore.ErrNotFound
+		}
+		return nil, fmt.Errorf("postgres: select line gate: %w", err)
+	}
+
+	if dec, err := s.crypto.Decrypt(g.ChannelSecret); err == nil {
+		g.ChannelSecret = dec
+	}
+	if dec, err := s.crypto.Decrypt(g.ChannelAccessToken); err == nil {
+		g.ChannelAccessToken = dec
+	}
+
+	s.mapVirtualFields(&g)
+	return &g, nil
+}
+
+func (s *lineStore) Update(ctx context.Context, g *lnmodel.LineGate) error {
+	secret, token, err := s.encrypt(g)
+	if err != nil {
+		return err
+	}
+
+	err = pgx.BeginFunc(ctx, s.pool, func(tx pgx.Tx) error {
+		const uGate = `UPDATE im_provider.gates SET name = $1, enabled = $2, updated_at = NOW() WHERE id = $3 RETURNING updated_at`
+		if err := tx.QueryRow(ctx, uGate, g.Name, g.Enabled, g.ID).Scan(&g.UpdatedAt); err != nil {
+			return err
+		}
+
+		const uBot = `UPDATE im_provider.bots SET sub = $1, iss = $2 WHERE gate_id = $3`
+		if _, err := tx.Exec(ctx, uBot, g.Peer.Sub, g.Peer.Iss, g.ID); err != nil {
+			return err
+		}
+
+		const uConfig = `
+			UPDATE im_provider.line
+			SET bot_user_id = $1, basic_id = $2, channel_secret = $3, channel_access_token = $4, webhook_url = $5
+			WHERE gate_id = $6`
+		_, err := tx.Exec(ctx, uConfig, g.BotUserID, g.BasicID, secret, token, g.WebhookURL, g.ID)
+		return err
+	})
+	if err != nil {
+		if pg.IsUniqueViolation(err) {
+			return fmt.Errorf("line bot %s is already bound: %w", g.BotUserID, sharedstore.ErrConflict)
+		}
+		return fmt.Errorf("postgres: update line gate: %w", err)
+	}
+
+	s.cache.Delete(g.ID)
+	s.mapVirtualFields(g)
+	return nil
+}
+
+func (s *lineStore) Delete(ctx context.Context, id string) error {
+	res, err := s.pool.Exec(ctx, "DELETE FROM im_provider.gates WHERE id = $1 AND type = 'line'", id)
+	if err != nil {
+		return fmt.Errorf("postgres: delete line gate: %w", err)
+	}
+	if res.RowsAffected() == 0 {
+		return sharedstore.ErrNotFound
+	}
+
+	s.cache.Delete(id)
+	return nil
+}
+
+// encrypt returns the channel secret and access token encrypted for storage.
+func (s *lineStore) encrypt(g *lnmodel.LineGate) (secret, token string, err error) {
+	if secret, err = s.crypto.Encrypt(g.ChannelSecret); err != nil {
+		return "", "", fmt.Errorf("crypto: %w", err)
+	}
+	if token, err = s.crypto.Encrypt(g.ChannelAccessToken); err != nil {
+		return "", "", fmt.Errorf("crypto: %w", err)
+	}
+	return secret, token, nil
+}
+
+func (s *lineStore) mapVirtualFields(g *lnmodel.LineGate) {
+	if g.Enabled {
+		g.Status = sharedmodel.StatusActive
+	} else {
+		g.Status = sharedmodel.StatusDisabled
+	}
+}
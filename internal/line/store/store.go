@@ -0,0 +1,16 @@
+package store
+
+import (
+	"context"
+
+	lnmodel "github.com/webitel/im-providers-service/internal/line/model"
+)
+
+// LineStore manages LINE Official Account integrations.
+type LineStore interface {
+	// Insert creates the gate together with its bot peer and LINE channel settings.
+	Insert(ctx context.Context, dc int64, g *lnmodel.LineGate) error
+	Select(ctx context.Context, id string) (*lnmodel.LineGate, error)
+	Update(ctx context.Context, g *lnmodel.LineGate) error
+	Delete(ctx context.Context, id string) error
+}
@@ -0,0 +1,206 @@
+package line
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// Webhook event types.
+// https://developers.line.biz/en/reference/messaging-api/#webhook-event-objects
+const (
+	EventMessage  = "message"
+	EventFollow   = "follow"
+	EventUnfollow = "unfollow"
+	EventPostback = "postback"
+)
+
+// Message types, both inbound and outbound.
+// https://developers.line.biz/en/reference/messaging-api/#message-event
+const (
+	MessageText     = "text"
+	MessageImage    = "image"
+	MessageVideo    = "video"
+	MessageAudio    = "audio"
+	MessageFile     = "file"
+	MessageLocation = "location"
+	MessageSticker  = "sticker"
+	MessageTemplate = "template"
+)
+
+// Source types of a webhook event. Only one-on-one chats are routed.
+const sourceUser = "user"
+
+// contentProviderLine marks media stored on the LINE platform, as opposed to an external URL.
+const contentProviderLine = "line"
+
+// maxMessagesPerRequest is the number of messages a reply or push request carries at most.
+const maxMessagesPerRequest = 5
+
+var (
+	// ErrTokenInvalid is returned when LINE rejects the channel access token.
+	ErrTokenInvalid = errors.New("line: channel access token invalid")
+	// ErrInvalidReplyToken is returned when a reply token has expired or was already used.
+	ErrInvalidReplyToken = errors.New("line: invalid reply token")
+)
+
+// APIError is a Messaging API reply with an error status.
+// https://developers.line.biz/en/reference/messaging-api/#error-responses
+type APIError struct {
+	StatusCode int
+	Message    string `json:"message"`
+	Details    []struct {
+		Message  string `json:"message"`
+		Property string `json:"property"`
+	} `json:"details"`
+}
+
+func (e *APIError) Error() string {
+	msg := fmt.Sprintf("messaging api status %d: %s", e.StatusCode, e.Message)
+	for _, d := range e.Details {
+		msg += fmt.Sprintf("; %s: %s", d.Property, d.Message)
+	}
+	return msg
+}
+
+// Is matches the sentinel errors of the statuses callers act on.
+func (e *APIError) Is(target error) bool {
+	switch target {
+	case ErrTokenInvalid:
+		return e.StatusCode == http.StatusUnauthorized
+	case ErrInvalidReplyToken:
+		return e.StatusCode == http.StatusBadRequest && strings.Contains(strings.ToLower(e.Message), "reply token")
+	}
+	return false
+}
+
+// --- Webhook ---
+
+// webhookBody is the body of a webhook request. Destination is the user ID of the bot.
+type webhookBody struct {
+	Destination string  `json:"destination"`
+	Events      []event `json:"events"`
+}
+
+type event struct {
+	Type           string         `json:"type"`
+	Mode           string         `json:"mode"`
+	Timestamp      int64          `json:"timestamp"`
+	Source         eventSource    `json:"source"`
+	WebhookEventID string         `json:"webhookEventId"`
+	ReplyToken     string         `json:"replyToken"`
+	Message        *eventMessage  `json:"message"`
+	Postback       *eventPostback `json:"postback"`
+}
+
+type eventSource struct {
+	Type    string `json:"type"`
+	UserID  string `json:"userId"`
+	GroupID string `json:"groupId"`
+	RoomID  string `json:"roomId"`
+}
+
+type eventMessage struct {
+	ID   string `json:"id"`
+	Type string `json:"type"`
+	Text string `json:"text"`
+	// ContentProvider tells where image, video and audio content is stored.
+	ContentProvider *contentProvider `json:"contentProvider"`
+	FileName        string           `json:"fileName"`
+	FileSize        int64            `json:"fileSize"`
+	Title           string           `json:"title"`
+	Address         string           `json:"address"`
+	Latitude        float64          `json:"latitude"`
+	Longitude       float64          `json:"longitude"`
+	StickerID       string           `json:"stickerId"`
+	Keywords        []string         `json:"keywords"`
+}
+
+type contentProvider struct {
+	Type               string `json:"type"`
+	OriginalContentURL string `json:"originalContentUrl"`
+}
+
+type eventPostback struct {
+	Data string `json:"data"`
+}
+
+// --- Messaging API requests and replies ---
+
+type botInfoResponse struct {
+	UserID      string `json:"userId"`
+	BasicID     string `json:"basicId"`
+	DisplayName string `json:"displayName"`
+}
+
+type profileResponse struct {
+	UserID      string `json:"userId"`
+	DisplayName string `json:"displayName"`
+	PictureURL  string `json:"pictureUrl"`
+	Language    string `json:"language"`
+}
+
+type webhookEndpointRequest struct {
+	Endpoint string `json:"endpoint"`
+}
+
+type replyRequest struct {
+	ReplyToken string             `json:"replyToken"`
+	Messages   []*outboundMessage `json:"messages"`
+}
+
+type pushRequest struct {
+	To       string             `json:"to"`
+	Messages []*outboundMessage `json:"messages"`
+}
+
+// sendResponse is the reply of the reply and push endpoints, one sent message per request message.
+type sendResponse struct {
+	SentMessages []struct {
+		ID         string `json:"id"`
+		QuoteToken string `json:"quoteToken"`
+	} `json:"sentMessages"`
+}
+
+// outboundMessage is a message object. Type selects which content fields apply.
+// https://developers.line.biz/en/reference/messaging-api/#message-objects
+type outboundMessage struct {
+	Type               string      `json:"type"`
+	Text               string      `json:"text,omitempty"`
+	OriginalContentURL string      `json:"originalContentUrl,omitempty"`
+	PreviewImageURL    string      `json:"previewImageUrl,omitempty"`
+	Title              string      `json:"title,omitempty"`
+	Address            string      `json:"address,omitempty"`
+	Latitude           float64     `json:"latitude,omitempty"`
+	Longitude          float64     `json:"longitude,omitempty"`
+	AltText            string      `json:"altText,omitempty"`
+	Template           *template   `json:"template,omitempty"`
+	QuickReply         *quickReply `json:"quickReply,omitempty"`
+}
+
+// template is a buttons template: a text with up to four action buttons.
+type template struct {
+	Type    string    `json:"type"`
+	Text    string    `json:"text"`
+	Actions []*action `json:"actions"`
+}
+
+type quickReply struct {
+	Items []quickReplyItem `json:"items"`
+}
+
+type quickReplyItem struct {
+	Type   string  `json:"type"`
+	Action *action `json:"action"`
+}
+
+// action is a template or quick reply action.
+// https://developers.line.biz/en/reference/messaging-api/#action-objects
+type action struct {
+	Type        string `json:"type"`
+	Label       string `json:"label"`
+	Data        string `json:"data,omitempty"`
+	DisplayText string `json:"displayText,omitempty"`
+	URI         string `json:"uri,omitempty"`
+}
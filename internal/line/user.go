@@ -0,0 +1,96 @@
+package line
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	gatewayv1 "github.com/webitel/im-providers-service/gen/go/gateway/v1"
+	sharedmodel "github.com/webitel/im-providers-service/internal/core/model"
+	lnmodel "github.com/webitel/im-providers-service/internal/line/model"
+	"github.com/webitel/im-providers-service/internal/provider/contactsync"
+)
+
+// Contact metadata keys filled from the LINE user profile.
+const (
+	metadataPicture  = "picture"
+	metadataLanguage = "language"
+)
+
+// syncContact resolves the internal contact for a LINE user, creating it if necessary.
+// The profile is fetched only for users not known yet, so repeated events from the
+// same user skip both the Messaging API and the gateway round-trip. The cache is
+// keyed by the gate and user ID alone: the profile is not known before the lookup.
+func (p *lineProvider) syncContact(ctx context.Context, gate *lnmodel.LineGate, userID string) (*gatewayv1.Contact, error) {
+	key := contactsync.KnownUser(gate.ID, &sharedmodel.ExternalUser{ID: userID})
+	if known, _ := p.userCache.IsKnown(ctx, key); known {
+		return &gatewayv1.Contact{Sub: userID}, nil
+	}
+
+	profile := p.userProfile(ctx, gate, userID)
+	external := toExternalUser(profile)
+	authCtx := contactsync.WithGatewayIdentity(ctx, gate.DomainID, gate.Peer)
+
+	contact, err := p.ensureContact(authCtx, external, profile)
+	if err != nil {
+		return nil, err
+	}
+
+	contactsync.EnsureVia(authCtx, p.gatewayer, p.logger, gate.ID, userID, contact.Iss)
+	_ = p.userCache.MarkKnown(ctx, key)
+	if profile.Language != "" {
+		_ = p.userCache.SetLocale(ctx, gate.ID, userID, profile.Language)
+	}
+
+	return contact, nil
+}
+
+// userProfile returns the profile of the user. It never fails: the profile is only
+// readable while the user is a friend of the bot, so the event continues with a
+// user-ID-only profile otherwise.
+func (p *lineProvider) userProfile(ctx context.Context, gate *lnmodel.LineGate, userID string) *lnmodel.Profile {
+	profile, err := p.api.GetProfile(ctx, gate.ChannelAccessToken, userID)
+	if err != nil {
+		var apiErr *APIError
+		if errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusNotFound {
+			p.logger.InfoContext(ctx, "user profile not accessible, using user ID only", "user_id", userID)
+		} else {
+			p.logger.WarnContext(ctx, "fetch user profile failed, using user ID only", "user_id", userID, "err", err)
+		}
+		return &lnmodel.Profile{UserID: userID}
+	}
+	if profile.UserID == "" {
+		profile.UserID = userID
+	}
+	return profile
+}
+
+// ensureContact creates the internal contact or returns a stub when the
+// contact already exists (idempotent by design on the gateway side).
+func (p *lineProvider) ensureContact(ctx context.Context, external *sharedmodel.ExternalUser, profile *lnmodel.Profile) (*gatewayv1.Contact, error) {
+	metadata := make(map[string]string, 2)
+	if profile.PictureURL != "" {
+		metadata[metadataPicture] = profile.PictureURL
+	}
+	if profile.Language != "" {
+		metadata[metadataLanguage] = profile.Language
+	}
+
+	return contactsync.CreateContact(ctx, p.gatewayer, &gatewayv1.CreateContactRequest{
+		IssId:    p.Type(),
+		Type:     p.Type(),
+		Name:     external.FirstName,
+		Subject:  external.ID,
+		Metadata: metadata,
+	})
+}
+
+// toExternalUser maps a LINE profile to the external user the contact is created for.
+// A user-ID-only profile falls back to the user ID as the display name.
+func toExternalUser(profile *lnmodel.Profile) *sharedmodel.ExternalUser {
+	name := profile.DisplayName
+	if name == "" {
+		name = profile.UserID
+	}
+	return &sharedmodel.ExternalUser{ID: profile.UserID, FirstName: name}
+}
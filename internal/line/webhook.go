@@ -0,0 +1,152 @@
+package line
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	sharedmodel "github.com/webitel/im-providers-service/internal/core/model"
+	lnmodel "github.com/webitel/im-providers-service/internal/line/model"
+	"github.com/webitel/im-providers-service/internal/provider/contactsync"
+)
+
+func (p *lineProvider) HandleWebhook(ctx context.Context, data []byte) error {
+	var body webhookBody
+	if err := json.Unmarshal(data, &body); err != nil {
+		p.logger.Warn("malformed webhook dropped", "err", err)
+		return nil
+	}
+
+	gate, err := p.resolveGate(ctx)
+	if err != nil || !gate.Enabled {
+		return err
+	}
+
+	// An empty event list is the verification request of the LINE console.
+	for i := range body.Events {
+		p.processEvent(ctx, gate, &body.Events[i])
+	}
+	return nil
+}
+
+// processEvent routes a single webhook event. Events of group chats and rooms are
+// dropped: the gate serves one-on-one chats only.
+func (p *lineProvider) processEvent(ctx context.Context, gate *lnmodel.LineGate, ev *event) {
+	if ev.Source.Type != sourceUser || ev.Source.UserID == "" {
+		p.logger.Debug("event from non-user source skipped", "event", ev.Type, "source", ev.Source.Type)
+		return
+	}
+	userID := ev.Source.UserID
+
+	switch ev.Type {
+	case EventMessage:
+		p.replyTokens.remember(gate.ID, userID, ev.ReplyToken)
+		if err := p.processMessage(ctx, gate, userID, ev.Message); err != nil {
+			p.logger.Error("message dropped", "webhook_event_id", ev.WebhookEventID, "err", err)
+		}
+	case EventPostback:
+		p.replyTokens.remember(gate.ID, userID, ev.ReplyToken)
+		if err := p.processPostback(ctx, gate, userID, ev.Postback); err != nil {
+			p.logger.Error("postback dropped", "webhook_event_id", ev.WebhookEventID, "err", err)
+		}
+	case EventFollow:
+		// The user can be messaged from now on, so the contact is linked up front.
+		p.replyTokens.remember(gate.ID, userID, ev.ReplyToken)
+		if _, err := p.syncContact(ctx, gate, userID); err != nil {
+			p.logger.Warn("sync contact failed", "event", ev.Type, "user_id", userID, "err", err)
+		}
+	case EventUnfollow:
+		p.logger.Info("user blocked the bot", "user_id", userID)
+	default:
+		p.logger.Debug("event acknowledged", "event", ev.Type, "webhook_event_id", ev.WebhookEventID)
+	}
+}
+
+// processMessage is the per-message pipeline:
+//
+//	sync contact → route content
+func (p *lineProvider) processMessage(ctx context.Context, gate *lnmodel.LineGate, userID string, msg *eventMessage) error {
+	if msg == nil {
+		return nil
+	}
+
+	if _, err := p.syncContact(ctx, gate, userID); err != nil {
+		return fmt.Errorf("sync contact [user_id=%s]: %w", userID, err)
+	}
+
+	peers := contactsync.InboundPeers(gate.ID, gate.Peer, userID)
+	switch msg.Type {
+	case MessageText:
+		p.sendText(ctx, gate, peers, msg.Text)
+	case MessageImage, MessageVideo, MessageAudio, MessageFile:
+		p.handleMedia(ctx, gate, peers, msg)
+	case MessageLocation:
+		p.sendLocation(ctx, gate, peers, msg)
+	case MessageSticker:
+		// Stickers cannot be copied; the text of a message sticker is forwarded, if any.
+		p.sendText(ctx, gate, peers, msg.Text)
+	default:
+		p.logger.Warn("unsupported message type, skipping", "type", msg.Type)
+	}
+	return nil
+}
+
+// processPostback emits a tap on a postback action as an interactive callback.
+func (p *lineProvider) processPostback(ctx context.Context, gate *lnmodel.LineGate, userID string, pb *eventPostback) error {
+	if pb == nil || pb.Data == "" {
+		return nil
+	}
+
+	if _, err := p.syncContact(ctx, gate, userID); err != nil {
+		return fmt.Errorf("sync contact [user_id=%s]: %w", userID, err)
+	}
+
+	peers := contactsync.InboundPeers(gate.ID, gate.Peer, userID)
+	data := decodePostback(pb.Data)
+	if err := p.messenger.SendInteractiveCallback(ctx, &sharedmodel.SendInteractiveCallbackRequest{
+		DomainID:     gate.DomainID,
+		From:         peers.From,
+		To:           peers.To,
+		InReplyTo:    data.MessageID,
+		ButtonCode:   data.Code,
+		CallbackData: data.Data,
+	}); err != nil {
+		return fmt.Errorf("send interactive callback [in_reply_to=%s]: %w", data.MessageID, err)
+	}
+	return nil
+}
+
+func (p *lineProvider) sendText(ctx context.Context, gate *lnmodel.LineGate, peers contactsync.Peers, text string) {
+	if text == "" {
+		return
+	}
+	if _, err := p.messenger.SendText(ctx, &sharedmodel.SendTextRequest{
+		DomainID: gate.DomainID,
+		From:     peers.From,
+		To:       peers.To,
+		Body:     text,
+	}); err != nil {
+		p.logger.Error("send text failed", "err", err)
+	}
+}
+
+func (p *lineProvider) sendLocation(ctx context.Context, gate *lnmodel.LineGate, peers contactsync.Peers, msg *eventMessage) {
+	req := &sharedmodel.SendLocationRequest{
+		DomainID:   int(gate.DomainID),
+		From:       peers.From,
+		To:         peers.To,
+		Latitude:   msg.Latitude,
+		Longitude:  msg.Longitude,
+		ExternalID: msg.ID,
+	}
+	if msg.Title != "" {
+		req.Name = &msg.Title
+	}
+	if msg.Address != "" {
+		req.Address = &msg.Address
+	}
+
+	if _, err := p.messenger.SendLocation(ctx, req); err != nil {
+		p.logger.Error("send location failed", "err", err)
+	}
+}
@@ -0,0 +1,232 @@
+package line
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	sharedstore "github.com/webitel/im-providers-service/internal/core/store"
+)
+
+func sign(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return base64.StdEncoding.EncodeToString(mac.Sum(nil))
+}
+
+func TestValidateSignature(t *testing.T) {
+	body := []byte(`{"destination":"U0bot0000000000000000000000000000","events":[]}`)
+
+	tests := []struct {
+		name    string
+		gateID  string
+		header  string
+		wantErr bool
+	}{
+		{name: "valid", gateID: testGateID, header: sign(testSecret, body)},
+		{name: "missing header", gateID: testGateID, wantErr: true},
+		{name: "other secret", gateID: testGateID, header: sign("other-secret", body), wantErr: true},
+		{name: "unknown gate", gateID: "0190a8a4-0000-7000-8000-000000000000", header: sign(testSecret, body), wantErr: true},
+		{name: "malformed uri", gateID: "not-a-gate", header: sign(testSecret, body), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := newStubbedProvider(t)
+			err := p.ValidateSignature(webhookContext(tt.gateID), tt.header, body)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ValidateSignature() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestResolveGate_MalformedURIIsNotFound(t *testing.T) {
+	p := newStubbedProvider(t)
+	if _, err := p.resolveGate(webhookContext("../gates")); !errors.Is(err, sharedstore.ErrNotFound) {
+		t.Fatalf("resolveGate() error = %v, want ErrNotFound", err)
+	}
+}
+
+func TestHandleWebhook_Text(t *testing.T) {
+	p := newStubbedProvider(t)
+
+	body := []byte(`{
+		"destination": "U0bot0000000000000000000000000000",
+		"events": [{
+			"type": "message",
+			"mode": "active",
+			"timestamp": 1462629479859,
+			"source": {"type": "user", "userId": "` + testUserID + `"},
+			"webhookEventId": "01FZ74A0TDDPYRVKNK77XKC3ZR",
+			"replyToken": "nHuyWiB7yP5Zw52FIkcQobQuGDXCTA",
+			"message": {"id": "444573844083572737", "type": "text", "text": "Hello, world"}
+		}]
+	}`)
+	if err := p.HandleWebhook(webhookContext(testGateID), body); err != nil {
+		t.Fatalf("HandleWebhook: %v", err)
+	}
+
+	if len(p.messenger.Texts()) != 1 {
+		t.Fatalf("expected 1 text, got %d", len(p.messenger.Texts()))
+	}
+	got := p.messenger.Texts()[0]
+	if got.Body != "Hello, world" || got.DomainID != 1 {
+		t.Errorf("unexpected text request: %+v", got)
+	}
+	if got.From.Sub != testUserID || got.From.Iss != "line" {
+		t.Errorf("unexpected sender peer: %+v", got.From)
+	}
+	if got.To.Sub != "bot-1" || got.To.Via == nil || *got.To.Via != testGateID {
+		t.Errorf("unexpected recipient peer: %+v", got.To)
+	}
+	if token, ok := p.replyTokens.take(testGateID, testUserID); !ok || token != "nHuyWiB7yP5Zw52FIkcQobQuGDXCTA" {
+		t.Errorf("reply token not remembered: %q, %v", token, ok)
+	}
+}
+
+func TestHandleWebhook_GroupEventSkipped(t *testing.T) {
+	p := newStubbedProvider(t)
+
+	body := []byte(`{"events": [{
+		"type": "message",
+		"source": {"type": "group", "groupId": "Ca56f94637c", "userId": "` + testUserID + `"},
+		"replyToken": "token",
+		"message": {"id": "1", "type": "text", "text": "hi all"}
+	}]}`)
+	if err := p.HandleWebhook(webhookContext(testGateID), body); err != nil {
+		t.Fatalf("HandleWebhook: %v", err)
+	}
+	if len(p.messenger.Texts()) != 0 {
+		t.Errorf("group message must not be routed: %+v", p.messenger.Texts())
+	}
+	if _, ok := p.replyTokens.take(testGateID, testUserID); ok {
+		t.Error("reply token of a group event must not be remembered")
+	}
+}
+
+func TestHandleWebhook_DisabledGate(t *testing.T) {
+	p := newStubbedProvider(t)
+	p.accounts[testGateID].Enabled = false
+
+	body := []byte(`{"events": [{
+		"type": "message",
+		"source": {"type": "user", "userId": "` + testUserID + `"},
+		"message": {"id": "1", "type": "text", "text": "hi"}
+	}]}`)
+	if err := p.HandleWebhook(webhookContext(testGateID), body); err != nil {
+		t.Fatalf("HandleWebhook: %v", err)
+	}
+	if len(p.messenger.Texts()) != 0 {
+		t.Errorf("message of a disabled gate must be dropped: %+v", p.messenger.Texts())
+	}
+}
+
+func TestHandleWebhook_Image(t *testing.T) {
+	p := newStubbedProvider(t)
+	p.stub.replies["GET /v2/bot/message/354718705033693859/content"] = stubReply{body: "jpeg-bytes", contentType: "image/jpeg"}
+
+	body := []byte(`{"events": [{
+		"type": "message",
+		"source": {"type": "user", "userId": "` + testUserID + `"},
+		"message": {"id": "354718705033693859", "type": "image", "contentProvider": {"type": "line"}}
+	}]}`)
+	if err := p.HandleWebhook(webhookContext(testGateID), body); err != nil {
+		t.Fatalf("HandleWebhook: %v", err)
+	}
+
+	if len(p.media.Uploads()) != 1 || p.media.Uploads()[0].Body != "jpeg-bytes" || p.media.Uploads()[0].Req.MimeType != "image/jpeg" {
+		t.Fatalf("unexpected uploads: %+v", p.media.Uploads())
+	}
+	calls := p.stub.sent()
+	if len(calls) != 1 || calls[0].token != "Bearer "+testToken {
+		t.Errorf("unexpected calls: %+v", calls)
+	}
+	if len(p.messenger.Images()) != 1 || p.messenger.Images()[0].Image.Images[0].ID != "file-1" {
+		t.Errorf("unexpected images: %+v", p.messenger.Images())
+	}
+}
+
+func TestHandleWebhook_ExternalContent(t *testing.T) {
+	p := newStubbedProvider(t)
+	external := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "video/mp4")
+		_, _ = w.Write([]byte("mp4-bytes"))
+	}))
+	t.Cleanup(external.Close)
+
+	body := []byte(`{"events": [{
+		"type": "message",
+		"source": {"type": "user", "userId": "` + testUserID + `"},
+		"message": {"id": "325708", "type": "video", "contentProvider": {"type": "external", "originalContentUrl": "` + external.URL + `/v.mp4"}}
+	}]}`)
+	if err := p.HandleWebhook(webhookContext(testGateID), body); err != nil {
+		t.Fatalf("HandleWebhook: %v", err)
+	}
+
+	if len(p.stub.sent()) != 0 {
+		t.Errorf("external content must not be read through the Messaging API: %+v", p.stub.sent())
+	}
+	if len(p.media.Uploads()) != 1 || p.media.Uploads()[0].Body != "mp4-bytes" {
+		t.Fatalf("unexpected uploads: %+v", p.media.Uploads())
+	}
+	if len(p.messenger.Documents()) != 1 || p.messenger.Documents()[0].Document.Documents[0].MimeType != "video/mp4" {
+		t.Errorf("unexpected documents: %+v", p.messenger.Documents())
+	}
+}
+
+func TestHandleWebhook_Location(t *testing.T) {
+	p := newStubbedProvider(t)
+
+	body := []byte(`{"events": [{
+		"type": "message",
+		"source": {"type": "user", "userId": "` + testUserID + `"},
+		"message": {"id": "325708", "type": "location", "title": "my location", "address": "1-3 Kioicho, Chiyoda-ku, Tokyo", "latitude": 35.67966, "longitude": 139.73669}
+	}]}`)
+	if err := p.HandleWebhook(webhookContext(testGateID), body); err != nil {
+		t.Fatalf("HandleWebhook: %v", err)
+	}
+
+	if len(p.messenger.Locations()) != 1 {
+		t.Fatalf("expected 1 location, got %d", len(p.messenger.Locations()))
+	}
+	got := p.messenger.Locations()[0]
+	if got.Latitude != 35.67966 || got.Longitude != 139.73669 || got.ExternalID != "325708" {
+		t.Errorf("unexpected location: %+v", got)
+	}
+	if got.Name == nil || *got.Name != "my location" || got.Address == nil || *got.Address != "1-3 Kioicho, Chiyoda-ku, Tokyo" {
+		t.Errorf("unexpected name or address: %v %v", got.Name, got.Address)
+	}
+}
+
+func TestHandleWebhook_Postback(t *testing.T) {
+	p := newStubbedProvider(t)
+
+	body := []byte(`{"events": [{
+		"type": "postback",
+		"source": {"type": "user", "userId": "` + testUserID + `"},
+		"replyToken": "b60d432864f44d079f6d8efe86cf404b",
+		"postback": {"data": "{\"m\":\"msg-1\",\"c\":\"yes\",\"d\":\"confirm\"}"}
+	}]}`)
+	if err := p.HandleWebhook(webhookContext(testGateID), body); err != nil {
+		t.Fatalf("HandleWebhook: %v", err)
+	}
+
+	if len(p.messenger.Callbacks()) != 1 {
+		t.Fatalf("expected 1 callback, got %d", len(p.messenger.Callbacks()))
+	}
+	got := p.messenger.Callbacks()[0]
+	if got.InReplyTo != "msg-1" || got.ButtonCode != "yes" || got.CallbackData != "confirm" || got.From.Sub != testUserID {
+		t.Errorf("unexpected callback: %+v", got)
+	}
+}
+
+func TestDecodePostback_RawData(t *testing.T) {
+	if got := decodePostback("action=buy&itemid=111"); got.MessageID != "" || got.Data != "action=buy&itemid=111" {
+		t.Errorf("decodePostback() = %+v", got)
+	}
+}
@@ -0,0 +1,35 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	sharedmodel "github.com/webitel/im-providers-service/internal/core/model"
+)
+
+// SendCards delivers the message cards through the provider's CardSender when it has one.
+// Other providers get a plain-text rendering with links, preceded by the message text.
+func SendCards(ctx context.Context, s Sender, req *sharedmodel.Message) (*sharedmodel.MessageResponse, error) {
+	if req.Carousel == nil && req.MediaCard == nil {
+		return nil, fmt.Errorf("message has no cards")
+	}
+	if cs, ok := s.(CardSender); ok {
+		return cs.SendCards(ctx, req)
+	}
+
+	parts := make([]string, 0, 2)
+	if req.Text != "" {
+		parts = append(parts, req.Text)
+	}
+	if req.MediaCard != nil {
+		parts = append(parts, req.MediaCard.PlainText())
+	} else {
+		parts = append(parts, req.Carousel.PlainText())
+	}
+
+	fallback := *req
+	fallback.Text = strings.Join(parts, "\n\n")
+	fallback.Carousel, fallback.MediaCard = nil, nil
+	return s.SendText(ctx, &fallback)
+}
@@ -0,0 +1,101 @@
+package provider
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	sharedmodel "github.com/webitel/im-providers-service/internal/core/model"
+)
+
+type textOnlySender struct {
+	sent *sharedmodel.Message
+}
+
+func (s *textOnlySender) Type() string { return "text-only" }
+func (s *textOnlySender) SendText(_ context.Context, req *sharedmodel.Message) (*sharedmodel.MessageResponse, error) {
+	s.sent = req
+	return &sharedmodel.MessageResponse{ID: "ext-1"}, nil
+}
+func (s *textOnlySender) SendImage(context.Context, *sharedmodel.Message) (*sharedmodel.MessageResponse, error) {
+	return nil, nil
+}
+func (s *textOnlySender) SendDocument(context.Context, *sharedmodel.Message) (*sharedmodel.MessageResponse, error) {
+	return nil, nil
+}
+
+type cardSender struct {
+	textOnlySender
+	cards *sharedmodel.Message
+}
+
+func (s *cardSender) SendCards(_ context.Context, req *sharedmodel.Message) (*sharedmodel.MessageResponse, error) {
+	s.cards = req
+	return &sharedmodel.MessageResponse{ID: "ext-cards"}, nil
+}
+
+func TestSendCards_NativeSender(t *testing.T) {
+	s := &cardSender{}
+	req := &sharedmodel.Message{Carousel: &sharedmodel.Carousel{Cards: []sharedmodel.Card{{Title: "A"}}}}
+
+	resp, err := SendCards(context.Background(), s, req)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if resp.ID != "ext-cards" || s.cards != req || s.sent != nil {
+		t.Error("expected native card delivery")
+	}
+}
+
+func TestSendCards_TextFallback(t *testing.T) {
+	s := &textOnlySender{}
+	req := &sharedmodel.Message{
+		Text: "Our picks:",
+		Carousel: &sharedmodel.Carousel{Cards: []sharedmodel.Card{
+			{
+				Title:      "Sneakers",
+				Subtitle:   "Size 42",
+				DefaultURL: "https://shop.example.com/1",
+				Buttons: []sharedmodel.KeyboardButton{
+					{Label: "Open", URL: &sharedmodel.KeyboardButtonURL{URL: "https://shop.example.com/1/buy"}},
+					{Label: "Like", Callback: &sharedmodel.KeyboardButtonCallback{Data: "LIKE"}},
+				},
+			},
+			{Title: "Boots", ImageURL: "https://cdn.example.com/2.jpg"},
+		}},
+	}
+
+	if _, err := SendCards(context.Background(), s, req); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if s.sent == nil {
+		t.Fatal("expected text fallback")
+	}
+	want := "Our picks:\n\n" +
+		"Sneakers\nSize 42\nhttps://shop.example.com/1\n• Open: https://shop.example.com/1/buy\n• Like\n\n" +
+		"Boots\nhttps://cdn.example.com/2.jpg"
+	if s.sent.Text != want {
+		t.Errorf("unexpected rendering:\n%q\nwant\n%q", s.sent.Text, want)
+	}
+	if s.sent.Carousel != nil || req.Carousel == nil {
+		t.Error("fallback must drop cards without mutating the request")
+	}
+}
+
+func TestSendCards_MediaFallback(t *testing.T) {
+	s := &textOnlySender{}
+	req := &sharedmodel.Message{MediaCard: &sharedmodel.MediaCard{MediaType: sharedmodel.MediaCardImage, URL: "https://cdn.example.com/a.png"}}
+
+	if _, err := SendCards(context.Background(), s, req); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !strings.HasPrefix(s.sent.Text, "https://cdn.example.com/a.png") {
+		t.Errorf("unexpected rendering: %q", s.sent.Text)
+	}
+}
+
+func TestSendCards_NoCards(t *testing.T) {
+	if _, err := SendCards(context.Background(), &textOnlySender{}, &sharedmodel.Message{Text: "hi"}); err == nil {
+		t.Error("expected error for message without cards")
+	}
+}
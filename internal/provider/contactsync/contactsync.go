@@ -0,0 +1,78 @@
+// Package contactsync links the users of a channel to internal contacts through im-gateway.
+// It holds the steps every provider takes for an inbound message: authenticate as the
+// gate, create the contact, link the gate to it as a "via" channel and route the message
+// between the two peers.
+package contactsync
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	gatewayv1 "github.com/webitel/im-providers-service/gen/go/gateway/v1"
+	grpcclient "github.com/webitel/im-providers-service/infra/client/grpc"
+	imgateway "github.com/webitel/im-providers-service/infra/client/grpc/im-gateway"
+	sharedmodel "github.com/webitel/im-providers-service/internal/core/model"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Peers carries the sender and recipient for a single routed message.
+// Bundling them prevents accidental argument swap at call sites.
+type Peers struct {
+	From sharedmodel.Peer
+	To   sharedmodel.Peer
+}
+
+// InboundPeers returns the peers of a message the external user sub sent to the gate.
+func InboundPeers(gateID string, gatePeer sharedmodel.Peer, sub string) Peers {
+	return Peers{
+		From: sharedmodel.Peer{Sub: sub, Iss: gatePeer.Iss},
+		To:   sharedmodel.Peer{Sub: gatePeer.Sub, Iss: gatePeer.Iss, Via: &gateID},
+	}
+}
+
+// WithGatewayIdentity attaches the domain-scoped caller identity required by
+// the im-gateway service to authenticate inbound gRPC calls.
+func WithGatewayIdentity(ctx context.Context, domainID int64, gatePeer sharedmodel.Peer) context.Context {
+	id := fmt.Sprintf("%d.%s", domainID, gatePeer.Sub)
+	return grpcclient.WithIdentity(ctx, grpcclient.StringIdentity(id))
+}
+
+// KnownUser returns the user cache key of a user of a gate. The via link is per gate,
+// so a user known through one gate is still synced when first seen through another.
+func KnownUser(gateID string, user *sharedmodel.ExternalUser) *sharedmodel.ExternalUser {
+	return &sharedmodel.ExternalUser{ID: gateID + ":" + user.ID, FirstName: user.FirstName, LastName: user.LastName}
+}
+
+// CreateContact creates the internal contact or returns a stub when the
+// contact already exists (idempotent by design on the gateway side).
+func CreateContact(ctx context.Context, gatewayer *imgateway.Client, req *gatewayv1.CreateContactRequest) (*gatewayv1.Contact, error) {
+	contact, err := gatewayer.Create(ctx, req)
+	if err != nil {
+		if IsAlreadyExists(err) {
+			return &gatewayv1.Contact{Sub: req.GetSubject(), Iss: req.GetIssId()}, nil
+		}
+		return nil, fmt.Errorf("create contact: %w", err)
+	}
+	return contact, nil
+}
+
+// EnsureVia links the gate to the internal contact as a "via" channel.
+// Errors are non-fatal — AlreadyExists is silently ignored.
+func EnsureVia(ctx context.Context, gatewayer *imgateway.Client, logger *slog.Logger, gateID, contactSub, contactIss string) {
+	_, err := gatewayer.CreateVia(ctx, &gatewayv1.ViasServiceCreateRequest{
+		Via: gateID,
+		Iss: &contactIss,
+		Sub: &contactSub,
+	})
+	if err != nil && !IsAlreadyExists(err) {
+		logger.Warn("create via: skipped", "contact", contactSub, "gate_id", gateID, "err", err)
+	}
+}
+
+// IsAlreadyExists reports whether a gRPC error carries the AlreadyExists code.
+func IsAlreadyExists(err error) bool {
+	st, ok := status.FromError(err)
+	return ok && st.Code() == codes.AlreadyExists
+}
@@ -0,0 +1,71 @@
+// Package gaterpc holds the steps the gRPC gate services of the providers share:
+// resolving the domain of the caller, keeping gates of other domains out of reach
+// and mapping the errors of the gate services to gRPC statuses.
+package gaterpc
+
+import (
+	"context"
+	"errors"
+
+	impb "github.com/webitel/im-providers-service/gen/go/provider/v1"
+	"github.com/webitel/im-providers-service/infra/auth"
+	sharedmodel "github.com/webitel/im-providers-service/internal/core/model"
+	sharedstore "github.com/webitel/im-providers-service/internal/core/store"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// DomainID returns the domain of the authenticated caller.
+func DomainID(ctx context.Context) (int64, error) {
+	identity, ok := auth.GetIdentityFromContext(ctx)
+	if !ok {
+		return 0, status.Error(codes.Unauthenticated, "missing identity in context")
+	}
+	return identity.GetDomainID(), nil
+}
+
+// CheckDomain returns NotFound unless the gate belongs to the domain of the caller,
+// so the gates of other domains can be neither read nor changed.
+func CheckDomain(ctx context.Context, gateDomainID int64) error {
+	domainID, err := DomainID(ctx)
+	if err != nil {
+		return err
+	}
+	if gateDomainID != domainID {
+		return status.Error(codes.NotFound, "not found")
+	}
+	return nil
+}
+
+// Peer maps the bot identity of a request; nil stays nil.
+func Peer(peer *impb.Peer) *sharedmodel.Peer {
+	if peer == nil {
+		return nil
+	}
+	return &sharedmodel.Peer{Sub: peer.GetSub(), Iss: peer.GetIss()}
+}
+
+// ToProtoPeer maps the bot identity of a gate.
+func ToProtoPeer(peer sharedmodel.Peer) *impb.Peer {
+	return &impb.Peer{Sub: peer.Sub, Iss: peer.Iss}
+}
+
+// ToStatus maps the errors of a gate service to the appropriate gRPC status code.
+// Unknown errors are wrapped as Internal so the client gets a safe, non-leaking message.
+func ToStatus(err error, internalMsg string) error {
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+
+	var ve *sharedmodel.ValidationError
+	switch {
+	case errors.As(err, &ve):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, sharedstore.ErrNotFound):
+		return status.Error(codes.NotFound, "not found")
+	case errors.Is(err, sharedstore.ErrConflict):
+		return status.Error(codes.AlreadyExists, "already exists")
+	default:
+		return status.Errorf(codes.Internal, "%s: %v", internalMsg, err)
+	}
+}
@@ -4,6 +4,7 @@ package provider
 
 import (
 	"context"
+	"net/http"
 	"net/url"
 
 	sharedmodel "github.com/webitel/im-providers-service/internal/core/model"
@@ -29,6 +30,34 @@ type InteractiveSender interface {
 	SendInteractive(ctx context.Context, req *sharedmodel.Message) (*sharedmodel.MessageResponse, error)
 }
 
+// CardSender is an optional interface for providers that render rich cards natively
+// (Message.Carousel or Message.MediaCard). Use SendCards to fall back to text elsewhere.
+type CardSender interface {
+	SendCards(ctx context.Context, req *sharedmodel.Message) (*sharedmodel.MessageResponse, error)
+}
+
+// LocationSender is an optional interface for providers that send a location natively
+// (Message.Location). Use SendLocation to get an Unimplemented error elsewhere.
+type LocationSender interface {
+	SendLocation(ctx context.Context, req *sharedmodel.Message) (*sharedmodel.MessageResponse, error)
+}
+
+// ContactSender is an optional interface for providers that send contact cards (Message.Contacts).
+type ContactSender interface {
+	SendContact(ctx context.Context, req *sharedmodel.Message) (*sharedmodel.MessageResponse, error)
+}
+
+// ReactionSender is an optional interface for providers that react to messages (Message.Reaction).
+type ReactionSender interface {
+	SendReaction(ctx context.Context, req *sharedmodel.Message) (*sharedmodel.MessageResponse, error)
+}
+
+// TypingSender is an optional interface for providers that show the recipient a
+// typing indicator on behalf of the agent (Message.GateID and Message.To).
+type TypingSender interface {
+	SendTyping(ctx context.Context, req *sharedmodel.Message) error
+}
+
 // Receiver is the inbound side — it handles raw webhook bytes from the platform.
 type Receiver interface {
 	Type() string
@@ -52,3 +81,30 @@ type Verifier interface {
 type SignatureValidator interface {
 	ValidateSignature(ctx context.Context, header string, body []byte) error
 }
+
+// SignatureHeader is an optional interface for SignatureValidator providers whose
+// platform sends the signature in a header other than X-Hub-Signature-256.
+type SignatureHeader interface {
+	SignatureHeader() string
+}
+
+// RequestValidator is an optional interface for providers that authenticate webhook
+// requests with more than one header (e.g. a signature bound to a timestamp header).
+// It is used instead of SignatureValidator when both are implemented.
+type RequestValidator interface {
+	ValidateRequest(ctx context.Context, header http.Header, body []byte) error
+}
+
+// Handshaker is an optional interface for providers whose platform verifies the
+// webhook with an authenticated POST request (e.g. Slack url_verification).
+// Handshake reports whether the payload is such a request and the reply body.
+type Handshaker interface {
+	Handshake(ctx context.Context, payload []byte) (reply string, ok bool)
+}
+
+// Acknowledger is an optional interface for providers whose platform expects a fixed
+// body in reply to a handled webhook (e.g. "ok" for the VK Callback API), and keeps
+// redelivering it otherwise.
+type Acknowledger interface {
+	Acknowledgement() string
+}
@@ -0,0 +1,179 @@
+// Package providertest holds the fakes the provider tests share: a user cache that knows
+// every user, an in-memory gate cache, and a messenger and media service that record
+// what a provider forwards to the core.
+package providertest
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sync"
+
+	sharedmodel "github.com/webitel/im-providers-service/internal/core/model"
+	sharedstore "github.com/webitel/im-providers-service/internal/core/store"
+)
+
+// KnownUsers reports every user as known, so no contact is synced through the gateway.
+type KnownUsers struct{}
+
+func (KnownUsers) IsKnown(context.Context, *sharedmodel.ExternalUser) (bool, error) { return true, nil }
+func (KnownUsers) MarkKnown(context.Context, *sharedmodel.ExternalUser) error       { return nil }
+func (KnownUsers) GetLocale(context.Context, string, string) (string, error) {
+	return "", sharedstore.ErrNotFound
+}
+func (KnownUsers) SetLocale(context.Context, string, string, string) error { return nil }
+
+// GateCache keeps gate states in a map.
+type GateCache struct {
+	mu     sync.Mutex
+	states map[string]sharedstore.GateState
+}
+
+func NewGateCache() *GateCache {
+	return &GateCache{states: make(map[string]sharedstore.GateState)}
+}
+
+func (c *GateCache) Set(key string, state sharedstore.GateState) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.states[key] = state
+}
+
+func (c *GateCache) Get(key string) (sharedstore.GateState, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	s, ok := c.states[key]
+	return s, ok
+}
+
+func (c *GateCache) Delete(key string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.states, key)
+}
+
+// Messenger records the requests a provider sends to the core.
+type Messenger struct {
+	mu        sync.Mutex
+	fail      error
+	texts     []*sharedmodel.SendTextRequest
+	images    []*sharedmodel.SendImageRequest
+	documents []*sharedmodel.SendDocumentRequest
+	locations []*sharedmodel.SendLocationRequest
+	contacts  []*sharedmodel.SendContactRequest
+	callbacks []*sharedmodel.SendInteractiveCallbackRequest
+	systems   []*sharedmodel.SendSystemMessageRequest
+}
+
+// Fail makes SendText return err until it is called again with nil.
+func (m *Messenger) Fail(err error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.fail = err
+}
+
+func (m *Messenger) SendText(_ context.Context, in *sharedmodel.SendTextRequest) (*sharedmodel.SendTextResponse, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.fail != nil {
+		return nil, m.fail
+	}
+	m.texts = append(m.texts, in)
+	return &sharedmodel.SendTextResponse{To: in.To}, nil
+}
+
+func (m *Messenger) SendImage(_ context.Context, in *sharedmodel.SendImageRequest) (*sharedmodel.SendImageResponse, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.images = append(m.images, in)
+	return &sharedmodel.SendImageResponse{To: in.To}, nil
+}
+
+func (m *Messenger) SendDocument(_ context.Context, in *sharedmodel.SendDocumentRequest) (*sharedmodel.SendDocumentResponse, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.documents = append(m.documents, in)
+	return &sharedmodel.SendDocumentResponse{To: in.To}, nil
+}
+
+func (m *Messenger) SendLocation(_ context.Context, in *sharedmodel.SendLocationRequest) (*sharedmodel.SendResponse, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.locations = append(m.locations, in)
+	return &sharedmodel.SendResponse{}, nil
+}
+
+func (m *Messenger) SendContact(_ context.Context, in *sharedmodel.SendContactRequest) (*sharedmodel.SendResponse, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.contacts = append(m.contacts, in)
+	return &sharedmodel.SendResponse{}, nil
+}
+
+func (m *Messenger) SendInteractiveCallback(_ context.Context, in *sharedmodel.SendInteractiveCallbackRequest) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.callbacks = append(m.callbacks, in)
+	return nil
+}
+
+func (m *Messenger) SendSystemMessage(_ context.Context, in *sharedmodel.SendSystemMessageRequest) (*sharedmodel.SendResponse, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.systems = append(m.systems, in)
+	return &sharedmodel.SendResponse{}, nil
+}
+
+func (m *Messenger) Texts() []*sharedmodel.SendTextRequest { return snapshot(m, m.texts) }
+
+func (m *Messenger) Images() []*sharedmodel.SendImageRequest { return snapshot(m, m.images) }
+
+func (m *Messenger) Documents() []*sharedmodel.SendDocumentRequest { return snapshot(m, m.documents) }
+
+func (m *Messenger) Locations() []*sharedmodel.SendLocationRequest { return snapshot(m, m.locations) }
+
+func (m *Messenger) Contacts() []*sharedmodel.SendContactRequest { return snapshot(m, m.contacts) }
+
+func (m *Messenger) Callbacks() []*sharedmodel.SendInteractiveCallbackRequest {
+	return snapshot(m, m.callbacks)
+}
+
+func (m *Messenger) Systems() []*sharedmodel.SendSystemMessageRequest { return snapshot(m, m.systems) }
+
+// snapshot copies the requests recorded so far, so a test can read them while the
+// provider is still sending.
+func snapshot[T any](m *Messenger, sent []T) []T {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]T(nil), sent...)
+}
+
+// Upload is a file a provider stored through Media.
+type Upload struct {
+	Req  sharedmodel.UploadRequest
+	Body string
+}
+
+// Media records the files a provider uploads. The n-th upload gets the ID file-n.
+type Media struct {
+	mu      sync.Mutex
+	uploads []Upload
+}
+
+func (m *Media) UploadFile(_ context.Context, req sharedmodel.UploadRequest, body io.Reader) (sharedmodel.UploadResponse, error) {
+	raw, err := io.ReadAll(body)
+	if err != nil {
+		return sharedmodel.UploadResponse{}, err
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.uploads = append(m.uploads, Upload{Req: req, Body: string(raw)})
+	id := fmt.Sprintf("file-%d", len(m.uploads))
+	return sharedmodel.UploadResponse{ID: id, Size: int64(len(raw)), URL: "https://files.example.com/" + id}, nil
+}
+
+func (m *Media) Uploads() []Upload {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]Upload(nil), m.uploads...)
+}
@@ -0,0 +1,58 @@
+package provider
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	sharedmodel "github.com/webitel/im-providers-service/internal/core/model"
+)
+
+// SendLocation delivers Message.Location through the provider's LocationSender.
+func SendLocation(ctx context.Context, s Sender, req *sharedmodel.Message) (*sharedmodel.MessageResponse, error) {
+	if req.Location == nil {
+		return nil, status.Error(codes.InvalidArgument, "message has no location")
+	}
+	ls, ok := s.(LocationSender)
+	if !ok {
+		return nil, status.Errorf(codes.Unimplemented, "provider %s does not support location messages", s.Type())
+	}
+	return ls.SendLocation(ctx, req)
+}
+
+// SendContact delivers Message.Contacts through the provider's ContactSender.
+func SendContact(ctx context.Context, s Sender, req *sharedmodel.Message) (*sharedmodel.MessageResponse, error) {
+	if len(req.Contacts) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "message has no contacts")
+	}
+	cs, ok := s.(ContactSender)
+	if !ok {
+		return nil, status.Errorf(codes.Unimplemented, "provider %s does not support contact messages", s.Type())
+	}
+	return cs.SendContact(ctx, req)
+}
+
+// SendReaction delivers Message.Reaction through the provider's ReactionSender.
+func SendReaction(ctx context.Context, s Sender, req *sharedmodel.Message) (*sharedmodel.MessageResponse, error) {
+	if req.Reaction == nil || req.Reaction.MessageID == "" {
+		return nil, status.Error(codes.InvalidArgument, "message has no reaction target")
+	}
+	rs, ok := s.(ReactionSender)
+	if !ok {
+		return nil, status.Errorf(codes.Unimplemented, "provider %s does not support reactions", s.Type())
+	}
+	return rs.SendReaction(ctx, req)
+}
+
+// SendTyping shows a typing indicator through the provider's TypingSender.
+func SendTyping(ctx context.Context, s Sender, req *sharedmodel.Message) error {
+	if req.To.Sub == "" {
+		return status.Error(codes.InvalidArgument, "typing indicator has no recipient")
+	}
+	ts, ok := s.(TypingSender)
+	if !ok {
+		return status.Errorf(codes.Unimplemented, "provider %s does not support typing indicators", s.Type())
+	}
+	return ts.SendTyping(ctx, req)
+}
@@ -0,0 +1,86 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	sharedmodel "github.com/webitel/im-providers-service/internal/core/model"
+)
+
+type shareSender struct {
+	textOnlySender
+	sent *sharedmodel.Message
+}
+
+func (s *shareSender) SendLocation(_ context.Context, req *sharedmodel.Message) (*sharedmodel.MessageResponse, error) {
+	s.sent = req
+	return &sharedmodel.MessageResponse{ID: "ext-location"}, nil
+}
+
+func (s *shareSender) SendContact(_ context.Context, req *sharedmodel.Message) (*sharedmodel.MessageResponse, error) {
+	s.sent = req
+	return &sharedmodel.MessageResponse{ID: "ext-contact"}, nil
+}
+
+func (s *shareSender) SendReaction(_ context.Context, req *sharedmodel.Message) (*sharedmodel.MessageResponse, error) {
+	s.sent = req
+	return &sharedmodel.MessageResponse{ID: "ext-reaction"}, nil
+}
+
+func (s *shareSender) SendTyping(_ context.Context, req *sharedmodel.Message) error {
+	s.sent = req
+	return nil
+}
+
+func TestShareSenders(t *testing.T) {
+	location := &sharedmodel.Message{Location: &sharedmodel.Location{Latitude: 50.45, Longitude: 30.52}}
+	contact := &sharedmodel.Message{Contacts: []*sharedmodel.ContactCard{{FirstName: "Anna"}}}
+	reaction := &sharedmodel.Message{Reaction: &sharedmodel.Reaction{MessageID: "wamid.1", Emoji: "👍"}}
+
+	tests := []struct {
+		name   string
+		send   func(context.Context, Sender, *sharedmodel.Message) (*sharedmodel.MessageResponse, error)
+		req    *sharedmodel.Message
+		wantID string
+	}{
+		{"location", SendLocation, location, "ext-location"},
+		{"contact", SendContact, contact, "ext-contact"},
+		{"reaction", SendReaction, reaction, "ext-reaction"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &shareSender{}
+			resp, err := tt.send(context.Background(), s, tt.req)
+			if err != nil || resp.ID != tt.wantID || s.sent != tt.req {
+				t.Errorf("native delivery: resp = %+v, err = %v", resp, err)
+			}
+
+			if _, err := tt.send(context.Background(), &textOnlySender{}, tt.req); status.Code(err) != codes.Unimplemented {
+				t.Errorf("text-only provider: want Unimplemented, got %v", err)
+			}
+
+			if _, err := tt.send(context.Background(), s, &sharedmodel.Message{Text: "hi"}); status.Code(err) != codes.InvalidArgument {
+				t.Errorf("empty message: want InvalidArgument, got %v", err)
+			}
+		})
+	}
+}
+
+func TestSendTyping(t *testing.T) {
+	req := &sharedmodel.Message{GateID: "gate-1", To: sharedmodel.Peer{Sub: "visitor-1"}}
+
+	s := &shareSender{}
+	if err := SendTyping(context.Background(), s, req); err != nil || s.sent != req {
+		t.Errorf("native delivery: err = %v", err)
+	}
+	if err := SendTyping(context.Background(), &textOnlySender{}, req); status.Code(err) != codes.Unimplemented {
+		t.Errorf("text-only provider: want Unimplemented, got %v", err)
+	}
+	if err := SendTyping(context.Background(), s, &sharedmodel.Message{}); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("no recipient: want InvalidArgument, got %v", err)
+	}
+}
@@ -0,0 +1,229 @@
+package slack
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	sharedmodel "github.com/webitel/im-providers-service/internal/core/model"
+	skmodel "github.com/webitel/im-providers-service/internal/slack/model"
+)
+
+// APIBaseURL is the base URL of the Web API methods.
+const APIBaseURL = "https://slack.com/api"
+
+// botAPI is the contract used by slackProvider and the gate service to talk to the Web API.
+// Keeping it as an interface allows both to be tested without network calls.
+type botAPI interface {
+	AuthTest(ctx context.Context, token string) (*skmodel.AuthInfo, error)
+	ConversationInfo(ctx context.Context, token, channel string) (*skmodel.Conversation, error)
+	UserInfo(ctx context.Context, token, userID string) (*skmodel.User, error)
+	PostMessage(ctx context.Context, token, channel, threadTS, text string) (*sharedmodel.MessageResponse, error)
+	UploadFile(ctx context.Context, token string, f *outboundFile) (string, error)
+	CompleteUpload(ctx context.Context, token string, req *fileShare) (*sharedmodel.MessageResponse, error)
+	Download(ctx context.Context, token, fileURL string) (*content, error)
+}
+
+// fileShare shares uploaded files in a channel or thread, with the comment posted as their message.
+type fileShare struct {
+	Channel  string
+	ThreadTS string
+	Comment  string
+	Files    []uploadedFile
+}
+
+// outboundFile is a file to upload. Size must be the exact length of Body.
+type outboundFile struct {
+	Name string
+	Body io.Reader
+	Size int64
+}
+
+// content is the body of a downloaded file. The caller closes Body.
+type content struct {
+	Body     io.ReadCloser
+	MimeType string
+	Size     int64
+}
+
+type apiClient struct {
+	client *http.Client
+	logger *slog.Logger
+	apiURL string
+}
+
+var _ botAPI = (*apiClient)(nil)
+
+func newAPIClient(l *slog.Logger) *apiClient {
+	return &apiClient{
+		// File uploads share the client, hence the generous timeout.
+		client: &http.Client{Timeout: 2 * time.Minute},
+		logger: l.With("component", "slack.api"),
+		apiURL: APIBaseURL,
+	}
+}
+
+// AuthTest returns the workspace and the bot the token belongs to.
+// https://api.slack.com/methods/auth.test
+func (c *apiClient) AuthTest(ctx context.Context, token string) (*skmodel.AuthInfo, error) {
+	var resp authTestResponse
+	if err := c.call(ctx, token, "auth.test", nil, &resp); err != nil {
+		return nil, err
+	}
+	return &skmodel.AuthInfo{TeamID: resp.TeamID, TeamName: resp.Team, BotUserID: resp.UserID, BotID: resp.BotID}, nil
+}
+
+// ConversationInfo returns a channel or direct message the bot is a member of.
+// https://api.slack.com/methods/conversations.info
+func (c *apiClient) ConversationInfo(ctx context.Context, token, channel string) (*skmodel.Conversation, error) {
+	var resp conversationInfoResponse
+	if err := c.call(ctx, token, "conversations.info", url.Values{"channel": {channel}}, &resp); err != nil {
+		return nil, err
+	}
+	ch := resp.Channel
+	return &skmodel.Conversation{ID: ch.ID, Name: ch.Name, IsIM: ch.IsIM, User: ch.User}, nil
+}
+
+// UserInfo returns a workspace member.
+// https://api.slack.com/methods/users.info
+func (c *apiClient) UserInfo(ctx context.Context, token, userID string) (*skmodel.User, error) {
+	var resp userInfoResponse
+	if err := c.call(ctx, token, "users.info", url.Values{"user": {userID}}, &resp); err != nil {
+		return nil, err
+	}
+	u := resp.User
+	return &skmodel.User{ID: u.ID, Name: u.Name, RealName: u.RealName, DisplayName: u.Profile.DisplayName}, nil
+}
+
+// PostMessage posts a message to a channel, or to a thread when threadTS is set.
+// https://api.slack.com/methods/chat.postMessage
+func (c *apiClient) PostMessage(ctx context.Context, token, channel, threadTS, text string) (*sharedmodel.MessageResponse, error) {
+	params := url.Values{"channel": {channel}, "text": {text}}
+	if threadTS != "" {
+		params.Set("thread_ts", threadTS)
+	}
+
+	var resp postMessageResponse
+	if err := c.call(ctx, token, "chat.postMessage", params, &resp); err != nil {
+		return nil, err
+	}
+	return &sharedmodel.MessageResponse{ID: resp.TS}, nil
+}
+
+// UploadFile sends the content of a file to the upload URL Slack hands out for it
+// and returns the ID of the file, not shared anywhere until CompleteUpload.
+// https://api.slack.com/methods/files.getUploadURLExternal
+func (c *apiClient) UploadFile(ctx context.Context, token string, f *outboundFile) (string, error) {
+	var target uploadURLResponse
+	params := url.Values{"filename": {f.Name}, "length": {strconv.FormatInt(f.Size, 10)}}
+	if err := c.call(ctx, token, "files.getUploadURLExternal", params, &target); err != nil {
+		return "", err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, target.UploadURL, f.Body)
+	if err != nil {
+		return "", fmt.Errorf("slack upload %s: %w", f.Name, err)
+	}
+	req.ContentLength = f.Size
+	req.Header.Set("Content-Type", "application/octet-stream")
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("slack upload %s: %w", f.Name, err)
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, resp.Body)
+
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("slack upload %s: status %s", f.Name, resp.Status)
+	}
+	return target.FileID, nil
+}
+
+// CompleteUpload shares the uploaded files in a single message.
+// https://api.slack.com/methods/files.completeUploadExternal
+func (c *apiClient) CompleteUpload(ctx context.Context, token string, req *fileShare) (*sharedmodel.MessageResponse, error) {
+	files, err := json.Marshal(req.Files)
+	if err != nil {
+		return nil, fmt.Errorf("slack files.completeUploadExternal: marshal: %w", err)
+	}
+	params := url.Values{"files": {string(files)}, "channel_id": {req.Channel}}
+	if req.ThreadTS != "" {
+		params.Set("thread_ts", req.ThreadTS)
+	}
+	if req.Comment != "" {
+		params.Set("initial_comment", req.Comment)
+	}
+
+	var resp completeUploadResponse
+	if err := c.call(ctx, token, "files.completeUploadExternal", params, &resp); err != nil {
+		return nil, err
+	}
+	out := &sharedmodel.MessageResponse{}
+	if len(resp.Files) > 0 {
+		out.ID = resp.Files[0].ID
+	}
+	return out, nil
+}
+
+// Download streams a private file shared in a channel the bot is a member of.
+// https://api.slack.com/types/file#authentication
+func (c *apiClient) Download(ctx context.Context, token, fileURL string) (*content, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, fileURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("slack download: %w", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("slack download: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		resp.Body.Close()
+		return nil, fmt.Errorf("slack download: status %s", resp.Status)
+	}
+	return &content{Body: resp.Body, MimeType: resp.Header.Get("Content-Type"), Size: resp.ContentLength}, nil
+}
+
+// call sends the form-encoded params to the Web API method and decodes the reply into
+// out, which must embed response. A reply with "ok": false is returned as an *APIError.
+func (c *apiClient) call(ctx context.Context, token, method string, params url.Values, out interface{ result() *response }) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.apiURL+"/"+method, strings.NewReader(params.Encode()))
+	if err != nil {
+		return fmt.Errorf("slack %s: %w", method, err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Authorization", "Bearer "+token)
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("slack %s: %w", method, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusTooManyRequests {
+		return &APIError{Method: method, Code: "ratelimited"}
+	}
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("slack %s: status %s", method, resp.Status)
+	}
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fmt.Errorf("slack %s: decode response: %w", method, err)
+	}
+
+	if r := out.result(); !r.OK {
+		c.logger.WarnContext(ctx, "web api request rejected", "method", method, "error", r.Error)
+		return &APIError{Method: method, Code: r.Error}
+	}
+	return nil
+}
+
+func (r *response) result() *response { return r }
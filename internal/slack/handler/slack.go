@@ -0,0 +1,131 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	impb "github.com/webitel/im-providers-service/gen/go/provider/v1"
+	"github.com/webitel/im-providers-service/internal/provider/gaterpc"
+	skmodel "github.com/webitel/im-providers-service/internal/slack/model"
+	skservice "github.com/webitel/im-providers-service/internal/slack/service"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type SlackHandler struct {
+	logger *slog.Logger
+	srv    skservice.SlackManager
+	impb.UnimplementedSlackServiceServer
+}
+
+func NewSlackHandler(logger *slog.Logger, srv skservice.SlackManager) *SlackHandler {
+	return &SlackHandler{logger: logger, srv: srv}
+}
+
+func (h *SlackHandler) CreateSlackGate(ctx context.Context, req *impb.ProviderCreateSlackGateRequest) (*impb.ProviderCreateSlackGateResponse, error) {
+	domainID, err := gaterpc.DomainID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	create := skmodel.CreateSlack{
+		Name:          req.GetName(),
+		Dc:            domainID,
+		BotToken:      req.GetBotToken(),
+		SigningSecret: req.GetSigningSecret(),
+	}
+	if peer := gaterpc.Peer(req.GetPeer()); peer != nil {
+		create.Peer = *peer
+	}
+
+	gate, err := h.srv.CreateGate(ctx, create)
+	if err != nil {
+		return nil, toStatus(err, "create gate")
+	}
+
+	return &impb.ProviderCreateSlackGateResponse{Item: gateToProto(gate)}, nil
+}
+
+func (h *SlackHandler) GetSlackGate(ctx context.Context, req *impb.ProviderGetSlackGateRequest) (*impb.ProviderGetSlackGateResponse, error) {
+	gate, err := h.gate(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	return &impb.ProviderGetSlackGateResponse{Item: gateToProto(gate)}, nil
+}
+
+func (h *SlackHandler) UpdateSlackGate(ctx context.Context, req *impb.ProviderUpdateSlackGateRequest) (*impb.ProviderUpdateSlackGateResponse, error) {
+	if _, err := h.gate(ctx, req.GetId()); err != nil {
+		return nil, err
+	}
+
+	gate, err := h.srv.UpdateGate(ctx, skmodel.UpdateSlack{
+		ID:            req.GetId(),
+		Name:          req.Name,
+		BotToken:      req.BotToken,
+		SigningSecret: req.SigningSecret,
+		Enabled:       req.Enabled,
+		Peer:          gaterpc.Peer(req.GetPeer()),
+	})
+	if err != nil {
+		return nil, toStatus(err, "update gate")
+	}
+
+	return &impb.ProviderUpdateSlackGateResponse{Item: gateToProto(gate)}, nil
+}
+
+func (h *SlackHandler) DeleteSlackGate(ctx context.Context, req *impb.ProviderDeleteSlackGateRequest) (*impb.ProviderDeleteSlackGateResponse, error) {
+	if _, err := h.gate(ctx, req.GetId()); err != nil {
+		return nil, err
+	}
+
+	gate, err := h.srv.DeleteGate(ctx, req.GetId())
+	if err != nil {
+		return nil, toStatus(err, "delete gate")
+	}
+
+	return &impb.ProviderDeleteSlackGateResponse{Item: gateToProto(gate)}, nil
+}
+
+// gate returns the gate when it belongs to the domain of the caller.
+func (h *SlackHandler) gate(ctx context.Context, id string) (*skmodel.SlackGate, error) {
+	gate, err := h.srv.GetGate(ctx, id)
+	if err != nil {
+		return nil, toStatus(err, "get gate")
+	}
+	if err := gaterpc.CheckDomain(ctx, gate.DomainID); err != nil {
+		return nil, err
+	}
+	return gate, nil
+}
+
+// toStatus reports a missing public URL as a precondition: there is no request URL to
+// give the app until the service is reachable from Slack.
+func toStatus(err error, internalMsg string) error {
+	if errors.Is(err, skservice.ErrPublicURLNotSet) {
+		return status.Error(codes.FailedPrecondition, err.Error())
+	}
+	return gaterpc.ToStatus(err, internalMsg)
+}
+
+func gateToProto(g *skmodel.SlackGate) *impb.ProviderSlackGate {
+	if g == nil {
+		return nil
+	}
+	return &impb.ProviderSlackGate{
+		Id:         g.ID,
+		Name:       g.Name,
+		Peer:       gaterpc.ToProtoPeer(g.Peer),
+		TeamId:     g.TeamID,
+		TeamName:   g.TeamName,
+		BotUserId:  g.BotUserID,
+		BotId:      g.BotID,
+		WebhookUrl: g.WebhookURL,
+		Status:     impb.ProviderStatus(g.Status),
+		CreatedAt:  g.CreatedAt.UnixMilli(),
+		UpdatedAt:  g.UpdatedAt.UnixMilli(),
+		Enabled:    g.Enabled,
+	}
+}
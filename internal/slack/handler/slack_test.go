@@ -0,0 +1,216 @@
+package handler
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"testing"
+
+	impb "github.com/webitel/im-providers-service/gen/go/provider/v1"
+	"github.com/webitel/im-providers-service/infra/auth"
+	sharedstore "github.com/webitel/im-providers-service/internal/core/store"
+	skmodel "github.com/webitel/im-providers-service/internal/slack/model"
+	skservice "github.com/webitel/im-providers-service/internal/slack/service"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type admin struct{ domainID int64 }
+
+func (a admin) GetContactID() string { return "" }
+func (a admin) GetDomainID() int64   { return a.domainID }
+func (a admin) GetName() string      { return "" }
+
+func adminContext(domainID int64) context.Context {
+	return context.WithValue(context.Background(), auth.AuthContextKey, admin{domainID: domainID})
+}
+
+// appDirectory connects the apps by the workspace of their bot token, one gate per
+// workspace, and fails with err when it is set.
+type appDirectory struct {
+	workspaces map[string]skmodel.AuthInfo
+	gates      map[string]*skmodel.SlackGate
+	deleted    []string
+	err        error
+}
+
+var _ skservice.SlackManager = (*appDirectory)(nil)
+
+func newAppDirectory(gates ...*skmodel.SlackGate) *appDirectory {
+	d := &appDirectory{
+		workspaces: map[string]skmodel.AuthInfo{
+			"xoxb-acme":   {TeamID: "T1", TeamName: "Acme", BotUserID: "U1", BotID: "B1"},
+			"xoxb-globex": {TeamID: "T2", TeamName: "Globex", BotUserID: "U2", BotID: "B2"},
+		},
+		gates: map[string]*skmodel.SlackGate{},
+	}
+	for _, g := range gates {
+		d.gates[g.ID] = g
+	}
+	return d
+}
+
+func (d *appDirectory) CreateGate(_ context.Context, req skmodel.CreateSlack) (*skmodel.SlackGate, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	if d.err != nil {
+		return nil, d.err
+	}
+	ws := d.workspaces[req.BotToken]
+	if _, ok := d.gates["gate-"+ws.TeamID]; ok {
+		return nil, sharedstore.ErrConflict
+	}
+	gate := &skmodel.SlackGate{
+		ID:            "gate-" + ws.TeamID,
+		DomainID:      req.Dc,
+		Name:          req.Name,
+		Peer:          req.Peer,
+		TeamID:        ws.TeamID,
+		TeamName:      ws.TeamName,
+		BotUserID:     ws.BotUserID,
+		BotID:         ws.BotID,
+		BotToken:      req.BotToken,
+		SigningSecret: req.SigningSecret,
+		WebhookURL:    "https://im.example.com/wh/slack/gate-" + ws.TeamID,
+		Enabled:       true,
+	}
+	d.gates[gate.ID] = gate
+	return gate, nil
+}
+
+func (d *appDirectory) GetGate(_ context.Context, id string) (*skmodel.SlackGate, error) {
+	g, ok := d.gates[id]
+	if !ok {
+		return nil, sharedstore.ErrNotFound
+	}
+	return g, nil
+}
+
+func (d *appDirectory) UpdateGate(_ context.Context, req skmodel.UpdateSlack) (*skmodel.SlackGate, error) {
+	gate := d.gates[req.ID]
+	req.ApplyTo(gate)
+	ws := d.workspaces[gate.BotToken]
+	gate.TeamID, gate.TeamName, gate.BotUserID, gate.BotID = ws.TeamID, ws.TeamName, ws.BotUserID, ws.BotID
+	return gate, nil
+}
+
+func (d *appDirectory) DeleteGate(_ context.Context, id string) (*skmodel.SlackGate, error) {
+	d.deleted = append(d.deleted, id)
+	return d.gates[id], nil
+}
+
+func acmeApp() *skmodel.SlackGate {
+	return &skmodel.SlackGate{
+		ID:            "gate-T1",
+		DomainID:      7,
+		Name:          "Support",
+		TeamID:        "T1",
+		TeamName:      "Acme",
+		BotUserID:     "U1",
+		BotID:         "B1",
+		BotToken:      "xoxb-acme",
+		SigningSecret: "signing-acme",
+		Enabled:       true,
+	}
+}
+
+func newHandler(d *appDirectory) *SlackHandler {
+	return NewSlackHandler(slog.New(slog.NewTextHandler(io.Discard, nil)), d)
+}
+
+func TestCreateSlackGate_ReturnsRequestURL(t *testing.T) {
+	d := newAppDirectory()
+
+	resp, err := newHandler(d).CreateSlackGate(adminContext(7), &impb.ProviderCreateSlackGateRequest{
+		Name:          "Support",
+		BotToken:      "xoxb-acme",
+		SigningSecret: "signing-acme",
+	})
+	if err != nil {
+		t.Fatalf("CreateSlackGate: %v", err)
+	}
+	item := resp.GetItem()
+	if item.GetTeamId() != "T1" || item.GetTeamName() != "Acme" || item.GetBotUserId() != "U1" || item.GetBotId() != "B1" {
+		t.Errorf("workspace = %+v", item)
+	}
+	if item.GetWebhookUrl() != "https://im.example.com/wh/slack/gate-T1" {
+		t.Errorf("request url = %q", item.GetWebhookUrl())
+	}
+	if g := d.gates["gate-T1"]; g.DomainID != 7 || g.SigningSecret != "signing-acme" {
+		t.Errorf("stored gate = %+v", g)
+	}
+}
+
+func TestCreateSlackGate_ErrorCodes(t *testing.T) {
+	tests := []struct {
+		name    string
+		req     *impb.ProviderCreateSlackGateRequest
+		failure error
+		want    codes.Code
+	}{
+		{
+			name: "no signing secret",
+			req:  &impb.ProviderCreateSlackGateRequest{Name: "Sales", BotToken: "xoxb-globex"},
+			want: codes.InvalidArgument,
+		},
+		{
+			name: "workspace already connected",
+			req:  &impb.ProviderCreateSlackGateRequest{Name: "Sales", BotToken: "xoxb-acme", SigningSecret: "s"},
+			want: codes.AlreadyExists,
+		},
+		{
+			name:    "no public url",
+			req:     &impb.ProviderCreateSlackGateRequest{Name: "Sales", BotToken: "xoxb-globex", SigningSecret: "s"},
+			failure: skservice.ErrPublicURLNotSet,
+			want:    codes.FailedPrecondition,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := newAppDirectory(acmeApp())
+			d.err = tt.failure
+
+			_, err := newHandler(d).CreateSlackGate(adminContext(7), tt.req)
+			if status.Code(err) != tt.want {
+				t.Errorf("code = %v, want %v", status.Code(err), tt.want)
+			}
+		})
+	}
+}
+
+func TestUpdateSlackGate_NewBotTokenMovesWorkspace(t *testing.T) {
+	d := newAppDirectory(acmeApp())
+
+	token := "xoxb-globex"
+	resp, err := newHandler(d).UpdateSlackGate(adminContext(7), &impb.ProviderUpdateSlackGateRequest{Id: "gate-T1", BotToken: &token})
+	if err != nil {
+		t.Fatalf("UpdateSlackGate: %v", err)
+	}
+	if item := resp.GetItem(); item.GetTeamId() != "T2" || item.GetBotUserId() != "U2" || item.GetName() != "Support" {
+		t.Errorf("item = %+v", item)
+	}
+	if d.gates["gate-T1"].SigningSecret != "signing-acme" {
+		t.Error("signing secret changed without being set")
+	}
+}
+
+func TestSlackGate_OtherDomainIsNotFound(t *testing.T) {
+	d := newAppDirectory(acmeApp())
+	h := newHandler(d)
+	ctx := adminContext(8)
+
+	if _, err := h.GetSlackGate(ctx, &impb.ProviderGetSlackGateRequest{Id: "gate-T1"}); status.Code(err) != codes.NotFound {
+		t.Errorf("get: code = %v, want NotFound", status.Code(err))
+	}
+	secret := "stolen"
+	if _, err := h.UpdateSlackGate(ctx, &impb.ProviderUpdateSlackGateRequest{Id: "gate-T1", SigningSecret: &secret}); status.Code(err) != codes.NotFound {
+		t.Errorf("update: code = %v, want NotFound", status.Code(err))
+	}
+	if _, err := h.DeleteSlackGate(ctx, &impb.ProviderDeleteSlackGateRequest{Id: "gate-T1"}); status.Code(err) != codes.NotFound {
+		t.Errorf("delete: code = %v, want NotFound", status.Code(err))
+	}
+	if d.gates["gate-T1"].SigningSecret != "signing-acme" || len(d.deleted) != 0 {
+		t.Errorf("gate of another domain changed: %+v, deleted %v", d.gates["gate-T1"], d.deleted)
+	}
+}
@@ -0,0 +1,91 @@
+package slack
+
+import (
+	"context"
+	"strings"
+
+	sharedmodel "github.com/webitel/im-providers-service/internal/core/model"
+	"github.com/webitel/im-providers-service/internal/provider/contactsync"
+	skmodel "github.com/webitel/im-providers-service/internal/slack/model"
+)
+
+// handleFile copies a shared file to the storage and forwards it with body as the
+// caption. Images become images, anything else becomes a document. It reports
+// whether the file was forwarded, so a caption is not lost when it was not.
+func (p *slackProvider) handleFile(ctx context.Context, gate *skmodel.SlackGate, peers contactsync.Peers, f *file, body string) bool {
+	if f.URLPrivateDownload == "" {
+		// Files of external organizations are not downloadable by the bot.
+		p.logger.Warn("file without download URL skipped", "file_id", f.ID)
+		return false
+	}
+
+	c, err := p.api.Download(ctx, gate.BotToken, f.URLPrivateDownload)
+	if err != nil {
+		p.logger.Error("failed to download file", "file_id", f.ID, "err", err)
+		return false
+	}
+	defer c.Body.Close()
+
+	name := f.Name
+	if name == "" {
+		name = f.ID
+	}
+	mimeType := f.Mimetype
+	if mimeType == "" {
+		mimeType = c.MimeType
+	}
+
+	uploaded, err := p.media.UploadFile(ctx, sharedmodel.UploadRequest{
+		DomainID: gate.DomainID,
+		Name:     name,
+		MimeType: mimeType,
+	}, c.Body)
+	if err != nil {
+		p.logger.Error("failed to sync file", "file_id", f.ID, "err", err)
+		return false
+	}
+
+	size := f.Size
+	if size <= 0 {
+		size = max(c.Size, 1)
+	}
+
+	if strings.HasPrefix(mimeType, "image/") {
+		if _, err := p.messenger.SendImage(ctx, &sharedmodel.SendImageRequest{
+			DomainID: gate.DomainID,
+			From:     peers.From,
+			To:       peers.To,
+			Image: sharedmodel.ImageRequest{
+				Body: body,
+				Images: []*sharedmodel.Image{{
+					ID:       uploaded.ID,
+					FileName: name,
+					MimeType: mimeType,
+				}},
+			},
+		}); err != nil {
+			p.logger.Error("failed to send image", "fileName", name, "err", err)
+			return false
+		}
+		return true
+	}
+
+	if _, err := p.messenger.SendDocument(ctx, &sharedmodel.SendDocumentRequest{
+		DomainID: gate.DomainID,
+		From:     peers.From,
+		To:       peers.To,
+		Document: sharedmodel.DocumentRequest{
+			Body: body,
+			Documents: []*sharedmodel.Document{{
+				ID:       uploaded.ID,
+				FileName: name,
+				MimeType: mimeType,
+				Size:     size,
+			}},
+		},
+	}); err != nil {
+		p.logger.Error("failed to send document", "fileName", name, "err", err)
+		return false
+	}
+	return true
+}
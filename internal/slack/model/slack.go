@@ -0,0 +1,123 @@
+package model
+
+import (
+	"time"
+
+	sharedmodel "github.com/webitel/im-providers-service/internal/core/model"
+)
+
+// SlackGate represents a Slack app installed in a workspace.
+type SlackGate struct {
+	ID       string           `json:"id" db:"id"`
+	DomainID int64            `json:"domain_id" db:"domain_id"`
+	Peer     sharedmodel.Peer `json:"peer" db:"peer"`
+	Name     string           `json:"name" db:"name"`
+	// TeamID is the workspace the bot token belongs to; events of other workspaces are dropped.
+	TeamID   string `json:"team_id" db:"team_id"`
+	TeamName string `json:"team_name" db:"team_name"`
+	// BotUserID and BotID identify the messages the app posts itself, so they are not routed back.
+	BotUserID string `json:"bot_user_id" db:"bot_user_id"`
+	BotID     string `json:"bot_id" db:"bot_id"`
+	// BotToken authorizes the Web API calls, SigningSecret signs the Events API requests.
+	BotToken      string `json:"-" db:"bot_token"`
+	SigningSecret string `json:"-" db:"signing_secret"`
+	// WebhookURL is the Events API request URL to configure in the Slack app settings.
+	WebhookURL string                 `json:"webhook_url" db:"webhook_url"`
+	Status     sharedmodel.GateStatus `json:"status" db:"status"`
+	CreatedAt  time.Time              `json:"created_at" db:"created_at"`
+	UpdatedAt  time.Time              `json:"updated_at" db:"updated_at"`
+	Enabled    bool                   `json:"enabled" db:"enabled"`
+}
+
+type CreateSlack struct {
+	Name          string
+	Dc            int64
+	BotToken      string
+	SigningSecret string
+	Peer          sharedmodel.Peer
+}
+
+type UpdateSlack struct {
+	ID            string
+	Name          *string
+	BotToken      *string
+	SigningSecret *string
+	Enabled       *bool
+	Peer          *sharedmodel.Peer
+}
+
+func (r UpdateSlack) ApplyTo(gate *SlackGate) {
+	if r.Name != nil {
+		gate.Name = *r.Name
+	}
+	if r.Enabled != nil {
+		gate.Enabled = *r.Enabled
+	}
+	if r.BotToken != nil {
+		gate.BotToken = *r.BotToken
+	}
+	if r.SigningSecret != nil {
+		gate.SigningSecret = *r.SigningSecret
+	}
+	if r.Peer != nil {
+		gate.Peer = *r.Peer
+	}
+}
+
+// AuthInfo is the workspace and bot a token belongs to, as returned by auth.test.
+type AuthInfo struct {
+	TeamID    string
+	TeamName  string
+	BotUserID string
+	BotID     string
+}
+
+// Conversation is a channel, a private channel or a direct message.
+type Conversation struct {
+	ID   string
+	Name string
+	// IsIM is set for direct messages; User is the other member then.
+	IsIM bool
+	User string
+}
+
+// User is a workspace member.
+type User struct {
+	ID          string
+	Name        string
+	RealName    string
+	DisplayName string
+}
+
+// DisplayedName returns the name Slack shows for the user.
+func (u *User) DisplayedName() string {
+	switch {
+	case u.DisplayName != "":
+		return u.DisplayName
+	case u.RealName != "":
+		return u.RealName
+	case u.Name != "":
+		return u.Name
+	}
+	return u.ID
+}
+
+func (r CreateSlack) Validate() error {
+	var missing []string
+	if r.Name == "" {
+		missing = append(missing, "name")
+	}
+	if r.BotToken == "" {
+		missing = append(missing, "bot_token")
+	}
+	if r.SigningSecret == "" {
+		missing = append(missing, "signing_secret")
+	}
+	if r.Dc <= 0 {
+		missing = append(missing, "domain_id")
+	}
+	if len(missing) > 0 {
+		return &sharedmodel.ValidationError{Fields: missing}
+	}
+	return nil
+}
@@ -0,0 +1,44 @@
+package slack
+
+import (
+	impb "github.com/webitel/im-providers-service/gen/go/provider/v1"
+	grpcsrv "github.com/webitel/im-providers-service/infra/srv/grpc"
+	"github.com/webitel/im-providers-service/internal/provider"
+	skhandler "github.com/webitel/im-providers-service/internal/slack/handler"
+	skservice "github.com/webitel/im-providers-service/internal/slack/service"
+	skstore "github.com/webitel/im-providers-service/internal/slack/store"
+	skpostgres "github.com/webitel/im-providers-service/internal/slack/store/postgres"
+	"go.uber.org/fx"
+)
+
+// Module provides the Slack provider adapter and the gRPC gate service.
+var Module = fx.Module("slack",
+	fx.Provide(
+		// Web API client — provided as *apiClient for the provider adapter
+		// and as BotAPI for the Slack service.
+		newAPIClient,
+		func(c *apiClient) skservice.BotAPI { return c },
+
+		// Provider adapter
+		fx.Annotate(
+			New,
+			fx.As(new(provider.Provider)),
+			fx.ResultTags(`group:"providers"`),
+		),
+
+		// Store implementations
+		fx.Annotate(skpostgres.NewSlackStore, fx.As(new(skstore.SlackStore))),
+
+		// Services
+		fx.Annotate(skservice.NewSlackService, fx.As(new(skservice.SlackManager))),
+
+		// gRPC handlers
+		skhandler.NewSlackHandler,
+	),
+	fx.Invoke(RegisterSlackService),
+)
+
+// RegisterSlackService connects the Slack gate gRPC handler to the gRPC server.
+func RegisterSlackService(server *grpcsrv.Server, slack *skhandler.SlackHandler) {
+	impb.RegisterSlackServiceServer(server.Server, slack)
+}
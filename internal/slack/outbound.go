@@ -0,0 +1,164 @@
+package slack
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"path"
+
+	"github.com/google/uuid"
+	contactv1 "github.com/webitel/im-providers-service/gen/go/contact/v1"
+	sharedmodel "github.com/webitel/im-providers-service/internal/core/model"
+	"github.com/webitel/im-providers-service/internal/provider/contactsync"
+	skmodel "github.com/webitel/im-providers-service/internal/slack/model"
+)
+
+// maxBufferedFile bounds the files whose size is not announced by the media host:
+// Slack needs the length of a file before its upload starts.
+const maxBufferedFile = 64 << 20
+
+// sharedFile is a file of an outbound message.
+type sharedFile struct {
+	url  string
+	name string
+}
+
+func (p *slackProvider) SendText(ctx context.Context, req *sharedmodel.Message) (*sharedmodel.MessageResponse, error) {
+	g, conv, err := p.target(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return p.api.PostMessage(ctx, g.BotToken, conv.channel, conv.threadTS, req.Text)
+}
+
+func (p *slackProvider) SendImage(ctx context.Context, req *sharedmodel.Message) (*sharedmodel.MessageResponse, error) {
+	files := make([]sharedFile, 0, len(req.Images))
+	for _, img := range req.Images {
+		files = append(files, sharedFile{url: img.URL, name: img.FileName})
+	}
+	return p.sendFiles(ctx, req, files)
+}
+
+func (p *slackProvider) SendDocument(ctx context.Context, req *sharedmodel.Message) (*sharedmodel.MessageResponse, error) {
+	files := make([]sharedFile, 0, len(req.Documents))
+	for _, doc := range req.Documents {
+		files = append(files, sharedFile{url: doc.URL, name: doc.FileName})
+	}
+	return p.sendFiles(ctx, req, files)
+}
+
+// sendFiles uploads the files one by one and shares them in a single message with
+// the message text as its comment.
+func (p *slackProvider) sendFiles(ctx context.Context, req *sharedmodel.Message, files []sharedFile) (*sharedmodel.MessageResponse, error) {
+	if len(files) == 0 {
+		return nil, fmt.Errorf("slack: message has no files")
+	}
+
+	g, conv, err := p.target(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	uploaded := make([]uploadedFile, 0, len(files))
+	for _, f := range files {
+		id, err := p.uploadFile(ctx, g, f)
+		if err != nil {
+			return nil, err
+		}
+		uploaded = append(uploaded, uploadedFile{ID: id, Title: f.name})
+	}
+
+	return p.api.CompleteUpload(ctx, g.BotToken, &fileShare{
+		Channel:  conv.channel,
+		ThreadTS: conv.threadTS,
+		Comment:  req.Text,
+		Files:    uploaded,
+	})
+}
+
+// uploadFile copies a file from the media URL to Slack and returns its file ID.
+func (p *slackProvider) uploadFile(ctx context.Context, g *skmodel.SlackGate, f sharedFile) (string, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, f.url, nil)
+	if err != nil {
+		return "", fmt.Errorf("slack: download %s: %w", f.url, err)
+	}
+	resp, err := p.httpClient.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("slack: download %s: %w", f.url, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("slack: download %s: status %s", f.url, resp.Status)
+	}
+
+	var body io.Reader = resp.Body
+	size := resp.ContentLength
+	if size < 0 {
+		raw, err := io.ReadAll(io.LimitReader(resp.Body, maxBufferedFile+1))
+		if err != nil {
+			return "", fmt.Errorf("slack: download %s: %w", f.url, err)
+		}
+		if len(raw) > maxBufferedFile {
+			return "", fmt.Errorf("slack: download %s: file of unknown size exceeds %d bytes", f.url, maxBufferedFile)
+		}
+		body, size = bytes.NewReader(raw), int64(len(raw))
+	}
+
+	return p.api.UploadFile(ctx, g.BotToken, &outboundFile{Name: fileName(f), Body: body, Size: size})
+}
+
+// target returns the gate and the conversation a message is sent to.
+func (p *slackProvider) target(ctx context.Context, req *sharedmodel.Message) (*skmodel.SlackGate, conversation, error) {
+	g, err := p.repo.Select(ctx, req.GateID)
+	if err != nil {
+		return nil, conversation{}, err
+	}
+	sub, err := p.resolveReceiver(ctx, g, req.To.Sub)
+	if err != nil {
+		return nil, conversation{}, err
+	}
+	return g, parseConversation(sub), nil
+}
+
+// resolveReceiver returns the conversation peer for the given sub.
+// A conversation peer is returned as-is; an internal contact UUID is resolved via the contact service.
+func (p *slackProvider) resolveReceiver(ctx context.Context, gate *skmodel.SlackGate, contactID string) (string, error) {
+	if _, err := uuid.Parse(contactID); err != nil {
+		return contactID, nil
+	}
+	if sub, ok := p.receiverCache.Get(contactID); ok {
+		return sub, nil
+	}
+
+	authCtx := contactsync.WithGatewayIdentity(ctx, gate.DomainID, gate.Peer)
+	resp, err := p.contactClient.SearchContact(authCtx, &contactv1.SearchContactRequest{
+		Ids: []string{contactID},
+	})
+	if err != nil {
+		return "", fmt.Errorf("resolve slack conversation for %s: %w", contactID, err)
+	}
+	items := resp.GetContacts()
+	if len(items) == 0 || items[0].GetSubject() == "" {
+		return "", fmt.Errorf("resolve slack conversation for %s: contact not found or has no subject", contactID)
+	}
+	sub := items[0].GetSubject()
+	p.receiverCache.Add(contactID, sub)
+	return sub, nil
+}
+
+// fileName returns the name of the file, falling back to the last segment of its URL.
+func fileName(f sharedFile) string {
+	if f.name != "" {
+		return f.name
+	}
+	if u, err := url.Parse(f.url); err == nil {
+		if base := path.Base(u.Path); base != "." && base != "/" {
+			return base
+		}
+	}
+	return "file"
+}
@@ -0,0 +1,117 @@
+package slack
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/google/uuid"
+	sharedmodel "github.com/webitel/im-providers-service/internal/core/model"
+)
+
+func outboundRequest(to string) *sharedmodel.Message {
+	return &sharedmodel.Message{
+		ID:     uuid.MustParse("0190a8a4-aaaa-7000-8000-000000000001"),
+		GateID: testGateID,
+		To:     sharedmodel.Peer{Sub: to},
+	}
+}
+
+func TestSendText_Thread(t *testing.T) {
+	p := newInstalledApp(t)
+	p.stub.replies["chat.postMessage"] = `{"ok":true,"channel":"` + testChannel + `","ts":"1503435956.000247"}`
+
+	req := outboundRequest(testChannel + ":1355517523.000005")
+	req.Text = "Looking into it"
+	resp, err := p.SendText(context.Background(), req)
+	if err != nil {
+		t.Fatalf("SendText: %v", err)
+	}
+	if resp.ID != "1503435956.000247" {
+		t.Errorf("message id = %q", resp.ID)
+	}
+
+	calls := p.stub.sent()
+	if len(calls) != 1 || calls[0].method != "chat.postMessage" || calls[0].token != "Bearer "+testToken {
+		t.Fatalf("unexpected calls: %+v", calls)
+	}
+	params := calls[0].params
+	if params.Get("channel") != testChannel || params.Get("thread_ts") != "1355517523.000005" || params.Get("text") != "Looking into it" {
+		t.Errorf("unexpected params: %v", params)
+	}
+}
+
+func TestSendText_NotInChannel(t *testing.T) {
+	p := newInstalledApp(t)
+	p.stub.replies["chat.postMessage"] = `{"ok":false,"error":"not_in_channel"}`
+
+	req := outboundRequest(testChannel)
+	req.Text = "Hello"
+	_, err := p.SendText(context.Background(), req)
+	if !errors.Is(err, ErrNotInChannel) {
+		t.Fatalf("SendText() error = %v, want ErrNotInChannel", err)
+	}
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || apiErr.Method != "chat.postMessage" {
+		t.Errorf("error does not carry the method: %v", err)
+	}
+}
+
+func TestSendDocument_Upload(t *testing.T) {
+	p := newInstalledApp(t)
+	p.stub.replies["files.getUploadURLExternal"] = `{"ok":true,"upload_url":"` + p.stub.url + `/upload/v1/abc","file_id":"F05"}`
+	p.stub.replies["files.completeUploadExternal"] = `{"ok":true,"files":[{"id":"F05","title":"report.pdf"}]}`
+
+	media := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		// Flushing first drops the Content-Length, as a chunked media host would.
+		w.(http.Flusher).Flush()
+		_, _ = w.Write([]byte("%PDF-1.7"))
+	}))
+	t.Cleanup(media.Close)
+
+	req := outboundRequest(testChannel)
+	req.Text = "Your report"
+	req.Documents = []*sharedmodel.Document{{URL: media.URL + "/files/report.pdf", FileName: "report.pdf"}}
+	resp, err := p.SendDocument(context.Background(), req)
+	if err != nil {
+		t.Fatalf("SendDocument: %v", err)
+	}
+	if resp.ID != "F05" {
+		t.Errorf("message id = %q", resp.ID)
+	}
+
+	want := []string{"files.getUploadURLExternal", "upload/v1/abc", "files.completeUploadExternal"}
+	if got := p.stub.methods(); !reflect.DeepEqual(got, want) {
+		t.Fatalf("calls = %v, want %v", got, want)
+	}
+	calls := p.stub.sent()
+	if calls[0].params.Get("filename") != "report.pdf" || calls[0].params.Get("length") != "8" {
+		t.Errorf("unexpected upload url params: %v", calls[0].params)
+	}
+	if calls[1].body != "%PDF-1.7" {
+		t.Errorf("unexpected upload body: %q", calls[1].body)
+	}
+
+	complete := calls[2].params
+	var files []uploadedFile
+	if err := json.Unmarshal([]byte(complete.Get("files")), &files); err != nil || len(files) != 1 || files[0].ID != "F05" {
+		t.Errorf("unexpected files: %q", complete.Get("files"))
+	}
+	if complete.Get("channel_id") != testChannel || complete.Get("initial_comment") != "Your report" || complete.Has("thread_ts") {
+		t.Errorf("unexpected complete params: %v", complete)
+	}
+}
+
+func TestSendImage_NoFiles(t *testing.T) {
+	p := newInstalledApp(t)
+	if _, err := p.SendImage(context.Background(), outboundRequest(testChannel)); err == nil {
+		t.Fatal("expected an error")
+	}
+	if calls := p.stub.sent(); len(calls) != 0 {
+		t.Errorf("nothing must be sent: %+v", calls)
+	}
+}
@@ -0,0 +1,168 @@
+// Package slack implements the Slack provider: channels, private channels and direct
+// messages of a workspace the app is installed in are bridged through the Events API
+// and the Web API.
+// https://api.slack.com/apis/events-api
+package slack
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	lru "github.com/hashicorp/golang-lru/v2"
+	"github.com/hashicorp/golang-lru/v2/expirable"
+	imcontact "github.com/webitel/im-providers-service/infra/client/grpc/im-contact"
+	imgateway "github.com/webitel/im-providers-service/infra/client/grpc/im-gateway"
+	sharedsvc "github.com/webitel/im-providers-service/internal/core/service"
+	sharedstore "github.com/webitel/im-providers-service/internal/core/store"
+	"github.com/webitel/im-providers-service/internal/provider"
+	skmodel "github.com/webitel/im-providers-service/internal/slack/model"
+	skstore "github.com/webitel/im-providers-service/internal/slack/store"
+)
+
+const (
+	// seenTTL covers the retries of an event: Slack retries three times within about
+	// an hour when a request is not acknowledged in time.
+	seenTTL     = 2 * time.Hour
+	seenEntries = 10000
+	// userNameTTL bounds how long a renamed member keeps the old name on messages.
+	userNameTTL     = time.Hour
+	userNameEntries = 10000
+)
+
+type slackProvider struct {
+	api botAPI
+	// httpClient downloads outbound media before it is uploaded to Slack.
+	httpClient    *http.Client
+	logger        *slog.Logger
+	messenger     sharedsvc.Messenger
+	gateCache     sharedstore.GateCache
+	userCache     sharedstore.ExternalUserCache
+	repo          skstore.SlackStore
+	gatewayer     *imgateway.Client
+	media         sharedsvc.MediaManager
+	contactClient *imcontact.Client
+	// receiverCache maps internal contact UUID → conversation peer to avoid an
+	// im-contact round-trip on every outbound message.
+	receiverCache *lru.Cache[string, string]
+	// seen holds the events and messages already routed. Retries carry the same
+	// event ID, and a mention is delivered both as message and app_mention.
+	seenMu sync.Mutex
+	seen   *expirable.LRU[string, struct{}]
+	// userNames caches the displayed names of the members posting in channels.
+	userNames *expirable.LRU[string, string]
+}
+
+func New(
+	m sharedsvc.Messenger,
+	l *slog.Logger,
+	gc sharedstore.GateCache,
+	uc sharedstore.ExternalUserCache,
+	repo skstore.SlackStore,
+	gatewayer *imgateway.Client,
+	media sharedsvc.MediaManager,
+	contactClient *imcontact.Client,
+	api *apiClient,
+) provider.Provider {
+	receiverCache, _ := lru.New[string, string](1000)
+	return &slackProvider{
+		api:           api,
+		httpClient:    &http.Client{Timeout: 60 * time.Second},
+		logger:        l.With("provider", "slack"),
+		messenger:     m,
+		gateCache:     gc,
+		userCache:     uc,
+		repo:          repo,
+		gatewayer:     gatewayer,
+		media:         media,
+		contactClient: contactClient,
+		receiverCache: receiverCache,
+		seen:          expirable.NewLRU[string, struct{}](seenEntries, nil, seenTTL),
+		userNames:     expirable.NewLRU[string, string](userNameEntries, nil, userNameTTL),
+	}
+}
+
+var (
+	_ provider.RequestValidator = (*slackProvider)(nil)
+	_ provider.Handshaker       = (*slackProvider)(nil)
+)
+
+func (p *slackProvider) Type() string { return "slack" }
+
+// resolveGate returns the gate a webhook was delivered to. The webhook URI segment is
+// the gate ID, configured as the request URL of the Slack app. Disabled gates are
+// short-circuited from the cache to avoid a DB round-trip on every request.
+func (p *slackProvider) resolveGate(ctx context.Context) (*skmodel.SlackGate, error) {
+	gateID, _ := ctx.Value(provider.WebhookURIKey).(string)
+	if _, err := uuid.Parse(gateID); err != nil {
+		return nil, fmt.Errorf("invalid webhook uri %q: %w", gateID, sharedstore.ErrNotFound)
+	}
+
+	if cached, ok := p.gateCache.Get(gateID); ok && !cached.Enabled {
+		return &skmodel.SlackGate{ID: gateID, Enabled: false}, nil
+	}
+
+	g, err := p.repo.Select(ctx, gateID)
+	if err != nil {
+		return nil, err
+	}
+	p.gateCache.Set(gateID, sharedstore.GateState{
+		GateID:  g.ID,
+		Enabled: g.Enabled,
+		Issuer:  g.Peer.Iss,
+		Sub:     g.Peer.Sub,
+		Domain:  g.DomainID,
+	})
+	return g, nil
+}
+
+// firstSeen reports whether the key is seen for the first time and remembers it.
+func (p *slackProvider) firstSeen(key string) bool {
+	if key == "" {
+		return true
+	}
+	p.seenMu.Lock()
+	defer p.seenMu.Unlock()
+
+	if p.seen.Contains(key) {
+		return false
+	}
+	p.seen.Add(key, struct{}{})
+	return true
+}
+
+// conversation is the external peer of a channel, or of a thread within it: every
+// thread is a conversation of its own, so replies go back to the thread.
+type conversation struct {
+	channel  string
+	threadTS string
+}
+
+// conversationOf returns the conversation a message belongs to. A thread is
+// identified by the timestamp of its parent message.
+func conversationOf(ev *event) conversation {
+	if ev.ThreadTS != "" && ev.ThreadTS != ev.TS {
+		return conversation{channel: ev.Channel, threadTS: ev.ThreadTS}
+	}
+	return conversation{channel: ev.Channel}
+}
+
+// parseConversation is the inverse of conversation.sub.
+func parseConversation(sub string) conversation {
+	channel, threadTS, _ := strings.Cut(sub, ":")
+	return conversation{channel: channel, threadTS: threadTS}
+}
+
+// sub is the peer subject of the conversation: the channel ID, followed by the
+// thread timestamp for a thread.
+func (c conversation) sub() string {
+	if c.threadTS == "" {
+		return c.channel
+	}
+	return c.channel + ":" + c.threadTS
+}
@@ -0,0 +1,145 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/webitel/im-providers-service/config"
+	skmodel "github.com/webitel/im-providers-service/internal/slack/model"
+	skstore "github.com/webitel/im-providers-service/internal/slack/store"
+)
+
+// ErrPublicURLNotSet is returned when a request URL is to be issued but service.public_url is empty.
+var ErrPublicURLNotSet = errors.New("slack: service.public_url is not configured")
+
+var _ SlackManager = (*SlackService)(nil)
+
+type SlackManager interface {
+	CreateGate(ctx context.Context, req skmodel.CreateSlack) (*skmodel.SlackGate, error)
+	GetGate(ctx context.Context, id string) (*skmodel.SlackGate, error)
+	UpdateGate(ctx context.Context, req skmodel.UpdateSlack) (*skmodel.SlackGate, error)
+	DeleteGate(ctx context.Context, id string) (*skmodel.SlackGate, error)
+}
+
+// BotAPI is the subset of the Web API used to identify the workspace of a bot token.
+// Defined here (exported) so the parent slack package can satisfy it without an import cycle.
+type BotAPI interface {
+	AuthTest(ctx context.Context, token string) (*skmodel.AuthInfo, error)
+}
+
+type SlackService struct {
+	repo   skstore.SlackStore
+	botAPI BotAPI
+	cfg    *config.Config
+	log    *slog.Logger
+}
+
+func NewSlackService(repo skstore.SlackStore, botAPI BotAPI, cfg *config.Config, log *slog.Logger) *SlackService {
+	return &SlackService{
+		repo:   repo,
+		botAPI: botAPI,
+		cfg:    cfg,
+		log:    log.With("layer", "service", "domain", "slack_gate"),
+	}
+}
+
+// CreateGate stores the gate of the workspace the bot token belongs to. Slack has no
+// API to set the Events API request URL, so the returned webhook URL is to be
+// entered in the app settings, where Slack verifies it with a url_verification request.
+func (s *SlackService) CreateGate(ctx context.Context, req skmodel.CreateSlack) (*skmodel.SlackGate, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	if s.cfg.Service.PublicURL == "" {
+		return nil, ErrPublicURLNotSet
+	}
+
+	auth, err := s.botAPI.AuthTest(ctx, req.BotToken)
+	if err != nil {
+		return nil, fmt.Errorf("slack auth test: %w", err)
+	}
+
+	gate := &skmodel.SlackGate{
+		Name:          req.Name,
+		TeamID:        auth.TeamID,
+		TeamName:      auth.TeamName,
+		BotUserID:     auth.BotUserID,
+		BotID:         auth.BotID,
+		BotToken:      req.BotToken,
+		SigningSecret: req.SigningSecret,
+		Peer:          req.Peer,
+		Enabled:       true,
+	}
+
+	if err := s.repo.Insert(ctx, req.Dc, gate); err != nil {
+		s.log.Error("failed to create slack gate", "team_id", auth.TeamID, "bot_user_id", auth.BotUserID, "err", err)
+		return nil, err
+	}
+
+	// The request URL contains the gate ID, known only once the gate is stored.
+	gate.WebhookURL = s.webhookURL(gate.ID)
+	if err := s.repo.Update(ctx, gate); err != nil {
+		s.log.Error("failed to store slack request url", "id", gate.ID, "err", err)
+		return nil, err
+	}
+
+	s.log.Info("slack gate created", "id", gate.ID, "team_id", gate.TeamID, "webhook", gate.WebhookURL)
+	return gate, nil
+}
+
+func (s *SlackService) GetGate(ctx context.Context, id string) (*skmodel.SlackGate, error) {
+	return s.repo.Select(ctx, id)
+}
+
+// UpdateGate applies the changes; a new bot token may belong to another workspace or
+// app, whose identity is then fetched again.
+func (s *SlackService) UpdateGate(ctx context.Context, req skmodel.UpdateSlack) (*skmodel.SlackGate, error) {
+	gate, err := s.repo.Select(ctx, req.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	prevToken := gate.BotToken
+	req.ApplyTo(gate)
+
+	if gate.BotToken != prevToken {
+		auth, err := s.botAPI.AuthTest(ctx, gate.BotToken)
+		if err != nil {
+			return nil, fmt.Errorf("slack auth test: %w", err)
+		}
+		gate.TeamID, gate.TeamName = auth.TeamID, auth.TeamName
+		gate.BotUserID, gate.BotID = auth.BotUserID, auth.BotID
+	}
+
+	if err := s.repo.Update(ctx, gate); err != nil {
+		s.log.Error("failed to update slack gate", "id", req.ID, "err", err)
+		return nil, err
+	}
+
+	s.log.Info("slack gate updated", "id", gate.ID, "team_id", gate.TeamID)
+	return gate, nil
+}
+
+// DeleteGate removes the gate. The app stays installed in the workspace; its events
+// are answered not found until the request URL is changed or the app is removed.
+func (s *SlackService) DeleteGate(ctx context.Context, id string) (*skmodel.SlackGate, error) {
+	gate, err := s.repo.Select(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.repo.Delete(ctx, id); err != nil {
+		s.log.Error("failed to delete slack gate", "id", id, "err", err)
+		return nil, err
+	}
+
+	s.log.Warn("slack gate removed", "id", id, "team_id", gate.TeamID)
+	return gate, nil
+}
+
+// webhookURL is the Events API request URL of the gate: the gate ID is the webhook URI segment.
+func (s *SlackService) webhookURL(gateID string) string {
+	return s.cfg.Service.PublicURL + s.cfg.Service.WebhookPath + "/slack/" + gateID
+}
@@ -0,0 +1,178 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"testing"
+
+	"github.com/webitel/im-providers-service/config"
+	sharedstore "github.com/webitel/im-providers-service/internal/core/store"
+	skmodel "github.com/webitel/im-providers-service/internal/slack/model"
+	skstore "github.com/webitel/im-providers-service/internal/slack/store"
+)
+
+var noopLogger = slog.New(slog.NewTextHandler(io.Discard, nil))
+
+// installations stores the gates under sequential IDs and records the deleted ones.
+type installations struct {
+	gates   map[string]*skmodel.SlackGate
+	deleted []string
+}
+
+var _ skstore.SlackStore = (*installations)(nil)
+
+func (i *installations) Insert(_ context.Context, dc int64, g *skmodel.SlackGate) error {
+	g.ID = fmt.Sprintf("gate-%d", len(i.gates)+len(i.deleted)+1)
+	g.DomainID = dc
+	cp := *g
+	i.gates[g.ID] = &cp
+	return nil
+}
+
+func (i *installations) Select(_ context.Context, id string) (*skmodel.SlackGate, error) {
+	g, ok := i.gates[id]
+	if !ok {
+		return nil, sharedstore.ErrNotFound
+	}
+	cp := *g
+	return &cp, nil
+}
+
+func (i *installations) Update(_ context.Context, g *skmodel.SlackGate) error {
+	cp := *g
+	i.gates[g.ID] = &cp
+	return nil
+}
+
+func (i *installations) Delete(_ context.Context, id string) error {
+	i.deleted = append(i.deleted, id)
+	delete(i.gates, id)
+	return nil
+}
+
+// authTest answers auth.test for the bot tokens of two workspaces; other tokens
+// fail like a revoked one.
+type authTest map[string]*skmodel.AuthInfo
+
+func (a authTest) AuthTest(_ context.Context, token string) (*skmodel.AuthInfo, error) {
+	info, ok := a[token]
+	if !ok {
+		return nil, errors.New("invalid_auth")
+	}
+	return info, nil
+}
+
+func newSlackService(publicURL string) (*SlackService, *installations) {
+	installed := &installations{gates: map[string]*skmodel.SlackGate{}}
+	api := authTest{
+		"xoxb-1": {TeamID: "T1", TeamName: "Acme", BotUserID: "U1", BotID: "B1"},
+		"xoxb-2": {TeamID: "T2", TeamName: "Globex", BotUserID: "U2", BotID: "B2"},
+	}
+	cfg := &config.Config{Service: config.ServiceConfig{PublicURL: publicURL, WebhookPath: "/wh"}}
+	return NewSlackService(installed, api, cfg, noopLogger), installed
+}
+
+func createRequest() skmodel.CreateSlack {
+	return skmodel.CreateSlack{Name: "Support", Dc: 1, BotToken: "xoxb-1", SigningSecret: "secret-1"}
+}
+
+func TestCreateGate_IssuesRequestURL(t *testing.T) {
+	svc, installed := newSlackService("https://im.example.com")
+
+	gate, err := svc.CreateGate(context.Background(), createRequest())
+	if err != nil {
+		t.Fatalf("CreateGate: %v", err)
+	}
+
+	const wantURL = "https://im.example.com/wh/slack/gate-1"
+	stored := installed.gates["gate-1"]
+	if stored == nil || stored.WebhookURL != wantURL || stored.TeamID != "T1" || stored.BotUserID != "U1" || stored.SigningSecret != "secret-1" || !stored.Enabled {
+		t.Errorf("unexpected stored gate: %+v", stored)
+	}
+	if gate.WebhookURL != wantURL {
+		t.Errorf("returned gate webhook = %q", gate.WebhookURL)
+	}
+}
+
+func TestCreateGate_Rejected(t *testing.T) {
+	tests := []struct {
+		name      string
+		publicURL string
+		req       skmodel.CreateSlack
+		wantErr   error
+	}{
+		{name: "missing fields", publicURL: "https://im.example.com", req: skmodel.CreateSlack{Dc: 1}},
+		{name: "public url not set", req: createRequest(), wantErr: ErrPublicURLNotSet},
+		{name: "invalid token", publicURL: "https://im.example.com", req: skmodel.CreateSlack{Name: "x", Dc: 1, BotToken: "bad", SigningSecret: "s"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc, installed := newSlackService(tt.publicURL)
+			_, err := svc.CreateGate(context.Background(), tt.req)
+			if err == nil {
+				t.Fatal("expected an error")
+			}
+			if tt.wantErr != nil && !errors.Is(err, tt.wantErr) {
+				t.Errorf("error = %v, want %v", err, tt.wantErr)
+			}
+			if len(installed.gates) != 0 {
+				t.Errorf("no gate must be stored, got %v", installed.gates)
+			}
+		})
+	}
+}
+
+func TestUpdateGate_NewTokenRefreshesIdentity(t *testing.T) {
+	svc, installed := newSlackService("https://im.example.com")
+	if _, err := svc.CreateGate(context.Background(), createRequest()); err != nil {
+		t.Fatalf("CreateGate: %v", err)
+	}
+
+	token := "xoxb-2"
+	gate, err := svc.UpdateGate(context.Background(), skmodel.UpdateSlack{ID: "gate-1", BotToken: &token})
+	if err != nil {
+		t.Fatalf("UpdateGate: %v", err)
+	}
+
+	stored := installed.gates["gate-1"]
+	if gate.TeamID != "T2" || stored.BotUserID != "U2" || stored.BotID != "B2" || stored.BotToken != "xoxb-2" {
+		t.Errorf("identity not refreshed: %+v", stored)
+	}
+}
+
+func TestUpdateGate_InvalidTokenKeepsGate(t *testing.T) {
+	svc, installed := newSlackService("https://im.example.com")
+	if _, err := svc.CreateGate(context.Background(), createRequest()); err != nil {
+		t.Fatalf("CreateGate: %v", err)
+	}
+
+	token := "bad"
+	if _, err := svc.UpdateGate(context.Background(), skmodel.UpdateSlack{ID: "gate-1", BotToken: &token}); err == nil {
+		t.Fatal("expected an error")
+	}
+	if installed.gates["gate-1"].BotToken != "xoxb-1" {
+		t.Errorf("rejected token must not be stored: %+v", installed.gates["gate-1"])
+	}
+}
+
+func TestDeleteGate(t *testing.T) {
+	svc, installed := newSlackService("https://im.example.com")
+	if _, err := svc.CreateGate(context.Background(), createRequest()); err != nil {
+		t.Fatalf("CreateGate: %v", err)
+	}
+
+	if _, err := svc.DeleteGate(context.Background(), "gate-1"); err != nil {
+		t.Fatalf("DeleteGate: %v", err)
+	}
+	if len(installed.gates) != 0 {
+		t.Errorf("gate not removed: %v", installed.gates)
+	}
+
+	if _, err := svc.DeleteGate(context.Background(), "gate-1"); !errors.Is(err, sharedstore.ErrNotFound) {
+		t.Errorf("second delete error = %v, want ErrNotFound", err)
+	}
+}
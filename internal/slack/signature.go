@@ -0,0 +1,73 @@
+package slack
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// Request signing headers.
+// https://api.slack.com/authentication/verifying-requests-from-slack
+const (
+	signatureHeader = "X-Slack-Signature"
+	timestampHeader = "X-Slack-Request-Timestamp"
+	// signatureVersion prefixes both the signed base string and the signature.
+	signatureVersion = "v0"
+	// maxRequestAge rejects replayed requests; Slack recommends five minutes.
+	maxRequestAge = 5 * time.Minute
+)
+
+// ValidateRequest implements provider.RequestValidator.
+// The signature covers the request timestamp, so both headers are needed.
+func (p *slackProvider) ValidateRequest(ctx context.Context, header http.Header, body []byte) error {
+	signature, timestamp := header.Get(signatureHeader), header.Get(timestampHeader)
+	if signature == "" || timestamp == "" {
+		return fmt.Errorf("missing %s or %s header", signatureHeader, timestampHeader)
+	}
+
+	ts, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return fmt.Errorf("malformed %s header: %w", timestampHeader, err)
+	}
+	if age := time.Since(time.Unix(ts, 0)); age > maxRequestAge || age < -maxRequestAge {
+		return fmt.Errorf("request timestamp out of range: %s", age)
+	}
+
+	gate, err := p.resolveGate(ctx)
+	if err != nil {
+		return fmt.Errorf("signature: gate lookup failed: %w", err)
+	}
+	if !gate.Enabled {
+		// Events of a disabled gate are acknowledged and dropped by HandleWebhook.
+		return nil
+	}
+
+	if !validSignature(gate.SigningSecret, timestamp, signature, body) {
+		return fmt.Errorf("signature mismatch")
+	}
+	return nil
+}
+
+// Handshake implements provider.Handshaker: Slack verifies the request URL by
+// expecting the challenge of a url_verification request back.
+func (p *slackProvider) Handshake(_ context.Context, payload []byte) (string, bool) {
+	var env envelope
+	if json.Unmarshal(payload, &env) != nil || env.Type != requestURLVerification {
+		return "", false
+	}
+	return env.Challenge, true
+}
+
+func validSignature(secret, timestamp, signature string, body []byte) bool {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(signatureVersion + ":" + timestamp + ":"))
+	mac.Write(body)
+	expected := signatureVersion + "=" + hex.EncodeToString(mac.Sum(nil))
+	return hmac.Equal([]byte(signature), []byte(expected))
+}
@@ -0,0 +1,182 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/georgysavva/scany/v2/pgxscan"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/webitel/im-providers-service/infra/db/pg"
+	sharedmodel "github.com/webitel/im-providers-service/internal/core/model"
+	sharedstore "github.com/webitel/im-providers-service/internal/core/store"
+	skmodel "github.com/webitel/im-providers-service/internal/slack/model"
+	skstore "github.com/webitel/im-providers-service/internal/slack/store"
+	"github.com/webitel/im-providers-service/pkg/crypto"
+)
+
+var _ skstore.SlackStore = (*slackStore)(nil)
+
+type slackStore struct {
+	pool   *pgxpool.Pool
+	crypto crypto.Encryptor
+	cache  sharedstore.GateCache
+}
+
+func NewSlackStore(pool *pgxpool.Pool, crypt crypto.Encryptor, cache sharedstore.GateCache) skstore.SlackStore {
+	return &slackStore{
+		pool:   pool,
+		crypto: crypt,
+		cache:  cache,
+	}
+}
+
+func (s *slackStore) Insert(ctx context.Context, dc int64, g *skmodel.SlackGate) error {
+	token, secret, err := s.encrypt(g)
+	if err != nil {
+		return err
+	}
+
+	const query = `
+	WITH new_gate AS (
+		INSERT INTO im_provider.gates (dc, name, type, enabled)
+		VALUES ($1, $2, 'slack', $3)
+		RETURNING id, name, created_at, updated_at
+	),
+	new_bot AS (
+		INSERT INTO im_provider.bots (sub, iss, gate_id)
+		SELECT $4, $5, id FROM new_gate
+		RETURNING id
+	)
+	INSERT INTO im_provider.slack (gate_id, team_id, team_name, bot_user_id, bot_id, bot_token, signing_secret, webhook_url)
+	SELECT id, $6, $7, $8, $9, $10, $11, $12 FROM new_gate
+	RETURNING
+		gate_id,
+		(SELECT name FROM new_gate),
+		(SELECT created_at FROM new_gate),
+		(SELECT updated_at FROM new_gate)`
+
+	err = s.pool.QueryRow(ctx, query,
+		dc, g.Name, g.Enabled, g.Peer.Sub, g.Peer.Iss, g.TeamID, g.TeamName, g.BotUserID, g.BotID, token, secret, g.WebhookURL,
+	).Scan(&g.ID, &g.Name, &g.CreatedAt, &g.UpdatedAt)
+	if err != nil {
+		if pg.IsUniqueViolation(err) {
+			return fmt.Errorf("slack bot %s is already bound: %w", g.BotUserID, sharedstore.ErrConflict)
+		}
+		return fmt.Errorf("postgres: insert slack gateway: %w", err)
+	}
+
+	g.DomainID = dc
+	s.mapVirtualFields(g)
+	return nil
+}
+
+func (s *slackStore) Select(ctx context.Context, id string) (*skmodel.SlackGate, error) {
+	const query = `
+	SELECT
+		g.id,
+		g.dc AS domain_id,
+		g.name,
+		g.enabled,
+		g.created_at,
+		g.updated_at,
+		b.sub AS "peer.sub",
+		b.iss AS "peer.iss",
+		sk.team_id,
+		sk.team_name,
+		sk.bot_user_id,
+		sk.bot_id,
+		sk.bot_token,
+		sk.signing_secret,
+		sk.webhook_url
+	FROM im_provider.gates g
+	JOIN im_provider.bots b ON g.id = b.gate_id
+	JOIN im_provider.slack sk ON g.id = sk.gate_id
+	WHERE g.id = $1`
+
+	var g skmodel.SlackGate
+	if err := pgxscan.Get(ctx, s.pool, &g, query, id); err != nil {
+		if pgxscan.NotFound(err) {
+			return nil, sharedstore.ErrNotFound
+		}
+		return nil, fmt.Errorf("postgres: select slack gate: %w", err)
+	}
+
+	if dec, err := s.crypto.Decrypt(g.BotToken); err == nil {
+		g.BotToken = dec
+	}
+	if dec, err := s.crypto.Decrypt(g.SigningSecret); err == nil {
+		g.SigningSecret = dec
+	}
+
+	s.mapVirtualFields(&g)
+	return &g, nil
+}
+
+func (s *slackStore) Update(ctx context.Context, g *skmodel.SlackGate) error {
+	token, secret, err := s.encrypt(g)
+	if err != nil {
+		return err
+	}
+
+	err = pgx.BeginFunc(ctx, s.pool, func(tx pgx.Tx) error {
+		const uGate = `UPDATE im_provider.gates SET name = $1, enabled = $2, updated_at = NOW() WHERE id = $3 RETURNING updated_at`
+		if err := tx.QueryRow(ctx, uGate, g.Name, g.Enabled, g.ID).Scan(&g.UpdatedAt); err != nil {
+			return err
+		}
+
+		const uBot = `UPDATE im_provider.bots SET sub = $1, iss = $2 WHERE gate_id = $3`
+		if _, err := tx.Exec(ctx, uBot, g.Peer.Sub, g.Peer.Iss, g.ID); err != nil {
+			return err
+		}
+
+		const uConfig = `
+			UPDATE im_provider.slack
+			SET team_id = $1, team_name = $2, bot_user_id = $3, bot_id = $4, bot_token = $5, signing_secret = $6, webhook_url = $7
+			WHERE gate_id = $8`
+		_, err := tx.Exec(ctx, uConfig, g.TeamID, g.TeamName, g.BotUserID, g.BotID, token, secret, g.WebhookURL, g.ID)
+		return err
+	})
+	if err != nil {
+		if pg.IsUniqueViolation(err) {
+			return fmt.Errorf("slack bot %s is already bound: %w", g.BotUserID, sharedstore.ErrConflict)
+		}
+		return fmt.Errorf("postgres: update slack gate: %w", err)
+	}
+
+	s.cache.Delete(g.ID)
+	s.mapVirtualFields(g)
+	return nil
+}
+
+func (s *slackStore) Delete(ctx context.Context, id string) error {
+	res, err := s.pool.Exec(ctx, "DELETE FROM im_provider.gates WHERE id = $1 AND type = 'slack'", id)
+	if err != nil {
+		return fmt.Errorf("postgres: delete slack gate: %w", err)
+	}
+	if res.RowsAffected() == 0 {
+		return sharedstore.ErrNotFound
+	}
+
+	s.cache.Delete(id)
+	return nil
+}
+
+// encrypt returns the bot token and signing secret encrypted for storage.
+func (s *slackStore) encrypt(g *skmodel.SlackGate) (token, secret string, err error) {
+	if token, err = s.crypto.Encrypt(g.BotToken); err != nil {
+		return "", "", fmt.Errorf("crypto: %w", err)
+	}
+	if secret, err = s.crypto.Encrypt(g.SigningSecret); err != nil {
+		return "", "", fmt.Errorf("crypto: %w", err)
+	}
+	return token, secret, nil
+}
+
+func (s *slackStore) mapVirtualFields(g *skmodel.SlackGate) {
+	if g.Enabled {
+		g.Status = sharedmodel.StatusActive
+	} else {
+		g.Status = sharedmodel.StatusDisabled
+	}
+}
@@ -0,0 +1,16 @@
+package store
+
+import (
+	"context"
+
+	skmodel "github.com/webitel/im-providers-service/internal/slack/model"
+)
+
+// SlackStore manages Slack app installations.
+type SlackStore interface {
+	// Insert creates the gate together with its bot peer and Slack app settings.
+	Insert(ctx context.Context, dc int64, g *skmodel.SlackGate) error
+	Select(ctx context.Context, id string) (*skmodel.SlackGate, error)
+	Update(ctx context.Context, g *skmodel.SlackGate) error
+	Delete(ctx context.Context, id string) error
+}
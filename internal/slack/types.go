@@ -0,0 +1,160 @@
+package slack
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Events API request types.
+// https://api.slack.com/apis/events-api#receiving-events
+const (
+	requestURLVerification = "url_verification"
+	requestEventCallback   = "event_callback"
+)
+
+// Event types.
+// https://api.slack.com/events
+const (
+	EventMessage             = "message"
+	EventAppMention          = "app_mention"
+	EventMemberJoinedChannel = "member_joined_channel"
+)
+
+// Message subtypes routed like plain messages. Other subtypes (edits, deletions,
+// joins, bot messages, …) describe changes to the channel and are dropped.
+// https://api.slack.com/events/message#subtypes
+const (
+	subtypeFileShare       = "file_share"
+	subtypeThreadBroadcast = "thread_broadcast"
+)
+
+// channelTypeIM marks a direct message with the bot.
+const channelTypeIM = "im"
+
+var (
+	// ErrTokenInvalid is returned when Slack rejects the bot token.
+	ErrTokenInvalid = errors.New("slack: bot token invalid")
+	// ErrNotInChannel is returned when the bot is not a member of the channel it posts to.
+	ErrNotInChannel = errors.New("slack: bot is not in the channel")
+)
+
+// APIError is a Web API reply with "ok": false.
+// https://api.slack.com/web#evaluating_responses
+type APIError struct {
+	Method string
+	Code   string
+}
+
+func (e *APIError) Error() string {
+	return fmt.Sprintf("slack %s: %s", e.Method, e.Code)
+}
+
+// Is matches the sentinel errors of the codes callers act on.
+func (e *APIError) Is(target error) bool {
+	switch target {
+	case ErrTokenInvalid:
+		return e.Code == "invalid_auth" || e.Code == "not_authed" || e.Code == "token_revoked" || e.Code == "account_inactive"
+	case ErrNotInChannel:
+		return e.Code == "not_in_channel" || e.Code == "channel_not_found"
+	}
+	return false
+}
+
+// --- Events API ---
+
+// envelope is the body of an Events API request.
+type envelope struct {
+	Type      string `json:"type"`
+	Challenge string `json:"challenge"`
+	TeamID    string `json:"team_id"`
+	EventID   string `json:"event_id"`
+	Event     *event `json:"event"`
+}
+
+// event is a message, app_mention or member_joined_channel event.
+type event struct {
+	Type        string `json:"type"`
+	Subtype     string `json:"subtype"`
+	User        string `json:"user"`
+	BotID       string `json:"bot_id"`
+	Channel     string `json:"channel"`
+	ChannelType string `json:"channel_type"`
+	Text        string `json:"text"`
+	TS          string `json:"ts"`
+	ThreadTS    string `json:"thread_ts"`
+	Files       []file `json:"files"`
+}
+
+// file is a file shared in a message.
+type file struct {
+	ID                 string `json:"id"`
+	Name               string `json:"name"`
+	Title              string `json:"title"`
+	Mimetype           string `json:"mimetype"`
+	Size               int64  `json:"size"`
+	URLPrivateDownload string `json:"url_private_download"`
+}
+
+// --- Web API replies ---
+
+// response is the envelope of every Web API reply.
+type response struct {
+	OK    bool   `json:"ok"`
+	Error string `json:"error"`
+}
+
+type authTestResponse struct {
+	response
+	TeamID string `json:"team_id"`
+	Team   string `json:"team"`
+	UserID string `json:"user_id"`
+	BotID  string `json:"bot_id"`
+}
+
+type conversationInfoResponse struct {
+	response
+	Channel struct {
+		ID   string `json:"id"`
+		Name string `json:"name"`
+		IsIM bool   `json:"is_im"`
+		User string `json:"user"`
+	} `json:"channel"`
+}
+
+type userInfoResponse struct {
+	response
+	User struct {
+		ID       string `json:"id"`
+		Name     string `json:"name"`
+		RealName string `json:"real_name"`
+		Profile  struct {
+			DisplayName string `json:"display_name"`
+		} `json:"profile"`
+	} `json:"user"`
+}
+
+type postMessageResponse struct {
+	response
+	Channel string `json:"channel"`
+	TS      string `json:"ts"`
+}
+
+type uploadURLResponse struct {
+	response
+	UploadURL string `json:"upload_url"`
+	FileID    string `json:"file_id"`
+}
+
+type completeUploadResponse struct {
+	response
+	Files []struct {
+		ID    string `json:"id"`
+		Title string `json:"title"`
+	} `json:"files"`
+}
+
+// uploadedFile is an entry of the files parameter of files.completeUploadExternal.
+type uploadedFile struct {
+	ID    string `json:"id"`
+	Title string `json:"title,omitempty"`
+}
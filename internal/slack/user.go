@@ -0,0 +1,101 @@
+package slack
+
+import (
+	"context"
+
+	gatewayv1 "github.com/webitel/im-providers-service/gen/go/gateway/v1"
+	sharedmodel "github.com/webitel/im-providers-service/internal/core/model"
+	"github.com/webitel/im-providers-service/internal/provider/contactsync"
+	skmodel "github.com/webitel/im-providers-service/internal/slack/model"
+)
+
+// Contact metadata keys of a conversation.
+const (
+	metadataTeam     = "team_id"
+	metadataChannel  = "channel"
+	metadataThreadTS = "thread_ts"
+)
+
+// syncContact resolves the internal contact for a conversation, creating it if
+// necessary. The contact is the channel or thread, not the member who posted: the
+// whole conversation is one chat. Known conversations skip the Web API and the
+// gateway round-trip.
+func (p *slackProvider) syncContact(ctx context.Context, gate *skmodel.SlackGate, conv conversation) (*gatewayv1.Contact, error) {
+	sub := conv.sub()
+	key := contactsync.KnownUser(gate.ID, &sharedmodel.ExternalUser{ID: sub})
+	if known, _ := p.userCache.IsKnown(ctx, key); known {
+		return &gatewayv1.Contact{Sub: sub}, nil
+	}
+
+	authCtx := contactsync.WithGatewayIdentity(ctx, gate.DomainID, gate.Peer)
+	contact, err := p.ensureContact(authCtx, gate, conv, p.conversationName(ctx, gate, conv))
+	if err != nil {
+		return nil, err
+	}
+
+	contactsync.EnsureVia(authCtx, p.gatewayer, p.logger, gate.ID, sub, contact.Iss)
+	_ = p.userCache.MarkKnown(ctx, key)
+
+	return contact, nil
+}
+
+// conversationName returns the contact name of a conversation: the member name for a
+// direct message, the channel name otherwise. It never fails and falls back to the
+// channel ID, so a conversation is still routed when the lookup is not permitted.
+func (p *slackProvider) conversationName(ctx context.Context, gate *skmodel.SlackGate, conv conversation) string {
+	name := conv.channel
+	info, err := p.api.ConversationInfo(ctx, gate.BotToken, conv.channel)
+	switch {
+	case err != nil:
+		p.logger.WarnContext(ctx, "fetch conversation info failed, using channel ID", "channel", conv.channel, "err", err)
+	case info.IsIM:
+		name = p.userName(ctx, gate, info.User)
+	case info.Name != "":
+		name = "#" + info.Name
+	}
+
+	if conv.threadTS != "" {
+		name += " (thread)"
+	}
+	return name
+}
+
+// userName returns the displayed name of a member, falling back to the member ID.
+func (p *slackProvider) userName(ctx context.Context, gate *skmodel.SlackGate, userID string) string {
+	if userID == "" {
+		return ""
+	}
+	key := gate.TeamID + ":" + userID
+	if name, ok := p.userNames.Get(key); ok {
+		return name
+	}
+
+	u, err := p.api.UserInfo(ctx, gate.BotToken, userID)
+	if err != nil {
+		p.logger.WarnContext(ctx, "fetch user info failed, using user ID", "user_id", userID, "err", err)
+		return userID
+	}
+	name := u.DisplayedName()
+	p.userNames.Add(key, name)
+	return name
+}
+
+// ensureContact creates the internal contact or returns a stub when the
+// contact already exists (idempotent by design on the gateway side).
+func (p *slackProvider) ensureContact(ctx context.Context, gate *skmodel.SlackGate, conv conversation, name string) (*gatewayv1.Contact, error) {
+	metadata := map[string]string{
+		metadataTeam:    gate.TeamID,
+		metadataChannel: conv.channel,
+	}
+	if conv.threadTS != "" {
+		metadata[metadataThreadTS] = conv.threadTS
+	}
+
+	return contactsync.CreateContact(ctx, p.gatewayer, &gatewayv1.CreateContactRequest{
+		IssId:    p.Type(),
+		Type:     p.Type(),
+		Name:     name,
+		Subject:  conv.sub(),
+		Metadata: metadata,
+	})
+}
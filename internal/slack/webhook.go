@@ -0,0 +1,117 @@
+package slack
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	sharedmodel "github.com/webitel/im-providers-service/internal/core/model"
+	"github.com/webitel/im-providers-service/internal/provider/contactsync"
+	skmodel "github.com/webitel/im-providers-service/internal/slack/model"
+)
+
+func (p *slackProvider) HandleWebhook(ctx context.Context, data []byte) error {
+	var env envelope
+	if err := json.Unmarshal(data, &env); err != nil {
+		p.logger.Warn("malformed request dropped", "err", err)
+		return nil
+	}
+	if env.Type != requestEventCallback || env.Event == nil {
+		// url_verification is answered by Handshake; app_rate_limited needs no action.
+		p.logger.Debug("request acknowledged", "type", env.Type)
+		return nil
+	}
+
+	gate, err := p.resolveGate(ctx)
+	if err != nil || !gate.Enabled {
+		return err
+	}
+	if env.TeamID != gate.TeamID {
+		p.logger.Warn("event of another workspace dropped", "team_id", env.TeamID, "gate_id", gate.ID)
+		return nil
+	}
+	if !p.firstSeen("event:" + env.EventID) {
+		p.logger.Debug("event retry dropped", "event_id", env.EventID)
+		return nil
+	}
+
+	ev := env.Event
+	switch ev.Type {
+	case EventMessage, EventAppMention:
+		if err := p.processMessage(ctx, gate, ev); err != nil {
+			p.logger.Error("message dropped", "event_id", env.EventID, "err", err)
+		}
+	case EventMemberJoinedChannel:
+		// The bot was added to a channel, which can be messaged from now on.
+		if ev.User != gate.BotUserID {
+			return nil
+		}
+		if _, err := p.syncContact(ctx, gate, conversation{channel: ev.Channel}); err != nil {
+			p.logger.Warn("sync contact failed", "event", ev.Type, "channel", ev.Channel, "err", err)
+		}
+	default:
+		p.logger.Debug("event acknowledged", "event", ev.Type, "event_id", env.EventID)
+	}
+	return nil
+}
+
+// processMessage is the per-message pipeline:
+//
+//	drop own and system messages → sync contact → route text and files
+func (p *slackProvider) processMessage(ctx context.Context, gate *skmodel.SlackGate, ev *event) error {
+	if ev.BotID != "" || ev.User == "" || ev.User == gate.BotUserID {
+		return nil
+	}
+	if ev.Subtype != "" && ev.Subtype != subtypeFileShare && ev.Subtype != subtypeThreadBroadcast {
+		p.logger.Debug("message subtype skipped", "subtype", ev.Subtype)
+		return nil
+	}
+	// A mention arrives both as message and as app_mention, with different event IDs.
+	if !p.firstSeen("message:" + ev.Channel + ":" + ev.TS) {
+		return nil
+	}
+
+	conv := conversationOf(ev)
+	if _, err := p.syncContact(ctx, gate, conv); err != nil {
+		return fmt.Errorf("sync contact [conversation=%s]: %w", conv.sub(), err)
+	}
+
+	peers := contactsync.InboundPeers(gate.ID, gate.Peer, conv.sub())
+	body := p.messageText(ctx, gate, ev)
+	for i := range ev.Files {
+		if p.handleFile(ctx, gate, peers, &ev.Files[i], body) {
+			body = ""
+		}
+	}
+	p.sendText(ctx, gate, peers, body)
+	return nil
+}
+
+// messageText returns the text of a message without the mention of the bot. Channels
+// are shared by many members, so the text is signed with the name of its author there.
+func (p *slackProvider) messageText(ctx context.Context, gate *skmodel.SlackGate, ev *event) string {
+	text := ev.Text
+	if gate.BotUserID != "" {
+		text = strings.ReplaceAll(text, "<@"+gate.BotUserID+">", "")
+	}
+	text = strings.TrimSpace(text)
+	if text == "" || ev.ChannelType == channelTypeIM {
+		return text
+	}
+	return p.userName(ctx, gate, ev.User) + ": " + text
+}
+
+func (p *slackProvider) sendText(ctx context.Context, gate *skmodel.SlackGate, peers contactsync.Peers, text string) {
+	if text == "" {
+		return
+	}
+	if _, err := p.messenger.SendText(ctx, &sharedmodel.SendTextRequest{
+		DomainID: gate.DomainID,
+		From:     peers.From,
+		To:       peers.To,
+		Body:     text,
+	}); err != nil {
+		p.logger.Error("send text failed", "err", err)
+	}
+}
@@ -0,0 +1,188 @@
+package slack
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func signedHeader(secret string, ts time.Time, body []byte) http.Header {
+	timestamp := strconv.FormatInt(ts.Unix(), 10)
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte("v0:" + timestamp + ":"))
+	mac.Write(body)
+
+	h := http.Header{}
+	h.Set(timestampHeader, timestamp)
+	h.Set(signatureHeader, "v0="+hex.EncodeToString(mac.Sum(nil)))
+	return h
+}
+
+func TestValidateRequest(t *testing.T) {
+	body := []byte(`{"token":"Jhj5dZrVaK7ZwHHjRyZWjbDl","type":"event_callback","team_id":"T061EG9R6"}`)
+
+	tests := []struct {
+		name    string
+		gateID  string
+		header  http.Header
+		wantErr bool
+	}{
+		{name: "valid", gateID: testGateID, header: signedHeader(testSecret, time.Now(), body)},
+		{name: "missing headers", gateID: testGateID, header: http.Header{}, wantErr: true},
+		{name: "other secret", gateID: testGateID, header: signedHeader("other-secret", time.Now(), body), wantErr: true},
+		{name: "replayed", gateID: testGateID, header: signedHeader(testSecret, time.Now().Add(-10*time.Minute), body), wantErr: true},
+		{name: "unknown gate", gateID: "0190a8a4-0000-7000-8000-000000000000", header: signedHeader(testSecret, time.Now(), body), wantErr: true},
+		{name: "malformed uri", gateID: "not-a-gate", header: signedHeader(testSecret, time.Now(), body), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := newInstalledApp(t)
+			err := p.ValidateRequest(webhookContext(tt.gateID), tt.header, body)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ValidateRequest() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestHandshake(t *testing.T) {
+	p := newInstalledApp(t)
+
+	reply, ok := p.Handshake(webhookContext(testGateID), []byte(`{
+		"token": "Jhj5dZrVaK7ZwHHjRyZWjbDl",
+		"challenge": "3eZbrw1aBm2rZgRNFdxV2595E9CY3gmdALWMmHkvFXO7tYXAYM8P",
+		"type": "url_verification"
+	}`))
+	if !ok || reply != "3eZbrw1aBm2rZgRNFdxV2595E9CY3gmdALWMmHkvFXO7tYXAYM8P" {
+		t.Errorf("Handshake() = %q, %v", reply, ok)
+	}
+
+	if _, ok := p.Handshake(webhookContext(testGateID), []byte(`{"type":"event_callback"}`)); ok {
+		t.Error("an event callback is not a handshake")
+	}
+}
+
+func messageEvent(eventID, fields string) []byte {
+	return []byte(`{
+		"type": "event_callback",
+		"team_id": "` + testTeamID + `",
+		"event_id": "` + eventID + `",
+		"event": {"type": "message", "channel": "` + testChannel + `", ` + fields + `}
+	}`)
+}
+
+func TestHandleWebhook_ChannelMessage(t *testing.T) {
+	p := newInstalledApp(t)
+	p.stub.replies["users.info"] = `{"ok":true,"user":{"id":"` + testUserID + `","name":"spengler","real_name":"Egon Spengler","profile":{"display_name":"Egon"}}}`
+
+	body := messageEvent("Ev0PV52K21", `"channel_type": "channel", "user": "`+testUserID+`", "text": "<@`+testBotUserID+`> is it down?", "ts": "1355517523.000005"`)
+	if err := p.HandleWebhook(webhookContext(testGateID), body); err != nil {
+		t.Fatalf("HandleWebhook: %v", err)
+	}
+
+	if len(p.messenger.Texts()) != 1 {
+		t.Fatalf("expected 1 text, got %d", len(p.messenger.Texts()))
+	}
+	got := p.messenger.Texts()[0]
+	if got.Body != "Egon: is it down?" || got.DomainID != 1 {
+		t.Errorf("unexpected text request: %+v", got)
+	}
+	if got.From.Sub != testChannel || got.From.Iss != "slack" {
+		t.Errorf("unexpected sender peer: %+v", got.From)
+	}
+	if got.To.Sub != "bot-1" || got.To.Via == nil || *got.To.Via != testGateID {
+		t.Errorf("unexpected recipient peer: %+v", got.To)
+	}
+}
+
+func TestHandleWebhook_ThreadReplyIsOwnConversation(t *testing.T) {
+	p := newInstalledApp(t)
+
+	body := messageEvent("Ev0PV52K22", `"channel_type": "im", "user": "`+testUserID+`", "text": "same here", "ts": "1355517600.000010", "thread_ts": "1355517523.000005"`)
+	if err := p.HandleWebhook(webhookContext(testGateID), body); err != nil {
+		t.Fatalf("HandleWebhook: %v", err)
+	}
+
+	if len(p.messenger.Texts()) != 1 {
+		t.Fatalf("expected 1 text, got %d", len(p.messenger.Texts()))
+	}
+	got := p.messenger.Texts()[0]
+	if got.From.Sub != testChannel+":1355517523.000005" || got.Body != "same here" {
+		t.Errorf("unexpected text request: %+v", got)
+	}
+}
+
+func TestHandleWebhook_Dropped(t *testing.T) {
+	tests := []struct {
+		name string
+		body []byte
+	}{
+		{name: "own message", body: messageEvent("Ev1", `"user": "`+testBotUserID+`", "text": "echo", "ts": "1.1"`)},
+		{name: "bot message", body: messageEvent("Ev2", `"bot_id": "B99", "text": "beep", "ts": "1.2"`)},
+		{name: "edit", body: messageEvent("Ev3", `"subtype": "message_changed", "ts": "1.3"`)},
+		{name: "other workspace", body: []byte(`{"type":"event_callback","team_id":"T999","event_id":"Ev4","event":{"type":"message","channel":"C1","user":"U1","text":"hi","ts":"1.4"}}`)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := newInstalledApp(t)
+			if err := p.HandleWebhook(webhookContext(testGateID), tt.body); err != nil {
+				t.Fatalf("HandleWebhook: %v", err)
+			}
+			if len(p.messenger.Texts()) != 0 {
+				t.Errorf("message must be dropped: %+v", p.messenger.Texts())
+			}
+		})
+	}
+}
+
+func TestHandleWebhook_Deduplicates(t *testing.T) {
+	p := newInstalledApp(t)
+
+	message := messageEvent("Ev5", `"channel_type": "im", "user": "`+testUserID+`", "text": "hello", "ts": "1.5"`)
+	mention := []byte(`{"type":"event_callback","team_id":"` + testTeamID + `","event_id":"Ev6",
+		"event":{"type":"app_mention","channel":"` + testChannel + `","user":"` + testUserID + `","text":"hello","ts":"1.5"}}`)
+	for _, body := range [][]byte{message, message, mention} {
+		if err := p.HandleWebhook(webhookContext(testGateID), body); err != nil {
+			t.Fatalf("HandleWebhook: %v", err)
+		}
+	}
+
+	if len(p.messenger.Texts()) != 1 {
+		t.Errorf("retries and the mention must not be routed again: %+v", p.messenger.Texts())
+	}
+}
+
+func TestHandleWebhook_FileShare(t *testing.T) {
+	p := newInstalledApp(t)
+
+	body := messageEvent("Ev7", `"subtype": "file_share", "channel_type": "im", "user": "`+testUserID+`", "text": "the logs", "ts": "1.7",
+		"files": [
+			{"id": "F1", "name": "screen.png", "mimetype": "image/png", "size": 12, "url_private_download": "`+p.stub.url+`/files/F1"},
+			{"id": "F2", "name": "app.log", "mimetype": "text/plain", "size": 34, "url_private_download": "`+p.stub.url+`/files/F2"}
+		]`)
+	if err := p.HandleWebhook(webhookContext(testGateID), body); err != nil {
+		t.Fatalf("HandleWebhook: %v", err)
+	}
+
+	if len(p.media.Uploads()) != 2 || p.media.Uploads()[0].Body != "content of F1" || p.media.Uploads()[1].Req.Name != "app.log" {
+		t.Fatalf("unexpected uploads: %+v", p.media.Uploads())
+	}
+	if calls := p.stub.sent(); calls[0].token != "Bearer "+testToken {
+		t.Errorf("private file downloaded without the bot token: %+v", calls[0])
+	}
+	if len(p.messenger.Images()) != 1 || p.messenger.Images()[0].Image.Body != "the logs" {
+		t.Errorf("the text must caption the first file: %+v", p.messenger.Images())
+	}
+	if len(p.messenger.Documents()) != 1 || p.messenger.Documents()[0].Document.Body != "" {
+		t.Errorf("unexpected documents: %+v", p.messenger.Documents())
+	}
+	if len(p.messenger.Texts()) != 0 {
+		t.Errorf("the text must not be sent twice: %+v", p.messenger.Texts())
+	}
+}
@@ -0,0 +1,201 @@
+package sms
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net"
+	"sync"
+	"time"
+
+	smsmodel "github.com/webitel/im-providers-service/internal/sms/model"
+	"github.com/webitel/im-providers-service/internal/sms/smpp"
+	smsstore "github.com/webitel/im-providers-service/internal/sms/store"
+)
+
+const (
+	// rebindMinDelay and rebindMaxDelay bound the exponential backoff between binds
+	// of a gate whose SMSC connection was lost or refused.
+	rebindMinDelay = time.Second
+	rebindMaxDelay = time.Minute
+)
+
+// ErrGateNotBound is returned when a message is sent through an SMPP gate that is not bound.
+var ErrGateNotBound = errors.New("sms: gate is not bound to the SMSC")
+
+// deliverHandler processes a deliver_sm received by the bind of the gate.
+type deliverHandler func(ctx context.Context, gate *smsmodel.SMSGate, m *smpp.ShortMessage) error
+
+// binds keeps a transceiver bind per enabled SMPP gate. The SMSC pushes inbound
+// messages and delivery receipts over it, so it replaces the webhook of HTTP gates.
+type binds struct {
+	repo   smsstore.SMSStore
+	handle deliverHandler
+	logger *slog.Logger
+
+	minDelay time.Duration
+	maxDelay time.Duration
+
+	mu      sync.Mutex
+	running map[string]*gateBind
+}
+
+// gateBind is the running bind loop of a gate.
+type gateBind struct {
+	gate   *smsmodel.SMSGate
+	cancel context.CancelFunc
+	done   chan struct{}
+
+	mu      sync.Mutex
+	session *smpp.Session
+}
+
+func newBinds(repo smsstore.SMSStore, handle deliverHandler, l *slog.Logger) *binds {
+	return &binds{
+		repo:     repo,
+		handle:   handle,
+		logger:   l,
+		minDelay: rebindMinDelay,
+		maxDelay: rebindMaxDelay,
+		running:  make(map[string]*gateBind),
+	}
+}
+
+// StartAll starts the binds of every enabled SMPP gate. Binding happens in the
+// background, so an unreachable SMSC does not keep the service down.
+func (b *binds) StartAll(ctx context.Context) error {
+	gates, err := b.repo.SelectEnabled(ctx, smsmodel.BackendSMPP)
+	if err != nil {
+		return fmt.Errorf("sms: load gates: %w", err)
+	}
+
+	for _, gate := range gates {
+		b.Start(gate)
+	}
+	return nil
+}
+
+// Start (re)starts the bind of the gate with its current settings.
+func (b *binds) Start(gate *smsmodel.SMSGate) {
+	b.Stop(gate.ID)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	bind := &gateBind{gate: gate, cancel: cancel, done: make(chan struct{})}
+
+	b.mu.Lock()
+	b.running[gate.ID] = bind
+	b.mu.Unlock()
+
+	go b.run(ctx, bind)
+}
+
+// Stop unbinds the gate, if bound, and waits for its loop to exit.
+func (b *binds) Stop(gateID string) {
+	b.mu.Lock()
+	bind, ok := b.running[gateID]
+	delete(b.running, gateID)
+	b.mu.Unlock()
+
+	if ok {
+		bind.cancel()
+		<-bind.done
+	}
+}
+
+// StopAll unbinds every gate, waiting for them until ctx is done.
+func (b *binds) StopAll(ctx context.Context) error {
+	b.mu.Lock()
+	all := make([]*gateBind, 0, len(b.running))
+	for id, bind := range b.running {
+		all = append(all, bind)
+		delete(b.running, id)
+	}
+	b.mu.Unlock()
+
+	for _, bind := range all {
+		bind.cancel()
+	}
+	for _, bind := range all {
+		select {
+		case <-bind.done:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+	return nil
+}
+
+// Session returns the bound session of the gate for sending.
+func (b *binds) Session(gateID string) (*smpp.Session, error) {
+	b.mu.Lock()
+	bind, ok := b.running[gateID]
+	b.mu.Unlock()
+	if !ok {
+		return nil, ErrGateNotBound
+	}
+
+	bind.mu.Lock()
+	defer bind.mu.Unlock()
+	if bind.session == nil || bind.session.Err() != nil {
+		return nil, ErrGateNotBound
+	}
+	return bind.session, nil
+}
+
+// run keeps the gate bound until its context is canceled, rebinding with an
+// exponential backoff.
+func (b *binds) run(ctx context.Context, bind *gateBind) {
+	defer close(bind.done)
+
+	gate := bind.gate
+	log := b.logger.With("gate_id", gate.ID)
+	cfg := smpp.Config{
+		Addr: gate.SMPP.Addr,
+		Bind: smpp.Bind{SystemID: gate.SMPP.SystemID, Password: gate.SMPP.Password, SystemType: gate.SMPP.SystemType},
+	}
+	if gate.SMPP.TLS {
+		host, _, _ := net.SplitHostPort(gate.SMPP.Addr)
+		cfg.TLS = &tls.Config{ServerName: host}
+	}
+	onDeliver := func(ctx context.Context, m *smpp.ShortMessage) error {
+		return b.handle(ctx, gate, m)
+	}
+
+	delay := b.minDelay
+	for {
+		started := time.Now()
+		session, err := smpp.Dial(ctx, cfg, onDeliver)
+		if err == nil {
+			bind.mu.Lock()
+			bind.session = session
+			bind.mu.Unlock()
+			log.Info("bound to the SMSC", "addr", cfg.Addr)
+
+			select {
+			case <-ctx.Done():
+				_ = session.Close()
+				return
+			case <-session.Done():
+				err = session.Err()
+			}
+		}
+		if ctx.Err() != nil {
+			return
+		}
+
+		// A bind that stayed up longer than the longest delay starts the backoff over.
+		if time.Since(started) > b.maxDelay {
+			delay = b.minDelay
+		}
+		log.Warn("SMSC connection lost, rebinding", "err", err, "delay", delay)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(delay):
+		}
+		delay = min(delay*2, b.maxDelay)
+	}
+}
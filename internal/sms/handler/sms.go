@@ -0,0 +1,165 @@
+package handler
+
+import (
+	"context"
+	"log/slog"
+
+	impb "github.com/webitel/im-providers-service/gen/go/provider/v1"
+	"github.com/webitel/im-providers-service/internal/provider/gaterpc"
+	smsmodel "github.com/webitel/im-providers-service/internal/sms/model"
+	smsservice "github.com/webitel/im-providers-service/internal/sms/service"
+)
+
+var backends = map[impb.SMSBackend]smsmodel.Backend{
+	impb.SMSBackend_SMS_BACKEND_SMPP: smsmodel.BackendSMPP,
+	impb.SMSBackend_SMS_BACKEND_HTTP: smsmodel.BackendHTTP,
+}
+
+type SMSHandler struct {
+	logger *slog.Logger
+	srv    smsservice.SMSManager
+	impb.UnimplementedSMSServiceServer
+}
+
+func NewSMSHandler(logger *slog.Logger, srv smsservice.SMSManager) *SMSHandler {
+	return &SMSHandler{logger: logger, srv: srv}
+}
+
+// CreateSMSGate returns the webhook secret of a new HTTP gate next to it: the gateway
+// signs its events with it, and it is not readable afterwards.
+func (h *SMSHandler) CreateSMSGate(ctx context.Context, req *impb.ProviderCreateSMSGateRequest) (*impb.ProviderCreateSMSGateResponse, error) {
+	domainID, err := gaterpc.DomainID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	create := smsmodel.CreateSMS{
+		Name:    req.GetName(),
+		Dc:      domainID,
+		Backend: backends[req.GetBackend()],
+		Sender:  req.GetSender(),
+	}
+	if cfg := smppFromProto(req.GetSmpp()); cfg != nil {
+		create.SMPP = *cfg
+	}
+	if cfg := httpFromProto(req.GetHttp()); cfg != nil {
+		create.HTTP = *cfg
+	}
+	if peer := gaterpc.Peer(req.GetPeer()); peer != nil {
+		create.Peer = *peer
+	}
+
+	gate, err := h.srv.CreateGate(ctx, create)
+	if err != nil {
+		return nil, gaterpc.ToStatus(err, "create gate")
+	}
+
+	return &impb.ProviderCreateSMSGateResponse{Item: gateToProto(gate), WebhookSecret: gate.HTTP.Secret}, nil
+}
+
+func (h *SMSHandler) GetSMSGate(ctx context.Context, req *impb.ProviderGetSMSGateRequest) (*impb.ProviderGetSMSGateResponse, error) {
+	gate, err := h.gate(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	return &impb.ProviderGetSMSGateResponse{Item: gateToProto(gate)}, nil
+}
+
+func (h *SMSHandler) UpdateSMSGate(ctx context.Context, req *impb.ProviderUpdateSMSGateRequest) (*impb.ProviderUpdateSMSGateResponse, error) {
+	if _, err := h.gate(ctx, req.GetId()); err != nil {
+		return nil, err
+	}
+
+	gate, err := h.srv.UpdateGate(ctx, smsmodel.UpdateSMS{
+		ID:      req.GetId(),
+		Name:    req.Name,
+		Sender:  req.Sender,
+		SMPP:    smppFromProto(req.GetSmpp()),
+		HTTP:    httpFromProto(req.GetHttp()),
+		Enabled: req.Enabled,
+		Peer:    gaterpc.Peer(req.GetPeer()),
+	})
+	if err != nil {
+		return nil, gaterpc.ToStatus(err, "update gate")
+	}
+
+	return &impb.ProviderUpdateSMSGateResponse{Item: gateToProto(gate)}, nil
+}
+
+func (h *SMSHandler) DeleteSMSGate(ctx context.Context, req *impb.ProviderDeleteSMSGateRequest) (*impb.ProviderDeleteSMSGateResponse, error) {
+	if _, err := h.gate(ctx, req.GetId()); err != nil {
+		return nil, err
+	}
+
+	gate, err := h.srv.DeleteGate(ctx, req.GetId())
+	if err != nil {
+		return nil, gaterpc.ToStatus(err, "delete gate")
+	}
+
+	return &impb.ProviderDeleteSMSGateResponse{Item: gateToProto(gate)}, nil
+}
+
+// gate returns the gate when it belongs to the domain of the caller.
+func (h *SMSHandler) gate(ctx context.Context, id string) (*smsmodel.SMSGate, error) {
+	gate, err := h.srv.GetGate(ctx, id)
+	if err != nil {
+		return nil, gaterpc.ToStatus(err, "get gate")
+	}
+	if err := gaterpc.CheckDomain(ctx, gate.DomainID); err != nil {
+		return nil, err
+	}
+	return gate, nil
+}
+
+func smppFromProto(cfg *impb.ProviderSMPPConfig) *smsmodel.SMPPConfig {
+	if cfg == nil {
+		return nil
+	}
+	return &smsmodel.SMPPConfig{
+		Addr:       cfg.GetAddr(),
+		SystemID:   cfg.GetSystemId(),
+		Password:   cfg.GetPassword(),
+		SystemType: cfg.GetSystemType(),
+		TLS:        cfg.GetTls(),
+	}
+}
+
+func httpFromProto(cfg *impb.ProviderSMSHTTPGateway) *smsmodel.HTTPGateway {
+	if cfg == nil {
+		return nil
+	}
+	return &smsmodel.HTTPGateway{SendURL: cfg.GetSendUrl(), APIKey: cfg.GetApiKey()}
+}
+
+// gateToProto leaves out the credentials: the SMPP password, the API key and the webhook secret.
+func gateToProto(g *smsmodel.SMSGate) *impb.ProviderSMSGate {
+	if g == nil {
+		return nil
+	}
+	item := &impb.ProviderSMSGate{
+		Id:         g.ID,
+		Name:       g.Name,
+		Peer:       gaterpc.ToProtoPeer(g.Peer),
+		Sender:     g.Sender,
+		WebhookUrl: g.WebhookURL,
+		Status:     impb.ProviderStatus(g.Status),
+		CreatedAt:  g.CreatedAt.UnixMilli(),
+		UpdatedAt:  g.UpdatedAt.UnixMilli(),
+		Enabled:    g.Enabled,
+	}
+	switch g.Backend {
+	case smsmodel.BackendSMPP:
+		item.Backend = impb.SMSBackend_SMS_BACKEND_SMPP
+		item.Smpp = &impb.ProviderSMPPConfig{
+			Addr:       g.SMPP.Addr,
+			SystemId:   g.SMPP.SystemID,
+			SystemType: g.SMPP.SystemType,
+			Tls:        g.SMPP.TLS,
+		}
+	case smsmodel.BackendHTTP:
+		item.Backend = impb.SMSBackend_SMS_BACKEND_HTTP
+		item.Http = &impb.ProviderSMSHTTPGateway{SendUrl: g.HTTP.SendURL}
+	}
+	return item
+}
@@ -0,0 +1,193 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"testing"
+
+	impb "github.com/webitel/im-providers-service/gen/go/provider/v1"
+	"github.com/webitel/im-providers-service/infra/auth"
+	sharedstore "github.com/webitel/im-providers-service/internal/core/store"
+	smsmodel "github.com/webitel/im-providers-service/internal/sms/model"
+	smsservice "github.com/webitel/im-providers-service/internal/sms/service"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type tenant struct{ domainID int64 }
+
+func (t tenant) GetContactID() string { return "" }
+func (t tenant) GetDomainID() int64   { return t.domainID }
+func (t tenant) GetName() string      { return "" }
+
+func tenantContext(domainID int64) context.Context {
+	return context.WithValue(context.Background(), auth.AuthContextKey, tenant{domainID: domainID})
+}
+
+// senderBook keeps the gates like the SMS service does: HTTP gates get a webhook
+// secret on create and credentials left empty on update keep the stored ones.
+type senderBook struct {
+	gates   map[string]*smsmodel.SMSGate
+	deleted []string
+}
+
+var _ smsservice.SMSManager = (*senderBook)(nil)
+
+func newSenderBook(gates ...*smsmodel.SMSGate) *senderBook {
+	b := &senderBook{gates: map[string]*smsmodel.SMSGate{}}
+	for _, g := range gates {
+		b.gates[g.ID] = g
+	}
+	return b
+}
+
+func (b *senderBook) CreateGate(_ context.Context, req smsmodel.CreateSMS) (*smsmodel.SMSGate, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	gate := &smsmodel.SMSGate{
+		ID:       fmt.Sprintf("gate-%d", len(b.gates)+1),
+		DomainID: req.Dc,
+		Name:     req.Name,
+		Backend:  req.Backend,
+		Sender:   req.Sender,
+		SMPP:     req.SMPP,
+		HTTP:     req.HTTP,
+		Peer:     req.Peer,
+		Enabled:  true,
+	}
+	if gate.Backend == smsmodel.BackendHTTP {
+		gate.HTTP.Secret = "webhook-secret"
+		gate.WebhookURL = "https://im.example.com/wh/sms/" + gate.ID
+	}
+	b.gates[gate.ID] = gate
+	return gate, nil
+}
+
+func (b *senderBook) GetGate(_ context.Context, id string) (*smsmodel.SMSGate, error) {
+	g, ok := b.gates[id]
+	if !ok {
+		return nil, sharedstore.ErrNotFound
+	}
+	return g, nil
+}
+
+func (b *senderBook) UpdateGate(_ context.Context, req smsmodel.UpdateSMS) (*smsmodel.SMSGate, error) {
+	req.ApplyTo(b.gates[req.ID])
+	return b.gates[req.ID], nil
+}
+
+func (b *senderBook) DeleteGate(_ context.Context, id string) (*smsmodel.SMSGate, error) {
+	b.deleted = append(b.deleted, id)
+	return b.gates[id], nil
+}
+
+func newHandler(b *senderBook) *SMSHandler {
+	return NewSMSHandler(slog.New(slog.NewTextHandler(io.Discard, nil)), b)
+}
+
+func TestCreateSMSGate_HTTPGatewayGetsWebhook(t *testing.T) {
+	b := newSenderBook()
+	h := newHandler(b)
+
+	resp, err := h.CreateSMSGate(tenantContext(7), &impb.ProviderCreateSMSGateRequest{
+		Name:    "Support",
+		Backend: impb.SMSBackend_SMS_BACKEND_HTTP,
+		Sender:  "Webitel",
+		Http:    &impb.ProviderSMSHTTPGateway{SendUrl: "https://gw.example.com/send", ApiKey: "key"},
+	})
+	if err != nil {
+		t.Fatalf("CreateSMSGate: %v", err)
+	}
+	if resp.GetWebhookSecret() != "webhook-secret" {
+		t.Errorf("webhook secret = %q", resp.GetWebhookSecret())
+	}
+	item := resp.GetItem()
+	if item.GetBackend() != impb.SMSBackend_SMS_BACKEND_HTTP || item.GetWebhookUrl() != "https://im.example.com/wh/sms/gate-1" || item.GetSmpp() != nil {
+		t.Errorf("unexpected gate: %+v", item)
+	}
+	if item.GetHttp().GetApiKey() != "" || item.GetHttp().GetSendUrl() != "https://gw.example.com/send" {
+		t.Errorf("http = %+v, want the send URL without the key", item.GetHttp())
+	}
+	if g := b.gates["gate-1"]; g.DomainID != 7 || g.HTTP.APIKey != "key" {
+		t.Errorf("stored gate = %+v", g)
+	}
+}
+
+func TestCreateSMSGate_Invalid(t *testing.T) {
+	tests := []struct {
+		name string
+		req  *impb.ProviderCreateSMSGateRequest
+	}{
+		{"no backend", &impb.ProviderCreateSMSGateRequest{Name: "Support", Sender: "Webitel"}},
+		{"smsc without port", &impb.ProviderCreateSMSGateRequest{
+			Name:    "Support",
+			Sender:  "Webitel",
+			Backend: impb.SMSBackend_SMS_BACKEND_SMPP,
+			Smpp:    &impb.ProviderSMPPConfig{Addr: "smsc.example.com", SystemId: "esme"},
+		}},
+		{"smpp gate with http account", &impb.ProviderCreateSMSGateRequest{
+			Name:    "Support",
+			Sender:  "Webitel",
+			Backend: impb.SMSBackend_SMS_BACKEND_SMPP,
+			Http:    &impb.ProviderSMSHTTPGateway{SendUrl: "https://gw.example.com/send"},
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := newHandler(newSenderBook()).CreateSMSGate(tenantContext(7), tt.req)
+			if status.Code(err) != codes.InvalidArgument {
+				t.Errorf("code = %v, want InvalidArgument", status.Code(err))
+			}
+		})
+	}
+}
+
+func TestUpdateSMSGate_NewSMSCKeepsPassword(t *testing.T) {
+	b := newSenderBook(&smsmodel.SMSGate{
+		ID:       "gate-1",
+		DomainID: 7,
+		Name:     "Support",
+		Backend:  smsmodel.BackendSMPP,
+		Sender:   "+380441234567",
+		SMPP:     smsmodel.SMPPConfig{Addr: "smsc.example.com:2775", SystemID: "esme", Password: "secret"},
+		Enabled:  true,
+	})
+	h := newHandler(b)
+
+	resp, err := h.UpdateSMSGate(tenantContext(7), &impb.ProviderUpdateSMSGateRequest{
+		Id:   "gate-1",
+		Smpp: &impb.ProviderSMPPConfig{Addr: "smsc2.example.com:2775", SystemId: "esme", Tls: true},
+	})
+	if err != nil {
+		t.Fatalf("UpdateSMSGate: %v", err)
+	}
+	if smpp := resp.GetItem().GetSmpp(); smpp.GetAddr() != "smsc2.example.com:2775" || !smpp.GetTls() || smpp.GetPassword() != "" {
+		t.Errorf("smpp = %+v", smpp)
+	}
+	if g := b.gates["gate-1"]; g.SMPP.Password != "secret" || g.Sender != "+380441234567" || g.Name != "Support" {
+		t.Errorf("stored gate = %+v", g)
+	}
+}
+
+func TestSMSGate_OtherDomainIsNotFound(t *testing.T) {
+	b := newSenderBook(&smsmodel.SMSGate{ID: "gate-1", DomainID: 7, Backend: smsmodel.BackendHTTP, Enabled: true})
+	h := newHandler(b)
+	ctx := tenantContext(8)
+
+	if _, err := h.GetSMSGate(ctx, &impb.ProviderGetSMSGateRequest{Id: "gate-1"}); status.Code(err) != codes.NotFound {
+		t.Errorf("get: code = %v, want NotFound", status.Code(err))
+	}
+	disabled := false
+	if _, err := h.UpdateSMSGate(ctx, &impb.ProviderUpdateSMSGateRequest{Id: "gate-1", Enabled: &disabled}); status.Code(err) != codes.NotFound {
+		t.Errorf("update: code = %v, want NotFound", status.Code(err))
+	}
+	if _, err := h.DeleteSMSGate(ctx, &impb.ProviderDeleteSMSGateRequest{Id: "gate-1"}); status.Code(err) != codes.NotFound {
+		t.Errorf("delete: code = %v, want NotFound", status.Code(err))
+	}
+	if !b.gates["gate-1"].Enabled || len(b.deleted) != 0 {
+		t.Errorf("gate of another domain changed: %+v, deleted %v", b.gates["gate-1"], b.deleted)
+	}
+}
@@ -0,0 +1,103 @@
+// Package httpgw implements the generic HTTP SMS gateway backend. Outbound messages
+// are posted as JSON to the send URL of the gate; the gateway posts inbound messages
+// and delivery reports as Events to the gate webhook.
+package httpgw
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// maxResponseSize bounds the response read for the message IDs.
+const maxResponseSize = 64 << 10
+
+// Event types posted to the gate webhook.
+const (
+	EventMessage = "message"
+	EventStatus  = "status"
+)
+
+// Event is an inbound message or a delivery report posted by the gateway.
+type Event struct {
+	Type string `json:"type"`
+	// ID is the gateway ID of the inbound message, or of the reported outbound one.
+	ID   string `json:"id"`
+	From string `json:"from,omitempty"`
+	To   string `json:"to,omitempty"`
+	Text string `json:"text,omitempty"`
+	// Status is the delivery state of a report: queued, sent, delivered, failed,
+	// undelivered, expired or rejected.
+	Status string `json:"status,omitempty"`
+	// Error is the gateway error code of a failed delivery.
+	Error string `json:"error,omitempty"`
+}
+
+// Request is the body posted to the send URL.
+type Request struct {
+	From string `json:"from"`
+	To   string `json:"to"`
+	Text string `json:"text"`
+	// Reference is the internal message ID, for the gateway logs.
+	Reference string `json:"reference,omitempty"`
+}
+
+// response is the body the send URL answers with: the ID of the message, or the IDs
+// of its parts when the gateway reports them one by one.
+type response struct {
+	ID  string   `json:"id"`
+	IDs []string `json:"ids"`
+}
+
+// Gateway posts outbound messages to HTTP SMS gateways.
+type Gateway struct {
+	client *http.Client
+}
+
+func New(client *http.Client) *Gateway {
+	return &Gateway{client: client}
+}
+
+// Send posts the message to sendURL, authorized by apiKey as a bearer token when set,
+// and returns the gateway IDs of the message.
+func (g *Gateway) Send(ctx context.Context, sendURL, apiKey string, req *Request) ([]string, error) {
+	body, err := json.Marshal(req)
+	if err != nil {
+		return nil, err
+	}
+
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, sendURL, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+	if apiKey != "" {
+		httpReq.Header.Set("Authorization", "Bearer "+apiKey)
+	}
+
+	resp, err := g.client.Do(httpReq)
+	if err != nil {
+		return nil, fmt.Errorf("httpgw: post: %w", err)
+	}
+	defer resp.Body.Close()
+
+	raw, _ := io.ReadAll(io.LimitReader(resp.Body, maxResponseSize))
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return nil, fmt.Errorf("httpgw: status %s: %s", resp.Status, bytes.TrimSpace(raw))
+	}
+
+	var out response
+	if err := json.Unmarshal(raw, &out); err != nil {
+		return nil, fmt.Errorf("httpgw: malformed response: %w", err)
+	}
+	if len(out.IDs) > 0 {
+		return out.IDs, nil
+	}
+	if out.ID == "" {
+		return nil, fmt.Errorf("httpgw: response has no message id")
+	}
+	return []string{out.ID}, nil
+}
@@ -0,0 +1,58 @@
+package httpgw
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestSend(t *testing.T) {
+	var got Request
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer key" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		_ = json.NewDecoder(r.Body).Decode(&got)
+		_, _ = w.Write([]byte(`{"ids":["p1","p2"]}`))
+	}))
+	defer srv.Close()
+
+	ids, err := New(srv.Client()).Send(context.Background(), srv.URL, "key", &Request{From: "Webitel", To: "+380501234567", Text: "hi"})
+	if err != nil {
+		t.Fatalf("Send: %v", err)
+	}
+	if len(ids) != 2 || ids[0] != "p1" {
+		t.Errorf("ids = %v", ids)
+	}
+	if got.To != "+380501234567" || got.Text != "hi" {
+		t.Errorf("request = %+v", got)
+	}
+}
+
+func TestSendErrors(t *testing.T) {
+	tests := []struct {
+		name   string
+		status int
+		body   string
+	}{
+		{"rejected", http.StatusBadRequest, `{"error":"bad number"}`},
+		{"no id", http.StatusOK, `{}`},
+		{"not json", http.StatusOK, `queued`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(tt.status)
+				_, _ = w.Write([]byte(tt.body))
+			}))
+			defer srv.Close()
+
+			if _, err := New(srv.Client()).Send(context.Background(), srv.URL, "", &Request{}); err == nil {
+				t.Error("expected error")
+			}
+		})
+	}
+}
@@ -0,0 +1,143 @@
+package sms
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	sharedmodel "github.com/webitel/im-providers-service/internal/core/model"
+	sharedstore "github.com/webitel/im-providers-service/internal/core/store"
+	"github.com/webitel/im-providers-service/internal/provider/contactsync"
+	smsmodel "github.com/webitel/im-providers-service/internal/sms/model"
+	"github.com/webitel/im-providers-service/internal/sms/smpp"
+)
+
+// systemTypeDeliveryFailed is the system message posted to the conversation of an
+// outbound message part that will not be delivered.
+const systemTypeDeliveryFailed = "sms_delivery_failed"
+
+// handleDeliver processes a deliver_sm of an SMPP gate: a delivery receipt or a part
+// of an inbound message. Malformed PDUs are logged and acknowledged, since the SMSC
+// would only deliver them again; forwarding errors are returned for a redelivery.
+func (p *smsProvider) handleDeliver(ctx context.Context, gate *smsmodel.SMSGate, m *smpp.ShortMessage) error {
+	log := p.logger.With("gate_id", gate.ID)
+
+	if m.IsReceipt() {
+		r, ok := smpp.ParseReceipt(m)
+		if !ok {
+			log.Warn("unreadable delivery receipt dropped", "text", string(m.Message))
+			return nil
+		}
+		reason := r.Stat
+		if r.Err != "" {
+			reason += " err:" + r.Err
+		}
+		return p.handleReport(ctx, gate, &smsmodel.DeliveryReport{
+			ExternalID: r.MessageID,
+			Status:     smppStatus(r.Stat),
+			Reason:     reason,
+		})
+	}
+
+	text, complete, err := p.assembler.Add(m)
+	if err != nil {
+		log.Warn("malformed message dropped", "from", m.Source, "err", err)
+		return nil
+	}
+	if !complete {
+		return nil
+	}
+	return p.handleInbound(ctx, gate, &smsmodel.InboundSMS{
+		From: smpp.FormatAddress(m.SourceTON, m.Source),
+		To:   smpp.FormatAddress(m.DestTON, m.Dest),
+		Text: text,
+	})
+}
+
+// handleInbound forwards a message with the phone number of the sender as the peer.
+func (p *smsProvider) handleInbound(ctx context.Context, gate *smsmodel.SMSGate, msg *smsmodel.InboundSMS) error {
+	if msg.From == "" {
+		return fmt.Errorf("sms: message %q has no sender", msg.ExternalID)
+	}
+	if strings.TrimSpace(msg.Text) == "" {
+		return nil
+	}
+
+	if err := p.syncContact(ctx, gate, msg.From); err != nil {
+		return fmt.Errorf("sync contact [phone=%s]: %w", msg.From, err)
+	}
+
+	peers := contactsync.InboundPeers(gate.ID, gate.Peer, msg.From)
+	_, err := p.messenger.SendText(ctx, &sharedmodel.SendTextRequest{
+		From:     peers.From,
+		To:       peers.To,
+		Body:     msg.Text,
+		DomainID: gate.DomainID,
+	})
+	return err
+}
+
+// handleReport applies a delivery report to the status of the part it is for. A part
+// that will not be delivered is reported to the conversation as a system message.
+// Reports of parts sent before the gate tracked them are ignored.
+func (p *smsProvider) handleReport(ctx context.Context, gate *smsmodel.SMSGate, report *smsmodel.DeliveryReport) error {
+	sent, err := p.sent.UpdateStatus(ctx, gate.ID, report)
+	if err != nil {
+		if errors.Is(err, sharedstore.ErrNotFound) {
+			p.logger.Debug("report for an untracked message", "gate_id", gate.ID, "external_id", report.ExternalID)
+			return nil
+		}
+		return err
+	}
+	if sent.Status != smsmodel.StatusFailed {
+		return nil
+	}
+
+	peers := contactsync.InboundPeers(gate.ID, gate.Peer, sent.Recipient)
+	_, err = p.messenger.SendSystemMessage(ctx, &sharedmodel.SendSystemMessageRequest{
+		From:     peers.From,
+		To:       peers.To,
+		DomainID: gate.DomainID,
+		Type:     systemTypeDeliveryFailed,
+		Body:     "SMS not delivered: " + report.Reason,
+		Metadata: map[string]any{
+			"message_id":  sent.MessageID,
+			"external_id": sent.ExternalID,
+			"reason":      report.Reason,
+		},
+		ExternalID: report.ExternalID,
+	})
+	if err != nil {
+		p.logger.Warn("delivery failure not posted", "gate_id", gate.ID, "message_id", sent.MessageID, "err", err)
+	}
+	return nil
+}
+
+// smppStatus maps the stat of an SMSC delivery receipt.
+func smppStatus(stat string) smsmodel.MessageStatus {
+	switch stat {
+	case "DELIVRD":
+		return smsmodel.StatusDelivered
+	case "ACCEPTD", "ENROUTE":
+		return smsmodel.StatusSent
+	case "EXPIRED", "DELETED", "UNDELIV", "REJECTD":
+		return smsmodel.StatusFailed
+	default:
+		return smsmodel.StatusUnknown
+	}
+}
+
+// httpStatus maps the status of an HTTP gateway report.
+func httpStatus(status string) smsmodel.MessageStatus {
+	switch strings.ToLower(status) {
+	case "delivered":
+		return smsmodel.StatusDelivered
+	case "queued", "accepted", "sent", "enroute":
+		return smsmodel.StatusSent
+	case "failed", "undelivered", "expired", "rejected":
+		return smsmodel.StatusFailed
+	default:
+		return smsmodel.StatusUnknown
+	}
+}
@@ -0,0 +1,193 @@
+package model
+
+import (
+	"net"
+	"net/url"
+	"time"
+
+	sharedmodel "github.com/webitel/im-providers-service/internal/core/model"
+)
+
+// Backend selects how a gate exchanges SMS.
+type Backend string
+
+const (
+	// BackendSMPP binds to an SMSC as a transceiver.
+	BackendSMPP Backend = "smpp"
+	// BackendHTTP posts to a generic HTTP SMS gateway and receives its webhooks.
+	BackendHTTP Backend = "http"
+)
+
+// SMSGate represents a gate of a sender number or alphanumeric sender ID.
+type SMSGate struct {
+	ID       string           `json:"id" db:"id"`
+	DomainID int64            `json:"domain_id" db:"domain_id"`
+	Peer     sharedmodel.Peer `json:"peer" db:"peer"`
+	Name     string           `json:"name" db:"name"`
+	Backend  Backend          `json:"backend" db:"backend"`
+	// Sender is the source address of outbound messages: an E.164 number with a
+	// leading "+" or an alphanumeric sender ID.
+	Sender string      `json:"sender" db:"sender"`
+	SMPP   SMPPConfig  `json:"smpp" db:"smpp"`
+	HTTP   HTTPGateway `json:"http" db:"http"`
+	// WebhookURL receives the events of an HTTP gateway. It is derived from service.public_url.
+	WebhookURL string                 `json:"webhook_url,omitempty" db:"-"`
+	Status     sharedmodel.GateStatus `json:"status" db:"status"`
+	CreatedAt  time.Time              `json:"created_at" db:"created_at"`
+	UpdatedAt  time.Time              `json:"updated_at" db:"updated_at"`
+	Enabled    bool                   `json:"enabled" db:"enabled"`
+}
+
+// SMPPConfig is the SMSC account of an SMPP gate.
+type SMPPConfig struct {
+	// Addr is the host:port of the SMSC.
+	Addr       string `json:"addr" db:"addr"`
+	SystemID   string `json:"system_id" db:"system_id"`
+	Password   string `json:"-" db:"password"`
+	SystemType string `json:"system_type" db:"system_type"`
+	TLS        bool   `json:"tls" db:"tls"`
+}
+
+// HTTPGateway is the account of an HTTP gateway gate.
+type HTTPGateway struct {
+	SendURL string `json:"send_url" db:"send_url"`
+	// APIKey authorizes outbound requests as a bearer token.
+	APIKey string `json:"-" db:"api_key"`
+	// Secret keys the HMAC signature of the webhook events.
+	Secret string `json:"-" db:"secret"`
+}
+
+type CreateSMS struct {
+	Name    string
+	Dc      int64
+	Backend Backend
+	Sender  string
+	SMPP    SMPPConfig
+	HTTP    HTTPGateway
+	Peer    sharedmodel.Peer
+}
+
+type UpdateSMS struct {
+	ID      string
+	Name    *string
+	Sender  *string
+	SMPP    *SMPPConfig
+	HTTP    *HTTPGateway
+	Enabled *bool
+	Peer    *sharedmodel.Peer
+}
+
+// ApplyTo applies the changes. Credentials left empty in a new SMPP or HTTP
+// configuration keep their stored values.
+func (r UpdateSMS) ApplyTo(gate *SMSGate) {
+	if r.Name != nil {
+		gate.Name = *r.Name
+	}
+	if r.Sender != nil {
+		gate.Sender = *r.Sender
+	}
+	if r.SMPP != nil {
+		cfg := *r.SMPP
+		if cfg.Password == "" {
+			cfg.Password = gate.SMPP.Password
+		}
+		gate.SMPP = cfg
+	}
+	if r.HTTP != nil {
+		cfg := *r.HTTP
+		if cfg.APIKey == "" {
+			cfg.APIKey = gate.HTTP.APIKey
+		}
+		if cfg.Secret == "" {
+			cfg.Secret = gate.HTTP.Secret
+		}
+		gate.HTTP = cfg
+	}
+	if r.Enabled != nil {
+		gate.Enabled = *r.Enabled
+	}
+	if r.Peer != nil {
+		gate.Peer = *r.Peer
+	}
+}
+
+func (r CreateSMS) Validate() error {
+	var missing []string
+	if r.Name == "" {
+		missing = append(missing, "name")
+	}
+	if r.Sender == "" {
+		missing = append(missing, "sender")
+	}
+	if r.Dc <= 0 {
+		missing = append(missing, "domain_id")
+	}
+	missing = append(missing, ValidateBackend(r.Backend, r.SMPP, r.HTTP)...)
+	if len(missing) > 0 {
+		return &sharedmodel.ValidationError{Fields: missing}
+	}
+	return nil
+}
+
+// ValidateBackend returns the invalid fields of the backend configuration.
+func ValidateBackend(backend Backend, smpp SMPPConfig, http HTTPGateway) []string {
+	var invalid []string
+	switch backend {
+	case BackendSMPP:
+		if _, port, err := net.SplitHostPort(smpp.Addr); err != nil || port == "" {
+			invalid = append(invalid, "smpp.addr")
+		}
+		if smpp.SystemID == "" {
+			invalid = append(invalid, "smpp.system_id")
+		}
+	case BackendHTTP:
+		u, err := url.Parse(http.SendURL)
+		if err != nil || (u.Scheme != "https" && u.Scheme != "http") || u.Host == "" {
+			invalid = append(invalid, "http.send_url")
+		}
+	default:
+		invalid = append(invalid, "backend")
+	}
+	return invalid
+}
+
+// MessageStatus is the delivery state of an outbound message part.
+type MessageStatus string
+
+const (
+	// StatusSent is a part accepted by the SMSC or gateway, not delivered yet.
+	StatusSent      MessageStatus = "sent"
+	StatusDelivered MessageStatus = "delivered"
+	// StatusFailed is a part that will not be delivered: undeliverable, expired, rejected or deleted.
+	StatusFailed  MessageStatus = "failed"
+	StatusUnknown MessageStatus = "unknown"
+)
+
+// DeliveryReport is the delivery state reported for an outbound message part.
+type DeliveryReport struct {
+	// ExternalID is the ID the SMSC or gateway assigned to the part.
+	ExternalID string
+	Status     MessageStatus
+	// Reason is the state as reported, e.g. "UNDELIV" or "expired", and the error code if any.
+	Reason string
+}
+
+// SentMessage is an outbound message part tracked for its delivery report.
+type SentMessage struct {
+	GateID     string        `db:"gate_id"`
+	ExternalID string        `db:"external_id"`
+	MessageID  string        `db:"message_id"`
+	Recipient  string        `db:"recipient"`
+	Status     MessageStatus `db:"status"`
+	Reason     string        `db:"reason"`
+	UpdatedAt  time.Time     `db:"updated_at"`
+}
+
+// InboundSMS is a message received by the sender number of a gate.
+type InboundSMS struct {
+	// ExternalID is the gateway ID of the message; SMPP gives inbound messages none.
+	ExternalID string
+	From       string
+	To         string
+	Text       string
+}
@@ -0,0 +1,50 @@
+package sms
+
+import (
+	impb "github.com/webitel/im-providers-service/gen/go/provider/v1"
+	grpcsrv "github.com/webitel/im-providers-service/infra/srv/grpc"
+	"github.com/webitel/im-providers-service/internal/provider"
+	smshandler "github.com/webitel/im-providers-service/internal/sms/handler"
+	smsservice "github.com/webitel/im-providers-service/internal/sms/service"
+	smsstore "github.com/webitel/im-providers-service/internal/sms/store"
+	smspostgres "github.com/webitel/im-providers-service/internal/sms/store/postgres"
+	"go.uber.org/fx"
+)
+
+// Module provides the SMS provider, the SMPP binds of its gates and the gRPC gate service.
+var Module = fx.Module("sms",
+	fx.Provide(
+		// Provider adapter — provided as *smsProvider for the lifecycle hooks,
+		// as a Provider for the registry and its binds as Binder for the service.
+		newProvider,
+		fx.Annotate(
+			func(p *smsProvider) provider.Provider { return p },
+			fx.ResultTags(`group:"providers"`),
+		),
+		func(p *smsProvider) smsservice.Binder { return p.binds },
+
+		// Store implementations
+		fx.Annotate(smspostgres.NewSMSStore, fx.As(new(smsstore.SMSStore))),
+		fx.Annotate(smspostgres.NewSentMessageStore, fx.As(new(smsstore.SentMessageStore))),
+
+		// Services
+		fx.Annotate(smsservice.NewSMSService, fx.As(new(smsservice.SMSManager))),
+
+		// gRPC handlers
+		smshandler.NewSMSHandler,
+	),
+	fx.Invoke(registerLifecycle, RegisterSMSService),
+)
+
+// RegisterSMSService connects the SMS gate gRPC handler to the gRPC server.
+func RegisterSMSService(server *grpcsrv.Server, sms *smshandler.SMSHandler) {
+	impb.RegisterSMSServiceServer(server.Server, sms)
+}
+
+// registerLifecycle binds the enabled SMPP gates on start and unbinds them on stop.
+func registerLifecycle(lc fx.Lifecycle, p *smsProvider) {
+	lc.Append(fx.Hook{
+		OnStart: p.binds.StartAll,
+		OnStop:  p.binds.StopAll,
+	})
+}
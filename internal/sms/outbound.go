@@ -0,0 +1,119 @@
+package sms
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	contactv1 "github.com/webitel/im-providers-service/gen/go/contact/v1"
+	sharedmodel "github.com/webitel/im-providers-service/internal/core/model"
+	"github.com/webitel/im-providers-service/internal/provider/contactsync"
+	smsmodel "github.com/webitel/im-providers-service/internal/sms/model"
+)
+
+func (p *smsProvider) SendText(ctx context.Context, req *sharedmodel.Message) (*sharedmodel.MessageResponse, error) {
+	return p.send(ctx, req, req.Text)
+}
+
+// SendImage sends the caption followed by the image links: SMS carries no media.
+func (p *smsProvider) SendImage(ctx context.Context, req *sharedmodel.Message) (*sharedmodel.MessageResponse, error) {
+	links := make([]string, 0, len(req.Images))
+	for _, img := range req.Images {
+		links = append(links, img.URL)
+	}
+	return p.send(ctx, req, withLinks(req.Text, links))
+}
+
+// SendDocument sends the caption followed by the document links: SMS carries no media.
+func (p *smsProvider) SendDocument(ctx context.Context, req *sharedmodel.Message) (*sharedmodel.MessageResponse, error) {
+	links := make([]string, 0, len(req.Documents))
+	for _, doc := range req.Documents {
+		links = append(links, doc.URL)
+	}
+	return p.send(ctx, req, withLinks(req.Text, links))
+}
+
+// send submits the text through the backend of the gate and tracks the parts it was
+// split into for their delivery reports. The response carries the ID of the first part.
+func (p *smsProvider) send(ctx context.Context, req *sharedmodel.Message, text string) (*sharedmodel.MessageResponse, error) {
+	if text == "" {
+		return nil, fmt.Errorf("sms: message has no text")
+	}
+
+	gate, err := p.repo.Select(ctx, req.GateID)
+	if err != nil {
+		return nil, err
+	}
+	b, ok := p.backends[gate.Backend]
+	if !ok {
+		return nil, fmt.Errorf("sms: gate %s has unknown backend %q", gate.ID, gate.Backend)
+	}
+	receiver, err := p.resolveReceiver(ctx, gate, req.To.Sub)
+	if err != nil {
+		return nil, err
+	}
+
+	messageID := req.ID.String()
+	ids, err := b.Send(ctx, gate, receiver, text, messageID)
+	if err != nil {
+		return nil, fmt.Errorf("sms: send to %s: %w", receiver, err)
+	}
+
+	parts := make([]*smsmodel.SentMessage, 0, len(ids))
+	for _, id := range ids {
+		parts = append(parts, &smsmodel.SentMessage{
+			GateID:     gate.ID,
+			ExternalID: id,
+			MessageID:  messageID,
+			Recipient:  receiver,
+			Status:     smsmodel.StatusSent,
+		})
+	}
+	// The message is out already: an untracked part only loses its delivery report.
+	if err := p.sent.InsertSent(ctx, parts); err != nil {
+		p.logger.Warn("sent parts not tracked", "gate_id", gate.ID, "message_id", messageID, "err", err)
+	}
+
+	return &sharedmodel.MessageResponse{ID: ids[0], MD: map[string]any{"parts": len(ids)}}, nil
+}
+
+// withLinks appends the links to the text, one per line.
+func withLinks(text string, links []string) string {
+	lines := make([]string, 0, len(links)+1)
+	if text != "" {
+		lines = append(lines, text)
+	}
+	for _, link := range links {
+		if link != "" {
+			lines = append(lines, link)
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+// resolveReceiver returns the phone number for the given sub.
+// A phone number is returned as-is; an internal contact UUID is resolved via the contact service.
+func (p *smsProvider) resolveReceiver(ctx context.Context, gate *smsmodel.SMSGate, contactID string) (string, error) {
+	if _, err := uuid.Parse(contactID); err != nil {
+		return contactID, nil
+	}
+	if phone, ok := p.receiverCache.Get(contactID); ok {
+		return phone, nil
+	}
+
+	authCtx := contactsync.WithGatewayIdentity(ctx, gate.DomainID, gate.Peer)
+	resp, err := p.contactClient.SearchContact(authCtx, &contactv1.SearchContactRequest{
+		Ids: []string{contactID},
+	})
+	if err != nil {
+		return "", fmt.Errorf("resolve phone number for %s: %w", contactID, err)
+	}
+	items := resp.GetContacts()
+	if len(items) == 0 || items[0].GetSubject() == "" {
+		return "", fmt.Errorf("resolve phone number for %s: contact not found or has no subject", contactID)
+	}
+	phone := items[0].GetSubject()
+	p.receiverCache.Add(contactID, phone)
+	return phone, nil
+}
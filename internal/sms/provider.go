@@ -0,0 +1,143 @@
+// Package sms implements the SMS provider. A gate is a sender number or sender ID
+// served by a pluggable backend: an SMSC bound over SMPP, or a generic HTTP SMS
+// gateway. Inbound messages are forwarded as text with the phone number as the
+// sender; delivery reports update the status of the outbound message parts.
+package sms
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+	lru "github.com/hashicorp/golang-lru/v2"
+	imcontact "github.com/webitel/im-providers-service/infra/client/grpc/im-contact"
+	imgateway "github.com/webitel/im-providers-service/infra/client/grpc/im-gateway"
+	sharedsvc "github.com/webitel/im-providers-service/internal/core/service"
+	sharedstore "github.com/webitel/im-providers-service/internal/core/store"
+	"github.com/webitel/im-providers-service/internal/provider"
+	"github.com/webitel/im-providers-service/internal/sms/httpgw"
+	smsmodel "github.com/webitel/im-providers-service/internal/sms/model"
+	"github.com/webitel/im-providers-service/internal/sms/smpp"
+	smsstore "github.com/webitel/im-providers-service/internal/sms/store"
+)
+
+// backend sends the messages of the gates of one smsmodel.Backend.
+type backend interface {
+	// Send submits the text to the number and returns the IDs the SMSC or gateway
+	// assigned to the parts of the message. reference is the internal message ID.
+	Send(ctx context.Context, gate *smsmodel.SMSGate, to, text, reference string) ([]string, error)
+}
+
+type smsProvider struct {
+	logger        *slog.Logger
+	messenger     sharedsvc.Messenger
+	gateCache     sharedstore.GateCache
+	userCache     sharedstore.ExternalUserCache
+	repo          smsstore.SMSStore
+	sent          smsstore.SentMessageStore
+	gatewayer     *imgateway.Client
+	contactClient *imcontact.Client
+	binds         *binds
+	backends      map[smsmodel.Backend]backend
+	// assembler joins the parts of concatenated messages received over SMPP.
+	assembler *smpp.Assembler
+	// receiverCache maps internal contact UUID → phone number to avoid an
+	// im-contact round-trip on every outbound message.
+	receiverCache *lru.Cache[string, string]
+}
+
+func newProvider(
+	m sharedsvc.Messenger,
+	l *slog.Logger,
+	gc sharedstore.GateCache,
+	uc sharedstore.ExternalUserCache,
+	repo smsstore.SMSStore,
+	sent smsstore.SentMessageStore,
+	gatewayer *imgateway.Client,
+	contactClient *imcontact.Client,
+) *smsProvider {
+	receiverCache, _ := lru.New[string, string](1000)
+	p := &smsProvider{
+		logger:        l.With("provider", "sms"),
+		messenger:     m,
+		gateCache:     gc,
+		userCache:     uc,
+		repo:          repo,
+		sent:          sent,
+		gatewayer:     gatewayer,
+		contactClient: contactClient,
+		assembler:     smpp.NewAssembler(),
+		receiverCache: receiverCache,
+	}
+	p.binds = newBinds(repo, p.handleDeliver, p.logger)
+	p.backends = map[smsmodel.Backend]backend{
+		smsmodel.BackendSMPP: smppBackend{binds: p.binds},
+		smsmodel.BackendHTTP: httpBackend{gw: httpgw.New(&http.Client{Timeout: 30 * time.Second})},
+	}
+	return p
+}
+
+var (
+	_ provider.Provider           = (*smsProvider)(nil)
+	_ provider.SignatureValidator = (*smsProvider)(nil)
+	_ provider.SignatureHeader    = (*smsProvider)(nil)
+)
+
+func (p *smsProvider) Type() string { return "sms" }
+
+// resolveGate returns the gate a webhook was delivered to. The webhook URI segment is
+// the gate ID. Disabled gates are short-circuited from the cache to avoid a DB
+// round-trip on every event.
+func (p *smsProvider) resolveGate(ctx context.Context) (*smsmodel.SMSGate, error) {
+	gateID, _ := ctx.Value(provider.WebhookURIKey).(string)
+	if _, err := uuid.Parse(gateID); err != nil {
+		return nil, fmt.Errorf("invalid webhook uri %q: %w", gateID, sharedstore.ErrNotFound)
+	}
+
+	if cached, ok := p.gateCache.Get(gateID); ok && !cached.Enabled {
+		return &smsmodel.SMSGate{ID: gateID, Enabled: false}, nil
+	}
+
+	g, err := p.repo.Select(ctx, gateID)
+	if err != nil {
+		return nil, err
+	}
+	p.gateCache.Set(gateID, sharedstore.GateState{
+		GateID:  g.ID,
+		Enabled: g.Enabled,
+		Issuer:  g.Peer.Iss,
+		Sub:     g.Peer.Sub,
+		Domain:  g.DomainID,
+	})
+	return g, nil
+}
+
+// smppBackend submits through the bound session of the gate.
+type smppBackend struct {
+	binds *binds
+}
+
+func (b smppBackend) Send(ctx context.Context, gate *smsmodel.SMSGate, to, text, _ string) ([]string, error) {
+	session, err := b.binds.Session(gate.ID)
+	if err != nil {
+		return nil, err
+	}
+	return session.SendText(ctx, gate.Sender, to, text, true)
+}
+
+// httpBackend posts to the send URL of the gate.
+type httpBackend struct {
+	gw *httpgw.Gateway
+}
+
+func (b httpBackend) Send(ctx context.Context, gate *smsmodel.SMSGate, to, text, reference string) ([]string, error) {
+	return b.gw.Send(ctx, gate.HTTP.SendURL, gate.HTTP.APIKey, &httpgw.Request{
+		From:      gate.Sender,
+		To:        to,
+		Text:      text,
+		Reference: reference,
+	})
+}
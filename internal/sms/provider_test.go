@@ -0,0 +1,396 @@
+package sms
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	sharedmodel "github.com/webitel/im-providers-service/internal/core/model"
+	sharedstore "github.com/webitel/im-providers-service/internal/core/store"
+	"github.com/webitel/im-providers-service/internal/provider"
+	"github.com/webitel/im-providers-service/internal/provider/providertest"
+	"github.com/webitel/im-providers-service/internal/sms/httpgw"
+	smsmodel "github.com/webitel/im-providers-service/internal/sms/model"
+	"github.com/webitel/im-providers-service/internal/sms/smpp"
+	"github.com/webitel/im-providers-service/internal/sms/smpp/smpptest"
+	smsstore "github.com/webitel/im-providers-service/internal/sms/store"
+)
+
+const (
+	testGateID = "0190a8a4-5f0e-7c2a-9d3b-0a1b2c3d4e5f"
+	testSecret = "s3cr3t"
+	testPhone  = "+380501234567"
+)
+
+var noopLogger = slog.New(slog.NewTextHandler(io.Discard, nil))
+
+// senderGates serves the gates to the webhook and to the SMPP binds. The provider only
+// reads gates; writing them is the job of the gate service.
+type senderGates map[string]*smsmodel.SMSGate
+
+var _ smsstore.SMSStore = senderGates(nil)
+
+func (g senderGates) Select(_ context.Context, id string) (*smsmodel.SMSGate, error) {
+	gate, ok := g[id]
+	if !ok {
+		return nil, sharedstore.ErrNotFound
+	}
+	cp := *gate
+	return &cp, nil
+}
+
+func (g senderGates) SelectEnabled(_ context.Context, backend smsmodel.Backend) ([]*smsmodel.SMSGate, error) {
+	var out []*smsmodel.SMSGate
+	for _, gate := range g {
+		if gate.Enabled && gate.Backend == backend {
+			cp := *gate
+			out = append(out, &cp)
+		}
+	}
+	return out, nil
+}
+
+func (senderGates) Insert(context.Context, int64, *smsmodel.SMSGate) error {
+	panic("sms provider must not insert gates")
+}
+
+func (senderGates) Update(context.Context, *smsmodel.SMSGate) error {
+	panic("sms provider must not update gates")
+}
+
+func (senderGates) Delete(context.Context, string) error {
+	panic("sms provider must not delete gates")
+}
+
+// deliveryLog keeps the sent message parts by the ID the SMSC or gateway assigned,
+// so delivery reports can be matched to them.
+type deliveryLog struct {
+	mu    sync.Mutex
+	parts map[string]*smsmodel.SentMessage
+}
+
+var _ smsstore.SentMessageStore = (*deliveryLog)(nil)
+
+func (l *deliveryLog) InsertSent(_ context.Context, msgs []*smsmodel.SentMessage) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	for _, msg := range msgs {
+		l.parts[msg.ExternalID] = msg
+	}
+	return nil
+}
+
+func (l *deliveryLog) UpdateStatus(_ context.Context, _ string, r *smsmodel.DeliveryReport) (*smsmodel.SentMessage, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	msg, ok := l.parts[r.ExternalID]
+	if !ok {
+		return nil, sharedstore.ErrNotFound
+	}
+	msg.Status, msg.Reason = r.Status, r.Reason
+	cp := *msg
+	return &cp, nil
+}
+
+func (l *deliveryLog) status(id string) smsmodel.MessageStatus {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if msg, ok := l.parts[id]; ok {
+		return msg.Status
+	}
+	return ""
+}
+
+func smppGate(addr string) *smsmodel.SMSGate {
+	return &smsmodel.SMSGate{
+		ID:       testGateID,
+		DomainID: 1,
+		Name:     "Support SMS",
+		Peer:     sharedmodel.Peer{Sub: "sms-bot", Iss: "sms"},
+		Backend:  smsmodel.BackendSMPP,
+		Sender:   "Webitel",
+		SMPP:     smsmodel.SMPPConfig{Addr: addr, SystemID: "esme", Password: "secret"},
+		Enabled:  true,
+	}
+}
+
+func httpGate(sendURL string) *smsmodel.SMSGate {
+	g := smppGate("")
+	g.Backend = smsmodel.BackendHTTP
+	g.SMPP = smsmodel.SMPPConfig{}
+	g.HTTP = smsmodel.HTTPGateway{SendURL: sendURL, APIKey: "key", Secret: testSecret}
+	return g
+}
+
+// newSenderProvider returns the provider of the gate; lost binds are retried quickly.
+func newSenderProvider(gate *smsmodel.SMSGate) (*smsProvider, *providertest.Messenger, *deliveryLog) {
+	m := &providertest.Messenger{}
+	sent := &deliveryLog{parts: map[string]*smsmodel.SentMessage{}}
+	p := newProvider(m, noopLogger, providertest.NewGateCache(), providertest.KnownUsers{}, senderGates{gate.ID: gate}, sent, nil, nil)
+	p.binds.minDelay, p.binds.maxDelay = 10*time.Millisecond, 50*time.Millisecond
+	return p, m, sent
+}
+
+// startBound starts the SMPP binds and waits for the gate to be bound to the simulator.
+func startBound(t *testing.T, p *smsProvider, sim *smpptest.Simulator, binds int) {
+	t.Helper()
+	if err := p.binds.StartAll(context.Background()); err != nil {
+		t.Fatalf("StartAll: %v", err)
+	}
+	t.Cleanup(func() { _ = p.binds.StopAll(context.Background()) })
+	waitFor(t, func() bool {
+		_, err := p.binds.Session(testGateID)
+		return err == nil && sim.Binds() >= binds
+	})
+}
+
+func waitFor(t *testing.T, cond func() bool) {
+	t.Helper()
+	deadline := time.Now().Add(3 * time.Second)
+	for !cond() {
+		if time.Now().After(deadline) {
+			t.Fatal("condition not met in time")
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+}
+
+func startSimulator(t *testing.T) *smpptest.Simulator {
+	t.Helper()
+	sim, err := smpptest.NewSimulator("esme", "secret")
+	if err != nil {
+		t.Fatalf("NewSimulator: %v", err)
+	}
+	t.Cleanup(sim.Close)
+	return sim
+}
+
+func webhookCtx() context.Context {
+	return context.WithValue(context.Background(), provider.WebhookURIKey, testGateID)
+}
+
+// -- SMPP --
+
+func TestSMPPInboundMessage(t *testing.T) {
+	sim := startSimulator(t)
+	p, m, _ := newSenderProvider(smppGate(sim.Addr))
+	startBound(t, p, sim, 1)
+
+	if status, err := sim.DeliverText(testPhone, "Webitel", "Hello"); err != nil || status != smpp.StatusOK {
+		t.Fatalf("status = %#x, err = %v", status, err)
+	}
+
+	texts := m.Texts()
+	if len(texts) != 1 {
+		t.Fatalf("forwarded %d texts", len(texts))
+	}
+	got := texts[0]
+	if got.Body != "Hello" || got.From.Sub != testPhone || got.From.Iss != "sms" || got.DomainID != 1 {
+		t.Errorf("forwarded = %+v", got)
+	}
+	if got.To.Sub != "sms-bot" || got.To.Via == nil || *got.To.Via != testGateID {
+		t.Errorf("addressed to %+v", got.To)
+	}
+}
+
+func TestSMPPInboundConcatenated(t *testing.T) {
+	sim := startSimulator(t)
+	p, m, _ := newSenderProvider(smppGate(sim.Addr))
+	startBound(t, p, sim, 1)
+
+	_, parts, _ := smpp.EncodeText(strings.Repeat("Привіт ", 15))
+	if len(parts) != 2 {
+		t.Fatalf("test text split into %d parts", len(parts))
+	}
+	for i, part := range parts {
+		msg := &smpp.ShortMessage{
+			SourceTON:  smpp.TONInternational,
+			Source:     strings.TrimPrefix(testPhone, "+"),
+			EsmClass:   smpp.EsmClassUDHI,
+			DataCoding: smpp.CodingUCS2,
+			Message:    append([]byte{0x05, 0x00, 0x03, 42, 2, byte(i + 1)}, part...),
+		}
+		if status, err := sim.Deliver(msg); err != nil || status != smpp.StatusOK {
+			t.Fatalf("part %d: status = %#x, err = %v", i+1, status, err)
+		}
+	}
+
+	texts := m.Texts()
+	if len(texts) != 1 || texts[0].Body != strings.Repeat("Привіт ", 15) || texts[0].From.Sub != testPhone {
+		t.Errorf("forwarded = %+v", texts)
+	}
+}
+
+func TestSMPPSendAndDeliveryReports(t *testing.T) {
+	sim := startSimulator(t)
+	p, m, sent := newSenderProvider(smppGate(sim.Addr))
+	startBound(t, p, sim, 1)
+
+	resp, err := p.SendText(context.Background(), &sharedmodel.Message{
+		ID:     uuid.New(),
+		GateID: testGateID,
+		To:     sharedmodel.Peer{Sub: testPhone},
+		Text:   strings.Repeat("a", 200),
+	})
+	if err != nil {
+		t.Fatalf("SendText: %v", err)
+	}
+	if resp.ID != "msg-1" || resp.MD["parts"] != 2 {
+		t.Errorf("response = %+v", resp)
+	}
+	sub := sim.Submitted()
+	if len(sub) != 2 || sub[0].Source != "Webitel" || sub[0].Dest != "380501234567" || sub[0].RegisteredDelivery != 1 {
+		t.Fatalf("submitted = %+v", sub)
+	}
+
+	if _, err := sim.DeliverReceipt("msg-1", "DELIVRD", "000"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := sim.DeliverReceipt("msg-2", "UNDELIV", "011"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := sim.DeliverReceipt("unknown", "DELIVRD", "000"); err != nil {
+		t.Fatal(err)
+	}
+
+	if s := sent.status("msg-1"); s != smsmodel.StatusDelivered {
+		t.Errorf("msg-1 status = %q", s)
+	}
+	if s := sent.status("msg-2"); s != smsmodel.StatusFailed {
+		t.Errorf("msg-2 status = %q", s)
+	}
+	systems := m.Systems()
+	if len(systems) != 1 || systems[0].Type != systemTypeDeliveryFailed || systems[0].From.Sub != testPhone ||
+		!strings.Contains(systems[0].Body, "UNDELIV err:011") {
+		t.Errorf("system messages = %+v", systems)
+	}
+}
+
+func TestSMPPRebindsAfterConnectionLoss(t *testing.T) {
+	sim := startSimulator(t)
+	p, _, _ := newSenderProvider(smppGate(sim.Addr))
+	startBound(t, p, sim, 1)
+
+	sim.DropConnections()
+	waitFor(t, func() bool {
+		_, err := p.binds.Session(testGateID)
+		return err == nil && sim.Binds() == 2
+	})
+
+	if _, err := p.SendText(context.Background(), &sharedmodel.Message{GateID: testGateID, To: sharedmodel.Peer{Sub: testPhone}, Text: "hi"}); err != nil {
+		t.Errorf("SendText after rebind: %v", err)
+	}
+}
+
+func TestSMPPSendWhileUnbound(t *testing.T) {
+	p, _, _ := newSenderProvider(smppGate("127.0.0.1:1"))
+
+	_, err := p.SendText(context.Background(), &sharedmodel.Message{GateID: testGateID, To: sharedmodel.Peer{Sub: testPhone}, Text: "hi"})
+	if err == nil || !strings.Contains(err.Error(), ErrGateNotBound.Error()) {
+		t.Errorf("err = %v, want ErrGateNotBound", err)
+	}
+}
+
+// -- HTTP gateway --
+
+func TestHTTPSendDocumentLinks(t *testing.T) {
+	var got httpgw.Request
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewDecoder(r.Body).Decode(&got)
+		_, _ = w.Write([]byte(`{"id":"gw-1"}`))
+	}))
+	defer srv.Close()
+
+	p, _, sent := newSenderProvider(httpGate(srv.URL))
+	resp, err := p.SendDocument(context.Background(), &sharedmodel.Message{
+		GateID:    testGateID,
+		To:        sharedmodel.Peer{Sub: testPhone},
+		Text:      "Your invoice",
+		Documents: []*sharedmodel.Document{{URL: "https://files.example.com/invoice.pdf"}},
+	})
+	if err != nil {
+		t.Fatalf("SendDocument: %v", err)
+	}
+	if resp.ID != "gw-1" || sent.status("gw-1") != smsmodel.StatusSent {
+		t.Errorf("response = %+v, tracked = %q", resp, sent.status("gw-1"))
+	}
+	if got.From != "Webitel" || got.To != testPhone || got.Text != "Your invoice\nhttps://files.example.com/invoice.pdf" {
+		t.Errorf("request = %+v", got)
+	}
+}
+
+func TestHTTPWebhook(t *testing.T) {
+	p, m, sent := newSenderProvider(httpGate("https://gw.example.com/send"))
+	sent.parts["gw-7"] = &smsmodel.SentMessage{ExternalID: "gw-7", Recipient: testPhone, Status: smsmodel.StatusSent}
+
+	if err := p.HandleWebhook(webhookCtx(), []byte(`{"type":"message","id":"in-1","from":"+380501234567","to":"Webitel","text":"Hi"}`)); err != nil {
+		t.Fatalf("message event: %v", err)
+	}
+	if err := p.HandleWebhook(webhookCtx(), []byte(`{"type":"status","id":"gw-7","status":"expired"}`)); err != nil {
+		t.Fatalf("status event: %v", err)
+	}
+
+	texts, systems := m.Texts(), m.Systems()
+	if len(texts) != 1 || texts[0].Body != "Hi" || texts[0].From.Sub != testPhone {
+		t.Errorf("texts = %+v", texts)
+	}
+	if sent.status("gw-7") != smsmodel.StatusFailed || len(systems) != 1 {
+		t.Errorf("status = %q, system messages = %d", sent.status("gw-7"), len(systems))
+	}
+
+	for _, body := range []string{`not json`, `{"type":"poll"}`, `{"type":"status"}`} {
+		if err := p.HandleWebhook(webhookCtx(), []byte(body)); err == nil {
+			t.Errorf("%s accepted", body)
+		}
+	}
+}
+
+func TestValidateSignature(t *testing.T) {
+	p, _, _ := newSenderProvider(httpGate("https://gw.example.com/send"))
+	body := []byte(`{"type":"message"}`)
+
+	if err := p.ValidateSignature(webhookCtx(), signaturePrefix+sign(testSecret, body), body); err != nil {
+		t.Errorf("valid signature rejected: %v", err)
+	}
+	for name, header := range map[string]string{
+		"missing":    "",
+		"no prefix":  sign(testSecret, body),
+		"wrong key":  signaturePrefix + sign("other", body),
+		"wrong body": signaturePrefix + sign(testSecret, []byte("{}")),
+	} {
+		if err := p.ValidateSignature(webhookCtx(), header, body); err == nil {
+			t.Errorf("%s: signature accepted", name)
+		}
+	}
+}
+
+func TestStatusMapping(t *testing.T) {
+	for stat, want := range map[string]smsmodel.MessageStatus{
+		"DELIVRD": smsmodel.StatusDelivered,
+		"ENROUTE": smsmodel.StatusSent,
+		"REJECTD": smsmodel.StatusFailed,
+		"UNKNOWN": smsmodel.StatusUnknown,
+	} {
+		if got := smppStatus(stat); got != want {
+			t.Errorf("smppStatus(%s) = %q, want %q", stat, got, want)
+		}
+	}
+	for status, want := range map[string]smsmodel.MessageStatus{
+		"Delivered":   smsmodel.StatusDelivered,
+		"queued":      smsmodel.StatusSent,
+		"undelivered": smsmodel.StatusFailed,
+		"weird":       smsmodel.StatusUnknown,
+	} {
+		if got := httpStatus(status); got != want {
+			t.Errorf("httpStatus(%s) = %q, want %q", status, got, want)
+		}
+	}
+}
@@ -0,0 +1,158 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"log/slog"
+
+	"github.com/webitel/im-providers-service/config"
+	sharedmodel "github.com/webitel/im-providers-service/internal/core/model"
+	smsmodel "github.com/webitel/im-providers-service/internal/sms/model"
+	smsstore "github.com/webitel/im-providers-service/internal/sms/store"
+)
+
+// secretSize is the number of random bytes of a generated webhook secret.
+const secretSize = 32
+
+var _ SMSManager = (*SMSService)(nil)
+
+type SMSManager interface {
+	CreateGate(ctx context.Context, req smsmodel.CreateSMS) (*smsmodel.SMSGate, error)
+	GetGate(ctx context.Context, id string) (*smsmodel.SMSGate, error)
+	UpdateGate(ctx context.Context, req smsmodel.UpdateSMS) (*smsmodel.SMSGate, error)
+	DeleteGate(ctx context.Context, id string) (*smsmodel.SMSGate, error)
+}
+
+// Binder keeps the SMPP gates bound to their SMSC.
+// Defined here (exported) so the parent sms package can satisfy it without an import cycle.
+type Binder interface {
+	Start(gate *smsmodel.SMSGate)
+	Stop(gateID string)
+}
+
+type SMSService struct {
+	repo  smsstore.SMSStore
+	binds Binder
+	cfg   *config.Config
+	log   *slog.Logger
+}
+
+func NewSMSService(repo smsstore.SMSStore, binds Binder, cfg *config.Config, log *slog.Logger) *SMSService {
+	return &SMSService{
+		repo:  repo,
+		binds: binds,
+		cfg:   cfg,
+		log:   log.With("layer", "service", "domain", "sms_gate"),
+	}
+}
+
+// CreateGate stores the gate and binds it when it uses SMPP. An HTTP gate without a
+// webhook secret in the request gets a generated one; the returned gate carries it,
+// so it can be handed over to the gateway.
+func (s *SMSService) CreateGate(ctx context.Context, req smsmodel.CreateSMS) (*smsmodel.SMSGate, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	if req.Backend == smsmodel.BackendHTTP && req.HTTP.Secret == "" {
+		secret, err := generateSecret()
+		if err != nil {
+			return nil, err
+		}
+		req.HTTP.Secret = secret
+	}
+
+	gate := &smsmodel.SMSGate{
+		Name:    req.Name,
+		Backend: req.Backend,
+		Sender:  req.Sender,
+		SMPP:    req.SMPP,
+		HTTP:    req.HTTP,
+		Peer:    req.Peer,
+		Enabled: true,
+	}
+	if err := s.repo.Insert(ctx, req.Dc, gate); err != nil {
+		s.log.Error("failed to create sms gate", "err", err)
+		return nil, err
+	}
+
+	s.rebind(gate)
+	s.setWebhookURL(gate)
+	s.log.Info("sms gate created", "id", gate.ID, "backend", gate.Backend, "sender", gate.Sender)
+	return gate, nil
+}
+
+func (s *SMSService) GetGate(ctx context.Context, id string) (*smsmodel.SMSGate, error) {
+	gate, err := s.repo.Select(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	s.setWebhookURL(gate)
+	return gate, nil
+}
+
+// UpdateGate applies the changes and rebinds an SMPP gate with them.
+func (s *SMSService) UpdateGate(ctx context.Context, req smsmodel.UpdateSMS) (*smsmodel.SMSGate, error) {
+	gate, err := s.repo.Select(ctx, req.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	req.ApplyTo(gate)
+	if invalid := smsmodel.ValidateBackend(gate.Backend, gate.SMPP, gate.HTTP); len(invalid) > 0 {
+		return nil, &sharedmodel.ValidationError{Fields: invalid}
+	}
+	if err := s.repo.Update(ctx, gate); err != nil {
+		s.log.Error("failed to update sms gate", "id", req.ID, "err", err)
+		return nil, err
+	}
+
+	s.rebind(gate)
+	s.setWebhookURL(gate)
+	s.log.Info("sms gate updated", "id", gate.ID)
+	return gate, nil
+}
+
+func (s *SMSService) DeleteGate(ctx context.Context, id string) (*smsmodel.SMSGate, error) {
+	gate, err := s.repo.Select(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	s.binds.Stop(id)
+	if err := s.repo.Delete(ctx, id); err != nil {
+		s.log.Error("failed to delete sms gate", "id", id, "err", err)
+		return nil, err
+	}
+
+	s.log.Warn("sms gate removed", "id", id)
+	return gate, nil
+}
+
+// rebind (re)starts the bind of an enabled SMPP gate and stops it otherwise.
+func (s *SMSService) rebind(gate *smsmodel.SMSGate) {
+	if gate.Backend == smsmodel.BackendSMPP && gate.Enabled {
+		s.binds.Start(gate)
+		return
+	}
+	s.binds.Stop(gate.ID)
+}
+
+// setWebhookURL fills the URL an HTTP gateway posts its events to: the gate ID is the
+// webhook URI segment. It stays empty until service.public_url is configured.
+func (s *SMSService) setWebhookURL(gate *smsmodel.SMSGate) {
+	if gate.Backend != smsmodel.BackendHTTP || s.cfg.Service.PublicURL == "" {
+		return
+	}
+	gate.WebhookURL = s.cfg.Service.PublicURL + s.cfg.Service.WebhookPath + "/sms/" + gate.ID
+}
+
+func generateSecret() (string, error) {
+	b := make([]byte, secretSize)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("generate secret: %w", err)
+	}
+	return hex.EncodeToString(b), nil
+}
@@ -0,0 +1,179 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"testing"
+
+	"github.com/webitel/im-providers-service/config"
+	sharedmodel "github.com/webitel/im-providers-service/internal/core/model"
+	sharedstore "github.com/webitel/im-providers-service/internal/core/store"
+	smsmodel "github.com/webitel/im-providers-service/internal/sms/model"
+	smsstore "github.com/webitel/im-providers-service/internal/sms/store"
+)
+
+var noopLogger = slog.New(slog.NewTextHandler(io.Discard, nil))
+
+// senders stores the gates under sequential IDs.
+type senders map[string]*smsmodel.SMSGate
+
+var _ smsstore.SMSStore = senders(nil)
+
+func (s senders) Insert(_ context.Context, dc int64, g *smsmodel.SMSGate) error {
+	g.ID = fmt.Sprintf("gate-%d", len(s)+1)
+	g.DomainID = dc
+	cp := *g
+	s[g.ID] = &cp
+	return nil
+}
+
+func (s senders) Select(_ context.Context, id string) (*smsmodel.SMSGate, error) {
+	g, ok := s[id]
+	if !ok {
+		return nil, sharedstore.ErrNotFound
+	}
+	cp := *g
+	return &cp, nil
+}
+
+func (s senders) SelectEnabled(context.Context, smsmodel.Backend) ([]*smsmodel.SMSGate, error) {
+	return nil, nil
+}
+
+func (s senders) Update(_ context.Context, g *smsmodel.SMSGate) error {
+	cp := *g
+	s[g.ID] = &cp
+	return nil
+}
+
+func (s senders) Delete(_ context.Context, id string) error {
+	delete(s, id)
+	return nil
+}
+
+// smscBinds records which SMPP gates were bound and unbound.
+type smscBinds struct {
+	started []string
+	stopped []string
+}
+
+func (b *smscBinds) Start(gate *smsmodel.SMSGate) { b.started = append(b.started, gate.ID) }
+func (b *smscBinds) Stop(gateID string)           { b.stopped = append(b.stopped, gateID) }
+
+// newSMSService derives the webhook URLs of HTTP gates from publicURL; empty leaves them unset.
+func newSMSService(publicURL string) (*SMSService, senders, *smscBinds) {
+	gates := senders{}
+	binds := &smscBinds{}
+	cfg := &config.Config{}
+	cfg.Service.PublicURL = publicURL
+	cfg.Service.WebhookPath = "/wh"
+	return NewSMSService(gates, binds, cfg, noopLogger), gates, binds
+}
+
+func TestCreateSMPPGateBinds(t *testing.T) {
+	svc, _, binds := newSMSService("https://im.example.com")
+
+	gate, err := svc.CreateGate(context.Background(), smsmodel.CreateSMS{
+		Name:    "Support",
+		Dc:      1,
+		Backend: smsmodel.BackendSMPP,
+		Sender:  "+380441234567",
+		SMPP:    smsmodel.SMPPConfig{Addr: "smsc.example.com:2775", SystemID: "esme", Password: "secret"},
+	})
+	if err != nil {
+		t.Fatalf("CreateGate: %v", err)
+	}
+	if len(binds.started) != 1 || binds.started[0] != gate.ID {
+		t.Errorf("started binds = %v", binds.started)
+	}
+	if gate.WebhookURL != "" || gate.HTTP.Secret != "" {
+		t.Errorf("SMPP gate got webhook settings: %q", gate.WebhookURL)
+	}
+}
+
+func TestCreateHTTPGateGeneratesSecret(t *testing.T) {
+	svc, gates, binds := newSMSService("https://im.example.com")
+
+	gate, err := svc.CreateGate(context.Background(), smsmodel.CreateSMS{
+		Name:    "Support",
+		Dc:      1,
+		Backend: smsmodel.BackendHTTP,
+		Sender:  "Webitel",
+		HTTP:    smsmodel.HTTPGateway{SendURL: "https://gw.example.com/send", APIKey: "key"},
+	})
+	if err != nil {
+		t.Fatalf("CreateGate: %v", err)
+	}
+	if len(gate.HTTP.Secret) != 2*secretSize || gates["gate-1"].HTTP.Secret != gate.HTTP.Secret {
+		t.Errorf("secret = %q", gate.HTTP.Secret)
+	}
+	if gate.WebhookURL != "https://im.example.com/wh/sms/gate-1" {
+		t.Errorf("webhook url = %q", gate.WebhookURL)
+	}
+	if len(binds.started) != 0 {
+		t.Errorf("HTTP gate bound: %v", binds.started)
+	}
+}
+
+func TestCreateGateValidates(t *testing.T) {
+	svc, _, _ := newSMSService("")
+
+	_, err := svc.CreateGate(context.Background(), smsmodel.CreateSMS{
+		Name:    "Support",
+		Dc:      1,
+		Backend: smsmodel.BackendSMPP,
+		Sender:  "Webitel",
+		SMPP:    smsmodel.SMPPConfig{Addr: "smsc.example.com"},
+	})
+	var vErr *sharedmodel.ValidationError
+	if !errors.As(err, &vErr) || len(vErr.Fields) != 2 || vErr.Fields[0] != "smpp.addr" || vErr.Fields[1] != "smpp.system_id" {
+		t.Errorf("err = %v, want smpp validation errors", err)
+	}
+
+	_, err = svc.CreateGate(context.Background(), smsmodel.CreateSMS{Name: "Support", Dc: 1, Sender: "Webitel", Backend: "mms"})
+	if !errors.As(err, &vErr) || vErr.Fields[0] != "backend" {
+		t.Errorf("err = %v, want backend validation error", err)
+	}
+}
+
+func TestUpdateGateRebinds(t *testing.T) {
+	svc, gates, binds := newSMSService("")
+	gates["gate-1"] = &smsmodel.SMSGate{
+		ID:      "gate-1",
+		Backend: smsmodel.BackendSMPP,
+		SMPP:    smsmodel.SMPPConfig{Addr: "smsc.example.com:2775", SystemID: "esme", Password: "secret"},
+		Enabled: true,
+	}
+	ctx := context.Background()
+
+	// A new configuration without a password keeps the stored one.
+	gate, err := svc.UpdateGate(ctx, smsmodel.UpdateSMS{
+		ID:   "gate-1",
+		SMPP: &smsmodel.SMPPConfig{Addr: "smsc2.example.com:2775", SystemID: "esme"},
+	})
+	if err != nil {
+		t.Fatalf("UpdateGate: %v", err)
+	}
+	if gate.SMPP.Addr != "smsc2.example.com:2775" || gates["gate-1"].SMPP.Password != "secret" {
+		t.Errorf("smpp = %+v", gates["gate-1"].SMPP)
+	}
+	if len(binds.started) != 1 {
+		t.Errorf("started binds = %v", binds.started)
+	}
+
+	disabled := false
+	if _, err := svc.UpdateGate(ctx, smsmodel.UpdateSMS{ID: "gate-1", Enabled: &disabled}); err != nil {
+		t.Fatalf("UpdateGate: %v", err)
+	}
+	if len(binds.stopped) != 1 {
+		t.Errorf("disabled gate still bound: %v", binds.stopped)
+	}
+
+	bad := &smsmodel.SMPPConfig{Addr: "nowhere", SystemID: "esme"}
+	if _, err := svc.UpdateGate(ctx, smsmodel.UpdateSMS{ID: "gate-1", SMPP: bad}); err == nil {
+		t.Error("invalid smpp addr accepted")
+	}
+}
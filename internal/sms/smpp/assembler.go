@@ -0,0 +1,77 @@
+package smpp
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/golang-lru/v2/expirable"
+)
+
+const (
+	// assemblyTTL bounds the wait for the missing parts of a concatenated message.
+	assemblyTTL = 10 * time.Minute
+	// assemblySize bounds the number of concatenated messages assembled at once.
+	assemblySize = 10000
+)
+
+// Assembler joins the parts of concatenated inbound messages.
+type Assembler struct {
+	mu      sync.Mutex
+	pending *expirable.LRU[string, *assembly]
+}
+
+type assembly struct {
+	coding byte
+	parts  [][]byte
+	got    int
+}
+
+func NewAssembler() *Assembler {
+	return &Assembler{pending: expirable.NewLRU[string, *assembly](assemblySize, nil, assemblyTTL)}
+}
+
+// Add takes a deliver_sm and returns its text once every part of the message has
+// arrived; complete is false while parts are missing. Parts are told apart by their
+// source, destination and concatenation reference; a repeated part replaces the first.
+func (a *Assembler) Add(m *ShortMessage) (text string, complete bool, err error) {
+	if m.EsmClass&EsmClassUDHI == 0 {
+		return DecodeText(m.DataCoding, m.Message), true, nil
+	}
+
+	concat, payload, err := SplitUDH(m.Message)
+	if err != nil {
+		return "", false, err
+	}
+	if concat == nil || concat.Total <= 1 {
+		return DecodeText(m.DataCoding, payload), true, nil
+	}
+	if concat.Seq == 0 || concat.Seq > concat.Total {
+		return "", false, fmt.Errorf("smpp: part %d of %d", concat.Seq, concat.Total)
+	}
+
+	key := fmt.Sprintf("%s|%s|%d|%d", m.Source, m.Dest, concat.Ref, concat.Total)
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	asm, ok := a.pending.Get(key)
+	if !ok {
+		asm = &assembly{coding: m.DataCoding, parts: make([][]byte, concat.Total)}
+		a.pending.Add(key, asm)
+	}
+	if asm.parts[concat.Seq-1] == nil {
+		asm.got++
+	}
+	asm.parts[concat.Seq-1] = payload
+	if asm.got < len(asm.parts) {
+		return "", false, nil
+	}
+
+	a.pending.Remove(key)
+	var joined []byte
+	for _, p := range asm.parts {
+		joined = append(joined, p...)
+	}
+	return DecodeText(asm.coding, joined), true, nil
+}
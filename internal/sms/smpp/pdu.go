@@ -0,0 +1,325 @@
+// Package smpp implements the subset of SMPP 3.4 an ESME needs to exchange SMS with
+// an SMSC over a transceiver bind: bind, enquire_link, submit_sm, deliver_sm and unbind.
+// https://smpp.org/SMPP_v3_4_Issue1_2.pdf
+package smpp
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// Command IDs.
+const (
+	GenericNack            uint32 = 0x80000000
+	BindTransceiver        uint32 = 0x00000009
+	BindTransceiverResp    uint32 = 0x80000009
+	SubmitSM               uint32 = 0x00000004
+	SubmitSMResp           uint32 = 0x80000004
+	DeliverSM              uint32 = 0x00000005
+	DeliverSMResp          uint32 = 0x80000005
+	Unbind                 uint32 = 0x00000006
+	UnbindResp             uint32 = 0x80000006
+	EnquireLink            uint32 = 0x00000015
+	EnquireLinkResp        uint32 = 0x80000015
+	respMask               uint32 = 0x80000000
+	interfaceVersion       byte   = 0x34
+	headerLen                     = 16
+	maxPDULen                     = 64 << 10
+	cOctetStringMaxDefault        = 256
+)
+
+// Command statuses.
+const (
+	StatusOK           uint32 = 0x00000000
+	StatusInvalidCmdID uint32 = 0x00000003
+	StatusBindFailed   uint32 = 0x0000000D
+	StatusInvalidPass  uint32 = 0x0000000E
+	StatusSysErr       uint32 = 0x00000008
+	StatusThrottled    uint32 = 0x00000058
+)
+
+// Optional parameter tags.
+const (
+	TagReceiptedMessageID uint16 = 0x001E
+	TagMessagePayload     uint16 = 0x0424
+	TagMessageState       uint16 = 0x0427
+)
+
+// esm_class bits.
+const (
+	// EsmClassReceipt marks a deliver_sm carrying an SMSC delivery receipt.
+	EsmClassReceipt byte = 0x04
+	// EsmClassUDHI marks a short message starting with a user data header.
+	EsmClassUDHI     byte = 0x40
+	esmClassTypeMask      = 0x3C
+)
+
+// Data codings.
+const (
+	CodingDefault byte = 0x00
+	CodingIA5     byte = 0x01
+	CodingLatin1  byte = 0x03
+	CodingUCS2    byte = 0x08
+)
+
+// Type of number / numbering plan.
+const (
+	TONUnknown       byte = 0x00
+	TONInternational byte = 0x01
+	TONAlphanumeric  byte = 0x05
+	NPIUnknown       byte = 0x00
+	NPIISDN          byte = 0x01
+)
+
+// StatusError is a PDU answered with a non-zero command status.
+type StatusError uint32
+
+func (e StatusError) Error() string { return fmt.Sprintf("smpp: command status 0x%08X", uint32(e)) }
+
+var errMalformed = errors.New("smpp: malformed PDU")
+
+// PDU is a protocol data unit. Body is the encoded mandatory and optional parameters.
+type PDU struct {
+	CommandID uint32
+	Status    uint32
+	Sequence  uint32
+	Body      []byte
+}
+
+// WritePDU encodes the PDU to w.
+func WritePDU(w io.Writer, p *PDU) error {
+	buf := make([]byte, headerLen+len(p.Body))
+	binary.BigEndian.PutUint32(buf[0:], uint32(len(buf)))
+	binary.BigEndian.PutUint32(buf[4:], p.CommandID)
+	binary.BigEndian.PutUint32(buf[8:], p.Status)
+	binary.BigEndian.PutUint32(buf[12:], p.Sequence)
+	copy(buf[headerLen:], p.Body)
+	_, err := w.Write(buf)
+	return err
+}
+
+// ReadPDU decodes the next PDU from r.
+func ReadPDU(r io.Reader) (*PDU, error) {
+	var hdr [headerLen]byte
+	if _, err := io.ReadFull(r, hdr[:]); err != nil {
+		return nil, err
+	}
+	n := binary.BigEndian.Uint32(hdr[0:])
+	if n < headerLen || n > maxPDULen {
+		return nil, fmt.Errorf("%w: command_length %d", errMalformed, n)
+	}
+
+	p := &PDU{
+		CommandID: binary.BigEndian.Uint32(hdr[4:]),
+		Status:    binary.BigEndian.Uint32(hdr[8:]),
+		Sequence:  binary.BigEndian.Uint32(hdr[12:]),
+		Body:      make([]byte, n-headerLen),
+	}
+	if _, err := io.ReadFull(r, p.Body); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// -- body encoding --
+
+type encoder struct{ bytes.Buffer }
+
+func (e *encoder) cstring(s string) {
+	e.WriteString(s)
+	e.WriteByte(0)
+}
+
+func (e *encoder) byte(b byte) { e.WriteByte(b) }
+
+func (e *encoder) tlv(tag uint16, value []byte) {
+	var hdr [4]byte
+	binary.BigEndian.PutUint16(hdr[0:], tag)
+	binary.BigEndian.PutUint16(hdr[2:], uint16(len(value)))
+	e.Write(hdr[:])
+	e.Write(value)
+}
+
+type decoder struct {
+	buf []byte
+	err error
+}
+
+func (d *decoder) cstring() string {
+	if d.err != nil {
+		return ""
+	}
+	i := bytes.IndexByte(d.buf, 0)
+	if i < 0 || i >= cOctetStringMaxDefault {
+		d.err = errMalformed
+		return ""
+	}
+	s := string(d.buf[:i])
+	d.buf = d.buf[i+1:]
+	return s
+}
+
+func (d *decoder) byte() byte {
+	if d.err != nil {
+		return 0
+	}
+	if len(d.buf) < 1 {
+		d.err = errMalformed
+		return 0
+	}
+	b := d.buf[0]
+	d.buf = d.buf[1:]
+	return b
+}
+
+func (d *decoder) bytes(n int) []byte {
+	if d.err != nil {
+		return nil
+	}
+	if len(d.buf) < n {
+		d.err = errMalformed
+		return nil
+	}
+	b := d.buf[:n]
+	d.buf = d.buf[n:]
+	return b
+}
+
+// tlvs decodes the remaining optional parameters.
+func (d *decoder) tlvs() map[uint16][]byte {
+	out := map[uint16][]byte{}
+	for d.err == nil && len(d.buf) >= 4 {
+		tag := binary.BigEndian.Uint16(d.buf[0:])
+		n := int(binary.BigEndian.Uint16(d.buf[2:]))
+		d.buf = d.buf[4:]
+		out[tag] = d.bytes(n)
+	}
+	return out
+}
+
+// -- bodies --
+
+// Bind is the body of bind_transceiver.
+type Bind struct {
+	SystemID   string
+	Password   string
+	SystemType string
+}
+
+func (b *Bind) Encode() []byte {
+	var e encoder
+	e.cstring(b.SystemID)
+	e.cstring(b.Password)
+	e.cstring(b.SystemType)
+	e.byte(interfaceVersion)
+	e.byte(TONUnknown)
+	e.byte(NPIUnknown)
+	e.cstring("")
+	return e.Bytes()
+}
+
+func DecodeBind(body []byte) (*Bind, error) {
+	d := decoder{buf: body}
+	b := &Bind{SystemID: d.cstring(), Password: d.cstring(), SystemType: d.cstring()}
+	return b, d.err
+}
+
+// ShortMessage is the body of submit_sm and deliver_sm, which share their layout.
+type ShortMessage struct {
+	ServiceType        string
+	SourceTON          byte
+	SourceNPI          byte
+	Source             string
+	DestTON            byte
+	DestNPI            byte
+	Dest               string
+	EsmClass           byte
+	RegisteredDelivery byte
+	DataCoding         byte
+	// Message is the raw short_message, including the user data header when EsmClassUDHI is set.
+	Message []byte
+	// TLVs are the optional parameters.
+	TLVs map[uint16][]byte
+}
+
+func (m *ShortMessage) Encode() []byte {
+	var e encoder
+	e.cstring(m.ServiceType)
+	e.byte(m.SourceTON)
+	e.byte(m.SourceNPI)
+	e.cstring(m.Source)
+	e.byte(m.DestTON)
+	e.byte(m.DestNPI)
+	e.cstring(m.Dest)
+	e.byte(m.EsmClass)
+	e.byte(0) // protocol_id
+	e.byte(0) // priority_flag
+	e.cstring("")
+	e.cstring("")
+	e.byte(m.RegisteredDelivery)
+	e.byte(0) // replace_if_present_flag
+	e.byte(m.DataCoding)
+	e.byte(0) // sm_default_msg_id
+	e.byte(byte(len(m.Message)))
+	e.Write(m.Message)
+	for tag, v := range m.TLVs {
+		e.tlv(tag, v)
+	}
+	return e.Bytes()
+}
+
+func DecodeShortMessage(body []byte) (*ShortMessage, error) {
+	d := decoder{buf: body}
+	m := &ShortMessage{}
+	m.ServiceType = d.cstring()
+	m.SourceTON = d.byte()
+	m.SourceNPI = d.byte()
+	m.Source = d.cstring()
+	m.DestTON = d.byte()
+	m.DestNPI = d.byte()
+	m.Dest = d.cstring()
+	m.EsmClass = d.byte()
+	d.byte() // protocol_id
+	d.byte() // priority_flag
+	d.cstring()
+	d.cstring()
+	m.RegisteredDelivery = d.byte()
+	d.byte() // replace_if_present_flag
+	m.DataCoding = d.byte()
+	d.byte() // sm_default_msg_id
+	n := int(d.byte())
+	m.Message = append([]byte(nil), d.bytes(n)...)
+	m.TLVs = d.tlvs()
+	if d.err != nil {
+		return nil, d.err
+	}
+
+	// A message too long for short_message travels in message_payload.
+	if payload, ok := m.TLVs[TagMessagePayload]; ok && len(m.Message) == 0 {
+		m.Message = payload
+	}
+	return m, nil
+}
+
+// IsReceipt reports whether the message is an SMSC delivery receipt.
+func (m *ShortMessage) IsReceipt() bool { return m.EsmClass&esmClassTypeMask == EsmClassReceipt }
+
+// EncodeMessageID encodes the body of submit_sm_resp and deliver_sm_resp.
+func EncodeMessageID(id string) []byte {
+	var e encoder
+	e.cstring(id)
+	return e.Bytes()
+}
+
+// DecodeMessageID decodes the body of submit_sm_resp.
+func DecodeMessageID(body []byte) (string, error) {
+	if len(body) == 0 {
+		return "", nil
+	}
+	d := decoder{buf: body}
+	id := d.cstring()
+	return id, d.err
+}
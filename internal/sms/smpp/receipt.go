@@ -0,0 +1,63 @@
+package smpp
+
+import (
+	"regexp"
+	"strings"
+)
+
+// Receipt is an SMSC delivery receipt for a submitted message.
+type Receipt struct {
+	// MessageID is the ID the SMSC answered the submit_sm with.
+	MessageID string
+	// Stat is the final state as spelled in the receipt text: DELIVRD, EXPIRED, DELETED,
+	// UNDELIV, ACCEPTD, UNKNOWN, REJECTD or ENROUTE.
+	Stat string
+	// Err is the network specific error code, "000" and the like when there is none.
+	Err string
+}
+
+// messageStates names the message_state TLV values the way the receipt text does.
+var messageStates = map[byte]string{
+	1: "ENROUTE",
+	2: "DELIVRD",
+	3: "EXPIRED",
+	4: "DELETED",
+	5: "UNDELIV",
+	6: "ACCEPTD",
+	7: "UNKNOWN",
+	8: "REJECTD",
+}
+
+var (
+	receiptID   = regexp.MustCompile(`(?i)\bid:(\S+)`)
+	receiptStat = regexp.MustCompile(`(?i)\bstat:(\S+)`)
+	receiptErr  = regexp.MustCompile(`(?i)\berr:(\S+)`)
+)
+
+// ParseReceipt reads the receipt of a deliver_sm flagged as one. The receipted_message_id
+// and message_state TLVs take precedence over the de facto standard text format
+// "id:... sub:... dlvrd:... submit date:... done date:... stat:... err:... text:...".
+func ParseReceipt(m *ShortMessage) (*Receipt, bool) {
+	r := &Receipt{}
+	text := string(m.Message)
+	if match := receiptID.FindStringSubmatch(text); match != nil {
+		r.MessageID = match[1]
+	}
+	if match := receiptStat.FindStringSubmatch(text); match != nil {
+		r.Stat = strings.ToUpper(match[1])
+	}
+	if match := receiptErr.FindStringSubmatch(text); match != nil {
+		r.Err = match[1]
+	}
+
+	if id, ok := m.TLVs[TagReceiptedMessageID]; ok {
+		r.MessageID = strings.TrimRight(string(id), "\x00")
+	}
+	if state, ok := m.TLVs[TagMessageState]; ok && len(state) == 1 {
+		if stat, ok := messageStates[state[0]]; ok {
+			r.Stat = stat
+		}
+	}
+
+	return r, r.MessageID != "" && r.Stat != ""
+}
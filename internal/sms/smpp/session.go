@@ -0,0 +1,318 @@
+package smpp
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+const (
+	// defaultTimeout bounds the wait for the response to a request.
+	defaultTimeout = 10 * time.Second
+	// defaultEnquireInterval is the period of the enquire_link keepalive.
+	defaultEnquireInterval = 30 * time.Second
+)
+
+// ErrClosed is returned by requests on a closed session.
+var ErrClosed = errors.New("smpp: session closed")
+
+// Handler processes a deliver_sm: an inbound message or a delivery receipt. An error
+// is answered with a system error status, so the SMSC delivers the PDU again later.
+type Handler func(ctx context.Context, m *ShortMessage) error
+
+// Config is the SMSC connection of a session.
+type Config struct {
+	// Addr is the host:port of the SMSC.
+	Addr string
+	// TLS wraps the connection when set.
+	TLS  *tls.Config
+	Bind Bind
+	// Timeout bounds the wait for responses, 10s when zero.
+	Timeout time.Duration
+	// EnquireInterval is the keepalive period, 30s when zero.
+	EnquireInterval time.Duration
+}
+
+// Session is a bound transceiver session. It is safe for concurrent use.
+type Session struct {
+	conn    net.Conn
+	handler Handler
+	timeout time.Duration
+
+	wmu sync.Mutex
+	seq atomic.Uint32
+	ref atomic.Uint32
+
+	mu      sync.Mutex
+	pending map[uint32]chan *PDU
+
+	closeOnce sync.Once
+	done      chan struct{}
+	err       error
+}
+
+// Dial connects to the SMSC and binds as a transceiver. Inbound PDUs are passed to
+// handler on their own goroutines until the session is closed.
+func Dial(ctx context.Context, cfg Config, handler Handler) (*Session, error) {
+	var d net.Dialer
+	conn, err := d.DialContext(ctx, "tcp", cfg.Addr)
+	if err != nil {
+		return nil, fmt.Errorf("smpp: dial %s: %w", cfg.Addr, err)
+	}
+	if cfg.TLS != nil {
+		conn = tls.Client(conn, cfg.TLS)
+	}
+
+	s := &Session{
+		conn:    conn,
+		handler: handler,
+		timeout: cfg.Timeout,
+		pending: make(map[uint32]chan *PDU),
+		done:    make(chan struct{}),
+	}
+	if s.timeout == 0 {
+		s.timeout = defaultTimeout
+	}
+	go s.read()
+
+	resp, err := s.request(ctx, BindTransceiver, cfg.Bind.Encode())
+	if err == nil && resp.Status != StatusOK {
+		err = StatusError(resp.Status)
+	}
+	if err != nil {
+		s.closeWith(err)
+		return nil, fmt.Errorf("smpp: bind %s: %w", cfg.Bind.SystemID, err)
+	}
+
+	interval := cfg.EnquireInterval
+	if interval == 0 {
+		interval = defaultEnquireInterval
+	}
+	go s.keepalive(interval)
+	return s, nil
+}
+
+// Done is closed when the session ends; Err then tells why.
+func (s *Session) Done() <-chan struct{} { return s.done }
+
+// Err returns the reason the session ended, nil while it is open.
+func (s *Session) Err() error {
+	select {
+	case <-s.done:
+		return s.err
+	default:
+		return nil
+	}
+}
+
+// Close unbinds and closes the connection.
+func (s *Session) Close() error {
+	select {
+	case <-s.done:
+		return nil
+	default:
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	_, _ = s.request(ctx, Unbind, nil)
+	s.closeWith(ErrClosed)
+	return nil
+}
+
+// Submit sends a submit_sm and returns the message ID the SMSC assigned.
+func (s *Session) Submit(ctx context.Context, m *ShortMessage) (string, error) {
+	resp, err := s.request(ctx, SubmitSM, m.Encode())
+	if err != nil {
+		return "", err
+	}
+	if resp.Status != StatusOK {
+		return "", StatusError(resp.Status)
+	}
+	return DecodeMessageID(resp.Body)
+}
+
+// SendText submits the text from source to dest, split into concatenated parts when it
+// does not fit into one, and returns the SMSC message IDs of the parts. With receipt set
+// the SMSC is asked for a delivery receipt of every part.
+func (s *Session) SendText(ctx context.Context, source, dest, text string, receipt bool) ([]string, error) {
+	coding, parts, err := EncodeText(text)
+	if err != nil {
+		return nil, err
+	}
+
+	m := &ShortMessage{DataCoding: coding}
+	m.SourceTON, m.SourceNPI, m.Source = Address(source)
+	m.DestTON, m.DestNPI, m.Dest = Address(dest)
+	if receipt {
+		m.RegisteredDelivery = 1
+	}
+
+	ref := byte(s.ref.Add(1))
+	ids := make([]string, 0, len(parts))
+	for i, part := range parts {
+		m.Message = part
+		if len(parts) > 1 {
+			m.EsmClass = EsmClassUDHI
+			m.Message = append(concatUDH(ref, byte(len(parts)), byte(i+1)), part...)
+		}
+		id, err := s.Submit(ctx, m)
+		if err != nil {
+			return ids, fmt.Errorf("smpp: submit part %d of %d: %w", i+1, len(parts), err)
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
+
+// Address returns the TON, NPI and digits of an address: "+" marks an international
+// number, letters an alphanumeric sender ID.
+func Address(addr string) (ton, npi byte, value string) {
+	if digits, ok := strings.CutPrefix(addr, "+"); ok {
+		return TONInternational, NPIISDN, digits
+	}
+	for _, r := range addr {
+		if r < '0' || r > '9' {
+			return TONAlphanumeric, NPIUnknown, addr
+		}
+	}
+	return TONUnknown, NPIISDN, addr
+}
+
+// FormatAddress is the inverse of Address: international numbers get their "+" back.
+func FormatAddress(ton byte, value string) string {
+	if ton == TONInternational && !strings.HasPrefix(value, "+") {
+		return "+" + value
+	}
+	return value
+}
+
+func (s *Session) request(ctx context.Context, cmd uint32, body []byte) (*PDU, error) {
+	seq := s.seq.Add(1)
+	ch := make(chan *PDU, 1)
+
+	s.mu.Lock()
+	s.pending[seq] = ch
+	s.mu.Unlock()
+	defer func() {
+		s.mu.Lock()
+		delete(s.pending, seq)
+		s.mu.Unlock()
+	}()
+
+	if err := s.write(&PDU{CommandID: cmd, Sequence: seq, Body: body}); err != nil {
+		return nil, err
+	}
+
+	timer := time.NewTimer(s.timeout)
+	defer timer.Stop()
+	select {
+	case resp := <-ch:
+		if resp.CommandID == GenericNack {
+			return nil, StatusError(resp.Status)
+		}
+		return resp, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case <-s.done:
+		return nil, s.err
+	case <-timer.C:
+		return nil, fmt.Errorf("smpp: command 0x%08X: response timeout", cmd)
+	}
+}
+
+func (s *Session) write(p *PDU) error {
+	s.wmu.Lock()
+	defer s.wmu.Unlock()
+
+	select {
+	case <-s.done:
+		return s.err
+	default:
+	}
+	_ = s.conn.SetWriteDeadline(time.Now().Add(s.timeout))
+	if err := WritePDU(s.conn, p); err != nil {
+		s.closeWith(err)
+		return err
+	}
+	return nil
+}
+
+func (s *Session) read() {
+	for {
+		p, err := ReadPDU(s.conn)
+		if err != nil {
+			s.closeWith(fmt.Errorf("smpp: read: %w", err))
+			return
+		}
+
+		if p.CommandID&respMask != 0 {
+			s.mu.Lock()
+			ch, ok := s.pending[p.Sequence]
+			s.mu.Unlock()
+			if ok {
+				ch <- p
+			}
+			continue
+		}
+
+		switch p.CommandID {
+		case DeliverSM:
+			go s.deliver(p)
+		case EnquireLink:
+			_ = s.write(&PDU{CommandID: EnquireLinkResp, Sequence: p.Sequence})
+		case Unbind:
+			_ = s.write(&PDU{CommandID: UnbindResp, Sequence: p.Sequence})
+			s.closeWith(fmt.Errorf("smpp: unbound by the SMSC"))
+			return
+		default:
+			_ = s.write(&PDU{CommandID: GenericNack, Status: StatusInvalidCmdID, Sequence: p.Sequence})
+		}
+	}
+}
+
+func (s *Session) deliver(p *PDU) {
+	status := StatusOK
+	m, err := DecodeShortMessage(p.Body)
+	if err == nil {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+		err = s.handler(ctx, m)
+		cancel()
+	}
+	if err != nil {
+		status = StatusSysErr
+	}
+	_ = s.write(&PDU{CommandID: DeliverSMResp, Status: status, Sequence: p.Sequence, Body: EncodeMessageID("")})
+}
+
+// keepalive sends enquire_link every interval and ends the session when the SMSC
+// stops answering.
+func (s *Session) keepalive(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-s.done:
+			return
+		case <-ticker.C:
+			if _, err := s.request(context.Background(), EnquireLink, nil); err != nil {
+				s.closeWith(fmt.Errorf("smpp: enquire_link: %w", err))
+				return
+			}
+		}
+	}
+}
+
+func (s *Session) closeWith(err error) {
+	s.closeOnce.Do(func() {
+		s.err = err
+		close(s.done)
+		_ = s.conn.Close()
+	})
+}
@@ -0,0 +1,155 @@
+package smpp_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/webitel/im-providers-service/internal/sms/smpp"
+	"github.com/webitel/im-providers-service/internal/sms/smpp/smpptest"
+)
+
+func startSimulator(t *testing.T) *smpptest.Simulator {
+	t.Helper()
+	sim, err := smpptest.NewSimulator("esme", "secret")
+	if err != nil {
+		t.Fatalf("NewSimulator: %v", err)
+	}
+	t.Cleanup(sim.Close)
+	return sim
+}
+
+func dial(t *testing.T, sim *smpptest.Simulator, handler smpp.Handler) *smpp.Session {
+	t.Helper()
+	s, err := smpp.Dial(context.Background(), smpp.Config{
+		Addr:    sim.Addr,
+		Bind:    smpp.Bind{SystemID: "esme", Password: "secret"},
+		Timeout: time.Second,
+	}, handler)
+	if err != nil {
+		t.Fatalf("Dial: %v", err)
+	}
+	t.Cleanup(func() { _ = s.Close() })
+	return s
+}
+
+func TestBindRejected(t *testing.T) {
+	sim := startSimulator(t)
+
+	_, err := smpp.Dial(context.Background(), smpp.Config{
+		Addr: sim.Addr,
+		Bind: smpp.Bind{SystemID: "esme", Password: "wrong"},
+	}, nil)
+	var status smpp.StatusError
+	if !errors.As(err, &status) || uint32(status) != smpp.StatusInvalidPass {
+		t.Errorf("err = %v, want invalid password status", err)
+	}
+}
+
+func TestSendTextConcatenatesUCS2(t *testing.T) {
+	sim := startSimulator(t)
+	s := dial(t, sim, nil)
+
+	text := ""
+	for range 100 {
+		text += "ї"
+	}
+	ids, err := s.SendText(context.Background(), "Webitel", "+380501234567", text, true)
+	if err != nil {
+		t.Fatalf("SendText: %v", err)
+	}
+	if len(ids) != 2 || ids[0] != "msg-1" || ids[1] != "msg-2" {
+		t.Fatalf("ids = %v", ids)
+	}
+
+	sub := sim.Submitted()
+	for i, m := range sub {
+		concat, payload, err := smpp.SplitUDH(m.Message)
+		if err != nil || concat == nil || concat.Total != 2 || concat.Seq != byte(i+1) {
+			t.Fatalf("part %d: udh = %+v, err = %v", i+1, concat, err)
+		}
+		if m.DataCoding != smpp.CodingUCS2 || m.EsmClass != smpp.EsmClassUDHI || m.RegisteredDelivery != 1 {
+			t.Errorf("part %d: coding = %#x, esm = %#x", i+1, m.DataCoding, m.EsmClass)
+		}
+		if m.Dest != "380501234567" || m.DestTON != smpp.TONInternational || m.SourceTON != smpp.TONAlphanumeric {
+			t.Errorf("part %d: addressing %q ton %d / %q ton %d", i+1, m.Source, m.SourceTON, m.Dest, m.DestTON)
+		}
+		if i == 0 && len(payload) != 134 {
+			t.Errorf("first part carries %d bytes", len(payload))
+		}
+	}
+}
+
+func TestSubmitRejected(t *testing.T) {
+	sim := startSimulator(t)
+	s := dial(t, sim, nil)
+	sim.SetSubmitStatus(smpp.StatusThrottled)
+
+	_, err := s.SendText(context.Background(), "Webitel", "+380501234567", "hi", false)
+	var status smpp.StatusError
+	if !errors.As(err, &status) || uint32(status) != smpp.StatusThrottled {
+		t.Errorf("err = %v, want throttled status", err)
+	}
+}
+
+func TestDeliverSM(t *testing.T) {
+	sim := startSimulator(t)
+
+	var (
+		mu       sync.Mutex
+		received []*smpp.ShortMessage
+		fail     bool
+	)
+	dial(t, sim, func(_ context.Context, m *smpp.ShortMessage) error {
+		mu.Lock()
+		defer mu.Unlock()
+		received = append(received, m)
+		if fail {
+			return errors.New("boom")
+		}
+		return nil
+	})
+
+	if status, err := sim.DeliverText("+380501234567", "Webitel", "hello"); err != nil || status != smpp.StatusOK {
+		t.Fatalf("status = %#x, err = %v", status, err)
+	}
+	mu.Lock()
+	fail = true
+	mu.Unlock()
+	if status, err := sim.DeliverReceipt("msg-1", "DELIVRD", "000"); err != nil || status != smpp.StatusSysErr {
+		t.Fatalf("failed handler answered %#x, err = %v", status, err)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	if len(received) != 2 {
+		t.Fatalf("received %d messages", len(received))
+	}
+	if m := received[0]; m.IsReceipt() || string(m.Message) != "hello" ||
+		smpp.FormatAddress(m.SourceTON, m.Source) != "+380501234567" {
+		t.Errorf("message = %+v", m)
+	}
+	if r, ok := smpp.ParseReceipt(received[1]); !received[1].IsReceipt() || !ok || r.MessageID != "msg-1" {
+		t.Errorf("receipt = %+v", r)
+	}
+}
+
+func TestSessionEndsWhenConnectionDrops(t *testing.T) {
+	sim := startSimulator(t)
+	s := dial(t, sim, nil)
+
+	sim.DropConnections()
+	select {
+	case <-s.Done():
+	case <-time.After(2 * time.Second):
+		t.Fatal("session still open")
+	}
+	if s.Err() == nil {
+		t.Error("no reason for the closed session")
+	}
+	if _, err := s.SendText(context.Background(), "a", "1", "hi", false); err == nil {
+		t.Error("send on a closed session succeeded")
+	}
+}
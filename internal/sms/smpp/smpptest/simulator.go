@@ -0,0 +1,215 @@
+// Package smpptest provides a local SMSC simulator for tests of SMPP clients.
+package smpptest
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/webitel/im-providers-service/internal/sms/smpp"
+)
+
+// Simulator is an SMSC accepting transceiver binds on a loopback port. It records the
+// submitted messages, answers them with sequential message IDs and lets the test push
+// deliver_sm PDUs to the bound sessions.
+type Simulator struct {
+	// Addr is the host:port to dial.
+	Addr     string
+	systemID string
+	password string
+	ln       net.Listener
+
+	mu        sync.Mutex
+	conns     []*conn
+	submitted []*smpp.ShortMessage
+	binds     int
+	nextID    int
+	// submitStatus answers every submit_sm when not zero.
+	submitStatus uint32
+}
+
+type conn struct {
+	net.Conn
+	wmu     sync.Mutex
+	seq     uint32
+	bound   bool
+	pending map[uint32]chan *smpp.PDU
+}
+
+// NewSimulator listens on a loopback port and accepts binds with the given credentials.
+func NewSimulator(systemID, password string) (*Simulator, error) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return nil, err
+	}
+	s := &Simulator{Addr: ln.Addr().String(), systemID: systemID, password: password, ln: ln}
+	go s.accept()
+	return s, nil
+}
+
+// Close stops listening and drops every connection.
+func (s *Simulator) Close() {
+	_ = s.ln.Close()
+	s.DropConnections()
+}
+
+// DropConnections closes the connections of the bound sessions, as a network failure would.
+func (s *Simulator) DropConnections() {
+	s.mu.Lock()
+	conns := s.conns
+	s.conns = nil
+	s.mu.Unlock()
+	for _, c := range conns {
+		_ = c.Close()
+	}
+}
+
+// Binds returns the number of successful binds so far.
+func (s *Simulator) Binds() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.binds
+}
+
+// Submitted returns the submit_sm messages received so far.
+func (s *Simulator) Submitted() []*smpp.ShortMessage {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]*smpp.ShortMessage(nil), s.submitted...)
+}
+
+// SetSubmitStatus makes the simulator answer submit_sm with the status, 0 to accept again.
+func (s *Simulator) SetSubmitStatus(status uint32) {
+	s.mu.Lock()
+	s.submitStatus = status
+	s.mu.Unlock()
+}
+
+// Deliver sends a deliver_sm to the most recently bound session and returns the
+// command status it was answered with.
+func (s *Simulator) Deliver(m *smpp.ShortMessage) (uint32, error) {
+	s.mu.Lock()
+	var c *conn
+	for i := len(s.conns) - 1; i >= 0; i-- {
+		if s.conns[i].bound {
+			c = s.conns[i]
+			break
+		}
+	}
+	s.mu.Unlock()
+	if c == nil {
+		return 0, errors.New("smpptest: no bound session")
+	}
+
+	ch := make(chan *smpp.PDU, 1)
+	c.wmu.Lock()
+	c.seq++
+	seq := c.seq
+	c.pending[seq] = ch
+	err := smpp.WritePDU(c, &smpp.PDU{CommandID: smpp.DeliverSM, Sequence: seq, Body: m.Encode()})
+	c.wmu.Unlock()
+	if err != nil {
+		return 0, err
+	}
+
+	select {
+	case resp := <-ch:
+		return resp.Status, nil
+	case <-time.After(5 * time.Second):
+		return 0, errors.New("smpptest: deliver_sm_resp timeout")
+	}
+}
+
+// DeliverText sends a single-part inbound message in the GSM default alphabet.
+func (s *Simulator) DeliverText(source, dest, text string) (uint32, error) {
+	m := &smpp.ShortMessage{Message: []byte(text)}
+	m.SourceTON, m.SourceNPI, m.Source = smpp.Address(source)
+	m.DestTON, m.DestNPI, m.Dest = smpp.Address(dest)
+	return s.Deliver(m)
+}
+
+// DeliverReceipt sends a delivery receipt in the text format for a submitted message ID.
+func (s *Simulator) DeliverReceipt(messageID, stat, errCode string) (uint32, error) {
+	text := fmt.Sprintf("id:%s sub:001 dlvrd:001 submit date:2601011200 done date:2601011201 stat:%s err:%s text:", messageID, stat, errCode)
+	return s.Deliver(&smpp.ShortMessage{EsmClass: smpp.EsmClassReceipt, Message: []byte(text)})
+}
+
+func (s *Simulator) accept() {
+	for {
+		nc, err := s.ln.Accept()
+		if err != nil {
+			return
+		}
+		c := &conn{Conn: nc, pending: make(map[uint32]chan *smpp.PDU)}
+		s.mu.Lock()
+		s.conns = append(s.conns, c)
+		s.mu.Unlock()
+		go s.serve(c)
+	}
+}
+
+func (s *Simulator) serve(c *conn) {
+	defer c.Close()
+	for {
+		p, err := smpp.ReadPDU(c)
+		if err != nil {
+			return
+		}
+
+		resp := &smpp.PDU{CommandID: p.CommandID | 0x80000000, Sequence: p.Sequence}
+		switch p.CommandID {
+		case smpp.BindTransceiver:
+			bind, err := smpp.DecodeBind(p.Body)
+			switch {
+			case err != nil:
+				resp.Status = smpp.StatusBindFailed
+			case bind.SystemID != s.systemID || bind.Password != s.password:
+				resp.Status = smpp.StatusInvalidPass
+			default:
+				s.mu.Lock()
+				c.bound = true
+				s.binds++
+				s.mu.Unlock()
+				resp.Body = smpp.EncodeMessageID("smpptest")
+			}
+		case smpp.SubmitSM:
+			m, err := smpp.DecodeShortMessage(p.Body)
+			s.mu.Lock()
+			switch {
+			case err != nil:
+				resp.Status = smpp.StatusSysErr
+			case s.submitStatus != 0:
+				resp.Status = s.submitStatus
+			default:
+				s.nextID++
+				s.submitted = append(s.submitted, m)
+				resp.Body = smpp.EncodeMessageID(fmt.Sprintf("msg-%d", s.nextID))
+			}
+			s.mu.Unlock()
+		case smpp.EnquireLink:
+		case smpp.Unbind:
+			s.write(c, resp)
+			return
+		case smpp.DeliverSMResp:
+			c.wmu.Lock()
+			ch, ok := c.pending[p.Sequence]
+			delete(c.pending, p.Sequence)
+			c.wmu.Unlock()
+			if ok {
+				ch <- p
+			}
+			continue
+		default:
+			resp = &smpp.PDU{CommandID: smpp.GenericNack, Status: smpp.StatusInvalidCmdID, Sequence: p.Sequence}
+		}
+		s.write(c, resp)
+	}
+}
+
+func (s *Simulator) write(c *conn, p *smpp.PDU) {
+	c.wmu.Lock()
+	defer c.wmu.Unlock()
+	_ = smpp.WritePDU(c, p)
+}
@@ -0,0 +1,197 @@
+package smpp
+
+import (
+	"errors"
+	"strings"
+	"unicode/utf16"
+)
+
+// Message size limits in characters: septets for the GSM default alphabet,
+// UTF-16 code units for UCS-2. A concatenated part gives up room for the UDH.
+const (
+	gsmSingleLimit  = 160
+	gsmPartLimit    = 153
+	ucs2SingleLimit = 70
+	ucs2PartLimit   = 67
+	maxParts        = 255
+)
+
+// ErrTooLong is returned for a text that does not fit into 255 concatenated parts.
+var ErrTooLong = errors.New("smpp: text too long")
+
+const gsmEscape = 0x1B
+
+// gsmBasic is the GSM 03.38 default alphabet indexed by septet; 0x1B escapes to gsmExtension.
+var gsmBasic = []rune("@£$¥èéùìòÇ\nØø\rÅåΔ_ΦΓΛΩΠΨΣΘΞ\x1bÆæßÉ !\"#¤%&'()*+,-./0123456789:;<=>?" +
+	"¡ABCDEFGHIJKLMNOPQRSTUVWXYZÄÖÑÜ§¿abcdefghijklmnopqrstuvwxyzäöñüà")
+
+var gsmExtension = map[byte]rune{
+	0x0A: '\f', 0x14: '^', 0x28: '{', 0x29: '}', 0x2F: '\\',
+	0x3C: '[', 0x3D: '~', 0x3E: ']', 0x40: '|', 0x65: '€',
+}
+
+var (
+	gsmBasicIndex     = map[rune]byte{}
+	gsmExtensionIndex = map[rune]byte{}
+)
+
+func init() {
+	for i, r := range gsmBasic {
+		if i != gsmEscape {
+			gsmBasicIndex[r] = byte(i)
+		}
+	}
+	for b, r := range gsmExtension {
+		gsmExtensionIndex[r] = b
+	}
+}
+
+// gsmSeptets returns the septets of r, and false when r is not in the GSM alphabet.
+func gsmSeptets(r rune) ([]byte, bool) {
+	if b, ok := gsmBasicIndex[r]; ok {
+		return []byte{b}, true
+	}
+	if b, ok := gsmExtensionIndex[r]; ok {
+		return []byte{gsmEscape, b}, true
+	}
+	return nil, false
+}
+
+// EncodeText encodes text in the GSM default alphabet when it can, in UCS-2 otherwise,
+// and splits it into the payloads of as many parts as it needs. GSM septets are sent
+// unpacked, one per octet, as SMPP expects for data_coding 0. A part never ends in the
+// middle of an escape sequence or a surrogate pair.
+func EncodeText(text string) (coding byte, parts [][]byte, err error) {
+	if gsm, ok := encodeGSM(text); ok {
+		parts, err = split(gsm, gsmSingleLimit, gsmPartLimit, func(b []byte, i int) int {
+			if b[i] == gsmEscape {
+				return 2
+			}
+			return 1
+		}, 1)
+		return CodingDefault, parts, err
+	}
+
+	units := utf16.Encode([]rune(text))
+	ucs2 := make([]byte, 0, 2*len(units))
+	for _, u := range units {
+		ucs2 = append(ucs2, byte(u>>8), byte(u))
+	}
+	parts, err = split(ucs2, ucs2SingleLimit, ucs2PartLimit, func(b []byte, i int) int {
+		if utf16.IsSurrogate(rune(b[i])<<8 | rune(b[i+1])) {
+			return 4
+		}
+		return 2
+	}, 2)
+	return CodingUCS2, parts, err
+}
+
+func encodeGSM(text string) ([]byte, bool) {
+	out := make([]byte, 0, len(text))
+	for _, r := range text {
+		s, ok := gsmSeptets(r)
+		if !ok {
+			return nil, false
+		}
+		out = append(out, s...)
+	}
+	return out, true
+}
+
+// split cuts the encoded text into parts of at most partLimit characters of unit bytes,
+// or returns it whole when it fits into singleLimit. step returns the byte length of
+// the character at i, which is kept in one part.
+func split(encoded []byte, singleLimit, partLimit int, step func(b []byte, i int) int, unit int) ([][]byte, error) {
+	if len(encoded) <= singleLimit*unit {
+		return [][]byte{encoded}, nil
+	}
+
+	var parts [][]byte
+	for start := 0; start < len(encoded); {
+		end := start
+		for end < len(encoded) {
+			n := step(encoded, end)
+			if end+n-start > partLimit*unit {
+				break
+			}
+			end += n
+		}
+		parts = append(parts, encoded[start:end])
+		start = end
+	}
+	if len(parts) > maxParts {
+		return nil, ErrTooLong
+	}
+	return parts, nil
+}
+
+// DecodeText decodes a short message payload, without its UDH, in the given data coding.
+// Codings other than GSM and UCS-2 are read as Latin-1, which covers IA5 as well.
+func DecodeText(coding byte, payload []byte) string {
+	var b strings.Builder
+	switch coding {
+	case CodingDefault:
+		for i := 0; i < len(payload); i++ {
+			c := payload[i] & 0x7F
+			if c == gsmEscape && i+1 < len(payload) {
+				i++
+				if r, ok := gsmExtension[payload[i]&0x7F]; ok {
+					b.WriteRune(r)
+				} else {
+					b.WriteRune(' ')
+				}
+				continue
+			}
+			b.WriteRune(gsmBasic[c])
+		}
+	case CodingUCS2:
+		units := make([]uint16, 0, len(payload)/2)
+		for i := 0; i+1 < len(payload); i += 2 {
+			units = append(units, uint16(payload[i])<<8|uint16(payload[i+1]))
+		}
+		b.WriteString(string(utf16.Decode(units)))
+	default:
+		for _, c := range payload {
+			b.WriteRune(rune(c))
+		}
+	}
+	return b.String()
+}
+
+// Concat identifies a part of a concatenated message.
+type Concat struct {
+	Ref   uint16
+	Total byte
+	Seq   byte
+}
+
+// concatUDH returns the user data header of part seq of total, using an 8-bit reference.
+func concatUDH(ref, total, seq byte) []byte {
+	return []byte{0x05, 0x00, 0x03, ref, total, seq}
+}
+
+// SplitUDH separates the user data header from the short message. It returns the
+// concatenation info the header carries, if any, and the payload after the header.
+func SplitUDH(msg []byte) (*Concat, []byte, error) {
+	if len(msg) == 0 || int(msg[0])+1 > len(msg) {
+		return nil, nil, errMalformed
+	}
+	hdr, payload := msg[1:1+int(msg[0])], msg[1+int(msg[0]):]
+
+	var concat *Concat
+	for len(hdr) >= 2 {
+		iei, n := hdr[0], int(hdr[1])
+		if len(hdr) < 2+n {
+			return nil, nil, errMalformed
+		}
+		ie := hdr[2 : 2+n]
+		switch {
+		case iei == 0x00 && n == 3:
+			concat = &Concat{Ref: uint16(ie[0]), Total: ie[1], Seq: ie[2]}
+		case iei == 0x08 && n == 4:
+			concat = &Concat{Ref: uint16(ie[0])<<8 | uint16(ie[1]), Total: ie[2], Seq: ie[3]}
+		}
+		hdr = hdr[2+n:]
+	}
+	return concat, payload, nil
+}
@@ -0,0 +1,143 @@
+package smpp
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestEncodeTextGSM(t *testing.T) {
+	coding, parts, err := EncodeText("Hi {there} €5")
+	if err != nil {
+		t.Fatalf("EncodeText: %v", err)
+	}
+	if coding != CodingDefault || len(parts) != 1 {
+		t.Fatalf("coding = %#x, parts = %d", coding, len(parts))
+	}
+	want := []byte{'H', 'i', ' ', 0x1B, 0x28, 't', 'h', 'e', 'r', 'e', 0x1B, 0x29, ' ', 0x1B, 0x65, '5'}
+	if !bytes.Equal(parts[0], want) {
+		t.Errorf("septets = % x, want % x", parts[0], want)
+	}
+	if got := DecodeText(coding, parts[0]); got != "Hi {there} €5" {
+		t.Errorf("round trip = %q", got)
+	}
+}
+
+func TestEncodeTextConcatenation(t *testing.T) {
+	tests := []struct {
+		name      string
+		text      string
+		coding    byte
+		parts     int
+		partBytes int
+	}{
+		{"gsm single", strings.Repeat("a", 160), CodingDefault, 1, 160},
+		{"gsm split", strings.Repeat("a", 161), CodingDefault, 2, 153},
+		{"ucs2 single", strings.Repeat("я", 70), CodingUCS2, 1, 140},
+		{"ucs2 split", strings.Repeat("я", 71), CodingUCS2, 2, 134},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			coding, parts, err := EncodeText(tt.text)
+			if err != nil {
+				t.Fatalf("EncodeText: %v", err)
+			}
+			if coding != tt.coding || len(parts) != tt.parts || len(parts[0]) != tt.partBytes {
+				t.Errorf("coding = %#x, parts = %d, first = %d bytes", coding, len(parts), len(parts[0]))
+			}
+		})
+	}
+}
+
+func TestEncodeTextKeepsCharactersWhole(t *testing.T) {
+	// 152 septets and an escape sequence: the escape moves to the second part.
+	_, parts, _ := EncodeText(strings.Repeat("a", 152) + "€" + strings.Repeat("b", 10))
+	if len(parts[0]) != 152 || parts[1][0] != 0x1B {
+		t.Errorf("escape split across parts: %d, % x", len(parts[0]), parts[1][:2])
+	}
+
+	// 66 code units and a surrogate pair: the pair moves to the second part.
+	_, parts, _ = EncodeText(strings.Repeat("я", 66) + "😀" + strings.Repeat("я", 10))
+	if len(parts[0]) != 132 {
+		t.Errorf("surrogate pair split across parts: first part %d bytes", len(parts[0]))
+	}
+	var joined []byte
+	for _, p := range parts {
+		joined = append(joined, p...)
+	}
+	if got := DecodeText(CodingUCS2, joined); got != strings.Repeat("я", 66)+"😀"+strings.Repeat("я", 10) {
+		t.Errorf("round trip = %q", got)
+	}
+}
+
+func TestEncodeTextTooLong(t *testing.T) {
+	if _, _, err := EncodeText(strings.Repeat("я", 67*255+1)); err != ErrTooLong {
+		t.Errorf("err = %v, want ErrTooLong", err)
+	}
+}
+
+func TestAssembler(t *testing.T) {
+	a := NewAssembler()
+	part := func(seq byte, text string) *ShortMessage {
+		return &ShortMessage{
+			Source:   "380501234567",
+			EsmClass: EsmClassUDHI,
+			Message:  append(concatUDH(7, 2, seq), text...),
+		}
+	}
+
+	if _, complete, err := a.Add(part(2, "world")); complete || err != nil {
+		t.Fatalf("complete = %v, err = %v after one part", complete, err)
+	}
+	text, complete, err := a.Add(part(1, "hello "))
+	if err != nil || !complete || text != "hello world" {
+		t.Errorf("text = %q, complete = %v, err = %v", text, complete, err)
+	}
+
+	if _, _, err := a.Add(part(3, "x")); err == nil {
+		t.Error("part beyond total accepted")
+	}
+}
+
+func TestParseReceipt(t *testing.T) {
+	m := &ShortMessage{
+		EsmClass: EsmClassReceipt,
+		Message:  []byte("id:A1B2 sub:001 dlvrd:000 submit date:2601011200 done date:2601011201 stat:UNDELIV err:011 text:hello"),
+	}
+	r, ok := ParseReceipt(m)
+	if !ok || r.MessageID != "A1B2" || r.Stat != "UNDELIV" || r.Err != "011" {
+		t.Errorf("receipt = %+v, ok = %v", r, ok)
+	}
+
+	m.TLVs = map[uint16][]byte{TagReceiptedMessageID: []byte("a1b2\x00"), TagMessageState: {2}}
+	if r, _ := ParseReceipt(m); r.MessageID != "a1b2" || r.Stat != "DELIVRD" {
+		t.Errorf("TLVs ignored: %+v", r)
+	}
+
+	if _, ok := ParseReceipt(&ShortMessage{EsmClass: EsmClassReceipt, Message: []byte("garbage")}); ok {
+		t.Error("receipt without id parsed")
+	}
+}
+
+func TestShortMessageRoundTrip(t *testing.T) {
+	in := &ShortMessage{
+		SourceTON: TONInternational, SourceNPI: NPIISDN, Source: "380501234567",
+		Dest: "Webitel", DestTON: TONAlphanumeric,
+		EsmClass: EsmClassUDHI, RegisteredDelivery: 1, DataCoding: CodingUCS2,
+		Message: []byte{0x05, 0x00, 0x03, 1, 2, 1, 0x04, 0x4F},
+		TLVs:    map[uint16][]byte{TagMessageState: {2}},
+	}
+	out, err := DecodeShortMessage(in.Encode())
+	if err != nil {
+		t.Fatalf("DecodeShortMessage: %v", err)
+	}
+	if out.Source != in.Source || out.Dest != in.Dest || out.DestTON != in.DestTON ||
+		out.EsmClass != in.EsmClass || out.RegisteredDelivery != 1 || out.DataCoding != CodingUCS2 ||
+		!bytes.Equal(out.Message, in.Message) || !bytes.Equal(out.TLVs[TagMessageState], []byte{2}) {
+		t.Errorf("round trip = %+v", out)
+	}
+
+	if _, err := DecodeShortMessage([]byte{0, 1}); err == nil {
+		t.Error("truncated body decoded")
+	}
+}
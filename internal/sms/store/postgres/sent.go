@@ -0,0 +1,59 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/georgysavva/scany/v2/pgxscan"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	sharedstore "github.com/webitel/im-providers-service/internal/core/store"
+	smsmodel "github.com/webitel/im-providers-service/internal/sms/model"
+	smsstore "github.com/webitel/im-providers-service/internal/sms/store"
+)
+
+var _ smsstore.SentMessageStore = (*sentMessageStore)(nil)
+
+type sentMessageStore struct {
+	pool *pgxpool.Pool
+}
+
+func NewSentMessageStore(pool *pgxpool.Pool) smsstore.SentMessageStore {
+	return &sentMessageStore{pool: pool}
+}
+
+func (s *sentMessageStore) InsertSent(ctx context.Context, msgs []*smsmodel.SentMessage) error {
+	const query = `
+	INSERT INTO im_provider.sms_sent (gate_id, external_id, message_id, recipient, status)
+	VALUES ($1, $2, $3, $4, $5)
+	ON CONFLICT (gate_id, external_id) DO NOTHING`
+
+	batch := &pgx.Batch{}
+	for _, m := range msgs {
+		batch.Queue(query, m.GateID, m.ExternalID, m.MessageID, m.Recipient, m.Status)
+	}
+	if err := s.pool.SendBatch(ctx, batch).Close(); err != nil {
+		return fmt.Errorf("postgres: insert sms sent: %w", err)
+	}
+	return nil
+}
+
+// UpdateStatus keeps a final status: a late "sent" report does not undo "delivered".
+func (s *sentMessageStore) UpdateStatus(ctx context.Context, gateID string, report *smsmodel.DeliveryReport) (*smsmodel.SentMessage, error) {
+	const query = `
+	UPDATE im_provider.sms_sent SET
+		status = CASE WHEN status IN ('delivered', 'failed') AND $3::text = 'sent' THEN status ELSE $3::text END,
+		reason = $4,
+		updated_at = NOW()
+	WHERE gate_id = $1 AND external_id = $2
+	RETURNING gate_id, external_id, message_id, recipient, status, reason, updated_at`
+
+	var m smsmodel.SentMessage
+	if err := pgxscan.Get(ctx, s.pool, &m, query, gateID, report.ExternalID, report.Status, report.Reason); err != nil {
+		if pgxscan.NotFound(err) {
+			return nil, sharedstore.ErrNotFound
+		}
+		return nil, fmt.Errorf("postgres: update sms status: %w", err)
+	}
+	return &m, nil
+}
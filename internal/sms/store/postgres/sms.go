@@ -0,0 +1,235 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/georgysavva/scany/v2/pgxscan"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	sharedmodel "github.com/webitel/im-providers-service/internal/core/model"
+	sharedstore "github.com/webitel/im-providers-service/internal/core/store"
+	smsmodel "github.com/webitel/im-providers-service/internal/sms/model"
+	smsstore "github.com/webitel/im-providers-service/internal/sms/store"
+	"github.com/webitel/im-providers-service/pkg/crypto"
+)
+
+var _ smsstore.SMSStore = (*smsStore)(nil)
+
+type smsStore struct {
+	pool   *pgxpool.Pool
+	crypto crypto.Encryptor
+	cache  sharedstore.GateCache
+}
+
+func NewSMSStore(pool *pgxpool.Pool, crypt crypto.Encryptor, cache sharedstore.GateCache) smsstore.SMSStore {
+	return &smsStore{
+		pool:   pool,
+		crypto: crypt,
+		cache:  cache,
+	}
+}
+
+const selectGates = `
+	SELECT
+		g.id,
+		g.dc AS domain_id,
+		g.name,
+		g.enabled,
+		g.created_at,
+		g.updated_at,
+		b.sub AS "peer.sub",
+		b.iss AS "peer.iss",
+		s.backend,
+		s.sender,
+		s.smpp_addr AS "smpp.addr",
+		s.smpp_system_id AS "smpp.system_id",
+		s.smpp_password AS "smpp.password",
+		s.smpp_system_type AS "smpp.system_type",
+		s.smpp_tls AS "smpp.tls",
+		s.http_send_url AS "http.send_url",
+		s.http_api_key AS "http.api_key",
+		s.http_secret AS "http.secret"
+	FROM im_provider.gates g
+	JOIN im_provider.bots b ON g.id = b.gate_id
+	JOIN im_provider.sms s ON g.id = s.gate_id`
+
+func (s *smsStore) Insert(ctx context.Context, dc int64, g *smsmodel.SMSGate) error {
+	creds, err := s.encrypt(g)
+	if err != nil {
+		return err
+	}
+
+	const query = `
+	WITH new_gate AS (
+		INSERT INTO im_provider.gates (dc, name, type, enabled)
+		VALUES ($1, $2, 'sms', $3)
+		RETURNING id, name, created_at, updated_at
+	),
+	new_bot AS (
+		INSERT INTO im_provider.bots (sub, iss, gate_id)
+		SELECT $4, $5, id FROM new_gate
+		RETURNING id
+	)
+	INSERT INTO im_provider.sms (
+		gate_id, backend, sender,
+		smpp_addr, smpp_system_id, smpp_password, smpp_system_type, smpp_tls,
+		http_send_url, http_api_key, http_secret
+	)
+	SELECT id, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15 FROM new_gate
+	RETURNING
+		gate_id,
+		(SELECT name FROM new_gate),
+		(SELECT created_at FROM new_gate),
+		(SELECT updated_at FROM new_gate)`
+
+	err = s.pool.QueryRow(ctx, query,
+		dc, g.Name, g.Enabled, g.Peer.Sub, g.Peer.Iss, g.Backend, g.Sender,
+		g.SMPP.Addr, g.SMPP.SystemID, creds.password, g.SMPP.SystemType, g.SMPP.TLS,
+		g.HTTP.SendURL, creds.apiKey, creds.secret,
+	).Scan(&g.ID, &g.Name, &g.CreatedAt, &g.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("postgres: insert sms gateway: %w", err)
+	}
+
+	g.DomainID = dc
+	mapVirtualFields(g)
+	return nil
+}
+
+func (s *smsStore) Select(ctx context.Context, id string) (*smsmodel.SMSGate, error) {
+	var g smsmodel.SMSGate
+	if err := pgxscan.Get(ctx, s.pool, &g, selectGates+` WHERE g.id = $1`, id); err != nil {
+		if pgxscan.NotFound(err) {
+			return nil, sharedstore.ErrNotFound
+		}
+		return nil, fmt.Errorf("postgres: select sms gate: %w", err)
+	}
+	if err := s.decrypt(&g); err != nil {
+		return nil, err
+	}
+
+	mapVirtualFields(&g)
+	return &g, nil
+}
+
+func (s *smsStore) SelectEnabled(ctx context.Context, backend smsmodel.Backend) ([]*smsmodel.SMSGate, error) {
+	var gates []*smsmodel.SMSGate
+	if err := pgxscan.Select(ctx, s.pool, &gates, selectGates+` WHERE g.enabled AND s.backend = $1 ORDER BY g.created_at`, backend); err != nil {
+		return nil, fmt.Errorf("postgres: select enabled sms gates: %w", err)
+	}
+
+	for _, g := range gates {
+		if err := s.decrypt(g); err != nil {
+			return nil, err
+		}
+		mapVirtualFields(g)
+	}
+	return gates, nil
+}
+
+func (s *smsStore) Update(ctx context.Context, g *smsmodel.SMSGate) error {
+	creds, err := s.encrypt(g)
+	if err != nil {
+		return err
+	}
+
+	err = pgx.BeginFunc(ctx, s.pool, func(tx pgx.Tx) error {
+		const uGate = `UPDATE im_provider.gates SET name = $1, enabled = $2, updated_at = NOW() WHERE id = $3 RETURNING updated_at`
+		if err := tx.QueryRow(ctx, uGate, g.Name, g.Enabled, g.ID).Scan(&g.UpdatedAt); err != nil {
+			return err
+		}
+
+		const uBot = `UPDATE im_provider.bots SET sub = $1, iss = $2 WHERE gate_id = $3`
+		if _, err := tx.Exec(ctx, uBot, g.Peer.Sub, g.Peer.Iss, g.ID); err != nil {
+			return err
+		}
+
+		const uConfig = `
+		UPDATE im_provider.sms SET
+			sender = $1,
+			smpp_addr = $2, smpp_system_id = $3, smpp_password = $4, smpp_system_type = $5, smpp_tls = $6,
+			http_send_url = $7, http_api_key = $8, http_secret = $9
+		WHERE gate_id = $10`
+		_, err := tx.Exec(ctx, uConfig,
+			g.Sender,
+			g.SMPP.Addr, g.SMPP.SystemID, creds.password, g.SMPP.SystemType, g.SMPP.TLS,
+			g.HTTP.SendURL, creds.apiKey, creds.secret,
+			g.ID,
+		)
+		return err
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return sharedstore.ErrNotFound
+		}
+		return fmt.Errorf("postgres: update sms gate: %w", err)
+	}
+
+	s.cache.Delete(g.ID)
+	mapVirtualFields(g)
+	return nil
+}
+
+func (s *smsStore) Delete(ctx context.Context, id string) error {
+	res, err := s.pool.Exec(ctx, "DELETE FROM im_provider.gates WHERE id = $1 AND type = 'sms'", id)
+	if err != nil {
+		return fmt.Errorf("postgres: delete sms gate: %w", err)
+	}
+	if res.RowsAffected() == 0 {
+		return sharedstore.ErrNotFound
+	}
+
+	s.cache.Delete(id)
+	return nil
+}
+
+// credentials are the encrypted secrets of a gate; unset ones stay empty.
+type credentials struct {
+	password, apiKey, secret string
+}
+
+func (s *smsStore) encrypt(g *smsmodel.SMSGate) (credentials, error) {
+	var (
+		c   credentials
+		err error
+	)
+	for _, f := range []struct{ dst, src *string }{
+		{&c.password, &g.SMPP.Password},
+		{&c.apiKey, &g.HTTP.APIKey},
+		{&c.secret, &g.HTTP.Secret},
+	} {
+		if *f.src == "" {
+			continue
+		}
+		if *f.dst, err = s.crypto.Encrypt(*f.src); err != nil {
+			return c, fmt.Errorf("crypto: %w", err)
+		}
+	}
+	return c, nil
+}
+
+// decrypt decrypts the credentials in place. A credential that cannot be decrypted
+// would fail every bind or request, so it is an error.
+func (s *smsStore) decrypt(g *smsmodel.SMSGate) error {
+	for _, v := range []*string{&g.SMPP.Password, &g.HTTP.APIKey, &g.HTTP.Secret} {
+		if *v == "" {
+			continue
+		}
+		dec, err := s.crypto.Decrypt(*v)
+		if err != nil {
+			return fmt.Errorf("sms gate %s: crypto: %w", g.ID, err)
+		}
+		*v = dec
+	}
+	return nil
+}
+
+func mapVirtualFields(g *smsmodel.SMSGate) {
+	if g.Enabled {
+		g.Status = sharedmodel.StatusActive
+	} else {
+		g.Status = sharedmodel.StatusDisabled
+	}
+}
@@ -0,0 +1,26 @@
+package store
+
+import (
+	"context"
+
+	smsmodel "github.com/webitel/im-providers-service/internal/sms/model"
+)
+
+// SMSStore manages SMS gates. Backend credentials are stored encrypted.
+type SMSStore interface {
+	// Insert creates the gate together with its bot peer and backend settings.
+	Insert(ctx context.Context, dc int64, g *smsmodel.SMSGate) error
+	Select(ctx context.Context, id string) (*smsmodel.SMSGate, error)
+	// SelectEnabled returns every enabled gate of the backend; SMPP gates are bound on start.
+	SelectEnabled(ctx context.Context, backend smsmodel.Backend) ([]*smsmodel.SMSGate, error)
+	Update(ctx context.Context, g *smsmodel.SMSGate) error
+	Delete(ctx context.Context, id string) error
+}
+
+// SentMessageStore tracks outbound message parts until their delivery reports arrive.
+type SentMessageStore interface {
+	InsertSent(ctx context.Context, msgs []*smsmodel.SentMessage) error
+	// UpdateStatus applies the report to the part it is for and returns the part.
+	// It returns sharedstore.ErrNotFound for a part that is not tracked.
+	UpdateStatus(ctx context.Context, gateID string, report *smsmodel.DeliveryReport) (*smsmodel.SentMessage, error)
+}
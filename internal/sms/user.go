@@ -0,0 +1,50 @@
+package sms
+
+import (
+	"context"
+
+	gatewayv1 "github.com/webitel/im-providers-service/gen/go/gateway/v1"
+	sharedmodel "github.com/webitel/im-providers-service/internal/core/model"
+	"github.com/webitel/im-providers-service/internal/provider/contactsync"
+	smsmodel "github.com/webitel/im-providers-service/internal/sms/model"
+)
+
+// metadataPhone is the contact metadata key of the phone number.
+const metadataPhone = "phone"
+
+// syncContact resolves the internal contact for a phone number, creating it if
+// necessary. The phone number is the subject and, SMS carrying no profile, the
+// name of the contact. The result is cached so repeated messages from the same
+// number skip the gateway round-trip.
+func (p *smsProvider) syncContact(ctx context.Context, gate *smsmodel.SMSGate, phone string) error {
+	external := &sharedmodel.ExternalUser{ID: phone, FirstName: phone}
+	key := contactsync.KnownUser(gate.ID, external)
+
+	if known, _ := p.userCache.IsKnown(ctx, key); known {
+		return nil
+	}
+
+	authCtx := contactsync.WithGatewayIdentity(ctx, gate.DomainID, gate.Peer)
+
+	contact, err := p.ensureContact(authCtx, external)
+	if err != nil {
+		return err
+	}
+
+	contactsync.EnsureVia(authCtx, p.gatewayer, p.logger, gate.ID, external.ID, contact.Iss)
+	_ = p.userCache.MarkKnown(ctx, key)
+
+	return nil
+}
+
+// ensureContact creates the internal contact or returns a stub when the
+// contact already exists (idempotent by design on the gateway side).
+func (p *smsProvider) ensureContact(ctx context.Context, external *sharedmodel.ExternalUser) (*gatewayv1.Contact, error) {
+	return contactsync.CreateContact(ctx, p.gatewayer, &gatewayv1.CreateContactRequest{
+		IssId:    p.Type(),
+		Type:     p.Type(),
+		Name:     external.FirstName,
+		Subject:  external.ID,
+		Metadata: map[string]string{metadataPhone: external.ID},
+	})
+}
@@ -0,0 +1,93 @@
+package sms
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/webitel/im-providers-service/internal/sms/httpgw"
+	smsmodel "github.com/webitel/im-providers-service/internal/sms/model"
+)
+
+// signatureHeader carries "sha256=" and the hex HMAC-SHA256 of the event keyed by the
+// webhook secret of the gate.
+const signatureHeader = "X-Webitel-Signature"
+
+const signaturePrefix = "sha256="
+
+// SignatureHeader implements provider.SignatureHeader.
+func (p *smsProvider) SignatureHeader() string { return signatureHeader }
+
+// ValidateSignature implements provider.SignatureValidator.
+func (p *smsProvider) ValidateSignature(ctx context.Context, header string, body []byte) error {
+	if header == "" {
+		return fmt.Errorf("missing %s header", signatureHeader)
+	}
+
+	gate, err := p.resolveGate(ctx)
+	if err != nil {
+		return fmt.Errorf("signature: gate lookup failed: %w", err)
+	}
+	if !gate.Enabled {
+		// Events of a disabled gate are acknowledged and dropped by HandleWebhook.
+		return nil
+	}
+
+	sig, ok := strings.CutPrefix(header, signaturePrefix)
+	if !ok || !hmac.Equal([]byte(sig), []byte(sign(gate.HTTP.Secret, body))) {
+		return fmt.Errorf("signature mismatch")
+	}
+	return nil
+}
+
+// sign returns the hex HMAC-SHA256 of body keyed by secret.
+func sign(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// HandleWebhook processes an event of an HTTP gateway. SMPP gates receive theirs over the bind.
+func (p *smsProvider) HandleWebhook(ctx context.Context, data []byte) error {
+	var ev httpgw.Event
+	if err := json.Unmarshal(data, &ev); err != nil {
+		return fmt.Errorf("sms: malformed event: %w", err)
+	}
+
+	gate, err := p.resolveGate(ctx)
+	if err != nil || !gate.Enabled {
+		return err
+	}
+	if gate.Backend != smsmodel.BackendHTTP {
+		return fmt.Errorf("sms: gate %s receives events over %s", gate.ID, gate.Backend)
+	}
+
+	switch ev.Type {
+	case httpgw.EventMessage:
+		return p.handleInbound(ctx, gate, &smsmodel.InboundSMS{
+			ExternalID: ev.ID,
+			From:       ev.From,
+			To:         ev.To,
+			Text:       ev.Text,
+		})
+	case httpgw.EventStatus:
+		if ev.ID == "" {
+			return fmt.Errorf("sms: status event has no message id")
+		}
+		reason := ev.Status
+		if ev.Error != "" {
+			reason += " error:" + ev.Error
+		}
+		return p.handleReport(ctx, gate, &smsmodel.DeliveryReport{
+			ExternalID: ev.ID,
+			Status:     httpStatus(ev.Status),
+			Reason:     reason,
+		})
+	default:
+		return fmt.Errorf("sms: unsupported event %q", ev.Type)
+	}
+}
@@ -0,0 +1,217 @@
+package teams
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	sharedmodel "github.com/webitel/im-providers-service/internal/core/model"
+	tmmodel "github.com/webitel/im-providers-service/internal/teams/model"
+)
+
+const (
+	// LoginBaseURL is the Microsoft identity platform issuing the bot tokens.
+	LoginBaseURL = "https://login.microsoftonline.com"
+	// botFrameworkTenant issues the tokens of multi-tenant bots.
+	botFrameworkTenant = "botframework.com"
+	// botFrameworkScope is the scope of the tokens the Bot Connector accepts.
+	botFrameworkScope = "https://api.botframework.com/.default"
+	// tokenRefreshMargin renews a token before it expires mid-request.
+	tokenRefreshMargin = 5 * time.Minute
+)
+
+// botAPI is the contract used by teamsProvider and the gate service to talk to the Bot Connector.
+// Keeping it as an interface allows both to be tested without network calls.
+type botAPI interface {
+	Authenticate(ctx context.Context, creds tmmodel.Credentials) error
+	SendActivity(ctx context.Context, creds tmmodel.Credentials, serviceURL, conversationID string, a *outboundActivity) (*sharedmodel.MessageResponse, error)
+	Download(ctx context.Context, creds tmmodel.Credentials, serviceURL, fileURL string) (*content, error)
+}
+
+// content is the body of a downloaded file. The caller closes Body.
+type content struct {
+	Body     io.ReadCloser
+	MimeType string
+	Size     int64
+}
+
+type accessToken struct {
+	value   string
+	expires time.Time
+}
+
+type apiClient struct {
+	client   *http.Client
+	logger   *slog.Logger
+	loginURL string
+
+	mu     sync.Mutex
+	tokens map[tmmodel.Credentials]accessToken
+}
+
+var _ botAPI = (*apiClient)(nil)
+
+func newAPIClient(l *slog.Logger) *apiClient {
+	return &apiClient{
+		client:   &http.Client{Timeout: 60 * time.Second},
+		logger:   l.With("component", "teams.api"),
+		loginURL: LoginBaseURL,
+		tokens:   make(map[tmmodel.Credentials]accessToken),
+	}
+}
+
+// Authenticate checks the credentials by requesting a token with them.
+func (c *apiClient) Authenticate(ctx context.Context, creds tmmodel.Credentials) error {
+	_, err := c.token(ctx, creds)
+	return err
+}
+
+// SendActivity posts a message activity to a conversation. Posting to a channel
+// thread, whose conversation ID carries the root message ID, replies in the thread.
+// https://learn.microsoft.com/en-us/azure/bot-service/rest-api/bot-framework-rest-connector-api-reference#send-to-conversation
+func (c *apiClient) SendActivity(ctx context.Context, creds tmmodel.Credentials, serviceURL, conversationID string, a *outboundActivity) (*sharedmodel.MessageResponse, error) {
+	if serviceURL == "" {
+		return nil, fmt.Errorf("teams send activity: no service url known yet, the bot has not received any activity")
+	}
+	token, err := c.token(ctx, creds)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := json.Marshal(a)
+	if err != nil {
+		return nil, fmt.Errorf("teams send activity: marshal: %w", err)
+	}
+	endpoint := strings.TrimRight(serviceURL, "/") + "/v3/conversations/" + url.PathEscape(conversationID) + "/activities"
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("teams send activity: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer "+token)
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("teams send activity: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		var e errorResponse
+		_ = json.NewDecoder(resp.Body).Decode(&e)
+		c.logger.WarnContext(ctx, "activity rejected", "status", resp.StatusCode, "code", e.Error.Code, "message", e.Error.Message)
+		if resp.StatusCode == http.StatusUnauthorized {
+			c.forget(creds)
+		}
+		return nil, &APIError{Operation: "send activity", StatusCode: resp.StatusCode, Code: e.Error.Code, Message: e.Error.Message}
+	}
+
+	var out resourceResponse
+	if err := json.NewDecoder(resp.Body).Decode(&out); err != nil && err != io.EOF {
+		return nil, fmt.Errorf("teams send activity: decode response: %w", err)
+	}
+	return &sharedmodel.MessageResponse{ID: out.ID}, nil
+}
+
+// Download streams an attachment. The bot token is only sent to the Bot Connector
+// itself: file download URLs are pre-authenticated and point elsewhere.
+func (c *apiClient) Download(ctx context.Context, creds tmmodel.Credentials, serviceURL, fileURL string) (*content, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, fileURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("teams download: %w", err)
+	}
+	if sameHost(fileURL, serviceURL) {
+		token, err := c.token(ctx, creds)
+		if err != nil {
+			return nil, err
+		}
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("teams download: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		resp.Body.Close()
+		return nil, fmt.Errorf("teams download: status %s", resp.Status)
+	}
+	return &content{Body: resp.Body, MimeType: resp.Header.Get("Content-Type"), Size: resp.ContentLength}, nil
+}
+
+// token returns a cached access token of the bot, requesting a new one when it is
+// about to expire.
+// https://learn.microsoft.com/en-us/azure/bot-service/rest-api/bot-framework-rest-connector-authentication#bot-to-connector
+func (c *apiClient) token(ctx context.Context, creds tmmodel.Credentials) (string, error) {
+	c.mu.Lock()
+	cached, ok := c.tokens[creds]
+	c.mu.Unlock()
+	if ok && time.Until(cached.expires) > tokenRefreshMargin {
+		return cached.value, nil
+	}
+
+	tenant := creds.TenantID
+	if tenant == "" {
+		tenant = botFrameworkTenant
+	}
+	form := url.Values{
+		"grant_type":    {"client_credentials"},
+		"client_id":     {creds.AppID},
+		"client_secret": {creds.AppPassword},
+		"scope":         {botFrameworkScope},
+	}
+	endpoint := c.loginURL + "/" + url.PathEscape(tenant) + "/oauth2/v2.0/token"
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return "", fmt.Errorf("teams token: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("teams token: %w", err)
+	}
+	defer resp.Body.Close()
+
+	var out tokenResponse
+	if err := json.NewDecoder(resp.Body).Decode(&out); err != nil {
+		return "", fmt.Errorf("teams token: status %s: decode response: %w", resp.Status, err)
+	}
+	if resp.StatusCode != http.StatusOK || out.AccessToken == "" {
+		c.logger.WarnContext(ctx, "token request rejected", "app_id", creds.AppID, "error", out.Error)
+		return "", &APIError{Operation: "token", StatusCode: resp.StatusCode, Code: out.Error, Message: out.ErrorDescription}
+	}
+
+	c.mu.Lock()
+	c.tokens[creds] = accessToken{value: out.AccessToken, expires: time.Now().Add(time.Duration(out.ExpiresIn) * time.Second)}
+	c.mu.Unlock()
+	return out.AccessToken, nil
+}
+
+// forget drops the cached token of a bot, e.g. once its secret was revoked.
+func (c *apiClient) forget(creds tmmodel.Credentials) {
+	c.mu.Lock()
+	delete(c.tokens, creds)
+	c.mu.Unlock()
+}
+
+// sameHost reports whether both URLs point to the same host.
+func sameHost(a, b string) bool {
+	ua, err := url.Parse(a)
+	if err != nil {
+		return false
+	}
+	ub, err := url.Parse(b)
+	if err != nil {
+		return false
+	}
+	return ua.Host != "" && strings.EqualFold(ua.Host, ub.Host)
+}
@@ -0,0 +1,132 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	impb "github.com/webitel/im-providers-service/gen/go/provider/v1"
+	"github.com/webitel/im-providers-service/internal/provider/gaterpc"
+	tmmodel "github.com/webitel/im-providers-service/internal/teams/model"
+	tmservice "github.com/webitel/im-providers-service/internal/teams/service"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type TeamsHandler struct {
+	logger *slog.Logger
+	srv    tmservice.TeamsManager
+	impb.UnimplementedTeamsServiceServer
+}
+
+func NewTeamsHandler(logger *slog.Logger, srv tmservice.TeamsManager) *TeamsHandler {
+	return &TeamsHandler{logger: logger, srv: srv}
+}
+
+func (h *TeamsHandler) CreateTeamsGate(ctx context.Context, req *impb.ProviderCreateTeamsGateRequest) (*impb.ProviderCreateTeamsGateResponse, error) {
+	domainID, err := gaterpc.DomainID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	create := tmmodel.CreateTeams{
+		Name:        req.GetName(),
+		Dc:          domainID,
+		AppID:       req.GetAppId(),
+		AppPassword: req.GetAppPassword(),
+		TenantID:    req.GetTenantId(),
+	}
+	if peer := gaterpc.Peer(req.GetPeer()); peer != nil {
+		create.Peer = *peer
+	}
+
+	gate, err := h.srv.CreateGate(ctx, create)
+	if err != nil {
+		return nil, toStatus(err, "create gate")
+	}
+
+	return &impb.ProviderCreateTeamsGateResponse{Item: gateToProto(gate)}, nil
+}
+
+func (h *TeamsHandler) GetTeamsGate(ctx context.Context, req *impb.ProviderGetTeamsGateRequest) (*impb.ProviderGetTeamsGateResponse, error) {
+	gate, err := h.gate(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	return &impb.ProviderGetTeamsGateResponse{Item: gateToProto(gate)}, nil
+}
+
+func (h *TeamsHandler) UpdateTeamsGate(ctx context.Context, req *impb.ProviderUpdateTeamsGateRequest) (*impb.ProviderUpdateTeamsGateResponse, error) {
+	if _, err := h.gate(ctx, req.GetId()); err != nil {
+		return nil, err
+	}
+
+	gate, err := h.srv.UpdateGate(ctx, tmmodel.UpdateTeams{
+		ID:          req.GetId(),
+		Name:        req.Name,
+		AppPassword: req.AppPassword,
+		TenantID:    req.TenantId,
+		Enabled:     req.Enabled,
+		Peer:        gaterpc.Peer(req.GetPeer()),
+	})
+	if err != nil {
+		return nil, toStatus(err, "update gate")
+	}
+
+	return &impb.ProviderUpdateTeamsGateResponse{Item: gateToProto(gate)}, nil
+}
+
+func (h *TeamsHandler) DeleteTeamsGate(ctx context.Context, req *impb.ProviderDeleteTeamsGateRequest) (*impb.ProviderDeleteTeamsGateResponse, error) {
+	if _, err := h.gate(ctx, req.GetId()); err != nil {
+		return nil, err
+	}
+
+	gate, err := h.srv.DeleteGate(ctx, req.GetId())
+	if err != nil {
+		return nil, toStatus(err, "delete gate")
+	}
+
+	return &impb.ProviderDeleteTeamsGateResponse{Item: gateToProto(gate)}, nil
+}
+
+// gate returns the gate when it belongs to the domain of the caller.
+func (h *TeamsHandler) gate(ctx context.Context, id string) (*tmmodel.TeamsGate, error) {
+	gate, err := h.srv.GetGate(ctx, id)
+	if err != nil {
+		return nil, toStatus(err, "get gate")
+	}
+	if err := gaterpc.CheckDomain(ctx, gate.DomainID); err != nil {
+		return nil, err
+	}
+	return gate, nil
+}
+
+// toStatus reports a missing public URL as a precondition: the Azure Bot resource needs
+// a messaging endpoint before Teams sends it anything.
+func toStatus(err error, internalMsg string) error {
+	if errors.Is(err, tmservice.ErrPublicURLNotSet) {
+		return status.Error(codes.FailedPrecondition, err.Error())
+	}
+	return gaterpc.ToStatus(err, internalMsg)
+}
+
+// gateToProto leaves out the service URL: it is the Bot Connector endpoint of the
+// latest activity, kept for the replies only.
+func gateToProto(g *tmmodel.TeamsGate) *impb.ProviderTeamsGate {
+	if g == nil {
+		return nil
+	}
+	return &impb.ProviderTeamsGate{
+		Id:         g.ID,
+		Name:       g.Name,
+		Peer:       gaterpc.ToProtoPeer(g.Peer),
+		AppId:      g.AppID,
+		TenantId:   g.TenantID,
+		WebhookUrl: g.WebhookURL,
+		Status:     impb.ProviderStatus(g.Status),
+		CreatedAt:  g.CreatedAt.UnixMilli(),
+		UpdatedAt:  g.UpdatedAt.UnixMilli(),
+		Enabled:    g.Enabled,
+	}
+}
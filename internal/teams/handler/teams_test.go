@@ -0,0 +1,184 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"testing"
+
+	impb "github.com/webitel/im-providers-service/gen/go/provider/v1"
+	"github.com/webitel/im-providers-service/infra/auth"
+	sharedstore "github.com/webitel/im-providers-service/internal/core/store"
+	tmmodel "github.com/webitel/im-providers-service/internal/teams/model"
+	tmservice "github.com/webitel/im-providers-service/internal/teams/service"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type owner struct{ domainID int64 }
+
+func (o owner) GetContactID() string { return "" }
+func (o owner) GetDomainID() int64   { return o.domainID }
+func (o owner) GetName() string      { return "" }
+
+func ownerContext(domainID int64) context.Context {
+	return context.WithValue(context.Background(), auth.AuthContextKey, owner{domainID: domainID})
+}
+
+// azureBots keeps the bot registrations like the Teams service does; it fails with
+// err when it is set.
+type azureBots struct {
+	gates   map[string]*tmmodel.TeamsGate
+	deleted []string
+	err     error
+}
+
+var _ tmservice.TeamsManager = (*azureBots)(nil)
+
+func newAzureBots(gates ...*tmmodel.TeamsGate) *azureBots {
+	b := &azureBots{gates: map[string]*tmmodel.TeamsGate{}}
+	for _, g := range gates {
+		b.gates[g.ID] = g
+	}
+	return b
+}
+
+func (b *azureBots) CreateGate(_ context.Context, req tmmodel.CreateTeams) (*tmmodel.TeamsGate, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	if b.err != nil {
+		return nil, b.err
+	}
+	gate := &tmmodel.TeamsGate{
+		ID:          fmt.Sprintf("gate-%d", len(b.gates)+1),
+		DomainID:    req.Dc,
+		Name:        req.Name,
+		Peer:        req.Peer,
+		AppID:       req.AppID,
+		AppPassword: req.AppPassword,
+		TenantID:    req.TenantID,
+		Enabled:     true,
+	}
+	gate.WebhookURL = "https://im.example.com/wh/teams/" + gate.ID
+	b.gates[gate.ID] = gate
+	return gate, nil
+}
+
+func (b *azureBots) GetGate(_ context.Context, id string) (*tmmodel.TeamsGate, error) {
+	g, ok := b.gates[id]
+	if !ok {
+		return nil, sharedstore.ErrNotFound
+	}
+	return g, nil
+}
+
+func (b *azureBots) UpdateGate(_ context.Context, req tmmodel.UpdateTeams) (*tmmodel.TeamsGate, error) {
+	req.ApplyTo(b.gates[req.ID])
+	return b.gates[req.ID], nil
+}
+
+func (b *azureBots) DeleteGate(_ context.Context, id string) (*tmmodel.TeamsGate, error) {
+	b.deleted = append(b.deleted, id)
+	return b.gates[id], nil
+}
+
+// contosoBot is a single-tenant bot that has already served a conversation.
+func contosoBot() *tmmodel.TeamsGate {
+	return &tmmodel.TeamsGate{
+		ID:          "gate-1",
+		DomainID:    7,
+		Name:        "Support",
+		AppID:       "app-1",
+		AppPassword: "secret-1",
+		TenantID:    "contoso.onmicrosoft.com",
+		ServiceURL:  "https://smba.trafficmanager.net/emea/",
+		Enabled:     true,
+	}
+}
+
+func newHandler(b *azureBots) *TeamsHandler {
+	return NewTeamsHandler(slog.New(slog.NewTextHandler(io.Discard, nil)), b)
+}
+
+func TestCreateTeamsGate_SingleTenant(t *testing.T) {
+	b := newAzureBots()
+
+	resp, err := newHandler(b).CreateTeamsGate(ownerContext(7), &impb.ProviderCreateTeamsGateRequest{
+		Name:        "Support",
+		AppId:       "app-1",
+		AppPassword: "secret-1",
+		TenantId:    "contoso.onmicrosoft.com",
+		Peer:        &impb.Peer{Sub: "teams-bot", Iss: "teams"},
+	})
+	if err != nil {
+		t.Fatalf("CreateTeamsGate: %v", err)
+	}
+	item := resp.GetItem()
+	if item.GetAppId() != "app-1" || item.GetTenantId() != "contoso.onmicrosoft.com" || item.GetWebhookUrl() != "https://im.example.com/wh/teams/gate-1" {
+		t.Errorf("unexpected gate: %+v", item)
+	}
+	if g := b.gates["gate-1"]; g.DomainID != 7 || g.AppPassword != "secret-1" {
+		t.Errorf("stored gate = %+v", g)
+	}
+}
+
+func TestCreateTeamsGate_ErrorCodes(t *testing.T) {
+	tests := []struct {
+		name    string
+		appID   string
+		failure error
+		want    codes.Code
+	}{
+		{name: "no app id", want: codes.InvalidArgument},
+		{name: "no public url", appID: "app-2", failure: tmservice.ErrPublicURLNotSet, want: codes.FailedPrecondition},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := newAzureBots()
+			b.err = tt.failure
+
+			_, err := newHandler(b).CreateTeamsGate(ownerContext(7), &impb.ProviderCreateTeamsGateRequest{Name: "Sales", AppId: tt.appID, AppPassword: "secret"})
+			if status.Code(err) != tt.want {
+				t.Errorf("code = %v, want %v", status.Code(err), tt.want)
+			}
+		})
+	}
+}
+
+func TestUpdateTeamsGate_RotatesSecretOnly(t *testing.T) {
+	b := newAzureBots(contosoBot())
+
+	rotated := "secret-2"
+	resp, err := newHandler(b).UpdateTeamsGate(ownerContext(7), &impb.ProviderUpdateTeamsGateRequest{Id: "gate-1", AppPassword: &rotated})
+	if err != nil {
+		t.Fatalf("UpdateTeamsGate: %v", err)
+	}
+	if item := resp.GetItem(); item.GetTenantId() != "contoso.onmicrosoft.com" || item.GetName() != "Support" {
+		t.Errorf("item = %+v", item)
+	}
+	if g := b.gates["gate-1"]; g.AppPassword != "secret-2" || g.ServiceURL != "https://smba.trafficmanager.net/emea/" {
+		t.Errorf("stored gate = %+v", g)
+	}
+}
+
+func TestTeamsGate_OtherDomainIsNotFound(t *testing.T) {
+	b := newAzureBots(contosoBot())
+	h := newHandler(b)
+	ctx := ownerContext(8)
+
+	if _, err := h.GetTeamsGate(ctx, &impb.ProviderGetTeamsGateRequest{Id: "gate-1"}); status.Code(err) != codes.NotFound {
+		t.Errorf("get: code = %v, want NotFound", status.Code(err))
+	}
+	rotated := "secret-2"
+	if _, err := h.UpdateTeamsGate(ctx, &impb.ProviderUpdateTeamsGateRequest{Id: "gate-1", AppPassword: &rotated}); status.Code(err) != codes.NotFound {
+		t.Errorf("update: code = %v, want NotFound", status.Code(err))
+	}
+	if _, err := h.DeleteTeamsGate(ctx, &impb.ProviderDeleteTeamsGateRequest{Id: "gate-1"}); status.Code(err) != codes.NotFound {
+		t.Errorf("delete: code = %v, want NotFound", status.Code(err))
+	}
+	if b.gates["gate-1"].AppPassword != "secret-1" || len(b.deleted) != 0 {
+		t.Errorf("gate of another domain changed: %+v, deleted %v", b.gates["gate-1"], b.deleted)
+	}
+}
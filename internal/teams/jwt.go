@@ -0,0 +1,238 @@
+package teams
+
+import (
+	"context"
+	"crypto"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Bot Framework token validation.
+// https://learn.microsoft.com/en-us/azure/bot-service/rest-api/bot-framework-rest-connector-authentication#connector-to-bot
+const (
+	// OpenIDConfigURL is the metadata document listing the keys the Bot Connector signs with.
+	OpenIDConfigURL = "https://login.botframework.com/v1/.well-known/openidconfiguration"
+	// botFrameworkIssuer is the issuer of the tokens sent by the Bot Connector.
+	botFrameworkIssuer = "https://api.botframework.com"
+	// clockSkew tolerates the clock difference with the Bot Connector.
+	clockSkew = 5 * time.Minute
+	// keysTTL re-reads the signing keys, which are rolled every few weeks.
+	keysTTL = 24 * time.Hour
+	// keysMinRefresh bounds how often an unknown key ID triggers a re-read.
+	keysMinRefresh = 5 * time.Minute
+)
+
+// tokenClaims are the claims of a Bot Connector token checked by the bot.
+type tokenClaims struct {
+	Issuer     string   `json:"iss"`
+	Audience   audience `json:"aud"`
+	ExpiresAt  int64    `json:"exp"`
+	NotBefore  int64    `json:"nbf"`
+	ServiceURL string   `json:"serviceurl"`
+}
+
+// audience is the aud claim, a single string or a list of strings.
+type audience []string
+
+func (a *audience) UnmarshalJSON(data []byte) error {
+	var single string
+	if err := json.Unmarshal(data, &single); err == nil {
+		*a = audience{single}
+		return nil
+	}
+	var list []string
+	if err := json.Unmarshal(data, &list); err != nil {
+		return err
+	}
+	*a = list
+	return nil
+}
+
+// signingKey is a key of the Bot Connector, endorsed for the channels it may sign for.
+type signingKey struct {
+	key          *rsa.PublicKey
+	endorsements []string
+}
+
+// tokenVerifier validates the JWT the Bot Connector sends with every activity.
+type tokenVerifier struct {
+	client    *http.Client
+	configURL string
+	now       func() time.Time
+
+	mu        sync.Mutex
+	keys      map[string]signingKey
+	fetchedAt time.Time
+}
+
+func newTokenVerifier() *tokenVerifier {
+	return &tokenVerifier{
+		client:    &http.Client{Timeout: 10 * time.Second},
+		configURL: OpenIDConfigURL,
+		now:       time.Now,
+	}
+}
+
+// Verify checks the signature and the claims of a token issued for the bot and
+// returns its claims with the channels the signing key is endorsed for.
+func (v *tokenVerifier) Verify(ctx context.Context, raw, appID string) (*tokenClaims, []string, error) {
+	parts := strings.Split(raw, ".")
+	if len(parts) != 3 {
+		return nil, nil, errors.New("malformed token")
+	}
+
+	var header struct {
+		Alg string `json:"alg"`
+		Kid string `json:"kid"`
+	}
+	if err := decodeSegment(parts[0], &header); err != nil {
+		return nil, nil, fmt.Errorf("malformed token header: %w", err)
+	}
+	if header.Alg != "RS256" {
+		return nil, nil, fmt.Errorf("unexpected signing algorithm %q", header.Alg)
+	}
+
+	key, err := v.key(ctx, header.Kid)
+	if err != nil {
+		return nil, nil, err
+	}
+	sig, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return nil, nil, fmt.Errorf("malformed token signature: %w", err)
+	}
+	digest := sha256.Sum256([]byte(parts[0] + "." + parts[1]))
+	if err := rsa.VerifyPKCS1v15(key.key, crypto.SHA256, digest[:], sig); err != nil {
+		return nil, nil, fmt.Errorf("token signature mismatch")
+	}
+
+	var claims tokenClaims
+	if err := decodeSegment(parts[1], &claims); err != nil {
+		return nil, nil, fmt.Errorf("malformed token claims: %w", err)
+	}
+	if claims.Issuer != botFrameworkIssuer {
+		return nil, nil, fmt.Errorf("unexpected token issuer %q", claims.Issuer)
+	}
+	if !slices.Contains(claims.Audience, appID) {
+		return nil, nil, fmt.Errorf("token issued for another bot")
+	}
+	now := v.now()
+	if claims.ExpiresAt == 0 || now.After(time.Unix(claims.ExpiresAt, 0).Add(clockSkew)) {
+		return nil, nil, fmt.Errorf("token expired")
+	}
+	if claims.NotBefore != 0 && now.Before(time.Unix(claims.NotBefore, 0).Add(-clockSkew)) {
+		return nil, nil, fmt.Errorf("token not valid yet")
+	}
+	return &claims, key.endorsements, nil
+}
+
+// key returns the signing key with the given ID, re-reading the keys when they are
+// stale or the ID is unknown, e.g. right after a key roll.
+func (v *tokenVerifier) key(ctx context.Context, kid string) (signingKey, error) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	key, ok := v.keys[kid]
+	age := v.now().Sub(v.fetchedAt)
+	if (ok && age < keysTTL) || (!ok && v.keys != nil && age < keysMinRefresh) {
+		if !ok {
+			return signingKey{}, fmt.Errorf("unknown signing key %q", kid)
+		}
+		return key, nil
+	}
+
+	keys, err := v.fetchKeys(ctx)
+	if err != nil {
+		if ok {
+			// Keep validating with the known key while the metadata is unreachable.
+			return key, nil
+		}
+		return signingKey{}, err
+	}
+	v.keys, v.fetchedAt = keys, v.now()
+
+	if key, ok = keys[kid]; !ok {
+		return signingKey{}, fmt.Errorf("unknown signing key %q", kid)
+	}
+	return key, nil
+}
+
+// fetchKeys reads the signing keys from the JWKS document the OpenID metadata points to.
+func (v *tokenVerifier) fetchKeys(ctx context.Context) (map[string]signingKey, error) {
+	var config struct {
+		JWKSURI string `json:"jwks_uri"`
+	}
+	if err := v.getJSON(ctx, v.configURL, &config); err != nil {
+		return nil, fmt.Errorf("read openid configuration: %w", err)
+	}
+	if config.JWKSURI == "" {
+		return nil, fmt.Errorf("read openid configuration: no jwks_uri")
+	}
+
+	var set struct {
+		Keys []struct {
+			Kty          string   `json:"kty"`
+			Kid          string   `json:"kid"`
+			N            string   `json:"n"`
+			E            string   `json:"e"`
+			Endorsements []string `json:"endorsements"`
+		} `json:"keys"`
+	}
+	if err := v.getJSON(ctx, config.JWKSURI, &set); err != nil {
+		return nil, fmt.Errorf("read signing keys: %w", err)
+	}
+
+	keys := make(map[string]signingKey, len(set.Keys))
+	for _, k := range set.Keys {
+		if k.Kty != "RSA" {
+			continue
+		}
+		n, errN := base64.RawURLEncoding.DecodeString(k.N)
+		e, errE := base64.RawURLEncoding.DecodeString(k.E)
+		if errN != nil || errE != nil {
+			continue
+		}
+		keys[k.Kid] = signingKey{
+			key: &rsa.PublicKey{
+				N: new(big.Int).SetBytes(n),
+				E: int(new(big.Int).SetBytes(e).Int64()),
+			},
+			endorsements: k.Endorsements,
+		}
+	}
+	return keys, nil
+}
+
+func (v *tokenVerifier) getJSON(ctx context.Context, url string, out any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+	resp, err := v.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("status %s", resp.Status)
+	}
+	return json.NewDecoder(resp.Body).Decode(out)
+}
+
+func decodeSegment(segment string, out any) error {
+	raw, err := base64.RawURLEncoding.DecodeString(segment)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(raw, out)
+}
@@ -0,0 +1,105 @@
+package teams
+
+import (
+	"context"
+	"encoding/json"
+	"mime"
+	"strings"
+
+	sharedmodel "github.com/webitel/im-providers-service/internal/core/model"
+	"github.com/webitel/im-providers-service/internal/provider/contactsync"
+	tmmodel "github.com/webitel/im-providers-service/internal/teams/model"
+)
+
+// handleAttachment copies a file or an inline image to the storage and forwards it
+// with body as the caption. Cards and the HTML copy of the text are skipped. It
+// reports whether the attachment was forwarded, so a caption is not lost when it was not.
+func (p *teamsProvider) handleAttachment(ctx context.Context, gate *tmmodel.TeamsGate, a *activity, peers contactsync.Peers, att *attachment, body string) bool {
+	var fileURL, mimeType string
+	switch {
+	case att.ContentType == contentTypeFileDownload:
+		var info fileDownloadInfo
+		if err := json.Unmarshal(att.Content, &info); err != nil || info.DownloadURL == "" {
+			p.logger.Warn("file without download URL skipped", "name", att.Name)
+			return false
+		}
+		fileURL = info.DownloadURL
+		if info.FileType != "" {
+			mimeType = mime.TypeByExtension("." + info.FileType)
+		}
+	case strings.HasPrefix(att.ContentType, "image/") && att.ContentURL != "":
+		fileURL, mimeType = att.ContentURL, att.ContentType
+	default:
+		if att.ContentType != contentTypeHTML {
+			p.logger.Debug("attachment skipped", "content_type", att.ContentType)
+		}
+		return false
+	}
+
+	c, err := p.api.Download(ctx, gate.Credentials(), a.ServiceURL, fileURL)
+	if err != nil {
+		p.logger.Error("failed to download attachment", "name", att.Name, "err", err)
+		return false
+	}
+	defer c.Body.Close()
+
+	if mimeType == "" {
+		mimeType = c.MimeType
+	}
+	name := att.Name
+	if name == "" {
+		name = "image"
+		if exts, _ := mime.ExtensionsByType(mimeType); len(exts) > 0 {
+			name += exts[0]
+		}
+	}
+
+	uploaded, err := p.media.UploadFile(ctx, sharedmodel.UploadRequest{
+		DomainID: gate.DomainID,
+		Name:     name,
+		MimeType: mimeType,
+	}, c.Body)
+	if err != nil {
+		p.logger.Error("failed to sync attachment", "name", name, "err", err)
+		return false
+	}
+
+	if strings.HasPrefix(mimeType, "image/") {
+		if _, err := p.messenger.SendImage(ctx, &sharedmodel.SendImageRequest{
+			DomainID: gate.DomainID,
+			From:     peers.From,
+			To:       peers.To,
+			Image: sharedmodel.ImageRequest{
+				Body: body,
+				Images: []*sharedmodel.Image{{
+					ID:       uploaded.ID,
+					FileName: name,
+					MimeType: mimeType,
+				}},
+			},
+		}); err != nil {
+			p.logger.Error("failed to send image", "fileName", name, "err", err)
+			return false
+		}
+		return true
+	}
+
+	if _, err := p.messenger.SendDocument(ctx, &sharedmodel.SendDocumentRequest{
+		DomainID: gate.DomainID,
+		From:     peers.From,
+		To:       peers.To,
+		Document: sharedmodel.DocumentRequest{
+			Body: body,
+			Documents: []*sharedmodel.Document{{
+				ID:       uploaded.ID,
+				FileName: name,
+				MimeType: mimeType,
+				Size:     max(c.Size, uploaded.Size, 1),
+			}},
+		},
+	}); err != nil {
+		p.logger.Error("failed to send document", "fileName", name, "err", err)
+		return false
+	}
+	return true
+}
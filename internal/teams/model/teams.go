@@ -0,0 +1,96 @@
+package model
+
+import (
+	"time"
+
+	sharedmodel "github.com/webitel/im-providers-service/internal/core/model"
+)
+
+// TeamsGate represents an Azure Bot registration serving Microsoft Teams.
+type TeamsGate struct {
+	ID       string           `json:"id" db:"id"`
+	DomainID int64            `json:"domain_id" db:"domain_id"`
+	Peer     sharedmodel.Peer `json:"peer" db:"peer"`
+	Name     string           `json:"name" db:"name"`
+	// AppID and AppPassword are the Microsoft App ID and client secret of the bot.
+	// TenantID is set for single-tenant bots, whose tokens are issued by their own tenant.
+	AppID       string `json:"app_id" db:"app_id"`
+	AppPassword string `json:"-" db:"app_password"`
+	TenantID    string `json:"tenant_id" db:"tenant_id"`
+	// ServiceURL is the Bot Connector endpoint of the latest activity; replies are sent there.
+	ServiceURL string `json:"service_url" db:"service_url"`
+	// WebhookURL is the messaging endpoint to configure in the Azure Bot resource.
+	WebhookURL string                 `json:"webhook_url" db:"webhook_url"`
+	Status     sharedmodel.GateStatus `json:"status" db:"status"`
+	CreatedAt  time.Time              `json:"created_at" db:"created_at"`
+	UpdatedAt  time.Time              `json:"updated_at" db:"updated_at"`
+	Enabled    bool                   `json:"enabled" db:"enabled"`
+}
+
+// Credentials returns the credentials the bot authenticates to the Bot Connector with.
+func (g *TeamsGate) Credentials() Credentials {
+	return Credentials{AppID: g.AppID, AppPassword: g.AppPassword, TenantID: g.TenantID}
+}
+
+// Credentials are the client credentials of a bot.
+type Credentials struct {
+	AppID       string
+	AppPassword string
+	TenantID    string
+}
+
+type CreateTeams struct {
+	Name        string
+	Dc          int64
+	AppID       string
+	AppPassword string
+	TenantID    string
+	Peer        sharedmodel.Peer
+}
+
+type UpdateTeams struct {
+	ID          string
+	Name        *string
+	AppPassword *string
+	TenantID    *string
+	Enabled     *bool
+	Peer        *sharedmodel.Peer
+}
+
+func (r UpdateTeams) ApplyTo(gate *TeamsGate) {
+	if r.Name != nil {
+		gate.Name = *r.Name
+	}
+	if r.Enabled != nil {
+		gate.Enabled = *r.Enabled
+	}
+	if r.AppPassword != nil {
+		gate.AppPassword = *r.AppPassword
+	}
+	if r.TenantID != nil {
+		gate.TenantID = *r.TenantID
+	}
+	if r.Peer != nil {
+		gate.Peer = *r.Peer
+	}
+}
+
+func (r CreateTeams) Validate() error {
+	var missing []string
+	if r.Name == "" {
+		missing = append(missing, "name")
+	}
+	if r.AppID == "" {
+		missing = append(missing, "app_id")
+	}
+	if r.AppPassword == "" {
+		missing = append(missing, "app_password")
+	}
+	if r.Dc <= 0 {
+		missing = append(missing, "domain_id")
+	}
+	if len(missing) > 0 {
+		return &sharedmodel.ValidationError{Fields: missing}
+	}
+	return nil
+}
@@ -0,0 +1,44 @@
+package teams
+
+import (
+	impb "github.com/webitel/im-providers-service/gen/go/provider/v1"
+	grpcsrv "github.com/webitel/im-providers-service/infra/srv/grpc"
+	"github.com/webitel/im-providers-service/internal/provider"
+	tmhandler "github.com/webitel/im-providers-service/internal/teams/handler"
+	tmservice "github.com/webitel/im-providers-service/internal/teams/service"
+	tmstore "github.com/webitel/im-providers-service/internal/teams/store"
+	tmpostgres "github.com/webitel/im-providers-service/internal/teams/store/postgres"
+	"go.uber.org/fx"
+)
+
+// Module provides the Teams provider adapter and the gRPC gate service.
+var Module = fx.Module("teams",
+	fx.Provide(
+		// Bot Connector client — provided as *apiClient for the provider adapter
+		// and as BotAPI for the Teams service.
+		newAPIClient,
+		func(c *apiClient) tmservice.BotAPI { return c },
+
+		// Provider adapter
+		fx.Annotate(
+			New,
+			fx.As(new(provider.Provider)),
+			fx.ResultTags(`group:"providers"`),
+		),
+
+		// Store implementations
+		fx.Annotate(tmpostgres.NewTeamsStore, fx.As(new(tmstore.TeamsStore))),
+
+		// Services
+		fx.Annotate(tmservice.NewTeamsService, fx.As(new(tmservice.TeamsManager))),
+
+		// gRPC handlers
+		tmhandler.NewTeamsHandler,
+	),
+	fx.Invoke(RegisterTeamsService),
+)
+
+// RegisterTeamsService connects the Teams gate gRPC handler to the gRPC server.
+func RegisterTeamsService(server *grpcsrv.Server, teams *tmhandler.TeamsHandler) {
+	impb.RegisterTeamsServiceServer(server.Server, teams)
+}
@@ -0,0 +1,131 @@
+package teams
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"path"
+	"strings"
+
+	"github.com/google/uuid"
+	contactv1 "github.com/webitel/im-providers-service/gen/go/contact/v1"
+	sharedmodel "github.com/webitel/im-providers-service/internal/core/model"
+	"github.com/webitel/im-providers-service/internal/provider/contactsync"
+	tmmodel "github.com/webitel/im-providers-service/internal/teams/model"
+)
+
+// textFormatMarkdown lets the document links of a message render as links.
+const textFormatMarkdown = "markdown"
+
+func (p *teamsProvider) SendText(ctx context.Context, req *sharedmodel.Message) (*sharedmodel.MessageResponse, error) {
+	g, conversationID, err := p.target(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return p.api.SendActivity(ctx, g.Credentials(), g.ServiceURL, conversationID, &outboundActivity{
+		Type: ActivityMessage,
+		Text: req.Text,
+	})
+}
+
+// SendImage sends the images as attachments Teams fetches from the media URLs.
+func (p *teamsProvider) SendImage(ctx context.Context, req *sharedmodel.Message) (*sharedmodel.MessageResponse, error) {
+	if len(req.Images) == 0 {
+		return nil, fmt.Errorf("teams: message has no images")
+	}
+	g, conversationID, err := p.target(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	a := &outboundActivity{Type: ActivityMessage, Text: req.Text}
+	for _, img := range req.Images {
+		mimeType := img.MimeType
+		if mimeType == "" {
+			mimeType = "image/png"
+		}
+		a.Attachments = append(a.Attachments, attachment{
+			ContentType: mimeType,
+			ContentURL:  img.URL,
+			Name:        fileName(img.FileName, img.URL),
+		})
+	}
+	return p.api.SendActivity(ctx, g.Credentials(), g.ServiceURL, conversationID, a)
+}
+
+// SendDocument sends the documents as links: bots can only attach files to personal
+// chats, and only after the member accepts a file consent card.
+func (p *teamsProvider) SendDocument(ctx context.Context, req *sharedmodel.Message) (*sharedmodel.MessageResponse, error) {
+	if len(req.Documents) == 0 {
+		return nil, fmt.Errorf("teams: message has no documents")
+	}
+	g, conversationID, err := p.target(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	lines := make([]string, 0, len(req.Documents)+1)
+	if req.Text != "" {
+		lines = append(lines, req.Text)
+	}
+	for _, doc := range req.Documents {
+		lines = append(lines, fmt.Sprintf("[%s](%s)", fileName(doc.FileName, doc.URL), doc.URL))
+	}
+	return p.api.SendActivity(ctx, g.Credentials(), g.ServiceURL, conversationID, &outboundActivity{
+		Type:       ActivityMessage,
+		Text:       strings.Join(lines, "\n\n"),
+		TextFormat: textFormatMarkdown,
+	})
+}
+
+// target returns the gate and the conversation a message is sent to.
+func (p *teamsProvider) target(ctx context.Context, req *sharedmodel.Message) (*tmmodel.TeamsGate, string, error) {
+	g, err := p.repo.Select(ctx, req.GateID)
+	if err != nil {
+		return nil, "", err
+	}
+	conversationID, err := p.resolveReceiver(ctx, g, req.To.Sub)
+	if err != nil {
+		return nil, "", err
+	}
+	return g, conversationID, nil
+}
+
+// resolveReceiver returns the conversation ID for the given sub.
+// A conversation ID is returned as-is; an internal contact UUID is resolved via the contact service.
+func (p *teamsProvider) resolveReceiver(ctx context.Context, gate *tmmodel.TeamsGate, contactID string) (string, error) {
+	if _, err := uuid.Parse(contactID); err != nil {
+		return contactID, nil
+	}
+	if sub, ok := p.receiverCache.Get(contactID); ok {
+		return sub, nil
+	}
+
+	authCtx := contactsync.WithGatewayIdentity(ctx, gate.DomainID, gate.Peer)
+	resp, err := p.contactClient.SearchContact(authCtx, &contactv1.SearchContactRequest{
+		Ids: []string{contactID},
+	})
+	if err != nil {
+		return "", fmt.Errorf("resolve teams conversation for %s: %w", contactID, err)
+	}
+	items := resp.GetContacts()
+	if len(items) == 0 || items[0].GetSubject() == "" {
+		return "", fmt.Errorf("resolve teams conversation for %s: contact not found or has no subject", contactID)
+	}
+	sub := items[0].GetSubject()
+	p.receiverCache.Add(contactID, sub)
+	return sub, nil
+}
+
+// fileName returns the name of a file, falling back to the last segment of its URL.
+func fileName(name, fileURL string) string {
+	if name != "" {
+		return name
+	}
+	if u, err := url.Parse(fileURL); err == nil {
+		if base := path.Base(u.Path); base != "." && base != "/" {
+			return base
+		}
+	}
+	return "file"
+}
@@ -0,0 +1,132 @@
+package teams
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/url"
+	"testing"
+
+	sharedmodel "github.com/webitel/im-providers-service/internal/core/model"
+)
+
+func outboundMessage(text string) *sharedmodel.Message {
+	return &sharedmodel.Message{GateID: testGateID, To: sharedmodel.Peer{Sub: testChannel + ";messageid=1616964412125"}, Text: text}
+}
+
+func sentActivity(t *testing.T, c apiCall) outboundActivity {
+	t.Helper()
+	var a outboundActivity
+	if err := json.Unmarshal([]byte(c.body), &a); err != nil {
+		t.Fatalf("decode activity: %v", err)
+	}
+	return a
+}
+
+func TestSendText(t *testing.T) {
+	p := newRegisteredBot(t)
+
+	for range 2 {
+		resp, err := p.SendText(context.Background(), outboundMessage("Your refund is on its way."))
+		if err != nil {
+			t.Fatalf("SendText() error = %v", err)
+		}
+		if resp.ID != "1:activity-1" {
+			t.Errorf("SendText() id = %q", resp.ID)
+		}
+	}
+
+	calls := p.stub.activities()
+	if len(calls) != 2 {
+		t.Fatalf("activities sent = %d, want 2", len(calls))
+	}
+	wantPath := "/v3/conversations/" + url.PathEscape(testChannel+";messageid=1616964412125") + "/activities"
+	if calls[0].path != wantPath {
+		t.Errorf("path = %q, want %q", calls[0].path, wantPath)
+	}
+	if calls[0].auth != "Bearer "+testToken {
+		t.Errorf("authorization = %q, want the bot token", calls[0].auth)
+	}
+	if a := sentActivity(t, calls[0]); a.Type != ActivityMessage || a.Text != "Your refund is on its way." {
+		t.Errorf("activity = %+v", a)
+	}
+
+	var logins int
+	for _, c := range p.stub.sent() {
+		if c.method == http.MethodPost && c.path == "/login/botframework.com/oauth2/v2.0/token" {
+			logins++
+		}
+	}
+	if logins != 1 {
+		t.Errorf("token requests = %d, the token is cached", logins)
+	}
+}
+
+func TestSendImage(t *testing.T) {
+	p := newRegisteredBot(t)
+	req := outboundMessage("Here is the label")
+	req.Images = []*sharedmodel.Image{{URL: "https://media.example.com/label.jpg", MimeType: "image/jpeg"}}
+
+	if _, err := p.SendImage(context.Background(), req); err != nil {
+		t.Fatalf("SendImage() error = %v", err)
+	}
+
+	a := sentActivity(t, p.stub.activities()[0])
+	if a.Text != "Here is the label" || len(a.Attachments) != 1 {
+		t.Fatalf("activity = %+v", a)
+	}
+	if att := a.Attachments[0]; att.ContentType != "image/jpeg" || att.ContentURL != "https://media.example.com/label.jpg" || att.Name != "label.jpg" {
+		t.Errorf("attachment = %+v", att)
+	}
+}
+
+func TestSendDocument(t *testing.T) {
+	p := newRegisteredBot(t)
+	req := outboundMessage("The contract")
+	req.Documents = []*sharedmodel.Document{{URL: "https://media.example.com/contract.pdf", FileName: "contract.pdf"}}
+
+	if _, err := p.SendDocument(context.Background(), req); err != nil {
+		t.Fatalf("SendDocument() error = %v", err)
+	}
+
+	a := sentActivity(t, p.stub.activities()[0])
+	want := "The contract\n\n[contract.pdf](https://media.example.com/contract.pdf)"
+	if a.Text != want || a.TextFormat != textFormatMarkdown || len(a.Attachments) != 0 {
+		t.Errorf("activity = %+v, want the document as a markdown link", a)
+	}
+}
+
+func TestSendText_Errors(t *testing.T) {
+	t.Run("no service url yet", func(t *testing.T) {
+		p := newRegisteredBot(t)
+		p.bots[testGateID].ServiceURL = ""
+
+		if _, err := p.SendText(context.Background(), outboundMessage("hi")); err == nil {
+			t.Fatal("SendText() succeeded before any activity was received")
+		}
+	})
+
+	t.Run("conversation not found", func(t *testing.T) {
+		p := newRegisteredBot(t)
+		p.stub.status = http.StatusNotFound
+
+		_, err := p.SendText(context.Background(), outboundMessage("hi"))
+		if !errors.Is(err, ErrConversationNotFound) {
+			t.Fatalf("SendText() error = %v, want ErrConversationNotFound", err)
+		}
+	})
+
+	t.Run("credentials rejected", func(t *testing.T) {
+		p := newRegisteredBot(t)
+		p.bots[testGateID].AppPassword = "revoked"
+
+		_, err := p.SendText(context.Background(), outboundMessage("hi"))
+		if !errors.Is(err, ErrUnauthorized) {
+			t.Fatalf("SendText() error = %v, want ErrUnauthorized", err)
+		}
+		if len(p.stub.activities()) != 0 {
+			t.Error("an activity was sent without a token")
+		}
+	})
+}
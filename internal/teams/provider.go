@@ -0,0 +1,124 @@
+// Package teams implements the Microsoft Teams provider: personal chats, group chats
+// and channel threads of an Azure Bot are bridged through the Bot Framework
+// activity webhook and the Bot Connector API.
+// https://learn.microsoft.com/en-us/azure/bot-service/rest-api/bot-framework-rest-connector-concepts
+package teams
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	lru "github.com/hashicorp/golang-lru/v2"
+	"github.com/hashicorp/golang-lru/v2/expirable"
+	imcontact "github.com/webitel/im-providers-service/infra/client/grpc/im-contact"
+	imgateway "github.com/webitel/im-providers-service/infra/client/grpc/im-gateway"
+	sharedsvc "github.com/webitel/im-providers-service/internal/core/service"
+	sharedstore "github.com/webitel/im-providers-service/internal/core/store"
+	"github.com/webitel/im-providers-service/internal/provider"
+	tmmodel "github.com/webitel/im-providers-service/internal/teams/model"
+	tmstore "github.com/webitel/im-providers-service/internal/teams/store"
+)
+
+const (
+	// seenTTL covers the redeliveries of an activity the Bot Connector did not get
+	// an answer for in time.
+	seenTTL     = time.Hour
+	seenEntries = 10000
+)
+
+type teamsProvider struct {
+	api           botAPI
+	verifier      *tokenVerifier
+	logger        *slog.Logger
+	messenger     sharedsvc.Messenger
+	gateCache     sharedstore.GateCache
+	userCache     sharedstore.ExternalUserCache
+	repo          tmstore.TeamsStore
+	gatewayer     *imgateway.Client
+	media         sharedsvc.MediaManager
+	contactClient *imcontact.Client
+	// receiverCache maps internal contact UUID → conversation ID to avoid an
+	// im-contact round-trip on every outbound message.
+	receiverCache *lru.Cache[string, string]
+	// seen holds the activities already routed.
+	seenMu sync.Mutex
+	seen   *expirable.LRU[string, struct{}]
+}
+
+func New(
+	m sharedsvc.Messenger,
+	l *slog.Logger,
+	gc sharedstore.GateCache,
+	uc sharedstore.ExternalUserCache,
+	repo tmstore.TeamsStore,
+	gatewayer *imgateway.Client,
+	media sharedsvc.MediaManager,
+	contactClient *imcontact.Client,
+	api *apiClient,
+) provider.Provider {
+	receiverCache, _ := lru.New[string, string](1000)
+	return &teamsProvider{
+		api:           api,
+		verifier:      newTokenVerifier(),
+		logger:        l.With("provider", "teams"),
+		messenger:     m,
+		gateCache:     gc,
+		userCache:     uc,
+		repo:          repo,
+		gatewayer:     gatewayer,
+		media:         media,
+		contactClient: contactClient,
+		receiverCache: receiverCache,
+		seen:          expirable.NewLRU[string, struct{}](seenEntries, nil, seenTTL),
+	}
+}
+
+var (
+	_ provider.SignatureValidator = (*teamsProvider)(nil)
+	_ provider.SignatureHeader    = (*teamsProvider)(nil)
+)
+
+func (p *teamsProvider) Type() string { return "teams" }
+
+// resolveGate returns the gate a webhook was delivered to. The webhook URI segment is
+// the gate ID, configured as the messaging endpoint of the Azure Bot. Disabled gates
+// are short-circuited from the cache to avoid a DB round-trip on every request.
+func (p *teamsProvider) resolveGate(ctx context.Context) (*tmmodel.TeamsGate, error) {
+	gateID, _ := ctx.Value(provider.WebhookURIKey).(string)
+	if _, err := uuid.Parse(gateID); err != nil {
+		return nil, fmt.Errorf("invalid webhook uri %q: %w", gateID, sharedstore.ErrNotFound)
+	}
+
+	if cached, ok := p.gateCache.Get(gateID); ok && !cached.Enabled {
+		return &tmmodel.TeamsGate{ID: gateID, Enabled: false}, nil
+	}
+
+	g, err := p.repo.Select(ctx, gateID)
+	if err != nil {
+		return nil, err
+	}
+	p.gateCache.Set(gateID, sharedstore.GateState{
+		GateID:  g.ID,
+		Enabled: g.Enabled,
+		Issuer:  g.Peer.Iss,
+		Sub:     g.Peer.Sub,
+		Domain:  g.DomainID,
+	})
+	return g, nil
+}
+
+// firstSeen reports whether the key is seen for the first time and remembers it.
+func (p *teamsProvider) firstSeen(key string) bool {
+	p.seenMu.Lock()
+	defer p.seenMu.Unlock()
+
+	if p.seen.Contains(key) {
+		return false
+	}
+	p.seen.Add(key, struct{}{})
+	return true
+}
@@ -0,0 +1,268 @@
+package teams
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	sharedmodel "github.com/webitel/im-providers-service/internal/core/model"
+	sharedstore "github.com/webitel/im-providers-service/internal/core/store"
+	"github.com/webitel/im-providers-service/internal/provider"
+	"github.com/webitel/im-providers-service/internal/provider/providertest"
+	tmmodel "github.com/webitel/im-providers-service/internal/teams/model"
+	tmstore "github.com/webitel/im-providers-service/internal/teams/store"
+)
+
+const (
+	testGateID      = "0190a8a4-5f0e-7c2a-9d3b-0a1b2c3d4e5f"
+	testAppID       = "8d5b0bd1-5a9b-4c0e-9f3a-6f1e2d3c4b5a"
+	testAppPassword = "kX98Q~secret"
+	testBotID       = "28:" + testAppID
+	testKeyID       = "test-key"
+	testToken       = "eyJ0eXAiOiJKV1QiLCJhbGciOiJSUzI1NiJ9.bot-token"
+)
+
+var noopLogger = slog.New(slog.NewTextHandler(io.Discard, nil))
+
+// -- Bot Connector stub --
+
+type apiCall struct {
+	method string
+	path   string
+	auth   string
+	body   string
+}
+
+// connectorStub serves everything the provider talks to: the token endpoint
+// (/login/...), the OpenID metadata and keys of the Bot Connector (/openid, /keys),
+// the Bot Connector API (/v3/...) and attachments (/attachments/...).
+type connectorStub struct {
+	mu    sync.Mutex
+	calls []apiCall
+	// status overrides the status of the Bot Connector API replies when set.
+	status int
+	key    *rsa.PrivateKey
+	// endorsements are the channels the signing key is endorsed for.
+	endorsements []string
+	url          string
+}
+
+func newConnectorStub(t *testing.T) (*connectorStub, *apiClient, *tokenVerifier) {
+	t.Helper()
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("generate key: %v", err)
+	}
+	stub := &connectorStub{key: key, endorsements: []string{"msteams"}}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		raw, _ := io.ReadAll(r.Body)
+		stub.mu.Lock()
+		stub.calls = append(stub.calls, apiCall{method: r.Method, path: r.URL.EscapedPath(), auth: r.Header.Get("Authorization"), body: string(raw)})
+		status, endorsements := stub.status, stub.endorsements
+		stub.mu.Unlock()
+
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case strings.HasPrefix(r.URL.Path, "/login/"):
+			if form, _ := url.ParseQuery(string(raw)); form.Get("client_secret") != testAppPassword {
+				w.WriteHeader(http.StatusUnauthorized)
+				_, _ = w.Write([]byte(`{"error":"invalid_client","error_description":"AADSTS7000215: Invalid client secret provided."}`))
+				return
+			}
+			_, _ = w.Write([]byte(`{"token_type":"Bearer","expires_in":3599,"access_token":"` + testToken + `"}`))
+		case r.URL.Path == "/openid":
+			_, _ = w.Write([]byte(`{"issuer":"https://api.botframework.com","jwks_uri":"` + stub.url + `/keys"}`))
+		case r.URL.Path == "/keys":
+			_ = json.NewEncoder(w).Encode(map[string]any{"keys": []map[string]any{{
+				"kty":          "RSA",
+				"use":          "sig",
+				"kid":          testKeyID,
+				"n":            base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+				"e":            base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+				"endorsements": endorsements,
+			}}})
+		case strings.HasPrefix(r.URL.Path, "/attachments/"):
+			w.Header().Set("Content-Type", "image/png")
+			_, _ = w.Write([]byte("content of " + strings.TrimPrefix(r.URL.Path, "/attachments/")))
+		case status != 0:
+			w.WriteHeader(status)
+			_, _ = w.Write([]byte(`{"error":{"code":"ConversationNotFound","message":"Conversation not found."}}`))
+		default:
+			w.WriteHeader(http.StatusCreated)
+			_, _ = w.Write([]byte(`{"id":"1:activity-1"}`))
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	stub.url = server.URL
+	api := newAPIClient(noopLogger)
+	api.loginURL = server.URL + "/login"
+	verifier := newTokenVerifier()
+	verifier.configURL = server.URL + "/openid"
+	return stub, api, verifier
+}
+
+func (s *connectorStub) sent() []apiCall {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]apiCall(nil), s.calls...)
+}
+
+// serviceURL is the Bot Connector endpoint activities are sent from.
+func (s *connectorStub) serviceURL() string { return s.url + "/" }
+
+// activities returns the calls to the Bot Connector API, in order.
+func (s *connectorStub) activities() []apiCall {
+	var out []apiCall
+	for _, c := range s.sent() {
+		if strings.HasPrefix(c.path, "/v3/") {
+			out = append(out, c)
+		}
+	}
+	return out
+}
+
+// sign issues a Bot Connector token with the given claims overriding the valid defaults.
+func (s *connectorStub) sign(t *testing.T, overrides map[string]any) string {
+	t.Helper()
+
+	claims := map[string]any{
+		"iss":        botFrameworkIssuer,
+		"aud":        testAppID,
+		"exp":        time.Now().Add(time.Hour).Unix(),
+		"nbf":        time.Now().Add(-time.Minute).Unix(),
+		"serviceurl": s.serviceURL(),
+	}
+	for k, v := range overrides {
+		claims[k] = v
+	}
+	header, _ := json.Marshal(map[string]string{"typ": "JWT", "alg": "RS256", "kid": testKeyID})
+	payload, _ := json.Marshal(claims)
+
+	signed := base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(payload)
+	digest := sha256.Sum256([]byte(signed))
+	sig, err := rsa.SignPKCS1v15(rand.Reader, s.key, crypto.SHA256, digest[:])
+	if err != nil {
+		t.Fatalf("sign token: %v", err)
+	}
+	return signed + "." + base64.RawURLEncoding.EncodeToString(sig)
+}
+
+// newFileServer serves pre-authenticated file downloads on a host of its own and
+// returns its URL with the Authorization header of the last download.
+func newFileServer(t *testing.T) (string, func() string) {
+	t.Helper()
+
+	var (
+		mu   sync.Mutex
+		auth string
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		auth = r.Header.Get("Authorization")
+		mu.Unlock()
+		w.Header().Set("Content-Type", "application/octet-stream")
+		_, _ = w.Write([]byte("content of " + r.URL.Path))
+	}))
+	t.Cleanup(server.Close)
+
+	return server.URL, func() string {
+		mu.Lock()
+		defer mu.Unlock()
+		return auth
+	}
+}
+
+// -- fakes --
+
+// botRegistrations serves the gates to the webhook and to outbound activities. Besides
+// reading gates the provider only follows the service URL of the latest activity.
+type botRegistrations map[string]*tmmodel.TeamsGate
+
+var _ tmstore.TeamsStore = botRegistrations(nil)
+
+func (r botRegistrations) Select(_ context.Context, id string) (*tmmodel.TeamsGate, error) {
+	g, ok := r[id]
+	if !ok {
+		return nil, sharedstore.ErrNotFound
+	}
+	cp := *g
+	return &cp, nil
+}
+
+func (r botRegistrations) SetServiceURL(_ context.Context, id, serviceURL string) error {
+	g, ok := r[id]
+	if !ok {
+		return sharedstore.ErrNotFound
+	}
+	g.ServiceURL = serviceURL
+	return nil
+}
+
+func (botRegistrations) Insert(context.Context, int64, *tmmodel.TeamsGate) error {
+	panic("teams provider must not insert gates")
+}
+
+func (botRegistrations) Update(context.Context, *tmmodel.TeamsGate) error {
+	panic("teams provider must not update gates")
+}
+
+func (botRegistrations) Delete(context.Context, string) error {
+	panic("teams provider must not delete gates")
+}
+
+// supportBot is the bot registration of the gate; replies go to serviceURL.
+func supportBot(serviceURL string) *tmmodel.TeamsGate {
+	return &tmmodel.TeamsGate{
+		ID:          testGateID,
+		DomainID:    1,
+		Name:        "Support",
+		Peer:        sharedmodel.Peer{Sub: "bot-1", Iss: "teams"},
+		AppID:       testAppID,
+		AppPassword: testAppPassword,
+		ServiceURL:  serviceURL,
+		Enabled:     true,
+	}
+}
+
+// registeredBot is the provider of the support bot talking to the Bot Connector stub,
+// whose tokens it accepts.
+type registeredBot struct {
+	*teamsProvider
+	stub      *connectorStub
+	messenger *providertest.Messenger
+	media     *providertest.Media
+	bots      botRegistrations
+}
+
+func newRegisteredBot(t *testing.T) *registeredBot {
+	t.Helper()
+
+	stub, api, verifier := newConnectorStub(t)
+	messenger := &providertest.Messenger{}
+	media := &providertest.Media{}
+	bots := botRegistrations{testGateID: supportBot(stub.serviceURL())}
+
+	p := New(messenger, noopLogger, providertest.NewGateCache(), providertest.KnownUsers{}, bots, nil, media, nil, api).(*teamsProvider)
+	p.verifier = verifier
+	return &registeredBot{teamsProvider: p, stub: stub, messenger: messenger, media: media, bots: bots}
+}
+
+func webhookContext(gateID string) context.Context {
+	return context.WithValue(context.Background(), provider.WebhookURIKey, gateID)
+}
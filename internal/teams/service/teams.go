@@ -0,0 +1,136 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/webitel/im-providers-service/config"
+	tmmodel "github.com/webitel/im-providers-service/internal/teams/model"
+	tmstore "github.com/webitel/im-providers-service/internal/teams/store"
+)
+
+// ErrPublicURLNotSet is returned when a messaging endpoint is to be issued but service.public_url is empty.
+var ErrPublicURLNotSet = errors.New("teams: service.public_url is not configured")
+
+var _ TeamsManager = (*TeamsService)(nil)
+
+type TeamsManager interface {
+	CreateGate(ctx context.Context, req tmmodel.CreateTeams) (*tmmodel.TeamsGate, error)
+	GetGate(ctx context.Context, id string) (*tmmodel.TeamsGate, error)
+	UpdateGate(ctx context.Context, req tmmodel.UpdateTeams) (*tmmodel.TeamsGate, error)
+	DeleteGate(ctx context.Context, id string) (*tmmodel.TeamsGate, error)
+}
+
+// BotAPI is the subset of the Bot Connector client used to check the bot credentials.
+// Defined here (exported) so the parent teams package can satisfy it without an import cycle.
+type BotAPI interface {
+	Authenticate(ctx context.Context, creds tmmodel.Credentials) error
+}
+
+type TeamsService struct {
+	repo   tmstore.TeamsStore
+	botAPI BotAPI
+	cfg    *config.Config
+	log    *slog.Logger
+}
+
+func NewTeamsService(repo tmstore.TeamsStore, botAPI BotAPI, cfg *config.Config, log *slog.Logger) *TeamsService {
+	return &TeamsService{
+		repo:   repo,
+		botAPI: botAPI,
+		cfg:    cfg,
+		log:    log.With("layer", "service", "domain", "teams_gate"),
+	}
+}
+
+// CreateGate stores the gate of an Azure Bot once its credentials are accepted. The
+// returned webhook URL is to be entered as the messaging endpoint of the bot; the
+// service URL replies are posted to is learned from the first activity.
+func (s *TeamsService) CreateGate(ctx context.Context, req tmmodel.CreateTeams) (*tmmodel.TeamsGate, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	if s.cfg.Service.PublicURL == "" {
+		return nil, ErrPublicURLNotSet
+	}
+
+	gate := &tmmodel.TeamsGate{
+		Name:        req.Name,
+		AppID:       req.AppID,
+		AppPassword: req.AppPassword,
+		TenantID:    req.TenantID,
+		Peer:        req.Peer,
+		Enabled:     true,
+	}
+	if err := s.botAPI.Authenticate(ctx, gate.Credentials()); err != nil {
+		return nil, fmt.Errorf("teams authenticate: %w", err)
+	}
+
+	if err := s.repo.Insert(ctx, req.Dc, gate); err != nil {
+		s.log.Error("failed to create teams gate", "app_id", req.AppID, "err", err)
+		return nil, err
+	}
+
+	// The messaging endpoint contains the gate ID, known only once the gate is stored.
+	gate.WebhookURL = s.webhookURL(gate.ID)
+	if err := s.repo.Update(ctx, gate); err != nil {
+		s.log.Error("failed to store teams messaging endpoint", "id", gate.ID, "err", err)
+		return nil, err
+	}
+
+	s.log.Info("teams gate created", "id", gate.ID, "app_id", gate.AppID, "webhook", gate.WebhookURL)
+	return gate, nil
+}
+
+func (s *TeamsService) GetGate(ctx context.Context, id string) (*tmmodel.TeamsGate, error) {
+	return s.repo.Select(ctx, id)
+}
+
+// UpdateGate applies the changes; new credentials are checked before they are stored.
+func (s *TeamsService) UpdateGate(ctx context.Context, req tmmodel.UpdateTeams) (*tmmodel.TeamsGate, error) {
+	gate, err := s.repo.Select(ctx, req.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	prev := gate.Credentials()
+	req.ApplyTo(gate)
+
+	if creds := gate.Credentials(); creds != prev {
+		if err := s.botAPI.Authenticate(ctx, creds); err != nil {
+			return nil, fmt.Errorf("teams authenticate: %w", err)
+		}
+	}
+
+	if err := s.repo.Update(ctx, gate); err != nil {
+		s.log.Error("failed to update teams gate", "id", req.ID, "err", err)
+		return nil, err
+	}
+
+	s.log.Info("teams gate updated", "id", gate.ID, "app_id", gate.AppID)
+	return gate, nil
+}
+
+// DeleteGate removes the gate. The bot stays installed in Teams; its activities are
+// answered not found until the messaging endpoint is changed or the bot is removed.
+func (s *TeamsService) DeleteGate(ctx context.Context, id string) (*tmmodel.TeamsGate, error) {
+	gate, err := s.repo.Select(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.repo.Delete(ctx, id); err != nil {
+		s.log.Error("failed to delete teams gate", "id", id, "err", err)
+		return nil, err
+	}
+
+	s.log.Warn("teams gate removed", "id", id, "app_id", gate.AppID)
+	return gate, nil
+}
+
+// webhookURL is the messaging endpoint of the gate: the gate ID is the webhook URI segment.
+func (s *TeamsService) webhookURL(gateID string) string {
+	return s.cfg.Service.PublicURL + s.cfg.Service.WebhookPath + "/teams/" + gateID
+}
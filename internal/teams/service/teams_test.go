@@ -0,0 +1,179 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"testing"
+
+	"github.com/webitel/im-providers-service/config"
+	sharedstore "github.com/webitel/im-providers-service/internal/core/store"
+	tmmodel "github.com/webitel/im-providers-service/internal/teams/model"
+	tmstore "github.com/webitel/im-providers-service/internal/teams/store"
+)
+
+var noopLogger = slog.New(slog.NewTextHandler(io.Discard, nil))
+
+// registrations stores the gates under sequential IDs.
+type registrations map[string]*tmmodel.TeamsGate
+
+var _ tmstore.TeamsStore = registrations(nil)
+
+func (r registrations) Insert(_ context.Context, dc int64, g *tmmodel.TeamsGate) error {
+	g.ID = fmt.Sprintf("gate-%d", len(r)+1)
+	g.DomainID = dc
+	cp := *g
+	r[g.ID] = &cp
+	return nil
+}
+
+func (r registrations) Select(_ context.Context, id string) (*tmmodel.TeamsGate, error) {
+	g, ok := r[id]
+	if !ok {
+		return nil, sharedstore.ErrNotFound
+	}
+	cp := *g
+	return &cp, nil
+}
+
+func (r registrations) Update(_ context.Context, g *tmmodel.TeamsGate) error {
+	cp := *g
+	r[g.ID] = &cp
+	return nil
+}
+
+func (r registrations) SetServiceURL(_ context.Context, id, serviceURL string) error {
+	r[id].ServiceURL = serviceURL
+	return nil
+}
+
+func (r registrations) Delete(_ context.Context, id string) error {
+	delete(r, id)
+	return nil
+}
+
+// appTenant issues Bot Connector tokens to the apps whose client secret matches and
+// records every sign-in.
+type appTenant struct {
+	// secrets are the valid passwords by app ID.
+	secrets map[string]string
+	signIns []tmmodel.Credentials
+}
+
+func (a *appTenant) Authenticate(_ context.Context, creds tmmodel.Credentials) error {
+	a.signIns = append(a.signIns, creds)
+	if a.secrets[creds.AppID] != creds.AppPassword {
+		return errors.New("invalid_client")
+	}
+	return nil
+}
+
+func newTeamsService(publicURL string) (*TeamsService, registrations, *appTenant) {
+	regs := registrations{}
+	tenant := &appTenant{secrets: map[string]string{"app-1": "secret-1"}}
+	cfg := &config.Config{Service: config.ServiceConfig{PublicURL: publicURL, WebhookPath: "/wh"}}
+	return NewTeamsService(regs, tenant, cfg, noopLogger), regs, tenant
+}
+
+func createRequest() tmmodel.CreateTeams {
+	return tmmodel.CreateTeams{Name: "Support", Dc: 1, AppID: "app-1", AppPassword: "secret-1"}
+}
+
+func TestCreateGate_IssuesMessagingEndpoint(t *testing.T) {
+	svc, regs, _ := newTeamsService("https://im.example.com")
+
+	gate, err := svc.CreateGate(context.Background(), createRequest())
+	if err != nil {
+		t.Fatalf("CreateGate: %v", err)
+	}
+
+	const wantURL = "https://im.example.com/wh/teams/gate-1"
+	stored := regs["gate-1"]
+	if stored == nil || stored.WebhookURL != wantURL || stored.AppID != "app-1" || stored.AppPassword != "secret-1" || !stored.Enabled {
+		t.Errorf("unexpected stored gate: %+v", stored)
+	}
+	if gate.WebhookURL != wantURL {
+		t.Errorf("returned gate webhook = %q", gate.WebhookURL)
+	}
+}
+
+func TestCreateGate_Rejected(t *testing.T) {
+	tests := []struct {
+		name      string
+		publicURL string
+		req       tmmodel.CreateTeams
+		wantErr   error
+	}{
+		{name: "missing fields", publicURL: "https://im.example.com", req: tmmodel.CreateTeams{Dc: 1}},
+		{name: "public url not set", req: createRequest(), wantErr: ErrPublicURLNotSet},
+		{name: "invalid credentials", publicURL: "https://im.example.com", req: tmmodel.CreateTeams{Name: "x", Dc: 1, AppID: "app-1", AppPassword: "bad"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc, regs, _ := newTeamsService(tt.publicURL)
+			_, err := svc.CreateGate(context.Background(), tt.req)
+			if err == nil {
+				t.Fatal("expected an error")
+			}
+			if tt.wantErr != nil && !errors.Is(err, tt.wantErr) {
+				t.Errorf("error = %v, want %v", err, tt.wantErr)
+			}
+			if len(regs) != 0 {
+				t.Errorf("no gate must be stored, got %v", regs)
+			}
+		})
+	}
+}
+
+func TestUpdateGate_Credentials(t *testing.T) {
+	svc, regs, tenant := newTeamsService("https://im.example.com")
+	if _, err := svc.CreateGate(context.Background(), createRequest()); err != nil {
+		t.Fatalf("CreateGate: %v", err)
+	}
+
+	name := "Billing"
+	if _, err := svc.UpdateGate(context.Background(), tmmodel.UpdateTeams{ID: "gate-1", Name: &name}); err != nil {
+		t.Fatalf("UpdateGate: %v", err)
+	}
+	if len(tenant.signIns) != 1 {
+		t.Errorf("authentications = %d, unchanged credentials are not checked again", len(tenant.signIns))
+	}
+
+	bad := "bad"
+	if _, err := svc.UpdateGate(context.Background(), tmmodel.UpdateTeams{ID: "gate-1", AppPassword: &bad}); err == nil {
+		t.Fatal("expected an error")
+	}
+	if regs["gate-1"].AppPassword != "secret-1" {
+		t.Errorf("rejected password must not be stored: %+v", regs["gate-1"])
+	}
+
+	tenant.secrets["app-1"] = "secret-2"
+	rotated := "secret-2"
+	if _, err := svc.UpdateGate(context.Background(), tmmodel.UpdateTeams{ID: "gate-1", AppPassword: &rotated}); err != nil {
+		t.Fatalf("UpdateGate: %v", err)
+	}
+	if stored := regs["gate-1"]; stored.AppPassword != "secret-2" || stored.Name != "Billing" {
+		t.Errorf("unexpected stored gate: %+v", stored)
+	}
+}
+
+func TestDeleteGate(t *testing.T) {
+	svc, regs, _ := newTeamsService("https://im.example.com")
+	if _, err := svc.CreateGate(context.Background(), createRequest()); err != nil {
+		t.Fatalf("CreateGate: %v", err)
+	}
+
+	if _, err := svc.DeleteGate(context.Background(), "gate-1"); err != nil {
+		t.Fatalf("DeleteGate: %v", err)
+	}
+	if len(regs) != 0 {
+		t.Errorf("gate not removed: %v", regs)
+	}
+
+	if _, err := svc.DeleteGate(context.Background(), "gate-1"); !errors.Is(err, sharedstore.ErrNotFound) {
+		t.Errorf("second delete error = %v, want ErrNotFound", err)
+	}
+}
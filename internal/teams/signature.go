@@ -0,0 +1,54 @@
+package teams
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// authorizationHeader carries the Bot Connector token of an activity.
+const authorizationHeader = "Authorization"
+
+// SignatureHeader implements provider.SignatureHeader.
+func (p *teamsProvider) SignatureHeader() string { return authorizationHeader }
+
+// ValidateSignature implements provider.SignatureValidator: every activity carries a
+// JWT of the Bot Connector, issued for the bot and bound to the service URL the
+// activity is sent from, which replies are posted to.
+func (p *teamsProvider) ValidateSignature(ctx context.Context, header string, body []byte) error {
+	token, ok := strings.CutPrefix(header, "Bearer ")
+	if !ok || token == "" {
+		return fmt.Errorf("missing bearer token")
+	}
+
+	gate, err := p.resolveGate(ctx)
+	if err != nil {
+		return fmt.Errorf("signature: gate lookup failed: %w", err)
+	}
+	if !gate.Enabled {
+		// Activities of a disabled gate are acknowledged and dropped by HandleWebhook.
+		return nil
+	}
+
+	claims, endorsements, err := p.verifier.Verify(ctx, token, gate.AppID)
+	if err != nil {
+		return err
+	}
+
+	var a struct {
+		ServiceURL string `json:"serviceUrl"`
+		ChannelID  string `json:"channelId"`
+	}
+	if err := json.Unmarshal(body, &a); err != nil {
+		return fmt.Errorf("malformed activity: %w", err)
+	}
+	if strings.TrimRight(claims.ServiceURL, "/") != strings.TrimRight(a.ServiceURL, "/") {
+		return fmt.Errorf("token issued for service url %q, activity sent from %q", claims.ServiceURL, a.ServiceURL)
+	}
+	if len(endorsements) > 0 && !slices.Contains(endorsements, a.ChannelID) {
+		return fmt.Errorf("signing key not endorsed for channel %q", a.ChannelID)
+	}
+	return nil
+}
@@ -0,0 +1,179 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/georgysavva/scany/v2/pgxscan"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/webitel/im-providers-service/infra/db/pg"
+	sharedmodel "github.com/webitel/im-providers-service/internal/core/model"
+	sharedstore "github.com/webitel/im-providers-service/internal/core/store"
+	tmmodel "github.com/webitel/im-providers-service/internal/teams/model"
+	tmstore "github.com/webitel/im-providers-service/internal/teams/store"
+	"github.com/webitel/im-providers-service/pkg/crypto"
+)
+
+var _ tmstore.TeamsStore = (*teamsStore)(nil)
+
+type teamsStore struct {
+	pool   *pgxpool.Pool
+	crypto crypto.Encryptor
+	cache  sharedstore.GateCache
+}
+
+func NewTeamsStore(pool *pgxpool.Pool, crypt crypto.Encryptor, cache sharedstore.GateCache) tmstore.TeamsStore {
+	return &teamsStore{
+		pool:   pool,
+		crypto: crypt,
+		cache:  cache,
+	}
+}
+
+func (s *teamsStore) Insert(ctx context.Context, dc int64, g *tmmodel.TeamsGate) error {
+	password, err := s.crypto.Encrypt(g.AppPassword)
+	if err != nil {
+		return fmt.Errorf("crypto: %w", err)
+	}
+
+	const query = `
+	WITH new_gate AS (
+		INSERT INTO im_provider.gates (dc, name, type, enabled)
+		VALUES ($1, $2, 'teams', $3)
+		RETURNING id, name, created_at, updated_at
+	),
+	new_bot AS (
+		INSERT INTO im_provider.bots (sub, iss, gate_id)
+		SELECT $4, $5, id FROM new_gate
+		RETURNING id
+	)
+	INSERT INTO im_provider.teams (gate_id, app_id, app_password, tenant_id, service_url, webhook_url)
+	SELECT id, $6, $7, $8, $9, $10 FROM new_gate
+	RETURNING
+		gate_id,
+		(SELECT name FROM new_gate),
+		(SELECT created_at FROM new_gate),
+		(SELECT updated_at FROM new_gate)`
+
+	err = s.pool.QueryRow(ctx, query,
+		dc, g.Name, g.Enabled, g.Peer.Sub, g.Peer.Iss, g.AppID, password, g.TenantID, g.ServiceURL, g.WebhookURL,
+	).Scan(&g.ID, &g.Name, &g.CreatedAt, &g.UpdatedAt)
+	if err != nil {
+		if pg.IsUniqueViolation(err) {
+			return fmt.Errorf("teams bot %s is already bound: %w", g.AppID, sharedstore.ErrConflict)
+		}
+		return fmt.Errorf("postgres: insert teams gateway: %w", err)
+	}
+
+	g.DomainID = dc
+	s.mapVirtualFields(g)
+	return nil
+}
+
+func (s *teamsStore) Select(ctx context.Context, id string) (*tmmodel.TeamsGate, error) {
+	const query = `
+	SELECT
+		g.id,
+		g.dc AS domain_id,
+		g.name,
+		g.enabled,
+		g.created_at,
+		g.updated_at,
+		b.sub AS "peer.sub",
+		b.iss AS "peer.iss",
+		tm.app_id,
+		tm.app_password,
+		tm.tenant_id,
+		tm.service_url,
+		tm.webhook_url
+	FROM im_provider.gates g
+	JOIN im_provider.bots b ON g.id = b.gate_id
+	JOIN im_provider.teams tm ON g.id = tm.gate_id
+	WHERE g.id = $1`
+
+	var g tmmodel.TeamsGate
+	if err := pgxscan.Get(ctx, s.pool, &g, query, id); err != nil {
+		if pgxscan.NotFound(err) {
+			return nil, sharedstore.ErrNotFound
+		}
+		return nil, fmt.Errorf("postgres: select teams gate: %w", err)
+	}
+
+	if dec, err := s.crypto.Decrypt(g.AppPassword); err == nil {
+		g.AppPassword = dec
+	}
+
+	s.mapVirtualFields(&g)
+	return &g, nil
+}
+
+func (s *teamsStore) Update(ctx context.Context, g *tmmodel.TeamsGate) error {
+	password, err := s.crypto.Encrypt(g.AppPassword)
+	if err != nil {
+		return fmt.Errorf("crypto: %w", err)
+	}
+
+	err = pgx.BeginFunc(ctx, s.pool, func(tx pgx.Tx) error {
+		const uGate = `UPDATE im_provider.gates SET name = $1, enabled = $2, updated_at = NOW() WHERE id = $3 RETURNING updated_at`
+		if err := tx.QueryRow(ctx, uGate, g.Name, g.Enabled, g.ID).Scan(&g.UpdatedAt); err != nil {
+			return err
+		}
+
+		const uBot = `UPDATE im_provider.bots SET sub = $1, iss = $2 WHERE gate_id = $3`
+		if _, err := tx.Exec(ctx, uBot, g.Peer.Sub, g.Peer.Iss, g.ID); err != nil {
+			return err
+		}
+
+		const uConfig = `
+			UPDATE im_provider.teams
+			SET app_id = $1, app_password = $2, tenant_id = $3, service_url = $4, webhook_url = $5
+			WHERE gate_id = $6`
+		_, err := tx.Exec(ctx, uConfig, g.AppID, password, g.TenantID, g.ServiceURL, g.WebhookURL, g.ID)
+		return err
+	})
+	if err != nil {
+		if pg.IsUniqueViolation(err) {
+			return fmt.Errorf("teams bot %s is already bound: %w", g.AppID, sharedstore.ErrConflict)
+		}
+		return fmt.Errorf("postgres: update teams gate: %w", err)
+	}
+
+	s.cache.Delete(g.ID)
+	s.mapVirtualFields(g)
+	return nil
+}
+
+// SetServiceURL stores the Bot Connector endpoint without touching the rest of the gate.
+func (s *teamsStore) SetServiceURL(ctx context.Context, id, serviceURL string) error {
+	const query = `UPDATE im_provider.teams SET service_url = $1 WHERE gate_id = $2`
+	res, err := s.pool.Exec(ctx, query, serviceURL, id)
+	if err != nil {
+		return fmt.Errorf("postgres: update teams service url: %w", err)
+	}
+	if res.RowsAffected() == 0 {
+		return sharedstore.ErrNotFound
+	}
+	return nil
+}
+
+func (s *teamsStore) Delete(ctx context.Context, id string) error {
+	res, err := s.pool.Exec(ctx, "DELETE FROM im_provider.gates WHERE id = $1 AND type = 'teams'", id)
+	if err != nil {
+		return fmt.Errorf("postgres: delete teams gate: %w", err)
+	}
+	if res.RowsAffected() == 0 {
+		return sharedstore.ErrNotFound
+	}
+
+	s.cache.Delete(id)
+	return nil
+}
+
+func (s *teamsStore) mapVirtualFields(g *tmmodel.TeamsGate) {
+	if g.Enabled {
+		g.Status = sharedmodel.StatusActive
+	} else {
+		g.Status = sharedmodel.StatusDisabled
+	}
+}
@@ -0,0 +1,18 @@
+package store
+
+import (
+	"context"
+
+	tmmodel "github.com/webitel/im-providers-service/internal/teams/model"
+)
+
+// TeamsStore manages Azure Bot registrations serving Microsoft Teams.
+type TeamsStore interface {
+	// Insert creates the gate together with its bot peer and bot credentials.
+	Insert(ctx context.Context, dc int64, g *tmmodel.TeamsGate) error
+	Select(ctx context.Context, id string) (*tmmodel.TeamsGate, error)
+	Update(ctx context.Context, g *tmmodel.TeamsGate) error
+	// SetServiceURL stores the Bot Connector endpoint the latest activity came from.
+	SetServiceURL(ctx context.Context, id, serviceURL string) error
+	Delete(ctx context.Context, id string) error
+}
@@ -0,0 +1,156 @@
+package teams
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+// Activity types.
+// https://learn.microsoft.com/en-us/azure/bot-service/rest-api/bot-framework-rest-connector-activities
+const (
+	ActivityMessage            = "message"
+	ActivityConversationUpdate = "conversationUpdate"
+)
+
+// Conversation types of Teams.
+const (
+	conversationPersonal  = "personal"
+	conversationGroupChat = "groupChat"
+	conversationChannel   = "channel"
+)
+
+// Attachment content types.
+const (
+	// contentTypeFileDownload is a file shared in a personal chat, downloadable without a token.
+	// https://learn.microsoft.com/en-us/microsoftteams/platform/bots/how-to/bots-filesv4
+	contentTypeFileDownload = "application/vnd.microsoft.teams.file.download.info"
+	// contentTypeHTML duplicates the text of a message with formatting and mentions.
+	contentTypeHTML = "text/html"
+)
+
+// entityMention marks a mention in the text of a message.
+const entityMention = "mention"
+
+var (
+	// ErrUnauthorized is returned when the bot credentials are rejected.
+	ErrUnauthorized = errors.New("teams: bot credentials rejected")
+	// ErrConversationNotFound is returned when the conversation is gone or the bot was removed from it.
+	ErrConversationNotFound = errors.New("teams: conversation not found")
+)
+
+// APIError is an error reply of the Bot Connector or of the token endpoint.
+type APIError struct {
+	Operation  string
+	StatusCode int
+	Code       string
+	Message    string
+}
+
+func (e *APIError) Error() string {
+	return fmt.Sprintf("teams %s: status %d: %s %s", e.Operation, e.StatusCode, e.Code, e.Message)
+}
+
+// Is matches the sentinel errors of the statuses callers act on.
+func (e *APIError) Is(target error) bool {
+	switch target {
+	case ErrUnauthorized:
+		return e.StatusCode == 401 || e.StatusCode == 403 || e.Code == "invalid_client" || e.Code == "unauthorized_client"
+	case ErrConversationNotFound:
+		return e.StatusCode == 404
+	}
+	return false
+}
+
+// --- Activities ---
+
+// activity is an incoming activity of the Bot Connector.
+type activity struct {
+	Type         string              `json:"type"`
+	ID           string              `json:"id"`
+	ServiceURL   string              `json:"serviceUrl"`
+	ChannelID    string              `json:"channelId"`
+	From         channelAccount      `json:"from"`
+	Recipient    channelAccount      `json:"recipient"`
+	Conversation conversationAccount `json:"conversation"`
+	Text         string              `json:"text"`
+	Attachments  []attachment        `json:"attachments"`
+	Entities     []entity            `json:"entities"`
+	MembersAdded []channelAccount    `json:"membersAdded"`
+	ChannelData  *channelData        `json:"channelData"`
+}
+
+type channelAccount struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	AADObjectID string `json:"aadObjectId,omitempty"`
+}
+
+type conversationAccount struct {
+	ID               string `json:"id"`
+	Name             string `json:"name"`
+	ConversationType string `json:"conversationType"`
+	TenantID         string `json:"tenantId"`
+}
+
+type attachment struct {
+	ContentType string          `json:"contentType"`
+	ContentURL  string          `json:"contentUrl,omitempty"`
+	Name        string          `json:"name,omitempty"`
+	Content     json.RawMessage `json:"content,omitempty"`
+}
+
+// fileDownloadInfo is the content of a contentTypeFileDownload attachment.
+type fileDownloadInfo struct {
+	DownloadURL string `json:"downloadUrl"`
+	FileType    string `json:"fileType"`
+}
+
+type entity struct {
+	Type      string          `json:"type"`
+	Text      string          `json:"text"`
+	Mentioned *channelAccount `json:"mentioned"`
+}
+
+// channelData carries the Teams specifics of an activity.
+// https://learn.microsoft.com/en-us/microsoftteams/platform/bots/how-to/conversations/conversation-messages#teams-channel-data
+type channelData struct {
+	Tenant  *channelDataRef `json:"tenant"`
+	Team    *channelDataRef `json:"team"`
+	Channel *channelDataRef `json:"channel"`
+}
+
+type channelDataRef struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+// outboundActivity is a message activity sent by the bot.
+type outboundActivity struct {
+	Type        string       `json:"type"`
+	Text        string       `json:"text,omitempty"`
+	TextFormat  string       `json:"textFormat,omitempty"`
+	Attachments []attachment `json:"attachments,omitempty"`
+}
+
+// --- Replies ---
+
+type resourceResponse struct {
+	ID string `json:"id"`
+}
+
+// errorResponse is the body of a Bot Connector error reply.
+type errorResponse struct {
+	Error struct {
+		Code    string `json:"code"`
+		Message string `json:"message"`
+	} `json:"error"`
+}
+
+// tokenResponse is the reply of the Microsoft identity platform token endpoint.
+type tokenResponse struct {
+	AccessToken      string `json:"access_token"`
+	ExpiresIn        int64  `json:"expires_in"`
+	Error            string `json:"error"`
+	ErrorDescription string `json:"error_description"`
+}
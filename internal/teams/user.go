@@ -0,0 +1,105 @@
+package teams
+
+import (
+	"context"
+	"strings"
+
+	gatewayv1 "github.com/webitel/im-providers-service/gen/go/gateway/v1"
+	sharedmodel "github.com/webitel/im-providers-service/internal/core/model"
+	"github.com/webitel/im-providers-service/internal/provider/contactsync"
+	tmmodel "github.com/webitel/im-providers-service/internal/teams/model"
+)
+
+// Contact metadata keys of a conversation.
+const (
+	metadataTenant           = "tenant_id"
+	metadataConversationType = "conversation_type"
+	metadataTeam             = "team_id"
+	metadataChannel          = "channel_id"
+)
+
+// threadMarker separates the channel from the root message in the conversation ID of a thread.
+const threadMarker = ";messageid="
+
+// syncContact resolves the internal contact for the conversation of an activity,
+// creating it if necessary. The contact is the chat or thread, not the member who
+// posted: the whole conversation is one chat.
+func (p *teamsProvider) syncContact(ctx context.Context, gate *tmmodel.TeamsGate, a *activity) (*gatewayv1.Contact, error) {
+	sub := a.Conversation.ID
+	key := contactsync.KnownUser(gate.ID, &sharedmodel.ExternalUser{ID: sub})
+	if known, _ := p.userCache.IsKnown(ctx, key); known {
+		return &gatewayv1.Contact{Sub: sub}, nil
+	}
+
+	authCtx := contactsync.WithGatewayIdentity(ctx, gate.DomainID, gate.Peer)
+	contact, err := p.ensureContact(authCtx, a)
+	if err != nil {
+		return nil, err
+	}
+
+	contactsync.EnsureVia(authCtx, p.gatewayer, p.logger, gate.ID, sub, contact.Iss)
+	_ = p.userCache.MarkKnown(ctx, key)
+
+	return contact, nil
+}
+
+// conversationName returns the contact name of a conversation: the member name for a
+// personal chat, the chat topic for a group chat and the team and channel names for
+// a channel thread.
+func conversationName(a *activity) string {
+	conv := a.Conversation
+	switch conv.ConversationType {
+	case conversationPersonal:
+		if a.From.Name != "" {
+			return a.From.Name
+		}
+	case conversationGroupChat:
+		if conv.Name != "" {
+			return conv.Name
+		}
+		return "Group chat"
+	case conversationChannel:
+		// The General channel has no name of its own.
+		name := "#General"
+		if cd := a.ChannelData; cd != nil {
+			if cd.Channel != nil && cd.Channel.Name != "" {
+				name = "#" + cd.Channel.Name
+			}
+			if cd.Team != nil && cd.Team.Name != "" {
+				name = cd.Team.Name + " " + name
+			}
+		}
+		if strings.Contains(conv.ID, threadMarker) {
+			name += " (thread)"
+		}
+		return name
+	}
+	return conv.ID
+}
+
+// ensureContact creates the internal contact or returns a stub when the
+// contact already exists (idempotent by design on the gateway side).
+func (p *teamsProvider) ensureContact(ctx context.Context, a *activity) (*gatewayv1.Contact, error) {
+	metadata := map[string]string{
+		metadataConversationType: a.Conversation.ConversationType,
+	}
+	if a.Conversation.TenantID != "" {
+		metadata[metadataTenant] = a.Conversation.TenantID
+	}
+	if cd := a.ChannelData; cd != nil {
+		if cd.Team != nil {
+			metadata[metadataTeam] = cd.Team.ID
+		}
+		if cd.Channel != nil {
+			metadata[metadataChannel] = cd.Channel.ID
+		}
+	}
+
+	return contactsync.CreateContact(ctx, p.gatewayer, &gatewayv1.CreateContactRequest{
+		IssId:    p.Type(),
+		Type:     p.Type(),
+		Name:     conversationName(a),
+		Subject:  a.Conversation.ID,
+		Metadata: metadata,
+	})
+}
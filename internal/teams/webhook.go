@@ -0,0 +1,124 @@
+package teams
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	sharedmodel "github.com/webitel/im-providers-service/internal/core/model"
+	"github.com/webitel/im-providers-service/internal/provider/contactsync"
+	tmmodel "github.com/webitel/im-providers-service/internal/teams/model"
+)
+
+func (p *teamsProvider) HandleWebhook(ctx context.Context, data []byte) error {
+	var a activity
+	if err := json.Unmarshal(data, &a); err != nil {
+		p.logger.Warn("malformed activity dropped", "err", err)
+		return nil
+	}
+
+	gate, err := p.resolveGate(ctx)
+	if err != nil || !gate.Enabled {
+		return err
+	}
+	if a.Conversation.ID == "" || a.From.ID == a.Recipient.ID {
+		return nil
+	}
+	if a.ID != "" && !p.firstSeen(a.Conversation.ID+":"+a.ID) {
+		p.logger.Debug("activity redelivery dropped", "activity_id", a.ID)
+		return nil
+	}
+	p.rememberServiceURL(ctx, gate, a.ServiceURL)
+
+	switch a.Type {
+	case ActivityMessage:
+		if err := p.processMessage(ctx, gate, &a); err != nil {
+			p.logger.Error("message dropped", "activity_id", a.ID, "err", err)
+		}
+	case ActivityConversationUpdate:
+		// The bot was added to a chat or team, which can be messaged from now on.
+		if !addsMember(&a, a.Recipient.ID) {
+			return nil
+		}
+		if _, err := p.syncContact(ctx, gate, &a); err != nil {
+			p.logger.Warn("sync contact failed", "activity", a.Type, "conversation", a.Conversation.ID, "err", err)
+		}
+	default:
+		p.logger.Debug("activity acknowledged", "activity", a.Type, "activity_id", a.ID)
+	}
+	return nil
+}
+
+// rememberServiceURL stores the Bot Connector endpoint replies are posted to. It
+// differs per region and may change, so the one of the latest activity is kept.
+func (p *teamsProvider) rememberServiceURL(ctx context.Context, gate *tmmodel.TeamsGate, serviceURL string) {
+	if serviceURL == "" || serviceURL == gate.ServiceURL {
+		return
+	}
+	if err := p.repo.SetServiceURL(ctx, gate.ID, serviceURL); err != nil {
+		p.logger.Warn("store service url failed", "gate_id", gate.ID, "err", err)
+		return
+	}
+	gate.ServiceURL = serviceURL
+}
+
+// processMessage is the per-message pipeline:
+//
+//	sync contact → route attachments and text
+func (p *teamsProvider) processMessage(ctx context.Context, gate *tmmodel.TeamsGate, a *activity) error {
+	if _, err := p.syncContact(ctx, gate, a); err != nil {
+		return fmt.Errorf("sync contact [conversation=%s]: %w", a.Conversation.ID, err)
+	}
+
+	peers := contactsync.InboundPeers(gate.ID, gate.Peer, a.Conversation.ID)
+	body := messageText(a)
+	for i := range a.Attachments {
+		if p.handleAttachment(ctx, gate, a, peers, &a.Attachments[i], body) {
+			body = ""
+		}
+	}
+	p.sendText(ctx, gate, peers, body)
+	return nil
+}
+
+// messageText returns the text of a message without the mention of the bot, which
+// is required to address it in a channel. Group chats and channels are shared by
+// many members, so the text is signed with the name of its author there.
+func messageText(a *activity) string {
+	text := a.Text
+	for _, e := range a.Entities {
+		if e.Type == entityMention && e.Mentioned != nil && e.Mentioned.ID == a.Recipient.ID && e.Text != "" {
+			text = strings.ReplaceAll(text, e.Text, "")
+		}
+	}
+	text = strings.TrimSpace(text)
+	if text == "" || a.Conversation.ConversationType == conversationPersonal || a.From.Name == "" {
+		return text
+	}
+	return a.From.Name + ": " + text
+}
+
+// addsMember reports whether the conversation update adds the given member.
+func addsMember(a *activity, id string) bool {
+	for _, m := range a.MembersAdded {
+		if m.ID == id {
+			return true
+		}
+	}
+	return false
+}
+
+func (p *teamsProvider) sendText(ctx context.Context, gate *tmmodel.TeamsGate, peers contactsync.Peers, text string) {
+	if text == "" {
+		return
+	}
+	if _, err := p.messenger.SendText(ctx, &sharedmodel.SendTextRequest{
+		DomainID: gate.DomainID,
+		From:     peers.From,
+		To:       peers.To,
+		Body:     text,
+	}); err != nil {
+		p.logger.Error("send text failed", "err", err)
+	}
+}
@@ -0,0 +1,289 @@
+package teams
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+const (
+	testUserID       = "29:1GcS4EyB_oSI8A88XmWBN7NJFyMqe3QGnJdgLfFGkJnVelzRGos0bPbpsfJjcbAD22bmKc4GMbrY2g4JDrrA8vM06X1-cHHle4zOE6U4ttcc"
+	testPersonalChat = "a:1H7XyzMwc1crC8Qfk-kYR2xdEUjOzP8O_Fsq2t_P4oHqnsCZTv7LaRt5m1EdqvjqgeUqMzZAqOcYXCcUdnt3hVGpYQvIgFsW6e42Qn5HthjhmZ1iJdfAYD3mUgEcm4jdA"
+	testChannel      = "19:0f9c5a3f4c2a4a1c8e2d3b4a5c6d7e8f@thread.tacv2"
+)
+
+func personalMessage(id, text string, serviceURL string) []byte {
+	return []byte(`{
+		"type": "message",
+		"id": "` + id + `",
+		"serviceUrl": "` + serviceURL + `",
+		"channelId": "msteams",
+		"from": {"id": "` + testUserID + `", "name": "Megan Bowen"},
+		"recipient": {"id": "` + testBotID + `", "name": "Support"},
+		"conversation": {"id": "` + testPersonalChat + `", "conversationType": "personal", "tenantId": "72f988bf"},
+		"text": "` + text + `"
+	}`)
+}
+
+func TestValidateSignature(t *testing.T) {
+	tests := []struct {
+		name         string
+		gateID       string
+		claims       map[string]any
+		header       func(token string) string
+		channelID    string
+		endorsements []string
+		wantErr      bool
+	}{
+		{name: "valid"},
+		{name: "audience list", claims: map[string]any{"aud": []string{"other", testAppID}}},
+		{name: "missing bearer", header: func(string) string { return "" }, wantErr: true},
+		{name: "tampered signature", header: func(token string) string { return "Bearer " + token[:len(token)-4] + "AAAA" }, wantErr: true},
+		{name: "another bot", claims: map[string]any{"aud": "other-app"}, wantErr: true},
+		{name: "another issuer", claims: map[string]any{"iss": "https://sts.windows.net/"}, wantErr: true},
+		{name: "expired", claims: map[string]any{"exp": time.Now().Add(-time.Hour).Unix()}, wantErr: true},
+		{name: "another service url", claims: map[string]any{"serviceurl": "https://evil.example.com/"}, wantErr: true},
+		{name: "key not endorsed for channel", channelID: "skype", wantErr: true},
+		{name: "key without endorsements", channelID: "skype", endorsements: []string{}},
+		{name: "unknown gate", gateID: "0190a8a4-0000-7000-8000-000000000000", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := newRegisteredBot(t)
+			if tt.endorsements != nil {
+				p.stub.endorsements = tt.endorsements
+			}
+			gateID := testGateID
+			if tt.gateID != "" {
+				gateID = tt.gateID
+			}
+			body := personalMessage("1", "hi", p.stub.serviceURL())
+			if tt.channelID != "" {
+				body = []byte(strings.Replace(string(body), `"channelId": "msteams"`, `"channelId": "`+tt.channelID+`"`, 1))
+			}
+
+			token := p.stub.sign(t, tt.claims)
+			header := "Bearer " + token
+			if tt.header != nil {
+				header = tt.header(token)
+			}
+
+			err := p.ValidateSignature(webhookContext(gateID), header, body)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ValidateSignature() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestHandleWebhook_PersonalMessage(t *testing.T) {
+	p := newRegisteredBot(t)
+	p.bots[testGateID].ServiceURL = ""
+
+	if err := p.HandleWebhook(webhookContext(testGateID), personalMessage("1", "Where is my order?", p.stub.serviceURL())); err != nil {
+		t.Fatalf("HandleWebhook() error = %v", err)
+	}
+
+	if len(p.messenger.Texts()) != 1 {
+		t.Fatalf("texts routed = %d, want 1", len(p.messenger.Texts()))
+	}
+	got := p.messenger.Texts()[0]
+	if got.Body != "Where is my order?" {
+		t.Errorf("body = %q, personal chats are not signed", got.Body)
+	}
+	if got.From.Sub != testPersonalChat || got.From.Iss != "teams" {
+		t.Errorf("from = %+v, want the conversation", got.From)
+	}
+	if got.To.Sub != "bot-1" || got.To.Via == nil || *got.To.Via != testGateID {
+		t.Errorf("to = %+v, want the bot via the gate", got.To)
+	}
+	if url := p.bots[testGateID].ServiceURL; url != p.stub.serviceURL() {
+		t.Errorf("service url = %q, want the one of the activity", url)
+	}
+}
+
+func TestHandleWebhook_ChannelMention(t *testing.T) {
+	p := newRegisteredBot(t)
+	thread := testChannel + ";messageid=1616964412125"
+
+	body := []byte(`{
+		"type": "message",
+		"id": "1616964412125",
+		"serviceUrl": "` + p.stub.serviceURL() + `",
+		"channelId": "msteams",
+		"from": {"id": "` + testUserID + `", "name": "Megan Bowen"},
+		"recipient": {"id": "` + testBotID + `", "name": "Support"},
+		"conversation": {"id": "` + thread + `", "conversationType": "channel", "isGroup": true},
+		"text": "<at>Support</at> the invoice is wrong",
+		"entities": [{"type": "mention", "text": "<at>Support</at>", "mentioned": {"id": "` + testBotID + `", "name": "Support"}}],
+		"attachments": [{"contentType": "text/html", "content": "<div><at>Support</at> the invoice is wrong</div>"}],
+		"channelData": {"team": {"id": "19:team", "name": "Sales"}, "channel": {"id": "` + testChannel + `", "name": "Billing"}}
+	}`)
+	if err := p.HandleWebhook(webhookContext(testGateID), body); err != nil {
+		t.Fatalf("HandleWebhook() error = %v", err)
+	}
+
+	if len(p.messenger.Texts()) != 1 {
+		t.Fatalf("texts routed = %d, want 1", len(p.messenger.Texts()))
+	}
+	if got := p.messenger.Texts()[0]; got.Body != "Megan Bowen: the invoice is wrong" || got.From.Sub != thread {
+		t.Errorf("text = %q from %q, want the signed text of the thread", got.Body, got.From.Sub)
+	}
+	if len(p.media.Uploads()) != 0 {
+		t.Errorf("uploads = %d, the HTML copy of the text is not a file", len(p.media.Uploads()))
+	}
+}
+
+func TestHandleWebhook_Attachments(t *testing.T) {
+	p := newRegisteredBot(t)
+	files, downloadAuth := newFileServer(t)
+
+	body := []byte(`{
+		"type": "message",
+		"id": "2",
+		"serviceUrl": "` + p.stub.serviceURL() + `",
+		"channelId": "msteams",
+		"from": {"id": "` + testUserID + `", "name": "Megan Bowen"},
+		"recipient": {"id": "` + testBotID + `"},
+		"conversation": {"id": "` + testPersonalChat + `", "conversationType": "personal"},
+		"text": "see attached",
+		"attachments": [
+			{"contentType": "application/vnd.microsoft.teams.file.download.info", "name": "invoice.pdf",
+			 "content": {"downloadUrl": "` + files + `/invoice.pdf?sig=abc", "fileType": "pdf", "uniqueId": "1150D938"}},
+			{"contentType": "image/png", "contentUrl": "` + p.stub.url + `/attachments/0-eus-d1/views/original"}
+		]
+	}`)
+	if err := p.HandleWebhook(webhookContext(testGateID), body); err != nil {
+		t.Fatalf("HandleWebhook() error = %v", err)
+	}
+
+	if auth := downloadAuth(); auth != "" {
+		t.Errorf("download authorization = %q, the bot token must not leave the Bot Connector", auth)
+	}
+	if len(p.messenger.Documents()) != 1 || len(p.messenger.Images()) != 1 || len(p.messenger.Texts()) != 0 {
+		t.Fatalf("routed %d documents, %d images, %d texts, want 1, 1, 0",
+			len(p.messenger.Documents()), len(p.messenger.Images()), len(p.messenger.Texts()))
+	}
+	doc := p.messenger.Documents()[0].Document
+	if doc.Body != "see attached" || doc.Documents[0].FileName != "invoice.pdf" || doc.Documents[0].MimeType != "application/pdf" {
+		t.Errorf("document = %+v (body %q), want the captioned pdf", doc.Documents[0], doc.Body)
+	}
+	if img := p.messenger.Images()[0].Image; img.Body != "" || img.Images[0].MimeType != "image/png" {
+		t.Errorf("image = %+v (body %q), the caption goes with the first attachment only", img.Images[0], img.Body)
+	}
+
+	var imageAuth string
+	for _, c := range p.stub.sent() {
+		if strings.HasPrefix(c.path, "/attachments/") {
+			imageAuth = c.auth
+		}
+	}
+	if imageAuth != "Bearer "+testToken {
+		t.Errorf("inline image authorization = %q, want the bot token", imageAuth)
+	}
+}
+
+func TestHandleWebhook_Dropped(t *testing.T) {
+	tests := []struct {
+		name string
+		body func(serviceURL string) []byte
+	}{
+		{
+			name: "own activity",
+			body: func(serviceURL string) []byte {
+				return []byte(strings.Replace(string(personalMessage("3", "echo", serviceURL)), testUserID, testBotID, 1))
+			},
+		},
+		{
+			name: "typing",
+			body: func(serviceURL string) []byte {
+				return []byte(strings.Replace(string(personalMessage("4", "", serviceURL)), `"type": "message"`, `"type": "typing"`, 1))
+			},
+		},
+		{name: "malformed", body: func(string) []byte { return []byte(`{`) }},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := newRegisteredBot(t)
+			if err := p.HandleWebhook(webhookContext(testGateID), tt.body(p.stub.serviceURL())); err != nil {
+				t.Fatalf("HandleWebhook() error = %v", err)
+			}
+			if len(p.messenger.Texts()) != 0 {
+				t.Errorf("texts routed = %d, want 0", len(p.messenger.Texts()))
+			}
+		})
+	}
+}
+
+func TestHandleWebhook_Redelivery(t *testing.T) {
+	p := newRegisteredBot(t)
+	body := personalMessage("5", "hello", p.stub.serviceURL())
+
+	for range 2 {
+		if err := p.HandleWebhook(webhookContext(testGateID), body); err != nil {
+			t.Fatalf("HandleWebhook() error = %v", err)
+		}
+	}
+	if len(p.messenger.Texts()) != 1 {
+		t.Errorf("texts routed = %d, a redelivered activity is routed once", len(p.messenger.Texts()))
+	}
+}
+
+func TestHandleWebhook_DisabledGate(t *testing.T) {
+	p := newRegisteredBot(t)
+	p.bots[testGateID].Enabled = false
+
+	if err := p.HandleWebhook(webhookContext(testGateID), personalMessage("6", "hello", p.stub.serviceURL())); err != nil {
+		t.Fatalf("HandleWebhook() error = %v", err)
+	}
+	if len(p.messenger.Texts()) != 0 {
+		t.Errorf("texts routed = %d, want 0", len(p.messenger.Texts()))
+	}
+}
+
+func TestConversationName(t *testing.T) {
+	tests := []struct {
+		name string
+		a    activity
+		want string
+	}{
+		{
+			name: "personal",
+			a:    activity{From: channelAccount{Name: "Megan Bowen"}, Conversation: conversationAccount{ID: "a:1", ConversationType: conversationPersonal}},
+			want: "Megan Bowen",
+		},
+		{
+			name: "group chat",
+			a:    activity{Conversation: conversationAccount{ID: "19:chat", Name: "Project X", ConversationType: conversationGroupChat}},
+			want: "Project X",
+		},
+		{
+			name: "untitled group chat",
+			a:    activity{Conversation: conversationAccount{ID: "19:chat", ConversationType: conversationGroupChat}},
+			want: "Group chat",
+		},
+		{
+			name: "channel thread",
+			a: activity{
+				Conversation: conversationAccount{ID: testChannel + ";messageid=1", ConversationType: conversationChannel},
+				ChannelData:  &channelData{Team: &channelDataRef{Name: "Sales"}, Channel: &channelDataRef{Name: "Billing"}},
+			},
+			want: "Sales #Billing (thread)",
+		},
+		{
+			name: "general channel",
+			a:    activity{Conversation: conversationAccount{ID: testChannel, ConversationType: conversationChannel}},
+			want: "#General",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := conversationName(&tt.a); got != tt.want {
+				t.Errorf("conversationName() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
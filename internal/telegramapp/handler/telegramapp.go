@@ -0,0 +1,174 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	impb "github.com/webitel/im-providers-service/gen/go/provider/v1"
+	"github.com/webitel/im-providers-service/internal/provider/gaterpc"
+	tamodel "github.com/webitel/im-providers-service/internal/telegramapp/model"
+	"github.com/webitel/im-providers-service/internal/telegramapp/mtproto"
+	taservice "github.com/webitel/im-providers-service/internal/telegramapp/service"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type TelegramAppHandler struct {
+	logger *slog.Logger
+	srv    taservice.TelegramAppManager
+	impb.UnimplementedTelegramAppServiceServer
+}
+
+func NewTelegramAppHandler(logger *slog.Logger, srv taservice.TelegramAppManager) *TelegramAppHandler {
+	return &TelegramAppHandler{logger: logger, srv: srv}
+}
+
+func (h *TelegramAppHandler) StartTelegramAppLogin(ctx context.Context, req *impb.ProviderStartTelegramAppLoginRequest) (*impb.ProviderStartTelegramAppLoginResponse, error) {
+	domainID, err := gaterpc.DomainID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	start := tamodel.StartLogin{
+		Name:  req.GetName(),
+		Dc:    domainID,
+		Phone: req.GetPhone(),
+	}
+	if peer := gaterpc.Peer(req.GetPeer()); peer != nil {
+		start.Peer = *peer
+	}
+
+	state, err := h.srv.StartLogin(ctx, start)
+	if err != nil {
+		return nil, toStatus(err, "start login")
+	}
+
+	return &impb.ProviderStartTelegramAppLoginResponse{Login: loginToProto(state)}, nil
+}
+
+func (h *TelegramAppHandler) SubmitTelegramAppCode(ctx context.Context, req *impb.ProviderSubmitTelegramAppCodeRequest) (*impb.ProviderSubmitTelegramAppCodeResponse, error) {
+	domainID, err := gaterpc.DomainID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	state, err := h.srv.SubmitCode(ctx, domainID, req.GetLoginId(), req.GetCode())
+	if err != nil {
+		return nil, toStatus(err, "submit code")
+	}
+
+	return &impb.ProviderSubmitTelegramAppCodeResponse{Login: loginToProto(state)}, nil
+}
+
+func (h *TelegramAppHandler) SubmitTelegramAppPassword(ctx context.Context, req *impb.ProviderSubmitTelegramAppPasswordRequest) (*impb.ProviderSubmitTelegramAppPasswordResponse, error) {
+	domainID, err := gaterpc.DomainID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	state, err := h.srv.SubmitPassword(ctx, domainID, req.GetLoginId(), req.GetPassword())
+	if err != nil {
+		return nil, toStatus(err, "submit password")
+	}
+
+	return &impb.ProviderSubmitTelegramAppPasswordResponse{Login: loginToProto(state)}, nil
+}
+
+func (h *TelegramAppHandler) GetTelegramAppGate(ctx context.Context, req *impb.ProviderGetTelegramAppGateRequest) (*impb.ProviderGetTelegramAppGateResponse, error) {
+	gate, err := h.gate(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	return &impb.ProviderGetTelegramAppGateResponse{Item: gateToProto(gate)}, nil
+}
+
+func (h *TelegramAppHandler) UpdateTelegramAppGate(ctx context.Context, req *impb.ProviderUpdateTelegramAppGateRequest) (*impb.ProviderUpdateTelegramAppGateResponse, error) {
+	if _, err := h.gate(ctx, req.GetId()); err != nil {
+		return nil, err
+	}
+
+	gate, err := h.srv.UpdateGate(ctx, tamodel.UpdateTelegramApp{
+		ID:      req.GetId(),
+		Name:    req.Name,
+		Enabled: req.Enabled,
+		Peer:    gaterpc.Peer(req.GetPeer()),
+	})
+	if err != nil {
+		return nil, toStatus(err, "update gate")
+	}
+
+	return &impb.ProviderUpdateTelegramAppGateResponse{Item: gateToProto(gate)}, nil
+}
+
+func (h *TelegramAppHandler) DeleteTelegramAppGate(ctx context.Context, req *impb.ProviderDeleteTelegramAppGateRequest) (*impb.ProviderDeleteTelegramAppGateResponse, error) {
+	if _, err := h.gate(ctx, req.GetId()); err != nil {
+		return nil, err
+	}
+
+	gate, err := h.srv.DeleteGate(ctx, req.GetId())
+	if err != nil {
+		return nil, toStatus(err, "delete gate")
+	}
+
+	return &impb.ProviderDeleteTelegramAppGateResponse{Item: gateToProto(gate)}, nil
+}
+
+// gate returns the gate when it belongs to the domain of the caller.
+func (h *TelegramAppHandler) gate(ctx context.Context, id string) (*tamodel.TelegramAppGate, error) {
+	gate, err := h.srv.GetGate(ctx, id)
+	if err != nil {
+		return nil, toStatus(err, "get gate")
+	}
+	if err := gaterpc.CheckDomain(ctx, gate.DomainID); err != nil {
+		return nil, err
+	}
+	return gate, nil
+}
+
+// toStatus reports a wrong code or password as an invalid argument, so the caller can
+// retry the step, and a login step out of order or a service without a Telegram
+// application as a failed precondition.
+func toStatus(err error, internalMsg string) error {
+	switch {
+	case errors.Is(err, mtproto.ErrCodeInvalid), errors.Is(err, mtproto.ErrPasswordInvalid):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, taservice.ErrLoginStep), errors.Is(err, mtproto.ErrUnavailable):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	}
+	return gaterpc.ToStatus(err, internalMsg)
+}
+
+var loginSteps = map[string]impb.TelegramAppLoginStep{
+	tamodel.LoginStepCode:     impb.TelegramAppLoginStep_TELEGRAM_APP_LOGIN_STEP_CODE,
+	tamodel.LoginStepPassword: impb.TelegramAppLoginStep_TELEGRAM_APP_LOGIN_STEP_PASSWORD,
+	tamodel.LoginStepDone:     impb.TelegramAppLoginStep_TELEGRAM_APP_LOGIN_STEP_DONE,
+}
+
+func loginToProto(s *tamodel.LoginState) *impb.ProviderTelegramAppLogin {
+	return &impb.ProviderTelegramAppLogin{
+		Id:    s.ID,
+		Phone: s.Phone,
+		Step:  loginSteps[s.Step],
+		Item:  gateToProto(s.Gate),
+	}
+}
+
+func gateToProto(g *tamodel.TelegramAppGate) *impb.ProviderTelegramAppGate {
+	if g == nil {
+		return nil
+	}
+	return &impb.ProviderTelegramAppGate{
+		Id:        g.ID,
+		Name:      g.Name,
+		Peer:      gaterpc.ToProtoPeer(g.Peer),
+		UserId:    g.UserID,
+		Phone:     g.Phone,
+		Username:  g.Username,
+		Status:    impb.ProviderStatus(g.Status),
+		CreatedAt: g.CreatedAt.UnixMilli(),
+		UpdatedAt: g.UpdatedAt.UnixMilli(),
+		Enabled:   g.Enabled,
+	}
+}
@@ -0,0 +1,224 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"testing"
+
+	impb "github.com/webitel/im-providers-service/gen/go/provider/v1"
+	"github.com/webitel/im-providers-service/infra/auth"
+	sharedmodel "github.com/webitel/im-providers-service/internal/core/model"
+	sharedstore "github.com/webitel/im-providers-service/internal/core/store"
+	tamodel "github.com/webitel/im-providers-service/internal/telegramapp/model"
+	"github.com/webitel/im-providers-service/internal/telegramapp/mtproto"
+	taservice "github.com/webitel/im-providers-service/internal/telegramapp/service"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type caller struct{ domainID int64 }
+
+func (c caller) GetContactID() string { return "" }
+func (c caller) GetDomainID() int64   { return c.domainID }
+func (c caller) GetName() string      { return "" }
+
+func callerContext(domainID int64) context.Context {
+	return context.WithValue(context.Background(), auth.AuthContextKey, caller{domainID: domainID})
+}
+
+// submitted is a login step the handler passed on.
+type submitted struct {
+	dc      int64
+	loginID string
+	input   string
+}
+
+// loginDesk answers every login step with the state set for it and records the steps.
+// Logins ask for the code first; an account with twoStep set then asks for the password.
+type loginDesk struct {
+	twoStep bool
+	err     error
+
+	started   *tamodel.StartLogin
+	codes     []submitted
+	passwords []submitted
+
+	gates   map[string]*tamodel.TelegramAppGate
+	updated *tamodel.UpdateTelegramApp
+	deleted []string
+}
+
+var _ taservice.TelegramAppManager = (*loginDesk)(nil)
+
+func (d *loginDesk) StartLogin(_ context.Context, req tamodel.StartLogin) (*tamodel.LoginState, error) {
+	if d.err != nil {
+		return nil, d.err
+	}
+	d.started = &req
+	return &tamodel.LoginState{ID: "login-1", Phone: req.Phone, Step: tamodel.LoginStepCode}, nil
+}
+
+func (d *loginDesk) SubmitCode(_ context.Context, dc int64, loginID, code string) (*tamodel.LoginState, error) {
+	if d.err != nil {
+		return nil, d.err
+	}
+	d.codes = append(d.codes, submitted{dc: dc, loginID: loginID, input: code})
+	if d.twoStep {
+		return &tamodel.LoginState{ID: loginID, Phone: "+380501112233", Step: tamodel.LoginStepPassword}, nil
+	}
+	return d.done(dc, loginID), nil
+}
+
+func (d *loginDesk) SubmitPassword(_ context.Context, dc int64, loginID, password string) (*tamodel.LoginState, error) {
+	if d.err != nil {
+		return nil, d.err
+	}
+	d.passwords = append(d.passwords, submitted{dc: dc, loginID: loginID, input: password})
+	return d.done(dc, loginID), nil
+}
+
+func (d *loginDesk) done(dc int64, loginID string) *tamodel.LoginState {
+	return &tamodel.LoginState{ID: loginID, Phone: "+380501112233", Step: tamodel.LoginStepDone, Gate: &tamodel.TelegramAppGate{
+		ID:       "gate-1",
+		DomainID: dc,
+		UserID:   777,
+		Phone:    "+380501112233",
+		Username: "sales",
+		Status:   sharedmodel.StatusActive,
+		Enabled:  true,
+	}}
+}
+
+func (d *loginDesk) GetGate(_ context.Context, id string) (*tamodel.TelegramAppGate, error) {
+	g, ok := d.gates[id]
+	if !ok {
+		return nil, fmt.Errorf("telegram_app gate %s: %w", id, sharedstore.ErrNotFound)
+	}
+	return g, nil
+}
+
+func (d *loginDesk) UpdateGate(_ context.Context, req tamodel.UpdateTelegramApp) (*tamodel.TelegramAppGate, error) {
+	d.updated = &req
+	g := *d.gates[req.ID]
+	req.ApplyTo(&g)
+	return &g, nil
+}
+
+func (d *loginDesk) DeleteGate(_ context.Context, id string) (*tamodel.TelegramAppGate, error) {
+	d.deleted = append(d.deleted, id)
+	return d.gates[id], nil
+}
+
+func newHandler(d *loginDesk) *TelegramAppHandler {
+	return NewTelegramAppHandler(slog.New(slog.NewTextHandler(io.Discard, nil)), d)
+}
+
+func TestTelegramAppLogin_TwoStepVerification(t *testing.T) {
+	d := &loginDesk{twoStep: true}
+	h := newHandler(d)
+	ctx := callerContext(7)
+
+	started, err := h.StartTelegramAppLogin(ctx, &impb.ProviderStartTelegramAppLoginRequest{
+		Name:  "Sales",
+		Phone: "+380501112233",
+		Peer:  &impb.Peer{Sub: "account-1", Iss: "telegram_app"},
+	})
+	if err != nil {
+		t.Fatalf("StartTelegramAppLogin: %v", err)
+	}
+	want := tamodel.StartLogin{Name: "Sales", Dc: 7, Phone: "+380501112233", Peer: sharedmodel.Peer{Sub: "account-1", Iss: "telegram_app"}}
+	if d.started == nil || *d.started != want {
+		t.Errorf("start request = %+v, want %+v", d.started, want)
+	}
+	if login := started.GetLogin(); login.GetId() != "login-1" || login.GetStep() != impb.TelegramAppLoginStep_TELEGRAM_APP_LOGIN_STEP_CODE {
+		t.Fatalf("login = %+v, want the code step", login)
+	}
+
+	coded, err := h.SubmitTelegramAppCode(ctx, &impb.ProviderSubmitTelegramAppCodeRequest{LoginId: "login-1", Code: "12345"})
+	if err != nil {
+		t.Fatalf("SubmitTelegramAppCode: %v", err)
+	}
+	if login := coded.GetLogin(); login.GetStep() != impb.TelegramAppLoginStep_TELEGRAM_APP_LOGIN_STEP_PASSWORD || login.GetItem() != nil {
+		t.Fatalf("login = %+v, want the password step", login)
+	}
+
+	done, err := h.SubmitTelegramAppPassword(ctx, &impb.ProviderSubmitTelegramAppPasswordRequest{LoginId: "login-1", Password: "hunter2"})
+	if err != nil {
+		t.Fatalf("SubmitTelegramAppPassword: %v", err)
+	}
+	login := done.GetLogin()
+	if login.GetStep() != impb.TelegramAppLoginStep_TELEGRAM_APP_LOGIN_STEP_DONE || login.GetItem().GetUserId() != 777 || login.GetItem().GetUsername() != "sales" {
+		t.Errorf("login = %+v, want done with the gate", login)
+	}
+
+	wantCode := submitted{dc: 7, loginID: "login-1", input: "12345"}
+	wantPassword := submitted{dc: 7, loginID: "login-1", input: "hunter2"}
+	if len(d.codes) != 1 || d.codes[0] != wantCode || len(d.passwords) != 1 || d.passwords[0] != wantPassword {
+		t.Errorf("steps: codes %+v, passwords %+v", d.codes, d.passwords)
+	}
+}
+
+func TestTelegramAppLogin_Errors(t *testing.T) {
+	tests := []struct {
+		name string
+		ctx  context.Context
+		err  error
+		want codes.Code
+	}{
+		{name: "no identity", ctx: context.Background(), want: codes.Unauthenticated},
+		{name: "wrong code", ctx: callerContext(7), err: fmt.Errorf("sign in: %w", mtproto.ErrCodeInvalid), want: codes.InvalidArgument},
+		{name: "wrong password", ctx: callerContext(7), err: fmt.Errorf("check password: %w", mtproto.ErrPasswordInvalid), want: codes.InvalidArgument},
+		{name: "step out of order", ctx: callerContext(7), err: fmt.Errorf("%w: code expected", taservice.ErrLoginStep), want: codes.FailedPrecondition},
+		{name: "no telegram application", ctx: callerContext(7), err: mtproto.ErrUnavailable, want: codes.FailedPrecondition},
+		{name: "expired login", ctx: callerContext(7), err: fmt.Errorf("telegram_app login login-1: %w", sharedstore.ErrNotFound), want: codes.NotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := newHandler(&loginDesk{err: tt.err})
+			if _, err := h.SubmitTelegramAppCode(tt.ctx, &impb.ProviderSubmitTelegramAppCodeRequest{LoginId: "login-1"}); status.Code(err) != tt.want {
+				t.Errorf("code = %v, want %v", status.Code(err), tt.want)
+			}
+		})
+	}
+}
+
+func TestUpdateTelegramAppGate_KeepsUnsetFields(t *testing.T) {
+	d := &loginDesk{gates: map[string]*tamodel.TelegramAppGate{
+		"gate-1": {ID: "gate-1", DomainID: 7, Name: "Sales", UserID: 777, Enabled: true},
+	}}
+	disabled := false
+
+	resp, err := newHandler(d).UpdateTelegramAppGate(callerContext(7), &impb.ProviderUpdateTelegramAppGateRequest{Id: "gate-1", Enabled: &disabled})
+	if err != nil {
+		t.Fatalf("UpdateTelegramAppGate: %v", err)
+	}
+
+	if d.updated.Name != nil || d.updated.Peer != nil || *d.updated.Enabled {
+		t.Errorf("update request = %+v", d.updated)
+	}
+	if item := resp.GetItem(); item.GetName() != "Sales" || item.GetEnabled() || item.GetUserId() != 777 {
+		t.Errorf("unexpected gate: %+v", item)
+	}
+}
+
+func TestTelegramAppGate_OtherDomainIsNotFound(t *testing.T) {
+	d := &loginDesk{gates: map[string]*tamodel.TelegramAppGate{"gate-1": {ID: "gate-1", DomainID: 7}}}
+	h := newHandler(d)
+	ctx := callerContext(8)
+
+	if _, err := h.GetTelegramAppGate(ctx, &impb.ProviderGetTelegramAppGateRequest{Id: "gate-1"}); status.Code(err) != codes.NotFound {
+		t.Errorf("get: code = %v, want NotFound", status.Code(err))
+	}
+	if _, err := h.UpdateTelegramAppGate(ctx, &impb.ProviderUpdateTelegramAppGateRequest{Id: "gate-1"}); status.Code(err) != codes.NotFound {
+		t.Errorf("update: code = %v, want NotFound", status.Code(err))
+	}
+	if _, err := h.DeleteTelegramAppGate(ctx, &impb.ProviderDeleteTelegramAppGateRequest{Id: "gate-1"}); status.Code(err) != codes.NotFound {
+		t.Errorf("delete: code = %v, want NotFound", status.Code(err))
+	}
+	if d.updated != nil || len(d.deleted) != 0 {
+		t.Errorf("gate of another domain changed: updated=%+v deleted=%v", d.updated, d.deleted)
+	}
+}
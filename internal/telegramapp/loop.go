@@ -0,0 +1,210 @@
+package telegramapp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	tamodel "github.com/webitel/im-providers-service/internal/telegramapp/model"
+	"github.com/webitel/im-providers-service/internal/telegramapp/mtproto"
+	tastore "github.com/webitel/im-providers-service/internal/telegramapp/store"
+)
+
+const (
+	// reconnectMinDelay and reconnectMaxDelay bound the exponential backoff between
+	// reconnects of a gate whose connection was lost.
+	reconnectMinDelay = time.Second
+	reconnectMaxDelay = time.Minute
+)
+
+// ErrGateNotRunning is returned when a message is sent through a gate whose update loop is not running.
+var ErrGateNotRunning = errors.New("telegram_app: gate is not connected")
+
+// updateHandler processes an update received by the account of the gate.
+type updateHandler func(ctx context.Context, gate *tamodel.TelegramAppGate, u *mtproto.Update) error
+
+// updateLoops runs a long-lived MTProto connection per enabled gate. Telegram pushes
+// updates over the connection, so it replaces the webhook of other providers.
+type updateLoops struct {
+	factory mtproto.Factory
+	repo    tastore.TelegramAppStore
+	handle  updateHandler
+	logger  *slog.Logger
+
+	minDelay time.Duration
+	maxDelay time.Duration
+
+	mu      sync.Mutex
+	running map[string]*gateLoop
+}
+
+// gateLoop is the running update loop of a gate.
+type gateLoop struct {
+	gate   *tamodel.TelegramAppGate
+	client mtproto.Client
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+func newUpdateLoops(factory mtproto.Factory, repo tastore.TelegramAppStore, handle updateHandler, l *slog.Logger) *updateLoops {
+	return &updateLoops{
+		factory:  factory,
+		repo:     repo,
+		handle:   handle,
+		logger:   l,
+		minDelay: reconnectMinDelay,
+		maxDelay: reconnectMaxDelay,
+		running:  make(map[string]*gateLoop),
+	}
+}
+
+// StartAll starts the loops of every enabled gate. A gate that fails to start is
+// logged and skipped, so one broken session does not keep the service down.
+func (l *updateLoops) StartAll(ctx context.Context) error {
+	gates, err := l.repo.SelectEnabled(ctx)
+	if err != nil {
+		return fmt.Errorf("telegram_app: load gates: %w", err)
+	}
+
+	for _, gate := range gates {
+		if err := l.Start(gate); err != nil {
+			l.logger.Error("update loop not started", "gate_id", gate.ID, "err", err)
+			if errors.Is(err, mtproto.ErrUnavailable) {
+				return nil
+			}
+		}
+	}
+	return nil
+}
+
+// Start (re)starts the loop of the gate with its stored session.
+func (l *updateLoops) Start(gate *tamodel.TelegramAppGate) error {
+	l.Stop(gate.ID)
+
+	client, err := l.factory.New(gate.Session)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	loop := &gateLoop{gate: gate, client: client, cancel: cancel, done: make(chan struct{})}
+
+	l.mu.Lock()
+	l.running[gate.ID] = loop
+	l.mu.Unlock()
+
+	go l.run(ctx, loop)
+	return nil
+}
+
+// Stop stops the loop of the gate, if running, and waits for it to exit.
+func (l *updateLoops) Stop(gateID string) {
+	l.mu.Lock()
+	loop, ok := l.running[gateID]
+	delete(l.running, gateID)
+	l.mu.Unlock()
+
+	if ok {
+		loop.cancel()
+		<-loop.done
+	}
+}
+
+// StopAll stops every loop, waiting for them until ctx is done.
+func (l *updateLoops) StopAll(ctx context.Context) error {
+	l.mu.Lock()
+	loops := make([]*gateLoop, 0, len(l.running))
+	for id, loop := range l.running {
+		loops = append(loops, loop)
+		delete(l.running, id)
+	}
+	l.mu.Unlock()
+
+	for _, loop := range loops {
+		loop.cancel()
+	}
+	for _, loop := range loops {
+		select {
+		case <-loop.done:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+	return nil
+}
+
+// Client returns the connected client of the gate for sending.
+func (l *updateLoops) Client(gateID string) (mtproto.Client, *tamodel.TelegramAppGate, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	loop, ok := l.running[gateID]
+	if !ok {
+		return nil, nil, ErrGateNotRunning
+	}
+	return loop.client, loop.gate, nil
+}
+
+// run keeps the gate connected until its context is canceled, reconnecting with
+// an exponential backoff. A revoked session ends the loop for good.
+func (l *updateLoops) run(ctx context.Context, loop *gateLoop) {
+	defer close(loop.done)
+
+	log := l.logger.With("gate_id", loop.gate.ID)
+	onUpdate := func(ctx context.Context, u *mtproto.Update) error {
+		if err := l.handle(ctx, loop.gate, u); err != nil {
+			log.Error("update dropped", "message_id", u.MessageID, "err", err)
+		}
+		return nil
+	}
+
+	delay := l.minDelay
+	for {
+		started := time.Now()
+		err := loop.client.Run(ctx, onUpdate)
+		l.saveSession(loop, log)
+
+		if ctx.Err() != nil {
+			return
+		}
+		if errors.Is(err, mtproto.ErrUnauthorized) {
+			log.Error("session revoked, the account has to log in again", "err", err)
+			l.mu.Lock()
+			if l.running[loop.gate.ID] == loop {
+				delete(l.running, loop.gate.ID)
+			}
+			l.mu.Unlock()
+			return
+		}
+
+		// A connection that stayed up longer than the longest delay starts the backoff over.
+		if time.Since(started) > l.maxDelay {
+			delay = l.minDelay
+		}
+		log.Warn("connection lost, reconnecting", "err", err, "delay", delay)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(delay):
+		}
+		delay = min(delay*2, l.maxDelay)
+	}
+}
+
+// saveSession stores the session the client may have renewed while connected.
+func (l *updateLoops) saveSession(loop *gateLoop, log *slog.Logger) {
+	session, err := loop.client.Session()
+	if err != nil || len(session) == 0 {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := l.repo.UpdateSession(ctx, loop.gate.ID, session); err != nil {
+		log.Warn("saving session failed", "err", err)
+	}
+}
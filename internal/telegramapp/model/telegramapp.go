@@ -0,0 +1,84 @@
+package model
+
+import (
+	"time"
+
+	sharedmodel "github.com/webitel/im-providers-service/internal/core/model"
+)
+
+// TelegramAppGate represents a gate of a Telegram user account.
+type TelegramAppGate struct {
+	ID       string           `json:"id" db:"id"`
+	DomainID int64            `json:"domain_id" db:"domain_id"`
+	Peer     sharedmodel.Peer `json:"peer" db:"peer"`
+	Name     string           `json:"name" db:"name"`
+	// UserID is the Telegram ID of the signed-in account.
+	UserID    int64                  `json:"user_id" db:"user_id"`
+	Phone     string                 `json:"phone" db:"phone"`
+	Username  string                 `json:"username" db:"username"`
+	Session   []byte                 `json:"-" db:"-"`
+	Status    sharedmodel.GateStatus `json:"status" db:"status"`
+	CreatedAt time.Time              `json:"created_at" db:"created_at"`
+	UpdatedAt time.Time              `json:"updated_at" db:"updated_at"`
+	Enabled   bool                   `json:"enabled" db:"enabled"`
+}
+
+type UpdateTelegramApp struct {
+	ID      string
+	Name    *string
+	Enabled *bool
+	Peer    *sharedmodel.Peer
+}
+
+func (r UpdateTelegramApp) ApplyTo(gate *TelegramAppGate) {
+	if r.Name != nil {
+		gate.Name = *r.Name
+	}
+	if r.Enabled != nil {
+		gate.Enabled = *r.Enabled
+	}
+	if r.Peer != nil {
+		gate.Peer = *r.Peer
+	}
+}
+
+// Login steps.
+const (
+	LoginStepCode     = "code"
+	LoginStepPassword = "password"
+	LoginStepDone     = "done"
+)
+
+// StartLogin requests a login code for the account to connect as a gate.
+type StartLogin struct {
+	Name  string
+	Dc    int64
+	Phone string
+	Peer  sharedmodel.Peer
+}
+
+// LoginState is an in-progress login. Step tells which input is expected next;
+// Gate is set once the login is done.
+type LoginState struct {
+	ID    string
+	Phone string
+	Step  string
+	Gate  *TelegramAppGate
+}
+
+func (r StartLogin) Validate() error {
+	var missing []string
+	if r.Name == "" {
+		missing = append(missing, "name")
+	}
+	if r.Dc <= 0 {
+		missing = append(missing, "domain_id")
+	}
+	if r.Phone == "" {
+		missing = append(missing, "phone")
+	}
+	if len(missing) > 0 {
+		return &sharedmodel.ValidationError{Fields: missing}
+	}
+	return nil
+}
@@ -0,0 +1,53 @@
+package telegramapp
+
+import (
+	impb "github.com/webitel/im-providers-service/gen/go/provider/v1"
+	grpcsrv "github.com/webitel/im-providers-service/infra/srv/grpc"
+	"github.com/webitel/im-providers-service/internal/provider"
+	tahandler "github.com/webitel/im-providers-service/internal/telegramapp/handler"
+	"github.com/webitel/im-providers-service/internal/telegramapp/mtproto/gotd"
+	taservice "github.com/webitel/im-providers-service/internal/telegramapp/service"
+	tastore "github.com/webitel/im-providers-service/internal/telegramapp/store"
+	tapostgres "github.com/webitel/im-providers-service/internal/telegramapp/store/postgres"
+	"go.uber.org/fx"
+)
+
+// Module provides the Telegram user-account provider, its update loops and the gRPC
+// gate service the accounts sign in through.
+var Module = fx.Module("telegram_app",
+	fx.Provide(
+		gotd.NewFactory,
+
+		// Provider adapter — provided as *telegramAppProvider for the lifecycle hooks,
+		// as a Provider for the registry and its loops as UpdateLoop for the service.
+		newProvider,
+		fx.Annotate(
+			func(p *telegramAppProvider) provider.Provider { return p },
+			fx.ResultTags(`group:"providers"`),
+		),
+		func(p *telegramAppProvider) taservice.UpdateLoop { return p.loops },
+
+		// Store implementations
+		fx.Annotate(tapostgres.NewTelegramAppStore, fx.As(new(tastore.TelegramAppStore))),
+
+		// Services
+		fx.Annotate(taservice.NewTelegramAppService, fx.As(new(taservice.TelegramAppManager))),
+
+		// gRPC handlers
+		tahandler.NewTelegramAppHandler,
+	),
+	fx.Invoke(registerLifecycle, RegisterTelegramAppService),
+)
+
+// RegisterTelegramAppService connects the Telegram account gRPC handler to the gRPC server.
+func RegisterTelegramAppService(server *grpcsrv.Server, telegramApp *tahandler.TelegramAppHandler) {
+	impb.RegisterTelegramAppServiceServer(server.Server, telegramApp)
+}
+
+// registerLifecycle connects the enabled gates on start and disconnects them on stop.
+func registerLifecycle(lc fx.Lifecycle, p *telegramAppProvider) {
+	lc.Append(fx.Hook{
+		OnStart: p.loops.StartAll,
+		OnStop:  p.loops.StopAll,
+	})
+}
@@ -0,0 +1,125 @@
+// Package mtproto defines the Telegram user-account client the telegramapp adapter
+// is built on. It keeps the adapter independent of the MTProto library in use:
+// a binding implements Factory and Client on top of it.
+package mtproto
+
+import (
+	"context"
+	"errors"
+	"io"
+)
+
+var (
+	// ErrPasswordNeeded is returned by SignIn when the account has two-step verification enabled.
+	ErrPasswordNeeded = errors.New("mtproto: 2FA password needed")
+	// ErrCodeInvalid is returned by SignIn when the login code is wrong or expired.
+	ErrCodeInvalid = errors.New("mtproto: login code invalid")
+	// ErrPasswordInvalid is returned by CheckPassword when the 2FA password is wrong.
+	ErrPasswordInvalid = errors.New("mtproto: 2FA password invalid")
+	// ErrUnauthorized is returned by Run when the session was revoked from another device.
+	ErrUnauthorized = errors.New("mtproto: session revoked")
+	// ErrUnavailable is returned by a factory without a Telegram application configured.
+	ErrUnavailable = errors.New("mtproto: no telegram application configured")
+)
+
+// Factory creates clients. An empty session starts a new login.
+type Factory interface {
+	New(session []byte) (Client, error)
+}
+
+// Client is a connection of a single Telegram user account.
+type Client interface {
+	// SendCode requests a login code for the phone and returns the code hash SignIn needs.
+	SendCode(ctx context.Context, phone string) (string, error)
+	// SignIn completes the login with the received code.
+	SignIn(ctx context.Context, phone, codeHash, code string) (*Account, error)
+	// CheckPassword completes a login that SignIn answered with ErrPasswordNeeded.
+	CheckPassword(ctx context.Context, password string) (*Account, error)
+	// Session returns the serialized authorization to resume the account with later.
+	Session() ([]byte, error)
+
+	// Run connects and calls onUpdate for every new message until ctx is done or the
+	// connection is lost. The client can be used for sending only while Run is active.
+	Run(ctx context.Context, onUpdate func(context.Context, *Update) error) error
+	SendMessage(ctx context.Context, msg *OutboundMessage) (int64, error)
+	// Download streams the media of a received message.
+	Download(ctx context.Context, media *Media) (io.ReadCloser, error)
+}
+
+// Account is the signed-in Telegram user.
+type Account struct {
+	UserID    int64
+	Username  string
+	FirstName string
+	LastName  string
+	Phone     string
+}
+
+// Media kinds.
+const (
+	MediaPhoto    = "photo"
+	MediaDocument = "document"
+)
+
+// Update is a new message of a private chat of the account.
+type Update struct {
+	MessageID int64
+	// Outgoing is set for messages the account itself sent from another device.
+	Outgoing bool
+	From     User
+	Text     string
+	Media    *Media
+	Location *Location
+	Contact  *Contact
+}
+
+// User is the other party of a private chat.
+type User struct {
+	ID        int64
+	Username  string
+	FirstName string
+	LastName  string
+	Phone     string
+}
+
+// Media references a file attached to a message. Ref is opaque to the adapter.
+type Media struct {
+	Kind     string
+	Ref      []byte
+	FileName string
+	MimeType string
+	Size     int64
+}
+
+type Location struct {
+	Latitude  float64
+	Longitude float64
+	Title     string
+	Address   string
+}
+
+type Contact struct {
+	FirstName string
+	LastName  string
+	Phone     string
+}
+
+// OutboundMessage is a message to a user. Media is fetched by the binding from MediaURL.
+type OutboundMessage struct {
+	UserID    int64
+	Text      string
+	MediaURL  string
+	MediaKind string
+	FileName  string
+	MimeType  string
+	Location  *Location
+	Contact   *Contact
+}
+
+// unavailableFactory is used while no Telegram application is configured.
+type unavailableFactory struct{}
+
+// NewUnavailableFactory returns a factory whose clients cannot be created.
+func NewUnavailableFactory() Factory { return unavailableFactory{} }
+
+func (unavailableFactory) New([]byte) (Client, error) { return nil, ErrUnavailable }
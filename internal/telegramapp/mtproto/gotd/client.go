@@ -0,0 +1,266 @@
+// Package gotd implements the Telegram user-account client of the telegramapp adapter
+// with gotd/td.
+package gotd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand/v2"
+	"sync"
+
+	"github.com/gotd/td/bin"
+	"github.com/gotd/td/telegram"
+	"github.com/gotd/td/telegram/auth"
+	"github.com/gotd/td/telegram/downloader"
+	"github.com/gotd/td/telegram/updates"
+	"github.com/gotd/td/tg"
+	"github.com/gotd/td/tgerr"
+	"github.com/webitel/im-providers-service/config"
+	"github.com/webitel/im-providers-service/internal/telegramapp/mtproto"
+)
+
+// errNotConnected is returned when the client is used for sending while it reconnects.
+var errNotConnected = errors.New("gotd: client is not connected")
+
+type factory struct {
+	appID   int
+	appHash string
+}
+
+// NewFactory returns the factory of clients signing in through the Telegram application
+// of the config. Without the application no account can sign in, so the factory then
+// fails like mtproto.NewUnavailableFactory.
+func NewFactory(cfg *config.Config) mtproto.Factory {
+	app := cfg.TelegramApp
+	if app.APIID == 0 || app.APIHash == "" {
+		return mtproto.NewUnavailableFactory()
+	}
+	return &factory{appID: app.APIID, appHash: app.APIHash}
+}
+
+func (f *factory) New(session []byte) (mtproto.Client, error) {
+	s, err := newStore(session)
+	if err != nil {
+		return nil, err
+	}
+	return &client{factory: f, store: s}, nil
+}
+
+// client connects for every login step and keeps a connection open while Run is active.
+// All connections share the store, so the authorization of the login is the one Run
+// resumes.
+type client struct {
+	*factory
+	store *store
+
+	mu   sync.Mutex
+	api  *tg.Client
+	gaps *updates.Manager
+}
+
+var _ mtproto.Client = (*client)(nil)
+
+func (c *client) SendCode(ctx context.Context, phone string) (string, error) {
+	var codeHash string
+	err := c.invoke(ctx, func(ctx context.Context, tc *telegram.Client) error {
+		sent, err := tc.Auth().SendCode(ctx, phone, auth.SendCodeOptions{})
+		if err != nil {
+			return err
+		}
+		code, ok := sent.(*tg.AuthSentCode)
+		if !ok {
+			return fmt.Errorf("gotd: unexpected sent code %T", sent)
+		}
+		codeHash = code.PhoneCodeHash
+		return nil
+	})
+	return codeHash, err
+}
+
+func (c *client) SignIn(ctx context.Context, phone, codeHash, code string) (*mtproto.Account, error) {
+	return c.authorize(ctx, func(ctx context.Context, a *auth.Client) (*tg.AuthAuthorization, error) {
+		return a.SignIn(ctx, phone, code, codeHash)
+	})
+}
+
+func (c *client) CheckPassword(ctx context.Context, password string) (*mtproto.Account, error) {
+	return c.authorize(ctx, func(ctx context.Context, a *auth.Client) (*tg.AuthAuthorization, error) {
+		return a.Password(ctx, password)
+	})
+}
+
+func (c *client) Session() ([]byte, error) {
+	return c.store.export()
+}
+
+// authorize runs a sign-in step and maps its outcome to the errors of the mtproto package.
+func (c *client) authorize(ctx context.Context, step func(context.Context, *auth.Client) (*tg.AuthAuthorization, error)) (*mtproto.Account, error) {
+	var account *mtproto.Account
+	err := c.invoke(ctx, func(ctx context.Context, tc *telegram.Client) error {
+		authorization, err := step(ctx, tc.Auth())
+		if err != nil {
+			return err
+		}
+		user, ok := authorization.User.(*tg.User)
+		if !ok {
+			return fmt.Errorf("gotd: unexpected user %T", authorization.User)
+		}
+		account = &mtproto.Account{
+			UserID:    user.ID,
+			Username:  user.Username,
+			FirstName: user.FirstName,
+			LastName:  user.LastName,
+			Phone:     user.Phone,
+		}
+		return nil
+	})
+
+	switch {
+	case err == nil:
+		return account, nil
+	case errors.Is(err, auth.ErrPasswordAuthNeeded):
+		return nil, mtproto.ErrPasswordNeeded
+	case errors.Is(err, auth.ErrPasswordInvalid):
+		return nil, mtproto.ErrPasswordInvalid
+	case tgerr.Is(err, "PHONE_CODE_INVALID", "PHONE_CODE_EXPIRED", "PHONE_CODE_EMPTY"):
+		return nil, fmt.Errorf("%w: %v", mtproto.ErrCodeInvalid, err)
+	default:
+		return nil, err
+	}
+}
+
+// invoke connects for a single request. Updates are not received on these connections.
+func (c *client) invoke(ctx context.Context, fn func(context.Context, *telegram.Client) error) error {
+	tc := telegram.NewClient(c.appID, c.appHash, telegram.Options{
+		SessionStorage: c.store,
+		NoUpdates:      true,
+	})
+	return tc.Run(ctx, func(ctx context.Context) error { return fn(ctx, tc) })
+}
+
+// Run connects the account and passes its new private messages to onUpdate. The update
+// manager fills the gaps of the update sequence, including the messages received while
+// the account was disconnected.
+func (c *client) Run(ctx context.Context, onUpdate func(context.Context, *mtproto.Update) error) error {
+	dispatcher := tg.NewUpdateDispatcher()
+	gaps := updates.New(updates.Config{
+		Handler:          dispatcher,
+		Storage:          c.store,
+		UserAccessHasher: c.store,
+	})
+	dispatcher.OnNewMessage(func(ctx context.Context, e tg.Entities, u *tg.UpdateNewMessage) error {
+		c.store.rememberUsers(e.Users)
+		if update := toUpdate(u.Message, e.Users); update != nil {
+			return onUpdate(ctx, update)
+		}
+		return nil
+	})
+
+	tc := telegram.NewClient(c.appID, c.appHash, telegram.Options{
+		SessionStorage: c.store,
+		UpdateHandler:  gaps,
+	})
+	err := tc.Run(ctx, func(ctx context.Context) error {
+		status, err := tc.Auth().Status(ctx)
+		if err != nil {
+			return err
+		}
+		if !status.Authorized {
+			return mtproto.ErrUnauthorized
+		}
+
+		c.connected(tc.API(), gaps)
+		defer c.connected(nil, nil)
+		return gaps.Run(ctx, tc.API(), status.User.ID, updates.AuthOptions{})
+	})
+	if auth.IsUnauthorized(err) {
+		return fmt.Errorf("%w: %v", mtproto.ErrUnauthorized, err)
+	}
+	return err
+}
+
+func (c *client) connected(api *tg.Client, gaps *updates.Manager) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.api, c.gaps = api, gaps
+}
+
+func (c *client) connection() (*tg.Client, *updates.Manager, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.api == nil {
+		return nil, nil, errNotConnected
+	}
+	return c.api, c.gaps, nil
+}
+
+// SendMessage sends the message to a user the account received a message from: Telegram
+// accepts a user only with the access hash it handed out to the account.
+func (c *client) SendMessage(ctx context.Context, msg *mtproto.OutboundMessage) (int64, error) {
+	api, gaps, err := c.connection()
+	if err != nil {
+		return 0, err
+	}
+	accessHash, ok := c.store.accessHash(msg.UserID)
+	if !ok {
+		return 0, fmt.Errorf("gotd: user %d is unknown to the account", msg.UserID)
+	}
+	peer := &tg.InputPeerUser{UserID: msg.UserID, AccessHash: accessHash}
+
+	media, err := inputMedia(ctx, api, msg)
+	if err != nil {
+		return 0, err
+	}
+
+	randomID := rand.Int64()
+	var sent tg.UpdatesClass
+	if media == nil {
+		sent, err = api.MessagesSendMessage(ctx, &tg.MessagesSendMessageRequest{
+			Peer:     peer,
+			Message:  msg.Text,
+			RandomID: randomID,
+		})
+	} else {
+		sent, err = api.MessagesSendMedia(ctx, &tg.MessagesSendMediaRequest{
+			Peer:     peer,
+			Media:    media,
+			Message:  msg.Text,
+			RandomID: randomID,
+		})
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	// The sent message advances the update sequence too. It is delivered and the
+	// manager recovers a gap on its own, so a failure here is not the caller's concern.
+	_ = gaps.Handle(ctx, sent)
+
+	id, ok := sentMessageID(sent, randomID)
+	if !ok {
+		return 0, fmt.Errorf("gotd: no message ID in %T", sent)
+	}
+	return int64(id), nil
+}
+
+// Download streams the file of a received message from the DC of the account.
+func (c *client) Download(ctx context.Context, media *mtproto.Media) (io.ReadCloser, error) {
+	api, _, err := c.connection()
+	if err != nil {
+		return nil, err
+	}
+	location, err := tg.DecodeInputFileLocation(&bin.Buffer{Buf: media.Ref})
+	if err != nil {
+		return nil, fmt.Errorf("gotd: decode file location: %w", err)
+	}
+
+	r, w := io.Pipe()
+	go func() {
+		_, err := downloader.NewDownloader().Download(api, location).Stream(ctx, w)
+		w.CloseWithError(err)
+	}()
+	return r, nil
+}
@@ -0,0 +1,199 @@
+package gotd
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gotd/td/bin"
+	"github.com/gotd/td/telegram/uploader"
+	"github.com/gotd/td/tg"
+	"github.com/webitel/im-providers-service/internal/telegramapp/mtproto"
+)
+
+// defaultMimeType is sent for documents of an unknown type; Telegram requires one.
+const defaultMimeType = "application/octet-stream"
+
+// toUpdate converts a message of a private chat. Messages of groups and channels,
+// and service messages, are not conversations of the account and return nil.
+func toUpdate(msg tg.MessageClass, users map[int64]*tg.User) *mtproto.Update {
+	m, ok := msg.(*tg.Message)
+	if !ok {
+		return nil
+	}
+	peer, ok := m.PeerID.(*tg.PeerUser)
+	if !ok {
+		return nil
+	}
+
+	update := &mtproto.Update{
+		MessageID: int64(m.ID),
+		Outgoing:  m.Out,
+		From:      mtproto.User{ID: peer.UserID},
+		Text:      m.Message,
+	}
+	if u, ok := users[peer.UserID]; ok {
+		update.From.Username = u.Username
+		update.From.FirstName = u.FirstName
+		update.From.LastName = u.LastName
+		update.From.Phone = u.Phone
+	}
+
+	switch media := m.Media.(type) {
+	case *tg.MessageMediaPhoto:
+		update.Media = photoMedia(media)
+	case *tg.MessageMediaDocument:
+		update.Media = documentMedia(media)
+	case *tg.MessageMediaGeo:
+		update.Location = geoLocation(media.Geo)
+	case *tg.MessageMediaVenue:
+		if update.Location = geoLocation(media.Geo); update.Location != nil {
+			update.Location.Title = media.Title
+			update.Location.Address = media.Address
+		}
+	case *tg.MessageMediaContact:
+		update.Contact = &mtproto.Contact{
+			FirstName: media.FirstName,
+			LastName:  media.LastName,
+			Phone:     media.PhoneNumber,
+		}
+	}
+	return update
+}
+
+// photoMedia references the largest size of the photo.
+func photoMedia(media *tg.MessageMediaPhoto) *mtproto.Media {
+	photo, ok := media.Photo.(*tg.Photo)
+	if !ok {
+		return nil
+	}
+
+	var thumb string
+	var size int
+	for _, s := range photo.Sizes {
+		switch s := s.(type) {
+		case *tg.PhotoSize:
+			if s.Size >= size {
+				thumb, size = s.Type, s.Size
+			}
+		case *tg.PhotoSizeProgressive:
+			if n := len(s.Sizes); n > 0 && s.Sizes[n-1] >= size {
+				thumb, size = s.Type, s.Sizes[n-1]
+			}
+		}
+	}
+	if thumb == "" {
+		return nil
+	}
+
+	return &mtproto.Media{
+		Kind: mtproto.MediaPhoto,
+		Ref: encodeLocation(&tg.InputPhotoFileLocation{
+			ID:            photo.ID,
+			AccessHash:    photo.AccessHash,
+			FileReference: photo.FileReference,
+			ThumbSize:     thumb,
+		}),
+		MimeType: "image/jpeg",
+		Size:     int64(size),
+	}
+}
+
+func documentMedia(media *tg.MessageMediaDocument) *mtproto.Media {
+	doc, ok := media.Document.(*tg.Document)
+	if !ok {
+		return nil
+	}
+
+	m := &mtproto.Media{
+		Kind: mtproto.MediaDocument,
+		Ref: encodeLocation(&tg.InputDocumentFileLocation{
+			ID:            doc.ID,
+			AccessHash:    doc.AccessHash,
+			FileReference: doc.FileReference,
+		}),
+		MimeType: doc.MimeType,
+		Size:     doc.Size,
+	}
+	for _, attr := range doc.Attributes {
+		if name, ok := attr.(*tg.DocumentAttributeFilename); ok {
+			m.FileName = name.FileName
+		}
+	}
+	return m
+}
+
+func geoLocation(geo tg.GeoPointClass) *mtproto.Location {
+	point, ok := geo.(*tg.GeoPoint)
+	if !ok {
+		return nil
+	}
+	return &mtproto.Location{Latitude: point.Lat, Longitude: point.Long}
+}
+
+// encodeLocation serializes a file location into the opaque Ref of the media.
+func encodeLocation(location tg.InputFileLocationClass) []byte {
+	var b bin.Buffer
+	if err := location.Encode(&b); err != nil {
+		return nil
+	}
+	return b.Buf
+}
+
+// inputMedia builds the media of an outbound message; a text message has none.
+// Files are fetched from their URL and uploaded to Telegram first.
+func inputMedia(ctx context.Context, api *tg.Client, msg *mtproto.OutboundMessage) (tg.InputMediaClass, error) {
+	switch {
+	case msg.Location != nil:
+		point := &tg.InputGeoPoint{Lat: msg.Location.Latitude, Long: msg.Location.Longitude}
+		if msg.Location.Title == "" && msg.Location.Address == "" {
+			return &tg.InputMediaGeoPoint{GeoPoint: point}, nil
+		}
+		return &tg.InputMediaVenue{GeoPoint: point, Title: msg.Location.Title, Address: msg.Location.Address}, nil
+
+	case msg.Contact != nil:
+		return &tg.InputMediaContact{
+			PhoneNumber: msg.Contact.Phone,
+			FirstName:   msg.Contact.FirstName,
+			LastName:    msg.Contact.LastName,
+		}, nil
+
+	case msg.MediaURL != "":
+		file, err := uploader.NewUploader(api).FromURL(ctx, msg.MediaURL)
+		if err != nil {
+			return nil, fmt.Errorf("gotd: upload %s: %w", msg.MediaKind, err)
+		}
+		if msg.MediaKind == mtproto.MediaPhoto {
+			return &tg.InputMediaUploadedPhoto{File: file}, nil
+		}
+
+		doc := &tg.InputMediaUploadedDocument{File: file, MimeType: msg.MimeType, ForceFile: true}
+		if doc.MimeType == "" {
+			doc.MimeType = defaultMimeType
+		}
+		if msg.FileName != "" {
+			doc.Attributes = []tg.DocumentAttributeClass{&tg.DocumentAttributeFilename{FileName: msg.FileName}}
+		}
+		return doc, nil
+	}
+	return nil, nil
+}
+
+// sentMessageID finds the ID Telegram assigned to the message sent with randomID.
+func sentMessageID(sent tg.UpdatesClass, randomID int64) (int, bool) {
+	var list []tg.UpdateClass
+	switch u := sent.(type) {
+	case *tg.UpdateShortSentMessage:
+		return u.ID, true
+	case *tg.Updates:
+		list = u.Updates
+	case *tg.UpdatesCombined:
+		list = u.Updates
+	}
+
+	for _, u := range list {
+		if id, ok := u.(*tg.UpdateMessageID); ok && id.RandomID == randomID {
+			return id.ID, true
+		}
+	}
+	return 0, false
+}
@@ -0,0 +1,122 @@
+package gotd
+
+import (
+	"testing"
+
+	"github.com/gotd/td/bin"
+	"github.com/gotd/td/tg"
+	"github.com/webitel/im-providers-service/internal/telegramapp/mtproto"
+)
+
+func TestToUpdate_PrivateMessage(t *testing.T) {
+	users := map[int64]*tg.User{
+		42: {ID: 42, Username: "jdoe", FirstName: "John", LastName: "Doe", Phone: "380501234567"},
+	}
+	msg := &tg.Message{ID: 7, PeerID: &tg.PeerUser{UserID: 42}, Message: "hello"}
+
+	u := toUpdate(msg, users)
+	if u == nil {
+		t.Fatal("update = nil")
+	}
+	want := mtproto.User{ID: 42, Username: "jdoe", FirstName: "John", LastName: "Doe", Phone: "380501234567"}
+	if u.MessageID != 7 || u.Text != "hello" || u.Outgoing || u.From != want {
+		t.Errorf("update = %+v", u)
+	}
+}
+
+func TestToUpdate_SkipsGroupsAndServiceMessages(t *testing.T) {
+	for name, msg := range map[string]tg.MessageClass{
+		"group":   &tg.Message{ID: 1, PeerID: &tg.PeerChat{ChatID: 5}, Message: "hi all"},
+		"channel": &tg.Message{ID: 2, PeerID: &tg.PeerChannel{ChannelID: 6}, Message: "news"},
+		"service": &tg.MessageService{ID: 3, PeerID: &tg.PeerUser{UserID: 42}},
+	} {
+		if u := toUpdate(msg, nil); u != nil {
+			t.Errorf("%s: update = %+v, want nil", name, u)
+		}
+	}
+}
+
+func TestToUpdate_PhotoReferencesLargestSize(t *testing.T) {
+	msg := &tg.Message{
+		ID:      8,
+		PeerID:  &tg.PeerUser{UserID: 42},
+		Message: "caption",
+		Media: &tg.MessageMediaPhoto{Photo: &tg.Photo{
+			ID:            100,
+			AccessHash:    200,
+			FileReference: []byte{1, 2, 3},
+			Sizes: []tg.PhotoSizeClass{
+				&tg.PhotoSize{Type: "m", Size: 10_000},
+				&tg.PhotoSizeProgressive{Type: "y", Sizes: []int{5_000, 60_000}},
+				&tg.PhotoSize{Type: "x", Size: 30_000},
+			},
+		}},
+	}
+
+	u := toUpdate(msg, nil)
+	if u.Media == nil || u.Media.Kind != mtproto.MediaPhoto || u.Media.Size != 60_000 {
+		t.Fatalf("media = %+v", u.Media)
+	}
+
+	location, err := tg.DecodeInputFileLocation(&bin.Buffer{Buf: u.Media.Ref})
+	if err != nil {
+		t.Fatalf("decoding ref: %v", err)
+	}
+	photo, ok := location.(*tg.InputPhotoFileLocation)
+	if !ok || photo.ID != 100 || photo.AccessHash != 200 || photo.ThumbSize != "y" {
+		t.Errorf("location = %+v", location)
+	}
+}
+
+func TestToUpdate_DocumentVenueAndContact(t *testing.T) {
+	doc := toUpdate(&tg.Message{PeerID: &tg.PeerUser{UserID: 42}, Media: &tg.MessageMediaDocument{Document: &tg.Document{
+		ID:         1,
+		MimeType:   "application/pdf",
+		Size:       2048,
+		Attributes: []tg.DocumentAttributeClass{&tg.DocumentAttributeFilename{FileName: "invoice.pdf"}},
+	}}}, nil)
+	if m := doc.Media; m == nil || m.Kind != mtproto.MediaDocument || m.FileName != "invoice.pdf" || m.MimeType != "application/pdf" || m.Size != 2048 {
+		t.Errorf("document = %+v", doc.Media)
+	}
+
+	venue := toUpdate(&tg.Message{PeerID: &tg.PeerUser{UserID: 42}, Media: &tg.MessageMediaVenue{
+		Geo:     &tg.GeoPoint{Lat: 50.45, Long: 30.52},
+		Title:   "Office",
+		Address: "Khreshchatyk 1",
+	}}, nil)
+	if want := (mtproto.Location{Latitude: 50.45, Longitude: 30.52, Title: "Office", Address: "Khreshchatyk 1"}); venue.Location == nil || *venue.Location != want {
+		t.Errorf("venue = %+v", venue.Location)
+	}
+
+	contact := toUpdate(&tg.Message{PeerID: &tg.PeerUser{UserID: 42}, Media: &tg.MessageMediaContact{
+		FirstName: "Jane", PhoneNumber: "380671234567",
+	}}, nil)
+	if want := (mtproto.Contact{FirstName: "Jane", Phone: "380671234567"}); contact.Contact == nil || *contact.Contact != want {
+		t.Errorf("contact = %+v", contact.Contact)
+	}
+}
+
+func TestSentMessageID(t *testing.T) {
+	tests := []struct {
+		name   string
+		sent   tg.UpdatesClass
+		wantID int
+		wantOK bool
+	}{
+		{name: "short", sent: &tg.UpdateShortSentMessage{ID: 11}, wantID: 11, wantOK: true},
+		{name: "matching random id", sent: &tg.Updates{Updates: []tg.UpdateClass{
+			&tg.UpdateMessageID{ID: 12, RandomID: 99},
+			&tg.UpdateMessageID{ID: 13, RandomID: 5},
+		}}, wantID: 13, wantOK: true},
+		{name: "no match", sent: &tg.Updates{Updates: []tg.UpdateClass{&tg.UpdateMessageID{ID: 12, RandomID: 99}}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			id, ok := sentMessageID(tt.sent, 5)
+			if id != tt.wantID || ok != tt.wantOK {
+				t.Errorf("sentMessageID = %d, %v; want %d, %v", id, ok, tt.wantID, tt.wantOK)
+			}
+		})
+	}
+}
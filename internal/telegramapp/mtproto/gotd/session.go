@@ -0,0 +1,198 @@
+package gotd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+
+	"github.com/gotd/td/session"
+	"github.com/gotd/td/telegram"
+	"github.com/gotd/td/telegram/updates"
+	"github.com/gotd/td/tg"
+)
+
+// sessionData is the exported session of an account: its MTProto authorization, the
+// access hashes of the users it talked to, without which nothing can be sent to them,
+// and its position in the update sequence, so the messages received while the gate
+// was disconnected are fetched on the next connect.
+type sessionData struct {
+	Auth  []byte          `json:"auth"`
+	Users map[int64]int64 `json:"users,omitempty"`
+	State *updates.State  `json:"state,omitempty"`
+}
+
+// store keeps the session of a single account. It serves gotd as the session storage,
+// the update state storage and the user access hash storage, so the userID the update
+// manager passes is always the account itself and is ignored.
+//
+// Channel positions are kept in memory only: the account is connected for its private
+// chats, and the manager drops channels whose access hash it does not know anyway.
+type store struct {
+	mu       sync.Mutex
+	data     sessionData
+	channels map[int64]int
+}
+
+var (
+	_ telegram.SessionStorage  = (*store)(nil)
+	_ updates.StateStorage     = (*store)(nil)
+	_ updates.UserAccessHasher = (*store)(nil)
+)
+
+func newStore(raw []byte) (*store, error) {
+	s := &store{channels: make(map[int64]int)}
+	if len(raw) > 0 {
+		if err := json.Unmarshal(raw, &s.data); err != nil {
+			return nil, fmt.Errorf("gotd: decode session: %w", err)
+		}
+	}
+	if s.data.Users == nil {
+		s.data.Users = make(map[int64]int64)
+	}
+	return s, nil
+}
+
+// export serializes the session; it is empty until the account is authorized by Telegram.
+func (s *store) export() ([]byte, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if len(s.data.Auth) == 0 {
+		return nil, nil
+	}
+	return json.Marshal(s.data)
+}
+
+// rememberUsers keeps the access hashes of the users of an update. Min users carry a
+// hash valid only in the context of the message, so they are skipped.
+func (s *store) rememberUsers(users map[int64]*tg.User) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for id, u := range users {
+		if hash, ok := u.GetAccessHash(); ok && !u.Min {
+			s.data.Users[id] = hash
+		}
+	}
+}
+
+func (s *store) accessHash(userID int64) (int64, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	hash, ok := s.data.Users[userID]
+	return hash, ok
+}
+
+func (s *store) LoadSession(context.Context) ([]byte, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if len(s.data.Auth) == 0 {
+		return nil, session.ErrNotFound
+	}
+	return append([]byte(nil), s.data.Auth...), nil
+}
+
+func (s *store) StoreSession(_ context.Context, data []byte) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.data.Auth = append([]byte(nil), data...)
+	return nil
+}
+
+func (s *store) GetState(context.Context, int64) (updates.State, bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.data.State == nil {
+		return updates.State{}, false, nil
+	}
+	return *s.data.State, true, nil
+}
+
+func (s *store) SetState(_ context.Context, _ int64, state updates.State) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.data.State = &state
+	return nil
+}
+
+func (s *store) SetPts(_ context.Context, _ int64, pts int) error {
+	return s.setState(func(st *updates.State) { st.Pts = pts })
+}
+
+func (s *store) SetQts(_ context.Context, _ int64, qts int) error {
+	return s.setState(func(st *updates.State) { st.Qts = qts })
+}
+
+func (s *store) SetDate(_ context.Context, _ int64, date int) error {
+	return s.setState(func(st *updates.State) { st.Date = date })
+}
+
+func (s *store) SetSeq(_ context.Context, _ int64, seq int) error {
+	return s.setState(func(st *updates.State) { st.Seq = seq })
+}
+
+func (s *store) SetDateSeq(_ context.Context, _ int64, date, seq int) error {
+	return s.setState(func(st *updates.State) { st.Date, st.Seq = date, seq })
+}
+
+func (s *store) setState(set func(*updates.State)) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.data.State == nil {
+		s.data.State = &updates.State{}
+	}
+	set(s.data.State)
+	return nil
+}
+
+func (s *store) GetChannelPts(_ context.Context, _, channelID int64) (int, bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	pts, ok := s.channels[channelID]
+	return pts, ok, nil
+}
+
+func (s *store) SetChannelPts(_ context.Context, _, channelID int64, pts int) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.channels[channelID] = pts
+	return nil
+}
+
+func (s *store) ForEachChannels(ctx context.Context, _ int64, f func(ctx context.Context, channelID int64, pts int) error) error {
+	s.mu.Lock()
+	channels := make(map[int64]int, len(s.channels))
+	for id, pts := range s.channels {
+		channels[id] = pts
+	}
+	s.mu.Unlock()
+
+	for id, pts := range channels {
+		if err := f(ctx, id, pts); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *store) SetUserAccessHash(_ context.Context, _, targetUserID, accessHash int64) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.data.Users[targetUserID] = accessHash
+	return nil
+}
+
+func (s *store) GetUserAccessHash(_ context.Context, _, targetUserID int64) (int64, bool, error) {
+	hash, ok := s.accessHash(targetUserID)
+	return hash, ok, nil
+}
@@ -0,0 +1,67 @@
+package gotd
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/gotd/td/session"
+	"github.com/gotd/td/telegram/updates"
+	"github.com/gotd/td/tg"
+)
+
+func TestStore_SessionIsEmptyUntilAuthorized(t *testing.T) {
+	s, err := newStore(nil)
+	if err != nil {
+		t.Fatalf("newStore: %v", err)
+	}
+
+	if _, err := s.LoadSession(context.Background()); !errors.Is(err, session.ErrNotFound) {
+		t.Errorf("LoadSession err = %v, want ErrNotFound", err)
+	}
+	if raw, err := s.export(); err != nil || raw != nil {
+		t.Errorf("export = %q, %v; want nothing", raw, err)
+	}
+}
+
+func TestStore_ResumesExportedSession(t *testing.T) {
+	ctx := context.Background()
+	s, _ := newStore(nil)
+
+	_ = s.StoreSession(ctx, []byte(`{"auth_key":"k"}`))
+	_ = s.SetState(ctx, 1, updates.State{Pts: 10, Qts: 1, Date: 1700000000, Seq: 3})
+	_ = s.SetPts(ctx, 1, 12)
+	_ = s.SetUserAccessHash(ctx, 1, 42, 4242)
+	s.rememberUsers(map[int64]*tg.User{
+		43: func() *tg.User { u := &tg.User{ID: 43}; u.SetAccessHash(4343); return u }(),
+		44: func() *tg.User { u := &tg.User{ID: 44, Min: true}; u.SetAccessHash(4444); return u }(),
+	})
+	_ = s.SetChannelPts(ctx, 1, 7, 70)
+
+	raw, err := s.export()
+	if err != nil {
+		t.Fatalf("export: %v", err)
+	}
+	resumed, err := newStore(raw)
+	if err != nil {
+		t.Fatalf("newStore: %v", err)
+	}
+
+	if auth, _ := resumed.LoadSession(ctx); string(auth) != `{"auth_key":"k"}` {
+		t.Errorf("auth = %s", auth)
+	}
+	if state, ok, _ := resumed.GetState(ctx, 1); !ok || state != (updates.State{Pts: 12, Qts: 1, Date: 1700000000, Seq: 3}) {
+		t.Errorf("state = %+v, %v", state, ok)
+	}
+	for id, want := range map[int64]int64{42: 4242, 43: 4343} {
+		if hash, ok := resumed.accessHash(id); !ok || hash != want {
+			t.Errorf("access hash of %d = %d, %v; want %d", id, hash, ok, want)
+		}
+	}
+	if _, ok := resumed.accessHash(44); ok {
+		t.Error("access hash of a min user was kept")
+	}
+	if _, ok, _ := resumed.GetChannelPts(ctx, 1, 7); ok {
+		t.Error("channel position was persisted")
+	}
+}
@@ -0,0 +1,148 @@
+package telegramapp
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/google/uuid"
+	contactv1 "github.com/webitel/im-providers-service/gen/go/contact/v1"
+	sharedmodel "github.com/webitel/im-providers-service/internal/core/model"
+	"github.com/webitel/im-providers-service/internal/provider/contactsync"
+	tamodel "github.com/webitel/im-providers-service/internal/telegramapp/model"
+	"github.com/webitel/im-providers-service/internal/telegramapp/mtproto"
+)
+
+func (p *telegramAppProvider) SendText(ctx context.Context, req *sharedmodel.Message) (*sharedmodel.MessageResponse, error) {
+	return p.send(ctx, req, &mtproto.OutboundMessage{Text: req.Text})
+}
+
+// SendImage sends every image as a photo, the text goes as the caption of the first one.
+func (p *telegramAppProvider) SendImage(ctx context.Context, req *sharedmodel.Message) (*sharedmodel.MessageResponse, error) {
+	msgs := make([]*mtproto.OutboundMessage, 0, len(req.Images))
+	for i, img := range req.Images {
+		msg := &mtproto.OutboundMessage{
+			MediaURL:  img.URL,
+			MediaKind: mtproto.MediaPhoto,
+			FileName:  img.FileName,
+			MimeType:  img.MimeType,
+		}
+		if i == 0 {
+			msg.Text = req.Text
+		}
+		msgs = append(msgs, msg)
+	}
+	return p.send(ctx, req, msgs...)
+}
+
+// SendDocument sends every document as a file, the text goes as the caption of the first one.
+func (p *telegramAppProvider) SendDocument(ctx context.Context, req *sharedmodel.Message) (*sharedmodel.MessageResponse, error) {
+	msgs := make([]*mtproto.OutboundMessage, 0, len(req.Documents))
+	for i, doc := range req.Documents {
+		msg := &mtproto.OutboundMessage{
+			MediaURL:  doc.URL,
+			MediaKind: mtproto.MediaDocument,
+			FileName:  doc.FileName,
+			MimeType:  doc.MimeType,
+		}
+		if i == 0 {
+			msg.Text = req.Text
+		}
+		msgs = append(msgs, msg)
+	}
+	return p.send(ctx, req, msgs...)
+}
+
+func (p *telegramAppProvider) SendLocation(ctx context.Context, req *sharedmodel.Message) (*sharedmodel.MessageResponse, error) {
+	if req.Location == nil {
+		return nil, fmt.Errorf("telegram_app: message has no location")
+	}
+	return p.send(ctx, req, &mtproto.OutboundMessage{
+		Location: &mtproto.Location{
+			Latitude:  req.Location.Latitude,
+			Longitude: req.Location.Longitude,
+			Title:     req.Location.Name,
+			Address:   req.Location.Address,
+		},
+	})
+}
+
+// SendContact sends a contact message per card. Telegram contacts hold a single phone,
+// so cards without one are sent as formatted text.
+func (p *telegramAppProvider) SendContact(ctx context.Context, req *sharedmodel.Message) (*sharedmodel.MessageResponse, error) {
+	msgs := make([]*mtproto.OutboundMessage, 0, len(req.Contacts))
+	for _, card := range req.Contacts {
+		if len(card.Phones) == 0 {
+			msgs = append(msgs, &mtproto.OutboundMessage{Text: card.PlainText()})
+			continue
+		}
+		msgs = append(msgs, &mtproto.OutboundMessage{
+			Contact: &mtproto.Contact{FirstName: card.FormattedName(), Phone: card.Phones[0]},
+		})
+	}
+	return p.send(ctx, req, msgs...)
+}
+
+// send delivers the messages in order through the connected account of the gate and
+// returns the response of the first one. It stops at the first failure.
+func (p *telegramAppProvider) send(ctx context.Context, req *sharedmodel.Message, msgs ...*mtproto.OutboundMessage) (*sharedmodel.MessageResponse, error) {
+	if len(msgs) == 0 {
+		return nil, fmt.Errorf("telegram_app: nothing to send")
+	}
+
+	client, gate, err := p.loops.Client(req.GateID)
+	if err != nil {
+		return nil, err
+	}
+	userID, err := p.resolveReceiver(ctx, gate, req.To.Sub)
+	if err != nil {
+		return nil, err
+	}
+
+	var first *sharedmodel.MessageResponse
+	for _, msg := range msgs {
+		msg.UserID = userID
+
+		id, err := client.SendMessage(ctx, msg)
+		if err != nil {
+			return nil, err
+		}
+		if first == nil {
+			first = &sharedmodel.MessageResponse{ID: strconv.FormatInt(id, 10)}
+		}
+	}
+	return first, nil
+}
+
+// resolveReceiver returns the Telegram user ID for the given sub.
+// A numeric ID is returned as-is; an internal contact UUID is resolved via the contact service.
+func (p *telegramAppProvider) resolveReceiver(ctx context.Context, gate *tamodel.TelegramAppGate, contactID string) (int64, error) {
+	if _, err := uuid.Parse(contactID); err != nil {
+		userID, err := strconv.ParseInt(contactID, 10, 64)
+		if err != nil {
+			return 0, fmt.Errorf("telegram_app: invalid receiver %q", contactID)
+		}
+		return userID, nil
+	}
+	if userID, ok := p.receiverCache.Get(contactID); ok {
+		return userID, nil
+	}
+
+	authCtx := contactsync.WithGatewayIdentity(ctx, gate.DomainID, gate.Peer)
+	resp, err := p.contactClient.SearchContact(authCtx, &contactv1.SearchContactRequest{
+		Ids: []string{contactID},
+	})
+	if err != nil {
+		return 0, fmt.Errorf("resolve telegram user for %s: %w", contactID, err)
+	}
+	items := resp.GetContacts()
+	if len(items) == 0 || items[0].GetSubject() == "" {
+		return 0, fmt.Errorf("resolve telegram user for %s: contact not found or has no subject", contactID)
+	}
+	userID, err := strconv.ParseInt(items[0].GetSubject(), 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("resolve telegram user for %s: invalid subject %q", contactID, items[0].GetSubject())
+	}
+	p.receiverCache.Add(contactID, userID)
+	return userID, nil
+}
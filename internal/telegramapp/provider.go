@@ -0,0 +1,71 @@
+// Package telegramapp implements the Telegram user-account provider. Unlike the
+// bot providers it signs in as a regular account over MTProto and receives
+// updates through a long-lived connection per gate instead of a webhook.
+package telegramapp
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	lru "github.com/hashicorp/golang-lru/v2"
+	imcontact "github.com/webitel/im-providers-service/infra/client/grpc/im-contact"
+	imgateway "github.com/webitel/im-providers-service/infra/client/grpc/im-gateway"
+	sharedsvc "github.com/webitel/im-providers-service/internal/core/service"
+	sharedstore "github.com/webitel/im-providers-service/internal/core/store"
+	"github.com/webitel/im-providers-service/internal/provider"
+	"github.com/webitel/im-providers-service/internal/telegramapp/mtproto"
+	tastore "github.com/webitel/im-providers-service/internal/telegramapp/store"
+)
+
+// errWebhookUnsupported is returned for webhook deliveries: updates arrive over MTProto.
+var errWebhookUnsupported = errors.New("telegram_app: updates are received over MTProto, not webhooks")
+
+type telegramAppProvider struct {
+	logger        *slog.Logger
+	messenger     sharedsvc.Messenger
+	userCache     sharedstore.ExternalUserCache
+	gatewayer     *imgateway.Client
+	media         sharedsvc.MediaManager
+	contactClient *imcontact.Client
+	loops         *updateLoops
+	// receiverCache maps internal contact UUID → Telegram user ID to avoid an
+	// im-contact round-trip on every outbound message.
+	receiverCache *lru.Cache[string, int64]
+}
+
+func newProvider(
+	m sharedsvc.Messenger,
+	l *slog.Logger,
+	uc sharedstore.ExternalUserCache,
+	repo tastore.TelegramAppStore,
+	gatewayer *imgateway.Client,
+	media sharedsvc.MediaManager,
+	contactClient *imcontact.Client,
+	factory mtproto.Factory,
+) *telegramAppProvider {
+	receiverCache, _ := lru.New[string, int64](1000)
+	p := &telegramAppProvider{
+		logger:        l.With("provider", "telegram_app"),
+		messenger:     m,
+		userCache:     uc,
+		gatewayer:     gatewayer,
+		media:         media,
+		contactClient: contactClient,
+		receiverCache: receiverCache,
+	}
+	p.loops = newUpdateLoops(factory, repo, p.handleUpdate, p.logger)
+	return p
+}
+
+var (
+	_ provider.Provider       = (*telegramAppProvider)(nil)
+	_ provider.LocationSender = (*telegramAppProvider)(nil)
+	_ provider.ContactSender  = (*telegramAppProvider)(nil)
+)
+
+func (p *telegramAppProvider) Type() string { return "telegram_app" }
+
+func (p *telegramAppProvider) HandleWebhook(context.Context, []byte) error {
+	return errWebhookUnsupported
+}
@@ -0,0 +1,333 @@
+package telegramapp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	sharedmodel "github.com/webitel/im-providers-service/internal/core/model"
+	"github.com/webitel/im-providers-service/internal/provider/providertest"
+	tamodel "github.com/webitel/im-providers-service/internal/telegramapp/model"
+	"github.com/webitel/im-providers-service/internal/telegramapp/mtproto"
+	tastore "github.com/webitel/im-providers-service/internal/telegramapp/store"
+)
+
+const testGateID = "0190a8a4-5f0e-7c2a-9d3b-0a1b2c3d4e5f"
+
+var noopLogger = slog.New(slog.NewTextHandler(io.Discard, nil))
+
+// gateSessions serves the enabled gates to the update loops and keeps the sessions they
+// save. The provider never writes gates; that is the job of the gate service.
+type gateSessions struct {
+	mu       sync.Mutex
+	gates    []*tamodel.TelegramAppGate
+	sessions map[string][]byte
+}
+
+var _ tastore.TelegramAppStore = (*gateSessions)(nil)
+
+func newGateSessions(gates ...*tamodel.TelegramAppGate) *gateSessions {
+	return &gateSessions{gates: gates, sessions: map[string][]byte{}}
+}
+
+func (s *gateSessions) SelectEnabled(context.Context) ([]*tamodel.TelegramAppGate, error) {
+	var gates []*tamodel.TelegramAppGate
+	for _, g := range s.gates {
+		if g.Enabled {
+			gates = append(gates, g)
+		}
+	}
+	return gates, nil
+}
+
+func (s *gateSessions) UpdateSession(_ context.Context, id string, session []byte) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.sessions[id] = session
+	return nil
+}
+
+func (s *gateSessions) session(id string) []byte {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.sessions[id]
+}
+
+func (s *gateSessions) Select(context.Context, string) (*tamodel.TelegramAppGate, error) {
+	panic("telegram_app provider must not read single gates")
+}
+
+func (s *gateSessions) Insert(context.Context, int64, *tamodel.TelegramAppGate) error {
+	panic("telegram_app provider must not insert gates")
+}
+
+func (s *gateSessions) Update(context.Context, *tamodel.TelegramAppGate) error {
+	panic("telegram_app provider must not update gates")
+}
+
+func (s *gateSessions) Delete(context.Context, string) error {
+	panic("telegram_app provider must not delete gates")
+}
+
+// accountConn is the connection of a signed-in account. Every Run drops with the next
+// scripted error and then stays connected until stopped; the session it exports
+// counts the connections. It is its own factory: the loops reconnect the same account.
+type accountConn struct {
+	mu      sync.Mutex
+	drops   []error
+	runs    int
+	sent    []*mtproto.OutboundMessage
+	media   string
+	running chan struct{}
+}
+
+var _ mtproto.Factory = (*accountConn)(nil)
+
+func newAccountConn(drops ...error) *accountConn {
+	return &accountConn{drops: drops, running: make(chan struct{}, 16)}
+}
+
+func (c *accountConn) New([]byte) (mtproto.Client, error) { return c, nil }
+
+func (c *accountConn) SendCode(context.Context, string) (string, error) {
+	panic("signed-in account must not log in")
+}
+
+func (c *accountConn) SignIn(context.Context, string, string, string) (*mtproto.Account, error) {
+	panic("signed-in account must not log in")
+}
+
+func (c *accountConn) CheckPassword(context.Context, string) (*mtproto.Account, error) {
+	panic("signed-in account must not log in")
+}
+
+func (c *accountConn) Session() ([]byte, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return fmt.Appendf(nil, "session-%d", c.runs), nil
+}
+
+func (c *accountConn) Run(ctx context.Context, _ func(context.Context, *mtproto.Update) error) error {
+	c.mu.Lock()
+	c.runs++
+	n := c.runs
+	c.mu.Unlock()
+
+	c.running <- struct{}{}
+	if n <= len(c.drops) {
+		return c.drops[n-1]
+	}
+	<-ctx.Done()
+	return ctx.Err()
+}
+
+func (c *accountConn) SendMessage(_ context.Context, msg *mtproto.OutboundMessage) (int64, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.sent = append(c.sent, msg)
+	return int64(100 + len(c.sent)), nil
+}
+
+func (c *accountConn) Download(context.Context, *mtproto.Media) (io.ReadCloser, error) {
+	return io.NopCloser(strings.NewReader(c.media)), nil
+}
+
+func (c *accountConn) runCount() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.runs
+}
+
+func salesGate() *tamodel.TelegramAppGate {
+	return &tamodel.TelegramAppGate{
+		ID:       testGateID,
+		DomainID: 1,
+		Name:     "Sales",
+		Peer:     sharedmodel.Peer{Sub: "account-1", Iss: "telegram_app"},
+		UserID:   777,
+		Phone:    "+380501112233",
+		Session:  []byte("session-0"),
+		Enabled:  true,
+	}
+}
+
+// newAccountProvider returns a provider whose loops reconnect the account without delay.
+func newAccountProvider(conn *accountConn, gates *gateSessions) (*telegramAppProvider, *providertest.Messenger, *providertest.Media) {
+	m := &providertest.Messenger{}
+	media := &providertest.Media{}
+	p := newProvider(m, noopLogger, providertest.KnownUsers{}, gates, nil, media, nil, conn)
+	p.loops.minDelay, p.loops.maxDelay = time.Millisecond, 4*time.Millisecond
+	return p, m, media
+}
+
+func waitRuns(t *testing.T, c *accountConn, n int) {
+	t.Helper()
+	for range n {
+		select {
+		case <-c.running:
+		case <-time.After(2 * time.Second):
+			t.Fatalf("client ran %d times, want %d", c.runCount(), n)
+		}
+	}
+}
+
+// -- tests --
+
+func TestUpdateLoopReconnects(t *testing.T) {
+	conn := newAccountConn(errors.New("connection reset"), errors.New("connection reset"))
+	gates := newGateSessions(salesGate())
+	p, _, _ := newAccountProvider(conn, gates)
+
+	if err := p.loops.StartAll(context.Background()); err != nil {
+		t.Fatalf("StartAll: %v", err)
+	}
+	waitRuns(t, conn, 3)
+
+	if _, _, err := p.loops.Client(testGateID); err != nil {
+		t.Fatalf("Client while running: %v", err)
+	}
+	if got := string(gates.session(testGateID)); got != "session-2" {
+		t.Errorf("stored session = %q, want the one saved after the last disconnect", got)
+	}
+
+	if err := p.loops.StopAll(context.Background()); err != nil {
+		t.Fatalf("StopAll: %v", err)
+	}
+	if got := string(gates.session(testGateID)); got != "session-3" {
+		t.Errorf("stored session = %q, want the one saved on stop", got)
+	}
+	if _, _, err := p.loops.Client(testGateID); !errors.Is(err, ErrGateNotRunning) {
+		t.Errorf("Client after stop: err = %v, want ErrGateNotRunning", err)
+	}
+}
+
+func TestUpdateLoopEndsOnRevokedSession(t *testing.T) {
+	conn := newAccountConn(mtproto.ErrUnauthorized)
+	p, _, _ := newAccountProvider(conn, newGateSessions())
+
+	if err := p.loops.Start(salesGate()); err != nil {
+		t.Fatalf("Start: %v", err)
+	}
+	waitRuns(t, conn, 1)
+
+	deadline := time.Now().Add(2 * time.Second)
+	for {
+		if _, _, err := p.loops.Client(testGateID); errors.Is(err, ErrGateNotRunning) {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatal("loop still registered after the session was revoked")
+		}
+		time.Sleep(time.Millisecond)
+	}
+
+	time.Sleep(10 * time.Millisecond)
+	if n := conn.runCount(); n != 1 {
+		t.Errorf("client ran %d times, want no reconnect", n)
+	}
+}
+
+func TestHandleUpdate(t *testing.T) {
+	conn := newAccountConn()
+	conn.media = "JPEG"
+	p, m, media := newAccountProvider(conn, newGateSessions())
+	gate := salesGate()
+	if err := p.loops.Start(gate); err != nil {
+		t.Fatalf("Start: %v", err)
+	}
+	t.Cleanup(func() { p.loops.Stop(testGateID) })
+	waitRuns(t, conn, 1)
+
+	from := mtproto.User{ID: 42, FirstName: "Olena"}
+	ctx := context.Background()
+	updates := []*mtproto.Update{
+		{MessageID: 1, From: from, Text: "hello"},
+		{MessageID: 2, From: from, Outgoing: true, Text: "sent from the phone"},
+		{MessageID: 3, From: from, Text: "look", Media: &mtproto.Media{Kind: mtproto.MediaPhoto, MimeType: "image/jpeg"}},
+		{MessageID: 4, From: from, Media: &mtproto.Media{Kind: mtproto.MediaDocument, FileName: "cv.pdf", MimeType: "application/pdf", Size: 2048}},
+		{MessageID: 5, From: from, Location: &mtproto.Location{Latitude: 50.45, Longitude: 30.52, Title: "Office"}},
+		{MessageID: 6, From: from, Contact: &mtproto.Contact{FirstName: "Ivan", LastName: "Petrenko", Phone: "+380671234567"}},
+	}
+	for _, u := range updates {
+		if err := p.handleUpdate(ctx, gate, u); err != nil {
+			t.Fatalf("handleUpdate %d: %v", u.MessageID, err)
+		}
+	}
+
+	if len(m.Texts()) != 1 || m.Texts()[0].Body != "hello" {
+		t.Fatalf("texts = %+v, want the incoming text only", m.Texts())
+	}
+	if got := m.Texts()[0].From; got.Sub != "42" || got.Iss != "telegram_app" {
+		t.Errorf("from = %+v", got)
+	}
+	if got := m.Texts()[0].To; got.Sub != "account-1" || got.Via == nil || *got.Via != testGateID {
+		t.Errorf("to = %+v", got)
+	}
+
+	if len(m.Images()) != 1 || m.Images()[0].Image.Body != "look" || m.Images()[0].Image.Images[0].ID != "file-1" {
+		t.Errorf("images = %+v", m.Images())
+	}
+	if uploads := media.Uploads(); len(uploads) != 2 || uploads[0].Body != "JPEG" {
+		t.Errorf("uploads = %+v", uploads)
+	}
+	if len(m.Documents()) != 1 {
+		t.Fatalf("documents = %d, want 1", len(m.Documents()))
+	}
+	if doc := m.Documents()[0].Document.Documents[0]; doc.FileName != "cv.pdf" || doc.Size != 2048 {
+		t.Errorf("document = %+v", doc)
+	}
+
+	if len(m.Locations()) != 1 || m.Locations()[0].Name == nil || *m.Locations()[0].Name != "Office" || m.Locations()[0].ExternalID != "5" {
+		t.Errorf("locations = %+v", m.Locations())
+	}
+	if len(m.Contacts()) != 1 || *m.Contacts()[0].Name != "Ivan Petrenko" || *m.Contacts()[0].PhoneNumber != "+380671234567" {
+		t.Errorf("contacts = %+v", m.Contacts())
+	}
+}
+
+func TestSendThroughRunningGate(t *testing.T) {
+	conn := newAccountConn()
+	p, _, _ := newAccountProvider(conn, newGateSessions())
+	req := &sharedmodel.Message{GateID: testGateID, To: sharedmodel.Peer{Sub: "42"}, Text: "hi"}
+
+	if _, err := p.SendText(context.Background(), req); !errors.Is(err, ErrGateNotRunning) {
+		t.Fatalf("SendText before start: err = %v, want ErrGateNotRunning", err)
+	}
+
+	if err := p.loops.Start(salesGate()); err != nil {
+		t.Fatalf("Start: %v", err)
+	}
+	t.Cleanup(func() { p.loops.Stop(testGateID) })
+
+	resp, err := p.SendText(context.Background(), req)
+	if err != nil {
+		t.Fatalf("SendText: %v", err)
+	}
+	if resp.ID != "101" {
+		t.Errorf("response id = %q, want 101", resp.ID)
+	}
+	if len(conn.sent) != 1 || conn.sent[0].UserID != 42 || conn.sent[0].Text != "hi" {
+		t.Errorf("sent = %+v", conn.sent)
+	}
+
+	req.Documents = []*sharedmodel.Document{{URL: "https://files/a.pdf", FileName: "a.pdf"}, {URL: "https://files/b.pdf", FileName: "b.pdf"}}
+	if _, err := p.SendDocument(context.Background(), req); err != nil {
+		t.Fatalf("SendDocument: %v", err)
+	}
+	if len(conn.sent) != 3 || conn.sent[1].Text != "hi" || conn.sent[2].Text != "" || conn.sent[2].MediaKind != mtproto.MediaDocument {
+		t.Errorf("documents sent = %+v %+v", conn.sent[1], conn.sent[2])
+	}
+}
+
+func TestHandleWebhookIsRejected(t *testing.T) {
+	p, _, _ := newAccountProvider(newAccountConn(), newGateSessions())
+	if err := p.HandleWebhook(context.Background(), []byte(`{}`)); !errors.Is(err, errWebhookUnsupported) {
+		t.Errorf("err = %v, want errWebhookUnsupported", err)
+	}
+}
@@ -0,0 +1,242 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/golang-lru/v2/expirable"
+	sharedstore "github.com/webitel/im-providers-service/internal/core/store"
+	tamodel "github.com/webitel/im-providers-service/internal/telegramapp/model"
+	"github.com/webitel/im-providers-service/internal/telegramapp/mtproto"
+	tastore "github.com/webitel/im-providers-service/internal/telegramapp/store"
+)
+
+const (
+	// loginTTL is how long a started login waits for the code or the password.
+	// Telegram login codes expire after a few minutes anyway.
+	loginTTL = 10 * time.Minute
+	// maxPendingLogins bounds the logins kept in memory.
+	maxPendingLogins = 1000
+)
+
+// ErrLoginStep is returned when a login is continued with an input it does not expect.
+var ErrLoginStep = errors.New("telegram_app: unexpected login step")
+
+var _ TelegramAppManager = (*TelegramAppService)(nil)
+
+// TelegramAppManager signs Telegram accounts in as gates and manages them.
+// A login is started with the phone number and continued with the received
+// code and, for accounts with two-step verification, the password.
+type TelegramAppManager interface {
+	StartLogin(ctx context.Context, req tamodel.StartLogin) (*tamodel.LoginState, error)
+	SubmitCode(ctx context.Context, dc int64, loginID, code string) (*tamodel.LoginState, error)
+	SubmitPassword(ctx context.Context, dc int64, loginID, password string) (*tamodel.LoginState, error)
+	GetGate(ctx context.Context, id string) (*tamodel.TelegramAppGate, error)
+	UpdateGate(ctx context.Context, req tamodel.UpdateTelegramApp) (*tamodel.TelegramAppGate, error)
+	DeleteGate(ctx context.Context, id string) (*tamodel.TelegramAppGate, error)
+}
+
+// UpdateLoop runs the connections of the gates.
+// Defined here (exported) so the parent telegramapp package can satisfy it without an import cycle.
+type UpdateLoop interface {
+	Start(gate *tamodel.TelegramAppGate) error
+	Stop(gateID string)
+}
+
+// pendingLogin is a login waiting for the code or the password.
+type pendingLogin struct {
+	mu       sync.Mutex
+	req      tamodel.StartLogin
+	client   mtproto.Client
+	codeHash string
+	step     string
+}
+
+type TelegramAppService struct {
+	repo    tastore.TelegramAppStore
+	factory mtproto.Factory
+	loops   UpdateLoop
+	logins  *expirable.LRU[string, *pendingLogin]
+	log     *slog.Logger
+}
+
+func NewTelegramAppService(repo tastore.TelegramAppStore, factory mtproto.Factory, loops UpdateLoop, log *slog.Logger) *TelegramAppService {
+	return &TelegramAppService{
+		repo:    repo,
+		factory: factory,
+		loops:   loops,
+		logins:  expirable.NewLRU[string, *pendingLogin](maxPendingLogins, nil, loginTTL),
+		log:     log.With("layer", "service", "domain", "telegram_app_gate"),
+	}
+}
+
+// StartLogin requests a login code for the phone. Telegram delivers it to the
+// account's other sessions or by SMS.
+func (s *TelegramAppService) StartLogin(ctx context.Context, req tamodel.StartLogin) (*tamodel.LoginState, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	client, err := s.factory.New(nil)
+	if err != nil {
+		return nil, err
+	}
+	codeHash, err := client.SendCode(ctx, req.Phone)
+	if err != nil {
+		return nil, fmt.Errorf("send login code: %w", err)
+	}
+
+	id := uuid.NewString()
+	s.logins.Add(id, &pendingLogin{req: req, client: client, codeHash: codeHash, step: tamodel.LoginStepCode})
+
+	s.log.Info("telegram_app login started", "login_id", id)
+	return &tamodel.LoginState{ID: id, Phone: req.Phone, Step: tamodel.LoginStepCode}, nil
+}
+
+// SubmitCode continues the login with the received code. A wrong code can be retried.
+func (s *TelegramAppService) SubmitCode(ctx context.Context, dc int64, loginID, code string) (*tamodel.LoginState, error) {
+	login, err := s.login(dc, loginID)
+	if err != nil {
+		return nil, err
+	}
+
+	login.mu.Lock()
+	defer login.mu.Unlock()
+
+	if login.step != tamodel.LoginStepCode {
+		return nil, fmt.Errorf("%w: %s expected", ErrLoginStep, login.step)
+	}
+
+	account, err := login.client.SignIn(ctx, login.req.Phone, login.codeHash, code)
+	if errors.Is(err, mtproto.ErrPasswordNeeded) {
+		login.step = tamodel.LoginStepPassword
+		return &tamodel.LoginState{ID: loginID, Phone: login.req.Phone, Step: login.step}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("sign in: %w", err)
+	}
+	return s.complete(ctx, loginID, login, account)
+}
+
+// SubmitPassword completes the login of an account with two-step verification.
+func (s *TelegramAppService) SubmitPassword(ctx context.Context, dc int64, loginID, password string) (*tamodel.LoginState, error) {
+	login, err := s.login(dc, loginID)
+	if err != nil {
+		return nil, err
+	}
+
+	login.mu.Lock()
+	defer login.mu.Unlock()
+
+	if login.step != tamodel.LoginStepPassword {
+		return nil, fmt.Errorf("%w: %s expected", ErrLoginStep, login.step)
+	}
+
+	account, err := login.client.CheckPassword(ctx, password)
+	if err != nil {
+		return nil, fmt.Errorf("check password: %w", err)
+	}
+	return s.complete(ctx, loginID, login, account)
+}
+
+// login returns the pending login when it was started in the domain: the logins of
+// other domains are not found.
+func (s *TelegramAppService) login(dc int64, id string) (*pendingLogin, error) {
+	login, ok := s.logins.Get(id)
+	if !ok || login.req.Dc != dc {
+		return nil, fmt.Errorf("telegram_app login %s: %w", id, sharedstore.ErrNotFound)
+	}
+	return login, nil
+}
+
+// complete stores the gate with the session of the signed-in account and starts its
+// update loop. A loop that fails to start leaves the gate stored: it is retried on
+// the next start or gate update.
+func (s *TelegramAppService) complete(ctx context.Context, loginID string, login *pendingLogin, account *mtproto.Account) (*tamodel.LoginState, error) {
+	session, err := login.client.Session()
+	if err != nil {
+		return nil, fmt.Errorf("export session: %w", err)
+	}
+
+	phone := account.Phone
+	if phone == "" {
+		phone = login.req.Phone
+	}
+	gate := &tamodel.TelegramAppGate{
+		Name:     login.req.Name,
+		Peer:     login.req.Peer,
+		UserID:   account.UserID,
+		Phone:    phone,
+		Username: account.Username,
+		Session:  session,
+		Enabled:  true,
+	}
+
+	if err := s.repo.Insert(ctx, login.req.Dc, gate); err != nil {
+		s.log.Error("failed to create telegram_app gate", "user_id", account.UserID, "err", err)
+		return nil, err
+	}
+	login.step = tamodel.LoginStepDone
+	s.logins.Remove(loginID)
+
+	if err := s.loops.Start(gate); err != nil {
+		s.log.Error("failed to start telegram_app update loop", "id", gate.ID, "err", err)
+	}
+
+	s.log.Info("telegram_app gate created", "id", gate.ID, "user_id", gate.UserID)
+	return &tamodel.LoginState{ID: loginID, Phone: gate.Phone, Step: tamodel.LoginStepDone, Gate: gate}, nil
+}
+
+func (s *TelegramAppService) GetGate(ctx context.Context, id string) (*tamodel.TelegramAppGate, error) {
+	return s.repo.Select(ctx, id)
+}
+
+// UpdateGate applies the changes and restarts the update loop of an enabled gate,
+// so it picks up the new settings; a disabled gate is disconnected.
+func (s *TelegramAppService) UpdateGate(ctx context.Context, req tamodel.UpdateTelegramApp) (*tamodel.TelegramAppGate, error) {
+	gate, err := s.repo.Select(ctx, req.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	req.ApplyTo(gate)
+	if err := s.repo.Update(ctx, gate); err != nil {
+		s.log.Error("failed to update telegram_app gate", "id", req.ID, "err", err)
+		return nil, err
+	}
+
+	if gate.Enabled {
+		if err := s.loops.Start(gate); err != nil {
+			s.log.Error("failed to start telegram_app update loop", "id", gate.ID, "err", err)
+		}
+	} else {
+		s.loops.Stop(gate.ID)
+	}
+
+	s.log.Info("telegram_app gate updated", "id", gate.ID)
+	return gate, nil
+}
+
+// DeleteGate disconnects and removes the gate. The session stays authorized on the
+// Telegram side until the account terminates it from its device list.
+func (s *TelegramAppService) DeleteGate(ctx context.Context, id string) (*tamodel.TelegramAppGate, error) {
+	gate, err := s.repo.Select(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	s.loops.Stop(id)
+
+	if err := s.repo.Delete(ctx, id); err != nil {
+		s.log.Error("failed to delete telegram_app gate", "id", id, "err", err)
+		return nil, err
+	}
+
+	s.log.Warn("telegram_app gate removed", "id", id, "user_id", gate.UserID)
+	return gate, nil
+}
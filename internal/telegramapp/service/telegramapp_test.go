@@ -0,0 +1,316 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"testing"
+
+	sharedmodel "github.com/webitel/im-providers-service/internal/core/model"
+	sharedstore "github.com/webitel/im-providers-service/internal/core/store"
+	tamodel "github.com/webitel/im-providers-service/internal/telegramapp/model"
+	"github.com/webitel/im-providers-service/internal/telegramapp/mtproto"
+	tastore "github.com/webitel/im-providers-service/internal/telegramapp/store"
+)
+
+var noopLogger = slog.New(slog.NewTextHandler(io.Discard, nil))
+
+// account is a Telegram user account; with a password it has two-step verification enabled.
+type account struct {
+	userID   int64
+	username string
+	code     string
+	password string
+}
+
+// telegram is the login side of Telegram: it hands out a code hash per phone and signs
+// the accounts in with their code and password. Its clients never connect for updates.
+type telegram struct {
+	accounts map[string]*account
+}
+
+func (t *telegram) New(session []byte) (mtproto.Client, error) {
+	if len(session) != 0 {
+		return nil, errors.New("login must start without a session")
+	}
+	return &loginClient{telegram: t}, nil
+}
+
+type loginClient struct {
+	telegram *telegram
+	// pending is the account that passed the code and waits for its password.
+	pending  *account
+	signedIn *account
+}
+
+func (c *loginClient) SendCode(_ context.Context, phone string) (string, error) {
+	if _, ok := c.telegram.accounts[phone]; !ok {
+		return "", errors.New("PHONE_NUMBER_INVALID")
+	}
+	return "hash:" + phone, nil
+}
+
+func (c *loginClient) SignIn(_ context.Context, phone, codeHash, code string) (*mtproto.Account, error) {
+	acc, ok := c.telegram.accounts[phone]
+	if !ok || codeHash != "hash:"+phone || code != acc.code {
+		return nil, mtproto.ErrCodeInvalid
+	}
+	if acc.password != "" {
+		c.pending = acc
+		return nil, mtproto.ErrPasswordNeeded
+	}
+	return c.signIn(acc), nil
+}
+
+func (c *loginClient) CheckPassword(_ context.Context, password string) (*mtproto.Account, error) {
+	if c.pending == nil {
+		return nil, errors.New("PASSWORD_HASH_INVALID")
+	}
+	if password != c.pending.password {
+		return nil, mtproto.ErrPasswordInvalid
+	}
+	return c.signIn(c.pending), nil
+}
+
+func (c *loginClient) signIn(acc *account) *mtproto.Account {
+	c.signedIn = acc
+	return &mtproto.Account{UserID: acc.userID, Username: acc.username}
+}
+
+func (c *loginClient) Session() ([]byte, error) {
+	if c.signedIn == nil {
+		return nil, nil
+	}
+	return fmt.Appendf(nil, "session:%d", c.signedIn.userID), nil
+}
+
+func (c *loginClient) Run(context.Context, func(context.Context, *mtproto.Update) error) error {
+	panic("login client must not connect for updates")
+}
+
+func (c *loginClient) SendMessage(context.Context, *mtproto.OutboundMessage) (int64, error) {
+	panic("login client must not send")
+}
+
+func (c *loginClient) Download(context.Context, *mtproto.Media) (io.ReadCloser, error) {
+	panic("login client must not download")
+}
+
+// gateRows stores the gates under sequential IDs.
+type gateRows map[string]*tamodel.TelegramAppGate
+
+var _ tastore.TelegramAppStore = gateRows(nil)
+
+func (r gateRows) Insert(_ context.Context, dc int64, g *tamodel.TelegramAppGate) error {
+	g.ID = fmt.Sprintf("gate-%d", len(r)+1)
+	g.DomainID = dc
+	cp := *g
+	r[g.ID] = &cp
+	return nil
+}
+
+func (r gateRows) Select(_ context.Context, id string) (*tamodel.TelegramAppGate, error) {
+	g, ok := r[id]
+	if !ok {
+		return nil, sharedstore.ErrNotFound
+	}
+	cp := *g
+	return &cp, nil
+}
+
+func (r gateRows) SelectEnabled(context.Context) ([]*tamodel.TelegramAppGate, error) {
+	return nil, nil
+}
+
+func (r gateRows) Update(_ context.Context, g *tamodel.TelegramAppGate) error {
+	cp := *g
+	r[g.ID] = &cp
+	return nil
+}
+
+func (r gateRows) UpdateSession(context.Context, string, []byte) error { return nil }
+
+func (r gateRows) Delete(_ context.Context, id string) error {
+	delete(r, id)
+	return nil
+}
+
+// connections records which gates were connected and disconnected.
+type connections struct {
+	started []string
+	stopped []string
+}
+
+func (c *connections) Start(g *tamodel.TelegramAppGate) error {
+	c.started = append(c.started, g.ID)
+	return nil
+}
+
+func (c *connections) Stop(id string) { c.stopped = append(c.stopped, id) }
+
+const salesPhone = "+380501112233"
+
+var salesLogin = tamodel.StartLogin{
+	Name:  "Sales",
+	Dc:    1,
+	Phone: salesPhone,
+	Peer:  sharedmodel.Peer{Sub: "account-1", Iss: "telegram_app"},
+}
+
+// newLoginService signs in through a Telegram that knows the sales account.
+func newLoginService(sales *account) (*TelegramAppService, gateRows, *connections) {
+	gates := gateRows{}
+	conns := &connections{}
+	tg := &telegram{accounts: map[string]*account{salesPhone: sales}}
+	return NewTelegramAppService(gates, tg, conns, noopLogger), gates, conns
+}
+
+func TestLogin_WithCode(t *testing.T) {
+	svc, gates, conns := newLoginService(&account{userID: 777, username: "sales", code: "12345"})
+	ctx := context.Background()
+
+	state, err := svc.StartLogin(ctx, salesLogin)
+	if err != nil {
+		t.Fatalf("StartLogin: %v", err)
+	}
+	if state.Step != tamodel.LoginStepCode || state.ID == "" {
+		t.Fatalf("state = %+v, want the code step", state)
+	}
+
+	if _, err := svc.SubmitCode(ctx, 1, state.ID, "00000"); !errors.Is(err, mtproto.ErrCodeInvalid) {
+		t.Fatalf("wrong code: err = %v, want ErrCodeInvalid", err)
+	}
+
+	state, err = svc.SubmitCode(ctx, 1, state.ID, "12345")
+	if err != nil {
+		t.Fatalf("SubmitCode: %v", err)
+	}
+	if state.Step != tamodel.LoginStepDone || state.Gate == nil {
+		t.Fatalf("state = %+v, want done with the gate", state)
+	}
+
+	stored := gates["gate-1"]
+	if stored == nil {
+		t.Fatal("gate not stored")
+	}
+	if stored.UserID != 777 || stored.Username != "sales" || stored.Phone != salesPhone || string(stored.Session) != "session:777" || !stored.Enabled {
+		t.Errorf("stored gate = %+v", stored)
+	}
+	if len(conns.started) != 1 || conns.started[0] != "gate-1" {
+		t.Errorf("connected gates = %v, want gate-1", conns.started)
+	}
+
+	if _, err := svc.SubmitCode(ctx, 1, state.ID, "12345"); !errors.Is(err, sharedstore.ErrNotFound) {
+		t.Errorf("finished login: err = %v, want ErrNotFound", err)
+	}
+}
+
+func TestLogin_TwoStepVerification(t *testing.T) {
+	svc, gates, _ := newLoginService(&account{userID: 777, code: "12345", password: "hunter2"})
+	ctx := context.Background()
+
+	state, err := svc.StartLogin(ctx, salesLogin)
+	if err != nil {
+		t.Fatalf("StartLogin: %v", err)
+	}
+	if _, err := svc.SubmitPassword(ctx, 1, state.ID, "hunter2"); !errors.Is(err, ErrLoginStep) {
+		t.Fatalf("password before code: err = %v, want ErrLoginStep", err)
+	}
+
+	state, err = svc.SubmitCode(ctx, 1, state.ID, "12345")
+	if err != nil {
+		t.Fatalf("SubmitCode: %v", err)
+	}
+	if state.Step != tamodel.LoginStepPassword {
+		t.Fatalf("step = %q, want password", state.Step)
+	}
+	if len(gates) != 0 {
+		t.Fatal("gate stored before the password was checked")
+	}
+
+	if _, err := svc.SubmitPassword(ctx, 1, state.ID, "wrong"); !errors.Is(err, mtproto.ErrPasswordInvalid) {
+		t.Fatalf("wrong password: err = %v, want ErrPasswordInvalid", err)
+	}
+	state, err = svc.SubmitPassword(ctx, 1, state.ID, "hunter2")
+	if err != nil {
+		t.Fatalf("SubmitPassword: %v", err)
+	}
+	if state.Step != tamodel.LoginStepDone || string(state.Gate.Session) != "session:777" {
+		t.Errorf("state = %+v", state)
+	}
+}
+
+func TestLogin_OtherDomainIsNotFound(t *testing.T) {
+	svc, gates, _ := newLoginService(&account{userID: 777, code: "12345", password: "hunter2"})
+	ctx := context.Background()
+
+	state, err := svc.StartLogin(ctx, salesLogin)
+	if err != nil {
+		t.Fatalf("StartLogin: %v", err)
+	}
+	if _, err := svc.SubmitCode(ctx, 2, state.ID, "12345"); !errors.Is(err, sharedstore.ErrNotFound) {
+		t.Fatalf("code from another domain: err = %v, want ErrNotFound", err)
+	}
+
+	if _, err := svc.SubmitCode(ctx, 1, state.ID, "12345"); err != nil {
+		t.Fatalf("SubmitCode: %v", err)
+	}
+	if _, err := svc.SubmitPassword(ctx, 2, state.ID, "hunter2"); !errors.Is(err, sharedstore.ErrNotFound) {
+		t.Errorf("password from another domain: err = %v, want ErrNotFound", err)
+	}
+	if len(gates) != 0 {
+		t.Errorf("gates = %v, want none", gates)
+	}
+}
+
+func TestStartLogin_Validates(t *testing.T) {
+	svc, _, _ := newLoginService(&account{})
+
+	_, err := svc.StartLogin(context.Background(), tamodel.StartLogin{Name: "Sales"})
+	var vErr *sharedmodel.ValidationError
+	if !errors.As(err, &vErr) {
+		t.Fatalf("err = %v, want ValidationError", err)
+	}
+	if len(vErr.Fields) != 2 {
+		t.Errorf("missing fields = %v, want domain_id and phone", vErr.Fields)
+	}
+}
+
+func TestStartLogin_WithoutApplication(t *testing.T) {
+	svc := NewTelegramAppService(gateRows{}, mtproto.NewUnavailableFactory(), &connections{}, noopLogger)
+
+	if _, err := svc.StartLogin(context.Background(), salesLogin); !errors.Is(err, mtproto.ErrUnavailable) {
+		t.Errorf("err = %v, want ErrUnavailable", err)
+	}
+}
+
+func TestUpdateGate_TogglesConnection(t *testing.T) {
+	svc, gates, conns := newLoginService(&account{})
+	gates["gate-1"] = &tamodel.TelegramAppGate{ID: "gate-1", Name: "Sales", Enabled: true}
+	ctx := context.Background()
+
+	disabled := false
+	if _, err := svc.UpdateGate(ctx, tamodel.UpdateTelegramApp{ID: "gate-1", Enabled: &disabled}); err != nil {
+		t.Fatalf("UpdateGate: %v", err)
+	}
+	if len(conns.stopped) != 1 || len(conns.started) != 0 {
+		t.Errorf("disable: started %v, stopped %v", conns.started, conns.stopped)
+	}
+
+	enabled := true
+	if _, err := svc.UpdateGate(ctx, tamodel.UpdateTelegramApp{ID: "gate-1", Enabled: &enabled}); err != nil {
+		t.Fatalf("UpdateGate: %v", err)
+	}
+	if len(conns.started) != 1 {
+		t.Errorf("enable: started %v", conns.started)
+	}
+
+	if _, err := svc.DeleteGate(ctx, "gate-1"); err != nil {
+		t.Fatalf("DeleteGate: %v", err)
+	}
+	if len(conns.stopped) != 2 || gates["gate-1"] != nil {
+		t.Errorf("delete: stopped %v, gates %v", conns.stopped, gates)
+	}
+}
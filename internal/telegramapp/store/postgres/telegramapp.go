@@ -0,0 +1,208 @@
+package postgres
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+
+	"github.com/georgysavva/scany/v2/pgxscan"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/webitel/im-providers-service/infra/db/pg"
+	sharedmodel "github.com/webitel/im-providers-service/internal/core/model"
+	sharedstore "github.com/webitel/im-providers-service/internal/core/store"
+	tamodel "github.com/webitel/im-providers-service/internal/telegramapp/model"
+	tastore "github.com/webitel/im-providers-service/internal/telegramapp/store"
+	"github.com/webitel/im-providers-service/pkg/crypto"
+)
+
+var _ tastore.TelegramAppStore = (*telegramAppStore)(nil)
+
+type telegramAppStore struct {
+	pool   *pgxpool.Pool
+	crypto crypto.Encryptor
+}
+
+func NewTelegramAppStore(pool *pgxpool.Pool, crypt crypto.Encryptor) tastore.TelegramAppStore {
+	return &telegramAppStore{
+		pool:   pool,
+		crypto: crypt,
+	}
+}
+
+// gateRecord is a gate row; the session column holds the encrypted base64 session.
+type gateRecord struct {
+	tamodel.TelegramAppGate
+	Session string `db:"session"`
+}
+
+const selectGates = `
+	SELECT
+		g.id,
+		g.dc AS domain_id,
+		g.name,
+		g.enabled,
+		g.created_at,
+		g.updated_at,
+		b.sub AS "peer.sub",
+		b.iss AS "peer.iss",
+		ta.user_id,
+		ta.phone,
+		ta.username,
+		ta.session
+	FROM im_provider.gates g
+	JOIN im_provider.bots b ON g.id = b.gate_id
+	JOIN im_provider.telegram_app ta ON g.id = ta.gate_id`
+
+func (s *telegramAppStore) Insert(ctx context.Context, dc int64, g *tamodel.TelegramAppGate) error {
+	session, err := s.encryptSession(g.Session)
+	if err != nil {
+		return err
+	}
+
+	const query = `
+	WITH new_gate AS (
+		INSERT INTO im_provider.gates (dc, name, type, enabled)
+		VALUES ($1, $2, 'telegram_app', $3)
+		RETURNING id, name, created_at, updated_at
+	),
+	new_bot AS (
+		INSERT INTO im_provider.bots (sub, iss, gate_id)
+		SELECT $4, $5, id FROM new_gate
+		RETURNING id
+	)
+	INSERT INTO im_provider.telegram_app (gate_id, user_id, phone, username, session)
+	SELECT id, $6, $7, $8, $9 FROM new_gate
+	RETURNING
+		gate_id,
+		(SELECT name FROM new_gate),
+		(SELECT created_at FROM new_gate),
+		(SELECT updated_at FROM new_gate)`
+
+	err = s.pool.QueryRow(ctx, query,
+		dc, g.Name, g.Enabled, g.Peer.Sub, g.Peer.Iss, g.UserID, g.Phone, g.Username, session,
+	).Scan(&g.ID, &g.Name, &g.CreatedAt, &g.UpdatedAt)
+	if err != nil {
+		if pg.IsUniqueViolation(err) {
+			return fmt.Errorf("telegram account %d is already bound: %w", g.UserID, sharedstore.ErrConflict)
+		}
+		return fmt.Errorf("postgres: insert telegram_app gateway: %w", err)
+	}
+
+	g.DomainID = dc
+	mapVirtualFields(g)
+	return nil
+}
+
+func (s *telegramAppStore) Select(ctx context.Context, id string) (*tamodel.TelegramAppGate, error) {
+	var rec gateRecord
+	if err := pgxscan.Get(ctx, s.pool, &rec, selectGates+` WHERE g.id = $1`, id); err != nil {
+		if pgxscan.NotFound(err) {
+			return nil, sharedstore.ErrNotFound
+		}
+		return nil, fmt.Errorf("postgres: select telegram_app gate: %w", err)
+	}
+	return s.toGate(&rec)
+}
+
+func (s *telegramAppStore) SelectEnabled(ctx context.Context) ([]*tamodel.TelegramAppGate, error) {
+	var recs []*gateRecord
+	if err := pgxscan.Select(ctx, s.pool, &recs, selectGates+` WHERE g.enabled ORDER BY g.created_at`); err != nil {
+		return nil, fmt.Errorf("postgres: select enabled telegram_app gates: %w", err)
+	}
+
+	gates := make([]*tamodel.TelegramAppGate, 0, len(recs))
+	for _, rec := range recs {
+		g, err := s.toGate(rec)
+		if err != nil {
+			return nil, err
+		}
+		gates = append(gates, g)
+	}
+	return gates, nil
+}
+
+func (s *telegramAppStore) Update(ctx context.Context, g *tamodel.TelegramAppGate) error {
+	err := pgx.BeginFunc(ctx, s.pool, func(tx pgx.Tx) error {
+		const uGate = `UPDATE im_provider.gates SET name = $1, enabled = $2, updated_at = NOW() WHERE id = $3 RETURNING updated_at`
+		if err := tx.QueryRow(ctx, uGate, g.Name, g.Enabled, g.ID).Scan(&g.UpdatedAt); err != nil {
+			return err
+		}
+
+		const uBot = `UPDATE im_provider.bots SET sub = $1, iss = $2 WHERE gate_id = $3`
+		_, err := tx.Exec(ctx, uBot, g.Peer.Sub, g.Peer.Iss, g.ID)
+		return err
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return sharedstore.ErrNotFound
+		}
+		return fmt.Errorf("postgres: update telegram_app gate: %w", err)
+	}
+
+	mapVirtualFields(g)
+	return nil
+}
+
+func (s *telegramAppStore) UpdateSession(ctx context.Context, id string, session []byte) error {
+	enc, err := s.encryptSession(session)
+	if err != nil {
+		return err
+	}
+
+	res, err := s.pool.Exec(ctx, `UPDATE im_provider.telegram_app SET session = $1 WHERE gate_id = $2`, enc, id)
+	if err != nil {
+		return fmt.Errorf("postgres: update telegram_app session: %w", err)
+	}
+	if res.RowsAffected() == 0 {
+		return sharedstore.ErrNotFound
+	}
+	return nil
+}
+
+func (s *telegramAppStore) Delete(ctx context.Context, id string) error {
+	res, err := s.pool.Exec(ctx, "DELETE FROM im_provider.gates WHERE id = $1 AND type = 'telegram_app'", id)
+	if err != nil {
+		return fmt.Errorf("postgres: delete telegram_app gate: %w", err)
+	}
+	if res.RowsAffected() == 0 {
+		return sharedstore.ErrNotFound
+	}
+	return nil
+}
+
+func (s *telegramAppStore) toGate(rec *gateRecord) (*tamodel.TelegramAppGate, error) {
+	g := rec.TelegramAppGate
+	session, err := s.decryptSession(rec.Session)
+	if err != nil {
+		return nil, fmt.Errorf("telegram_app gate %s: %w", g.ID, err)
+	}
+	g.Session = session
+	mapVirtualFields(&g)
+	return &g, nil
+}
+
+func (s *telegramAppStore) encryptSession(session []byte) (string, error) {
+	enc, err := s.crypto.Encrypt(base64.StdEncoding.EncodeToString(session))
+	if err != nil {
+		return "", fmt.Errorf("crypto: %w", err)
+	}
+	return enc, nil
+}
+
+func (s *telegramAppStore) decryptSession(enc string) ([]byte, error) {
+	dec, err := s.crypto.Decrypt(enc)
+	if err != nil {
+		return nil, fmt.Errorf("crypto: %w", err)
+	}
+	return base64.StdEncoding.DecodeString(dec)
+}
+
+func mapVirtualFields(g *tamodel.TelegramAppGate) {
+	if g.Enabled {
+		g.Status = sharedmodel.StatusActive
+	} else {
+		g.Status = sharedmodel.StatusDisabled
+	}
+}
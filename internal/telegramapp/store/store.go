@@ -0,0 +1,20 @@
+package store
+
+import (
+	"context"
+
+	tamodel "github.com/webitel/im-providers-service/internal/telegramapp/model"
+)
+
+// TelegramAppStore manages Telegram user-account gates. Sessions are stored encrypted.
+type TelegramAppStore interface {
+	// Insert creates the gate together with its bot peer and account session.
+	Insert(ctx context.Context, dc int64, g *tamodel.TelegramAppGate) error
+	Select(ctx context.Context, id string) (*tamodel.TelegramAppGate, error)
+	// SelectEnabled returns every enabled gate, whose update loops run on start.
+	SelectEnabled(ctx context.Context) ([]*tamodel.TelegramAppGate, error)
+	Update(ctx context.Context, g *tamodel.TelegramAppGate) error
+	// UpdateSession stores the session the client renewed while running.
+	UpdateSession(ctx context.Context, id string, session []byte) error
+	Delete(ctx context.Context, id string) error
+}
@@ -0,0 +1,155 @@
+package telegramapp
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	sharedmodel "github.com/webitel/im-providers-service/internal/core/model"
+	"github.com/webitel/im-providers-service/internal/provider/contactsync"
+	tamodel "github.com/webitel/im-providers-service/internal/telegramapp/model"
+	"github.com/webitel/im-providers-service/internal/telegramapp/mtproto"
+)
+
+// handleUpdate forwards a message of a private chat to the messenger. Messages the
+// account sent itself from another device are skipped, they are not customer input.
+func (p *telegramAppProvider) handleUpdate(ctx context.Context, gate *tamodel.TelegramAppGate, u *mtproto.Update) error {
+	if u.Outgoing || u.From.ID == 0 {
+		return nil
+	}
+
+	if _, err := p.syncContact(ctx, gate, &u.From); err != nil {
+		return fmt.Errorf("sync contact: %w", err)
+	}
+
+	peers := contactsync.InboundPeers(gate.ID, gate.Peer, strconv.FormatInt(u.From.ID, 10))
+	externalID := strconv.FormatInt(u.MessageID, 10)
+
+	switch {
+	case u.Media != nil:
+		return p.sendMedia(ctx, gate, peers, u)
+	case u.Location != nil:
+		return p.sendLocation(ctx, gate, peers, u.Location, externalID)
+	case u.Contact != nil:
+		return p.sendContact(ctx, gate, peers, u.Contact, externalID)
+	case u.Text != "":
+		_, err := p.messenger.SendText(ctx, &sharedmodel.SendTextRequest{
+			DomainID: gate.DomainID,
+			From:     peers.From,
+			To:       peers.To,
+			Body:     u.Text,
+		})
+		return err
+	}
+	return nil
+}
+
+// sendMedia copies the media to the storage and forwards it with the message text as
+// the caption. Photos become images, everything else documents.
+func (p *telegramAppProvider) sendMedia(ctx context.Context, gate *tamodel.TelegramAppGate, peers contactsync.Peers, u *mtproto.Update) error {
+	client, _, err := p.loops.Client(gate.ID)
+	if err != nil {
+		return err
+	}
+
+	body, err := client.Download(ctx, u.Media)
+	if err != nil {
+		return fmt.Errorf("download media: %w", err)
+	}
+	defer body.Close()
+
+	name := mediaFileName(u.Media)
+	uploaded, err := p.media.UploadFile(ctx, sharedmodel.UploadRequest{
+		DomainID: gate.DomainID,
+		Name:     name,
+		MimeType: u.Media.MimeType,
+	}, body)
+	if err != nil {
+		return fmt.Errorf("upload media: %w", err)
+	}
+
+	if u.Media.Kind == mtproto.MediaPhoto {
+		_, err = p.messenger.SendImage(ctx, &sharedmodel.SendImageRequest{
+			DomainID: gate.DomainID,
+			From:     peers.From,
+			To:       peers.To,
+			Image: sharedmodel.ImageRequest{
+				Body: u.Text,
+				Images: []*sharedmodel.Image{{
+					ID:       uploaded.ID,
+					FileName: name,
+					MimeType: u.Media.MimeType,
+				}},
+			},
+		})
+		return err
+	}
+
+	size := u.Media.Size
+	if size <= 0 {
+		size = 1
+	}
+	_, err = p.messenger.SendDocument(ctx, &sharedmodel.SendDocumentRequest{
+		DomainID: gate.DomainID,
+		From:     peers.From,
+		To:       peers.To,
+		Document: sharedmodel.DocumentRequest{
+			Body: u.Text,
+			Documents: []*sharedmodel.Document{{
+				ID:       uploaded.ID,
+				FileName: name,
+				MimeType: u.Media.MimeType,
+				Size:     size,
+			}},
+		},
+	})
+	return err
+}
+
+func (p *telegramAppProvider) sendLocation(ctx context.Context, gate *tamodel.TelegramAppGate, peers contactsync.Peers, loc *mtproto.Location, externalID string) error {
+	req := &sharedmodel.SendLocationRequest{
+		DomainID:   int(gate.DomainID),
+		From:       peers.From,
+		To:         peers.To,
+		Latitude:   loc.Latitude,
+		Longitude:  loc.Longitude,
+		ExternalID: externalID,
+	}
+	if loc.Title != "" {
+		req.Name = &loc.Title
+	}
+	if loc.Address != "" {
+		req.Address = &loc.Address
+	}
+	_, err := p.messenger.SendLocation(ctx, req)
+	return err
+}
+
+func (p *telegramAppProvider) sendContact(ctx context.Context, gate *tamodel.TelegramAppGate, peers contactsync.Peers, c *mtproto.Contact, externalID string) error {
+	req := &sharedmodel.SendContactRequest{
+		DomainID:   int(gate.DomainID),
+		From:       peers.From,
+		To:         peers.To,
+		ExternalID: externalID,
+	}
+	if name := fullName(c.FirstName, c.LastName); name != "" {
+		req.Name = &name
+	}
+	if c.Phone != "" {
+		req.PhoneNumber = &c.Phone
+	}
+	_, err := p.messenger.SendContact(ctx, req)
+	return err
+}
+
+func mediaFileName(m *mtproto.Media) string {
+	if m.FileName != "" {
+		return m.FileName
+	}
+	ext := ".bin"
+	if m.Kind == mtproto.MediaPhoto {
+		ext = ".jpg"
+	}
+	return fmt.Sprintf("telegram_%s_%d%s", m.Kind, time.Now().Unix(), ext)
+}
@@ -0,0 +1,81 @@
+package telegramapp
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	gatewayv1 "github.com/webitel/im-providers-service/gen/go/gateway/v1"
+	sharedmodel "github.com/webitel/im-providers-service/internal/core/model"
+	"github.com/webitel/im-providers-service/internal/provider/contactsync"
+	tamodel "github.com/webitel/im-providers-service/internal/telegramapp/model"
+	"github.com/webitel/im-providers-service/internal/telegramapp/mtproto"
+)
+
+// Contact metadata keys filled from the Telegram user details.
+const (
+	metadataUsername = "username"
+	metadataPhone    = "phone"
+)
+
+// syncContact resolves the internal contact for a Telegram user, creating it
+// if necessary. The result is cached so repeated messages from the same
+// user skip the gateway round-trip.
+func (p *telegramAppProvider) syncContact(ctx context.Context, gate *tamodel.TelegramAppGate, u *mtproto.User) (*gatewayv1.Contact, error) {
+	external := toExternalUser(u)
+	key := contactsync.KnownUser(gate.ID, external)
+
+	if known, _ := p.userCache.IsKnown(ctx, key); known {
+		return &gatewayv1.Contact{Sub: external.ID}, nil
+	}
+
+	authCtx := contactsync.WithGatewayIdentity(ctx, gate.DomainID, gate.Peer)
+
+	contact, err := p.ensureContact(authCtx, external, u)
+	if err != nil {
+		return nil, err
+	}
+
+	contactsync.EnsureVia(authCtx, p.gatewayer, p.logger, gate.ID, external.ID, contact.Iss)
+	_ = p.userCache.MarkKnown(ctx, key)
+
+	return contact, nil
+}
+
+// ensureContact creates the internal contact or returns a stub when the
+// contact already exists (idempotent by design on the gateway side).
+func (p *telegramAppProvider) ensureContact(ctx context.Context, external *sharedmodel.ExternalUser, u *mtproto.User) (*gatewayv1.Contact, error) {
+	metadata := make(map[string]string, 2)
+	if u.Username != "" {
+		metadata[metadataUsername] = u.Username
+	}
+	if u.Phone != "" {
+		metadata[metadataPhone] = u.Phone
+	}
+
+	return contactsync.CreateContact(ctx, p.gatewayer, &gatewayv1.CreateContactRequest{
+		IssId:    p.Type(),
+		Type:     p.Type(),
+		Name:     fullName(external.FirstName, external.LastName),
+		Subject:  external.ID,
+		Metadata: metadata,
+	})
+}
+
+// toExternalUser maps a Telegram user to the domain cache key. Users without a
+// name fall back to the username, then to the user ID.
+func toExternalUser(u *mtproto.User) *sharedmodel.ExternalUser {
+	id := strconv.FormatInt(u.ID, 10)
+	first := u.FirstName
+	if first == "" && u.LastName == "" {
+		first = u.Username
+	}
+	if first == "" && u.LastName == "" {
+		first = id
+	}
+	return &sharedmodel.ExternalUser{ID: id, FirstName: first, LastName: u.LastName}
+}
+
+func fullName(first, last string) string {
+	return strings.TrimSpace(first + " " + last)
+}
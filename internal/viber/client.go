@@ -0,0 +1,138 @@
+package viber
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"time"
+
+	sharedmodel "github.com/webitel/im-providers-service/internal/core/model"
+	vbmodel "github.com/webitel/im-providers-service/internal/viber/model"
+)
+
+// APIBaseURL is the Viber REST Bot API endpoint.
+// https://developers.viber.com/docs/api/rest-bot-api/
+const APIBaseURL = "https://chatapi.viber.com/pa"
+
+// authTokenHeader authorizes every Bot API request.
+const authTokenHeader = "X-Viber-Auth-Token"
+
+// webhookEventTypes are the optional callbacks subscribed to on set_webhook.
+// message, subscribed and unsubscribed are always delivered.
+var webhookEventTypes = []string{
+	EventDelivered,
+	EventSeen,
+	EventFailed,
+	EventConversationStarted,
+}
+
+// botAPI is the contract used by viberProvider and the gate service to talk to the Bot API.
+// Keeping it as an interface allows both to be tested without network calls.
+type botAPI interface {
+	GetAccountInfo(ctx context.Context, token string) (*vbmodel.Account, error)
+	SetWebhook(ctx context.Context, token, url string) error
+	RemoveWebhook(ctx context.Context, token string) error
+	SendMessage(ctx context.Context, token string, msg *outboundMessage) (*sharedmodel.MessageResponse, error)
+}
+
+type apiClient struct {
+	client *http.Client
+	logger *slog.Logger
+	apiURL string
+}
+
+var _ botAPI = (*apiClient)(nil)
+
+func newAPIClient(l *slog.Logger) *apiClient {
+	return &apiClient{
+		client: &http.Client{Timeout: 15 * time.Second},
+		logger: l.With("component", "viber.api"),
+		apiURL: APIBaseURL,
+	}
+}
+
+// GetAccountInfo returns the bot account the token belongs to.
+// https://developers.viber.com/docs/api/rest-bot-api/#get-account-info
+func (c *apiClient) GetAccountInfo(ctx context.Context, token string) (*vbmodel.Account, error) {
+	var resp accountInfoResponse
+	if err := c.call(ctx, token, "get_account_info", struct{}{}, &resp); err != nil {
+		return nil, err
+	}
+	return &vbmodel.Account{ID: resp.ID, Name: resp.Name, URI: resp.URI, Webhook: resp.Webhook}, nil
+}
+
+// SetWebhook points the bot callbacks to url. Viber checks the URL synchronously
+// with a "webhook" callback, so the gate must already be resolvable by then.
+// https://developers.viber.com/docs/api/rest-bot-api/#setting-a-webhook
+func (c *apiClient) SetWebhook(ctx context.Context, token, url string) error {
+	req := setWebhookRequest{
+		URL:        url,
+		EventTypes: webhookEventTypes,
+		SendName:   true,
+		SendPhoto:  true,
+	}
+	return c.call(ctx, token, "set_webhook", req, &apiResponse{})
+}
+
+// RemoveWebhook stops the callbacks of the bot by setting an empty webhook URL.
+// https://developers.viber.com/docs/api/rest-bot-api/#removing-your-webhook
+func (c *apiClient) RemoveWebhook(ctx context.Context, token string) error {
+	return c.call(ctx, token, "set_webhook", setWebhookRequest{URL: ""}, &apiResponse{})
+}
+
+// SendMessage delivers a single message to a subscribed user.
+// https://developers.viber.com/docs/api/rest-bot-api/#send-message
+func (c *apiClient) SendMessage(ctx context.Context, token string, msg *outboundMessage) (*sharedmodel.MessageResponse, error) {
+	var resp sendMessageResponse
+	if err := c.call(ctx, token, "send_message", msg, &resp); err != nil {
+		return nil, err
+	}
+	return &sharedmodel.MessageResponse{
+		ID: strconv.FormatInt(resp.MessageToken, 10),
+		MD: map[string]any{"chat_hostname": resp.ChatHostname},
+	}, nil
+}
+
+// call posts body to the Bot API method and decodes the reply into out.
+// Viber answers application errors with HTTP 200 and a non-zero status.
+func (c *apiClient) call(ctx context.Context, token, method string, body any, out statusCarrier) error {
+	payload, err := json.Marshal(body)
+	if err != nil {
+		return fmt.Errorf("viber %s: marshal: %w", method, err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.apiURL+"/"+method, bytes.NewReader(payload))
+	if err != nil {
+		return fmt.Errorf("viber %s: %w", method, err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(authTokenHeader, token)
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("viber %s: %w", method, err)
+	}
+	defer resp.Body.Close()
+
+	raw, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("viber %s: read response: %w", method, err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("viber %s: status %s", method, resp.Status)
+	}
+
+	if err := json.Unmarshal(raw, out); err != nil {
+		return fmt.Errorf("viber %s: decode response: %w", method, err)
+	}
+	if apiErr := out.apiError(); apiErr != nil {
+		c.logger.WarnContext(ctx, "bot api request rejected", "method", method, "status", apiErr.Status, "message", apiErr.Message)
+		return fmt.Errorf("viber %s: %w", method, apiErr)
+	}
+	return nil
+}
@@ -0,0 +1,127 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	impb "github.com/webitel/im-providers-service/gen/go/provider/v1"
+	"github.com/webitel/im-providers-service/internal/provider/gaterpc"
+	vbmodel "github.com/webitel/im-providers-service/internal/viber/model"
+	vbservice "github.com/webitel/im-providers-service/internal/viber/service"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type ViberHandler struct {
+	logger *slog.Logger
+	srv    vbservice.ViberManager
+	impb.UnimplementedViberServiceServer
+}
+
+func NewViberHandler(logger *slog.Logger, srv vbservice.ViberManager) *ViberHandler {
+	return &ViberHandler{logger: logger, srv: srv}
+}
+
+func (h *ViberHandler) CreateViberGate(ctx context.Context, req *impb.ProviderCreateViberGateRequest) (*impb.ProviderCreateViberGateResponse, error) {
+	domainID, err := gaterpc.DomainID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	create := vbmodel.CreateViber{
+		Name:     req.GetName(),
+		Dc:       domainID,
+		BotToken: req.GetBotToken(),
+	}
+	if peer := gaterpc.Peer(req.GetPeer()); peer != nil {
+		create.Peer = *peer
+	}
+
+	gate, err := h.srv.CreateGate(ctx, create)
+	if err != nil {
+		return nil, toStatus(err, "create gate")
+	}
+
+	return &impb.ProviderCreateViberGateResponse{Item: gateToProto(gate)}, nil
+}
+
+func (h *ViberHandler) GetViberGate(ctx context.Context, req *impb.ProviderGetViberGateRequest) (*impb.ProviderGetViberGateResponse, error) {
+	gate, err := h.gate(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	return &impb.ProviderGetViberGateResponse{Item: gateToProto(gate)}, nil
+}
+
+func (h *ViberHandler) UpdateViberGate(ctx context.Context, req *impb.ProviderUpdateViberGateRequest) (*impb.ProviderUpdateViberGateResponse, error) {
+	if _, err := h.gate(ctx, req.GetId()); err != nil {
+		return nil, err
+	}
+
+	gate, err := h.srv.UpdateGate(ctx, vbmodel.UpdateViber{
+		ID:       req.GetId(),
+		Name:     req.Name,
+		BotToken: req.BotToken,
+		Enabled:  req.Enabled,
+		Peer:     gaterpc.Peer(req.GetPeer()),
+	})
+	if err != nil {
+		return nil, toStatus(err, "update gate")
+	}
+
+	return &impb.ProviderUpdateViberGateResponse{Item: gateToProto(gate)}, nil
+}
+
+func (h *ViberHandler) DeleteViberGate(ctx context.Context, req *impb.ProviderDeleteViberGateRequest) (*impb.ProviderDeleteViberGateResponse, error) {
+	if _, err := h.gate(ctx, req.GetId()); err != nil {
+		return nil, err
+	}
+
+	gate, err := h.srv.DeleteGate(ctx, req.GetId())
+	if err != nil {
+		return nil, toStatus(err, "delete gate")
+	}
+
+	return &impb.ProviderDeleteViberGateResponse{Item: gateToProto(gate)}, nil
+}
+
+// gate returns the gate when it belongs to the domain of the caller.
+func (h *ViberHandler) gate(ctx context.Context, id string) (*vbmodel.ViberGate, error) {
+	gate, err := h.srv.GetGate(ctx, id)
+	if err != nil {
+		return nil, toStatus(err, "get gate")
+	}
+	if err := gaterpc.CheckDomain(ctx, gate.DomainID); err != nil {
+		return nil, err
+	}
+	return gate, nil
+}
+
+// toStatus reports a missing public URL as a precondition: the webhook of the bot
+// cannot be set until the service is reachable from Viber.
+func toStatus(err error, internalMsg string) error {
+	if errors.Is(err, vbservice.ErrPublicURLNotSet) {
+		return status.Error(codes.FailedPrecondition, err.Error())
+	}
+	return gaterpc.ToStatus(err, internalMsg)
+}
+
+func gateToProto(g *vbmodel.ViberGate) *impb.ProviderViberGate {
+	if g == nil {
+		return nil
+	}
+	return &impb.ProviderViberGate{
+		Id:         g.ID,
+		Name:       g.Name,
+		Peer:       gaterpc.ToProtoPeer(g.Peer),
+		BotId:      g.BotID,
+		BotUri:     g.BotURI,
+		WebhookUrl: g.WebhookURL,
+		Status:     impb.ProviderStatus(g.Status),
+		CreatedAt:  g.CreatedAt.UnixMilli(),
+		UpdatedAt:  g.UpdatedAt.UnixMilli(),
+		Enabled:    g.Enabled,
+	}
+}
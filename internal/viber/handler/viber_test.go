@@ -0,0 +1,162 @@
+package handler
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"testing"
+
+	impb "github.com/webitel/im-providers-service/gen/go/provider/v1"
+	"github.com/webitel/im-providers-service/infra/auth"
+	sharedmodel "github.com/webitel/im-providers-service/internal/core/model"
+	sharedstore "github.com/webitel/im-providers-service/internal/core/store"
+	vbmodel "github.com/webitel/im-providers-service/internal/viber/model"
+	vbservice "github.com/webitel/im-providers-service/internal/viber/service"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type identity struct{ domainID int64 }
+
+func (i identity) GetContactID() string { return "" }
+func (i identity) GetDomainID() int64   { return i.domainID }
+func (i identity) GetName() string      { return "" }
+
+func domainContext(domainID int64) context.Context {
+	return context.WithValue(context.Background(), auth.AuthContextKey, identity{domainID: domainID})
+}
+
+// gateManager keeps the gates of every domain and records the requests it is given.
+type gateManager struct {
+	gates   map[string]*vbmodel.ViberGate
+	created *vbmodel.CreateViber
+	updated *vbmodel.UpdateViber
+	deleted []string
+	err     error
+}
+
+var _ vbservice.ViberManager = (*gateManager)(nil)
+
+func (m *gateManager) CreateGate(_ context.Context, req vbmodel.CreateViber) (*vbmodel.ViberGate, error) {
+	if m.err != nil {
+		return nil, m.err
+	}
+	m.created = &req
+	return &vbmodel.ViberGate{
+		ID:         "gate-1",
+		DomainID:   req.Dc,
+		Name:       req.Name,
+		Peer:       req.Peer,
+		BotID:      "pa:1",
+		BotURI:     "support",
+		WebhookURL: "https://im.example.com/wh/viber/gate-1",
+		Status:     sharedmodel.StatusActive,
+		Enabled:    true,
+	}, nil
+}
+
+func (m *gateManager) GetGate(_ context.Context, id string) (*vbmodel.ViberGate, error) {
+	g, ok := m.gates[id]
+	if !ok {
+		return nil, sharedstore.ErrNotFound
+	}
+	return g, nil
+}
+
+func (m *gateManager) UpdateGate(_ context.Context, req vbmodel.UpdateViber) (*vbmodel.ViberGate, error) {
+	m.updated = &req
+	g := *m.gates[req.ID]
+	req.ApplyTo(&g)
+	return &g, nil
+}
+
+func (m *gateManager) DeleteGate(_ context.Context, id string) (*vbmodel.ViberGate, error) {
+	m.deleted = append(m.deleted, id)
+	return m.gates[id], nil
+}
+
+func newHandler(m *gateManager) *ViberHandler {
+	return NewViberHandler(slog.New(slog.NewTextHandler(io.Discard, nil)), m)
+}
+
+func TestCreateViberGate(t *testing.T) {
+	m := &gateManager{}
+	resp, err := newHandler(m).CreateViberGate(domainContext(7), &impb.ProviderCreateViberGateRequest{
+		Name:     "Support",
+		BotToken: "token-1",
+		Peer:     &impb.Peer{Sub: "bot-1", Iss: "viber"},
+	})
+	if err != nil {
+		t.Fatalf("CreateViberGate: %v", err)
+	}
+
+	want := vbmodel.CreateViber{Name: "Support", Dc: 7, BotToken: "token-1", Peer: sharedmodel.Peer{Sub: "bot-1", Iss: "viber"}}
+	if m.created == nil || *m.created != want {
+		t.Errorf("create request = %+v, want %+v", m.created, want)
+	}
+	item := resp.GetItem()
+	if item.GetWebhookUrl() != "https://im.example.com/wh/viber/gate-1" || item.GetBotUri() != "support" || item.GetStatus() != impb.ProviderStatus_PROVIDER_STATUS_ACTIVE {
+		t.Errorf("unexpected gate: %+v", item)
+	}
+}
+
+func TestCreateViberGate_Errors(t *testing.T) {
+	tests := []struct {
+		name string
+		ctx  context.Context
+		err  error
+		want codes.Code
+	}{
+		{name: "no identity", ctx: context.Background(), want: codes.Unauthenticated},
+		{name: "missing fields", ctx: domainContext(7), err: &sharedmodel.ValidationError{Fields: []string{"bot_token"}}, want: codes.InvalidArgument},
+		{name: "public url not set", ctx: domainContext(7), err: vbservice.ErrPublicURLNotSet, want: codes.FailedPrecondition},
+		{name: "bot already bound", ctx: domainContext(7), err: sharedstore.ErrConflict, want: codes.AlreadyExists},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := newHandler(&gateManager{err: tt.err}).CreateViberGate(tt.ctx, &impb.ProviderCreateViberGateRequest{})
+			if status.Code(err) != tt.want {
+				t.Errorf("code = %v, want %v", status.Code(err), tt.want)
+			}
+		})
+	}
+}
+
+func TestUpdateViberGate_KeepsUnsetFields(t *testing.T) {
+	m := &gateManager{gates: map[string]*vbmodel.ViberGate{
+		"gate-1": {ID: "gate-1", DomainID: 7, Name: "Support", Peer: sharedmodel.Peer{Sub: "bot-1", Iss: "viber"}, Enabled: true},
+	}}
+	token := "token-2"
+
+	resp, err := newHandler(m).UpdateViberGate(domainContext(7), &impb.ProviderUpdateViberGateRequest{Id: "gate-1", BotToken: &token})
+	if err != nil {
+		t.Fatalf("UpdateViberGate: %v", err)
+	}
+
+	if m.updated.Name != nil || m.updated.Enabled != nil || m.updated.Peer != nil || *m.updated.BotToken != "token-2" {
+		t.Errorf("update request = %+v", m.updated)
+	}
+	if item := resp.GetItem(); item.GetName() != "Support" || !item.GetEnabled() || item.GetPeer().GetSub() != "bot-1" {
+		t.Errorf("unexpected gate: %+v", item)
+	}
+}
+
+func TestViberGate_OtherDomainIsNotFound(t *testing.T) {
+	m := &gateManager{gates: map[string]*vbmodel.ViberGate{"gate-1": {ID: "gate-1", DomainID: 7}}}
+	h := newHandler(m)
+	ctx := domainContext(8)
+
+	if _, err := h.GetViberGate(ctx, &impb.ProviderGetViberGateRequest{Id: "gate-1"}); status.Code(err) != codes.NotFound {
+		t.Errorf("get: code = %v, want NotFound", status.Code(err))
+	}
+	if _, err := h.UpdateViberGate(ctx, &impb.ProviderUpdateViberGateRequest{Id: "gate-1"}); status.Code(err) != codes.NotFound {
+		t.Errorf("update: code = %v, want NotFound", status.Code(err))
+	}
+	if _, err := h.DeleteViberGate(ctx, &impb.ProviderDeleteViberGateRequest{Id: "gate-1"}); status.Code(err) != codes.NotFound {
+		t.Errorf("delete: code = %v, want NotFound", status.Code(err))
+	}
+	if m.updated != nil || len(m.deleted) != 0 {
+		t.Errorf("gate of another domain changed: updated=%+v deleted=%v", m.updated, m.deleted)
+	}
+}
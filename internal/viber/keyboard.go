@@ -0,0 +1,307 @@
+package viber
+
+import (
+	"encoding/json"
+	"html"
+
+	sharedmodel "github.com/webitel/im-providers-service/internal/core/model"
+)
+
+const (
+	// keyboardColumns is the width of a keyboard row and of a rich media slide.
+	keyboardColumns = 6
+	// richMediaMaxRows is the tallest rich media slide Viber renders.
+	richMediaMaxRows = 7
+	// richMediaMaxSlides bounds the carousel length.
+	richMediaMaxSlides = 6
+	// cardImageRows and cardTextRows are the heights of a card image and its title block.
+	cardImageRows = 3
+	cardTextRows  = 2
+	// trackingDataMaxLen is the tracking_data limit of send_message.
+	trackingDataMaxLen = 4096
+)
+
+// Minimal client API versions of the message features used.
+// https://developers.viber.com/docs/tools/keyboards/#keyboard-design
+const (
+	apiVersionRichMedia   = 2
+	apiVersionDeviceShare = 3
+)
+
+// trackingData is sent with a keyboard message and echoed back with the user reply.
+// It correlates a reply with the message and button it came from, so no state is kept.
+type trackingData struct {
+	MessageID string `json:"m"`
+	// Buttons maps the reply ActionBody to the button code.
+	Buttons map[string]string `json:"b"`
+}
+
+// encodeTracking returns the tracking data for the callback buttons of a message,
+// or "" when there are none or they do not fit the limit.
+func encodeTracking(messageID string, all []sharedmodel.KeyboardButton) string {
+	buttons := callbackButtons(all)
+	if messageID == "" || len(buttons) == 0 {
+		return ""
+	}
+	raw, err := json.Marshal(trackingData{MessageID: messageID, Buttons: buttons})
+	if err != nil || len(raw) > trackingDataMaxLen {
+		return ""
+	}
+	return string(raw)
+}
+
+func decodeTracking(raw string) (trackingData, bool) {
+	var t trackingData
+	if raw == "" || json.Unmarshal([]byte(raw), &t) != nil || t.MessageID == "" {
+		return trackingData{}, false
+	}
+	return t, true
+}
+
+// callbackButtons collects callback data → button code for every callback button.
+// The button label stands in for the code when the button has no ID.
+func callbackButtons(all []sharedmodel.KeyboardButton) map[string]string {
+	buttons := make(map[string]string, len(all))
+	for _, b := range all {
+		if b.Callback == nil || b.Callback.Data == "" {
+			continue
+		}
+		code := b.ID
+		if code == "" {
+			code = b.Label
+		}
+		buttons[b.Callback.Data] = code
+	}
+	return buttons
+}
+
+// interactiveButtons flattens the keyboard or list buttons of an interactive message.
+func interactiveButtons(interactive *sharedmodel.Interactive) []sharedmodel.KeyboardButton {
+	if interactive == nil {
+		return nil
+	}
+
+	var all []sharedmodel.KeyboardButton
+	if interactive.Markup != nil {
+		for _, row := range interactive.Markup.Rows {
+			all = append(all, row.Buttons...)
+		}
+	}
+	if interactive.ListReply != nil {
+		for _, section := range interactive.ListReply.Sections {
+			all = append(all, section.Buttons...)
+		}
+	}
+	return all
+}
+
+// buildKeyboard maps the interactive markup onto a custom keyboard. Markup rows keep
+// their layout, up to six buttons a row; list items get a full-width row each.
+// The second result is the minimal client API version the keyboard needs.
+func buildKeyboard(interactive *sharedmodel.Interactive) (*keyboard, int) {
+	if interactive == nil {
+		return nil, 0
+	}
+
+	var rows [][]sharedmodel.KeyboardButton
+	if interactive.Markup != nil {
+		for _, row := range interactive.Markup.Rows {
+			for start := 0; start < len(row.Buttons); start += keyboardColumns {
+				end := min(start+keyboardColumns, len(row.Buttons))
+				rows = append(rows, row.Buttons[start:end])
+			}
+		}
+	}
+	if interactive.ListReply != nil {
+		for _, section := range interactive.ListReply.Sections {
+			for _, b := range section.Buttons {
+				rows = append(rows, []sharedmodel.KeyboardButton{b})
+			}
+		}
+	}
+
+	kb := &keyboard{Type: "keyboard"}
+	apiVersion := 0
+	for _, row := range rows {
+		mapped := make([]button, 0, len(row))
+		for _, b := range row {
+			if vb, version, ok := mapButton(b); ok {
+				mapped = append(mapped, vb)
+				apiVersion = max(apiVersion, version)
+			}
+		}
+		for i := range mapped {
+			mapped[i].Columns = rowColumns(len(mapped), i)
+			mapped[i].Rows = 1
+		}
+		kb.Buttons = append(kb.Buttons, mapped...)
+	}
+
+	if len(kb.Buttons) == 0 {
+		return nil, 0
+	}
+	return kb, apiVersion
+}
+
+// rowColumns spreads the six columns of a row over n buttons, the remainder going to the first ones.
+func rowColumns(n, i int) int {
+	columns := keyboardColumns / n
+	if i < keyboardColumns%n {
+		columns++
+	}
+	return columns
+}
+
+// mapButton converts a shared button into a Viber reply, URL or device share button.
+// The second result is the minimal client API version the button needs.
+func mapButton(b sharedmodel.KeyboardButton) (button, int, bool) {
+	vb := button{Text: html.EscapeString(b.Label), TextSize: "regular"}
+	switch {
+	case b.Callback != nil && b.Callback.Data != "":
+		vb.ActionType = actionReply
+		vb.ActionBody = b.Callback.Data
+	case b.URL != nil && b.URL.URL != "":
+		vb.ActionType = actionOpenURL
+		vb.ActionBody = b.URL.URL
+	case b.Request != nil:
+		switch b.Request.Action {
+		case "location":
+			vb.ActionType = actionLocationPicker
+		case "phone", "user_phone_number", "contact":
+			vb.ActionType = actionSharePhone
+		default:
+			return button{}, 0, false
+		}
+		// Device share buttons ignore the body, but it must not be empty.
+		vb.ActionBody = b.Request.Action
+		return vb, apiVersionDeviceShare, true
+	default:
+		return button{}, 0, false
+	}
+	return vb, 0, true
+}
+
+// buildRichMedia maps a carousel or a media card onto rich media slides. Every slide
+// is padded to the same height, as Viber fills the slides with buttons in order.
+// The second result is the minimal client API version the message needs.
+func buildRichMedia(carousel *sharedmodel.Carousel, media *sharedmodel.MediaCard) (*richMedia, int) {
+	var cards []sharedmodel.Card
+	switch {
+	case media != nil:
+		cards = []sharedmodel.Card{mediaCardAsCard(media)}
+	case carousel != nil:
+		cards = carousel.Cards
+	}
+	if len(cards) > richMediaMaxSlides {
+		cards = cards[:richMediaMaxSlides]
+	}
+
+	apiVersion := apiVersionRichMedia
+	slides := make([][]button, 0, len(cards))
+	groupRows := 1
+	for _, card := range cards {
+		slide, version := cardButtons(card)
+		if len(slide) == 0 {
+			continue
+		}
+		apiVersion = max(apiVersion, version)
+		groupRows = max(groupRows, slideRows(slide))
+		slides = append(slides, slide)
+	}
+	if len(slides) == 0 {
+		return nil, 0
+	}
+
+	rm := &richMedia{
+		Type:                "rich_media",
+		ButtonsGroupColumns: keyboardColumns,
+		ButtonsGroupRows:    groupRows,
+		BgColor:             "#FFFFFF",
+	}
+	for _, slide := range slides {
+		rm.Buttons = append(rm.Buttons, slide...)
+		if rows := slideRows(slide); rows < groupRows {
+			rm.Buttons = append(rm.Buttons, button{
+				Columns:    keyboardColumns,
+				Rows:       groupRows - rows,
+				ActionType: actionNone,
+				ActionBody: actionNone,
+			})
+		}
+	}
+	return rm, apiVersion
+}
+
+// cardButtons lays a card out as an image, a title block and one row per button,
+// dropping the buttons that do not fit the slide.
+func cardButtons(card sharedmodel.Card) ([]button, int) {
+	action, body := actionNone, actionNone
+	if card.DefaultURL != "" {
+		action, body = actionOpenURL, card.DefaultURL
+	}
+
+	var slide []button
+	if card.ImageURL != "" {
+		slide = append(slide, button{
+			Columns:    keyboardColumns,
+			Rows:       cardImageRows,
+			ActionType: action,
+			ActionBody: body,
+			Image:      card.ImageURL,
+		})
+	}
+	if card.Title != "" || card.Subtitle != "" {
+		text := "<b>" + html.EscapeString(card.Title) + "</b>"
+		if card.Subtitle != "" {
+			text += "<br>" + html.EscapeString(card.Subtitle)
+		}
+		slide = append(slide, button{
+			Columns:    keyboardColumns,
+			Rows:       cardTextRows,
+			ActionType: action,
+			ActionBody: body,
+			Text:       text,
+			TextVAlign: "middle",
+			TextHAlign: "left",
+		})
+	}
+
+	apiVersion := 0
+	for _, b := range card.Buttons {
+		if slideRows(slide) >= richMediaMaxRows {
+			break
+		}
+		vb, version, ok := mapButton(b)
+		if !ok {
+			continue
+		}
+		vb.Columns = keyboardColumns
+		vb.Rows = 1
+		vb.TextVAlign = "middle"
+		vb.TextHAlign = "center"
+		slide = append(slide, vb)
+		apiVersion = max(apiVersion, version)
+	}
+	return slide, apiVersion
+}
+
+func slideRows(slide []button) int {
+	rows := 0
+	for _, b := range slide {
+		rows += b.Rows
+	}
+	return rows
+}
+
+// mediaCardAsCard shows a media card image as a card image. Rich media cannot play
+// a video, so a video card opens the video on tap.
+func mediaCardAsCard(media *sharedmodel.MediaCard) sharedmodel.Card {
+	card := sharedmodel.Card{Buttons: media.Buttons}
+	if media.MediaType == sharedmodel.MediaCardVideo {
+		card.Title = "▶"
+		card.DefaultURL = media.URL
+		return card
+	}
+	card.ImageURL = media.URL
+	return card
+}
@@ -0,0 +1,128 @@
+package viber
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"path"
+	"time"
+
+	sharedmodel "github.com/webitel/im-providers-service/internal/core/model"
+	"github.com/webitel/im-providers-service/internal/provider/contactsync"
+	vbmodel "github.com/webitel/im-providers-service/internal/viber/model"
+)
+
+type syncedMedia struct {
+	id       string
+	mimeType string
+	size     int64
+}
+
+// handleMedia copies the media of an inbound message to the storage and forwards it.
+// Pictures and stickers become images, videos and files become documents.
+func (p *viberProvider) handleMedia(ctx context.Context, gate *vbmodel.ViberGate, peers contactsync.Peers, msg *inboundMessage) {
+	if msg.Media == "" {
+		return
+	}
+
+	name := mediaFileName(msg)
+	media, err := p.downloadAndUpload(ctx, gate, msg.Media, name)
+	if err != nil {
+		p.logger.Error("failed to sync media", "type", msg.Type, "err", err)
+		return
+	}
+
+	if media.size <= 0 {
+		media.size = msg.FileSize
+	}
+	if media.size <= 0 {
+		media.size = 1
+	}
+
+	switch msg.Type {
+	case MessagePicture, MessageSticker:
+		if _, err := p.messenger.SendImage(ctx, &sharedmodel.SendImageRequest{
+			DomainID: gate.DomainID,
+			From:     peers.From,
+			To:       peers.To,
+			Image: sharedmodel.ImageRequest{
+				Body: msg.Text,
+				Images: []*sharedmodel.Image{{
+					ID:       media.id,
+					FileName: name,
+					MimeType: media.mimeType,
+				}},
+			},
+		}); err != nil {
+			p.logger.Error("failed to send image", "fileName", name, "err", err)
+		}
+	default:
+		if _, err := p.messenger.SendDocument(ctx, &sharedmodel.SendDocumentRequest{
+			DomainID: gate.DomainID,
+			From:     peers.From,
+			To:       peers.To,
+			Document: sharedmodel.DocumentRequest{
+				Body: msg.Text,
+				Documents: []*sharedmodel.Document{{
+					ID:       media.id,
+					FileName: name,
+					MimeType: media.mimeType,
+					Size:     media.size,
+				}},
+			},
+		}); err != nil {
+			p.logger.Error("failed to send document", "fileName", name, "err", err)
+		}
+	}
+}
+
+func (p *viberProvider) downloadAndUpload(ctx context.Context, gate *vbmodel.ViberGate, mediaURL, fileName string) (*syncedMedia, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, mediaURL, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := p.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("viber download: status %s", resp.Status)
+	}
+
+	mimeType := resp.Header.Get("Content-Type")
+	size := resp.ContentLength
+
+	uploaded, err := p.media.UploadFile(ctx, sharedmodel.UploadRequest{
+		DomainID: gate.DomainID,
+		Name:     fileName,
+		MimeType: mimeType,
+	}, resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	return &syncedMedia{id: uploaded.ID, mimeType: mimeType, size: size}, nil
+}
+
+func mediaFileName(msg *inboundMessage) string {
+	if msg.FileName != "" {
+		return msg.FileName
+	}
+
+	ext := map[string]string{
+		MessagePicture: ".jpg",
+		MessageSticker: ".png",
+		MessageVideo:   ".mp4",
+	}[msg.Type]
+	if u, err := url.Parse(msg.Media); ext == "" && err == nil {
+		ext = path.Ext(u.Path)
+	}
+	if ext == "" {
+		ext = ".bin"
+	}
+	return fmt.Sprintf("viber_%s_%d%s", msg.Type, time.Now().Unix(), ext)
+}
@@ -0,0 +1,79 @@
+package model
+
+import (
+	"time"
+
+	sharedmodel "github.com/webitel/im-providers-service/internal/core/model"
+)
+
+// ViberGate represents a Viber bot gate configuration.
+type ViberGate struct {
+	ID       string           `json:"id" db:"id"`
+	DomainID int64            `json:"domain_id" db:"domain_id"`
+	Peer     sharedmodel.Peer `json:"peer" db:"peer"`
+	Name     string           `json:"name" db:"name"`
+	// BotID is the Viber account ID of the bot, BotURI its public account URI.
+	BotID      string                 `json:"bot_id" db:"bot_id"`
+	BotURI     string                 `json:"bot_uri" db:"bot_uri"`
+	BotToken   string                 `json:"-" db:"bot_token"`
+	WebhookURL string                 `json:"webhook_url" db:"webhook_url"`
+	Status     sharedmodel.GateStatus `json:"status" db:"status"`
+	CreatedAt  time.Time              `json:"created_at" db:"created_at"`
+	UpdatedAt  time.Time              `json:"updated_at" db:"updated_at"`
+	Enabled    bool                   `json:"enabled" db:"enabled"`
+}
+
+type CreateViber struct {
+	Name     string
+	Dc       int64
+	BotToken string
+	Peer     sharedmodel.Peer
+}
+
+type UpdateViber struct {
+	ID       string
+	Name     *string
+	BotToken *string
+	Enabled  *bool
+	Peer     *sharedmodel.Peer
+}
+
+func (r UpdateViber) ApplyTo(gate *ViberGate) {
+	if r.Name != nil {
+		gate.Name = *r.Name
+	}
+	if r.Enabled != nil {
+		gate.Enabled = *r.Enabled
+	}
+	if r.BotToken != nil {
+		gate.BotToken = *r.BotToken
+	}
+	if r.Peer != nil {
+		gate.Peer = *r.Peer
+	}
+}
+
+// Account is the bot account as returned by get_account_info.
+type Account struct {
+	ID      string
+	Name    string
+	URI     string
+	Webhook string
+}
+
+func (r CreateViber) Validate() error {
+	var missing []string
+	if r.Name == "" {
+		missing = append(missing, "name")
+	}
+	if r.BotToken == "" {
+		missing = append(missing, "bot_token")
+	}
+	if r.Dc <= 0 {
+		missing = append(missing, "domain_id")
+	}
+	if len(missing) > 0 {
+		return &sharedmodel.ValidationError{Fields: missing}
+	}
+	return nil
+}
@@ -0,0 +1,44 @@
+package viber
+
+import (
+	impb "github.com/webitel/im-providers-service/gen/go/provider/v1"
+	grpcsrv "github.com/webitel/im-providers-service/infra/srv/grpc"
+	"github.com/webitel/im-providers-service/internal/provider"
+	vbhandler "github.com/webitel/im-providers-service/internal/viber/handler"
+	vbservice "github.com/webitel/im-providers-service/internal/viber/service"
+	vbstore "github.com/webitel/im-providers-service/internal/viber/store"
+	vbpostgres "github.com/webitel/im-providers-service/internal/viber/store/postgres"
+	"go.uber.org/fx"
+)
+
+// Module provides the Viber provider adapter and the gRPC gate service.
+var Module = fx.Module("viber",
+	fx.Provide(
+		// Bot API client — provided as *apiClient for the provider adapter
+		// and as BotAPI for the Viber service.
+		newAPIClient,
+		func(c *apiClient) vbservice.BotAPI { return c },
+
+		// Provider adapter
+		fx.Annotate(
+			New,
+			fx.As(new(provider.Provider)),
+			fx.ResultTags(`group:"providers"`),
+		),
+
+		// Store implementations
+		fx.Annotate(vbpostgres.NewViberStore, fx.As(new(vbstore.ViberStore))),
+
+		// Services
+		fx.Annotate(vbservice.NewViberService, fx.As(new(vbservice.ViberManager))),
+
+		// gRPC handlers
+		vbhandler.NewViberHandler,
+	),
+	fx.Invoke(RegisterViberService),
+)
+
+// RegisterViberService connects the Viber gRPC handler to the gRPC server.
+func RegisterViberService(server *grpcsrv.Server, viber *vbhandler.ViberHandler) {
+	impb.RegisterViberServiceServer(server.Server, viber)
+}
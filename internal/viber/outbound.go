@@ -0,0 +1,195 @@
+package viber
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	contactv1 "github.com/webitel/im-providers-service/gen/go/contact/v1"
+	sharedmodel "github.com/webitel/im-providers-service/internal/core/model"
+	"github.com/webitel/im-providers-service/internal/provider/contactsync"
+	vbmodel "github.com/webitel/im-providers-service/internal/viber/model"
+)
+
+// senderNameMaxLen is the limit of the sender name shown above bot messages.
+const senderNameMaxLen = 28
+
+func (p *viberProvider) SendText(ctx context.Context, req *sharedmodel.Message) (*sharedmodel.MessageResponse, error) {
+	return p.send(ctx, req, &outboundMessage{Type: MessageText, Text: req.Text})
+}
+
+// SendImage sends every image as a picture message, the text goes as the caption of the first one.
+func (p *viberProvider) SendImage(ctx context.Context, req *sharedmodel.Message) (*sharedmodel.MessageResponse, error) {
+	msgs := make([]*outboundMessage, 0, len(req.Images))
+	for i, img := range req.Images {
+		msg := &outboundMessage{Type: MessagePicture, Media: img.URL}
+		if i == 0 {
+			msg.Text = req.Text
+		}
+		msgs = append(msgs, msg)
+	}
+	return p.send(ctx, req, msgs...)
+}
+
+// SendDocument sends every document as a file message. A message text is sent first,
+// file messages carry no caption.
+func (p *viberProvider) SendDocument(ctx context.Context, req *sharedmodel.Message) (*sharedmodel.MessageResponse, error) {
+	msgs := make([]*outboundMessage, 0, len(req.Documents)+1)
+	if req.Text != "" {
+		msgs = append(msgs, &outboundMessage{Type: MessageText, Text: req.Text})
+	}
+	for _, doc := range req.Documents {
+		msgs = append(msgs, &outboundMessage{
+			Type:     MessageFile,
+			Media:    doc.URL,
+			Size:     doc.Size,
+			FileName: doc.FileName,
+		})
+	}
+	return p.send(ctx, req, msgs...)
+}
+
+// SendInteractive sends the text with the buttons as a custom keyboard.
+func (p *viberProvider) SendInteractive(ctx context.Context, req *sharedmodel.Message) (*sharedmodel.MessageResponse, error) {
+	text := req.Text
+	if text == "" && req.Interactive != nil {
+		text = req.Interactive.Body
+	}
+
+	msg := &outboundMessage{Type: MessageText, Text: text}
+	msg.Keyboard, msg.MinAPIVersion = buildKeyboard(req.Interactive)
+	if msg.Keyboard != nil {
+		msg.TrackingData = encodeTracking(messageID(req), interactiveButtons(req.Interactive))
+	}
+	return p.send(ctx, req, msg)
+}
+
+// SendCards sends a carousel or a media card as a rich media message.
+// Rich media carries no free text, so a message text is sent first as a separate message.
+func (p *viberProvider) SendCards(ctx context.Context, req *sharedmodel.Message) (*sharedmodel.MessageResponse, error) {
+	rm, apiVersion := buildRichMedia(req.Carousel, req.MediaCard)
+	if rm == nil {
+		return nil, fmt.Errorf("viber: cards have no content")
+	}
+
+	var buttons []sharedmodel.KeyboardButton
+	if req.MediaCard != nil {
+		buttons = req.MediaCard.Buttons
+	} else if req.Carousel != nil {
+		buttons = req.Carousel.Buttons()
+	}
+
+	msgs := make([]*outboundMessage, 0, 2)
+	if req.Text != "" {
+		msgs = append(msgs, &outboundMessage{Type: MessageText, Text: req.Text})
+	}
+	msgs = append(msgs, &outboundMessage{
+		Type:          MessageRichMedia,
+		MinAPIVersion: apiVersion,
+		RichMedia:     rm,
+		TrackingData:  encodeTracking(messageID(req), buttons),
+	})
+	return p.send(ctx, req, msgs...)
+}
+
+func (p *viberProvider) SendLocation(ctx context.Context, req *sharedmodel.Message) (*sharedmodel.MessageResponse, error) {
+	if req.Location == nil {
+		return nil, fmt.Errorf("viber: message has no location")
+	}
+	return p.send(ctx, req, &outboundMessage{
+		Type:     MessageLocation,
+		Location: &messageLocation{Lat: req.Location.Latitude, Lon: req.Location.Longitude},
+	})
+}
+
+// SendContact sends a contact message per card. Viber contacts hold a single phone,
+// so cards without one are sent as formatted text.
+func (p *viberProvider) SendContact(ctx context.Context, req *sharedmodel.Message) (*sharedmodel.MessageResponse, error) {
+	msgs := make([]*outboundMessage, 0, len(req.Contacts))
+	for _, card := range req.Contacts {
+		if len(card.Phones) == 0 {
+			msgs = append(msgs, &outboundMessage{Type: MessageText, Text: card.PlainText()})
+			continue
+		}
+		msgs = append(msgs, &outboundMessage{
+			Type:    MessageContact,
+			Contact: &messageContact{Name: card.FormattedName(), PhoneNumber: card.Phones[0]},
+		})
+	}
+	return p.send(ctx, req, msgs...)
+}
+
+// send delivers the messages in order on behalf of the gate bot and returns the
+// response of the first one. It stops at the first failure.
+func (p *viberProvider) send(ctx context.Context, req *sharedmodel.Message, msgs ...*outboundMessage) (*sharedmodel.MessageResponse, error) {
+	if len(msgs) == 0 {
+		return nil, fmt.Errorf("viber: nothing to send")
+	}
+
+	g, err := p.repo.Select(ctx, req.GateID)
+	if err != nil {
+		return nil, err
+	}
+	receiver, err := p.resolveReceiver(ctx, g, req.To.Sub)
+	if err != nil {
+		return nil, err
+	}
+
+	var first *sharedmodel.MessageResponse
+	for _, msg := range msgs {
+		msg.Receiver = receiver
+		msg.Sender = messageSender{Name: senderName(g.Name)}
+
+		resp, err := p.api.SendMessage(ctx, g.BotToken, msg)
+		if err != nil {
+			return nil, err
+		}
+		if first == nil {
+			first = resp
+		}
+	}
+	return first, nil
+}
+
+// resolveReceiver returns the Viber user ID for the given sub.
+// A Viber ID is returned as-is; an internal contact UUID is resolved via the contact service.
+func (p *viberProvider) resolveReceiver(ctx context.Context, gate *vbmodel.ViberGate, contactID string) (string, error) {
+	if _, err := uuid.Parse(contactID); err != nil {
+		return contactID, nil
+	}
+	if userID, ok := p.receiverCache.Get(contactID); ok {
+		return userID, nil
+	}
+
+	authCtx := contactsync.WithGatewayIdentity(ctx, gate.DomainID, gate.Peer)
+	resp, err := p.contactClient.SearchContact(authCtx, &contactv1.SearchContactRequest{
+		Ids: []string{contactID},
+	})
+	if err != nil {
+		return "", fmt.Errorf("resolve viber user for %s: %w", contactID, err)
+	}
+	items := resp.GetContacts()
+	if len(items) == 0 || items[0].GetSubject() == "" {
+		return "", fmt.Errorf("resolve viber user for %s: contact not found or has no subject", contactID)
+	}
+	userID := items[0].GetSubject()
+	p.receiverCache.Add(contactID, userID)
+	return userID, nil
+}
+
+func messageID(req *sharedmodel.Message) string {
+	if req.ID == uuid.Nil {
+		return ""
+	}
+	return req.ID.String()
+}
+
+// senderName fits the gate name into the sender name limit.
+func senderName(name string) string {
+	name = strings.TrimSpace(name)
+	if runes := []rune(name); len(runes) > senderNameMaxLen {
+		return string(runes[:senderNameMaxLen])
+	}
+	return name
+}
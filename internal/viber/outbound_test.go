@@ -0,0 +1,293 @@
+package viber
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	sharedmodel "github.com/webitel/im-providers-service/internal/core/model"
+)
+
+func outboundRequest() *sharedmodel.Message {
+	return &sharedmodel.Message{
+		ID:     uuid.MustParse("0190a8a4-aaaa-7000-8000-000000000001"),
+		GateID: testGateID,
+		To:     sharedmodel.Peer{Sub: testUserID},
+	}
+}
+
+func TestSendText(t *testing.T) {
+	p := newConnectedBot(t)
+	p.stub.replies["send_message"] = `{"status":0,"status_message":"ok","message_token":5741311803571721087,"chat_hostname":"SN-CHAT-05_"}`
+
+	req := outboundRequest()
+	req.Text = "Hello world!"
+	resp, err := p.SendText(context.Background(), req)
+	if err != nil {
+		t.Fatalf("SendText: %v", err)
+	}
+	if resp.ID != "5741311803571721087" {
+		t.Errorf("message id = %q", resp.ID)
+	}
+
+	calls := p.stub.sent()
+	if len(calls) != 1 || calls[0].method != "send_message" || calls[0].token != testToken {
+		t.Fatalf("unexpected calls: %+v", calls)
+	}
+	body := calls[0].body
+	if body["receiver"] != testUserID || body["type"] != "text" || body["text"] != "Hello world!" {
+		t.Errorf("unexpected body: %v", body)
+	}
+	if sender, _ := body["sender"].(map[string]any); sender["name"] != "Support" {
+		t.Errorf("unexpected sender: %v", body["sender"])
+	}
+}
+
+func TestSendText_NotSubscribed(t *testing.T) {
+	p := newConnectedBot(t)
+	p.stub.replies["send_message"] = `{"status":6,"status_message":"notSubscribed"}`
+
+	req := outboundRequest()
+	req.Text = "Hello"
+	_, err := p.SendText(context.Background(), req)
+	if !errors.Is(err, ErrNotSubscribed) {
+		t.Fatalf("SendText() error = %v, want ErrNotSubscribed", err)
+	}
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || apiErr.Status != 6 {
+		t.Errorf("error does not carry the API status: %v", err)
+	}
+}
+
+func TestSendImageAndDocument(t *testing.T) {
+	p := newConnectedBot(t)
+
+	req := outboundRequest()
+	req.Text = "caption"
+	req.Images = []*sharedmodel.Image{{URL: "https://cdn.example.com/1.jpg"}, {URL: "https://cdn.example.com/2.jpg"}}
+	if _, err := p.SendImage(context.Background(), req); err != nil {
+		t.Fatalf("SendImage: %v", err)
+	}
+
+	req = outboundRequest()
+	req.Documents = []*sharedmodel.Document{{URL: "https://cdn.example.com/a.pdf", FileName: "a.pdf", Size: 10240}}
+	if _, err := p.SendDocument(context.Background(), req); err != nil {
+		t.Fatalf("SendDocument: %v", err)
+	}
+
+	calls := p.stub.sent()
+	if len(calls) != 3 {
+		t.Fatalf("expected 3 messages, got %d", len(calls))
+	}
+	if b := calls[0].body; b["type"] != "picture" || b["media"] != "https://cdn.example.com/1.jpg" || b["text"] != "caption" {
+		t.Errorf("unexpected first picture: %v", b)
+	}
+	if b := calls[1].body; b["type"] != "picture" || b["text"] != nil {
+		t.Errorf("only the first picture carries the caption: %v", b)
+	}
+	if b := calls[2].body; b["type"] != "file" || b["file_name"] != "a.pdf" || b["size"] != float64(10240) {
+		t.Errorf("unexpected file: %v", b)
+	}
+}
+
+func TestSendLocationAndContact(t *testing.T) {
+	p := newConnectedBot(t)
+
+	req := outboundRequest()
+	req.Location = &sharedmodel.Location{Latitude: 50.4501, Longitude: 30.5234}
+	if _, err := p.SendLocation(context.Background(), req); err != nil {
+		t.Fatalf("SendLocation: %v", err)
+	}
+
+	req = outboundRequest()
+	req.Contacts = []*sharedmodel.ContactCard{
+		{FirstName: "Jane", LastName: "Doe", Phones: []string{"+380501234567", "+380671234567"}},
+		{Company: "ACME", Emails: []string{"info@acme.example"}},
+	}
+	if _, err := p.SendContact(context.Background(), req); err != nil {
+		t.Fatalf("SendContact: %v", err)
+	}
+
+	calls := p.stub.sent()
+	if len(calls) != 3 {
+		t.Fatalf("expected 3 messages, got %d", len(calls))
+	}
+	loc, _ := calls[0].body["location"].(map[string]any)
+	if calls[0].body["type"] != "location" || loc["lat"] != 50.4501 || loc["lon"] != 30.5234 {
+		t.Errorf("unexpected location: %v", calls[0].body)
+	}
+	contact, _ := calls[1].body["contact"].(map[string]any)
+	if calls[1].body["type"] != "contact" || contact["name"] != "Jane Doe" || contact["phone_number"] != "+380501234567" {
+		t.Errorf("unexpected contact: %v", calls[1].body)
+	}
+	if calls[2].body["type"] != "text" || calls[2].body["text"] != "ACME\nEmail: info@acme.example" {
+		t.Errorf("a card without phone must be sent as text: %v", calls[2].body)
+	}
+}
+
+func TestSendInteractive_Keyboard(t *testing.T) {
+	p := newConnectedBot(t)
+
+	req := outboundRequest()
+	req.Text = "Pick one"
+	req.Interactive = &sharedmodel.Interactive{Markup: &sharedmodel.KeyboardMarkup{Rows: []sharedmodel.KeyboardRow{
+		{Buttons: []sharedmodel.KeyboardButton{
+			{ID: "btn-yes", Label: "Yes", Callback: &sharedmodel.KeyboardButtonCallback{Data: "YES"}},
+			{ID: "btn-no", Label: "No", Callback: &sharedmodel.KeyboardButtonCallback{Data: "NO"}},
+			{Label: "Site", URL: &sharedmodel.KeyboardButtonURL{URL: "https://example.com"}},
+			{Label: "Share phone", Request: &sharedmodel.KeyboardButtonRequest{Action: "phone"}},
+		}},
+	}}}
+	if _, err := p.SendInteractive(context.Background(), req); err != nil {
+		t.Fatalf("SendInteractive: %v", err)
+	}
+
+	calls := p.stub.sent()
+	if len(calls) != 1 {
+		t.Fatalf("expected 1 message, got %d", len(calls))
+	}
+	raw, _ := json.Marshal(calls[0].body)
+	var got outboundMessage
+	if err := json.Unmarshal(raw, &got); err != nil {
+		t.Fatal(err)
+	}
+
+	if got.Type != MessageText || got.Text != "Pick one" || got.Keyboard == nil {
+		t.Fatalf("unexpected message: %s", raw)
+	}
+	if got.MinAPIVersion != apiVersionDeviceShare {
+		t.Errorf("min_api_version = %d, want %d", got.MinAPIVersion, apiVersionDeviceShare)
+	}
+
+	wantActions := []string{actionReply, actionReply, actionOpenURL, actionSharePhone}
+	if len(got.Keyboard.Buttons) != len(wantActions) {
+		t.Fatalf("expected %d buttons, got %d", len(wantActions), len(got.Keyboard.Buttons))
+	}
+	columns := 0
+	for i, b := range got.Keyboard.Buttons {
+		if b.ActionType != wantActions[i] {
+			t.Errorf("button %d action = %q, want %q", i, b.ActionType, wantActions[i])
+		}
+		columns += b.Columns
+	}
+	if columns != keyboardColumns {
+		t.Errorf("row spans %d columns, want %d", columns, keyboardColumns)
+	}
+
+	tracking, ok := decodeTracking(got.TrackingData)
+	if !ok || tracking.MessageID != req.ID.String() || tracking.Buttons["YES"] != "btn-yes" || tracking.Buttons["NO"] != "btn-no" {
+		t.Errorf("unexpected tracking data: %q", got.TrackingData)
+	}
+}
+
+func TestSendCards_RichMedia(t *testing.T) {
+	p := newConnectedBot(t)
+
+	req := outboundRequest()
+	req.Text = "Our picks"
+	req.Carousel = &sharedmodel.Carousel{Cards: []sharedmodel.Card{
+		{
+			Title:      "Sneakers",
+			Subtitle:   "Size 42",
+			ImageURL:   "https://cdn.example.com/1.jpg",
+			DefaultURL: "https://shop.example.com/1",
+			Buttons: []sharedmodel.KeyboardButton{
+				{ID: "buy-1", Label: "Buy", Callback: &sharedmodel.KeyboardButtonCallback{Data: "BUY_1"}},
+				{Label: "Open", URL: &sharedmodel.KeyboardButtonURL{URL: "https://shop.example.com/1"}},
+			},
+		},
+		{Title: "Boots"},
+	}}
+	if _, err := p.SendCards(context.Background(), req); err != nil {
+		t.Fatalf("SendCards: %v", err)
+	}
+
+	calls := p.stub.sent()
+	if len(calls) != 2 || calls[0].body["text"] != "Our picks" {
+		t.Fatalf("expected the text and the rich media, got %+v", calls)
+	}
+	raw, _ := json.Marshal(calls[1].body)
+	var got outboundMessage
+	if err := json.Unmarshal(raw, &got); err != nil {
+		t.Fatal(err)
+	}
+	if got.Type != MessageRichMedia || got.RichMedia == nil || got.MinAPIVersion != apiVersionRichMedia {
+		t.Fatalf("unexpected message: %s", raw)
+	}
+
+	rm := got.RichMedia
+	// image (3) + title (2) + two buttons
+	if rm.ButtonsGroupColumns != keyboardColumns || rm.ButtonsGroupRows != 7 {
+		t.Errorf("group = %dx%d, want 6x7", rm.ButtonsGroupColumns, rm.ButtonsGroupRows)
+	}
+	if rows := slideRows(rm.Buttons); rows != 2*rm.ButtonsGroupRows {
+		t.Errorf("buttons span %d rows, want two full slides of %d", rows, rm.ButtonsGroupRows)
+	}
+	if first := rm.Buttons[0]; first.Image != "https://cdn.example.com/1.jpg" || first.ActionType != actionOpenURL {
+		t.Errorf("unexpected image button: %+v", first)
+	}
+	if last := rm.Buttons[len(rm.Buttons)-1]; last.ActionType != actionNone || last.Rows != 5 {
+		t.Errorf("short slide must be padded: %+v", last)
+	}
+
+	tracking, ok := decodeTracking(got.TrackingData)
+	if !ok || tracking.Buttons["BUY_1"] != "buy-1" {
+		t.Errorf("unexpected tracking data: %q", got.TrackingData)
+	}
+}
+
+func TestBuildKeyboard_ListAndWideRows(t *testing.T) {
+	var row []sharedmodel.KeyboardButton
+	for i := range 8 {
+		data := string(rune('A' + i))
+		row = append(row, sharedmodel.KeyboardButton{Label: data, Callback: &sharedmodel.KeyboardButtonCallback{Data: data}})
+	}
+
+	kb, version := buildKeyboard(&sharedmodel.Interactive{
+		Markup: &sharedmodel.KeyboardMarkup{Rows: []sharedmodel.KeyboardRow{{Buttons: row}}},
+		ListReply: &sharedmodel.KeyboardListReply{Sections: []sharedmodel.KeyboardRowWithSection{
+			{Section: "More", Buttons: []sharedmodel.KeyboardButton{{Label: "Item", Callback: &sharedmodel.KeyboardButtonCallback{Data: "ITEM"}}}},
+		}},
+	})
+	if kb == nil || version != 0 {
+		t.Fatalf("buildKeyboard() = %+v, %d", kb, version)
+	}
+
+	// 8 buttons split into rows of 6 and 2, then a full-width list item.
+	wantColumns := []int{1, 1, 1, 1, 1, 1, 3, 3, 6}
+	if len(kb.Buttons) != len(wantColumns) {
+		t.Fatalf("expected %d buttons, got %d", len(wantColumns), len(kb.Buttons))
+	}
+	for i, b := range kb.Buttons {
+		if b.Columns != wantColumns[i] {
+			t.Errorf("button %d columns = %d, want %d", i, b.Columns, wantColumns[i])
+		}
+	}
+
+	if kb, _ := buildKeyboard(&sharedmodel.Interactive{Body: "no buttons"}); kb != nil {
+		t.Errorf("expected no keyboard, got %+v", kb)
+	}
+}
+
+func TestRowColumns(t *testing.T) {
+	for n := 1; n <= keyboardColumns; n++ {
+		total := 0
+		for i := range n {
+			total += rowColumns(n, i)
+		}
+		if total != keyboardColumns {
+			t.Errorf("rowColumns over %d buttons spans %d columns", n, total)
+		}
+	}
+}
+
+func TestSenderName(t *testing.T) {
+	if got := senderName("  Webitel customer support desk  "); got != "Webitel customer support des" {
+		t.Errorf("senderName() = %q", got)
+	}
+	if got := senderName("Підтримка"); got != "Підтримка" {
+		t.Errorf("senderName() = %q", got)
+	}
+}
@@ -0,0 +1,103 @@
+// Package viber implements the Viber REST Bot API provider.
+// https://developers.viber.com/docs/api/rest-bot-api/
+package viber
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+	lru "github.com/hashicorp/golang-lru/v2"
+	imcontact "github.com/webitel/im-providers-service/infra/client/grpc/im-contact"
+	imgateway "github.com/webitel/im-providers-service/infra/client/grpc/im-gateway"
+	sharedsvc "github.com/webitel/im-providers-service/internal/core/service"
+	sharedstore "github.com/webitel/im-providers-service/internal/core/store"
+	"github.com/webitel/im-providers-service/internal/provider"
+	vbmodel "github.com/webitel/im-providers-service/internal/viber/model"
+	vbstore "github.com/webitel/im-providers-service/internal/viber/store"
+)
+
+type viberProvider struct {
+	api           botAPI
+	logger        *slog.Logger
+	messenger     sharedsvc.Messenger
+	gateCache     sharedstore.GateCache
+	userCache     sharedstore.ExternalUserCache
+	repo          vbstore.ViberStore
+	gatewayer     *imgateway.Client
+	media         sharedsvc.MediaManager
+	contactClient *imcontact.Client
+	// receiverCache maps internal contact UUID → Viber user ID to avoid an
+	// im-contact round-trip on every outbound message.
+	receiverCache *lru.Cache[string, string]
+	// httpClient downloads inbound media. Viber media URLs are public and short-lived.
+	httpClient *http.Client
+}
+
+func New(
+	m sharedsvc.Messenger,
+	l *slog.Logger,
+	gc sharedstore.GateCache,
+	uc sharedstore.ExternalUserCache,
+	repo vbstore.ViberStore,
+	gatewayer *imgateway.Client,
+	media sharedsvc.MediaManager,
+	contactClient *imcontact.Client,
+	api *apiClient,
+) provider.Provider {
+	receiverCache, _ := lru.New[string, string](1000)
+	return &viberProvider{
+		api:           api,
+		logger:        l.With("provider", "viber"),
+		messenger:     m,
+		gateCache:     gc,
+		userCache:     uc,
+		repo:          repo,
+		gatewayer:     gatewayer,
+		media:         media,
+		contactClient: contactClient,
+		receiverCache: receiverCache,
+		httpClient:    &http.Client{Timeout: 30 * time.Second},
+	}
+}
+
+var (
+	_ provider.SignatureValidator = (*viberProvider)(nil)
+	_ provider.SignatureHeader    = (*viberProvider)(nil)
+	_ provider.InteractiveSender  = (*viberProvider)(nil)
+	_ provider.CardSender         = (*viberProvider)(nil)
+	_ provider.LocationSender     = (*viberProvider)(nil)
+	_ provider.ContactSender      = (*viberProvider)(nil)
+)
+
+func (p *viberProvider) Type() string { return "viber" }
+
+// resolveGate returns the gate a webhook was delivered to. The webhook URI segment is
+// the gate ID, set by the gate service on set_webhook. Disabled gates are
+// short-circuited from the cache to avoid a DB round-trip on every callback.
+func (p *viberProvider) resolveGate(ctx context.Context) (*vbmodel.ViberGate, error) {
+	gateID, _ := ctx.Value(provider.WebhookURIKey).(string)
+	if _, err := uuid.Parse(gateID); err != nil {
+		return nil, fmt.Errorf("invalid webhook uri %q: %w", gateID, sharedstore.ErrNotFound)
+	}
+
+	if cached, ok := p.gateCache.Get(gateID); ok && !cached.Enabled {
+		return &vbmodel.ViberGate{ID: gateID, Enabled: false}, nil
+	}
+
+	g, err := p.repo.Select(ctx, gateID)
+	if err != nil {
+		return nil, err
+	}
+	p.gateCache.Set(gateID, sharedstore.GateState{
+		GateID:  g.ID,
+		Enabled: g.Enabled,
+		Issuer:  g.Peer.Iss,
+		Sub:     g.Peer.Sub,
+		Domain:  g.DomainID,
+	})
+	return g, nil
+}
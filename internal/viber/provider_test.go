@@ -0,0 +1,139 @@
+package viber
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	sharedmodel "github.com/webitel/im-providers-service/internal/core/model"
+	sharedstore "github.com/webitel/im-providers-service/internal/core/store"
+	"github.com/webitel/im-providers-service/internal/provider"
+	"github.com/webitel/im-providers-service/internal/provider/providertest"
+	vbmodel "github.com/webitel/im-providers-service/internal/viber/model"
+	vbstore "github.com/webitel/im-providers-service/internal/viber/store"
+)
+
+const (
+	testGateID = "0190a8a4-5f0e-7c2a-9d3b-0a1b2c3d4e5f"
+	testToken  = "445da6az1s345z78-dazcczb2542zv51a-e0vc5fva17480im9"
+	testUserID = "01234567890A="
+)
+
+var noopLogger = slog.New(slog.NewTextHandler(io.Discard, nil))
+
+// -- Bot API stub --
+
+type apiCall struct {
+	method string
+	token  string
+	body   map[string]any
+}
+
+// botStub is a Viber Bot API stub answering every method with the reply set for it,
+// or with {"status":0} when none is set.
+type botStub struct {
+	mu      sync.Mutex
+	calls   []apiCall
+	replies map[string]string
+}
+
+func newBotStub(t *testing.T) (*botStub, *apiClient) {
+	t.Helper()
+
+	stub := &botStub{replies: map[string]string{}}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]any
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Errorf("decoding %s body: %v", r.URL.Path, err)
+		}
+
+		method := strings.TrimPrefix(r.URL.Path, "/")
+		stub.mu.Lock()
+		stub.calls = append(stub.calls, apiCall{method: method, token: r.Header.Get(authTokenHeader), body: body})
+		reply, ok := stub.replies[method]
+		stub.mu.Unlock()
+
+		if !ok {
+			reply = `{"status":0,"status_message":"ok"}`
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(reply))
+	}))
+	t.Cleanup(server.Close)
+
+	api := newAPIClient(noopLogger)
+	api.apiURL = server.URL
+	return stub, api
+}
+
+func (s *botStub) sent() []apiCall {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]apiCall(nil), s.calls...)
+}
+
+// botGates serves the gates a webhook resolves to. The provider only reads gates;
+// writing them is the job of the gate service.
+type botGates map[string]*vbmodel.ViberGate
+
+var _ vbstore.ViberStore = botGates(nil)
+
+func (g botGates) Select(_ context.Context, id string) (*vbmodel.ViberGate, error) {
+	gate, ok := g[id]
+	if !ok {
+		return nil, sharedstore.ErrNotFound
+	}
+	cp := *gate
+	return &cp, nil
+}
+
+func (botGates) Insert(context.Context, int64, *vbmodel.ViberGate) error {
+	panic("viber provider must not insert gates")
+}
+
+func (botGates) Update(context.Context, *vbmodel.ViberGate) error {
+	panic("viber provider must not update gates")
+}
+
+func (botGates) Delete(context.Context, string) error {
+	panic("viber provider must not delete gates")
+}
+
+// connectedBot is the provider with one gate whose bot talks to the Bot API stub.
+type connectedBot struct {
+	*viberProvider
+	stub      *botStub
+	messenger *providertest.Messenger
+	media     *providertest.Media
+	gate      *vbmodel.ViberGate
+}
+
+func newConnectedBot(t *testing.T) *connectedBot {
+	t.Helper()
+
+	stub, api := newBotStub(t)
+	messenger := &providertest.Messenger{}
+	media := &providertest.Media{}
+	gate := &vbmodel.ViberGate{
+		ID:       testGateID,
+		DomainID: 1,
+		Name:     "Support",
+		Peer:     sharedmodel.Peer{Sub: "bot-1", Iss: "viber"},
+		BotID:    "pa:5516787538472947251",
+		BotToken: testToken,
+		Enabled:  true,
+	}
+
+	p := New(messenger, noopLogger, providertest.NewGateCache(), providertest.KnownUsers{}, botGates{testGateID: gate}, nil, media, nil, api).(*viberProvider)
+	return &connectedBot{viberProvider: p, stub: stub, messenger: messenger, media: media, gate: gate}
+}
+
+func webhookContext(gateID string) context.Context {
+	return context.WithValue(context.Background(), provider.WebhookURIKey, gateID)
+}
@@ -0,0 +1,186 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/webitel/im-providers-service/config"
+	vbmodel "github.com/webitel/im-providers-service/internal/viber/model"
+	vbstore "github.com/webitel/im-providers-service/internal/viber/store"
+)
+
+// ErrPublicURLNotSet is returned when a webhook is to be set but service.public_url is empty.
+var ErrPublicURLNotSet = errors.New("viber: service.public_url is not configured")
+
+var _ ViberManager = (*ViberService)(nil)
+
+type ViberManager interface {
+	CreateGate(ctx context.Context, req vbmodel.CreateViber) (*vbmodel.ViberGate, error)
+	GetGate(ctx context.Context, id string) (*vbmodel.ViberGate, error)
+	UpdateGate(ctx context.Context, req vbmodel.UpdateViber) (*vbmodel.ViberGate, error)
+	DeleteGate(ctx context.Context, id string) (*vbmodel.ViberGate, error)
+}
+
+// BotAPI is the subset of the Viber Bot API used to manage the bot webhook.
+// Defined here (exported) so the parent viber package can satisfy it without an import cycle.
+type BotAPI interface {
+	GetAccountInfo(ctx context.Context, token string) (*vbmodel.Account, error)
+	SetWebhook(ctx context.Context, token, url string) error
+	RemoveWebhook(ctx context.Context, token string) error
+}
+
+type ViberService struct {
+	repo   vbstore.ViberStore
+	botAPI BotAPI
+	cfg    *config.Config
+	log    *slog.Logger
+}
+
+func NewViberService(repo vbstore.ViberStore, botAPI BotAPI, cfg *config.Config, log *slog.Logger) *ViberService {
+	return &ViberService{
+		repo:   repo,
+		botAPI: botAPI,
+		cfg:    cfg,
+		log:    log.With("layer", "service", "domain", "viber_gate"),
+	}
+}
+
+// CreateGate stores the gate of the bot the token belongs to and points the bot
+// webhook to it. The gate is removed again when Viber rejects the webhook.
+func (v *ViberService) CreateGate(ctx context.Context, req vbmodel.CreateViber) (*vbmodel.ViberGate, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	if v.cfg.Service.PublicURL == "" {
+		return nil, ErrPublicURLNotSet
+	}
+
+	account, err := v.botAPI.GetAccountInfo(ctx, req.BotToken)
+	if err != nil {
+		return nil, fmt.Errorf("get viber account info: %w", err)
+	}
+
+	gate := &vbmodel.ViberGate{
+		Name:     req.Name,
+		BotID:    account.ID,
+		BotURI:   account.URI,
+		BotToken: req.BotToken,
+		Peer:     req.Peer,
+		Enabled:  true,
+	}
+
+	if err := v.repo.Insert(ctx, req.Dc, gate); err != nil {
+		v.log.Error("failed to create viber gate", "bot_id", account.ID, "err", err)
+		return nil, err
+	}
+
+	if err := v.setWebhook(ctx, gate); err != nil {
+		if delErr := v.repo.Delete(ctx, gate.ID); delErr != nil {
+			v.log.Error("failed to remove viber gate without webhook", "id", gate.ID, "err", delErr)
+		}
+		return nil, err
+	}
+
+	v.log.Info("viber gate created", "id", gate.ID, "bot_id", gate.BotID, "webhook", gate.WebhookURL)
+	return gate, nil
+}
+
+func (v *ViberService) GetGate(ctx context.Context, id string) (*vbmodel.ViberGate, error) {
+	return v.repo.Select(ctx, id)
+}
+
+// UpdateGate applies the changes; a new token may belong to another bot, whose
+// webhook is then set while the previous bot stops delivering to the gate.
+func (v *ViberService) UpdateGate(ctx context.Context, req vbmodel.UpdateViber) (*vbmodel.ViberGate, error) {
+	gate, err := v.repo.Select(ctx, req.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	prevToken := gate.BotToken
+	req.ApplyTo(gate)
+
+	if gate.BotToken != prevToken {
+		if v.cfg.Service.PublicURL == "" {
+			return nil, ErrPublicURLNotSet
+		}
+
+		account, err := v.botAPI.GetAccountInfo(ctx, gate.BotToken)
+		if err != nil {
+			return nil, fmt.Errorf("get viber account info: %w", err)
+		}
+		prevBotID := gate.BotID
+		gate.BotID, gate.BotURI = account.ID, account.URI
+
+		// The gate must resolve with the new token before Viber probes the webhook.
+		if err := v.repo.Update(ctx, gate); err != nil {
+			v.log.Error("failed to update viber gate", "id", req.ID, "err", err)
+			return nil, err
+		}
+		if err := v.setWebhook(ctx, gate); err != nil {
+			return nil, err
+		}
+		if prevBotID != gate.BotID {
+			v.removeWebhook(ctx, prevToken, gate.ID)
+		}
+
+		v.log.Info("viber gate updated", "id", gate.ID, "bot_id", gate.BotID)
+		return gate, nil
+	}
+
+	if err := v.repo.Update(ctx, gate); err != nil {
+		v.log.Error("failed to update viber gate", "id", req.ID, "err", err)
+		return nil, err
+	}
+
+	v.log.Info("viber gate updated", "id", gate.ID)
+	return gate, nil
+}
+
+// DeleteGate removes the gate. The bot webhook is removed first on a best-effort
+// basis: a bot whose token was already revoked must still be deletable.
+func (v *ViberService) DeleteGate(ctx context.Context, id string) (*vbmodel.ViberGate, error) {
+	gate, err := v.repo.Select(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	v.removeWebhook(ctx, gate.BotToken, gate.ID)
+
+	if err := v.repo.Delete(ctx, id); err != nil {
+		v.log.Error("failed to delete viber gate", "id", id, "err", err)
+		return nil, err
+	}
+
+	v.log.Warn("viber gate removed", "id", id, "bot_id", gate.BotID)
+	return gate, nil
+}
+
+// setWebhook points the bot callbacks to the gate webhook and stores the URL.
+func (v *ViberService) setWebhook(ctx context.Context, gate *vbmodel.ViberGate) error {
+	url := v.webhookURL(gate.ID)
+	if err := v.botAPI.SetWebhook(ctx, gate.BotToken, url); err != nil {
+		v.log.Error("failed to set viber webhook", "id", gate.ID, "url", url, "err", err)
+		return fmt.Errorf("set viber webhook: %w", err)
+	}
+
+	gate.WebhookURL = url
+	if err := v.repo.Update(ctx, gate); err != nil {
+		v.log.Error("failed to store viber webhook", "id", gate.ID, "err", err)
+		return err
+	}
+	return nil
+}
+
+func (v *ViberService) removeWebhook(ctx context.Context, token, gateID string) {
+	if err := v.botAPI.RemoveWebhook(ctx, token); err != nil {
+		v.log.Warn("failed to remove viber webhook", "id", gateID, "err", err)
+	}
+}
+
+// webhookURL is the callback URL of the gate: the gate ID is the webhook URI segment.
+func (v *ViberService) webhookURL(gateID string) string {
+	return v.cfg.Service.PublicURL + v.cfg.Service.WebhookPath + "/viber/" + gateID
+}
@@ -0,0 +1,232 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"strings"
+	"testing"
+
+	"github.com/webitel/im-providers-service/config"
+	sharedstore "github.com/webitel/im-providers-service/internal/core/store"
+	vbmodel "github.com/webitel/im-providers-service/internal/viber/model"
+	vbstore "github.com/webitel/im-providers-service/internal/viber/store"
+)
+
+var noopLogger = slog.New(slog.NewTextHandler(io.Discard, nil))
+
+// gateTable is the gates table: Insert assigns sequential IDs, Delete keeps a log.
+type gateTable struct {
+	rows    map[string]*vbmodel.ViberGate
+	deleted []string
+}
+
+var _ vbstore.ViberStore = (*gateTable)(nil)
+
+func (t *gateTable) Insert(_ context.Context, dc int64, g *vbmodel.ViberGate) error {
+	g.ID = fmt.Sprintf("gate-%d", len(t.rows)+len(t.deleted)+1)
+	g.DomainID = dc
+	cp := *g
+	t.rows[g.ID] = &cp
+	return nil
+}
+
+func (t *gateTable) Select(_ context.Context, id string) (*vbmodel.ViberGate, error) {
+	g, ok := t.rows[id]
+	if !ok {
+		return nil, sharedstore.ErrNotFound
+	}
+	cp := *g
+	return &cp, nil
+}
+
+func (t *gateTable) Update(_ context.Context, g *vbmodel.ViberGate) error {
+	cp := *g
+	t.rows[g.ID] = &cp
+	return nil
+}
+
+func (t *gateTable) Delete(_ context.Context, id string) error {
+	t.deleted = append(t.deleted, id)
+	delete(t.rows, id)
+	return nil
+}
+
+// viberPlatform plays the Viber side of the Bot API: it knows the bot of each token
+// and, like Viber, probes a webhook URL with a callback before accepting it. The probe
+// only succeeds when the gate the URL points to already resolves.
+type viberPlatform struct {
+	bots     map[string]*vbmodel.Account
+	gates    *gateTable
+	webhooks map[string]string
+	removed  []string
+	// unreachable fails every probe, as when the public URL is behind a firewall.
+	unreachable bool
+}
+
+func (v *viberPlatform) GetAccountInfo(_ context.Context, token string) (*vbmodel.Account, error) {
+	a, ok := v.bots[token]
+	if !ok {
+		return nil, errors.New("invalidAuthToken")
+	}
+	return a, nil
+}
+
+func (v *viberPlatform) SetWebhook(ctx context.Context, token, url string) error {
+	gateID := url[strings.LastIndex(url, "/")+1:]
+	if _, err := v.gates.Select(ctx, gateID); err != nil || v.unreachable {
+		return fmt.Errorf("invalidUrl: callback to %s failed", url)
+	}
+	v.webhooks[token] = url
+	return nil
+}
+
+func (v *viberPlatform) RemoveWebhook(_ context.Context, token string) error {
+	v.removed = append(v.removed, token)
+	delete(v.webhooks, token)
+	return nil
+}
+
+// newViberService returns the service reachable at publicURL, with the "Support"
+// bot behind token-1 and the "Sales" bot behind token-2.
+func newViberService(publicURL string) (*ViberService, *gateTable, *viberPlatform) {
+	gates := &gateTable{rows: map[string]*vbmodel.ViberGate{}}
+	viber := &viberPlatform{
+		bots: map[string]*vbmodel.Account{
+			"token-1": {ID: "pa:1", Name: "Support", URI: "support"},
+			"token-2": {ID: "pa:2", Name: "Sales", URI: "sales"},
+		},
+		gates:    gates,
+		webhooks: map[string]string{},
+	}
+	cfg := &config.Config{Service: config.ServiceConfig{PublicURL: publicURL, WebhookPath: "/wh"}}
+	return NewViberService(gates, viber, cfg, noopLogger), gates, viber
+}
+
+func createRequest() vbmodel.CreateViber {
+	return vbmodel.CreateViber{Name: "Support", Dc: 1, BotToken: "token-1"}
+}
+
+func TestCreateGate_SetsWebhook(t *testing.T) {
+	svc, gates, viber := newViberService("https://im.example.com")
+
+	gate, err := svc.CreateGate(context.Background(), createRequest())
+	if err != nil {
+		t.Fatalf("CreateGate: %v", err)
+	}
+
+	const wantURL = "https://im.example.com/wh/viber/gate-1"
+	if viber.webhooks["token-1"] != wantURL {
+		t.Errorf("webhook = %q, want %q", viber.webhooks["token-1"], wantURL)
+	}
+	stored := gates.rows["gate-1"]
+	if stored == nil || stored.WebhookURL != wantURL || stored.BotID != "pa:1" || stored.BotURI != "support" || !stored.Enabled {
+		t.Errorf("unexpected stored gate: %+v", stored)
+	}
+	if gate.WebhookURL != wantURL {
+		t.Errorf("returned gate webhook = %q", gate.WebhookURL)
+	}
+}
+
+func TestCreateGate_WebhookRejectedRemovesGate(t *testing.T) {
+	svc, gates, viber := newViberService("https://im.example.com")
+	viber.unreachable = true
+
+	if _, err := svc.CreateGate(context.Background(), createRequest()); err == nil {
+		t.Fatal("expected an error")
+	}
+	if len(gates.rows) != 0 || len(gates.deleted) != 1 || len(viber.webhooks) != 0 {
+		t.Errorf("gate without webhook must be removed: gates=%v deleted=%v", gates.rows, gates.deleted)
+	}
+}
+
+func TestCreateGate_Rejected(t *testing.T) {
+	tests := []struct {
+		name      string
+		publicURL string
+		req       vbmodel.CreateViber
+		wantErr   error
+	}{
+		{name: "missing fields", publicURL: "https://im.example.com", req: vbmodel.CreateViber{Dc: 1}},
+		{name: "public url not set", req: createRequest(), wantErr: ErrPublicURLNotSet},
+		{name: "invalid token", publicURL: "https://im.example.com", req: vbmodel.CreateViber{Name: "x", Dc: 1, BotToken: "bad"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc, gates, _ := newViberService(tt.publicURL)
+			_, err := svc.CreateGate(context.Background(), tt.req)
+			if err == nil {
+				t.Fatal("expected an error")
+			}
+			if tt.wantErr != nil && !errors.Is(err, tt.wantErr) {
+				t.Errorf("error = %v, want %v", err, tt.wantErr)
+			}
+			if len(gates.rows) != 0 {
+				t.Errorf("no gate must be stored, got %v", gates.rows)
+			}
+		})
+	}
+}
+
+func TestUpdateGate_NewBotMovesWebhook(t *testing.T) {
+	svc, gates, viber := newViberService("https://im.example.com")
+	if _, err := svc.CreateGate(context.Background(), createRequest()); err != nil {
+		t.Fatalf("CreateGate: %v", err)
+	}
+
+	token := "token-2"
+	gate, err := svc.UpdateGate(context.Background(), vbmodel.UpdateViber{ID: "gate-1", BotToken: &token})
+	if err != nil {
+		t.Fatalf("UpdateGate: %v", err)
+	}
+
+	if gate.BotID != "pa:2" || gates.rows["gate-1"].BotToken != "token-2" {
+		t.Errorf("gate not moved to the new bot: %+v", gates.rows["gate-1"])
+	}
+	if viber.webhooks["token-2"] != "https://im.example.com/wh/viber/gate-1" {
+		t.Errorf("webhook of the new bot not set: %v", viber.webhooks)
+	}
+	if len(viber.removed) != 1 || viber.removed[0] != "token-1" {
+		t.Errorf("webhook of the previous bot not removed: %v", viber.removed)
+	}
+}
+
+func TestUpdateGate_NameOnly(t *testing.T) {
+	svc, gates, viber := newViberService("https://im.example.com")
+	if _, err := svc.CreateGate(context.Background(), createRequest()); err != nil {
+		t.Fatalf("CreateGate: %v", err)
+	}
+	delete(viber.webhooks, "token-1")
+
+	name := "Renamed"
+	if _, err := svc.UpdateGate(context.Background(), vbmodel.UpdateViber{ID: "gate-1", Name: &name}); err != nil {
+		t.Fatalf("UpdateGate: %v", err)
+	}
+	if gates.rows["gate-1"].Name != "Renamed" {
+		t.Errorf("name not updated: %+v", gates.rows["gate-1"])
+	}
+	if len(viber.webhooks) != 0 || len(viber.removed) != 0 {
+		t.Errorf("webhook must be left alone: set=%v removed=%v", viber.webhooks, viber.removed)
+	}
+}
+
+func TestDeleteGate_RemovesWebhook(t *testing.T) {
+	svc, gates, viber := newViberService("https://im.example.com")
+	if _, err := svc.CreateGate(context.Background(), createRequest()); err != nil {
+		t.Fatalf("CreateGate: %v", err)
+	}
+
+	if _, err := svc.DeleteGate(context.Background(), "gate-1"); err != nil {
+		t.Fatalf("DeleteGate: %v", err)
+	}
+	if len(gates.rows) != 0 || len(viber.removed) != 1 {
+		t.Errorf("gate=%v removed webhooks=%v", gates.rows, viber.removed)
+	}
+
+	if _, err := svc.DeleteGate(context.Background(), "gate-1"); !errors.Is(err, sharedstore.ErrNotFound) {
+		t.Errorf("second delete error = %v, want ErrNotFound", err)
+	}
+}
@@ -0,0 +1,45 @@
+package viber
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+)
+
+// signatureHeader carries the hex HMAC-SHA256 of the callback body keyed by the bot auth token.
+// https://developers.viber.com/docs/api/rest-bot-api/#callbacks
+const signatureHeader = "X-Viber-Content-Signature"
+
+// SignatureHeader implements provider.SignatureHeader.
+func (p *viberProvider) SignatureHeader() string { return signatureHeader }
+
+// ValidateSignature implements provider.SignatureValidator.
+// It validates the X-Viber-Content-Signature header sent with every callback.
+func (p *viberProvider) ValidateSignature(ctx context.Context, header string, body []byte) error {
+	if header == "" {
+		return fmt.Errorf("missing %s header", signatureHeader)
+	}
+
+	gate, err := p.resolveGate(ctx)
+	if err != nil {
+		return fmt.Errorf("signature: gate lookup failed: %w", err)
+	}
+	if !gate.Enabled {
+		// Callbacks of a disabled gate are acknowledged and dropped by HandleWebhook.
+		return nil
+	}
+
+	if !validSignature(gate.BotToken, header, body) {
+		return fmt.Errorf("signature mismatch")
+	}
+	return nil
+}
+
+func validSignature(token, signature string, body []byte) bool {
+	mac := hmac.New(sha256.New, []byte(token))
+	mac.Write(body)
+	expected := hex.EncodeToString(mac.Sum(nil))
+	return hmac.Equal([]byte(signature), []byte(expected))
+}
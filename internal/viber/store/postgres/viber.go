@@ -0,0 +1,165 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/georgysavva/scany/v2/pgxscan"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/webitel/im-providers-service/infra/db/pg"
+	sharedmodel "github.com/webitel/im-providers-service/internal/core/model"
+	sharedstore "github.com/webitel/im-providers-service/internal/core/store"
+	vbmodel "github.com/webitel/im-providers-service/internal/viber/model"
+	vbstore "github.com/webitel/im-providers-service/internal/viber/store"
+	"github.com/webitel/im-providers-service/pkg/crypto"
+)
+
+var _ vbstore.ViberStore = (*viberStore)(nil)
+
+type viberStore struct {
+	pool   *pgxpool.Pool
+	crypto crypto.Encryptor
+	cache  sharedstore.GateCache
+}
+
+func NewViberStore(pool *pgxpool.Pool, crypt crypto.Encryptor, cache sharedstore.GateCache) vbstore.ViberStore {
+	return &viberStore{
+		pool:   pool,
+		crypto: crypt,
+		cache:  cache,
+	}
+}
+
+func (s *viberStore) Insert(ctx context.Context, dc int64, g *vbmodel.ViberGate) error {
+	token, err := s.crypto.Encrypt(g.BotToken)
+	if err != nil {
+		return fmt.Errorf("crypto: %w", err)
+	}
+
+	const query = `
+	WITH new_gate AS (
+		INSERT INTO im_provider.gates (dc, name, type, enabled)
+		VALUES ($1, $2, 'viber', $3)
+		RETURNING id, name, created_at, updated_at
+	),
+	new_bot AS (
+		INSERT INTO im_provider.bots (sub, iss, gate_id)
+		SELECT $4, $5, id FROM new_gate
+		RETURNING id
+	)
+	INSERT INTO im_provider.viber (gate_id, bot_id, bot_uri, bot_token, webhook_url)
+	SELECT id, $6, $7, $8, $9 FROM new_gate
+	RETURNING
+		gate_id,
+		(SELECT name FROM new_gate),
+		(SELECT created_at FROM new_gate),
+		(SELECT updated_at FROM new_gate)`
+
+	err = s.pool.QueryRow(ctx, query,
+		dc, g.Name, g.Enabled, g.Peer.Sub, g.Peer.Iss, g.BotID, g.BotURI, token, g.WebhookURL,
+	).Scan(&g.ID, &g.Name, &g.CreatedAt, &g.UpdatedAt)
+	if err != nil {
+		if pg.IsUniqueViolation(err) {
+			return fmt.Errorf("viber bot %s is already bound: %w", g.BotID, sharedstore.ErrConflict)
+		}
+		return fmt.Errorf("postgres: insert viber gateway: %w", err)
+	}
+
+	g.DomainID = dc
+	s.mapVirtualFields(g)
+	return nil
+}
+
+func (s *viberStore) Select(ctx context.Context, id string) (*vbmodel.ViberGate, error) {
+	const query = `
+	SELECT
+		g.id,
+		g.dc AS domain_id,
+		g.name,
+		g.enabled,
+		g.created_at,
+		g.updated_at,
+		b.sub AS "peer.sub",
+		b.iss AS "peer.iss",
+		vb.bot_id,
+		vb.bot_uri,
+		vb.bot_token,
+		vb.webhook_url
+	FROM im_provider.gates g
+	JOIN im_provider.bots b ON g.id = b.gate_id
+	JOIN im_provider.viber vb ON g.id = vb.gate_id
+	WHERE g.id = $1`
+
+	var g vbmodel.ViberGate
+	if err := pgxscan.Get(ctx, s.pool, &g, query, id); err != nil {
+		if pgxscan.NotFound(err) {
+			return nil, sharedstore.ErrNotFound
+		}
+		return nil, fmt.Errorf("postgres: select viber gate: %w", err)
+	}
+
+	if dec, err := s.crypto.Decrypt(g.BotToken); err == nil {
+		g.BotToken = dec
+	}
+
+	s.mapVirtualFields(&g)
+	return &g, nil
+}
+
+func (s *viberStore) Update(ctx context.Context, g *vbmodel.ViberGate) error {
+	token, err := s.crypto.Encrypt(g.BotToken)
+	if err != nil {
+		return fmt.Errorf("crypto: %w", err)
+	}
+
+	err = pgx.BeginFunc(ctx, s.pool, func(tx pgx.Tx) error {
+		const uGate = `UPDATE im_provider.gates SET name = $1, enabled = $2, updated_at = NOW() WHERE id = $3 RETURNING updated_at`
+		if err := tx.QueryRow(ctx, uGate, g.Name, g.Enabled, g.ID).Scan(&g.UpdatedAt); err != nil {
+			return err
+		}
+
+		const uBot = `UPDATE im_provider.bots SET sub = $1, iss = $2 WHERE gate_id = $3`
+		if _, err := tx.Exec(ctx, uBot, g.Peer.Sub, g.Peer.Iss, g.ID); err != nil {
+			return err
+		}
+
+		const uConfig = `
+			UPDATE im_provider.viber
+			SET bot_id = $1, bot_uri = $2, bot_token = $3, webhook_url = $4
+			WHERE gate_id = $5`
+		_, err := tx.Exec(ctx, uConfig, g.BotID, g.BotURI, token, g.WebhookURL, g.ID)
+		return err
+	})
+	if err != nil {
+		if pg.IsUniqueViolation(err) {
+			return fmt.Errorf("viber bot %s is already bound: %w", g.BotID, sharedstore.ErrConflict)
+		}
+		return fmt.Errorf("postgres: update viber gate: %w", err)
+	}
+
+	s.cache.Delete(g.ID)
+	s.mapVirtualFields(g)
+	return nil
+}
+
+func (s *viberStore) Delete(ctx context.Context, id string) error {
+	res, err := s.pool.Exec(ctx, "DELETE FROM im_provider.gates WHERE id = $1 AND type = 'viber'", id)
+	if err != nil {
+		return fmt.Errorf("postgres: delete viber gate: %w", err)
+	}
+	if res.RowsAffected() == 0 {
+		return sharedstore.ErrNotFound
+	}
+
+	s.cache.Delete(id)
+	return nil
+}
+
+func (s *viberStore) mapVirtualFields(g *vbmodel.ViberGate) {
+	if g.Enabled {
+		g.Status = sharedmodel.StatusActive
+	} else {
+		g.Status = sharedmodel.StatusDisabled
+	}
+}
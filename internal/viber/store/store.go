@@ -0,0 +1,16 @@
+package store
+
+import (
+	"context"
+
+	vbmodel "github.com/webitel/im-providers-service/internal/viber/model"
+)
+
+// ViberStore manages Viber bot integrations.
+type ViberStore interface {
+	// Insert creates the gate together with its bot peer and Viber settings.
+	Insert(ctx context.Context, dc int64, g *vbmodel.ViberGate) error
+	Select(ctx context.Context, id string) (*vbmodel.ViberGate, error)
+	Update(ctx context.Context, g *vbmodel.ViberGate) error
+	Delete(ctx context.Context, id string) error
+}
@@ -0,0 +1,222 @@
+package viber
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Callback event types.
+// https://developers.viber.com/docs/api/rest-bot-api/#callbacks
+const (
+	EventWebhook             = "webhook"
+	EventMessage             = "message"
+	EventSubscribed          = "subscribed"
+	EventUnsubscribed        = "unsubscribed"
+	EventConversationStarted = "conversation_started"
+	EventDelivered           = "delivered"
+	EventSeen                = "seen"
+	EventFailed              = "failed"
+)
+
+// Message types, both inbound and outbound.
+// https://developers.viber.com/docs/api/rest-bot-api/#message-types
+const (
+	MessageText      = "text"
+	MessagePicture   = "picture"
+	MessageVideo     = "video"
+	MessageFile      = "file"
+	MessageSticker   = "sticker"
+	MessageContact   = "contact"
+	MessageURL       = "url"
+	MessageLocation  = "location"
+	MessageRichMedia = "rich_media"
+)
+
+// Bot API status codes.
+// https://developers.viber.com/docs/api/rest-bot-api/#error-codes
+const (
+	statusOK                    = 0
+	statusInvalidAuthToken      = 2
+	statusReceiverNotRegistered = 5
+	statusReceiverNotSubscribed = 6
+)
+
+var (
+	// ErrTokenInvalid is returned when Viber rejects the bot auth token.
+	ErrTokenInvalid = errors.New("viber: bot auth token invalid")
+	// ErrNotSubscribed is returned when the receiver is not a subscriber of the bot
+	// and has not opened a conversation with it in the last 24 hours.
+	ErrNotSubscribed = errors.New("viber: receiver is not subscribed")
+)
+
+// APIError is a Bot API reply with a non-zero status.
+type APIError struct {
+	Status  int
+	Message string
+}
+
+func (e *APIError) Error() string {
+	return fmt.Sprintf("bot api status %d: %s", e.Status, e.Message)
+}
+
+// Is matches the sentinel errors of the statuses callers act on.
+func (e *APIError) Is(target error) bool {
+	switch target {
+	case ErrTokenInvalid:
+		return e.Status == statusInvalidAuthToken
+	case ErrNotSubscribed:
+		return e.Status == statusReceiverNotRegistered || e.Status == statusReceiverNotSubscribed
+	}
+	return false
+}
+
+// --- Bot API requests and replies ---
+
+type statusCarrier interface {
+	apiError() *APIError
+}
+
+type apiResponse struct {
+	Status        int    `json:"status"`
+	StatusMessage string `json:"status_message"`
+}
+
+func (r *apiResponse) apiError() *APIError {
+	if r.Status == statusOK {
+		return nil
+	}
+	return &APIError{Status: r.Status, Message: r.StatusMessage}
+}
+
+type accountInfoResponse struct {
+	apiResponse
+	ID      string `json:"id"`
+	Name    string `json:"name"`
+	URI     string `json:"uri"`
+	Webhook string `json:"webhook"`
+}
+
+type sendMessageResponse struct {
+	apiResponse
+	MessageToken int64  `json:"message_token"`
+	ChatHostname string `json:"chat_hostname"`
+}
+
+type setWebhookRequest struct {
+	URL        string   `json:"url"`
+	EventTypes []string `json:"event_types,omitempty"`
+	SendName   bool     `json:"send_name,omitempty"`
+	SendPhoto  bool     `json:"send_photo,omitempty"`
+}
+
+// outboundMessage is the send_message body. Type selects which content fields apply.
+type outboundMessage struct {
+	Receiver      string           `json:"receiver"`
+	MinAPIVersion int              `json:"min_api_version,omitempty"`
+	Sender        messageSender    `json:"sender"`
+	TrackingData  string           `json:"tracking_data,omitempty"`
+	Type          string           `json:"type"`
+	Text          string           `json:"text,omitempty"`
+	Media         string           `json:"media,omitempty"`
+	Size          int64            `json:"size,omitempty"`
+	FileName      string           `json:"file_name,omitempty"`
+	Location      *messageLocation `json:"location,omitempty"`
+	Contact       *messageContact  `json:"contact,omitempty"`
+	RichMedia     *richMedia       `json:"rich_media,omitempty"`
+	Keyboard      *keyboard        `json:"keyboard,omitempty"`
+}
+
+type messageSender struct {
+	Name   string `json:"name"`
+	Avatar string `json:"avatar,omitempty"`
+}
+
+type messageLocation struct {
+	Lat float64 `json:"lat"`
+	Lon float64 `json:"lon"`
+}
+
+type messageContact struct {
+	Name        string `json:"name"`
+	PhoneNumber string `json:"phone_number"`
+	Avatar      string `json:"avatar,omitempty"`
+}
+
+// keyboard is a custom keyboard shown under the message input.
+// https://developers.viber.com/docs/tools/keyboards/
+type keyboard struct {
+	Type          string   `json:"Type"`
+	DefaultHeight bool     `json:"DefaultHeight"`
+	Buttons       []button `json:"Buttons"`
+}
+
+// richMedia is a carousel of button groups, each group being one slide.
+// https://developers.viber.com/docs/api/rest-bot-api/#rich-media-message--carousel-content-message
+type richMedia struct {
+	Type                string   `json:"Type"`
+	ButtonsGroupColumns int      `json:"ButtonsGroupColumns"`
+	ButtonsGroupRows    int      `json:"ButtonsGroupRows"`
+	BgColor             string   `json:"BgColor,omitempty"`
+	Buttons             []button `json:"Buttons"`
+}
+
+// button is a keyboard or rich media button.
+// https://developers.viber.com/docs/tools/keyboards/#buttons-parameters
+type button struct {
+	Columns    int    `json:"Columns"`
+	Rows       int    `json:"Rows"`
+	ActionType string `json:"ActionType"`
+	ActionBody string `json:"ActionBody"`
+	Text       string `json:"Text,omitempty"`
+	TextSize   string `json:"TextSize,omitempty"`
+	TextVAlign string `json:"TextVAlign,omitempty"`
+	TextHAlign string `json:"TextHAlign,omitempty"`
+	Image      string `json:"Image,omitempty"`
+	Silent     bool   `json:"Silent,omitempty"`
+}
+
+// Button action types.
+const (
+	actionReply          = "reply"
+	actionOpenURL        = "open-url"
+	actionSharePhone     = "share-phone"
+	actionLocationPicker = "location-picker"
+	actionNone           = "none"
+)
+
+// --- Callbacks ---
+
+// callback is the body of every webhook request; the fields set depend on Event.
+type callback struct {
+	Event        string          `json:"event"`
+	Timestamp    int64           `json:"timestamp"`
+	MessageToken int64           `json:"message_token"`
+	Sender       *user           `json:"sender,omitempty"`
+	User         *user           `json:"user,omitempty"`
+	UserID       string          `json:"user_id,omitempty"`
+	Message      *inboundMessage `json:"message,omitempty"`
+	Context      string          `json:"context,omitempty"`
+	Subscribed   bool            `json:"subscribed,omitempty"`
+	Desc         string          `json:"desc,omitempty"`
+}
+
+type user struct {
+	ID         string `json:"id"`
+	Name       string `json:"name"`
+	Avatar     string `json:"avatar"`
+	Country    string `json:"country"`
+	Language   string `json:"language"`
+	APIVersion int    `json:"api_version"`
+}
+
+type inboundMessage struct {
+	Type         string           `json:"type"`
+	Text         string           `json:"text"`
+	Media        string           `json:"media"`
+	Location     *messageLocation `json:"location,omitempty"`
+	Contact      *messageContact  `json:"contact,omitempty"`
+	TrackingData string           `json:"tracking_data"`
+	FileName     string           `json:"file_name"`
+	FileSize     int64            `json:"file_size"`
+	StickerID    int64            `json:"sticker_id"`
+}
@@ -0,0 +1,77 @@
+package viber
+
+import (
+	"context"
+
+	gatewayv1 "github.com/webitel/im-providers-service/gen/go/gateway/v1"
+	sharedmodel "github.com/webitel/im-providers-service/internal/core/model"
+	"github.com/webitel/im-providers-service/internal/provider/contactsync"
+	vbmodel "github.com/webitel/im-providers-service/internal/viber/model"
+)
+
+// Contact metadata keys filled from the Viber user details.
+const (
+	metadataAvatar   = "avatar"
+	metadataCountry  = "country"
+	metadataLanguage = "language"
+)
+
+// syncContact resolves the internal contact for a Viber user, creating it
+// if necessary. The result is cached so repeated callbacks from the same
+// user skip the gateway round-trip.
+func (p *viberProvider) syncContact(ctx context.Context, gate *vbmodel.ViberGate, u *user) (*gatewayv1.Contact, error) {
+	external := toExternalUser(u)
+	key := contactsync.KnownUser(gate.ID, external)
+
+	if known, _ := p.userCache.IsKnown(ctx, key); known {
+		return &gatewayv1.Contact{Sub: u.ID}, nil
+	}
+
+	authCtx := contactsync.WithGatewayIdentity(ctx, gate.DomainID, gate.Peer)
+
+	contact, err := p.ensureContact(authCtx, external, u)
+	if err != nil {
+		return nil, err
+	}
+
+	contactsync.EnsureVia(authCtx, p.gatewayer, p.logger, gate.ID, u.ID, contact.Iss)
+	_ = p.userCache.MarkKnown(ctx, key)
+	if u.Language != "" {
+		_ = p.userCache.SetLocale(ctx, gate.ID, u.ID, u.Language)
+	}
+
+	return contact, nil
+}
+
+// ensureContact creates the internal contact or returns a stub when the
+// contact already exists (idempotent by design on the gateway side).
+func (p *viberProvider) ensureContact(ctx context.Context, external *sharedmodel.ExternalUser, u *user) (*gatewayv1.Contact, error) {
+	metadata := make(map[string]string, 3)
+	for k, v := range map[string]string{
+		metadataAvatar:   u.Avatar,
+		metadataCountry:  u.Country,
+		metadataLanguage: u.Language,
+	} {
+		if v != "" {
+			metadata[k] = v
+		}
+	}
+
+	return contactsync.CreateContact(ctx, p.gatewayer, &gatewayv1.CreateContactRequest{
+		IssId:    p.Type(),
+		Type:     p.Type(),
+		Name:     external.FirstName,
+		Subject:  external.ID,
+		Metadata: metadata,
+	})
+}
+
+// toExternalUser maps a Viber user to the domain cache key.
+// Users who hide their name fall back to the user ID as the display name.
+func toExternalUser(u *user) *sharedmodel.ExternalUser {
+	name := u.Name
+	if name == "" {
+		name = u.ID
+	}
+	return &sharedmodel.ExternalUser{ID: u.ID, FirstName: name}
+}
@@ -0,0 +1,156 @@
+package viber
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	sharedmodel "github.com/webitel/im-providers-service/internal/core/model"
+	"github.com/webitel/im-providers-service/internal/provider/contactsync"
+	vbmodel "github.com/webitel/im-providers-service/internal/viber/model"
+)
+
+func (p *viberProvider) HandleWebhook(ctx context.Context, data []byte) error {
+	var cb callback
+	if err := json.Unmarshal(data, &cb); err != nil {
+		p.logger.Warn("malformed callback dropped", "err", err)
+		return nil
+	}
+
+	gate, err := p.resolveGate(ctx)
+	if err != nil || !gate.Enabled {
+		return err
+	}
+
+	switch cb.Event {
+	case EventMessage:
+		if err := p.processMessage(ctx, gate, &cb); err != nil {
+			p.logger.Error("message dropped", "message_token", cb.MessageToken, "err", err)
+		}
+	case EventSubscribed, EventConversationStarted:
+		// The user can be messaged from now on, so the contact is linked up front.
+		if cb.User == nil {
+			return nil
+		}
+		if _, err := p.syncContact(ctx, gate, cb.User); err != nil {
+			p.logger.Warn("sync contact failed", "event", cb.Event, "user_id", cb.User.ID, "err", err)
+		}
+	case EventFailed:
+		p.logger.Warn("message delivery failed", "message_token", cb.MessageToken, "user_id", cb.UserID, "desc", cb.Desc)
+	default:
+		// webhook, unsubscribed, delivered and seen need no action.
+		p.logger.Debug("callback acknowledged", "event", cb.Event, "message_token", cb.MessageToken)
+	}
+	return nil
+}
+
+// processMessage is the per-message pipeline:
+//
+//	sync contact → route content
+func (p *viberProvider) processMessage(ctx context.Context, gate *vbmodel.ViberGate, cb *callback) error {
+	if cb.Sender == nil || cb.Sender.ID == "" || cb.Message == nil {
+		return nil
+	}
+
+	if _, err := p.syncContact(ctx, gate, cb.Sender); err != nil {
+		return fmt.Errorf("sync contact [user_id=%s]: %w", cb.Sender.ID, err)
+	}
+
+	peers := contactsync.InboundPeers(gate.ID, gate.Peer, cb.Sender.ID)
+	externalID := strconv.FormatInt(cb.MessageToken, 10)
+	msg := cb.Message
+
+	switch msg.Type {
+	case MessageText:
+		p.routeText(ctx, gate, peers, msg)
+	case MessagePicture, MessageVideo, MessageFile, MessageSticker:
+		p.handleMedia(ctx, gate, peers, msg)
+	case MessageURL:
+		p.sendText(ctx, gate, peers, msg.Media)
+	case MessageLocation:
+		p.sendLocation(ctx, gate, peers, msg, externalID)
+	case MessageContact:
+		p.sendContact(ctx, gate, peers, msg, externalID)
+	default:
+		p.logger.Warn("unsupported message type, skipping", "type", msg.Type)
+	}
+	return nil
+}
+
+// routeText emits a keyboard reply as an interactive callback and anything else as text.
+// A reply button posts its ActionBody as the message text; the tracking data of the
+// keyboard message comes back with it and tells whether the text matches a button.
+func (p *viberProvider) routeText(ctx context.Context, gate *vbmodel.ViberGate, peers contactsync.Peers, msg *inboundMessage) {
+	tracking, ok := decodeTracking(msg.TrackingData)
+	if ok {
+		if buttonCode, tapped := tracking.Buttons[msg.Text]; tapped {
+			if err := p.messenger.SendInteractiveCallback(ctx, &sharedmodel.SendInteractiveCallbackRequest{
+				DomainID:     gate.DomainID,
+				From:         peers.From,
+				To:           peers.To,
+				InReplyTo:    tracking.MessageID,
+				ButtonCode:   buttonCode,
+				CallbackData: msg.Text,
+			}); err != nil {
+				p.logger.Error("send interactive callback failed", "in_reply_to", tracking.MessageID, "payload", msg.Text, "err", err)
+			}
+			return
+		}
+	}
+
+	p.sendText(ctx, gate, peers, msg.Text)
+}
+
+func (p *viberProvider) sendText(ctx context.Context, gate *vbmodel.ViberGate, peers contactsync.Peers, text string) {
+	if text == "" {
+		return
+	}
+	if _, err := p.messenger.SendText(ctx, &sharedmodel.SendTextRequest{
+		DomainID: gate.DomainID,
+		From:     peers.From,
+		To:       peers.To,
+		Body:     text,
+	}); err != nil {
+		p.logger.Error("send text failed", "err", err)
+	}
+}
+
+func (p *viberProvider) sendLocation(ctx context.Context, gate *vbmodel.ViberGate, peers contactsync.Peers, msg *inboundMessage, externalID string) {
+	if msg.Location == nil {
+		return
+	}
+	if _, err := p.messenger.SendLocation(ctx, &sharedmodel.SendLocationRequest{
+		DomainID:   int(gate.DomainID),
+		From:       peers.From,
+		To:         peers.To,
+		Latitude:   msg.Location.Lat,
+		Longitude:  msg.Location.Lon,
+		ExternalID: externalID,
+	}); err != nil {
+		p.logger.Error("send location failed", "err", err)
+	}
+}
+
+func (p *viberProvider) sendContact(ctx context.Context, gate *vbmodel.ViberGate, peers contactsync.Peers, msg *inboundMessage, externalID string) {
+	if msg.Contact == nil {
+		return
+	}
+
+	req := &sharedmodel.SendContactRequest{
+		DomainID:   int(gate.DomainID),
+		From:       peers.From,
+		To:         peers.To,
+		ExternalID: externalID,
+	}
+	if msg.Contact.Name != "" {
+		req.Name = &msg.Contact.Name
+	}
+	if msg.Contact.PhoneNumber != "" {
+		req.PhoneNumber = &msg.Contact.PhoneNumber
+	}
+
+	if _, err := p.messenger.SendContact(ctx, req); err != nil {
+		p.logger.Error("send contact failed", "err", err)
+	}
+}
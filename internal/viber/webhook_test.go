@@ -0,0 +1,243 @@
+package viber
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	sharedstore "github.com/webitel/im-providers-service/internal/core/store"
+)
+
+func sign(token string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(token))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func TestValidateSignature(t *testing.T) {
+	body := []byte(`{"event":"webhook","timestamp":1457764197627,"message_token":241256543215}`)
+
+	tests := []struct {
+		name    string
+		gateID  string
+		header  string
+		wantErr bool
+	}{
+		{name: "valid", gateID: testGateID, header: sign(testToken, body)},
+		{name: "missing header", gateID: testGateID, wantErr: true},
+		{name: "other token", gateID: testGateID, header: sign("other-token", body), wantErr: true},
+		{name: "unknown gate", gateID: "0190a8a4-0000-7000-8000-000000000000", header: sign(testToken, body), wantErr: true},
+		{name: "malformed uri", gateID: "not-a-gate", header: sign(testToken, body), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := newConnectedBot(t)
+			err := p.ValidateSignature(webhookContext(tt.gateID), tt.header, body)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ValidateSignature() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestResolveGate_MalformedURIIsNotFound(t *testing.T) {
+	p := newConnectedBot(t)
+	if _, err := p.resolveGate(webhookContext("../gates")); !errors.Is(err, sharedstore.ErrNotFound) {
+		t.Fatalf("resolveGate() error = %v, want ErrNotFound", err)
+	}
+}
+
+func TestHandleWebhook_Text(t *testing.T) {
+	p := newConnectedBot(t)
+
+	body := []byte(`{
+		"event": "message",
+		"timestamp": 1457764197627,
+		"message_token": 4912661846655238145,
+		"sender": {"id": "` + testUserID + `", "name": "John McClane", "language": "en"},
+		"message": {"type": "text", "text": "a message to the service"}
+	}`)
+	if err := p.HandleWebhook(webhookContext(testGateID), body); err != nil {
+		t.Fatalf("HandleWebhook: %v", err)
+	}
+
+	if len(p.messenger.Texts()) != 1 {
+		t.Fatalf("expected 1 text, got %d", len(p.messenger.Texts()))
+	}
+	got := p.messenger.Texts()[0]
+	if got.Body != "a message to the service" || got.DomainID != 1 {
+		t.Errorf("unexpected text request: %+v", got)
+	}
+	if got.From.Sub != testUserID || got.From.Iss != "viber" {
+		t.Errorf("unexpected sender peer: %+v", got.From)
+	}
+	if got.To.Sub != "bot-1" || got.To.Via == nil || *got.To.Via != testGateID {
+		t.Errorf("unexpected recipient peer: %+v", got.To)
+	}
+}
+
+func TestHandleWebhook_KeyboardReply(t *testing.T) {
+	tracking := `{\"m\":\"0190a8a4-aaaa-7000-8000-000000000001\",\"b\":{\"YES\":\"btn-yes\"}}`
+
+	tests := []struct {
+		name          string
+		text          string
+		wantCallbacks int
+		wantTexts     int
+	}{
+		{name: "button tapped", text: "YES", wantCallbacks: 1},
+		{name: "typed over keyboard", text: "maybe", wantTexts: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := newConnectedBot(t)
+
+			body := []byte(`{
+				"event": "message",
+				"message_token": 1,
+				"sender": {"id": "` + testUserID + `"},
+				"message": {"type": "text", "text": "` + tt.text + `", "tracking_data": "` + tracking + `"}
+			}`)
+			if err := p.HandleWebhook(webhookContext(testGateID), body); err != nil {
+				t.Fatalf("HandleWebhook: %v", err)
+			}
+
+			if len(p.messenger.Callbacks()) != tt.wantCallbacks || len(p.messenger.Texts()) != tt.wantTexts {
+				t.Fatalf("callbacks = %d, texts = %d", len(p.messenger.Callbacks()), len(p.messenger.Texts()))
+			}
+			if tt.wantCallbacks == 0 {
+				return
+			}
+			cb := p.messenger.Callbacks()[0]
+			if cb.InReplyTo != "0190a8a4-aaaa-7000-8000-000000000001" || cb.ButtonCode != "btn-yes" || cb.CallbackData != "YES" {
+				t.Errorf("unexpected callback: %+v", cb)
+			}
+		})
+	}
+}
+
+func TestHandleWebhook_LocationAndContact(t *testing.T) {
+	p := newConnectedBot(t)
+	ctx := webhookContext(testGateID)
+
+	location := []byte(`{
+		"event": "message",
+		"message_token": 11,
+		"sender": {"id": "` + testUserID + `"},
+		"message": {"type": "location", "location": {"lat": 50.4501, "lon": 30.5234}}
+	}`)
+	contact := []byte(`{
+		"event": "message",
+		"message_token": 12,
+		"sender": {"id": "` + testUserID + `"},
+		"message": {"type": "contact", "contact": {"name": "Jane", "phone_number": "+380501234567"}}
+	}`)
+	for _, body := range [][]byte{location, contact} {
+		if err := p.HandleWebhook(ctx, body); err != nil {
+			t.Fatalf("HandleWebhook: %v", err)
+		}
+	}
+
+	if len(p.messenger.Locations()) != 1 {
+		t.Fatalf("expected 1 location, got %d", len(p.messenger.Locations()))
+	}
+	if loc := p.messenger.Locations()[0]; loc.Latitude != 50.4501 || loc.Longitude != 30.5234 || loc.ExternalID != "11" {
+		t.Errorf("unexpected location: %+v", loc)
+	}
+
+	if len(p.messenger.Contacts()) != 1 {
+		t.Fatalf("expected 1 contact, got %d", len(p.messenger.Contacts()))
+	}
+	c := p.messenger.Contacts()[0]
+	if c.Name == nil || *c.Name != "Jane" || c.PhoneNumber == nil || *c.PhoneNumber != "+380501234567" {
+		t.Errorf("unexpected contact: %+v", c)
+	}
+}
+
+func TestHandleWebhook_Media(t *testing.T) {
+	media := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/pdf")
+		_, _ = w.Write([]byte("%PDF"))
+	}))
+	t.Cleanup(media.Close)
+
+	p := newConnectedBot(t)
+	ctx := webhookContext(testGateID)
+
+	picture := []byte(`{
+		"event": "message",
+		"message_token": 21,
+		"sender": {"id": "` + testUserID + `"},
+		"message": {"type": "picture", "text": "look", "media": "` + media.URL + `/photo.jpg"}
+	}`)
+	file := []byte(`{
+		"event": "message",
+		"message_token": 22,
+		"sender": {"id": "` + testUserID + `"},
+		"message": {"type": "file", "media": "` + media.URL + `/doc?sig=1", "file_name": "invoice.pdf", "file_size": 4}
+	}`)
+	for _, body := range [][]byte{picture, file} {
+		if err := p.HandleWebhook(ctx, body); err != nil {
+			t.Fatalf("HandleWebhook: %v", err)
+		}
+	}
+
+	if len(p.media.Uploads()) != 2 || p.media.Uploads()[1].Body != "%PDF" || p.media.Uploads()[1].Req.Name != "invoice.pdf" {
+		t.Fatalf("unexpected uploads: %+v", p.media.Uploads())
+	}
+	if len(p.messenger.Images()) != 1 || p.messenger.Images()[0].Image.Body != "look" {
+		t.Fatalf("unexpected images: %+v", p.messenger.Images())
+	}
+	if len(p.messenger.Documents()) != 1 {
+		t.Fatalf("expected 1 document, got %d", len(p.messenger.Documents()))
+	}
+	doc := p.messenger.Documents()[0].Document.Documents[0]
+	if doc.ID != "file-2" || doc.FileName != "invoice.pdf" || doc.MimeType != "application/pdf" || doc.Size != 4 {
+		t.Errorf("unexpected document: %+v", doc)
+	}
+}
+
+func TestHandleWebhook_DisabledGateDropsMessages(t *testing.T) {
+	p := newConnectedBot(t)
+	p.gate.Enabled = false
+
+	body := []byte(`{"event":"message","sender":{"id":"` + testUserID + `"},"message":{"type":"text","text":"hi"}}`)
+	for range 2 {
+		if err := p.HandleWebhook(webhookContext(testGateID), body); err != nil {
+			t.Fatalf("HandleWebhook: %v", err)
+		}
+	}
+	if len(p.messenger.Texts()) != 0 {
+		t.Fatalf("disabled gate forwarded %d texts", len(p.messenger.Texts()))
+	}
+}
+
+func TestMediaFileName(t *testing.T) {
+	tests := []struct {
+		msg      inboundMessage
+		wantName string
+		wantExt  string
+	}{
+		{msg: inboundMessage{Type: MessageFile, FileName: "report.xlsx"}, wantName: "report.xlsx"},
+		{msg: inboundMessage{Type: MessagePicture}, wantExt: ".jpg"},
+		{msg: inboundMessage{Type: MessageFile, Media: "https://dl.viber.com/a/b.pdf?x=1"}, wantExt: ".pdf"},
+		{msg: inboundMessage{Type: MessageFile, Media: "https://dl.viber.com/a/b"}, wantExt: ".bin"},
+	}
+
+	for _, tt := range tests {
+		got := mediaFileName(&tt.msg)
+		if tt.wantName != "" && got != tt.wantName {
+			t.Errorf("mediaFileName(%+v) = %q, want %q", tt.msg, got, tt.wantName)
+		}
+		if tt.wantExt != "" && !strings.HasSuffix(got, tt.wantExt) {
+			t.Errorf("mediaFileName(%+v) = %q, want %s extension", tt.msg, got, tt.wantExt)
+		}
+	}
+}
@@ -0,0 +1,52 @@
+package vk
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// ValidateRequest implements provider.RequestValidator. VK sends the secret key of
+// the callback server in the body of every request, the confirmation one included.
+func (p *vkProvider) ValidateRequest(ctx context.Context, _ http.Header, body []byte) error {
+	gate, err := p.resolveGate(ctx)
+	if err != nil {
+		return fmt.Errorf("secret: gate lookup failed: %w", err)
+	}
+	if !gate.Enabled {
+		// Events of a disabled gate are acknowledged and dropped by HandleWebhook.
+		return nil
+	}
+
+	var cb callback
+	if err := json.Unmarshal(body, &cb); err != nil {
+		return fmt.Errorf("malformed callback: %w", err)
+	}
+	if cb.GroupID != gate.GroupID {
+		return fmt.Errorf("callback of community %d delivered to the gate of community %d", cb.GroupID, gate.GroupID)
+	}
+	if subtle.ConstantTimeCompare([]byte(cb.Secret), []byte(gate.SecretKey)) != 1 {
+		return fmt.Errorf("secret key mismatch")
+	}
+	return nil
+}
+
+// Handshake implements provider.Handshaker: VK confirms a callback server by
+// expecting the confirmation code of the community back. A disabled gate is not
+// confirmed.
+func (p *vkProvider) Handshake(ctx context.Context, payload []byte) (string, bool) {
+	var cb callback
+	if json.Unmarshal(payload, &cb) != nil || cb.Type != EventConfirmation {
+		return "", false
+	}
+	gate, err := p.resolveGate(ctx)
+	if err != nil || !gate.Enabled {
+		return "", false
+	}
+	return gate.ConfirmationCode, true
+}
+
+// Acknowledgement implements provider.Acknowledger.
+func (p *vkProvider) Acknowledgement() string { return acknowledgement }
@@ -0,0 +1,307 @@
+package vk
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"mime/multipart"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	vkmodel "github.com/webitel/im-providers-service/internal/vk/model"
+)
+
+// APIBaseURL is the host of the VK API methods.
+// https://dev.vk.com/en/api/api-requests
+const APIBaseURL = "https://api.vk.com"
+
+// userFields are the profile fields requested for contact sync.
+const userFields = "screen_name,photo_200"
+
+// communityAPI is the contract used by vkProvider and the gate service to talk to the VK API.
+// Keeping it as an interface allows both to be tested without network calls.
+type communityAPI interface {
+	GetGroup(ctx context.Context, token string) (*vkmodel.Group, error)
+	GetConfirmationCode(ctx context.Context, token string, groupID int64) (string, error)
+	AddCallbackServer(ctx context.Context, token string, groupID int64, url, title, secretKey string) (int64, error)
+	SetCallbackSettings(ctx context.Context, token string, groupID, serverID int64) error
+	DeleteCallbackServer(ctx context.Context, token string, groupID, serverID int64) error
+	GetUser(ctx context.Context, token string, userID int64) (*vkmodel.Profile, error)
+	Send(ctx context.Context, token string, params url.Values) (string, error)
+	SendEventAnswer(ctx context.Context, token, eventID string, userID, peerID int64) error
+	UploadPhoto(ctx context.Context, token string, peerID int64, name string, body io.Reader) (string, error)
+	UploadDocument(ctx context.Context, token string, peerID int64, name string, body io.Reader) (string, error)
+}
+
+type apiClient struct {
+	client *http.Client
+	logger *slog.Logger
+	apiURL string
+}
+
+var _ communityAPI = (*apiClient)(nil)
+
+func newAPIClient(l *slog.Logger) *apiClient {
+	return &apiClient{
+		client: &http.Client{Timeout: 30 * time.Second},
+		logger: l.With("component", "vk.api"),
+		apiURL: APIBaseURL,
+	}
+}
+
+// GetGroup returns the community the access token belongs to.
+// https://dev.vk.com/en/method/groups.getById
+func (c *apiClient) GetGroup(ctx context.Context, token string) (*vkmodel.Group, error) {
+	var resp groupsResponse
+	if err := c.call(ctx, token, "groups.getById", url.Values{}, &resp); err != nil {
+		return nil, err
+	}
+	if len(resp.Groups) == 0 {
+		return nil, fmt.Errorf("vk groups.getById: token belongs to no community")
+	}
+	g := resp.Groups[0]
+	return &vkmodel.Group{ID: g.ID, Name: g.Name, ScreenName: g.ScreenName}, nil
+}
+
+// GetConfirmationCode returns the code the callback server answers the confirmation request with.
+// https://dev.vk.com/en/method/groups.getCallbackConfirmationCode
+func (c *apiClient) GetConfirmationCode(ctx context.Context, token string, groupID int64) (string, error) {
+	var resp confirmationCodeResponse
+	params := url.Values{"group_id": {strconv.FormatInt(groupID, 10)}}
+	if err := c.call(ctx, token, "groups.getCallbackConfirmationCode", params, &resp); err != nil {
+		return "", err
+	}
+	return resp.Code, nil
+}
+
+// AddCallbackServer adds a callback server to the community and returns its ID.
+// https://dev.vk.com/en/method/groups.addCallbackServer
+func (c *apiClient) AddCallbackServer(ctx context.Context, token string, groupID int64, serverURL, title, secretKey string) (int64, error) {
+	var resp callbackServerResponse
+	params := url.Values{
+		"group_id":   {strconv.FormatInt(groupID, 10)},
+		"url":        {serverURL},
+		"title":      {title},
+		"secret_key": {secretKey},
+	}
+	if err := c.call(ctx, token, "groups.addCallbackServer", params, &resp); err != nil {
+		return 0, err
+	}
+	return resp.ServerID, nil
+}
+
+// SetCallbackSettings subscribes the callback server to the message events.
+// https://dev.vk.com/en/method/groups.setCallbackSettings
+func (c *apiClient) SetCallbackSettings(ctx context.Context, token string, groupID, serverID int64) error {
+	params := url.Values{
+		"group_id":      {strconv.FormatInt(groupID, 10)},
+		"server_id":     {strconv.FormatInt(serverID, 10)},
+		"api_version":   {APIVersion},
+		"message_new":   {"1"},
+		"message_event": {"1"},
+		"message_allow": {"1"},
+		"message_deny":  {"1"},
+	}
+	return c.call(ctx, token, "groups.setCallbackSettings", params, nil)
+}
+
+// DeleteCallbackServer removes the callback server from the community.
+// https://dev.vk.com/en/method/groups.deleteCallbackServer
+func (c *apiClient) DeleteCallbackServer(ctx context.Context, token string, groupID, serverID int64) error {
+	params := url.Values{
+		"group_id":  {strconv.FormatInt(groupID, 10)},
+		"server_id": {strconv.FormatInt(serverID, 10)},
+	}
+	return c.call(ctx, token, "groups.deleteCallbackServer", params, nil)
+}
+
+// GetUser returns the profile of a user.
+// https://dev.vk.com/en/method/users.get
+func (c *apiClient) GetUser(ctx context.Context, token string, userID int64) (*vkmodel.Profile, error) {
+	var resp []userResponse
+	params := url.Values{"user_ids": {strconv.FormatInt(userID, 10)}, "fields": {userFields}}
+	if err := c.call(ctx, token, "users.get", params, &resp); err != nil {
+		return nil, err
+	}
+	if len(resp) == 0 {
+		return nil, fmt.Errorf("vk users.get: user %d not found", userID)
+	}
+	u := resp[0]
+	return &vkmodel.Profile{
+		UserID:     u.ID,
+		FirstName:  u.FirstName,
+		LastName:   u.LastName,
+		ScreenName: u.ScreenName,
+		PhotoURL:   u.Photo200,
+	}, nil
+}
+
+// Send sends a message and returns its ID. The caller sets the recipient, the
+// random_id and the content.
+// https://dev.vk.com/en/method/messages.send
+func (c *apiClient) Send(ctx context.Context, token string, params url.Values) (string, error) {
+	var id int64
+	if err := c.call(ctx, token, "messages.send", params, &id); err != nil {
+		return "", err
+	}
+	return strconv.FormatInt(id, 10), nil
+}
+
+// SendEventAnswer answers a tap on a callback button, so the button stops loading.
+// https://dev.vk.com/en/method/messages.sendMessageEventAnswer
+func (c *apiClient) SendEventAnswer(ctx context.Context, token, eventID string, userID, peerID int64) error {
+	params := url.Values{
+		"event_id": {eventID},
+		"user_id":  {strconv.FormatInt(userID, 10)},
+		"peer_id":  {strconv.FormatInt(peerID, 10)},
+	}
+	return c.call(ctx, token, "messages.sendMessageEventAnswer", params, nil)
+}
+
+// UploadPhoto uploads a photo for a message to the peer and returns its attachment.
+// https://dev.vk.com/en/api/upload/photo-in-message
+func (c *apiClient) UploadPhoto(ctx context.Context, token string, peerID int64, name string, body io.Reader) (string, error) {
+	var server uploadServerResponse
+	if err := c.call(ctx, token, "photos.getMessagesUploadServer", url.Values{"peer_id": {strconv.FormatInt(peerID, 10)}}, &server); err != nil {
+		return "", err
+	}
+
+	var uploaded photoUploadResponse
+	if err := c.upload(ctx, server.UploadURL, "photo", name, body, &uploaded); err != nil {
+		return "", err
+	}
+	if uploaded.Photo == "" || uploaded.Photo == "[]" {
+		return "", fmt.Errorf("vk upload photo: rejected by the upload server")
+	}
+
+	var saved []savedPhoto
+	params := url.Values{
+		"photo":  {uploaded.Photo},
+		"server": {strconv.FormatInt(uploaded.Server, 10)},
+		"hash":   {uploaded.Hash},
+	}
+	if err := c.call(ctx, token, "photos.saveMessagesPhoto", params, &saved); err != nil {
+		return "", err
+	}
+	if len(saved) == 0 {
+		return "", fmt.Errorf("vk photos.saveMessagesPhoto: no photo saved")
+	}
+
+	p := saved[0]
+	ref := fmt.Sprintf("photo%d_%d", p.OwnerID, p.ID)
+	if p.AccessKey != "" {
+		ref += "_" + p.AccessKey
+	}
+	return ref, nil
+}
+
+// UploadDocument uploads a document for a message to the peer and returns its attachment.
+// https://dev.vk.com/en/api/upload/document-in-message
+func (c *apiClient) UploadDocument(ctx context.Context, token string, peerID int64, name string, body io.Reader) (string, error) {
+	var server uploadServerResponse
+	params := url.Values{"type": {"doc"}, "peer_id": {strconv.FormatInt(peerID, 10)}}
+	if err := c.call(ctx, token, "docs.getMessagesUploadServer", params, &server); err != nil {
+		return "", err
+	}
+
+	var uploaded docUploadResponse
+	if err := c.upload(ctx, server.UploadURL, "file", name, body, &uploaded); err != nil {
+		return "", err
+	}
+	if uploaded.File == "" {
+		return "", fmt.Errorf("vk upload document: rejected by the upload server: %s", uploaded.Error)
+	}
+
+	var saved savedDoc
+	if err := c.call(ctx, token, "docs.save", url.Values{"file": {uploaded.File}, "title": {name}}, &saved); err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("doc%d_%d", saved.Doc.OwnerID, saved.Doc.ID), nil
+}
+
+// call invokes the API method with the form parameters and decodes the response
+// object into out, if set.
+func (c *apiClient) call(ctx context.Context, token, method string, params url.Values, out any) error {
+	form := url.Values{}
+	for k, v := range params {
+		form[k] = v
+	}
+	form.Set("access_token", token)
+	form.Set("v", APIVersion)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.apiURL+"/method/"+method, strings.NewReader(form.Encode()))
+	if err != nil {
+		return fmt.Errorf("vk %s: %w", method, err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("vk %s: %w", method, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("vk %s: status %s", method, resp.Status)
+	}
+
+	// The API answers errors with 200 and an error object.
+	var envelope apiResponse
+	if err := json.NewDecoder(resp.Body).Decode(&envelope); err != nil {
+		return fmt.Errorf("vk %s: decode response: %w", method, err)
+	}
+	if envelope.Error != nil {
+		c.logger.WarnContext(ctx, "api request rejected", "method", method, "code", envelope.Error.Code, "message", envelope.Error.Message)
+		return fmt.Errorf("vk %s: %w", method, envelope.Error)
+	}
+	if out == nil {
+		return nil
+	}
+	if err := json.Unmarshal(envelope.Response, out); err != nil {
+		return fmt.Errorf("vk %s: decode response: %w", method, err)
+	}
+	return nil
+}
+
+// upload posts the file as a multipart form field to an upload server and decodes
+// the reply into out.
+func (c *apiClient) upload(ctx context.Context, uploadURL, field, name string, body io.Reader, out any) error {
+	var buf bytes.Buffer
+	mw := multipart.NewWriter(&buf)
+	part, err := mw.CreateFormFile(field, name)
+	if err != nil {
+		return fmt.Errorf("vk upload: %w", err)
+	}
+	if _, err := io.Copy(part, body); err != nil {
+		return fmt.Errorf("vk upload: %w", err)
+	}
+	if err := mw.Close(); err != nil {
+		return fmt.Errorf("vk upload: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, uploadURL, &buf)
+	if err != nil {
+		return fmt.Errorf("vk upload: %w", err)
+	}
+	req.Header.Set("Content-Type", mw.FormDataContentType())
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("vk upload: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("vk upload: status %s", resp.Status)
+	}
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fmt.Errorf("vk upload: decode response: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,130 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	impb "github.com/webitel/im-providers-service/gen/go/provider/v1"
+	"github.com/webitel/im-providers-service/internal/provider/gaterpc"
+	vkmodel "github.com/webitel/im-providers-service/internal/vk/model"
+	vkservice "github.com/webitel/im-providers-service/internal/vk/service"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type VKHandler struct {
+	logger *slog.Logger
+	srv    vkservice.VKManager
+	impb.UnimplementedVKServiceServer
+}
+
+func NewVKHandler(logger *slog.Logger, srv vkservice.VKManager) *VKHandler {
+	return &VKHandler{logger: logger, srv: srv}
+}
+
+func (h *VKHandler) CreateVKGate(ctx context.Context, req *impb.ProviderCreateVKGateRequest) (*impb.ProviderCreateVKGateResponse, error) {
+	domainID, err := gaterpc.DomainID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	create := vkmodel.CreateVK{
+		Name:        req.GetName(),
+		Dc:          domainID,
+		AccessToken: req.GetAccessToken(),
+	}
+	if peer := gaterpc.Peer(req.GetPeer()); peer != nil {
+		create.Peer = *peer
+	}
+
+	gate, err := h.srv.CreateGate(ctx, create)
+	if err != nil {
+		return nil, toStatus(err, "create gate")
+	}
+
+	return &impb.ProviderCreateVKGateResponse{Item: gateToProto(gate)}, nil
+}
+
+func (h *VKHandler) GetVKGate(ctx context.Context, req *impb.ProviderGetVKGateRequest) (*impb.ProviderGetVKGateResponse, error) {
+	gate, err := h.gate(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	return &impb.ProviderGetVKGateResponse{Item: gateToProto(gate)}, nil
+}
+
+func (h *VKHandler) UpdateVKGate(ctx context.Context, req *impb.ProviderUpdateVKGateRequest) (*impb.ProviderUpdateVKGateResponse, error) {
+	if _, err := h.gate(ctx, req.GetId()); err != nil {
+		return nil, err
+	}
+
+	gate, err := h.srv.UpdateGate(ctx, vkmodel.UpdateVK{
+		ID:          req.GetId(),
+		Name:        req.Name,
+		AccessToken: req.AccessToken,
+		Enabled:     req.Enabled,
+		Peer:        gaterpc.Peer(req.GetPeer()),
+	})
+	if err != nil {
+		return nil, toStatus(err, "update gate")
+	}
+
+	return &impb.ProviderUpdateVKGateResponse{Item: gateToProto(gate)}, nil
+}
+
+func (h *VKHandler) DeleteVKGate(ctx context.Context, req *impb.ProviderDeleteVKGateRequest) (*impb.ProviderDeleteVKGateResponse, error) {
+	if _, err := h.gate(ctx, req.GetId()); err != nil {
+		return nil, err
+	}
+
+	gate, err := h.srv.DeleteGate(ctx, req.GetId())
+	if err != nil {
+		return nil, toStatus(err, "delete gate")
+	}
+
+	return &impb.ProviderDeleteVKGateResponse{Item: gateToProto(gate)}, nil
+}
+
+// gate returns the gate when it belongs to the domain of the caller.
+func (h *VKHandler) gate(ctx context.Context, id string) (*vkmodel.VKGate, error) {
+	gate, err := h.srv.GetGate(ctx, id)
+	if err != nil {
+		return nil, toStatus(err, "get gate")
+	}
+	if err := gaterpc.CheckDomain(ctx, gate.DomainID); err != nil {
+		return nil, err
+	}
+	return gate, nil
+}
+
+// toStatus reports a missing public URL as a precondition: the callback server of the
+// community must point somewhere VK can reach.
+func toStatus(err error, internalMsg string) error {
+	if errors.Is(err, vkservice.ErrPublicURLNotSet) {
+		return status.Error(codes.FailedPrecondition, err.Error())
+	}
+	return gaterpc.ToStatus(err, internalMsg)
+}
+
+// gateToProto leaves out the access token, the secret key and the confirmation code:
+// they are shared with VK only.
+func gateToProto(g *vkmodel.VKGate) *impb.ProviderVKGate {
+	if g == nil {
+		return nil
+	}
+	return &impb.ProviderVKGate{
+		Id:         g.ID,
+		Name:       g.Name,
+		Peer:       gaterpc.ToProtoPeer(g.Peer),
+		GroupId:    g.GroupID,
+		ScreenName: g.ScreenName,
+		ServerId:   g.ServerID,
+		WebhookUrl: g.WebhookURL,
+		Status:     impb.ProviderStatus(g.Status),
+		CreatedAt:  g.CreatedAt.UnixMilli(),
+		UpdatedAt:  g.UpdatedAt.UnixMilli(),
+		Enabled:    g.Enabled,
+	}
+}
@@ -0,0 +1,205 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"testing"
+
+	impb "github.com/webitel/im-providers-service/gen/go/provider/v1"
+	"github.com/webitel/im-providers-service/infra/auth"
+	sharedstore "github.com/webitel/im-providers-service/internal/core/store"
+	vkmodel "github.com/webitel/im-providers-service/internal/vk/model"
+	vkservice "github.com/webitel/im-providers-service/internal/vk/service"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type moderator struct{ domainID int64 }
+
+func (m moderator) GetContactID() string { return "" }
+func (m moderator) GetDomainID() int64   { return m.domainID }
+func (m moderator) GetName() string      { return "" }
+
+func moderatorContext(domainID int64) context.Context {
+	return context.WithValue(context.Background(), auth.AuthContextKey, moderator{domainID: domainID})
+}
+
+// communityCallbacks connects the communities by their access token, adding a callback
+// server for each, and fails with err when it is set.
+type communityCallbacks struct {
+	groups  map[string]vkmodel.Group
+	gates   map[string]*vkmodel.VKGate
+	servers int64
+	deleted []string
+	err     error
+}
+
+var _ vkservice.VKManager = (*communityCallbacks)(nil)
+
+func newCommunityCallbacks(gates ...*vkmodel.VKGate) *communityCallbacks {
+	c := &communityCallbacks{
+		groups: map[string]vkmodel.Group{
+			"token-club":  {ID: 101, Name: "Support club", ScreenName: "support_club"},
+			"token-store": {ID: 202, Name: "Store", ScreenName: "store"},
+		},
+		gates: map[string]*vkmodel.VKGate{},
+	}
+	for _, g := range gates {
+		c.gates[g.ID] = g
+		c.servers = max(c.servers, g.ServerID)
+	}
+	return c
+}
+
+// bind points a new callback server of the community of the token to the gate.
+func (c *communityCallbacks) bind(gate *vkmodel.VKGate) {
+	group := c.groups[gate.AccessToken]
+	c.servers++
+	gate.GroupID, gate.ScreenName, gate.ServerID = group.ID, group.ScreenName, c.servers
+}
+
+func (c *communityCallbacks) CreateGate(_ context.Context, req vkmodel.CreateVK) (*vkmodel.VKGate, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	if c.err != nil {
+		return nil, c.err
+	}
+	gate := &vkmodel.VKGate{
+		ID:          fmt.Sprintf("gate-%d", len(c.gates)+1),
+		DomainID:    req.Dc,
+		Name:        req.Name,
+		Peer:        req.Peer,
+		AccessToken: req.AccessToken,
+		Enabled:     true,
+	}
+	c.bind(gate)
+	gate.WebhookURL = "https://im.example.com/wh/vk/" + gate.ID
+	c.gates[gate.ID] = gate
+	return gate, nil
+}
+
+func (c *communityCallbacks) GetGate(_ context.Context, id string) (*vkmodel.VKGate, error) {
+	g, ok := c.gates[id]
+	if !ok {
+		return nil, sharedstore.ErrNotFound
+	}
+	return g, nil
+}
+
+func (c *communityCallbacks) UpdateGate(_ context.Context, req vkmodel.UpdateVK) (*vkmodel.VKGate, error) {
+	gate := c.gates[req.ID]
+	prevToken := gate.AccessToken
+	req.ApplyTo(gate)
+	if gate.AccessToken != prevToken {
+		c.bind(gate)
+	}
+	return gate, nil
+}
+
+func (c *communityCallbacks) DeleteGate(_ context.Context, id string) (*vkmodel.VKGate, error) {
+	c.deleted = append(c.deleted, id)
+	return c.gates[id], nil
+}
+
+// supportClub is a community gate whose callback server VK has confirmed.
+func supportClub() *vkmodel.VKGate {
+	return &vkmodel.VKGate{
+		ID:               "gate-1",
+		DomainID:         7,
+		Name:             "Support",
+		GroupID:          101,
+		ScreenName:       "support_club",
+		AccessToken:      "token-club",
+		SecretKey:        "secret-club",
+		ConfirmationCode: "code-101",
+		ServerID:         3,
+		WebhookURL:       "https://im.example.com/wh/vk/gate-1",
+		Enabled:          true,
+	}
+}
+
+func newHandler(c *communityCallbacks) *VKHandler {
+	return NewVKHandler(slog.New(slog.NewTextHandler(io.Discard, nil)), c)
+}
+
+func TestCreateVKGate_ConnectsCommunity(t *testing.T) {
+	c := newCommunityCallbacks()
+
+	resp, err := newHandler(c).CreateVKGate(moderatorContext(7), &impb.ProviderCreateVKGateRequest{
+		Name:        "Support",
+		AccessToken: "token-club",
+		Peer:        &impb.Peer{Sub: "club-bot", Iss: "vk"},
+	})
+	if err != nil {
+		t.Fatalf("CreateVKGate: %v", err)
+	}
+	item := resp.GetItem()
+	if item.GetGroupId() != 101 || item.GetScreenName() != "support_club" || item.GetServerId() != 1 {
+		t.Errorf("community = %+v", item)
+	}
+	if item.GetWebhookUrl() != "https://im.example.com/wh/vk/gate-1" {
+		t.Errorf("webhook url = %q", item.GetWebhookUrl())
+	}
+	if g := c.gates["gate-1"]; g.DomainID != 7 || g.AccessToken != "token-club" {
+		t.Errorf("stored gate = %+v", g)
+	}
+}
+
+func TestCreateVKGate_ErrorCodes(t *testing.T) {
+	tests := []struct {
+		name    string
+		token   string
+		failure error
+		want    codes.Code
+	}{
+		{name: "no access token", want: codes.InvalidArgument},
+		{name: "no public url", token: "token-store", failure: vkservice.ErrPublicURLNotSet, want: codes.FailedPrecondition},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := newCommunityCallbacks()
+			c.err = tt.failure
+
+			_, err := newHandler(c).CreateVKGate(moderatorContext(7), &impb.ProviderCreateVKGateRequest{Name: "Store", AccessToken: tt.token})
+			if status.Code(err) != tt.want {
+				t.Errorf("code = %v, want %v", status.Code(err), tt.want)
+			}
+		})
+	}
+}
+
+func TestUpdateVKGate_TokenOfAnotherCommunity(t *testing.T) {
+	c := newCommunityCallbacks(supportClub())
+
+	token := "token-store"
+	resp, err := newHandler(c).UpdateVKGate(moderatorContext(7), &impb.ProviderUpdateVKGateRequest{Id: "gate-1", AccessToken: &token})
+	if err != nil {
+		t.Fatalf("UpdateVKGate: %v", err)
+	}
+	if item := resp.GetItem(); item.GetGroupId() != 202 || item.GetScreenName() != "store" || item.GetServerId() != 4 || item.GetName() != "Support" {
+		t.Errorf("item = %+v", item)
+	}
+}
+
+func TestVKGate_OtherDomainIsNotFound(t *testing.T) {
+	c := newCommunityCallbacks(supportClub())
+	h := newHandler(c)
+	ctx := moderatorContext(8)
+
+	if _, err := h.GetVKGate(ctx, &impb.ProviderGetVKGateRequest{Id: "gate-1"}); status.Code(err) != codes.NotFound {
+		t.Errorf("get: code = %v, want NotFound", status.Code(err))
+	}
+	token := "token-store"
+	if _, err := h.UpdateVKGate(ctx, &impb.ProviderUpdateVKGateRequest{Id: "gate-1", AccessToken: &token}); status.Code(err) != codes.NotFound {
+		t.Errorf("update: code = %v, want NotFound", status.Code(err))
+	}
+	if _, err := h.DeleteVKGate(ctx, &impb.ProviderDeleteVKGateRequest{Id: "gate-1"}); status.Code(err) != codes.NotFound {
+		t.Errorf("delete: code = %v, want NotFound", status.Code(err))
+	}
+	if g := c.gates["gate-1"]; g.GroupID != 101 || len(c.deleted) != 0 {
+		t.Errorf("gate of another domain changed: %+v, deleted %v", g, c.deleted)
+	}
+}
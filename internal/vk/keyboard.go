@@ -0,0 +1,176 @@
+package vk
+
+import (
+	"encoding/json"
+
+	sharedmodel "github.com/webitel/im-providers-service/internal/core/model"
+)
+
+// Keyboard limits.
+// https://dev.vk.com/en/api/bots/development/keyboard
+const (
+	inlineMaxRows      = 6
+	inlineMaxButtons   = 10
+	keyboardMaxRows    = 10
+	keyboardMaxButtons = 40
+	rowMaxButtons      = 5
+	labelMaxLen        = 40
+	payloadMaxLen      = 255
+)
+
+// Button action types.
+const (
+	actionCallback = "callback"
+	actionOpenLink = "open_link"
+	actionLocation = "location"
+)
+
+// payloadData is sent as the payload of a callback button and echoed back in the
+// message_event of a tap. It correlates the tap with the message and button it came
+// from, so no state is kept.
+type payloadData struct {
+	MessageID string `json:"m,omitempty"`
+	Code      string `json:"c,omitempty"`
+	Data      string `json:"d"`
+}
+
+// encodePayload returns the payload of a callback button. The message and the button
+// are left out when the envelope does not fit the limit; a button whose callback data
+// alone does not fit has no payload.
+func encodePayload(messageID string, b sharedmodel.KeyboardButton) (string, bool) {
+	code := b.ID
+	if code == "" {
+		code = b.Label
+	}
+	for _, d := range []payloadData{
+		{MessageID: messageID, Code: code, Data: b.Callback.Data},
+		{Data: b.Callback.Data},
+	} {
+		if raw, err := json.Marshal(d); err == nil && len(raw) <= payloadMaxLen {
+			return string(raw), true
+		}
+	}
+	return "", false
+}
+
+// decodePayload reads the payload of a message_event. A payload not sent in the
+// envelope is returned as the callback data of an unknown message.
+func decodePayload(raw json.RawMessage) payloadData {
+	var d payloadData
+	if json.Unmarshal(raw, &d) != nil || (d.MessageID == "" && d.Data == "") {
+		return payloadData{Data: string(raw)}
+	}
+	return d
+}
+
+// buildKeyboard maps the buttons of an interactive message onto a keyboard. The
+// keyboard is inline, below the message, unless it asks for the location: location
+// buttons work in the keyboard of the user only, which single-use messages hide once
+// a button is used. Rows are kept; a location button takes a row of its own and the
+// buttons left over the limits are dropped.
+func buildKeyboard(messageID string, interactive *sharedmodel.Interactive) *keyboard {
+	rows := interactiveRows(interactive)
+
+	inline := true
+	for _, row := range rows {
+		for _, b := range row {
+			if isLocationRequest(b) {
+				inline = false
+			}
+		}
+	}
+	maxRows, maxButtons := inlineMaxRows, inlineMaxButtons
+	if !inline {
+		maxRows, maxButtons = keyboardMaxRows, keyboardMaxButtons
+	}
+
+	kb := &keyboard{Inline: inline, OneTime: !inline && interactive.SingleUse}
+	total := 0
+	add := func(row []*button) {
+		if len(row) > 0 && len(kb.Buttons) < maxRows {
+			kb.Buttons = append(kb.Buttons, row)
+			total += len(row)
+		}
+	}
+	for _, row := range rows {
+		var out []*button
+		for _, b := range row {
+			if total+len(out) == maxButtons {
+				break
+			}
+			btn := toButton(messageID, b)
+			switch {
+			case btn == nil:
+			case btn.Action.Type == actionLocation:
+				add(out)
+				add([]*button{btn})
+				out = nil
+			case len(out) == rowMaxButtons:
+				add(out)
+				out = []*button{btn}
+			default:
+				out = append(out, btn)
+			}
+		}
+		add(out)
+	}
+
+	if len(kb.Buttons) == 0 {
+		return nil
+	}
+	return kb
+}
+
+// interactiveRows returns the button rows of an interactive message. List sections
+// become a button per row.
+func interactiveRows(interactive *sharedmodel.Interactive) [][]sharedmodel.KeyboardButton {
+	if interactive == nil {
+		return nil
+	}
+
+	var rows [][]sharedmodel.KeyboardButton
+	if interactive.Markup != nil {
+		for _, row := range interactive.Markup.Rows {
+			rows = append(rows, row.Buttons)
+		}
+	}
+	if interactive.ListReply != nil {
+		for _, section := range interactive.ListReply.Sections {
+			for _, b := range section.Buttons {
+				rows = append(rows, []sharedmodel.KeyboardButton{b})
+			}
+		}
+	}
+	return rows
+}
+
+// toButton maps a button: callback buttons report taps as message_event, URL buttons
+// open the link and location requests share the location. Other buttons are dropped.
+func toButton(messageID string, b sharedmodel.KeyboardButton) *button {
+	label := truncate(b.Label, labelMaxLen)
+	switch {
+	case b.Callback != nil && b.Callback.Data != "":
+		payload, ok := encodePayload(messageID, b)
+		if !ok {
+			return nil
+		}
+		return &button{Action: buttonAction{Type: actionCallback, Label: label, Payload: payload}}
+	case b.URL != nil && b.URL.URL != "":
+		return &button{Action: buttonAction{Type: actionOpenLink, Label: label, Link: b.URL.URL}}
+	case isLocationRequest(b):
+		return &button{Action: buttonAction{Type: actionLocation}}
+	}
+	return nil
+}
+
+func isLocationRequest(b sharedmodel.KeyboardButton) bool {
+	return b.Request != nil && b.Request.Action == "location"
+}
+
+// truncate cuts s to at most n characters.
+func truncate(s string, n int) string {
+	if runes := []rune(s); len(runes) > n {
+		return string(runes[:n])
+	}
+	return s
+}
@@ -0,0 +1,162 @@
+package vk
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"path"
+	"strings"
+
+	sharedmodel "github.com/webitel/im-providers-service/internal/core/model"
+	"github.com/webitel/im-providers-service/internal/provider/contactsync"
+	vkmodel "github.com/webitel/im-providers-service/internal/vk/model"
+)
+
+// content is a downloaded file. The caller closes Body.
+type content struct {
+	Body     io.ReadCloser
+	MimeType string
+	Size     int64
+}
+
+// inboundFile is the file of an attachment of an inbound message.
+type inboundFile struct {
+	url   string
+	name  string
+	size  int64
+	image bool
+}
+
+// handleAttachment copies the file of an inbound attachment to the storage and
+// forwards it with the caption. Photos become images, documents and voice messages
+// become documents. It reports whether the attachment was forwarded.
+func (p *vkProvider) handleAttachment(ctx context.Context, gate *vkmodel.VKGate, peers contactsync.Peers, a *attachment, caption string) bool {
+	f, ok := attachmentFile(a)
+	if !ok {
+		if a.Type == AttachmentSticker {
+			// Stickers cannot be copied; the text of the message is forwarded, if any.
+			p.logger.Debug("sticker skipped")
+		} else {
+			p.logger.Warn("unsupported attachment type, skipping", "type", a.Type)
+		}
+		return false
+	}
+
+	c, err := p.download(ctx, f.url)
+	if err != nil {
+		p.logger.Error("failed to download attachment", "type", a.Type, "err", err)
+		return false
+	}
+	defer c.Body.Close()
+
+	mimeType := c.MimeType
+	if mimeType == "" {
+		mimeType = "application/octet-stream"
+	}
+	uploaded, err := p.media.UploadFile(ctx, sharedmodel.UploadRequest{
+		DomainID: gate.DomainID,
+		Name:     f.name,
+		MimeType: mimeType,
+	}, c.Body)
+	if err != nil {
+		p.logger.Error("failed to sync attachment", "type", a.Type, "err", err)
+		return false
+	}
+
+	if f.image {
+		if _, err := p.messenger.SendImage(ctx, &sharedmodel.SendImageRequest{
+			DomainID: gate.DomainID,
+			From:     peers.From,
+			To:       peers.To,
+			Image: sharedmodel.ImageRequest{
+				Body: caption,
+				Images: []*sharedmodel.Image{{
+					ID:       uploaded.ID,
+					FileName: f.name,
+					MimeType: mimeType,
+				}},
+			},
+		}); err != nil {
+			p.logger.Error("failed to send image", "fileName", f.name, "err", err)
+			return false
+		}
+		return true
+	}
+
+	size := max(uploaded.Size, f.size, c.Size, 1)
+	if _, err := p.messenger.SendDocument(ctx, &sharedmodel.SendDocumentRequest{
+		DomainID: gate.DomainID,
+		From:     peers.From,
+		To:       peers.To,
+		Document: sharedmodel.DocumentRequest{
+			Body: caption,
+			Documents: []*sharedmodel.Document{{
+				ID:       uploaded.ID,
+				FileName: f.name,
+				MimeType: mimeType,
+				Size:     size,
+			}},
+		},
+	}); err != nil {
+		p.logger.Error("failed to send document", "fileName", f.name, "err", err)
+		return false
+	}
+	return true
+}
+
+// attachmentFile returns the file of an attachment. Photos are taken in their
+// largest size; stickers, videos and the like carry no file to copy.
+func attachmentFile(a *attachment) (inboundFile, bool) {
+	switch a.Type {
+	case AttachmentPhoto:
+		if a.Photo == nil || len(a.Photo.Sizes) == 0 {
+			return inboundFile{}, false
+		}
+		largest := a.Photo.Sizes[0]
+		for _, s := range a.Photo.Sizes[1:] {
+			if s.Width*s.Height > largest.Width*largest.Height {
+				largest = s
+			}
+		}
+		return inboundFile{url: largest.URL, name: fmt.Sprintf("vk_photo_%d_%d.jpg", a.Photo.OwnerID, a.Photo.ID), image: true}, largest.URL != ""
+	case AttachmentDoc:
+		if a.Doc == nil || a.Doc.URL == "" {
+			return inboundFile{}, false
+		}
+		name := a.Doc.Title
+		if name == "" {
+			name = fmt.Sprintf("vk_doc_%d_%d", a.Doc.OwnerID, a.Doc.ID)
+		}
+		if a.Doc.Ext != "" && !strings.EqualFold(path.Ext(name), "."+a.Doc.Ext) {
+			name += "." + a.Doc.Ext
+		}
+		return inboundFile{url: a.Doc.URL, name: name, size: a.Doc.Size}, true
+	case AttachmentAudioMessage:
+		if a.AudioMessage == nil || a.AudioMessage.LinkMP3 == "" {
+			return inboundFile{}, false
+		}
+		return inboundFile{url: a.AudioMessage.LinkMP3, name: fmt.Sprintf("vk_voice_%d.mp3", a.AudioMessage.ID)}, true
+	}
+	return inboundFile{}, false
+}
+
+// download fetches a file: the media of inbound messages is served from the VK CDN,
+// the files of outbound ones from the storage. The caller closes the body.
+func (p *vkProvider) download(ctx context.Context, fileURL string) (*content, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, fileURL, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := p.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		_, _ = io.Copy(io.Discard, resp.Body)
+		resp.Body.Close()
+		return nil, fmt.Errorf("vk download: status %s", resp.Status)
+	}
+	return &content{Body: resp.Body, MimeType: resp.Header.Get("Content-Type"), Size: resp.ContentLength}, nil
+}
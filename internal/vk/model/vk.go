@@ -0,0 +1,95 @@
+package model
+
+import (
+	"time"
+
+	sharedmodel "github.com/webitel/im-providers-service/internal/core/model"
+)
+
+// VKGate represents a VK community gate configuration.
+type VKGate struct {
+	ID       string           `json:"id" db:"id"`
+	DomainID int64            `json:"domain_id" db:"domain_id"`
+	Peer     sharedmodel.Peer `json:"peer" db:"peer"`
+	Name     string           `json:"name" db:"name"`
+	// GroupID is the ID of the community, sent with every Callback API request.
+	// ScreenName is its short address (vk.com/<screen_name>).
+	GroupID    int64  `json:"group_id" db:"group_id"`
+	ScreenName string `json:"screen_name" db:"screen_name"`
+	// AccessToken is the community token authorizing the API calls.
+	AccessToken string `json:"-" db:"access_token"`
+	// SecretKey is sent by VK with every Callback API request; ConfirmationCode is
+	// the reply VK expects to the confirmation request of the callback server.
+	SecretKey        string `json:"-" db:"secret_key"`
+	ConfirmationCode string `json:"-" db:"confirmation_code"`
+	// ServerID is the callback server of the community pointing to the gate.
+	ServerID   int64                  `json:"server_id" db:"server_id"`
+	WebhookURL string                 `json:"webhook_url" db:"webhook_url"`
+	Status     sharedmodel.GateStatus `json:"status" db:"status"`
+	CreatedAt  time.Time              `json:"created_at" db:"created_at"`
+	UpdatedAt  time.Time              `json:"updated_at" db:"updated_at"`
+	Enabled    bool                   `json:"enabled" db:"enabled"`
+}
+
+type CreateVK struct {
+	Name        string
+	Dc          int64
+	AccessToken string
+	Peer        sharedmodel.Peer
+}
+
+type UpdateVK struct {
+	ID          string
+	Name        *string
+	AccessToken *string
+	Enabled     *bool
+	Peer        *sharedmodel.Peer
+}
+
+func (r UpdateVK) ApplyTo(gate *VKGate) {
+	if r.Name != nil {
+		gate.Name = *r.Name
+	}
+	if r.Enabled != nil {
+		gate.Enabled = *r.Enabled
+	}
+	if r.AccessToken != nil {
+		gate.AccessToken = *r.AccessToken
+	}
+	if r.Peer != nil {
+		gate.Peer = *r.Peer
+	}
+}
+
+// Group is the community the access token belongs to.
+type Group struct {
+	ID         int64
+	Name       string
+	ScreenName string
+}
+
+// Profile is the profile of a VK user.
+type Profile struct {
+	UserID     int64
+	FirstName  string
+	LastName   string
+	ScreenName string
+	PhotoURL   string
+}
+
+func (r CreateVK) Validate() error {
+	var missing []string
+	if r.Name == "" {
+		missing = append(missing, "name")
+	}
+	if r.AccessToken == "" {
+		missing = append(missing, "access_token")
+	}
+	if r.Dc <= 0 {
+		missing = append(missing, "domain_id")
+	}
+	if len(missing) > 0 {
+		return &sharedmodel.ValidationError{Fields: missing}
+	}
+	return nil
+}
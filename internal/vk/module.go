@@ -0,0 +1,44 @@
+package vk
+
+import (
+	impb "github.com/webitel/im-providers-service/gen/go/provider/v1"
+	grpcsrv "github.com/webitel/im-providers-service/infra/srv/grpc"
+	"github.com/webitel/im-providers-service/internal/provider"
+	vkhandler "github.com/webitel/im-providers-service/internal/vk/handler"
+	vkservice "github.com/webitel/im-providers-service/internal/vk/service"
+	vkstore "github.com/webitel/im-providers-service/internal/vk/store"
+	vkpostgres "github.com/webitel/im-providers-service/internal/vk/store/postgres"
+	"go.uber.org/fx"
+)
+
+// Module provides the VK provider adapter and the gRPC gate service.
+var Module = fx.Module("vk",
+	fx.Provide(
+		// API client — provided as *apiClient for the provider adapter
+		// and as GroupAPI for the VK service.
+		newAPIClient,
+		func(c *apiClient) vkservice.GroupAPI { return c },
+
+		// Provider adapter
+		fx.Annotate(
+			New,
+			fx.As(new(provider.Provider)),
+			fx.ResultTags(`group:"providers"`),
+		),
+
+		// Store implementations
+		fx.Annotate(vkpostgres.NewVKStore, fx.As(new(vkstore.VKStore))),
+
+		// Services
+		fx.Annotate(vkservice.NewVKService, fx.As(new(vkservice.VKManager))),
+
+		// gRPC handlers
+		vkhandler.NewVKHandler,
+	),
+	fx.Invoke(RegisterVKService),
+)
+
+// RegisterVKService connects the VK gate gRPC handler to the gRPC server.
+func RegisterVKService(server *grpcsrv.Server, vk *vkhandler.VKHandler) {
+	impb.RegisterVKServiceServer(server.Server, vk)
+}
@@ -0,0 +1,222 @@
+package vk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"hash/fnv"
+	"math"
+	"math/rand/v2"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/google/uuid"
+	contactv1 "github.com/webitel/im-providers-service/gen/go/contact/v1"
+	sharedmodel "github.com/webitel/im-providers-service/internal/core/model"
+	"github.com/webitel/im-providers-service/internal/provider/contactsync"
+	vkmodel "github.com/webitel/im-providers-service/internal/vk/model"
+)
+
+// outbound is a message to send: the text goes with the first request, the
+// attachments ten per request.
+type outbound struct {
+	text        string
+	attachments []string
+	keyboard    *keyboard
+}
+
+func (p *vkProvider) SendText(ctx context.Context, req *sharedmodel.Message) (*sharedmodel.MessageResponse, error) {
+	g, userID, err := p.recipient(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return p.send(ctx, g, userID, req, &outbound{text: req.Text})
+}
+
+// SendImage uploads the images through the photo upload server and sends them as
+// photos of a message with the text.
+func (p *vkProvider) SendImage(ctx context.Context, req *sharedmodel.Message) (*sharedmodel.MessageResponse, error) {
+	g, userID, err := p.recipient(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	msg := &outbound{text: req.Text}
+	for _, img := range req.Images {
+		ref, err := p.uploadPhoto(ctx, g, userID, img.FileName, img.URL)
+		if err != nil {
+			return nil, err
+		}
+		msg.attachments = append(msg.attachments, ref)
+	}
+	return p.send(ctx, g, userID, req, msg)
+}
+
+// SendDocument uploads the documents through the document upload server and sends
+// them as documents of a message with the text.
+func (p *vkProvider) SendDocument(ctx context.Context, req *sharedmodel.Message) (*sharedmodel.MessageResponse, error) {
+	g, userID, err := p.recipient(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	msg := &outbound{text: req.Text}
+	for _, doc := range req.Documents {
+		ref, err := p.uploadDocument(ctx, g, userID, doc.FileName, doc.URL)
+		if err != nil {
+			return nil, err
+		}
+		msg.attachments = append(msg.attachments, ref)
+	}
+	return p.send(ctx, g, userID, req, msg)
+}
+
+// SendInteractive sends the text with the buttons as a keyboard, see buildKeyboard.
+func (p *vkProvider) SendInteractive(ctx context.Context, req *sharedmodel.Message) (*sharedmodel.MessageResponse, error) {
+	text := req.Text
+	if text == "" && req.Interactive != nil {
+		text = req.Interactive.Body
+	}
+	if text == "" {
+		return nil, fmt.Errorf("vk: interactive message has no text")
+	}
+
+	g, userID, err := p.recipient(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return p.send(ctx, g, userID, req, &outbound{text: text, keyboard: buildKeyboard(messageID(req), req.Interactive)})
+}
+
+// send delivers the message in as many requests as its attachments take and returns
+// the response of the first one.
+func (p *vkProvider) send(ctx context.Context, g *vkmodel.VKGate, userID int64, req *sharedmodel.Message, msg *outbound) (*sharedmodel.MessageResponse, error) {
+	if msg.text == "" && len(msg.attachments) == 0 {
+		return nil, fmt.Errorf("vk: nothing to send")
+	}
+
+	var first *sharedmodel.MessageResponse
+	for n, start := 0, 0; n == 0 || start < len(msg.attachments); n, start = n+1, start+maxAttachmentsPerMessage {
+		params := url.Values{
+			"user_id":   {strconv.FormatInt(userID, 10)},
+			"random_id": {strconv.FormatInt(int64(randomID(req, n)), 10)},
+		}
+		if n == 0 {
+			if msg.text != "" {
+				params.Set("message", msg.text)
+			}
+			if msg.keyboard != nil {
+				raw, err := json.Marshal(msg.keyboard)
+				if err != nil {
+					return nil, fmt.Errorf("vk: marshal keyboard: %w", err)
+				}
+				params.Set("keyboard", string(raw))
+			}
+		}
+		if start < len(msg.attachments) {
+			batch := msg.attachments[start:min(start+maxAttachmentsPerMessage, len(msg.attachments))]
+			params.Set("attachment", strings.Join(batch, ","))
+		}
+
+		id, err := p.api.Send(ctx, g.AccessToken, params)
+		if err != nil {
+			return nil, err
+		}
+		if first == nil {
+			first = &sharedmodel.MessageResponse{ID: id}
+		}
+	}
+	return first, nil
+}
+
+// uploadPhoto copies an image of the storage to the photo upload server and returns
+// the attachment of the saved photo.
+func (p *vkProvider) uploadPhoto(ctx context.Context, g *vkmodel.VKGate, userID int64, name, fileURL string) (string, error) {
+	c, err := p.download(ctx, fileURL)
+	if err != nil {
+		return "", fmt.Errorf("vk: download image %q: %w", name, err)
+	}
+	defer c.Body.Close()
+	return p.api.UploadPhoto(ctx, g.AccessToken, userID, fileName(name, "image"), c.Body)
+}
+
+// uploadDocument copies a file of the storage to the document upload server and
+// returns the attachment of the saved document.
+func (p *vkProvider) uploadDocument(ctx context.Context, g *vkmodel.VKGate, userID int64, name, fileURL string) (string, error) {
+	c, err := p.download(ctx, fileURL)
+	if err != nil {
+		return "", fmt.Errorf("vk: download document %q: %w", name, err)
+	}
+	defer c.Body.Close()
+	return p.api.UploadDocument(ctx, g.AccessToken, userID, fileName(name, "file"), c.Body)
+}
+
+func fileName(name, fallback string) string {
+	if name == "" {
+		return fallback
+	}
+	return name
+}
+
+// recipient returns the gate of the message and the VK user it is addressed to.
+func (p *vkProvider) recipient(ctx context.Context, req *sharedmodel.Message) (*vkmodel.VKGate, int64, error) {
+	g, err := p.repo.Select(ctx, req.GateID)
+	if err != nil {
+		return nil, 0, err
+	}
+	sub, err := p.resolveReceiver(ctx, g, req.To.Sub)
+	if err != nil {
+		return nil, 0, err
+	}
+	userID, err := strconv.ParseInt(sub, 10, 64)
+	if err != nil || userID <= 0 {
+		return nil, 0, fmt.Errorf("vk: %q is not a user id", sub)
+	}
+	return g, userID, nil
+}
+
+// resolveReceiver returns the VK user ID for the given sub.
+// A VK user ID is returned as-is; an internal contact UUID is resolved via the contact service.
+func (p *vkProvider) resolveReceiver(ctx context.Context, gate *vkmodel.VKGate, contactID string) (string, error) {
+	if _, err := uuid.Parse(contactID); err != nil {
+		return contactID, nil
+	}
+	if userID, ok := p.receiverCache.Get(contactID); ok {
+		return userID, nil
+	}
+
+	authCtx := contactsync.WithGatewayIdentity(ctx, gate.DomainID, gate.Peer)
+	resp, err := p.contactClient.SearchContact(authCtx, &contactv1.SearchContactRequest{
+		Ids: []string{contactID},
+	})
+	if err != nil {
+		return "", fmt.Errorf("resolve vk user for %s: %w", contactID, err)
+	}
+	items := resp.GetContacts()
+	if len(items) == 0 || items[0].GetSubject() == "" {
+		return "", fmt.Errorf("resolve vk user for %s: contact not found or has no subject", contactID)
+	}
+	userID := items[0].GetSubject()
+	p.receiverCache.Add(contactID, userID)
+	return userID, nil
+}
+
+// randomID returns the random_id of the n-th request of a message. VK sends a
+// random_id once per user, so a message redelivered by the core is not duplicated.
+func randomID(req *sharedmodel.Message, n int) int32 {
+	if req.ID == uuid.Nil {
+		return rand.Int32()
+	}
+	h := fnv.New32a()
+	h.Write(req.ID[:])
+	h.Write([]byte(strconv.Itoa(n)))
+	return int32(h.Sum32() & math.MaxInt32)
+}
+
+func messageID(req *sharedmodel.Message) string {
+	if req.ID == uuid.Nil {
+		return ""
+	}
+	return req.ID.String()
+}
@@ -0,0 +1,322 @@
+package vk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	sharedmodel "github.com/webitel/im-providers-service/internal/core/model"
+)
+
+func outboundRequest() *sharedmodel.Message {
+	return &sharedmodel.Message{
+		ID:     uuid.MustParse("0190a8a4-aaaa-7000-8000-000000000001"),
+		GateID: testGateID,
+		To:     sharedmodel.Peer{Sub: fmt.Sprint(testUserID)},
+	}
+}
+
+func keyboardOf(t *testing.T, call apiCall) *keyboard {
+	t.Helper()
+	raw := call.form.Get("keyboard")
+	if raw == "" {
+		return nil
+	}
+	var kb keyboard
+	if err := json.Unmarshal([]byte(raw), &kb); err != nil {
+		t.Fatalf("decoding keyboard %s: %v", raw, err)
+	}
+	return &kb
+}
+
+func TestSendText(t *testing.T) {
+	p := newClubProvider(t)
+	p.stub.replies["messages.send"] = `{"response":1045}`
+
+	req := outboundRequest()
+	req.Text = "Здравствуйте!"
+	resp, err := p.SendText(context.Background(), req)
+	if err != nil {
+		t.Fatalf("SendText: %v", err)
+	}
+	if resp.ID != "1045" {
+		t.Errorf("unexpected response: %+v", resp)
+	}
+
+	calls := p.stub.methods("messages.send")
+	if len(calls) != 1 {
+		t.Fatalf("expected 1 messages.send, got %+v", p.stub.sent())
+	}
+	form := calls[0].form
+	if form.Get("user_id") != fmt.Sprint(testUserID) || form.Get("message") != "Здравствуйте!" {
+		t.Errorf("unexpected form: %v", form)
+	}
+	if form.Get("access_token") != testToken || form.Get("v") != APIVersion {
+		t.Errorf("unexpected auth: %v", form)
+	}
+	if form.Get("random_id") == "" || form.Has("keyboard") || form.Has("attachment") {
+		t.Errorf("unexpected form: %v", form)
+	}
+}
+
+func TestSendText_RandomIDIsStable(t *testing.T) {
+	p := newClubProvider(t)
+
+	req := outboundRequest()
+	req.Text = "hi"
+	for range 2 {
+		if _, err := p.SendText(context.Background(), req); err != nil {
+			t.Fatalf("SendText: %v", err)
+		}
+	}
+
+	calls := p.stub.methods("messages.send")
+	if len(calls) != 2 || calls[0].form.Get("random_id") != calls[1].form.Get("random_id") {
+		t.Fatalf("a redelivered message must keep its random_id: %+v", calls)
+	}
+	if randomID(req, 0) == randomID(req, 1) {
+		t.Error("the requests of a message must differ in random_id")
+	}
+}
+
+func TestSendText_APIError(t *testing.T) {
+	p := newClubProvider(t)
+	p.stub.replies["messages.send"] = `{"error":{"error_code":901,"error_msg":"Can't send messages for users without permission"}}`
+
+	req := outboundRequest()
+	req.Text = "hi"
+	if _, err := p.SendText(context.Background(), req); err == nil || !strings.Contains(err.Error(), "901") {
+		t.Fatalf("SendText() error = %v, want the API error", err)
+	}
+}
+
+func TestSendText_NotAUser(t *testing.T) {
+	p := newClubProvider(t)
+
+	req := outboundRequest()
+	req.To.Sub = "durov"
+	req.Text = "hi"
+	if _, err := p.SendText(context.Background(), req); err == nil {
+		t.Fatal("a screen name must be rejected as the recipient")
+	}
+	if len(p.stub.sent()) != 0 {
+		t.Errorf("no request expected: %+v", p.stub.sent())
+	}
+}
+
+func TestSendImage_Upload(t *testing.T) {
+	p := newClubProvider(t)
+	p.stub.replies["photos.getMessagesUploadServer"] = fmt.Sprintf(`{"response":{"upload_url":"%s/upload/photo","album_id":-64,"group_id":%d}}`, p.stub.url, testGroupID)
+	p.stub.replies["/upload/photo"] = `{"server":851234,"photo":"[{\"photo\":\"abc\"}]","hash":"7f3a"}`
+	p.stub.replies["photos.saveMessagesPhoto"] = fmt.Sprintf(`{"response":[{"id":457239020,"owner_id":-%d,"access_key":"k3y"}]}`, testGroupID)
+
+	req := outboundRequest()
+	req.Text = "the receipt"
+	req.Images = []*sharedmodel.Image{{FileName: "receipt.jpg", URL: p.stub.url + "/files/receipt.jpg"}}
+	if _, err := p.SendImage(context.Background(), req); err != nil {
+		t.Fatalf("SendImage: %v", err)
+	}
+
+	servers := p.stub.methods("photos.getMessagesUploadServer")
+	if len(servers) != 1 || servers[0].form.Get("peer_id") != fmt.Sprint(testUserID) {
+		t.Fatalf("unexpected upload server request: %+v", servers)
+	}
+	uploads := p.stub.methods("/upload/photo")
+	if len(uploads) != 1 || uploads[0].form.Get("field") != "photo" || uploads[0].file != "receipt.jpg" || uploads[0].body != "content of /files/receipt.jpg" {
+		t.Fatalf("unexpected upload: %+v", uploads)
+	}
+	saves := p.stub.methods("photos.saveMessagesPhoto")
+	if len(saves) != 1 || saves[0].form.Get("server") != "851234" || saves[0].form.Get("hash") != "7f3a" || saves[0].form.Get("photo") != `[{"photo":"abc"}]` {
+		t.Fatalf("unexpected save: %+v", saves)
+	}
+
+	sends := p.stub.methods("messages.send")
+	if len(sends) != 1 {
+		t.Fatalf("expected 1 messages.send, got %d", len(sends))
+	}
+	want := fmt.Sprintf("photo-%d_457239020_k3y", testGroupID)
+	if got := sends[0].form.Get("attachment"); got != want || sends[0].form.Get("message") != "the receipt" {
+		t.Errorf("unexpected message: %v, want attachment %s", sends[0].form, want)
+	}
+}
+
+func TestSendImage_UploadRejected(t *testing.T) {
+	p := newClubProvider(t)
+	p.stub.replies["photos.getMessagesUploadServer"] = fmt.Sprintf(`{"response":{"upload_url":"%s/upload/photo"}}`, p.stub.url)
+	p.stub.replies["/upload/photo"] = `{"server":1,"photo":"[]","hash":""}`
+
+	req := outboundRequest()
+	req.Images = []*sharedmodel.Image{{FileName: "a.jpg", URL: p.stub.url + "/files/a.jpg"}}
+	if _, err := p.SendImage(context.Background(), req); err == nil {
+		t.Fatal("a rejected upload must fail the message")
+	}
+	if len(p.stub.methods("messages.send")) != 0 {
+		t.Error("no message expected")
+	}
+}
+
+func TestSendDocument_Batches(t *testing.T) {
+	p := newClubProvider(t)
+	p.stub.replies["docs.getMessagesUploadServer"] = fmt.Sprintf(`{"response":{"upload_url":"%s/upload/doc"}}`, p.stub.url)
+	p.stub.replies["/upload/doc"] = `{"file":"file-token"}`
+	p.stub.replies["docs.save"] = fmt.Sprintf(`{"response":{"type":"doc","doc":{"id":66,"owner_id":%d}}}`, testUserID)
+
+	req := outboundRequest()
+	req.Text = "the documents"
+	for i := range 12 {
+		req.Documents = append(req.Documents, &sharedmodel.Document{FileName: fmt.Sprintf("doc%d.pdf", i), URL: p.stub.url + "/files/doc"})
+	}
+	if _, err := p.SendDocument(context.Background(), req); err != nil {
+		t.Fatalf("SendDocument: %v", err)
+	}
+
+	if servers := p.stub.methods("docs.getMessagesUploadServer"); len(servers) != 12 || servers[0].form.Get("type") != "doc" {
+		t.Fatalf("unexpected upload server requests: %+v", servers)
+	}
+	if saves := p.stub.methods("docs.save"); saves[0].form.Get("file") != "file-token" || saves[0].form.Get("title") != "doc0.pdf" {
+		t.Errorf("unexpected save: %+v", saves[0])
+	}
+
+	sends := p.stub.methods("messages.send")
+	if len(sends) != 2 {
+		t.Fatalf("expected 2 messages.send, got %d", len(sends))
+	}
+	if n := len(strings.Split(sends[0].form.Get("attachment"), ",")); n != maxAttachmentsPerMessage || sends[0].form.Get("message") != "the documents" {
+		t.Errorf("unexpected first message: %d attachments, %v", n, sends[0].form)
+	}
+	if got := sends[1].form.Get("attachment"); got != fmt.Sprintf("doc%d_66,doc%d_66", testUserID, testUserID) || sends[1].form.Has("message") {
+		t.Errorf("unexpected second message: %v", sends[1].form)
+	}
+	if sends[0].form.Get("random_id") == sends[1].form.Get("random_id") {
+		t.Error("the requests of a message must differ in random_id")
+	}
+}
+
+func TestSendInteractive_InlineKeyboard(t *testing.T) {
+	p := newClubProvider(t)
+
+	req := outboundRequest()
+	req.Interactive = &sharedmodel.Interactive{
+		Body: "Was the issue resolved?",
+		Markup: &sharedmodel.KeyboardMarkup{Rows: []sharedmodel.KeyboardRow{
+			{Buttons: []sharedmodel.KeyboardButton{
+				{ID: "yes", Label: "Yes", Callback: &sharedmodel.KeyboardButtonCallback{Data: "resolved"}},
+				{ID: "no", Label: "No", Callback: &sharedmodel.KeyboardButtonCallback{Data: "open"}},
+			}},
+			{Buttons: []sharedmodel.KeyboardButton{
+				{Label: "Help center", URL: &sharedmodel.KeyboardButtonURL{URL: "https://example.com/help"}},
+				{Label: "Share contact", Request: &sharedmodel.KeyboardButtonRequest{Action: "contact"}},
+			}},
+		}},
+	}
+	if _, err := p.SendInteractive(context.Background(), req); err != nil {
+		t.Fatalf("SendInteractive: %v", err)
+	}
+
+	sends := p.stub.methods("messages.send")
+	if len(sends) != 1 || sends[0].form.Get("message") != "Was the issue resolved?" {
+		t.Fatalf("unexpected messages: %+v", sends)
+	}
+	kb := keyboardOf(t, sends[0])
+	if kb == nil || !kb.Inline || kb.OneTime || len(kb.Buttons) != 2 || len(kb.Buttons[0]) != 2 || len(kb.Buttons[1]) != 1 {
+		t.Fatalf("unexpected keyboard: %+v", kb)
+	}
+
+	yes := kb.Buttons[0][0].Action
+	if yes.Type != actionCallback || yes.Label != "Yes" {
+		t.Errorf("unexpected callback button: %+v", yes)
+	}
+	data := decodePayload([]byte(yes.Payload))
+	if data.MessageID != req.ID.String() || data.Code != "yes" || data.Data != "resolved" {
+		t.Errorf("unexpected payload: %+v", data)
+	}
+	if link := kb.Buttons[1][0].Action; link.Type != actionOpenLink || link.Link != "https://example.com/help" {
+		t.Errorf("unexpected link button: %+v", link)
+	}
+}
+
+func TestSendInteractive_NoText(t *testing.T) {
+	p := newClubProvider(t)
+
+	req := outboundRequest()
+	req.Interactive = &sharedmodel.Interactive{Markup: &sharedmodel.KeyboardMarkup{}}
+	if _, err := p.SendInteractive(context.Background(), req); err == nil {
+		t.Fatal("an interactive message without text must be rejected")
+	}
+}
+
+func TestBuildKeyboard_Location(t *testing.T) {
+	interactive := &sharedmodel.Interactive{
+		SingleUse: true,
+		Markup: &sharedmodel.KeyboardMarkup{Rows: []sharedmodel.KeyboardRow{{Buttons: []sharedmodel.KeyboardButton{
+			{Label: "Menu", Callback: &sharedmodel.KeyboardButtonCallback{Data: "menu"}},
+			{Label: "Send location", Request: &sharedmodel.KeyboardButtonRequest{Action: "location"}},
+			{Label: "Cancel", Callback: &sharedmodel.KeyboardButtonCallback{Data: "cancel"}},
+		}}}},
+	}
+
+	kb := buildKeyboard("msg-1", interactive)
+	if kb == nil || kb.Inline || !kb.OneTime || len(kb.Buttons) != 3 {
+		t.Fatalf("unexpected keyboard: %+v", kb)
+	}
+	if row := kb.Buttons[1]; len(row) != 1 || row[0].Action.Type != actionLocation {
+		t.Errorf("the location button must take a row of its own: %+v", row)
+	}
+}
+
+func TestBuildKeyboard_Limits(t *testing.T) {
+	var row []sharedmodel.KeyboardButton
+	for i := range 12 {
+		row = append(row, sharedmodel.KeyboardButton{
+			Label:    strings.Repeat("Option ", 10) + fmt.Sprint(i),
+			Callback: &sharedmodel.KeyboardButtonCallback{Data: fmt.Sprint(i)},
+		})
+	}
+	row = append(row, sharedmodel.KeyboardButton{
+		Label:    "Too much data",
+		Callback: &sharedmodel.KeyboardButtonCallback{Data: strings.Repeat("x", payloadMaxLen)},
+	})
+
+	kb := buildKeyboard("msg-1", &sharedmodel.Interactive{Markup: &sharedmodel.KeyboardMarkup{Rows: []sharedmodel.KeyboardRow{{Buttons: row}}}})
+	if kb == nil || !kb.Inline {
+		t.Fatalf("unexpected keyboard: %+v", kb)
+	}
+
+	total := 0
+	for _, r := range kb.Buttons {
+		if len(r) > rowMaxButtons {
+			t.Errorf("row of %d buttons", len(r))
+		}
+		for _, b := range r {
+			total++
+			if n := len([]rune(b.Action.Label)); n > labelMaxLen {
+				t.Errorf("label of %d characters", n)
+			}
+			if len(b.Action.Payload) > payloadMaxLen {
+				t.Errorf("payload of %d bytes", len(b.Action.Payload))
+			}
+		}
+	}
+	if total != inlineMaxButtons || len(kb.Buttons) != 2 {
+		t.Errorf("want %d buttons in 2 rows, got %d in %d", inlineMaxButtons, total, len(kb.Buttons))
+	}
+}
+
+func TestBuildKeyboard_ListReply(t *testing.T) {
+	kb := buildKeyboard("", &sharedmodel.Interactive{ListReply: &sharedmodel.KeyboardListReply{Sections: []sharedmodel.KeyboardRowWithSection{{
+		Section: "Plans",
+		Buttons: []sharedmodel.KeyboardButton{
+			{ID: "basic", Label: "Basic", Callback: &sharedmodel.KeyboardButtonCallback{Data: "plan:basic"}},
+			{ID: "pro", Label: "Pro", Callback: &sharedmodel.KeyboardButtonCallback{Data: "plan:pro"}},
+		},
+	}}}})
+	if kb == nil || len(kb.Buttons) != 2 || len(kb.Buttons[0]) != 1 {
+		t.Fatalf("every list entry must take a row: %+v", kb)
+	}
+	if data := decodePayload([]byte(kb.Buttons[1][0].Action.Payload)); data.Code != "pro" || data.Data != "plan:pro" {
+		t.Errorf("unexpected payload: %+v", data)
+	}
+}
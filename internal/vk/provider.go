@@ -0,0 +1,102 @@
+// Package vk implements the VK community messages provider: the Callback API
+// delivers the events of the community, the API methods send the replies.
+// https://dev.vk.com/en/api/callback/getting-started
+package vk
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+	lru "github.com/hashicorp/golang-lru/v2"
+	imcontact "github.com/webitel/im-providers-service/infra/client/grpc/im-contact"
+	imgateway "github.com/webitel/im-providers-service/infra/client/grpc/im-gateway"
+	sharedsvc "github.com/webitel/im-providers-service/internal/core/service"
+	sharedstore "github.com/webitel/im-providers-service/internal/core/store"
+	"github.com/webitel/im-providers-service/internal/provider"
+	vkmodel "github.com/webitel/im-providers-service/internal/vk/model"
+	vkstore "github.com/webitel/im-providers-service/internal/vk/store"
+)
+
+type vkProvider struct {
+	api communityAPI
+	// httpClient downloads the media of inbound messages and the files of outbound ones.
+	httpClient    *http.Client
+	logger        *slog.Logger
+	messenger     sharedsvc.Messenger
+	gateCache     sharedstore.GateCache
+	userCache     sharedstore.ExternalUserCache
+	repo          vkstore.VKStore
+	gatewayer     *imgateway.Client
+	media         sharedsvc.MediaManager
+	contactClient *imcontact.Client
+	// receiverCache maps internal contact UUID → VK user ID to avoid an
+	// im-contact round-trip on every outbound message.
+	receiverCache *lru.Cache[string, string]
+}
+
+func New(
+	m sharedsvc.Messenger,
+	l *slog.Logger,
+	gc sharedstore.GateCache,
+	uc sharedstore.ExternalUserCache,
+	repo vkstore.VKStore,
+	gatewayer *imgateway.Client,
+	media sharedsvc.MediaManager,
+	contactClient *imcontact.Client,
+	api *apiClient,
+) provider.Provider {
+	receiverCache, _ := lru.New[string, string](1000)
+	return &vkProvider{
+		api:           api,
+		httpClient:    &http.Client{Timeout: 60 * time.Second},
+		logger:        l.With("provider", "vk"),
+		messenger:     m,
+		gateCache:     gc,
+		userCache:     uc,
+		repo:          repo,
+		gatewayer:     gatewayer,
+		media:         media,
+		contactClient: contactClient,
+		receiverCache: receiverCache,
+	}
+}
+
+var (
+	_ provider.RequestValidator  = (*vkProvider)(nil)
+	_ provider.Handshaker        = (*vkProvider)(nil)
+	_ provider.Acknowledger      = (*vkProvider)(nil)
+	_ provider.InteractiveSender = (*vkProvider)(nil)
+)
+
+func (p *vkProvider) Type() string { return "vk" }
+
+// resolveGate returns the gate a callback was delivered to. The webhook URI segment is
+// the gate ID, set by the gate service as the callback server URL. Disabled gates
+// are short-circuited from the cache to avoid a DB round-trip on every request.
+func (p *vkProvider) resolveGate(ctx context.Context) (*vkmodel.VKGate, error) {
+	gateID, _ := ctx.Value(provider.WebhookURIKey).(string)
+	if _, err := uuid.Parse(gateID); err != nil {
+		return nil, fmt.Errorf("invalid webhook uri %q: %w", gateID, sharedstore.ErrNotFound)
+	}
+
+	if cached, ok := p.gateCache.Get(gateID); ok && !cached.Enabled {
+		return &vkmodel.VKGate{ID: gateID, Enabled: false}, nil
+	}
+
+	g, err := p.repo.Select(ctx, gateID)
+	if err != nil {
+		return nil, err
+	}
+	p.gateCache.Set(gateID, sharedstore.GateState{
+		GateID:  g.ID,
+		Enabled: g.Enabled,
+		Issuer:  g.Peer.Iss,
+		Sub:     g.Peer.Sub,
+		Domain:  g.DomainID,
+	})
+	return g, nil
+}
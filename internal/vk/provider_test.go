@@ -0,0 +1,190 @@
+package vk
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+	"testing"
+
+	sharedmodel "github.com/webitel/im-providers-service/internal/core/model"
+	sharedstore "github.com/webitel/im-providers-service/internal/core/store"
+	"github.com/webitel/im-providers-service/internal/provider"
+	"github.com/webitel/im-providers-service/internal/provider/providertest"
+	vkmodel "github.com/webitel/im-providers-service/internal/vk/model"
+	vkstore "github.com/webitel/im-providers-service/internal/vk/store"
+)
+
+const (
+	testGateID  = "0190a8a4-5f0e-7c2a-9d3b-0a1b2c3d4e5f"
+	testGroupID = 218765432
+	testSecret  = "c2VjcmV0LWtleS1vZi10aGUtZ2F0ZQ"
+	testToken   = "vk1.a.community-token"
+	testCode    = "a1b2c3d4"
+	testUserID  = 405060
+)
+
+var noopLogger = slog.New(slog.NewTextHandler(io.Discard, nil))
+
+// -- VK API stub --
+
+// apiCall is a request to the stub: an API method with its form, or a file posted
+// to an upload server with the form field it was posted as.
+type apiCall struct {
+	route string
+	form  url.Values
+	file  string
+	body  string
+}
+
+// vkStub serves the API methods under /method/, the upload servers under /upload/
+// and the files of messages under /files/. Every method answers with the reply set
+// for it, or with {"response":1} when none is set.
+type vkStub struct {
+	url     string
+	mu      sync.Mutex
+	calls   []apiCall
+	replies map[string]string
+}
+
+func newVKStub(t *testing.T) (*vkStub, *apiClient) {
+	t.Helper()
+
+	stub := &vkStub{replies: map[string]string{}}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		call := apiCall{route: r.URL.Path}
+		switch {
+		case strings.HasPrefix(r.URL.Path, "/method/"):
+			call.route = strings.TrimPrefix(r.URL.Path, "/method/")
+			if err := r.ParseForm(); err != nil {
+				t.Errorf("parsing %s form: %v", call.route, err)
+			}
+			call.form = r.PostForm
+		case strings.HasPrefix(r.URL.Path, "/upload/"):
+			if err := r.ParseMultipartForm(1 << 20); err != nil {
+				t.Errorf("parsing %s form: %v", r.URL.Path, err)
+			}
+			for field, files := range r.MultipartForm.File {
+				f, _ := files[0].Open()
+				raw, _ := io.ReadAll(f)
+				f.Close()
+				call.form = url.Values{"field": {field}}
+				call.file, call.body = files[0].Filename, string(raw)
+			}
+		}
+
+		stub.mu.Lock()
+		stub.calls = append(stub.calls, call)
+		reply, ok := stub.replies[call.route]
+		stub.mu.Unlock()
+
+		if !ok {
+			if strings.HasPrefix(r.URL.Path, "/files/") {
+				w.Header().Set("Content-Type", "image/jpeg")
+				_, _ = w.Write([]byte("content of " + r.URL.Path))
+				return
+			}
+			reply = `{"response":1}`
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(reply))
+	}))
+	t.Cleanup(server.Close)
+
+	stub.url = server.URL
+	api := newAPIClient(noopLogger)
+	api.apiURL = server.URL
+	return stub, api
+}
+
+func (s *vkStub) sent() []apiCall {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]apiCall(nil), s.calls...)
+}
+
+// methods returns the calls of an API method.
+func (s *vkStub) methods(name string) []apiCall {
+	var out []apiCall
+	for _, c := range s.sent() {
+		if c.route == name {
+			out = append(out, c)
+		}
+	}
+	return out
+}
+
+// -- fakes --
+
+// communityGates holds the gates of the test communities. The provider only reads
+// gates, so the writes panic.
+type communityGates map[string]*vkmodel.VKGate
+
+var _ vkstore.VKStore = communityGates(nil)
+
+func (c communityGates) Select(_ context.Context, id string) (*vkmodel.VKGate, error) {
+	g, ok := c[id]
+	if !ok {
+		return nil, sharedstore.ErrNotFound
+	}
+	cp := *g
+	return &cp, nil
+}
+
+func (c communityGates) Insert(context.Context, int64, *vkmodel.VKGate) error {
+	panic("vk provider must not insert gates")
+}
+
+func (c communityGates) Update(context.Context, *vkmodel.VKGate) error {
+	panic("vk provider must not update gates")
+}
+
+func (c communityGates) Delete(context.Context, string) error {
+	panic("vk provider must not delete gates")
+}
+
+// supportClub is the gate of the test community, with its callback server confirmed.
+func supportClub() *vkmodel.VKGate {
+	return &vkmodel.VKGate{
+		ID:               testGateID,
+		DomainID:         1,
+		Name:             "Support",
+		Peer:             sharedmodel.Peer{Sub: "bot-1", Iss: "vk"},
+		GroupID:          testGroupID,
+		ScreenName:       "support_club",
+		AccessToken:      testToken,
+		SecretKey:        testSecret,
+		ConfirmationCode: testCode,
+		ServerID:         7,
+		Enabled:          true,
+	}
+}
+
+// clubProvider is the provider of the test community against the stubbed VK API.
+type clubProvider struct {
+	*vkProvider
+	stub      *vkStub
+	messenger *providertest.Messenger
+	media     *providertest.Media
+	gates     communityGates
+}
+
+func newClubProvider(t *testing.T) *clubProvider {
+	t.Helper()
+
+	stub, api := newVKStub(t)
+	messenger := &providertest.Messenger{}
+	media := &providertest.Media{}
+	gates := communityGates{testGateID: supportClub()}
+
+	p := New(messenger, noopLogger, providertest.NewGateCache(), providertest.KnownUsers{}, gates, nil, media, nil, api).(*vkProvider)
+	return &clubProvider{vkProvider: p, stub: stub, messenger: messenger, media: media, gates: gates}
+}
+
+func webhookContext(gateID string) context.Context {
+	return context.WithValue(context.Background(), provider.WebhookURIKey, gateID)
+}
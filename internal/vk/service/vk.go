@@ -0,0 +1,213 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/webitel/im-providers-service/config"
+	vkmodel "github.com/webitel/im-providers-service/internal/vk/model"
+	vkstore "github.com/webitel/im-providers-service/internal/vk/store"
+)
+
+// ErrPublicURLNotSet is returned when a callback server is to be set but service.public_url is empty.
+var ErrPublicURLNotSet = errors.New("vk: service.public_url is not configured")
+
+// callbackServerTitle names the callback server in the community settings (14 characters at most).
+const callbackServerTitle = "Webitel"
+
+var _ VKManager = (*VKService)(nil)
+
+type VKManager interface {
+	CreateGate(ctx context.Context, req vkmodel.CreateVK) (*vkmodel.VKGate, error)
+	GetGate(ctx context.Context, id string) (*vkmodel.VKGate, error)
+	UpdateGate(ctx context.Context, req vkmodel.UpdateVK) (*vkmodel.VKGate, error)
+	DeleteGate(ctx context.Context, id string) (*vkmodel.VKGate, error)
+}
+
+// GroupAPI is the subset of the VK API used to manage the callback server of a community.
+// Defined here (exported) so the parent vk package can satisfy it without an import cycle.
+type GroupAPI interface {
+	GetGroup(ctx context.Context, token string) (*vkmodel.Group, error)
+	GetConfirmationCode(ctx context.Context, token string, groupID int64) (string, error)
+	AddCallbackServer(ctx context.Context, token string, groupID int64, url, title, secretKey string) (int64, error)
+	SetCallbackSettings(ctx context.Context, token string, groupID, serverID int64) error
+	DeleteCallbackServer(ctx context.Context, token string, groupID, serverID int64) error
+}
+
+type VKService struct {
+	repo     vkstore.VKStore
+	groupAPI GroupAPI
+	cfg      *config.Config
+	log      *slog.Logger
+}
+
+func NewVKService(repo vkstore.VKStore, groupAPI GroupAPI, cfg *config.Config, log *slog.Logger) *VKService {
+	return &VKService{
+		repo:     repo,
+		groupAPI: groupAPI,
+		cfg:      cfg,
+		log:      log.With("layer", "service", "domain", "vk_gate"),
+	}
+}
+
+// CreateGate stores the gate of the community the access token belongs to and adds a
+// callback server pointing to it. The gate is removed again when VK rejects the server.
+func (s *VKService) CreateGate(ctx context.Context, req vkmodel.CreateVK) (*vkmodel.VKGate, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	if s.cfg.Service.PublicURL == "" {
+		return nil, ErrPublicURLNotSet
+	}
+
+	gate := &vkmodel.VKGate{
+		Name:        req.Name,
+		AccessToken: req.AccessToken,
+		Peer:        req.Peer,
+		Enabled:     true,
+	}
+	if err := s.bindGroup(ctx, gate); err != nil {
+		return nil, err
+	}
+
+	if err := s.repo.Insert(ctx, req.Dc, gate); err != nil {
+		s.log.Error("failed to create vk gate", "group_id", gate.GroupID, "err", err)
+		return nil, err
+	}
+
+	if err := s.setCallbackServer(ctx, gate); err != nil {
+		if delErr := s.repo.Delete(ctx, gate.ID); delErr != nil {
+			s.log.Error("failed to remove vk gate without callback server", "id", gate.ID, "err", delErr)
+		}
+		return nil, err
+	}
+
+	s.log.Info("vk gate created", "id", gate.ID, "group_id", gate.GroupID, "webhook", gate.WebhookURL)
+	return gate, nil
+}
+
+func (s *VKService) GetGate(ctx context.Context, id string) (*vkmodel.VKGate, error) {
+	return s.repo.Select(ctx, id)
+}
+
+// UpdateGate applies the changes; a new access token may belong to another community,
+// which then gets a callback server of its own and the previous one is removed.
+func (s *VKService) UpdateGate(ctx context.Context, req vkmodel.UpdateVK) (*vkmodel.VKGate, error) {
+	gate, err := s.repo.Select(ctx, req.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	prev := *gate
+	req.ApplyTo(gate)
+
+	if gate.AccessToken != prev.AccessToken {
+		if s.cfg.Service.PublicURL == "" {
+			return nil, ErrPublicURLNotSet
+		}
+		if err := s.bindGroup(ctx, gate); err != nil {
+			return nil, err
+		}
+	}
+
+	if gate.GroupID != prev.GroupID {
+		// The gate must resolve with the new confirmation code before VK confirms the server.
+		gate.ServerID = 0
+		if err := s.repo.Update(ctx, gate); err != nil {
+			s.log.Error("failed to update vk gate", "id", req.ID, "err", err)
+			return nil, err
+		}
+		if err := s.setCallbackServer(ctx, gate); err != nil {
+			return nil, err
+		}
+		s.removeCallbackServer(ctx, &prev)
+
+		s.log.Info("vk gate updated", "id", gate.ID, "group_id", gate.GroupID)
+		return gate, nil
+	}
+
+	if err := s.repo.Update(ctx, gate); err != nil {
+		s.log.Error("failed to update vk gate", "id", req.ID, "err", err)
+		return nil, err
+	}
+
+	s.log.Info("vk gate updated", "id", gate.ID)
+	return gate, nil
+}
+
+// DeleteGate removes the gate and the callback server of the community.
+func (s *VKService) DeleteGate(ctx context.Context, id string) (*vkmodel.VKGate, error) {
+	gate, err := s.repo.Select(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.repo.Delete(ctx, id); err != nil {
+		s.log.Error("failed to delete vk gate", "id", id, "err", err)
+		return nil, err
+	}
+	s.removeCallbackServer(ctx, gate)
+
+	s.log.Warn("vk gate removed", "id", id, "group_id", gate.GroupID)
+	return gate, nil
+}
+
+// bindGroup fills the community of the access token and its confirmation code in,
+// with a new secret key for its callback server.
+func (s *VKService) bindGroup(ctx context.Context, gate *vkmodel.VKGate) error {
+	group, err := s.groupAPI.GetGroup(ctx, gate.AccessToken)
+	if err != nil {
+		return fmt.Errorf("get vk community: %w", err)
+	}
+	code, err := s.groupAPI.GetConfirmationCode(ctx, gate.AccessToken, group.ID)
+	if err != nil {
+		return fmt.Errorf("get vk confirmation code: %w", err)
+	}
+	gate.GroupID, gate.ScreenName = group.ID, group.ScreenName
+	// VK takes up to 50 letters and digits; rand.Text is 26 of them.
+	gate.ConfirmationCode, gate.SecretKey = code, rand.Text()
+	return nil
+}
+
+// setCallbackServer adds a callback server pointing to the gate, subscribes it to the
+// message events and stores it. VK confirms the server right away, so the gate must
+// already be stored with its confirmation code.
+func (s *VKService) setCallbackServer(ctx context.Context, gate *vkmodel.VKGate) error {
+	url := s.webhookURL(gate.ID)
+	serverID, err := s.groupAPI.AddCallbackServer(ctx, gate.AccessToken, gate.GroupID, url, callbackServerTitle, gate.SecretKey)
+	if err != nil {
+		s.log.Error("failed to add vk callback server", "id", gate.ID, "url", url, "err", err)
+		return fmt.Errorf("add vk callback server: %w", err)
+	}
+	if err := s.groupAPI.SetCallbackSettings(ctx, gate.AccessToken, gate.GroupID, serverID); err != nil {
+		s.log.Error("failed to set vk callback settings", "id", gate.ID, "server_id", serverID, "err", err)
+		_ = s.groupAPI.DeleteCallbackServer(ctx, gate.AccessToken, gate.GroupID, serverID)
+		return fmt.Errorf("set vk callback settings: %w", err)
+	}
+
+	gate.ServerID, gate.WebhookURL = serverID, url
+	if err := s.repo.Update(ctx, gate); err != nil {
+		s.log.Error("failed to store vk callback server", "id", gate.ID, "err", err)
+		return err
+	}
+	return nil
+}
+
+// removeCallbackServer removes the callback server of the gate from its community.
+// Errors are non-fatal: the server of a removed gate is answered not found.
+func (s *VKService) removeCallbackServer(ctx context.Context, gate *vkmodel.VKGate) {
+	if gate.ServerID == 0 {
+		return
+	}
+	if err := s.groupAPI.DeleteCallbackServer(ctx, gate.AccessToken, gate.GroupID, gate.ServerID); err != nil {
+		s.log.Warn("failed to remove vk callback server", "id", gate.ID, "group_id", gate.GroupID, "server_id", gate.ServerID, "err", err)
+	}
+}
+
+// webhookURL is the webhook URL of the gate: the gate ID is the webhook URI segment.
+func (s *VKService) webhookURL(gateID string) string {
+	return s.cfg.Service.PublicURL + s.cfg.Service.WebhookPath + "/vk/" + gateID
+}
@@ -0,0 +1,266 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"testing"
+
+	"github.com/webitel/im-providers-service/config"
+	sharedstore "github.com/webitel/im-providers-service/internal/core/store"
+	vkmodel "github.com/webitel/im-providers-service/internal/vk/model"
+	vkstore "github.com/webitel/im-providers-service/internal/vk/store"
+)
+
+var noopLogger = slog.New(slog.NewTextHandler(io.Discard, nil))
+
+// gateBook stores the gates under sequential IDs and records the deletions.
+type gateBook struct {
+	gates   map[string]*vkmodel.VKGate
+	deleted []string
+}
+
+var _ vkstore.VKStore = (*gateBook)(nil)
+
+func (b *gateBook) Insert(_ context.Context, dc int64, g *vkmodel.VKGate) error {
+	g.ID = fmt.Sprintf("gate-%d", len(b.gates)+len(b.deleted)+1)
+	g.DomainID = dc
+	cp := *g
+	b.gates[g.ID] = &cp
+	return nil
+}
+
+func (b *gateBook) Select(_ context.Context, id string) (*vkmodel.VKGate, error) {
+	g, ok := b.gates[id]
+	if !ok {
+		return nil, sharedstore.ErrNotFound
+	}
+	cp := *g
+	return &cp, nil
+}
+
+func (b *gateBook) Update(_ context.Context, g *vkmodel.VKGate) error {
+	cp := *g
+	b.gates[g.ID] = &cp
+	return nil
+}
+
+func (b *gateBook) Delete(_ context.Context, id string) error {
+	b.deleted = append(b.deleted, id)
+	delete(b.gates, id)
+	return nil
+}
+
+type callbackServer struct {
+	url, secretKey string
+	subscribed     bool
+}
+
+// communityAPI answers for the communities of its tokens and keeps their callback servers.
+type communityAPI struct {
+	groups      map[string]*vkmodel.Group
+	settingsErr error
+	nextID      int64
+	// servers are the callback servers by community and server ID.
+	servers map[int64]map[int64]*callbackServer
+}
+
+func (m *communityAPI) GetGroup(_ context.Context, token string) (*vkmodel.Group, error) {
+	g, ok := m.groups[token]
+	if !ok {
+		return nil, errors.New("api error 5: User authorization failed")
+	}
+	return g, nil
+}
+
+func (m *communityAPI) GetConfirmationCode(_ context.Context, _ string, groupID int64) (string, error) {
+	return fmt.Sprintf("code-%d", groupID), nil
+}
+
+func (m *communityAPI) AddCallbackServer(_ context.Context, _ string, groupID int64, url, _, secretKey string) (int64, error) {
+	m.nextID++
+	if m.servers[groupID] == nil {
+		m.servers[groupID] = map[int64]*callbackServer{}
+	}
+	m.servers[groupID][m.nextID] = &callbackServer{url: url, secretKey: secretKey}
+	return m.nextID, nil
+}
+
+func (m *communityAPI) SetCallbackSettings(_ context.Context, _ string, groupID, serverID int64) error {
+	if m.settingsErr != nil {
+		return m.settingsErr
+	}
+	m.servers[groupID][serverID].subscribed = true
+	return nil
+}
+
+func (m *communityAPI) DeleteCallbackServer(_ context.Context, _ string, groupID, serverID int64) error {
+	delete(m.servers[groupID], serverID)
+	return nil
+}
+
+// newVKService manages the gates of the communities of token-1 and token-2; an empty
+// publicURL leaves service.public_url unset.
+func newVKService(publicURL string) (*VKService, *gateBook, *communityAPI) {
+	book := &gateBook{gates: map[string]*vkmodel.VKGate{}}
+	api := &communityAPI{
+		groups: map[string]*vkmodel.Group{
+			"token-1": {ID: 1, Name: "Support", ScreenName: "support"},
+			"token-2": {ID: 2, Name: "Sales", ScreenName: "sales"},
+		},
+		servers: map[int64]map[int64]*callbackServer{},
+	}
+	cfg := &config.Config{Service: config.ServiceConfig{PublicURL: publicURL, WebhookPath: "/wh"}}
+	return NewVKService(book, api, cfg, noopLogger), book, api
+}
+
+func createRequest() vkmodel.CreateVK {
+	return vkmodel.CreateVK{Name: "Support", Dc: 1, AccessToken: "token-1"}
+}
+
+func TestCreateGate_AddsCallbackServer(t *testing.T) {
+	svc, book, api := newVKService("https://im.example.com")
+
+	gate, err := svc.CreateGate(context.Background(), createRequest())
+	if err != nil {
+		t.Fatalf("CreateGate: %v", err)
+	}
+
+	const wantURL = "https://im.example.com/wh/vk/gate-1"
+	server := api.servers[1][gate.ServerID]
+	if server == nil || server.url != wantURL || !server.subscribed {
+		t.Fatalf("unexpected callback server: %+v", api.servers)
+	}
+	stored := book.gates["gate-1"]
+	if stored == nil || stored.WebhookURL != wantURL || stored.ServerID != gate.ServerID || stored.GroupID != 1 || stored.ScreenName != "support" || !stored.Enabled {
+		t.Errorf("unexpected stored gate: %+v", stored)
+	}
+	if stored.ConfirmationCode != "code-1" || stored.SecretKey == "" || stored.SecretKey != server.secretKey {
+		t.Errorf("callback server credentials not stored: %+v", stored)
+	}
+}
+
+func TestCreateGate_SecretKeyPerGate(t *testing.T) {
+	svc, _, _ := newVKService("https://im.example.com")
+
+	first, err := svc.CreateGate(context.Background(), createRequest())
+	if err != nil {
+		t.Fatalf("CreateGate: %v", err)
+	}
+	second, err := svc.CreateGate(context.Background(), createRequest())
+	if err != nil {
+		t.Fatalf("CreateGate: %v", err)
+	}
+	if first.SecretKey == second.SecretKey {
+		t.Error("every callback server must get a secret key of its own")
+	}
+}
+
+func TestCreateGate_SettingsRejectedRemovesGate(t *testing.T) {
+	svc, book, api := newVKService("https://im.example.com")
+	api.settingsErr = errors.New("api error 100: invalid server_id")
+
+	if _, err := svc.CreateGate(context.Background(), createRequest()); err == nil {
+		t.Fatal("expected an error")
+	}
+	if len(book.gates) != 0 || len(book.deleted) != 1 {
+		t.Errorf("gate without callback server must be removed: gates=%v deleted=%v", book.gates, book.deleted)
+	}
+	if len(api.servers[1]) != 0 {
+		t.Errorf("callback server must be removed: %v", api.servers[1])
+	}
+}
+
+func TestCreateGate_Rejected(t *testing.T) {
+	tests := []struct {
+		name      string
+		publicURL string
+		req       vkmodel.CreateVK
+		wantErr   error
+	}{
+		{name: "missing fields", publicURL: "https://im.example.com", req: vkmodel.CreateVK{Dc: 1}},
+		{name: "public url not set", req: createRequest(), wantErr: ErrPublicURLNotSet},
+		{name: "invalid token", publicURL: "https://im.example.com", req: vkmodel.CreateVK{Name: "x", Dc: 1, AccessToken: "bad"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc, book, _ := newVKService(tt.publicURL)
+			_, err := svc.CreateGate(context.Background(), tt.req)
+			if err == nil {
+				t.Fatal("expected an error")
+			}
+			if tt.wantErr != nil && !errors.Is(err, tt.wantErr) {
+				t.Errorf("error = %v, want %v", err, tt.wantErr)
+			}
+			if len(book.gates) != 0 {
+				t.Errorf("no gate must be stored, got %v", book.gates)
+			}
+		})
+	}
+}
+
+func TestUpdateGate_NewCommunityMovesCallbackServer(t *testing.T) {
+	svc, book, api := newVKService("https://im.example.com")
+	created, err := svc.CreateGate(context.Background(), createRequest())
+	if err != nil {
+		t.Fatalf("CreateGate: %v", err)
+	}
+
+	token := "token-2"
+	gate, err := svc.UpdateGate(context.Background(), vkmodel.UpdateVK{ID: "gate-1", AccessToken: &token})
+	if err != nil {
+		t.Fatalf("UpdateGate: %v", err)
+	}
+
+	stored := book.gates["gate-1"]
+	if gate.GroupID != 2 || stored.AccessToken != "token-2" || stored.ScreenName != "sales" || stored.ConfirmationCode != "code-2" {
+		t.Errorf("gate not moved to the new community: %+v", stored)
+	}
+	if server := api.servers[2][stored.ServerID]; server == nil || !server.subscribed || server.url != "https://im.example.com/wh/vk/gate-1" {
+		t.Errorf("callback server of the new community not added: %v", api.servers[2])
+	}
+	if _, ok := api.servers[1][created.ServerID]; ok {
+		t.Errorf("callback server of the previous community must be removed: %v", api.servers[1])
+	}
+}
+
+func TestUpdateGate_NameOnly(t *testing.T) {
+	svc, book, api := newVKService("https://im.example.com")
+	created, err := svc.CreateGate(context.Background(), createRequest())
+	if err != nil {
+		t.Fatalf("CreateGate: %v", err)
+	}
+
+	name := "Renamed"
+	if _, err := svc.UpdateGate(context.Background(), vkmodel.UpdateVK{ID: "gate-1", Name: &name}); err != nil {
+		t.Fatalf("UpdateGate: %v", err)
+	}
+	stored := book.gates["gate-1"]
+	if stored.Name != "Renamed" || stored.ServerID != created.ServerID || stored.SecretKey != created.SecretKey {
+		t.Errorf("unexpected stored gate: %+v", stored)
+	}
+	if len(api.servers[1]) != 1 || api.nextID != 1 {
+		t.Errorf("callback server must be left alone: %v", api.servers)
+	}
+}
+
+func TestDeleteGate_RemovesCallbackServer(t *testing.T) {
+	svc, book, api := newVKService("https://im.example.com")
+	if _, err := svc.CreateGate(context.Background(), createRequest()); err != nil {
+		t.Fatalf("CreateGate: %v", err)
+	}
+
+	if _, err := svc.DeleteGate(context.Background(), "gate-1"); err != nil {
+		t.Fatalf("DeleteGate: %v", err)
+	}
+	if len(book.gates) != 0 || len(api.servers[1]) != 0 {
+		t.Errorf("gate or callback server not removed: %v %v", book.gates, api.servers)
+	}
+
+	if _, err := svc.DeleteGate(context.Background(), "gate-1"); !errors.Is(err, sharedstore.ErrNotFound) {
+		t.Errorf("second delete error = %v, want ErrNotFound", err)
+	}
+}
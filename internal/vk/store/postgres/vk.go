@@ -0,0 +1,184 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/georgysavva/scany/v2/pgxscan"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/webitel/im-providers-service/infra/db/pg"
+	sharedmodel "github.com/webitel/im-providers-service/internal/core/model"
+	sharedstore "github.com/webitel/im-providers-service/internal/core/store"
+	vkmodel "github.com/webitel/im-providers-service/internal/vk/model"
+	vkstore "github.com/webitel/im-providers-service/internal/vk/store"
+	"github.com/webitel/im-providers-service/pkg/crypto"
+)
+
+var _ vkstore.VKStore = (*vkStore)(nil)
+
+type vkStore struct {
+	pool   *pgxpool.Pool
+	crypto crypto.Encryptor
+	cache  sharedstore.GateCache
+}
+
+func NewVKStore(pool *pgxpool.Pool, crypt crypto.Encryptor, cache sharedstore.GateCache) vkstore.VKStore {
+	return &vkStore{
+		pool:   pool,
+		crypto: crypt,
+		cache:  cache,
+	}
+}
+
+func (s *vkStore) Insert(ctx context.Context, dc int64, g *vkmodel.VKGate) error {
+	token, secret, err := s.encrypt(g)
+	if err != nil {
+		return err
+	}
+
+	const query = `
+	WITH new_gate AS (
+		INSERT INTO im_provider.gates (dc, name, type, enabled)
+		VALUES ($1, $2, 'vk', $3)
+		RETURNING id, name, created_at, updated_at
+	),
+	new_bot AS (
+		INSERT INTO im_provider.bots (sub, iss, gate_id)
+		SELECT $4, $5, id FROM new_gate
+		RETURNING id
+	)
+	INSERT INTO im_provider.vk (gate_id, group_id, screen_name, access_token, secret_key, confirmation_code, server_id, webhook_url)
+	SELECT id, $6, $7, $8, $9, $10, $11, $12 FROM new_gate
+	RETURNING
+		gate_id,
+		(SELECT name FROM new_gate),
+		(SELECT created_at FROM new_gate),
+		(SELECT updated_at FROM new_gate)`
+
+	err = s.pool.QueryRow(ctx, query,
+		dc, g.Name, g.Enabled, g.Peer.Sub, g.Peer.Iss,
+		g.GroupID, g.ScreenName, token, secret, g.ConfirmationCode, g.ServerID, g.WebhookURL,
+	).Scan(&g.ID, &g.Name, &g.CreatedAt, &g.UpdatedAt)
+	if err != nil {
+		if pg.IsUniqueViolation(err) {
+			return fmt.Errorf("vk community %d is already bound: %w", g.GroupID, sharedstore.ErrConflict)
+		}
+		return fmt.Errorf("postgres: insert vk gateway: %w", err)
+	}
+
+	g.DomainID = dc
+	s.mapVirtualFields(g)
+	return nil
+}
+
+func (s *vkStore) Select(ctx context.Context, id string) (*vkmodel.VKGate, error) {
+	const query = `
+	SELECT
+		g.id,
+		g.dc AS domain_id,
+		g.name,
+		g.enabled,
+		g.created_at,
+		g.updated_at,
+		b.sub AS "peer.sub",
+		b.iss AS "peer.iss",
+		vk.group_id,
+		vk.screen_name,
+		vk.access_token,
+		vk.secret_key,
+		vk.confirmation_code,
+		vk.server_id,
+		vk.webhook_url
+	FROM im_provider.gates g
+	JOIN im_provider.bots b ON g.id = b.gate_id
+	JOIN im_provider.vk vk ON g.id = vk.gate_id
+	WHERE g.id = $1`
+
+	var g vkmodel.VKGate
+	if err := pgxscan.Get(ctx, s.pool, &g, query, id); err != nil {
+		if pgxscan.NotFound(err) {
+			return nil, sharedstore.ErrNotFound
+		}
+		return nil, fmt.Errorf("postgres: select vk gate: %w", err)
+	}
+
+	if dec, err := s.crypto.Decrypt(g.AccessToken); err == nil {
+		g.AccessToken = dec
+	}
+	if dec, err := s.crypto.Decrypt(g.SecretKey); err == nil {
+		g.SecretKey = dec
+	}
+
+	s.mapVirtualFields(&g)
+	return &g, nil
+}
+
+func (s *vkStore) Update(ctx context.Context, g *vkmodel.VKGate) error {
+	token, secret, err := s.encrypt(g)
+	if err != nil {
+		return err
+	}
+
+	err = pgx.BeginFunc(ctx, s.pool, func(tx pgx.Tx) error {
+		const uGate = `UPDATE im_provider.gates SET name = $1, enabled = $2, updated_at = NOW() WHERE id = $3 RETURNING updated_at`
+		if err := tx.QueryRow(ctx, uGate, g.Name, g.Enabled, g.ID).Scan(&g.UpdatedAt); err != nil {
+			return err
+		}
+
+		const uBot = `UPDATE im_provider.bots SET sub = $1, iss = $2 WHERE gate_id = $3`
+		if _, err := tx.Exec(ctx, uBot, g.Peer.Sub, g.Peer.Iss, g.ID); err != nil {
+			return err
+		}
+
+		const uConfig = `
+			UPDATE im_provider.vk
+			SET group_id = $1, screen_name = $2, access_token = $3, secret_key = $4,
+				confirmation_code = $5, server_id = $6, webhook_url = $7
+			WHERE gate_id = $8`
+		_, err := tx.Exec(ctx, uConfig, g.GroupID, g.ScreenName, token, secret, g.ConfirmationCode, g.ServerID, g.WebhookURL, g.ID)
+		return err
+	})
+	if err != nil {
+		if pg.IsUniqueViolation(err) {
+			return fmt.Errorf("vk community %d is already bound: %w", g.GroupID, sharedstore.ErrConflict)
+		}
+		return fmt.Errorf("postgres: update vk gate: %w", err)
+	}
+
+	s.cache.Delete(g.ID)
+	s.mapVirtualFields(g)
+	return nil
+}
+
+func (s *vkStore) Delete(ctx context.Context, id string) error {
+	res, err := s.pool.Exec(ctx, "DELETE FROM im_provider.gates WHERE id = $1 AND type = 'vk'", id)
+	if err != nil {
+		return fmt.Errorf("postgres: delete vk gate: %w", err)
+	}
+	if res.RowsAffected() == 0 {
+		return sharedstore.ErrNotFound
+	}
+
+	s.cache.Delete(id)
+	return nil
+}
+
+// encrypt returns the access token and secret key encrypted for storage.
+func (s *vkStore) encrypt(g *vkmodel.VKGate) (token, secret string, err error) {
+	if token, err = s.crypto.Encrypt(g.AccessToken); err != nil {
+		return "", "", fmt.Errorf("crypto: %w", err)
+	}
+	if secret, err = s.crypto.Encrypt(g.SecretKey); err != nil {
+		return "", "", fmt.Errorf("crypto: %w", err)
+	}
+	return token, secret, nil
+}
+
+func (s *vkStore) mapVirtualFields(g *vkmodel.VKGate) {
+	if g.Enabled {
+		g.Status = sharedmodel.StatusActive
+	} else {
+		g.Status = sharedmodel.StatusDisabled
+	}
+}
@@ -0,0 +1,16 @@
+package store
+
+import (
+	"context"
+
+	vkmodel "github.com/webitel/im-providers-service/internal/vk/model"
+)
+
+// VKStore manages VK community integrations.
+type VKStore interface {
+	// Insert creates the gate together with its bot peer and VK community settings.
+	Insert(ctx context.Context, dc int64, g *vkmodel.VKGate) error
+	Select(ctx context.Context, id string) (*vkmodel.VKGate, error)
+	Update(ctx context.Context, g *vkmodel.VKGate) error
+	Delete(ctx context.Context, id string) error
+}
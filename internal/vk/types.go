@@ -0,0 +1,236 @@
+package vk
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+// Callback API event types.
+// https://dev.vk.com/en/api/community-events/json-schema
+const (
+	EventConfirmation = "confirmation"
+	EventMessageNew   = "message_new"
+	EventMessageEvent = "message_event"
+	EventMessageAllow = "message_allow"
+	EventMessageDeny  = "message_deny"
+)
+
+// Attachment types of an inbound message.
+const (
+	AttachmentPhoto        = "photo"
+	AttachmentDoc          = "doc"
+	AttachmentAudioMessage = "audio_message"
+	AttachmentSticker      = "sticker"
+)
+
+// APIVersion is the VK API version of the requests and of the callback server.
+const APIVersion = "5.199"
+
+// acknowledgement is the reply VK expects to every handled Callback API request;
+// anything else is redelivered.
+const acknowledgement = "ok"
+
+// chatPeerOffset is the offset of the peer IDs of group chats. Only private
+// dialogs, whose peer ID is the user ID, are routed.
+const chatPeerOffset = 2_000_000_000
+
+// maxAttachmentsPerMessage is the number of attachments a message carries at most.
+const maxAttachmentsPerMessage = 10
+
+// API error codes callers act on.
+// https://dev.vk.com/en/reference/errors
+const (
+	errorCodeAuth           = 5
+	errorCodeMessagesDenied = 901
+)
+
+var (
+	// ErrTokenInvalid is returned when VK rejects the community access token.
+	ErrTokenInvalid = errors.New("vk: community access token invalid")
+	// ErrMessagesDenied is returned when the user has not allowed the community to message them.
+	ErrMessagesDenied = errors.New("vk: user has not allowed messages from the community")
+)
+
+// APIError is an API reply with an error object.
+type APIError struct {
+	Code    int    `json:"error_code"`
+	Message string `json:"error_msg"`
+}
+
+func (e *APIError) Error() string {
+	return fmt.Sprintf("api error %d: %s", e.Code, e.Message)
+}
+
+// Is matches the sentinel errors of the codes callers act on.
+func (e *APIError) Is(target error) bool {
+	switch target {
+	case ErrTokenInvalid:
+		return e.Code == errorCodeAuth
+	case ErrMessagesDenied:
+		return e.Code == errorCodeMessagesDenied
+	}
+	return false
+}
+
+// --- Callback API ---
+
+// callback is the body of a Callback API request. Secret is the secret key of the
+// callback server.
+type callback struct {
+	Type    string          `json:"type"`
+	EventID string          `json:"event_id"`
+	GroupID int64           `json:"group_id"`
+	Secret  string          `json:"secret"`
+	Object  json.RawMessage `json:"object"`
+}
+
+type messageNew struct {
+	Message message `json:"message"`
+}
+
+type message struct {
+	ID          int64        `json:"id"`
+	Date        int64        `json:"date"`
+	PeerID      int64        `json:"peer_id"`
+	FromID      int64        `json:"from_id"`
+	Text        string       `json:"text"`
+	Attachments []attachment `json:"attachments"`
+	Geo         *geo         `json:"geo"`
+}
+
+type attachment struct {
+	Type         string        `json:"type"`
+	Photo        *photo        `json:"photo"`
+	Doc          *doc          `json:"doc"`
+	AudioMessage *audioMessage `json:"audio_message"`
+}
+
+type photo struct {
+	ID      int64       `json:"id"`
+	OwnerID int64       `json:"owner_id"`
+	Sizes   []photoSize `json:"sizes"`
+}
+
+type photoSize struct {
+	Type   string `json:"type"`
+	URL    string `json:"url"`
+	Width  int    `json:"width"`
+	Height int    `json:"height"`
+}
+
+type doc struct {
+	ID      int64  `json:"id"`
+	OwnerID int64  `json:"owner_id"`
+	Title   string `json:"title"`
+	Ext     string `json:"ext"`
+	URL     string `json:"url"`
+	Size    int64  `json:"size"`
+}
+
+type audioMessage struct {
+	ID      int64  `json:"id"`
+	LinkMP3 string `json:"link_mp3"`
+}
+
+type geo struct {
+	Coordinates struct {
+		Latitude  float64 `json:"latitude"`
+		Longitude float64 `json:"longitude"`
+	} `json:"coordinates"`
+	Place *struct {
+		Title string `json:"title"`
+	} `json:"place"`
+}
+
+// messageEvent is a tap on a callback button. Payload is the payload of the button.
+type messageEvent struct {
+	UserID  int64           `json:"user_id"`
+	PeerID  int64           `json:"peer_id"`
+	EventID string          `json:"event_id"`
+	Payload json.RawMessage `json:"payload"`
+}
+
+// --- API replies ---
+
+// apiResponse is the envelope of every API reply.
+type apiResponse struct {
+	Response json.RawMessage `json:"response"`
+	Error    *APIError       `json:"error"`
+}
+
+type groupsResponse struct {
+	Groups []struct {
+		ID         int64  `json:"id"`
+		Name       string `json:"name"`
+		ScreenName string `json:"screen_name"`
+	} `json:"groups"`
+}
+
+type confirmationCodeResponse struct {
+	Code string `json:"code"`
+}
+
+type callbackServerResponse struct {
+	ServerID int64 `json:"server_id"`
+}
+
+type userResponse struct {
+	ID         int64  `json:"id"`
+	FirstName  string `json:"first_name"`
+	LastName   string `json:"last_name"`
+	ScreenName string `json:"screen_name"`
+	Photo200   string `json:"photo_200"`
+}
+
+type uploadServerResponse struct {
+	UploadURL string `json:"upload_url"`
+}
+
+// photoUploadResponse is the reply of the upload server of a photo, passed on to
+// photos.saveMessagesPhoto. Photo is "[]" when the upload was rejected.
+type photoUploadResponse struct {
+	Server int64  `json:"server"`
+	Photo  string `json:"photo"`
+	Hash   string `json:"hash"`
+}
+
+// docUploadResponse is the reply of the upload server of a document, passed on to docs.save.
+type docUploadResponse struct {
+	File  string `json:"file"`
+	Error string `json:"error"`
+}
+
+type savedPhoto struct {
+	ID        int64  `json:"id"`
+	OwnerID   int64  `json:"owner_id"`
+	AccessKey string `json:"access_key"`
+}
+
+type savedDoc struct {
+	Doc struct {
+		ID      int64 `json:"id"`
+		OwnerID int64 `json:"owner_id"`
+	} `json:"doc"`
+}
+
+// --- Keyboards ---
+
+// keyboard is a bot keyboard: inline below a message or in place of the user's keyboard.
+// https://dev.vk.com/en/api/bots/development/keyboard
+type keyboard struct {
+	OneTime bool        `json:"one_time,omitempty"`
+	Inline  bool        `json:"inline,omitempty"`
+	Buttons [][]*button `json:"buttons"`
+}
+
+type button struct {
+	Action buttonAction `json:"action"`
+}
+
+type buttonAction struct {
+	Type    string `json:"type"`
+	Label   string `json:"label,omitempty"`
+	Payload string `json:"payload,omitempty"`
+	Link    string `json:"link,omitempty"`
+}
@@ -0,0 +1,87 @@
+package vk
+
+import (
+	"context"
+	"strconv"
+
+	gatewayv1 "github.com/webitel/im-providers-service/gen/go/gateway/v1"
+	sharedmodel "github.com/webitel/im-providers-service/internal/core/model"
+	"github.com/webitel/im-providers-service/internal/provider/contactsync"
+	vkmodel "github.com/webitel/im-providers-service/internal/vk/model"
+)
+
+// Contact metadata keys filled from the VK user profile.
+const (
+	metadataPicture    = "picture"
+	metadataScreenName = "screen_name"
+)
+
+// syncContact resolves the internal contact for a VK user, creating it if necessary.
+// The profile is fetched only for users not known yet, so repeated events from the
+// same user skip both users.get and the gateway round-trip.
+func (p *vkProvider) syncContact(ctx context.Context, gate *vkmodel.VKGate, userID int64) (*gatewayv1.Contact, error) {
+	sub := strconv.FormatInt(userID, 10)
+	key := contactsync.KnownUser(gate.ID, &sharedmodel.ExternalUser{ID: sub})
+	if known, _ := p.userCache.IsKnown(ctx, key); known {
+		return &gatewayv1.Contact{Sub: sub}, nil
+	}
+
+	profile := p.userProfile(ctx, gate, userID)
+	external := toExternalUser(profile)
+	authCtx := contactsync.WithGatewayIdentity(ctx, gate.DomainID, gate.Peer)
+
+	contact, err := p.ensureContact(authCtx, external, profile)
+	if err != nil {
+		return nil, err
+	}
+
+	contactsync.EnsureVia(authCtx, p.gatewayer, p.logger, gate.ID, sub, contact.Iss)
+	_ = p.userCache.MarkKnown(ctx, key)
+
+	return contact, nil
+}
+
+// userProfile returns the profile of the user. It never fails: the event continues
+// with a user-ID-only profile when users.get is not available.
+func (p *vkProvider) userProfile(ctx context.Context, gate *vkmodel.VKGate, userID int64) *vkmodel.Profile {
+	profile, err := p.api.GetUser(ctx, gate.AccessToken, userID)
+	if err != nil {
+		p.logger.WarnContext(ctx, "fetch user profile failed, using user ID only", "user_id", userID, "err", err)
+		return &vkmodel.Profile{UserID: userID}
+	}
+	if profile.UserID == 0 {
+		profile.UserID = userID
+	}
+	return profile
+}
+
+// ensureContact creates the internal contact or returns a stub when the
+// contact already exists (idempotent by design on the gateway side).
+func (p *vkProvider) ensureContact(ctx context.Context, external *sharedmodel.ExternalUser, profile *vkmodel.Profile) (*gatewayv1.Contact, error) {
+	metadata := make(map[string]string, 2)
+	if profile.PhotoURL != "" {
+		metadata[metadataPicture] = profile.PhotoURL
+	}
+	if profile.ScreenName != "" {
+		metadata[metadataScreenName] = profile.ScreenName
+	}
+
+	return contactsync.CreateContact(ctx, p.gatewayer, &gatewayv1.CreateContactRequest{
+		IssId:    p.Type(),
+		Type:     p.Type(),
+		Name:     external.FirstName,
+		Subject:  external.ID,
+		Metadata: metadata,
+	})
+}
+
+// toExternalUser maps a VK profile to the external user the contact is created for.
+// A user-ID-only profile falls back to the user ID as the display name.
+func toExternalUser(profile *vkmodel.Profile) *sharedmodel.ExternalUser {
+	id := strconv.FormatInt(profile.UserID, 10)
+	first := profile.FirstName
+	if first == "" && profile.LastName == "" {
+		first = id
+	}
+	return &sharedmodel.ExternalUser{ID: id, FirstName: first, LastName: profile.LastName}
+}
@@ -0,0 +1,153 @@
+package vk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	sharedmodel "github.com/webitel/im-providers-service/internal/core/model"
+	"github.com/webitel/im-providers-service/internal/provider/contactsync"
+	vkmodel "github.com/webitel/im-providers-service/internal/vk/model"
+)
+
+func (p *vkProvider) HandleWebhook(ctx context.Context, data []byte) error {
+	var cb callback
+	if err := json.Unmarshal(data, &cb); err != nil {
+		p.logger.Warn("malformed callback dropped", "err", err)
+		return nil
+	}
+
+	gate, err := p.resolveGate(ctx)
+	if err != nil || !gate.Enabled {
+		return err
+	}
+
+	switch cb.Type {
+	case EventMessageNew:
+		var obj messageNew
+		if err := json.Unmarshal(cb.Object, &obj); err != nil {
+			p.logger.Warn("malformed message dropped", "event_id", cb.EventID, "err", err)
+			return nil
+		}
+		if err := p.processMessage(ctx, gate, &obj.Message); err != nil {
+			p.logger.Error("message dropped", "event_id", cb.EventID, "err", err)
+		}
+	case EventMessageEvent:
+		var ev messageEvent
+		if err := json.Unmarshal(cb.Object, &ev); err != nil {
+			p.logger.Warn("malformed button event dropped", "event_id", cb.EventID, "err", err)
+			return nil
+		}
+		if err := p.processButton(ctx, gate, &ev); err != nil {
+			p.logger.Error("button event dropped", "event_id", cb.EventID, "err", err)
+		}
+	case EventMessageAllow:
+		// The user can be messaged from now on, so the contact is linked up front.
+		var obj struct {
+			UserID int64 `json:"user_id"`
+		}
+		if json.Unmarshal(cb.Object, &obj) == nil && obj.UserID > 0 {
+			if _, err := p.syncContact(ctx, gate, obj.UserID); err != nil {
+				p.logger.Warn("sync contact failed", "event", cb.Type, "user_id", obj.UserID, "err", err)
+			}
+		}
+	case EventMessageDeny:
+		p.logger.Info("user denied messages from the community", "event_id", cb.EventID)
+	default:
+		p.logger.Debug("event acknowledged", "event", cb.Type, "event_id", cb.EventID)
+	}
+	return nil
+}
+
+// processMessage is the per-message pipeline:
+//
+//	sync contact → route content
+//
+// Messages of group chats and of other communities are dropped: the gate serves
+// private dialogs with users only.
+func (p *vkProvider) processMessage(ctx context.Context, gate *vkmodel.VKGate, msg *message) error {
+	if msg.FromID <= 0 || msg.PeerID != msg.FromID || msg.PeerID >= chatPeerOffset {
+		p.logger.Debug("message outside of a private dialog skipped", "peer_id", msg.PeerID, "from_id", msg.FromID)
+		return nil
+	}
+
+	if _, err := p.syncContact(ctx, gate, msg.FromID); err != nil {
+		return fmt.Errorf("sync contact [user_id=%d]: %w", msg.FromID, err)
+	}
+
+	peers := contactsync.InboundPeers(gate.ID, gate.Peer, strconv.FormatInt(msg.FromID, 10))
+	// The text is the caption of the first attachment forwarded, if any.
+	caption := msg.Text
+	for i := range msg.Attachments {
+		if p.handleAttachment(ctx, gate, peers, &msg.Attachments[i], caption) {
+			caption = ""
+		}
+	}
+	p.sendText(ctx, gate, peers, caption)
+	if msg.Geo != nil {
+		p.sendLocation(ctx, gate, peers, msg)
+	}
+	return nil
+}
+
+// processButton emits a tap on a callback button as an interactive callback. The tap
+// is answered first, so the button stops loading whatever happens next.
+func (p *vkProvider) processButton(ctx context.Context, gate *vkmodel.VKGate, ev *messageEvent) error {
+	if ev.UserID <= 0 || ev.PeerID != ev.UserID {
+		return nil
+	}
+	if err := p.api.SendEventAnswer(ctx, gate.AccessToken, ev.EventID, ev.UserID, ev.PeerID); err != nil {
+		p.logger.Warn("button event left unanswered", "user_id", ev.UserID, "err", err)
+	}
+
+	if _, err := p.syncContact(ctx, gate, ev.UserID); err != nil {
+		return fmt.Errorf("sync contact [user_id=%d]: %w", ev.UserID, err)
+	}
+
+	peers := contactsync.InboundPeers(gate.ID, gate.Peer, strconv.FormatInt(ev.UserID, 10))
+	data := decodePayload(ev.Payload)
+	if err := p.messenger.SendInteractiveCallback(ctx, &sharedmodel.SendInteractiveCallbackRequest{
+		DomainID:     gate.DomainID,
+		From:         peers.From,
+		To:           peers.To,
+		InReplyTo:    data.MessageID,
+		ButtonCode:   data.Code,
+		CallbackData: data.Data,
+	}); err != nil {
+		return fmt.Errorf("send interactive callback [in_reply_to=%s]: %w", data.MessageID, err)
+	}
+	return nil
+}
+
+func (p *vkProvider) sendText(ctx context.Context, gate *vkmodel.VKGate, peers contactsync.Peers, text string) {
+	if text == "" {
+		return
+	}
+	if _, err := p.messenger.SendText(ctx, &sharedmodel.SendTextRequest{
+		DomainID: gate.DomainID,
+		From:     peers.From,
+		To:       peers.To,
+		Body:     text,
+	}); err != nil {
+		p.logger.Error("send text failed", "err", err)
+	}
+}
+
+func (p *vkProvider) sendLocation(ctx context.Context, gate *vkmodel.VKGate, peers contactsync.Peers, msg *message) {
+	req := &sharedmodel.SendLocationRequest{
+		DomainID:   int(gate.DomainID),
+		From:       peers.From,
+		To:         peers.To,
+		Latitude:   msg.Geo.Coordinates.Latitude,
+		Longitude:  msg.Geo.Coordinates.Longitude,
+		ExternalID: fmt.Sprint(msg.ID),
+	}
+	if msg.Geo.Place != nil && msg.Geo.Place.Title != "" {
+		req.Name = &msg.Geo.Place.Title
+	}
+
+	if _, err := p.messenger.SendLocation(ctx, req); err != nil {
+		p.logger.Error("send location failed", "err", err)
+	}
+}
@@ -0,0 +1,240 @@
+package vk
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	sharedstore "github.com/webitel/im-providers-service/internal/core/store"
+)
+
+// callbackBody returns a Callback API request of the test community.
+func callbackBody(eventType, object string) []byte {
+	return fmt.Appendf(nil, `{"type":%q,"event_id":"ev-1","v":"5.199","group_id":%d,"secret":%q,"object":%s}`,
+		eventType, testGroupID, testSecret, object)
+}
+
+func textMessage(text string) string {
+	return fmt.Sprintf(`{"message":{"id":17,"date":1700000000,"peer_id":%d,"from_id":%d,"text":%q,"attachments":[]},"client_info":{}}`,
+		testUserID, testUserID, text)
+}
+
+func TestValidateRequest(t *testing.T) {
+	valid := callbackBody(EventMessageNew, textMessage("hi"))
+
+	tests := []struct {
+		name    string
+		gateID  string
+		body    []byte
+		wantErr bool
+	}{
+		{name: "valid", gateID: testGateID, body: valid},
+		{name: "other secret", gateID: testGateID, body: []byte(strings.Replace(string(valid), testSecret, "other-secret", 1)), wantErr: true},
+		{name: "no secret", gateID: testGateID, body: fmt.Appendf(nil, `{"type":"message_new","group_id":%d,"object":{}}`, testGroupID), wantErr: true},
+		{name: "other community", gateID: testGateID, body: []byte(strings.Replace(string(valid), fmt.Sprint(testGroupID), "1", 1)), wantErr: true},
+		{name: "malformed body", gateID: testGateID, body: []byte(`{"type":`), wantErr: true},
+		{name: "unknown gate", gateID: "0190a8a4-0000-7000-8000-000000000000", body: valid, wantErr: true},
+		{name: "malformed uri", gateID: "not-a-gate", body: valid, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := newClubProvider(t)
+			err := p.ValidateRequest(webhookContext(tt.gateID), nil, tt.body)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ValidateRequest() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestResolveGate_MalformedURIIsNotFound(t *testing.T) {
+	p := newClubProvider(t)
+	if _, err := p.resolveGate(webhookContext("../gates")); !errors.Is(err, sharedstore.ErrNotFound) {
+		t.Fatalf("resolveGate() error = %v, want ErrNotFound", err)
+	}
+}
+
+func TestHandshake(t *testing.T) {
+	p := newClubProvider(t)
+	ctx := webhookContext(testGateID)
+
+	code, ok := p.Handshake(ctx, callbackBody(EventConfirmation, "{}"))
+	if !ok || code != testCode {
+		t.Fatalf("Handshake() = %q, %v; want the confirmation code", code, ok)
+	}
+
+	if _, ok := p.Handshake(ctx, callbackBody(EventMessageNew, textMessage("hi"))); ok {
+		t.Error("an event must not be answered as a confirmation")
+	}
+
+	p.gates[testGateID].Enabled = false
+	if _, ok := p.Handshake(ctx, callbackBody(EventConfirmation, "{}")); ok {
+		t.Error("a disabled gate must not be confirmed")
+	}
+}
+
+func TestAcknowledgement(t *testing.T) {
+	p := newClubProvider(t)
+	if got := p.Acknowledgement(); got != "ok" {
+		t.Errorf("Acknowledgement() = %q, want ok", got)
+	}
+}
+
+func TestHandleWebhook_Text(t *testing.T) {
+	p := newClubProvider(t)
+
+	if err := p.HandleWebhook(webhookContext(testGateID), callbackBody(EventMessageNew, textMessage("Привет"))); err != nil {
+		t.Fatalf("HandleWebhook: %v", err)
+	}
+
+	if len(p.messenger.Texts()) != 1 {
+		t.Fatalf("expected 1 text, got %d", len(p.messenger.Texts()))
+	}
+	got := p.messenger.Texts()[0]
+	if got.Body != "Привет" || got.DomainID != 1 {
+		t.Errorf("unexpected text request: %+v", got)
+	}
+	if got.From.Sub != fmt.Sprint(testUserID) || got.From.Iss != "vk" {
+		t.Errorf("unexpected sender peer: %+v", got.From)
+	}
+	if got.To.Sub != "bot-1" || got.To.Via == nil || *got.To.Via != testGateID {
+		t.Errorf("unexpected recipient peer: %+v", got.To)
+	}
+}
+
+func TestHandleWebhook_ChatMessageSkipped(t *testing.T) {
+	p := newClubProvider(t)
+
+	object := fmt.Sprintf(`{"message":{"id":0,"peer_id":2000000001,"from_id":%d,"text":"hi all"}}`, testUserID)
+	if err := p.HandleWebhook(webhookContext(testGateID), callbackBody(EventMessageNew, object)); err != nil {
+		t.Fatalf("HandleWebhook: %v", err)
+	}
+	if len(p.messenger.Texts()) != 0 {
+		t.Errorf("chat message must not be routed: %+v", p.messenger.Texts())
+	}
+}
+
+func TestHandleWebhook_DisabledGate(t *testing.T) {
+	p := newClubProvider(t)
+	p.gates[testGateID].Enabled = false
+
+	if err := p.HandleWebhook(webhookContext(testGateID), callbackBody(EventMessageNew, textMessage("hi"))); err != nil {
+		t.Fatalf("HandleWebhook: %v", err)
+	}
+	if len(p.messenger.Texts()) != 0 {
+		t.Errorf("disabled gate must not route messages: %+v", p.messenger.Texts())
+	}
+}
+
+func TestHandleWebhook_Photo(t *testing.T) {
+	p := newClubProvider(t)
+
+	object := fmt.Sprintf(`{"message":{"id":18,"peer_id":%d,"from_id":%d,"text":"look","attachments":[
+		{"type":"photo","photo":{"id":457239017,"owner_id":%d,"sizes":[
+			{"type":"s","url":"%s/files/small.jpg","width":75,"height":56},
+			{"type":"w","url":"%s/files/large.jpg","width":1280,"height":960},
+			{"type":"m","url":"%s/files/medium.jpg","width":130,"height":97}
+		]}}
+	]}}`, testUserID, testUserID, testUserID, p.stub.url, p.stub.url, p.stub.url)
+	if err := p.HandleWebhook(webhookContext(testGateID), callbackBody(EventMessageNew, object)); err != nil {
+		t.Fatalf("HandleWebhook: %v", err)
+	}
+
+	if len(p.media.Uploads()) != 1 || p.media.Uploads()[0].Body != "content of /files/large.jpg" {
+		t.Fatalf("the largest size must be synced: %+v", p.media.Uploads())
+	}
+	if up := p.media.Uploads()[0].Req; up.MimeType != "image/jpeg" || up.DomainID != 1 {
+		t.Errorf("unexpected upload request: %+v", up)
+	}
+	if len(p.messenger.Images()) != 1 {
+		t.Fatalf("expected 1 image, got %d", len(p.messenger.Images()))
+	}
+	img := p.messenger.Images()[0].Image
+	if img.Body != "look" || img.Images[0].ID != "file-1" || img.Images[0].FileName != fmt.Sprintf("vk_photo_%d_457239017.jpg", testUserID) {
+		t.Errorf("unexpected image: %+v / %+v", img, img.Images[0])
+	}
+	if len(p.messenger.Texts()) != 0 {
+		t.Errorf("the text must ride on the image as its caption: %+v", p.messenger.Texts())
+	}
+}
+
+func TestHandleWebhook_DocumentAndVoice(t *testing.T) {
+	p := newClubProvider(t)
+
+	object := fmt.Sprintf(`{"message":{"id":19,"peer_id":%d,"from_id":%d,"text":"","attachments":[
+		{"type":"doc","doc":{"id":1,"owner_id":%d,"title":"invoice","ext":"pdf","size":2048,"url":"%s/files/invoice"}},
+		{"type":"audio_message","audio_message":{"id":2,"link_mp3":"%s/files/voice.mp3"}},
+		{"type":"sticker","sticker":{"sticker_id":9}}
+	]}}`, testUserID, testUserID, testUserID, p.stub.url, p.stub.url)
+	if err := p.HandleWebhook(webhookContext(testGateID), callbackBody(EventMessageNew, object)); err != nil {
+		t.Fatalf("HandleWebhook: %v", err)
+	}
+
+	if len(p.messenger.Documents()) != 2 {
+		t.Fatalf("expected 2 documents, got %d", len(p.messenger.Documents()))
+	}
+	doc := p.messenger.Documents()[0].Document.Documents[0]
+	if doc.FileName != "invoice.pdf" || doc.Size != 2048 {
+		t.Errorf("unexpected document: %+v", doc)
+	}
+	if voice := p.messenger.Documents()[1].Document.Documents[0]; voice.FileName != "vk_voice_2.mp3" {
+		t.Errorf("unexpected voice message: %+v", voice)
+	}
+	if len(p.messenger.Texts()) != 0 {
+		t.Errorf("no text expected: %+v", p.messenger.Texts())
+	}
+}
+
+func TestHandleWebhook_Location(t *testing.T) {
+	p := newClubProvider(t)
+
+	object := fmt.Sprintf(`{"message":{"id":20,"peer_id":%d,"from_id":%d,"text":"",
+		"geo":{"type":"point","coordinates":{"latitude":55.7558,"longitude":37.6173},"place":{"title":"Moscow"}}}}`, testUserID, testUserID)
+	if err := p.HandleWebhook(webhookContext(testGateID), callbackBody(EventMessageNew, object)); err != nil {
+		t.Fatalf("HandleWebhook: %v", err)
+	}
+
+	if len(p.messenger.Locations()) != 1 {
+		t.Fatalf("expected 1 location, got %d", len(p.messenger.Locations()))
+	}
+	loc := p.messenger.Locations()[0]
+	if loc.Latitude != 55.7558 || loc.Longitude != 37.6173 || loc.Name == nil || *loc.Name != "Moscow" || loc.ExternalID != "20" {
+		t.Errorf("unexpected location: %+v", loc)
+	}
+}
+
+func TestHandleWebhook_ButtonTap(t *testing.T) {
+	p := newClubProvider(t)
+
+	object := fmt.Sprintf(`{"user_id":%d,"peer_id":%d,"event_id":"3a9f0c1e2b7d","payload":{"m":"msg-1","c":"yes","d":"confirm:42"},"conversation_message_id":5}`,
+		testUserID, testUserID)
+	if err := p.HandleWebhook(webhookContext(testGateID), callbackBody(EventMessageEvent, object)); err != nil {
+		t.Fatalf("HandleWebhook: %v", err)
+	}
+
+	answers := p.stub.methods("messages.sendMessageEventAnswer")
+	if len(answers) != 1 || answers[0].form.Get("event_id") != "3a9f0c1e2b7d" || answers[0].form.Get("user_id") != fmt.Sprint(testUserID) {
+		t.Fatalf("the tap must be answered: %+v", answers)
+	}
+	if answers[0].form.Get("access_token") != testToken {
+		t.Errorf("unexpected token: %q", answers[0].form.Get("access_token"))
+	}
+
+	if len(p.messenger.Callbacks()) != 1 {
+		t.Fatalf("expected 1 callback, got %d", len(p.messenger.Callbacks()))
+	}
+	cb := p.messenger.Callbacks()[0]
+	if cb.InReplyTo != "msg-1" || cb.ButtonCode != "yes" || cb.CallbackData != "confirm:42" {
+		t.Errorf("unexpected callback: %+v", cb)
+	}
+}
+
+func TestDecodePayload_Foreign(t *testing.T) {
+	// A payload set outside of the provider is passed on as the callback data.
+	got := decodePayload([]byte(`{"command":"start"}`))
+	if got.MessageID != "" || got.Data != `{"command":"start"}` {
+		t.Errorf("decodePayload() = %+v", got)
+	}
+}
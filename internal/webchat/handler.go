@@ -0,0 +1,333 @@
+package webchat
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	imgateway "github.com/webitel/im-providers-service/infra/client/grpc/im-gateway"
+	sharedsvc "github.com/webitel/im-providers-service/internal/core/service"
+	sharedstore "github.com/webitel/im-providers-service/internal/core/store"
+	wcmodel "github.com/webitel/im-providers-service/internal/webchat/model"
+	wcstore "github.com/webitel/im-providers-service/internal/webchat/store"
+)
+
+const (
+	// maxNameLength bounds the name a visitor introduces itself with, in runes.
+	maxNameLength = 100
+	// maxSessionRequest bounds the body of a session request.
+	maxSessionRequest = 4 << 10
+)
+
+type gateContextKey struct{}
+
+// Handler serves the widget API, mounted on BasePath:
+//
+//	POST {gate_id}/sessions  starts or resumes a visitor session, see sessionRequest
+//	GET  {gate_id}/ws        the socket: replays the history, then pushes the events
+//	                         and takes the frames of the widget, see clientFrame
+//	GET  {gate_id}/history   the history after the seq in ?after=
+//	POST {gate_id}/messages  a frame, for widgets without a socket
+//	POST {gate_id}/files     a multipart upload of a file, with an optional text
+//
+// The socket takes the visitor token in ?token=, the other endpoints but sessions
+// in the Authorization header as a bearer token.
+type Handler struct {
+	router    chi.Router
+	logger    *slog.Logger
+	messenger sharedsvc.Messenger
+	media     sharedsvc.MediaManager
+	gateCache sharedstore.GateCache
+	userCache sharedstore.ExternalUserCache
+	repo      wcstore.WebChatStore
+	events    wcstore.EventLog
+	gatewayer *imgateway.Client
+	hub       *hub
+	now       func() time.Time
+}
+
+func NewHandler(
+	l *slog.Logger,
+	m sharedsvc.Messenger,
+	media sharedsvc.MediaManager,
+	gc sharedstore.GateCache,
+	uc sharedstore.ExternalUserCache,
+	repo wcstore.WebChatStore,
+	events wcstore.EventLog,
+	gatewayer *imgateway.Client,
+) *Handler {
+	logger := l.With("provider", "webchat")
+	h := &Handler{
+		logger:    logger,
+		messenger: m,
+		media:     media,
+		gateCache: gc,
+		userCache: uc,
+		repo:      repo,
+		events:    events,
+		gatewayer: gatewayer,
+		hub:       newHub(events, logger),
+		now:       time.Now,
+	}
+
+	r := chi.NewRouter()
+	r.Route("/{gate}", func(r chi.Router) {
+		r.Use(h.withGate)
+		r.Post("/sessions", h.startSession)
+		r.Get("/ws", h.socket)
+		r.Get("/history", h.history)
+		r.Post("/messages", h.message)
+		r.Post("/files", h.upload)
+	})
+	h.router = r
+	return h
+}
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	h.router.ServeHTTP(w, r)
+}
+
+// withGate resolves the gate of the request and answers CORS: the widget runs on
+// the websites of the customer, the API is called cross-origin.
+func (h *Handler) withGate(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gate, err := h.resolveGate(r.Context(), chi.URLParam(r, "gate"))
+		if err != nil || !gate.Enabled {
+			if err != nil && !errors.Is(err, sharedstore.ErrNotFound) {
+				h.logger.Error("gate lookup failed", "err", err)
+			}
+			writeError(w, http.StatusNotFound, "web chat not found")
+			return
+		}
+
+		if origin := r.Header.Get("Origin"); origin != "" {
+			if !gate.AllowsOrigin(origin) {
+				writeError(w, http.StatusForbidden, "origin not allowed")
+				return
+			}
+			w.Header().Set("Access-Control-Allow-Origin", origin)
+			w.Header().Add("Vary", "Origin")
+		}
+		if r.Method == http.MethodOptions {
+			w.Header().Set("Access-Control-Allow-Methods", "GET, POST, OPTIONS")
+			w.Header().Set("Access-Control-Allow-Headers", "Authorization, Content-Type")
+			w.Header().Set("Access-Control-Max-Age", "600")
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+
+		next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), gateContextKey{}, gate)))
+	})
+}
+
+// resolveGate returns the gate of the widget. Disabled gates are short-circuited
+// from the cache to avoid a DB round-trip on every request.
+func (h *Handler) resolveGate(ctx context.Context, gateID string) (*wcmodel.WebChatGate, error) {
+	if _, err := uuid.Parse(gateID); err != nil {
+		return nil, fmt.Errorf("invalid gate id %q: %w", gateID, sharedstore.ErrNotFound)
+	}
+
+	if cached, ok := h.gateCache.Get(gateID); ok && !cached.Enabled {
+		return &wcmodel.WebChatGate{ID: gateID, Enabled: false}, nil
+	}
+
+	g, err := h.repo.Select(ctx, gateID)
+	if err != nil {
+		return nil, err
+	}
+	h.gateCache.Set(gateID, sharedstore.GateState{
+		GateID:  g.ID,
+		Enabled: g.Enabled,
+		Issuer:  g.Peer.Iss,
+		Sub:     g.Peer.Sub,
+		Domain:  g.DomainID,
+	})
+	return g, nil
+}
+
+func gateFrom(ctx context.Context) *wcmodel.WebChatGate {
+	g, _ := ctx.Value(gateContextKey{}).(*wcmodel.WebChatGate)
+	return g
+}
+
+// authorize returns the visitor of the bearer token of the request.
+func (h *Handler) authorize(w http.ResponseWriter, r *http.Request, gate *wcmodel.WebChatGate) (*visitorClaims, bool) {
+	token, _ := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+	claims, err := parseVisitor(gate.SigningKey, gate.ID, token, h.now())
+	if err != nil {
+		writeError(w, http.StatusUnauthorized, "invalid visitor token")
+		return nil, false
+	}
+	return claims, true
+}
+
+// startSession issues a visitor token. A live token of the gate resumes its visitor,
+// anything else starts a new one.
+func (h *Handler) startSession(w http.ResponseWriter, r *http.Request) {
+	gate := gateFrom(r.Context())
+
+	var req sessionRequest
+	if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxSessionRequest)).Decode(&req); err != nil && !errors.Is(err, io.EOF) {
+		writeError(w, http.StatusBadRequest, "malformed session request")
+		return
+	}
+
+	var claims visitorClaims
+	if req.Token != "" {
+		if resumed, err := parseVisitor(gate.SigningKey, gate.ID, req.Token, h.now()); err == nil {
+			claims = *resumed
+		}
+	}
+	if claims.Visitor == "" {
+		visitorID, err := newVisitorID()
+		if err != nil {
+			h.logger.Error("failed to start session", "gate_id", gate.ID, "err", err)
+			writeError(w, http.StatusInternalServerError, "failed to start session")
+			return
+		}
+		claims = visitorClaims{Gate: gate.ID, Visitor: visitorID}
+	}
+	if name := strings.TrimSpace(req.Name); name != "" {
+		claims.Name = truncate(name, maxNameLength)
+	}
+	expires := h.now().Add(sessionTTL)
+	claims.Expires = expires.Unix()
+
+	token, err := signVisitor(gate.SigningKey, claims)
+	if err != nil {
+		h.logger.Error("failed to start session", "gate_id", gate.ID, "err", err)
+		writeError(w, http.StatusInternalServerError, "failed to start session")
+		return
+	}
+	writeJSON(w, http.StatusOK, session{Token: token, VisitorID: claims.Visitor, ExpiresAt: expires.UnixMilli()})
+}
+
+// history returns the messages after ?after=, or the latest ones without it.
+func (h *Handler) history(w http.ResponseWriter, r *http.Request) {
+	gate := gateFrom(r.Context())
+	claims, ok := h.authorize(w, r, gate)
+	if !ok {
+		return
+	}
+
+	limit := replayLimit
+	if n, err := strconv.Atoi(r.URL.Query().Get("limit")); err == nil && n > 0 && n < limit {
+		limit = n
+	}
+	events, err := h.events.Since(r.Context(), gate.ID, claims.Visitor, r.URL.Query().Get("after"), limit)
+	if err != nil {
+		h.logger.Error("failed to read history", "gate_id", gate.ID, "visitor_id", claims.Visitor, "err", err)
+		writeError(w, http.StatusInternalServerError, "failed to read history")
+		return
+	}
+	writeJSON(w, http.StatusOK, map[string][]*wcmodel.Event{"events": events})
+}
+
+// message takes a frame over HTTP. A message is answered with its event.
+func (h *Handler) message(w http.ResponseWriter, r *http.Request) {
+	gate := gateFrom(r.Context())
+	claims, ok := h.authorize(w, r, gate)
+	if !ok {
+		return
+	}
+
+	var f clientFrame
+	if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxFrameSize)).Decode(&f); err != nil {
+		writeError(w, http.StatusBadRequest, "malformed frame")
+		return
+	}
+	ev, err := h.dispatch(r.Context(), gate, claims, r.Header.Get("Origin"), &f)
+	if err != nil {
+		h.writeDispatchError(w, err)
+		return
+	}
+	if ev == nil {
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+	writeJSON(w, http.StatusOK, ev)
+}
+
+// upload takes a file in the "file" field of a multipart form, with an optional
+// caption in "text" and a message ID in "id". It is answered with the message event.
+func (h *Handler) upload(w http.ResponseWriter, r *http.Request) {
+	gate := gateFrom(r.Context())
+	claims, ok := h.authorize(w, r, gate)
+	if !ok {
+		return
+	}
+
+	r.Body = http.MaxBytesReader(w, r.Body, maxUploadSize+maxFrameSize)
+	if err := r.ParseMultipartForm(1 << 20); err != nil {
+		writeError(w, http.StatusRequestEntityTooLarge, "file too large or malformed form")
+		return
+	}
+	defer func() { _ = r.MultipartForm.RemoveAll() }()
+
+	file, header, err := r.FormFile("file")
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "form has no file")
+		return
+	}
+	defer file.Close()
+
+	mimeType := header.Header.Get("Content-Type")
+	if mimeType == "" {
+		mimeType = "application/octet-stream"
+	}
+	ev, err := h.sendFile(r.Context(), gate, claims, r.Header.Get("Origin"), upload{
+		body:     file,
+		name:     header.Filename,
+		mimeType: mimeType,
+		text:     r.FormValue("text"),
+		clientID: r.FormValue("id"),
+	})
+	if err != nil {
+		h.writeDispatchError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, ev)
+}
+
+// writeDispatchError answers a frame the widget got wrong with 400, anything else with 502.
+func (h *Handler) writeDispatchError(w http.ResponseWriter, err error) {
+	if isFrameError(err) {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	writeError(w, http.StatusBadGateway, "failed to deliver the message")
+}
+
+// isFrameError reports whether the error is caused by the frame rather than by the delivery.
+func isFrameError(err error) bool {
+	return errors.Is(err, errUnknownFrame) || errors.Is(err, errEmptyMessage) ||
+		errors.Is(err, errTextTooLong) || errors.Is(err, errBadCallback)
+}
+
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func writeError(w http.ResponseWriter, status int, msg string) {
+	writeJSON(w, status, serverFrame{Type: frameError, Error: msg})
+}
+
+// truncate cuts s to at most n runes.
+func truncate(s string, n int) string {
+	if utf8.RuneCountInString(s) <= n {
+		return s
+	}
+	return string([]rune(s)[:n])
+}
@@ -0,0 +1,134 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	impb "github.com/webitel/im-providers-service/gen/go/provider/v1"
+	"github.com/webitel/im-providers-service/internal/provider/gaterpc"
+	wcmodel "github.com/webitel/im-providers-service/internal/webchat/model"
+	wcservice "github.com/webitel/im-providers-service/internal/webchat/service"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type WebChatHandler struct {
+	logger *slog.Logger
+	srv    wcservice.WebChatManager
+	impb.UnimplementedWebChatServiceServer
+}
+
+func NewWebChatHandler(logger *slog.Logger, srv wcservice.WebChatManager) *WebChatHandler {
+	return &WebChatHandler{logger: logger, srv: srv}
+}
+
+func (h *WebChatHandler) CreateWebChatGate(ctx context.Context, req *impb.ProviderCreateWebChatGateRequest) (*impb.ProviderCreateWebChatGateResponse, error) {
+	domainID, err := gaterpc.DomainID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	create := wcmodel.CreateWebChat{
+		Name:           req.GetName(),
+		Dc:             domainID,
+		AllowedOrigins: req.GetAllowedOrigins(),
+	}
+	if peer := gaterpc.Peer(req.GetPeer()); peer != nil {
+		create.Peer = *peer
+	}
+
+	gate, err := h.srv.CreateGate(ctx, create)
+	if err != nil {
+		return nil, toStatus(err, "create gate")
+	}
+
+	return &impb.ProviderCreateWebChatGateResponse{Item: gateToProto(gate)}, nil
+}
+
+func (h *WebChatHandler) GetWebChatGate(ctx context.Context, req *impb.ProviderGetWebChatGateRequest) (*impb.ProviderGetWebChatGateResponse, error) {
+	gate, err := h.gate(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	return &impb.ProviderGetWebChatGateResponse{Item: gateToProto(gate)}, nil
+}
+
+func (h *WebChatHandler) UpdateWebChatGate(ctx context.Context, req *impb.ProviderUpdateWebChatGateRequest) (*impb.ProviderUpdateWebChatGateResponse, error) {
+	if _, err := h.gate(ctx, req.GetId()); err != nil {
+		return nil, err
+	}
+
+	update := wcmodel.UpdateWebChat{
+		ID:        req.GetId(),
+		Name:      req.Name,
+		Enabled:   req.Enabled,
+		Peer:      gaterpc.Peer(req.GetPeer()),
+		RotateKey: req.GetRotateKey(),
+	}
+	if origins := req.GetAllowedOrigins(); origins != nil {
+		allowed := origins.GetOrigins()
+		update.AllowedOrigins = &allowed
+	}
+
+	gate, err := h.srv.UpdateGate(ctx, update)
+	if err != nil {
+		return nil, toStatus(err, "update gate")
+	}
+
+	return &impb.ProviderUpdateWebChatGateResponse{Item: gateToProto(gate)}, nil
+}
+
+func (h *WebChatHandler) DeleteWebChatGate(ctx context.Context, req *impb.ProviderDeleteWebChatGateRequest) (*impb.ProviderDeleteWebChatGateResponse, error) {
+	if _, err := h.gate(ctx, req.GetId()); err != nil {
+		return nil, err
+	}
+
+	gate, err := h.srv.DeleteGate(ctx, req.GetId())
+	if err != nil {
+		return nil, toStatus(err, "delete gate")
+	}
+
+	return &impb.ProviderDeleteWebChatGateResponse{Item: gateToProto(gate)}, nil
+}
+
+// gate returns the gate when it belongs to the domain of the caller.
+func (h *WebChatHandler) gate(ctx context.Context, id string) (*wcmodel.WebChatGate, error) {
+	gate, err := h.srv.GetGate(ctx, id)
+	if err != nil {
+		return nil, toStatus(err, "get gate")
+	}
+	if err := gaterpc.CheckDomain(ctx, gate.DomainID); err != nil {
+		return nil, err
+	}
+	return gate, nil
+}
+
+// toStatus reports a missing public URL as a precondition: the widget has no endpoint
+// to connect to without it.
+func toStatus(err error, internalMsg string) error {
+	if errors.Is(err, wcservice.ErrPublicURLNotSet) {
+		return status.Error(codes.FailedPrecondition, err.Error())
+	}
+	return gaterpc.ToStatus(err, internalMsg)
+}
+
+// gateToProto leaves out the signing key: visitor tokens are issued by the service,
+// never by the website.
+func gateToProto(g *wcmodel.WebChatGate) *impb.ProviderWebChatGate {
+	if g == nil {
+		return nil
+	}
+	return &impb.ProviderWebChatGate{
+		Id:             g.ID,
+		Name:           g.Name,
+		Peer:           gaterpc.ToProtoPeer(g.Peer),
+		AllowedOrigins: g.AllowedOrigins,
+		EndpointUrl:    g.EndpointURL,
+		Status:         impb.ProviderStatus(g.Status),
+		CreatedAt:      g.CreatedAt.UnixMilli(),
+		UpdatedAt:      g.UpdatedAt.UnixMilli(),
+		Enabled:        g.Enabled,
+	}
+}
@@ -0,0 +1,211 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"testing"
+
+	impb "github.com/webitel/im-providers-service/gen/go/provider/v1"
+	"github.com/webitel/im-providers-service/infra/auth"
+	sharedstore "github.com/webitel/im-providers-service/internal/core/store"
+	wcmodel "github.com/webitel/im-providers-service/internal/webchat/model"
+	wcservice "github.com/webitel/im-providers-service/internal/webchat/service"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type webmaster struct{ domainID int64 }
+
+func (w webmaster) GetContactID() string { return "" }
+func (w webmaster) GetDomainID() int64   { return w.domainID }
+func (w webmaster) GetName() string      { return "" }
+
+func webmasterContext(domainID int64) context.Context {
+	return context.WithValue(context.Background(), auth.AuthContextKey, webmaster{domainID: domainID})
+}
+
+// widgetConsole keeps the widgets like the web chat service does and records the
+// updates it is asked for; it fails with err when it is set.
+type widgetConsole struct {
+	gates   map[string]*wcmodel.WebChatGate
+	updates []wcmodel.UpdateWebChat
+	deleted []string
+	err     error
+}
+
+var _ wcservice.WebChatManager = (*widgetConsole)(nil)
+
+func newWidgetConsole(gates ...*wcmodel.WebChatGate) *widgetConsole {
+	c := &widgetConsole{gates: map[string]*wcmodel.WebChatGate{}}
+	for _, g := range gates {
+		c.gates[g.ID] = g
+	}
+	return c
+}
+
+func (c *widgetConsole) CreateGate(_ context.Context, req wcmodel.CreateWebChat) (*wcmodel.WebChatGate, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	if c.err != nil {
+		return nil, c.err
+	}
+	gate := &wcmodel.WebChatGate{
+		ID:             fmt.Sprintf("gate-%d", len(c.gates)+1),
+		DomainID:       req.Dc,
+		Name:           req.Name,
+		Peer:           req.Peer,
+		AllowedOrigins: req.AllowedOrigins,
+		SigningKey:     "issued-key",
+		Enabled:        true,
+	}
+	gate.EndpointURL = "https://im.example.com/webchat/" + gate.ID
+	c.gates[gate.ID] = gate
+	return gate, nil
+}
+
+func (c *widgetConsole) GetGate(_ context.Context, id string) (*wcmodel.WebChatGate, error) {
+	g, ok := c.gates[id]
+	if !ok {
+		return nil, sharedstore.ErrNotFound
+	}
+	return g, nil
+}
+
+func (c *widgetConsole) UpdateGate(_ context.Context, req wcmodel.UpdateWebChat) (*wcmodel.WebChatGate, error) {
+	c.updates = append(c.updates, req)
+	gate := c.gates[req.ID]
+	req.ApplyTo(gate)
+	if req.RotateKey {
+		gate.SigningKey = "rotated-key"
+	}
+	return gate, nil
+}
+
+func (c *widgetConsole) DeleteGate(_ context.Context, id string) (*wcmodel.WebChatGate, error) {
+	c.deleted = append(c.deleted, id)
+	return c.gates[id], nil
+}
+
+// storefront is the widget of a shop that may be embedded on its two websites only.
+func storefront() *wcmodel.WebChatGate {
+	return &wcmodel.WebChatGate{
+		ID:             "gate-1",
+		DomainID:       7,
+		Name:           "Storefront",
+		AllowedOrigins: []string{"https://shop.example.com", "https://m.shop.example.com"},
+		SigningKey:     "issued-key",
+		EndpointURL:    "https://im.example.com/webchat/gate-1",
+		Enabled:        true,
+	}
+}
+
+func newHandler(c *widgetConsole) *WebChatHandler {
+	return NewWebChatHandler(slog.New(slog.NewTextHandler(io.Discard, nil)), c)
+}
+
+func TestCreateWebChatGate_ReturnsEndpoint(t *testing.T) {
+	c := newWidgetConsole()
+
+	resp, err := newHandler(c).CreateWebChatGate(webmasterContext(7), &impb.ProviderCreateWebChatGateRequest{
+		Name:           "Storefront",
+		AllowedOrigins: []string{"https://shop.example.com"},
+		Peer:           &impb.Peer{Sub: "shop-bot", Iss: "webchat"},
+	})
+	if err != nil {
+		t.Fatalf("CreateWebChatGate: %v", err)
+	}
+	item := resp.GetItem()
+	if item.GetEndpointUrl() != "https://im.example.com/webchat/gate-1" || len(item.GetAllowedOrigins()) != 1 || item.GetPeer().GetSub() != "shop-bot" {
+		t.Errorf("unexpected gate: %+v", item)
+	}
+	if g := c.gates["gate-1"]; g.DomainID != 7 {
+		t.Errorf("stored gate = %+v", g)
+	}
+}
+
+func TestCreateWebChatGate_ErrorCodes(t *testing.T) {
+	tests := []struct {
+		name    string
+		gate    string
+		failure error
+		want    codes.Code
+	}{
+		{name: "no name", want: codes.InvalidArgument},
+		{name: "no public url", gate: "Blog", failure: wcservice.ErrPublicURLNotSet, want: codes.FailedPrecondition},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := newWidgetConsole()
+			c.err = tt.failure
+
+			_, err := newHandler(c).CreateWebChatGate(webmasterContext(7), &impb.ProviderCreateWebChatGateRequest{Name: tt.gate})
+			if status.Code(err) != tt.want {
+				t.Errorf("code = %v, want %v", status.Code(err), tt.want)
+			}
+		})
+	}
+}
+
+func TestUpdateWebChatGate_Origins(t *testing.T) {
+	tests := []struct {
+		name    string
+		origins *impb.ProviderWebChatOrigins
+		want    []string
+	}{
+		{name: "unset keeps them", want: storefront().AllowedOrigins},
+		{name: "empty list allows any website", origins: &impb.ProviderWebChatOrigins{}, want: nil},
+		{name: "list replaces them", origins: &impb.ProviderWebChatOrigins{Origins: []string{"https://outlet.example.com"}}, want: []string{"https://outlet.example.com"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := newWidgetConsole(storefront())
+
+			resp, err := newHandler(c).UpdateWebChatGate(webmasterContext(7), &impb.ProviderUpdateWebChatGateRequest{Id: "gate-1", AllowedOrigins: tt.origins})
+			if err != nil {
+				t.Fatalf("UpdateWebChatGate: %v", err)
+			}
+			if got := resp.GetItem().GetAllowedOrigins(); fmt.Sprint(got) != fmt.Sprint(tt.want) {
+				t.Errorf("origins = %v, want %v", got, tt.want)
+			}
+			if c.gates["gate-1"].Name != "Storefront" {
+				t.Error("name changed without being set")
+			}
+		})
+	}
+}
+
+func TestUpdateWebChatGate_RotatesKey(t *testing.T) {
+	c := newWidgetConsole(storefront())
+
+	if _, err := newHandler(c).UpdateWebChatGate(webmasterContext(7), &impb.ProviderUpdateWebChatGateRequest{Id: "gate-1", RotateKey: true}); err != nil {
+		t.Fatalf("UpdateWebChatGate: %v", err)
+	}
+	if len(c.updates) != 1 || !c.updates[0].RotateKey {
+		t.Fatalf("updates = %+v", c.updates)
+	}
+	if c.gates["gate-1"].SigningKey != "rotated-key" {
+		t.Error("signing key not rotated")
+	}
+}
+
+func TestWebChatGate_OtherDomainIsNotFound(t *testing.T) {
+	c := newWidgetConsole(storefront())
+	h := newHandler(c)
+	ctx := webmasterContext(8)
+
+	if _, err := h.GetWebChatGate(ctx, &impb.ProviderGetWebChatGateRequest{Id: "gate-1"}); status.Code(err) != codes.NotFound {
+		t.Errorf("get: code = %v, want NotFound", status.Code(err))
+	}
+	if _, err := h.UpdateWebChatGate(ctx, &impb.ProviderUpdateWebChatGateRequest{Id: "gate-1", RotateKey: true}); status.Code(err) != codes.NotFound {
+		t.Errorf("update: code = %v, want NotFound", status.Code(err))
+	}
+	if _, err := h.DeleteWebChatGate(ctx, &impb.ProviderDeleteWebChatGateRequest{Id: "gate-1"}); status.Code(err) != codes.NotFound {
+		t.Errorf("delete: code = %v, want NotFound", status.Code(err))
+	}
+	if len(c.updates) != 0 || len(c.deleted) != 0 {
+		t.Errorf("gate of another domain changed: updates %+v, deleted %v", c.updates, c.deleted)
+	}
+}
@@ -0,0 +1,261 @@
+package webchat
+
+import (
+	"bytes"
+	"encoding/json"
+	"mime/multipart"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	wcmodel "github.com/webitel/im-providers-service/internal/webchat/model"
+)
+
+// call performs a request of the widget from testOrigin, with the visitor token when set.
+func (h *widgetServer) call(t *testing.T, method, path, token, contentType string, body []byte) *http.Response {
+	t.Helper()
+
+	req, err := http.NewRequest(method, h.server.URL+"/"+testGateID+path, bytes.NewReader(body))
+	if err != nil {
+		t.Fatalf("request: %v", err)
+	}
+	req.Header.Set("Origin", testOrigin)
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("%s %s: %v", method, path, err)
+	}
+	t.Cleanup(func() { _ = resp.Body.Close() })
+	return resp
+}
+
+func decode[T any](t *testing.T, resp *http.Response) T {
+	t.Helper()
+
+	var v T
+	if err := json.NewDecoder(resp.Body).Decode(&v); err != nil {
+		t.Fatalf("decode: %v", err)
+	}
+	return v
+}
+
+func TestStartSession(t *testing.T) {
+	h := newWidgetServer(t)
+
+	resp := h.call(t, http.MethodPost, "/sessions", "", "application/json", []byte(`{"name":"  Anna  "}`))
+	if resp.StatusCode != http.StatusOK || resp.Header.Get("Access-Control-Allow-Origin") != testOrigin {
+		t.Fatalf("status = %d, CORS origin = %q", resp.StatusCode, resp.Header.Get("Access-Control-Allow-Origin"))
+	}
+	started := decode[session](t, resp)
+	claims, err := parseVisitor(testKey, testGateID, started.Token, testNow)
+	if err != nil || claims.Visitor != started.VisitorID || claims.Name != "Anna" {
+		t.Fatalf("token claims = %+v, %v", claims, err)
+	}
+	if started.ExpiresAt != testNow.Add(sessionTTL).UnixMilli() {
+		t.Errorf("expires_at = %d", started.ExpiresAt)
+	}
+
+	// A live token resumes the visitor and keeps its name.
+	body, _ := json.Marshal(sessionRequest{Token: started.Token})
+	resumed := decode[session](t, h.call(t, http.MethodPost, "/sessions", "", "application/json", body))
+	claims, _ = parseVisitor(testKey, testGateID, resumed.Token, testNow)
+	if resumed.VisitorID != started.VisitorID || claims == nil || claims.Name != "Anna" {
+		t.Errorf("resumed session = %+v, claims = %+v", resumed, claims)
+	}
+
+	// A token of another gate starts a new visitor.
+	foreign, _ := signVisitor(testKey, visitorClaims{Gate: "other-gate", Visitor: "v_1", Expires: testNow.Add(time.Hour).Unix()})
+	body, _ = json.Marshal(sessionRequest{Token: foreign})
+	fresh := decode[session](t, h.call(t, http.MethodPost, "/sessions", "", "", body))
+	if fresh.VisitorID == "v_1" || fresh.VisitorID == started.VisitorID {
+		t.Errorf("foreign token resumed visitor %q", fresh.VisitorID)
+	}
+}
+
+func TestGateAccess(t *testing.T) {
+	h := newWidgetServer(t)
+
+	// Preflight.
+	resp := h.call(t, http.MethodOptions, "/messages", "", "", nil)
+	if resp.StatusCode != http.StatusNoContent || !strings.Contains(resp.Header.Get("Access-Control-Allow-Headers"), "Authorization") {
+		t.Errorf("preflight: status = %d, headers = %v", resp.StatusCode, resp.Header)
+	}
+
+	req, _ := http.NewRequest(http.MethodPost, h.server.URL+"/"+testGateID+"/sessions", nil)
+	req.Header.Set("Origin", "https://evil.example.com")
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("request: %v", err)
+	}
+	_ = resp.Body.Close()
+	if resp.StatusCode != http.StatusForbidden || resp.Header.Get("Access-Control-Allow-Origin") != "" {
+		t.Errorf("foreign origin: status = %d", resp.StatusCode)
+	}
+
+	resp, err = http.Post(h.server.URL+"/not-a-gate/sessions", "application/json", nil)
+	if err != nil {
+		t.Fatalf("request: %v", err)
+	}
+	_ = resp.Body.Close()
+	if resp.StatusCode != http.StatusNotFound {
+		t.Errorf("unknown gate: status = %d", resp.StatusCode)
+	}
+
+	h.widgets[testGateID].Enabled = false
+	if resp := h.call(t, http.MethodPost, "/sessions", "", "", nil); resp.StatusCode != http.StatusNotFound {
+		t.Errorf("disabled gate: status = %d", resp.StatusCode)
+	}
+}
+
+func TestMessage(t *testing.T) {
+	h := newWidgetServer(t)
+	token := h.token(t, "v_1")
+
+	if resp := h.call(t, http.MethodPost, "/messages", "", "", []byte(`{"type":"message","text":"hi"}`)); resp.StatusCode != http.StatusUnauthorized {
+		t.Errorf("no token: status = %d", resp.StatusCode)
+	}
+
+	resp := h.call(t, http.MethodPost, "/messages", token, "application/json", []byte(`{"type":"message","id":"c-1","text":" Where is my order? "}`))
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("status = %d", resp.StatusCode)
+	}
+	ev := decode[wcmodel.Event](t, resp)
+	if ev.Text != "Where is my order?" || ev.ClientID != "c-1" || ev.From != wcmodel.FromVisitor || ev.Seq == "" {
+		t.Errorf("event = %+v", ev)
+	}
+
+	texts := h.messenger.Texts()
+	if len(texts) != 1 {
+		t.Fatalf("sent %d texts", len(texts))
+	}
+	got := texts[0]
+	if got.Body != "Where is my order?" || got.DomainID != 1 || got.From.Sub != "v_1" || got.To.Sub != "bot-1" || got.To.Via == nil || *got.To.Via != testGateID {
+		t.Errorf("sent text = %+v", got)
+	}
+
+	history, _ := h.events.Since(t.Context(), testGateID, "v_1", "", replayLimit)
+	if len(history) != 1 || history[0].Text != "Where is my order?" {
+		t.Errorf("history = %+v", history)
+	}
+
+	tests := []struct {
+		name  string
+		frame string
+		want  int
+	}{
+		{"empty", `{"type":"message","text":"  "}`, http.StatusBadRequest},
+		{"too long", `{"type":"message","text":"` + strings.Repeat("a", maxTextLength+1) + `"}`, http.StatusBadRequest},
+		{"unknown", `{"type":"sticker"}`, http.StatusBadRequest},
+		{"malformed", `{"type":`, http.StatusBadRequest},
+		{"typing", `{"type":"typing"}`, http.StatusNoContent},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if resp := h.call(t, http.MethodPost, "/messages", token, "", []byte(tt.frame)); resp.StatusCode != tt.want {
+				t.Errorf("status = %d, want %d", resp.StatusCode, tt.want)
+			}
+		})
+	}
+	if n := len(h.messenger.Texts()); n != 1 {
+		t.Errorf("rejected frames sent: %d texts", n)
+	}
+}
+
+func TestCallback(t *testing.T) {
+	h := newWidgetServer(t)
+	token := h.token(t, "v_1")
+
+	resp := h.call(t, http.MethodPost, "/messages", token, "", []byte(`{"type":"callback","in_reply_to":"msg-1","code":"yes","data":"order:42"}`))
+	if resp.StatusCode != http.StatusNoContent {
+		t.Fatalf("status = %d", resp.StatusCode)
+	}
+	if len(h.messenger.Callbacks()) != 1 {
+		t.Fatalf("sent %d callbacks", len(h.messenger.Callbacks()))
+	}
+	cb := h.messenger.Callbacks()[0]
+	if cb.InReplyTo != "msg-1" || cb.ButtonCode != "yes" || cb.CallbackData != "order:42" || cb.From.Sub != "v_1" {
+		t.Errorf("callback = %+v", cb)
+	}
+
+	if resp := h.call(t, http.MethodPost, "/messages", token, "", []byte(`{"type":"callback","code":"yes"}`)); resp.StatusCode != http.StatusBadRequest {
+		t.Errorf("callback without message: status = %d", resp.StatusCode)
+	}
+}
+
+func TestUpload(t *testing.T) {
+	tests := []struct {
+		name     string
+		mimeType string
+		image    bool
+	}{
+		{"image", "image/png", true},
+		{"document", "application/pdf", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := newWidgetServer(t)
+
+			var body bytes.Buffer
+			mw := multipart.NewWriter(&body)
+			_ = mw.WriteField("text", "see attached")
+			_ = mw.WriteField("id", "c-2")
+			part, _ := mw.CreatePart(map[string][]string{
+				"Content-Disposition": {`form-data; name="file"; filename="order.bin"`},
+				"Content-Type":        {tt.mimeType},
+			})
+			_, _ = part.Write([]byte("file content"))
+			_ = mw.Close()
+
+			resp := h.call(t, http.MethodPost, "/files", h.token(t, "v_1"), mw.FormDataContentType(), body.Bytes())
+			if resp.StatusCode != http.StatusOK {
+				t.Fatalf("status = %d", resp.StatusCode)
+			}
+			ev := decode[wcmodel.Event](t, resp)
+			if ev.Text != "see attached" || ev.ClientID != "c-2" || len(ev.Files) != 1 || ev.Files[0].ID != "file-1" || ev.Files[0].MimeType != tt.mimeType {
+				t.Errorf("event = %+v", ev)
+			}
+
+			if len(h.media.Uploads()) != 1 || h.media.Uploads()[0].Body != "file content" || h.media.Uploads()[0].Req.Name != "order.bin" {
+				t.Fatalf("uploads = %+v", h.media.Uploads())
+			}
+			if tt.image {
+				if len(h.messenger.Images()) != 1 || h.messenger.Images()[0].Image.Body != "see attached" || h.messenger.Images()[0].Image.Images[0].ID != "file-1" {
+					t.Errorf("images = %+v", h.messenger.Images())
+				}
+				return
+			}
+			if len(h.messenger.Documents()) != 1 || h.messenger.Documents()[0].Document.Documents[0].FileName != "order.bin" {
+				t.Errorf("documents = %+v", h.messenger.Documents())
+			}
+		})
+	}
+}
+
+func TestHistory(t *testing.T) {
+	h := newWidgetServer(t)
+	token := h.token(t, "v_1")
+
+	for _, text := range []string{"one", "two", "three"} {
+		_ = h.events.Append(t.Context(), &wcmodel.Event{Type: wcmodel.EventMessage, GateID: testGateID, VisitorID: "v_1", Text: text})
+	}
+	_ = h.events.Append(t.Context(), &wcmodel.Event{Type: wcmodel.EventMessage, GateID: testGateID, VisitorID: "v_2", Text: "other visitor"})
+
+	type page struct {
+		Events []*wcmodel.Event `json:"events"`
+	}
+	all := decode[page](t, h.call(t, http.MethodGet, "/history", token, "", nil))
+	if len(all.Events) != 3 || all.Events[0].Text != "one" || all.Events[2].Text != "three" {
+		t.Fatalf("history = %+v", all.Events)
+	}
+
+	after := decode[page](t, h.call(t, http.MethodGet, "/history?after="+all.Events[0].Seq+"&limit=1", token, "", nil))
+	if len(after.Events) != 1 || after.Events[0].Text != "two" {
+		t.Errorf("history after %s = %+v", all.Events[0].Seq, after.Events)
+	}
+}
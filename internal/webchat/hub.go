@@ -0,0 +1,143 @@
+package webchat
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strconv"
+	"strings"
+	"sync"
+
+	wcmodel "github.com/webitel/im-providers-service/internal/webchat/model"
+	wcstore "github.com/webitel/im-providers-service/internal/webchat/store"
+)
+
+// clientBuffer is the number of events a slow connection may lag behind before it is dropped.
+const clientBuffer = 64
+
+// hub delivers the events of the event log to the connections of the visitors on
+// this instance.
+type hub struct {
+	events wcstore.EventLog
+	logger *slog.Logger
+
+	mu      sync.Mutex
+	clients map[string]map[*client]struct{}
+	cancel  context.CancelFunc
+	done    chan struct{}
+}
+
+// client is a connection of a visitor. A visitor may hold several, one per tab.
+type client struct {
+	key    string
+	events chan *wcmodel.Event
+	// dropped is closed when the connection lags too far behind; the widget
+	// reconnects and replays what it missed.
+	dropped chan struct{}
+	once    sync.Once
+}
+
+func newHub(events wcstore.EventLog, logger *slog.Logger) *hub {
+	return &hub{
+		events:  events,
+		logger:  logger,
+		clients: make(map[string]map[*client]struct{}),
+	}
+}
+
+// Start subscribes to the event log.
+func (h *hub) Start(context.Context) error {
+	ctx, cancel := context.WithCancel(context.Background())
+	events, err := h.events.Subscribe(ctx)
+	if err != nil {
+		cancel()
+		return fmt.Errorf("webchat: %w", err)
+	}
+
+	h.cancel = cancel
+	h.done = make(chan struct{})
+	go func() {
+		defer close(h.done)
+		for ev := range events {
+			h.deliver(ev)
+		}
+	}()
+	return nil
+}
+
+// Stop unsubscribes from the event log.
+func (h *hub) Stop(ctx context.Context) error {
+	if h.cancel == nil {
+		return nil
+	}
+	h.cancel()
+	select {
+	case <-h.done:
+	case <-ctx.Done():
+	}
+	return nil
+}
+
+func (h *hub) register(gateID, visitorID string) *client {
+	c := &client{
+		key:     clientKey(gateID, visitorID),
+		events:  make(chan *wcmodel.Event, clientBuffer),
+		dropped: make(chan struct{}),
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if h.clients[c.key] == nil {
+		h.clients[c.key] = make(map[*client]struct{})
+	}
+	h.clients[c.key][c] = struct{}{}
+	return c
+}
+
+func (h *hub) unregister(c *client) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	delete(h.clients[c.key], c)
+	if len(h.clients[c.key]) == 0 {
+		delete(h.clients, c.key)
+	}
+}
+
+// deliver hands the event to the connections of its visitor without blocking.
+func (h *hub) deliver(ev *wcmodel.Event) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	for c := range h.clients[clientKey(ev.GateID, ev.VisitorID)] {
+		select {
+		case c.events <- ev:
+		default:
+			h.logger.Warn("slow web chat connection dropped", "gate_id", ev.GateID, "visitor_id", ev.VisitorID)
+			c.once.Do(func() { close(c.dropped) })
+		}
+	}
+}
+
+func clientKey(gateID, visitorID string) string {
+	return gateID + ":" + visitorID
+}
+
+// seqAfter reports whether the history position a is after b. Positions are
+// Redis stream IDs, "<milliseconds>-<sequence>"; any position is after an empty one.
+func seqAfter(a, b string) bool {
+	if b == "" {
+		return true
+	}
+	aMs, aSeq := splitSeq(a)
+	bMs, bSeq := splitSeq(b)
+	if aMs != bMs {
+		return aMs > bMs
+	}
+	return aSeq > bSeq
+}
+
+func splitSeq(s string) (ms, seq uint64) {
+	head, tail, _ := strings.Cut(s, "-")
+	ms, _ = strconv.ParseUint(head, 10, 64)
+	seq, _ = strconv.ParseUint(tail, 10, 64)
+	return ms, seq
+}
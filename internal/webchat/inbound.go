@@ -0,0 +1,192 @@
+package webchat
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+
+	sharedmodel "github.com/webitel/im-providers-service/internal/core/model"
+	"github.com/webitel/im-providers-service/internal/provider/contactsync"
+	wcmodel "github.com/webitel/im-providers-service/internal/webchat/model"
+)
+
+// upload is a file uploaded by a visitor.
+type upload struct {
+	body     io.Reader
+	name     string
+	mimeType string
+	text     string
+	clientID string
+}
+
+// dispatch routes a frame of the widget. A message is appended to the history of
+// the visitor once delivered, and its event returned.
+func (h *Handler) dispatch(ctx context.Context, gate *wcmodel.WebChatGate, claims *visitorClaims, origin string, f *clientFrame) (*wcmodel.Event, error) {
+	switch f.Type {
+	case FrameMessage:
+		return h.sendText(ctx, gate, claims, origin, f)
+	case FrameCallback:
+		return nil, h.sendCallback(ctx, gate, claims, origin, f)
+	case FrameTyping:
+		// im-gateway has no typing indicator for agents yet: the frame is accepted and dropped.
+		h.logger.Debug("visitor typing", "gate_id", gate.ID, "visitor_id", claims.Visitor)
+		return nil, nil
+	case FramePing:
+		return nil, nil
+	default:
+		return nil, fmt.Errorf("%w: %q", errUnknownFrame, f.Type)
+	}
+}
+
+func (h *Handler) sendText(ctx context.Context, gate *wcmodel.WebChatGate, claims *visitorClaims, origin string, f *clientFrame) (*wcmodel.Event, error) {
+	text := strings.TrimSpace(f.Text)
+	if text == "" {
+		return nil, errEmptyMessage
+	}
+	if len(text) > maxTextLength {
+		return nil, errTextTooLong
+	}
+
+	if err := h.syncContact(ctx, gate, claims, origin); err != nil {
+		return nil, fmt.Errorf("sync contact [visitor_id=%s]: %w", claims.Visitor, err)
+	}
+
+	peers := contactsync.InboundPeers(gate.ID, gate.Peer, claims.Visitor)
+	resp, err := h.messenger.SendText(ctx, &sharedmodel.SendTextRequest{
+		DomainID: gate.DomainID,
+		From:     peers.From,
+		To:       peers.To,
+		Body:     text,
+	})
+	if err != nil {
+		h.logger.Error("send text failed", "gate_id", gate.ID, "visitor_id", claims.Visitor, "err", err)
+		return nil, err
+	}
+
+	ev := h.visitorEvent(gate, claims, resp.ID.String(), f.ID)
+	ev.Text = text
+	h.appendEvent(ctx, ev)
+	return ev, nil
+}
+
+// sendCallback forwards a button the visitor picked in an interactive message.
+func (h *Handler) sendCallback(ctx context.Context, gate *wcmodel.WebChatGate, claims *visitorClaims, origin string, f *clientFrame) error {
+	if f.InReplyTo == "" || (f.Code == "" && f.Data == "") {
+		return errBadCallback
+	}
+
+	if err := h.syncContact(ctx, gate, claims, origin); err != nil {
+		return fmt.Errorf("sync contact [visitor_id=%s]: %w", claims.Visitor, err)
+	}
+
+	peers := contactsync.InboundPeers(gate.ID, gate.Peer, claims.Visitor)
+	if err := h.messenger.SendInteractiveCallback(ctx, &sharedmodel.SendInteractiveCallbackRequest{
+		DomainID:     gate.DomainID,
+		From:         peers.From,
+		To:           peers.To,
+		InReplyTo:    f.InReplyTo,
+		ButtonCode:   f.Code,
+		CallbackData: f.Data,
+	}); err != nil {
+		h.logger.Error("send interactive callback failed", "gate_id", gate.ID, "in_reply_to", f.InReplyTo, "err", err)
+		return err
+	}
+	return nil
+}
+
+// sendFile copies a file of the visitor to the storage and sends it with its
+// caption. Images become images, anything else becomes a document.
+func (h *Handler) sendFile(ctx context.Context, gate *wcmodel.WebChatGate, claims *visitorClaims, origin string, u upload) (*wcmodel.Event, error) {
+	text := strings.TrimSpace(u.text)
+	if len(text) > maxTextLength {
+		return nil, errTextTooLong
+	}
+	name := u.name
+	if name == "" {
+		name = "file"
+	}
+
+	if err := h.syncContact(ctx, gate, claims, origin); err != nil {
+		return nil, fmt.Errorf("sync contact [visitor_id=%s]: %w", claims.Visitor, err)
+	}
+
+	uploaded, err := h.media.UploadFile(ctx, sharedmodel.UploadRequest{
+		DomainID: gate.DomainID,
+		Name:     name,
+		MimeType: u.mimeType,
+	}, u.body)
+	if err != nil {
+		h.logger.Error("failed to sync file", "name", name, "err", err)
+		return nil, err
+	}
+
+	peers := contactsync.InboundPeers(gate.ID, gate.Peer, claims.Visitor)
+	var messageID string
+	if strings.HasPrefix(u.mimeType, "image/") {
+		resp, err := h.messenger.SendImage(ctx, &sharedmodel.SendImageRequest{
+			DomainID: gate.DomainID,
+			From:     peers.From,
+			To:       peers.To,
+			Image: sharedmodel.ImageRequest{
+				Body: text,
+				Images: []*sharedmodel.Image{{
+					ID:       uploaded.ID,
+					FileName: name,
+					MimeType: u.mimeType,
+				}},
+			},
+		})
+		if err != nil {
+			h.logger.Error("failed to send image", "fileName", name, "err", err)
+			return nil, err
+		}
+		messageID = resp.ID.String()
+	} else {
+		resp, err := h.messenger.SendDocument(ctx, &sharedmodel.SendDocumentRequest{
+			DomainID: gate.DomainID,
+			From:     peers.From,
+			To:       peers.To,
+			Document: sharedmodel.DocumentRequest{
+				Body: text,
+				Documents: []*sharedmodel.Document{{
+					ID:       uploaded.ID,
+					FileName: name,
+					MimeType: u.mimeType,
+					Size:     max(uploaded.Size, 1),
+				}},
+			},
+		})
+		if err != nil {
+			h.logger.Error("failed to send document", "fileName", name, "err", err)
+			return nil, err
+		}
+		messageID = resp.ID.String()
+	}
+
+	ev := h.visitorEvent(gate, claims, messageID, u.clientID)
+	ev.Text = text
+	ev.Files = []wcmodel.File{{ID: uploaded.ID, Name: name, MimeType: u.mimeType, Size: uploaded.Size, URL: uploaded.URL}}
+	h.appendEvent(ctx, ev)
+	return ev, nil
+}
+
+func (h *Handler) visitorEvent(gate *wcmodel.WebChatGate, claims *visitorClaims, messageID, clientID string) *wcmodel.Event {
+	return &wcmodel.Event{
+		Type:      wcmodel.EventMessage,
+		GateID:    gate.ID,
+		VisitorID: claims.Visitor,
+		From:      wcmodel.FromVisitor,
+		ID:        messageID,
+		ClientID:  clientID,
+		Time:      h.now().UnixMilli(),
+	}
+}
+
+// appendEvent keeps a delivered message in the history. The message is delivered
+// anyway, so a failure is logged only: the widget still gets the event in reply.
+func (h *Handler) appendEvent(ctx context.Context, ev *wcmodel.Event) {
+	if err := h.events.Append(ctx, ev); err != nil {
+		h.logger.Warn("message left out of the history", "gate_id", ev.GateID, "visitor_id", ev.VisitorID, "err", err)
+	}
+}
@@ -0,0 +1,47 @@
+package model
+
+import (
+	sharedmodel "github.com/webitel/im-providers-service/internal/core/model"
+)
+
+// Event types of a visitor conversation.
+const (
+	EventMessage = "message"
+	EventTyping  = "typing"
+)
+
+// Event authors.
+const (
+	FromVisitor = "visitor"
+	FromAgent   = "agent"
+)
+
+// Event is an event of a visitor conversation, pushed to the widget as-is.
+// Messages are kept in the history of the visitor; typing indicators are not.
+type Event struct {
+	// Seq is the position of a message in the history, the cursor to replay it from.
+	Seq       string `json:"seq,omitempty"`
+	Type      string `json:"type"`
+	GateID    string `json:"gate_id"`
+	VisitorID string `json:"visitor_id"`
+	From      string `json:"from"`
+	// ID is the ID of the message; interactive callbacks reply to it.
+	ID string `json:"id,omitempty"`
+	// ClientID is the ID the widget sent a visitor message with, so it can match
+	// the message it rendered before it was delivered.
+	ClientID    string                   `json:"client_id,omitempty"`
+	Text        string                   `json:"text,omitempty"`
+	Files       []File                   `json:"files,omitempty"`
+	Interactive *sharedmodel.Interactive `json:"interactive,omitempty"`
+	// Time is the time of the event in Unix milliseconds.
+	Time int64 `json:"time"`
+}
+
+// File is a file of a message.
+type File struct {
+	ID       string `json:"id,omitempty"`
+	Name     string `json:"name"`
+	MimeType string `json:"mime_type,omitempty"`
+	Size     int64  `json:"size,omitempty"`
+	URL      string `json:"url,omitempty"`
+}
@@ -0,0 +1,88 @@
+package model
+
+import (
+	"strings"
+	"time"
+
+	sharedmodel "github.com/webitel/im-providers-service/internal/core/model"
+)
+
+// WebChatGate represents a web chat widget embedded on the websites of a customer.
+// Visitors talk to it anonymously, with the tokens of the sessions the gate issues.
+type WebChatGate struct {
+	ID       string           `json:"id" db:"id"`
+	DomainID int64            `json:"domain_id" db:"domain_id"`
+	Peer     sharedmodel.Peer `json:"peer" db:"peer"`
+	Name     string           `json:"name" db:"name"`
+	// AllowedOrigins are the websites the widget may be embedded on; any origin is
+	// allowed when empty.
+	AllowedOrigins []string `json:"allowed_origins" db:"allowed_origins"`
+	// SigningKey is the base64-encoded key the visitor tokens are signed with.
+	// Rotating it ends every visitor session.
+	SigningKey string `json:"-" db:"signing_key"`
+	// EndpointURL is the base URL the widget is configured with.
+	EndpointURL string                 `json:"endpoint_url" db:"endpoint_url"`
+	Status      sharedmodel.GateStatus `json:"status" db:"status"`
+	CreatedAt   time.Time              `json:"created_at" db:"created_at"`
+	UpdatedAt   time.Time              `json:"updated_at" db:"updated_at"`
+	Enabled     bool                   `json:"enabled" db:"enabled"`
+}
+
+// AllowsOrigin reports whether the widget may be embedded on the website of the origin.
+func (g *WebChatGate) AllowsOrigin(origin string) bool {
+	if len(g.AllowedOrigins) == 0 {
+		return true
+	}
+	for _, allowed := range g.AllowedOrigins {
+		if strings.EqualFold(strings.TrimSuffix(allowed, "/"), origin) {
+			return true
+		}
+	}
+	return false
+}
+
+type CreateWebChat struct {
+	Name           string
+	Dc             int64
+	AllowedOrigins []string
+	Peer           sharedmodel.Peer
+}
+
+type UpdateWebChat struct {
+	ID             string
+	Name           *string
+	AllowedOrigins *[]string
+	Enabled        *bool
+	Peer           *sharedmodel.Peer
+	// RotateKey issues a new signing key, ending every visitor session.
+	RotateKey bool
+}
+
+func (r UpdateWebChat) ApplyTo(gate *WebChatGate) {
+	if r.Name != nil {
+		gate.Name = *r.Name
+	}
+	if r.AllowedOrigins != nil {
+		gate.AllowedOrigins = *r.AllowedOrigins
+	}
+	if r.Enabled != nil {
+		gate.Enabled = *r.Enabled
+	}
+	if r.Peer != nil {
+		gate.Peer = *r.Peer
+	}
+}
+
+func (r CreateWebChat) Validate() error {
+	var missing []string
+	if r.Name == "" {
+		missing = append(missing, "name")
+	}
+	if r.Dc <= 0 {
+		missing = append(missing, "domain_id")
+	}
+	if len(missing) > 0 {
+		return &sharedmodel.ValidationError{Fields: missing}
+	}
+	return nil
+}
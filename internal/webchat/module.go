@@ -0,0 +1,58 @@
+package webchat
+
+import (
+	"github.com/redis/go-redis/v9"
+	impb "github.com/webitel/im-providers-service/gen/go/provider/v1"
+	grpcsrv "github.com/webitel/im-providers-service/infra/srv/grpc"
+	"github.com/webitel/im-providers-service/internal/provider"
+	wchandler "github.com/webitel/im-providers-service/internal/webchat/handler"
+	wcservice "github.com/webitel/im-providers-service/internal/webchat/service"
+	wcstore "github.com/webitel/im-providers-service/internal/webchat/store"
+	wcpostgres "github.com/webitel/im-providers-service/internal/webchat/store/postgres"
+	wcredis "github.com/webitel/im-providers-service/internal/webchat/store/redis"
+	"go.uber.org/fx"
+)
+
+// Module provides the web chat provider, the widget API Handler mounted by the
+// router and the gRPC gate service.
+var Module = fx.Module("webchat",
+	fx.Provide(
+		// Provider adapter
+		fx.Annotate(
+			New,
+			fx.As(new(provider.Provider)),
+			fx.ResultTags(`group:"providers"`),
+		),
+
+		// Widget API
+		NewHandler,
+
+		// Store implementations
+		fx.Annotate(wcpostgres.NewWebChatStore, fx.As(new(wcstore.WebChatStore))),
+		func(rdb *redis.Client) wcstore.EventLog {
+			// The history of a visitor lives as long as its session.
+			return wcredis.NewRedisEventLog(rdb, sessionTTL)
+		},
+
+		// Services
+		fx.Annotate(wcservice.NewWebChatService, fx.As(new(wcservice.WebChatManager))),
+
+		// gRPC handlers
+		wchandler.NewWebChatHandler,
+	),
+	fx.Invoke(registerLifecycle, RegisterWebChatService),
+)
+
+// RegisterWebChatService connects the web chat gate gRPC handler to the gRPC server.
+func RegisterWebChatService(server *grpcsrv.Server, wc *wchandler.WebChatHandler) {
+	impb.RegisterWebChatServiceServer(server.Server, wc)
+}
+
+// registerLifecycle subscribes the connections of the visitors to the events of
+// every instance on start and unsubscribes on stop.
+func registerLifecycle(lc fx.Lifecycle, h *Handler) {
+	lc.Append(fx.Hook{
+		OnStart: h.hub.Start,
+		OnStop:  h.hub.Stop,
+	})
+}
@@ -0,0 +1,87 @@
+package webchat
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	sharedmodel "github.com/webitel/im-providers-service/internal/core/model"
+	wcmodel "github.com/webitel/im-providers-service/internal/webchat/model"
+)
+
+// Agent messages are appended to the history of the visitor and pushed to its
+// connections; a visitor that is offline gets them replayed on reconnect.
+
+func (p *webchatProvider) SendText(ctx context.Context, req *sharedmodel.Message) (*sharedmodel.MessageResponse, error) {
+	return p.send(ctx, req, nil)
+}
+
+func (p *webchatProvider) SendImage(ctx context.Context, req *sharedmodel.Message) (*sharedmodel.MessageResponse, error) {
+	files := make([]wcmodel.File, 0, len(req.Images))
+	for _, img := range req.Images {
+		files = append(files, wcmodel.File{ID: img.ID, Name: img.FileName, MimeType: img.MimeType, Size: img.Size, URL: img.URL})
+	}
+	return p.send(ctx, req, func(ev *wcmodel.Event) { ev.Files = files })
+}
+
+func (p *webchatProvider) SendDocument(ctx context.Context, req *sharedmodel.Message) (*sharedmodel.MessageResponse, error) {
+	files := make([]wcmodel.File, 0, len(req.Documents))
+	for _, doc := range req.Documents {
+		files = append(files, wcmodel.File{ID: doc.ID, Name: doc.FileName, MimeType: doc.MimeType, Size: doc.Size, URL: doc.URL})
+	}
+	return p.send(ctx, req, func(ev *wcmodel.Event) { ev.Files = files })
+}
+
+// SendInteractive passes the interactive message to the widget as-is: the widget
+// renders every kind of it. Picked buttons come back as callback frames.
+func (p *webchatProvider) SendInteractive(ctx context.Context, req *sharedmodel.Message) (*sharedmodel.MessageResponse, error) {
+	if req.Interactive == nil {
+		return nil, fmt.Errorf("webchat: message has no interactive content")
+	}
+	return p.send(ctx, req, func(ev *wcmodel.Event) { ev.Interactive = req.Interactive })
+}
+
+// SendTyping shows the visitor that the agent is typing. The indicator is not kept in the history.
+func (p *webchatProvider) SendTyping(ctx context.Context, req *sharedmodel.Message) error {
+	ev, err := p.event(ctx, req, wcmodel.EventTyping)
+	if err != nil {
+		return err
+	}
+	return p.events.Publish(ctx, ev)
+}
+
+func (p *webchatProvider) send(ctx context.Context, req *sharedmodel.Message, build func(*wcmodel.Event)) (*sharedmodel.MessageResponse, error) {
+	ev, err := p.event(ctx, req, wcmodel.EventMessage)
+	if err != nil {
+		return nil, err
+	}
+	ev.ID = uuid.NewString()
+	ev.Text = req.Text
+	if build != nil {
+		build(ev)
+	}
+
+	if err := p.events.Append(ctx, ev); err != nil {
+		return nil, err
+	}
+	return &sharedmodel.MessageResponse{ID: ev.ID}, nil
+}
+
+// event returns an event of the agent for the visitor the message is addressed to.
+func (p *webchatProvider) event(ctx context.Context, req *sharedmodel.Message, eventType string) (*wcmodel.Event, error) {
+	gate, err := p.repo.Select(ctx, req.GateID)
+	if err != nil {
+		return nil, err
+	}
+	visitorID, err := p.resolveReceiver(ctx, gate, req.To.Sub)
+	if err != nil {
+		return nil, err
+	}
+	return &wcmodel.Event{
+		Type:      eventType,
+		GateID:    gate.ID,
+		VisitorID: visitorID,
+		From:      wcmodel.FromAgent,
+		Time:      p.now().UnixMilli(),
+	}, nil
+}
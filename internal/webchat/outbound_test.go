@@ -0,0 +1,72 @@
+package webchat
+
+import (
+	"testing"
+
+	sharedmodel "github.com/webitel/im-providers-service/internal/core/model"
+	wcmodel "github.com/webitel/im-providers-service/internal/webchat/model"
+)
+
+func TestSend(t *testing.T) {
+	events := newMemEventLog()
+	p := newShopProvider(events)
+	to := sharedmodel.Peer{Sub: "v_1"}
+
+	resp, err := p.SendText(t.Context(), &sharedmodel.Message{GateID: testGateID, To: to, Text: "Hello"})
+	if err != nil || resp.ID == "" {
+		t.Fatalf("SendText = %+v, %v", resp, err)
+	}
+	_, err = p.SendImage(t.Context(), &sharedmodel.Message{GateID: testGateID, To: to, Text: "photo", Images: []*sharedmodel.Image{{ID: "img-1", FileName: "a.png", MimeType: "image/png", URL: "https://files.example.com/img-1"}}})
+	if err != nil {
+		t.Fatalf("SendImage: %v", err)
+	}
+	_, err = p.SendDocument(t.Context(), &sharedmodel.Message{GateID: testGateID, To: to, Documents: []*sharedmodel.Document{{ID: "doc-1", FileName: "invoice.pdf", Size: 10}}})
+	if err != nil {
+		t.Fatalf("SendDocument: %v", err)
+	}
+	interactive := &sharedmodel.Interactive{Body: "Pick one"}
+	if _, err := p.SendInteractive(t.Context(), &sharedmodel.Message{GateID: testGateID, To: to, Text: "Pick one", Interactive: interactive}); err != nil {
+		t.Fatalf("SendInteractive: %v", err)
+	}
+
+	history, _ := events.Since(t.Context(), testGateID, "v_1", "", replayLimit)
+	if len(history) != 4 {
+		t.Fatalf("history = %+v", history)
+	}
+	if h := history[0]; h.ID != resp.ID || h.Text != "Hello" || h.From != wcmodel.FromAgent || h.Time != testNow.UnixMilli() {
+		t.Errorf("text event = %+v", h)
+	}
+	if h := history[1]; len(h.Files) != 1 || h.Files[0].ID != "img-1" || h.Files[0].URL == "" || h.Text != "photo" {
+		t.Errorf("image event = %+v", h)
+	}
+	if h := history[2]; len(h.Files) != 1 || h.Files[0].Name != "invoice.pdf" {
+		t.Errorf("document event = %+v", h)
+	}
+	if h := history[3]; h.Interactive == nil || h.Text != "Pick one" {
+		t.Errorf("interactive event = %+v", h)
+	}
+
+	if _, err := p.SendInteractive(t.Context(), &sharedmodel.Message{GateID: testGateID, To: to}); err == nil {
+		t.Error("SendInteractive without content: want error")
+	}
+	if _, err := p.SendText(t.Context(), &sharedmodel.Message{GateID: "missing", To: to, Text: "Hello"}); err == nil {
+		t.Error("SendText to a missing gate: want error")
+	}
+}
+
+func TestSendTyping(t *testing.T) {
+	events := newMemEventLog()
+	p := newShopProvider(events)
+
+	if err := p.SendTyping(t.Context(), &sharedmodel.Message{GateID: testGateID, To: sharedmodel.Peer{Sub: "v_1"}}); err != nil {
+		t.Fatalf("SendTyping: %v", err)
+	}
+
+	published := events.events()
+	if len(published) != 1 || published[0].Type != wcmodel.EventTyping || published[0].VisitorID != "v_1" {
+		t.Errorf("published = %+v", published)
+	}
+	if history, _ := events.Since(t.Context(), testGateID, "v_1", "", replayLimit); len(history) != 0 {
+		t.Errorf("typing kept in the history: %+v", history)
+	}
+}
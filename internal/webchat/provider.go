@@ -0,0 +1,93 @@
+// Package webchat implements the first-party web chat provider: a widget embedded on
+// the websites of a customer talks to it through the widget API (sessions, a socket,
+// history and uploads, see Handler), agents reply through the provider API.
+package webchat
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+	lru "github.com/hashicorp/golang-lru/v2"
+	contactv1 "github.com/webitel/im-providers-service/gen/go/contact/v1"
+	imcontact "github.com/webitel/im-providers-service/infra/client/grpc/im-contact"
+	"github.com/webitel/im-providers-service/internal/provider"
+	"github.com/webitel/im-providers-service/internal/provider/contactsync"
+	wcmodel "github.com/webitel/im-providers-service/internal/webchat/model"
+	wcstore "github.com/webitel/im-providers-service/internal/webchat/store"
+)
+
+// providerType is the gate type, the issuer of the visitor contacts.
+const providerType = "webchat"
+
+// errNoWebhook is returned for a webhook delivered to a web chat gate: the widget
+// talks to the widget API instead.
+var errNoWebhook = errors.New("webchat: gates receive no webhooks, the widget uses " + BasePath)
+
+type webchatProvider struct {
+	logger        *slog.Logger
+	repo          wcstore.WebChatStore
+	events        wcstore.EventLog
+	contactClient *imcontact.Client
+	// receiverCache maps internal contact UUID → visitor ID to avoid an
+	// im-contact round-trip on every outbound message.
+	receiverCache *lru.Cache[string, string]
+	now           func() time.Time
+}
+
+func New(
+	l *slog.Logger,
+	repo wcstore.WebChatStore,
+	events wcstore.EventLog,
+	contactClient *imcontact.Client,
+) provider.Provider {
+	receiverCache, _ := lru.New[string, string](1000)
+	return &webchatProvider{
+		logger:        l.With("provider", "webchat"),
+		repo:          repo,
+		events:        events,
+		contactClient: contactClient,
+		receiverCache: receiverCache,
+		now:           time.Now,
+	}
+}
+
+var (
+	_ provider.InteractiveSender = (*webchatProvider)(nil)
+	_ provider.TypingSender      = (*webchatProvider)(nil)
+)
+
+func (p *webchatProvider) Type() string { return providerType }
+
+func (p *webchatProvider) HandleWebhook(context.Context, []byte) error {
+	return errNoWebhook
+}
+
+// resolveReceiver returns the visitor ID for the given sub.
+// A visitor ID is returned as-is; an internal contact UUID is resolved via the contact service.
+func (p *webchatProvider) resolveReceiver(ctx context.Context, gate *wcmodel.WebChatGate, contactID string) (string, error) {
+	if _, err := uuid.Parse(contactID); err != nil {
+		return contactID, nil
+	}
+	if sub, ok := p.receiverCache.Get(contactID); ok {
+		return sub, nil
+	}
+
+	authCtx := contactsync.WithGatewayIdentity(ctx, gate.DomainID, gate.Peer)
+	resp, err := p.contactClient.SearchContact(authCtx, &contactv1.SearchContactRequest{
+		Ids: []string{contactID},
+	})
+	if err != nil {
+		return "", fmt.Errorf("resolve web chat visitor for %s: %w", contactID, err)
+	}
+	items := resp.GetContacts()
+	if len(items) == 0 || items[0].GetSubject() == "" {
+		return "", fmt.Errorf("resolve web chat visitor for %s: contact not found or has no subject", contactID)
+	}
+	sub := items[0].GetSubject()
+	p.receiverCache.Add(contactID, sub)
+	return sub, nil
+}
@@ -0,0 +1,208 @@
+package webchat
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	sharedmodel "github.com/webitel/im-providers-service/internal/core/model"
+	sharedstore "github.com/webitel/im-providers-service/internal/core/store"
+	"github.com/webitel/im-providers-service/internal/provider/providertest"
+	wcmodel "github.com/webitel/im-providers-service/internal/webchat/model"
+	wcstore "github.com/webitel/im-providers-service/internal/webchat/store"
+)
+
+const (
+	testGateID    = "0190a8a4-5f0e-7c2a-9d3b-0a1b2c3d4e5f"
+	testOrigin    = "https://shop.example.com"
+	testContactID = "5b6c7d8e-1f2a-4b3c-8d4e-5f6a7b8c9d0e"
+)
+
+// testKey is the base64-encoded signing key of the test gate.
+var testKey = base64.StdEncoding.EncodeToString([]byte("signing-key-of-the-test-web-chat"))
+
+var noopLogger = slog.New(slog.NewTextHandler(io.Discard, nil))
+
+// -- fakes --
+
+// embeddedWidgets holds the gates of the widgets embedded on the test sites. The widget
+// API and the provider only read gates, so the writes panic.
+type embeddedWidgets map[string]*wcmodel.WebChatGate
+
+var _ wcstore.WebChatStore = embeddedWidgets(nil)
+
+func (w embeddedWidgets) Select(_ context.Context, id string) (*wcmodel.WebChatGate, error) {
+	g, ok := w[id]
+	if !ok {
+		return nil, sharedstore.ErrNotFound
+	}
+	cp := *g
+	return &cp, nil
+}
+
+func (w embeddedWidgets) Insert(context.Context, int64, *wcmodel.WebChatGate) error {
+	panic("webchat provider must not insert gates")
+}
+
+func (w embeddedWidgets) Update(context.Context, *wcmodel.WebChatGate) error {
+	panic("webchat provider must not update gates")
+}
+
+func (w embeddedWidgets) Delete(context.Context, string) error {
+	panic("webchat provider must not delete gates")
+}
+
+// memEventLog keeps the history in memory and fans the events out to its subscribers
+// as the Redis event log does.
+type memEventLog struct {
+	mu          sync.Mutex
+	history     map[string][]*wcmodel.Event
+	published   []*wcmodel.Event
+	subscribers []chan *wcmodel.Event
+	next        int64
+}
+
+var _ wcstore.EventLog = (*memEventLog)(nil)
+
+func newMemEventLog() *memEventLog {
+	return &memEventLog{history: map[string][]*wcmodel.Event{}}
+}
+
+func (l *memEventLog) Append(ctx context.Context, ev *wcmodel.Event) error {
+	l.mu.Lock()
+	l.next++
+	ev.Seq = fmt.Sprintf("%d-0", l.next)
+	key := clientKey(ev.GateID, ev.VisitorID)
+	cp := *ev
+	l.history[key] = append(l.history[key], &cp)
+	l.mu.Unlock()
+	return l.Publish(ctx, ev)
+}
+
+func (l *memEventLog) Publish(_ context.Context, ev *wcmodel.Event) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	cp := *ev
+	l.published = append(l.published, &cp)
+	for _, sub := range l.subscribers {
+		sub <- &cp
+	}
+	return nil
+}
+
+func (l *memEventLog) Since(_ context.Context, gateID, visitorID, after string, limit int) ([]*wcmodel.Event, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	var events []*wcmodel.Event
+	for _, ev := range l.history[clientKey(gateID, visitorID)] {
+		if seqAfter(ev.Seq, after) {
+			cp := *ev
+			events = append(events, &cp)
+		}
+	}
+	if after == "" && len(events) > limit {
+		return events[len(events)-limit:], nil
+	}
+	if len(events) > limit {
+		events = events[:limit]
+	}
+	return events, nil
+}
+
+func (l *memEventLog) Subscribe(ctx context.Context) (<-chan *wcmodel.Event, error) {
+	sub := make(chan *wcmodel.Event, 64)
+	l.mu.Lock()
+	l.subscribers = append(l.subscribers, sub)
+	l.mu.Unlock()
+
+	go func() {
+		<-ctx.Done()
+		l.mu.Lock()
+		defer l.mu.Unlock()
+		for i, s := range l.subscribers {
+			if s == sub {
+				l.subscribers = append(l.subscribers[:i], l.subscribers[i+1:]...)
+				break
+			}
+		}
+		close(sub)
+	}()
+	return sub, nil
+}
+
+func (l *memEventLog) events() []*wcmodel.Event {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return append([]*wcmodel.Event(nil), l.published...)
+}
+
+// shopWidget is the gate of the widget embedded on the test shop.
+func shopWidget() *wcmodel.WebChatGate {
+	return &wcmodel.WebChatGate{
+		ID:             testGateID,
+		DomainID:       1,
+		Name:           "Shop",
+		Peer:           sharedmodel.Peer{Sub: "bot-1", Iss: "webchat"},
+		AllowedOrigins: []string{testOrigin + "/"},
+		SigningKey:     testKey,
+		Enabled:        true,
+	}
+}
+
+// testNow is the clock of the tests, so tokens are issued and checked at a fixed time.
+var testNow = time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+
+type widgetServer struct {
+	*Handler
+	server    *httptest.Server
+	messenger *providertest.Messenger
+	media     *providertest.Media
+	widgets   embeddedWidgets
+	events    *memEventLog
+}
+
+// newWidgetServer serves the widget API with a started hub.
+func newWidgetServer(t *testing.T) *widgetServer {
+	t.Helper()
+
+	messenger := &providertest.Messenger{}
+	media := &providertest.Media{}
+	widgets := embeddedWidgets{testGateID: shopWidget()}
+	events := newMemEventLog()
+
+	h := NewHandler(noopLogger, messenger, media, providertest.NewGateCache(), providertest.KnownUsers{}, widgets, events, nil)
+	h.now = func() time.Time { return testNow }
+	if err := h.hub.Start(context.Background()); err != nil {
+		t.Fatalf("start hub: %v", err)
+	}
+	t.Cleanup(func() { _ = h.hub.Stop(context.Background()) })
+
+	server := httptest.NewServer(h)
+	t.Cleanup(server.Close)
+
+	return &widgetServer{Handler: h, server: server, messenger: messenger, media: media, widgets: widgets, events: events}
+}
+
+// token issues a visitor token of the test gate.
+func (h *widgetServer) token(t *testing.T, visitorID string) string {
+	t.Helper()
+
+	token, err := signVisitor(testKey, visitorClaims{Gate: testGateID, Visitor: visitorID, Expires: testNow.Add(time.Hour).Unix()})
+	if err != nil {
+		t.Fatalf("sign: %v", err)
+	}
+	return token
+}
+
+// newShopProvider delivers the replies of the shop widget through events.
+func newShopProvider(events *memEventLog) *webchatProvider {
+	p := New(noopLogger, embeddedWidgets{testGateID: shopWidget()}, events, nil).(*webchatProvider)
+	p.now = func() time.Time { return testNow }
+	return p
+}
@@ -0,0 +1,139 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/webitel/im-providers-service/config"
+	wcmodel "github.com/webitel/im-providers-service/internal/webchat/model"
+	wcstore "github.com/webitel/im-providers-service/internal/webchat/store"
+)
+
+// ErrPublicURLNotSet is returned when a widget endpoint is to be issued but service.public_url is empty.
+var ErrPublicURLNotSet = errors.New("webchat: service.public_url is not configured")
+
+// signingKeySize is the size of the key the visitor tokens of a gate are signed with.
+const signingKeySize = 32
+
+var _ WebChatManager = (*WebChatService)(nil)
+
+type WebChatManager interface {
+	CreateGate(ctx context.Context, req wcmodel.CreateWebChat) (*wcmodel.WebChatGate, error)
+	GetGate(ctx context.Context, id string) (*wcmodel.WebChatGate, error)
+	UpdateGate(ctx context.Context, req wcmodel.UpdateWebChat) (*wcmodel.WebChatGate, error)
+	DeleteGate(ctx context.Context, id string) (*wcmodel.WebChatGate, error)
+}
+
+type WebChatService struct {
+	repo wcstore.WebChatStore
+	cfg  *config.Config
+	log  *slog.Logger
+}
+
+func NewWebChatService(repo wcstore.WebChatStore, cfg *config.Config, log *slog.Logger) *WebChatService {
+	return &WebChatService{
+		repo: repo,
+		cfg:  cfg,
+		log:  log.With("layer", "service", "domain", "webchat_gate"),
+	}
+}
+
+// CreateGate stores the gate of a website with a new signing key. The returned
+// endpoint URL is the one to configure the widget with.
+func (s *WebChatService) CreateGate(ctx context.Context, req wcmodel.CreateWebChat) (*wcmodel.WebChatGate, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	if s.cfg.Service.PublicURL == "" {
+		return nil, ErrPublicURLNotSet
+	}
+
+	key, err := newSigningKey()
+	if err != nil {
+		return nil, err
+	}
+
+	gate := &wcmodel.WebChatGate{
+		Name:           req.Name,
+		AllowedOrigins: req.AllowedOrigins,
+		SigningKey:     key,
+		Peer:           req.Peer,
+		Enabled:        true,
+	}
+
+	if err := s.repo.Insert(ctx, req.Dc, gate); err != nil {
+		s.log.Error("failed to create webchat gate", "name", req.Name, "err", err)
+		return nil, err
+	}
+
+	// The widget endpoint contains the gate ID, known only once the gate is stored.
+	gate.EndpointURL = s.endpointURL(gate.ID)
+	if err := s.repo.Update(ctx, gate); err != nil {
+		s.log.Error("failed to store webchat endpoint", "id", gate.ID, "err", err)
+		return nil, err
+	}
+
+	s.log.Info("webchat gate created", "id", gate.ID, "endpoint", gate.EndpointURL)
+	return gate, nil
+}
+
+func (s *WebChatService) GetGate(ctx context.Context, id string) (*wcmodel.WebChatGate, error) {
+	return s.repo.Select(ctx, id)
+}
+
+func (s *WebChatService) UpdateGate(ctx context.Context, req wcmodel.UpdateWebChat) (*wcmodel.WebChatGate, error) {
+	gate, err := s.repo.Select(ctx, req.ID)
+	if err != nil {
+		return nil, err
+	}
+	req.ApplyTo(gate)
+
+	if req.RotateKey {
+		if gate.SigningKey, err = newSigningKey(); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := s.repo.Update(ctx, gate); err != nil {
+		s.log.Error("failed to update webchat gate", "id", req.ID, "err", err)
+		return nil, err
+	}
+
+	s.log.Info("webchat gate updated", "id", gate.ID, "key_rotated", req.RotateKey)
+	return gate, nil
+}
+
+// DeleteGate removes the gate. Widgets still embedded in the website are answered
+// not found from then on.
+func (s *WebChatService) DeleteGate(ctx context.Context, id string) (*wcmodel.WebChatGate, error) {
+	gate, err := s.repo.Select(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.repo.Delete(ctx, id); err != nil {
+		s.log.Error("failed to delete webchat gate", "id", id, "err", err)
+		return nil, err
+	}
+
+	s.log.Warn("webchat gate removed", "id", id)
+	return gate, nil
+}
+
+// endpointURL is the widget API base of the gate, mounted at /webchat by the router.
+func (s *WebChatService) endpointURL(gateID string) string {
+	return s.cfg.Service.PublicURL + "/webchat/" + gateID
+}
+
+// newSigningKey returns a random base64-encoded signing key.
+func newSigningKey() (string, error) {
+	key := make([]byte, signingKeySize)
+	if _, err := rand.Read(key); err != nil {
+		return "", fmt.Errorf("generate signing key: %w", err)
+	}
+	return base64.StdEncoding.EncodeToString(key), nil
+}
@@ -0,0 +1,137 @@
+package service
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"testing"
+
+	"github.com/webitel/im-providers-service/config"
+	sharedstore "github.com/webitel/im-providers-service/internal/core/store"
+	wcmodel "github.com/webitel/im-providers-service/internal/webchat/model"
+	wcstore "github.com/webitel/im-providers-service/internal/webchat/store"
+)
+
+var noopLogger = slog.New(slog.NewTextHandler(io.Discard, nil))
+
+// siteWidgets stores the web chat gates under sequential IDs.
+type siteWidgets map[string]*wcmodel.WebChatGate
+
+var _ wcstore.WebChatStore = siteWidgets(nil)
+
+func (w siteWidgets) Insert(_ context.Context, dc int64, g *wcmodel.WebChatGate) error {
+	g.ID = fmt.Sprintf("gate-%d", len(w)+1)
+	g.DomainID = dc
+	cp := *g
+	w[g.ID] = &cp
+	return nil
+}
+
+func (w siteWidgets) Select(_ context.Context, id string) (*wcmodel.WebChatGate, error) {
+	g, ok := w[id]
+	if !ok {
+		return nil, sharedstore.ErrNotFound
+	}
+	cp := *g
+	return &cp, nil
+}
+
+func (w siteWidgets) Update(_ context.Context, g *wcmodel.WebChatGate) error {
+	cp := *g
+	w[g.ID] = &cp
+	return nil
+}
+
+func (w siteWidgets) Delete(_ context.Context, id string) error {
+	delete(w, id)
+	return nil
+}
+
+// newWebChatService serves the widgets from publicURL; an empty publicURL leaves
+// service.public_url unset.
+func newWebChatService(publicURL string) (*WebChatService, siteWidgets) {
+	widgets := siteWidgets{}
+	cfg := &config.Config{Service: config.ServiceConfig{PublicURL: publicURL, WebhookPath: "/wh"}}
+	return NewWebChatService(widgets, cfg, noopLogger), widgets
+}
+
+func createRequest() wcmodel.CreateWebChat {
+	return wcmodel.CreateWebChat{Name: "Shop", Dc: 1, AllowedOrigins: []string{"https://shop.example.com"}}
+}
+
+func TestCreateGate_IssuesEndpointAndKey(t *testing.T) {
+	svc, widgets := newWebChatService("https://im.example.com")
+
+	gate, err := svc.CreateGate(context.Background(), createRequest())
+	if err != nil {
+		t.Fatalf("CreateGate: %v", err)
+	}
+
+	const wantURL = "https://im.example.com/webchat/gate-1"
+	stored := widgets["gate-1"]
+	if stored == nil || stored.EndpointURL != wantURL || len(stored.AllowedOrigins) != 1 || !stored.Enabled {
+		t.Errorf("unexpected stored gate: %+v", stored)
+	}
+	if key, err := base64.StdEncoding.DecodeString(stored.SigningKey); err != nil || len(key) != signingKeySize {
+		t.Errorf("signing key %q: %v", stored.SigningKey, err)
+	}
+	if gate.EndpointURL != wantURL {
+		t.Errorf("endpoint = %q", gate.EndpointURL)
+	}
+}
+
+func TestCreateGate_Rejects(t *testing.T) {
+	svc, widgets := newWebChatService("")
+	if _, err := svc.CreateGate(context.Background(), createRequest()); !errors.Is(err, ErrPublicURLNotSet) {
+		t.Errorf("no public URL: want ErrPublicURLNotSet, got %v", err)
+	}
+
+	svc, _ = newWebChatService("https://im.example.com")
+	if _, err := svc.CreateGate(context.Background(), wcmodel.CreateWebChat{Dc: 1}); err == nil {
+		t.Error("no name: want validation error")
+	}
+	if len(widgets) != 0 {
+		t.Errorf("gates stored: %+v", widgets)
+	}
+}
+
+func TestUpdateGate_RotatesKey(t *testing.T) {
+	svc, widgets := newWebChatService("https://im.example.com")
+	created, err := svc.CreateGate(context.Background(), createRequest())
+	if err != nil {
+		t.Fatalf("CreateGate: %v", err)
+	}
+
+	origins := []string{}
+	if _, err := svc.UpdateGate(context.Background(), wcmodel.UpdateWebChat{ID: created.ID, AllowedOrigins: &origins}); err != nil {
+		t.Fatalf("UpdateGate: %v", err)
+	}
+	if stored := widgets[created.ID]; stored.SigningKey != created.SigningKey || len(stored.AllowedOrigins) != 0 {
+		t.Errorf("plain update: %+v", stored)
+	}
+
+	if _, err := svc.UpdateGate(context.Background(), wcmodel.UpdateWebChat{ID: created.ID, RotateKey: true}); err != nil {
+		t.Fatalf("UpdateGate: %v", err)
+	}
+	if stored := widgets[created.ID]; stored.SigningKey == created.SigningKey || stored.SigningKey == "" {
+		t.Error("signing key not rotated")
+	}
+}
+
+func TestDeleteGate(t *testing.T) {
+	svc, widgets := newWebChatService("https://im.example.com")
+	created, _ := svc.CreateGate(context.Background(), createRequest())
+
+	if _, err := svc.DeleteGate(context.Background(), created.ID); err != nil {
+		t.Fatalf("DeleteGate: %v", err)
+	}
+	if _, ok := widgets[created.ID]; ok {
+		t.Error("gate not deleted")
+	}
+	if _, err := svc.DeleteGate(context.Background(), created.ID); !errors.Is(err, sharedstore.ErrNotFound) {
+		t.Errorf("missing gate: want ErrNotFound, got %v", err)
+	}
+}
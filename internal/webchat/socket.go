@@ -0,0 +1,106 @@
+package webchat
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	wcmodel "github.com/webitel/im-providers-service/internal/webchat/model"
+	"golang.org/x/net/websocket"
+)
+
+// socket upgrades the request to the socket of the visitor of ?token=. The history
+// after ?after= (the latest messages without it) is replayed first.
+func (h *Handler) socket(w http.ResponseWriter, r *http.Request) {
+	gate := gateFrom(r.Context())
+	claims, err := parseVisitor(gate.SigningKey, gate.ID, r.URL.Query().Get("token"), h.now())
+	if err != nil {
+		writeError(w, http.StatusUnauthorized, "invalid visitor token")
+		return
+	}
+
+	origin := r.Header.Get("Origin")
+	after := r.URL.Query().Get("after")
+	srv := websocket.Server{
+		// The origin is checked against the gate by withGate.
+		Handshake: func(*websocket.Config, *http.Request) error { return nil },
+		Handler: func(ws *websocket.Conn) {
+			h.serveSocket(ws, gate, claims, origin, after)
+		},
+	}
+	srv.ServeHTTP(w, r)
+}
+
+func (h *Handler) serveSocket(ws *websocket.Conn, gate *wcmodel.WebChatGate, claims *visitorClaims, origin, after string) {
+	defer ws.Close()
+	ws.MaxPayloadBytes = maxFrameSize
+
+	ctx, cancel := context.WithCancel(ws.Request().Context())
+	defer cancel()
+
+	// Live events are buffered from now on: those the replayed history already
+	// holds are skipped, so none is lost or sent twice in between.
+	c := h.hub.register(gate.ID, claims.Visitor)
+	defer h.hub.unregister(c)
+
+	history, err := h.events.Since(ctx, gate.ID, claims.Visitor, after, replayLimit)
+	if err != nil {
+		h.logger.Error("failed to replay history", "gate_id", gate.ID, "visitor_id", claims.Visitor, "err", err)
+		_ = websocket.JSON.Send(ws, serverFrame{Type: frameError, Error: "failed to read history"})
+		return
+	}
+	last := after
+	for _, ev := range history {
+		if err := websocket.JSON.Send(ws, ev); err != nil {
+			return
+		}
+		last = ev.Seq
+	}
+
+	go h.readFrames(ctx, cancel, ws, gate, claims, origin)
+
+	for {
+		select {
+		case ev := <-c.events:
+			if ev.Seq != "" {
+				if !seqAfter(ev.Seq, last) {
+					continue
+				}
+				last = ev.Seq
+			}
+			if err := websocket.JSON.Send(ws, ev); err != nil {
+				return
+			}
+		case <-c.dropped:
+			return
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// readFrames dispatches the frames of the widget until the socket fails or is idle
+// for idleTimeout. A frame that fails is answered with an error frame.
+func (h *Handler) readFrames(ctx context.Context, cancel context.CancelFunc, ws *websocket.Conn, gate *wcmodel.WebChatGate, claims *visitorClaims, origin string) {
+	defer cancel()
+
+	for {
+		_ = ws.SetReadDeadline(time.Now().Add(idleTimeout))
+		var f clientFrame
+		if err := websocket.JSON.Receive(ws, &f); err != nil {
+			return
+		}
+
+		if f.Type == FramePing {
+			_ = websocket.JSON.Send(ws, serverFrame{Type: framePong})
+			continue
+		}
+		if _, err := h.dispatch(ctx, gate, claims, origin, &f); err != nil {
+			msg := "failed to deliver the message"
+			if isFrameError(err) {
+				msg = err.Error()
+			}
+			_ = websocket.JSON.Send(ws, serverFrame{Type: frameError, ID: f.ID, Error: msg})
+		}
+	}
+}
@@ -0,0 +1,104 @@
+package webchat
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	sharedmodel "github.com/webitel/im-providers-service/internal/core/model"
+	wcmodel "github.com/webitel/im-providers-service/internal/webchat/model"
+	"golang.org/x/net/websocket"
+)
+
+func (h *widgetServer) dial(t *testing.T, token, after string) *websocket.Conn {
+	t.Helper()
+
+	url := "ws" + strings.TrimPrefix(h.server.URL, "http") + "/" + testGateID + "/ws?token=" + token
+	if after != "" {
+		url += "&after=" + after
+	}
+	ws, err := websocket.Dial(url, "", testOrigin)
+	if err != nil {
+		t.Fatalf("dial: %v", err)
+	}
+	t.Cleanup(func() { _ = ws.Close() })
+	return ws
+}
+
+// receive reads the next frame of the socket into v.
+func receive(t *testing.T, ws *websocket.Conn, v any) {
+	t.Helper()
+
+	_ = ws.SetReadDeadline(time.Now().Add(5 * time.Second))
+	if err := websocket.JSON.Receive(ws, v); err != nil {
+		t.Fatalf("receive: %v", err)
+	}
+}
+
+func TestSocket(t *testing.T) {
+	h := newWidgetServer(t)
+	p := newShopProvider(h.events)
+
+	for _, text := range []string{"one", "two"} {
+		_ = h.events.Append(t.Context(), &wcmodel.Event{Type: wcmodel.EventMessage, GateID: testGateID, VisitorID: "v_1", Text: text})
+	}
+	history, _ := h.events.Since(t.Context(), testGateID, "v_1", "", replayLimit)
+
+	// The history after the cursor is replayed first.
+	ws := h.dial(t, h.token(t, "v_1"), history[0].Seq)
+	var replayed wcmodel.Event
+	receive(t, ws, &replayed)
+	if replayed.Text != "two" {
+		t.Fatalf("replayed = %+v", replayed)
+	}
+
+	if err := websocket.JSON.Send(ws, clientFrame{Type: FramePing}); err != nil {
+		t.Fatalf("send: %v", err)
+	}
+	var pong serverFrame
+	receive(t, ws, &pong)
+	if pong.Type != framePong {
+		t.Errorf("ping answered with %+v", pong)
+	}
+
+	// The messages of the visitor come back as events, those of the agent are pushed.
+	if err := websocket.JSON.Send(ws, clientFrame{Type: FrameMessage, ID: "c-1", Text: "hello"}); err != nil {
+		t.Fatalf("send: %v", err)
+	}
+	var echoed wcmodel.Event
+	receive(t, ws, &echoed)
+	if echoed.Text != "hello" || echoed.ClientID != "c-1" || echoed.From != wcmodel.FromVisitor {
+		t.Errorf("echoed = %+v", echoed)
+	}
+	if texts := h.messenger.Texts(); len(texts) != 1 || texts[0].Body != "hello" {
+		t.Errorf("sent texts = %+v", texts)
+	}
+
+	if _, err := p.SendText(t.Context(), &sharedmodel.Message{GateID: testGateID, To: sharedmodel.Peer{Sub: "v_1"}, Text: "Hi, how can I help?"}); err != nil {
+		t.Fatalf("SendText: %v", err)
+	}
+	var pushed wcmodel.Event
+	receive(t, ws, &pushed)
+	if pushed.Text != "Hi, how can I help?" || pushed.From != wcmodel.FromAgent {
+		t.Errorf("pushed = %+v", pushed)
+	}
+
+	if err := websocket.JSON.Send(ws, clientFrame{Type: FrameMessage, ID: "c-2"}); err != nil {
+		t.Fatalf("send: %v", err)
+	}
+	var rejected serverFrame
+	receive(t, ws, &rejected)
+	if rejected.Type != frameError || rejected.ID != "c-2" {
+		t.Errorf("empty message answered with %+v", rejected)
+	}
+}
+
+func TestSocket_RejectsInvalidToken(t *testing.T) {
+	h := newWidgetServer(t)
+
+	url := "ws" + strings.TrimPrefix(h.server.URL, "http") + "/" + testGateID + "/ws?token=forged"
+	if ws, err := websocket.Dial(url, "", testOrigin); err == nil {
+		_ = ws.Close()
+		t.Error("socket opened with a forged token")
+	}
+}
@@ -0,0 +1,165 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/georgysavva/scany/v2/pgxscan"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	sharedmodel "github.com/webitel/im-providers-service/internal/core/model"
+	sharedstore "github.com/webitel/im-providers-service/internal/core/store"
+	wcmodel "github.com/webitel/im-providers-service/internal/webchat/model"
+	wcstore "github.com/webitel/im-providers-service/internal/webchat/store"
+	"github.com/webitel/im-providers-service/pkg/crypto"
+)
+
+var _ wcstore.WebChatStore = (*webChatStore)(nil)
+
+type webChatStore struct {
+	pool   *pgxpool.Pool
+	crypto crypto.Encryptor
+	cache  sharedstore.GateCache
+}
+
+func NewWebChatStore(pool *pgxpool.Pool, crypt crypto.Encryptor, cache sharedstore.GateCache) wcstore.WebChatStore {
+	return &webChatStore{
+		pool:   pool,
+		crypto: crypt,
+		cache:  cache,
+	}
+}
+
+func (s *webChatStore) Insert(ctx context.Context, dc int64, g *wcmodel.WebChatGate) error {
+	key, err := s.crypto.Encrypt(g.SigningKey)
+	if err != nil {
+		return fmt.Errorf("crypto: %w", err)
+	}
+
+	const query = `
+	WITH new_gate AS (
+		INSERT INTO im_provider.gates (dc, name, type, enabled)
+		VALUES ($1, $2, 'webchat', $3)
+		RETURNING id, name, created_at, updated_at
+	),
+	new_bot AS (
+		INSERT INTO im_provider.bots (sub, iss, gate_id)
+		SELECT $4, $5, id FROM new_gate
+		RETURNING id
+	)
+	INSERT INTO im_provider.webchat (gate_id, allowed_origins, signing_key, endpoint_url)
+	SELECT id, $6, $7, $8 FROM new_gate
+	RETURNING
+		gate_id,
+		(SELECT name FROM new_gate),
+		(SELECT created_at FROM new_gate),
+		(SELECT updated_at FROM new_gate)`
+
+	err = s.pool.QueryRow(ctx, query,
+		dc, g.Name, g.Enabled, g.Peer.Sub, g.Peer.Iss, origins(g.AllowedOrigins), key, g.EndpointURL,
+	).Scan(&g.ID, &g.Name, &g.CreatedAt, &g.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("postgres: insert web chat gateway: %w", err)
+	}
+
+	g.DomainID = dc
+	s.mapVirtualFields(g)
+	return nil
+}
+
+func (s *webChatStore) Select(ctx context.Context, id string) (*wcmodel.WebChatGate, error) {
+	const query = `
+	SELECT
+		g.id,
+		g.dc AS domain_id,
+		g.name,
+		g.enabled,
+		g.created_at,
+		g.updated_at,
+		b.sub AS "peer.sub",
+		b.iss AS "peer.iss",
+		wc.allowed_origins,
+		wc.signing_key,
+		wc.endpoint_url
+	FROM im_provider.gates g
+	JOIN im_provider.bots b ON g.id = b.gate_id
+	JOIN im_provider.webchat wc ON g.id = wc.gate_id
+	WHERE g.id = $1`
+
+	var g wcmodel.WebChatGate
+	if err := pgxscan.Get(ctx, s.pool, &g, query, id); err != nil {
+		if pgxscan.NotFound(err) {
+			return nil, sharedstore.ErrNotFound
+		}
+		return nil, fmt.Errorf("postgres: select web chat gate: %w", err)
+	}
+
+	if dec, err := s.crypto.Decrypt(g.SigningKey); err == nil {
+		g.SigningKey = dec
+	}
+
+	s.mapVirtualFields(&g)
+	return &g, nil
+}
+
+func (s *webChatStore) Update(ctx context.Context, g *wcmodel.WebChatGate) error {
+	key, err := s.crypto.Encrypt(g.SigningKey)
+	if err != nil {
+		return fmt.Errorf("crypto: %w", err)
+	}
+
+	err = pgx.BeginFunc(ctx, s.pool, func(tx pgx.Tx) error {
+		const uGate = `UPDATE im_provider.gates SET name = $1, enabled = $2, updated_at = NOW() WHERE id = $3 RETURNING updated_at`
+		if err := tx.QueryRow(ctx, uGate, g.Name, g.Enabled, g.ID).Scan(&g.UpdatedAt); err != nil {
+			return err
+		}
+
+		const uBot = `UPDATE im_provider.bots SET sub = $1, iss = $2 WHERE gate_id = $3`
+		if _, err := tx.Exec(ctx, uBot, g.Peer.Sub, g.Peer.Iss, g.ID); err != nil {
+			return err
+		}
+
+		const uConfig = `
+			UPDATE im_provider.webchat
+			SET allowed_origins = $1, signing_key = $2, endpoint_url = $3
+			WHERE gate_id = $4`
+		_, err := tx.Exec(ctx, uConfig, origins(g.AllowedOrigins), key, g.EndpointURL, g.ID)
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("postgres: update web chat gate: %w", err)
+	}
+
+	s.cache.Delete(g.ID)
+	s.mapVirtualFields(g)
+	return nil
+}
+
+func (s *webChatStore) Delete(ctx context.Context, id string) error {
+	res, err := s.pool.Exec(ctx, "DELETE FROM im_provider.gates WHERE id = $1 AND type = 'webchat'", id)
+	if err != nil {
+		return fmt.Errorf("postgres: delete web chat gate: %w", err)
+	}
+	if res.RowsAffected() == 0 {
+		return sharedstore.ErrNotFound
+	}
+
+	s.cache.Delete(id)
+	return nil
+}
+
+func (s *webChatStore) mapVirtualFields(g *wcmodel.WebChatGate) {
+	if g.Enabled {
+		g.Status = sharedmodel.StatusActive
+	} else {
+		g.Status = sharedmodel.StatusDisabled
+	}
+}
+
+// origins returns the allowed origins as stored: an empty array rather than NULL.
+func origins(list []string) []string {
+	if list == nil {
+		return []string{}
+	}
+	return list
+}
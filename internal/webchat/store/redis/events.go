@@ -0,0 +1,148 @@
+package redis
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	wcmodel "github.com/webitel/im-providers-service/internal/webchat/model"
+	wcstore "github.com/webitel/im-providers-service/internal/webchat/store"
+)
+
+const (
+	// historyKeyPrefix prefixes the stream of the messages of a visitor:
+	// webchat:history:<gate_id>:<visitor_id>.
+	historyKeyPrefix = "webchat:history:"
+	// eventsChannel fans the events out to every instance.
+	eventsChannel = "webchat:events"
+	// eventField is the stream entry field holding the event.
+	eventField = "e"
+	// historyLength bounds the history of a visitor, oldest messages are trimmed first.
+	historyLength = 500
+)
+
+var _ wcstore.EventLog = (*redisEventLog)(nil)
+
+type redisEventLog struct {
+	rdb *redis.Client
+	// ttl is how long the history of an idle visitor is kept.
+	ttl time.Duration
+}
+
+// NewRedisEventLog initializes the Redis-based event log: the history of a visitor
+// is a stream, the events are fanned out over a Pub/Sub channel.
+func NewRedisEventLog(rdb *redis.Client, ttl time.Duration) wcstore.EventLog {
+	return &redisEventLog{rdb: rdb, ttl: ttl}
+}
+
+func (r *redisEventLog) Append(ctx context.Context, ev *wcmodel.Event) error {
+	raw, err := json.Marshal(ev)
+	if err != nil {
+		return fmt.Errorf("redis: marshal web chat event: %w", err)
+	}
+
+	key := historyKey(ev.GateID, ev.VisitorID)
+	seq, err := r.rdb.XAdd(ctx, &redis.XAddArgs{
+		Stream: key,
+		MaxLen: historyLength,
+		Approx: true,
+		Values: map[string]any{eventField: raw},
+	}).Result()
+	if err != nil {
+		return fmt.Errorf("redis: append web chat event: %w", err)
+	}
+	// The stream entry ID becomes the cursor the widget replays the history from.
+	ev.Seq = seq
+
+	if err := r.rdb.Expire(ctx, key, r.ttl).Err(); err != nil {
+		return fmt.Errorf("redis: expire web chat history: %w", err)
+	}
+	return r.Publish(ctx, ev)
+}
+
+func (r *redisEventLog) Publish(ctx context.Context, ev *wcmodel.Event) error {
+	raw, err := json.Marshal(ev)
+	if err != nil {
+		return fmt.Errorf("redis: marshal web chat event: %w", err)
+	}
+	if err := r.rdb.Publish(ctx, eventsChannel, raw).Err(); err != nil {
+		return fmt.Errorf("redis: publish web chat event: %w", err)
+	}
+	return nil
+}
+
+func (r *redisEventLog) Since(ctx context.Context, gateID, visitorID, after string, limit int) ([]*wcmodel.Event, error) {
+	key := historyKey(gateID, visitorID)
+
+	var (
+		entries []redis.XMessage
+		err     error
+	)
+	if after != "" {
+		// An exclusive range, the message at the cursor has been seen.
+		entries, err = r.rdb.XRangeN(ctx, key, "("+after, "+", int64(limit)).Result()
+	} else {
+		// A fresh widget shows the end of the conversation.
+		entries, err = r.rdb.XRevRangeN(ctx, key, "+", "-", int64(limit)).Result()
+		slices.Reverse(entries)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("redis: read web chat history: %w", err)
+	}
+
+	events := make([]*wcmodel.Event, 0, len(entries))
+	for _, entry := range entries {
+		raw, _ := entry.Values[eventField].(string)
+		var ev wcmodel.Event
+		if err := json.Unmarshal([]byte(raw), &ev); err != nil {
+			continue
+		}
+		ev.Seq = entry.ID
+		events = append(events, &ev)
+	}
+	return events, nil
+}
+
+func (r *redisEventLog) Subscribe(ctx context.Context) (<-chan *wcmodel.Event, error) {
+	sub := r.rdb.Subscribe(ctx, eventsChannel)
+	// Wait for the confirmation, so no event published after Subscribe returns is missed.
+	if _, err := sub.Receive(ctx); err != nil {
+		_ = sub.Close()
+		return nil, fmt.Errorf("redis: subscribe to web chat events: %w", err)
+	}
+
+	out := make(chan *wcmodel.Event, 256)
+	go func() {
+		defer close(out)
+		defer sub.Close()
+
+		messages := sub.Channel()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case msg, ok := <-messages:
+				if !ok {
+					return
+				}
+				var ev wcmodel.Event
+				if err := json.Unmarshal([]byte(msg.Payload), &ev); err != nil {
+					continue
+				}
+				select {
+				case out <- &ev:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+	return out, nil
+}
+
+func historyKey(gateID, visitorID string) string {
+	return historyKeyPrefix + gateID + ":" + visitorID
+}
@@ -0,0 +1,30 @@
+package store
+
+import (
+	"context"
+
+	wcmodel "github.com/webitel/im-providers-service/internal/webchat/model"
+)
+
+// WebChatStore manages the web chat widgets.
+type WebChatStore interface {
+	// Insert creates the gate together with its bot peer and signing key.
+	Insert(ctx context.Context, dc int64, g *wcmodel.WebChatGate) error
+	Select(ctx context.Context, id string) (*wcmodel.WebChatGate, error)
+	Update(ctx context.Context, g *wcmodel.WebChatGate) error
+	Delete(ctx context.Context, id string) error
+}
+
+// EventLog keeps the history of the visitor conversations and fans their events out
+// to every instance, whichever one holds the connections of the visitor.
+type EventLog interface {
+	// Append adds a message to the history of the visitor, sets its Seq and publishes it.
+	Append(ctx context.Context, ev *wcmodel.Event) error
+	// Publish publishes an event without keeping it (e.g. a typing indicator).
+	Publish(ctx context.Context, ev *wcmodel.Event) error
+	// Since returns up to limit messages of the history of the visitor after the
+	// given Seq, or the latest ones when it is empty, oldest first.
+	Since(ctx context.Context, gateID, visitorID, after string, limit int) ([]*wcmodel.Event, error)
+	// Subscribe returns the events published by every instance until ctx is done.
+	Subscribe(ctx context.Context) (<-chan *wcmodel.Event, error)
+}
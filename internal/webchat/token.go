@@ -0,0 +1,87 @@
+package webchat
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// errInvalidToken is returned for a visitor token that is malformed, forged,
+// expired or issued by another gate.
+var errInvalidToken = errors.New("webchat: invalid visitor token")
+
+// visitorClaims are the claims of a visitor token. The token is the whole session:
+// nothing is stored server-side but the history of the visitor.
+type visitorClaims struct {
+	Gate    string `json:"g"`
+	Visitor string `json:"v"`
+	Name    string `json:"n,omitempty"`
+	// Expires is the expiry of the token in Unix seconds.
+	Expires int64 `json:"exp"`
+}
+
+// signVisitor issues a token with the base64-encoded signing key of the gate.
+// The token is the base64url-encoded claims and their HMAC-SHA256, dot-separated.
+func signVisitor(signingKey string, c visitorClaims) (string, error) {
+	key, err := base64.StdEncoding.DecodeString(signingKey)
+	if err != nil {
+		return "", fmt.Errorf("webchat: malformed signing key: %w", err)
+	}
+	raw, err := json.Marshal(c)
+	if err != nil {
+		return "", fmt.Errorf("webchat: marshal visitor claims: %w", err)
+	}
+	payload := base64.RawURLEncoding.EncodeToString(raw)
+	return payload + "." + base64.RawURLEncoding.EncodeToString(tokenMAC(key, payload)), nil
+}
+
+// parseVisitor checks a token issued by signVisitor for the gate and returns its claims.
+func parseVisitor(signingKey, gateID, token string, now time.Time) (*visitorClaims, error) {
+	payload, sig, ok := strings.Cut(token, ".")
+	if !ok {
+		return nil, errInvalidToken
+	}
+	key, err := base64.StdEncoding.DecodeString(signingKey)
+	if err != nil {
+		return nil, fmt.Errorf("webchat: malformed signing key: %w", err)
+	}
+	mac, err := base64.RawURLEncoding.DecodeString(sig)
+	if err != nil || !hmac.Equal(mac, tokenMAC(key, payload)) {
+		return nil, errInvalidToken
+	}
+
+	raw, err := base64.RawURLEncoding.DecodeString(payload)
+	if err != nil {
+		return nil, errInvalidToken
+	}
+	var c visitorClaims
+	if err := json.Unmarshal(raw, &c); err != nil {
+		return nil, errInvalidToken
+	}
+	if c.Gate != gateID || c.Visitor == "" || now.Unix() >= c.Expires {
+		return nil, errInvalidToken
+	}
+	return &c, nil
+}
+
+func tokenMAC(key []byte, payload string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(payload))
+	return mac.Sum(nil)
+}
+
+// newVisitorID returns the ID of a new visitor. It is never a UUID, which outbound
+// messages address internal contacts by, see resolveReceiver.
+func newVisitorID() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("webchat: generate visitor id: %w", err)
+	}
+	return visitorIDPrefix + base64.RawURLEncoding.EncodeToString(b), nil
+}
@@ -0,0 +1,58 @@
+package webchat
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestVisitorToken(t *testing.T) {
+	claims := visitorClaims{Gate: testGateID, Visitor: "v_1", Name: "Anna", Expires: testNow.Add(time.Hour).Unix()}
+	token, err := signVisitor(testKey, claims)
+	if err != nil {
+		t.Fatalf("sign: %v", err)
+	}
+
+	got, err := parseVisitor(testKey, testGateID, token, testNow)
+	if err != nil || *got != claims {
+		t.Fatalf("parse = %+v, %v", got, err)
+	}
+
+	payload, sig, _ := strings.Cut(token, ".")
+	otherKey, _ := signVisitor("b3RoZXIta2V5", claims)
+	forged, _ := signVisitor(testKey, visitorClaims{Gate: testGateID, Visitor: "v_2", Expires: claims.Expires})
+	_, forgedSig, _ := strings.Cut(forged, ".")
+
+	tests := []struct {
+		name  string
+		token string
+		gate  string
+		now   time.Time
+	}{
+		{"expired", token, testGateID, testNow.Add(2 * time.Hour)},
+		{"other gate", token, "other-gate", testNow},
+		{"other key", otherKey, testGateID, testNow},
+		{"swapped signature", payload + "." + forgedSig, testGateID, testNow},
+		{"unsigned", payload, testGateID, testNow},
+		{"garbage", "a." + sig, testGateID, testNow},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := parseVisitor(testKey, tt.gate, tt.token, tt.now); !errors.Is(err, errInvalidToken) {
+				t.Errorf("want errInvalidToken, got %v", err)
+			}
+		})
+	}
+}
+
+func TestNewVisitorID(t *testing.T) {
+	a, err := newVisitorID()
+	if err != nil {
+		t.Fatalf("newVisitorID: %v", err)
+	}
+	b, _ := newVisitorID()
+	if !strings.HasPrefix(a, visitorIDPrefix) || a == b {
+		t.Errorf("visitor IDs %q and %q", a, b)
+	}
+}
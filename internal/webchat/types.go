@@ -0,0 +1,85 @@
+package webchat
+
+import (
+	"errors"
+	"time"
+)
+
+// BasePath is the path the widget API is mounted on.
+const BasePath = "/webchat"
+
+const (
+	// sessionTTL is the lifetime of a visitor token, renewed on every new session,
+	// and of the history of an idle visitor.
+	sessionTTL = 30 * 24 * time.Hour
+	// visitorIDPrefix prefixes the IDs of visitors.
+	visitorIDPrefix = "v_"
+	// replayLimit bounds the messages replayed at once; the widget pages through
+	// the rest of the history with the seq of the last one.
+	replayLimit = 100
+	// maxTextLength bounds the text of a visitor message, in bytes.
+	maxTextLength = 4096
+	// maxFrameSize bounds a frame received from the widget.
+	maxFrameSize = 16 << 10
+	// maxUploadSize bounds a file uploaded by a visitor.
+	maxUploadSize = 20 << 20
+	// idleTimeout closes a socket the widget has not sent anything on, a ping included.
+	idleTimeout = 90 * time.Second
+)
+
+// Frame types sent by the widget over the socket or to the messages endpoint.
+const (
+	FrameMessage  = "message"
+	FrameTyping   = "typing"
+	FrameCallback = "callback"
+	FramePing     = "ping"
+)
+
+// Frame types sent to the widget besides the events, see wcmodel.Event.
+const (
+	framePong  = "pong"
+	frameError = "error"
+)
+
+var (
+	errUnknownFrame = errors.New("webchat: unknown frame type")
+	errEmptyMessage = errors.New("webchat: message is empty")
+	errTextTooLong  = errors.New("webchat: message text is too long")
+	errBadCallback  = errors.New("webchat: callback has no message or button")
+)
+
+// clientFrame is a frame sent by the widget.
+type clientFrame struct {
+	Type string `json:"type"`
+	// ID is the ID the widget assigned to a message, echoed back as the client_id
+	// of the message event and the id of an error frame.
+	ID   string `json:"id,omitempty"`
+	Text string `json:"text,omitempty"`
+	// InReplyTo, Code and Data answer an interactive message: the ID of the message
+	// and the ID and callback data of the button.
+	InReplyTo string `json:"in_reply_to,omitempty"`
+	Code      string `json:"code,omitempty"`
+	Data      string `json:"data,omitempty"`
+}
+
+// serverFrame is a frame sent to the widget that is not an event.
+type serverFrame struct {
+	Type  string `json:"type"`
+	ID    string `json:"id,omitempty"`
+	Error string `json:"error,omitempty"`
+}
+
+// session is the reply to a session request.
+type session struct {
+	Token     string `json:"token"`
+	VisitorID string `json:"visitor_id"`
+	// ExpiresAt is the expiry of the token in Unix milliseconds.
+	ExpiresAt int64 `json:"expires_at"`
+}
+
+// sessionRequest starts or resumes a session. A token of a live session resumes it
+// and keeps the history of the visitor.
+type sessionRequest struct {
+	Token string `json:"token,omitempty"`
+	Name  string `json:"name,omitempty"`
+}
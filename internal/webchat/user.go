@@ -0,0 +1,66 @@
+package webchat
+
+import (
+	"context"
+
+	gatewayv1 "github.com/webitel/im-providers-service/gen/go/gateway/v1"
+	sharedmodel "github.com/webitel/im-providers-service/internal/core/model"
+	"github.com/webitel/im-providers-service/internal/provider/contactsync"
+	wcmodel "github.com/webitel/im-providers-service/internal/webchat/model"
+)
+
+// metadataOrigin is the contact metadata key of the website a visitor came from.
+const metadataOrigin = "origin"
+
+// visitorNameSuffix is the length of the visitor ID suffix naming an anonymous contact.
+const visitorNameSuffix = 6
+
+// syncContact resolves the internal contact for a visitor, creating it if necessary.
+// Visitors are anonymous unless they introduced themselves when the session started.
+func (h *Handler) syncContact(ctx context.Context, gate *wcmodel.WebChatGate, claims *visitorClaims, origin string) error {
+	external := &sharedmodel.ExternalUser{ID: claims.Visitor, FirstName: visitorName(claims)}
+	key := contactsync.KnownUser(gate.ID, external)
+	if known, _ := h.userCache.IsKnown(ctx, key); known {
+		return nil
+	}
+
+	authCtx := contactsync.WithGatewayIdentity(ctx, gate.DomainID, gate.Peer)
+	contact, err := h.ensureContact(authCtx, external, origin)
+	if err != nil {
+		return err
+	}
+
+	contactsync.EnsureVia(authCtx, h.gatewayer, h.logger, gate.ID, claims.Visitor, contact.Iss)
+	_ = h.userCache.MarkKnown(ctx, key)
+	return nil
+}
+
+// visitorName returns the contact name of a visitor, told apart by the end of the
+// visitor ID when it did not introduce itself.
+func visitorName(claims *visitorClaims) string {
+	if claims.Name != "" {
+		return claims.Name
+	}
+	id := claims.Visitor
+	if len(id) > visitorNameSuffix {
+		id = id[len(id)-visitorNameSuffix:]
+	}
+	return "Web visitor " + id
+}
+
+// ensureContact creates the internal contact or returns a stub when the
+// contact already exists (idempotent by design on the gateway side).
+func (h *Handler) ensureContact(ctx context.Context, external *sharedmodel.ExternalUser, origin string) (*gatewayv1.Contact, error) {
+	var metadata map[string]string
+	if origin != "" {
+		metadata = map[string]string{metadataOrigin: origin}
+	}
+
+	return contactsync.CreateContact(ctx, h.gatewayer, &gatewayv1.CreateContactRequest{
+		IssId:    providerType,
+		Type:     providerType,
+		Name:     external.FirstName,
+		Subject:  external.ID,
+		Metadata: metadata,
+	})
+}
@@ -2,6 +2,7 @@ package whatsapp
 
 import (
 	impb "github.com/webitel/im-providers-service/gen/go/provider/v1"
+	"github.com/webitel/im-providers-service/internal/provider"
 	"github.com/webitel/im-providers-service/internal/whatsapp/messaging"
 	"github.com/webitel/im-providers-service/internal/whatsapp/webhook"
 )
@@ -14,3 +15,10 @@ type WhatsApp struct {
 	*webhook.WebhookManager
 	*messaging.Messaging
 }
+
+var (
+	_ provider.InteractiveSender = (*WhatsApp)(nil)
+	_ provider.LocationSender    = (*WhatsApp)(nil)
+	_ provider.ContactSender     = (*WhatsApp)(nil)
+	_ provider.ReactionSender    = (*WhatsApp)(nil)
+)
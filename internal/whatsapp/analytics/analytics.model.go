@@ -0,0 +1,92 @@
+package analytics
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/webitel/webitel-go-kit/pkg/errors"
+)
+
+// maxUsageRange bounds the period of a single usage query.
+const maxUsageRange = 366 * 24 * time.Hour
+
+// ConversationEvent is the conversation and pricing of a sent message, as reported by its status.
+// https://developers.facebook.com/docs/whatsapp/cloud-api/webhooks/payload-examples#message-status-updates
+type ConversationEvent struct {
+	GateID   uuid.UUID `db:"gate_id"`
+	DomainID int64     `db:"dc"`
+
+	// ConversationID is empty under per-message pricing, where statuses carry no conversation.
+	ConversationID        string     `db:"conversation_id"`
+	MessageID             string     `db:"message_id"`
+	RecipientID           string     `db:"recipient_id"`
+	Status                string     `db:"status"`
+	OriginType            string     `db:"origin_type"`
+	ConversationExpiresAt *time.Time `db:"conversation_expires_at"`
+
+	Billable     bool   `db:"billable"`
+	PricingModel string `db:"pricing_model"`
+	Category     string `db:"category"`
+
+	OccurredAt time.Time `db:"occurred_at"`
+}
+
+func (event *ConversationEvent) Validate() error {
+	if event == nil {
+		return errors.InvalidArgument("conversation event is required", errors.WithID("analytics.model.validate"))
+	}
+
+	if event.GateID == uuid.Nil {
+		return errors.InvalidArgument("gate id is required", errors.WithID("analytics.model.validate"))
+	}
+
+	if event.MessageID == "" {
+		return errors.InvalidArgument("message id is required", errors.WithID("analytics.model.validate"), errors.WithValue("gate_id", event.GateID.String()))
+	}
+
+	if event.OccurredAt.IsZero() {
+		return errors.InvalidArgument("event time is required", errors.WithID("analytics.model.validate"), errors.WithValue("message_id", event.MessageID))
+	}
+
+	return nil
+}
+
+// UsageQuery selects the conversation events of a domain in [From, To), optionally of some gates or a category.
+type UsageQuery struct {
+	DomainID int64
+	GateIDs  []uuid.UUID
+	Category string
+	From     time.Time
+	To       time.Time
+}
+
+func (query *UsageQuery) Validate() error {
+	if query == nil {
+		return errors.InvalidArgument("usage query is required", errors.WithID("analytics.model.validate_usage_query"))
+	}
+
+	if query.DomainID <= 0 {
+		return errors.InvalidArgument("domain id is required", errors.WithID("analytics.model.validate_usage_query"))
+	}
+
+	if query.From.IsZero() || query.To.IsZero() || !query.From.Before(query.To) {
+		return errors.InvalidArgument("usage period must start before it ends", errors.WithID("analytics.model.validate_usage_query"), errors.WithValue("from", query.From), errors.WithValue("to", query.To))
+	}
+
+	if query.To.Sub(query.From) > maxUsageRange {
+		return errors.InvalidArgument("usage period must not exceed a year", errors.WithID("analytics.model.validate_usage_query"), errors.WithValue("from", query.From), errors.WithValue("to", query.To))
+	}
+
+	return nil
+}
+
+// Usage is the number of conversations and messages of a gate and pricing category in a day (UTC).
+type Usage struct {
+	GateID                uuid.UUID `db:"gate_id"`
+	Category              string    `db:"category"`
+	Day                   time.Time `db:"day"`
+	Conversations         int64     `db:"conversations"`
+	BillableConversations int64     `db:"billable_conversations"`
+	Messages              int64     `db:"messages"`
+	BillableMessages      int64     `db:"billable_messages"`
+}
@@ -0,0 +1,110 @@
+package analytics
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/webitel/im-providers-service/infra/db/postgresx"
+	"github.com/webitel/webitel-go-kit/pkg/errors"
+)
+
+type analyticsRepository struct {
+	db postgresx.DB
+}
+
+func newAnalyticsRepository(db postgresx.DB) *analyticsRepository {
+	return &analyticsRepository{db: db}
+}
+
+// Save upserts the events in one batch. A later status of a message updates its row,
+// while the row keeps the time of the first status as the time the message was billed.
+func (repository *analyticsRepository) Save(ctx context.Context, events []ConversationEvent) error {
+	batch := postgresx.NewBatchBuilder()
+	for _, event := range events {
+		batch.Queue(saveConversationEventSQLQuery, postgresx.NamedArgs{
+			"GateID":                event.GateID,
+			"DomainID":              event.DomainID,
+			"ConversationID":        event.ConversationID,
+			"MessageID":             event.MessageID,
+			"RecipientID":           event.RecipientID,
+			"Status":                event.Status,
+			"OriginType":            event.OriginType,
+			"ConversationExpiresAt": event.ConversationExpiresAt,
+			"Billable":              event.Billable,
+			"PricingModel":          event.PricingModel,
+			"Category":              event.Category,
+			"OccurredAt":            event.OccurredAt,
+		})
+	}
+
+	results := repository.db.SendBatch(ctx, batch.Build())
+	defer results.Close()
+
+	for range batch.Len() {
+		if _, err := results.Exec(); err != nil {
+			return errors.Internal("saving conversation events", errors.WithCause(err), errors.WithID("analytics.repository.save"), errors.WithValue("stmt", saveConversationEventSQLQuery))
+		}
+	}
+
+	return nil
+}
+
+func (repository *analyticsRepository) Usage(ctx context.Context, query UsageQuery) ([]Usage, error) {
+	args := postgresx.NamedArgs{
+		"DomainID": query.DomainID,
+		"GateIDs":  query.GateIDs,
+		"Category": query.Category,
+		"From":     query.From,
+		"To":       query.To,
+	}
+
+	rows, err := repository.db.Replica().Query(ctx, usageSQLQuery, args)
+	if err != nil {
+		return nil, errors.Internal("executing usage sql query", errors.WithCause(err), errors.WithID("analytics.repository.usage"), errors.WithValue("stmt", usageSQLQuery))
+	}
+
+	usage, err := pgx.CollectRows(rows, pgx.RowToStructByName[Usage])
+	if err != nil {
+		return nil, errors.Internal("collecting usage rows", errors.WithCause(err), errors.WithID("analytics.repository.usage"))
+	}
+
+	return usage, nil
+}
+
+var saveConversationEventSQLQuery = postgresx.CompactSQL(`
+	insert into "im_provider"."waba_conversation_events" as e (
+		"gate_id", "dc", "conversation_id", "message_id", "recipient_id", "status", "origin_type",
+		"conversation_expires_at", "billable", "pricing_model", "category", "occurred_at", "status_at"
+	)
+	values (
+		@GateID, @DomainID, @ConversationID, @MessageID, @RecipientID, @Status, @OriginType,
+		@ConversationExpiresAt, @Billable, @PricingModel, @Category, @OccurredAt, @OccurredAt
+	)
+	on conflict ("gate_id", "conversation_id", "message_id") do update set
+		"status" = case when excluded."status_at" >= e."status_at" then excluded."status" else e."status" end,
+		"status_at" = greatest(e."status_at", excluded."status_at"),
+		"occurred_at" = least(e."occurred_at", excluded."occurred_at"),
+		"origin_type" = coalesce(nullif(excluded."origin_type", ''), e."origin_type"),
+		"conversation_expires_at" = coalesce(excluded."conversation_expires_at", e."conversation_expires_at"),
+		"billable" = excluded."billable",
+		"pricing_model" = coalesce(nullif(excluded."pricing_model", ''), e."pricing_model"),
+		"category" = coalesce(nullif(excluded."category", ''), e."category");
+`)
+
+var usageSQLQuery = postgresx.CompactSQL(`
+	select
+		"gate_id",
+		"category",
+		date_trunc('day', "occurred_at", 'UTC') as "day",
+		count(distinct nullif("conversation_id", '')) as "conversations",
+		count(distinct nullif("conversation_id", '')) filter (where "billable") as "billable_conversations",
+		count(*) as "messages",
+		count(*) filter (where "billable") as "billable_messages"
+	from "im_provider"."waba_conversation_events"
+	where "dc" = @DomainID
+		and "occurred_at" >= @From and "occurred_at" < @To
+		and (coalesce(cardinality(@GateIDs::uuid[]), 0) = 0 or "gate_id" = any(@GateIDs::uuid[]))
+		and (@Category::text = '' or "category" = @Category::text)
+	group by "gate_id", "category", "day"
+	order by "day", "gate_id", "category";
+`)
@@ -0,0 +1,114 @@
+package analytics
+
+import (
+	"context"
+	"io/fs"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/webitel/im-providers-service/infra/db/postgresx"
+	"github.com/webitel/im-providers-service/migrations"
+)
+
+// testPostgresDSNEnv points the repository tests at a disposable Postgres:
+// the im_provider schema is dropped and migrated from scratch on every run.
+const testPostgresDSNEnv = "IM_PROVIDERS_TEST_POSTGRES_DSN"
+
+func newTestRepository(t *testing.T) *analyticsRepository {
+	t.Helper()
+
+	dsn := os.Getenv(testPostgresDSNEnv)
+	if dsn == "" {
+		t.Skipf("%s is not set", testPostgresDSNEnv)
+	}
+
+	ctx := context.Background()
+	db, err := postgresx.New(ctx, dsn)
+	if err != nil {
+		t.Fatalf("connecting to postgres: %v", err)
+	}
+	t.Cleanup(db.Close)
+
+	if _, err := db.Exec(ctx, `drop schema if exists "im_provider" cascade`); err != nil {
+		t.Fatalf("dropping schema: %v", err)
+	}
+
+	entries, err := fs.ReadDir(migrations.EmbedMigrations, ".")
+	if err != nil {
+		t.Fatalf("reading migrations: %v", err)
+	}
+	for _, entry := range entries {
+		raw, err := fs.ReadFile(migrations.EmbedMigrations, entry.Name())
+		if err != nil {
+			t.Fatalf("reading %s: %v", entry.Name(), err)
+		}
+
+		up, _, _ := strings.Cut(string(raw), "-- +goose Down")
+		up = strings.NewReplacer("-- +goose Up", "", "-- +goose StatementBegin", "", "-- +goose StatementEnd", "").Replace(up)
+
+		if _, err := db.Exec(ctx, up); err != nil {
+			t.Fatalf("applying %s: %v", entry.Name(), err)
+		}
+	}
+
+	return newAnalyticsRepository(db)
+}
+
+func TestAnalyticsRepository_Usage(t *testing.T) {
+	repository := newTestRepository(t)
+	ctx := context.Background()
+
+	var (
+		gateA = uuid.New()
+		gateB = uuid.New()
+		day   = time.Date(2025, 6, 18, 0, 0, 0, 0, time.UTC)
+	)
+
+	events := []ConversationEvent{
+		// two messages of one billable utility conversation, the first one reported twice
+		{GateID: gateA, DomainID: 1, ConversationID: "c1", MessageID: "m1", Status: "sent", Billable: true, PricingModel: "CBP", Category: "utility", OccurredAt: day.Add(9 * time.Hour)},
+		{GateID: gateA, DomainID: 1, ConversationID: "c1", MessageID: "m1", Status: "delivered", Billable: true, PricingModel: "CBP", Category: "utility", OccurredAt: day.Add(9*time.Hour + time.Minute)},
+		{GateID: gateA, DomainID: 1, ConversationID: "c1", MessageID: "m2", Status: "sent", Billable: true, PricingModel: "CBP", Category: "utility", OccurredAt: day.Add(10 * time.Hour)},
+		// a free service conversation
+		{GateID: gateA, DomainID: 1, ConversationID: "c2", MessageID: "m3", Status: "sent", PricingModel: "CBP", Category: "service", OccurredAt: day.Add(11 * time.Hour)},
+		// a per-message priced marketing message of another gate, on the next day
+		{GateID: gateB, DomainID: 1, MessageID: "m4", Status: "delivered", Billable: true, PricingModel: "PMP", Category: "marketing", OccurredAt: day.Add(26 * time.Hour)},
+		// another domain
+		{GateID: uuid.New(), DomainID: 2, ConversationID: "c3", MessageID: "m5", Status: "sent", Billable: true, Category: "utility", OccurredAt: day.Add(9 * time.Hour)},
+	}
+	if err := repository.Save(ctx, events); err != nil {
+		t.Fatalf("Save: %v", err)
+	}
+
+	usage, err := repository.Usage(ctx, UsageQuery{DomainID: 1, From: day, To: day.AddDate(0, 0, 7)})
+	if err != nil {
+		t.Fatalf("Usage: %v", err)
+	}
+
+	if len(usage) != 3 {
+		t.Fatalf("got %d usage rows, want 3: %+v", len(usage), usage)
+	}
+
+	// rows are ordered by day, gate and category
+	service, utility, marketing := usage[0], usage[1], usage[2]
+	if utility.Category != "utility" || utility.Conversations != 1 || utility.BillableConversations != 1 || utility.Messages != 2 || utility.BillableMessages != 2 {
+		t.Errorf("utility usage = %+v", utility)
+	}
+	if service.Category != "service" || service.Conversations != 1 || service.BillableConversations != 0 || service.Messages != 1 {
+		t.Errorf("service usage = %+v", service)
+	}
+	if marketing.GateID != gateB || marketing.Conversations != 0 || marketing.BillableMessages != 1 || !marketing.Day.Equal(day.AddDate(0, 0, 1)) {
+		t.Errorf("marketing usage = %+v", marketing)
+	}
+
+	filtered, err := repository.Usage(ctx, UsageQuery{DomainID: 1, GateIDs: []uuid.UUID{gateB}, From: day, To: day.AddDate(0, 0, 7)})
+	if err != nil {
+		t.Fatalf("Usage of a gate: %v", err)
+	}
+	if len(filtered) != 1 || filtered[0].GateID != gateB {
+		t.Errorf("usage of gate B = %+v", filtered)
+	}
+}
@@ -0,0 +1,51 @@
+package analytics
+
+import (
+	"context"
+	"log/slog"
+)
+
+type AnalyticsRepository interface {
+	Save(ctx context.Context, events []ConversationEvent) error
+	Usage(ctx context.Context, query UsageQuery) ([]Usage, error)
+}
+
+// Analytics records the conversation and pricing of WhatsApp message statuses and reports the usage of domains.
+type Analytics struct {
+	logger     *slog.Logger
+	repository AnalyticsRepository
+}
+
+func newAnalytics(logger *slog.Logger, repository AnalyticsRepository) *Analytics {
+	return &Analytics{
+		logger:     logger.With("component", "whatsapp_analytics_usecase"),
+		repository: repository,
+	}
+}
+
+// RecordConversationEvents saves the events, skipping the invalid ones.
+func (analytics *Analytics) RecordConversationEvents(ctx context.Context, events []ConversationEvent) error {
+	valid := make([]ConversationEvent, 0, len(events))
+	for _, event := range events {
+		if err := event.Validate(); err != nil {
+			analytics.logger.Warn("skipping conversation event", "error", err, "message_id", event.MessageID)
+			continue
+		}
+		valid = append(valid, event)
+	}
+
+	if len(valid) == 0 {
+		return nil
+	}
+
+	return analytics.repository.Save(ctx, valid)
+}
+
+// Usage aggregates the conversation events of a domain by gate, pricing category and day.
+func (analytics *Analytics) Usage(ctx context.Context, query UsageQuery) ([]Usage, error) {
+	if err := query.Validate(); err != nil {
+		return nil, err
+	}
+
+	return analytics.repository.Usage(ctx, query)
+}
@@ -0,0 +1,80 @@
+package analytics
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type fakeAnalyticsRepository struct {
+	saved  []ConversationEvent
+	usages []UsageQuery
+}
+
+func (repository *fakeAnalyticsRepository) Save(_ context.Context, events []ConversationEvent) error {
+	repository.saved = append(repository.saved, events...)
+	return nil
+}
+
+func (repository *fakeAnalyticsRepository) Usage(_ context.Context, query UsageQuery) ([]Usage, error) {
+	repository.usages = append(repository.usages, query)
+	return nil, nil
+}
+
+func newTestAnalytics() (*Analytics, *fakeAnalyticsRepository) {
+	repository := &fakeAnalyticsRepository{}
+	return newAnalytics(slog.New(slog.NewTextHandler(io.Discard, nil)), repository), repository
+}
+
+func TestAnalytics_RecordConversationEvents(t *testing.T) {
+	analytics, repository := newTestAnalytics()
+	now := time.Now()
+
+	err := analytics.RecordConversationEvents(context.Background(), []ConversationEvent{
+		{GateID: uuid.New(), MessageID: "wamid.1", OccurredAt: now},
+		{GateID: uuid.Nil, MessageID: "wamid.2", OccurredAt: now},
+		{GateID: uuid.New(), MessageID: "", OccurredAt: now},
+		{GateID: uuid.New(), MessageID: "wamid.4"},
+	})
+	if err != nil {
+		t.Fatalf("RecordConversationEvents: %v", err)
+	}
+
+	if len(repository.saved) != 1 || repository.saved[0].MessageID != "wamid.1" {
+		t.Errorf("saved = %+v, want only the valid event", repository.saved)
+	}
+}
+
+func TestAnalytics_Usage_Validate(t *testing.T) {
+	from := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name  string
+		query UsageQuery
+		valid bool
+	}{
+		{"month", UsageQuery{DomainID: 1, From: from, To: from.AddDate(0, 1, 0)}, true},
+		{"no domain", UsageQuery{From: from, To: from.AddDate(0, 1, 0)}, false},
+		{"empty period", UsageQuery{DomainID: 1, From: from, To: from}, false},
+		{"reversed period", UsageQuery{DomainID: 1, From: from, To: from.AddDate(0, 0, -1)}, false},
+		{"over a year", UsageQuery{DomainID: 1, From: from, To: from.AddDate(1, 1, 0)}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			analytics, repository := newTestAnalytics()
+
+			_, err := analytics.Usage(context.Background(), tt.query)
+			if (err == nil) != tt.valid {
+				t.Errorf("Usage() error = %v, want valid %v", err, tt.valid)
+			}
+			if queried := len(repository.usages) == 1; queried != tt.valid {
+				t.Errorf("repository queried = %v", queried)
+			}
+		})
+	}
+}
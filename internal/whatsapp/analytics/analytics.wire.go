@@ -0,0 +1,22 @@
+package analytics
+
+import (
+	"log/slog"
+
+	"github.com/webitel/im-providers-service/infra/db/postgresx"
+)
+
+type analyticsModule struct {
+	Analytics *Analytics
+}
+
+func NewAnalyticsModule(logger *slog.Logger, db postgresx.DB) *analyticsModule {
+	var (
+		analyticsRepository = newAnalyticsRepository(db)
+		analyticsUsecase    = newAnalytics(logger, analyticsRepository)
+	)
+
+	return &analyticsModule{
+		Analytics: analyticsUsecase,
+	}
+}
@@ -0,0 +1,119 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"slices"
+
+	"github.com/webitel/webitel-go-kit/pkg/errors"
+	"google.golang.org/grpc/codes"
+)
+
+// https://developers.facebook.com/docs/whatsapp/cloud-api/support/error-codes
+// Meta error codes of requests that were not processed and may succeed when repeated.
+var temporaryMetaErrorCodes = []int{
+	1,      // API Unknown
+	2,      // API Service
+	4,      // API Too Many Calls
+	17,     // API User Too Many Calls
+	341,    // Application limit reached
+	80007,  // Rate limit issues
+	130429, // Rate limit hit
+	131000, // Something went wrong
+	131016, // Service unavailable
+	133004, // Server temporarily unavailable
+}
+
+// MessageSendError is the error object of Graph API responses.
+type MessageSendError struct {
+	Message   string `json:"message"`
+	Type      string `json:"type"`
+	Code      int    `json:"code"`
+	ErrorData struct {
+		MessagingProduct string `json:"messaging_product"`
+		Details          string `json:"details"`
+	} `json:"error_data"`
+	ErrorSubcode int    `json:"error_subcode"`
+	IsTransient  bool   `json:"is_transient"`
+	FbtraceID    string `json:"fbtrace_id"`
+
+	// StatusCode is the HTTP status of the response the error came with.
+	StatusCode int `json:"-"`
+}
+
+func (messageSendError *MessageSendError) Error() string {
+	return fmt.Sprintf("graph api error %d: %s", messageSendError.Code, messageSendError.Message)
+}
+
+// Temporary reports whether the request was not processed and may be repeated.
+func (messageSendError *MessageSendError) Temporary() bool {
+	return messageSendError.IsTransient || slices.Contains(temporaryMetaErrorCodes, messageSendError.Code)
+}
+
+func (messageSendError MessageSendError) ToGRPCError() error {
+	var code codes.Code
+	switch messageSendError.Code {
+	case 190:
+		code = codes.Unauthenticated
+	case 100:
+		code = codes.InvalidArgument
+	case 4, 17, 80007, 130429:
+		code = codes.ResourceExhausted
+	case 10:
+		code = codes.PermissionDenied
+	default:
+		code = codes.Internal
+	}
+
+	return errors.New(
+		messageSendError.Message,
+		errors.WithCode(code),
+		errors.WithCause(&messageSendError),
+		errors.WithValue("fbtrace_id", messageSendError.FbtraceID),
+		errors.WithValue("meta_code", messageSendError.Code),
+		errors.WithValue("meta_subcode", messageSendError.ErrorSubcode),
+	)
+}
+
+// decodeErrorResponse decodes the error of a non-2xx Graph API response.
+// A body without an error object becomes an error with the HTTP status text.
+func decodeErrorResponse(statusCode int, body []byte) *MessageSendError {
+	var errorResponse struct {
+		Error *MessageSendError `json:"error"`
+	}
+
+	if err := json.Unmarshal(body, &errorResponse); err != nil || errorResponse.Error == nil {
+		// gateway failures mean the request has not reached Graph API
+		errorResponse.Error = &MessageSendError{
+			Message:     http.StatusText(statusCode),
+			IsTransient: slices.Contains([]int{http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout}, statusCode),
+		}
+	}
+
+	errorResponse.Error.StatusCode = statusCode
+	return errorResponse.Error
+}
+
+// MediaDownloadStatusError is the non-2xx status of a media download response.
+type MediaDownloadStatusError struct {
+	StatusCode int
+}
+
+func (err *MediaDownloadStatusError) Error() string {
+	return fmt.Sprintf("media download responded with status %d", err.StatusCode)
+}
+
+// Expired reports whether the media URL is no longer valid and has to be retrieved again by media ID.
+func (err *MediaDownloadStatusError) Expired() bool {
+	switch err.StatusCode {
+	case http.StatusUnauthorized, http.StatusForbidden, http.StatusNotFound:
+		return true
+	}
+	return false
+}
+
+// Temporary reports whether the same request may succeed later.
+func (err *MediaDownloadStatusError) Temporary() bool {
+	return err.StatusCode == http.StatusTooManyRequests || err.StatusCode >= http.StatusInternalServerError
+}
@@ -1,13 +1,23 @@
 package client
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
 	"strings"
 
 	"github.com/webitel/webitel-go-kit/pkg/errors"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	otelcodes "go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc/codes"
 )
 
 const (
@@ -22,10 +32,14 @@ const (
 	WhatsAppApiTypeBusiness WhatsAppApiType = "business"
 )
 
+var tracer = otel.Tracer("github.com/webitel/im-providers-service/internal/whatsapp/client")
+
 type RequestClient struct {
 	apiVersion  string
 	baseUrl     string
 	accessToken string
+	httpClient  *http.Client
+	retryPolicy RetryPolicy
 }
 
 func (client *RequestClient) BaseURL() string     { return client.baseUrl }
@@ -48,67 +62,143 @@ func NewRequesClient(options ...func(cfg *RequestClientConfig)) (*RequestClient,
 		apiVersion:  cfg.apiVersion,
 		baseUrl:     cfg.baseURL,
 		accessToken: cfg.accessToken,
+		httpClient:  cfg.httpClient,
+		retryPolicy: cfg.retryPolicy,
 	}
 
 	return &client, nil
 }
 
-type RequestCloudApiParams struct {
-	Body       string
-	Path       string
-	Method     string
-	QueryParam map[string]string
+// graphRequest is a single Graph API call.
+type graphRequest struct {
+	method string
+	// path is relative to the versioned Graph API URL.
+	path        string
+	query       url.Values
+	contentType string
+	// authorization defaults to the Bearer access token.
+	authorization string
+	header        map[string]string
+
+	// body is sent as is on every attempt; stream is sent once and is never retried.
+	body   []byte
+	stream io.Reader
 }
 
-func (client *RequestClient) requestWithContext(ctx context.Context, params RequestCloudApiParams) (string, error) {
-	queryParamsString := ""
-	if len(params.QueryParam) > 0 {
-		queryParamsString = "?"
-		for k, v := range params.QueryParam {
-			if queryParamsString != "?" {
-				queryParamsString += "&"
-				queryParamsString += strings.Join([]string{
-					queryParamsString, k, "=", v,
-				}, "")
-			} else {
-				queryParamsString += strings.Join([]string{k, "=", v}, "")
-			}
-		}
+func (client *RequestClient) endpoint(path string, query url.Values) (string, error) {
+	endpoint, err := url.JoinPath(client.baseUrl, client.apiVersion, path)
+	if err != nil {
+		return "", err
 	}
 
-	requestPath := strings.Join(
-		[]string{RequestProtocol, "://", client.baseUrl, "/", client.apiVersion, "/", params.Path, queryParamsString},
-		"",
+	if len(query) > 0 {
+		endpoint += "?" + query.Encode()
+	}
+
+	return endpoint, nil
+}
+
+// send executes the request within a client span. Responses with a temporary Meta error
+// are retried, as Meta has not processed them. Transport failures are only retried for
+// GET and DELETE. A non-2xx response is returned as a gRPC error caused by MessageSendError.
+func (client *RequestClient) send(ctx context.Context, request graphRequest) ([]byte, error) {
+	ctx, span := tracer.Start(ctx, "whatsapp.graph_api "+request.method,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			attribute.String("http.request.method", request.method),
+			attribute.String("url.path", request.path),
+			attribute.String("server.address", client.baseUrl),
+		),
+	)
+	defer span.End()
+
+	var (
+		responseBody []byte
+		attempt      int
 	)
 
-	httpRequest, err := http.NewRequestWithContext(ctx, params.Method, requestPath, strings.NewReader(params.Body))
+	err := client.retryPolicy.Do(ctx, func(ctx context.Context) error {
+		attempt++
+		if attempt > 1 {
+			span.AddEvent("retry", trace.WithAttributes(attribute.Int("attempt", attempt)))
+		}
+
+		var err error
+		responseBody, err = client.sendOnce(ctx, span, request)
+		if err != nil && request.stream != nil {
+			return Permanent(err)
+		}
+		return err
+	})
+
+	span.SetAttributes(attribute.Int("whatsapp.request.attempts", attempt))
 	if err != nil {
-		return "", errors.Internal("creating new http request", errors.WithCause(err), errors.WithID("client.request.client.request"))
+		span.RecordError(err)
+		span.SetStatus(otelcodes.Error, err.Error())
+		return nil, err
 	}
 
-	httpRequest.Header.Set("Content-Type", "application/json")
-	httpRequest.Header.Set(
-		"Authorization",
-		fmt.Sprintf("Bearer %s", client.accessToken),
-	)
+	return responseBody, nil
+}
 
-	httpClient := http.DefaultClient
-	response, err := httpClient.Do(httpRequest)
+func (client *RequestClient) sendOnce(ctx context.Context, span trace.Span, request graphRequest) ([]byte, error) {
+	endpoint, err := client.endpoint(request.path, request.query)
 	if err != nil {
-		return "", err
+		return nil, Permanent(errors.InvalidArgument("building request url", errors.WithCause(err), errors.WithID("client.request.client.send"), errors.WithValue("path", request.path)))
+	}
+
+	body := request.stream
+	if body == nil {
+		body = bytes.NewReader(request.body)
+	}
+
+	httpRequest, err := http.NewRequestWithContext(ctx, request.method, endpoint, body)
+	if err != nil {
+		return nil, Permanent(errors.Internal("creating new http request", errors.WithCause(err), errors.WithID("client.request.client.send")))
+	}
+
+	authorization := request.authorization
+	if authorization == "" {
+		authorization = "Bearer " + client.accessToken
+	}
+	httpRequest.Header.Set("Authorization", authorization)
+
+	if request.contentType != "" {
+		httpRequest.Header.Set("Content-Type", request.contentType)
+	}
+	for key, value := range request.header {
+		httpRequest.Header.Set(key, value)
+	}
+
+	response, err := client.httpClient.Do(httpRequest)
+	if err != nil {
+		transportErr := errors.New("executing graph api request", errors.WithCode(codes.Unavailable), errors.WithCause(err), errors.WithID("client.request.client.send"))
+		if !slices.Contains([]string{http.MethodGet, http.MethodDelete}, request.method) {
+			return nil, Permanent(transportErr)
+		}
+		return nil, transportErr
 	}
 	defer response.Body.Close()
 
-	body, err := io.ReadAll(response.Body)
+	span.SetAttributes(attribute.Int("http.response.status_code", response.StatusCode))
+
+	responseBody, err := io.ReadAll(response.Body)
 	if err != nil {
-		return "", errors.Internal("reading response body", errors.WithCause(err), errors.WithID("client.request.client.request"))
+		return nil, errors.New("reading response body", errors.WithCode(codes.Unavailable), errors.WithCause(err), errors.WithID("client.request.client.send"))
 	}
 
-	return string(body), nil
-}
+	if response.StatusCode < 200 || response.StatusCode >= 300 {
+		apiErr := decodeErrorResponse(response.StatusCode, responseBody)
+		span.SetAttributes(attribute.Int("whatsapp.error.code", apiErr.Code), attribute.String("whatsapp.error.fbtrace_id", apiErr.FbtraceID))
+
+		err := errors.Wrap(apiErr.ToGRPCError(), errors.WithID("client.request.client.send"), errors.WithValue("path", request.path))
+		if !apiErr.Temporary() {
+			return nil, Permanent(err)
+		}
+		return nil, err
+	}
 
-func (client *RequestClient) request(params RequestCloudApiParams) (string, error) {
-	return client.requestWithContext(context.Background(), params)
+	return responseBody, nil
 }
 
 type ApiRequest struct {
@@ -156,44 +246,54 @@ func (request *ApiRequest) SetBody(body string) {
 	request.Body = body
 }
 
-func (request *ApiRequest) ExecuteWithContext(ctx context.Context) (string, error) {
-	queryParam := map[string]string{}
+// query encodes the fields as the Graph API field expansion, e.g. "name,picture.width(100)".
+func (request *ApiRequest) query() url.Values {
+	query := url.Values{}
+
 	if len(request.Fields) > 0 {
-		fieldString := ""
+		fields := make([]string, 0, len(request.Fields))
 		for _, field := range request.Fields {
-			newFieldString := ""
-			if fieldString != "" {
-				newFieldString = ","
+			filterKeys := make([]string, 0, len(field.Filters))
+			for key := range field.Filters {
+				filterKeys = append(filterKeys, key)
 			}
-			filterString := ""
-			for k, v := range field.Filters {
-				filterString += strings.Join([]string{".", k, "(" + v + ")"}, "")
+			slices.Sort(filterKeys)
+
+			fieldString := field.Name
+			for _, key := range filterKeys {
+				fieldString += "." + key + "(" + field.Filters[key] + ")"
 			}
-			newFieldString += strings.Join([]string{field.Name, filterString}, "")
-			fieldString += newFieldString
+			fields = append(fields, fieldString)
 		}
 
-		queryParam["fields"] = fieldString
+		query.Set("fields", strings.Join(fields, ","))
 	}
 
-	if len(request.QueryParams) > 0 {
-		for k, v := range request.QueryParams {
-			queryParam[k] = v
-		}
+	for key, value := range request.QueryParams {
+		query.Set(key, value)
 	}
 
-	response, err := request.Requester.requestWithContext(ctx, RequestCloudApiParams{
-		Body:       request.Body,
-		Path:       request.Path,
-		Method:     request.Method,
-		QueryParam: queryParam,
-	})
+	return query
+}
+
+func (request *ApiRequest) ExecuteWithContext(ctx context.Context) (string, error) {
+	graphRequest := graphRequest{
+		method:      request.Method,
+		path:        request.Path,
+		query:       request.query(),
+		contentType: "application/json",
+	}
+
+	if request.Body != "" {
+		graphRequest.body = []byte(request.Body)
+	}
 
+	response, err := request.Requester.send(ctx, graphRequest)
 	if err != nil {
 		return "", err
 	}
 
-	return response, nil
+	return string(response), nil
 }
 
 func (request *ApiRequest) Execute() (string, error) {
@@ -204,30 +304,19 @@ func (client *RequestClient) RequestMultipart(method, path, contentType string,
 	return client.RequestMultipartWithContext(context.Background(), method, path, contentType, body)
 }
 
+// RequestMultipartWithContext streams the body once: a failed multipart request is not retried.
 func (client *RequestClient) RequestMultipartWithContext(ctx context.Context, method, path, contentType string, body io.Reader) (string, error) {
-	requestPath := strings.Join([]string{
-		RequestProtocol, "://", client.baseUrl, "/", client.apiVersion, "/", path,
-	}, "")
-
-	httpRequest, err := http.NewRequestWithContext(ctx, method, requestPath, body)
-	if err != nil {
-		return "", errors.Internal("creating http request", errors.WithID("client.request.client.request_multipart"), errors.WithCause(err))
-	}
-	httpRequest.Header.Set("Authorization", fmt.Sprintf("Bearer %s", client.accessToken))
-	httpRequest.Header.Set("Content-Type", contentType)
-
-	httpClient := http.DefaultClient
-	response, err := httpClient.Do(httpRequest)
+	response, err := client.send(ctx, graphRequest{
+		method:      method,
+		path:        path,
+		contentType: contentType,
+		stream:      body,
+	})
 	if err != nil {
-		return "", err
+		return "", errors.Wrap(err, errors.WithID("client.request.client.request_multipart"))
 	}
-	defer response.Body.Close()
 
-	respBody, err := io.ReadAll(response.Body)
-	if err != nil {
-		return "", errors.Internal("reading response body", errors.WithID("client.request.client.request_multipart"), errors.WithCause(err))
-	}
-	return string(respBody), nil
+	return string(response), nil
 }
 
 // Download media files using URLs obtained from media retrieval endpoints.
@@ -240,22 +329,30 @@ func (client *RequestClient) RequestMediaDownloadByURLWithContext(ctx context.Co
 		return nil, "", errors.InvalidArgument("media url is required", errors.WithID("whatsapp.request.client.request_media_download_by_url_with_context"))
 	}
 
+	ctx, span := tracer.Start(ctx, "whatsapp.media_download", trace.WithSpanKind(trace.SpanKindClient))
+	defer span.End()
+
 	httpRequest, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
 	if err != nil {
 		return nil, "", errors.Internal("creating download whatsapp media request", errors.WithCause(err), errors.WithID("whatsapp.request.client.request_media_download_by_url_with_context"))
 	}
 	httpRequest.Header.Set("Authorization", fmt.Sprintf("Bearer %s", client.accessToken))
-	httpRequest.Header.Set("Content-Type", "application/json")
 
-	response, err := http.DefaultClient.Do(httpRequest)
+	response, err := client.httpClient.Do(httpRequest)
 	if err != nil {
-		return nil, "", errors.Wrap(err, errors.WithID("whatsapp.request.client.request_media_download_by_url_with_context"))
+		span.RecordError(err)
+		span.SetStatus(otelcodes.Error, err.Error())
+		return nil, "", errors.New("executing download whatsapp media request", errors.WithCode(codes.Unavailable), errors.WithCause(err), errors.WithID("whatsapp.request.client.request_media_download_by_url_with_context"))
 	}
 
-	if response.StatusCode < 200 || response.StatusCode >= 300 { //TODO: add falback
+	span.SetAttributes(attribute.Int("http.response.status_code", response.StatusCode))
+
+	if response.StatusCode < 200 || response.StatusCode >= 300 {
 		response.Body.Close()
+		span.SetStatus(otelcodes.Error, http.StatusText(response.StatusCode))
 		return nil, "", errors.NotFound(
 			"bad media download response status",
+			errors.WithCause(&MediaDownloadStatusError{StatusCode: response.StatusCode}),
 			errors.WithID("whatsapp.request.client.request_media_download_by_url_with_context"),
 			errors.WithValue("response_status_code", response.StatusCode),
 		)
@@ -263,3 +360,45 @@ func (client *RequestClient) RequestMediaDownloadByURLWithContext(ctx context.Co
 
 	return response.Body, response.Header.Get("Content-Type"), nil
 }
+
+// RequestResumableUploadWithContext uploads a file through the Graph API Resumable Upload API
+// and returns the file handle, e.g. for a business profile picture.
+// The upload session is opened on behalf of the Meta app with the given app id.
+func (client *RequestClient) RequestResumableUploadWithContext(ctx context.Context, appID, mimeType string, content []byte) (string, error) {
+	sessionRequest := client.NewApiRequest(appID+"/uploads", http.MethodPost)
+	sessionRequest.AddQueryParam("file_length", strconv.Itoa(len(content)))
+	sessionRequest.AddQueryParam("file_type", mimeType)
+
+	sessionResponse, err := sessionRequest.ExecuteWithContext(ctx)
+	if err != nil {
+		return "", errors.Wrap(err, errors.WithID("client.request.client.request_resumable_upload"))
+	}
+
+	var session struct {
+		ID string `json:"id"`
+	}
+	if err := json.Unmarshal([]byte(sessionResponse), &session); err != nil || session.ID == "" {
+		return "", errors.Internal("unexpected upload session response", errors.WithCause(err), errors.WithID("client.request.client.request_resumable_upload"), errors.WithValue("response", sessionResponse))
+	}
+
+	// The upload endpoint expects the OAuth scheme rather than Bearer.
+	body, err := client.send(ctx, graphRequest{
+		method:        http.MethodPost,
+		path:          session.ID,
+		authorization: "OAuth " + client.accessToken,
+		header:        map[string]string{"file_offset": "0"},
+		body:          content,
+	})
+	if err != nil {
+		return "", errors.Wrap(err, errors.WithID("client.request.client.request_resumable_upload"))
+	}
+
+	var uploaded struct {
+		Handle string `json:"h"`
+	}
+	if err := json.Unmarshal(body, &uploaded); err != nil || uploaded.Handle == "" {
+		return "", errors.Internal("unexpected file upload response", errors.WithCause(err), errors.WithID("client.request.client.request_resumable_upload"), errors.WithValue("response", string(body)))
+	}
+
+	return uploaded.Handle, nil
+}
@@ -1,18 +1,40 @@
 package client
 
 import (
+	"net"
+	"net/http"
+	"net/url"
 	"regexp"
 	"strings"
+	"time"
 
 	"github.com/webitel/webitel-go-kit/pkg/errors"
 )
 
 var apiVersionRegex = regexp.MustCompile(`^v\d+\.\d+$`)
 
+// DefaultHTTPClient bounds connecting and waiting for response headers. Reading a body
+// is bounded by the request context only, as media downloads may take long.
+var DefaultHTTPClient = &http.Client{
+	Transport: &http.Transport{
+		Proxy:                 http.ProxyFromEnvironment,
+		DialContext:           (&net.Dialer{Timeout: 10 * time.Second, KeepAlive: 30 * time.Second}).DialContext,
+		ForceAttemptHTTP2:     true,
+		MaxIdleConns:          100,
+		MaxIdleConnsPerHost:   20,
+		IdleConnTimeout:       90 * time.Second,
+		TLSHandshakeTimeout:   10 * time.Second,
+		ResponseHeaderTimeout: 30 * time.Second,
+		ExpectContinueTimeout: time.Second,
+	},
+}
+
 type RequestClientConfig struct {
 	apiVersion  string
 	baseURL     string
 	accessToken string
+	httpClient  *http.Client
+	retryPolicy RetryPolicy
 }
 
 func (config *RequestClientConfig) Validate() error {
@@ -32,12 +54,17 @@ func (config *RequestClientConfig) Validate() error {
 		return errors.InvalidArgument("invalid format for graph api", errors.WithID("client.options.validate"), errors.WithValue("version", config.apiVersion))
 	}
 
-	if !strings.Contains(config.baseURL, BaseURL) {
-		return errors.InvalidArgument(`base URL must contain "graph.facebook.com"`, errors.WithID("client.options.validate"), errors.WithValue("base-url", config.baseURL))
+	baseURL, err := url.Parse(config.baseURL)
+	if err != nil || (baseURL.Scheme != "https" && baseURL.Scheme != "http") || baseURL.Host == "" {
+		return errors.InvalidArgument(`base URL must be an absolute http(s) URL, e.g. "https://graph.facebook.com"`, errors.WithCause(err), errors.WithID("client.options.validate"), errors.WithValue("base-url", config.baseURL))
+	}
+
+	if config.httpClient == nil {
+		return errors.InvalidArgument("http client is required", errors.WithID("client.options.validate"))
 	}
 
-	if strings.HasPrefix(config.baseURL, "https://") {
-		return errors.InvalidArgument(`base URL must not include protocol as "http://" adds by default`, errors.WithID("client.options.validate"), errors.WithValue("base-url", config.baseURL))
+	if config.retryPolicy.Attempts < 1 {
+		return errors.InvalidArgument("retry attempts must be positive", errors.WithID("client.options.validate"), errors.WithValue("attempts", config.retryPolicy.Attempts))
 	}
 
 	return nil
@@ -46,8 +73,10 @@ func (config *RequestClientConfig) Validate() error {
 func getDefaultClientConfig() RequestClientConfig {
 	return RequestClientConfig{
 		apiVersion:  APIVersion,
-		baseURL:     BaseURL,
+		baseURL:     RequestProtocol + "://" + BaseURL,
 		accessToken: "",
+		httpClient:  DefaultHTTPClient,
+		retryPolicy: DefaultRetryPolicy,
 	}
 }
 
@@ -57,9 +86,10 @@ func WithApiVersionConfig(version string) func(cfg *RequestClientConfig) {
 	}
 }
 
+// WithBaseURLConfig overrides the Graph API URL, e.g. with an httptest server URL.
 func WithBaseURLConfig(base string) func(cfg *RequestClientConfig) {
 	return func(cfg *RequestClientConfig) {
-		cfg.baseURL = base
+		cfg.baseURL = strings.TrimSuffix(base, "/")
 	}
 }
 
@@ -68,3 +98,15 @@ func WithAccessTokenConfig(token string) func(cfg *RequestClientConfig) {
 		cfg.accessToken = token
 	}
 }
+
+func WithHTTPClientConfig(httpClient *http.Client) func(cfg *RequestClientConfig) {
+	return func(cfg *RequestClientConfig) {
+		cfg.httpClient = httpClient
+	}
+}
+
+func WithRetryPolicyConfig(policy RetryPolicy) func(cfg *RequestClientConfig) {
+	return func(cfg *RequestClientConfig) {
+		cfg.retryPolicy = policy
+	}
+}
@@ -0,0 +1,64 @@
+package client
+
+import (
+	"context"
+	"time"
+
+	"github.com/webitel/webitel-go-kit/pkg/errors"
+)
+
+// RetryPolicy repeats a failed call with an exponential backoff.
+type RetryPolicy struct {
+	Attempts     int
+	InitialDelay time.Duration
+	MaxDelay     time.Duration
+}
+
+var DefaultRetryPolicy = RetryPolicy{
+	Attempts:     3,
+	InitialDelay: 500 * time.Millisecond,
+	MaxDelay:     4 * time.Second,
+}
+
+// permanentError stops the retries: the same call can not succeed later.
+type permanentError struct {
+	err error
+}
+
+func (err *permanentError) Error() string { return err.err.Error() }
+func (err *permanentError) Unwrap() error { return err.err }
+
+// Permanent marks the error of a call that must not be repeated.
+func Permanent(err error) error {
+	return &permanentError{err: err}
+}
+
+// Do calls the operation until it succeeds, fails with a Permanent error,
+// the attempts run out or the context is done.
+func (policy RetryPolicy) Do(ctx context.Context, operation func(ctx context.Context) error) error {
+	delay := policy.InitialDelay
+
+	for attempt := 1; ; attempt++ {
+		err := operation(ctx)
+		if err == nil {
+			return nil
+		}
+
+		var permanentErr *permanentError
+		if errors.As(err, &permanentErr) {
+			return permanentErr.err
+		}
+
+		if attempt >= policy.Attempts || ctx.Err() != nil {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return err
+		case <-time.After(delay):
+		}
+
+		delay = min(delay*2, policy.MaxDelay)
+	}
+}
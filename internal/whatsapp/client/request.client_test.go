@@ -0,0 +1,205 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/webitel/webitel-go-kit/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func newTestClient(t *testing.T, handler http.HandlerFunc) (*RequestClient, *atomic.Int32) {
+	t.Helper()
+
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		handler(w, r)
+	}))
+	t.Cleanup(server.Close)
+
+	client, err := NewRequesClient(
+		WithAccessTokenConfig("token"),
+		WithBaseURLConfig(server.URL),
+		WithHTTPClientConfig(server.Client()),
+		WithRetryPolicyConfig(RetryPolicy{Attempts: 3, InitialDelay: time.Millisecond, MaxDelay: time.Millisecond}),
+	)
+	if err != nil {
+		t.Fatalf("NewRequesClient: %v", err)
+	}
+
+	return client, &calls
+}
+
+func TestApiRequest_Query(t *testing.T) {
+	client, _ := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/"+APIVersion+"/debug_token" {
+			t.Errorf("path = %q", r.URL.Path)
+		}
+		if r.Header.Get("Authorization") != "Bearer token" {
+			t.Errorf("authorization = %q", r.Header.Get("Authorization"))
+		}
+
+		query := r.URL.Query()
+		if got := query.Get("input_token"); got != "a&b=c d" {
+			t.Errorf("input_token = %q", got)
+		}
+		if got := query.Get("file_type"); got != "image/png" {
+			t.Errorf("file_type = %q", got)
+		}
+		if got := query.Get("fields"); got != "id,picture.height(10).width(20)" {
+			t.Errorf("fields = %q", got)
+		}
+		w.Write([]byte(`{"success":true}`))
+	})
+
+	request := client.NewApiRequest("debug_token", http.MethodGet)
+	request.AddQueryParam("input_token", "a&b=c d")
+	request.AddQueryParam("file_type", "image/png")
+	request.AddField(ApiRequestParamField{Name: "id"})
+	request.AddField(ApiRequestParamField{Name: "picture", Filters: map[string]string{"width": "20", "height": "10"}})
+
+	response, err := request.ExecuteWithContext(context.Background())
+	if err != nil {
+		t.Fatalf("ExecuteWithContext: %v", err)
+	}
+	if response != `{"success":true}` {
+		t.Errorf("response = %q", response)
+	}
+}
+
+func TestApiRequest_ErrorResponse(t *testing.T) {
+	tests := []struct {
+		name      string
+		method    string
+		status    int
+		body      string
+		wantCalls int32
+		wantCode  codes.Code
+		wantMeta  int
+	}{
+		{
+			name:      "invalid parameter",
+			method:    http.MethodPost,
+			status:    http.StatusBadRequest,
+			body:      `{"error":{"message":"(#100) Invalid parameter","type":"OAuthException","code":100,"fbtrace_id":"A1"}}`,
+			wantCalls: 1,
+			wantCode:  codes.InvalidArgument,
+			wantMeta:  100,
+		},
+		{
+			name:      "expired token",
+			method:    http.MethodGet,
+			status:    http.StatusUnauthorized,
+			body:      `{"error":{"message":"Error validating access token","type":"OAuthException","code":190,"fbtrace_id":"A2"}}`,
+			wantCalls: 1,
+			wantCode:  codes.Unauthenticated,
+			wantMeta:  190,
+		},
+		{
+			name:      "rate limit is retried",
+			method:    http.MethodPost,
+			status:    http.StatusBadRequest,
+			body:      `{"error":{"message":"(#130429) Rate limit hit","type":"OAuthException","code":130429,"fbtrace_id":"A3"}}`,
+			wantCalls: 3,
+			wantCode:  codes.ResourceExhausted,
+			wantMeta:  130429,
+		},
+		{
+			name:      "gateway failure without body",
+			method:    http.MethodGet,
+			status:    http.StatusServiceUnavailable,
+			wantCalls: 3,
+			wantCode:  codes.Internal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client, calls := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(tt.status)
+				w.Write([]byte(tt.body))
+			})
+
+			_, err := client.NewApiRequest("123/messages", tt.method).ExecuteWithContext(context.Background())
+			if err == nil {
+				t.Fatal("ExecuteWithContext must fail")
+			}
+
+			if got := calls.Load(); got != tt.wantCalls {
+				t.Errorf("calls = %d, want %d", got, tt.wantCalls)
+			}
+			if got := status.Code(err); got != tt.wantCode {
+				t.Errorf("code = %v, want %v", got, tt.wantCode)
+			}
+
+			var apiErr *MessageSendError
+			if !errors.As(err, &apiErr) {
+				t.Fatalf("error %v is not caused by MessageSendError", err)
+			}
+			if apiErr.Code != tt.wantMeta || apiErr.StatusCode != tt.status {
+				t.Errorf("meta code = %d, status = %d", apiErr.Code, apiErr.StatusCode)
+			}
+		})
+	}
+}
+
+func TestApiRequest_RetrySucceeds(t *testing.T) {
+	var failed atomic.Bool
+	client, calls := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Content-Type") != "application/json" {
+			t.Errorf("content type = %q", r.Header.Get("Content-Type"))
+		}
+		if !failed.Swap(true) {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`{"error":{"message":"Service temporarily unavailable","code":2,"is_transient":true}}`))
+			return
+		}
+		w.Write([]byte(`{"messages":[{"id":"wamid.1"}]}`))
+	})
+
+	request := client.NewApiRequest("123/messages", http.MethodPost)
+	request.SetBody(`{"messaging_product":"whatsapp"}`)
+
+	response, err := request.ExecuteWithContext(context.Background())
+	if err != nil {
+		t.Fatalf("ExecuteWithContext: %v", err)
+	}
+	if calls.Load() != 2 || response != `{"messages":[{"id":"wamid.1"}]}` {
+		t.Errorf("calls = %d, response = %q", calls.Load(), response)
+	}
+}
+
+func TestRequestClientConfig_BaseURL(t *testing.T) {
+	for base, valid := range map[string]bool{
+		"https://graph.facebook.com": true,
+		"http://127.0.0.1:8080":      true,
+		"graph.facebook.com":         false,
+		"ftp://graph.facebook.com":   false,
+	} {
+		_, err := NewRequesClient(WithAccessTokenConfig("token"), WithBaseURLConfig(base))
+		if (err == nil) != valid {
+			t.Errorf("base URL %q: error = %v, want valid %v", base, err, valid)
+		}
+	}
+}
+
+func TestRetryPolicy_Permanent(t *testing.T) {
+	policy := RetryPolicy{Attempts: 5, InitialDelay: time.Millisecond, MaxDelay: time.Millisecond}
+	cause := errors.New("bad request")
+
+	calls := 0
+	err := policy.Do(context.Background(), func(context.Context) error {
+		calls++
+		return Permanent(cause)
+	})
+
+	if calls != 1 || err != cause {
+		t.Errorf("calls = %d, error = %v; want a single call failing with the cause", calls, err)
+	}
+}
@@ -0,0 +1,48 @@
+package correlation
+
+import (
+	"context"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"github.com/webitel/webitel-go-kit/pkg/errors"
+)
+
+// sentMessageTTL bounds how long a reply can be traced back to the message it answers.
+// Reply buttons and flows stay usable long after the message was delivered.
+const sentMessageTTL = 30 * 24 * time.Hour
+
+type correlationRepository struct {
+	rdb *redis.Client
+}
+
+func newCorrelationRepository(rdb *redis.Client) *correlationRepository {
+	return &correlationRepository{rdb: rdb}
+}
+
+// RecordSentMessage maps the wamid of a sent message to the internal message ID.
+func (repository *correlationRepository) RecordSentMessage(ctx context.Context, wamid, messageID string) error {
+	if err := repository.rdb.Set(ctx, sentMessageKey(wamid), messageID, sentMessageTTL).Err(); err != nil {
+		return errors.Wrap(err, errors.WithID("whatsapp.correlation.repository.record_sent_message"))
+	}
+
+	return nil
+}
+
+// FindSentMessage returns the internal message ID of the wamid, or an empty string
+// when the message was not sent through this service or its record expired.
+func (repository *correlationRepository) FindSentMessage(ctx context.Context, wamid string) (string, error) {
+	messageID, err := repository.rdb.Get(ctx, sentMessageKey(wamid)).Result()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return "", nil
+		}
+
+		return "", errors.Wrap(err, errors.WithID("whatsapp.correlation.repository.find_sent_message"))
+	}
+
+	return messageID, nil
+}
+
+// Key format: wa:sent:<wamid>
+func sentMessageKey(wamid string) string { return "wa:sent:" + wamid }
@@ -0,0 +1,13 @@
+package correlation
+
+import "github.com/redis/go-redis/v9"
+
+type correlationModule struct {
+	Correlation *correlationRepository
+}
+
+func NewCorrelationModule(rdb *redis.Client) *correlationModule {
+	return &correlationModule{
+		Correlation: newCorrelationRepository(rdb),
+	}
+}
@@ -0,0 +1,85 @@
+package gate
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/webitel/im-providers-service/internal/whatsapp/client"
+	"github.com/webitel/im-providers-service/internal/whatsapp/messaging"
+	"github.com/webitel/webitel-go-kit/pkg/errors"
+)
+
+var flowFields = []string{"id", "name", "status", "categories", "validation_errors"}
+
+// flowsPageLimit bounds the number of pages read when listing flows.
+const flowsPageLimit = 20
+
+// ListFlows returns the flows of the business account behind the gate, in drafts and published alike.
+func (gate *gate) ListFlows(ctx context.Context, id uuid.UUID) ([]Flow, error) {
+	wabaGate, requestClient, err := gate.phoneNumberClient(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		flows []Flow
+		after string
+	)
+
+	for range flowsPageLimit {
+		req := requestClient.NewApiRequest(wabaGate.BusinessID+"/flows", http.MethodGet)
+		for _, field := range flowFields {
+			req.AddField(client.ApiRequestParamField{Name: field})
+		}
+		if after != "" {
+			req.AddQueryParam("after", after)
+		}
+
+		response, err := req.ExecuteWithContext(ctx)
+		if err != nil {
+			return nil, errors.Wrap(err, errors.WithID("gate.flows.list_flows"))
+		}
+
+		page, err := unmarshalFlowsPage(response)
+		if err != nil {
+			return nil, err
+		}
+
+		flows = append(flows, page.Data...)
+
+		if page.Paging.Next == "" || page.Paging.Cursors.After == "" {
+			return flows, nil
+		}
+		after = page.Paging.Cursors.After
+	}
+
+	gate.logger.Warn("flows list truncated", "gate_id", id.String(), "flows", len(flows))
+
+	return flows, nil
+}
+
+type flowsPage struct {
+	Data   []Flow `json:"data"`
+	Paging struct {
+		Cursors struct {
+			After string `json:"after"`
+		} `json:"cursors"`
+		Next string `json:"next"`
+	} `json:"paging"`
+	Error *messaging.MessageSendError `json:"error,omitempty"`
+}
+
+func unmarshalFlowsPage(response string) (*flowsPage, error) {
+	var page flowsPage
+	if err := json.Unmarshal([]byte(response), &page); err != nil {
+		return nil, errors.Internal("unmarshaling flows response", errors.WithCause(err), errors.WithID("gate.flows.list_flows"))
+	}
+
+	if page.Error != nil {
+		return nil, errors.Wrap(page.Error.ToGRPCError(), errors.WithID("gate.flows.list_flows"))
+	}
+
+	return &page, nil
+}
@@ -0,0 +1,36 @@
+package gate
+
+import (
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestUnmarshalFlowsPage(t *testing.T) {
+	page, err := unmarshalFlowsPage(`{
+		"data": [
+			{"id": "1234", "name": "Booking", "status": "PUBLISHED", "categories": ["APPOINTMENT_BOOKING"]},
+			{"id": "5678", "name": "Lead form", "status": "DRAFT", "categories": ["LEAD_GENERATION"],
+			 "validation_errors": [{"error": "INVALID_PROPERTY", "error_type": "FLOW_JSON_ERROR", "message": "Invalid property", "line_start": 10}]}
+		],
+		"paging": {"cursors": {"before": "QVFI", "after": "QVFJ"}, "next": "https://graph.facebook.com/v23.0/1/flows?after=QVFJ"}
+	}`)
+	if err != nil {
+		t.Fatalf("unmarshalFlowsPage: %v", err)
+	}
+
+	if len(page.Data) != 2 || page.Data[0].Name != "Booking" || page.Data[1].ValidationErrors[0].LineStart != 10 {
+		t.Errorf("flows = %+v", page.Data)
+	}
+	if page.Paging.Cursors.After != "QVFJ" || page.Paging.Next == "" {
+		t.Errorf("paging = %+v", page.Paging)
+	}
+}
+
+func TestUnmarshalFlowsPage_Error(t *testing.T) {
+	_, err := unmarshalFlowsPage(`{"error": {"message": "Invalid OAuth access token", "type": "OAuthException", "code": 190}}`)
+	if status.Code(err) != codes.Unauthenticated {
+		t.Errorf("want Unauthenticated, got %v", err)
+	}
+}
@@ -0,0 +1,84 @@
+package gate
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/webitel/im-providers-service/internal/whatsapp/client"
+	"github.com/webitel/im-providers-service/internal/whatsapp/messaging"
+	"github.com/webitel/webitel-go-kit/pkg/errors"
+)
+
+var qualityRatingFields = []string{"quality_rating", "messaging_limit_tier"}
+
+// ApplyHealthUpdate stores the health Meta reports for the gates of a business account.
+// Quality events do not carry the rating itself, so it is read from the Graph API afterwards.
+func (gate *gate) ApplyHealthUpdate(ctx context.Context, update *HealthUpdate) error {
+	log := gate.logger.With("operation", "whatsapp.gate.apply_health_update", "business_id", update.BusinessID, "event", update.Event)
+
+	if err := update.Validate(); err != nil {
+		return err
+	}
+
+	targets, err := gate.wabaGateRepository.UpdateHealth(ctx, update)
+	if err != nil {
+		log.Error("updating WhatsApp gates health", "error", err)
+		return err
+	}
+
+	if len(targets) == 0 {
+		log.Debug("no WhatsApp gates for health update", "phone_number", update.DisplayPhoneNumber)
+		return nil
+	}
+
+	if update.Flagged == nil && update.MessagingLimitTier == nil {
+		return nil
+	}
+
+	// The stored update already marks the gate status, a missing rating is only logged.
+	for i := range targets {
+		if err := gate.refreshQualityRating(ctx, &targets[i]); err != nil {
+			log.Warn("refreshing WhatsApp phone number quality rating", "error", err, "gate_id", targets[i].ID.String())
+		}
+	}
+
+	return nil
+}
+
+func (gate *gate) refreshQualityRating(ctx context.Context, waba *WhatsAppBusinessAccountGate) error {
+	fetched, err := waba.PostFetch(gate.encryptor)
+	if err != nil {
+		return err
+	}
+
+	if err := fetched.SetUpClient(); err != nil {
+		return err
+	}
+
+	requestClient := fetched.GetClient()
+	req := requestClient.NewApiRequest(fetched.PhoneNumberID, http.MethodGet)
+	for _, field := range qualityRatingFields {
+		req.AddField(client.ApiRequestParamField{Name: field})
+	}
+
+	response, err := req.ExecuteWithContext(ctx)
+	if err != nil {
+		return errors.Wrap(err, errors.WithID("gate.health.refresh_quality_rating"))
+	}
+
+	var phoneNumber struct {
+		QualityRating      string                      `json:"quality_rating"`
+		MessagingLimitTier string                      `json:"messaging_limit_tier"`
+		Error              *messaging.MessageSendError `json:"error,omitempty"`
+	}
+	if err := json.Unmarshal([]byte(response), &phoneNumber); err != nil {
+		return errors.Internal("unmarshaling phone number quality response", errors.WithCause(err), errors.WithID("gate.health.refresh_quality_rating"))
+	}
+
+	if phoneNumber.Error != nil {
+		return errors.Wrap(phoneNumber.Error.ToGRPCError(), errors.WithID("gate.health.refresh_quality_rating"))
+	}
+
+	return gate.wabaGateRepository.SetQualityRating(ctx, waba.ID, phoneNumber.QualityRating, phoneNumber.MessagingLimitTier)
+}
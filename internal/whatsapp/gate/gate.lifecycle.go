@@ -0,0 +1,145 @@
+package gate
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/webitel/im-providers-service/infra/auth"
+	"github.com/webitel/im-providers-service/internal/whatsapp/messaging"
+	"github.com/webitel/webitel-go-kit/pkg/errors"
+	"golang.org/x/sync/errgroup"
+)
+
+func (gate *gate) Get(ctx context.Context, id uuid.UUID) (*Gate, error) {
+	session, ok := auth.GetIdentityFromContext(ctx)
+	if !ok {
+		return nil, errors.Unauthenticated("not found session in context", errors.WithID("whatsapp.gate.get.session"))
+	}
+
+	return gate.wabaGateRepository.Get(ctx, session.GetDomainID(), id)
+}
+
+// Update renames, enables/disables or re-tokens the gate. A new access token is validated
+// against the phone number before it is encrypted and stored.
+func (gate *gate) Update(ctx context.Context, update *GateUpdate) (*Gate, error) {
+	log := gate.logger.With("operation", "whatsapp.gate.update", "gate_id", update.ID.String())
+
+	session, ok := auth.GetIdentityFromContext(ctx)
+	if !ok {
+		return nil, errors.Unauthenticated("not found session in context", errors.WithID("whatsapp.gate.update.session"))
+	}
+
+	update.DC = session.GetDomainID()
+
+	if err := update.Validate(); err != nil {
+		log.Warn("validating WhatsApp gate update request", "error", err)
+		return nil, err
+	}
+
+	if update.AccessToken != nil {
+		if err := gate.rotateAccessToken(ctx, update); err != nil {
+			log.Error("rotating WhatsApp gate access token", "error", err)
+			return nil, err
+		}
+	}
+
+	updated, err := gate.wabaGateRepository.Update(ctx, update)
+	if err != nil {
+		log.Error("updating WhatsApp gate", "error", err)
+		return nil, err
+	}
+
+	return updated, nil
+}
+
+func (gate *gate) rotateAccessToken(ctx context.Context, update *GateUpdate) error {
+	current, err := gate.wabaGateRepository.Get(ctx, update.DC, update.ID)
+	if err != nil {
+		return err
+	}
+
+	waba := current.WhatsAppBusinessAccountGate
+	waba.AccessToken = *update.AccessToken
+
+	if err := waba.SetUpClient(); err != nil {
+		return err
+	}
+
+	g, ctxGroup := errgroup.WithContext(ctx)
+	g.Go(func() error { return gate.validateWhatsAppAccount(ctxGroup, waba.PhoneNumberID, waba.GetClient()) })
+	g.Go(func() error { return gate.validateWhatsAppAccountToken(ctxGroup, &waba) })
+	if err := g.Wait(); err != nil {
+		return err
+	}
+
+	encrypted, err := waba.PreSave(gate.encryptor)
+	if err != nil {
+		return err
+	}
+	update.AccessTokenEncrypted = encrypted.AccessTokenEncrypted
+
+	return nil
+}
+
+// Delete removes the gate. When it was the last gate of its WhatsApp Business Account,
+// the app is unsubscribed from the account webhooks as well.
+func (gate *gate) Delete(ctx context.Context, id uuid.UUID) (*Gate, error) {
+	log := gate.logger.With("operation", "whatsapp.gate.delete", "gate_id", id.String())
+
+	session, ok := auth.GetIdentityFromContext(ctx)
+	if !ok {
+		return nil, errors.Unauthenticated("not found session in context", errors.WithID("whatsapp.gate.delete.session"))
+	}
+
+	deleted, err := gate.wabaGateRepository.Delete(ctx, session.GetDomainID(), id)
+	if err != nil {
+		log.Error("deleting WhatsApp gate", "error", err)
+		return nil, err
+	}
+
+	// The gate is already gone: a failed deregistration leaves a dangling subscription,
+	// whose webhooks are dropped by the resolver, so it is only logged.
+	if err := gate.deregisterWebhook(ctx, &deleted.WhatsAppBusinessAccountGate); err != nil {
+		log.Warn("deregistering WhatsApp webhook subscription", "error", err, "business_id", deleted.WhatsAppBusinessAccountGate.BusinessID)
+	}
+
+	return deleted, nil
+}
+
+func (gate *gate) deregisterWebhook(ctx context.Context, waba *WhatsAppBusinessAccountGate) error {
+	remaining, err := gate.wabaGateRepository.CountByBusinessID(ctx, waba.BusinessID)
+	if err != nil {
+		return err
+	}
+
+	if remaining > 0 {
+		return nil
+	}
+
+	fetched, err := waba.PostFetch(gate.encryptor)
+	if err != nil {
+		return err
+	}
+
+	if err := fetched.SetUpClient(); err != nil {
+		return err
+	}
+
+	requestClient := fetched.GetClient()
+	response, err := requestClient.NewApiRequest(fetched.BusinessID+"/subscribed_apps", http.MethodDelete).ExecuteWithContext(ctx)
+	if err != nil {
+		return errors.Internal("executing unsubscribe app request", errors.WithCause(err), errors.WithID("gate.usecase.deregister_webhook"))
+	}
+
+	unmarshaledResponse, err := messaging.UnmarshalStatusResponse(response)
+	if err != nil {
+		return err
+	}
+
+	if unmarshaledResponse.Error != nil {
+		return errors.Wrap(unmarshaledResponse.Error.ToGRPCError(), errors.WithID("gate.usecase.deregister_webhook"))
+	}
+
+	return nil
+}
@@ -1,9 +1,12 @@
 package gate
 
 import (
+	"regexp"
+	"slices"
 	"strings"
 	"sync"
 	"time"
+	"unicode/utf8"
 
 	"github.com/google/uuid"
 	"github.com/webitel/im-providers-service/internal/whatsapp/client"
@@ -83,6 +86,9 @@ type WhatsAppBusinessAccountGate struct {
 	AccessTokenExpiresAt *time.Time `json:"access_token_expires_at" db:"access_token_expires_at"`
 	BusinessID           string     `json:"business_id" db:"business_id"`
 
+	// Health is reported by Meta webhooks, see HealthUpdate.
+	Health PhoneNumberHealth `json:"health" db:"-"`
+
 	ClientMu *sync.RWMutex
 	Client   *client.RequestClient `json:"-" db:"-"`
 }
@@ -174,3 +180,237 @@ func (gate *WhatsAppBusinessAccountGate) PostFetch(encryptor common.Encryptor) (
 
 	return prepared, nil
 }
+
+// GateUpdate is a partial change of a WhatsApp gate; nil fields are left untouched.
+type GateUpdate struct {
+	ID          uuid.UUID
+	DC          int64
+	Name        *string
+	Enabled     *bool
+	AccessToken *string
+
+	AccessTokenEncrypted []byte
+}
+
+func (update *GateUpdate) Validate() error {
+	if update == nil || update.ID == uuid.Nil {
+		return errors.InvalidArgument("gate id is required", errors.WithID("gate.model.update.validate"))
+	}
+
+	if update.Name != nil && strings.Trim(*update.Name, " ") == "" {
+		return errors.InvalidArgument("gate name can`t be empty", errors.WithID("gate.model.update.validate"))
+	}
+
+	if update.AccessToken != nil && strings.Trim(*update.AccessToken, " ") == "" {
+		return errors.InvalidArgument("WhatsApp access token can`t be empty", errors.WithID("gate.model.update.validate"))
+	}
+
+	if update.Name == nil && update.Enabled == nil && update.AccessToken == nil {
+		return errors.InvalidArgument("nothing to update", errors.WithID("gate.model.update.validate"))
+	}
+
+	return nil
+}
+
+const (
+	businessProfileAboutMaxLength       = 139
+	businessProfileAddressMaxLength     = 256
+	businessProfileDescriptionMaxLength = 512
+	businessProfileEmailMaxLength       = 128
+	businessProfileWebsiteMaxLength     = 256
+	businessProfileMaxWebsites          = 2
+	businessProfilePictureMaxSize       = 5 << 20
+)
+
+var (
+	twoStepVerificationPINRegex = regexp.MustCompile(`^\d{6}$`)
+
+	businessProfileVerticals = []string{
+		"UNDEFINED", "OTHER", "AUTO", "BEAUTY", "APPAREL", "EDU", "ENTERTAIN", "EVENT_PLAN", "FINANCE",
+		"GROCERY", "GOVT", "HOTEL", "HEALTH", "NONPROFIT", "PROF_SERVICES", "RETAIL", "TRAVEL", "RESTAURANT", "NOT_A_BIZ",
+	}
+)
+
+// ValidateTwoStepVerificationPIN checks the six-digit PIN used to register a phone number
+// and protect it with two-step verification.
+func ValidateTwoStepVerificationPIN(pin string) error {
+	if !twoStepVerificationPINRegex.MatchString(pin) {
+		return errors.InvalidArgument("two-step verification PIN must be 6 digits", errors.WithID("gate.model.validate_two_step_verification_pin"))
+	}
+	return nil
+}
+
+// BusinessProfile is the WhatsApp Business profile shown to customers.
+type BusinessProfile struct {
+	About             string   `json:"about,omitempty"`
+	Address           string   `json:"address,omitempty"`
+	Description       string   `json:"description,omitempty"`
+	Email             string   `json:"email,omitempty"`
+	Websites          []string `json:"websites,omitempty"`
+	Vertical          string   `json:"vertical,omitempty"`
+	ProfilePictureURL string   `json:"profile_picture_url,omitempty"`
+}
+
+// BusinessProfilePicture is a new profile photo; Meta accepts JPEG and PNG images.
+type BusinessProfilePicture struct {
+	MimeType string
+	Content  []byte
+}
+
+// BusinessProfileUpdate is a partial change of the business profile; nil fields are left untouched.
+type BusinessProfileUpdate struct {
+	About          *string                 `json:"about,omitempty"`
+	Address        *string                 `json:"address,omitempty"`
+	Description    *string                 `json:"description,omitempty"`
+	Email          *string                 `json:"email,omitempty"`
+	Websites       []string                `json:"websites,omitempty"`
+	Vertical       *string                 `json:"vertical,omitempty"`
+	ProfilePicture *BusinessProfilePicture `json:"-"`
+}
+
+func (update *BusinessProfileUpdate) Validate() error {
+	if update == nil {
+		return errors.InvalidArgument("business profile is required", errors.WithID("gate.model.business_profile_update.validate"))
+	}
+
+	lengths := []struct {
+		field string
+		value *string
+		max   int
+	}{
+		{"about", update.About, businessProfileAboutMaxLength},
+		{"address", update.Address, businessProfileAddressMaxLength},
+		{"description", update.Description, businessProfileDescriptionMaxLength},
+		{"email", update.Email, businessProfileEmailMaxLength},
+	}
+	for _, length := range lengths {
+		if length.value != nil && utf8.RuneCountInString(*length.value) > length.max {
+			return errors.InvalidArgument("business profile field is too long", errors.WithID("gate.model.business_profile_update.validate"), errors.WithValue("field", length.field), errors.WithValue("max", length.max))
+		}
+	}
+
+	if len(update.Websites) > businessProfileMaxWebsites {
+		return errors.InvalidArgument("business profile accepts up to 2 websites", errors.WithID("gate.model.business_profile_update.validate"))
+	}
+	for _, website := range update.Websites {
+		if !strings.HasPrefix(website, "http://") && !strings.HasPrefix(website, "https://") || len(website) > businessProfileWebsiteMaxLength {
+			return errors.InvalidArgument("business profile website must be an http(s) URL up to 256 characters", errors.WithID("gate.model.business_profile_update.validate"), errors.WithValue("website", website))
+		}
+	}
+
+	if update.Vertical != nil && !slices.Contains(businessProfileVerticals, *update.Vertical) {
+		return errors.InvalidArgument("unknown business profile vertical", errors.WithID("gate.model.business_profile_update.validate"), errors.WithValue("vertical", *update.Vertical))
+	}
+
+	if picture := update.ProfilePicture; picture != nil {
+		if picture.MimeType != "image/jpeg" && picture.MimeType != "image/png" {
+			return errors.InvalidArgument("business profile picture must be a JPEG or PNG image", errors.WithID("gate.model.business_profile_update.validate"))
+		}
+		if len(picture.Content) == 0 || len(picture.Content) > businessProfilePictureMaxSize {
+			return errors.InvalidArgument("business profile picture must be non-empty and up to 5MB", errors.WithID("gate.model.business_profile_update.validate"))
+		}
+	}
+
+	return nil
+}
+
+// Flow is a WhatsApp Flow of the business account.
+// https://developers.facebook.com/docs/whatsapp/flows/reference/flowsapi
+type Flow struct {
+	ID         string   `json:"id"`
+	Name       string   `json:"name"`
+	Status     string   `json:"status"`
+	Categories []string `json:"categories,omitempty"`
+	// ValidationErrors lists the errors of the flow JSON that keep it from being published.
+	ValidationErrors []FlowValidationError `json:"validation_errors,omitempty"`
+}
+
+type FlowValidationError struct {
+	Error       string `json:"error"`
+	ErrorType   string `json:"error_type"`
+	Message     string `json:"message"`
+	LineStart   int    `json:"line_start,omitempty"`
+	ColumnStart int    `json:"column_start,omitempty"`
+}
+
+// Meta account ban states, see https://developers.facebook.com/docs/graph-api/webhooks/reference/whatsapp-business-account/#account_update
+const (
+	BanStateScheduleForDisable = "SCHEDULE_FOR_DISABLE"
+	BanStateDisable            = "DISABLE"
+	BanStateReinstate          = "REINSTATE"
+)
+
+// PhoneNumberHealth is the quality and messaging limits of the gate phone number and the
+// restrictions of its business account, as Meta last reported them.
+type PhoneNumberHealth struct {
+	// QualityRating is GREEN, YELLOW, RED or UNKNOWN; empty until Meta reports it.
+	QualityRating string `json:"quality_rating,omitempty"`
+	// MessagingLimitTier is the limit of business-initiated conversations, e.g. TIER_1K.
+	MessagingLimitTier string        `json:"messaging_limit_tier,omitempty"`
+	Flagged            bool          `json:"flagged"`
+	BanState           string        `json:"ban_state,omitempty"`
+	Restrictions       []Restriction `json:"restrictions,omitempty"`
+	UpdatedAt          *time.Time    `json:"updated_at,omitempty"`
+}
+
+// Restriction limits the messaging of the business account until it expires; no expiration means until lifted.
+type Restriction struct {
+	Type       string     `json:"type"`
+	Expiration *time.Time `json:"expiration,omitempty"`
+}
+
+func (restriction *Restriction) ActiveAt(now time.Time) bool {
+	return restriction.Expiration == nil || restriction.Expiration.After(now)
+}
+
+// Faulty reports whether Meta flags the number, restricts or disables the account at the given time.
+// The gate_summary view applies the same rule to gate lists.
+func (health *PhoneNumberHealth) Faulty(now time.Time) bool {
+	if health.Flagged || health.BanState == BanStateDisable || health.BanState == BanStateScheduleForDisable {
+		return true
+	}
+
+	for _, restriction := range health.Restrictions {
+		if restriction.ActiveAt(now) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// HealthUpdate changes the health of the gates of a business account, nil fields are left untouched.
+// An empty DisplayPhoneNumber applies the update to every phone number of the account.
+type HealthUpdate struct {
+	BusinessID         string
+	DisplayPhoneNumber string
+	// Event is the Meta event reported, e.g. FLAGGED or ACCOUNT_RESTRICTION.
+	Event              string
+	MessagingLimitTier *string
+	Flagged            *bool
+	BanState           *string
+	Restrictions       *[]Restriction
+}
+
+func (update *HealthUpdate) Validate() error {
+	if update == nil {
+		return errors.InvalidArgument("health update is required", errors.WithID("gate.model.validate_health_update"))
+	}
+
+	if update.BusinessID == "" || strings.Trim(update.BusinessID, " ") == "" {
+		return errors.InvalidArgument("business account id is required", errors.WithID("gate.model.validate_health_update"))
+	}
+
+	return nil
+}
+
+// phoneNumberDigits keeps the digits of a phone number: Meta reports display numbers
+// without the formatting gates are saved with.
+func phoneNumberDigits(phoneNumber string) string {
+	return strings.Map(func(r rune) rune {
+		if r >= '0' && r <= '9' {
+			return r
+		}
+		return -1
+	}, phoneNumber)
+}
@@ -0,0 +1,84 @@
+package gate
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func ptr[T any](v T) *T { return &v }
+
+func TestValidateTwoStepVerificationPIN(t *testing.T) {
+	for pin, valid := range map[string]bool{
+		"123456":  true,
+		"12345":   false,
+		"1234567": false,
+		"12a456":  false,
+		"":        false,
+	} {
+		if err := ValidateTwoStepVerificationPIN(pin); (err == nil) != valid {
+			t.Errorf("ValidateTwoStepVerificationPIN(%q) error = %v, want valid %v", pin, err, valid)
+		}
+	}
+}
+
+func TestBusinessProfileUpdate_Validate(t *testing.T) {
+	tests := []struct {
+		name   string
+		update BusinessProfileUpdate
+		valid  bool
+	}{
+		{"partial", BusinessProfileUpdate{About: ptr("Open 9-18"), Vertical: ptr("RETAIL")}, true},
+		{"clear field", BusinessProfileUpdate{Email: ptr("")}, true},
+		{"about too long", BusinessProfileUpdate{About: ptr(strings.Repeat("a", 140))}, false},
+		{"unknown vertical", BusinessProfileUpdate{Vertical: ptr("SHOP")}, false},
+		{"too many websites", BusinessProfileUpdate{Websites: []string{"https://a.com", "https://b.com", "https://c.com"}}, false},
+		{"website without scheme", BusinessProfileUpdate{Websites: []string{"example.com"}}, false},
+		{"png picture", BusinessProfileUpdate{ProfilePicture: &BusinessProfilePicture{MimeType: "image/png", Content: []byte{1}}}, true},
+		{"gif picture", BusinessProfileUpdate{ProfilePicture: &BusinessProfilePicture{MimeType: "image/gif", Content: []byte{1}}}, false},
+		{"empty picture", BusinessProfileUpdate{ProfilePicture: &BusinessProfilePicture{MimeType: "image/jpeg"}}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.update.Validate(); (err == nil) != tt.valid {
+				t.Errorf("Validate() error = %v, want valid %v", err, tt.valid)
+			}
+		})
+	}
+}
+
+func TestPhoneNumberHealth_Faulty(t *testing.T) {
+	now := time.Date(2025, 6, 20, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name   string
+		health PhoneNumberHealth
+		faulty bool
+	}{
+		{"unknown", PhoneNumberHealth{}, false},
+		{"green", PhoneNumberHealth{QualityRating: "GREEN", MessagingLimitTier: "TIER_1K"}, false},
+		{"red but not flagged", PhoneNumberHealth{QualityRating: "RED"}, false},
+		{"flagged", PhoneNumberHealth{Flagged: true}, true},
+		{"scheduled for disable", PhoneNumberHealth{BanState: BanStateScheduleForDisable}, true},
+		{"disabled", PhoneNumberHealth{BanState: BanStateDisable}, true},
+		{"reinstated", PhoneNumberHealth{BanState: BanStateReinstate}, false},
+		{"active restriction", PhoneNumberHealth{Restrictions: []Restriction{{Type: "RESTRICTED_BIZ_INITIATED_MESSAGING", Expiration: ptr(now.Add(time.Hour))}}}, true},
+		{"restriction without expiration", PhoneNumberHealth{Restrictions: []Restriction{{Type: "RESTRICTED_ADD_PHONE_NUMBER_ACTION"}}}, true},
+		{"expired restriction", PhoneNumberHealth{Restrictions: []Restriction{{Type: "RESTRICTED_BIZ_INITIATED_MESSAGING", Expiration: ptr(now.Add(-time.Hour))}}}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.health.Faulty(now); got != tt.faulty {
+				t.Errorf("Faulty() = %v, want %v", got, tt.faulty)
+			}
+		})
+	}
+}
+
+func TestPhoneNumberDigits(t *testing.T) {
+	if got := phoneNumberDigits("+1 (555) 078-3881"); got != "15550783881" {
+		t.Errorf("phoneNumberDigits() = %q", got)
+	}
+}
@@ -0,0 +1,191 @@
+package gate
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/webitel/im-providers-service/internal/whatsapp/client"
+	"github.com/webitel/im-providers-service/internal/whatsapp/messaging"
+	"github.com/webitel/webitel-go-kit/pkg/errors"
+)
+
+const whatsAppMessagingProduct = "whatsapp"
+
+var businessProfileFields = []string{"about", "address", "description", "email", "profile_picture_url", "websites", "vertical"}
+
+// RegisterPhoneNumber registers the gate phone number for Cloud API messaging.
+// The PIN becomes the two-step verification PIN of the number.
+func (gate *gate) RegisterPhoneNumber(ctx context.Context, id uuid.UUID, pin string) error {
+	if err := ValidateTwoStepVerificationPIN(pin); err != nil {
+		return err
+	}
+
+	wabaGate, requestClient, err := gate.phoneNumberClient(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	body, _ := json.Marshal(map[string]string{
+		"messaging_product": whatsAppMessagingProduct,
+		"pin":               pin,
+	})
+
+	return gate.executePhoneNumberRequest(ctx, requestClient, wabaGate.PhoneNumberID+"/register", string(body), "gate.phone.register_phone_number")
+}
+
+// DeregisterPhoneNumber removes the gate phone number from Cloud API messaging.
+func (gate *gate) DeregisterPhoneNumber(ctx context.Context, id uuid.UUID) error {
+	wabaGate, requestClient, err := gate.phoneNumberClient(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	return gate.executePhoneNumberRequest(ctx, requestClient, wabaGate.PhoneNumberID+"/deregister", "", "gate.phone.deregister_phone_number")
+}
+
+// SetTwoStepVerificationPIN changes the two-step verification PIN of a registered phone number.
+func (gate *gate) SetTwoStepVerificationPIN(ctx context.Context, id uuid.UUID, pin string) error {
+	if err := ValidateTwoStepVerificationPIN(pin); err != nil {
+		return err
+	}
+
+	wabaGate, requestClient, err := gate.phoneNumberClient(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	body, _ := json.Marshal(map[string]string{"pin": pin})
+
+	return gate.executePhoneNumberRequest(ctx, requestClient, wabaGate.PhoneNumberID, string(body), "gate.phone.set_two_step_verification_pin")
+}
+
+func (gate *gate) GetBusinessProfile(ctx context.Context, id uuid.UUID) (*BusinessProfile, error) {
+	wabaGate, requestClient, err := gate.phoneNumberClient(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	req := requestClient.NewApiRequest(wabaGate.PhoneNumberID+"/whatsapp_business_profile", http.MethodGet)
+	for _, field := range businessProfileFields {
+		req.AddField(client.ApiRequestParamField{Name: field})
+	}
+
+	response, err := req.ExecuteWithContext(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.WithID("gate.phone.get_business_profile"))
+	}
+
+	var profileResponse struct {
+		Data  []BusinessProfile           `json:"data"`
+		Error *messaging.MessageSendError `json:"error,omitempty"`
+	}
+	if err := json.Unmarshal([]byte(response), &profileResponse); err != nil {
+		return nil, errors.Internal("unmarshaling business profile response", errors.WithCause(err), errors.WithID("gate.phone.get_business_profile"))
+	}
+
+	if profileResponse.Error != nil {
+		return nil, errors.Wrap(profileResponse.Error.ToGRPCError(), errors.WithID("gate.phone.get_business_profile"))
+	}
+
+	if len(profileResponse.Data) == 0 {
+		return &BusinessProfile{}, nil
+	}
+
+	return &profileResponse.Data[0], nil
+}
+
+// UpdateBusinessProfile changes the given business profile fields. A new profile picture
+// is uploaded through the Resumable Upload API of the gate Meta app first.
+func (gate *gate) UpdateBusinessProfile(ctx context.Context, id uuid.UUID, update *BusinessProfileUpdate) (*BusinessProfile, error) {
+	log := gate.logger.With("operation", "whatsapp.gate.update_business_profile", "gate_id", id.String())
+
+	if err := update.Validate(); err != nil {
+		return nil, err
+	}
+
+	wabaGate, requestClient, err := gate.phoneNumberClient(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	payload := struct {
+		MessagingProduct string `json:"messaging_product"`
+		*BusinessProfileUpdate
+		ProfilePictureHandle string `json:"profile_picture_handle,omitempty"`
+	}{
+		MessagingProduct:      whatsAppMessagingProduct,
+		BusinessProfileUpdate: update,
+	}
+
+	if update.ProfilePicture != nil {
+		appID, err := gate.wabaGateRepository.GetMetaAppExternalID(ctx, wabaGate.MetaAppID)
+		if err != nil {
+			return nil, err
+		}
+
+		handle, err := requestClient.RequestResumableUploadWithContext(ctx, appID, update.ProfilePicture.MimeType, update.ProfilePicture.Content)
+		if err != nil {
+			log.Error("uploading business profile picture", "error", err)
+			return nil, err
+		}
+		payload.ProfilePictureHandle = handle
+	}
+
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return nil, errors.Internal("marshaling business profile", errors.WithCause(err), errors.WithID("gate.phone.update_business_profile"))
+	}
+
+	if err := gate.executePhoneNumberRequest(ctx, requestClient, wabaGate.PhoneNumberID+"/whatsapp_business_profile", string(body), "gate.phone.update_business_profile"); err != nil {
+		log.Error("updating business profile", "error", err)
+		return nil, err
+	}
+
+	return gate.GetBusinessProfile(ctx, id)
+}
+
+// phoneNumberClient loads the gate of the caller domain and sets up a request client with its token.
+func (gate *gate) phoneNumberClient(ctx context.Context, id uuid.UUID) (*WhatsAppBusinessAccountGate, client.RequestClient, error) {
+	current, err := gate.Get(ctx, id)
+	if err != nil {
+		return nil, client.RequestClient{}, err
+	}
+
+	wabaGate, err := current.WhatsAppBusinessAccountGate.PostFetch(gate.encryptor)
+	if err != nil {
+		return nil, client.RequestClient{}, err
+	}
+
+	if err := wabaGate.SetUpClient(); err != nil {
+		return nil, client.RequestClient{}, err
+	}
+
+	return &wabaGate, wabaGate.GetClient(), nil
+}
+
+func (gate *gate) executePhoneNumberRequest(ctx context.Context, requestClient client.RequestClient, path, body, id string) error {
+	req := requestClient.NewApiRequest(path, http.MethodPost)
+	req.SetBody(body)
+
+	response, err := req.ExecuteWithContext(ctx)
+	if err != nil {
+		return errors.Wrap(err, errors.WithID(id))
+	}
+
+	unmarshaledResponse, err := messaging.UnmarshalStatusResponse(response)
+	if err != nil {
+		return err
+	}
+
+	if unmarshaledResponse.Error != nil {
+		return errors.Wrap(unmarshaledResponse.Error.ToGRPCError(), errors.WithID(id))
+	}
+
+	if !unmarshaledResponse.Success {
+		return errors.Internal("phone number request was not accepted", errors.WithID(id), errors.WithValue("response", response))
+	}
+
+	return nil
+}
@@ -0,0 +1,62 @@
+package gate
+
+import (
+	"context"
+	"sync"
+
+	"github.com/webitel/im-providers-service/infra/auth"
+	fbmodel "github.com/webitel/im-providers-service/internal/facebook/model"
+	"github.com/webitel/im-providers-service/internal/whatsapp/common"
+	"github.com/webitel/webitel-go-kit/pkg/errors"
+)
+
+// ProvisionWhatsAppGate creates a WhatsApp gate for a phone number discovered by the
+// Meta OAuth flow, or refreshes the access token when the number is already connected
+// within the caller domain.
+func (gate *gate) ProvisionWhatsAppGate(ctx context.Context, req fbmodel.ProvisionWhatsApp) (string, error) {
+	log := gate.logger.With("operation", "whatsapp.gate.provision", "phone_number_id", req.PhoneNumberID)
+
+	session, ok := auth.GetIdentityFromContext(ctx)
+	if !ok {
+		return "", errors.Unauthenticated("not found session in context", errors.WithID("whatsapp.gate.provision.session"))
+	}
+
+	encryptedToken, err := gate.encryptor.Encrypt(req.AccessToken)
+	if err != nil {
+		return "", errors.Internal("encrypting WABA access token", errors.WithCause(err), errors.WithID("whatsapp.gate.provision"))
+	}
+
+	gateID, err := gate.wabaGateRepository.RefreshAccessToken(ctx, session.GetDomainID(), req.PhoneNumberID, []byte(encryptedToken))
+	if err != nil {
+		return "", err
+	}
+
+	if gateID != "" {
+		log.Info("refreshed WhatsApp gate access token", "gate_id", gateID)
+		return gateID, nil
+	}
+
+	saved, err := gate.Save(ctx, &Gate{
+		Name:    req.Name,
+		Type:    WhatsAppGateType,
+		Enabled: true,
+		Contact: &common.Contact{
+			Iss: req.Peer.Iss,
+			Sub: req.Peer.Sub,
+		},
+		WhatsAppBusinessAccountGate: WhatsAppBusinessAccountGate{
+			MetaAppID:     common.SafeConvertStringToUUID(req.MetaAppID),
+			PhoneNumber:   req.PhoneNumber,
+			PhoneNumberID: req.PhoneNumberID,
+			AccessToken:   req.AccessToken,
+			BusinessID:    req.BusinessID,
+			ClientMu:      &sync.RWMutex{},
+		},
+	})
+	if err != nil {
+		return "", err
+	}
+
+	log.Info("provisioned WhatsApp gate", "gate_id", saved.ID.String())
+	return saved.ID.String(), nil
+}
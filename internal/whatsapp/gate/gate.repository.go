@@ -2,10 +2,14 @@ package gate
 
 import (
 	"context"
+	"sync"
+	"time"
 
+	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/webitel/im-providers-service/infra/db/postgresx"
+	"github.com/webitel/im-providers-service/internal/whatsapp/common"
 	"github.com/webitel/webitel-go-kit/pkg/errors"
 	"google.golang.org/grpc/codes"
 )
@@ -119,3 +123,325 @@ func (repository *gateRepository) prepareWhatsAppGateSaveQuery(wabaGate *Gate) (
 
 	return stmt, args
 }
+
+// RefreshAccessToken replaces the encrypted access token of the gate bound to the
+// phone number within the given domain and returns its ID. An empty ID means no such gate.
+func (repository *gateRepository) RefreshAccessToken(ctx context.Context, dc int64, phoneNumberID string, encryptedToken []byte) (string, error) {
+	stmt := `
+		update "im_provider"."gate_waba" gw
+		set "access_token" = @AccessToken
+		from "im_provider"."gates" g
+		where g.id = gw.id
+			and g.dc = @DC
+			and gw.phone_number_id = @PhoneNumberID
+		returning gw.id::text;
+	`
+
+	args := postgresx.NamedArgs{
+		"AccessToken":   encryptedToken,
+		"DC":            dc,
+		"PhoneNumberID": phoneNumberID,
+	}
+
+	var gateID string
+	if err := repository.db.QueryRow(ctx, stmt, args).Scan(&gateID); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return "", nil
+		}
+		return "", errors.Internal("refreshing whatsapp gate access token", errors.WithCause(err), errors.WithID("gate.repository.refresh_access_token"), errors.WithValue("stmt", postgresx.CompactSQL(stmt)))
+	}
+
+	return gateID, nil
+}
+
+// UpdateHealth applies the health update to the gates of the business account, across all
+// domains, and returns the updated gates with their phone number IDs and encrypted access tokens.
+func (repository *gateRepository) UpdateHealth(ctx context.Context, update *HealthUpdate) ([]WhatsAppBusinessAccountGate, error) {
+	stmt := `
+		update "im_provider"."gate_waba"
+		set
+			"messaging_limit_tier" = coalesce(@MessagingLimitTier, "messaging_limit_tier"),
+			"flagged" = coalesce(@Flagged, "flagged"),
+			"ban_state" = coalesce(@BanState, "ban_state"),
+			"restrictions" = coalesce(@Restrictions::jsonb, "restrictions"),
+			"health_updated_at" = now()
+		where "business_id" = @BusinessID
+			and (@PhoneNumber = '' or regexp_replace("phone_number", '\D', '', 'g') = @PhoneNumber)
+		returning "id", "phone_number_id", "access_token";
+	`
+
+	args := postgresx.NamedArgs{
+		"BusinessID":         update.BusinessID,
+		"PhoneNumber":        phoneNumberDigits(update.DisplayPhoneNumber),
+		"MessagingLimitTier": update.MessagingLimitTier,
+		"Flagged":            update.Flagged,
+		"BanState":           update.BanState,
+		"Restrictions":       nil,
+	}
+	if update.Restrictions != nil {
+		restrictions := *update.Restrictions
+		if restrictions == nil {
+			restrictions = []Restriction{}
+		}
+		args["Restrictions"] = restrictions
+	}
+
+	rows, err := repository.db.Query(ctx, stmt, args)
+	if err != nil {
+		return nil, errors.Internal("updating whatsapp gates health", errors.WithCause(err), errors.WithID("gate.repository.update_health"), errors.WithValue("stmt", postgresx.CompactSQL(stmt)))
+	}
+
+	targets, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (WhatsAppBusinessAccountGate, error) {
+		target := WhatsAppBusinessAccountGate{ClientMu: &sync.RWMutex{}}
+		err := row.Scan(&target.ID, &target.PhoneNumberID, &target.AccessTokenEncrypted)
+		return target, err
+	})
+	if err != nil {
+		return nil, errors.Internal("collecting updated whatsapp gates", errors.WithCause(err), errors.WithID("gate.repository.update_health"))
+	}
+
+	return targets, nil
+}
+
+// SetQualityRating stores the quality rating and messaging limit tier the Graph API reports for the gate phone number.
+// An empty messaging limit tier keeps the current one.
+func (repository *gateRepository) SetQualityRating(ctx context.Context, id uuid.UUID, qualityRating, messagingLimitTier string) error {
+	stmt := `
+		update "im_provider"."gate_waba"
+		set
+			"quality_rating" = @QualityRating,
+			"messaging_limit_tier" = coalesce(nullif(@MessagingLimitTier, ''), "messaging_limit_tier"),
+			"health_updated_at" = now()
+		where "id" = @ID;
+	`
+
+	args := postgresx.NamedArgs{
+		"ID":                 id,
+		"QualityRating":      qualityRating,
+		"MessagingLimitTier": messagingLimitTier,
+	}
+
+	if _, err := repository.db.Exec(ctx, stmt, args); err != nil {
+		return errors.Internal("setting whatsapp gate quality rating", errors.WithCause(err), errors.WithID("gate.repository.set_quality_rating"), errors.WithValue("stmt", postgresx.CompactSQL(stmt)))
+	}
+
+	return nil
+}
+
+// gateRecord is a flat WhatsApp gate row. The encrypted access token is excluded from
+// the JSON form of WhatsAppBusinessAccountGate, so the gate is not selected with to_jsonb.
+type gateRecord struct {
+	ID                   uuid.UUID     `db:"id"`
+	Name                 string        `db:"name"`
+	Type                 string        `db:"type"`
+	Enabled              bool          `db:"enabled"`
+	DC                   int64         `db:"dc"`
+	CreatedAt            time.Time     `db:"created_at"`
+	UpdatedAt            time.Time     `db:"updated_at"`
+	MetaAppID            uuid.UUID     `db:"meta_app_id"`
+	PhoneNumber          string        `db:"phone_number"`
+	PhoneNumberID        string        `db:"phone_number_id"`
+	AccessToken          []byte        `db:"access_token"`
+	AccessTokenExpiresAt *time.Time    `db:"access_token_expires_at"`
+	BusinessID           string        `db:"business_id"`
+	QualityRating        string        `db:"quality_rating"`
+	MessagingLimitTier   string        `db:"messaging_limit_tier"`
+	Flagged              bool          `db:"flagged"`
+	BanState             string        `db:"ban_state"`
+	Restrictions         []Restriction `db:"restrictions"`
+	HealthUpdatedAt      *time.Time    `db:"health_updated_at"`
+	BotID                uuid.UUID     `db:"bot_id"`
+	BotIss               string        `db:"bot_iss"`
+	BotSub               string        `db:"bot_sub"`
+}
+
+func (record *gateRecord) toGate() *Gate {
+	return &Gate{
+		ID:        record.ID,
+		Name:      record.Name,
+		Type:      record.Type,
+		Enabled:   record.Enabled,
+		DC:        record.DC,
+		CreatedAt: record.CreatedAt,
+		UpdatedAt: record.UpdatedAt,
+		Contact: &common.Contact{
+			ID:  record.BotID,
+			Iss: record.BotIss,
+			Sub: record.BotSub,
+		},
+		WhatsAppBusinessAccountGate: WhatsAppBusinessAccountGate{
+			ID:                   record.ID,
+			MetaAppID:            record.MetaAppID,
+			PhoneNumber:          record.PhoneNumber,
+			PhoneNumberID:        record.PhoneNumberID,
+			AccessTokenEncrypted: record.AccessToken,
+			AccessTokenExpiresAt: record.AccessTokenExpiresAt,
+			BusinessID:           record.BusinessID,
+			Health: PhoneNumberHealth{
+				QualityRating:      record.QualityRating,
+				MessagingLimitTier: record.MessagingLimitTier,
+				Flagged:            record.Flagged,
+				BanState:           record.BanState,
+				Restrictions:       record.Restrictions,
+				UpdatedAt:          record.HealthUpdatedAt,
+			},
+			ClientMu: &sync.RWMutex{},
+		},
+	}
+}
+
+func (repository *gateRepository) collectGate(rows pgx.Rows, id string) (*Gate, error) {
+	record, err := pgx.CollectOneRow(rows, pgx.RowToAddrOfStructByNameLax[gateRecord])
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, errors.NotFound("whatsapp gate not found", errors.WithID(id))
+		}
+		return nil, errors.Internal("collecting whatsapp gate record", errors.WithCause(err), errors.WithID(id))
+	}
+
+	return record.toGate(), nil
+}
+
+// Get returns the WhatsApp gate of the domain, along with its encrypted access token.
+func (repository *gateRepository) Get(ctx context.Context, dc int64, id uuid.UUID) (*Gate, error) {
+	stmt := `
+		select
+			g.id, g.name, g.type, g.enabled, g.dc, g.created_at, g.updated_at,
+			w.meta_app_id, w.phone_number, w.phone_number_id, w.access_token,
+			w.access_token_expires_at, w.business_id,
+			w.quality_rating, w.messaging_limit_tier, w.flagged, w.ban_state,
+			w.restrictions, w.health_updated_at,
+			b.id as bot_id, b.iss as bot_iss, b.sub as bot_sub
+		from "im_provider"."gates" g
+		inner join "im_provider"."gate_waba" w using(id)
+		inner join "im_provider"."bots" b on b.gate_id = g.id
+		where g.id = @ID and g.dc = @DC;
+	`
+
+	args := postgresx.NamedArgs{
+		"ID": id,
+		"DC": dc,
+	}
+
+	rows, err := repository.db.Replica().Query(ctx, stmt, args)
+	if err != nil {
+		return nil, errors.Internal("selecting whatsapp gate", errors.WithCause(err), errors.WithID("gate.repository.get"), errors.WithValue("stmt", postgresx.CompactSQL(stmt)))
+	}
+
+	return repository.collectGate(rows, "gate.repository.get")
+}
+
+// Update applies the non-nil fields of the update. A new access token resets its expiry,
+// which is unknown until the token is debugged again.
+func (repository *gateRepository) Update(ctx context.Context, update *GateUpdate) (*Gate, error) {
+	stmt := `
+		with gate_upd as (
+			update "im_provider"."gates"
+			set
+				"name" = coalesce(@Name, "name"),
+				"enabled" = coalesce(@Enabled, "enabled")
+			where "id" = @ID and "dc" = @DC
+			returning "id", "name", "type", "enabled", "dc", "created_at", "updated_at"
+		),
+		waba_gate_upd as (
+			update "im_provider"."gate_waba" w
+			set
+				"access_token" = coalesce(@AccessToken, w."access_token"),
+				"access_token_expires_at" = case when @AccessToken::bytea is null then w."access_token_expires_at" end
+			from gate_upd g
+			where w.id = g.id
+			returning w.*
+		)
+		select
+			g.id, g.name, g.type, g.enabled, g.dc, g.created_at, g.updated_at,
+			w.meta_app_id, w.phone_number, w.phone_number_id, w.access_token,
+			w.access_token_expires_at, w.business_id,
+			w.quality_rating, w.messaging_limit_tier, w.flagged, w.ban_state,
+			w.restrictions, w.health_updated_at,
+			b.id as bot_id, b.iss as bot_iss, b.sub as bot_sub
+		from gate_upd g
+		inner join waba_gate_upd w using(id)
+		inner join "im_provider"."bots" b on b.gate_id = g.id;
+	`
+
+	args := postgresx.NamedArgs{
+		"ID":          update.ID,
+		"DC":          update.DC,
+		"Name":        update.Name,
+		"Enabled":     update.Enabled,
+		"AccessToken": update.AccessTokenEncrypted,
+	}
+
+	rows, err := repository.db.Query(ctx, stmt, args)
+	if err != nil {
+		return nil, errors.Internal("updating whatsapp gate", errors.WithCause(err), errors.WithID("gate.repository.update"), errors.WithValue("stmt", postgresx.CompactSQL(stmt)))
+	}
+
+	return repository.collectGate(rows, "gate.repository.update")
+}
+
+// Delete removes the gate of the domain and returns it as it was before deletion.
+// The WABA settings and the bot are removed by cascade.
+func (repository *gateRepository) Delete(ctx context.Context, dc int64, id uuid.UUID) (*Gate, error) {
+	stmt := `
+		delete from "im_provider"."gates" g
+		using "im_provider"."gate_waba" w, "im_provider"."bots" b
+		where g.id = @ID and g.dc = @DC
+			and w.id = g.id
+			and b.gate_id = g.id
+		returning
+			g.id, g.name, g.type, g.enabled, g.dc, g.created_at, g.updated_at,
+			w.meta_app_id, w.phone_number, w.phone_number_id, w.access_token,
+			w.access_token_expires_at, w.business_id,
+			w.quality_rating, w.messaging_limit_tier, w.flagged, w.ban_state,
+			w.restrictions, w.health_updated_at,
+			b.id as bot_id, b.iss as bot_iss, b.sub as bot_sub;
+	`
+
+	args := postgresx.NamedArgs{
+		"ID": id,
+		"DC": dc,
+	}
+
+	rows, err := repository.db.Query(ctx, stmt, args)
+	if err != nil {
+		return nil, errors.Internal("deleting whatsapp gate", errors.WithCause(err), errors.WithID("gate.repository.delete"), errors.WithValue("stmt", postgresx.CompactSQL(stmt)))
+	}
+
+	return repository.collectGate(rows, "gate.repository.delete")
+}
+
+// CountByBusinessID returns the number of gates, across all domains, bound to the WhatsApp Business Account.
+func (repository *gateRepository) CountByBusinessID(ctx context.Context, businessID string) (int, error) {
+	stmt := `
+		select count(*)
+		from "im_provider"."gate_waba"
+		where "business_id" = @BusinessID;
+	`
+
+	var count int
+	if err := repository.db.QueryRow(ctx, stmt, postgresx.NamedArgs{"BusinessID": businessID}).Scan(&count); err != nil {
+		return 0, errors.Internal("counting whatsapp business account gates", errors.WithCause(err), errors.WithID("gate.repository.count_by_business_id"), errors.WithValue("stmt", postgresx.CompactSQL(stmt)))
+	}
+
+	return count, nil
+}
+
+// GetMetaAppExternalID returns the Meta-issued app id of the Meta app the gate is bound to.
+func (repository *gateRepository) GetMetaAppExternalID(ctx context.Context, metaAppID uuid.UUID) (string, error) {
+	stmt := `
+		select "app_id"
+		from "im_provider"."meta_apps"
+		where "id" = @ID;
+	`
+
+	var appID string
+	if err := repository.db.Replica().QueryRow(ctx, stmt, postgresx.NamedArgs{"ID": metaAppID}).Scan(&appID); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return "", errors.NotFound("meta app not found", errors.WithID("gate.repository.get_meta_app_external_id"), errors.WithValue("meta_app_id", metaAppID.String()))
+		}
+		return "", errors.Internal("selecting meta app id", errors.WithCause(err), errors.WithID("gate.repository.get_meta_app_external_id"), errors.WithValue("stmt", postgresx.CompactSQL(stmt)))
+	}
+
+	return appID, nil
+}
@@ -0,0 +1,244 @@
+package gate
+
+import (
+	"context"
+	"io/fs"
+	"os"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/webitel/im-providers-service/infra/db/postgresx"
+	"github.com/webitel/im-providers-service/internal/whatsapp/common"
+	"github.com/webitel/im-providers-service/migrations"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// testPostgresDSNEnv points the repository tests at a disposable Postgres:
+// the im_provider schema is dropped and migrated from scratch on every run.
+const testPostgresDSNEnv = "IM_PROVIDERS_TEST_POSTGRES_DSN"
+
+func newTestRepository(t *testing.T) (*gateRepository, uuid.UUID) {
+	t.Helper()
+
+	dsn := os.Getenv(testPostgresDSNEnv)
+	if dsn == "" {
+		t.Skipf("%s is not set", testPostgresDSNEnv)
+	}
+
+	ctx := context.Background()
+	db, err := postgresx.New(ctx, dsn)
+	if err != nil {
+		t.Fatalf("connecting to postgres: %v", err)
+	}
+	t.Cleanup(db.Close)
+
+	if _, err := db.Exec(ctx, `drop schema if exists "im_provider" cascade`); err != nil {
+		t.Fatalf("dropping schema: %v", err)
+	}
+	migrateUp(t, db)
+
+	var metaAppID uuid.UUID
+	err = db.QueryRow(ctx, `
+		insert into "im_provider"."meta_apps" ("name", "app_id", "app_secret", "redirect_uri", "uri")
+		values ('test', 'app-1', 'secret', 'https://example.com/callback', 'test-app')
+		returning "id"
+	`).Scan(&metaAppID)
+	if err != nil {
+		t.Fatalf("inserting meta app: %v", err)
+	}
+
+	return newGateRepository(db), metaAppID
+}
+
+// migrateUp applies the Up sections of the embedded goose migrations in order.
+func migrateUp(t *testing.T, db postgresx.DB) {
+	t.Helper()
+
+	entries, err := fs.ReadDir(migrations.EmbedMigrations, ".")
+	if err != nil {
+		t.Fatalf("reading migrations: %v", err)
+	}
+
+	for _, entry := range entries {
+		raw, err := fs.ReadFile(migrations.EmbedMigrations, entry.Name())
+		if err != nil {
+			t.Fatalf("reading %s: %v", entry.Name(), err)
+		}
+
+		up, _, _ := strings.Cut(string(raw), "-- +goose Down")
+		up = strings.NewReplacer("-- +goose Up", "", "-- +goose StatementBegin", "", "-- +goose StatementEnd", "").Replace(up)
+
+		if _, err := db.Exec(context.Background(), up); err != nil {
+			t.Fatalf("applying %s: %v", entry.Name(), err)
+		}
+	}
+}
+
+func saveTestGate(t *testing.T, repository *gateRepository, metaAppID uuid.UUID, dc int64, phoneNumberID string) *Gate {
+	t.Helper()
+
+	saved, err := repository.Save(context.Background(), &Gate{
+		Name:    "support",
+		Type:    WhatsAppGateType,
+		Enabled: true,
+		DC:      dc,
+		Contact: &common.Contact{Iss: "bot", Sub: "bot-" + phoneNumberID},
+		WhatsAppBusinessAccountGate: WhatsAppBusinessAccountGate{
+			MetaAppID:            metaAppID,
+			PhoneNumber:          "+380000000000",
+			PhoneNumberID:        phoneNumberID,
+			AccessTokenEncrypted: []byte("encrypted-1"),
+			BusinessID:           "waba-1",
+			ClientMu:             &sync.RWMutex{},
+		},
+	})
+	if err != nil {
+		t.Fatalf("saving gate: %v", err)
+	}
+
+	return saved
+}
+
+func TestGateRepository_Get(t *testing.T) {
+	repository, metaAppID := newTestRepository(t)
+	saved := saveTestGate(t, repository, metaAppID, 1, "phone-1")
+
+	got, err := repository.Get(context.Background(), 1, saved.ID)
+	if err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+
+	waba := got.WhatsAppBusinessAccountGate
+	if got.Name != "support" || !got.Enabled || got.DC != 1 {
+		t.Errorf("unexpected gate: %+v", got)
+	}
+	if waba.PhoneNumberID != "phone-1" || waba.BusinessID != "waba-1" || waba.MetaAppID != metaAppID {
+		t.Errorf("unexpected WABA settings: %+v", waba)
+	}
+	if string(waba.AccessTokenEncrypted) != "encrypted-1" {
+		t.Errorf("encrypted token = %q", waba.AccessTokenEncrypted)
+	}
+	if got.Contact.Sub != "bot-phone-1" {
+		t.Errorf("unexpected bot: %+v", got.Contact)
+	}
+
+	if _, err := repository.Get(context.Background(), 2, saved.ID); status.Code(err) != codes.NotFound {
+		t.Errorf("gate of another domain: want NotFound, got %v", err)
+	}
+}
+
+func TestGateRepository_Update(t *testing.T) {
+	repository, metaAppID := newTestRepository(t)
+	saved := saveTestGate(t, repository, metaAppID, 1, "phone-1")
+
+	name, enabled := "sales", false
+	updated, err := repository.Update(context.Background(), &GateUpdate{ID: saved.ID, DC: 1, Name: &name, Enabled: &enabled})
+	if err != nil {
+		t.Fatalf("Update: %v", err)
+	}
+	if updated.Name != "sales" || updated.Enabled {
+		t.Errorf("unexpected gate: %+v", updated)
+	}
+	if string(updated.WhatsAppBusinessAccountGate.AccessTokenEncrypted) != "encrypted-1" {
+		t.Error("token must be kept when not updated")
+	}
+
+	updated, err = repository.Update(context.Background(), &GateUpdate{ID: saved.ID, DC: 1, AccessTokenEncrypted: []byte("encrypted-2")})
+	if err != nil {
+		t.Fatalf("Update token: %v", err)
+	}
+	if updated.Name != "sales" || string(updated.WhatsAppBusinessAccountGate.AccessTokenEncrypted) != "encrypted-2" {
+		t.Errorf("unexpected gate after token rotation: %+v", updated)
+	}
+
+	if _, err := repository.Update(context.Background(), &GateUpdate{ID: saved.ID, DC: 2, Name: &name}); status.Code(err) != codes.NotFound {
+		t.Errorf("gate of another domain: want NotFound, got %v", err)
+	}
+}
+
+func TestGateRepository_Delete(t *testing.T) {
+	repository, metaAppID := newTestRepository(t)
+	first := saveTestGate(t, repository, metaAppID, 1, "phone-1")
+	saveTestGate(t, repository, metaAppID, 1, "phone-2")
+	ctx := context.Background()
+
+	if _, err := repository.Delete(ctx, 2, first.ID); status.Code(err) != codes.NotFound {
+		t.Errorf("gate of another domain: want NotFound, got %v", err)
+	}
+
+	deleted, err := repository.Delete(ctx, 1, first.ID)
+	if err != nil {
+		t.Fatalf("Delete: %v", err)
+	}
+	if deleted.WhatsAppBusinessAccountGate.PhoneNumberID != "phone-1" || string(deleted.WhatsAppBusinessAccountGate.AccessTokenEncrypted) != "encrypted-1" {
+		t.Errorf("unexpected deleted gate: %+v", deleted)
+	}
+
+	if _, err := repository.Get(ctx, 1, first.ID); status.Code(err) != codes.NotFound {
+		t.Errorf("deleted gate: want NotFound, got %v", err)
+	}
+
+	count, err := repository.CountByBusinessID(ctx, "waba-1")
+	if err != nil {
+		t.Fatalf("CountByBusinessID: %v", err)
+	}
+	if count != 1 {
+		t.Errorf("remaining gates = %d, want 1", count)
+	}
+}
+
+func TestGateRepository_UpdateHealth(t *testing.T) {
+	repository, metaAppID := newTestRepository(t)
+	first := saveTestGate(t, repository, metaAppID, 1, "phone-1")
+	second := saveTestGate(t, repository, metaAppID, 2, "phone-2")
+	ctx := context.Background()
+
+	// Meta reports the display number without the formatting the gate was saved with
+	targets, err := repository.UpdateHealth(ctx, &HealthUpdate{BusinessID: "waba-1", DisplayPhoneNumber: "380000000000", Flagged: ptr(true), MessagingLimitTier: ptr("TIER_1K")})
+	if err != nil {
+		t.Fatalf("UpdateHealth: %v", err)
+	}
+	if len(targets) != 2 || string(targets[0].AccessTokenEncrypted) != "encrypted-1" {
+		t.Fatalf("targets = %+v", targets)
+	}
+
+	expiration := time.Now().Add(time.Hour).UTC().Truncate(time.Second)
+	restrictions := []Restriction{{Type: "RESTRICTED_BIZ_INITIATED_MESSAGING", Expiration: &expiration}}
+	if _, err := repository.UpdateHealth(ctx, &HealthUpdate{BusinessID: "waba-1", Restrictions: &restrictions}); err != nil {
+		t.Fatalf("UpdateHealth restrictions: %v", err)
+	}
+
+	if err := repository.SetQualityRating(ctx, first.ID, "RED", ""); err != nil {
+		t.Fatalf("SetQualityRating: %v", err)
+	}
+
+	got, err := repository.Get(ctx, 1, first.ID)
+	if err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+	health := got.WhatsAppBusinessAccountGate.Health
+	if !health.Flagged || health.QualityRating != "RED" || health.MessagingLimitTier != "TIER_1K" || health.UpdatedAt == nil {
+		t.Errorf("health = %+v", health)
+	}
+	if len(health.Restrictions) != 1 || !health.Restrictions[0].Expiration.Equal(expiration) {
+		t.Errorf("restrictions = %+v", health.Restrictions)
+	}
+
+	// the gate list status follows the same rule as PhoneNumberHealth.Faulty
+	var summaryStatus string
+	if err := repository.db.QueryRow(ctx, `select "status" from "im_provider"."gate_summary" where "id" = $1`, second.ID).Scan(&summaryStatus); err != nil {
+		t.Fatalf("selecting gate summary: %v", err)
+	}
+	if summaryStatus != "error" {
+		t.Errorf("gate summary status = %q, want error", summaryStatus)
+	}
+
+	targets, err = repository.UpdateHealth(ctx, &HealthUpdate{BusinessID: "waba-2", Flagged: ptr(true)})
+	if err != nil || len(targets) != 0 {
+		t.Errorf("another business account: targets = %+v, error = %v", targets, err)
+	}
+}
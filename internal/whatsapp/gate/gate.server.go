@@ -3,25 +3,54 @@ package gate
 import (
 	"context"
 	"sync"
+	"time"
 
+	"github.com/google/uuid"
 	impb "github.com/webitel/im-providers-service/gen/go/provider/v1"
+	"github.com/webitel/im-providers-service/infra/auth"
+	"github.com/webitel/im-providers-service/internal/whatsapp/analytics"
 	"github.com/webitel/im-providers-service/internal/whatsapp/common"
 	"github.com/webitel/webitel-go-kit/pkg/errors"
-	"google.golang.org/grpc/codes"
 )
 
 type GateEditor interface {
 	Save(ctx context.Context, wabaGate *Gate) (*Gate, error)
+	Get(ctx context.Context, id uuid.UUID) (*Gate, error)
+	Update(ctx context.Context, update *GateUpdate) (*Gate, error)
+	Delete(ctx context.Context, id uuid.UUID) (*Gate, error)
+}
+
+// PhoneNumberEditor manages the phone number behind a gate: Cloud API registration,
+// two-step verification and the business profile.
+type PhoneNumberEditor interface {
+	RegisterPhoneNumber(ctx context.Context, id uuid.UUID, pin string) error
+	DeregisterPhoneNumber(ctx context.Context, id uuid.UUID) error
+	SetTwoStepVerificationPIN(ctx context.Context, id uuid.UUID, pin string) error
+	GetBusinessProfile(ctx context.Context, id uuid.UUID) (*BusinessProfile, error)
+	UpdateBusinessProfile(ctx context.Context, id uuid.UUID, update *BusinessProfileUpdate) (*BusinessProfile, error)
+}
+
+// FlowLister lists the WhatsApp Flows of the business account behind a gate.
+type FlowLister interface {
+	ListFlows(ctx context.Context, id uuid.UUID) ([]Flow, error)
+}
+
+// UsageReporter aggregates the conversations and messages of a domain by gate, pricing category and day.
+type UsageReporter interface {
+	Usage(ctx context.Context, query analytics.UsageQuery) ([]analytics.Usage, error)
 }
 
 type whatsAppBusinessAccountServer struct {
 	impb.UnimplementedWhatsAppServiceServer
 
 	editor GateEditor
+	phones PhoneNumberEditor
+	flows  FlowLister
+	usage  UsageReporter
 }
 
-func newWhatsAppBusinessAccountServer(editor GateEditor) *whatsAppBusinessAccountServer {
-	return &whatsAppBusinessAccountServer{editor: editor}
+func newWhatsAppBusinessAccountServer(editor GateEditor, phones PhoneNumberEditor, flows FlowLister, usage UsageReporter) *whatsAppBusinessAccountServer {
+	return &whatsAppBusinessAccountServer{editor: editor, phones: phones, flows: flows, usage: usage}
 }
 
 func (server *whatsAppBusinessAccountServer) CreateWhatsAppGate(ctx context.Context, in *impb.CreateGateRequest) (*impb.GateResponse, error) {
@@ -87,11 +116,267 @@ func (server *whatsAppBusinessAccountServer) CreateWhatsAppGate(ctx context.Cont
 }
 
 func (server *whatsAppBusinessAccountServer) GetWhatsAppGate(ctx context.Context, in *impb.ProviderGetWhatsAppGateRequest) (*impb.ProviderGetWhatsAppGateResponse, error) {
-	return nil, errors.New("not implemented", errors.WithCode(codes.Unimplemented))
+	id, err := parseGateID(in.GetId(), "gate.server.get_whatsapp_gate")
+	if err != nil {
+		return nil, err
+	}
+
+	gate, err := server.editor.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &impb.ProviderGetWhatsAppGateResponse{Item: toProviderWhatsAppGate(gate)}, nil
 }
+
+// UpdateWhatsAppGate renames the gate and/or rotates its access token; empty fields are left untouched.
 func (server *whatsAppBusinessAccountServer) UpdateWhatsAppGate(ctx context.Context, in *impb.ProviderUpdateWhatsAppGateRequest) (*impb.ProviderUpdateWhatsAppGateResponse, error) {
-	return nil, errors.New("not implemented", errors.WithCode(codes.Unimplemented))
+	id, err := parseGateID(in.GetId(), "gate.server.update_whatsapp_gate")
+	if err != nil {
+		return nil, err
+	}
+
+	update := GateUpdate{ID: id}
+	if name := in.GetName(); name != "" {
+		update.Name = &name
+	}
+	if accessToken := in.GetAccessToken(); accessToken != "" {
+		update.AccessToken = &accessToken
+	}
+
+	gate, err := server.editor.Update(ctx, &update)
+	if err != nil {
+		return nil, err
+	}
+
+	return &impb.ProviderUpdateWhatsAppGateResponse{Item: toProviderWhatsAppGate(gate)}, nil
 }
+
 func (server *whatsAppBusinessAccountServer) DeleteWhatsAppGate(ctx context.Context, in *impb.ProviderDeleteWhatsAppGateRequest) (*impb.ProviderDeleteWhatsAppGateResponse, error) {
-	return nil, errors.New("not implemented", errors.WithCode(codes.Unimplemented))
+	id, err := parseGateID(in.GetId(), "gate.server.delete_whatsapp_gate")
+	if err != nil {
+		return nil, err
+	}
+
+	gate, err := server.editor.Delete(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &impb.ProviderDeleteWhatsAppGateResponse{Item: toProviderWhatsAppGate(gate)}, nil
+}
+
+func (server *whatsAppBusinessAccountServer) RegisterWhatsAppPhoneNumber(ctx context.Context, in *impb.ProviderRegisterWhatsAppPhoneNumberRequest) (*impb.ProviderRegisterWhatsAppPhoneNumberResponse, error) {
+	id, err := parseGateID(in.GetId(), "gate.server.register_whatsapp_phone_number")
+	if err != nil {
+		return nil, err
+	}
+
+	if err := server.phones.RegisterPhoneNumber(ctx, id, in.GetPin()); err != nil {
+		return nil, err
+	}
+
+	return &impb.ProviderRegisterWhatsAppPhoneNumberResponse{}, nil
+}
+
+func (server *whatsAppBusinessAccountServer) DeregisterWhatsAppPhoneNumber(ctx context.Context, in *impb.ProviderDeregisterWhatsAppPhoneNumberRequest) (*impb.ProviderDeregisterWhatsAppPhoneNumberResponse, error) {
+	id, err := parseGateID(in.GetId(), "gate.server.deregister_whatsapp_phone_number")
+	if err != nil {
+		return nil, err
+	}
+
+	if err := server.phones.DeregisterPhoneNumber(ctx, id); err != nil {
+		return nil, err
+	}
+
+	return &impb.ProviderDeregisterWhatsAppPhoneNumberResponse{}, nil
+}
+
+func (server *whatsAppBusinessAccountServer) SetWhatsAppTwoStepVerificationPin(ctx context.Context, in *impb.ProviderSetWhatsAppTwoStepVerificationPinRequest) (*impb.ProviderSetWhatsAppTwoStepVerificationPinResponse, error) {
+	id, err := parseGateID(in.GetId(), "gate.server.set_whatsapp_two_step_verification_pin")
+	if err != nil {
+		return nil, err
+	}
+
+	if err := server.phones.SetTwoStepVerificationPIN(ctx, id, in.GetPin()); err != nil {
+		return nil, err
+	}
+
+	return &impb.ProviderSetWhatsAppTwoStepVerificationPinResponse{}, nil
+}
+
+func (server *whatsAppBusinessAccountServer) GetWhatsAppBusinessProfile(ctx context.Context, in *impb.ProviderGetWhatsAppBusinessProfileRequest) (*impb.ProviderGetWhatsAppBusinessProfileResponse, error) {
+	id, err := parseGateID(in.GetId(), "gate.server.get_whatsapp_business_profile")
+	if err != nil {
+		return nil, err
+	}
+
+	profile, err := server.phones.GetBusinessProfile(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &impb.ProviderGetWhatsAppBusinessProfileResponse{Profile: toProviderBusinessProfile(profile)}, nil
+}
+
+// UpdateWhatsAppBusinessProfile changes the given business profile fields; unset fields are left untouched.
+func (server *whatsAppBusinessAccountServer) UpdateWhatsAppBusinessProfile(ctx context.Context, in *impb.ProviderUpdateWhatsAppBusinessProfileRequest) (*impb.ProviderUpdateWhatsAppBusinessProfileResponse, error) {
+	id, err := parseGateID(in.GetId(), "gate.server.update_whatsapp_business_profile")
+	if err != nil {
+		return nil, err
+	}
+
+	update := BusinessProfileUpdate{
+		About:       in.About,
+		Address:     in.Address,
+		Description: in.Description,
+		Email:       in.Email,
+		Websites:    in.GetWebsites(),
+		Vertical:    in.Vertical,
+	}
+	if picture := in.GetProfilePicture(); picture != nil {
+		update.ProfilePicture = &BusinessProfilePicture{
+			MimeType: picture.GetMimeType(),
+			Content:  picture.GetContent(),
+		}
+	}
+
+	profile, err := server.phones.UpdateBusinessProfile(ctx, id, &update)
+	if err != nil {
+		return nil, err
+	}
+
+	return &impb.ProviderUpdateWhatsAppBusinessProfileResponse{Profile: toProviderBusinessProfile(profile)}, nil
+}
+
+func (server *whatsAppBusinessAccountServer) ListWhatsAppFlows(ctx context.Context, in *impb.ProviderListWhatsAppFlowsRequest) (*impb.ProviderListWhatsAppFlowsResponse, error) {
+	id, err := parseGateID(in.GetId(), "gate.server.list_whatsapp_flows")
+	if err != nil {
+		return nil, err
+	}
+
+	flows, err := server.flows.ListFlows(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]*impb.ProviderWhatsAppFlow, 0, len(flows))
+	for _, flow := range flows {
+		items = append(items, toProviderWhatsAppFlow(flow))
+	}
+
+	return &impb.ProviderListWhatsAppFlowsResponse{Items: items}, nil
+}
+
+func (server *whatsAppBusinessAccountServer) GetWhatsAppUsage(ctx context.Context, in *impb.ProviderGetWhatsAppUsageRequest) (*impb.ProviderGetWhatsAppUsageResponse, error) {
+	session, ok := auth.GetIdentityFromContext(ctx)
+	if !ok {
+		return nil, errors.Unauthenticated("not found session in context", errors.WithID("gate.server.get_whatsapp_usage"))
+	}
+
+	gateIDs := make([]uuid.UUID, 0, len(in.GetGateIds()))
+	for _, raw := range in.GetGateIds() {
+		id, err := parseGateID(raw, "gate.server.get_whatsapp_usage")
+		if err != nil {
+			return nil, err
+		}
+		gateIDs = append(gateIDs, id)
+	}
+
+	usage, err := server.usage.Usage(ctx, analytics.UsageQuery{
+		DomainID: session.GetDomainID(),
+		GateIDs:  gateIDs,
+		Category: in.GetCategory(),
+		From:     fromUnixMilli(in.GetFrom()),
+		To:       fromUnixMilli(in.GetTo()),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]*impb.ProviderWhatsAppUsage, 0, len(usage))
+	for _, day := range usage {
+		items = append(items, &impb.ProviderWhatsAppUsage{
+			GateId:                day.GateID.String(),
+			Category:              day.Category,
+			Day:                   day.Day.UnixMilli(),
+			Conversations:         day.Conversations,
+			BillableConversations: day.BillableConversations,
+			Messages:              day.Messages,
+			BillableMessages:      day.BillableMessages,
+		})
+	}
+
+	return &impb.ProviderGetWhatsAppUsageResponse{Items: items}, nil
+}
+
+// fromUnixMilli leaves an unset timestamp zero so the query reports it as missing.
+func fromUnixMilli(milli int64) time.Time {
+	if milli == 0 {
+		return time.Time{}
+	}
+	return time.UnixMilli(milli).UTC()
+}
+
+func parseGateID(raw, id string) (uuid.UUID, error) {
+	gateID, err := uuid.Parse(raw)
+	if err != nil {
+		return uuid.Nil, errors.InvalidArgument("invalid gate id", errors.WithID(id), errors.WithCause(err))
+	}
+	return gateID, nil
+}
+
+func toProviderWhatsAppGate(gate *Gate) *impb.ProviderWhatsAppGate {
+	status := impb.ProviderStatus_PROVIDER_STATUS_ACTIVE
+	switch {
+	case !gate.Enabled:
+		status = impb.ProviderStatus_PROVIDER_STATUS_INACTIVE
+	case gate.WhatsAppBusinessAccountGate.Health.Faulty(time.Now()):
+		status = impb.ProviderStatus_PROVIDER_STATUS_ERROR
+	}
+
+	return &impb.ProviderWhatsAppGate{
+		Id:            gate.ID.String(),
+		Name:          gate.Name,
+		MetaAppId:     gate.WhatsAppBusinessAccountGate.MetaAppID.String(),
+		WabaId:        gate.WhatsAppBusinessAccountGate.BusinessID,
+		PhoneNumberId: gate.WhatsAppBusinessAccountGate.PhoneNumberID,
+		PhoneDisplay:  gate.WhatsAppBusinessAccountGate.PhoneNumber,
+		Status:        status,
+		CreatedAt:     gate.CreatedAtUnixUTCMilli(),
+		UpdatedAt:     gate.UpdatedAtUnixUTCMilli(),
+	}
+}
+
+func toProviderBusinessProfile(profile *BusinessProfile) *impb.ProviderWhatsAppBusinessProfile {
+	return &impb.ProviderWhatsAppBusinessProfile{
+		About:             profile.About,
+		Address:           profile.Address,
+		Description:       profile.Description,
+		Email:             profile.Email,
+		Websites:          profile.Websites,
+		Vertical:          profile.Vertical,
+		ProfilePictureUrl: profile.ProfilePictureURL,
+	}
+}
+
+func toProviderWhatsAppFlow(flow Flow) *impb.ProviderWhatsAppFlow {
+	validationErrors := make([]*impb.ProviderWhatsAppFlowValidationError, 0, len(flow.ValidationErrors))
+	for _, validationError := range flow.ValidationErrors {
+		validationErrors = append(validationErrors, &impb.ProviderWhatsAppFlowValidationError{
+			Error:       validationError.Error,
+			ErrorType:   validationError.ErrorType,
+			Message:     validationError.Message,
+			LineStart:   int32(validationError.LineStart),
+			ColumnStart: int32(validationError.ColumnStart),
+		})
+	}
+
+	return &impb.ProviderWhatsAppFlow{
+		Id:               flow.ID,
+		Name:             flow.Name,
+		Status:           flow.Status,
+		Categories:       flow.Categories,
+		ValidationErrors: validationErrors,
+	}
 }
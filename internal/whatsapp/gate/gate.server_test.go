@@ -0,0 +1,213 @@
+package gate
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	impb "github.com/webitel/im-providers-service/gen/go/provider/v1"
+	"github.com/webitel/im-providers-service/infra/auth"
+	"github.com/webitel/im-providers-service/internal/whatsapp/analytics"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type fakePhoneNumberEditor struct {
+	registered map[uuid.UUID]string
+	update     *BusinessProfileUpdate
+	profile    BusinessProfile
+}
+
+func (editor *fakePhoneNumberEditor) RegisterPhoneNumber(_ context.Context, id uuid.UUID, pin string) error {
+	if editor.registered == nil {
+		editor.registered = make(map[uuid.UUID]string)
+	}
+	editor.registered[id] = pin
+	return nil
+}
+
+func (editor *fakePhoneNumberEditor) DeregisterPhoneNumber(_ context.Context, id uuid.UUID) error {
+	delete(editor.registered, id)
+	return nil
+}
+
+func (editor *fakePhoneNumberEditor) SetTwoStepVerificationPIN(_ context.Context, id uuid.UUID, pin string) error {
+	return editor.RegisterPhoneNumber(context.Background(), id, pin)
+}
+
+func (editor *fakePhoneNumberEditor) GetBusinessProfile(context.Context, uuid.UUID) (*BusinessProfile, error) {
+	profile := editor.profile
+	return &profile, nil
+}
+
+func (editor *fakePhoneNumberEditor) UpdateBusinessProfile(_ context.Context, _ uuid.UUID, update *BusinessProfileUpdate) (*BusinessProfile, error) {
+	editor.update = update
+	if update.About != nil {
+		editor.profile.About = *update.About
+	}
+	profile := editor.profile
+	return &profile, nil
+}
+
+func TestServer_RegisterWhatsAppPhoneNumber(t *testing.T) {
+	phones := &fakePhoneNumberEditor{}
+	server := newWhatsAppBusinessAccountServer(nil, phones, nil, nil)
+	id := uuid.New()
+
+	if _, err := server.RegisterWhatsAppPhoneNumber(context.Background(), &impb.ProviderRegisterWhatsAppPhoneNumberRequest{Id: id.String(), Pin: "123456"}); err != nil {
+		t.Fatalf("RegisterWhatsAppPhoneNumber: %v", err)
+	}
+	if phones.registered[id] != "123456" {
+		t.Errorf("registered = %v", phones.registered)
+	}
+
+	if _, err := server.DeregisterWhatsAppPhoneNumber(context.Background(), &impb.ProviderDeregisterWhatsAppPhoneNumberRequest{Id: id.String()}); err != nil {
+		t.Fatalf("DeregisterWhatsAppPhoneNumber: %v", err)
+	}
+	if _, ok := phones.registered[id]; ok {
+		t.Error("phone number still registered")
+	}
+}
+
+func TestServer_PhoneNumberMethodsRejectInvalidGateID(t *testing.T) {
+	server := newWhatsAppBusinessAccountServer(nil, &fakePhoneNumberEditor{}, nil, nil)
+	ctx := context.Background()
+
+	calls := map[string]func() error{
+		"register": func() error {
+			_, err := server.RegisterWhatsAppPhoneNumber(ctx, &impb.ProviderRegisterWhatsAppPhoneNumberRequest{Id: "gate", Pin: "123456"})
+			return err
+		},
+		"pin": func() error {
+			_, err := server.SetWhatsAppTwoStepVerificationPin(ctx, &impb.ProviderSetWhatsAppTwoStepVerificationPinRequest{Id: "gate", Pin: "123456"})
+			return err
+		},
+		"profile": func() error {
+			_, err := server.GetWhatsAppBusinessProfile(ctx, &impb.ProviderGetWhatsAppBusinessProfileRequest{})
+			return err
+		},
+	}
+	for name, call := range calls {
+		if err := call(); status.Code(err) != codes.InvalidArgument {
+			t.Errorf("%s: want InvalidArgument, got %v", name, err)
+		}
+	}
+}
+
+func TestServer_UpdateWhatsAppBusinessProfile(t *testing.T) {
+	phones := &fakePhoneNumberEditor{profile: BusinessProfile{Email: "shop@example.com", Vertical: "RETAIL"}}
+	server := newWhatsAppBusinessAccountServer(nil, phones, nil, nil)
+
+	about := "Open 9 to 5"
+	response, err := server.UpdateWhatsAppBusinessProfile(context.Background(), &impb.ProviderUpdateWhatsAppBusinessProfileRequest{
+		Id:             uuid.NewString(),
+		About:          &about,
+		ProfilePicture: &impb.ProviderWhatsAppProfilePicture{MimeType: "image/png", Content: []byte("png")},
+	})
+	if err != nil {
+		t.Fatalf("UpdateWhatsAppBusinessProfile: %v", err)
+	}
+
+	update := phones.update
+	if update.About == nil || *update.About != about {
+		t.Errorf("about = %v", update.About)
+	}
+	if update.Email != nil || update.Vertical != nil || update.Websites != nil {
+		t.Errorf("unset fields must stay nil: %+v", update)
+	}
+	if update.ProfilePicture == nil || update.ProfilePicture.MimeType != "image/png" || string(update.ProfilePicture.Content) != "png" {
+		t.Errorf("profile picture = %+v", update.ProfilePicture)
+	}
+
+	profile := response.GetProfile()
+	if profile.GetAbout() != about || profile.GetEmail() != "shop@example.com" || profile.GetVertical() != "RETAIL" {
+		t.Errorf("profile = %+v", profile)
+	}
+}
+
+type fakeFlowLister []Flow
+
+func (flows fakeFlowLister) ListFlows(context.Context, uuid.UUID) ([]Flow, error) {
+	return flows, nil
+}
+
+func TestServer_ListWhatsAppFlows(t *testing.T) {
+	server := newWhatsAppBusinessAccountServer(nil, nil, fakeFlowLister{
+		{ID: "1234", Name: "Booking", Status: "PUBLISHED", Categories: []string{"APPOINTMENT_BOOKING"}},
+		{ID: "5678", Name: "Lead form", Status: "DRAFT", ValidationErrors: []FlowValidationError{
+			{Error: "INVALID_PROPERTY", ErrorType: "FLOW_JSON_ERROR", Message: "Invalid property", LineStart: 10, ColumnStart: 3},
+		}},
+	}, nil)
+
+	response, err := server.ListWhatsAppFlows(context.Background(), &impb.ProviderListWhatsAppFlowsRequest{Id: uuid.NewString()})
+	if err != nil {
+		t.Fatalf("ListWhatsAppFlows: %v", err)
+	}
+
+	items := response.GetItems()
+	if len(items) != 2 || items[0].GetName() != "Booking" || items[0].GetCategories()[0] != "APPOINTMENT_BOOKING" {
+		t.Fatalf("items = %+v", items)
+	}
+	if validationErrors := items[1].GetValidationErrors(); len(validationErrors) != 1 || validationErrors[0].GetLineStart() != 10 || validationErrors[0].GetColumnStart() != 3 {
+		t.Errorf("validation errors = %+v", validationErrors)
+	}
+}
+
+type domainIdentity int64
+
+func (identity domainIdentity) GetContactID() string { return "" }
+func (identity domainIdentity) GetDomainID() int64   { return int64(identity) }
+func (identity domainIdentity) GetName() string      { return "" }
+
+type fakeUsageReporter struct {
+	query analytics.UsageQuery
+	usage []analytics.Usage
+}
+
+func (reporter *fakeUsageReporter) Usage(_ context.Context, query analytics.UsageQuery) ([]analytics.Usage, error) {
+	reporter.query = query
+	return reporter.usage, nil
+}
+
+func TestServer_GetWhatsAppUsage(t *testing.T) {
+	gateID := uuid.New()
+	day := time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC)
+	reporter := &fakeUsageReporter{usage: []analytics.Usage{
+		{GateID: gateID, Category: "marketing", Day: day, Conversations: 3, BillableConversations: 2, Messages: 7, BillableMessages: 5},
+	}}
+	server := newWhatsAppBusinessAccountServer(nil, nil, nil, reporter)
+	ctx := context.WithValue(context.Background(), auth.AuthContextKey, domainIdentity(42))
+
+	response, err := server.GetWhatsAppUsage(ctx, &impb.ProviderGetWhatsAppUsageRequest{
+		GateIds:  []string{gateID.String()},
+		Category: "marketing",
+		From:     day.UnixMilli(),
+		To:       day.AddDate(0, 0, 7).UnixMilli(),
+	})
+	if err != nil {
+		t.Fatalf("GetWhatsAppUsage: %v", err)
+	}
+
+	query := reporter.query
+	if query.DomainID != 42 || len(query.GateIDs) != 1 || query.GateIDs[0] != gateID || query.Category != "marketing" {
+		t.Errorf("query = %+v", query)
+	}
+	if !query.From.Equal(day) || !query.To.Equal(day.AddDate(0, 0, 7)) {
+		t.Errorf("period = [%v, %v)", query.From, query.To)
+	}
+
+	items := response.GetItems()
+	if len(items) != 1 || items[0].GetGateId() != gateID.String() || items[0].GetDay() != day.UnixMilli() || items[0].GetBillableMessages() != 5 {
+		t.Errorf("items = %+v", items)
+	}
+}
+
+func TestServer_GetWhatsAppUsage_RequiresSession(t *testing.T) {
+	server := newWhatsAppBusinessAccountServer(nil, nil, nil, &fakeUsageReporter{})
+
+	_, err := server.GetWhatsAppUsage(context.Background(), &impb.ProviderGetWhatsAppUsageRequest{})
+	if status.Code(err) != codes.Unauthenticated {
+		t.Errorf("code = %v, want Unauthenticated", status.Code(err))
+	}
+}
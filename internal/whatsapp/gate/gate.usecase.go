@@ -5,6 +5,7 @@ import (
 	"log/slog"
 	"net/http"
 
+	"github.com/google/uuid"
 	"github.com/webitel/im-providers-service/infra/auth"
 	"github.com/webitel/im-providers-service/internal/whatsapp/client"
 	"github.com/webitel/im-providers-service/internal/whatsapp/common"
@@ -19,6 +20,14 @@ type InternalContactResolver interface {
 
 type WABAGateRepository interface {
 	Save(ctx context.Context, wabaGate *Gate) (*Gate, error)
+	Get(ctx context.Context, dc int64, id uuid.UUID) (*Gate, error)
+	Update(ctx context.Context, update *GateUpdate) (*Gate, error)
+	Delete(ctx context.Context, dc int64, id uuid.UUID) (*Gate, error)
+	CountByBusinessID(ctx context.Context, businessID string) (int, error)
+	GetMetaAppExternalID(ctx context.Context, metaAppID uuid.UUID) (string, error)
+	RefreshAccessToken(ctx context.Context, dc int64, phoneNumberID string, encryptedToken []byte) (string, error)
+	UpdateHealth(ctx context.Context, update *HealthUpdate) ([]WhatsAppBusinessAccountGate, error)
+	SetQualityRating(ctx context.Context, id uuid.UUID, qualityRating, messagingLimitTier string) error
 }
 
 type gate struct {
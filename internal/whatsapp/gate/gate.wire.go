@@ -39,18 +39,23 @@ func (contactClient *contactClientAdapter) Search(ctx context.Context, peer Peer
 }
 
 type gateModule struct {
-	GateServer *whatsAppBusinessAccountServer
+	GateServer  *whatsAppBusinessAccountServer
+	Provisioner *gate
+	// HealthUpdater applies the quality and account updates of the webhooks.
+	HealthUpdater *gate
 }
 
-func NewGateModule(logger *slog.Logger, db postgresx.DB, client *imgateway.Client, encryptor crypto.Encryptor) *gateModule {
+func NewGateModule(logger *slog.Logger, db postgresx.DB, client *imgateway.Client, encryptor crypto.Encryptor, usage UsageReporter) *gateModule {
 	var (
 		gateRepository        = newGateRepository(db)
 		internalContactClient = newContactClientAdapter(client)
 		gateEditor            = newGate(logger, gateRepository, internalContactClient, encryptor)
-		gateGRPCServer        = newWhatsAppBusinessAccountServer(gateEditor)
+		gateGRPCServer        = newWhatsAppBusinessAccountServer(gateEditor, gateEditor, gateEditor, usage)
 	)
 
 	return &gateModule{
-		GateServer: gateGRPCServer,
+		GateServer:    gateGRPCServer,
+		Provisioner:   gateEditor,
+		HealthUpdater: gateEditor,
 	}
 }
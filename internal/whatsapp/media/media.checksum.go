@@ -0,0 +1,59 @@
+package media
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"hash"
+	"io"
+
+	"github.com/webitel/webitel-go-kit/pkg/errors"
+	"google.golang.org/grpc/codes"
+)
+
+var ErrMediaChecksumMismatch = errors.New("downloaded media does not match its sha256", errors.WithCode(codes.DataLoss), errors.WithID("whatsapp.media.checksum"))
+
+// decodeSHA256 accepts the base64 digest of webhook notifications and the hex digest of the Media API.
+func decodeSHA256(digest string) ([]byte, bool) {
+	if decoded, err := base64.StdEncoding.DecodeString(digest); err == nil && len(decoded) == sha256.Size {
+		return decoded, true
+	}
+
+	if decoded, err := hex.DecodeString(digest); err == nil && len(decoded) == sha256.Size {
+		return decoded, true
+	}
+
+	return nil, false
+}
+
+// checksumReader hashes the media while it is read and fails the final read
+// with ErrMediaChecksumMismatch instead of io.EOF when the digest differs.
+type checksumReader struct {
+	io.ReadCloser
+
+	hash hash.Hash
+	want []byte
+}
+
+// verifySHA256 wraps the media body with a sha256 check. A body with an empty
+// or undecodable digest is returned as is.
+func verifySHA256(body io.ReadCloser, digest string) io.ReadCloser {
+	want, ok := decodeSHA256(digest)
+	if !ok {
+		return body
+	}
+
+	return &checksumReader{ReadCloser: body, hash: sha256.New(), want: want}
+}
+
+func (reader *checksumReader) Read(p []byte) (int, error) {
+	n, err := reader.ReadCloser.Read(p)
+	reader.hash.Write(p[:n])
+
+	if err == io.EOF && !bytes.Equal(reader.hash.Sum(nil), reader.want) {
+		return n, ErrMediaChecksumMismatch
+	}
+
+	return n, err
+}
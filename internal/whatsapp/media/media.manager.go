@@ -25,11 +25,12 @@ type MediaMetadata struct {
 }
 
 type MediaManager struct {
-	client client.RequestClient
+	client      client.RequestClient
+	retryPolicy client.RetryPolicy
 }
 
-func NewMediaManager(client client.RequestClient) *MediaManager {
-	return &MediaManager{client: client}
+func NewMediaManager(requestClient client.RequestClient) *MediaManager {
+	return &MediaManager{client: requestClient, retryPolicy: client.DefaultRetryPolicy}
 }
 
 // https://developers.facebook.com/documentation/business-messaging/whatsapp/business-phone-numbers/media/#get-media-url
@@ -40,7 +41,7 @@ func (mediaManager *MediaManager) GetMediaURLByID(ctx context.Context, id string
 
 	raw, err := apiRequest.ExecuteWithContext(ctx)
 	if err != nil {
-		return "", errors.New("executing get media url by id request", errors.WithCause(err), errors.WithID("whatsapp.media.manager.get_media_url_by_id"))
+		return "", errors.Wrap(err, errors.WithID("whatsapp.media.manager.get_media_url_by_id"), errors.WithValue("id", id))
 	}
 
 	var res MediaMetadata
@@ -62,26 +63,31 @@ type DeleteSuccessResponse struct {
 // https://developers.facebook.com/documentation/business-messaging/whatsapp/business-phone-numbers/media/#delete-media
 // Use the Media API to delete a media asset.
 func (mediaManager *MediaManager) DeleteMedia(ctx context.Context, id string) (bool, error) {
-	apiRequest := mediaManager.client.NewApiRequest(
-		strings.Join([]string{"media", id}, "/"),
-		http.MethodDelete,
-	)
+	err := mediaManager.retryPolicy.Do(ctx, func(ctx context.Context) error {
+		apiRequest := mediaManager.client.NewApiRequest(
+			strings.Join([]string{"media", id}, "/"),
+			http.MethodDelete,
+		)
+
+		// Graph API errors are already retried by the request client
+		raw, err := apiRequest.ExecuteWithContext(ctx)
+		if err != nil {
+			return client.Permanent(errors.Wrap(err, errors.WithID("whatsapp.media.manager.delete_media"), errors.WithValue("id", id)))
+		}
 
-	raw, err := apiRequest.ExecuteWithContext(ctx) //TODO: make retriable
-	if err != nil {
-		return false, errors.New("executing delete media request", errors.WithCause(err), errors.WithID("whatsapp.media.manager.delete_media"), errors.WithValue("id", id))
-	}
+		var deleteSuccessResponse DeleteSuccessResponse
+		if err := json.Unmarshal([]byte(raw), &deleteSuccessResponse); err != nil {
+			return client.Permanent(errors.Internal("unmarshaling raw to delete success response", errors.WithCause(err), errors.WithID("whatsapp.media.manager.delete_media"), errors.WithValue("id", id)))
+		}
 
-	var deleteSuccessResponse DeleteSuccessResponse
-	if err := json.Unmarshal([]byte(raw), &deleteSuccessResponse); err != nil {
-		return false, errors.Internal("unmarshaling raw to delete success response", errors.WithCause(err), errors.WithID("whatsapp.media.manager.delete_media"), errors.WithValue("id", id))
-	}
+		if !deleteSuccessResponse.Success {
+			return errors.New("media deletion", errors.WithID("whatsapp.media.manager.delete_media"), errors.WithValue("id", id), errors.WithValue("response", raw))
+		}
 
-	if !deleteSuccessResponse.Success { //TODO: make retriable with exponential backoff?
-		return false, errors.New("media deletion", errors.WithID("whatsapp.media.manager.delete_media"))
-	}
+		return nil
+	})
 
-	return deleteSuccessResponse.Success, nil
+	return err == nil, err
 }
 
 // https://developers.facebook.com/documentation/business-messaging/whatsapp/business-phone-numbers/media/#upload-media
@@ -144,17 +150,67 @@ func (mediaManager *MediaManager) UploadMedia(ctx context.Context, file io.Reade
 // In that case, try to get a new media URL and download it again.
 // If doing so doesn’t resolve the issue, renew your access token and attempt to download the media asset again.
 func (mediaManager *MediaManager) DownloadMedia(ctx context.Context, id string) (io.ReadCloser, string, error) {
-	url, err := mediaManager.GetMediaURLByID(ctx, id)
+	mediaBody, mimeType, err := mediaManager.FetchMedia(ctx, id, "", "")
 	if err != nil {
 		return nil, "", errors.Wrap(err, errors.WithID("whatsapp.media.manager.download_media"))
 	}
 
-	mediaBody, mimeType, err := mediaManager.DownloadMediaByURL(ctx, url)
+	return mediaBody, mimeType, nil
+}
+
+// FetchMedia downloads a received media asset. The URL from the webhook is tried first,
+// and a fresh one is retrieved by media ID when it is missing or has expired (401, 403 or 404).
+// Transient failures are retried with backoff. With a non-empty sha256, the last read of
+// the returned body fails with ErrMediaChecksumMismatch when the content does not match.
+func (mediaManager *MediaManager) FetchMedia(ctx context.Context, id, url, sha256 string) (io.ReadCloser, string, error) {
+	var (
+		mediaBody io.ReadCloser
+		mimeType  string
+	)
+
+	err := mediaManager.retryPolicy.Do(ctx, func(ctx context.Context) error {
+		resolved := false
+		if url == "" {
+			if id == "" {
+				return client.Permanent(errors.InvalidArgument("media id or url is required", errors.WithID("whatsapp.media.manager.fetch_media")))
+			}
+
+			// Graph API errors are already retried by the request client
+			freshURL, err := mediaManager.GetMediaURLByID(ctx, id)
+			if err != nil {
+				return client.Permanent(err)
+			}
+			url, resolved = freshURL, true
+		}
+
+		var err error
+		mediaBody, mimeType, err = mediaManager.DownloadMediaByURL(ctx, url)
+		if err == nil {
+			return nil
+		}
+
+		var statusErr *client.MediaDownloadStatusError
+		if !errors.As(err, &statusErr) {
+			return err
+		}
+
+		switch {
+		case statusErr.Expired() && !resolved && id != "":
+			// the next attempt retrieves a fresh URL by media ID
+			url = ""
+			return err
+		case statusErr.Temporary():
+			return err
+		default:
+			return client.Permanent(err)
+		}
+	})
+
 	if err != nil {
-		return nil, "", errors.Wrap(err, errors.WithID("whatsapp.media.manager.download_media"))
+		return nil, "", errors.Wrap(err, errors.WithID("whatsapp.media.manager.fetch_media"), errors.WithValue("id", id))
 	}
 
-	return mediaBody, mimeType, nil
+	return verifySHA256(mediaBody, sha256), mimeType, nil
 }
 
 func (mediaManager *MediaManager) DownloadMediaByURL(ctx context.Context, url string) (io.ReadCloser, string, error) {
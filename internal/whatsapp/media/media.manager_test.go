@@ -0,0 +1,144 @@
+package media
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/webitel/im-providers-service/internal/whatsapp/client"
+	"github.com/webitel/webitel-go-kit/pkg/errors"
+)
+
+var testMedia = []byte("\x89PNG media content")
+
+func newTestMediaManager(t *testing.T) *MediaManager {
+	t.Helper()
+
+	requestClient, err := client.NewRequesClient(client.WithAccessTokenConfig("token"))
+	if err != nil {
+		t.Fatalf("NewRequesClient: %v", err)
+	}
+
+	mediaManager := NewMediaManager(*requestClient)
+	mediaManager.retryPolicy = client.RetryPolicy{Attempts: 3, InitialDelay: time.Millisecond, MaxDelay: time.Millisecond}
+	return mediaManager
+}
+
+// newMediaServer responds with the given statuses in turn and with the media once they run out.
+func newMediaServer(t *testing.T, statuses ...int) (*httptest.Server, *atomic.Int32) {
+	t.Helper()
+
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		call := int(calls.Add(1))
+		if r.Header.Get("Authorization") != "Bearer token" {
+			t.Errorf("unexpected authorization header %q", r.Header.Get("Authorization"))
+		}
+
+		if call <= len(statuses) {
+			w.WriteHeader(statuses[call-1])
+			return
+		}
+
+		w.Header().Set("Content-Type", "image/png")
+		w.Write(testMedia)
+	}))
+	t.Cleanup(server.Close)
+
+	return server, &calls
+}
+
+func TestMediaManager_FetchMedia(t *testing.T) {
+	digest := sha256.Sum256(testMedia)
+
+	tests := []struct {
+		name      string
+		statuses  []int
+		sha256    string
+		wantCalls int32
+		wantErr   bool
+		wantRead  error
+	}{
+		{name: "base64 digest", sha256: base64.StdEncoding.EncodeToString(digest[:]), wantCalls: 1},
+		{name: "hex digest", sha256: hex.EncodeToString(digest[:]), wantCalls: 1},
+		{name: "no digest", wantCalls: 1},
+		{name: "digest mismatch", sha256: base64.StdEncoding.EncodeToString(make([]byte, sha256.Size)), wantCalls: 1, wantRead: ErrMediaChecksumMismatch},
+		{name: "transient failures", statuses: []int{http.StatusServiceUnavailable, http.StatusTooManyRequests}, wantCalls: 3},
+		{name: "retries exhausted", statuses: []int{http.StatusBadGateway, http.StatusBadGateway, http.StatusBadGateway}, wantCalls: 3, wantErr: true},
+		{name: "bad request", statuses: []int{http.StatusBadRequest}, wantCalls: 1, wantErr: true},
+		{name: "expired without media id", statuses: []int{http.StatusNotFound}, wantCalls: 1, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server, calls := newMediaServer(t, tt.statuses...)
+
+			body, mimeType, err := newTestMediaManager(t).FetchMedia(context.Background(), "", server.URL, tt.sha256)
+			if got := calls.Load(); got != tt.wantCalls {
+				t.Errorf("download calls = %d, want %d", got, tt.wantCalls)
+			}
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("FetchMedia() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			defer body.Close()
+
+			if mimeType != "image/png" {
+				t.Errorf("mime type = %q", mimeType)
+			}
+
+			content, err := io.ReadAll(body)
+			if !errors.Is(err, tt.wantRead) {
+				t.Fatalf("reading media: error = %v, want %v", err, tt.wantRead)
+			}
+			if string(content) != string(testMedia) {
+				t.Errorf("content = %q", content)
+			}
+		})
+	}
+}
+
+func TestMediaManager_FetchMedia_ExpiredURL(t *testing.T) {
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/expired":
+			w.WriteHeader(http.StatusNotFound)
+		case "/" + client.APIVersion + "/media-1":
+			w.Write([]byte(`{"messaging_product":"whatsapp","url":"` + server.URL + `/fresh","id":"media-1"}`))
+		case "/fresh":
+			w.Header().Set("Content-Type", "image/png")
+			w.Write(testMedia)
+		default:
+			t.Errorf("unexpected request %s", r.URL.Path)
+			w.WriteHeader(http.StatusBadRequest)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	requestClient, err := client.NewRequesClient(client.WithAccessTokenConfig("token"), client.WithBaseURLConfig(server.URL))
+	if err != nil {
+		t.Fatalf("NewRequesClient: %v", err)
+	}
+	mediaManager := NewMediaManager(*requestClient)
+	mediaManager.retryPolicy = client.RetryPolicy{Attempts: 3, InitialDelay: time.Millisecond, MaxDelay: time.Millisecond}
+
+	body, _, err := mediaManager.FetchMedia(context.Background(), "media-1", server.URL+"/expired", "")
+	if err != nil {
+		t.Fatalf("FetchMedia: %v", err)
+	}
+	defer body.Close()
+
+	if content, _ := io.ReadAll(body); string(content) != string(testMedia) {
+		t.Errorf("content = %q", content)
+	}
+}
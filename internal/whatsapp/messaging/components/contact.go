@@ -161,6 +161,10 @@ func NewContactMessage(configs []Contact) *ContactMessage {
 }
 
 func (m *ContactMessage) ToJson(configs ApiCompatibleJsonConverterConfigs) ([]byte, error) {
+	if err := configs.Validate(); err != nil {
+		return nil, err
+	}
+
 	jsonData := ContactMessageApiPayload{
 		BaseMessagePayload: CreateBaseMessagePayload(configs.SendToPhoneNumber(), MessageTypeContact),
 		Contacts:           m.Contacts,
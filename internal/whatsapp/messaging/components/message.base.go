@@ -13,7 +13,10 @@ const (
 	MessageTypeDocument MessageType = "document"
 	MessageTypeImage    MessageType = "image"
 	MessageTypeLocation MessageType = "location"
-	MessageTypeContact  MessageType = "contact"
+	MessageTypeContact  MessageType = "contacts"
+	MessageTypeReaction MessageType = "reaction"
+	// MessageTypeInteractive is the type of interactive messages, e.g. flow messages.
+	MessageTypeInteractive MessageType = "interactive"
 )
 
 type ApiCompatibleJsonConverterConfigs struct {
@@ -45,7 +48,7 @@ type MessageContext struct {
 }
 
 type BaseMessagePayload struct {
-	MessageContext   *MessageContext `json:"message_context,omitempty"`
+	MessageContext   *MessageContext `json:"context,omitempty"`
 	To               string          `json:"to"`
 	Type             MessageType     `json:"type"`
 	MessagingProduct string          `json:"messaging_product"`
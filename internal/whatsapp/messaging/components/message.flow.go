@@ -0,0 +1,161 @@
+package components
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/webitel/webitel-go-kit/pkg/errors"
+)
+
+// https://developers.facebook.com/docs/whatsapp/flows/guides/sendingaflow
+const (
+	flowMessageVersion = "3"
+
+	FlowActionNavigate     = "navigate"
+	FlowActionDataExchange = "data_exchange"
+
+	FlowModeDraft     = "draft"
+	FlowModePublished = "published"
+)
+
+type flowMessage struct {
+	Body   string
+	FlowID string
+	Token  string
+	CTA    string
+	Screen string
+	Data   map[string]any
+	Mode   string
+}
+
+type FlowMessageConfigs struct {
+	Body   string `json:"body"`
+	FlowID string `json:"flow_id"`
+	Token  string `json:"flow_token"`
+	CTA    string `json:"flow_cta"`
+	// Screen is the first screen of the flow; an empty screen starts the flow with a data exchange request.
+	Screen string         `json:"screen,omitempty"`
+	Data   map[string]any `json:"data,omitempty"`
+	Draft  bool           `json:"draft,omitempty"`
+}
+
+func (configs *FlowMessageConfigs) Validate() error {
+	if configs == nil {
+		return errors.InvalidArgument("configs is required", errors.WithID("message.flow.validate"))
+	}
+
+	if configs.Body == "" || strings.Trim(configs.Body, " ") == "" {
+		return errors.InvalidArgument("flow message body is required", errors.WithID("message.flow.validate"))
+	}
+
+	if configs.FlowID == "" || strings.Trim(configs.FlowID, " ") == "" {
+		return errors.InvalidArgument("flow id is required", errors.WithID("message.flow.validate"))
+	}
+
+	if configs.Token == "" || strings.Trim(configs.Token, " ") == "" {
+		return errors.InvalidArgument("flow token is required", errors.WithID("message.flow.validate"))
+	}
+
+	if configs.CTA == "" || strings.Trim(configs.CTA, " ") == "" {
+		return errors.InvalidArgument("flow call to action is required", errors.WithID("message.flow.validate"))
+	}
+
+	if configs.Screen == "" && len(configs.Data) > 0 {
+		return errors.InvalidArgument("flow data requires a screen to navigate to", errors.WithID("message.flow.validate"))
+	}
+
+	return nil
+}
+
+type FlowMessageApiPayloadActionPayload struct {
+	Screen string         `json:"screen"`
+	Data   map[string]any `json:"data,omitempty"`
+}
+
+type FlowMessageApiPayloadParameters struct {
+	FlowMessageVersion string                              `json:"flow_message_version"`
+	FlowToken          string                              `json:"flow_token"`
+	FlowID             string                              `json:"flow_id"`
+	FlowCTA            string                              `json:"flow_cta"`
+	FlowAction         string                              `json:"flow_action"`
+	Mode               string                              `json:"mode,omitempty"`
+	FlowActionPayload  *FlowMessageApiPayloadActionPayload `json:"flow_action_payload,omitempty"`
+}
+
+type FlowMessageApiPayloadInteractive struct {
+	Type string `json:"type"`
+	Body struct {
+		Text string `json:"text"`
+	} `json:"body"`
+	Action struct {
+		Name       string                          `json:"name"`
+		Parameters FlowMessageApiPayloadParameters `json:"parameters"`
+	} `json:"action"`
+}
+
+type FlowMessageApiPayload struct {
+	BaseMessagePayload `json:",inline"`
+	Interactive        FlowMessageApiPayloadInteractive `json:"interactive"`
+}
+
+func NewFlowMessage(configs FlowMessageConfigs) (*flowMessage, error) {
+	if err := configs.Validate(); err != nil {
+		return nil, err
+	}
+
+	mode := FlowModePublished
+	if configs.Draft {
+		mode = FlowModeDraft
+	}
+
+	return &flowMessage{
+		Body:   configs.Body,
+		FlowID: configs.FlowID,
+		Token:  configs.Token,
+		CTA:    configs.CTA,
+		Screen: configs.Screen,
+		Data:   configs.Data,
+		Mode:   mode,
+	}, nil
+}
+
+func (message *flowMessage) ToJson(configs ApiCompatibleJsonConverterConfigs) ([]byte, error) {
+	if err := configs.Validate(); err != nil {
+		return nil, err
+	}
+
+	parameters := FlowMessageApiPayloadParameters{
+		FlowMessageVersion: flowMessageVersion,
+		FlowToken:          message.Token,
+		FlowID:             message.FlowID,
+		FlowCTA:            message.CTA,
+		FlowAction:         FlowActionDataExchange,
+		Mode:               message.Mode,
+	}
+
+	if message.Screen != "" {
+		parameters.FlowAction = FlowActionNavigate
+		parameters.FlowActionPayload = &FlowMessageApiPayloadActionPayload{Screen: message.Screen, Data: message.Data}
+	}
+
+	jsonData := FlowMessageApiPayload{
+		BaseMessagePayload: CreateBaseMessagePayload(configs.SendingPhoneNumber, MessageTypeInteractive),
+	}
+	jsonData.Interactive.Type = "flow"
+	jsonData.Interactive.Body.Text = message.Body
+	jsonData.Interactive.Action.Name = "flow"
+	jsonData.Interactive.Action.Parameters = parameters
+
+	if configs.ReplyToMessageID() != "" {
+		jsonData.MessageContext = &MessageContext{
+			MessageID: configs.ReplyToMessageID(),
+		}
+	}
+
+	marshalled, err := json.Marshal(jsonData)
+	if err != nil {
+		return nil, errors.Internal("marshaling flow message payload", errors.WithCause(err), errors.WithID("message.flow.to_json"), errors.WithValue("flow_id", message.FlowID))
+	}
+
+	return marshalled, nil
+}
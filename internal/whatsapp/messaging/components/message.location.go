@@ -35,7 +35,11 @@ func (locationMessage *LocationMessage) SetName(name string) *LocationMessage {
 	return locationMessage
 }
 
-func (locationMessage *LocationMessage) ToJSON(configs ApiCompatibleJsonConverterConfigs) ([]byte, error) {
+func (locationMessage *LocationMessage) ToJson(configs ApiCompatibleJsonConverterConfigs) ([]byte, error) {
+	if err := configs.Validate(); err != nil {
+		return nil, err
+	}
+
 	jsonData := LocationMessageAPIPayload{
 		BaseMessagePayload: CreateBaseMessagePayload(configs.SendToPhoneNumber(), MessageTypeLocation),
 		Location:           *locationMessage,
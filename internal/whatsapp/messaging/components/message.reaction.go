@@ -0,0 +1,70 @@
+package components
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/webitel/webitel-go-kit/pkg/errors"
+)
+
+type reactionMessage struct {
+	MessageID string
+	Emoji     string
+}
+
+type ReactionMessageConfigs struct {
+	MessageID string `json:"message_id"`
+	// Emoji is the reaction to set, an empty emoji removes the previous reaction.
+	Emoji string `json:"emoji"`
+}
+
+func (configs *ReactionMessageConfigs) Validate() error {
+	if configs == nil {
+		return errors.InvalidArgument("configs is required", errors.WithID("message.reaction.validate"))
+	}
+
+	if configs.MessageID == "" || strings.Trim(configs.MessageID, " ") == "" {
+		return errors.InvalidArgument("message id is required", errors.WithID("message.reaction.validate"))
+	}
+
+	return nil
+}
+
+type ReactionMessageApiPayloadReaction struct {
+	MessageID string `json:"message_id"`
+	Emoji     string `json:"emoji"`
+}
+
+type ReactionMessageApiPayload struct {
+	BaseMessagePayload `json:",inline"`
+	Reaction           ReactionMessageApiPayloadReaction `json:"reaction"`
+}
+
+func NewReactionMessage(configs ReactionMessageConfigs) (*reactionMessage, error) {
+	if err := configs.Validate(); err != nil {
+		return nil, err
+	}
+
+	return &reactionMessage{MessageID: configs.MessageID, Emoji: configs.Emoji}, nil
+}
+
+func (message *reactionMessage) ToJson(configs ApiCompatibleJsonConverterConfigs) ([]byte, error) {
+	if err := configs.Validate(); err != nil {
+		return nil, err
+	}
+
+	jsonData := ReactionMessageApiPayload{
+		BaseMessagePayload: CreateBaseMessagePayload(configs.SendingPhoneNumber, MessageTypeReaction),
+		Reaction: ReactionMessageApiPayloadReaction{
+			MessageID: message.MessageID,
+			Emoji:     message.Emoji,
+		},
+	}
+
+	marshalled, err := json.Marshal(jsonData)
+	if err != nil {
+		return nil, errors.Internal("marshaling reaction message payload", errors.WithCause(err), errors.WithID("message.reaction.to_json"), errors.WithValue("message_id", message.MessageID))
+	}
+
+	return marshalled, nil
+}
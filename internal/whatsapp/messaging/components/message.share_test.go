@@ -0,0 +1,129 @@
+package components
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestShareMessages_ToJson(t *testing.T) {
+	configs := ApiCompatibleJsonConverterConfigs{SendingPhoneNumber: "380501234567", ReplyToMessage: "wamid.reply"}
+
+	reaction, err := NewReactionMessage(ReactionMessageConfigs{MessageID: "wamid.1", Emoji: "👍"})
+	if err != nil {
+		t.Fatalf("NewReactionMessage: %v", err)
+	}
+
+	tests := []struct {
+		name    string
+		message interface {
+			ToJson(ApiCompatibleJsonConverterConfigs) ([]byte, error)
+		}
+		want string
+	}{
+		{
+			name:    "location",
+			message: NewLocationMessage(50.45, 30.52).SetName("Office"),
+			want:    `{"context":{"message_id":"wamid.reply"},"to":"380501234567","type":"location","messaging_product":"whatsapp","recipient_type":"individual","location":{"latitude":50.45,"longitude":30.52,"name":"Office"}}`,
+		},
+		{
+			name:    "contacts",
+			message: NewContactMessage([]Contact{{Name: ContactName{FormattedName: "Anna"}, Phones: []ContactPhone{{Phone: "+380501234567", Type: CellPhone}}}}),
+			want:    `{"context":{"message_id":"wamid.reply"},"to":"380501234567","type":"contacts","messaging_product":"whatsapp","recipient_type":"individual","contacts":[{"name":{"formatted_name":"Anna"},"org":{},"phones":[{"phone":"+380501234567","type":"CELL"}]}]}`,
+		},
+		{
+			name:    "reaction",
+			message: reaction,
+			want:    `{"to":"380501234567","type":"reaction","messaging_product":"whatsapp","recipient_type":"individual","reaction":{"message_id":"wamid.1","emoji":"👍"}}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			raw, err := tt.message.ToJson(configs)
+			if err != nil {
+				t.Fatalf("ToJson: %v", err)
+			}
+			if !jsonEqual(t, raw, tt.want) {
+				t.Errorf("ToJson() = %s\nwant %s", raw, tt.want)
+			}
+
+			if _, err := tt.message.ToJson(ApiCompatibleJsonConverterConfigs{}); err == nil {
+				t.Error("ToJson without a phone number: want error")
+			}
+		})
+	}
+}
+
+func TestNewReactionMessage_RequiresMessageID(t *testing.T) {
+	if _, err := NewReactionMessage(ReactionMessageConfigs{Emoji: "👍"}); err == nil {
+		t.Error("want error for a reaction without message id")
+	}
+}
+
+func jsonEqual(t *testing.T, got []byte, want string) bool {
+	t.Helper()
+
+	var gotValue, wantValue any
+	if err := json.Unmarshal(got, &gotValue); err != nil {
+		t.Fatalf("unmarshal result: %v", err)
+	}
+	if err := json.Unmarshal([]byte(want), &wantValue); err != nil {
+		t.Fatalf("unmarshal want: %v", err)
+	}
+
+	gotRaw, _ := json.Marshal(gotValue)
+	wantRaw, _ := json.Marshal(wantValue)
+	return string(gotRaw) == string(wantRaw)
+}
+
+func TestFlowMessage_ToJson(t *testing.T) {
+	configs := ApiCompatibleJsonConverterConfigs{SendingPhoneNumber: "380501234567"}
+
+	tests := []struct {
+		name    string
+		configs FlowMessageConfigs
+		want    string
+	}{
+		{
+			name:    "navigate",
+			configs: FlowMessageConfigs{Body: "Book a visit", FlowID: "1234", Token: "booking-7f3a", CTA: "Book", Screen: "SLOTS", Data: map[string]any{"branch": "kyiv"}},
+			want:    `{"to":"380501234567","type":"interactive","messaging_product":"whatsapp","recipient_type":"individual","interactive":{"type":"flow","body":{"text":"Book a visit"},"action":{"name":"flow","parameters":{"flow_message_version":"3","flow_token":"booking-7f3a","flow_id":"1234","flow_cta":"Book","flow_action":"navigate","mode":"published","flow_action_payload":{"screen":"SLOTS","data":{"branch":"kyiv"}}}}}}`,
+		},
+		{
+			name:    "draft data exchange",
+			configs: FlowMessageConfigs{Body: "Leave your contacts", FlowID: "5678", Token: "lead-1", CTA: "Open", Draft: true},
+			want:    `{"to":"380501234567","type":"interactive","messaging_product":"whatsapp","recipient_type":"individual","interactive":{"type":"flow","body":{"text":"Leave your contacts"},"action":{"name":"flow","parameters":{"flow_message_version":"3","flow_token":"lead-1","flow_id":"5678","flow_cta":"Open","flow_action":"data_exchange","mode":"draft"}}}}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			message, err := NewFlowMessage(tt.configs)
+			if err != nil {
+				t.Fatalf("NewFlowMessage: %v", err)
+			}
+
+			raw, err := message.ToJson(configs)
+			if err != nil {
+				t.Fatalf("ToJson: %v", err)
+			}
+			if !jsonEqual(t, raw, tt.want) {
+				t.Errorf("ToJson() = %s\nwant %s", raw, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewFlowMessage_Validate(t *testing.T) {
+	for name, configs := range map[string]FlowMessageConfigs{
+		"no body":             {FlowID: "1", Token: "t", CTA: "Open"},
+		"no flow id":          {Body: "b", Token: "t", CTA: "Open"},
+		"no token":            {Body: "b", FlowID: "1", CTA: "Open"},
+		"no cta":              {Body: "b", FlowID: "1", Token: "t"},
+		"data without screen": {Body: "b", FlowID: "1", Token: "t", CTA: "Open", Data: map[string]any{"k": "v"}},
+	} {
+		if _, err := NewFlowMessage(configs); err == nil {
+			t.Errorf("%s: want error", name)
+		}
+	}
+}
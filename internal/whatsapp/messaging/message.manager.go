@@ -9,7 +9,6 @@ import (
 	"github.com/webitel/im-providers-service/internal/whatsapp/client"
 	"github.com/webitel/im-providers-service/internal/whatsapp/messaging/components"
 	"github.com/webitel/webitel-go-kit/pkg/errors"
-	"google.golang.org/grpc/codes"
 )
 
 type ApiCompatibleJsonConverterConfigs interface {
@@ -46,41 +45,8 @@ type MessageSendResponse struct {
 	Error *MessageSendError `json:"error,omitempty"`
 }
 
-type MessageSendError struct {
-	Message   string `json:"message"`
-	Type      string `json:"type"`
-	Code      int    `json:"code"`
-	ErrorData struct {
-		MessagingProduct string `json:"messaging_product"`
-		Details          string `json:"details"`
-	} `json:"error_data"`
-	ErrorSubcode int    `json:"error_subcode"`
-	FbtraceID    string `json:"fbtrace_id"`
-}
-
-func (messageSendError MessageSendError) ToGRPCError() error {
-	var code codes.Code
-	switch messageSendError.Code {
-	case 190:
-		code = codes.Unauthenticated
-	case 100:
-		code = codes.InvalidArgument
-	case 4, 17, 80007:
-		code = codes.ResourceExhausted
-	case 10:
-		code = codes.PermissionDenied
-	default:
-		code = codes.Internal
-	}
-
-	return errors.New(
-		messageSendError.Message,
-		errors.WithCode(code),
-		errors.WithValue("fbtrace_id", messageSendError.FbtraceID),
-		errors.WithValue("meta_code", messageSendError.Code),
-		errors.WithValue("meta_subcode", messageSendError.ErrorSubcode),
-	)
-}
+// MessageSendError is the error object of Graph API responses.
+type MessageSendError = client.MessageSendError
 
 type StatusResponse struct {
 	Success bool              `json:"success"`
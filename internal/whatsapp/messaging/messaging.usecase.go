@@ -5,12 +5,14 @@ import (
 	"fmt"
 	"log/slog"
 
+	"github.com/google/uuid"
 	"github.com/webitel/im-providers-service/gen/go/gateway/v1"
 	imgateway "github.com/webitel/im-providers-service/infra/client/grpc/im-gateway"
 	"github.com/webitel/im-providers-service/internal/core/model"
 	"github.com/webitel/im-providers-service/internal/whatsapp/common"
 	"github.com/webitel/im-providers-service/internal/whatsapp/messaging/components"
 	"github.com/webitel/webitel-go-kit/pkg/errors"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
 )
 
@@ -24,15 +26,22 @@ type WhatsAppBusinessAccountResolver interface {
 	Resolve(ctx context.Context, query ResolveWhatsAppBusinessAccountQuery) (*WhatsAppBusinessAccount, error)
 }
 
+// SentMessageRecorder keeps the internal ID of sent messages by wamid, so replies to them
+// can be reported against the internal message.
+type SentMessageRecorder interface {
+	RecordSentMessage(ctx context.Context, wamid, messageID string) error
+}
+
 type Messaging struct {
 	logger                          *slog.Logger
 	encryptor                       common.Encryptor
 	whatsAppBusinessAccountResolver WhatsAppBusinessAccountResolver
 	gatewayClient                   *imgateway.Client
+	sentMessageRecorder             SentMessageRecorder
 }
 
-func newMessaging(logger *slog.Logger, encryptor common.Encryptor, whatsAppBusinessAccountResolver WhatsAppBusinessAccountResolver, gatewayClient *imgateway.Client) *Messaging {
-	return &Messaging{logger: logger, encryptor: encryptor, whatsAppBusinessAccountResolver: whatsAppBusinessAccountResolver, gatewayClient: gatewayClient}
+func newMessaging(logger *slog.Logger, encryptor common.Encryptor, whatsAppBusinessAccountResolver WhatsAppBusinessAccountResolver, gatewayClient *imgateway.Client, sentMessageRecorder SentMessageRecorder) *Messaging {
+	return &Messaging{logger: logger, encryptor: encryptor, whatsAppBusinessAccountResolver: whatsAppBusinessAccountResolver, gatewayClient: gatewayClient, sentMessageRecorder: sentMessageRecorder}
 }
 
 func (messaging *Messaging) prepareMessageManagerFromBusinessAccount(businessAccount *WhatsAppBusinessAccount) (*MessageManager, error) {
@@ -169,6 +178,8 @@ func (messaging *Messaging) SendText(ctx context.Context, req *model.Message) (*
 		sendMessageID = response.Messages[0].ID
 	}
 
+	messaging.recordSentMessage(ctx, req, sendMessageID)
+
 	return &model.MessageResponse{ID: sendMessageID}, nil
 }
 
@@ -201,6 +212,8 @@ func (messaging *Messaging) SendImage(ctx context.Context, req *model.Message) (
 		sendMessageID = response.Messages[0].ID
 	}
 
+	messaging.recordSentMessage(ctx, req, sendMessageID)
+
 	return &model.MessageResponse{ID: sendMessageID}, nil
 }
 
@@ -234,5 +247,125 @@ func (messaging *Messaging) SendDocument(ctx context.Context, req *model.Message
 		sendMessageID = response.Messages[0].ID
 	}
 
+	messaging.recordSentMessage(ctx, req, sendMessageID)
+
+	return &model.MessageResponse{ID: sendMessageID}, nil
+}
+
+func (messaging *Messaging) SendLocation(ctx context.Context, req *model.Message) (*model.MessageResponse, error) {
+	locationMessage := components.NewLocationMessage(req.Location.Latitude, req.Location.Longitude).
+		SetName(req.Location.Name).
+		SetAddress(req.Location.Address)
+
+	return messaging.sendOutbound(ctx, req, locationMessage, "messaging.usecase.send_location")
+}
+
+func (messaging *Messaging) SendContact(ctx context.Context, req *model.Message) (*model.MessageResponse, error) {
+	contacts := make([]components.Contact, 0, len(req.Contacts))
+	for _, card := range req.Contacts {
+		contacts = append(contacts, contactFromCard(card))
+	}
+
+	return messaging.sendOutbound(ctx, req, components.NewContactMessage(contacts), "messaging.usecase.send_contact")
+}
+
+func (messaging *Messaging) SendReaction(ctx context.Context, req *model.Message) (*model.MessageResponse, error) {
+	reactionMessage, err := components.NewReactionMessage(components.ReactionMessageConfigs{
+		MessageID: req.Reaction.MessageID,
+		Emoji:     req.Reaction.Emoji,
+	})
+
+	if err != nil {
+		return nil, errors.Wrap(err, errors.WithID("messaging.usecase.send_reaction"))
+	}
+
+	return messaging.sendOutbound(ctx, req, reactionMessage, "messaging.usecase.send_reaction")
+}
+
+// SendInteractive sends the flow of an interactive message. WhatsApp reply buttons and lists are not supported yet.
+func (messaging *Messaging) SendInteractive(ctx context.Context, req *model.Message) (*model.MessageResponse, error) {
+	if req.Interactive == nil || req.Interactive.Flow == nil {
+		return nil, errors.New("only flow interactive messages are supported by whatsapp", errors.WithCode(codes.Unimplemented), errors.WithID("messaging.usecase.send_interactive"))
+	}
+
+	flow := req.Interactive.Flow
+	token := flow.Token
+	if token == "" && req.ID != uuid.Nil {
+		token = req.ID.String()
+	}
+
+	flowMessage, err := components.NewFlowMessage(components.FlowMessageConfigs{
+		Body:   req.Text,
+		FlowID: flow.FlowID,
+		Token:  token,
+		CTA:    flow.CTA,
+		Screen: flow.Screen,
+		Data:   flow.Data,
+		Draft:  flow.Draft,
+	})
+
+	if err != nil {
+		return nil, errors.Wrap(err, errors.WithID("messaging.usecase.send_interactive"))
+	}
+
+	return messaging.sendOutbound(ctx, req, flowMessage, "messaging.usecase.send_interactive")
+}
+
+// sendOutbound sends an already built message to the recipient of req and returns the wamid of the sent message.
+func (messaging *Messaging) sendOutbound(ctx context.Context, req *model.Message, message BaseMessage, errorID string) (*model.MessageResponse, error) {
+	sendingInfo, err := messaging.prepareOutboundMessageInfo(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := sendingInfo.messageManager.Send(ctx, message, sendingInfo.externalPhoneNumber)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.WithID(errorID))
+	}
+
+	if response.Error != nil {
+		return nil, errors.New("sending whatsapp message", errors.WithCause(response.Error.ToGRPCError()), errors.WithID(errorID))
+	}
+
+	sendMessageID := ""
+	if len(response.Messages) > 0 {
+		sendMessageID = response.Messages[0].ID
+	}
+
+	messaging.recordSentMessage(ctx, req, sendMessageID)
+
 	return &model.MessageResponse{ID: sendMessageID}, nil
 }
+
+// recordSentMessage remembers the internal ID of a sent message. A failure only loses
+// the link between later replies and the message, so it is logged and not returned.
+func (messaging *Messaging) recordSentMessage(ctx context.Context, req *model.Message, wamid string) {
+	if req.ID == uuid.Nil || wamid == "" {
+		return
+	}
+
+	if err := messaging.sentMessageRecorder.RecordSentMessage(ctx, wamid, req.ID.String()); err != nil {
+		messaging.logger.Warn("recording sent whatsapp message", "error", err, "wamid", wamid, "message_id", req.ID.String())
+	}
+}
+
+func contactFromCard(card *model.ContactCard) components.Contact {
+	contact := components.Contact{
+		Name: components.ContactName{
+			FormattedName: card.FormattedName(),
+			FirstName:     card.FirstName,
+			LastName:      card.LastName,
+		},
+		Org: components.ContactOrg{Company: card.Company},
+	}
+
+	for _, phone := range card.Phones {
+		contact.Phones = append(contact.Phones, components.ContactPhone{Phone: phone, Type: components.CellPhone})
+	}
+
+	for _, email := range card.Emails {
+		contact.Emails = append(contact.Emails, components.ContactEmail{Email: email})
+	}
+
+	return contact
+}
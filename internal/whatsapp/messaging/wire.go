@@ -17,10 +17,11 @@ func NewMessagingWire(
 	encryptor common.Encryptor,
 	gatewayClient *imgateway.Client,
 	db postgresx.DB,
+	sentMessageRecorder SentMessageRecorder,
 ) *messagingWire {
 	messagingRepo := newMessagingRepository(db)
 
 	return &messagingWire{
-		Messaging: newMessaging(logger, encryptor, messagingRepo, gatewayClient),
+		Messaging: newMessaging(logger, encryptor, messagingRepo, gatewayClient, sentMessageRecorder),
 	}
 }
@@ -4,11 +4,16 @@ import (
 	"context"
 	"log/slog"
 
+	"github.com/redis/go-redis/v9"
 	"github.com/webitel/im-providers-service/config"
+	imcontact "github.com/webitel/im-providers-service/infra/client/grpc/im-contact"
 	imgateway "github.com/webitel/im-providers-service/infra/client/grpc/im-gateway"
 	"github.com/webitel/im-providers-service/infra/db/postgresx"
 	"github.com/webitel/im-providers-service/internal/core/service"
+	fbservice "github.com/webitel/im-providers-service/internal/facebook/service"
 	"github.com/webitel/im-providers-service/internal/provider"
+	"github.com/webitel/im-providers-service/internal/whatsapp/analytics"
+	"github.com/webitel/im-providers-service/internal/whatsapp/correlation"
 	"github.com/webitel/im-providers-service/internal/whatsapp/gate"
 	"github.com/webitel/im-providers-service/internal/whatsapp/messaging"
 	"github.com/webitel/im-providers-service/internal/whatsapp/resolver"
@@ -21,9 +26,26 @@ var Module = fx.Module(
 	"whatsapp",
 	fx.Provide(ProvideNewPostgresxConnection),
 	fx.Provide(
-		func(logger *slog.Logger, db postgresx.DB, internalContactResolver *imgateway.Client, encryptor crypto.Encryptor) WhatsAppGateServer {
-			gateWire := gate.NewGateModule(logger, db, internalContactResolver, encryptor)
-			return gateWire.GateServer
+		func(logger *slog.Logger, db postgresx.DB) webhook.WhatsAppBusinessAccountResolver {
+			return resolver.NewResolverModule[*webhook.WhatsAppBusinessAccountResolveQuery](logger, db).Resolver
+		},
+	),
+	fx.Provide(
+		func(logger *slog.Logger, db postgresx.DB, internalContactResolver *imgateway.Client, encryptor crypto.Encryptor, usageReporter gate.UsageReporter) (WhatsAppGateServer, fbservice.WhatsAppProvisioner, webhook.GateHealthUpdater) {
+			gateWire := gate.NewGateModule(logger, db, internalContactResolver, encryptor, usageReporter)
+			return gateWire.GateServer, gateWire.Provisioner, gateWire.HealthUpdater
+		},
+	),
+	fx.Provide(
+		func(rdb *redis.Client) (messaging.SentMessageRecorder, webhook.SentMessageFinder) {
+			correlationWire := correlation.NewCorrelationModule(rdb)
+			return correlationWire.Correlation, correlationWire.Correlation
+		},
+	),
+	fx.Provide(
+		func(logger *slog.Logger, db postgresx.DB) (gate.UsageReporter, webhook.ConversationRecorder) {
+			analyticsWire := analytics.NewAnalyticsModule(logger, db)
+			return analyticsWire.Analytics, analyticsWire.Analytics
 		},
 	),
 
@@ -35,15 +57,19 @@ var Module = fx.Module(
 				encryptor crypto.Encryptor,
 				coreMessanger service.Messenger,
 				client *imgateway.Client,
+				contactClient *imcontact.Client,
 				media *service.MediaService,
+				webhookResolver webhook.WhatsAppBusinessAccountResolver,
+				conversationRecorder webhook.ConversationRecorder,
+				gateHealthUpdater webhook.GateHealthUpdater,
+				sentMessageFinder webhook.SentMessageFinder,
+				sentMessageRecorder messaging.SentMessageRecorder,
 			) *WhatsApp {
-				webhookResolver := resolver.NewResolverModule[*webhook.WhatsAppBusinessAccountResolveQuery](logger, db)
-
 				webhookConfig := webhook.WebhookManagerConfig{
 					Logger: logger,
 				}
 
-				webhhokModule, err := webhook.NewWebhookModule(webhookConfig, encryptor, coreMessanger, webhookResolver.Resolver, client, media)
+				webhhokModule, err := webhook.NewWebhookModule(webhookConfig, encryptor, coreMessanger, webhookResolver, client, contactClient, media, conversationRecorder, gateHealthUpdater, sentMessageFinder)
 				if err != nil {
 					logger.Error("whatsapp:wire:constructing new webhook module", "error", err)
 					return nil
@@ -54,6 +80,7 @@ var Module = fx.Module(
 					encryptor,
 					client,
 					db,
+					sentMessageRecorder,
 				)
 
 				return &WhatsApp{
@@ -38,6 +38,9 @@ func (repository *resolverRepository) Resolve(ctx context.Context, query resolve
 
 	whatsAppBusinessAccount, err := pgx.CollectOneRow(rows, pgx.RowToAddrOfStructByNameLax[common.WhatsappBusinessAccount])
 	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrNoCorespondingWhatsAppBusinessAccount
+		}
 		return nil, errors.Internal(
 			"collecting resolved whatsapp business account",
 			errors.WithCause(err),
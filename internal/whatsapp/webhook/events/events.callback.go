@@ -0,0 +1,21 @@
+package events
+
+// CallbackMessageEvent is a tap on a quick-reply button or an interactive reply button or list row.
+// WhatsApp echoes the ID of the message that carried the button in the event context.
+type CallbackMessageEvent struct {
+	BaseMessageEvent `json:",inline"`
+
+	// Code identifies the tapped button: the reply ID, or the payload of a template button.
+	Code  string `json:"code"`
+	Data  string `json:"data"`
+	Title string `json:"title"`
+}
+
+func NewCallbackMessageEvent(baseMessageEvent BaseMessageEvent, code, data, title string) *CallbackMessageEvent {
+	return &CallbackMessageEvent{
+		BaseMessageEvent: baseMessageEvent,
+		Code:             code,
+		Data:             data,
+		Title:            title,
+	}
+}
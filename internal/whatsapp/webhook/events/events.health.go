@@ -0,0 +1,44 @@
+package events
+
+// PhoneNumberQualityEvent reports a change of the quality or messaging limit of a business phone number.
+type PhoneNumberQualityEvent struct {
+	BusinessAccountID  string `json:"business_account_id"`
+	DisplayPhoneNumber string `json:"display_phone_number"`
+	Event              string `json:"event"`
+	// MessagingLimitTier is the current limit of business-initiated conversations, e.g. TIER_1K.
+	MessagingLimitTier string `json:"messaging_limit_tier,omitempty"`
+}
+
+func NewPhoneNumberQualityEvent(businessAccountID, displayPhoneNumber, event, messagingLimitTier string) *PhoneNumberQualityEvent {
+	return &PhoneNumberQualityEvent{
+		BusinessAccountID:  businessAccountID,
+		DisplayPhoneNumber: displayPhoneNumber,
+		Event:              event,
+		MessagingLimitTier: messagingLimitTier,
+	}
+}
+
+// AccountUpdateEvent reports restrictions and bans of a business account.
+type AccountUpdateEvent struct {
+	BusinessAccountID string               `json:"business_account_id"`
+	PhoneNumber       string               `json:"phone_number,omitempty"`
+	Event             string               `json:"event"`
+	Restrictions      []AccountRestriction `json:"restrictions,omitempty"`
+	BanState          string               `json:"ban_state,omitempty"`
+}
+
+// AccountRestriction is a messaging restriction of the account, with the expiration as Meta formats it.
+type AccountRestriction struct {
+	Type       string `json:"type"`
+	Expiration string `json:"expiration,omitempty"`
+}
+
+func NewAccountUpdateEvent(businessAccountID, phoneNumber, event string, restrictions []AccountRestriction, banState string) *AccountUpdateEvent {
+	return &AccountUpdateEvent{
+		BusinessAccountID: businessAccountID,
+		PhoneNumber:       phoneNumber,
+		Event:             event,
+		Restrictions:      restrictions,
+		BanState:          banState,
+	}
+}
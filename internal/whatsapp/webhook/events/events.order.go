@@ -0,0 +1,45 @@
+package events
+
+type OrderItem struct {
+	ProductRetailerID string `json:"product_retailer_id"`
+	Quantity          string `json:"quantity"`
+	ItemPrice         string `json:"item_price"`
+	Currency          string `json:"currency"`
+}
+
+// OrderMessageEvent is a cart sent by the customer from a catalog.
+type OrderMessageEvent struct {
+	BaseMessageEvent `json:",inline"`
+
+	CatalogID string      `json:"catalog_id"`
+	Text      string      `json:"text"`
+	Items     []OrderItem `json:"items"`
+}
+
+func NewOrderMessageEvent(baseMessageEvent BaseMessageEvent, catalogID, text string, items []OrderItem) *OrderMessageEvent {
+	return &OrderMessageEvent{
+		BaseMessageEvent: baseMessageEvent,
+		CatalogID:        catalogID,
+		Text:             text,
+		Items:            items,
+	}
+}
+
+// Metadata returns the order as structured message metadata.
+func (orderMessageEvent *OrderMessageEvent) Metadata() map[string]any {
+	items := make([]any, 0, len(orderMessageEvent.Items))
+	for _, item := range orderMessageEvent.Items {
+		items = append(items, map[string]any{
+			"product_retailer_id": item.ProductRetailerID,
+			"quantity":            item.Quantity,
+			"item_price":          item.ItemPrice,
+			"currency":            item.Currency,
+		})
+	}
+
+	return map[string]any{
+		"catalog_id":    orderMessageEvent.CatalogID,
+		"text":          orderMessageEvent.Text,
+		"product_items": items,
+	}
+}
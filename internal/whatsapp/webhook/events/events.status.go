@@ -0,0 +1,33 @@
+package events
+
+// StatusEvent carries the status updates of the messages sent from a business phone number.
+type StatusEvent struct {
+	BusinessAccountID string              `json:"business_account_id"`
+	PhoneNumber       BusinessPhoneNumber `json:"phone_number"`
+	Statuses          []MessageStatus     `json:"statuses"`
+}
+
+// MessageStatus is the status of a sent message with the conversation and pricing Meta bills it under.
+// Timestamps are unix seconds, as Meta sends them.
+type MessageStatus struct {
+	MessageID   string `json:"message_id"`
+	Status      string `json:"status"`
+	Timestamp   string `json:"timestamp"`
+	RecipientID string `json:"recipient_id"`
+
+	ConversationID                  string `json:"conversation_id,omitempty"`
+	ConversationOriginType          string `json:"conversation_origin_type,omitempty"`
+	ConversationExpirationTimestamp string `json:"conversation_expiration_timestamp,omitempty"`
+
+	Billable        bool   `json:"billable"`
+	PricingModel    string `json:"pricing_model,omitempty"`
+	PricingCategory string `json:"pricing_category,omitempty"`
+}
+
+func NewStatusEvent(businessAccountID string, phoneNumber BusinessPhoneNumber, statuses []MessageStatus) *StatusEvent {
+	return &StatusEvent{
+		BusinessAccountID: businessAccountID,
+		PhoneNumber:       phoneNumber,
+		Statuses:          statuses,
+	}
+}
@@ -0,0 +1,26 @@
+package events
+
+const (
+	SystemMessageTypeCustomerChangedNumber   = "customer_changed_number"
+	SystemMessageTypeCustomerIdentityChanged = "customer_identity_changed"
+)
+
+// SystemMessageEvent is a WhatsApp system notification about the customer,
+// e.g. a phone number change.
+type SystemMessageEvent struct {
+	BaseMessageEvent `json:",inline"`
+
+	Type string `json:"type"`
+	Body string `json:"body"`
+	// NewWaID is the customer new WhatsApp ID when the number has changed.
+	NewWaID string `json:"new_wa_id"`
+}
+
+func NewSystemMessageEvent(baseMessageEvent BaseMessageEvent, systemType, body, newWaID string) *SystemMessageEvent {
+	return &SystemMessageEvent{
+		BaseMessageEvent: baseMessageEvent,
+		Type:             systemType,
+		Body:             body,
+		NewWaID:          newWaID,
+	}
+}
@@ -0,0 +1,28 @@
+package events
+
+// UnsupportedMessageEvent is a message the service cannot deliver to the core as is:
+// a type WhatsApp reports as unsupported or unknown, or one without a handler yet.
+type UnsupportedMessageEvent struct {
+	BaseMessageEvent `json:",inline"`
+
+	MessageType string `json:"message_type"`
+	// Reason is the WhatsApp error title, when WhatsApp provides one.
+	Reason string `json:"reason"`
+}
+
+func NewUnsupportedMessageEvent(baseMessageEvent BaseMessageEvent, messageType, reason string) *UnsupportedMessageEvent {
+	return &UnsupportedMessageEvent{
+		BaseMessageEvent: baseMessageEvent,
+		MessageType:      messageType,
+		Reason:           reason,
+	}
+}
+
+// Placeholder is the text shown to agents in place of the message.
+func (unsupportedMessageEvent *UnsupportedMessageEvent) Placeholder() string {
+	placeholder := "[Unsupported WhatsApp message: " + unsupportedMessageEvent.MessageType + "]"
+	if unsupportedMessageEvent.Reason != "" {
+		placeholder += " " + unsupportedMessageEvent.Reason
+	}
+	return placeholder
+}
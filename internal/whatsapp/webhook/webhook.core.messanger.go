@@ -4,13 +4,17 @@ import (
 	"context"
 	"fmt"
 
+	contactv1 "github.com/webitel/im-providers-service/gen/go/contact/v1"
 	"github.com/webitel/im-providers-service/gen/go/gateway/v1"
+	grpcclient "github.com/webitel/im-providers-service/infra/client/grpc"
+	imcontact "github.com/webitel/im-providers-service/infra/client/grpc/im-contact"
 	imgateway "github.com/webitel/im-providers-service/infra/client/grpc/im-gateway"
 	"github.com/webitel/im-providers-service/internal/core/model"
 	"github.com/webitel/webitel-go-kit/pkg/errors"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
 )
 
 const newContactsSource string = "whatsapp"
@@ -25,10 +29,11 @@ type decoratedCoreMessanger struct {
 	CoreMessanger
 
 	gatewayClient *imgateway.Client
+	contactClient *imcontact.Client
 }
 
-func newDecoratedCoreMessanger(coreMessanger CoreMessanger, gatewayClient *imgateway.Client) *decoratedCoreMessanger {
-	return &decoratedCoreMessanger{CoreMessanger: coreMessanger, gatewayClient: gatewayClient}
+func newDecoratedCoreMessanger(coreMessanger CoreMessanger, gatewayClient *imgateway.Client, contactClient *imcontact.Client) *decoratedCoreMessanger {
+	return &decoratedCoreMessanger{CoreMessanger: coreMessanger, gatewayClient: gatewayClient, contactClient: contactClient}
 }
 
 func (decoratedCoreMessanger *decoratedCoreMessanger) SendText(ctx context.Context, in *model.SendTextRequest) (*model.SendTextResponse, error) {
@@ -54,6 +59,42 @@ func (decoratedCoreMessanger *decoratedCoreMessanger) prepareOutgoingSendCoreReq
 }
 
 func (decoratedCoreMessanger *decoratedCoreMessanger) resolveInternalContactIdentity(ctx context.Context, to, from model.Peer, dc int) error {
+	return decoratedCoreMessanger.createContactIdentity(ctx, to, from, dc, map[string]string{})
+}
+
+// ChangeContactIdentity moves the contact of a customer who changed their WhatsApp number
+// to the new identity. The subject of the existing contact is updated in place, so the
+// conversations stay with it; a customer without a contact gets one for the new identity.
+func (decoratedCoreMessanger *decoratedCoreMessanger) ChangeContactIdentity(ctx context.Context, to, from, changedFrom model.Peer, dc int) error {
+	authContext := grpcclient.WithIdentity(ctx, grpcclient.StringIdentity(fmt.Sprintf("%d.%s", dc, to.Sub)))
+
+	response, err := decoratedCoreMessanger.contactClient.SearchContact(authContext, &contactv1.SearchContactRequest{
+		Subjects: []string{from.Sub},
+		IssId:    []string{from.Iss},
+		DomainId: int32(dc),
+		Size:     1,
+	})
+	if err != nil {
+		return errors.Internal("executing search contact request", errors.WithCause(err), errors.WithID("whatsapp.webhook.core.messanger.change_contact_identity"))
+	}
+
+	if contacts := response.GetContacts(); len(contacts) > 0 {
+		_, err := decoratedCoreMessanger.contactClient.PatchContact(authContext, &contactv1.PatchContactRequest{
+			Id:        contacts[0].GetId(),
+			DomainId:  contacts[0].GetDomainId(),
+			Subject:   changedFrom.Sub,
+			FieldMask: &fieldmaskpb.FieldMask{Paths: []string{"subject"}},
+		})
+		if err != nil {
+			return errors.Internal("executing patch contact request", errors.WithCause(err), errors.WithID("whatsapp.webhook.core.messanger.change_contact_identity"))
+		}
+	}
+
+	// Links the gate to the contact under its new identity, creating the contact when it was missing.
+	return decoratedCoreMessanger.createContactIdentity(ctx, to, changedFrom, dc, map[string]string{})
+}
+
+func (decoratedCoreMessanger *decoratedCoreMessanger) createContactIdentity(ctx context.Context, to, from model.Peer, dc int, contactMetadata map[string]string) error {
 	outgoingContext, err := decoratedCoreMessanger.prepareOutCallMetadata(ctx, dc, to.Sub)
 	if err != nil {
 		return err
@@ -64,7 +105,7 @@ func (decoratedCoreMessanger *decoratedCoreMessanger) resolveInternalContactIden
 		Type:     newContactsSource,
 		Name:     from.Name,
 		Username: from.Name, //TODO
-		Metadata: map[string]string{},
+		Metadata: contactMetadata,
 		Subject:  from.Sub,
 		DomainId: int32(dc),
 		IsBot:    false,
@@ -157,3 +198,21 @@ func (decoratedCoreMessanger *decoratedCoreMessanger) SendContact(ctx context.Co
 	}
 	return decoratedCoreMessanger.CoreMessanger.SendContact(requestContext, in)
 }
+
+func (decoratedCoreMessanger *decoratedCoreMessanger) SendInteractiveCallback(ctx context.Context, in *model.SendInteractiveCallbackRequest) error {
+	requestContext, err := decoratedCoreMessanger.prepareOutgoingSendCoreRequest(ctx, in.From, in.To, int(in.DomainID))
+	if err != nil {
+		return err
+	}
+
+	return decoratedCoreMessanger.CoreMessanger.SendInteractiveCallback(requestContext, in)
+}
+
+func (decoratedCoreMessanger *decoratedCoreMessanger) SendSystemMessage(ctx context.Context, in *model.SendSystemMessageRequest) (*model.SendResponse, error) {
+	requestContext, err := decoratedCoreMessanger.prepareOutgoingSendCoreRequest(ctx, in.From, in.To, int(in.DomainID))
+	if err != nil {
+		return nil, err
+	}
+
+	return decoratedCoreMessanger.CoreMessanger.SendSystemMessage(requestContext, in)
+}
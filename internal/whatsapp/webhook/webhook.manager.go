@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"log/slog"
 	"net/url"
+	"strings"
 
 	"github.com/webitel/im-providers-service/internal/core/model"
 	"github.com/webitel/im-providers-service/internal/whatsapp/client"
@@ -32,6 +33,13 @@ type CoreIntegrationHandler interface {
 	HandleImageMessage(ctx context.Context, imageEvent *events.ImageMessageEvent) error
 	HandleLocationMessage(ctx context.Context, locationEvent *events.LocationMessageEvent) error
 	HandleContactsMessage(ctx context.Context, contacts *events.ContactMessageEvent) error
+	HandleCallbackMessage(ctx context.Context, callbackEvent *events.CallbackMessageEvent) error
+	HandleOrderMessage(ctx context.Context, orderEvent *events.OrderMessageEvent) error
+	HandleSystemMessage(ctx context.Context, systemEvent *events.SystemMessageEvent) error
+	HandleUnsupportedMessage(ctx context.Context, unsupportedEvent *events.UnsupportedMessageEvent) error
+	HandleStatuses(ctx context.Context, statusEvent *events.StatusEvent) error
+	HandlePhoneNumberQualityUpdate(ctx context.Context, qualityEvent *events.PhoneNumberQualityEvent) error
+	HandleAccountUpdate(ctx context.Context, accountEvent *events.AccountUpdateEvent) error
 }
 
 type WebhookManager struct {
@@ -96,6 +104,10 @@ func (webhookManager *WebhookManager) HandleWebhook(ctx context.Context, payload
 		return errors.InvalidArgument("unmarshaling paylaod data", errors.WithCause(err), errors.WithID("webhook.manager.handle_webhook"))
 	}
 
+	var (
+		handled int
+		failed  []error
+	)
 	for _, entry := range webhookPayload.Entry {
 		for _, change := range entry.Changes {
 			switch change.Field {
@@ -106,30 +118,36 @@ func (webhookManager *WebhookManager) HandleWebhook(ctx context.Context, payload
 					return err
 				}
 
-				senderName := ""
-				if len(messageValue.Contacts) > 0 {
-					senderName = messageValue.Contacts[0].Profile.Name
-				}
-
-				err = webhookManager.handleMessagesSubscriptionEvents(ctx, handleMessagesSubscriptionEvents{
+				result := webhookManager.handleMessagesSubscriptionEvents(ctx, handleMessagesSubscriptionEvents{
 					Messages:          messageValue.Messages,
 					Statuses:          messageValue.Statuses,
+					Contacts:          messageValue.Contacts,
 					BusinessAccountID: entry.ID,
-					SenderName:        senderName,
 					PhoneNumber: events.BusinessPhoneNumber{
 						DisplayNumber: messageValue.Metadata.DisplayPhoneNumber,
 						ID:            messageValue.Metadata.PhoneNumberID,
 					},
 				})
 
-				if err != nil {
-					log.Error("handling messages subscription events", "error", err)
-					return errors.Internal("handling messages subscription events", errors.WithCause(err), errors.WithID("webhook.manager.handle_webhook"))
+				handled += result.handled
+				failed = append(failed, result.failed...)
+			case WebhookFieldEnumPhoneNumberQualityUpdate, WebhookFieldEnumAccountUpdate:
+				if err := webhookManager.handleHealthUpdate(ctx, entry.ID, change); err != nil {
+					log.Error("handling health update", "error", err, "field", change.Field, "business_account_id", entry.ID)
+					failed = append(failed, err)
+					continue
 				}
+				handled++
 			}
 		}
 	}
 
+	// Meta redelivers the whole notification on a non-2xx response, so it is only
+	// requested when nothing was handled and the redelivery duplicates no message.
+	if handled == 0 && len(failed) > 0 {
+		return errors.Internal("handling messages subscription events", errors.WithCause(failed[0]), errors.WithID("webhook.manager.handle_webhook"), errors.WithValue("failed_messages", len(failed)))
+	}
+
 	return nil
 }
 
@@ -137,15 +155,36 @@ type handleMessagesSubscriptionEvents struct {
 	Messages []Message `json:"messages"`
 	Statuses []Status  `json:"statuses"`
 
+	//  profiles of the message senders, matched to the messages by wa_id
+	Contacts []SenderContact `json:"contacts"`
+
 	//  business account id to which this event has been sent to
 	BusinessAccountID string `json:"business_account_id"`
-	SenderName        string `json:"sender_name"`
 
 	//  this is the phone number to which this event has bee sent to
 	PhoneNumber events.BusinessPhoneNumber `json:"phone_number"`
 }
 
-func (webhookManager *WebhookManager) handleMessagesSubscriptionEvents(ctx context.Context, payload handleMessagesSubscriptionEvents) error {
+// senderName returns the profile name of the contact with the given wa_id.
+func (payload *handleMessagesSubscriptionEvents) senderName(waID string) string {
+	for _, contact := range payload.Contacts {
+		if contact.WaID == waID {
+			return contact.Profile.Name
+		}
+	}
+
+	return ""
+}
+
+type messagesBatchResult struct {
+	handled int
+	failed  []error
+}
+
+func (webhookManager *WebhookManager) handleMessagesSubscriptionEvents(ctx context.Context, payload handleMessagesSubscriptionEvents) messagesBatchResult {
+	log := webhookManager.logger.With("component", "whatsapp_webhook_manager", "operation", "handle_messages_subscription_events", "business_account_id", payload.BusinessAccountID)
+
+	var result messagesBatchResult
 	for _, message := range payload.Messages {
 		repliedTo := message.Context.Id
 		baseMessageEvent := events.BaseMessageEvent{
@@ -153,83 +192,251 @@ func (webhookManager *WebhookManager) handleMessagesSubscriptionEvents(ctx conte
 			Requester:         webhookManager.RequestClient,
 			MessageID:         message.Id,
 			From:              message.From,
-			SenderName:        payload.SenderName,
+			SenderName:        payload.senderName(message.From),
 			Context:           events.MessageContext{RepliedToMessageID: repliedTo},
 			Timestamp:         message.Timestamp,
 			IsForwarder:       message.Context.Forwarded,
 			PhoneNumber:       payload.PhoneNumber,
 		}
 
-		switch message.Type {
-		case NotificationMessageTypeText:
-			{
-				err := webhookManager.coreIntegrationHandler.HandleTextMessage(
-					ctx, events.NewTextMessageEven(baseMessageEvent, message.Text.Body),
-				)
+		// Messages are isolated from each other: a failed one must not make Meta redeliver the rest of the batch.
+		if err := webhookManager.handleMessage(ctx, baseMessageEvent, message); err != nil {
+			log.Error("handling message", "error", err, "message_id", message.Id, "message_type", message.Type, "from", message.From)
+			result.failed = append(result.failed, err)
+			continue
+		}
+		result.handled++
+	}
 
-				if err != nil {
-					return err
-				}
-			}
-		case NotificationMessageTypeDocument:
-			{
-				documentMessage, err := components.NewDocumentMessage(components.DocumentMessageConfigs{
-					ID:       message.Document.Id,
-					Link:     message.Document.Link,
-					Caption:  &message.Document.Caption,
-					FileName: message.Document.Filename,
-				})
+	if len(payload.Statuses) > 0 {
+		if err := webhookManager.coreIntegrationHandler.HandleStatuses(ctx, events.NewStatusEvent(payload.BusinessAccountID, payload.PhoneNumber, messageStatuses(payload.Statuses))); err != nil {
+			log.Error("handling statuses", "error", err, "statuses", len(payload.Statuses))
+			result.failed = append(result.failed, err)
+		} else {
+			result.handled++
+		}
+	}
 
-				if err != nil {
-					return err
-				}
+	return result
+}
+
+// handleHealthUpdate passes on the phone number quality and account changes, which mark the gates in error.
+func (webhookManager *WebhookManager) handleHealthUpdate(ctx context.Context, businessAccountID string, change Change) error {
+	if change.Field == WebhookFieldEnumPhoneNumberQualityUpdate {
+		qualityValue, err := unmarshallWebhookValue[PhoneNumberQualityUpdateValue](change.Value)
+		if err != nil {
+			return err
+		}
 
-				err = webhookManager.coreIntegrationHandler.HandleDocumentMessage(ctx, events.NewDocumentMessageEvent(
-					baseMessageEvent, *documentMessage, message.Document.Id, message.Document.SHA256, message.Document.MIMEType,
-				))
+		messagingLimitTier := qualityValue.CurrentLimit
+		if messagingLimitTier == "" {
+			messagingLimitTier = qualityValue.MaxDailyConversationsPerBusiness
+		}
 
-				if err != nil {
-					return err
-				}
+		return webhookManager.coreIntegrationHandler.HandlePhoneNumberQualityUpdate(ctx, events.NewPhoneNumberQualityEvent(
+			businessAccountID, qualityValue.DisplayPhoneNumber, qualityValue.Event, messagingLimitTier,
+		))
+	}
+
+	accountValue, err := unmarshallWebhookValue[AccountUpdateValue](change.Value)
+	if err != nil {
+		return err
+	}
+
+	restrictions := make([]events.AccountRestriction, 0, len(accountValue.RestrictionInfo))
+	for _, restriction := range accountValue.RestrictionInfo {
+		restrictions = append(restrictions, events.AccountRestriction{Type: restriction.RestrictionType, Expiration: restriction.Expiration})
+	}
+
+	var banState string
+	if accountValue.BanInfo != nil && len(accountValue.BanInfo.WabaBanState) > 0 {
+		banState = accountValue.BanInfo.WabaBanState[0]
+	}
+
+	return webhookManager.coreIntegrationHandler.HandleAccountUpdate(ctx, events.NewAccountUpdateEvent(
+		businessAccountID, accountValue.PhoneNumber, accountValue.Event, restrictions, banState,
+	))
+}
+
+func messageStatuses(statuses []Status) []events.MessageStatus {
+	messageStatuses := make([]events.MessageStatus, 0, len(statuses))
+	for _, status := range statuses {
+		originType := status.Conversation.Origin.Type
+		expiration := status.Conversation.ExpirationTimestamp
+		if expiration == "" {
+			expiration = status.Conversation.Origin.ExpirationTimestamp
+		}
+
+		messageStatuses = append(messageStatuses, events.MessageStatus{
+			MessageID:                       status.ID,
+			Status:                          status.Status,
+			Timestamp:                       status.Timestamp,
+			RecipientID:                     status.RecipientID,
+			ConversationID:                  status.Conversation.ID,
+			ConversationOriginType:          string(originType),
+			ConversationExpirationTimestamp: expiration,
+			Billable:                        status.Pricing.Billable,
+			PricingModel:                    status.Pricing.PricingModel,
+			PricingCategory:                 string(status.Pricing.Category),
+		})
+	}
+
+	return messageStatuses
+}
+
+func (webhookManager *WebhookManager) handleMessage(ctx context.Context, baseMessageEvent events.BaseMessageEvent, message Message) error {
+	switch message.Type {
+	case NotificationMessageTypeText:
+		{
+			err := webhookManager.coreIntegrationHandler.HandleTextMessage(
+				ctx, events.NewTextMessageEven(baseMessageEvent, message.Text.Body),
+			)
+
+			if err != nil {
+				return err
 			}
-		case NotificationMessageTypeImage:
-			imageMessage, err := components.NewImageMessage(components.ImageMessageConfigs{
-				ID:      message.Image.Id,
-				Link:    message.Image.Url,
-				Caption: &message.Image.Caption,
+		}
+	case NotificationMessageTypeDocument:
+		{
+			documentMessage, err := components.NewDocumentMessage(components.DocumentMessageConfigs{
+				ID:       message.Document.Id,
+				Link:     message.Document.Link,
+				Caption:  optionalCaption(message.Document.Caption),
+				FileName: documentFileName(message.Document.Filename, message.Document.Id),
 			})
 
 			if err != nil {
 				return err
 			}
 
-			err = webhookManager.coreIntegrationHandler.HandleImageMessage(ctx, events.NewImageMessageEvent(
-				baseMessageEvent, *imageMessage, message.Image.Id, message.Image.SHA256, message.Image.MIMEType,
+			err = webhookManager.coreIntegrationHandler.HandleDocumentMessage(ctx, events.NewDocumentMessageEvent(
+				baseMessageEvent, *documentMessage, message.Document.Id, message.Document.SHA256, message.Document.MIMEType,
 			))
 
 			if err != nil {
 				return err
 			}
-		case NotificationMessageTypeLocation:
-			locationMessage := components.NewLocationMessage(message.Location.Latitude, message.Location.Longitude)
+		}
+	case NotificationMessageTypeImage:
+		imageMessage, err := components.NewImageMessage(components.ImageMessageConfigs{
+			ID:      message.Image.Id,
+			Link:    message.Image.Url,
+			Caption: optionalCaption(message.Image.Caption),
+		})
+
+		if err != nil {
+			return err
+		}
 
-			locationMessage.SetName(message.Location.Name).SetAddress(message.Location.Address)
+		err = webhookManager.coreIntegrationHandler.HandleImageMessage(ctx, events.NewImageMessageEvent(
+			baseMessageEvent, *imageMessage, message.Image.Id, message.Image.SHA256, message.Image.MIMEType,
+		))
 
-			if err := webhookManager.coreIntegrationHandler.HandleLocationMessage(ctx, events.NewLocationMessageEvent(baseMessageEvent, *locationMessage)); err != nil {
-				return err
-			}
+		if err != nil {
+			return err
+		}
+	case NotificationMessageTypeLocation:
+		locationMessage := components.NewLocationMessage(message.Location.Latitude, message.Location.Longitude)
 
-		case NotificationMessageTypeContacts:
-			contactMessage := components.NewContactMessage(message.Contacts)
-			if err := webhookManager.coreIntegrationHandler.HandleContactsMessage(ctx, events.NewContactsMessageEvent(baseMessageEvent, *contactMessage)); err != nil {
-				return err
-			}
+		locationMessage.SetName(message.Location.Name).SetAddress(message.Location.Address)
+
+		if err := webhookManager.coreIntegrationHandler.HandleLocationMessage(ctx, events.NewLocationMessageEvent(baseMessageEvent, *locationMessage)); err != nil {
+			return err
+		}
+
+	case NotificationMessageTypeContacts:
+		contactMessage := components.NewContactMessage(message.Contacts)
+		if err := webhookManager.coreIntegrationHandler.HandleContactsMessage(ctx, events.NewContactsMessageEvent(baseMessageEvent, *contactMessage)); err != nil {
+			return err
+		}
+
+	case NotificationMessageTypeButton:
+		callbackEvent := events.NewCallbackMessageEvent(baseMessageEvent, message.Button.Payload, message.Button.Payload, message.Button.Text)
+		if err := webhookManager.coreIntegrationHandler.HandleCallbackMessage(ctx, callbackEvent); err != nil {
+			return err
+		}
+
+	case NotificationMessageTypeInteractive:
+		interactive := message.Interactive
+		var callbackEvent *events.CallbackMessageEvent
+		switch interactive.Type {
+		case InteractiveReplyTypeButton:
+			callbackEvent = events.NewCallbackMessageEvent(baseMessageEvent, interactive.ButtonReply.ID, interactive.ButtonReply.ID, interactive.ButtonReply.Title)
+		case InteractiveReplyTypeList:
+			callbackEvent = events.NewCallbackMessageEvent(baseMessageEvent, interactive.ListReply.ID, interactive.ListReply.ID, interactive.ListReply.Title)
+		case InteractiveReplyTypeFlow:
+			// the flow token identifies the flow session, the form values go as the callback data
+			callbackEvent = events.NewCallbackMessageEvent(baseMessageEvent, interactive.NfmReply.FlowToken(), interactive.NfmReply.ResponseJSON, interactive.NfmReply.Body)
+		}
+
+		if callbackEvent == nil {
+			unsupportedEvent := events.NewUnsupportedMessageEvent(baseMessageEvent, string(message.Type)+"/"+interactive.Type, "")
+			return webhookManager.coreIntegrationHandler.HandleUnsupportedMessage(ctx, unsupportedEvent)
+		}
+
+		if err := webhookManager.coreIntegrationHandler.HandleCallbackMessage(ctx, callbackEvent); err != nil {
+			return err
+		}
+
+	case NotificationMessageTypeOrder:
+		items := make([]events.OrderItem, 0, len(message.Order.ProductItems))
+		for _, item := range message.Order.ProductItems {
+			items = append(items, events.OrderItem{
+				ProductRetailerID: item.ProductRetailerID,
+				Quantity:          string(item.Quantity),
+				ItemPrice:         string(item.ItemPrice),
+				Currency:          item.Currency,
+			})
+		}
+
+		orderEvent := events.NewOrderMessageEvent(baseMessageEvent, message.Order.CatalogID, message.Order.Text, items)
+		if err := webhookManager.coreIntegrationHandler.HandleOrderMessage(ctx, orderEvent); err != nil {
+			return err
+		}
+
+	case NotificationMessageTypeSystem:
+		newWaID := message.System.WaID
+		if newWaID == "" {
+			newWaID = message.System.NewWaID
+		}
+
+		systemEvent := events.NewSystemMessageEvent(baseMessageEvent, message.System.Type, message.System.Body, newWaID)
+		if err := webhookManager.coreIntegrationHandler.HandleSystemMessage(ctx, systemEvent); err != nil {
+			return err
+		}
+
+	default:
+		reason := ""
+		if len(message.Errors) > 0 {
+			reason = message.Errors[0].Title
+		}
+
+		unsupportedEvent := events.NewUnsupportedMessageEvent(baseMessageEvent, string(message.Type), reason)
+		if err := webhookManager.coreIntegrationHandler.HandleUnsupportedMessage(ctx, unsupportedEvent); err != nil {
+			return err
 		}
 	}
 
 	return nil
 }
 
+func optionalCaption(caption string) *string {
+	if caption == "" {
+		return nil
+	}
+
+	return &caption
+}
+
+// documentFileName falls back to the media id, as documents sent from some clients come without a file name.
+func documentFileName(fileName, mediaID string) string {
+	if strings.TrimSpace(fileName) == "" {
+		return mediaID
+	}
+
+	return fileName
+}
+
 func (webhookManager *WebhookManager) Verify(ctx context.Context, query url.Values) (string, error) {
 	var (
 		hubVerificationToken = query.Get("hub.verify_token")
@@ -0,0 +1,320 @@
+package webhook
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/webitel/im-providers-service/internal/whatsapp/webhook/events"
+)
+
+// handledEvent is what the recording handler saw: the handler name, the message and a type specific detail.
+type handledEvent struct {
+	handler    string
+	messageID  string
+	from       string
+	senderName string
+	detail     string
+}
+
+// recordingHandler is a CoreIntegrationHandler that records the handled events
+// and fails the messages listed in failMessageIDs.
+type recordingHandler struct {
+	failMessageIDs map[string]bool
+	failStatuses   bool
+	failHealth     bool
+	handled        []handledEvent
+}
+
+func (handler *recordingHandler) record(name string, base events.BaseMessageEvent, detail string) error {
+	if handler.failMessageIDs[base.MessageID] {
+		return errors.New("core is unavailable")
+	}
+
+	handler.handled = append(handler.handled, handledEvent{
+		handler:    name,
+		messageID:  base.MessageID,
+		from:       base.From,
+		senderName: base.SenderName,
+		detail:     detail,
+	})
+	return nil
+}
+
+func (handler *recordingHandler) HandleTextMessage(_ context.Context, textEvent *events.TextMessageEvent) error {
+	return handler.record("text", textEvent.BaseMessageEvent, textEvent.Text)
+}
+
+func (handler *recordingHandler) HandleDocumentMessage(_ context.Context, documentEvent *events.DocumentMessageEvent) error {
+	caption := "<nil>"
+	if documentEvent.Document.Caption != nil {
+		caption = *documentEvent.Document.Caption
+	}
+	return handler.record("document", documentEvent.BaseMessageEvent, documentEvent.Document.FileName+"|"+caption)
+}
+
+func (handler *recordingHandler) HandleImageMessage(_ context.Context, imageEvent *events.ImageMessageEvent) error {
+	caption := "<nil>"
+	if imageEvent.Image.Caption != nil {
+		caption = *imageEvent.Image.Caption
+	}
+	return handler.record("image", imageEvent.BaseMessageEvent, imageEvent.MediaID+"|"+caption)
+}
+
+func (handler *recordingHandler) HandleLocationMessage(_ context.Context, locationEvent *events.LocationMessageEvent) error {
+	return handler.record("location", locationEvent.BaseMessageEvent, locationEvent.Location.Name)
+}
+
+func (handler *recordingHandler) HandleContactsMessage(_ context.Context, contacts *events.ContactMessageEvent) error {
+	return handler.record("contacts", contacts.BaseMessageEvent, "")
+}
+
+func (handler *recordingHandler) HandleCallbackMessage(_ context.Context, callbackEvent *events.CallbackMessageEvent) error {
+	return handler.record("callback", callbackEvent.BaseMessageEvent, callbackEvent.Code+"|"+callbackEvent.Title+"|"+callbackEvent.Context.RepliedToMessageID)
+}
+
+func (handler *recordingHandler) HandleOrderMessage(_ context.Context, orderEvent *events.OrderMessageEvent) error {
+	detail := orderEvent.CatalogID
+	for _, item := range orderEvent.Items {
+		detail += "|" + item.ProductRetailerID + "x" + item.Quantity + "@" + item.ItemPrice
+	}
+	return handler.record("order", orderEvent.BaseMessageEvent, detail)
+}
+
+func (handler *recordingHandler) HandleSystemMessage(_ context.Context, systemEvent *events.SystemMessageEvent) error {
+	return handler.record("system", systemEvent.BaseMessageEvent, systemEvent.Type+"|"+systemEvent.NewWaID)
+}
+
+func (handler *recordingHandler) HandleUnsupportedMessage(_ context.Context, unsupportedEvent *events.UnsupportedMessageEvent) error {
+	return handler.record("unsupported", unsupportedEvent.BaseMessageEvent, unsupportedEvent.MessageType+"|"+unsupportedEvent.Reason)
+}
+
+func (handler *recordingHandler) HandleStatuses(_ context.Context, statusEvent *events.StatusEvent) error {
+	if handler.failStatuses {
+		return errors.New("analytics store is unavailable")
+	}
+
+	for _, status := range statusEvent.Statuses {
+		handler.handled = append(handler.handled, handledEvent{
+			handler:   "status",
+			messageID: status.MessageID,
+			from:      status.RecipientID,
+			detail:    status.Status + "|" + status.ConversationID + "|" + status.ConversationOriginType + "|" + status.PricingModel + "|" + status.PricingCategory,
+		})
+	}
+	return nil
+}
+
+func (handler *recordingHandler) HandlePhoneNumberQualityUpdate(_ context.Context, qualityEvent *events.PhoneNumberQualityEvent) error {
+	if handler.failHealth {
+		return errors.New("gate store is unavailable")
+	}
+
+	handler.handled = append(handler.handled, handledEvent{
+		handler: "quality",
+		from:    qualityEvent.DisplayPhoneNumber,
+		detail:  qualityEvent.BusinessAccountID + "|" + qualityEvent.Event + "|" + qualityEvent.MessagingLimitTier,
+	})
+	return nil
+}
+
+func (handler *recordingHandler) HandleAccountUpdate(_ context.Context, accountEvent *events.AccountUpdateEvent) error {
+	if handler.failHealth {
+		return errors.New("gate store is unavailable")
+	}
+
+	detail := accountEvent.BusinessAccountID + "|" + accountEvent.Event + "|" + accountEvent.BanState
+	for _, restriction := range accountEvent.Restrictions {
+		detail += "|" + restriction.Type + "@" + restriction.Expiration
+	}
+	handler.handled = append(handler.handled, handledEvent{
+		handler: "account",
+		from:    accountEvent.PhoneNumber,
+		detail:  detail,
+	})
+	return nil
+}
+
+func TestWebhookManager_HandleWebhook(t *testing.T) {
+	const (
+		sheena = "16505551234"
+		pablo  = "14155552671"
+	)
+
+	tests := []struct {
+		fixture        string
+		failMessageIDs []string
+		failStatuses   bool
+		failHealth     bool
+		want           []handledEvent
+		wantErr        bool
+	}{
+		{
+			fixture: "text.json",
+			want: []handledEvent{
+				{"text", "wamid.HBgLMTY1MDM4Nzk0MzkVAgASGBQzQTRBNjU5OUFFRTAzODEwMTQ0RgA=", sheena, "Sheena Nelson", "Does it come in another color?"},
+			},
+		},
+		{
+			fixture: "image_without_caption.json",
+			want: []handledEvent{
+				{"image", "wamid.HBgLMTY1MDM4Nzk0MzkVAgASGBQzQUFERjg0NDEzNDdFODU3MUMxMAA=", sheena, "Sheena Nelson", "1003383421387256|<nil>"},
+			},
+		},
+		{
+			fixture: "document.json",
+			want: []handledEvent{
+				{"document", "wamid.HBgLMTY1MDM4Nzk0MzkVAgASGBQzQTU2MDhFNkE5RjJGRTg0QjBBOAA=", sheena, "Sheena Nelson", "invoice-may.pdf|Invoice for May"},
+				{"document", "wamid.HBgLMTY1MDM4Nzk0MzkVAgASGBQzQTg4NjU0MjE3NzdGRDg4QkUzNAA=", sheena, "Sheena Nelson", "1184867446687498|<nil>"},
+			},
+		},
+		{
+			fixture: "multiple_senders.json",
+			want: []handledEvent{
+				{"text", "wamid.HBgLMTQxNTU1NTI2NzEVAgASGBQzQUI3NDQ2QkRFMjBBMUFGMkQzNwA=", pablo, "Pablo Morales", "Is the store open on Sunday?"},
+				{"text", "wamid.HBgLMTY1MDM4Nzk0MzkVAgASGBQzQTZDNUNCQkM3Mzk1NDQ3NTNFMQA=", sheena, "Sheena Nelson", "Thanks!"},
+			},
+		},
+		{
+			fixture:        "multiple_senders.json",
+			failMessageIDs: []string{"wamid.HBgLMTQxNTU1NTI2NzEVAgASGBQzQUI3NDQ2QkRFMjBBMUFGMkQzNwA="},
+			want: []handledEvent{
+				{"text", "wamid.HBgLMTY1MDM4Nzk0MzkVAgASGBQzQTZDNUNCQkM3Mzk1NDQ3NTNFMQA=", sheena, "Sheena Nelson", "Thanks!"},
+			},
+		},
+		{
+			fixture: "multiple_senders.json",
+			failMessageIDs: []string{
+				"wamid.HBgLMTQxNTU1NTI2NzEVAgASGBQzQUI3NDQ2QkRFMjBBMUFGMkQzNwA=",
+				"wamid.HBgLMTY1MDM4Nzk0MzkVAgASGBQzQTZDNUNCQkM3Mzk1NDQ3NTNFMQA=",
+			},
+			wantErr: true,
+		},
+		{
+			fixture: "location.json",
+			want: []handledEvent{
+				{"location", "wamid.HBgLMTY1MDM4Nzk0MzkVAgASGBQzQTE5NjQ5NzIzRjAzRjQ4NjZGOQA=", sheena, "Sheena Nelson", "Main office"},
+			},
+		},
+		{
+			fixture: "button.json",
+			want: []handledEvent{
+				{"callback", "wamid.HBgLMTY1MDM4Nzk0MzkVAgASGBQzQTJFQzEyRjIzNjhBNEY1QjM5OQA=", sheena, "Sheena Nelson", "UNSUBSCRIBE|Stop promotions|wamid.HBgLMTY1MDM4Nzk0MzkVAgARGBI3RjFBRUM5NjVGOTZDODU0RkMA"},
+			},
+		},
+		{
+			fixture: "interactive.json",
+			want: []handledEvent{
+				{"callback", "wamid.HBgLMTY1MDM4Nzk0MzkVAgASGBQzQUZBMDY5OEE2RDMzNTgzNkJBQwA=", sheena, "Sheena Nelson", "confirm-delivery|Confirm|wamid.HBgLMTY1MDM4Nzk0MzkVAgARGBJBMUYwQkUzNjFBRkQ2NDc0QzMA"},
+				{"callback", "wamid.HBgLMTY1MDM4Nzk0MzkVAgASGBQzQTcyQzBCMzE3QzQ4OEE1NjMxMwA=", sheena, "Sheena Nelson", "slot-10am|10:00 AM|wamid.HBgLMTY1MDM4Nzk0MzkVAgARGBJCMjc2RjE4MzY0N0E0MzMzOTIA"},
+			},
+		},
+		{
+			fixture: "flow_reply.json",
+			want: []handledEvent{
+				{"callback", "wamid.HBgLMTY1MDM4Nzk0MzkVAgASGBQzQUQ0N0JGMjc5QUQ1MzQ0RjlGRgA=", sheena, "Sheena Nelson", "booking-7f3a|Sent|wamid.HBgLMTY1MDM4Nzk0MzkVAgARGBJGNDVEMUMxQTk2NUJDQzY3QjUA"},
+			},
+		},
+		{
+			fixture: "order.json",
+			want: []handledEvent{
+				{"order", "wamid.HBgLMTY1MDM4Nzk0MzkVAgASGBQzQTQ4OEJGRTlERTg1ODYwRjc5NQA=", sheena, "Sheena Nelson", "194836987003835|di9ozbzfi4x2@30|nqryix03ezx1@12.5"},
+			},
+		},
+		{
+			fixture: "system.json",
+			want: []handledEvent{
+				{"system", "wamid.HBgLMTY1MDM4Nzk0MzkVAgASGBQzQTNEMDUzRDI0RkFDNEI0QjlBMAA=", sheena, "", "customer_changed_number|16505559876"},
+			},
+		},
+		{
+			fixture: "unsupported.json",
+			want: []handledEvent{
+				{"unsupported", "wamid.HBgLMTY1MDM4Nzk0MzkVAgASGBQzQUY3RDdEMjdDRUExQzVCQ0YwMwA=", sheena, "Sheena Nelson", "unsupported|Message type unknown"},
+			},
+		},
+		{
+			fixture: "statuses.json",
+			want: []handledEvent{
+				{"status", "wamid.HBgLMTY1MDM4Nzk0MzkVAgARGBI3RjFBRUM5NjVGOTZDODU0RkMA", sheena, "", "delivered|||PMP|marketing"},
+			},
+		},
+		{
+			fixture: "statuses_pricing.json",
+			want: []handledEvent{
+				{"status", "wamid.HBgLMTY1MDM4Nzk0MzkVAgARGBI0MkM2QzVGRkM0QUU0NzE2QjUA", sheena, "", "sent|6ceb9d929c8ff7c3f9e4ec5b9ac9b5f3|utility|CBP|utility"},
+				{"status", "wamid.HBgLMTY1MDM4Nzk0MzkVAgARGBI0MkM2QzVGRkM0QUU0NzE2QjUA", sheena, "", "read||||"},
+				{"status", "wamid.HBgLMTY1MDM4Nzk0MzkVAgARGBJBMjdCRDFGNjM1RDg0RDI0MzgA", sheena, "", "delivered|a3b5c4a1f2e9d8c7b6a5f4e3d2c1b0a9|service|CBP|service"},
+				{"status", "wamid.HBgLMTY1MDM4Nzk0MzkVAgARGBJGQjQ1RkM3QjM1MjBBMEFCNTMA", sheena, "", "failed||||"},
+			},
+		},
+		{
+			fixture:      "statuses.json",
+			failStatuses: true,
+			wantErr:      true,
+		},
+		{
+			fixture: "quality_update.json",
+			want: []handledEvent{
+				{handler: "quality", from: "15550783881", detail: "102290129340398|FLAGGED|TIER_10K"},
+				{handler: "quality", from: "15550783881", detail: "102290129340398|UPGRADE|TIER_2K"},
+			},
+		},
+		{
+			fixture: "account_update.json",
+			want: []handledEvent{
+				{handler: "account", from: "15550783881", detail: "102290129340398|ACCOUNT_RESTRICTION||RESTRICTED_BIZ_INITIATED_MESSAGING@2025-06-25T12:00:00+0000"},
+				{handler: "account", detail: "102290129340398|DISABLED_UPDATE|SCHEDULE_FOR_DISABLE"},
+			},
+		},
+		{
+			fixture:    "account_update.json",
+			failHealth: true,
+			wantErr:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.fixture, func(t *testing.T) {
+			payload, err := os.ReadFile(filepath.Join("testdata", tt.fixture))
+			if err != nil {
+				t.Fatalf("reading fixture: %v", err)
+			}
+
+			handler := &recordingHandler{failMessageIDs: map[string]bool{}, failStatuses: tt.failStatuses, failHealth: tt.failHealth}
+			for _, id := range tt.failMessageIDs {
+				handler.failMessageIDs[id] = true
+			}
+
+			manager, err := newWebhookManager(WebhookManagerConfig{Logger: slog.New(slog.NewTextHandler(io.Discard, nil))}, handler)
+			if err != nil {
+				t.Fatalf("newWebhookManager: %v", err)
+			}
+
+			err = manager.HandleWebhook(context.Background(), payload)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("HandleWebhook() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if !reflect.DeepEqual(handler.handled, tt.want) {
+				t.Errorf("handled events:\n got %+v\nwant %+v", handler.handled, tt.want)
+			}
+		})
+	}
+}
+
+func TestWebhookManager_HandleWebhook_InvalidPayload(t *testing.T) {
+	manager, err := newWebhookManager(WebhookManagerConfig{Logger: slog.New(slog.NewTextHandler(io.Discard, nil))}, &recordingHandler{})
+	if err != nil {
+		t.Fatalf("newWebhookManager: %v", err)
+	}
+
+	if err := manager.HandleWebhook(context.Background(), []byte(`{"entry":`)); err == nil {
+		t.Error("HandleWebhook() must fail on a malformed payload")
+	}
+}
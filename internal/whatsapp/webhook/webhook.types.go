@@ -1,6 +1,11 @@
 package webhook
 
-import "github.com/webitel/im-providers-service/internal/whatsapp/messaging/components"
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/webitel/im-providers-service/internal/whatsapp/messaging/components"
+)
 
 type WhatsappApiNotificationPayloadSchemaType struct {
 	Object string  `json:"object"`
@@ -16,7 +21,9 @@ type Entry struct {
 type WebhookFieldEnum string
 
 const (
-	WebhookFieldEnumMessages WebhookFieldEnum = "messages"
+	WebhookFieldEnumMessages                 WebhookFieldEnum = "messages"
+	WebhookFieldEnumPhoneNumberQualityUpdate WebhookFieldEnum = "phone_number_quality_update"
+	WebhookFieldEnumAccountUpdate            WebhookFieldEnum = "account_update"
 )
 
 type Change struct {
@@ -33,6 +40,55 @@ type MessagesValue struct {
 	Messages         []Message       `json:"messages,omitempty"`
 }
 
+// PhoneNumberQualityUpdateValue reports a change of the phone number quality or messaging limit,
+// see https://developers.facebook.com/docs/graph-api/webhooks/reference/whatsapp-business-account/#phone_number_quality_update
+type PhoneNumberQualityUpdateValue struct {
+	DisplayPhoneNumber string `json:"display_phone_number"`
+	// Event is FLAGGED, UNFLAGGED, UPGRADE, DOWNGRADE and the like.
+	Event        string `json:"event"`
+	CurrentLimit string `json:"current_limit,omitempty"`
+	OldLimit     string `json:"old_limit,omitempty"`
+	// MaxDailyConversationsPerBusiness replaces CurrentLimit once the limit is shared by the business numbers.
+	MaxDailyConversationsPerBusiness string `json:"max_daily_conversations_per_business,omitempty"`
+}
+
+// AccountUpdateValue reports a change of the business account,
+// see https://developers.facebook.com/docs/graph-api/webhooks/reference/whatsapp-business-account/#account_update
+type AccountUpdateValue struct {
+	PhoneNumber     string            `json:"phone_number,omitempty"`
+	Event           string            `json:"event"`
+	RestrictionInfo []RestrictionInfo `json:"restriction_info,omitempty"`
+	BanInfo         *BanInfo          `json:"ban_info,omitempty"`
+}
+
+type RestrictionInfo struct {
+	RestrictionType string `json:"restriction_type"`
+	Expiration      string `json:"expiration,omitempty"`
+}
+
+type BanInfo struct {
+	WabaBanState BanStates `json:"waba_ban_state"`
+	WabaBanDate  string    `json:"waba_ban_date,omitempty"`
+}
+
+// BanStates is the ban state of the account, which Meta sends either as a string or as an array of one.
+type BanStates []string
+
+func (banStates *BanStates) UnmarshalJSON(data []byte) error {
+	var state string
+	if err := json.Unmarshal(data, &state); err == nil {
+		*banStates = BanStates{state}
+		return nil
+	}
+
+	var states []string
+	if err := json.Unmarshal(data, &states); err != nil {
+		return err
+	}
+	*banStates = states
+	return nil
+}
+
 type Error struct {
 	Code      int    `json:"code"`
 	Title     string `json:"title"`
@@ -49,9 +105,9 @@ type Metadata struct {
 }
 
 type SenderContact struct {
-	WaID            string
-	IdentityHashKey string
-	Profile         Profile
+	WaID            string  `json:"wa_id"`
+	IdentityHashKey string  `json:"identity_key_hash,omitempty"`
+	Profile         Profile `json:"profile"`
 }
 
 type Profile struct {
@@ -96,18 +152,22 @@ const (
 )
 
 type Message struct {
-	Id                                           string                                      `json:"id"`
-	From                                         string                                      `json:"from"`
-	Timestamp                                    string                                      `json:"timestamp"`
-	Type                                         NotificationMessageTypeEnum                 `json:"type"`
-	GroupId                                      string                                      `json:"group_id,omitempty"`
-	Context                                      NotificationPayloadMessageContextSchemaType `json:"context,omitempty"`
-	Errors                                       []Error                                     `json:"errors,omitempty"`
-	NotificationPayloadTextMessageSchemaType     `json:",inline"`
-	NotificationPayloadImageMessageSchemaType    `json:",inline"`
-	NotificationPayloadDocumentMessageSchemaType `json:",inline"`
-	NotificationPayloadLocationMessageSchemaType `json:",inline"`
-	NotificationPayloadContactMessageSchemaType  `json:",inline"`
+	Id                                              string                                      `json:"id"`
+	From                                            string                                      `json:"from"`
+	Timestamp                                       string                                      `json:"timestamp"`
+	Type                                            NotificationMessageTypeEnum                 `json:"type"`
+	GroupId                                         string                                      `json:"group_id,omitempty"`
+	Context                                         NotificationPayloadMessageContextSchemaType `json:"context,omitempty"`
+	Errors                                          []Error                                     `json:"errors,omitempty"`
+	NotificationPayloadTextMessageSchemaType        `json:",inline"`
+	NotificationPayloadImageMessageSchemaType       `json:",inline"`
+	NotificationPayloadDocumentMessageSchemaType    `json:",inline"`
+	NotificationPayloadLocationMessageSchemaType    `json:",inline"`
+	NotificationPayloadContactMessageSchemaType     `json:",inline"`
+	NotificationPayloadButtonMessageSchemaType      `json:",inline"`
+	NotificationPayloadInteractiveMessageSchemaType `json:",inline"`
+	NotificationPayloadOrderMessageSchemaType       `json:",inline"`
+	NotificationPayloadSystemMessageSchemaType      `json:",inline"`
 }
 
 type NotificationMessageTypeEnum string
@@ -180,3 +240,89 @@ type NotificationPayloadLocationMessageSchemaType struct {
 type NotificationPayloadContactMessageSchemaType struct {
 	Contacts []components.Contact `json:"contacts"`
 }
+
+// NotificationPayloadButtonMessageSchemaType is a tap on a quick-reply button of a template message.
+type NotificationPayloadButtonMessageSchemaType struct {
+	Button struct {
+		Payload string `json:"payload"`
+		Text    string `json:"text"`
+	} `json:"button,omitempty"`
+}
+
+const (
+	InteractiveReplyTypeButton = "button_reply"
+	InteractiveReplyTypeList   = "list_reply"
+	// InteractiveReplyTypeFlow is the completion response of a WhatsApp Flow.
+	InteractiveReplyTypeFlow = "nfm_reply"
+)
+
+type NotificationPayloadInteractiveMessageSchemaType struct {
+	Interactive struct {
+		Type        string `json:"type"`
+		ButtonReply struct {
+			ID    string `json:"id"`
+			Title string `json:"title"`
+		} `json:"button_reply,omitempty"`
+		ListReply struct {
+			ID          string `json:"id"`
+			Title       string `json:"title"`
+			Description string `json:"description,omitempty"`
+		} `json:"list_reply,omitempty"`
+		NfmReply FlowReply `json:"nfm_reply,omitempty"`
+	} `json:"interactive,omitempty"`
+}
+
+// FlowReply is the response of a completed flow. ResponseJSON holds the flow_token of the flow
+// message and the values of the flow form as a JSON string.
+type FlowReply struct {
+	Name         string `json:"name"`
+	Body         string `json:"body"`
+	ResponseJSON string `json:"response_json"`
+}
+
+// FlowToken extracts the flow_token from the response, empty when the response is not a JSON object.
+func (reply *FlowReply) FlowToken() string {
+	var response struct {
+		FlowToken string `json:"flow_token"`
+	}
+	if err := json.Unmarshal([]byte(reply.ResponseJSON), &response); err != nil {
+		return ""
+	}
+	return response.FlowToken
+}
+
+type NotificationPayloadOrderMessageSchemaType struct {
+	Order struct {
+		CatalogID    string             `json:"catalog_id"`
+		Text         string             `json:"text,omitempty"`
+		ProductItems []OrderProductItem `json:"product_items"`
+	} `json:"order,omitempty"`
+}
+
+type OrderProductItem struct {
+	ProductRetailerID string        `json:"product_retailer_id"`
+	Quantity          webhookNumber `json:"quantity"`
+	ItemPrice         webhookNumber `json:"item_price"`
+	Currency          string        `json:"currency"`
+}
+
+// webhookNumber accepts both JSON numbers and numeric strings:
+// order quantities and prices come in either form.
+type webhookNumber string
+
+func (number *webhookNumber) UnmarshalJSON(data []byte) error {
+	*number = webhookNumber(strings.Trim(string(data), `"`))
+	return nil
+}
+
+type NotificationPayloadSystemMessageSchemaType struct {
+	System struct {
+		Body string `json:"body"`
+		Type string `json:"type"`
+		// Before Graph API v12 the new number came as new_wa_id, later as wa_id.
+		NewWaID  string `json:"new_wa_id,omitempty"`
+		WaID     string `json:"wa_id,omitempty"`
+		Identity string `json:"identity,omitempty"`
+		Customer string `json:"customer,omitempty"`
+	} `json:"system,omitempty"`
+}
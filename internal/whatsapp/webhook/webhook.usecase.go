@@ -5,10 +5,13 @@ import (
 	"fmt"
 	"io"
 	"log/slog"
+	"strconv"
 	"time"
 
 	"github.com/webitel/im-providers-service/internal/core/model"
+	"github.com/webitel/im-providers-service/internal/whatsapp/analytics"
 	"github.com/webitel/im-providers-service/internal/whatsapp/common"
+	"github.com/webitel/im-providers-service/internal/whatsapp/gate"
 	"github.com/webitel/im-providers-service/internal/whatsapp/webhook/events"
 	"github.com/webitel/webitel-go-kit/pkg/errors"
 )
@@ -23,6 +26,29 @@ type CoreMessanger interface {
 	SendDocument(ctx context.Context, in *model.SendDocumentRequest) (*model.SendDocumentResponse, error)
 	SendContact(ctx context.Context, in *model.SendContactRequest) (*model.SendResponse, error)
 	SendLocation(ctx context.Context, in *model.SendLocationRequest) (*model.SendResponse, error)
+	SendInteractiveCallback(ctx context.Context, in *model.SendInteractiveCallbackRequest) error
+	SendSystemMessage(ctx context.Context, in *model.SendSystemMessageRequest) (*model.SendResponse, error)
+}
+
+// ContactIdentityChanger moves a customer to a new WhatsApp identity after a number change.
+type ContactIdentityChanger interface {
+	ChangeContactIdentity(ctx context.Context, to, from, changedFrom model.Peer, dc int) error
+}
+
+// ConversationRecorder keeps the conversation and pricing of sent messages for cost reporting.
+type ConversationRecorder interface {
+	RecordConversationEvents(ctx context.Context, events []analytics.ConversationEvent) error
+}
+
+// GateHealthUpdater stores the phone number quality and account restrictions Meta reports for the gates.
+type GateHealthUpdater interface {
+	ApplyHealthUpdate(ctx context.Context, update *gate.HealthUpdate) error
+}
+
+// SentMessageFinder returns the internal ID of a message sent through the gate by its wamid,
+// or an empty string when the message is unknown.
+type SentMessageFinder interface {
+	FindSentMessage(ctx context.Context, wamid string) (string, error)
 }
 
 type WhatsAppBusinessAccountResolveQuery struct {
@@ -40,25 +66,37 @@ type WhatsAppBusinessAccountResolver interface {
 type webhook struct {
 	logger                          *slog.Logger
 	coreMessanger                   CoreMessanger
+	contactIdentityChanger          ContactIdentityChanger
 	whatsAppBusinessAccountResolver WhatsAppBusinessAccountResolver
 	encryptor                       common.Encryptor
 	mediaUploader                   MediaUploader
+	conversationRecorder            ConversationRecorder
+	gateHealthUpdater               GateHealthUpdater
+	sentMessageFinder               SentMessageFinder
 }
 
 func newWebhook(
 	logger *slog.Logger,
 	coreMessanger CoreMessanger,
+	contactIdentityChanger ContactIdentityChanger,
 	whatsAppBusinessAccountResolver WhatsAppBusinessAccountResolver,
 	encryptor common.Encryptor,
 	mediaUploader MediaUploader,
+	conversationRecorder ConversationRecorder,
+	gateHealthUpdater GateHealthUpdater,
+	sentMessageFinder SentMessageFinder,
 ) *webhook {
 	log := logger.With("component", "whatsapp_webhook_usecase")
 	return &webhook{
 		logger:                          log,
 		coreMessanger:                   coreMessanger,
+		contactIdentityChanger:          contactIdentityChanger,
 		whatsAppBusinessAccountResolver: whatsAppBusinessAccountResolver,
 		encryptor:                       encryptor,
 		mediaUploader:                   mediaUploader,
+		conversationRecorder:            conversationRecorder,
+		gateHealthUpdater:               gateHealthUpdater,
+		sentMessageFinder:               sentMessageFinder,
 	}
 }
 
@@ -79,20 +117,15 @@ func (webhook *webhook) resolveWhatsappBusinessAccount(ctx context.Context, phon
 	return &preparedBusinessAccount, nil
 }
 
-func (webhook *webhook) uploadReceivedMedia(ctx context.Context, metadata model.UploadRequest, whatsAppBusinessAccount *common.WhatsappBusinessAccount) (model.UploadResponse, error) {
-	urlObj := metadata.URL
+// uploadReceivedMedia copies a received media asset into the internal storage.
+// The sha256 from the webhook is verified against the downloaded content.
+func (webhook *webhook) uploadReceivedMedia(ctx context.Context, metadata model.UploadRequest, sha256 string, whatsAppBusinessAccount *common.WhatsappBusinessAccount) (model.UploadResponse, error) {
 	mediaClient, err := whatsAppBusinessAccount.CreateMediaClient()
 	if err != nil {
 		return model.UploadResponse{}, errors.Wrap(err, errors.WithID("whatsapp.webhook.usecase.upload_received_media"), errors.WithValue("phone_number_id", whatsAppBusinessAccount.PhoneNumberID))
 	}
 
-	if urlObj == "" {
-		if urlObj, err = mediaClient.GetMediaURLByID(ctx, metadata.ExternalID); err != nil {
-			return model.UploadResponse{}, errors.Wrap(err, errors.WithID("whatsapp.webhook.usecase.upload_received_media"))
-		}
-	}
-
-	file, mime, err := mediaClient.DownloadMediaByURL(ctx, urlObj) // add fallback to retrive URL by ID in case of 404
+	file, mime, err := mediaClient.FetchMedia(ctx, metadata.ExternalID, metadata.URL, sha256)
 	if err != nil {
 		return model.UploadResponse{}, errors.Wrap(err, errors.WithID("whatsapp.webhook.usecase.upload_received_media"))
 	}
@@ -169,6 +202,15 @@ func extractPeerFromWebhookInput(senderPhoneNumber, senderName string) model.Pee
 	}
 }
 
+// captionText returns the media caption, which WhatsApp omits when the sender typed none.
+func captionText(caption *string) string {
+	if caption == nil {
+		return ""
+	}
+
+	return *caption
+}
+
 func (webhook *webhook) HandleDocumentMessage(ctx context.Context, documentEvent *events.DocumentMessageEvent) error {
 	log := webhook.logger.With("operation", "handle_document_message")
 
@@ -196,6 +238,7 @@ func (webhook *webhook) HandleDocumentMessage(ctx context.Context, documentEvent
 			URL:        documentEvent.Document.Link,
 			ExternalID: documentEvent.Document.ID,
 		},
+		documentEvent.Sha256,
 		whatsAppBusinessAccount,
 	)
 
@@ -208,7 +251,7 @@ func (webhook *webhook) HandleDocumentMessage(ctx context.Context, documentEvent
 		From: extractPeerFromWebhookInput(documentEvent.From, documentEvent.SenderName),
 		To:   extractPeerFromWhatsAppBusinessAccount(whatsAppBusinessAccount),
 		Document: model.DocumentRequest{
-			Body: *documentEvent.Document.Caption,
+			Body: captionText(documentEvent.Document.Caption),
 			Documents: []*model.Document{
 				{
 					FileName: documentEvent.Document.FileName,
@@ -256,6 +299,7 @@ func (webhook *webhook) HandleImageMessage(ctx context.Context, imageEvent *even
 			URL:        imageEvent.Image.Link,
 			ExternalID: imageEvent.MediaID,
 		},
+		imageEvent.Sha256,
 		whatsAppBusinessAccount,
 	)
 
@@ -274,7 +318,7 @@ func (webhook *webhook) HandleImageMessage(ctx context.Context, imageEvent *even
 					ID:       mediaMetadata.ID,
 				},
 			},
-			Body: *imageEvent.Image.Caption,
+			Body: captionText(imageEvent.Image.Caption),
 		},
 		DomainID: int64(whatsAppBusinessAccount.DC),
 	}
@@ -385,3 +429,376 @@ func (webhook *webhook) HandleContactsMessage(ctx context.Context, contacts *eve
 
 	return nil
 }
+
+// HandleCallbackMessage forwards a button or list reply as an interactive callback on the
+// message that carried the button. Without that message in the context, e.g. for a template
+// sent outside the service, the reply title is delivered as plain text instead.
+func (webhook *webhook) HandleCallbackMessage(ctx context.Context, callbackEvent *events.CallbackMessageEvent) error {
+	log := webhook.logger.With("operation", "handle_callback_message")
+
+	if callbackEvent == nil {
+		log.Warn("received nil pointer callback event")
+		return errors.InvalidArgument("received nil pointer callback event", errors.WithID("whatsapp.webhook.usecase.handle_callback_message"))
+	}
+
+	whatsAppBusinessAccount, err := webhook.resolveWhatsappBusinessAccount(ctx, callbackEvent.PhoneNumber.ID)
+	if err != nil {
+		log.Error("resolving whatsapp business account", "error", err)
+		return errors.Wrap(err, errors.WithID("whatsapp.webhook.usecase.handle_callback_message"))
+	}
+
+	if whatsAppBusinessAccount == nil {
+		return nil
+	}
+
+	var (
+		fromPeer = extractPeerFromWebhookInput(callbackEvent.From, callbackEvent.SenderName)
+		toPeer   = extractPeerFromWhatsAppBusinessAccount(whatsAppBusinessAccount)
+	)
+
+	// WhatsApp refers to the replied message by wamid; IM core knows it by the internal ID.
+	// A reply to a message that was not sent through this service goes as text.
+	var inReplyTo string
+	if wamid := callbackEvent.Context.RepliedToMessageID; wamid != "" {
+		inReplyTo, err = webhook.sentMessageFinder.FindSentMessage(ctx, wamid)
+		if err != nil {
+			log.Warn("finding replied whatsapp message", "error", err, "wamid", wamid)
+		}
+	}
+
+	if inReplyTo == "" {
+		body := callbackEvent.Title
+		if body == "" {
+			body = callbackEvent.Data
+		}
+
+		if _, err := webhook.coreMessanger.SendText(ctx, &model.SendTextRequest{From: fromPeer, To: toPeer, Body: body, DomainID: int64(whatsAppBusinessAccount.DC)}); err != nil {
+			log.Error("sending callback as text message to IM core", "error", err)
+			return errors.Wrap(err, errors.WithID("whatsapp.webhook.usecase.handle_callback_message"))
+		}
+		return nil
+	}
+
+	callbackRequest := model.SendInteractiveCallbackRequest{
+		From:         fromPeer,
+		To:           toPeer,
+		DomainID:     int64(whatsAppBusinessAccount.DC),
+		InReplyTo:    inReplyTo,
+		ButtonCode:   callbackEvent.Code,
+		CallbackData: callbackEvent.Data,
+	}
+
+	if err := webhook.coreMessanger.SendInteractiveCallback(ctx, &callbackRequest); err != nil {
+		log.Error("sending interactive callback to IM core", "error", err, "in_reply_to", callbackRequest.InReplyTo)
+		return errors.Wrap(err, errors.WithID("whatsapp.webhook.usecase.handle_callback_message"))
+	}
+
+	return nil
+}
+
+func (webhook *webhook) HandleOrderMessage(ctx context.Context, orderEvent *events.OrderMessageEvent) error {
+	log := webhook.logger.With("operation", "handle_order_message")
+
+	if orderEvent == nil {
+		log.Warn("received nil pointer order event")
+		return errors.InvalidArgument("received nil pointer order event", errors.WithID("whatsapp.webhook.usecase.handle_order_message"))
+	}
+
+	whatsAppBusinessAccount, err := webhook.resolveWhatsappBusinessAccount(ctx, orderEvent.PhoneNumber.ID)
+	if err != nil {
+		log.Error("resolving whatsapp business account", "error", err)
+		return errors.Wrap(err, errors.WithID("whatsapp.webhook.usecase.handle_order_message"))
+	}
+
+	if whatsAppBusinessAccount == nil {
+		return nil
+	}
+
+	orderMessage := model.SendSystemMessageRequest{
+		From:       extractPeerFromWebhookInput(orderEvent.From, orderEvent.SenderName),
+		To:         extractPeerFromWhatsAppBusinessAccount(whatsAppBusinessAccount),
+		DomainID:   int64(whatsAppBusinessAccount.DC),
+		Type:       string(NotificationMessageTypeOrder),
+		Body:       orderSummary(orderEvent),
+		Metadata:   orderEvent.Metadata(),
+		ExternalID: orderEvent.MessageID,
+	}
+
+	if _, err := webhook.coreMessanger.SendSystemMessage(ctx, &orderMessage); err != nil {
+		log.Error("sending order message to IM core", "error", err, "catalog_id", orderEvent.CatalogID)
+		return errors.Wrap(err, errors.WithID("whatsapp.webhook.usecase.handle_order_message"))
+	}
+
+	return nil
+}
+
+func orderSummary(orderEvent *events.OrderMessageEvent) string {
+	summary := fmt.Sprintf("Order: %d item(s)", len(orderEvent.Items))
+	if orderEvent.Text != "" {
+		summary += "\n" + orderEvent.Text
+	}
+	return summary
+}
+
+// HandleSystemMessage delivers a WhatsApp system notification to the conversation.
+// A number change also registers the new identity of the customer.
+func (webhook *webhook) HandleSystemMessage(ctx context.Context, systemEvent *events.SystemMessageEvent) error {
+	log := webhook.logger.With("operation", "handle_system_message")
+
+	if systemEvent == nil {
+		log.Warn("received nil pointer system event")
+		return errors.InvalidArgument("received nil pointer system event", errors.WithID("whatsapp.webhook.usecase.handle_system_message"))
+	}
+
+	whatsAppBusinessAccount, err := webhook.resolveWhatsappBusinessAccount(ctx, systemEvent.PhoneNumber.ID)
+	if err != nil {
+		log.Error("resolving whatsapp business account", "error", err)
+		return errors.Wrap(err, errors.WithID("whatsapp.webhook.usecase.handle_system_message"))
+	}
+
+	if whatsAppBusinessAccount == nil {
+		return nil
+	}
+
+	var (
+		fromPeer = extractPeerFromWebhookInput(systemEvent.From, systemEvent.SenderName)
+		toPeer   = extractPeerFromWhatsAppBusinessAccount(whatsAppBusinessAccount)
+		metadata = map[string]any{"wa_id": systemEvent.From}
+	)
+
+	if systemEvent.Type == events.SystemMessageTypeCustomerChangedNumber && systemEvent.NewWaID != "" {
+		changedFrom := extractPeerFromWebhookInput(systemEvent.NewWaID, systemEvent.SenderName)
+		if err := webhook.contactIdentityChanger.ChangeContactIdentity(ctx, toPeer, fromPeer, changedFrom, whatsAppBusinessAccount.DC); err != nil {
+			log.Error("changing contact identity", "error", err, "from", systemEvent.From, "new_wa_id", systemEvent.NewWaID)
+			return errors.Wrap(err, errors.WithID("whatsapp.webhook.usecase.handle_system_message"))
+		}
+		metadata["new_wa_id"] = systemEvent.NewWaID
+		// The contact now carries the new identity, so the notice is delivered from it.
+		fromPeer = changedFrom
+	}
+
+	systemMessage := model.SendSystemMessageRequest{
+		From:       fromPeer,
+		To:         toPeer,
+		DomainID:   int64(whatsAppBusinessAccount.DC),
+		Type:       systemEvent.Type,
+		Body:       systemEvent.Body,
+		Metadata:   metadata,
+		ExternalID: systemEvent.MessageID,
+	}
+
+	if _, err := webhook.coreMessanger.SendSystemMessage(ctx, &systemMessage); err != nil {
+		log.Error("sending system message to IM core", "error", err, "type", systemEvent.Type)
+		return errors.Wrap(err, errors.WithID("whatsapp.webhook.usecase.handle_system_message"))
+	}
+
+	return nil
+}
+
+// HandleUnsupportedMessage delivers a text placeholder, so agents see that the customer sent something.
+func (webhook *webhook) HandleUnsupportedMessage(ctx context.Context, unsupportedEvent *events.UnsupportedMessageEvent) error {
+	log := webhook.logger.With("operation", "handle_unsupported_message")
+
+	if unsupportedEvent == nil {
+		log.Warn("received nil pointer unsupported message event")
+		return errors.InvalidArgument("received nil pointer unsupported message event", errors.WithID("whatsapp.webhook.usecase.handle_unsupported_message"))
+	}
+
+	whatsAppBusinessAccount, err := webhook.resolveWhatsappBusinessAccount(ctx, unsupportedEvent.PhoneNumber.ID)
+	if err != nil {
+		log.Error("resolving whatsapp business account", "error", err)
+		return errors.Wrap(err, errors.WithID("whatsapp.webhook.usecase.handle_unsupported_message"))
+	}
+
+	if whatsAppBusinessAccount == nil {
+		return nil
+	}
+
+	log.Info("delivering placeholder for unsupported message", "type", unsupportedEvent.MessageType, "reason", unsupportedEvent.Reason)
+
+	placeholder := model.SendTextRequest{
+		From:     extractPeerFromWebhookInput(unsupportedEvent.From, unsupportedEvent.SenderName),
+		To:       extractPeerFromWhatsAppBusinessAccount(whatsAppBusinessAccount),
+		Body:     unsupportedEvent.Placeholder(),
+		DomainID: int64(whatsAppBusinessAccount.DC),
+	}
+
+	if _, err := webhook.coreMessanger.SendText(ctx, &placeholder); err != nil {
+		log.Error("sending unsupported message placeholder to IM core", "error", err)
+		return errors.Wrap(err, errors.WithID("whatsapp.webhook.usecase.handle_unsupported_message"))
+	}
+
+	return nil
+}
+
+// HandleStatuses records the conversation and pricing of the sent messages. Statuses
+// without either, e.g. failed messages, carry nothing to bill and are skipped.
+func (webhook *webhook) HandleStatuses(ctx context.Context, statusEvent *events.StatusEvent) error {
+	log := webhook.logger.With("operation", "handle_statuses")
+
+	if statusEvent == nil {
+		log.Warn("received nil pointer status event")
+		return errors.InvalidArgument("received nil pointer status event", errors.WithID("whatsapp.webhook.usecase.handle_statuses"))
+	}
+
+	whatsAppBusinessAccount, err := webhook.resolveWhatsappBusinessAccount(ctx, statusEvent.PhoneNumber.ID)
+	if err != nil {
+		log.Error("resolving whatsapp business account", "error", err)
+		return errors.Wrap(err, errors.WithID("whatsapp.webhook.usecase.handle_statuses"))
+	}
+
+	if whatsAppBusinessAccount == nil {
+		return nil
+	}
+
+	conversationEvents := conversationEvents(whatsAppBusinessAccount, statusEvent.Statuses)
+	if len(conversationEvents) == 0 {
+		return nil
+	}
+
+	if err := webhook.conversationRecorder.RecordConversationEvents(ctx, conversationEvents); err != nil {
+		log.Error("recording conversation events", "error", err, "gate_id", whatsAppBusinessAccount.ID.String())
+		return errors.Wrap(err, errors.WithID("whatsapp.webhook.usecase.handle_statuses"))
+	}
+
+	return nil
+}
+
+func conversationEvents(account *common.WhatsappBusinessAccount, statuses []events.MessageStatus) []analytics.ConversationEvent {
+	conversationEvents := make([]analytics.ConversationEvent, 0, len(statuses))
+	for _, status := range statuses {
+		if status.ConversationID == "" && status.PricingModel == "" {
+			continue
+		}
+
+		occurredAt := unixTimestamp(status.Timestamp)
+		if occurredAt == nil {
+			continue
+		}
+
+		conversationEvents = append(conversationEvents, analytics.ConversationEvent{
+			GateID:                account.ID,
+			DomainID:              int64(account.DC),
+			ConversationID:        status.ConversationID,
+			MessageID:             status.MessageID,
+			RecipientID:           status.RecipientID,
+			Status:                status.Status,
+			OriginType:            status.ConversationOriginType,
+			ConversationExpiresAt: unixTimestamp(status.ConversationExpirationTimestamp),
+			Billable:              status.Billable,
+			PricingModel:          status.PricingModel,
+			Category:              status.PricingCategory,
+			OccurredAt:            *occurredAt,
+		})
+	}
+
+	return conversationEvents
+}
+
+// unixTimestamp parses a webhook timestamp in unix seconds, nil when it is empty or malformed.
+func unixTimestamp(timestamp string) *time.Time {
+	seconds, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil || seconds <= 0 {
+		return nil
+	}
+
+	parsed := time.Unix(seconds, 0).UTC()
+	return &parsed
+}
+
+// restrictionExpirationLayout is the format of account_update restriction expirations, e.g. 2024-05-08T12:00:00+0000.
+const restrictionExpirationLayout = "2006-01-02T15:04:05-0700"
+
+// HandlePhoneNumberQualityUpdate flags or unflags the gates of the phone number and stores its messaging limit tier.
+func (webhook *webhook) HandlePhoneNumberQualityUpdate(ctx context.Context, qualityEvent *events.PhoneNumberQualityEvent) error {
+	if qualityEvent == nil {
+		webhook.logger.Warn("received nil pointer phone number quality event")
+		return errors.InvalidArgument("received nil pointer phone number quality event", errors.WithID("whatsapp.webhook.usecase.handle_phone_number_quality_update"))
+	}
+
+	update := &gate.HealthUpdate{
+		BusinessID:         qualityEvent.BusinessAccountID,
+		DisplayPhoneNumber: qualityEvent.DisplayPhoneNumber,
+		Event:              qualityEvent.Event,
+	}
+
+	switch qualityEvent.Event {
+	case "FLAGGED":
+		flagged := true
+		update.Flagged = &flagged
+	case "UNFLAGGED", "UPGRADE", "DOWNGRADE":
+		flagged := false
+		update.Flagged = &flagged
+	}
+
+	if qualityEvent.MessagingLimitTier != "" {
+		update.MessagingLimitTier = &qualityEvent.MessagingLimitTier
+	}
+
+	return webhook.applyHealthUpdate(ctx, update, "whatsapp.webhook.usecase.handle_phone_number_quality_update")
+}
+
+// HandleAccountUpdate stores the restrictions and the ban state of the business account.
+// Other account events do not affect the gates and are skipped.
+func (webhook *webhook) HandleAccountUpdate(ctx context.Context, accountEvent *events.AccountUpdateEvent) error {
+	if accountEvent == nil {
+		webhook.logger.Warn("received nil pointer account update event")
+		return errors.InvalidArgument("received nil pointer account update event", errors.WithID("whatsapp.webhook.usecase.handle_account_update"))
+	}
+
+	update := &gate.HealthUpdate{
+		BusinessID:         accountEvent.BusinessAccountID,
+		DisplayPhoneNumber: accountEvent.PhoneNumber,
+		Event:              accountEvent.Event,
+	}
+
+	switch accountEvent.Event {
+	case "ACCOUNT_RESTRICTION":
+		restrictions := accountRestrictions(accountEvent.Restrictions)
+		update.Restrictions = &restrictions
+	case "DISABLED_UPDATE":
+		update.BanState = &accountEvent.BanState
+	default:
+		webhook.logger.Debug("skipping account update event", "event", accountEvent.Event, "business_account_id", accountEvent.BusinessAccountID)
+		return nil
+	}
+
+	return webhook.applyHealthUpdate(ctx, update, "whatsapp.webhook.usecase.handle_account_update")
+}
+
+func (webhook *webhook) applyHealthUpdate(ctx context.Context, update *gate.HealthUpdate, id string) error {
+	if err := webhook.gateHealthUpdater.ApplyHealthUpdate(ctx, update); err != nil {
+		webhook.logger.Error("applying gate health update", "error", err, "event", update.Event, "business_account_id", update.BusinessID)
+		return errors.Wrap(err, errors.WithID(id))
+	}
+
+	return nil
+}
+
+func accountRestrictions(accountRestrictions []events.AccountRestriction) []gate.Restriction {
+	restrictions := make([]gate.Restriction, 0, len(accountRestrictions))
+	for _, restriction := range accountRestrictions {
+		restrictions = append(restrictions, gate.Restriction{
+			Type:       restriction.Type,
+			Expiration: restrictionExpiration(restriction.Expiration),
+		})
+	}
+
+	return restrictions
+}
+
+// restrictionExpiration parses the expiration of a restriction; a missing or malformed one is
+// kept as no expiration, so the restriction holds until Meta lifts it.
+func restrictionExpiration(expiration string) *time.Time {
+	if expiration == "" {
+		return nil
+	}
+
+	for _, layout := range []string{restrictionExpirationLayout, time.RFC3339} {
+		if parsed, err := time.Parse(layout, expiration); err == nil {
+			return &parsed
+		}
+	}
+
+	return nil
+}
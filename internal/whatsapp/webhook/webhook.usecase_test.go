@@ -0,0 +1,316 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/webitel/im-providers-service/internal/core/model"
+	"github.com/webitel/im-providers-service/internal/whatsapp/common"
+	"github.com/webitel/im-providers-service/internal/whatsapp/gate"
+	"github.com/webitel/im-providers-service/internal/whatsapp/webhook/events"
+)
+
+func readFixtureStatuses(t *testing.T, fixture string) []Status {
+	t.Helper()
+
+	payload, err := os.ReadFile(filepath.Join("testdata", fixture))
+	if err != nil {
+		t.Fatalf("reading fixture: %v", err)
+	}
+
+	var notification struct {
+		Entry []struct {
+			Changes []struct {
+				Value MessagesValue `json:"value"`
+			} `json:"changes"`
+		} `json:"entry"`
+	}
+	if err := json.Unmarshal(payload, &notification); err != nil {
+		t.Fatalf("unmarshaling fixture: %v", err)
+	}
+
+	return notification.Entry[0].Changes[0].Value.Statuses
+}
+
+func TestConversationEvents(t *testing.T) {
+	account := &common.WhatsappBusinessAccount{ID: uuid.MustParse("4f8a3f0e-7c1d-4f5e-9a57-6f2b1c3d4e5f"), DC: 7}
+
+	conversationEvents := conversationEvents(account, messageStatuses(readFixtureStatuses(t, "statuses_pricing.json")))
+
+	// the read status carries no pricing and the failed message is not billed: both are skipped
+	if len(conversationEvents) != 2 {
+		t.Fatalf("got %d events, want 2: %+v", len(conversationEvents), conversationEvents)
+	}
+
+	utility := conversationEvents[0]
+	if utility.GateID != account.ID || utility.DomainID != 7 {
+		t.Errorf("gate = %s, domain = %d", utility.GateID, utility.DomainID)
+	}
+	if utility.ConversationID != "6ceb9d929c8ff7c3f9e4ec5b9ac9b5f3" || utility.OriginType != "utility" || utility.Category != "utility" || !utility.Billable || utility.PricingModel != "CBP" {
+		t.Errorf("utility event = %+v", utility)
+	}
+	if !utility.OccurredAt.Equal(time.Unix(1750263773, 0)) {
+		t.Errorf("occurred at = %s", utility.OccurredAt)
+	}
+	if utility.ConversationExpiresAt == nil || !utility.ConversationExpiresAt.Equal(time.Unix(1750350180, 0)) {
+		t.Errorf("conversation expires at = %v", utility.ConversationExpiresAt)
+	}
+
+	service := conversationEvents[1]
+	if service.Billable || service.Category != "service" || service.Status != "delivered" || service.ConversationExpiresAt != nil {
+		t.Errorf("service event = %+v", service)
+	}
+}
+
+func TestConversationEvents_PerMessagePricing(t *testing.T) {
+	account := &common.WhatsappBusinessAccount{ID: uuid.New(), DC: 1}
+
+	conversationEvents := conversationEvents(account, messageStatuses(readFixtureStatuses(t, "statuses.json")))
+	if len(conversationEvents) != 1 {
+		t.Fatalf("got %d events, want 1", len(conversationEvents))
+	}
+
+	if event := conversationEvents[0]; event.ConversationID != "" || event.PricingModel != "PMP" || event.Category != "marketing" || !event.Billable {
+		t.Errorf("event = %+v", event)
+	}
+}
+
+type fakeGateHealthUpdater struct {
+	updates []*gate.HealthUpdate
+}
+
+func (updater *fakeGateHealthUpdater) ApplyHealthUpdate(_ context.Context, update *gate.HealthUpdate) error {
+	updater.updates = append(updater.updates, update)
+	return nil
+}
+
+func newHealthTestWebhook(updater GateHealthUpdater) *webhook {
+	return newWebhook(slog.New(slog.NewTextHandler(io.Discard, nil)), nil, nil, nil, nil, nil, nil, updater, nil)
+}
+
+func TestWebhook_HandlePhoneNumberQualityUpdate(t *testing.T) {
+	tests := []struct {
+		event       string
+		tier        string
+		wantFlagged *bool
+		wantTier    *string
+	}{
+		{event: "FLAGGED", tier: "TIER_1K", wantFlagged: ptr(true), wantTier: ptr("TIER_1K")},
+		{event: "UNFLAGGED", wantFlagged: ptr(false)},
+		{event: "DOWNGRADE", tier: "TIER_250", wantFlagged: ptr(false), wantTier: ptr("TIER_250")},
+		{event: "ONBOARDING", tier: "TIER_250", wantTier: ptr("TIER_250")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.event, func(t *testing.T) {
+			updater := &fakeGateHealthUpdater{}
+			err := newHealthTestWebhook(updater).HandlePhoneNumberQualityUpdate(context.Background(), events.NewPhoneNumberQualityEvent("waba-1", "+1 555-078-3881", tt.event, tt.tier))
+			if err != nil {
+				t.Fatalf("HandlePhoneNumberQualityUpdate: %v", err)
+			}
+
+			if len(updater.updates) != 1 {
+				t.Fatalf("got %d updates, want 1", len(updater.updates))
+			}
+			update := updater.updates[0]
+			if update.BusinessID != "waba-1" || update.DisplayPhoneNumber != "+1 555-078-3881" || update.Event != tt.event {
+				t.Errorf("update = %+v", update)
+			}
+			if !equalPointers(update.Flagged, tt.wantFlagged) {
+				t.Errorf("flagged = %v, want %v", update.Flagged, tt.wantFlagged)
+			}
+			if !equalPointers(update.MessagingLimitTier, tt.wantTier) {
+				t.Errorf("messaging limit tier = %v, want %v", update.MessagingLimitTier, tt.wantTier)
+			}
+			if update.Restrictions != nil || update.BanState != nil {
+				t.Errorf("quality update changes the account: %+v", update)
+			}
+		})
+	}
+}
+
+func TestWebhook_HandleAccountUpdate(t *testing.T) {
+	updater := &fakeGateHealthUpdater{}
+	webhook := newHealthTestWebhook(updater)
+
+	restricted := events.NewAccountUpdateEvent("waba-1", "15550783881", "ACCOUNT_RESTRICTION", []events.AccountRestriction{
+		{Type: "RESTRICTED_BIZ_INITIATED_MESSAGING", Expiration: "2025-06-25T12:00:00+0000"},
+		{Type: "RESTRICTED_CUSTOMER_INITIATED_MESSAGING", Expiration: "2025-06-26T09:30:00Z"},
+		{Type: "RESTRICTED_ADD_PHONE_NUMBER_ACTION"},
+	}, "")
+	for _, accountEvent := range []*events.AccountUpdateEvent{
+		restricted,
+		events.NewAccountUpdateEvent("waba-1", "", "ACCOUNT_RESTRICTION", nil, ""),
+		events.NewAccountUpdateEvent("waba-1", "", "DISABLED_UPDATE", nil, "DISABLE"),
+		events.NewAccountUpdateEvent("waba-1", "", "VERIFIED_ACCOUNT", nil, ""),
+	} {
+		if err := webhook.HandleAccountUpdate(context.Background(), accountEvent); err != nil {
+			t.Fatalf("HandleAccountUpdate(%s): %v", accountEvent.Event, err)
+		}
+	}
+
+	// the VERIFIED_ACCOUNT event does not affect the gates
+	if len(updater.updates) != 3 {
+		t.Fatalf("got %d updates, want 3", len(updater.updates))
+	}
+
+	restrictions := *updater.updates[0].Restrictions
+	if len(restrictions) != 3 {
+		t.Fatalf("restrictions = %+v", restrictions)
+	}
+	if restrictions[0].Type != "RESTRICTED_BIZ_INITIATED_MESSAGING" || restrictions[0].Expiration == nil || !restrictions[0].Expiration.Equal(time.Date(2025, 6, 25, 12, 0, 0, 0, time.UTC)) {
+		t.Errorf("restriction = %+v", restrictions[0])
+	}
+	if restrictions[1].Expiration == nil || !restrictions[1].Expiration.Equal(time.Date(2025, 6, 26, 9, 30, 0, 0, time.UTC)) {
+		t.Errorf("restriction = %+v", restrictions[1])
+	}
+	if restrictions[2].Expiration != nil {
+		t.Errorf("restriction without expiration = %+v", restrictions[2])
+	}
+
+	// a restriction update without restrictions lifts them
+	if lifted := updater.updates[1].Restrictions; lifted == nil || len(*lifted) != 0 {
+		t.Errorf("lifted restrictions = %v", lifted)
+	}
+
+	if banState := updater.updates[2].BanState; banState == nil || *banState != "DISABLE" || updater.updates[2].Restrictions != nil {
+		t.Errorf("ban update = %+v", updater.updates[2])
+	}
+}
+
+type fakeCoreMessanger struct {
+	CoreMessanger
+	texts     []*model.SendTextRequest
+	callbacks []*model.SendInteractiveCallbackRequest
+	systems   []*model.SendSystemMessageRequest
+}
+
+func (messanger *fakeCoreMessanger) SendSystemMessage(_ context.Context, in *model.SendSystemMessageRequest) (*model.SendResponse, error) {
+	messanger.systems = append(messanger.systems, in)
+	return &model.SendResponse{}, nil
+}
+
+func (messanger *fakeCoreMessanger) SendText(_ context.Context, in *model.SendTextRequest) (*model.SendTextResponse, error) {
+	messanger.texts = append(messanger.texts, in)
+	return &model.SendTextResponse{}, nil
+}
+
+func (messanger *fakeCoreMessanger) SendInteractiveCallback(_ context.Context, in *model.SendInteractiveCallbackRequest) error {
+	messanger.callbacks = append(messanger.callbacks, in)
+	return nil
+}
+
+type fakeResolver struct{}
+
+func (fakeResolver) Resolve(context.Context, *WhatsAppBusinessAccountResolveQuery) (*common.WhatsappBusinessAccount, error) {
+	return &common.WhatsappBusinessAccount{PhoneNumberID: "phone-1", AccessTokenDecrypted: "token", DC: 1}, nil
+}
+
+type plainEncryptor struct{}
+
+func (plainEncryptor) Encrypt(plaintext string) (string, error)  { return plaintext, nil }
+func (plainEncryptor) Decrypt(ciphertext string) (string, error) { return ciphertext, nil }
+
+type fakeSentMessageFinder struct {
+	sent map[string]string
+	err  error
+}
+
+func (finder fakeSentMessageFinder) FindSentMessage(_ context.Context, wamid string) (string, error) {
+	return finder.sent[wamid], finder.err
+}
+
+func TestWebhook_HandleCallbackMessage(t *testing.T) {
+	messageID := uuid.NewString()
+	tests := []struct {
+		name          string
+		repliedTo     string
+		finder        fakeSentMessageFinder
+		wantInReplyTo string
+	}{
+		{name: "sent message", repliedTo: "wamid.sent", finder: fakeSentMessageFinder{sent: map[string]string{"wamid.sent": messageID}}, wantInReplyTo: messageID},
+		{name: "unknown message", repliedTo: "wamid.other", finder: fakeSentMessageFinder{sent: map[string]string{"wamid.sent": messageID}}},
+		{name: "lookup failure", repliedTo: "wamid.sent", finder: fakeSentMessageFinder{err: errors.New("redis down")}},
+		{name: "no reply context", finder: fakeSentMessageFinder{sent: map[string]string{"wamid.sent": messageID}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			messanger := &fakeCoreMessanger{}
+			webhook := newWebhook(slog.New(slog.NewTextHandler(io.Discard, nil)), messanger, nil, fakeResolver{}, plainEncryptor{}, nil, nil, nil, tt.finder)
+
+			event := events.NewCallbackMessageEvent(events.BaseMessageEvent{
+				From:        "380501234567",
+				PhoneNumber: events.BusinessPhoneNumber{ID: "phone-1"},
+				Context:     events.MessageContext{RepliedToMessageID: tt.repliedTo},
+			}, "yes", "payload", "Yes")
+			if err := webhook.HandleCallbackMessage(context.Background(), event); err != nil {
+				t.Fatalf("HandleCallbackMessage: %v", err)
+			}
+
+			if tt.wantInReplyTo == "" {
+				if len(messanger.callbacks) != 0 || len(messanger.texts) != 1 || messanger.texts[0].Body != "Yes" {
+					t.Fatalf("callbacks = %+v, texts = %+v", messanger.callbacks, messanger.texts)
+				}
+				return
+			}
+
+			if len(messanger.texts) != 0 || len(messanger.callbacks) != 1 {
+				t.Fatalf("callbacks = %+v, texts = %+v", messanger.callbacks, messanger.texts)
+			}
+			if callback := messanger.callbacks[0]; callback.InReplyTo != tt.wantInReplyTo || callback.ButtonCode != "yes" || callback.CallbackData != "payload" {
+				t.Errorf("callback = %+v", callback)
+			}
+		})
+	}
+}
+
+type fakeContactIdentityChanger struct {
+	from, changedFrom model.Peer
+}
+
+func (changer *fakeContactIdentityChanger) ChangeContactIdentity(_ context.Context, _, from, changedFrom model.Peer, _ int) error {
+	changer.from, changer.changedFrom = from, changedFrom
+	return nil
+}
+
+func TestWebhook_HandleSystemMessage_CustomerChangedNumber(t *testing.T) {
+	messanger := &fakeCoreMessanger{}
+	changer := &fakeContactIdentityChanger{}
+	webhook := newWebhook(slog.New(slog.NewTextHandler(io.Discard, nil)), messanger, changer, fakeResolver{}, plainEncryptor{}, nil, nil, nil, nil)
+
+	event := events.NewSystemMessageEvent(events.BaseMessageEvent{
+		From:        "380501234567",
+		PhoneNumber: events.BusinessPhoneNumber{ID: "phone-1"},
+	}, events.SystemMessageTypeCustomerChangedNumber, "User A changed from 380501234567 to 380671234567", "380671234567")
+	if err := webhook.HandleSystemMessage(context.Background(), event); err != nil {
+		t.Fatalf("HandleSystemMessage: %v", err)
+	}
+
+	if changer.from.Sub != "380501234567" || changer.changedFrom.Sub != "380671234567" {
+		t.Errorf("identity change = %+v -> %+v", changer.from, changer.changedFrom)
+	}
+	if len(messanger.systems) != 1 {
+		t.Fatalf("system messages = %+v", messanger.systems)
+	}
+	if system := messanger.systems[0]; system.From.Sub != "380671234567" || system.Metadata["wa_id"] != "380501234567" {
+		t.Errorf("system message = %+v", system)
+	}
+}
+
+func ptr[T any](v T) *T { return &v }
+
+func equalPointers[T comparable](got, want *T) bool {
+	if got == nil || want == nil {
+		return got == want
+	}
+	return *got == *want
+}
@@ -1,6 +1,7 @@
 package webhook
 
 import (
+	imcontact "github.com/webitel/im-providers-service/infra/client/grpc/im-contact"
 	imgateway "github.com/webitel/im-providers-service/infra/client/grpc/im-gateway"
 	"github.com/webitel/im-providers-service/internal/core/service"
 	"github.com/webitel/im-providers-service/internal/whatsapp/common"
@@ -16,11 +17,15 @@ func NewWebhookModule(
 	coreMessanger CoreMessanger,
 	whatsAppBusinessAccountResolver WhatsAppBusinessAccountResolver,
 	client *imgateway.Client,
+	contactClient *imcontact.Client,
 	media service.MediaManager,
+	conversationRecorder ConversationRecorder,
+	gateHealthUpdater GateHealthUpdater,
+	sentMessageFinder SentMessageFinder,
 ) (*webhookModule, error) {
 	var (
-		coreMessangerDecorated = newDecoratedCoreMessanger(coreMessanger, client)
-		webhookUsecase         = newWebhook(config.Logger, coreMessangerDecorated, whatsAppBusinessAccountResolver, encryptor, media)
+		coreMessangerDecorated = newDecoratedCoreMessanger(coreMessanger, client, contactClient)
+		webhookUsecase         = newWebhook(config.Logger, coreMessangerDecorated, coreMessangerDecorated, whatsAppBusinessAccountResolver, encryptor, media, conversationRecorder, gateHealthUpdater, sentMessageFinder)
 	)
 
 	webhookMaanager, err := newWebhookManager(config, webhookUsecase)
@@ -0,0 +1,20 @@
+-- +goose Up
+-- facebook_profile stores the Messenger Profile configured for a Facebook gate
+-- (persistent menu, get started, greeting, ice breakers). Localized fields are
+-- JSON objects keyed by Messenger locale, "default" included.
+-- It is the source of truth for re-pushing the profile after a token rotation.
+CREATE TABLE IF NOT EXISTS im_provider.facebook_profile (
+    gate_id         UUID PRIMARY KEY REFERENCES im_provider.facebook(gate_id) ON DELETE CASCADE,
+    persistent_menu JSONB,
+    get_started     TEXT NOT NULL DEFAULT '',
+    greeting        JSONB,
+    ice_breakers    JSONB,
+    updated_at      TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE TRIGGER tr_facebook_profile_updated
+BEFORE UPDATE ON im_provider.facebook_profile
+FOR EACH ROW EXECUTE FUNCTION im_provider.update_timestamp();
+
+-- +goose Down
+DROP TABLE IF EXISTS im_provider.facebook_profile;
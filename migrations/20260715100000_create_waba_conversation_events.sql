@@ -0,0 +1,28 @@
+-- +goose Up
+-- waba_conversation_events keeps the conversation and pricing reported by the statuses
+-- of sent WhatsApp messages, for cost reporting. A message has a single row that later
+-- statuses update. There is no foreign key to the gate: billing history outlives it.
+create table if not exists "im_provider"."waba_conversation_events"(
+  "gate_id" uuid not null,
+  "dc" bigint not null,
+  "conversation_id" text not null default '',
+  "message_id" text not null check (trim("message_id") <> ''),
+  "recipient_id" text not null default '',
+  "status" text not null,
+  "origin_type" text not null default '',
+  "conversation_expires_at" timestamp with time zone,
+  "billable" boolean not null default false,
+  "pricing_model" text not null default '',
+  "category" text not null default '',
+  "occurred_at" timestamp with time zone not null,
+  "status_at" timestamp with time zone not null,
+
+  primary key ("gate_id", "conversation_id", "message_id")
+);
+
+create index if not exists "waba_conversation_events_dc_occurred_at_idx"
+  on "im_provider"."waba_conversation_events" ("dc", "occurred_at");
+
+
+-- +goose Down
+drop table if exists "im_provider"."waba_conversation_events";
@@ -0,0 +1,71 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- Phone number health reported by the phone_number_quality_update and account_update
+-- webhooks. Restrictions are a JSON array of {"type", "expiration"} objects.
+alter table "im_provider"."gate_waba"
+  add column if not exists "quality_rating" text not null default '',
+  add column if not exists "messaging_limit_tier" text not null default '',
+  add column if not exists "flagged" boolean not null default false,
+  add column if not exists "ban_state" text not null default '',
+  add column if not exists "restrictions" jsonb not null default '[]',
+  add column if not exists "health_updated_at" timestamp with time zone;
+
+-- A WhatsApp gate is in error while Meta flags its number, restricts or disables its account.
+DROP VIEW IF EXISTS im_provider.gate_summary;
+
+CREATE VIEW im_provider.gate_summary AS
+SELECT
+    g.id,
+    g.name,
+    g.type,
+    CASE
+        WHEN NOT g.enabled THEN 'disabled'
+        WHEN gw.flagged
+            OR gw.ban_state IN ('DISABLE', 'SCHEDULE_FOR_DISABLE')
+            OR EXISTS (
+                SELECT 1 FROM jsonb_array_elements(gw.restrictions) r
+                WHERE coalesce((r->>'expiration')::timestamptz, 'infinity') > now()
+            )
+            THEN 'error'
+        ELSE 'active'
+    END AS status,
+    COALESCE(fb.page_id, 'N/A') AS contact,
+    ma.id::text AS provider_app_id,
+    g.created_at,
+    g.updated_at
+FROM im_provider.gates g
+LEFT JOIN im_provider.facebook fb ON g.id = fb.gate_id
+LEFT JOIN im_provider.meta_apps ma ON fb.meta_app_id = ma.id
+LEFT JOIN im_provider.gate_waba gw ON g.id = gw.id;
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+DROP VIEW IF EXISTS im_provider.gate_summary;
+
+CREATE VIEW im_provider.gate_summary AS
+SELECT
+    g.id,
+    g.name,
+    g.type,
+    CASE WHEN g.enabled THEN 'active' ELSE 'disabled' END AS status,
+    COALESCE(fb.page_id, 'N/A') AS contact,
+    ma.id::text AS provider_app_id,
+    g.created_at,
+    g.updated_at
+FROM im_provider.gates g
+LEFT JOIN im_provider.facebook fb ON g.id = fb.gate_id
+LEFT JOIN im_provider.meta_apps ma ON fb.meta_app_id = ma.id;
+
+alter table "im_provider"."gate_waba"
+  drop column if exists "quality_rating",
+  drop column if exists "messaging_limit_tier",
+  drop column if exists "flagged",
+  drop column if exists "ban_state",
+  drop column if exists "restrictions",
+  drop column if exists "health_updated_at";
+
+-- +goose StatementEnd
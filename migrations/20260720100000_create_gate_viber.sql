@@ -0,0 +1,76 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- Viber bot gateway settings. The auth token both authorizes the REST API calls and
+-- signs the webhook callbacks; one bot delivers callbacks to a single webhook only.
+CREATE TABLE IF NOT EXISTS im_provider.viber (
+    gate_id      UUID PRIMARY KEY REFERENCES im_provider.gates(id) ON DELETE CASCADE,
+    bot_id       TEXT NOT NULL UNIQUE,
+    bot_uri      TEXT NOT NULL DEFAULT '',
+    bot_token    TEXT NOT NULL,
+    webhook_url  TEXT NOT NULL DEFAULT ''
+);
+
+DROP VIEW IF EXISTS im_provider.gate_summary;
+
+CREATE VIEW im_provider.gate_summary AS
+SELECT
+    g.id,
+    g.name,
+    g.type,
+    CASE
+        WHEN NOT g.enabled THEN 'disabled'
+        WHEN gw.flagged
+            OR gw.ban_state IN ('DISABLE', 'SCHEDULE_FOR_DISABLE')
+            OR EXISTS (
+                SELECT 1 FROM jsonb_array_elements(gw.restrictions) r
+                WHERE coalesce((r->>'expiration')::timestamptz, 'infinity') > now()
+            )
+            THEN 'error'
+        ELSE 'active'
+    END AS status,
+    COALESCE(fb.page_id, NULLIF(vb.bot_uri, ''), 'N/A') AS contact,
+    ma.id::text AS provider_app_id,
+    g.created_at,
+    g.updated_at
+FROM im_provider.gates g
+LEFT JOIN im_provider.facebook fb ON g.id = fb.gate_id
+LEFT JOIN im_provider.meta_apps ma ON fb.meta_app_id = ma.id
+LEFT JOIN im_provider.gate_waba gw ON g.id = gw.id
+LEFT JOIN im_provider.viber vb ON g.id = vb.gate_id;
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+DROP VIEW IF EXISTS im_provider.gate_summary;
+
+CREATE VIEW im_provider.gate_summary AS
+SELECT
+    g.id,
+    g.name,
+    g.type,
+    CASE
+        WHEN NOT g.enabled THEN 'disabled'
+        WHEN gw.flagged
+            OR gw.ban_state IN ('DISABLE', 'SCHEDULE_FOR_DISABLE')
+            OR EXISTS (
+                SELECT 1 FROM jsonb_array_elements(gw.restrictions) r
+                WHERE coalesce((r->>'expiration')::timestamptz, 'infinity') > now()
+            )
+            THEN 'error'
+        ELSE 'active'
+    END AS status,
+    COALESCE(fb.page_id, 'N/A') AS contact,
+    ma.id::text AS provider_app_id,
+    g.created_at,
+    g.updated_at
+FROM im_provider.gates g
+LEFT JOIN im_provider.facebook fb ON g.id = fb.gate_id
+LEFT JOIN im_provider.meta_apps ma ON fb.meta_app_id = ma.id
+LEFT JOIN im_provider.gate_waba gw ON g.id = gw.id;
+
+DROP TABLE IF EXISTS im_provider.viber;
+
+-- +goose StatementEnd
@@ -0,0 +1,79 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- Custom webhook gateway settings. Inbound envelopes are posted to the gate
+-- webhook, outbound envelopes to callback_url; both are signed with the
+-- encrypted per-gate secret.
+CREATE TABLE IF NOT EXISTS im_provider.custom (
+    gate_id      UUID PRIMARY KEY REFERENCES im_provider.gates(id) ON DELETE CASCADE,
+    callback_url TEXT NOT NULL,
+    secret       TEXT NOT NULL
+);
+
+DROP VIEW IF EXISTS im_provider.gate_summary;
+
+CREATE VIEW im_provider.gate_summary AS
+SELECT
+    g.id,
+    g.name,
+    g.type,
+    CASE
+        WHEN NOT g.enabled THEN 'disabled'
+        WHEN gw.flagged
+            OR gw.ban_state IN ('DISABLE', 'SCHEDULE_FOR_DISABLE')
+            OR EXISTS (
+                SELECT 1 FROM jsonb_array_elements(gw.restrictions) r
+                WHERE coalesce((r->>'expiration')::timestamptz, 'infinity') > now()
+            )
+            THEN 'error'
+        ELSE 'active'
+    END AS status,
+    COALESCE(fb.page_id, NULLIF(vb.bot_uri, ''), ta.phone, cw.callback_url, 'N/A') AS contact,
+    ma.id::text AS provider_app_id,
+    g.created_at,
+    g.updated_at
+FROM im_provider.gates g
+LEFT JOIN im_provider.facebook fb ON g.id = fb.gate_id
+LEFT JOIN im_provider.meta_apps ma ON fb.meta_app_id = ma.id
+LEFT JOIN im_provider.gate_waba gw ON g.id = gw.id
+LEFT JOIN im_provider.viber vb ON g.id = vb.gate_id
+LEFT JOIN im_provider.telegram_app ta ON g.id = ta.gate_id
+LEFT JOIN im_provider.custom cw ON g.id = cw.gate_id;
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+DROP VIEW IF EXISTS im_provider.gate_summary;
+
+CREATE VIEW im_provider.gate_summary AS
+SELECT
+    g.id,
+    g.name,
+    g.type,
+    CASE
+        WHEN NOT g.enabled THEN 'disabled'
+        WHEN gw.flagged
+            OR gw.ban_state IN ('DISABLE', 'SCHEDULE_FOR_DISABLE')
+            OR EXISTS (
+                SELECT 1 FROM jsonb_array_elements(gw.restrictions) r
+                WHERE coalesce((r->>'expiration')::timestamptz, 'infinity') > now()
+            )
+            THEN 'error'
+        ELSE 'active'
+    END AS status,
+    COALESCE(fb.page_id, NULLIF(vb.bot_uri, ''), ta.phone, 'N/A') AS contact,
+    ma.id::text AS provider_app_id,
+    g.created_at,
+    g.updated_at
+FROM im_provider.gates g
+LEFT JOIN im_provider.facebook fb ON g.id = fb.gate_id
+LEFT JOIN im_provider.meta_apps ma ON fb.meta_app_id = ma.id
+LEFT JOIN im_provider.gate_waba gw ON g.id = gw.id
+LEFT JOIN im_provider.viber vb ON g.id = vb.gate_id
+LEFT JOIN im_provider.telegram_app ta ON g.id = ta.gate_id;
+
+DROP TABLE IF EXISTS im_provider.custom;
+
+-- +goose StatementEnd
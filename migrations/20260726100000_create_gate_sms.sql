@@ -0,0 +1,106 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- SMS gateway settings. SMPP gates bind to the SMSC at smpp_addr as a
+-- transceiver; HTTP gates post to http_send_url and receive the gateway
+-- events on the gate webhook. Passwords, API keys and secrets are encrypted.
+CREATE TABLE IF NOT EXISTS im_provider.sms (
+    gate_id          UUID PRIMARY KEY REFERENCES im_provider.gates(id) ON DELETE CASCADE,
+    backend          TEXT NOT NULL CHECK (backend IN ('smpp', 'http')),
+    sender           TEXT NOT NULL,
+    smpp_addr        TEXT NOT NULL DEFAULT '',
+    smpp_system_id   TEXT NOT NULL DEFAULT '',
+    smpp_password    TEXT NOT NULL DEFAULT '',
+    smpp_system_type TEXT NOT NULL DEFAULT '',
+    smpp_tls         BOOLEAN NOT NULL DEFAULT FALSE,
+    http_send_url    TEXT NOT NULL DEFAULT '',
+    http_api_key     TEXT NOT NULL DEFAULT '',
+    http_secret      TEXT NOT NULL DEFAULT ''
+);
+
+-- Outbound message parts by the ID the SMSC or gateway assigned them, with the
+-- status of their latest delivery report.
+CREATE TABLE IF NOT EXISTS im_provider.sms_sent (
+    gate_id     UUID NOT NULL REFERENCES im_provider.gates(id) ON DELETE CASCADE,
+    external_id TEXT NOT NULL,
+    message_id  TEXT NOT NULL,
+    recipient   TEXT NOT NULL,
+    status      TEXT NOT NULL,
+    reason      TEXT NOT NULL DEFAULT '',
+    created_at  TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at  TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (gate_id, external_id)
+);
+
+CREATE INDEX IF NOT EXISTS sms_sent_message_id_idx ON im_provider.sms_sent (message_id);
+
+DROP VIEW IF EXISTS im_provider.gate_summary;
+
+CREATE VIEW im_provider.gate_summary AS
+SELECT
+    g.id,
+    g.name,
+    g.type,
+    CASE
+        WHEN NOT g.enabled THEN 'disabled'
+        WHEN gw.flagged
+            OR gw.ban_state IN ('DISABLE', 'SCHEDULE_FOR_DISABLE')
+            OR EXISTS (
+                SELECT 1 FROM jsonb_array_elements(gw.restrictions) r
+                WHERE coalesce((r->>'expiration')::timestamptz, 'infinity') > now()
+            )
+            THEN 'error'
+        ELSE 'active'
+    END AS status,
+    COALESCE(fb.page_id, NULLIF(vb.bot_uri, ''), ta.phone, cw.callback_url, NULLIF(sm.sender, ''), 'N/A') AS contact,
+    ma.id::text AS provider_app_id,
+    g.created_at,
+    g.updated_at
+FROM im_provider.gates g
+LEFT JOIN im_provider.facebook fb ON g.id = fb.gate_id
+LEFT JOIN im_provider.meta_apps ma ON fb.meta_app_id = ma.id
+LEFT JOIN im_provider.gate_waba gw ON g.id = gw.id
+LEFT JOIN im_provider.viber vb ON g.id = vb.gate_id
+LEFT JOIN im_provider.telegram_app ta ON g.id = ta.gate_id
+LEFT JOIN im_provider.custom cw ON g.id = cw.gate_id
+LEFT JOIN im_provider.sms sm ON g.id = sm.gate_id;
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+DROP VIEW IF EXISTS im_provider.gate_summary;
+
+CREATE VIEW im_provider.gate_summary AS
+SELECT
+    g.id,
+    g.name,
+    g.type,
+    CASE
+        WHEN NOT g.enabled THEN 'disabled'
+        WHEN gw.flagged
+            OR gw.ban_state IN ('DISABLE', 'SCHEDULE_FOR_DISABLE')
+            OR EXISTS (
+                SELECT 1 FROM jsonb_array_elements(gw.restrictions) r
+                WHERE coalesce((r->>'expiration')::timestamptz, 'infinity') > now()
+            )
+            THEN 'error'
+        ELSE 'active'
+    END AS status,
+    COALESCE(fb.page_id, NULLIF(vb.bot_uri, ''), ta.phone, cw.callback_url, 'N/A') AS contact,
+    ma.id::text AS provider_app_id,
+    g.created_at,
+    g.updated_at
+FROM im_provider.gates g
+LEFT JOIN im_provider.facebook fb ON g.id = fb.gate_id
+LEFT JOIN im_provider.meta_apps ma ON fb.meta_app_id = ma.id
+LEFT JOIN im_provider.gate_waba gw ON g.id = gw.id
+LEFT JOIN im_provider.viber vb ON g.id = vb.gate_id
+LEFT JOIN im_provider.telegram_app ta ON g.id = ta.gate_id
+LEFT JOIN im_provider.custom cw ON g.id = cw.gate_id;
+
+DROP TABLE IF EXISTS im_provider.sms_sent;
+DROP TABLE IF EXISTS im_provider.sms;
+
+-- +goose StatementEnd
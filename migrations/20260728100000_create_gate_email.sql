@@ -0,0 +1,106 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- Email gate settings. Inbound mail is collected from the IMAP mailbox,
+-- replies are sent over SMTP from address. Passwords are encrypted.
+CREATE TABLE IF NOT EXISTS im_provider.email (
+    gate_id       UUID PRIMARY KEY REFERENCES im_provider.gates(id) ON DELETE CASCADE,
+    address       TEXT NOT NULL,
+    display_name  TEXT NOT NULL DEFAULT '',
+    mailbox       TEXT NOT NULL DEFAULT 'INBOX',
+    imap_host     TEXT NOT NULL,
+    imap_port     INTEGER NOT NULL,
+    imap_username TEXT NOT NULL,
+    imap_password TEXT NOT NULL DEFAULT '',
+    imap_tls      BOOLEAN NOT NULL DEFAULT TRUE,
+    smtp_host     TEXT NOT NULL,
+    smtp_port     INTEGER NOT NULL,
+    smtp_username TEXT NOT NULL DEFAULT '',
+    smtp_password TEXT NOT NULL DEFAULT '',
+    smtp_tls      BOOLEAN NOT NULL DEFAULT FALSE
+);
+
+-- The mail thread of every correspondent of a gate: the Message-ID replies
+-- answer and the references they carry, oldest first.
+CREATE TABLE IF NOT EXISTS im_provider.email_threads (
+    gate_id    UUID NOT NULL REFERENCES im_provider.gates(id) ON DELETE CASCADE,
+    contact    TEXT NOT NULL,
+    subject    TEXT NOT NULL DEFAULT '',
+    message_id TEXT NOT NULL DEFAULT '',
+    refs       TEXT[] NOT NULL DEFAULT '{}',
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (gate_id, contact)
+);
+
+DROP VIEW IF EXISTS im_provider.gate_summary;
+
+CREATE VIEW im_provider.gate_summary AS
+SELECT
+    g.id,
+    g.name,
+    g.type,
+    CASE
+        WHEN NOT g.enabled THEN 'disabled'
+        WHEN gw.flagged
+            OR gw.ban_state IN ('DISABLE', 'SCHEDULE_FOR_DISABLE')
+            OR EXISTS (
+                SELECT 1 FROM jsonb_array_elements(gw.restrictions) r
+                WHERE coalesce((r->>'expiration')::timestamptz, 'infinity') > now()
+            )
+            THEN 'error'
+        ELSE 'active'
+    END AS status,
+    COALESCE(fb.page_id, NULLIF(vb.bot_uri, ''), ta.phone, cw.callback_url, NULLIF(sm.sender, ''), NULLIF(em.address, ''), 'N/A') AS contact,
+    ma.id::text AS provider_app_id,
+    g.created_at,
+    g.updated_at
+FROM im_provider.gates g
+LEFT JOIN im_provider.facebook fb ON g.id = fb.gate_id
+LEFT JOIN im_provider.meta_apps ma ON fb.meta_app_id = ma.id
+LEFT JOIN im_provider.gate_waba gw ON g.id = gw.id
+LEFT JOIN im_provider.viber vb ON g.id = vb.gate_id
+LEFT JOIN im_provider.telegram_app ta ON g.id = ta.gate_id
+LEFT JOIN im_provider.custom cw ON g.id = cw.gate_id
+LEFT JOIN im_provider.sms sm ON g.id = sm.gate_id
+LEFT JOIN im_provider.email em ON g.id = em.gate_id;
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+DROP VIEW IF EXISTS im_provider.gate_summary;
+
+CREATE VIEW im_provider.gate_summary AS
+SELECT
+    g.id,
+    g.name,
+    g.type,
+    CASE
+        WHEN NOT g.enabled THEN 'disabled'
+        WHEN gw.flagged
+            OR gw.ban_state IN ('DISABLE', 'SCHEDULE_FOR_DISABLE')
+            OR EXISTS (
+                SELECT 1 FROM jsonb_array_elements(gw.restrictions) r
+                WHERE coalesce((r->>'expiration')::timestamptz, 'infinity') > now()
+            )
+            THEN 'error'
+        ELSE 'active'
+    END AS status,
+    COALESCE(fb.page_id, NULLIF(vb.bot_uri, ''), ta.phone, cw.callback_url, NULLIF(sm.sender, ''), 'N/A') AS contact,
+    ma.id::text AS provider_app_id,
+    g.created_at,
+    g.updated_at
+FROM im_provider.gates g
+LEFT JOIN im_provider.facebook fb ON g.id = fb.gate_id
+LEFT JOIN im_provider.meta_apps ma ON fb.meta_app_id = ma.id
+LEFT JOIN im_provider.gate_waba gw ON g.id = gw.id
+LEFT JOIN im_provider.viber vb ON g.id = vb.gate_id
+LEFT JOIN im_provider.telegram_app ta ON g.id = ta.gate_id
+LEFT JOIN im_provider.custom cw ON g.id = cw.gate_id
+LEFT JOIN im_provider.sms sm ON g.id = sm.gate_id;
+
+DROP TABLE IF EXISTS im_provider.email_threads;
+DROP TABLE IF EXISTS im_provider.email;
+
+-- +goose StatementEnd
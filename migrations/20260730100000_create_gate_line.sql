@@ -0,0 +1,88 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- LINE Official Account gate settings. The channel secret signs the webhook
+-- requests, the channel access token authorizes the Messaging API calls; both
+-- are encrypted. One bot delivers its webhooks to a single endpoint only.
+CREATE TABLE IF NOT EXISTS im_provider.line (
+    gate_id              UUID PRIMARY KEY REFERENCES im_provider.gates(id) ON DELETE CASCADE,
+    bot_user_id          TEXT NOT NULL UNIQUE,
+    basic_id             TEXT NOT NULL DEFAULT '',
+    channel_secret       TEXT NOT NULL,
+    channel_access_token TEXT NOT NULL,
+    webhook_url          TEXT NOT NULL DEFAULT ''
+);
+
+DROP VIEW IF EXISTS im_provider.gate_summary;
+
+CREATE VIEW im_provider.gate_summary AS
+SELECT
+    g.id,
+    g.name,
+    g.type,
+    CASE
+        WHEN NOT g.enabled THEN 'disabled'
+        WHEN gw.flagged
+            OR gw.ban_state IN ('DISABLE', 'SCHEDULE_FOR_DISABLE')
+            OR EXISTS (
+                SELECT 1 FROM jsonb_array_elements(gw.restrictions) r
+                WHERE coalesce((r->>'expiration')::timestamptz, 'infinity') > now()
+            )
+            THEN 'error'
+        ELSE 'active'
+    END AS status,
+    COALESCE(fb.page_id, NULLIF(vb.bot_uri, ''), ta.phone, cw.callback_url, NULLIF(sm.sender, ''), NULLIF(em.address, ''), NULLIF(ln.basic_id, ''), 'N/A') AS contact,
+    ma.id::text AS provider_app_id,
+    g.created_at,
+    g.updated_at
+FROM im_provider.gates g
+LEFT JOIN im_provider.facebook fb ON g.id = fb.gate_id
+LEFT JOIN im_provider.meta_apps ma ON fb.meta_app_id = ma.id
+LEFT JOIN im_provider.gate_waba gw ON g.id = gw.id
+LEFT JOIN im_provider.viber vb ON g.id = vb.gate_id
+LEFT JOIN im_provider.telegram_app ta ON g.id = ta.gate_id
+LEFT JOIN im_provider.custom cw ON g.id = cw.gate_id
+LEFT JOIN im_provider.sms sm ON g.id = sm.gate_id
+LEFT JOIN im_provider.email em ON g.id = em.gate_id
+LEFT JOIN im_provider.line ln ON g.id = ln.gate_id;
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+DROP VIEW IF EXISTS im_provider.gate_summary;
+
+CREATE VIEW im_provider.gate_summary AS
+SELECT
+    g.id,
+    g.name,
+    g.type,
+    CASE
+        WHEN NOT g.enabled THEN 'disabled'
+        WHEN gw.flagged
+            OR gw.ban_state IN ('DISABLE', 'SCHEDULE_FOR_DISABLE')
+            OR EXISTS (
+                SELECT 1 FROM jsonb_array_elements(gw.restrictions) r
+                WHERE coalesce((r->>'expiration')::timestamptz, 'infinity') > now()
+            )
+            THEN 'error'
+        ELSE 'active'
+    END AS status,
+    COALESCE(fb.page_id, NULLIF(vb.bot_uri, ''), ta.phone, cw.callback_url, NULLIF(sm.sender, ''), NULLIF(em.address, ''), 'N/A') AS contact,
+    ma.id::text AS provider_app_id,
+    g.created_at,
+    g.updated_at
+FROM im_provider.gates g
+LEFT JOIN im_provider.facebook fb ON g.id = fb.gate_id
+LEFT JOIN im_provider.meta_apps ma ON fb.meta_app_id = ma.id
+LEFT JOIN im_provider.gate_waba gw ON g.id = gw.id
+LEFT JOIN im_provider.viber vb ON g.id = vb.gate_id
+LEFT JOIN im_provider.telegram_app ta ON g.id = ta.gate_id
+LEFT JOIN im_provider.custom cw ON g.id = cw.gate_id
+LEFT JOIN im_provider.sms sm ON g.id = sm.gate_id
+LEFT JOIN im_provider.email em ON g.id = em.gate_id;
+
+DROP TABLE IF EXISTS im_provider.line;
+
+-- +goose StatementEnd
@@ -0,0 +1,106 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- Slack app installation gate settings. The bot token authorizes the Web API
+-- calls, the signing secret signs the Events API requests; both are encrypted.
+-- An app delivers the events of a workspace to a single request URL only.
+CREATE TABLE IF NOT EXISTS im_provider.slack (
+    gate_id        UUID PRIMARY KEY REFERENCES im_provider.gates(id) ON DELETE CASCADE,
+    team_id        TEXT NOT NULL,
+    team_name      TEXT NOT NULL DEFAULT '',
+    bot_user_id    TEXT NOT NULL UNIQUE,
+    bot_id         TEXT NOT NULL DEFAULT '',
+    bot_token      TEXT NOT NULL,
+    signing_secret TEXT NOT NULL,
+    webhook_url    TEXT NOT NULL DEFAULT ''
+);
+
+-- Azure Bot gate settings for Microsoft Teams. The app password (client secret)
+-- is encrypted. The service URL replies are posted to is learned from the
+-- activities, so it is empty until the bot receives the first one.
+CREATE TABLE IF NOT EXISTS im_provider.teams (
+    gate_id      UUID PRIMARY KEY REFERENCES im_provider.gates(id) ON DELETE CASCADE,
+    app_id       TEXT NOT NULL UNIQUE,
+    app_password TEXT NOT NULL,
+    tenant_id    TEXT NOT NULL DEFAULT '',
+    service_url  TEXT NOT NULL DEFAULT '',
+    webhook_url  TEXT NOT NULL DEFAULT ''
+);
+
+DROP VIEW IF EXISTS im_provider.gate_summary;
+
+CREATE VIEW im_provider.gate_summary AS
+SELECT
+    g.id,
+    g.name,
+    g.type,
+    CASE
+        WHEN NOT g.enabled THEN 'disabled'
+        WHEN gw.flagged
+            OR gw.ban_state IN ('DISABLE', 'SCHEDULE_FOR_DISABLE')
+            OR EXISTS (
+                SELECT 1 FROM jsonb_array_elements(gw.restrictions) r
+                WHERE coalesce((r->>'expiration')::timestamptz, 'infinity') > now()
+            )
+            THEN 'error'
+        ELSE 'active'
+    END AS status,
+    COALESCE(fb.page_id, NULLIF(vb.bot_uri, ''), ta.phone, cw.callback_url, NULLIF(sm.sender, ''), NULLIF(em.address, ''), NULLIF(ln.basic_id, ''), NULLIF(sk.team_name, ''), tm.app_id, 'N/A') AS contact,
+    ma.id::text AS provider_app_id,
+    g.created_at,
+    g.updated_at
+FROM im_provider.gates g
+LEFT JOIN im_provider.facebook fb ON g.id = fb.gate_id
+LEFT JOIN im_provider.meta_apps ma ON fb.meta_app_id = ma.id
+LEFT JOIN im_provider.gate_waba gw ON g.id = gw.id
+LEFT JOIN im_provider.viber vb ON g.id = vb.gate_id
+LEFT JOIN im_provider.telegram_app ta ON g.id = ta.gate_id
+LEFT JOIN im_provider.custom cw ON g.id = cw.gate_id
+LEFT JOIN im_provider.sms sm ON g.id = sm.gate_id
+LEFT JOIN im_provider.email em ON g.id = em.gate_id
+LEFT JOIN im_provider.line ln ON g.id = ln.gate_id
+LEFT JOIN im_provider.slack sk ON g.id = sk.gate_id
+LEFT JOIN im_provider.teams tm ON g.id = tm.gate_id;
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+DROP VIEW IF EXISTS im_provider.gate_summary;
+
+CREATE VIEW im_provider.gate_summary AS
+SELECT
+    g.id,
+    g.name,
+    g.type,
+    CASE
+        WHEN NOT g.enabled THEN 'disabled'
+        WHEN gw.flagged
+            OR gw.ban_state IN ('DISABLE', 'SCHEDULE_FOR_DISABLE')
+            OR EXISTS (
+                SELECT 1 FROM jsonb_array_elements(gw.restrictions) r
+                WHERE coalesce((r->>'expiration')::timestamptz, 'infinity') > now()
+            )
+            THEN 'error'
+        ELSE 'active'
+    END AS status,
+    COALESCE(fb.page_id, NULLIF(vb.bot_uri, ''), ta.phone, cw.callback_url, NULLIF(sm.sender, ''), NULLIF(em.address, ''), NULLIF(ln.basic_id, ''), 'N/A') AS contact,
+    ma.id::text AS provider_app_id,
+    g.created_at,
+    g.updated_at
+FROM im_provider.gates g
+LEFT JOIN im_provider.facebook fb ON g.id = fb.gate_id
+LEFT JOIN im_provider.meta_apps ma ON fb.meta_app_id = ma.id
+LEFT JOIN im_provider.gate_waba gw ON g.id = gw.id
+LEFT JOIN im_provider.viber vb ON g.id = vb.gate_id
+LEFT JOIN im_provider.telegram_app ta ON g.id = ta.gate_id
+LEFT JOIN im_provider.custom cw ON g.id = cw.gate_id
+LEFT JOIN im_provider.sms sm ON g.id = sm.gate_id
+LEFT JOIN im_provider.email em ON g.id = em.gate_id
+LEFT JOIN im_provider.line ln ON g.id = ln.gate_id;
+
+DROP TABLE IF EXISTS im_provider.teams;
+DROP TABLE IF EXISTS im_provider.slack;
+
+-- +goose StatementEnd
@@ -0,0 +1,94 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- Web chat gate settings. The signing key signs the tokens of the visitor sessions,
+-- so it is encrypted. The widget may be embedded on the allowed origins only, on
+-- any website when there are none.
+CREATE TABLE IF NOT EXISTS im_provider.webchat (
+    gate_id         UUID PRIMARY KEY REFERENCES im_provider.gates(id) ON DELETE CASCADE,
+    allowed_origins TEXT[] NOT NULL DEFAULT '{}',
+    signing_key     TEXT NOT NULL,
+    endpoint_url    TEXT NOT NULL DEFAULT ''
+);
+
+DROP VIEW IF EXISTS im_provider.gate_summary;
+
+CREATE VIEW im_provider.gate_summary AS
+SELECT
+    g.id,
+    g.name,
+    g.type,
+    CASE
+        WHEN NOT g.enabled THEN 'disabled'
+        WHEN gw.flagged
+            OR gw.ban_state IN ('DISABLE', 'SCHEDULE_FOR_DISABLE')
+            OR EXISTS (
+                SELECT 1 FROM jsonb_array_elements(gw.restrictions) r
+                WHERE coalesce((r->>'expiration')::timestamptz, 'infinity') > now()
+            )
+            THEN 'error'
+        ELSE 'active'
+    END AS status,
+    COALESCE(fb.page_id, NULLIF(vb.bot_uri, ''), ta.phone, cw.callback_url, NULLIF(sm.sender, ''), NULLIF(em.address, ''), NULLIF(ln.basic_id, ''), NULLIF(sk.team_name, ''), tm.app_id, NULLIF(ab.business_id, ''), wc.allowed_origins[1], 'N/A') AS contact,
+    ma.id::text AS provider_app_id,
+    g.created_at,
+    g.updated_at
+FROM im_provider.gates g
+LEFT JOIN im_provider.facebook fb ON g.id = fb.gate_id
+LEFT JOIN im_provider.meta_apps ma ON fb.meta_app_id = ma.id
+LEFT JOIN im_provider.gate_waba gw ON g.id = gw.id
+LEFT JOIN im_provider.viber vb ON g.id = vb.gate_id
+LEFT JOIN im_provider.telegram_app ta ON g.id = ta.gate_id
+LEFT JOIN im_provider.custom cw ON g.id = cw.gate_id
+LEFT JOIN im_provider.sms sm ON g.id = sm.gate_id
+LEFT JOIN im_provider.email em ON g.id = em.gate_id
+LEFT JOIN im_provider.line ln ON g.id = ln.gate_id
+LEFT JOIN im_provider.slack sk ON g.id = sk.gate_id
+LEFT JOIN im_provider.teams tm ON g.id = tm.gate_id
+LEFT JOIN im_provider.apple_business ab ON g.id = ab.gate_id
+LEFT JOIN im_provider.webchat wc ON g.id = wc.gate_id;
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+DROP VIEW IF EXISTS im_provider.gate_summary;
+
+CREATE VIEW im_provider.gate_summary AS
+SELECT
+    g.id,
+    g.name,
+    g.type,
+    CASE
+        WHEN NOT g.enabled THEN 'disabled'
+        WHEN gw.flagged
+            OR gw.ban_state IN ('DISABLE', 'SCHEDULE_FOR_DISABLE')
+            OR EXISTS (
+                SELECT 1 FROM jsonb_array_elements(gw.restrictions) r
+                WHERE coalesce((r->>'expiration')::timestamptz, 'infinity') > now()
+            )
+            THEN 'error'
+        ELSE 'active'
+    END AS status,
+    COALESCE(fb.page_id, NULLIF(vb.bot_uri, ''), ta.phone, cw.callback_url, NULLIF(sm.sender, ''), NULLIF(em.address, ''), NULLIF(ln.basic_id, ''), NULLIF(sk.team_name, ''), tm.app_id, NULLIF(ab.business_id, ''), 'N/A') AS contact,
+    ma.id::text AS provider_app_id,
+    g.created_at,
+    g.updated_at
+FROM im_provider.gates g
+LEFT JOIN im_provider.facebook fb ON g.id = fb.gate_id
+LEFT JOIN im_provider.meta_apps ma ON fb.meta_app_id = ma.id
+LEFT JOIN im_provider.gate_waba gw ON g.id = gw.id
+LEFT JOIN im_provider.viber vb ON g.id = vb.gate_id
+LEFT JOIN im_provider.telegram_app ta ON g.id = ta.gate_id
+LEFT JOIN im_provider.custom cw ON g.id = cw.gate_id
+LEFT JOIN im_provider.sms sm ON g.id = sm.gate_id
+LEFT JOIN im_provider.email em ON g.id = em.gate_id
+LEFT JOIN im_provider.line ln ON g.id = ln.gate_id
+LEFT JOIN im_provider.slack sk ON g.id = sk.gate_id
+LEFT JOIN im_provider.teams tm ON g.id = tm.gate_id
+LEFT JOIN im_provider.apple_business ab ON g.id = ab.gate_id;
+
+DROP TABLE IF EXISTS im_provider.webchat;
+
+-- +goose StatementEnd
@@ -0,0 +1,101 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- VK community gate settings. The access token authorizes the API calls of the
+-- community and the secret key authenticates the requests of its callback server,
+-- so both are encrypted. The confirmation code is answered to VK when it confirms
+-- the callback server.
+CREATE TABLE IF NOT EXISTS im_provider.vk (
+    gate_id           UUID PRIMARY KEY REFERENCES im_provider.gates(id) ON DELETE CASCADE,
+    group_id          BIGINT NOT NULL UNIQUE,
+    screen_name       TEXT NOT NULL DEFAULT '',
+    access_token      TEXT NOT NULL,
+    secret_key        TEXT NOT NULL,
+    confirmation_code TEXT NOT NULL,
+    server_id         BIGINT NOT NULL DEFAULT 0,
+    webhook_url       TEXT NOT NULL DEFAULT ''
+);
+
+DROP VIEW IF EXISTS im_provider.gate_summary;
+
+CREATE VIEW im_provider.gate_summary AS
+SELECT
+    g.id,
+    g.name,
+    g.type,
+    CASE
+        WHEN NOT g.enabled THEN 'disabled'
+        WHEN gw.flagged
+            OR gw.ban_state IN ('DISABLE', 'SCHEDULE_FOR_DISABLE')
+            OR EXISTS (
+                SELECT 1 FROM jsonb_array_elements(gw.restrictions) r
+                WHERE coalesce((r->>'expiration')::timestamptz, 'infinity') > now()
+            )
+            THEN 'error'
+        ELSE 'active'
+    END AS status,
+    COALESCE(fb.page_id, NULLIF(vb.bot_uri, ''), ta.phone, cw.callback_url, NULLIF(sm.sender, ''), NULLIF(em.address, ''), NULLIF(ln.basic_id, ''), NULLIF(sk.team_name, ''), tm.app_id, NULLIF(ab.business_id, ''), wc.allowed_origins[1], NULLIF(vk.screen_name, ''), 'N/A') AS contact,
+    ma.id::text AS provider_app_id,
+    g.created_at,
+    g.updated_at
+FROM im_provider.gates g
+LEFT JOIN im_provider.facebook fb ON g.id = fb.gate_id
+LEFT JOIN im_provider.meta_apps ma ON fb.meta_app_id = ma.id
+LEFT JOIN im_provider.gate_waba gw ON g.id = gw.id
+LEFT JOIN im_provider.viber vb ON g.id = vb.gate_id
+LEFT JOIN im_provider.telegram_app ta ON g.id = ta.gate_id
+LEFT JOIN im_provider.custom cw ON g.id = cw.gate_id
+LEFT JOIN im_provider.sms sm ON g.id = sm.gate_id
+LEFT JOIN im_provider.email em ON g.id = em.gate_id
+LEFT JOIN im_provider.line ln ON g.id = ln.gate_id
+LEFT JOIN im_provider.slack sk ON g.id = sk.gate_id
+LEFT JOIN im_provider.teams tm ON g.id = tm.gate_id
+LEFT JOIN im_provider.apple_business ab ON g.id = ab.gate_id
+LEFT JOIN im_provider.webchat wc ON g.id = wc.gate_id
+LEFT JOIN im_provider.vk vk ON g.id = vk.gate_id;
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+DROP VIEW IF EXISTS im_provider.gate_summary;
+
+CREATE VIEW im_provider.gate_summary AS
+SELECT
+    g.id,
+    g.name,
+    g.type,
+    CASE
+        WHEN NOT g.enabled THEN 'disabled'
+        WHEN gw.flagged
+            OR gw.ban_state IN ('DISABLE', 'SCHEDULE_FOR_DISABLE')
+            OR EXISTS (
+                SELECT 1 FROM jsonb_array_elements(gw.restrictions) r
+                WHERE coalesce((r->>'expiration')::timestamptz, 'infinity') > now()
+            )
+            THEN 'error'
+        ELSE 'active'
+    END AS status,
+    COALESCE(fb.page_id, NULLIF(vb.bot_uri, ''), ta.phone, cw.callback_url, NULLIF(sm.sender, ''), NULLIF(em.address, ''), NULLIF(ln.basic_id, ''), NULLIF(sk.team_name, ''), tm.app_id, NULLIF(ab.business_id, ''), wc.allowed_origins[1], 'N/A') AS contact,
+    ma.id::text AS provider_app_id,
+    g.created_at,
+    g.updated_at
+FROM im_provider.gates g
+LEFT JOIN im_provider.facebook fb ON g.id = fb.gate_id
+LEFT JOIN im_provider.meta_apps ma ON fb.meta_app_id = ma.id
+LEFT JOIN im_provider.gate_waba gw ON g.id = gw.id
+LEFT JOIN im_provider.viber vb ON g.id = vb.gate_id
+LEFT JOIN im_provider.telegram_app ta ON g.id = ta.gate_id
+LEFT JOIN im_provider.custom cw ON g.id = cw.gate_id
+LEFT JOIN im_provider.sms sm ON g.id = sm.gate_id
+LEFT JOIN im_provider.email em ON g.id = em.gate_id
+LEFT JOIN im_provider.line ln ON g.id = ln.gate_id
+LEFT JOIN im_provider.slack sk ON g.id = sk.gate_id
+LEFT JOIN im_provider.teams tm ON g.id = tm.gate_id
+LEFT JOIN im_provider.apple_business ab ON g.id = ab.gate_id
+LEFT JOIN im_provider.webchat wc ON g.id = wc.gate_id;
+
+DROP TABLE IF EXISTS im_provider.vk;
+
+-- +goose StatementEnd